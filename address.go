@@ -2,10 +2,42 @@ package capnp
 
 import (
 	"strconv"
+	"sync/atomic"
 
 	"capnproto.org/go/capnp/v3/internal/str"
 )
 
+// Offset and size arithmetic in this package goes through the methods
+// in this file.  The checked methods report whether the result is in
+// range, and must be used on values derived from a message.  The
+// unchecked methods are for values that have already been validated;
+// with invariant checks enabled, they verify that assumption.
+
+var invariantChecks atomic.Bool
+
+// SetInvariantChecks enables or disables invariant checks, and returns
+// the previous setting.  With checks enabled, the offset and size
+// arithmetic that the package does without overflow checks, because
+// its operands were validated earlier, verifies that it does not
+// overflow and panics if it does.  Such a panic is a bug in the
+// package, for instance validation that a crafted message got past.
+// The checks slow down message access, so they are meant for tests
+// and fuzzing.  They are disabled by default.
+func SetInvariantChecks(enabled bool) (prev bool) {
+	return invariantChecks.Swap(enabled)
+}
+
+// InvariantChecks reports whether invariant checks are enabled.
+func InvariantChecks() bool {
+	return invariantChecks.Load()
+}
+
+// invariantViolated panics with a message describing a failed
+// invariant check.
+func invariantViolated(msg string) {
+	panic("capnp: invariant violated: " + msg)
+}
+
 // An address is an index inside a segment's data (in bytes).
 // It is bounded to [0, maxSegmentSize).
 type address uint32
@@ -32,9 +64,22 @@ func (a address) addSize(sz Size) (_ address, ok bool) {
 
 // addSizeUnchecked returns a+sz without any overflow checking.
 func (a address) addSizeUnchecked(sz Size) address {
+	if invariantChecks.Load() {
+		if _, ok := a.addSize(sz); !ok {
+			invariantViolated("address " + a.String() + " + " + sz.String() + " overflows")
+		}
+	}
 	return a + address(sz)
 }
 
+// subSizeUnchecked returns a-sz without any underflow checking.
+func (a address) subSizeUnchecked(sz Size) address {
+	if invariantChecks.Load() && Size(a) < sz {
+		invariantViolated("address " + a.String() + " - " + sz.String() + " underflows")
+	}
+	return a - address(sz)
+}
+
 // element returns the address a+i*sz.  ok is false if the result would
 // be an invalid address.
 func (a address) element(i int32, sz Size) (_ address, ok bool) {
@@ -84,12 +129,20 @@ func (sz Size) times(n int32) (_ Size, ok bool) {
 
 // timesUnchecked returns sz*n without any overflow or negative checking.
 func (sz Size) timesUnchecked(n int32) Size {
+	if invariantChecks.Load() {
+		if _, ok := sz.times(n); !ok {
+			invariantViolated(sz.String() + " * " + str.Itod(n) + " overflows")
+		}
+	}
 	return sz * Size(n)
 }
 
 // padToWord adds padding to sz to make it divisible by wordSize.
 // The result is undefined if sz > maxSegmentSize.
 func (sz Size) padToWord() Size {
+	if invariantChecks.Load() && sz > maxSegmentSize {
+		invariantViolated("padding " + sz.String() + " overflows")
+	}
 	n := Size(wordSize - 1)
 	return (sz + n) &^ n
 }
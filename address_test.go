@@ -1,9 +1,18 @@
 package capnp
 
 import (
+	"math"
+	"os"
 	"testing"
 )
 
+func TestMain(m *testing.M) {
+	// Run the package's tests with invariant checks, so that they catch
+	// unchecked arithmetic that overflows.
+	SetInvariantChecks(true)
+	os.Exit(m.Run())
+}
+
 func TestAddressAddSize(t *testing.T) {
 	tests := []struct {
 		a   address
@@ -99,3 +108,72 @@ func TestSizeTimes(t *testing.T) {
 		}
 	}
 }
+
+func TestInvariantChecks(t *testing.T) {
+	if !InvariantChecks() {
+		t.Fatal("invariant checks are not enabled in tests")
+	}
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"addSizeUnchecked", func() { address(0xfffffff8).addSizeUnchecked(8) }},
+		{"subSizeUnchecked", func() { address(0).subSizeUnchecked(wordSize) }},
+		{"timesUnchecked", func() { wordSize.timesUnchecked(0x20000000) }},
+		{"padToWord", func() { Size(0xfffffff9).padToWord() }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic on overflow", test.name)
+				}
+			}()
+			test.f()
+		}()
+	}
+	if got := address(16).subSizeUnchecked(wordSize); got != 8 {
+		t.Errorf("address(16).subSizeUnchecked(8) = %v; want 8", got)
+	}
+}
+
+func FuzzAddressAddSize(f *testing.F) {
+	f.Add(uint32(0), uint32(0))
+	f.Add(uint32(0xfffffff8), uint32(0))
+	f.Add(uint32(0xfffffff7), uint32(1))
+	f.Add(uint32(0xffffffff), uint32(0xffffffff))
+	f.Fuzz(func(t *testing.T, a, sz uint32) {
+		out, ok := address(a).addSize(Size(sz))
+		want := uint64(a) + uint64(sz)
+		if wantOK := want <= uint64(maxSegmentSize); ok != wantOK || (ok && uint64(out) != want) {
+			t.Errorf("address(%#x).addSize(%#x) = %v, %t; want %#x, %t", a, sz, out, ok, want, wantOK)
+		}
+	})
+}
+
+func FuzzAddressElement(f *testing.F) {
+	f.Add(uint32(0), int32(0), uint32(8))
+	f.Add(uint32(8), int32(-1), uint32(8))
+	f.Add(uint32(0), int32(0x1fffffff), uint32(8))
+	f.Add(uint32(0xffffffff), int32(math.MinInt32), uint32(0xffffffff))
+	f.Fuzz(func(t *testing.T, a uint32, i int32, sz uint32) {
+		out, ok := address(a).element(i, Size(sz))
+		want := int64(a) + int64(i)*int64(sz)
+		if wantOK := want >= 0 && want <= int64(maxSegmentSize); ok != wantOK || (ok && int64(out) != want) {
+			t.Errorf("address(%#x).element(%d, %#x) = %v, %t; want %#x, %t", a, i, sz, out, ok, want, wantOK)
+		}
+	})
+}
+
+func FuzzSizeTimes(f *testing.F) {
+	f.Add(uint32(8), int32(0x1fffffff))
+	f.Add(uint32(8), int32(0x20000000))
+	f.Add(uint32(0xffffffff), int32(math.MinInt32))
+	f.Fuzz(func(t *testing.T, sz uint32, n int32) {
+		out, ok := Size(sz).times(n)
+		want := int64(sz) * int64(n)
+		if wantOK := want >= 0 && want <= int64(maxSegmentSize); ok != wantOK || (ok && int64(out) != want) {
+			t.Errorf("Size(%#x).times(%d) = %v, %t; want %#x, %t", sz, n, out, ok, want, wantOK)
+		}
+	})
+}
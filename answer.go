@@ -397,6 +397,11 @@ func (f *Future) transform() []PipelineOp {
 	return xform
 }
 
+// Answer returns the answer that f accesses a portion of.
+func (f *Future) Answer() *Answer {
+	return f.promise.Answer()
+}
+
 // Done returns a channel that is closed when the answer's call is finished.
 func (f *Future) Done() <-chan struct{} {
 	return f.promise.resolved
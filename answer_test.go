@@ -127,6 +127,79 @@ func TestPromiseFulfill(t *testing.T) {
 	})
 }
 
+func TestFutureAwait(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Progress", func(t *testing.T) {
+		_, seg := NewSingleSegmentMessage(nil)
+		root, _ := NewStruct(seg, ObjectSize{PointerCount: 1})
+		inner, _ := NewStruct(seg, ObjectSize{PointerCount: 2})
+		if err := inner.SetText(1, "hi"); err != nil {
+			t.Fatal(err)
+		}
+		if err := root.SetPtr(0, inner.ToPtr()); err != nil {
+			t.Fatal(err)
+		}
+		p := NewPromise(dummyMethod, dummyPipelineCaller{}, nil)
+		defer p.ReleaseClients()
+		f := p.Answer().Field(0, nil).Field(1, nil)
+		p.Fulfill(root.ToPtr())
+
+		var steps []AwaitProgress
+		ptr, err := f.Await(context.Background(), func(pr AwaitProgress) {
+			steps = append(steps, pr)
+		})
+		if err != nil {
+			t.Fatal("Await:", err)
+		}
+		if ptr.Text() != "hi" {
+			t.Errorf("Await = %q; want \"hi\"", ptr.Text())
+		}
+		want := []struct {
+			step  AwaitStep
+			depth int
+		}{{AwaitReturned, 0}, {AwaitField, 1}, {AwaitField, 2}}
+		if len(steps) != len(want) {
+			t.Fatalf("observed %d steps; want %d", len(steps), len(want))
+		}
+		for i := range want {
+			if steps[i].Step != want[i].step || steps[i].Depth != want[i].depth || steps[i].Steps != 2 {
+				t.Errorf("step %d = %v at depth %d of %d; want %v at depth %d of 2", i, steps[i].Step, steps[i].Depth, steps[i].Steps, want[i].step, want[i].depth)
+			}
+		}
+		if steps[1].Ptr.Struct().Segment() != seg || steps[1].Ptr.Struct().Size() != inner.Size() {
+			t.Error("AwaitField at depth 1 did not report the inner struct")
+		}
+	})
+	t.Run("Reject", func(t *testing.T) {
+		p := NewPromise(dummyMethod, dummyPipelineCaller{}, nil)
+		defer p.ReleaseClients()
+		p.Reject(errors.New("omg bbq"))
+		called := false
+		_, err := p.Answer().Field(0, nil).Await(context.Background(), func(AwaitProgress) {
+			called = true
+		})
+		if err == nil || !strings.Contains(err.Error(), "omg bbq") {
+			t.Errorf("Await error = %v; want message containing \"omg bbq\"", err)
+		}
+		if called {
+			t.Error("observer called for rejected answer")
+		}
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		p := NewPromise(dummyMethod, dummyPipelineCaller{}, nil)
+		defer func() {
+			p.Reject(errors.New("done"))
+			p.ReleaseClients()
+		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := p.Answer().Future().Await(ctx, nil); !errors.Is(err, context.Canceled) {
+			t.Errorf("Await error = %v; want %v", err, context.Canceled)
+		}
+	})
+}
+
 type dummyPipelineCaller struct{}
 
 func (dummyPipelineCaller) PipelineRecv(ctx context.Context, transform []PipelineOp, r Recv) PipelineCaller {
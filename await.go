@@ -0,0 +1,92 @@
+package capnp
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/util/sync/mutex"
+)
+
+// An AwaitStep identifies the kind of progress that Future.Await
+// reports.
+type AwaitStep int
+
+const (
+	// AwaitReturned is reported when the call that the future belongs
+	// to has returned.  The progress's Ptr is the call's results, which
+	// are a partial result if the future is for a field of them.
+	AwaitReturned AwaitStep = iota
+
+	// AwaitField is reported for each pipeline operation that is
+	// applied to the results on the way to the future's value, in
+	// order.  The progress's Ptr is the value of the field.
+	AwaitField
+)
+
+// String returns the name of s.
+func (s AwaitStep) String() string {
+	switch s {
+	case AwaitReturned:
+		return "returned"
+	case AwaitField:
+		return "field"
+	default:
+		return "AwaitStep(" + str.Itod(int(s)) + ")"
+	}
+}
+
+// AwaitProgress describes a step towards resolving a Future.
+type AwaitProgress struct {
+	Step AwaitStep
+
+	// Depth is the number of pipeline operations that have been
+	// applied to the call's results: 0 for AwaitReturned, and from 1
+	// to Steps for AwaitField.
+	Depth int
+
+	// Steps is the number of pipeline operations between the call's
+	// results and the future's value.
+	Steps int
+
+	// Ptr is the value at Depth.
+	Ptr Ptr
+}
+
+// Await waits until the future is resolved or ctx is done and returns
+// the pointer that the future represents, like Ptr.  If observe is not
+// nil, it is called with each step towards the future's value, so that
+// long chains of pipelined calls can report their progress.  observe
+// is called from the goroutine that called Await.
+//
+// If ctx is done first, Await returns ctx.Err().  The call is not
+// canceled: canceling the Context that the call was made with does
+// that.
+func (f *Future) Await(ctx context.Context, observe func(AwaitProgress)) (Ptr, error) {
+	p := f.promise
+	select {
+	case <-p.resolved:
+	case <-ctx.Done():
+		return Ptr{}, ctx.Err()
+	}
+	r := mutex.With1(&p.state, func(s *promiseState) resolution {
+		return s.resolution(p.method)
+	})
+	transform := f.transform()
+	if observe == nil || r.err != nil {
+		return r.ptr(transform)
+	}
+	observe(AwaitProgress{Step: AwaitReturned, Steps: len(transform), Ptr: r.result})
+	var ptr Ptr
+	for i := range transform {
+		var err error
+		ptr, err = r.ptr(transform[:i+1])
+		if err != nil {
+			return Ptr{}, err
+		}
+		observe(AwaitProgress{Step: AwaitField, Depth: i + 1, Steps: len(transform), Ptr: ptr})
+	}
+	if len(transform) == 0 {
+		ptr = r.result
+	}
+	return ptr, nil
+}
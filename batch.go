@@ -0,0 +1,173 @@
+package capnp
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"capnproto.org/go/capnp/v3/util/rc"
+	"capnproto.org/go/capnp/v3/util/sync/mutex"
+)
+
+// A BatchSender is a ClientHook that can send several calls in a
+// single burst, such as a single write to a network connection.
+// Client.Batch uses it when the client's hook implements it.
+type BatchSender interface {
+	ClientHook
+
+	// SendBatch is like calling Send with each of the calls, in order,
+	// and returns the answers and release functions in the same order.
+	SendBatch(calls []BatchCall) ([]*Answer, []ReleaseFunc)
+}
+
+// A BatchCall is a call in a batch.
+type BatchCall struct {
+	Ctx  context.Context
+	Send Send
+}
+
+// A Batch accumulates calls on a client and sends them together when
+// Flush is called, so that a chatty client pays the per-message cost
+// of its transport once per batch instead of once per call.  A Batch
+// is not safe to use from multiple goroutines.
+type Batch struct {
+	c     Client
+	calls []*batchCall
+}
+
+// batchCall is a call that has been added to a batch.  Its promise is
+// resolved with the results of the real call once that returns.
+type batchCall struct {
+	BatchCall
+	p *Promise
+
+	mu       sync.Mutex
+	flushed  bool // set by Flush
+	canceled bool // released before it was flushed
+	release  ReleaseFunc
+}
+
+// Batch returns an empty batch of calls on c.  The client reference is
+// borrowed: c must not be released until the batch has been flushed.
+func (c Client) Batch() *Batch {
+	return &Batch{c: c}
+}
+
+// Len returns the number of calls that have been added since the
+// batch was last flushed.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Add adds a call to the batch and returns its future.  The call is
+// not sent until Flush is called, and calls pipelined on the future
+// are queued until the call returns.  The caller must call the
+// returned release function when it no longer needs the answer's
+// data; releasing a call before it is flushed removes it from the
+// batch.
+func (b *Batch) Add(ctx context.Context, s Send) (*Future, ReleaseFunc) {
+	aq := NewAnswerQueue(s.Method)
+	bc := &batchCall{
+		BatchCall: BatchCall{Ctx: ctx, Send: s},
+		p:         NewPromise(s.Method, aq, aq),
+	}
+	b.calls = append(b.calls, bc)
+	return bc.p.Answer().Future(), bc.releaseFunc()
+}
+
+func (bc *batchCall) releaseFunc() ReleaseFunc {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			bc.mu.Lock()
+			if !bc.flushed {
+				bc.canceled = true
+				bc.mu.Unlock()
+				bc.p.Reject(errors.New("batch call released before flush"))
+				bc.p.ReleaseClients()
+				return
+			}
+			bc.mu.Unlock()
+			<-bc.p.resolved
+			bc.p.ReleaseClients()
+			bc.release()
+		})
+	}
+}
+
+// Flush sends the calls that have been added since the batch was last
+// flushed and empties the batch.  If the client's hook is a
+// BatchSender, the calls are sent in a single burst; otherwise, they
+// are sent one at a time, in the order they were added.
+func (b *Batch) Flush() {
+	calls := b.calls[:0]
+	for _, bc := range b.calls {
+		bc.mu.Lock()
+		if !bc.canceled {
+			bc.flushed = true
+			calls = append(calls, bc)
+		}
+		bc.mu.Unlock()
+	}
+	b.calls = nil
+	if len(calls) == 0 {
+		return
+	}
+
+	h, _, released := b.c.startCall()
+	defer h.Release()
+	var answers []*Answer
+	var releases []ReleaseFunc
+	if bs, ok := b.batchSender(h, released); ok {
+		answers, releases = b.sendBatch(bs, calls)
+	} else {
+		answers = make([]*Answer, len(calls))
+		releases = make([]ReleaseFunc, len(calls))
+		for i, bc := range calls {
+			answers[i], releases[i] = b.c.SendCall(bc.Ctx, bc.Send)
+		}
+	}
+	for i, bc := range calls {
+		bc.release = releases[i]
+		go bc.forward(answers[i])
+	}
+}
+
+// batchSender returns the client's hook h if it is a BatchSender that
+// calls can be sent to.  Otherwise, SendCall reports why they can't.
+func (b *Batch) batchSender(h *rc.Ref[clientHook], released bool) (BatchSender, bool) {
+	if released || h == nil {
+		return nil, false
+	}
+	if mutex.With1(&b.c.state, func(c *clientState) error { return c.stream.err }) != nil {
+		return nil, false
+	}
+	bs, ok := h.Value().ClientHook.(BatchSender)
+	return bs, ok
+}
+
+// sendBatch sends calls with bs, respecting the client's flow limiter.
+func (b *Batch) sendBatch(bs BatchSender, calls []*batchCall) ([]*Answer, []ReleaseFunc) {
+	batch := make([]BatchCall, len(calls))
+	sent := make([]func(*Answer), len(calls))
+	for i, bc := range calls {
+		batch[i] = bc.BatchCall
+		sent[i] = b.c.limitFlow(bc.Ctx, &batch[i].Send)
+	}
+	answers, releases := bs.SendBatch(batch)
+	for i, ans := range answers {
+		sent[i](ans)
+		releases[i] = trackAnswerRelease(releases[i])
+	}
+	return answers, releases
+}
+
+// forward resolves bc's promise with the outcome of ans.
+func (bc *batchCall) forward(ans *Answer) {
+	<-ans.Done()
+	p := ans.f.promise
+	r := mutex.With1(&p.state, func(s *promiseState) resolution {
+		return s.resolution(p.method)
+	})
+	bc.p.Resolve(r.result, r.err)
+}
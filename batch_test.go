@@ -0,0 +1,90 @@
+package capnp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	send := func(id uint16) Send {
+		return Send{Method: Method{InterfaceID: 0xa7317bd7216570aa, MethodID: id}}
+	}
+
+	t.Run("BatchSender", func(t *testing.T) {
+		h := &batchHook{dummyHook: new(dummyHook)}
+		c := NewClient(h)
+		defer c.Release()
+
+		b := c.Batch()
+		var futures []*Future
+		for id := uint16(1); id <= 3; id++ {
+			f, release := b.Add(ctx, send(id))
+			defer release()
+			futures = append(futures, f)
+		}
+		_, release := b.Add(ctx, send(4))
+		release()
+		require.Equal(t, 4, b.Len())
+		select {
+		case <-futures[0].Done():
+			t.Fatal("call resolved before flush")
+		default:
+		}
+
+		b.Flush()
+		assert.Equal(t, 0, b.Len())
+		assert.Equal(t, [][]uint16{{1, 2, 3}}, h.batches, "calls not sent in a single batch")
+		assert.Equal(t, 0, h.calls)
+		for i, f := range futures {
+			s, err := f.Struct()
+			require.NoError(t, err)
+			assert.Equal(t, uint64(i+1), s.Uint64(0))
+		}
+	})
+	t.Run("Fallback", func(t *testing.T) {
+		h := new(dummyHook)
+		c := NewClient(h)
+		defer c.Release()
+
+		b := c.Batch()
+		f1, release := b.Add(ctx, send(1))
+		defer release()
+		f2, release := b.Add(ctx, send(2))
+		defer release()
+		b.Flush()
+		_, err := f1.Struct()
+		require.NoError(t, err)
+		_, err = f2.Struct()
+		require.NoError(t, err)
+		assert.Equal(t, 2, h.calls)
+	})
+}
+
+// batchHook is a BatchSender whose calls return a struct holding the
+// method ID.
+type batchHook struct {
+	*dummyHook
+	batches [][]uint16
+}
+
+func (bh *batchHook) SendBatch(calls []BatchCall) ([]*Answer, []ReleaseFunc) {
+	answers := make([]*Answer, len(calls))
+	releases := make([]ReleaseFunc, len(calls))
+	var ids []uint16
+	for i, call := range calls {
+		id := call.Send.Method.MethodID
+		ids = append(ids, id)
+		_, seg := NewSingleSegmentMessage(nil)
+		s, _ := NewRootStruct(seg, ObjectSize{DataSize: 8})
+		s.SetUint64(0, uint64(id))
+		answers[i], releases[i] = ImmediateAnswer(call.Send.Method, s.ToPtr()), func() {}
+	}
+	bh.batches = append(bh.batches, ids)
+	return answers, releases
+}
@@ -0,0 +1,302 @@
+// Package cache provides a capability decorator that memoizes the
+// answers of idempotent methods.
+//
+// Caching is useful for read-heavy capabilities, such as directory
+// listings or metadata lookups, where repeating a call with the same
+// arguments within a short window is expected to produce the same
+// results.  Answers are keyed by method and canonicalized arguments.
+// Calls whose arguments or results contain capabilities are never
+// cached, since capabilities have identity that canonical bytes don't
+// capture.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/exp/clock"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/internal/syncutil"
+)
+
+// DefaultMaxEntries is the number of answers kept when
+// Options.MaxEntries is zero.
+const DefaultMaxEntries = 1024
+
+// Options configures a caching client.
+type Options struct {
+	// TTL is how long an answer stays in the cache after it is
+	// received.  Zero means answers don't expire, and are only
+	// evicted to make room for newer answers.
+	TTL time.Duration
+
+	// MaxEntries is the maximum number of answers in the cache.  When
+	// the cache is full, the least recently used answer is evicted.
+	// Zero means DefaultMaxEntries.
+	MaxEntries int
+
+	// Cacheable reports whether answers to a method may be cached.
+	// Calls to other methods are passed through unchanged.  If nil,
+	// all methods are cacheable.
+	Cacheable func(capnp.Method) bool
+
+	// Clock is used to expire answers.  If nil, the system clock is
+	// used.
+	Clock clock.Clock
+}
+
+// New returns a client that forwards calls to c and caches their
+// answers according to opts.  New takes ownership of c: it is released
+// when the returned client is.  If opts is nil, default options are
+// used.
+//
+// Answers returned from the cache share their results between callers,
+// so callers must not modify them.
+func New(c capnp.Client, opts *Options) capnp.Client {
+	h := &hook{
+		client:  c,
+		entries: make(map[key]*list.Element),
+		lru:     list.New(),
+	}
+	if opts != nil {
+		h.opts = *opts
+	}
+	if h.opts.MaxEntries <= 0 {
+		h.opts.MaxEntries = DefaultMaxEntries
+	}
+	if h.opts.Clock == nil {
+		h.opts.Clock = clock.System
+	}
+	return capnp.NewClient(h)
+}
+
+type hook struct {
+	client capnp.Client
+	opts   Options
+
+	mu      sync.Mutex
+	entries map[key]*list.Element // values are *entry
+	lru     *list.List            // most recently used first
+}
+
+type key struct {
+	method capnp.Method
+	args   string // canonical encoding
+}
+
+type entry struct {
+	key     key
+	results capnp.Struct
+	expires time.Time // zero if the entry doesn't expire
+}
+
+func (h *hook) String() string {
+	return "cache.hook{0x" + str.PtrToHex(h) + "}"
+}
+
+func (h *hook) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	m := capnp.Method{InterfaceID: s.Method.InterfaceID, MethodID: s.Method.MethodID}
+	if h.opts.Cacheable != nil && !h.opts.Cacheable(s.Method) {
+		return h.client.SendCall(ctx, s)
+	}
+
+	args, err := placeArgs(s)
+	if err != nil {
+		return capnp.ErrorAnswer(s.Method, err), func() {}
+	}
+	fwd := capnp.Send{
+		Method:   s.Method,
+		ArgsSize: s.ArgsSize,
+		PlaceArgs: func(dst capnp.Struct) error {
+			return dst.CopyFrom(args)
+		},
+	}
+	if args.Message().CapTable().Len() > 0 {
+		defer args.Message().Release()
+		return h.client.SendCall(ctx, fwd)
+	}
+	canon, err := capnp.Canonicalize(args)
+	if err != nil {
+		args.Message().Release()
+		return capnp.ErrorAnswer(s.Method, exc.WrapError("cache: canonicalize arguments", err)), func() {}
+	}
+	k := key{method: m, args: string(canon)}
+	if results, ok := h.get(k); ok {
+		args.Message().Release()
+		return capnp.ImmediateAnswer(s.Method, results.ToPtr()), func() {}
+	}
+
+	ans, release := h.client.SendCall(ctx, fwd)
+	args.Message().Release()
+	p := capnp.NewPromise(s.Method, ans, nil)
+	var results capnp.Struct
+	go func() {
+		defer release()
+		r, err := ans.Struct()
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		if results, err = copyResults(r); err != nil {
+			p.Reject(exc.WrapError("cache: copy results", err))
+			return
+		}
+		if results.Message().CapTable().Len() == 0 {
+			h.put(k, results)
+		}
+		p.Fulfill(results.ToPtr())
+	}()
+	return p.Answer(), func() {
+		<-p.Answer().Done()
+		p.ReleaseClients()
+		if msg := results.Message(); msg != nil && msg.CapTable().Len() > 0 {
+			// Not cached, so nobody else references the results.
+			msg.Release()
+		}
+	}
+}
+
+func (h *hook) Recv(ctx context.Context, r capnp.Recv) capnp.PipelineCaller {
+	ans, finish := h.Send(ctx, capnp.Send{
+		Method:   r.Method,
+		ArgsSize: r.Args.Size(),
+		PlaceArgs: func(s capnp.Struct) error {
+			return s.CopyFrom(r.Args)
+		},
+	})
+	r.ReleaseArgs()
+	select {
+	case <-ans.Done():
+		returnAnswer(r.Returner, ans, finish)
+		return nil
+	default:
+		go returnAnswer(r.Returner, ans, finish)
+		return ans
+	}
+}
+
+func (h *hook) Brand() capnp.Brand {
+	return capnp.Brand{Value: h}
+}
+
+func (h *hook) Shutdown() {
+	h.client.Release()
+	syncutil.With(&h.mu, func() {
+		h.entries = nil
+		h.lru.Init()
+	})
+}
+
+// get returns the cached results for k, if present and unexpired.
+func (h *hook) get(k key) (capnp.Struct, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	elem := h.entries[k]
+	if elem == nil {
+		return capnp.Struct{}, false
+	}
+	e := elem.Value.(*entry)
+	if !e.expires.IsZero() && !h.opts.Clock.Now().Before(e.expires) {
+		h.lru.Remove(elem)
+		delete(h.entries, k)
+		return capnp.Struct{}, false
+	}
+	h.lru.MoveToFront(elem)
+	return e.results, true
+}
+
+// put adds results to the cache, evicting the least recently used
+// entries if the cache is full.
+func (h *hook) put(k key, results capnp.Struct) {
+	e := &entry{key: k, results: results}
+	if h.opts.TTL > 0 {
+		e.expires = h.opts.Clock.Now().Add(h.opts.TTL)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.entries == nil {
+		// Shut down.
+		return
+	}
+	if elem := h.entries[k]; elem != nil {
+		elem.Value = e
+		h.lru.MoveToFront(elem)
+		return
+	}
+	h.entries[k] = h.lru.PushFront(e)
+	for h.lru.Len() > h.opts.MaxEntries {
+		old := h.lru.Remove(h.lru.Back()).(*entry)
+		delete(h.entries, old.key)
+	}
+}
+
+// placeArgs builds the arguments of s in a new message.
+func placeArgs(s capnp.Send) (capnp.Struct, error) {
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return capnp.Struct{}, exc.WrapError("cache: place arguments", err)
+	}
+	args, err := capnp.NewRootStruct(seg, s.ArgsSize)
+	if err != nil {
+		seg.Message().Release()
+		return capnp.Struct{}, exc.WrapError("cache: place arguments", err)
+	}
+	if s.PlaceArgs == nil {
+		return args, nil
+	}
+	if err := s.PlaceArgs(args); err != nil {
+		seg.Message().Release()
+		return capnp.Struct{}, exc.WrapError("cache: place arguments", err)
+	}
+	return args, nil
+}
+
+// copyResults copies r into a message of its own, which may be shared
+// by any number of readers.
+func copyResults(r capnp.Struct) (capnp.Struct, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return capnp.Struct{}, err
+	}
+	// Cached results are read again and again, so they would
+	// eventually exhaust any finite traversal limit.
+	msg.ResetReadLimit(math.MaxUint64)
+	results, err := capnp.NewRootStruct(seg, r.Size())
+	if err != nil {
+		return capnp.Struct{}, err
+	}
+	if err := results.CopyFrom(r); err != nil {
+		msg.Release()
+		return capnp.Struct{}, err
+	}
+	return results, nil
+}
+
+func returnAnswer(ret capnp.Returner, ans *capnp.Answer, finish func()) {
+	defer finish()
+	defer ret.ReleaseResults()
+	result, err := ans.Struct()
+	if err != nil {
+		ret.PrepareReturn(err)
+		ret.Return()
+		return
+	}
+	recvResult, err := ret.AllocResults(result.Size())
+	if err != nil {
+		ret.PrepareReturn(err)
+		ret.Return()
+		return
+	}
+	if err := recvResult.CopyFrom(result); err != nil {
+		ret.PrepareReturn(err)
+		ret.Return()
+		return
+	}
+	ret.PrepareReturn(nil)
+	ret.Return()
+}
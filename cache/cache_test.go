@@ -0,0 +1,144 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/cache"
+	"capnproto.org/go/capnp/v3/exp/clock"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+)
+
+// countingEcho echoes its input and counts the calls it receives.
+type countingEcho struct {
+	n atomic.Int32
+}
+
+func (e *countingEcho) Echo(ctx context.Context, call air.Echo_echo) error {
+	e.n.Add(1)
+	in, err := call.Args().In()
+	if err != nil {
+		return err
+	}
+	if in == "fail" {
+		return errors.New("failed")
+	}
+	r, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return r.SetOut(in)
+}
+
+func echo(t *testing.T, c air.Echo, in string) (string, error) {
+	t.Helper()
+	ans, release := c.Echo(context.Background(), func(p air.Echo_echo_Params) error {
+		return p.SetIn(in)
+	})
+	defer release()
+	r, err := ans.Struct()
+	if err != nil {
+		return "", err
+	}
+	out, err := r.Out()
+	require.NoError(t, err)
+	return out, nil
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	impl := new(countingEcho)
+	c := air.Echo(cache.New(capnp.Client(air.Echo_ServerToClient(impl)), nil))
+	defer c.Release()
+
+	for i := 0; i < 3; i++ {
+		out, err := echo(t, c, "foo")
+		require.NoError(t, err)
+		assert.Equal(t, "foo", out)
+	}
+	assert.Equal(t, int32(1), impl.n.Load(), "repeated calls should hit the cache")
+
+	out, err := echo(t, c, "bar")
+	require.NoError(t, err)
+	assert.Equal(t, "bar", out)
+	assert.Equal(t, int32(2), impl.n.Load(), "different arguments should miss the cache")
+}
+
+func TestCacheErrorsNotCached(t *testing.T) {
+	t.Parallel()
+
+	impl := new(countingEcho)
+	c := air.Echo(cache.New(capnp.Client(air.Echo_ServerToClient(impl)), nil))
+	defer c.Release()
+
+	for i := 0; i < 2; i++ {
+		_, err := echo(t, c, "fail")
+		require.Error(t, err)
+	}
+	assert.Equal(t, int32(2), impl.n.Load())
+}
+
+func TestCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	clk := clock.NewManual(time.Unix(0, 0))
+	impl := new(countingEcho)
+	c := air.Echo(cache.New(capnp.Client(air.Echo_ServerToClient(impl)), &cache.Options{
+		TTL:   time.Minute,
+		Clock: clk,
+	}))
+	defer c.Release()
+
+	_, err := echo(t, c, "foo")
+	require.NoError(t, err)
+	clk.Advance(30 * time.Second)
+	_, err = echo(t, c, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), impl.n.Load())
+
+	clk.Advance(30 * time.Second)
+	_, err = echo(t, c, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), impl.n.Load(), "expired answer should be refetched")
+}
+
+func TestCacheMaxEntries(t *testing.T) {
+	t.Parallel()
+
+	impl := new(countingEcho)
+	c := air.Echo(cache.New(capnp.Client(air.Echo_ServerToClient(impl)), &cache.Options{
+		MaxEntries: 2,
+	}))
+	defer c.Release()
+
+	for _, in := range []string{"a", "b", "a", "c", "a", "b"} {
+		_, err := echo(t, c, in)
+		require.NoError(t, err)
+	}
+	// "b" is evicted when "c" is added, since "a" was used more recently.
+	assert.Equal(t, int32(4), impl.n.Load())
+}
+
+func TestCacheCacheable(t *testing.T) {
+	t.Parallel()
+
+	impl := new(countingEcho)
+	c := air.Echo(cache.New(capnp.Client(air.Echo_ServerToClient(impl)), &cache.Options{
+		Cacheable: func(m capnp.Method) bool { return false },
+	}))
+	defer c.Release()
+
+	for i := 0; i < 2; i++ {
+		_, err := echo(t, c, "foo")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), impl.n.Load())
+}
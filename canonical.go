@@ -1,6 +1,11 @@
 package capnp
 
 import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+
 	"capnproto.org/go/capnp/v3/exc"
 	"capnproto.org/go/capnp/v3/internal/str"
 )
@@ -10,135 +15,475 @@ import (
 // for equivalent structs, even as the schema evolves.  The blob is
 // suitable for hashing or signing.
 func Canonicalize(s Struct) ([]byte, error) {
-	msg, seg, _ := NewMessage(SingleSegment(nil))
-	if !s.IsValid() {
-		return seg.Data(), nil
-	}
-	root, err := NewRootStruct(seg, canonicalStructSize(s))
+	c := &canonicalizer{ctx: context.Background()}
+	words, err := c.measureRoot(s)
 	if err != nil {
 		return nil, exc.WrapError("canonicalize", err)
 	}
-	if err := msg.SetRoot(root.ToPtr()); err != nil {
+	c.buf = make([]byte, 0, words*uint64(wordSize))
+	if err := c.writeRoot(s); err != nil {
 		return nil, exc.WrapError("canonicalize", err)
 	}
-	if err := fillCanonicalStruct(root, s); err != nil {
-		return nil, exc.WrapError("canonicalize", err)
+	return c.buf, nil
+}
+
+// CanonicalizeTo writes the canonical form of s, as returned by
+// Canonicalize, to w and returns the number of bytes written.  Unlike
+// Canonicalize, it does not hold the encoding in memory: apart from a
+// fixed-size buffer, it only needs memory proportional to the number
+// of pointers in s, which makes it suitable for very large messages.
+//
+// The struct is traversed twice: once to lay out the canonical form
+// and once to write it.  ctx is checked between chunks of work during
+// both passes.  If ctx is done before the encoding is complete,
+// CanonicalizeTo returns ctx.Err() and w may have received a prefix of
+// the encoding.
+func CanonicalizeTo(ctx context.Context, w io.Writer, s Struct) (int64, error) {
+	c := &canonicalizer{
+		ctx: ctx,
+		w:   w,
+		buf: make([]byte, 0, canonicalBufSize),
+	}
+	if _, err := c.measureRoot(s); err != nil {
+		return 0, canonicalizeError(ctx, err)
+	}
+	if err := c.writeRoot(s); err != nil {
+		return c.n, canonicalizeError(ctx, err)
+	}
+	if err := c.flush(); err != nil {
+		return c.n, canonicalizeError(ctx, err)
+	}
+	return c.n, nil
+}
+
+func canonicalizeError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		return ctxErr
+	}
+	return exc.WrapError("canonicalize", err)
+}
+
+const (
+	// canonicalBufSize is the size of the buffer that CanonicalizeTo
+	// fills before writing to its io.Writer.
+	canonicalBufSize = 32 * 1024
+
+	// canonicalCheckWords is the number of words that are measured
+	// between checks for cancellation.
+	canonicalCheckWords = 64 * 1024
+)
+
+// A canonicalizer produces the canonical form of a struct in two
+// passes.  The measuring pass computes the size of every object
+// referenced by a pointer, including its descendants, and the writing
+// pass uses those sizes to compute pointer offsets while emitting
+// objects in order.
+type canonicalizer struct {
+	ctx context.Context
+
+	// sizes holds the size in words of the subtree rooted at each
+	// pointer, recorded by the measuring pass.  The sizes of an
+	// object's pointers are stored contiguously, in the order that the
+	// writing pass visits objects.
+	sizes []uint64
+	next  int    // index in sizes of the writing pass's next object
+	work  uint64 // words measured since ctx was last checked
+
+	w   io.Writer // nil if buf holds the entire encoding
+	buf []byte
+	n   int64  // bytes flushed to w
+	out uint64 // bytes emitted so far
+}
+
+func (c *canonicalizer) measureRoot(s Struct) (uint64, error) {
+	if !s.IsValid() {
+		return 1, nil
 	}
-	return seg.Data(), nil
+	words, err := c.measureStruct(s, canonicalStructSize(s))
+	if err != nil {
+		return 0, err
+	}
+	words++ // root pointer
+	if words*uint64(wordSize) > uint64(maxSegmentSize) {
+		return 0, errors.New("canonical form exceeds maximum segment size")
+	}
+	return words, nil
+}
+
+// tick records that words of input have been processed and checks
+// ctx periodically.
+func (c *canonicalizer) tick(words uint64) error {
+	c.work += words + 1
+	if c.work < canonicalCheckWords {
+		return nil
+	}
+	c.work = 0
+	return c.ctx.Err()
 }
 
-func canonicalPtr(dst *Segment, p Ptr) (Ptr, error) {
+// reserve allocates n consecutive entries in c.sizes and returns the
+// index of the first.
+func (c *canonicalizer) reserve(n int) int {
+	start := len(c.sizes)
+	for i := 0; i < n; i++ {
+		c.sizes = append(c.sizes, 0)
+	}
+	return start
+}
+
+func (c *canonicalizer) measurePtr(p Ptr) (uint64, error) {
 	if !p.IsValid() {
-		return Ptr{}, nil
+		return 0, nil
 	}
 	switch p.flags.ptrType() {
 	case structPtrType:
-		ss, err := NewStruct(dst, canonicalStructSize(p.Struct()))
-		if err != nil {
-			return Ptr{}, exc.WrapError("struct", err)
-		}
-		if err := fillCanonicalStruct(ss, p.Struct()); err != nil {
-			return Ptr{}, err
-		}
-		return ss.ToPtr(), nil
+		s := p.Struct()
+		return c.measureStruct(s, canonicalStructSize(s))
 	case listPtrType:
-		ll, err := canonicalList(dst, p.List())
-		if err != nil {
-			return Ptr{}, err
-		}
-		return ll.ToPtr(), nil
-	case interfacePtrType:
-		iface := NewInterface(dst, p.Interface().Capability())
-		return iface.ToPtr(), nil
+		return c.measureList(p.List())
 	default:
-		panic("unreachable")
+		return 0, nil
 	}
 }
 
-func fillCanonicalStruct(dst, s Struct) error {
-	copy(dst.seg.slice(dst.off, dst.size.DataSize), s.seg.slice(s.off, s.size.DataSize))
-	for i := uint16(0); i < dst.size.PointerCount; i++ {
+func (c *canonicalizer) measureStruct(s Struct, sz ObjectSize) (uint64, error) {
+	words := uint64(sz.totalWordCount())
+	if err := c.tick(words); err != nil {
+		return 0, err
+	}
+	start := c.reserve(int(sz.PointerCount))
+	for i := uint16(0); i < sz.PointerCount; i++ {
 		p, err := s.Ptr(i)
 		if err != nil {
-			return exc.WrapError("struct pointer "+str.Utod(i), err)
+			return 0, exc.WrapError("struct pointer "+str.Utod(i), err)
 		}
-		cp, err := canonicalPtr(dst.seg, p)
+		n, err := c.measurePtr(p)
 		if err != nil {
-			return exc.WrapError("struct pointer "+str.Utod(i), err)
+			return 0, exc.WrapError("struct pointer "+str.Utod(i), err)
 		}
-		if err := dst.SetPtr(i, cp); err != nil {
-			return exc.WrapError("struct pointer "+str.Utod(i), err)
+		c.sizes[start+int(i)] = n
+		words += n
+	}
+	return words, nil
+}
+
+func (c *canonicalizer) measureList(l List) (uint64, error) {
+	switch {
+	case l.flags&isCompositeList != 0:
+		elemSize := canonicalElemSize(l)
+		words := 1 + uint64(l.length)*uint64(elemSize.totalWordCount())
+		if err := c.tick(words); err != nil {
+			return 0, err
 		}
+		start := c.reserve(l.Len() * int(elemSize.PointerCount))
+		for i := 0; i < l.Len(); i++ {
+			elem := l.Struct(i)
+			for j := uint16(0); j < elemSize.PointerCount; j++ {
+				p, err := elem.Ptr(j)
+				if err != nil {
+					return 0, exc.WrapError("list element "+str.Itod(i), err)
+				}
+				n, err := c.measurePtr(p)
+				if err != nil {
+					return 0, exc.WrapError("list element "+str.Itod(i), err)
+				}
+				c.sizes[start+i*int(elemSize.PointerCount)+int(j)] = n
+				words += n
+			}
+		}
+		return words, nil
+	case l.size.PointerCount == 0:
+		words := uint64(l.allocSize().padToWord() / wordSize)
+		return words, c.tick(words)
+	default:
+		words := uint64(l.length)
+		if err := c.tick(words); err != nil {
+			return 0, err
+		}
+		start := c.reserve(l.Len())
+		for i := 0; i < l.Len(); i++ {
+			p, err := PointerList(l).At(i)
+			if err != nil {
+				return 0, exc.WrapError("list element "+str.Itod(i), err)
+			}
+			n, err := c.measurePtr(p)
+			if err != nil {
+				return 0, exc.WrapError("list element "+str.Itod(i), err)
+			}
+			c.sizes[start+i] = n
+			words += n
+		}
+		return words, nil
 	}
-	return nil
 }
 
-func canonicalStructSize(s Struct) ObjectSize {
+// take returns the next n sizes recorded by the measuring pass.
+func (c *canonicalizer) take(n int) []uint64 {
+	sizes := c.sizes[c.next : c.next+n]
+	c.next += n
+	return sizes
+}
+
+func (c *canonicalizer) writeRoot(s Struct) error {
 	if !s.IsValid() {
-		return ObjectSize{}
+		return c.writeWord(0)
 	}
-	var sz ObjectSize
-	// int32 will not overflow because max struct data size is 2^16 words.
-	for off := int32(s.size.DataSize &^ (wordSize - 1)); off >= 0; off -= int32(wordSize) {
-		if s.Uint64(DataOffset(off)) != 0 {
-			sz.DataSize = Size(off) + wordSize
-			break
-		}
+	sz := canonicalStructSize(s)
+	if err := c.writeStructPtr(sz, 1); err != nil {
+		return err
 	}
-	for i := int32(s.size.PointerCount) - 1; i >= 0; i-- {
-		if s.seg.readRawPointer(s.pointerAddress(uint16(i))) != 0 {
-			sz.PointerCount = uint16(i + 1)
-			break
-		}
+	return c.writeStruct(s, sz)
+}
+
+// writeObject writes the object that p points to and its descendants.
+func (c *canonicalizer) writeObject(p Ptr) error {
+	if !p.IsValid() {
+		return nil
+	}
+	switch p.flags.ptrType() {
+	case structPtrType:
+		s := p.Struct()
+		return c.writeStruct(s, canonicalStructSize(s))
+	case listPtrType:
+		return c.writeList(p.List())
+	default:
+		return nil
 	}
-	return sz
 }
 
-func canonicalList(dst *Segment, l List) (List, error) {
-	if !l.IsValid() {
-		return List{}, nil
+func (c *canonicalizer) writeStruct(s Struct, sz ObjectSize) error {
+	sizes := c.take(int(sz.PointerCount))
+	child := c.pos() + uint64(sz.totalWordCount())
+	if err := c.writeData(s, sz.DataSize); err != nil {
+		return err
 	}
-	if l.size.PointerCount == 0 {
-		// Data only, just copy over.
-		sz := l.allocSize()
-		_, newAddr, err := alloc(dst, sz)
+	for i := uint16(0); i < sz.PointerCount; i++ {
+		p, err := rereadPtr(s.Ptr(i))
 		if err != nil {
-			return List{}, exc.WrapError("list", err)
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
 		}
-		cl := List{
-			seg:        dst,
-			off:        newAddr,
-			length:     l.length,
-			size:       l.size,
-			flags:      l.flags,
-			depthLimit: maxDepth,
+		if err := c.writePtr(p, child); err != nil {
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
 		}
-		end, _ := l.off.addSize(sz) // list was already validated
-		copy(dst.data[newAddr:], l.seg.data[l.off:end])
-		return cl, nil
+		child += sizes[i]
 	}
-	if l.flags&isCompositeList == 0 {
-		cl, err := NewPointerList(dst, l.length)
+	for i := uint16(0); i < sz.PointerCount; i++ {
+		p, err := rereadPtr(s.Ptr(i))
 		if err != nil {
-			return List{}, exc.WrapError("list", err)
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+		if err := c.writeObject(p); err != nil {
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
 		}
+	}
+	return nil
+}
+
+func (c *canonicalizer) writeList(l List) error {
+	switch {
+	case l.flags&isCompositeList != 0:
+		elemSize := canonicalElemSize(l)
+		pc := int(elemSize.PointerCount)
+		sizes := c.take(l.Len() * pc)
+		if err := c.writeWord(rawStructPointer(pointerOffset(l.length), elemSize)); err != nil {
+			return err
+		}
+		child := c.pos() + uint64(l.length)*uint64(elemSize.totalWordCount())
 		for i := 0; i < l.Len(); i++ {
-			p, err := PointerList(l).At(i)
+			elem := l.Struct(i)
+			if err := c.writeData(elem, elemSize.DataSize); err != nil {
+				return err
+			}
+			for j := 0; j < pc; j++ {
+				p, err := rereadPtr(elem.Ptr(uint16(j)))
+				if err != nil {
+					return exc.WrapError("list element "+str.Itod(i), err)
+				}
+				if err := c.writePtr(p, child); err != nil {
+					return exc.WrapError("list element "+str.Itod(i), err)
+				}
+				child += sizes[i*pc+j]
+			}
+		}
+		for i := 0; i < l.Len(); i++ {
+			elem := l.Struct(i)
+			for j := 0; j < pc; j++ {
+				p, err := rereadPtr(elem.Ptr(uint16(j)))
+				if err != nil {
+					return exc.WrapError("list element "+str.Itod(i), err)
+				}
+				if err := c.writeObject(p); err != nil {
+					return exc.WrapError("list element "+str.Itod(i), err)
+				}
+			}
+		}
+		return nil
+	case l.size.PointerCount == 0:
+		sz := l.allocSize()
+		end, _ := l.off.addSize(sz) // list was already validated
+		if err := c.write(l.seg.data[l.off:end]); err != nil {
+			return err
+		}
+		return c.pad(sz.padToWord() - sz)
+	default:
+		sizes := c.take(l.Len())
+		child := c.pos() + uint64(l.length)
+		for i := 0; i < l.Len(); i++ {
+			p, err := rereadPtr(PointerList(l).At(i))
 			if err != nil {
-				return List{}, exc.WrapError("list element "+str.Itod(i), err)
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+			if err := c.writePtr(p, child); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
 			}
-			cp, err := canonicalPtr(dst, p)
+			child += sizes[i]
+		}
+		for i := 0; i < l.Len(); i++ {
+			p, err := rereadPtr(PointerList(l).At(i))
 			if err != nil {
-				return List{}, exc.WrapError("list element "+str.Itod(i), err)
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+			if err := c.writeObject(p); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
 			}
-			if err := cl.Set(i, cp); err != nil {
-				return List{}, exc.WrapError("list element "+str.Itod(i), err)
+		}
+		return nil
+	}
+}
+
+// rereadPtr returns the result of reading a pointer that the measuring
+// pass has already read, refunding the message's read limit so that
+// the object is only counted once.
+func rereadPtr(p Ptr, err error) (Ptr, error) {
+	if err != nil || !p.IsValid() {
+		return p, err
+	}
+	switch p.flags.ptrType() {
+	case structPtrType:
+		p.seg.msg.Unread(p.Struct().readSize())
+	case listPtrType:
+		p.seg.msg.Unread(p.List().readSize())
+	}
+	return p, nil
+}
+
+// writePtr writes a pointer to p's canonical form, which the caller
+// will write starting at word target.
+func (c *canonicalizer) writePtr(p Ptr, target uint64) error {
+	if !p.IsValid() {
+		return c.writeWord(0)
+	}
+	switch p.flags.ptrType() {
+	case structPtrType:
+		return c.writeStructPtr(canonicalStructSize(p.Struct()), target)
+	case listPtrType:
+		l := p.List()
+		off := c.offset(target)
+		switch {
+		case l.flags&isCompositeList != 0:
+			elemSize := canonicalElemSize(l)
+			return c.writeWord(rawListPointer(off, compositeList, l.length*elemSize.totalWordCount()))
+		default:
+			return c.writeWord(l.raw().withOffset(off))
+		}
+	case interfacePtrType:
+		return c.writeWord(rawInterfacePointer(p.Interface().Capability()))
+	default:
+		panic("unreachable")
+	}
+}
+
+func (c *canonicalizer) writeStructPtr(sz ObjectSize, target uint64) error {
+	if sz.isZero() {
+		// Zero-sized structs are encoded with offset -1 to avoid
+		// conflating them with null.
+		return c.writeWord(rawStructPointer(-1, ObjectSize{}))
+	}
+	return c.writeWord(rawStructPointer(c.offset(target), sz))
+}
+
+// pos returns the index of the next word to be written.
+func (c *canonicalizer) pos() uint64 {
+	return c.out / uint64(wordSize)
+}
+
+// offset returns the offset of a pointer written at the current
+// position that points to word target.
+func (c *canonicalizer) offset(target uint64) pointerOffset {
+	return pointerOffset(target - (c.pos() + 1))
+}
+
+// writeData writes the first sz bytes of s's data section, padded with
+// zeros if s has a smaller data section.
+func (c *canonicalizer) writeData(s Struct, sz Size) error {
+	n := sz
+	if s.size.DataSize < n {
+		n = s.size.DataSize
+	}
+	if err := c.write(s.seg.slice(s.off, n)); err != nil {
+		return err
+	}
+	return c.pad(sz - n)
+}
+
+func (c *canonicalizer) writeWord(w rawPointer) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(w))
+	return c.write(b[:])
+}
+
+var zeroWords [canonicalBufSize]byte
+
+func (c *canonicalizer) pad(n Size) error {
+	for n > 0 {
+		chunk := n
+		if chunk > Size(len(zeroWords)) {
+			chunk = Size(len(zeroWords))
+		}
+		if err := c.write(zeroWords[:chunk]); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+func (c *canonicalizer) write(b []byte) error {
+	c.out += uint64(len(b))
+	if c.w == nil {
+		c.buf = append(c.buf, b...)
+		return nil
+	}
+	for len(b) > 0 {
+		n := copy(c.buf[len(c.buf):cap(c.buf)], b)
+		c.buf = c.buf[:len(c.buf)+n]
+		b = b[n:]
+		if len(c.buf) == cap(c.buf) {
+			if err := c.flush(); err != nil {
+				return err
 			}
 		}
-		return List(cl), nil
 	}
+	return nil
+}
 
-	// Struct/composite list
+func (c *canonicalizer) flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	if err := c.ctx.Err(); err != nil {
+		return err
+	}
+	n, err := c.w.Write(c.buf)
+	c.n += int64(n)
+	c.buf = c.buf[:0]
+	return err
+}
+
+// canonicalElemSize returns the element size of the canonical form of
+// a composite list: the smallest size that holds every element's
+// canonical form.
+func canonicalElemSize(l List) ObjectSize {
 	var elemSize ObjectSize
 	for i := 0; i < l.Len(); i++ {
 		sz := canonicalStructSize(l.Struct(i))
@@ -149,14 +494,26 @@ func canonicalList(dst *Segment, l List) (List, error) {
 			elemSize.PointerCount = sz.PointerCount
 		}
 	}
-	cl, err := NewCompositeList(dst, elemSize, l.length)
-	if err != nil {
-		return List{}, exc.WrapError("list", err)
+	return elemSize
+}
+
+func canonicalStructSize(s Struct) ObjectSize {
+	if !s.IsValid() {
+		return ObjectSize{}
+	}
+	var sz ObjectSize
+	// int32 will not overflow because max struct data size is 2^16 words.
+	for off := int32(s.size.DataSize &^ (wordSize - 1)); off >= 0; off -= int32(wordSize) {
+		if s.Uint64(DataOffset(off)) != 0 {
+			sz.DataSize = Size(off) + wordSize
+			break
+		}
 	}
-	for i := 0; i < cl.Len(); i++ {
-		if err := fillCanonicalStruct(cl.Struct(i), l.Struct(i)); err != nil {
-			return List{}, exc.WrapError("list element "+str.Itod(i), err)
+	for i := int32(s.size.PointerCount) - 1; i >= 0; i-- {
+		if s.seg.readRawPointer(s.pointerAddress(uint16(i))) != 0 {
+			sz.PointerCount = uint16(i + 1)
+			break
 		}
 	}
-	return cl, nil
+	return sz
 }
@@ -2,7 +2,9 @@ package capnp
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
+	"errors"
 	"testing"
 )
 
@@ -188,6 +190,29 @@ func TestCanonicalize(t *testing.T) {
 			t.Errorf("Canonicalize(zero struct list) =\n%s\n; want\n%s", hex.Dump(b), hex.Dump(want))
 		}
 	}
+	{
+		// data-only struct list
+		_, seg, _ := NewMessage(SingleSegment(nil))
+		s, _ := NewStruct(seg, ObjectSize{PointerCount: 1})
+		l, _ := NewCompositeList(seg, ObjectSize{DataSize: 16}, 2)
+		s.SetPtr(0, l.ToPtr())
+		l.Struct(0).SetUint8(0, 7)
+		l.Struct(1).SetUint8(0, 9)
+		b, err := Canonicalize(s)
+		if err != nil {
+			t.Fatal("Canonicalize(data-only struct list):", err)
+		}
+		want := ([]byte{
+			0, 0, 0, 0, 0, 0, 1, 0,
+			0x01, 0, 0, 0, 0x17, 0, 0, 0,
+			0x08, 0, 0, 0, 1, 0, 0, 0,
+			7, 0, 0, 0, 0, 0, 0, 0,
+			9, 0, 0, 0, 0, 0, 0, 0,
+		})
+		if !bytes.Equal(b, want) {
+			t.Errorf("Canonicalize(data-only struct list) =\n%s\n; want\n%s", hex.Dump(b), hex.Dump(want))
+		}
+	}
 	{
 		// zero-length struct list
 		_, seg, _ := NewMessage(SingleSegment(nil))
@@ -208,3 +233,113 @@ func TestCanonicalize(t *testing.T) {
 		}
 	}
 }
+
+func TestCanonicalizeTo(t *testing.T) {
+	_, seg, _ := NewMessage(SingleSegment(nil))
+	s, _ := NewStruct(seg, ObjectSize{DataSize: 16, PointerCount: 4})
+	s.SetUint32(4, 0xcafe)
+	l, _ := NewCompositeList(seg, ObjectSize{DataSize: 8, PointerCount: 2}, 3)
+	s.SetPtr(0, l.ToPtr())
+	for i := 0; i < l.Len(); i++ {
+		l.Struct(i).SetUint16(0, uint16(i+1))
+		txt, _ := NewText(seg, "element "+string(rune('a'+i)))
+		l.Struct(i).SetPtr(1, txt.ToPtr())
+	}
+	bits, _ := NewBitList(seg, 11)
+	bits.Set(10, true)
+	s.SetPtr(1, bits.ToPtr())
+	ptrs, _ := NewPointerList(seg, 3)
+	inner, _ := NewStruct(seg, ObjectSize{PointerCount: 1})
+	data, _ := NewData(seg, []byte("abc"))
+	inner.SetPtr(0, data.ToPtr())
+	ptrs.Set(0, inner.ToPtr())
+	ptrs.Set(2, NewInterface(seg, 5).ToPtr())
+	s.SetPtr(3, ptrs.ToPtr())
+
+	want, err := Canonicalize(s)
+	if err != nil {
+		t.Fatal("Canonicalize:", err)
+	}
+	var buf bytes.Buffer
+	n, err := CanonicalizeTo(context.Background(), &buf, s)
+	if err != nil {
+		t.Fatal("CanonicalizeTo:", err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("CanonicalizeTo returned %d; wrote %d bytes", n, buf.Len())
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("CanonicalizeTo wrote\n%s\n; want\n%s", hex.Dump(buf.Bytes()), hex.Dump(want))
+	}
+
+	msg := &Message{Arena: SingleSegment(want)}
+	root, err := msg.Root()
+	if err != nil {
+		t.Fatal("Root:", err)
+	}
+	rs := root.Struct()
+	if again, err := Canonicalize(rs); err != nil {
+		t.Error("Canonicalize(canonical form):", err)
+	} else if !bytes.Equal(again, want) {
+		t.Errorf("Canonicalize(canonical form) =\n%s\n; want\n%s", hex.Dump(again), hex.Dump(want))
+	}
+	if got := rs.Uint32(4); got != 0xcafe {
+		t.Errorf("root data = %#x; want 0xcafe", got)
+	}
+	p, err := rs.Ptr(0)
+	if err != nil {
+		t.Fatal("root pointer 0:", err)
+	}
+	p, err = p.List().Struct(2).Ptr(1)
+	if err != nil {
+		t.Fatal("list element 2 pointer 1:", err)
+	}
+	if got := p.Text(); got != "element c" {
+		t.Errorf("list element 2 text = %q; want \"element c\"", got)
+	}
+	p, err = rs.Ptr(3)
+	if err != nil {
+		t.Fatal("root pointer 3:", err)
+	}
+	p, err = PointerList(p.List()).At(0)
+	if err != nil {
+		t.Fatal("pointer list element 0:", err)
+	}
+	p, err = p.Struct().Ptr(0)
+	if err != nil {
+		t.Fatal("pointer list element 0 pointer 0:", err)
+	}
+	if got := p.Data(); string(got) != "abc" {
+		t.Errorf("data = %q; want \"abc\"", got)
+	}
+}
+
+func TestCanonicalizeToCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	{
+		// canceled while measuring
+		_, seg, _ := NewMessage(SingleSegment(nil))
+		s, _ := NewStruct(seg, ObjectSize{PointerCount: 1})
+		l, _ := NewCompositeList(seg, ObjectSize{DataSize: 8}, 1<<17)
+		s.SetPtr(0, l.ToPtr())
+		var buf bytes.Buffer
+		if _, err := CanonicalizeTo(ctx, &buf, s); !errors.Is(err, context.Canceled) {
+			t.Errorf("CanonicalizeTo(canceled ctx, struct list) = _, %v; want %v", err, context.Canceled)
+		}
+	}
+	{
+		// canceled while writing
+		_, seg, _ := NewMessage(SingleSegment(nil))
+		s, _ := NewStruct(seg, ObjectSize{PointerCount: 1})
+		data, _ := NewData(seg, make([]byte, 1<<20))
+		s.SetPtr(0, data.ToPtr())
+		var buf bytes.Buffer
+		if _, err := CanonicalizeTo(ctx, &buf, s); !errors.Is(err, context.Canceled) {
+			t.Errorf("CanonicalizeTo(canceled ctx, data) = _, %v; want %v", err, context.Canceled)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("CanonicalizeTo(canceled ctx, data) wrote %d bytes; want 0", buf.Len())
+		}
+	}
+}
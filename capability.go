@@ -6,9 +6,9 @@ import (
 	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 
 	"capnproto.org/go/capnp/v3/exc"
-	"capnproto.org/go/capnp/v3/exp/bufferpool"
 	"capnproto.org/go/capnp/v3/flowcontrol"
 	"capnproto.org/go/capnp/v3/internal/str"
 	"capnproto.org/go/capnp/v3/util/deferred"
@@ -352,6 +352,16 @@ func (c Client) SendCall(ctx context.Context, s Send) (*Answer, ReleaseFunc) {
 		return ErrorAnswer(s.Method, exc.WrapError("stream error", err)), func() {}
 	}
 
+	sent := c.limitFlow(ctx, &s)
+	ans, rel := h.Value().Send(ctx, s)
+	sent(ans)
+	return ans, trackAnswerRelease(rel)
+}
+
+// limitFlow wraps s.PlaceArgs to measure the size of the arguments,
+// and returns a function to call with s's answer once s has been sent,
+// which reserves the size with the client's flow limiter.
+func (c Client) limitFlow(ctx context.Context, s *Send) func(*Answer) {
 	limiter := c.GetFlowLimiter()
 
 	// We need to call PlaceArgs before we will know the size of message for
@@ -372,44 +382,43 @@ func (c Client) SendCall(ctx context.Context, s Send) (*Answer, ReleaseFunc) {
 		return err
 	}
 
-	ans, rel := h.Value().Send(ctx, s)
-	// FIXME: an earlier version of this code called StartMessage() from
-	// within PlaceArgs -- but that can result in a deadlock, since it means
-	// the client hook is holding a lock while we're waiting on the limiter.
-	//
-	// As a temporary workaround, we instead do StartMessage *after* the send.
-	// This still has a bug, but a much less serious one: we may slightly
-	// over-use our limit, but only by the size of a single message. This is
-	// mostly a problem in that it contradicts the documentation and is
-	// conceptually odd.
-	//
-	// Longer term, we should fix a more serious design problem: Send() is
-	// holding a lock while calling into user code (PlaceArgs), so this
-	// deadlock could also arise if the user code blocks. Once that is solved,
-	// we can back out this hack.
-	gotResponse, err := limiter.StartMessage(ctx, size)
-	if err != nil {
-		// HACK: An error should only happen if the context was cancelled,
-		// in which case the caller will notice it soon probably. The call
-		// still went off ok, so we can just return the result we already
-		// got, and trying to report the error is awkward because we can't
-		// return one... so we don't. Set gotResponse to something that won't
-		// break things, and call it a day. See comments above about a
-		// longer term solution to this mess.
-		gotResponse = func() {}
-	}
-	p := ans.f.promise
-	l := p.state.Lock()
-	if l.Value().isResolved() {
-		// Wow, that was fast.
-		l.Unlock()
-		gotResponse()
-	} else {
-		l.Value().signals = append(l.Value().signals, gotResponse)
-		l.Unlock()
+	return func(ans *Answer) {
+		// FIXME: an earlier version of this code called StartMessage() from
+		// within PlaceArgs -- but that can result in a deadlock, since it means
+		// the client hook is holding a lock while we're waiting on the limiter.
+		//
+		// As a temporary workaround, we instead do StartMessage *after* the send.
+		// This still has a bug, but a much less serious one: we may slightly
+		// over-use our limit, but only by the size of a single message. This is
+		// mostly a problem in that it contradicts the documentation and is
+		// conceptually odd.
+		//
+		// Longer term, we should fix a more serious design problem: Send() is
+		// holding a lock while calling into user code (PlaceArgs), so this
+		// deadlock could also arise if the user code blocks. Once that is solved,
+		// we can back out this hack.
+		gotResponse, err := limiter.StartMessage(ctx, size)
+		if err != nil {
+			// HACK: An error should only happen if the context was cancelled,
+			// in which case the caller will notice it soon probably. The call
+			// still went off ok, so we can just return the result we already
+			// got, and trying to report the error is awkward because we can't
+			// return one... so we don't. Set gotResponse to something that won't
+			// break things, and call it a day. See comments above about a
+			// longer term solution to this mess.
+			gotResponse = func() {}
+		}
+		p := ans.f.promise
+		l := p.state.Lock()
+		if l.Value().isResolved() {
+			// Wow, that was fast.
+			l.Unlock()
+			gotResponse()
+		} else {
+			l.Value().signals = append(l.Value().signals, gotResponse)
+			l.Unlock()
+		}
 	}
-
-	return ans, rel
 }
 
 // SendStreamCall is like SendCall except that:
@@ -553,13 +562,24 @@ func (c Client) AddRef() Client {
 // WeakRef creates a new WeakClient that refers to the same capability
 // as c.  If c is nil or has resolved to null, then WeakRef returns nil.
 func (c Client) WeakRef() WeakClient {
-	cursor := mutex.With1(&c.state, func(s *clientState) *rc.WeakRef[clientCursor] {
+	if c.client == nil {
+		return WeakClient{}
+	}
+	hook := mutex.With1(&c.state, func(s *clientState) *rc.WeakRef[clientHook] {
 		if s.released {
 			panic("WeakRef on released client")
 		}
-		return s.cursor.Weak()
+		if !s.cursor.IsValid() {
+			return nil
+		}
+		return mutex.With1(&s.cursor.Value().hook, func(h **rc.Ref[clientHook]) *rc.WeakRef[clientHook] {
+			if !(*h).IsValid() {
+				return nil
+			}
+			return (*h).Weak()
+		})
 	})
-	return WeakClient{r: cursor}
+	return WeakClient{r: hook}
 }
 
 // Snapshot reads the current state of the client.  It returns the zero
@@ -806,45 +826,105 @@ func (s *resolveState) isResolved() bool {
 	}
 }
 
-var setupLeakReporting func(any) = func(any) {}
+// leakFunc is the callback set by SetClientLeakFunc, or nil.
+var leakFunc atomic.Pointer[func(msg string)]
 
-// SetClientLeakFunc sets a callback for reporting Clients that went
-// out of scope without being released.  The callback is not guaranteed
-// to be called and must be safe to call concurrently from multiple
-// goroutines.  The exact format of the message is unspecified.
+// SetClientLeakFunc sets a callback for reporting Clients and Answers
+// that went out of scope without being released.  An Answer is leaked
+// if the ReleaseFunc returned with it by Client.SendCall is never
+// called.  The callback is not guaranteed to be called and must be safe
+// to call concurrently from multiple goroutines.  The exact format of
+// the message is unspecified, but includes the stack trace of the code
+// that created the leaked object.
 //
-// SetClientLeakFunc must not be called after any calls to NewClient or
-// NewPromisedClient.
+// Recording stack traces is expensive, so this is intended for tests
+// and debugging.  Clients and Answers created before SetClientLeakFunc
+// is called are not tracked.  Passing nil stops tracking new objects.
 func SetClientLeakFunc(clientLeakFunc func(msg string)) {
-	setupLeakReporting = func(v any) {
-		buf := bufferpool.Default.Get(1e6)
-		n := runtime.Stack(buf, false)
-		stack := string(buf[:n])
-		bufferpool.Default.Put(buf)
-		switch c := v.(type) {
-		case Client:
-			runtime.SetFinalizer(c.client, func(c *client) {
-				released := mutex.With1(&c.state, func(c *clientState) bool {
-					return c.released
-				})
-				if released {
-					return
-				}
-				clientLeakFunc("leaked client created at:\n\n" + stack)
+	if clientLeakFunc == nil {
+		leakFunc.Store(nil)
+		return
+	}
+	leakFunc.Store(&clientLeakFunc)
+}
+
+// leakStack returns the leak callback and the program counters of
+// the caller's stack, or nil if leaks are not being tracked.  The
+// stack is only formatted if a leak is reported, since that is rare.
+func leakStack() (report func(string), stack []uintptr) {
+	f := leakFunc.Load()
+	if f == nil {
+		return nil, nil
+	}
+	pcs := make([]uintptr, 64)
+	return *f, pcs[:runtime.Callers(3, pcs)]
+}
+
+// formatStack formats the stack returned by leakStack.
+func formatStack(pcs []uintptr) string {
+	var buf []byte
+	frames := runtime.CallersFrames(pcs)
+	for {
+		f, more := frames.Next()
+		buf = append(buf, f.Function...)
+		buf = append(buf, "\n\t"...)
+		buf = append(buf, f.File...)
+		buf = append(buf, ':')
+		buf = strconv.AppendInt(buf, int64(f.Line), 10)
+		buf = append(buf, '\n')
+		if !more {
+			return string(buf)
+		}
+	}
+}
+
+func setupLeakReporting(v any) {
+	clientLeakFunc, stack := leakStack()
+	if clientLeakFunc == nil {
+		return
+	}
+	switch c := v.(type) {
+	case Client:
+		runtime.SetFinalizer(c.client, func(c *client) {
+			released := mutex.With1(&c.state, func(c *clientState) bool {
+				return c.released
 			})
-		case ClientSnapshot:
+			if released {
+				return
+			}
+			clientLeakFunc("leaked client created at:\n\n" + formatStack(stack))
+		})
+	case ClientSnapshot:
+		if !c.IsValid() {
+			return
+		}
+		runtime.SetFinalizer(c.hook, func(c *rc.Ref[clientHook]) {
 			if !c.IsValid() {
 				return
 			}
-			runtime.SetFinalizer(c.hook, func(c *rc.Ref[clientHook]) {
-				if !c.IsValid() {
-					return
-				}
-				clientLeakFunc("leaked client snapshot created at:\n\n" + stack)
-			})
-		default:
-			panic("setupLeakReporting called on unrecognized type!")
+			clientLeakFunc("leaked client snapshot created at:\n\n" + formatStack(stack))
+		})
+	default:
+		panic("setupLeakReporting called on unrecognized type!")
+	}
+}
+
+// trackAnswerRelease returns a ReleaseFunc that calls release, and
+// reports a leak if it is garbage collected without being called.
+func trackAnswerRelease(release ReleaseFunc) ReleaseFunc {
+	clientLeakFunc, stack := leakStack()
+	if clientLeakFunc == nil {
+		return release
+	}
+	released := new(atomic.Bool)
+	runtime.SetFinalizer(released, func(released *atomic.Bool) {
+		if !released.Load() {
+			clientLeakFunc("leaked answer created at:\n\n" + formatStack(stack))
 		}
+	})
+	return func() {
+		released.Store(true)
+		release()
 	}
 }
 
@@ -909,8 +989,12 @@ func (cp *clientPromise) fulfill(dq *deferred.Queue, c Client) {
 // A WeakClient is a weak reference to a capability: it refers to a
 // capability without preventing it from being shut down.  The zero
 // value is a null reference.
+//
+// The reference is to the capability's hook rather than to the Client
+// it was created from, so AddRef succeeds as long as any Client refers
+// to the hook, including promises that have resolved to it.
 type WeakClient struct {
-	r *rc.WeakRef[clientCursor]
+	r *rc.WeakRef[clientHook]
 }
 
 // AddRef creates a new Client that refers to the same capability as c
@@ -919,10 +1003,14 @@ func (wc WeakClient) AddRef() (c Client, ok bool) {
 	if wc.r == nil {
 		return Client{}, true
 	}
-	cursor, ok := wc.r.AddRef()
+	hook, ok := wc.r.AddRef()
 	if !ok {
 		return Client{}, false
 	}
+	cursor := rc.NewRefInPlace(func(c *clientCursor) func() {
+		*c = clientCursor{hook: mutex.New(hook)}
+		return c.Release
+	})
 	c = Client{client: &client{state: mutex.New(clientState{cursor: cursor})}}
 	setupLeakReporting(c)
 	return c, true
@@ -987,6 +1075,19 @@ type Send struct {
 
 	// ArgsSize specifies the size of the struct to pass to PlaceArgs.
 	ArgsSize ObjectSize
+
+	// Idempotent is true if the method is annotated with
+	// $Go.idempotent, meaning that calling it more than once with the
+	// same arguments has the same effect as calling it once.  Retry
+	// policies only repeat idempotent calls.
+	Idempotent bool
+
+	// SetTimeout, if not nil, stores the time left until ctx's deadline
+	// in the $Go.timeout parameter of args.  Generated clients set it
+	// for methods with such a parameter, so that a retry policy that
+	// sends the same arguments again can update the timeout for each
+	// attempt.
+	SetTimeout func(ctx context.Context, args Struct)
 }
 
 // Recv is the input to ClientHook.Recv.
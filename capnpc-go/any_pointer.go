@@ -7,11 +7,13 @@ type anyPointerRenderStrategy interface {
 	ListParams() any
 	CapabilityParams() any
 	PtrParams() any
+	ParamParams() any
 }
 
 type anyPointer struct {
 	G    *generator
 	Type schema.Type
+	Node *node
 }
 
 func (ap anyPointer) Render(s anyPointerRenderStrategy) error {
@@ -19,10 +21,14 @@ func (ap anyPointer) Render(s anyPointerRenderStrategy) error {
 	case schema.Type_anyPointer_Which_unconstrained:
 		return ap.RenderUnconstrained(s)
 
-	case schema.Type_anyPointer_Which_parameter:
-		// TODO(soon):  implement parameter
-	case schema.Type_anyPointer_Which_implicitMethodParameter:
-		// TODO(soon):  implement implicit method parameter
+	case schema.Type_anyPointer_Which_parameter, schema.Type_anyPointer_Which_implicitMethodParameter:
+		_, ok, err := ap.G.paramTypeName(ap.Type, ap.Node)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return ap.G.r.Render(s.ParamParams())
+		}
 	}
 
 	return ap.G.r.Render(s.PtrParams())
@@ -73,6 +79,10 @@ func (s structAnyPointerRenderStrategy) PtrParams() any {
 	}
 }
 
+func (s structAnyPointerRenderStrategy) ParamParams() any {
+	return structParamFieldParams(s.Params)
+}
+
 type promiseAnyPointerRenderStrategy struct {
 	G     *generator
 	Node  *node
@@ -95,6 +105,10 @@ func (s promiseAnyPointerRenderStrategy) PtrParams() any {
 	return promiseFieldAnyPointerParams(s)
 }
 
+func (s promiseAnyPointerRenderStrategy) ParamParams() any {
+	return promiseFieldAnyPointerParams(s)
+}
+
 func isAnyCap(ap schema.Type_anyPointer) bool {
 	if ap.Which() != schema.Type_anyPointer_Which_unconstrained {
 		return false
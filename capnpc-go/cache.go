@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// cacheVersion is mixed into every hash in the code generation cache.
+// Change it whenever a change to capnpc-go changes its output for the
+// same input in a way that the templates do not capture.
+const cacheVersion = "capnpc-go cache v1"
+
+// A genCache records a hash of the input used to generate each output
+// file, so that files whose input has not changed since the last run
+// can be skipped.  It is stored as a text file with one line per
+// output file, holding the file name and the hex-encoded hash.
+type genCache struct {
+	path   string
+	hashes map[string]string // keyed by output file name
+}
+
+// loadCache reads the cache at path.  A missing file is an empty cache.
+func loadCache(path string) (*genCache, error) {
+	c := &genCache{path: path, hashes: make(map[string]string)}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for line := 1; s.Scan(); line++ {
+		name, hash, ok := strings.Cut(s.Text(), " ")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: malformed line", path, line)
+		}
+		c.hashes[name] = hash
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// save writes the cache back to its file.
+func (c *genCache) save() error {
+	names := make([]string, 0, len(c.hashes))
+	for name := range c.hashes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var sb strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sb, "%s %s\n", name, c.hashes[name])
+	}
+	return os.WriteFile(c.path, []byte(sb.String()), 0666)
+}
+
+// upToDate reports whether name was generated from input with the given
+// hash and still exists.
+func (c *genCache) upToDate(name, hash string) bool {
+	if c.hashes[name] != hash {
+		return false
+	}
+	_, err := os.Stat(name)
+	return err == nil
+}
+
+// inputHash returns a hash of everything that the generated code for
+// reqf depends on: the nodes declared in the file and in the files it
+// imports, their doc comments, the options and the templates.  If
+// registers is true, the file has the declarations that cover its
+// package, such as TypeNames and RegisterSchema, so the nodes of the
+// other files in the package are included too.
+func inputHash(reqf schema.CodeGeneratorRequest_RequestedFile, trees nodeTrees, opts genoptions, registers bool) (string, error) {
+	f, err := trees.nodes.mustFind(reqf.Id())
+	if err != nil {
+		return "", err
+	}
+	ids := map[uint64]bool{f.Id(): true}
+	for _, n := range f.nodes {
+		ids[n.Id()] = true
+	}
+	imports, err := reqf.Imports()
+	if err != nil {
+		return "", err
+	}
+	for i := 0; i < imports.Len(); i++ {
+		imp := trees.nodes[imports.At(i).Id()]
+		if imp == nil {
+			// Imports without nodes, such as those only used for
+			// annotations that are not in the request.
+			continue
+		}
+		ids[imp.Id()] = true
+		for _, n := range imp.nodes {
+			ids[n.Id()] = true
+		}
+	}
+	if registers {
+		for _, id := range trees.pkgs[f.pkg].nodeId {
+			ids[id] = true
+		}
+	}
+	sorted := make([]uint64, 0, len(ids))
+	for id := range ids {
+		sorted = append(sorted, id)
+	}
+	sort.Sort(uint64Slice(sorted))
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%+v\nregisters=%t\n", cacheVersion, opts.hashable(), registers)
+	t := templates
+	if opts.templates != nil {
+		t = opts.templates
+	}
+	writeTemplates(h, t)
+	for _, id := range sorted {
+		n, err := trees.nodes.mustFind(id)
+		if err != nil {
+			return "", err
+		}
+		data, err := capnp.Canonicalize(capnp.Struct(n.Node))
+		if err != nil {
+			return "", fmt.Errorf("%s: %v", n, err)
+		}
+		writeHashed(h, data)
+		writeHashed(h, []byte(n.doc))
+		for _, doc := range n.memberDocs {
+			writeHashed(h, []byte(doc))
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeHashed writes b to w prefixed by its length, so that the
+// boundaries between values are part of the hash.
+func writeHashed(w io.Writer, b []byte) {
+	var n [8]byte
+	binary.LittleEndian.PutUint64(n[:], uint64(len(b)))
+	w.Write(n[:])
+	w.Write(b)
+}
+
+// writeTemplates writes the parse trees of the templates in t to w.
+func writeTemplates(w io.Writer, t *template.Template) {
+	ts := t.Templates()
+	sort.Slice(ts, func(i, j int) bool { return ts[i].Name() < ts[j].Name() })
+	for _, t := range ts {
+		writeHashed(w, []byte(t.Name()))
+		if t.Tree != nil && t.Tree.Root != nil {
+			writeHashed(w, []byte(t.Tree.Root.String()))
+		}
+	}
+}
+
+// hashable returns the options that affect the generated code, in a
+// form that can be formatted for hashing.
+func (opts genoptions) hashable() any {
+	return struct {
+		promises, schemas, structStrings, forceSchemasAlways, generics, sorted, mustGetters, cloneMethods, hashMethods, typeNames, splitOutput, schemaFiles bool
+	}{
+		opts.promises, opts.schemas, opts.structStrings, opts.forceSchemasAlways, opts.generics, opts.sorted, opts.mustGetters, opts.cloneMethods, opts.hashMethods, opts.typeNames, opts.splitOutput, opts.schemaFiles,
+	}
+}
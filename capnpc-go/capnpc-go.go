@@ -6,6 +6,16 @@ capnpc-go is the Cap'n proto code generator for Go.  It reads a
 CodeGeneratorRequest from stdin and for a file foo.capnp it writes
 foo.capnp.go.  This is usually invoked from `capnp compile -ogo`.
 
+capnpc-go can also compile schemas itself, so that the capnp tool
+need not be installed:
+
+	capnpc-go -I ../std foo.capnp bar.capnp
+
+The standard schemas, including /go.capnp, are bundled and are used
+for imports that no -I directory provides.  Generic types and
+embedded files are not supported this way; use the capnp tool for
+schemas that have them.
+
 See https://capnproto.org/otherlang.html#how-to-write-compiler-plugins
 for more details.
 */
@@ -18,6 +28,7 @@ import (
 	"flag"
 	"fmt"
 	"go/format"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -47,6 +58,41 @@ type genoptions struct {
 	schemas            bool
 	structStrings      bool
 	forceSchemasAlways bool
+	generics           bool
+
+	// changedOnly prevents rewriting output files that have not
+	// changed.
+	changedOnly bool
+
+	// sorted makes the output independent of the order of the nodes
+	// and files in the request, so that it is byte-identical across
+	// runs of the schema compiler. It is on by default.
+	sorted bool
+
+	// mustGetters adds a MustX variant of each getter that returns an
+	// error, which panics instead.
+	mustGetters bool
+
+	// cloneMethods adds a Clone method to each struct type.
+	cloneMethods bool
+
+	// hashMethods adds a Hash64 method to each struct type.
+	hashMethods bool
+
+	// typeNames adds a _TypeName constant for each type and a
+	// TypeNames map to each package.
+	typeNames bool
+
+	// splitOutput writes each top-level type of a schema file to a
+	// file of its own.
+	splitOutput bool
+
+	// schemaFiles writes each package's schema blob to a file of its
+	// own, which the generated code loads with go:embed.
+	schemaFiles bool
+
+	// templates overrides the built-in templates if not nil.
+	templates *template.Template
 }
 
 type renderer interface {
@@ -77,17 +123,36 @@ func (tr *templateRenderer) Bytes() []byte {
 // generator builds up the generated code for a single file.
 type generator struct {
 	r       renderer
+	t       *template.Template
 	fileID  uint64
 	nodes   nodeMap
 	pkgs    pkgMap
 	imports imports
 	data    staticData
 	opts    genoptions
+
+	// part is the top-level node whose declarations are generated if
+	// opts.splitOutput is set, or nil for the file's own declarations.
+	part *node
+
+	// validatedNodes caches the result of validated.
+	validatedNodes map[uint64]bool
+
+	// schemaFile is the schema blob to write to the file named
+	// schemaFileName, if opts.schemaFiles is set and the file has the
+	// package's declarations.
+	schemaFile     []byte
+	schemaFileName string
 }
 
 func newGenerator(fileID uint64, trees nodeTrees, opts genoptions) *generator {
+	t := templates
+	if opts.templates != nil {
+		t = opts.templates
+	}
 	g := &generator{
-		r:       &templateRenderer{t: templates},
+		r:       &templateRenderer{t: t},
+		t:       t,
 		fileID:  fileID,
 		nodes:   trees.nodes,
 		pkgs:    trees.pkgs,
@@ -111,17 +176,53 @@ func (g *generator) Basename() (string, error) {
 	return filepath.Base(dn), nil
 }
 
+// embedsSchema reports whether the file's package embeds its schema,
+// as set by the package's $Go.embedSchema annotations or else by
+// opts.schemas.
+func (g *generator) embedsSchema() bool {
+	if pkg := g.pkgs[g.nodes[g.fileID].pkg]; pkg != nil && pkg.embedSchema != nil {
+		return *pkg.embedSchema
+	}
+	return g.opts.schemas
+}
+
 func (g *generator) Imports() *imports {
 	return &g.imports
 }
 
+// MustGetters reports whether to generate MustX variants of getters.
+func (g *generator) MustGetters() bool {
+	return g.opts.mustGetters
+}
+
+// CloneMethods reports whether to generate Clone methods for structs.
+func (g *generator) CloneMethods() bool {
+	return g.opts.cloneMethods
+}
+
+// HashMethods reports whether to generate Hash64 methods for structs.
+func (g *generator) HashMethods() bool {
+	return g.opts.hashMethods
+}
+
+// TypeNames reports whether to generate the _TypeName constants and
+// the TypeNames map.
+func (g *generator) TypeNames() bool {
+	return g.opts.typeNames
+}
+
 // generate produces unformatted Go source code from the nodes defined in it.
 func (g *generator) generate() []byte {
 	var out bytes.Buffer
-	out.WriteString("// Code generated by capnpc-go. DO NOT EDIT.\n\n")
+	out.WriteString(generatedHeader + "\n\n")
 	fmt.Fprintf(&out, "package %s\n\n", g.nodes[g.fileID].pkg)
 	out.WriteString("import (\n")
-	for _, imp := range g.imports.usedImports() {
+	g.imports.useReferenced(g.r.Bytes())
+	used := g.imports.usedImports()
+	if g.opts.sorted {
+		sort.Slice(used, func(i, j int) bool { return used[i].path < used[j].path })
+	}
+	for _, imp := range used {
 		fmt.Fprintf(&out, "%v\n", imp)
 	}
 	out.WriteString(")\n")
@@ -143,17 +244,21 @@ func writeByteLiteral(out *bytes.Buffer, name string, data []byte) {
 	fmt.Fprintf(out, "\n}\n")
 }
 
-func (g *generator) defineSchemaVar() error {
+// packageNodeIDs returns the IDs of the nodes that the package-level
+// declarations in the file being generated cover, and marks them as
+// written.  It returns nil if another file in the package already has
+// the declarations.
+func (g *generator) packageNodeIDs() ([]uint64, error) {
 	var ids []uint64
-	// Unless g.opts.forceSchemasAlways overrides it, only call g.r.Render() if
-	// .done is false, then set .done = true
+	// Unless g.opts.forceSchemasAlways overrides it, only write the
+	// declarations if .done is false, then set .done = true
 	if !g.opts.forceSchemasAlways {
 		pkg, ok := g.pkgs[g.nodes[g.fileID].pkg]
 		if !ok {
-			return fmt.Errorf("BUG: file %v, $Go.package %q: not found", g.fileID, g.nodes[g.fileID].pkg)
+			return nil, fmt.Errorf("BUG: file %v, $Go.package %q: not found", g.fileID, g.nodes[g.fileID].pkg)
 		}
 		if pkg.done {
-			return nil	// RegisterSchema was written in another file
+			return nil, nil // written in another file
 		}
 		ids = make([]uint64, len(pkg.nodeId))
 		copy(ids, pkg.nodeId)
@@ -167,6 +272,27 @@ func (g *generator) defineSchemaVar() error {
 		}
 	}
 	sort.Sort(uint64Slice(ids))
+	return ids, nil
+}
+
+// defineTypeNames writes the TypeNames map for the nodes in ids, if
+// type names are enabled.
+func (g *generator) defineTypeNames(ids []uint64) error {
+	if !g.opts.typeNames {
+		return nil
+	}
+	var nodes []*node
+	for _, id := range ids {
+		n := g.nodes[id]
+		switch n.Which() {
+		case schema.Node_Which_structNode, schema.Node_Which_enum, schema.Node_Which_interface:
+			nodes = append(nodes, n)
+		}
+	}
+	return g.r.Render(typeNamesParams{G: g, Nodes: nodes})
+}
+
+func (g *generator) defineSchemaVar(ids []uint64) error {
 
 	msg, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
 	req, _ := schema.NewRootCodeGeneratorRequest(seg)
@@ -188,12 +314,23 @@ func (g *generator) defineSchemaVar() error {
 	if err := z.Close(); err != nil {
 		return err
 	}
-	return g.r.Render(schemaVarParams{
+	params := schemaVarParams{
 		G:       g,
 		FileID:  g.fileID,
 		NodeIDs: ids,
 		schema:  buf.Bytes(),
-	})
+	}
+	if g.opts.schemaFiles {
+		base, err := g.Basename()
+		if err != nil {
+			return err
+		}
+		g.schemaFile = buf.Bytes()
+		g.schemaFileName = base + ".schema"
+		params.EmbedFile = g.schemaFileName
+		g.imports.add(importSpec{path: "embed", name: "_"})
+	}
+	return g.r.Render(params)
 }
 
 // importForNode returns the import spec needed to reference n from
@@ -212,7 +349,16 @@ func importForNode(n, rel *node) (importSpec, error) {
 	if n.imp == rel.imp {
 		return importSpec{}, nil
 	}
-	return importSpec{path: n.imp, name: n.pkg}, nil
+	return importSpec{path: n.imp, name: n.importName()}, nil
+}
+
+// importName returns the name that other packages import n's package
+// as, unless it collides with another import.
+func (n *node) importName() string {
+	if n.alias != "" {
+		return n.alias
+	}
+	return n.pkg
 }
 
 func (g *generator) RemoteNodeNew(n, rel *node) (string, error) {
@@ -223,26 +369,59 @@ func (g *generator) RemoteNodeNew(n, rel *node) (string, error) {
 	if ref.newfunc == "" {
 		return "", fmt.Errorf("no new function for %s", ref.name)
 	}
+	args, err := g.typeArgs(n, rel, nil)
+	if err != nil {
+		return "", err
+	}
 	if ref.imp.path == "" {
-		return ref.newfunc, nil
+		return ref.newfunc + args, nil
 	}
 	qname := g.imports.add(ref.imp)
-	return qname + "." + ref.newfunc, nil
+	return qname + "." + ref.newfunc + args, nil
 }
 
 func (g *generator) RemoteNodeName(n, rel *node) (string, error) {
-	ref, err := makeNodeTypeRef(n, rel)
+	args, err := g.typeArgs(n, rel, nil)
 	if err != nil {
 		return "", err
 	}
-	if ref.imp.path == "" {
-		return ref.name, nil
+	return g.remoteNodeName(n, rel, "", args)
+}
+
+// RemoteMethodName returns the name of n, which is the interface or
+// a parameter struct of m, with suffix appended.  If m is inherited
+// from a generic superclass, the superclass's brand supplies the type
+// arguments.
+func (g *generator) RemoteMethodName(m interfaceMethod, n *node, suffix string, rel *node) (string, error) {
+	args, err := g.typeArgs(n, rel, m.brands)
+	if err != nil {
+		return "", err
 	}
-	qname := g.imports.add(ref.imp)
-	return qname + "." + ref.name, nil
+	return g.remoteNodeName(n, rel, suffix, args)
+}
+
+// RemoteTypeFuture returns the name of the Future type for the struct
+// type t.
+func (g *generator) RemoteTypeFuture(t schema.Type, rel *node) (string, error) {
+	n, args, err := g.brandedNode(t, rel)
+	if err != nil {
+		return "", err
+	}
+	if n == nil {
+		return "", fmt.Errorf("no future type for %v", t.Which())
+	}
+	return g.remoteNodeName(n, rel, "_Future", args)
 }
 
 func (g *generator) RemoteTypeNew(t schema.Type, rel *node) (string, error) {
+	if name, newfunc, ok, err := g.genericTypeRef(t, rel); err != nil {
+		return "", err
+	} else if ok {
+		if newfunc == "" {
+			return "", fmt.Errorf("no new function for %s", name)
+		}
+		return newfunc, nil
+	}
 	ref, err := makeTypeRef(t, rel, g.nodes)
 	if err != nil {
 		return "", err
@@ -258,6 +437,9 @@ func (g *generator) RemoteTypeNew(t schema.Type, rel *node) (string, error) {
 }
 
 func (g *generator) RemoteTypeName(t schema.Type, rel *node) (string, error) {
+	if name, _, ok, err := g.genericTypeRef(t, rel); ok || err != nil {
+		return name, err
+	}
 	ref, err := makeTypeRef(t, rel, g.nodes)
 	if err != nil {
 		return "", err
@@ -276,11 +458,10 @@ func (g *generator) defineEnum(n *node) error {
 		e := es.At(i)
 		ev[e.CodeOrder()] = makeEnumval(n, i, e)
 	}
-	nann, _ := n.Annotations()
 	err := g.r.Render(enumParams{
 		G:           g,
 		Node:        n,
-		Annotations: parseAnnotations(nann),
+		Annotations: n.annotations(),
 		EnumValues:  ev,
 	})
 	if err != nil {
@@ -371,7 +552,7 @@ func (g *generator) Value(rel *node, t schema.Type, v schema.Value) (string, err
 	case schema.Type_Which_structType:
 		data, _ := v.StructValue()
 		var buf bytes.Buffer
-		tn, err := g.nodes.mustFind(t.StructType().TypeId())
+		styp, err := g.RemoteTypeName(t, rel)
 		if err != nil {
 			return "", err
 		}
@@ -379,10 +560,9 @@ func (g *generator) Value(rel *node, t schema.Type, v schema.Value) (string, err
 		if err != nil {
 			return "", err
 		}
-		err = templates.ExecuteTemplate(&buf, "structValue", structValueParams{
+		err = g.t.ExecuteTemplate(&buf, "structValue", structValueParams{
 			G:     g,
-			Node:  rel,
-			Typ:   tn,
+			Typ:   styp,
 			Value: sd,
 		})
 		return buf.String(), err
@@ -404,7 +584,7 @@ func (g *generator) Value(rel *node, t schema.Type, v schema.Value) (string, err
 		// TODO:  handle other pointer types
 
 		// Fall back to default case => generic pointer value
-		err = templates.ExecuteTemplate(&buf, "pointerValue", pointerValueParams{
+		err = g.t.ExecuteTemplate(&buf, "pointerValue", pointerValueParams{
 			G:     g,
 			Value: sd,
 		})
@@ -422,7 +602,7 @@ func (g *generator) Value(rel *node, t schema.Type, v schema.Value) (string, err
 		if err != nil {
 			return "", err
 		}
-		err = templates.ExecuteTemplate(&buf, "listValue", listValueParams{
+		err = g.t.ExecuteTemplate(&buf, "listValue", listValueParams{
 			G:     g,
 			Typ:   ftyp,
 			Value: sd,
@@ -628,6 +808,7 @@ func (g *generator) defineField(n *node, f field) (err error) {
 		return anyPointer{
 			G:    g,
 			Type: t,
+			Node: n,
 		}.Render(s)
 
 	case schema.Type_Which_list:
@@ -640,10 +821,16 @@ func (g *generator) defineField(n *node, f field) (err error) {
 				return err
 			}
 		}
-		return g.r.Render(structListFieldParams{
-			structFieldParams: params,
-			Default:           defref,
-		})
+		lp := structListFieldParams{
+			structObjectFieldParams: structObjectFieldParams{
+				structFieldParams: params,
+				Default:           defref,
+			},
+		}
+		if lp.ElemType, lp.FromSlice, err = g.listFromSlice(t, n); err != nil {
+			return err
+		}
+		return g.r.Render(lp)
 
 	case schema.Type_Which_interface:
 		return g.r.Render(structInterfaceFieldParams(params))
@@ -652,6 +839,33 @@ func (g *generator) defineField(n *node, f field) (err error) {
 	}
 }
 
+// listFromSlice returns the Go element type of the list type t and the
+// capnp function that makes such a list from a slice, instantiated for
+// the element type if it is generic, or empty strings
+// if there is no such function for t.
+func (g *generator) listFromSlice(t schema.Type, rel *node) (elemType, fromSlice string, err error) {
+	et, err := t.List().ElementType()
+	if err != nil {
+		return "", "", err
+	}
+	switch et.Which() {
+	case schema.Type_Which_void:
+		return "", "", nil
+	case schema.Type_Which_enum:
+		name, err := g.RemoteTypeName(et, rel)
+		if err != nil {
+			return "", "", err
+		}
+		return name, g.imports.Capnp() + ".NewEnumListFromSlice[" + name + "]", nil
+	}
+	ref, ok := staticTypeRefs[et.Which()]
+	if !ok {
+		return "", "", nil
+	}
+	lref := staticListTypeRefs[et.Which()]
+	return ref.name, g.imports.Capnp() + "." + lref.newfunc + "FromSlice", nil
+}
+
 // typeRef is a Go reference to a Cap'n Proto type.
 type typeRef struct {
 	name    string
@@ -859,6 +1073,9 @@ func (g *generator) defineStruct(n *node) error {
 	if err := g.defineStructFuncs(n); err != nil {
 		return err
 	}
+	if err := g.defineValidate(n); err != nil {
+		return err
+	}
 	if err := g.defineStructList(n); err != nil {
 		return err
 	}
@@ -871,8 +1088,7 @@ func (g *generator) defineStruct(n *node) error {
 }
 
 func (g *generator) defineStructTypes(n, baseNode *node) error {
-	nann, _ := n.Annotations()
-	ann := parseAnnotations(nann)
+	ann := n.annotations()
 	err := g.r.Render(structTypesParams{
 		G:           g,
 		Node:        n,
@@ -918,6 +1134,9 @@ func (g *generator) defineStructEnums(n *node) error {
 		if err != nil {
 			return fmt.Errorf("struct enums for %s: %v", n, err)
 		}
+		if err := g.defineVisitor(n, members); err != nil {
+			return fmt.Errorf("union visitor for %s: %v", n, err)
+		}
 	}
 	for _, f := range fields {
 		if f.Which() == schema.Field_Which_group {
@@ -933,6 +1152,36 @@ func (g *generator) defineStructEnums(n *node) error {
 	return nil
 }
 
+// checkMustGetters returns an error if the MustX variant of one of n's
+// getters would have the same name as the getter of another field.
+func checkMustGetters(n *node) error {
+	fields := n.codeOrderFields()
+	getters := make(map[string]string, len(fields))
+	for _, f := range fields {
+		getters[f.Getter] = f.Name
+	}
+	for _, f := range fields {
+		if f.Which() != schema.Field_Which_slot {
+			continue
+		}
+		t, err := f.Slot().Type()
+		if err != nil {
+			return err
+		}
+		switch t.Which() {
+		case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_list,
+			schema.Type_Which_structType, schema.Type_Which_anyPointer:
+		default:
+			// The getter does not return an error.
+			continue
+		}
+		if other, ok := getters["Must"+f.Getter]; ok {
+			return fmt.Errorf("%s: Must%s, the panicking getter of field %s, collides with the getter of field %s; rename one of them with $Go.name", n, f.Getter, f.Name, other)
+		}
+	}
+	return nil
+}
+
 func (g *generator) defineStructFuncs(n *node) error {
 	err := g.r.Render(structFuncsParams{
 		G:    g,
@@ -941,6 +1190,11 @@ func (g *generator) defineStructFuncs(n *node) error {
 	if err != nil {
 		return fmt.Errorf("struct funcs for %s: %v", n, err)
 	}
+	if g.opts.mustGetters {
+		if err := checkMustGetters(n); err != nil {
+			return err
+		}
+	}
 
 	for _, f := range n.codeOrderFields() {
 		switch f.Which() {
@@ -965,11 +1219,124 @@ func (g *generator) defineStructFuncs(n *node) error {
 			if err := g.defineStructFuncs(grp); err != nil {
 				return err
 			}
+			fann, _ := f.Annotations()
+			if ann := parseAnnotations(fann); ann.Flatten {
+				if err := g.defineFlattenedUnion(n, f, grp, ann.FlattenWhich); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
+// defineFlattenedUnion defines accessors on n for the members of grp,
+// the named union of field f, as requested by $Go.flatten.
+func (g *generator) defineFlattenedUnion(n *node, f field, grp *node, which string) error {
+	if grp.StructNode().DiscriminantCount() == 0 {
+		return fmt.Errorf("%s.%s: $Go.flatten applies only to unions", n.shortDisplayName(), f.Name)
+	}
+	if err := g.checkFlattenedNames(n, f, grp, which); err != nil {
+		return err
+	}
+	err := g.r.Render(structFlattenedWhichParams{
+		G:     g,
+		Node:  n,
+		Group: grp,
+		Field: f,
+		Which: which,
+	})
+	if err != nil {
+		return fmt.Errorf("flattened union %s: %v", grp, err)
+	}
+
+	// The members of a group are stored in the enclosing struct, so the
+	// group's accessors work unchanged on n when declared with n's name
+	// as the receiver.
+	flat := *grp
+	flat.Name = n.Name
+	for _, gf := range grp.codeOrderFields() {
+		switch gf.Which() {
+		case schema.Field_Which_slot:
+			if err := g.defineField(&flat, gf); err != nil {
+				return err
+			}
+		case schema.Field_Which_group:
+			sub, err := g.nodes.mustFind(gf.Group().TypeId())
+			if err != nil {
+				return err
+			}
+			err = g.r.Render(structGroupParams{
+				G:     g,
+				Node:  &flat,
+				Group: sub,
+				Field: gf,
+			})
+			if err != nil {
+				return fmt.Errorf("flattened group for %s: %v", sub, err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkFlattenedNames returns an error if an accessor that flattening
+// grp onto n would add has the same name as one of n's own methods.
+func (g *generator) checkFlattenedNames(n *node, f field, grp *node, which string) error {
+	owner := make(map[string]string)
+	for _, f := range n.codeOrderFields() {
+		for _, name := range accessorNames(f) {
+			owner[name] = f.Name
+		}
+	}
+	if n.StructNode().DiscriminantCount() > 0 {
+		owner["Which"] = "the union of " + n.Name
+	}
+	// Other flattened unions of n add accessors too.
+	for _, of := range n.codeOrderFields() {
+		if of.Which() != schema.Field_Which_group || of.CodeOrder() == f.CodeOrder() {
+			continue
+		}
+		oann, _ := of.Annotations()
+		ann := parseAnnotations(oann)
+		if !ann.Flatten {
+			continue
+		}
+		ogrp, err := g.nodes.mustFind(of.Group().TypeId())
+		if err != nil {
+			return err
+		}
+		owner[ann.FlattenWhich] = "the flattened union " + of.Name
+		for _, gf := range ogrp.codeOrderFields() {
+			for _, name := range accessorNames(gf) {
+				owner[name] = gf.Name
+			}
+		}
+	}
+	if other, ok := owner[which]; ok {
+		return fmt.Errorf("%s.%s: flattened discriminant getter %s collides with an accessor of %s; choose another name with $Go.flatten", n.shortDisplayName(), f.Name, which, other)
+	}
+	for _, gf := range grp.codeOrderFields() {
+		for _, name := range accessorNames(gf) {
+			if other, ok := owner[name]; ok {
+				return fmt.Errorf("%s.%s: flattened accessor %s of %s collides with an accessor of %s; rename one of them with $Go.name", n.shortDisplayName(), f.Name, name, gf.Name, other)
+			}
+		}
+	}
+	return nil
+}
+
+// accessorNames returns the names of the methods that may be generated
+// for field f.
+func accessorNames(f field) []string {
+	name := strings.Title(f.Name)
+	return []string{
+		f.Getter, f.Setter, "Must" + f.Getter, f.Getter + "Bytes", f.Setter + "FromSlice",
+		f.Getter + "Reader", f.Setter + "FromReader",
+		"Has" + name, "New" + name,
+	}
+}
+
 func (g *generator) ObjectSize(n *node) (string, error) {
 	if n.Which() != schema.Node_Which_structNode {
 		return "", fmt.Errorf("object size called for %v node", n.Which())
@@ -984,7 +1351,8 @@ func (g *generator) defineBaseStructFuncs(n *node) error {
 	err := g.r.Render(baseStructFuncsParams{
 		G:            g,
 		Node:         n,
-		StringMethod: g.opts.structStrings,
+		Annotations:  n.annotations(),
+		StringMethod: g.opts.structStrings && g.embedsSchema(),
 	})
 	if err != nil {
 		return fmt.Errorf("base struct functions for %s: %v", n, err)
@@ -996,7 +1364,7 @@ func (g *generator) defineStructList(n *node) error {
 	err := g.r.Render(structListParams{
 		G:            g,
 		Node:         n,
-		StringMethod: g.opts.structStrings,
+		StringMethod: g.opts.structStrings && g.embedsSchema(),
 	})
 	if err != nil {
 		return fmt.Errorf("new struct function for %s: %v", n, err)
@@ -1073,6 +1441,7 @@ func (g *generator) definePromiseField(n *node, f field) error {
 		return anyPointer{
 			G:    g,
 			Type: t,
+			Node: n,
 		}.Render(promiseAnyPointerRenderStrategy{
 			G:     g,
 			Node:  n,
@@ -1096,16 +1465,31 @@ func (g *generator) definePromiseField(n *node, f field) error {
 }
 
 func (g *generator) defineInterface(n *node) error {
-	m, err := methodSet(nil, n, g.nodes)
+	m, err := methodSet(nil, n, g.nodes, nil)
 	if err != nil {
 		return fmt.Errorf("building method set of interface %s: %v", n, err)
 	}
-	nann, _ := n.Annotations()
+	supers, err := superclasses(nil, n, g.nodes, nil)
+	if err != nil {
+		return fmt.Errorf("finding superclasses of interface %s: %v", n, err)
+	}
+	taken := make(map[string]bool, len(m))
+	for _, meth := range m {
+		taken[strings.Title(meth.Name)] = true
+	}
+	for i := range supers {
+		if name := "As" + supers[i].Node.Name; !taken[name] {
+			supers[i].Upcast = name
+			taken[name] = true
+		}
+	}
+	ann := n.annotations()
 	err = g.r.Render(interfaceClientParams{
 		G:           g,
 		Node:        n,
-		Annotations: parseAnnotations(nann),
+		Annotations: ann,
 		Methods:     m,
+		Supers:      supers,
 	})
 	if err != nil {
 		return fmt.Errorf("interface client %s: %v", n, err)
@@ -1114,8 +1498,9 @@ func (g *generator) defineInterface(n *node) error {
 	err = g.r.Render(interfaceServerParams{
 		G:           g,
 		Node:        n,
-		Annotations: parseAnnotations(nann),
+		Annotations: ann,
 		Methods:     m,
+		Supers:      supers,
 	})
 	if err != nil {
 		return fmt.Errorf("interface server %s: %v", n, err)
@@ -1158,17 +1543,85 @@ func (g *generator) defineFile() error {
 		return errors.New("missing import annotation")
 	}
 
-	for _, n := range f.nodes {
+	if g.importsMayCollide(f) {
+		// Generate the file once to find the imports it uses, then
+		// reserve names for them that do not depend on the order in
+		// which they were used.
+		probe := newGenerator(g.fileID, nodeTrees{nodes: g.nodes, pkgs: g.pkgs}, g.opts)
+		probe.part = g.part
+		if err := probe.defineNodes(f); err != nil {
+			return err
+		}
+		if probe.imports.renamed {
+			for _, spec := range probe.imports.aliases() {
+				g.imports.reserve(spec)
+			}
+		}
+	}
+	if err := g.defineNodes(f); err != nil {
+		return err
+	}
+	if g.part != nil {
+		// The package's declarations are in the file's own output.
+		return nil
+	}
+	ids, err := g.packageNodeIDs()
+	if err != nil || ids == nil {
+		return err
+	}
+	if err := g.defineTypeNames(ids); err != nil {
+		return err
+	}
+	if g.embedsSchema() {
+		if err := g.defineSchemaVar(ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importsMayCollide reports whether the package of some other file in
+// the request asks to be imported with the same name as another
+// package, including the packages that generated code always may
+// import.
+func (g *generator) importsMayCollide(f *node) bool {
+	names := make(map[string]string, len(importList))
+	for _, spec := range importList {
+		names[spec.name] = spec.path
+	}
+	for _, n := range g.nodes {
+		if n.Which() != schema.Node_Which_file || n.imp == "" || n.imp == f.imp {
+			continue
+		}
+		name := n.importName()
+		if imp, ok := names[name]; ok && imp != n.imp {
+			return true
+		}
+		names[name] = n.imp
+	}
+	return false
+}
+
+// defineNodes generates the declarations for the nodes in the file f.
+func (g *generator) defineNodes(f *node) error {
+	nodes := f.nodes
+	if g.opts.splitOutput {
+		nodes = g.partNodes(f)
+	}
+	if g.opts.sorted {
+		nodes = sortedNodes(nodes)
+	}
+	for _, n := range nodes {
 		if n.Which() == schema.Node_Which_annotation {
 			if err := g.defineAnnotation(n); err != nil {
 				return err
 			}
 		}
 	}
-	if err := g.defineConstNodes(f.nodes); err != nil {
+	if err := g.defineConstNodes(nodes); err != nil {
 		return err
 	}
-	for _, n := range f.nodes {
+	for _, n := range nodes {
 		var err error
 		switch n.Which() {
 		case schema.Node_Which_enum:
@@ -1184,98 +1637,311 @@ func (g *generator) defineFile() error {
 			return err
 		}
 	}
-	if g.opts.schemas {
-		if err := g.defineSchemaVar(); err != nil {
-			return err
+	return nil
+}
+
+// sortedNodes returns a copy of nodes ordered by name, then by ID.
+func sortedNodes(nodes []*node) []*node {
+	sorted := make([]*node, len(nodes))
+	copy(sorted, nodes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Id() < sorted[j].Id()
+	})
+	return sorted
+}
+
+// requestedFiles returns the files in req to generate code for.  If
+// sorted is true, the files are ordered by name instead of by their
+// order in the request, so that the choice of file that registers a
+// package's schemas does not depend on the order of the files on the
+// command line.
+func requestedFiles(req schema.CodeGeneratorRequest, sorted bool) ([]schema.CodeGeneratorRequest_RequestedFile, error) {
+	reqFiles, err := req.RequestedFiles()
+	if err != nil {
+		return nil, err
+	}
+	files := make([]schema.CodeGeneratorRequest_RequestedFile, reqFiles.Len())
+	names := make([]string, len(files))
+	for i := range files {
+		files[i] = reqFiles.At(i)
+		if names[i], err = files[i].Filename(); err != nil {
+			return nil, fmt.Errorf("failed to get filename %d/%d: %v", i+1, len(files), err)
 		}
 	}
-	return nil
+	if sorted {
+		sort.Stable(requestedFileSlice{files, names})
+	}
+	return files, nil
+}
+
+type requestedFileSlice struct {
+	files []schema.CodeGeneratorRequest_RequestedFile
+	names []string
+}
+
+func (p requestedFileSlice) Len() int           { return len(p.files) }
+func (p requestedFileSlice) Less(i, j int) bool { return p.names[i] < p.names[j] }
+func (p requestedFileSlice) Swap(i, j int) {
+	p.files[i], p.files[j] = p.files[j], p.files[i]
+	p.names[i], p.names[j] = p.names[j], p.names[i]
 }
 
-func generateFile(reqf schema.CodeGeneratorRequest_RequestedFile, trees nodeTrees, opts genoptions) error {
+// generateFile generates the Go code for reqf.  It returns the names
+// of the output files written, which leave out files that already have
+// the generated content if opts.changedOnly is set.
+func generateFile(reqf schema.CodeGeneratorRequest_RequestedFile, trees nodeTrees, opts genoptions) (written []string, err error) {
 	if opts.structStrings && !opts.schemas {
-		return errors.New("cannot generate struct String() methods without embedding schemas")
+		return nil, errors.New("cannot generate struct String() methods without embedding schemas")
 	}
 	id := reqf.Id()
 	fname, _ := reqf.Filename()
 	g := newGenerator(id, trees, opts)
 	if err := g.defineFile(); err != nil {
-		return err
+		return nil, err
+	}
+	gens := []*generator{g}
+	names := []string{fname + ".go"}
+	if opts.splitOutput {
+		parts, err := splitParts(trees.nodes[id], opts.sorted)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range parts {
+			pg := newGenerator(id, trees, opts)
+			pg.part = part
+			pg.data.init(part.Id())
+			if err := pg.defineFile(); err != nil {
+				return nil, err
+			}
+			gens = append(gens, pg)
+			names = append(names, partFileName(fname, part))
+		}
 	}
 
 	if dirPath, _ := filepath.Split(fname); dirPath != "" {
 		err := os.MkdirAll(dirPath, os.ModePerm)
 		if err != nil {
-			return err
+			return nil, err
+		}
+	}
+	for i, g := range gens {
+		ok, err := writeGenerated(names[i], g, opts)
+		if ok {
+			written = append(written, names[i])
+		}
+		if err != nil {
+			return written, err
 		}
 	}
+	if g.schemaFile != nil {
+		name := filepath.Join(filepath.Dir(fname), g.schemaFileName)
+		ok, err := writeFile(name, g.schemaFile, opts)
+		if ok {
+			written = append(written, name)
+		}
+		if err != nil {
+			return written, err
+		}
+	}
+	if opts.splitOutput {
+		if err := removeStaleParts(fname, names[1:]); err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
 
+// writeGenerated formats the code generated by g and writes it to the
+// file name.  It reports whether the file was written, which is false
+// if opts.changedOnly is set and the file already has the content.
+func writeGenerated(name string, g *generator, opts genoptions) (written bool, err error) {
 	unformatted := g.generate()
 	formatted, fmtErr := format.Source(unformatted)
 	if fmtErr != nil {
 		formatted = unformatted
 	}
+	if fmtErr != nil {
+		// Write the unformatted code to help debug the generator.
+		opts.changedOnly = false
+	}
+	written, err = writeFile(name, formatted, opts)
+	if fmtErr != nil {
+		return written, fmtErr
+	}
+	return written, err
+}
 
-	file, err := os.Create(fname + ".go")
+// writeFile writes data to the file name.  It reports whether the file
+// was written, which is false if opts.changedOnly is set and the file
+// already has the content.
+func writeFile(name string, data []byte, opts genoptions) (written bool, err error) {
+	if opts.changedOnly {
+		if old, err := os.ReadFile(name); err == nil && bytes.Equal(old, data) {
+			return false, nil
+		}
+	}
+
+	file, err := os.Create(name)
 	if err != nil {
-		return err
+		return false, err
 	}
-	_, werr := file.Write(formatted)
+	_, werr := file.Write(data)
 	cerr := file.Close()
-	if fmtErr != nil {
-		return fmtErr
-	}
 	if werr != nil {
-		return err
+		return true, werr
 	}
 	if cerr != nil {
-		return err
+		return true, cerr
 	}
-	return nil
+	return true, nil
+}
+
+// writesPackageDecls reports whether the code generated next for the
+// file f will include the declarations that cover its whole package:
+// TypeNames, if type names are enabled, and, if schemas are enabled,
+// the RegisterSchema function.
+func writesPackageDecls(f *node, trees nodeTrees, opts genoptions) bool {
+	if opts.forceSchemasAlways {
+		return true
+	}
+	pkg := trees.pkgs[f.pkg]
+	return pkg != nil && !pkg.done
+}
+
+// generateFiles generates the Go code for each of reqFiles, skipping
+// files that are up to date in cache if it is not nil.  If
+// opts.changedOnly is set, the name of each file written is printed to
+// changed.  Errors are printed to stderr; generateFiles reports whether
+// all files were generated successfully.
+func generateFiles(reqFiles []schema.CodeGeneratorRequest_RequestedFile, trees nodeTrees, opts genoptions, cache *genCache, changed io.Writer) bool {
+	success := true
+	for _, reqf := range reqFiles {
+		fname, _ := reqf.Filename()
+		var hash string
+		if cache != nil {
+			f := trees.nodes[reqf.Id()]
+			registers := f != nil && writesPackageDecls(f, trees, opts)
+			var err error
+			if hash, err = inputHash(reqf, trees, opts, registers); err != nil {
+				fmt.Fprintf(os.Stderr, "capnpc-go: hashing %s: %v\n", fname, err)
+				success = false
+				continue
+			}
+			if cache.upToDate(fname+".go", hash) && (!opts.splitOutput || partsExist(fname, f, opts.sorted)) {
+				if registers && !opts.forceSchemasAlways {
+					// Another file in the package must not write
+					// the package's declarations again.
+					trees.pkgs[f.pkg].done = true
+				}
+				continue
+			}
+		}
+		written, err := generateFile(reqf, trees, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "capnpc-go: generating %s: %v\n", fname, err)
+			success = false
+			continue
+		}
+		if cache != nil {
+			cache.hashes[fname+".go"] = hash
+		}
+		if opts.changedOnly {
+			for _, name := range written {
+				fmt.Fprintln(changed, name)
+			}
+		}
+	}
+	return success
 }
 
 func main() {
 	var opts genoptions
+	var plugins pluginFlag
 	flag.BoolVar(&opts.promises, "promises", true, "generate code for promises")
 	flag.BoolVar(&opts.schemas, "schemas", true, "embed schema information in generated code")
 	flag.BoolVar(&opts.structStrings, "structstrings", true, "generate String() methods for structs (-schemas must be true)")
+	flag.BoolVar(&opts.generics, "generics", false, "generate Go generic types for generic structs and interfaces")
+	flag.BoolVar(&opts.changedOnly, "changed-only", false, "only write output files whose content changed, and print the name of each file written")
+	cachePath := flag.String("cache", "", "skip generating files whose input has not changed since the last run, recording input hashes in `file`")
+	flag.BoolVar(&opts.mustGetters, "must", false, "also generate a MustX() variant of each getter that returns an error, which panics on error instead")
+	flag.BoolVar(&opts.cloneMethods, "clone", false, "generate a Clone(seg) method for each struct, which deep copies the struct into seg's message")
+	flag.BoolVar(&opts.hashMethods, "hash", false, "generate a Hash64() method for each struct, which hashes the struct's canonical form")
+	flag.BoolVar(&opts.typeNames, "typenames", false, "generate a Foo_TypeName constant holding the fully-qualified name of each type Foo, and a TypeNames map from type IDs to names in each package")
+	flag.BoolVar(&opts.splitOutput, "split-output", false, "write each top-level type of a schema file to a file of its own, named after the schema file and the type, instead of one file for the whole schema")
+	flag.BoolVar(&opts.schemaFiles, "schema-files", false, "write the schema embedded in each package to a file named after the schema file with a .schema suffix, and load it with go:embed instead of a string constant")
+	flag.BoolVar(&opts.sorted, "sorted", true, "make the output byte-identical regardless of the order of the nodes and files in the request, for committing generated code and checking it in CI; -sorted=false keeps the request's order")
 	flag.BoolVar(&opts.forceSchemasAlways, "forceschemasalways", false, "(temporary, will be removed) force RegisterSchema() code in every generated .go file even if it is in the same package as another go file. Perhaps useful if the code generation erroneously omits a RegisterSchemas()")
+	templateDir := flag.String("templates", "", "overlay the Go templates in `dir` over the built-in templates used to generate code")
+	flag.Var(&plugins, "plugin", "run the plugin `name[=param]` after generating code, either built in or an executable named capnpc-go-name in $PATH (may be repeated)")
+	var importPath importPathFlag
+	flag.Var(&importPath, "I", "search `dir` for absolute imports when compiling schema files given as arguments (may be repeated)")
 	flag.Parse()
+	if *templateDir != "" {
+		t, err := loadTemplates(*templateDir)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "capnpc-go: loading templates:", err)
+			os.Exit(1)
+		}
+		opts.templates = t
+	}
 
-	msg, err := capnp.NewDecoder(os.Stdin).Decode()
+	var req schema.CodeGeneratorRequest
+	if flag.NArg() > 0 {
+		var err error
+		if req, err = compileRequest(flag.Args(), importPath); err != nil {
+			fmt.Fprintln(os.Stderr, "capnpc-go:", err)
+			os.Exit(1)
+		}
+	} else {
+		msg, err := capnp.NewDecoder(os.Stdin).Decode()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "capnpc-go: reading input:", err)
+			os.Exit(1)
+		}
+		req, err = schema.ReadRootCodeGeneratorRequest(msg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "capnpc-go: reading input:", err)
+			os.Exit(1)
+		}
+	}
+	trees, err := makeNodeTrees(req)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "capnpc-go: reading input:", err)
+		fmt.Fprintln(os.Stderr, "capnpc-go:", err)
 		os.Exit(1)
 	}
-	req, err := schema.ReadRootCodeGeneratorRequest(msg)
+	reqFiles, err := requestedFiles(req, opts.sorted)
 	if err != nil {
-		fmt.Fprintln(os.Stderr, "capnpc-go: reading input:", err)
+		fmt.Fprintln(os.Stderr, "capnpc-go:", err)
 		os.Exit(1)
 	}
-	trees, err := makeNodeTrees(req)
-	if err != nil {
+	if err := checkNameCollisions(reqFiles, trees); err != nil {
 		fmt.Fprintln(os.Stderr, "capnpc-go:", err)
 		os.Exit(1)
 	}
-	success := true
-	reqFiles, _ := req.RequestedFiles()
-	for i := 0; i < reqFiles.Len(); i++ {
-		reqf := reqFiles.At(i)
-		fname, err := reqf.Filename()
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "capnpc-go: failed to get filename %d/%d: %v\n", i + 1, reqFiles.Len(), err)
-			success = false
-			break
+	var cache *genCache
+	if *cachePath != "" {
+		if cache, err = loadCache(*cachePath); err != nil {
+			fmt.Fprintln(os.Stderr, "capnpc-go: loading cache:", err)
+			os.Exit(1)
 		}
-		err = generateFile(reqf, trees, opts)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "capnpc-go: generating %s: %v\n", fname, err)
-			success = false
+	}
+	success := generateFiles(reqFiles, trees, opts, cache, os.Stdout)
+	if cache != nil && success {
+		if err := cache.save(); err != nil {
+			fmt.Fprintln(os.Stderr, "capnpc-go: saving cache:", err)
+			os.Exit(1)
 		}
 	}
 	if !success {
 		os.Exit(1)
 	}
+	if err := runPlugins(plugins, req, trees); err != nil {
+		fmt.Fprintln(os.Stderr, "capnpc-go:", err)
+		os.Exit(1)
+	}
 }
 
 type uint64Slice []uint64
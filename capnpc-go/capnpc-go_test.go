@@ -6,12 +6,17 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/format"
 	"go/parser"
 	"go/token"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
@@ -20,6 +25,7 @@ import (
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/encoding/text"
 	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/schemas"
 )
 
 func readTestFile(name string) ([]byte, error) {
@@ -343,6 +349,13 @@ func TestDefineFile(t *testing.T) {
 		{"rpc.capnp.out", defaultOptions},
 		{"scopes.capnp.out", defaultOptions},
 		{"util.capnp.out", defaultOptions},
+		{"generics.capnp.out", defaultOptions},
+		{"generics.capnp.out", genoptions{
+			promises:      true,
+			schemas:       true,
+			structStrings: true,
+			generics:      true,
+		}},
 	}
 	for _, test := range tests {
 		data, err := readTestFile(test.fname)
@@ -643,3 +656,1708 @@ func TestPersistent(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerics(t *testing.T) {
+	t.Parallel()
+	dir, err := setupTempDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The capnp.out is generated with:
+	// capnp compile -o- generics.capnp > generics.capnp.out
+	req := mustReadGeneratorRequest(t, "generics.capnp.out")
+	reqFiles, err := req.RequestedFiles()
+	if err != nil {
+		t.Fatal("RequestedFiles:", err)
+	}
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	opts := genoptions{
+		promises:      true,
+		schemas:       true,
+		structStrings: true,
+		generics:      true,
+	}
+	g := newGenerator(reqFiles.At(0).Id(), trees, opts)
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src := g.generate()
+	if err := os.WriteFile(filepath.Join(dir, "generics.capnp.go"), src, 0660); err != nil {
+		t.Fatal(err)
+	}
+
+	// The brand bindings in the schema must show up in the Go types, so
+	// that this only compiles if the generated code is properly typed.
+	const usage = `package generics
+
+import "capnproto.org/go/capnp/v3"
+
+func useGenerics(u Uses, s Store_Server[Inner]) error {
+	m, err := u.InnerMap()
+	if err != nil {
+		return err
+	}
+	entries, err := m.NewEntries(1)
+	if err != nil {
+		return err
+	}
+	var inner Inner = Inner{}
+	if err := entries.At(0).SetValue(inner); err != nil {
+		return err
+	}
+	var key capnp.Ptr
+	key, err = entries.At(0).Key()
+	if err != nil {
+		return err
+	}
+	_ = key
+
+	p, err := u.NewPair()
+	if err != nil {
+		return err
+	}
+	var list Inner_List
+	if err := p.SetSecond(list); err != nil {
+		return err
+	}
+	var first Inner
+	first, err = p.Grp().AlsoFirst()
+	_ = first
+
+	var store Store[Inner] = u.Store()
+	_ = store
+	var c InnerStore = InnerStore(Store_ServerToClient(s))
+	store = c.AsStore()
+
+	// A superclass implementation serves the subclass, since the
+	// server interface embeds the superclass's.
+	var is InnerStore_Server = s
+	_ = InnerStore_ServerToClient(is)
+	return err
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "usage.go"), []byte(usage), 0660); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "vet", "generics.capnp.go", "usage.go")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("go vet: %v\n%s\n%s", err, out, src)
+	}
+}
+
+func TestPluginFlag(t *testing.T) {
+	var f pluginFlag
+	for _, s := range []string{"docs", "orm=out/orm,tags=db"} {
+		if err := f.Set(s); err != nil {
+			t.Fatalf("Set(%q): %v", s, err)
+		}
+	}
+	want := pluginFlag{{name: "docs"}, {name: "orm", param: "out/orm,tags=db"}}
+	if len(f) != len(want) || f[0] != want[0] || f[1] != want[1] {
+		t.Errorf("plugins = %+v; want %+v", f, want)
+	}
+	if err := f.Set("=x"); err == nil {
+		t.Error("Set(\"=x\") succeeded; want error")
+	}
+}
+
+func TestExecPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin script requires a Unix shell")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\ncat > \"$(dirname \"$0\")/request.bin\"\nprintf %s \"$1\" > \"$(dirname \"$0\")/param.txt\"\n"
+	if err := os.WriteFile(filepath.Join(dir, pluginPrefix+"test"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	req := mustReadGeneratorRequest(t, "go.capnp.out")
+	if err := runPlugins([]pluginSpec{{name: "test", param: "foo=bar"}}, req, nodeTrees{}); err != nil {
+		t.Fatal("runPlugins:", err)
+	}
+	want, err := req.Message().Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := os.ReadFile(filepath.Join(dir, "request.bin")); err != nil {
+		t.Error(err)
+	} else if !bytes.Equal(got, want) {
+		t.Error("plugin did not receive the code generator request")
+	}
+	if got, err := os.ReadFile(filepath.Join(dir, "param.txt")); err != nil {
+		t.Error(err)
+	} else if string(got) != "foo=bar" {
+		t.Errorf("plugin parameter = %q; want \"foo=bar\"", got)
+	}
+
+	if err := runPlugins([]pluginSpec{{name: "nonexistent"}}, req, nodeTrees{}); err == nil {
+		t.Error("runPlugins with missing plugin succeeded; want error")
+	}
+}
+
+func TestJSONSchemaPlugin(t *testing.T) {
+	tests := []struct {
+		fname string
+		out   string
+		check func(t *testing.T, defs map[string]any)
+	}{
+		{
+			fname: "aircraft.capnp.out",
+			out:   "aircraft.capnp.schema.json",
+			check: func(t *testing.T, defs map[string]any) {
+				wantJSON(t, defs, "Airport", `{"title":"Airport","type":"string","enum":["none","jfk","lax","sfo","luv","dfw","test"]}`)
+				wantJSON(t, defs, "Zdate", `{
+					"title": "Zdate",
+					"type": "object",
+					"additionalProperties": false,
+					"properties": {
+						"year": {"type": "integer", "minimum": -32768, "maximum": 32767},
+						"month": {"type": "integer", "minimum": 0, "maximum": 255},
+						"day": {"type": "integer", "minimum": 0, "maximum": 255}
+					}
+				}`)
+				z, _ := defs["Z"].(map[string]any)
+				props, _ := z["properties"].(map[string]any)
+				wantJSON(t, props, "i64", `{"type": "string", "pattern": "^-?[0-9]+$"}`)
+				wantJSON(t, props, "zdatevec", `{"type": "array", "items": {"$ref": "#/$defs/Zdate"}}`)
+				wantJSON(t, props, "airport", `{"$ref": "#/$defs/Airport"}`)
+			},
+		},
+		{
+			fname: "group.capnp.out",
+			out:   "group.capnp.schema.json",
+			check: func(t *testing.T, defs map[string]any) {
+				wantJSON(t, defs, "SomeMisguidedStruct", `{
+					"title": "SomeMisguidedStruct",
+					"type": "object",
+					"additionalProperties": false,
+					"properties": {
+						"someGroup": {
+							"type": "object",
+							"additionalProperties": false,
+							"properties": {
+								"someGroupField": {"type": "string", "pattern": "^[0-9]+$"}
+							}
+						}
+					}
+				}`)
+				if len(defs) != 1 {
+					t.Errorf("got %d definitions; want 1 (groups should not be defined separately)", len(defs))
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		req := mustReadGeneratorRequest(t, test.fname)
+		trees, err := makeNodeTrees(req)
+		if err != nil {
+			t.Errorf("makeNodeTrees %s: %v", test.fname, err)
+			continue
+		}
+		dir := t.TempDir()
+		if err := runPlugins([]pluginSpec{{name: "jsonschema", param: dir}}, req, trees); err != nil {
+			t.Errorf("jsonschema plugin %s: %v", test.fname, err)
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(test.out)))
+		if err != nil {
+			t.Errorf("%s: %v", test.fname, err)
+			continue
+		}
+		var doc struct {
+			Schema string         `json:"$schema"`
+			Defs   map[string]any `json:"$defs"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Errorf("%s: parsing output: %v", test.fname, err)
+			continue
+		}
+		if doc.Schema != jsonSchemaURI {
+			t.Errorf("%s: $schema = %q; want %q", test.fname, doc.Schema, jsonSchemaURI)
+		}
+		test.check(t, doc.Defs)
+	}
+}
+
+func TestDescribePlugin(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "describe.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	dir := t.TempDir()
+	if err := runPlugins([]pluginSpec{{name: "describe", param: dir}}, req, trees); err != nil {
+		t.Fatal("describe plugin:", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "describe.capnp.describe.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var desc fileDesc
+	if err := json.Unmarshal(data, &desc); err != nil {
+		t.Fatal("parsing output:", err)
+	}
+	if desc.File != "describe.capnp" || desc.ID != "0xd6a1c9f3e2b48a57" {
+		t.Errorf("file = %q, id = %s; want \"describe.capnp\", 0xd6a1c9f3e2b48a57", desc.File, desc.ID)
+	}
+	nodes := make(map[string]nodeDesc)
+	for _, n := range desc.Nodes {
+		nodes[n.Name] = n
+	}
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	wantNames := []string{"Catalog", "Catalog.find$Params", "Catalog.find$Results", "Item", "Item.dims", "Kind", "label", "maxItems"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("node names = %q; want %q", names, wantNames)
+	}
+
+	item := nodes["Item"]
+	if item.Kind != "struct" || item.GoName != "Item" || item.Doc != "An item in a catalog.\n" {
+		t.Errorf("Item = {Kind: %q, GoName: %q, Doc: %q}; want {\"struct\", \"Item\", \"An item in a catalog.\\n\"}", item.Kind, item.GoName, item.Doc)
+	}
+	if len(item.Fields) != 7 {
+		t.Fatalf("Item has %d fields; want 7", len(item.Fields))
+	}
+	name := item.Fields[0]
+	wantAnn := []annotationDesc{{ID: "0x8d2726f768fc7a55", Name: "describe.capnp:label", Value: "Name"}}
+	if name.Doc != "The item's name.\n" || !reflect.DeepEqual(name.Annotations, wantAnn) {
+		t.Errorf("Item.name doc = %q, annotations = %+v; want \"The item's name.\\n\", %+v", name.Doc, name.Annotations, wantAnn)
+	}
+	if price := item.Fields[1]; price.Default != "-5" {
+		t.Errorf("Item.price default = %#v; want \"-5\"", price.Default)
+	}
+	if tags := item.Fields[2]; tags.Type == nil || tags.Type.Kind != "list" || tags.Type.Element == nil || tags.Type.Element.Kind != "text" {
+		t.Errorf("Item.tags type = %+v; want list of text", tags.Type)
+	}
+	if owner := item.Fields[5]; owner.DiscriminantValue == nil || *owner.DiscriminantValue != 1 || owner.Type == nil || owner.Type.Name != "describe.capnp:Catalog" {
+		t.Errorf("Item.owner = %+v; want union member 1 of type Catalog", owner)
+	}
+	if dims := item.Fields[6]; dims.Group != nodes["Item.dims"].ID || dims.Ordinal != nil {
+		t.Errorf("Item.dims = %+v; want group %s without ordinal", dims, nodes["Item.dims"].ID)
+	}
+
+	kind := nodes["Kind"]
+	if len(kind.Enumerants) != 2 || kind.Enumerants[1].Name != "gadget" || len(kind.Enumerants[1].Annotations) != 1 {
+		t.Errorf("Kind enumerants = %+v; want widget, gadget with one annotation", kind.Enumerants)
+	}
+	catalog := nodes["Catalog"]
+	if len(catalog.Methods) != 1 {
+		t.Fatalf("Catalog has %d methods; want 1", len(catalog.Methods))
+	}
+	find := catalog.Methods[0]
+	if find.Name != "find" || find.Params != nodes["Catalog.find$Params"].ID || find.Results != nodes["Catalog.find$Results"].ID {
+		t.Errorf("Catalog.find = %+v", find)
+	}
+	if len(find.Annotations) != 1 || find.Annotations[0].Name != "go.capnp:idempotent" {
+		t.Errorf("Catalog.find annotations = %+v; want $Go.idempotent", find.Annotations)
+	}
+	if c := nodes["maxItems"]; c.Kind != "const" || c.Value != float64(100) {
+		t.Errorf("maxItems = {Kind: %q, Value: %#v}; want {\"const\", 100}", c.Kind, c.Value)
+	}
+	if a := nodes["label"]; a.Kind != "annotation" || !reflect.DeepEqual(a.Targets, []string{"enumerant", "field"}) {
+		t.Errorf("label = {Kind: %q, Targets: %q}; want {\"annotation\", [enumerant field]}", a.Kind, a.Targets)
+	}
+}
+
+func TestGRPCPlugin(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "describe.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	dir := t.TempDir()
+	if err := runPlugins([]pluginSpec{{name: "grpc", param: dir}}, req, trees); err != nil {
+		t.Fatal("grpc plugin:", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "describe.capnp.grpc.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "describe.capnp.grpc.go", data, 0)
+	if err != nil {
+		t.Fatal("parsing output:", err)
+	}
+	if f.Name.Name != "describe" {
+		t.Errorf("package = %s; want describe", f.Name.Name)
+	}
+	var decls []string
+	for name := range f.Scope.Objects {
+		decls = append(decls, name)
+	}
+	sort.Strings(decls)
+	wantDecls := []string{"Catalog_FromGRPC", "Catalog_GRPCServiceDesc", "Catalog_RegisterGRPC"}
+	if !reflect.DeepEqual(decls, wantDecls) {
+		t.Errorf("declarations = %q; want %q", decls, wantDecls)
+	}
+	for _, want := range []string{
+		`ServiceName: "describe.Catalog"`,
+		`MethodName: "find"`,
+		`FullMethod: "/describe.Catalog/find"`,
+		`InterfaceID:   0xd1a1bc419f932cc0`,
+		`InterfaceName: "describe.capnp:Catalog"`,
+	} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+
+	// Files without interfaces produce no output.
+	req = mustReadGeneratorRequest(t, "const.capnp.out")
+	if trees, err = makeNodeTrees(req); err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	if err := runPlugins([]pluginSpec{{name: "grpc", param: dir}}, req, trees); err != nil {
+		t.Fatal("grpc plugin:", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "const.capnp.grpc.go")); !os.IsNotExist(err) {
+		t.Errorf("stat const.capnp.grpc.go: %v; want not exist", err)
+	}
+}
+
+func TestSizeReportPlugin(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "sizes.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	dir := t.TempDir()
+	if err := runPlugins([]pluginSpec{{name: "sizereport", param: dir}}, req, trees); err != nil {
+		t.Fatal("sizereport plugin:", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "sizes.capnp.sizes.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report sizeReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatal("parsing output:", err)
+	}
+	if report.File != "sizes.capnp" {
+		t.Errorf("file = %q; want \"sizes.capnp\"", report.File)
+	}
+	want := []structSizes{
+		{
+			Name:         "Mixed",
+			ID:           "0xa58a0d0ebc7a6c7d",
+			DataWords:    2,
+			PointerCount: 1,
+			UsedDataBits: 105,
+			PaddingBits:  23,
+			MinDataWords: 2,
+		},
+		{
+			Name:           "Late",
+			ID:             "0xf1b586c34b61381c",
+			DataWords:      4,
+			UsedDataBits:   201,
+			PaddingBits:    55,
+			MinDataWords:   3,
+			SuggestedOrder: []string{"c", "grp.e", "grp.d", "a", "b", "f"},
+		},
+	}
+	if !reflect.DeepEqual(report.Structs, want) {
+		t.Errorf("structs = %+v; want %+v", report.Structs, want)
+	}
+}
+
+// wantJSON reports an error if m[key] is not equal to the JSON value
+// in want.
+func wantJSON(t *testing.T, m map[string]any, key string, want string) {
+	t.Helper()
+	var w any
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("bad JSON for %s: %v", key, err)
+	}
+	if got := m[key]; !reflect.DeepEqual(got, w) {
+		gotJSON, _ := json.Marshal(got)
+		t.Errorf("%s = %s; want %s", key, gotJSON, want)
+	}
+}
+
+func TestTemplateOverlay(t *testing.T) {
+	dir := t.TempDir()
+	typeid := "// {{.Name}}_TypeID is overridden.\nconst {{.Name}}_TypeID = {{.Id|printf \"%#x\"}}\n"
+	if err := os.WriteFile(filepath.Join(dir, "_typeid"), []byte(typeid), 0666); err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := loadTemplates(dir)
+	if err != nil {
+		t.Fatal("loadTemplates:", err)
+	}
+	if templates.Lookup("_typeid") == tmpl.Lookup("_typeid") {
+		t.Fatal("loadTemplates did not replace _typeid")
+	}
+
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	opts := genoptions{promises: true, templates: tmpl}
+	g := newGenerator(reqFiles.At(0).Id(), trees, opts)
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src := string(g.generate())
+	if !strings.Contains(src, "// Zdate_TypeID is overridden.") {
+		t.Error("generated code does not use overridden _typeid template")
+	}
+	if !strings.Contains(src, "type Zdate capnp.Struct") {
+		t.Error("generated code is missing output of built-in templates")
+	}
+
+	// The built-in templates must be unaffected.
+	g = newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	if strings.Contains(string(g.generate()), "is overridden") {
+		t.Error("overriding templates changed the built-in templates")
+	}
+
+	if _, err := loadTemplates(t.TempDir()); err == nil {
+		t.Error("loadTemplates on empty directory succeeded; want error")
+	}
+}
+
+func TestDocComments(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "doc.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	tests := []string{
+		"// A widget is something that can be displayed.\n//\n// Widgets have a name.\ntype Widget capnp.Struct\n",
+		"// The widget's name.\nfunc (s Widget) Name() (string, error) {\n",
+		"// The widget's size\n// in pixels.\nfunc (s Widget) Size() uint32 {\n",
+		"// The widget's label.\ntype Widget_label Widget\n",
+		"// The widget's label.\nfunc (s Widget) Label() Widget_label {",
+		// $Go.doc takes precedence over the doc comment.
+		"// Kind is the kind of a widget.\ntype Kind uint16\n",
+		"\t// A clickable button.\n\tKind_button Kind = 0\n",
+		"// Display shows widgets.\ntype Display capnp.Client\n",
+		"// Shows a widget on the display.\nfunc (c Display) Show(",
+		"type Display_Server interface {\n\t// Shows a widget on the display.\n\tShow(",
+	}
+	for _, want := range tests {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if strings.Contains(string(src), "Kinds of widgets.") {
+		t.Error("generated code contains doc comment overridden by $Go.doc")
+	}
+}
+
+func TestDeprecated(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "deprecated.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	tests := []string{
+		"// An old kind of widget.\n//\n// Deprecated: use Widget instead.\ntype OldWidget capnp.Struct\n",
+		"// Deprecated: use Widget instead.\nfunc NewOldWidget(",
+		"// Deprecated: use Widget instead.\nfunc NewRootOldWidget(",
+		"// Deprecated: use Widget instead.\nfunc ReadRootOldWidget(",
+		"// The widget's name.\n//\n// Deprecated: use title instead.\nfunc (s Widget) Name() (string, error) {\n",
+		"// Deprecated: use title instead.\nfunc (s Widget) HasName() bool {\n",
+		"// Deprecated: use title instead.\nfunc (s Widget) NameBytes() ([]byte, error) {\n",
+		"// Deprecated: use title instead.\nfunc (s Widget) SetName(v string) error {\n",
+		"// Deprecated: " + defaultDeprecation + "\nfunc (s Widget) Parent() (Widget, error) {\n",
+		"preferring placement in s's segment.\n//\n// Deprecated: " + defaultDeprecation + "\nfunc (s Widget) NewParent() (Widget, error) {\n",
+		"\t// Deprecated: use gray instead.\n\tColor_grey Color = 1\n",
+		"// Draws a widget.\n//\n// Deprecated: use show instead.\nfunc (c Display) Draw(",
+		"\t// Draws a widget.\n\t//\n\t// Deprecated: use show instead.\n\tDraw(context.Context, Display_draw) error\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if n := strings.Count(string(src), "Deprecated:"); n != 15 {
+		t.Errorf("generated code has %d deprecation notices; want 15", n)
+	}
+}
+
+func TestIdempotent(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "idempotent.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	want := "\t\t\tMethodName:    \"get\",\n\t\t},\n\t\tIdempotent: true,\n\t}\n"
+	if !strings.Contains(string(src), want) {
+		t.Errorf("generated code does not contain %q", want)
+	}
+	if n := strings.Count(string(src), "Idempotent: true"); n != 1 {
+		t.Errorf("generated code marks %d calls as idempotent; want 1", n)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "timeout.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	for _, test := range []struct {
+		want string
+		n    int
+	}{
+		// Once in PlaceArgs and once in SetTimeout.
+		{"\t\ts.SetUint64(0, server.CallTimeout(ctx))\n", 2},
+		{"\ts.SetTimeout = func(ctx context.Context, s capnp.Struct) {\n", 1},
+		{"\t\tTimeout: func(args capnp.Struct) uint64 { return args.Uint64(0) },\n", 1},
+	} {
+		if n := strings.Count(string(src), test.want); n != test.n {
+			t.Errorf("generated code contains %q %d times; want %d", test.want, n, test.n)
+		}
+	}
+}
+
+func TestFieldPaths(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "deprecated.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	tests := []string{
+		"func (s OldWidget) Name() (string, error) {\n\treturn capnp.GetTextField(s, 0, \"OldWidget.name\")\n",
+		"func (s Widget) NameBytes() ([]byte, error) {\n\treturn capnp.GetTextBytesField(s, 1, \"Widget.name\")\n",
+		"func (s Widget) Parent() (Widget, error) {\n\treturn capnp.GetStructField[Widget](s, 2, \"Widget.parent\")\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if strings.Contains(string(src), "capnp.Struct(s).Ptr(") {
+		t.Error("generated getters read pointers without a field path")
+	}
+}
+
+func TestCloneMethod(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "style.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	generate := func(opts genoptions) string {
+		g := newGenerator(reqFiles.At(0).Id(), trees, opts)
+		if err := g.defineFile(); err != nil {
+			t.Fatal("defineFile:", err)
+		}
+		src, err := format.Source(g.generate())
+		if err != nil {
+			t.Fatal("formatting generated code:", err)
+		}
+		return string(src)
+	}
+
+	want := "func (s Widget) Clone(seg *capnp.Segment) (Widget, error) {\n\tc, err := capnp.Struct(s).Clone(seg)\n\treturn Widget(c), err\n}\n"
+	if src := generate(genoptions{promises: true, schemas: true, structStrings: true, cloneMethods: true}); !strings.Contains(src, want) {
+		t.Errorf("generated code does not contain %q", want)
+	}
+	if src := generate(genoptions{promises: true, schemas: true, structStrings: true}); strings.Contains(src, ") Clone(") {
+		t.Error("generated code contains Clone without cloneMethods")
+	}
+}
+
+func TestMustGetters(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	generate := func(opts genoptions) string {
+		g := newGenerator(reqFiles.At(0).Id(), trees, opts)
+		if err := g.defineFile(); err != nil {
+			t.Fatal("defineFile:", err)
+		}
+		src, err := format.Source(g.generate())
+		if err != nil {
+			t.Fatal("formatting generated code:", err)
+		}
+		return string(src)
+	}
+
+	src := generate(genoptions{promises: true, schemas: true, structStrings: true, mustGetters: true})
+	tests := []string{
+		"func (s PlaneBase) MustName() string {\n\tv, err := s.Name()\n\tif err != nil {\n\t\tpanic(err)\n\t}\n\treturn v\n}\n",
+		"func (s PlaneBase) MustHomes() Airport_List {\n",
+		"func (s Z) MustZvec() Z_List {\n",
+		"func (s Z) MustBlob() []byte {\n",
+		"func (s Z) MustPlanebase() PlaneBase {\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	for _, unwanted := range []string{"MustRating", "MustEcho("} {
+		if strings.Contains(src, unwanted) {
+			t.Errorf("generated code contains %s, but its getter returns no error", unwanted)
+		}
+	}
+
+	if src := generate(genoptions{promises: true, schemas: true, structStrings: true}); strings.Contains(src, ") MustName() ") {
+		t.Error("generated code contains MustName without mustGetters")
+	}
+}
+
+func TestDataReaderAccessors(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	tests := []string{
+		"func (s Z) BlobReader() (*bytes.Reader, error) {\n\tv, err := s.Blob()\n\treturn bytes.NewReader(v), err\n}\n",
+		"func (s Z) SetBlobFromReader(r io.Reader, size int) error {\n\tcapnp.Struct(s).SetUint16(0, 14)\n\treturn capnp.Struct(s).SetDataFromReader(0, r, size)\n}\n",
+		"bytes \"bytes\"\n",
+		"io \"io\"\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+}
+
+// TestSharedFieldAccessors checks that the accessors of pointer fields
+// in a schema with every kind of field are thin wrappers around the
+// shared helpers in package capnp, so that the code for reading and
+// writing a kind of pointer is compiled once rather than once per
+// field.
+func TestSharedFieldAccessors(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "aircraft.capnp.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var direct []string
+	helpers := 0
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Body == nil {
+			continue
+		}
+		recv, ok := fn.Recv.List[0].Type.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		calls := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == "capnp" && strings.HasSuffix(sel.Sel.Name, "Field") {
+				helpers++
+			}
+			if sel.Sel.Name == "FieldPtr" || sel.Sel.Name == "SetPtr" {
+				calls = true
+			}
+			return true
+		})
+		if calls {
+			direct = append(direct, recv.Name+"."+fn.Name.Name)
+		}
+	}
+	if len(direct) > 0 {
+		t.Errorf("methods that access the pointer section directly: %q", direct)
+	}
+	if helpers == 0 {
+		t.Error("generated code does not call the shared field helpers")
+	}
+}
+
+func TestMustGettersCollision(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "mustcollision.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{mustGetters: true})
+	err = g.defineFile()
+	if err == nil || !strings.Contains(err.Error(), "MustName") {
+		t.Errorf("defineFile() = %v; want MustName collision error", err)
+	}
+	g = newGenerator(reqFiles.At(0).Id(), trees, genoptions{})
+	if err := g.defineFile(); err != nil {
+		t.Errorf("defineFile() without mustGetters: %v", err)
+	}
+}
+
+func TestSetFromSlice(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	tests := []string{
+		"func (s Z) SetF64vecFromSlice(v []float64) error {\n",
+		"\treturn capnp.SetListFieldFromSlice(s, 0, v, capnp.NewFloat64ListFromSlice)\n",
+		"func (s Z) SetU8vecFromSlice(v []uint8) error {\n",
+		"func (s Z) SetBoolvecFromSlice(v []bool) error {\n",
+		"func (s Z) SetTextvecFromSlice(v []string) error {\n",
+		"func (s Z) SetDatavecFromSlice(v [][]byte) error {\n",
+		"func (s PlaneBase) SetHomesFromSlice(v []Airport) error {\n",
+		"\treturn capnp.SetListFieldFromSlice(s, 1, v, capnp.NewEnumListFromSlice[Airport])\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	for _, unwanted := range []string{"SetZvecFromSlice", "SetZvecvecFromSlice"} {
+		if strings.Contains(string(src), unwanted) {
+			t.Errorf("generated code contains %s, but struct lists cannot be made from slices", unwanted)
+		}
+	}
+}
+
+func TestImportAliases(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "importalias.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "importalias.capnp.go", src, parser.ImportsOnly)
+	if err != nil {
+		t.Fatal("parsing generated code:", err)
+	}
+	got := make(map[string]string)
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		got[path] = spec.Name.Name
+	}
+	const testdata = "capnproto.org/go/capnp/v3/capnpc-go/testdata/"
+	want := map[string]string{
+		testdata + "a/util":    "autil",
+		testdata + "b/util":    "butil",
+		testdata + "c/util":    "cutil",
+		testdata + "d/context": "dcontext",
+		"context":              "context",
+	}
+	for path, name := range want {
+		if got[path] != name {
+			t.Errorf("import %q as %q; want %q", path, got[path], name)
+		}
+	}
+}
+
+func TestImportAliasesOrder(t *testing.T) {
+	specs := []importSpec{
+		{path: "example.com/a/util", name: "util"},
+		{path: "example.com/b/util", name: "util"},
+		{path: "example.com/v2/util", name: "util"},
+		{path: "example.com/other", name: "other"},
+		{path: "example.com/x/strconv", name: "strconv"},
+		{path: "example.com/1/util", name: "util"},
+	}
+	var want []importSpec
+	for _, perm := range [][]int{{0, 1, 2, 3, 4, 5}, {5, 4, 3, 2, 1, 0}, {2, 0, 4, 1, 5, 3}} {
+		imp := newImports()
+		for _, i := range perm {
+			imp.add(specs[i])
+		}
+		if !imp.renamed {
+			t.Fatalf("order %v: no import was renamed", perm)
+		}
+		got := imp.aliases()
+		if want == nil {
+			want = got
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("order %v: aliases = %s; want %s", perm, formatImportSpecs(got), formatImportSpecs(want))
+		}
+	}
+	names := make(map[string]bool)
+	for _, spec := range want {
+		if names[spec.name] {
+			t.Errorf("aliases = %s; name %q used twice", formatImportSpecs(want), spec.name)
+		}
+		names[spec.name] = true
+	}
+	for _, spec := range []importSpec{
+		{path: "example.com/a/util", name: "autil"},
+		{path: "example.com/v2/util", name: "v2util"},
+		{path: "example.com/other", name: "other"},
+		{path: "example.com/x/strconv", name: "xstrconv"},
+	} {
+		if !hasExactImports([]importSpec{spec}, imports{specs: want, used: map[string]bool{spec.path: true}}) {
+			t.Errorf("aliases = %s; want %s among them", formatImportSpecs(want), spec)
+		}
+	}
+}
+
+func TestAccessorStyle(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "style.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	tests := []string{
+		"func (s Widget) GetName() (string, error) {\n",
+		"func (s Widget) GetNameBytes() ([]byte, error) {\n",
+		"func (s Widget) WithName(v string) error {\n",
+		"func (s Widget) HasName() bool {\n",
+		"func (s Widget) GetCount() uint32 {\n",
+		"func (s Widget) WithCount(v uint32) {\n",
+		"func (s Widget) GetParent() (Widget, error) {\n",
+		"func (s Widget) NewParent() (Widget, error) {\n",
+		"func (s Widget) NewTags(n int32) (capnp.TextList, error) {\n",
+		"func (s Widget) WithNone() {\n",
+		"func (s Widget) GetSize() Widget_size { return Widget_size(s) }\n",
+		"func (s Widget) WithSize() {\n",
+		"func (s Widget_size) GetWidth() float64 {\n",
+		"func (p Widget_Future) GetParent() Widget_Future {\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if strings.Contains(string(src), ") SetName(") {
+		t.Error("generated code contains SetName")
+	}
+}
+
+func TestFileStyle(t *testing.T) {
+	tests := []struct {
+		getter, setter string
+		want           style
+		ok             bool
+	}{
+		{"", "", style{setterPrefix: "Set"}, true},
+		{"Get", "", style{getterPrefix: "Get", setterPrefix: "Set"}, true},
+		{"", "With", style{setterPrefix: "With"}, true},
+		{"Set", "", style{}, false},
+		{"get", "", style{}, false},
+		{"", "With Foo", style{}, false},
+	}
+	for _, test := range tests {
+		got, err := fileStyle(&annotations{GetterPrefix: test.getter, SetterPrefix: test.setter})
+		if test.ok && (err != nil || got != test.want) {
+			t.Errorf("fileStyle(%q, %q) = %+v, %v; want %+v, <nil>", test.getter, test.setter, got, err, test.want)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("fileStyle(%q, %q) = %+v; want error", test.getter, test.setter, got)
+		}
+	}
+}
+
+func TestComment(t *testing.T) {
+	tests := []struct {
+		doc, want string
+	}{
+		{"", ""},
+		{" \n", ""},
+		{"Hello.\n", "// Hello.\n"},
+		{"Line one.\n\nLine two.  \n", "// Line one.\n//\n// Line two.\n"},
+	}
+	for _, test := range tests {
+		if got := comment(test.doc); got != test.want {
+			t.Errorf("comment(%q) = %q; want %q", test.doc, got, test.want)
+		}
+	}
+}
+
+func TestSorted(t *testing.T) {
+	// persistent-simple-and-samepkg.capnp.out has two files in the same
+	// package, so only one of them registers the package's schemas.
+	req := mustReadGeneratorRequest(t, "persistent-simple-and-samepkg.capnp.out")
+	opts := genoptions{promises: true, schemas: true, structStrings: true, sorted: true}
+	want := generateAll(t, req, opts)
+	got := generateAll(t, reverseRequest(t, req), opts)
+	if len(got) != len(want) {
+		t.Fatalf("generated %d files from reversed request; want %d", len(got), len(want))
+	}
+	registered := 0
+	for name, src := range want {
+		if !bytes.Equal(got[name], src) {
+			t.Errorf("%s differs when generated from reversed request", name)
+		}
+		if bytes.Contains(src, []byte("func RegisterSchema(")) {
+			registered++
+		}
+	}
+	if registered != 1 {
+		t.Errorf("RegisterSchema generated in %d files; want 1", registered)
+	}
+}
+
+func TestTypeNames(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	srcs := generateAll(t, req, genoptions{promises: true, structStrings: true, typeNames: true})
+	src := string(srcs["aircraft.capnp"])
+	tests := []string{
+		"const Zdate_TypeName = \"aircraft.capnp:Zdate\"\n",
+		"const Echo_echo_Params_TypeName = \"aircraft.capnp:Echo.echo$Params\"\n",
+		"var TypeNames = map[uint64]string{",
+		"0xde50aebbad57549d: \"aircraft.capnp:Zdate\",\n",
+	}
+	for _, want := range tests {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+
+	// Files in the same package must not declare TypeNames twice,
+	// whether or not they register schemas.
+	req = mustReadGeneratorRequest(t, "persistent-simple-and-samepkg.capnp.out")
+	declared := 0
+	for _, src := range generateAll(t, req, genoptions{promises: true, structStrings: true, typeNames: true}) {
+		declared += bytes.Count(src, []byte("var TypeNames = "))
+	}
+	if declared != 1 {
+		t.Errorf("TypeNames declared in %d files; want 1", declared)
+	}
+
+	// Without the option, neither is generated.
+	for _, src := range generateAll(t, req, genoptions{promises: true, structStrings: true}) {
+		if bytes.Contains(src, []byte("TypeName")) {
+			t.Error("generated code contains type names without typeNames")
+		}
+	}
+}
+
+// generateAll generates code for all the files requested in req,
+// returning the unformatted source keyed by file name.
+func generateAll(t *testing.T, req schema.CodeGeneratorRequest, opts genoptions) map[string][]byte {
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, err := requestedFiles(req, opts.sorted)
+	if err != nil {
+		t.Fatal("requestedFiles:", err)
+	}
+	srcs := make(map[string][]byte)
+	for _, reqf := range reqFiles {
+		fname, _ := reqf.Filename()
+		g := newGenerator(reqf.Id(), trees, opts)
+		if err := g.defineFile(); err != nil {
+			t.Fatalf("defineFile %s: %v", fname, err)
+		}
+		srcs[fname] = g.generate()
+	}
+	return srcs
+}
+
+// reverseRequest returns a copy of req with its nodes and requested
+// files in reverse order.
+func reverseRequest(t *testing.T, req schema.CodeGeneratorRequest) schema.CodeGeneratorRequest {
+	_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+	rev, err := schema.NewRootCodeGeneratorRequest(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, _ := req.Nodes()
+	revNodes, err := rev.NewNodes(int32(nodes.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < nodes.Len(); i++ {
+		if err := revNodes.Set(i, nodes.At(nodes.Len()-1-i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, _ := req.RequestedFiles()
+	revFiles, err := rev.NewRequestedFiles(int32(files.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < files.Len(); i++ {
+		if err := revFiles.Set(i, files.At(files.Len()-1-i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return rev
+}
+
+func TestCache(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "persistent-simple-and-samepkg.capnp.out")
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	opts := genoptions{promises: true, schemas: true, structStrings: true, changedOnly: true}
+	cachePath := filepath.Join(dir, "capnpc-go.cache")
+	run := func(useCache bool) []string {
+		t.Helper()
+		trees, err := makeNodeTrees(req)
+		if err != nil {
+			t.Fatal("makeNodeTrees:", err)
+		}
+		reqFiles, err := requestedFiles(req, opts.sorted)
+		if err != nil {
+			t.Fatal("requestedFiles:", err)
+		}
+		var cache *genCache
+		if useCache {
+			if cache, err = loadCache(cachePath); err != nil {
+				t.Fatal("loadCache:", err)
+			}
+		}
+		var changed bytes.Buffer
+		if !generateFiles(reqFiles, trees, opts, cache, &changed) {
+			t.Fatal("generateFiles failed")
+		}
+		if cache != nil {
+			if err := cache.save(); err != nil {
+				t.Fatal("save:", err)
+			}
+		}
+		return strings.Fields(changed.String())
+	}
+	outputs := func() map[string]string {
+		t.Helper()
+		m := make(map[string]string)
+		for _, name := range []string{"persistent-simple.capnp.go", "persistent-samepkg.capnp.go"} {
+			m[name] = string(mustReadFile(t, name))
+		}
+		return m
+	}
+
+	want := []string{"persistent-simple.capnp.go", "persistent-samepkg.capnp.go"}
+	if got := run(true); !reflect.DeepEqual(got, want) {
+		t.Errorf("first run wrote %q; want %q", got, want)
+	}
+	first := outputs()
+	if got := run(true); len(got) != 0 {
+		t.Errorf("run with up to date cache wrote %q; want none", got)
+	}
+	if got := run(false); len(got) != 0 {
+		t.Errorf("run without cache wrote unchanged files %q; want none", got)
+	}
+
+	// Remove the file that does not register the package's schemas: it
+	// must be regenerated without them.
+	registered := ""
+	for name, src := range first {
+		if strings.Contains(src, "func RegisterSchema(") {
+			registered = name
+		}
+	}
+	for _, name := range want {
+		if name == registered {
+			continue
+		}
+		if err := os.Remove(name); err != nil {
+			t.Fatal(err)
+		}
+		if got := run(true); !reflect.DeepEqual(got, []string{name}) {
+			t.Errorf("run after removing %s wrote %q", name, got)
+		}
+	}
+	if got := outputs(); !reflect.DeepEqual(got, first) {
+		t.Error("regenerated files differ from first run")
+	}
+
+	// A change in the options invalidates the cache.
+	opts.promises = false
+	if got := run(true); !reflect.DeepEqual(got, want) {
+		t.Errorf("run with new options wrote %q; want %q", got, want)
+	}
+}
+
+func mustReadFile(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSplitOutput(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "aircraft.capnp.out")
+	opts := genoptions{promises: true, schemas: true, structStrings: true}
+	var whole []byte
+	for _, src := range generateAll(t, req, opts) {
+		whole = src
+	}
+	want := topLevelDecls(t, "whole", whole)
+
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	// Files of an earlier run for types that no longer exist are
+	// removed, unless capnpc-go did not write them.
+	stale := "aircraft.capnp.gone.go"
+	mine := "aircraft.capnp.mine.go"
+	if err := os.WriteFile(stale, []byte(generatedHeader+"\n\npackage aircraftlib\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(mine, []byte("package aircraftlib\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	opts.splitOutput = true
+	opts.changedOnly = true
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, err := requestedFiles(req, false)
+	if err != nil {
+		t.Fatal("requestedFiles:", err)
+	}
+	var changed bytes.Buffer
+	if !generateFiles(reqFiles, trees, opts, nil, &changed) {
+		t.Fatal("generateFiles failed")
+	}
+	written := strings.Fields(changed.String())
+	if len(written) < 2 || written[0] != "aircraft.capnp.go" {
+		t.Fatalf("wrote %q; want aircraft.capnp.go followed by a file per type", written)
+	}
+	for _, name := range written[1:] {
+		if !strings.HasPrefix(name, "aircraft.capnp.") || strings.Count(name, ".") != 3 {
+			t.Errorf("wrote %s; want aircraft.capnp.<type>.go", name)
+		}
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("stale file %s was not removed", stale)
+	}
+	if _, err := os.Stat(mine); err != nil {
+		t.Errorf("file %s that capnpc-go did not write: %v", mine, err)
+	}
+
+	got := make(map[string]string)
+	registered := 0
+	for _, name := range written {
+		src := mustReadFile(t, name)
+		if bytes.Contains(src, []byte("func RegisterSchema(")) {
+			registered++
+		}
+		for decl := range topLevelDecls(t, name, src) {
+			if prev, dup := got[decl]; dup {
+				t.Errorf("%s declared in both %s and %s", decl, prev, name)
+			}
+			got[decl] = name
+		}
+	}
+	if registered != 1 {
+		t.Errorf("RegisterSchema generated in %d files; want 1", registered)
+	}
+	for decl := range want {
+		if _, ok := got[decl]; !ok {
+			t.Errorf("%s not declared in split output", decl)
+		}
+	}
+	for decl, name := range got {
+		if !want[decl] {
+			t.Errorf("%s declared in %s, but not in the whole output", decl, name)
+		}
+	}
+	if got["Zdate"] != "aircraft.capnp.zdate.go" {
+		t.Errorf("Zdate declared in %q; want aircraft.capnp.zdate.go", got["Zdate"])
+	}
+}
+
+// topLevelDecls parses the Go source src and returns the names of its
+// top-level declarations, with methods named Type.Method.  The static
+// data variables, whose names depend on how the output is split, are
+// left out.
+func topLevelDecls(t *testing.T, name string, src []byte) map[string]bool {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), name, src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decls := make(map[string]bool)
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				decls[d.Name.Name] = true
+				continue
+			}
+			typ := d.Recv.List[0].Type
+			if star, ok := typ.(*ast.StarExpr); ok {
+				typ = star.X
+			}
+			if idx, ok := typ.(*ast.IndexExpr); ok {
+				typ = idx.X
+			}
+			decls[typ.(*ast.Ident).Name+"."+d.Name.Name] = true
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					decls[spec.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, id := range spec.Names {
+						if id.Name != "_" && !strings.HasPrefix(id.Name, "x_") {
+							decls[id.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return decls
+}
+
+func TestNameCollisions(t *testing.T) {
+	tests := []struct {
+		fname string
+		want  string // substring of the error, or empty for no error
+	}{
+		{"persistent-simple-and-samepkg.capnp.out", ""},
+		{"samepkg-collision.capnp.out", "samepkg-a.capnp:Point and samepkg-b.capnp:Point both declare the Go name Point"},
+	}
+	for _, test := range tests {
+		req := mustReadGeneratorRequest(t, test.fname)
+		trees, err := makeNodeTrees(req)
+		if err != nil {
+			t.Fatalf("makeNodeTrees(%s): %v", test.fname, err)
+		}
+		reqFiles, err := requestedFiles(req, false)
+		if err != nil {
+			t.Fatalf("requestedFiles(%s): %v", test.fname, err)
+		}
+		err = checkNameCollisions(reqFiles, trees)
+		switch {
+		case test.want == "" && err != nil:
+			t.Errorf("checkNameCollisions(%s) = %v; want nil", test.fname, err)
+		case test.want != "" && err == nil:
+			t.Errorf("checkNameCollisions(%s) = nil; want error containing %q", test.fname, test.want)
+		case test.want != "" && !strings.Contains(err.Error(), test.want):
+			t.Errorf("checkNameCollisions(%s) = %v; want error containing %q", test.fname, err, test.want)
+		}
+	}
+
+	// Generating only one of the files still reports the collision
+	// with the other, as when the other file is imported and was
+	// generated separately.
+	req := mustReadGeneratorRequest(t, "samepkg-collision.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, err := requestedFiles(req, false)
+	if err != nil {
+		t.Fatal("requestedFiles:", err)
+	}
+	for _, reqf := range reqFiles {
+		if err := checkNameCollisions([]schema.CodeGeneratorRequest_RequestedFile{reqf}, trees); err == nil {
+			name, _ := reqf.Filename()
+			t.Errorf("checkNameCollisions(%s) = nil; want error", name)
+		}
+	}
+}
+
+func TestFlattenedUnion(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "flatten.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "flatten.capnp.go", src, 0)
+	if err != nil {
+		t.Fatal("parsing generated code:", err)
+	}
+	methods := make(map[string]bool)
+	for _, d := range f.Decls {
+		fd, ok := d.(*ast.FuncDecl)
+		if !ok || fd.Recv == nil {
+			continue
+		}
+		if id, ok := fd.Recv.List[0].Type.(*ast.Ident); ok && id.Name == "Shape" {
+			methods[fd.Name.Name] = true
+		}
+	}
+	for _, name := range []string{
+		"Kind", "KindWhich", "Circle", "SetCircle", "Label", "SetLabel", "HasLabel", "Rect", "SetRect",
+		"Style", "Which", "SetPlain", "Color", "SetColor",
+	} {
+		if !methods[name] {
+			t.Errorf("Shape has no method %s", name)
+		}
+	}
+}
+
+func TestFlattenedUnionCollision(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "flatten-collide.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{})
+	err = g.defineFile()
+	if err == nil || !strings.Contains(err.Error(), "collides") {
+		t.Errorf("defineFile() = %v; want collision error", err)
+	}
+}
+
+func TestUnionVisitor(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "visitor.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	for _, want := range []string{
+		"type Shape_Visitor interface {\n" +
+			"\tVisitCircle(float64) error\n" +
+			"\tVisitLabel(string) error\n" +
+			"\tVisitNone() error\n" +
+			"\tVisitRect(Shape_rect) error\n" +
+			"\tVisitPoints(Point_List) error\n" +
+			"}\n",
+		"func (s Shape) Visit(v Shape_Visitor) error {\n",
+		"\tcase Shape_Which_label:\n\t\tx, err := s.Label()\n",
+		"\tcase Shape_Which_rect:\n\t\treturn v.VisitRect(s.Rect())\n",
+		"\t\treturn &capnp.UnknownMemberError{Union: \"Shape\", Which: uint16(w)}\n",
+		"type Shape_kind_Visitor interface {\n\tVisitPlain() error\n\tVisitOrigin(Point) error\n}\n",
+		"func (s Shape_kind) Visit(v Shape_kind_Visitor) error {\n",
+		"\t\treturn &capnp.UnknownMemberError{Union: \"Shape.kind\", Which: uint16(w)}\n",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+
+	// Visitors that would collide with other declarations are left out.
+	for _, unwanted := range []string{
+		"func (s Visits) Visit(",
+		"type Visits_Visitor interface",
+		"func (s Tree) Visit(",
+		"type Tree_Visitor interface",
+	} {
+		if bytes.Contains(src, []byte(unwanted)) {
+			t.Errorf("generated code contains %q", unwanted)
+		}
+	}
+}
+
+func TestCompileRequest(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir("testdata"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	// Schemas are omitted from the generated code, since older
+	// versions of capnp encoded empty lists differently.
+	opts := genoptions{promises: true, structStrings: true}
+	for _, name := range []string{"doc.capnp", "group.capnp", "importalias.capnp"} {
+		data, err := os.ReadFile(name + ".out")
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg, err := capnp.Unmarshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := schema.ReadRootCodeGeneratorRequest(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := compileRequest([]string{name}, []string{filepath.Join("..", "..", "std")})
+		if err != nil {
+			t.Errorf("compileRequest(%q): %v", name, err)
+			continue
+		}
+		if !bytes.Equal(generateAll(t, got, opts)[name], generateAll(t, want, opts)[name]) {
+			t.Errorf("code generated from compiled %s differs from code generated from capnp's request", name)
+		}
+	}
+
+	// The standard schemas are used when the import path lacks them.
+	if _, err := compileRequest([]string{"doc.capnp"}, nil); err != nil {
+		t.Errorf("compileRequest without import path: %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "validate.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	for _, want := range []string{
+		"func (s Order) Validate() error {\n",
+		"\t\tif !s.HasId() {\n\t\t\treturn &capnp.ValidationError{Path: \"Order.id\", Reason: \"is required\"}\n",
+		"regexp.MustCompile(`^[A-Z]{3}-[0-9]+$`)\n",
+		"\t\tv := s.Quantity()\n\t\tif v < 1 {\n",
+		"\t\tif v > 0.5 {\n",
+		"\t\tif v.Len() > 10 {\n",
+		"\t\tif len(v) > 64 {\n",
+		"return capnp.ValidationErrorIn(\"Order.items\"+\"[\"+strconv.Itoa(i)+\"]\", \"Item\", err)\n",
+		"\t\tif s.HasCustomer() {\n",
+		"\t\tif err := s.Shipping().Validate(); err != nil {\n\t\t\treturn err\n",
+		"\tif s.Which() == Order_Which_courier {\n",
+		"func (s Order_shipping) Validate() error {\n",
+		"func (s Customer) Validate() error {\n",
+		"func (s Item) Validate() error {\n",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+
+	// Bounds that every value of a field satisfies are left out, as are
+	// Validate methods for structs with nothing to check and for those
+	// that would collide with an accessor.
+	for _, unwanted := range []string{
+		"v < -32768",
+		"s.Total()",
+		"func (s Plain) Validate(",
+		"func (s Named) Validate() error",
+	} {
+		if bytes.Contains(src, []byte(unwanted)) {
+			t.Errorf("generated code contains %q", unwanted)
+		}
+	}
+}
+
+func TestHash64Method(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "style.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	generate := func(opts genoptions) string {
+		g := newGenerator(reqFiles.At(0).Id(), trees, opts)
+		if err := g.defineFile(); err != nil {
+			t.Fatal("defineFile:", err)
+		}
+		src, err := format.Source(g.generate())
+		if err != nil {
+			t.Fatal("formatting generated code:", err)
+		}
+		return string(src)
+	}
+
+	want := "func (s Widget) Hash64() (uint64, error) {\n\treturn capnp.Struct(s).Hash64()\n}\n"
+	if src := generate(genoptions{promises: true, schemas: true, structStrings: true, hashMethods: true}); !strings.Contains(src, want) {
+		t.Errorf("generated code does not contain %q", want)
+	}
+	if src := generate(genoptions{promises: true, schemas: true, structStrings: true}); strings.Contains(src, ") Hash64() ") {
+		t.Error("generated code contains Hash64 without hashMethods")
+	}
+}
+
+func TestEmbedSchemaAnnotation(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "embed.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	for _, s := range []string{"const schema_", "RegisterSchema", "SchemaRequest", "String() string"} {
+		if strings.Contains(string(src), s) {
+			t.Errorf("generated code contains %q despite $Go.embedSchema(false)", s)
+		}
+	}
+}
+
+func TestSchemaFiles(t *testing.T) {
+	req := mustReadGeneratorRequest(t, "style.capnp.out")
+	trees, err := makeNodeTrees(req)
+	if err != nil {
+		t.Fatal("makeNodeTrees:", err)
+	}
+	reqFiles, _ := req.RequestedFiles()
+	g := newGenerator(reqFiles.At(0).Id(), trees, genoptions{promises: true, schemas: true, structStrings: true, schemaFiles: true})
+	if err := g.defineFile(); err != nil {
+		t.Fatal("defineFile:", err)
+	}
+	src, err := format.Source(g.generate())
+	if err != nil {
+		t.Fatal("formatting generated code:", err)
+	}
+	for _, s := range []string{
+		"_ \"embed\"",
+		"//go:embed style.capnp.schema\nvar schema_",
+		"func SchemaRequest() ([]byte, error) {",
+	} {
+		if !strings.Contains(string(src), s) {
+			t.Errorf("generated code does not contain %q", s)
+		}
+	}
+	if g.schemaFileName != "style.capnp.schema" || len(g.schemaFile) == 0 {
+		t.Errorf("schema file = %q (%d bytes); want style.capnp.schema", g.schemaFileName, len(g.schemaFile))
+	}
+	blob := schemas.Schema{Bytes: g.schemaFile, Compressed: true}
+	data, err := blob.Request()
+	if err != nil {
+		t.Fatal("Request:", err)
+	}
+	msg, err := capnp.Unmarshal(data)
+	if err != nil {
+		t.Fatal("capnp.Unmarshal:", err)
+	}
+	if _, err := schema.ReadRootCodeGeneratorRequest(msg); err != nil {
+		t.Error("ReadRootCodeGeneratorRequest:", err)
+	}
+}
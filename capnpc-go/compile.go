@@ -0,0 +1,39 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+	"capnproto.org/go/capnp/v3/std"
+)
+
+// importPathFlag is the -I flag, which adds a directory to the import
+// path used when capnpc-go compiles schemas itself.
+type importPathFlag []string
+
+func (f *importPathFlag) String() string {
+	return strings.Join(*f, string(filepath.ListSeparator))
+}
+
+func (f *importPathFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+// compileRequest compiles the schema files named on the command line
+// into a CodeGeneratorRequest, as the capnp tool would before running
+// capnpc-go.  Absolute imports are searched for in importPath, then in
+// the standard schemas bundled with capnpc-go.
+func compileRequest(files []string, importPath []string) (schema.CodeGeneratorRequest, error) {
+	req, err := compiler.Compile(&compiler.Options{
+		ImportPath: importPath,
+		Std:        std.Schemas,
+	}, files...)
+	if err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	return schema.CodeGeneratorRequest(capnp.Struct(req)), nil
+}
@@ -0,0 +1,491 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+func init() {
+	builtinPlugins["describe"] = describePlugin{}
+}
+
+// describePlugin writes a JSON document next to each generated Go file
+// describing the declarations of the schema file: its structs, enums,
+// interfaces, constants and annotations, with their IDs, members, doc
+// comments and annotations.  It lets tools such as documentation
+// generators and web UIs consume a schema without decoding the
+// compiler's binary output.  The plugin's parameter, if any, is a
+// directory to write the documents to instead of the working directory.
+//
+// IDs are hexadecimal strings and 64-bit integers are decimal strings,
+// so that the documents can be read losslessly in JavaScript.  Values
+// of pointer types other than Text and Data are omitted.
+type describePlugin struct{}
+
+// A fileDesc is the document written for a single schema file.
+type fileDesc struct {
+	File  string     `json:"file"`
+	ID    string     `json:"id"`
+	Nodes []nodeDesc `json:"nodes"`
+}
+
+// A nodeDesc describes a declaration.  Name is qualified by the names
+// of the enclosing declarations, like "Outer.Inner".  Which fields are
+// set depends on Kind, which is one of "struct", "enum", "interface",
+// "const" and "annotation".
+type nodeDesc struct {
+	Kind        string           `json:"kind"`
+	Name        string           `json:"name"`
+	GoName      string           `json:"goName"`
+	ID          string           `json:"id"`
+	ScopeID     string           `json:"scopeId"`
+	Doc         string           `json:"doc,omitempty"`
+	Parameters  []string         `json:"parameters,omitempty"`
+	Annotations []annotationDesc `json:"annotations,omitempty"`
+
+	// Structs
+	IsGroup      bool        `json:"isGroup,omitempty"`
+	DataWords    uint16      `json:"dataWords,omitempty"`
+	PointerCount uint16      `json:"pointerCount,omitempty"`
+	Fields       []fieldDesc `json:"fields,omitempty"`
+
+	// Enums
+	Enumerants []memberDesc `json:"enumerants,omitempty"`
+
+	// Interfaces
+	Superclasses []string     `json:"superclasses,omitempty"`
+	Methods      []methodDesc `json:"methods,omitempty"`
+
+	// Constants and annotations
+	Type    *typeDesc `json:"type,omitempty"`
+	Value   any       `json:"value,omitempty"`
+	Targets []string  `json:"targets,omitempty"`
+}
+
+// A memberDesc describes an enumerant, and is embedded in the
+// descriptions of fields and methods.
+type memberDesc struct {
+	Name        string           `json:"name"`
+	CodeOrder   uint16           `json:"codeOrder"`
+	Doc         string           `json:"doc,omitempty"`
+	Annotations []annotationDesc `json:"annotations,omitempty"`
+}
+
+type fieldDesc struct {
+	memberDesc
+	Ordinal *uint16 `json:"ordinal,omitempty"` // nil for groups
+
+	// DiscriminantValue is set for union members.
+	DiscriminantValue *uint16 `json:"discriminantValue,omitempty"`
+
+	// Slots
+	Type    *typeDesc `json:"type,omitempty"`
+	Offset  *uint32   `json:"offset,omitempty"`
+	Default any       `json:"default,omitempty"`
+
+	// Group is the ID of the group's struct node.
+	Group string `json:"group,omitempty"`
+}
+
+type methodDesc struct {
+	memberDesc
+	Ordinal uint16 `json:"ordinal"`
+	Params  string `json:"params"`
+	Results string `json:"results"`
+}
+
+type annotationDesc struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"` // empty if the annotation's node is not known
+	Value any    `json:"value,omitempty"`
+}
+
+// A typeDesc describes a type.  Kind is the name of the type for
+// primitive types, or one of "list", "enum", "struct", "interface" and
+// "anyPointer".
+type typeDesc struct {
+	Kind    string    `json:"kind"`
+	ID      string    `json:"id,omitempty"`
+	Name    string    `json:"name,omitempty"`
+	Element *typeDesc `json:"element,omitempty"`
+}
+
+func (describePlugin) run(req schema.CodeGeneratorRequest, trees nodeTrees, dir string) error {
+	reqFiles, err := req.RequestedFiles()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < reqFiles.Len(); i++ {
+		fname, err := reqFiles.At(i).Filename()
+		if err != nil {
+			return fmt.Errorf("reading filename of requested file %d: %v", i+1, err)
+		}
+		f, err := trees.nodes.mustFind(reqFiles.At(i).Id())
+		if err != nil {
+			return err
+		}
+		desc := fileDesc{File: fname, ID: idString(f.Id()), Nodes: []nodeDesc{}}
+		for _, n := range f.nodes {
+			nd, err := describeNode(trees.nodes, n)
+			if err != nil {
+				return fmt.Errorf("%s: %v", fname, err)
+			}
+			desc.Nodes = append(desc.Nodes, nd)
+		}
+		b, err := json.MarshalIndent(desc, "", "  ")
+		if err != nil {
+			return err
+		}
+		out := filepath.Join(dir, filepath.FromSlash(fname)+".describe.json")
+		if err := os.MkdirAll(filepath.Dir(out), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(out, append(b, '\n'), 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func describeNode(nodes nodeMap, n *node) (nodeDesc, error) {
+	dn := n.String()
+	nd := nodeDesc{
+		Name:    dn[strings.IndexByte(dn, ':')+1:],
+		GoName:  n.Name,
+		ID:      idString(n.Id()),
+		ScopeID: idString(n.ScopeId()),
+		Doc:     n.doc,
+	}
+	params, err := n.Parameters()
+	if err != nil {
+		return nodeDesc{}, fmt.Errorf("%v: %v", n, err)
+	}
+	for i := 0; i < params.Len(); i++ {
+		name, _ := params.At(i).Name()
+		nd.Parameters = append(nd.Parameters, name)
+	}
+	ann, err := n.Annotations()
+	if err != nil {
+		return nodeDesc{}, fmt.Errorf("%v: %v", n, err)
+	}
+	if nd.Annotations, err = describeAnnotations(nodes, ann); err != nil {
+		return nodeDesc{}, fmt.Errorf("%v: %v", n, err)
+	}
+
+	switch n.Which() {
+	case schema.Node_Which_structNode:
+		nd.Kind = "struct"
+		err = describeStruct(nodes, n, &nd)
+	case schema.Node_Which_enum:
+		nd.Kind = "enum"
+		err = describeEnum(nodes, n, &nd)
+	case schema.Node_Which_interface:
+		nd.Kind = "interface"
+		err = describeInterface(nodes, n, &nd)
+	case schema.Node_Which_const:
+		nd.Kind = "const"
+		var t schema.Type
+		if t, err = n.Const().Type(); err == nil {
+			if nd.Type, err = describeType(nodes, t); err == nil {
+				var v schema.Value
+				if v, err = n.Const().Value(); err == nil {
+					nd.Value, err = describeValue(v)
+				}
+			}
+		}
+	case schema.Node_Which_annotation:
+		nd.Kind = "annotation"
+		var t schema.Type
+		if t, err = n.Annotation().Type(); err == nil {
+			nd.Type, err = describeType(nodes, t)
+		}
+		nd.Targets = annotationTargets(n.Annotation())
+	default:
+		return nodeDesc{}, fmt.Errorf("%v: unexpected %v node", n, n.Which())
+	}
+	if err != nil {
+		return nodeDesc{}, fmt.Errorf("%v: %v", n, err)
+	}
+	return nd, nil
+}
+
+func describeStruct(nodes nodeMap, n *node, nd *nodeDesc) error {
+	sn := n.StructNode()
+	nd.IsGroup = sn.IsGroup()
+	nd.DataWords = sn.DataWordCount()
+	nd.PointerCount = sn.PointerCount()
+	fields, err := sn.Fields()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		name, err := f.Name()
+		if err != nil {
+			return err
+		}
+		ann, err := f.Annotations()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		fd := fieldDesc{memberDesc: memberDesc{
+			Name:      name,
+			CodeOrder: f.CodeOrder(),
+			Doc:       n.memberDoc(i),
+		}}
+		if fd.Annotations, err = describeAnnotations(nodes, ann); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		if f.Ordinal().Which() == schema.Field_ordinal_Which_explicit {
+			ord := f.Ordinal().Explicit()
+			fd.Ordinal = &ord
+		}
+		if dv := f.DiscriminantValue(); dv != schema.Field_noDiscriminant {
+			fd.DiscriminantValue = &dv
+		}
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			t, err := f.Slot().Type()
+			if err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			if fd.Type, err = describeType(nodes, t); err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			off := f.Slot().Offset()
+			fd.Offset = &off
+			if f.Slot().HadExplicitDefault() {
+				v, err := f.Slot().DefaultValue()
+				if err != nil {
+					return fmt.Errorf("%s: %v", name, err)
+				}
+				if fd.Default, err = describeValue(v); err != nil {
+					return fmt.Errorf("%s: %v", name, err)
+				}
+			}
+		case schema.Field_Which_group:
+			fd.Group = idString(f.Group().TypeId())
+		}
+		nd.Fields = append(nd.Fields, fd)
+	}
+	return nil
+}
+
+func describeEnum(nodes nodeMap, n *node, nd *nodeDesc) error {
+	es, err := n.Enum().Enumerants()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < es.Len(); i++ {
+		e := es.At(i)
+		name, err := e.Name()
+		if err != nil {
+			return err
+		}
+		ann, err := e.Annotations()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		md := memberDesc{
+			Name:      name,
+			CodeOrder: e.CodeOrder(),
+			Doc:       n.memberDoc(i),
+		}
+		if md.Annotations, err = describeAnnotations(nodes, ann); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		nd.Enumerants = append(nd.Enumerants, md)
+	}
+	return nil
+}
+
+func describeInterface(nodes nodeMap, n *node, nd *nodeDesc) error {
+	supers, err := n.Interface().Superclasses()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < supers.Len(); i++ {
+		nd.Superclasses = append(nd.Superclasses, idString(supers.At(i).Id()))
+	}
+	ms, err := n.Interface().Methods()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		name, err := m.Name()
+		if err != nil {
+			return err
+		}
+		ann, err := m.Annotations()
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		md := methodDesc{
+			memberDesc: memberDesc{
+				Name:      name,
+				CodeOrder: m.CodeOrder(),
+				Doc:       n.memberDoc(i),
+			},
+			Ordinal: uint16(i),
+			Params:  idString(m.ParamStructType()),
+			Results: idString(m.ResultStructType()),
+		}
+		if md.Annotations, err = describeAnnotations(nodes, ann); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		nd.Methods = append(nd.Methods, md)
+	}
+	return nil
+}
+
+func describeAnnotations(nodes nodeMap, list schema.Annotation_List) ([]annotationDesc, error) {
+	var descs []annotationDesc
+	for i := 0; i < list.Len(); i++ {
+		a := list.At(i)
+		ad := annotationDesc{ID: idString(a.Id())}
+		if an := nodes[a.Id()]; an != nil {
+			ad.Name = an.String()
+		}
+		v, err := a.Value()
+		if err != nil {
+			return nil, err
+		}
+		if ad.Value, err = describeValue(v); err != nil {
+			return nil, err
+		}
+		descs = append(descs, ad)
+	}
+	return descs, nil
+}
+
+func describeType(nodes nodeMap, t schema.Type) (*typeDesc, error) {
+	var id uint64
+	switch t.Which() {
+	case schema.Type_Which_list:
+		et, err := t.List().ElementType()
+		if err != nil {
+			return nil, err
+		}
+		elem, err := describeType(nodes, et)
+		if err != nil {
+			return nil, err
+		}
+		return &typeDesc{Kind: "list", Element: elem}, nil
+	case schema.Type_Which_enum:
+		id = t.Enum().TypeId()
+	case schema.Type_Which_structType:
+		id = t.StructType().TypeId()
+	case schema.Type_Which_interface:
+		id = t.Interface().TypeId()
+	default:
+		return &typeDesc{Kind: t.Which().String()}, nil
+	}
+	td := &typeDesc{Kind: t.Which().String(), ID: idString(id)}
+	if t.Which() == schema.Type_Which_structType {
+		td.Kind = "struct"
+	}
+	if n := nodes[id]; n != nil {
+		td.Name = n.String()
+	}
+	return td, nil
+}
+
+// describeValue returns v as a value that encodes to JSON, or nil for
+// void and for pointer values other than Text and Data.
+func describeValue(v schema.Value) (any, error) {
+	switch v.Which() {
+	case schema.Value_Which_bool:
+		return v.Bool(), nil
+	case schema.Value_Which_int8:
+		return v.Int8(), nil
+	case schema.Value_Which_int16:
+		return v.Int16(), nil
+	case schema.Value_Which_int32:
+		return v.Int32(), nil
+	case schema.Value_Which_int64:
+		return strconv.FormatInt(v.Int64(), 10), nil
+	case schema.Value_Which_uint8:
+		return v.Uint8(), nil
+	case schema.Value_Which_uint16:
+		return v.Uint16(), nil
+	case schema.Value_Which_uint32:
+		return v.Uint32(), nil
+	case schema.Value_Which_uint64:
+		return strconv.FormatUint(v.Uint64(), 10), nil
+	case schema.Value_Which_float32:
+		return floatValue(float64(v.Float32())), nil
+	case schema.Value_Which_float64:
+		return floatValue(v.Float64()), nil
+	case schema.Value_Which_text:
+		return v.Text()
+	case schema.Value_Which_data:
+		d, err := v.Data()
+		if err != nil {
+			return nil, err
+		}
+		// Encode as an array of numbers rather than base64, like the
+		// Cap'n Proto JSON codec.
+		bytes := make([]int, len(d))
+		for i, b := range d {
+			bytes[i] = int(b)
+		}
+		return bytes, nil
+	case schema.Value_Which_enum:
+		return v.Enum(), nil
+	default:
+		return nil, nil
+	}
+}
+
+// floatValue returns f, or a string for values that JSON numbers
+// cannot represent.
+func floatValue(f float64) any {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	default:
+		return f
+	}
+}
+
+func annotationTargets(a schema.Node_annotation) []string {
+	var targets []string
+	for _, t := range []struct {
+		name string
+		ok   bool
+	}{
+		{"file", a.TargetsFile()},
+		{"const", a.TargetsConst()},
+		{"enum", a.TargetsEnum()},
+		{"enumerant", a.TargetsEnumerant()},
+		{"struct", a.TargetsStruct()},
+		{"field", a.TargetsField()},
+		{"union", a.TargetsUnion()},
+		{"group", a.TargetsGroup()},
+		{"interface", a.TargetsInterface()},
+		{"method", a.TargetsMethod()},
+		{"param", a.TargetsParam()},
+		{"annotation", a.TargetsAnnotation()},
+	} {
+		if t.ok {
+			targets = append(targets, t.name)
+		}
+	}
+	return targets
+}
+
+func idString(id uint64) string {
+	return fmt.Sprintf("%#x", id)
+}
@@ -2,6 +2,9 @@ package main
 
 import (
 	"embed"
+	"fmt"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -11,6 +14,71 @@ var (
 	templateFS embed.FS
 
 	templates = template.Must(template.New("").Funcs(template.FuncMap{
-		"title": strings.Title,
+		"title":      strings.Title,
+		"comment":    comment,
+		"deprecated": deprecated,
+		"fieldPath":  fieldPath,
 	}).ParseFS(templateFS, "templates/*"))
 )
+
+// comment formats a schema doc comment as a Go comment, one line of
+// "//" per line of doc.  It returns the empty string for empty docs.
+func comment(doc string) string {
+	doc = strings.TrimSpace(doc)
+	if doc == "" {
+		return ""
+	}
+	var sb strings.Builder
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			sb.WriteString("//\n")
+			continue
+		}
+		sb.WriteString("// ")
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// loadTemplates returns the built-in templates overlaid with the
+// templates in dir.  As with the built-in templates, each file defines
+// the template named after the file, replacing the built-in template
+// of the same name if there is one.  Files may also define additional
+// templates with {{define}}.
+func loadTemplates(dir string) (*template.Template, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no templates in %s", dir)
+	}
+	t, err := templates.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return t.ParseFiles(files...)
+}
+
+// deprecated formats a deprecation notice as a Go comment.  It returns
+// the empty string if msg is empty.
+func deprecated(msg string) string {
+	if msg == "" {
+		return ""
+	}
+	return comment("Deprecated: " + msg)
+}
+
+// fieldPath returns a Go string literal naming field f of n for error
+// messages: the schema names of n and the field, as in
+// "Person.address", regardless of any $Go.name annotations.
+func fieldPath(n *node, f field) string {
+	dn := displayName(n)
+	if i := strings.IndexByte(dn, ':'); i >= 0 {
+		dn = dn[i+1:]
+	}
+	name, _ := f.Field.Name()
+	return strconv.Quote(dn + "." + name)
+}
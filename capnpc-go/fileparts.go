@@ -2,9 +2,14 @@ package main
 
 import (
 	"fmt"
+	"go/scanner"
+	"go/token"
+	"hash/fnv"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"capnproto.org/go/capnp/v3"
 )
@@ -72,11 +77,16 @@ func (ref staticDataRef) String() string {
 
 type imports struct {
 	specs []importSpec
-	used  map[string]bool // keyed on import path
+	used  map[string]bool   // keyed on import path
+	want  map[string]string // names asked for, keyed on import path
+
+	// renamed is set when reserve had to change the name of an import
+	// that collided with another.
+	renamed bool
 }
 
 func newImports() imports {
-	i := imports{used: make(map[string]bool)}
+	i := imports{used: make(map[string]bool), want: make(map[string]string)}
 
 	for _, spec := range importList {
 		i.reserve(spec)
@@ -113,10 +123,22 @@ func (i *imports) Math() string {
 	return i.add(importSpec{path: "math", name: "math"})
 }
 
+func (i *imports) Bytes() string {
+	return i.add(importSpec{path: "bytes", name: "bytes"})
+}
+
+func (i *imports) IO() string {
+	return i.add(importSpec{path: "io", name: "io"})
+}
+
 func (i *imports) Strconv() string {
 	return i.add(importSpec{path: "strconv", name: "strconv"})
 }
 
+func (i *imports) Regexp() string {
+	return i.add(importSpec{path: "regexp", name: "regexp"})
+}
+
 func (i *imports) usedImports() []importSpec {
 	specs := make([]importSpec, 0, len(i.specs))
 	for _, s := range i.specs {
@@ -151,6 +173,38 @@ func (i *imports) add(spec importSpec) (name string) {
 	return name
 }
 
+// useReferenced marks the imports in importList that src refers to as
+// used.  Some templates refer to these packages by name without adding
+// the import, relying on other declarations in the file to add it,
+// which they might not when the output is split.
+func (i *imports) useReferenced(src []byte) {
+	names := make(map[string]string, len(importList))
+	for _, spec := range importList {
+		if ispec, ok := i.byPath(spec.path); ok {
+			names[ispec.name] = ispec.path
+		}
+	}
+	fset := token.NewFileSet()
+	var s scanner.Scanner
+	s.Init(fset.AddFile("", -1, len(src)), src, nil, 0)
+	var prev string
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			return
+		}
+		if tok == token.PERIOD {
+			if path, ok := names[prev]; ok {
+				i.used[path] = true
+			}
+		}
+		prev = ""
+		if tok == token.IDENT {
+			prev = lit
+		}
+	}
+}
+
 // reserve adds an import spec without marking it as used.
 func (i *imports) reserve(spec importSpec) (name string) {
 	if ispec, ok := i.byPath(spec.path); ok {
@@ -159,7 +213,9 @@ func (i *imports) reserve(spec importSpec) (name string) {
 	if spec.name == "" {
 		spec.name = pkgFromImport(spec.path)
 	}
+	i.want[spec.path] = spec.name
 	if _, found := i.byName(spec.name); found {
+		i.renamed = true
 		for base, n := spec.name, uint64(2); ; n++ {
 			spec.name = base + strconv.FormatUint(n, 10)
 			if _, found = i.byName(spec.name); !found {
@@ -171,6 +227,88 @@ func (i *imports) reserve(spec importSpec) (name string) {
 	return spec.name
 }
 
+// aliases returns the used imports with names that do not collide,
+// for generating the file again after reserve renamed an import.  The
+// names reserve picks depend on the order that the imports are first
+// used in, so editing the schema could rename an unrelated import.
+// Here an import keeps the name it asked for unless another used
+// import asks for the same name or the name is one of the imports that
+// generated code relies on.  Colliding imports are named after their
+// parent directory and name, like "v2util" for package util at
+// "example.com/v2/util",
+// or failing that after a hash of their import path.
+func (i *imports) aliases() []importSpec {
+	taken := make(map[string]bool, len(importList))
+	builtin := make(map[string]bool, len(importList))
+	for _, spec := range importList {
+		taken[spec.name] = true
+		builtin[spec.path] = true
+	}
+	var paths []string
+	byName := make(map[string][]string)
+	for _, spec := range i.usedImports() {
+		if builtin[spec.path] {
+			continue
+		}
+		name := i.want[spec.path]
+		paths = append(paths, spec.path)
+		byName[name] = append(byName[name], spec.path)
+	}
+	sort.Strings(paths)
+
+	specs := make([]importSpec, 0, len(paths))
+	var collide []string
+	for _, path := range paths {
+		name := i.want[path]
+		if len(byName[name]) > 1 || taken[name] {
+			collide = append(collide, path)
+			continue
+		}
+		specs = append(specs, importSpec{path: path, name: name})
+		taken[name] = true
+	}
+	for _, path := range collide {
+		name := pathAlias(path, i.want[path])
+		if name == "" || taken[name] {
+			h := fnv.New32a()
+			h.Write([]byte(path))
+			name = fmt.Sprintf("%s%08x", i.want[path], h.Sum32())
+		}
+		for base, n := name, uint64(2); taken[name]; n++ {
+			name = base + strconv.FormatUint(n, 10)
+		}
+		specs = append(specs, importSpec{path: path, name: name})
+		taken[name] = true
+	}
+	return specs
+}
+
+// pathAlias returns name prefixed with the lowercased letters and
+// digits of the parent directory in path, or the empty string if that
+// does not make a valid identifier.
+func pathAlias(path, name string) string {
+	dir := path
+	if i := strings.LastIndex(dir, "/"); i != -1 {
+		dir = dir[:i]
+	} else {
+		return ""
+	}
+	if i := strings.LastIndex(dir, "/"); i != -1 {
+		dir = dir[i+1:]
+	}
+	var sb strings.Builder
+	for _, r := range strings.ToLower(dir) {
+		if isIdent(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	prefix := sb.String()
+	if r, _ := utf8.DecodeRuneInString(prefix); !isIdent(r) {
+		return ""
+	}
+	return prefix + name
+}
+
 func pkgFromImport(path string) string {
 	if i := strings.LastIndex(path, "/"); i != -1 {
 		path = path[i+1:]
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// A typeParam is a generic parameter of a struct or interface, which
+// becomes a Go type parameter when generics are enabled.
+type typeParam struct {
+	scopeID uint64
+	index   int
+	name    string
+}
+
+// typeParams returns the generic parameters in scope for n, outermost
+// scope first.  Nested types, groups, and method parameter structs
+// inherit the parameters of their enclosing scopes.  typeParams returns
+// nil if generics are disabled or n is not a struct or interface.
+func (g *generator) typeParams(n *node) ([]typeParam, error) {
+	if !g.opts.generics {
+		return nil, nil
+	}
+	if w := n.Which(); w != schema.Node_Which_structNode && w != schema.Node_Which_interface {
+		return nil, nil
+	}
+	var scopes []*node
+	for s := n; s != nil; {
+		scopes = append(scopes, s)
+		switch {
+		case s.methodScope != nil:
+			s = s.methodScope
+		case s.ScopeId() != 0:
+			s = g.nodes[s.ScopeId()]
+		default:
+			s = nil
+		}
+	}
+	var params []typeParam
+	seen := make(map[string]bool)
+	for i := len(scopes) - 1; i >= 0; i-- {
+		s := scopes[i]
+		ps, err := s.Parameters()
+		if err != nil {
+			return nil, fmt.Errorf("reading parameters of %s: %v", s, err)
+		}
+		for j := 0; j < ps.Len(); j++ {
+			name, err := ps.At(j).Name()
+			if err != nil {
+				return nil, fmt.Errorf("reading parameter %d of %s: %v", j, s, err)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("%s: generic parameter %s shadows a parameter of an enclosing scope", s, name)
+			}
+			seen[name] = true
+			params = append(params, typeParam{scopeID: s.Id(), index: j, name: name})
+		}
+	}
+	return params, nil
+}
+
+// TypeParams returns the Go type parameter list for the declaration of
+// n, like "[K capnp.TypeParam[K], V capnp.TypeParam[V]]", or the empty
+// string if n is not generic.
+func (g *generator) TypeParams(n *node) (string, error) {
+	params, err := g.typeParams(n)
+	if err != nil || len(params) == 0 {
+		return "", err
+	}
+	decls := make([]string, len(params))
+	for i, p := range params {
+		decls[i] = fmt.Sprintf("%s %s.TypeParam[%[1]s]", p.name, g.imports.Capnp())
+	}
+	return "[" + strings.Join(decls, ", ") + "]", nil
+}
+
+// TypeArgs returns the type argument list that instantiates n with its
+// own type parameters, like "[K, V]", or the empty string if n is not
+// generic.
+func (g *generator) TypeArgs(n *node) (string, error) {
+	params, err := g.typeParams(n)
+	if err != nil || len(params) == 0 {
+		return "", err
+	}
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = p.name
+	}
+	return "[" + strings.Join(names, ", ") + "]", nil
+}
+
+// typeArgs returns the type argument list for a reference to n from
+// rel.  brands holds the brands that apply to the reference, innermost
+// first.  Parameters that are bound by a brand use the bound type,
+// parameters that rel has in scope are passed through, and any others
+// are unbound and become capnp.Ptr.
+func (g *generator) typeArgs(n, rel *node, brands []schema.Brand) (string, error) {
+	params, err := g.typeParams(n)
+	if err != nil || len(params) == 0 {
+		return "", err
+	}
+	args := make([]string, len(params))
+	for i, p := range params {
+		if args[i], err = g.typeArg(p.scopeID, p.index, rel, brands); err != nil {
+			return "", err
+		}
+	}
+	return "[" + strings.Join(args, ", ") + "]", nil
+}
+
+func (g *generator) typeArg(scopeID uint64, index int, rel *node, brands []schema.Brand) (string, error) {
+	for i, b := range brands {
+		binding, ok, err := brandBinding(b, scopeID, index)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+		if binding.Which() != schema.Brand_Binding_Which_type {
+			return g.imports.Capnp() + ".Ptr", nil
+		}
+		t, err := binding.Type()
+		if err != nil {
+			return "", err
+		}
+		return g.typeArgType(t, rel, brands[i+1:])
+	}
+	return g.paramName(scopeID, index, rel)
+}
+
+// brandBinding returns the binding for a parameter in b.  It reports
+// false if b does not bind the parameter's scope.
+func brandBinding(b schema.Brand, scopeID uint64, index int) (schema.Brand_Binding, bool, error) {
+	if !b.IsValid() {
+		return schema.Brand_Binding{}, false, nil
+	}
+	scopes, err := b.Scopes()
+	if err != nil {
+		return schema.Brand_Binding{}, false, err
+	}
+	for i := 0; i < scopes.Len(); i++ {
+		s := scopes.At(i)
+		if s.ScopeId() != scopeID || s.Which() != schema.Brand_Scope_Which_bind {
+			continue
+		}
+		binds, err := s.Bind()
+		if err != nil {
+			return schema.Brand_Binding{}, false, err
+		}
+		if index >= binds.Len() {
+			return schema.Brand_Binding{}, false, nil
+		}
+		return binds.At(index), true, nil
+	}
+	return schema.Brand_Binding{}, false, nil
+}
+
+// typeArgType returns the Go type argument for a type bound to a
+// generic parameter.  Text and Data have no pointer type of their own,
+// so they are passed as capnp.Ptr.
+func (g *generator) typeArgType(t schema.Type, rel *node, outer []schema.Brand) (string, error) {
+	switch t.Which() {
+	case schema.Type_Which_text, schema.Type_Which_data:
+		return g.imports.Capnp() + ".Ptr", nil
+	case schema.Type_Which_anyPointer:
+		ap := t.AnyPointer()
+		switch ap.Which() {
+		case schema.Type_anyPointer_Which_parameter:
+			return g.typeArg(ap.Parameter().ScopeId(), int(ap.Parameter().ParameterIndex()), rel, outer)
+		case schema.Type_anyPointer_Which_implicitMethodParameter:
+			return g.implicitParamName(int(ap.ImplicitMethodParameter().ParameterIndex()), rel)
+		}
+		switch ap.Unconstrained().Which() {
+		case schema.Type_anyPointer_unconstrained_Which_struct:
+			return g.imports.Capnp() + ".Struct", nil
+		case schema.Type_anyPointer_unconstrained_Which_list:
+			return g.imports.Capnp() + ".List", nil
+		case schema.Type_anyPointer_unconstrained_Which_capability:
+			return g.imports.Capnp() + ".Client", nil
+		}
+		return g.imports.Capnp() + ".Ptr", nil
+	}
+	return g.RemoteTypeName(t, rel)
+}
+
+// paramName returns the name of a generic parameter as seen from rel,
+// or capnp.Ptr if rel does not have the parameter in scope.
+func (g *generator) paramName(scopeID uint64, index int, rel *node) (string, error) {
+	params, err := g.typeParams(rel)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range params {
+		if p.scopeID == scopeID && p.index == index {
+			return p.name, nil
+		}
+	}
+	return g.imports.Capnp() + ".Ptr", nil
+}
+
+// implicitParamName returns the name of an implicit method parameter.
+// Go methods cannot have type parameters, so implicit parameters are
+// only named inside their method's parameter structs.
+func (g *generator) implicitParamName(index int, rel *node) (string, error) {
+	if rel.methodScope == nil {
+		return g.imports.Capnp() + ".Ptr", nil
+	}
+	return g.paramName(rel.Id(), index, rel)
+}
+
+// paramTypeName returns the Go type parameter that t refers to.  It
+// reports false if t is not a generic parameter in scope for rel.
+func (g *generator) paramTypeName(t schema.Type, rel *node) (string, bool, error) {
+	if !g.opts.generics || t.Which() != schema.Type_Which_anyPointer {
+		return "", false, nil
+	}
+	var name string
+	var err error
+	switch ap := t.AnyPointer(); ap.Which() {
+	case schema.Type_anyPointer_Which_parameter:
+		name, err = g.paramName(ap.Parameter().ScopeId(), int(ap.Parameter().ParameterIndex()), rel)
+	case schema.Type_anyPointer_Which_implicitMethodParameter:
+		name, err = g.implicitParamName(int(ap.ImplicitMethodParameter().ParameterIndex()), rel)
+	default:
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return name, name != g.imports.Capnp()+".Ptr", nil
+}
+
+// brandedNode returns the node that a struct or interface type refers
+// to, along with its type arguments.  args is empty if the node is not
+// generic, and n is nil if t is not a struct or interface type.
+func (g *generator) brandedNode(t schema.Type, rel *node) (n *node, args string, err error) {
+	var id uint64
+	var brand schema.Brand
+	switch t.Which() {
+	case schema.Type_Which_structType:
+		id = t.StructType().TypeId()
+		brand, err = t.StructType().Brand()
+	case schema.Type_Which_interface:
+		id = t.Interface().TypeId()
+		brand, err = t.Interface().Brand()
+	default:
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if n, err = g.nodes.mustFind(id); err != nil {
+		return nil, "", err
+	}
+	args, err = g.typeArgs(n, rel, []schema.Brand{brand})
+	return n, args, err
+}
+
+// genericTypeRef returns the Go type and New function for t if t is a
+// generic parameter, an instance of a generic struct or interface, or a
+// list of such instances.  It reports false for all other types, which
+// are handled by makeTypeRef.
+func (g *generator) genericTypeRef(t schema.Type, rel *node) (name, newfunc string, ok bool, err error) {
+	if !g.opts.generics {
+		return "", "", false, nil
+	}
+	if name, ok, err := g.paramTypeName(t, rel); ok || err != nil {
+		return name, "", ok, err
+	}
+	elem := t
+	if t.Which() == schema.Type_Which_list {
+		if elem, err = t.List().ElementType(); err != nil {
+			return "", "", false, err
+		}
+	}
+	n, args, err := g.brandedNode(elem, rel)
+	if err != nil || args == "" {
+		return "", "", false, err
+	}
+	if name, err = g.remoteNodeName(n, rel, "", args); err != nil {
+		return "", "", false, err
+	}
+	if t.Which() != schema.Type_Which_list {
+		if n.Which() == schema.Node_Which_structNode {
+			newfunc, err = g.remoteNodeName(n, rel, "", "")
+			newfunc = qualifyPrefix(newfunc, "New") + args
+		}
+		return name, newfunc, true, err
+	}
+	list := "StructList"
+	if n.Which() == schema.Node_Which_interface {
+		list = "CapList"
+	}
+	newfunc, err = g.remoteNodeName(n, rel, "_List", "")
+	return g.imports.Capnp() + "." + list + "[" + name + "]", qualifyPrefix(newfunc, "New") + args, true, err
+}
+
+// qualifyPrefix adds prefix to the unqualified part of a possibly
+// package-qualified name.
+func qualifyPrefix(name, prefix string) string {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return name[:i+1] + prefix + name[i+1:]
+	}
+	return prefix + name
+}
+
+// SuperName returns the name of the superclass s with suffix appended,
+// instantiated with the type arguments of its brand.
+func (g *generator) SuperName(s interfaceSuper, suffix string, rel *node) (string, error) {
+	args, err := g.typeArgs(s.Node, rel, s.brands)
+	if err != nil {
+		return "", err
+	}
+	return g.remoteNodeName(s.Node, rel, suffix, args)
+}
+
+// remoteNodeName returns the name of n with suffix and type arguments
+// args, qualified by its package name if it is not in rel's package.
+func (g *generator) remoteNodeName(n, rel *node, suffix, args string) (string, error) {
+	ref, err := makeNodeTypeRef(n, rel)
+	if err != nil {
+		return "", err
+	}
+	name := ref.name + suffix + args
+	if ref.imp.path == "" {
+		return name, nil
+	}
+	return g.imports.add(ref.imp) + "." + name, nil
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+func init() {
+	builtinPlugins["grpc"] = grpcPlugin{}
+}
+
+// grpcPlugin writes a Go file next to each generated Go file that
+// bridges the schema file's interfaces and gRPC, for running both RPC
+// systems side by side while migrating from one to the other.  For
+// each interface Foo, it declares:
+//
+//	Foo_GRPCServiceDesc  a grpc.ServiceDesc whose handlers call a Foo
+//	Foo_RegisterGRPC     registers a Foo with a gRPC server
+//	Foo_FromGRPC         returns a Foo that calls a gRPC service
+//
+// The services carry Cap'n Proto messages encoded by the
+// exp/grpcshim package, not protocol buffers, so both ends of a
+// connection must use the generated code.  Only the interface's own
+// methods are included, not those it inherits, and generic interfaces
+// and interfaces without methods are skipped.  The plugin's parameter,
+// if any, is a directory to write the files to instead of the working
+// directory; it should be the directory of the generated package.
+type grpcPlugin struct{}
+
+// grpcFile holds the parameters of grpcTemplate.
+type grpcFile struct {
+	Source     string
+	Package    string
+	Interfaces []grpcInterface
+}
+
+type grpcInterface struct {
+	Name        string // Go name
+	DisplayName string
+	ID          uint64
+	Service     string // gRPC service name
+	Methods     []grpcMethod
+}
+
+type grpcMethod struct {
+	ID   uint16
+	Name string
+}
+
+func (grpcPlugin) run(req schema.CodeGeneratorRequest, trees nodeTrees, dir string) error {
+	reqFiles, err := req.RequestedFiles()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < reqFiles.Len(); i++ {
+		fname, err := reqFiles.At(i).Filename()
+		if err != nil {
+			return fmt.Errorf("reading filename of requested file %d: %v", i+1, err)
+		}
+		f, err := trees.nodes.mustFind(reqFiles.At(i).Id())
+		if err != nil {
+			return err
+		}
+		gf := grpcFile{Source: fname, Package: f.pkg}
+		for _, n := range f.nodes {
+			if n.Which() != schema.Node_Which_interface || n.IsGeneric() {
+				continue
+			}
+			iface, err := grpcInterfaceOf(n, f.pkg)
+			if err != nil {
+				return fmt.Errorf("%s: %v", fname, err)
+			}
+			if len(iface.Methods) > 0 {
+				gf.Interfaces = append(gf.Interfaces, iface)
+			}
+		}
+		if len(gf.Interfaces) == 0 {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := grpcTemplate.Execute(&buf, gf); err != nil {
+			return fmt.Errorf("%s: %v", fname, err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("%s: formatting generated code: %v", fname, err)
+		}
+		out := filepath.Join(dir, filepath.FromSlash(fname)+".grpc.go")
+		if err := os.MkdirAll(filepath.Dir(out), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(out, src, 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func grpcInterfaceOf(n *node, pkg string) (grpcInterface, error) {
+	iface := grpcInterface{
+		Name:        n.Name,
+		DisplayName: n.String(),
+		ID:          n.Id(),
+		Service:     pkg + "." + n.Name,
+	}
+	methods, err := n.Interface().Methods()
+	if err != nil {
+		return grpcInterface{}, fmt.Errorf("%v: %v", n, err)
+	}
+	for i := 0; i < methods.Len(); i++ {
+		name, err := methods.At(i).Name()
+		if err != nil {
+			return grpcInterface{}, fmt.Errorf("%v: %v", n, err)
+		}
+		iface.Methods = append(iface.Methods, grpcMethod{ID: uint16(i), Name: name})
+	}
+	return iface, nil
+}
+
+var grpcTemplate = template.Must(template.New("grpc").Parse(`// Code generated by capnpc-go -plugin=grpc from {{.Source}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	context "context"
+
+	capnp "capnproto.org/go/capnp/v3"
+	grpcshim "capnproto.org/go/capnp/v3/exp/grpcshim"
+	server "capnproto.org/go/capnp/v3/server"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	encoding "google.golang.org/grpc/encoding"
+	status "google.golang.org/grpc/status"
+)
+
+func init() {
+	encoding.RegisterCodec(grpcshim.Codec{})
+}
+{{range $iface := .Interfaces}}
+// {{.Name}}_GRPCServiceDesc describes {{.Name}} as a gRPC service whose
+// requests and responses are Cap'n Proto messages.  The handlers expect
+// the service implementation to be a {{.Name}}.
+var {{.Name}}_GRPCServiceDesc = grpc.ServiceDesc{
+	ServiceName: {{printf "%q" .Service}},
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{{- range .Methods}}
+		{
+			MethodName: {{printf "%q" .Name}},
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(grpcshim.Message)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					out, err := grpcshim.Call(ctx, srv.(capnp.Client), capnp.Method{
+						InterfaceID:   {{printf "%#x" $iface.ID}},
+						MethodID:      {{.ID}},
+						InterfaceName: {{printf "%q" $iface.DisplayName}},
+						MethodName:    {{printf "%q" .Name}},
+					}, req.(*grpcshim.Message))
+					if err != nil {
+						return nil, status.Error(codes.Code(grpcshim.Code(err)), err.Error())
+					}
+					return out, nil
+				}
+				if interceptor == nil {
+					return handler(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{
+					Server:     srv,
+					FullMethod: {{printf "%q" (printf "/%s/%s" $iface.Service .Name)}},
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{{- end}}
+	},
+	Metadata: {{printf "%q" $.Source}},
+}
+
+// {{.Name}}_RegisterGRPC registers c with s as the implementation of
+// {{.Name}}_GRPCServiceDesc.  The registration holds c until s is stopped.
+func {{.Name}}_RegisterGRPC(s grpc.ServiceRegistrar, c {{.Name}}) {
+	s.RegisterService(&{{.Name}}_GRPCServiceDesc, capnp.Client(c))
+}
+
+// {{.Name}}_FromGRPC returns a {{.Name}} whose calls are forwarded to the
+// gRPC service described by {{.Name}}_GRPCServiceDesc at cc.
+func {{.Name}}_FromGRPC(cc grpc.ClientConnInterface) {{.Name}} {
+	invoke := func(fullMethod string) func(context.Context, *server.Call) error {
+		return func(ctx context.Context, call *server.Call) error {
+			return grpcshim.Forward(ctx, call, func(ctx context.Context, in, out *grpcshim.Message) error {
+				err := cc.Invoke(ctx, fullMethod, in, out, grpc.CallContentSubtype(grpcshim.CodecName))
+				if err == nil {
+					return nil
+				}
+				if s, ok := status.FromError(err); ok {
+					return grpcshim.Error(uint32(s.Code()), s.Message())
+				}
+				return err
+			})
+		}
+	}
+	return {{.Name}}(capnp.NewClient(server.New([]server.Method{
+		{{- range .Methods}}
+		{
+			Method: capnp.Method{
+				InterfaceID:   {{printf "%#x" $iface.ID}},
+				MethodID:      {{.ID}},
+				InterfaceName: {{printf "%q" $iface.DisplayName}},
+				MethodName:    {{printf "%q" .Name}},
+			},
+			Impl: invoke({{printf "%q" (printf "/%s/%s" $iface.Service .Name)}}),
+		},
+		{{- end}}
+	}, nil, nil)))
+}
+{{end}}`))
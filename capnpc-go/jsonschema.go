@@ -0,0 +1,257 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+func init() {
+	builtinPlugins["jsonschema"] = jsonSchemaPlugin{}
+}
+
+// jsonSchemaURI identifies the JSON Schema dialect that the jsonschema
+// plugin emits.
+const jsonSchemaURI = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaPlugin writes a JSON Schema document next to each generated
+// Go file, describing the structs and enums of the schema file as they
+// are encoded by the standard Cap'n Proto JSON codec.  Each type is a
+// definition in $defs, named by its Go type name.  The plugin's
+// parameter, if any, is a directory to write the documents to instead
+// of the working directory.
+//
+// As in the JSON codec, 64-bit integers are encoded as strings to
+// avoid loss of precision, Data is an array of bytes, enums are encoded
+// by name, and groups are nested objects.  Capabilities cannot be
+// encoded, so interface fields accept any value.
+type jsonSchemaPlugin struct{}
+
+func (jsonSchemaPlugin) run(req schema.CodeGeneratorRequest, trees nodeTrees, dir string) error {
+	reqFiles, err := req.RequestedFiles()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < reqFiles.Len(); i++ {
+		fname, err := reqFiles.At(i).Filename()
+		if err != nil {
+			return fmt.Errorf("reading filename of requested file %d: %v", i+1, err)
+		}
+		f, err := trees.nodes.mustFind(reqFiles.At(i).Id())
+		if err != nil {
+			return err
+		}
+		js := &jsonSchemaBuilder{nodes: trees.nodes, file: f}
+		doc, err := js.document(fname)
+		if err != nil {
+			return fmt.Errorf("%s: %v", fname, err)
+		}
+		out := filepath.Join(dir, filepath.FromSlash(fname)+".schema.json")
+		if err := os.MkdirAll(filepath.Dir(out), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(out, doc, 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonSchemaBuilder builds the JSON Schema document for a single file.
+type jsonSchemaBuilder struct {
+	nodes nodeMap
+	file  *node
+}
+
+type jsonObject = map[string]any
+
+func (js *jsonSchemaBuilder) document(fname string) ([]byte, error) {
+	defs := make(jsonObject)
+	for _, n := range js.file.nodes {
+		switch n.Which() {
+		case schema.Node_Which_structNode:
+			if n.StructNode().IsGroup() {
+				continue
+			}
+			def, err := js.structSchema(n)
+			if err != nil {
+				return nil, err
+			}
+			defs[n.Name] = def
+		case schema.Node_Which_enum:
+			def, err := js.enumSchema(n)
+			if err != nil {
+				return nil, err
+			}
+			defs[n.Name] = def
+		}
+	}
+	doc := jsonObject{
+		"$schema": jsonSchemaURI,
+		"title":   fname,
+		"$defs":   defs,
+	}
+	b, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func (js *jsonSchemaBuilder) structSchema(n *node) (jsonObject, error) {
+	props := make(jsonObject)
+	for _, f := range n.codeOrderFields() {
+		name, err := f.Field.Name()
+		if err != nil {
+			return nil, fmt.Errorf("%s: reading field name: %v", n, err)
+		}
+		var fs jsonObject
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			t, err := f.Slot().Type()
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", n, name, err)
+			}
+			if fs, err = js.typeSchema(t); err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", n, name, err)
+			}
+		case schema.Field_Which_group:
+			grp, err := js.nodes.mustFind(f.Group().TypeId())
+			if err != nil {
+				return nil, err
+			}
+			if fs, err = js.structSchema(grp); err != nil {
+				return nil, err
+			}
+		}
+		props[name] = fs
+	}
+	s := jsonObject{
+		"type":                 "object",
+		"properties":           props,
+		"additionalProperties": false,
+	}
+	if !n.StructNode().IsGroup() {
+		s["title"] = n.shortDisplayName()
+	}
+	return s, nil
+}
+
+func (js *jsonSchemaBuilder) enumSchema(n *node) (jsonObject, error) {
+	es, err := n.Enum().Enumerants()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", n, err)
+	}
+	names := make([]string, es.Len())
+	for i := range names {
+		if names[i], err = es.At(i).Name(); err != nil {
+			return nil, fmt.Errorf("%s: %v", n, err)
+		}
+	}
+	return jsonObject{
+		"title": n.shortDisplayName(),
+		"type":  "string",
+		"enum":  names,
+	}, nil
+}
+
+func (js *jsonSchemaBuilder) typeSchema(t schema.Type) (jsonObject, error) {
+	switch t.Which() {
+	case schema.Type_Which_void:
+		return jsonObject{"type": "null"}, nil
+	case schema.Type_Which_bool:
+		return jsonObject{"type": "boolean"}, nil
+	case schema.Type_Which_int8:
+		return jsonInteger(math.MinInt8, math.MaxInt8), nil
+	case schema.Type_Which_int16:
+		return jsonInteger(math.MinInt16, math.MaxInt16), nil
+	case schema.Type_Which_int32:
+		return jsonInteger(math.MinInt32, math.MaxInt32), nil
+	case schema.Type_Which_uint8:
+		return jsonInteger(0, math.MaxUint8), nil
+	case schema.Type_Which_uint16:
+		return jsonInteger(0, math.MaxUint16), nil
+	case schema.Type_Which_uint32:
+		return jsonInteger(0, math.MaxUint32), nil
+	case schema.Type_Which_int64:
+		return jsonObject{"type": "string", "pattern": "^-?[0-9]+$"}, nil
+	case schema.Type_Which_uint64:
+		return jsonObject{"type": "string", "pattern": "^[0-9]+$"}, nil
+	case schema.Type_Which_float32, schema.Type_Which_float64:
+		// Non-finite values are encoded as strings.
+		return jsonObject{
+			"anyOf": []any{
+				jsonObject{"type": "number"},
+				jsonObject{"enum": []string{"NaN", "Infinity", "-Infinity"}},
+			},
+		}, nil
+	case schema.Type_Which_text:
+		return jsonObject{"type": "string"}, nil
+	case schema.Type_Which_data:
+		return jsonObject{"type": "array", "items": jsonInteger(0, math.MaxUint8)}, nil
+	case schema.Type_Which_list:
+		et, err := t.List().ElementType()
+		if err != nil {
+			return nil, err
+		}
+		items, err := js.typeSchema(et)
+		if err != nil {
+			return nil, err
+		}
+		return jsonObject{"type": "array", "items": items}, nil
+	case schema.Type_Which_enum:
+		return js.ref(t.Enum().TypeId())
+	case schema.Type_Which_structType:
+		return js.ref(t.StructType().TypeId())
+	default:
+		// Interfaces and AnyPointer.
+		return jsonObject{}, nil
+	}
+}
+
+func jsonInteger(min, max int64) jsonObject {
+	return jsonObject{"type": "integer", "minimum": min, "maximum": max}
+}
+
+// ref returns a reference to the definition of a node, which may be in
+// another file's document.
+func (js *jsonSchemaBuilder) ref(id uint64) (jsonObject, error) {
+	n, err := js.nodes.mustFind(id)
+	if err != nil {
+		return nil, err
+	}
+	f, err := js.fileOf(n)
+	if err != nil {
+		return nil, err
+	}
+	if f == js.file {
+		return jsonObject{"$ref": "#/$defs/" + n.Name}, nil
+	}
+	from, _ := js.file.DisplayName()
+	to, _ := f.DisplayName()
+	rel, err := filepath.Rel(path.Dir(from), to)
+	if err != nil {
+		return nil, err
+	}
+	return jsonObject{"$ref": filepath.ToSlash(rel) + ".schema.json#/$defs/" + n.Name}, nil
+}
+
+// fileOf returns the file node that n is declared in.
+func (js *jsonSchemaBuilder) fileOf(n *node) (*node, error) {
+	for n.Which() != schema.Node_Which_file {
+		if n.methodScope != nil {
+			n = n.methodScope
+			continue
+		}
+		var err error
+		if n, err = js.nodes.mustFind(n.ScopeId()); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
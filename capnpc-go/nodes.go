@@ -3,6 +3,9 @@ package main
 import (
 	"errors"
 	"fmt"
+	"go/token"
+	"path"
+	"sort"
 	"strings"
 
 	"capnproto.org/go/capnp/v3"
@@ -17,14 +20,47 @@ var renameIdents = map[string]bool {
 	"String":  true,	// unusual to burden codegen with.
 	"Message": true,
 	"Which":   true,
+	"Clone":   true,
+	"Hash64":  true,
 }
 
 type node struct {
 	schema.Node
 	pkg   string
 	imp   string
+	alias string // $Go.importAlias of the node's file
+	style style
 	nodes []*node // only for file nodes
 	Name  string
+
+	// methodScope is the interface that declares a method whose
+	// implicit parameter or result struct this node is.
+	methodScope *node
+
+	// doc and memberDocs are the doc comments of the node and its
+	// members (fields, enumerants or methods) in the schema source.
+	doc        string
+	memberDocs []string
+}
+
+// memberDoc returns the doc comment of the i'th member of the node.
+func (n *node) memberDoc(i int) string {
+	if i < len(n.memberDocs) {
+		return n.memberDocs[i]
+	}
+	return ""
+}
+
+// annotations parses the node's annotations, using the node's doc
+// comment if it has no $doc annotation.
+func (n *node) annotations() *annotations {
+	nann, _ := n.Annotations()
+	ann := parseAnnotations(nann)
+	if ann.Doc == "" {
+		ann.Doc = n.doc
+	}
+	ann.Doc = deprecatedDoc(ann.Doc, ann.Deprecated)
+	return ann
 }
 
 func (n *node) codeOrderFields() []field {
@@ -35,14 +71,26 @@ func (n *node) codeOrderFields() []field {
 		f := fields.At(i)
 		fann, _ := f.Annotations()
 		fname, _ := f.Name()
-		var renamed = parseAnnotations(fann).Rename(fname)
+		ann := parseAnnotations(fann)
+		var renamed = ann.Rename(fname)
 		if renamed == fname {	// Avoid collisions if no annotation
 			if _, ok := renameIdents[strings.Title(fname)]; ok {
 				renamed = fname + "_"
 			}
 
 		}
-		mbrs[f.CodeOrder()] = field{Field: f, Name: renamed}
+		doc := ann.Doc
+		if doc == "" {
+			doc = n.memberDoc(i)
+		}
+		mbrs[f.CodeOrder()] = field{
+			Field:      f,
+			Name:       renamed,
+			Getter:     n.style.getterPrefix + strings.Title(renamed),
+			Setter:     n.style.setterPrefix + strings.Title(renamed),
+			Doc:        deprecatedDoc(doc, ann.Deprecated),
+			Deprecated: ann.Deprecated,
+		}
 	}
 	return mbrs
 }
@@ -77,7 +125,11 @@ func displayName(n interface {
 
 type field struct {
 	schema.Field
-	Name string
+	Name       string
+	Getter     string // name of the getter method
+	Setter     string // name of the setter method
+	Doc        string
+	Deprecated string
 }
 
 // HasDiscriminant reports whether the field is in a union.
@@ -90,6 +142,7 @@ type enumval struct {
 	Name   string
 	Val    int
 	Tag    string
+	Doc    string
 	parent *node
 }
 
@@ -99,7 +152,11 @@ func makeEnumval(enum *node, i int, e schema.Enumerant) enumval {
 	name, _ := e.Name()
 	name = ann.Rename(name)
 	t := ann.Tag(name)
-	return enumval{e, name, i, t, enum}
+	doc := ann.Doc
+	if doc == "" {
+		doc = enum.memberDoc(i)
+	}
+	return enumval{e, name, i, t, deprecatedDoc(doc, ann.Deprecated), enum}
 }
 
 func (e *enumval) FullName() string {
@@ -112,20 +169,66 @@ type interfaceMethod struct {
 	ID           int
 	Name         string
 	OriginalName string
+	Doc          string
 	Params       *node
 	Results      *node
+	Idempotent   bool
+
+	// HasTimeout is set if a parameter is annotated with $timeout, and
+	// TimeoutOffset is the byte offset of that parameter in the data
+	// section of the params struct.
+	HasTimeout    bool
+	TimeoutOffset uint32
+
+	// brands binds the generic parameters of Interface when the method
+	// is inherited from a generic superclass, innermost first.
+	brands []schema.Brand
 }
 
 func (m interfaceMethod) IsStreaming() bool {
 	return m.Results.Id() == stream.StreamResult_TypeID
 }
 
-func methodSet(methods []interfaceMethod, n *node, nodes nodeMap) ([]interfaceMethod, error) {
+// timeoutParam returns the byte offset in the data section of params of
+// the field annotated with $timeout, if there is one.
+func timeoutParam(params *node) (off uint32, ok bool, err error) {
+	if params.Which() != schema.Node_Which_structNode {
+		return 0, false, nil
+	}
+	fields, _ := params.StructNode().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		fann, _ := f.Annotations()
+		if !parseAnnotations(fann).Timeout {
+			continue
+		}
+		fname, _ := f.Name()
+		if ok {
+			return 0, false, fmt.Errorf("more than one parameter annotated with $timeout")
+		}
+		if f.Which() != schema.Field_Which_slot || f.DiscriminantValue() != schema.Field_noDiscriminant {
+			return 0, false, fmt.Errorf("$timeout parameter %s must be a plain field", fname)
+		}
+		t, _ := f.Slot().Type()
+		if t.Which() != schema.Type_Which_uint64 {
+			return 0, false, fmt.Errorf("$timeout parameter %s must be a UInt64", fname)
+		}
+		off, ok = f.Slot().Offset()*8, true
+	}
+	return off, ok, nil
+}
+
+func methodSet(methods []interfaceMethod, n *node, nodes nodeMap, brands []schema.Brand) ([]interfaceMethod, error) {
 	ms, _ := n.Interface().Methods()
 	for i := 0; i < ms.Len(); i++ {
 		m := ms.At(i)
 		mname, _ := m.Name()
 		mann, _ := m.Annotations()
+		ann := parseAnnotations(mann)
+		doc := ann.Doc
+		if doc == "" {
+			doc = n.memberDoc(i)
+		}
 		pn, err := nodes.mustFind(m.ParamStructType())
 		if err != nil {
 			return methods, fmt.Errorf("could not find param type for %s.%s", n.shortDisplayName(), mname)
@@ -134,14 +237,23 @@ func methodSet(methods []interfaceMethod, n *node, nodes nodeMap) ([]interfaceMe
 		if err != nil {
 			return methods, fmt.Errorf("could not find result type for %s.%s", n.shortDisplayName(), mname)
 		}
+		toff, hasTimeout, err := timeoutParam(pn)
+		if err != nil {
+			return methods, fmt.Errorf("%s.%s: %v", n.shortDisplayName(), mname, err)
+		}
 		methods = append(methods, interfaceMethod{
-			Method:       m,
-			Interface:    n,
-			ID:           i,
-			OriginalName: mname,
-			Name:         parseAnnotations(mann).Rename(mname),
-			Params:       pn,
-			Results:      rn,
+			Method:        m,
+			Interface:     n,
+			ID:            i,
+			OriginalName:  mname,
+			Name:          ann.Rename(mname),
+			Doc:           deprecatedDoc(doc, ann.Deprecated),
+			Params:        pn,
+			Results:       rn,
+			Idempotent:    ann.Idempotent,
+			HasTimeout:    hasTimeout,
+			TimeoutOffset: toff,
+			brands:        brands,
 		})
 	}
 	// TODO(light): sort added methods by code order
@@ -153,7 +265,11 @@ func methodSet(methods []interfaceMethod, n *node, nodes nodeMap) ([]interfaceMe
 		if err != nil {
 			return methods, fmt.Errorf("could not find superclass %#x of %s", s.Id(), n)
 		}
-		methods, err = methodSet(methods, sn, nodes)
+		sb, err := s.Brand()
+		if err != nil {
+			return methods, fmt.Errorf("reading brand of superclass %#x of %s: %v", s.Id(), n, err)
+		}
+		methods, err = methodSet(methods, sn, nodes, append([]schema.Brand{sb}, brands...))
 		if err != nil {
 			return methods, err
 		}
@@ -161,6 +277,57 @@ func methodSet(methods []interfaceMethod, n *node, nodes nodeMap) ([]interfaceMe
 	return methods, nil
 }
 
+// An interfaceSuper is an interface that another interface extends,
+// directly or through its other superclasses.
+type interfaceSuper struct {
+	Node *node
+
+	// Direct is true if the interface names Node in its extends clause.
+	Direct bool
+
+	// Upcast is the name of the client method that converts to Node's
+	// client type, or empty if that would collide with another method.
+	Upcast string
+
+	// brands binds the generic parameters of Node, innermost first.
+	brands []schema.Brand
+}
+
+// superclasses returns the interfaces that n extends, in the order that
+// methodSet visits them.  An interface that n inherits more than once
+// is only listed the first time.
+func superclasses(supers []interfaceSuper, n *node, nodes nodeMap, brands []schema.Brand) ([]interfaceSuper, error) {
+	ss, _ := n.Interface().Superclasses()
+	for i := 0; i < ss.Len(); i++ {
+		s := ss.At(i)
+		sn, err := nodes.mustFind(s.Id())
+		if err != nil {
+			return supers, fmt.Errorf("could not find superclass %#x of %s", s.Id(), n)
+		}
+		sb, err := s.Brand()
+		if err != nil {
+			return supers, fmt.Errorf("reading brand of superclass %#x of %s: %v", s.Id(), n, err)
+		}
+		dup := false
+		for _, prev := range supers {
+			dup = dup || prev.Node.Id() == sn.Id()
+		}
+		if dup {
+			continue
+		}
+		sbrands := append([]schema.Brand{sb}, brands...)
+		supers = append(supers, interfaceSuper{
+			Node:   sn,
+			Direct: brands == nil,
+			brands: sbrands,
+		})
+		if supers, err = superclasses(supers, sn, nodes, sbrands); err != nil {
+			return supers, err
+		}
+	}
+	return supers, nil
+}
+
 // Tag types
 const (
 	defaultTag = iota
@@ -169,14 +336,44 @@ const (
 )
 
 type annotations struct {
-	Doc       string
-	Package   string
-	Import    string
-	TagType   int
-	CustomTag string
-	Name      string
+	Doc          string
+	Package      string
+	Import       string
+	ImportAlias  string
+	TagType      int
+	CustomTag    string
+	Name         string
+	Deprecated   string
+	GetterPrefix string
+	SetterPrefix string
+	Idempotent   bool
+	Timeout      bool
+
+	// Flatten is set for unions annotated with $flatten, and
+	// FlattenWhich is the name of their flattened discriminant getter.
+	Flatten      bool
+	FlattenWhich string
+
+	// The validation annotations of a field.  Min and Max are nil if
+	// the field has no $min or $max.
+	Min, Max *float64
+	Regex    string
+	Required bool
+
+	// EmbedSchema is the value of a file's $embedSchema, or nil if it
+	// has none.
+	EmbedSchema *bool
+}
+
+// validates reports whether ann has any validation annotations.
+func (ann *annotations) validates() bool {
+	return ann.Min != nil || ann.Max != nil || ann.Regex != "" || ann.Required
 }
 
+// defaultDeprecation is the deprecation notice for elements annotated
+// with an empty $deprecated.
+const defaultDeprecation = "this is deprecated in the schema."
+
 func parseAnnotations(list capnp.StructList[schema.Annotation]) *annotations {
 	ann := new(annotations)
 	for i, n := 0, list.Len(); i < n; i++ {
@@ -189,6 +386,8 @@ func parseAnnotations(list capnp.StructList[schema.Annotation]) *annotations {
 			ann.Package, _ = val.Text()
 		case 0xe130b601260e44b5: // $import
 			ann.Import, _ = val.Text()
+		case 0xbbf01c906ac1209d: // $importAlias
+			ann.ImportAlias, _ = val.Text()
 		case 0xa574b41924caefc7: // $tag
 			ann.TagType = customTag
 			ann.CustomTag, _ = val.Text()
@@ -196,11 +395,80 @@ func parseAnnotations(list capnp.StructList[schema.Annotation]) *annotations {
 			ann.TagType = noTag
 		case 0xc2b96012172f8df1: // $name
 			ann.Name, _ = val.Text()
+		case 0xc52416aab2dee380: // $deprecated
+			ann.Deprecated, _ = val.Text()
+			if ann.Deprecated == "" {
+				ann.Deprecated = defaultDeprecation
+			}
+		case 0xbdc942455af8bdea: // $getterPrefix
+			ann.GetterPrefix, _ = val.Text()
+		case 0x8ef7184fcd66536e: // $setterPrefix
+			ann.SetterPrefix, _ = val.Text()
+		case 0xc5c67716e14c947e: // $idempotent
+			ann.Idempotent = true
+		case 0x808ecdd9a5393352: // $timeout
+			ann.Timeout = true
+		case 0xa1f4c5658297aab9: // $flatten
+			ann.Flatten = true
+			ann.FlattenWhich, _ = val.Text()
+			if ann.FlattenWhich == "" {
+				ann.FlattenWhich = "Which"
+			}
+		case 0xeef9cfe81ddeca5e: // $min
+			v := val.Float64()
+			ann.Min = &v
+		case 0xe1f93203db42ac8b: // $max
+			v := val.Float64()
+			ann.Max = &v
+		case 0xa6bc2151e60649c5: // $regex
+			ann.Regex, _ = val.Text()
+		case 0x8b2455025d97a887: // $required
+			ann.Required = true
+		case 0xf05d3e886c7e6c99: // $embedSchema
+			v := val.Bool()
+			ann.EmbedSchema = &v
 		}
 	}
 	return ann
 }
 
+// deprecatedDoc returns doc with a "Deprecated:" paragraph added if
+// deprecated is not empty.
+func deprecatedDoc(doc, deprecated string) string {
+	if deprecated == "" {
+		return doc
+	}
+	doc = strings.TrimSpace(doc)
+	if doc != "" {
+		doc += "\n\n"
+	}
+	return doc + "Deprecated: " + deprecated
+}
+
+// A style is the naming style of the accessors generated for a file,
+// set by the $getterPrefix and $setterPrefix file annotations.
+type style struct {
+	getterPrefix string
+	setterPrefix string
+}
+
+// fileStyle returns the style set by a file's annotations.
+func fileStyle(ann *annotations) (style, error) {
+	s := style{getterPrefix: ann.GetterPrefix, setterPrefix: ann.SetterPrefix}
+	if s.setterPrefix == "" {
+		s.setterPrefix = "Set"
+	}
+	for _, p := range []string{s.getterPrefix, s.setterPrefix} {
+		if p != "" && (!token.IsIdentifier(p) || !token.IsExported(p)) {
+			return style{}, fmt.Errorf("accessor prefix %q is not an exported identifier", p)
+		}
+	}
+	if s.getterPrefix == s.setterPrefix {
+		return style{}, fmt.Errorf("getter and setter prefixes are both %q", s.getterPrefix)
+	}
+	return s, nil
+}
+
 // Tag returns the string value that an enumerant value called name should have.
 // An empty string indicates that this enumerant value has no tag.
 func (ann *annotations) Tag(name string) string {
@@ -232,6 +500,9 @@ type pkgSchema struct {
 	// done is true if this schema has been written, i.e. do not
 	// write it to multiple files in a Go package.
 	done bool
+	// embedSchema is set if a file in the package has an $embedSchema
+	// annotation, and is false if any of them is false.
+	embedSchema *bool
 }
 
 type nodeMap map[uint64]*node
@@ -266,6 +537,9 @@ func makeNodeTrees(req schema.CodeGeneratorRequest) (nodeTrees, error) {
 			allfiles = append(allfiles, n)
 		}
 	}
+	if err := addSourceInfo(ret.nodes, req); err != nil {
+		return ret, err
+	}
 	for _, f := range allfiles {
 		fann, err := f.Annotations()
 		if err != nil {
@@ -274,6 +548,10 @@ func makeNodeTrees(req schema.CodeGeneratorRequest) (nodeTrees, error) {
 		ann := parseAnnotations(fann)
 		f.pkg = ann.Package
 		f.imp = ann.Import
+		f.alias = ann.ImportAlias
+		if f.style, err = fileStyle(ann); err != nil {
+			return ret, fmt.Errorf("%v: %v", f, err)
+		}
 		nnodes, _ := f.NestedNodes()
 		for i := 0; i < nnodes.Len(); i++ {
 			nn := nnodes.At(i)
@@ -295,11 +573,111 @@ func makeNodeTrees(req schema.CodeGeneratorRequest) (nodeTrees, error) {
 		for _, n := range f.nodes {
 			pkg.nodeId = append(pkg.nodeId, n.Id())
 		}
+		if e := ann.EmbedSchema; e != nil && (pkg.embedSchema == nil || !*e) {
+			pkg.embedSchema = e
+		}
 		ret.pkgs[f.pkg] = pkg
 	}
 	return ret, nil
 }
 
+// checkNameCollisions returns an error if a file in reqFiles and another
+// file whose code is in the same Go package declare nodes with the same
+// Go name, which would otherwise produce duplicate declarations that
+// fail to compile.  Files share a Go package if they have the same
+// $Go.import annotation or, for files without one, the same $Go.package
+// annotation and directory.
+func checkNameCollisions(reqFiles []schema.CodeGeneratorRequest_RequestedFile, trees nodeTrees) error {
+	var keys []string
+	pkgs := make(map[string][]*node)
+	requested := make(map[uint64]bool)
+	for _, reqf := range reqFiles {
+		f := trees.nodes[reqf.Id()]
+		if f == nil {
+			continue
+		}
+		requested[f.Id()] = true
+		k := goPackageKey(f)
+		if pkgs[k] == nil {
+			keys = append(keys, k)
+		}
+		pkgs[k] = append(pkgs[k], f)
+	}
+	// Imported files were generated separately, but their declarations
+	// still collide if they are in the same package.
+	var imported []*node
+	for _, n := range trees.nodes {
+		if n.Which() == schema.Node_Which_file && !requested[n.Id()] && n.imp != "" {
+			imported = append(imported, n)
+		}
+	}
+	sort.Slice(imported, func(i, j int) bool { return imported[i].Id() < imported[j].Id() })
+	for _, f := range imported {
+		if k := goPackageKey(f); pkgs[k] != nil {
+			pkgs[k] = append(pkgs[k], f)
+		}
+	}
+
+	for _, k := range keys {
+		files := pkgs[k]
+		if len(files) < 2 {
+			continue
+		}
+		type decl struct{ n, file *node }
+		declared := make(map[string]decl)
+		for _, f := range files {
+			for _, n := range f.nodes {
+				if n.Name == "" {
+					continue
+				}
+				prev, ok := declared[n.Name]
+				if !ok {
+					declared[n.Name] = decl{n, f}
+					continue
+				}
+				if prev.file != f {
+					return fmt.Errorf("%v and %v both declare the Go name %s in package %s; "+
+						"give one of them a different name with the $Go.name annotation, "+
+						"or move one of the files to a different $Go.package", prev.n, n, n.Name, f.pkg)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// goPackageKey returns a string that is equal for files whose generated
+// code is in the same Go package.
+func goPackageKey(f *node) string {
+	if f.imp != "" {
+		return f.imp
+	}
+	return path.Dir(displayName(f)) + " " + f.pkg
+}
+
+// addSourceInfo copies the doc comments from the request's source info
+// into the nodes.
+func addSourceInfo(nodes nodeMap, req schema.CodeGeneratorRequest) error {
+	infos, err := req.SourceInfo()
+	if err != nil {
+		return fmt.Errorf("reading source info: %v", err)
+	}
+	for i := 0; i < infos.Len(); i++ {
+		info := infos.At(i)
+		n := nodes[info.Id()]
+		if n == nil {
+			continue
+		}
+		n.doc, _ = info.DocComment()
+		members, _ := info.Members()
+		n.memberDocs = make([]string, members.Len())
+		for j := range n.memberDocs {
+			n.memberDocs[j], _ = members.At(j).DocComment()
+		}
+	}
+	return nil
+}
+
 // resolveName is called as part of building up a node map to populate the name field of n.
 func resolveName(nodes nodeMap, n *node, base, name string, file *node) error {
 	na, err := n.Annotations()
@@ -325,6 +703,8 @@ func resolveName(nodes nodeMap, n *node, base, name string, file *node) error {
 	}
 	n.pkg = file.pkg
 	n.imp = file.imp
+	n.alias = file.alias
+	n.style = file.style
 	file.nodes = append(file.nodes, n)
 
 	nnodes, err := n.NestedNodes()
@@ -375,6 +755,7 @@ func resolveName(nodes nodeMap, n *node, base, name string, file *node) error {
 			if x.ScopeId() != 0 {
 				return nil
 			}
+			x.methodScope = n
 			return resolveName(nodes, x, base, name, file)
 		}
 		for i := 0; i < m.Len(); i++ {
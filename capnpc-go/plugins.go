@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// A plugin generates additional output from a code generation request,
+// after the Go code has been generated.
+type plugin interface {
+	run(req schema.CodeGeneratorRequest, trees nodeTrees, param string) error
+}
+
+// builtinPlugins maps names to plugins that are compiled into capnpc-go.
+// Built-in plugins take precedence over executables with the same name.
+var builtinPlugins = map[string]plugin{}
+
+// pluginPrefix is prepended to a plugin's name to find its executable.
+const pluginPrefix = "capnpc-go-"
+
+// A pluginSpec is a plugin requested on the command line, in the form
+// name or name=param.
+type pluginSpec struct {
+	name  string
+	param string
+}
+
+// pluginFlag collects the values of the repeatable -plugin flag.
+type pluginFlag []pluginSpec
+
+func (f *pluginFlag) String() string {
+	specs := make([]string, len(*f))
+	for i, s := range *f {
+		specs[i] = s.name
+		if s.param != "" {
+			specs[i] += "=" + s.param
+		}
+	}
+	return strings.Join(specs, ",")
+}
+
+func (f *pluginFlag) Set(s string) error {
+	name, param, _ := strings.Cut(s, "=")
+	if name == "" {
+		return errors.New("missing plugin name")
+	}
+	*f = append(*f, pluginSpec{name: name, param: param})
+	return nil
+}
+
+// findPlugin returns the plugin with the given name: either a built-in
+// plugin or an executable called capnpc-go-NAME in $PATH.
+func findPlugin(name string) (plugin, error) {
+	if p := builtinPlugins[name]; p != nil {
+		return p, nil
+	}
+	path, err := exec.LookPath(pluginPrefix + name)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s: %v", name, err)
+	}
+	return execPlugin(path), nil
+}
+
+// runPlugins runs each of the requested plugins in order.
+func runPlugins(specs []pluginSpec, req schema.CodeGeneratorRequest, trees nodeTrees) error {
+	for _, s := range specs {
+		p, err := findPlugin(s.name)
+		if err != nil {
+			return err
+		}
+		if err := p.run(req, trees, s.param); err != nil {
+			return fmt.Errorf("plugin %s: %v", s.name, err)
+		}
+	}
+	return nil
+}
+
+// An execPlugin is a plugin executable.  Like a capnp compiler plugin,
+// it reads the CodeGeneratorRequest from standard input and writes its
+// output files relative to its working directory.  The plugin's
+// parameter, if any, is passed as its only argument.
+type execPlugin string
+
+func (path execPlugin) run(req schema.CodeGeneratorRequest, _ nodeTrees, param string) error {
+	data, err := req.Message().Marshal()
+	if err != nil {
+		return fmt.Errorf("encoding request: %v", err)
+	}
+	var args []string
+	if param != "" {
+		args = append(args, param)
+	}
+	cmd := exec.Command(string(path), args...)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
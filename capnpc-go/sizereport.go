@@ -0,0 +1,252 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+func init() {
+	builtinPlugins["sizereport"] = sizeReportPlugin{}
+}
+
+// sizeReportPlugin writes a JSON report next to each generated Go file
+// describing the layout of the file's structs: how many data words and
+// pointers each one uses, how many bits of its data section are padding,
+// and, where a different choice of ordinals would need fewer data words,
+// an order for the data fields that packs them more tightly.  The
+// plugin's parameter, if any, is a directory to write the reports to
+// instead of the working directory.
+//
+// The report is meant to be consulted while a schema is still being
+// designed: once a schema has been published, its ordinals cannot be
+// changed.
+type sizeReportPlugin struct{}
+
+// A sizeReport is the document written for a single schema file.
+type sizeReport struct {
+	File    string        `json:"file"`
+	Structs []structSizes `json:"structs"`
+}
+
+// structSizes describes the layout of a single struct.
+type structSizes struct {
+	Name         string `json:"name"`
+	ID           string `json:"id"`
+	DataWords    uint16 `json:"dataWords"`
+	PointerCount uint16 `json:"pointerCount"`
+
+	// UsedDataBits is the number of bits of the data section occupied
+	// by fields and union discriminants, and PaddingBits is the rest.
+	UsedDataBits uint `json:"usedDataBits"`
+	PaddingBits  uint `json:"paddingBits"`
+
+	// MinDataWords is a lower bound on the data section size needed to
+	// hold the struct's fields, counting only the largest member of
+	// each union.
+	MinDataWords uint `json:"minDataWords"`
+
+	// SuggestedOrder lists the struct's data fields, including those
+	// in groups and unions, largest first.  It is only reported when
+	// MinDataWords is less than DataWords.  Assigning ordinals in this
+	// order leaves no padding between fields outside of unions.
+	SuggestedOrder []string `json:"suggestedOrder,omitempty"`
+}
+
+func (sizeReportPlugin) run(req schema.CodeGeneratorRequest, trees nodeTrees, dir string) error {
+	reqFiles, err := req.RequestedFiles()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < reqFiles.Len(); i++ {
+		fname, err := reqFiles.At(i).Filename()
+		if err != nil {
+			return fmt.Errorf("reading filename of requested file %d: %v", i+1, err)
+		}
+		f, err := trees.nodes.mustFind(reqFiles.At(i).Id())
+		if err != nil {
+			return err
+		}
+		report := sizeReport{File: fname, Structs: []structSizes{}}
+		for _, n := range f.nodes {
+			if n.Which() != schema.Node_Which_structNode || n.StructNode().IsGroup() {
+				continue
+			}
+			s, err := layoutSizes(trees.nodes, n)
+			if err != nil {
+				return fmt.Errorf("%s: %v", fname, err)
+			}
+			report.Structs = append(report.Structs, s)
+		}
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		out := filepath.Join(dir, filepath.FromSlash(fname)+".sizes.json")
+		if err := os.MkdirAll(filepath.Dir(out), os.ModePerm); err != nil {
+			return err
+		}
+		if err := os.WriteFile(out, append(b, '\n'), 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// layoutSizes computes the size report entry for the struct n.
+func layoutSizes(nodes nodeMap, n *node) (structSizes, error) {
+	sn := n.StructNode()
+	dn := n.String()
+	s := structSizes{
+		Name:         dn[strings.IndexByte(dn, ':')+1:],
+		ID:           fmt.Sprintf("%#x", n.Id()),
+		DataWords:    sn.DataWordCount(),
+		PointerCount: sn.PointerCount(),
+	}
+	used := make([]bool, int(s.DataWords)*64)
+	minBits, err := markDataBits(nodes, n, used)
+	if err != nil {
+		return structSizes{}, err
+	}
+	for _, u := range used {
+		if u {
+			s.UsedDataBits++
+		}
+	}
+	s.PaddingBits = uint(len(used)) - s.UsedDataBits
+	s.MinDataWords = (minBits + 63) / 64
+	if s.MinDataWords >= uint(s.DataWords) {
+		return s, nil
+	}
+
+	fields, err := dataFields(nodes, n, "")
+	if err != nil {
+		return structSizes{}, err
+	}
+	// Fields are collected in ordinal order, which the suggestion
+	// keeps among fields of the same size.
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].bits > fields[j].bits })
+	for _, f := range fields {
+		s.SuggestedOrder = append(s.SuggestedOrder, f.name)
+	}
+	return s, nil
+}
+
+// A dataField is a field stored in a struct's data section.
+type dataField struct {
+	name string // qualified by group names, like "group.field"
+	bits uint
+}
+
+// dataFields returns the data fields of n and its groups, in ordinal
+// order.
+func dataFields(nodes nodeMap, n *node, prefix string) ([]dataField, error) {
+	fields, err := n.StructNode().Fields()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", n, err)
+	}
+	var dfs []dataField
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		name, err := f.Name()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", n, err)
+		}
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			t, err := f.Slot().Type()
+			if err != nil {
+				return nil, fmt.Errorf("%v.%s: %v", n, name, err)
+			}
+			if bits := dataBits(t.Which()); bits > 0 {
+				dfs = append(dfs, dataField{prefix + name, bits})
+			}
+		case schema.Field_Which_group:
+			grp, err := nodes.mustFind(f.Group().TypeId())
+			if err != nil {
+				return nil, err
+			}
+			gfs, err := dataFields(nodes, grp, prefix+name+".")
+			if err != nil {
+				return nil, err
+			}
+			dfs = append(dfs, gfs...)
+		}
+	}
+	return dfs, nil
+}
+
+// markDataBits marks the data section bits occupied by the fields of
+// n, which is a struct or group, and returns the number of bits they
+// need at minimum.
+func markDataBits(nodes nodeMap, n *node, used []bool) (minBits uint, err error) {
+	sn := n.StructNode()
+	var unionBits uint
+	if sn.DiscriminantCount() > 0 {
+		mark(used, uint(sn.DiscriminantOffset())*16, 16)
+		minBits += 16
+	}
+	fields, err := sn.Fields()
+	if err != nil {
+		return 0, fmt.Errorf("%v: %v", n, err)
+	}
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		var bits uint
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			t, err := f.Slot().Type()
+			if err != nil {
+				return 0, fmt.Errorf("%v: %v", n, err)
+			}
+			bits = dataBits(t.Which())
+			mark(used, uint(f.Slot().Offset())*bits, bits)
+		case schema.Field_Which_group:
+			grp, err := nodes.mustFind(f.Group().TypeId())
+			if err != nil {
+				return 0, err
+			}
+			if bits, err = markDataBits(nodes, grp, used); err != nil {
+				return 0, err
+			}
+		}
+		if f.DiscriminantValue() != schema.Field_noDiscriminant {
+			if bits > unionBits {
+				unionBits = bits
+			}
+		} else {
+			minBits += bits
+		}
+	}
+	return minBits + unionBits, nil
+}
+
+func mark(used []bool, start, n uint) {
+	for i := start; i < start+n && i < uint(len(used)); i++ {
+		used[i] = true
+	}
+}
+
+// dataBits returns the number of data section bits that a field of
+// type t occupies, or zero for types stored in the pointer section.
+func dataBits(t schema.Type_Which) uint {
+	switch t {
+	case schema.Type_Which_bool:
+		return 1
+	case schema.Type_Which_int8, schema.Type_Which_uint8:
+		return 8
+	case schema.Type_Which_int16, schema.Type_Which_uint16, schema.Type_Which_enum:
+		return 16
+	case schema.Type_Which_int32, schema.Type_Which_uint32, schema.Type_Which_float32:
+		return 32
+	case schema.Type_Which_int64, schema.Type_Which_uint64, schema.Type_Which_float64:
+		return 64
+	default:
+		return 0
+	}
+}
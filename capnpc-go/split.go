@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// generatedHeader starts every file that capnpc-go writes.
+const generatedHeader = "// Code generated by capnpc-go. DO NOT EDIT."
+
+// topLevel returns the node declared directly in the file f that n is
+// nested in, n itself if it is declared in f, or nil if n is not in f.
+// The implicit parameter and result structs of a method are nested in
+// the method's interface.
+func (g *generator) topLevel(f, n *node) *node {
+	for n != nil {
+		scope := n.ScopeId()
+		if scope == f.Id() {
+			return n
+		}
+		if n.methodScope != nil && scope == 0 {
+			n = n.methodScope
+			continue
+		}
+		n = g.nodes[scope]
+	}
+	return nil
+}
+
+// partNodes returns the nodes of the file f whose declarations are
+// generated in the output of g's part: the nodes nested in the part's
+// top-level node, or for the file's own output, the annotations and
+// constants that are not nested in a type of their own.
+func (g *generator) partNodes(f *node) []*node {
+	var nodes []*node
+	for _, n := range f.nodes {
+		top := g.topLevel(f, n)
+		if top != nil && !isSplitPart(top) {
+			top = nil
+		}
+		if top == g.part {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// isSplitPart reports whether n is a top-level node that gets a file of
+// its own when the output is split.
+func isSplitPart(n *node) bool {
+	switch n.Which() {
+	case schema.Node_Which_enum, schema.Node_Which_interface:
+		return true
+	case schema.Node_Which_structNode:
+		return !n.StructNode().IsGroup()
+	}
+	return false
+}
+
+// splitParts returns the top-level nodes of the file f that get a file
+// of their own, in the order of their declarations or, if sorted is
+// true, by name.  It returns an error if two of the files would have
+// names that differ only in case.
+func splitParts(f *node, sorted bool) ([]*node, error) {
+	var parts []*node
+	seen := make(map[string]*node)
+	for _, n := range f.nodes {
+		if n.ScopeId() != f.Id() || !isSplitPart(n) {
+			continue
+		}
+		key := strings.ToLower(n.Name)
+		if prev := seen[key]; prev != nil {
+			return nil, fmt.Errorf("split output: %s and %s would be written to the same file", prev, n)
+		}
+		seen[key] = n
+		parts = append(parts, n)
+	}
+	if sorted {
+		parts = sortedNodes(parts)
+	}
+	return parts, nil
+}
+
+// partFileName returns the name of the file that the declarations of
+// the top-level node n of the schema file fname are written to.
+func partFileName(fname string, n *node) string {
+	return fname + "." + strings.ToLower(n.Name) + ".go"
+}
+
+// removeStaleParts removes the files that an earlier run wrote for
+// top-level nodes of the schema file fname that no longer exist.  Only
+// files that capnpc-go generated and that are not in keep are removed.
+func removeStaleParts(fname string, keep []string) error {
+	dir, base := filepath.Split(fname)
+	entries, err := os.ReadDir(filepath.Join(dir, "."))
+	if err != nil {
+		return err
+	}
+	kept := make(map[string]bool, len(keep))
+	for _, name := range keep {
+		kept[name] = true
+	}
+	for _, e := range entries {
+		// The name of the type is a Go identifier, which keeps the
+		// output of other schema files, such as base.bar.capnp.go, out.
+		if e.IsDir() || e.Name() == base+".go" || !strings.HasPrefix(e.Name(), base+".") || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		typ := strings.TrimSuffix(strings.TrimPrefix(e.Name(), base+"."), ".go")
+		if typ == "" || strings.Contains(typ, ".") {
+			continue
+		}
+		name := dir + e.Name()
+		if kept[name] || !isGenerated(name) {
+			continue
+		}
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isGenerated reports whether the file name starts with the header of
+// the files that capnpc-go writes.
+func isGenerated(name string) bool {
+	f, err := os.Open(name)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	line, _ := bufio.NewReader(f).ReadString('\n')
+	return strings.TrimSpace(line) == generatedHeader
+}
+
+// partsExist reports whether the files for the top-level nodes of the
+// schema file f, named fname, all exist.
+func partsExist(fname string, f *node, sorted bool) bool {
+	parts, err := splitParts(f, sorted)
+	if err != nil {
+		return false
+	}
+	for _, n := range parts {
+		if _, err := os.Stat(partFileName(fname, n)); err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -37,6 +37,7 @@ func (p structTypesParams) IsBase() bool {
 type baseStructFuncsParams struct {
 	G            *generator
 	Node         *node
+	Annotations  *annotations
 	StringMethod bool
 }
 
@@ -52,6 +53,14 @@ type structGroupParams struct {
 	Field field
 }
 
+type structFlattenedWhichParams struct {
+	G     *generator
+	Node  *node
+	Group *node
+	Field field
+	Which string
+}
+
 type structFieldParams struct {
 	G           *generator
 	Node        *node
@@ -61,11 +70,11 @@ type structFieldParams struct {
 }
 
 type (
+	structParamFieldParams      structFieldParams
 	structFloatFieldParams      structUintFieldParams
 	structInterfaceFieldParams  structFieldParams
 	structCapabilityFieldParams structFieldParams
 	structVoidFieldParams       structFieldParams
-	structListFieldParams       structObjectFieldParams
 	structPointerFieldParams    structObjectFieldParams
 	structStructFieldParams     structObjectFieldParams
 	structAnyStructFieldParams  structObjectFieldParams
@@ -119,6 +128,16 @@ type structObjectFieldParams struct {
 	Default  staticDataRef
 }
 
+type structListFieldParams struct {
+	structObjectFieldParams
+
+	// ElemType is the Go type of the list's elements and FromSlice
+	// the capnp function that makes a list from a slice of them, for
+	// lists that can be made from slices.
+	ElemType  string
+	FromSlice string
+}
+
 type structListParams struct {
 	G            *generator
 	Node         *node
@@ -132,6 +151,19 @@ type structEnumsParams struct {
 	EnumString enumString
 }
 
+type structVisitorParams struct {
+	G       *generator
+	Node    *node
+	Members []unionMember
+	Path    string
+}
+
+type structValidateParams struct {
+	G      *generator
+	Node   *node
+	Fields []validatedField
+}
+
 type promiseParams struct {
 	G      *generator
 	Node   *node
@@ -183,6 +215,7 @@ type interfaceClientParams struct {
 	Node        *node
 	Annotations *annotations
 	Methods     []interfaceMethod
+	Supers      []interfaceSuper
 }
 
 type interfaceServerParams struct {
@@ -190,12 +223,12 @@ type interfaceServerParams struct {
 	Node        *node
 	Annotations *annotations
 	Methods     []interfaceMethod
+	Supers      []interfaceSuper
 }
 
 type structValueParams struct {
 	G     *generator
-	Node  *node
-	Typ   *node
+	Typ   string
 	Value staticDataRef
 }
 
@@ -210,11 +243,20 @@ type listValueParams struct {
 	Value staticDataRef
 }
 
+type typeNamesParams struct {
+	G     *generator
+	Nodes []*node
+}
+
 type schemaVarParams struct {
 	G       *generator
 	FileID  uint64
 	NodeIDs []uint64
 	schema  []byte
+
+	// EmbedFile is the name of the file that holds the schema, if it
+	// is loaded with go:embed.
+	EmbedFile string
 }
 
 func (p schemaVarParams) SchemaLiteral() string {
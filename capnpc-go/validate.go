@@ -0,0 +1,303 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// A validatedField is a field that a struct's Validate method checks.
+type validatedField struct {
+	Field field
+	Path  string // Go string literal naming the field, as fieldPath
+
+	// Kind is "number", "text", "data", "list", "struct", "pointer"
+	// or "group", after how the field's value is read and checked.
+	Kind string
+
+	// Which is the discriminant constant of the field if it is a union
+	// member, whose checks are only made when the member is set.
+	Which string
+
+	// Min and Max are Go constants that bound the field's value, or
+	// for text, data and lists, their length.  They are empty if the
+	// field has no bound.  MinReason and MaxReason are Go string
+	// literals that describe a value out of bounds.
+	Min, Max             string
+	MinReason, MaxReason string
+
+	// Regex names the package-level variable that holds the compiled
+	// $regex of a Text field, and RegexReason describes a mismatch.
+	Regex       string
+	RegexSrc    string
+	RegexReason string
+
+	Required bool
+
+	// Nested is set if the field's value, or each element of a list,
+	// is a struct with a Validate method of its own.  NestedType is a
+	// Go string literal naming that struct, as its errors do.
+	Nested     bool
+	NestedType string
+}
+
+// validated returns the IDs of the struct nodes that get a Validate
+// method: those with fields that have validation annotations, and
+// those with fields whose values are structs or lists of structs that
+// have Validate methods.  Structs with an accessor named Validate get
+// no method.
+func (g *generator) validated() map[uint64]bool {
+	if g.validatedNodes != nil {
+		return g.validatedNodes
+	}
+	m := make(map[uint64]bool)
+	var candidates []*node
+	for id, n := range g.nodes {
+		if n.Which() != schema.Node_Which_structNode || g.hasAccessor(n, "Validate") {
+			continue
+		}
+		direct := false
+		for _, f := range n.codeOrderFields() {
+			fann, _ := f.Annotations()
+			if parseAnnotations(fann).validates() {
+				direct = true
+				break
+			}
+		}
+		if direct {
+			m[id] = true
+		} else {
+			candidates = append(candidates, n)
+		}
+	}
+	// Structs that nest validated structs, at any depth, also get a
+	// Validate method.  Recursive types make this a fixed point.
+	for changed := true; changed; {
+		changed = false
+		for _, n := range candidates {
+			if m[n.Id()] {
+				continue
+			}
+			for _, f := range n.codeOrderFields() {
+				if id, ok := nestedStruct(f); ok && m[id] {
+					m[n.Id()] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	g.validatedNodes = m
+	return m
+}
+
+// nestedStruct returns the ID of the struct that is the value of the
+// field f: a group, a struct or the elements of a list.
+func nestedStruct(f field) (uint64, bool) {
+	if f.Which() == schema.Field_Which_group {
+		return f.Group().TypeId(), true
+	}
+	t, err := f.Slot().Type()
+	if err != nil {
+		return 0, false
+	}
+	if t.Which() == schema.Type_Which_list {
+		if t, err = t.List().ElementType(); err != nil {
+			return 0, false
+		}
+	}
+	if t.Which() == schema.Type_Which_structType {
+		return t.StructType().TypeId(), true
+	}
+	return 0, false
+}
+
+// defineValidate generates the Validate method of the struct n and of
+// its groups, if they get one.
+func (g *generator) defineValidate(n *node) error {
+	for _, f := range n.codeOrderFields() {
+		if f.Which() != schema.Field_Which_group {
+			continue
+		}
+		grp, err := g.nodes.mustFind(f.Group().TypeId())
+		if err != nil {
+			return err
+		}
+		if err := g.defineValidate(grp); err != nil {
+			return err
+		}
+	}
+	if !g.validated()[n.Id()] {
+		return nil
+	}
+	var fields []validatedField
+	for i, f := range n.codeOrderFields() {
+		vf, ok, err := g.validatedField(n, f, i)
+		if err != nil {
+			name, _ := f.Field.Name()
+			return fmt.Errorf("validation of %s.%s: %v", n.Name, name, err)
+		}
+		if ok {
+			fields = append(fields, vf)
+		}
+	}
+	return g.r.Render(structValidateParams{
+		G:      g,
+		Node:   n,
+		Fields: fields,
+	})
+}
+
+// validatedField returns the checks of the i'th field of n in code
+// order, or false if there are none.
+func (g *generator) validatedField(n *node, f field, i int) (vf validatedField, ok bool, err error) {
+	fann, _ := f.Annotations()
+	ann := parseAnnotations(fann)
+	vf = validatedField{
+		Field:    f,
+		Path:     fieldPath(n, f),
+		Required: ann.Required,
+	}
+	if f.HasDiscriminant() {
+		vf.Which = n.Name + "_Which_" + f.Name
+	}
+	if id, ok := nestedStruct(f); ok && g.validated()[id] {
+		vf.Nested = true
+		vf.NestedType = unionPath(g.nodes[id])
+	}
+
+	if f.Which() == schema.Field_Which_group {
+		if ann.validates() {
+			return validatedField{}, false, fmt.Errorf("validation annotations do not apply to groups")
+		}
+		vf.Kind = "group"
+		return vf, vf.Nested, nil
+	}
+	t, err := f.Slot().Type()
+	if err != nil {
+		return validatedField{}, false, err
+	}
+	var (
+		lo, hi   float64 // the range of the field's values
+		integral bool
+	)
+	switch t.Which() {
+	case schema.Type_Which_int8, schema.Type_Which_int16, schema.Type_Which_int32, schema.Type_Which_int64:
+		bits := intBits(t.Which())
+		vf.Kind, integral = "number", true
+		lo, hi = -math.Exp2(float64(bits-1)), math.Exp2(float64(bits-1))-1
+	case schema.Type_Which_uint8, schema.Type_Which_uint16, schema.Type_Which_uint32, schema.Type_Which_uint64:
+		bits := intBits(t.Which())
+		vf.Kind, integral = "number", true
+		lo, hi = 0, math.Exp2(float64(bits))-1
+	case schema.Type_Which_float32:
+		vf.Kind = "number"
+		lo, hi = -math.MaxFloat32, math.MaxFloat32
+	case schema.Type_Which_float64:
+		vf.Kind = "number"
+		lo, hi = -math.MaxFloat64, math.MaxFloat64
+	case schema.Type_Which_text:
+		vf.Kind, integral = "text", true
+		lo, hi = 0, math.MaxInt32
+	case schema.Type_Which_data:
+		vf.Kind, integral = "data", true
+		lo, hi = 0, math.MaxInt32
+	case schema.Type_Which_list:
+		vf.Kind, integral = "list", true
+		lo, hi = 0, math.MaxInt32
+	case schema.Type_Which_structType:
+		vf.Kind = "struct"
+	case schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		vf.Kind = "pointer"
+	}
+
+	if vf.Kind == "" || vf.Kind == "number" {
+		if ann.Required {
+			return validatedField{}, false, fmt.Errorf("$required only applies to pointer fields")
+		}
+	}
+	if ann.Min != nil || ann.Max != nil {
+		if lo == 0 && hi == 0 {
+			return validatedField{}, false, fmt.Errorf("$min and $max do not apply to %v fields", t.Which())
+		}
+		if ann.Min != nil && ann.Max != nil && *ann.Min > *ann.Max {
+			return validatedField{}, false, fmt.Errorf("$min %v is greater than $max %v", *ann.Min, *ann.Max)
+		}
+	}
+	below, above := "less than ", "greater than "
+	if vf.Kind != "number" {
+		below, above = "shorter than ", "longer than "
+	}
+	// Integer bounds that every value satisfies are left out, so that
+	// the generated code does not compare against constants that
+	// overflow the field's type.
+	if ann.Min != nil {
+		if vf.Min, err = boundConst(*ann.Min, lo, hi, integral); err != nil {
+			return validatedField{}, false, fmt.Errorf("$min: %v", err)
+		}
+		if integral && *ann.Min <= lo {
+			vf.Min = ""
+		} else {
+			vf.MinReason = strconv.Quote(below + vf.Min)
+		}
+	}
+	if ann.Max != nil {
+		if vf.Max, err = boundConst(*ann.Max, lo, hi, integral); err != nil {
+			return validatedField{}, false, fmt.Errorf("$max: %v", err)
+		}
+		if integral && *ann.Max >= hi {
+			vf.Max = ""
+		} else {
+			vf.MaxReason = strconv.Quote(above + vf.Max)
+		}
+	}
+	if ann.Regex != "" {
+		if vf.Kind != "text" {
+			return validatedField{}, false, fmt.Errorf("$regex only applies to Text fields")
+		}
+		if _, err := regexp.Compile(ann.Regex); err != nil {
+			return validatedField{}, false, fmt.Errorf("$regex: %v", err)
+		}
+		vf.Regex = fmt.Sprintf("x_%x_regex%d", n.Id(), i)
+		vf.RegexSrc = "`" + ann.Regex + "`"
+		if strings.Contains(ann.Regex, "`") {
+			vf.RegexSrc = strconv.Quote(ann.Regex)
+		}
+		vf.RegexReason = strconv.Quote("does not match " + ann.Regex)
+	}
+	ok = vf.Min != "" || vf.Max != "" || vf.Regex != "" || vf.Required || vf.Nested
+	return vf, ok, nil
+}
+
+// intBits returns the size in bits of an integer type.
+func intBits(w schema.Type_Which) int {
+	switch w {
+	case schema.Type_Which_int8, schema.Type_Which_uint8:
+		return 8
+	case schema.Type_Which_int16, schema.Type_Which_uint16:
+		return 16
+	case schema.Type_Which_int32, schema.Type_Which_uint32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+// boundConst returns v as a Go constant for a bound on values in the
+// range [lo, hi], which are integers if integral is set.
+func boundConst(v, lo, hi float64, integral bool) (string, error) {
+	if math.IsNaN(v) || v < lo || v > hi {
+		return "", fmt.Errorf("%v is out of range", v)
+	}
+	if integral {
+		if v != math.Trunc(v) {
+			return "", fmt.Errorf("%v is not an integer", v)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64), nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// A unionMember is a member of a union as the union's visitor sees it.
+type unionMember struct {
+	Field field
+
+	// Method is the name of the visitor's method for the member.
+	Method string
+
+	// Type is the Go type of the member's value, which is passed to
+	// Method, or empty for Void members.  Fallible is true if the
+	// member's getter returns an error.
+	Type     string
+	Fallible bool
+}
+
+// defineVisitor generates the visitor interface and Visit method for
+// the union of n, whose members are given.  It generates nothing if
+// their names would collide with other declarations.
+func (g *generator) defineVisitor(n *node, members []field) error {
+	if g.visitorCollides(n) {
+		return nil
+	}
+	ms := make([]unionMember, len(members))
+	for i, f := range members {
+		typ, fallible, err := g.visitorArg(n, f)
+		if err != nil {
+			return err
+		}
+		ms[i] = unionMember{
+			Field:    f,
+			Method:   "Visit" + f.Getter,
+			Type:     typ,
+			Fallible: fallible,
+		}
+	}
+	return g.r.Render(structVisitorParams{
+		G:       g,
+		Node:    n,
+		Members: ms,
+		Path:    unionPath(n),
+	})
+}
+
+// visitorCollides reports whether the Visit method or the visitor
+// interface of n's union would have the same name as an accessor of n
+// or another type in n's package.
+func (g *generator) visitorCollides(n *node) bool {
+	if g.hasAccessor(n, "Visit") {
+		return true
+	}
+	visitor := n.Name + "_Visitor"
+	for _, other := range g.nodes {
+		if other.pkg == n.pkg && other.Name == visitor {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAccessor reports whether the struct n has a field accessor with
+// the given name, including the accessors of its flattened unions.
+func (g *generator) hasAccessor(n *node, name string) bool {
+	for _, f := range n.codeOrderFields() {
+		for _, a := range accessorNames(f) {
+			if a == name {
+				return true
+			}
+		}
+		if f.Which() != schema.Field_Which_group {
+			continue
+		}
+		fann, _ := f.Annotations()
+		if ann := parseAnnotations(fann); !ann.Flatten {
+			continue
+		}
+		grp := g.nodes[f.Group().TypeId()]
+		if grp == nil {
+			continue
+		}
+		for _, gf := range grp.codeOrderFields() {
+			for _, a := range accessorNames(gf) {
+				if a == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// visitorArg returns the type of the value that the getter of the
+// union member f of n returns, and whether the getter also returns an
+// error.  The type is empty if f is Void, which has no getter.
+func (g *generator) visitorArg(n *node, f field) (typ string, fallible bool, err error) {
+	if f.Which() == schema.Field_Which_group {
+		grp, err := g.nodes.mustFind(f.Group().TypeId())
+		if err != nil {
+			return "", false, err
+		}
+		args, err := g.TypeArgs(grp)
+		return grp.Name + args, false, err
+	}
+	t, err := f.Slot().Type()
+	if err != nil {
+		return "", false, err
+	}
+	switch t.Which() {
+	case schema.Type_Which_void:
+		return "", false, nil
+	case schema.Type_Which_text:
+		return "string", true, nil
+	case schema.Type_Which_data:
+		return "[]byte", true, nil
+	case schema.Type_Which_structType, schema.Type_Which_list:
+		typ, err := g.RemoteTypeName(t, n)
+		return typ, true, err
+	case schema.Type_Which_anyPointer:
+		return g.anyPointerVisitorArg(t, n)
+	default:
+		// Primitives, enums and interfaces.
+		typ, err := g.RemoteTypeName(t, n)
+		return typ, false, err
+	}
+}
+
+// anyPointerVisitorArg is visitorArg for AnyPointer fields, whose
+// getters depend on the kind of pointer as in anyPointer.Render.
+func (g *generator) anyPointerVisitorArg(t schema.Type, n *node) (typ string, fallible bool, err error) {
+	capnpName := g.imports.Capnp()
+	switch ap := t.AnyPointer(); ap.Which() {
+	case schema.Type_anyPointer_Which_unconstrained:
+		switch ap.Unconstrained().Which() {
+		case schema.Type_anyPointer_unconstrained_Which_struct:
+			return capnpName + ".Struct", true, nil
+		case schema.Type_anyPointer_unconstrained_Which_list:
+			return capnpName + ".List", true, nil
+		case schema.Type_anyPointer_unconstrained_Which_capability:
+			typ, err := g.RemoteTypeName(t, n)
+			return typ, false, err
+		}
+	case schema.Type_anyPointer_Which_parameter, schema.Type_anyPointer_Which_implicitMethodParameter:
+		if _, ok, err := g.paramTypeName(t, n); err != nil {
+			return "", false, err
+		} else if ok {
+			typ, err := g.RemoteTypeName(t, n)
+			return typ, true, err
+		}
+	}
+	return capnpName + ".Ptr", true, nil
+}
+
+// unionPath returns a Go string literal naming the union of n for error
+// messages, like fieldPath.
+func unionPath(n *node) string {
+	dn := displayName(n)
+	if i := strings.IndexByte(dn, ':'); i >= 0 {
+		dn = dn[i+1:]
+	}
+	return strconv.Quote(dn)
+}
@@ -0,0 +1,66 @@
+// Package capnptest provides helpers for testing code that uses
+// Cap'n Proto.
+package capnptest
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+var leaks struct {
+	once sync.Once
+	mu   sync.Mutex
+	msgs []string
+}
+
+func recordLeak(msg string) {
+	leaks.mu.Lock()
+	defer leaks.mu.Unlock()
+	leaks.msgs = append(leaks.msgs, msg)
+}
+
+// VerifyNoLeaks reports an error at the end of the test if any
+// capnp.Client, ClientSnapshot or Answer created during the test is
+// garbage collected without being released.  Call it at the start of
+// the test:
+//
+//	func TestFoo(t *testing.T) {
+//		capnptest.VerifyNoLeaks(t)
+//		...
+//	}
+//
+// VerifyNoLeaks enables leak tracking with capnp.SetClientLeakFunc,
+// replacing any callback set by the program, and leaves it enabled.
+// Leaks are found by running the garbage collector when the test
+// finishes, so objects that are still reachable then are not
+// reported.  Leaks from tests that run in parallel with the test may
+// be reported as well.
+func VerifyNoLeaks(t testing.TB) {
+	t.Helper()
+	leaks.once.Do(func() {
+		capnp.SetClientLeakFunc(recordLeak)
+	})
+	leaks.mu.Lock()
+	start := len(leaks.msgs)
+	leaks.mu.Unlock()
+
+	t.Cleanup(func() {
+		// Finalizers run on a separate goroutine after the collection,
+		// and objects referenced by finalized objects are only freed
+		// by the next one, so collect a few times.
+		for i := 0; i < 5; i++ {
+			runtime.GC()
+			time.Sleep(10 * time.Millisecond)
+		}
+		leaks.mu.Lock()
+		msgs := append([]string(nil), leaks.msgs[start:]...)
+		leaks.mu.Unlock()
+		for _, msg := range msgs {
+			t.Errorf("capnp: %s", msg)
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package capnptest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// fakeTB records the errors reported by VerifyNoLeaks.
+type fakeTB struct {
+	testing.TB
+	errors  []string
+	cleanup []func()
+}
+
+func (tb *fakeTB) Helper() {}
+
+func (tb *fakeTB) Errorf(format string, args ...any) {
+	tb.errors = append(tb.errors, fmt.Sprintf(format, args...))
+}
+
+func (tb *fakeTB) Cleanup(f func()) {
+	tb.cleanup = append(tb.cleanup, f)
+}
+
+func (tb *fakeTB) finish() {
+	for i := len(tb.cleanup) - 1; i >= 0; i-- {
+		tb.cleanup[i]()
+	}
+}
+
+// errorHook is a capnp.ClientHook that fails all calls.
+type errorHook struct{}
+
+func (errorHook) Send(_ context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	return capnp.ErrorAnswer(s.Method, errors.New("test")), func() {}
+}
+
+func (errorHook) Recv(_ context.Context, r capnp.Recv) capnp.PipelineCaller {
+	r.Reject(errors.New("test"))
+	return nil
+}
+
+func (errorHook) Brand() capnp.Brand { return capnp.Brand{} }
+func (errorHook) Shutdown()          {}
+func (errorHook) String() string     { return "errorHook" }
+
+func TestVerifyNoLeaks(t *testing.T) {
+	tests := []struct {
+		name string
+		leak string
+		f    func()
+	}{
+		{
+			name: "ReleasedClient",
+			f: func() {
+				c := capnp.NewClient(errorHook{})
+				c.Release()
+			},
+		},
+		{
+			name: "LeakedClient",
+			leak: "leaked client",
+			f: func() {
+				capnp.NewClient(errorHook{})
+			},
+		},
+		{
+			name: "ReleasedAnswer",
+			f: func() {
+				c := capnp.NewClient(errorHook{})
+				defer c.Release()
+				_, release := c.SendCall(context.Background(), capnp.Send{})
+				release()
+			},
+		},
+		{
+			name: "LeakedAnswer",
+			leak: "leaked answer",
+			f: func() {
+				c := capnp.NewClient(errorHook{})
+				defer c.Release()
+				c.SendCall(context.Background(), capnp.Send{})
+			},
+		},
+	}
+	for _, test := range tests {
+		tb := &fakeTB{TB: t}
+		VerifyNoLeaks(tb)
+		test.f()
+		tb.finish()
+
+		if test.leak == "" {
+			if len(tb.errors) > 0 {
+				t.Errorf("%s: reported %q; want no leaks", test.name, tb.errors)
+			}
+			continue
+		}
+		if len(tb.errors) != 1 {
+			t.Errorf("%s: reported %d leaks; want 1", test.name, len(tb.errors))
+			continue
+		}
+		if !strings.Contains(tb.errors[0], test.leak) || !strings.Contains(tb.errors[0], "capnptest.TestVerifyNoLeaks") {
+			t.Errorf("%s: reported %q; want %s with stack trace", test.name, tb.errors[0], test.leak)
+		}
+	}
+}
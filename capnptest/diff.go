@@ -0,0 +1,316 @@
+package capnptest
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/dynamic"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+// Options controls how Diff and Equal compare structs.  A nil *Options
+// is the same as the zero value.
+type Options struct {
+	// IgnoreDefaults treats a null struct or list field as equal to
+	// one that is set to the field's default value: a struct whose
+	// fields all have their default values, or an empty list.
+	IgnoreDefaults bool
+
+	// Registry is consulted for schemas instead of the default
+	// registry, if not nil.
+	Registry *schemas.Registry
+}
+
+// Diff compares a and b, structs of the type with the given ID, field
+// by field and returns a human-readable description of the
+// differences, or the empty string if there are none.  Each line of
+// the description names a field by its path from the root and gives
+// the values in a and b:
+//
+//	name: "Alice" != "Bob"
+//	address.zip: 12345 != 54321
+//	phones[1].type: mobile != work
+//
+// Fields are compared by value, so structs of different sizes, such as
+// ones written by different versions of a schema, are equal if the
+// fields that the schema knows about are.  Only the selected member of
+// a union is compared.  Interface fields are equal if they refer to
+// the same capability, and AnyPointer fields if their contents are
+// equal.
+func Diff(typeID uint64, a, b capnp.Struct, opts *Options) (string, error) {
+	d := differ{}
+	if opts != nil {
+		d.ignoreDefaults = opts.IgnoreDefaults
+		if opts.Registry != nil {
+			d.nodes.UseRegistry(opts.Registry)
+			d.conv.UseRegistry(opts.Registry)
+		}
+	}
+	n, err := d.structNode(typeID)
+	if err != nil {
+		return "", exc.WrapError("diff", err)
+	}
+	ma, err := d.conv.ToMap(typeID, a)
+	if err != nil {
+		return "", exc.WrapError("diff", err)
+	}
+	mb, err := d.conv.ToMap(typeID, b)
+	if err != nil {
+		return "", exc.WrapError("diff", err)
+	}
+	if err := d.diffStruct("", n, ma, mb); err != nil {
+		return "", exc.WrapError("diff", err)
+	}
+	return strings.Join(d.lines, "\n"), nil
+}
+
+// Equal reports whether Diff finds no differences between a and b.
+func Equal(typeID uint64, a, b capnp.Struct, opts *Options) (bool, error) {
+	diff, err := Diff(typeID, a, b, opts)
+	return diff == "" && err == nil, err
+}
+
+type differ struct {
+	ignoreDefaults bool
+	nodes          nodemap.Map
+	conv           dynamic.Converter
+	lines          []string
+}
+
+func (d *differ) report(path string, a, b string) {
+	d.lines = append(d.lines, path+": "+a+" != "+b)
+}
+
+func (d *differ) structNode(typeID uint64) (schema.Node, error) {
+	n, err := d.nodes.Find(typeID)
+	if err != nil {
+		return schema.Node{}, err
+	}
+	if n.Which() != schema.Node_Which_structNode {
+		return schema.Node{}, fmt.Errorf("type %s is not a struct", str.UToHex(typeID))
+	}
+	return n, nil
+}
+
+// diffStruct compares the maps that dynamic.Converter produced for two
+// structs of the type n.
+func (d *differ) diffStruct(path string, n schema.Node, a, b map[string]any) error {
+	fields, err := n.StructNode().Fields()
+	if err != nil {
+		return err
+	}
+	if n.StructNode().DiscriminantCount() > 0 {
+		// Only the selected union member is in each map.
+		ua, ub, err := unionMembers(fields, a, b)
+		if err != nil {
+			return err
+		}
+		if ua != ub {
+			d.report(join(path, "which"), ua, ub)
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		name, err := f.Name()
+		if err != nil {
+			return err
+		}
+		va, okA := a[name]
+		vb, okB := b[name]
+		if !okA || !okB {
+			continue
+		}
+		fpath := join(path, name)
+		switch f.Which() {
+		case schema.Field_Which_group:
+			g, err := d.structNode(f.Group().TypeId())
+			if err != nil {
+				return err
+			}
+			err = d.diffStruct(fpath, g, va.(map[string]any), vb.(map[string]any))
+			if err != nil {
+				return err
+			}
+		case schema.Field_Which_slot:
+			typ, err := f.Slot().Type()
+			if err != nil {
+				return err
+			}
+			if err := d.diffValue(fpath, typ, va, vb); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unionMembers returns the names of the union members set in a and b.
+func unionMembers(fields schema.Field_List, a, b map[string]any) (ua, ub string, err error) {
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		if f.DiscriminantValue() == schema.Field_noDiscriminant {
+			continue
+		}
+		name, err := f.Name()
+		if err != nil {
+			return "", "", err
+		}
+		if _, ok := a[name]; ok {
+			ua = name
+		}
+		if _, ok := b[name]; ok {
+			ub = name
+		}
+	}
+	return ua, ub, nil
+}
+
+func (d *differ) diffValue(path string, typ schema.Type, a, b any) error {
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		return nil
+	case schema.Type_Which_float32:
+		fa, fb := a.(float32), b.(float32)
+		if fa != fb && !(math.IsNaN(float64(fa)) && math.IsNaN(float64(fb))) {
+			d.report(path, fmt.Sprint(fa), fmt.Sprint(fb))
+		}
+		return nil
+	case schema.Type_Which_float64:
+		fa, fb := a.(float64), b.(float64)
+		if fa != fb && !(math.IsNaN(fa) && math.IsNaN(fb)) {
+			d.report(path, fmt.Sprint(fa), fmt.Sprint(fb))
+		}
+		return nil
+	case schema.Type_Which_text:
+		if a.(string) != b.(string) {
+			d.report(path, fmt.Sprintf("%q", a), fmt.Sprintf("%q", b))
+		}
+		return nil
+	case schema.Type_Which_data:
+		if !bytes.Equal(a.([]byte), b.([]byte)) {
+			d.report(path, fmt.Sprintf("%q", a), fmt.Sprintf("%q", b))
+		}
+		return nil
+	case schema.Type_Which_structType:
+		return d.diffStructValue(path, typ.StructType().TypeId(), a, b)
+	case schema.Type_Which_list:
+		return d.diffList(path, typ, a, b)
+	case schema.Type_Which_interface:
+		ca, _ := a.(capnp.Client)
+		cb, _ := b.(capnp.Client)
+		if !ca.IsSame(cb) {
+			d.report(path, formatClient(ca), formatClient(cb))
+		}
+		return nil
+	case schema.Type_Which_anyPointer:
+		pa, _ := a.(capnp.Ptr)
+		pb, _ := b.(capnp.Ptr)
+		eq, err := capnp.Equal(pa, pb)
+		if err != nil {
+			return exc.WrapError(path, err)
+		}
+		if !eq {
+			d.report(path, formatPtr(pa), formatPtr(pb))
+		}
+		return nil
+	default:
+		// Bools, integers and enums, which compare with ==.
+		if a != b {
+			d.report(path, fmt.Sprint(a), fmt.Sprint(b))
+		}
+		return nil
+	}
+}
+
+// diffStructValue compares two struct field values, either of which
+// may be nil for a null pointer.  A null struct is compared with the
+// struct's defaults, so that the fields that were set are listed.
+func (d *differ) diffStructValue(path string, typeID uint64, a, b any) error {
+	ma, _ := a.(map[string]any)
+	mb, _ := b.(map[string]any)
+	if ma == nil && mb == nil {
+		return nil
+	}
+	n, err := d.structNode(typeID)
+	if err != nil {
+		return err
+	}
+	if ma == nil || mb == nil {
+		if !d.ignoreDefaults {
+			d.report(path, formatNull(ma == nil), formatNull(mb == nil))
+		}
+		defaults, err := d.conv.ToMap(typeID, capnp.Struct{})
+		if err != nil {
+			return err
+		}
+		if ma == nil {
+			ma = defaults
+		} else {
+			mb = defaults
+		}
+	}
+	return d.diffStruct(path, n, ma, mb)
+}
+
+func (d *differ) diffList(path string, typ schema.Type, a, b any) error {
+	la, _ := a.([]any)
+	lb, _ := b.([]any)
+	if (la == nil) != (lb == nil) && !d.ignoreDefaults {
+		d.report(path, formatNull(la == nil), formatNull(lb == nil))
+		return nil
+	}
+	if len(la) != len(lb) {
+		d.report(path, "length "+str.Itod(len(la)), "length "+str.Itod(len(lb)))
+	}
+	elem, err := typ.List().ElementType()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(la) && i < len(lb); i++ {
+		if err := d.diffValue(path+"["+str.Itod(i)+"]", elem, la[i], lb[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func formatNull(null bool) string {
+	if null {
+		return "null"
+	}
+	return "set"
+}
+
+func formatClient(c capnp.Client) string {
+	if !c.IsValid() {
+		return "null"
+	}
+	return c.String()
+}
+
+func formatPtr(p capnp.Ptr) string {
+	switch {
+	case !p.IsValid():
+		return "null"
+	case p.Struct().IsValid():
+		return "struct"
+	case p.List().IsValid():
+		return "list of " + str.Itod(p.List().Len())
+	default:
+		return "capability"
+	}
+}
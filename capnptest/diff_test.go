@@ -0,0 +1,239 @@
+package capnptest
+
+import (
+	"strings"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+)
+
+const diffSchema = `
+@0xb6a2a3d8c4e1f001;
+
+struct Person @0xc3e4f5a6b7c8d901 {
+  name @0 :Text;
+  age @1 :UInt32;
+  kind @2 :Kind;
+  address @3 :Address;
+  tags @4 :List(Text);
+  contact :union {
+    email @5 :Text;
+    phone @6 :UInt64;
+  }
+}
+
+struct Address {
+  city @0 :Text;
+  zip @1 :UInt32 = 10000;
+}
+
+enum Kind {
+  person @0;
+  robot @1;
+}
+`
+
+const personTypeID = 0xc3e4f5a6b7c8d901
+
+func diffRegistry(t *testing.T) *schemas.Registry {
+	return compileRegistry(t, "diff.capnp", diffSchema)
+}
+
+// compileRegistry returns a registry of the nodes of a schema file.
+func compileRegistry(t *testing.T, name, src string) *schemas.Registry {
+	req, err := compiler.CompileString(nil, name, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := req.Message().Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, err := req.Nodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]uint64, nodes.Len())
+	for i := range ids {
+		ids[i] = nodes.At(i).Id()
+	}
+	reg := new(schemas.Registry)
+	if err := reg.Register(&schemas.Schema{Bytes: b, Nodes: ids}); err != nil {
+		t.Fatal(err)
+	}
+	return reg
+}
+
+// person holds the fields of a Person; zero fields are left unset.
+type person struct {
+	name    string
+	age     uint32
+	kind    uint16
+	city    string
+	zip     uint32
+	address bool // set address even if city and zip are zero
+	tags    []string
+	email   string
+	phone   uint64
+}
+
+func (p person) build(t *testing.T) capnp.Struct {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	s, err := capnp.NewRootStruct(seg, capnp.ObjectSize{DataSize: 24, PointerCount: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.name != "" {
+		if err := s.SetNewText(0, p.name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.SetUint32(0, p.age)
+	s.SetUint16(4, p.kind)
+	if p.address || p.city != "" || p.zip != 0 {
+		a, err := capnp.NewStruct(seg, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.city != "" {
+			if err := a.SetNewText(0, p.city); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if p.zip != 0 {
+			a.SetUint32(0, p.zip^10000)
+		}
+		if err := s.SetPtr(1, a.ToPtr()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p.tags != nil {
+		l, err := capnp.NewTextList(seg, int32(len(p.tags)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, tag := range p.tags {
+			if err := l.Set(i, tag); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := s.SetPtr(2, l.ToPtr()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p.phone != 0 {
+		s.SetUint16(6, 1)
+		s.SetUint64(8, p.phone)
+	} else if p.email != "" {
+		if err := s.SetNewText(3, p.email); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestDiff(t *testing.T) {
+	reg := diffRegistry(t)
+	alice := person{name: "Alice", age: 30, city: "Paris", zip: 75001, tags: []string{"a", "b"}, email: "a@example.com"}
+	tests := []struct {
+		name           string
+		a, b           person
+		ignoreDefaults bool
+		want           []string
+	}{
+		{
+			name: "Equal",
+			a:    alice,
+			b:    alice,
+		},
+		{
+			name: "Empty",
+		},
+		{
+			name: "Fields",
+			a:    alice,
+			b:    person{name: "Bob", age: 30, kind: 1, city: "Paris", zip: 10001, tags: []string{"a", "c", "d"}, email: "a@example.com"},
+			want: []string{
+				`name: "Alice" != "Bob"`,
+				`kind: person != robot`,
+				`address.zip: 75001 != 10001`,
+				`tags: length 2 != length 3`,
+				`tags[1]: "b" != "c"`,
+			},
+		},
+		{
+			name: "Union",
+			a:    person{email: "a@example.com"},
+			b:    person{phone: 5551234},
+			want: []string{`contact.which: email != phone`},
+		},
+		{
+			name: "NullStruct",
+			a:    person{},
+			b:    person{city: "Paris"},
+			want: []string{
+				`address: null != set`,
+				`address.city: "" != "Paris"`,
+			},
+		},
+		{
+			name: "DefaultStruct",
+			a:    person{},
+			b:    person{address: true},
+			want: []string{`address: null != set`},
+		},
+		{
+			name:           "IgnoreDefaultStruct",
+			a:              person{},
+			b:              person{address: true},
+			ignoreDefaults: true,
+		},
+		{
+			name:           "IgnoreDefaultsStillCompares",
+			a:              person{},
+			b:              person{zip: 12345},
+			ignoreDefaults: true,
+			want:           []string{`address.zip: 10000 != 12345`},
+		},
+		{
+			name: "EmptyList",
+			a:    person{},
+			b:    person{tags: []string{}},
+			want: []string{`tags: null != set`},
+		},
+		{
+			name:           "IgnoreEmptyList",
+			a:              person{},
+			b:              person{tags: []string{}},
+			ignoreDefaults: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &Options{IgnoreDefaults: test.ignoreDefaults, Registry: reg}
+			got, err := Diff(personTypeID, test.a.build(t), test.b.build(t), opts)
+			if err != nil {
+				t.Fatal("Diff:", err)
+			}
+			if want := strings.Join(test.want, "\n"); got != want {
+				t.Errorf("Diff = %q; want %q", got, want)
+			}
+			eq, err := Equal(personTypeID, test.a.build(t), test.b.build(t), opts)
+			if err != nil {
+				t.Fatal("Equal:", err)
+			}
+			if want := len(test.want) == 0; eq != want {
+				t.Errorf("Equal = %t; want %t", eq, want)
+			}
+		})
+	}
+}
+
+func TestDiffUnknownType(t *testing.T) {
+	_, err := Diff(0x1234, capnp.Struct{}, capnp.Struct{}, &Options{Registry: new(schemas.Registry)})
+	if err == nil {
+		t.Error("Diff of an unknown type succeeded")
+	}
+}
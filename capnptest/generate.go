@@ -0,0 +1,419 @@
+package capnptest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/dynamic"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+// Constraints bound the messages that Generate builds.  A nil
+// *Constraints is the same as the zero value, and zero fields take
+// their defaults.
+type Constraints struct {
+	// MaxDepth is the greatest number of structs and lists that are
+	// nested below the root struct.  Struct and list fields deeper
+	// than that are null, so that recursive types produce finite
+	// messages.  The default is 4.
+	MaxDepth int
+
+	// MaxListLen is the greatest number of elements in a list.  The
+	// default is 4.
+	MaxListLen int
+
+	// MaxTextLen is the greatest number of characters in a Text field
+	// and of bytes in a Data field.  The default is 16.
+	MaxTextLen int
+
+	// Registry is consulted for schemas instead of the default
+	// registry, if not nil.
+	Registry *schemas.Registry
+}
+
+// Generate returns the root struct of a new message that holds a
+// random struct of the type with the given ID, for property-based
+// tests and for seeding fuzzing corpora.  The struct is valid for its
+// schema: each union has one of its members set, enums hold one of
+// their enumerants, and Text is valid UTF-8.  Pointer fields are
+// sometimes null, and interface and AnyPointer fields always are.
+//
+// Generate draws all of its randomness from r, so the same seed
+// generates the same message.
+func Generate(typeID uint64, r *rand.Rand, c *Constraints) (capnp.Struct, error) {
+	g := &generator{
+		r:          r,
+		maxDepth:   4,
+		maxListLen: 4,
+		maxTextLen: 16,
+	}
+	if c != nil {
+		if c.MaxDepth > 0 {
+			g.maxDepth = c.MaxDepth
+		}
+		if c.MaxListLen > 0 {
+			g.maxListLen = c.MaxListLen
+		}
+		if c.MaxTextLen > 0 {
+			g.maxTextLen = c.MaxTextLen
+		}
+		if c.Registry != nil {
+			g.nodes.UseRegistry(c.Registry)
+			g.conv.UseRegistry(c.Registry)
+		}
+	}
+	n, err := g.structNode(typeID)
+	if err != nil {
+		return capnp.Struct{}, exc.WrapError("generate", err)
+	}
+	_, seg, err := capnp.NewMessage(capnp.MultiSegment(nil))
+	if err != nil {
+		return capnp.Struct{}, exc.WrapError("generate", err)
+	}
+	s, err := capnp.NewRootStruct(seg, structSize(n))
+	if err != nil {
+		return capnp.Struct{}, exc.WrapError("generate", err)
+	}
+	if err := g.fill(n, s, 0); err != nil {
+		return capnp.Struct{}, exc.WrapError("generate", err)
+	}
+	return s, nil
+}
+
+type generator struct {
+	r          *rand.Rand
+	maxDepth   int
+	maxListLen int
+	maxTextLen int
+	nodes      nodemap.Map
+	conv       dynamic.Converter
+}
+
+func (g *generator) structNode(typeID uint64) (schema.Node, error) {
+	n, err := g.nodes.Find(typeID)
+	if err != nil {
+		return schema.Node{}, err
+	}
+	if n.Which() != schema.Node_Which_structNode {
+		return schema.Node{}, fmt.Errorf("type %s is not a struct", str.UToHex(typeID))
+	}
+	return n, nil
+}
+
+// fill sets the fields of s, a struct of the type n that is depth
+// levels below the root.  Fields are set in schema order, so that the
+// layout of the message only depends on the random source.
+func (g *generator) fill(n schema.Node, s capnp.Struct, depth int) error {
+	fields, err := n.StructNode().Fields()
+	if err != nil {
+		return err
+	}
+	var members []schema.Field
+	for i := 0; i < fields.Len(); i++ {
+		if f := fields.At(i); f.DiscriminantValue() != schema.Field_noDiscriminant {
+			members = append(members, f)
+		}
+	}
+	var member schema.Field
+	if len(members) > 0 {
+		member = members[g.r.Intn(len(members))]
+	}
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		if f.DiscriminantValue() != schema.Field_noDiscriminant && f.DiscriminantValue() != member.DiscriminantValue() {
+			continue
+		}
+		name, err := f.Name()
+		if err != nil {
+			return err
+		}
+		if err := g.field(n, s, f, name, depth); err != nil {
+			return exc.WrapError("field "+name, err)
+		}
+	}
+	return nil
+}
+
+func (g *generator) field(n schema.Node, s capnp.Struct, f schema.Field, name string, depth int) error {
+	if f.Which() == schema.Field_Which_group {
+		grp, err := g.structNode(f.Group().TypeId())
+		if err != nil {
+			return err
+		}
+		if f.DiscriminantValue() != schema.Field_noDiscriminant {
+			s.SetUint16(capnp.DataOffset(n.StructNode().DiscriminantOffset()*2), f.DiscriminantValue())
+		}
+		return g.fill(grp, s, depth)
+	}
+	typ, err := f.Slot().Type()
+	if err != nil {
+		return err
+	}
+	if !isPointer(typ.Which()) {
+		// The converter stores the value relative to the field's
+		// default and selects the field if it is a union member.
+		v, err := g.scalar(typ)
+		if err != nil {
+			return err
+		}
+		return g.conv.FromMap(n.Id(), s, map[string]any{name: v})
+	}
+	if f.DiscriminantValue() != schema.Field_noDiscriminant {
+		s.SetUint16(capnp.DataOffset(n.StructNode().DiscriminantOffset()*2), f.DiscriminantValue())
+	}
+	p, err := g.ptr(s.Segment(), typ, depth+1)
+	if err != nil {
+		return err
+	}
+	return s.SetPtr(uint16(f.Slot().Offset()), p)
+}
+
+// ptr returns a new random value of a pointer type at the given depth,
+// or a null pointer.
+func (g *generator) ptr(seg *capnp.Segment, typ schema.Type, depth int) (capnp.Ptr, error) {
+	if g.r.Intn(8) == 0 {
+		return capnp.Ptr{}, nil
+	}
+	switch typ.Which() {
+	case schema.Type_Which_text:
+		t := g.text()
+		if t == "" {
+			return capnp.Ptr{}, nil
+		}
+		p, err := capnp.NewText(seg, t)
+		return p.ToPtr(), err
+	case schema.Type_Which_data:
+		b := make([]byte, g.r.Intn(g.maxTextLen+1))
+		g.r.Read(b)
+		if len(b) == 0 {
+			return capnp.Ptr{}, nil
+		}
+		p, err := capnp.NewData(seg, b)
+		return p.ToPtr(), err
+	case schema.Type_Which_structType:
+		if depth > g.maxDepth {
+			return capnp.Ptr{}, nil
+		}
+		n, err := g.structNode(typ.StructType().TypeId())
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		s, err := capnp.NewStruct(seg, structSize(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		return s.ToPtr(), g.fill(n, s, depth)
+	case schema.Type_Which_list:
+		if depth > g.maxDepth {
+			return capnp.Ptr{}, nil
+		}
+		elem, err := typ.List().ElementType()
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		return g.list(seg, elem, depth)
+	default:
+		// Interfaces and AnyPointers.
+		return capnp.Ptr{}, nil
+	}
+}
+
+// list returns a new random list with elements of type elem at the
+// given depth.
+func (g *generator) list(seg *capnp.Segment, elem schema.Type, depth int) (capnp.Ptr, error) {
+	n := g.r.Intn(g.maxListLen + 1)
+	switch elem.Which() {
+	case schema.Type_Which_structType:
+		sn, err := g.structNode(elem.StructType().TypeId())
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		l, err := capnp.NewCompositeList(seg, structSize(sn), int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := 0; i < n; i++ {
+			if err := g.fill(sn, l.Struct(i), depth); err != nil {
+				return capnp.Ptr{}, exc.WrapError("element "+str.Itod(i), err)
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_list,
+		schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		l, err := capnp.NewPointerList(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := 0; i < n; i++ {
+			p, err := g.ptr(seg, elem, depth+1)
+			if err == nil {
+				err = l.Set(i, p)
+			}
+			if err != nil {
+				return capnp.Ptr{}, exc.WrapError("element "+str.Itod(i), err)
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_void:
+		return capnp.NewVoidList(seg, int32(n)).ToPtr(), nil
+	case schema.Type_Which_bool:
+		l, err := capnp.NewBitList(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := 0; i < n; i++ {
+			l.Set(i, g.r.Intn(2) == 1)
+		}
+		return l.ToPtr(), nil
+	}
+
+	// Lists of numbers and enums hold the bits of their values.
+	bits := make([]uint64, n)
+	for i := range bits {
+		v, err := g.scalar(elem)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		bits[i] = scalarBits(v)
+	}
+	switch elem.Which() {
+	case schema.Type_Which_int8, schema.Type_Which_uint8:
+		l, err := capnp.NewUInt8List(seg, int32(n))
+		for i := 0; err == nil && i < n; i++ {
+			l.Set(i, uint8(bits[i]))
+		}
+		return l.ToPtr(), err
+	case schema.Type_Which_int16, schema.Type_Which_uint16, schema.Type_Which_enum:
+		l, err := capnp.NewUInt16List(seg, int32(n))
+		for i := 0; err == nil && i < n; i++ {
+			l.Set(i, uint16(bits[i]))
+		}
+		return l.ToPtr(), err
+	case schema.Type_Which_int32, schema.Type_Which_uint32, schema.Type_Which_float32:
+		l, err := capnp.NewUInt32List(seg, int32(n))
+		for i := 0; err == nil && i < n; i++ {
+			l.Set(i, uint32(bits[i]))
+		}
+		return l.ToPtr(), err
+	default:
+		l, err := capnp.NewUInt64List(seg, int32(n))
+		for i := 0; err == nil && i < n; i++ {
+			l.Set(i, bits[i])
+		}
+		return l.ToPtr(), err
+	}
+}
+
+// scalar returns a random value of a non-pointer type, of the Go type
+// that dynamic.Converter uses for it, except that enums are numbers.
+func (g *generator) scalar(typ schema.Type) (any, error) {
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		return nil, nil
+	case schema.Type_Which_bool:
+		return g.r.Intn(2) == 1, nil
+	case schema.Type_Which_int8:
+		return int8(g.r.Uint64()), nil
+	case schema.Type_Which_int16:
+		return int16(g.r.Uint64()), nil
+	case schema.Type_Which_int32:
+		return int32(g.r.Uint64()), nil
+	case schema.Type_Which_int64:
+		return int64(g.r.Uint64()), nil
+	case schema.Type_Which_uint8:
+		return uint8(g.r.Uint64()), nil
+	case schema.Type_Which_uint16:
+		return uint16(g.r.Uint64()), nil
+	case schema.Type_Which_uint32:
+		return g.r.Uint32(), nil
+	case schema.Type_Which_uint64:
+		return g.r.Uint64(), nil
+	case schema.Type_Which_float32:
+		return float32(g.float()), nil
+	case schema.Type_Which_float64:
+		return g.float(), nil
+	case schema.Type_Which_enum:
+		n, err := g.nodes.Find(typ.Enum().TypeId())
+		if err != nil {
+			return nil, err
+		}
+		enums, err := n.Enum().Enumerants()
+		if err != nil {
+			return nil, err
+		}
+		if enums.Len() == 0 {
+			return uint16(0), nil
+		}
+		return uint16(g.r.Intn(enums.Len())), nil
+	default:
+		return nil, fmt.Errorf("unknown type %v", typ.Which())
+	}
+}
+
+// float returns a random finite number of varying magnitude.
+func (g *generator) float() float64 {
+	return (g.r.Float64()*2 - 1) * math.Pow(10, float64(g.r.Intn(13)-6))
+}
+
+// textRunes are the characters of generated Text, which include
+// multi-byte characters to exercise UTF-8 handling.
+var textRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 .,-_éßλж日本")
+
+func (g *generator) text() string {
+	rs := make([]rune, g.r.Intn(g.maxTextLen+1))
+	for i := range rs {
+		rs[i] = textRunes[g.r.Intn(len(textRunes))]
+	}
+	return string(rs)
+}
+
+// scalarBits returns the bit pattern of a value returned by scalar.
+func scalarBits(v any) uint64 {
+	switch v := v.(type) {
+	case int8:
+		return uint64(uint8(v))
+	case int16:
+		return uint64(uint16(v))
+	case int32:
+		return uint64(uint32(v))
+	case int64:
+		return uint64(v)
+	case uint8:
+		return uint64(v)
+	case uint16:
+		return uint64(v)
+	case uint32:
+		return uint64(v)
+	case uint64:
+		return v
+	case float32:
+		return uint64(math.Float32bits(v))
+	case float64:
+		return math.Float64bits(v)
+	default:
+		return 0
+	}
+}
+
+func isPointer(w schema.Type_Which) bool {
+	switch w {
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_structType,
+		schema.Type_Which_list, schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		return true
+	default:
+		return false
+	}
+}
+
+func structSize(n schema.Node) capnp.ObjectSize {
+	return capnp.ObjectSize{
+		DataSize:     capnp.Size(n.StructNode().DataWordCount()) * 8,
+		PointerCount: n.StructNode().PointerCount(),
+	}
+}
@@ -0,0 +1,135 @@
+package capnptest
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/dynamic"
+)
+
+const generateSchema = `
+@0xd1c2b3a4f5e6d001;
+
+struct Tree @0xe2d3c4b5a6f7e801 {
+  label @0 :Text;
+  weight @1 :Float64;
+  color @2 :Color = blue;
+  children @3 :List(Tree);
+  matrix @4 :List(List(Int16));
+  flags @5 :List(Bool);
+  blob @6 :Data;
+  shape :union {
+    none @7 :Void;
+    circle @8 :Float32;
+    rect :group {
+      width @9 :UInt32;
+      height @10 :UInt32;
+    }
+    colors @11 :List(Color);
+  }
+  parent @12 :Tree;
+}
+
+enum Color {
+  red @0;
+  green @1;
+  blue @2;
+}
+`
+
+const treeTypeID = 0xe2d3c4b5a6f7e801
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	reg := compileRegistry(t, "generate.capnp", generateSchema)
+	c := &Constraints{MaxDepth: 3, MaxListLen: 3, MaxTextLen: 8, Registry: reg}
+	var conv dynamic.Converter
+	conv.UseRegistry(reg)
+
+	members := make(map[string]bool)
+	for seed := int64(0); seed < 50; seed++ {
+		s, err := Generate(treeTypeID, rand.New(rand.NewSource(seed)), c)
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		m, err := conv.ToMap(treeTypeID, s)
+		if err != nil {
+			t.Fatalf("seed %d: ToMap: %v", seed, err)
+		}
+		checkTree(t, m, 0, c)
+		for _, name := range []string{"none", "circle", "rect", "colors"} {
+			if _, ok := m["shape"].(map[string]any)[name]; ok {
+				members[name] = true
+			}
+		}
+
+		// The same seed generates the same message, byte for byte.
+		again, err := Generate(treeTypeID, rand.New(rand.NewSource(seed)), c)
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		if !bytes.Equal(mustMarshal(t, s), mustMarshal(t, again)) {
+			t.Errorf("seed %d: messages differ", seed)
+		}
+	}
+	if len(members) != 4 {
+		t.Errorf("union members generated = %v; want all 4", members)
+	}
+}
+
+// checkTree reports an error if the fields of a Tree at the given
+// depth break the constraints.
+func checkTree(t *testing.T, m map[string]any, depth int, c *Constraints) {
+	t.Helper()
+	if label, _ := m["label"].(string); !utf8.ValidString(label) || utf8.RuneCountInString(label) > c.MaxTextLen {
+		t.Errorf("label = %q", label)
+	}
+	if blob, _ := m["blob"].([]byte); len(blob) > c.MaxTextLen {
+		t.Errorf("len(blob) = %d", len(blob))
+	}
+	if _, ok := m["color"].(string); !ok {
+		t.Errorf("color = %v; want an enumerant", m["color"])
+	}
+	if colors, ok := m["shape"].(map[string]any)["colors"].([]any); ok {
+		for _, color := range colors {
+			if _, ok := color.(string); !ok {
+				t.Errorf("shape.colors has %v; want enumerants", color)
+			}
+		}
+	}
+	children, _ := m["children"].([]any)
+	if len(children) > c.MaxListLen {
+		t.Errorf("len(children) = %d", len(children))
+	}
+	if depth >= c.MaxDepth && (children != nil || m["parent"] != nil || m["matrix"] != nil) {
+		t.Errorf("depth %d has nested objects", depth)
+	}
+	for _, child := range children {
+		checkTree(t, child.(map[string]any), depth+1, c)
+	}
+	if parent, ok := m["parent"].(map[string]any); ok {
+		checkTree(t, parent, depth+1, c)
+	}
+}
+
+func mustMarshal(t *testing.T, s capnp.Struct) []byte {
+	t.Helper()
+	b, err := s.Message().Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestGenerate_NotStruct(t *testing.T) {
+	t.Parallel()
+
+	reg := compileRegistry(t, "generate.capnp", generateSchema)
+	if _, err := Generate(0xd1c2b3a4f5e6d001, rand.New(rand.NewSource(1)), &Constraints{Registry: reg}); err == nil {
+		t.Error("Generate(file ID) did not return an error")
+	}
+}
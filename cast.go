@@ -0,0 +1,66 @@
+package capnp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+// ErrWrongInterface is the cause of the errors returned by CastClient
+// when a capability does not implement the requested interface.  Use
+// errors.Is to detect it.
+var ErrWrongInterface = errors.New("capability does not implement interface")
+
+// An InterfaceChecker can report which interfaces a capability
+// implements.  The Value of a capability's Brand implements it if the
+// capability can be checked, as servers in the server package do.
+type InterfaceChecker interface {
+	// ImplementsInterface reports whether the capability implements
+	// the interface with the given ID, directly or by extending it.
+	// known is false if the capability cannot tell, in which case the
+	// cast is not checked.
+	ImplementsInterface(ctx context.Context, id uint64) (ok, known bool, err error)
+}
+
+// CastClient converts c to the client type T of the interface with the
+// given ID, which generated code declares as T_TypeID, after checking
+// that c implements the interface.  Unlike a Go conversion, which
+// always succeeds, a cast to the wrong type then fails here rather than
+// at the first call.
+//
+// CastClient waits for c to resolve, then asks its brand as an
+// InterfaceChecker.  Local servers can be checked, and so can remote
+// capabilities whose servers have introspection enabled with the
+// std/introspect package.  Capabilities that cannot be checked are
+// converted unchecked.  If c does not implement the interface, the
+// error is a Failed exception wrapping ErrWrongInterface.
+//
+// The result shares c's reference, so only one of them should be
+// released.  A null c converts to a null T.
+func CastClient[T ~ClientKind](ctx context.Context, c Client, id uint64) (T, error) {
+	if c == (Client{}) {
+		return T{}, nil
+	}
+	if err := c.Resolve(ctx); err != nil {
+		return T{}, err
+	}
+	snap := c.Snapshot()
+	brand := snap.Brand()
+	snap.Release()
+	if ic, ok := brand.Value.(InterfaceChecker); ok {
+		ok, known, err := ic.ImplementsInterface(ctx, id)
+		if err != nil {
+			return T{}, err
+		}
+		if known && !ok {
+			return T{}, &exc.Exception{
+				Type:   exc.Failed,
+				Prefix: "capnp",
+				Cause:  fmt.Errorf("%w %#x", ErrWrongInterface, id),
+			}
+		}
+	}
+	return T(c), nil
+}
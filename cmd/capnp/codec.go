@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"io"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/text"
+	"capnproto.org/go/capnp/v3/packed"
+)
+
+func decode(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	flat := fs.Bool("flat", false, "read a single unframed, single-segment message")
+	pack := fs.Bool("packed", false, "read packed messages")
+	fs.Bool("short", false, "write each message on a single line (always the case)")
+	var sf schemaFlags
+	sf.Register(fs)
+	if err := parseArgs(fs, args, 2); err != nil {
+		return err
+	}
+	set, err := sf.load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	n, err := set.lookupStruct(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	next := messageReader(stdin, *flat, *pack)
+	enc := text.NewEncoder(stdout)
+	enc.UseRegistry(set.reg)
+	for {
+		msg, err := next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		root, err := msg.Root()
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(n.Id(), root.Struct()); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(stdout, "\n"); err != nil {
+			return err
+		}
+	}
+}
+
+// messageReader returns a function that reads the next message from r,
+// returning io.EOF after the last message.
+func messageReader(r io.Reader, flat, pack bool) func() (*capnp.Message, error) {
+	if flat {
+		done := false
+		return func() (*capnp.Message, error) {
+			if done {
+				return nil, io.EOF
+			}
+			done = true
+			if pack {
+				r = packed.NewReader(bufio.NewReader(r))
+			}
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			if len(data)%8 != 0 {
+				return nil, errors.New("flat message is not a whole number of words")
+			}
+			return &capnp.Message{Arena: capnp.SingleSegment(data)}, nil
+		}
+	}
+	var dec *capnp.Decoder
+	if pack {
+		dec = capnp.NewPackedDecoder(r)
+	} else {
+		dec = capnp.NewDecoder(r)
+	}
+	return dec.Decode
+}
+
+func encode(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	flat := fs.Bool("flat", false, "write each message as a single unframed segment")
+	pack := fs.Bool("packed", false, "write packed messages")
+	var sf schemaFlags
+	sf.Register(fs)
+	if err := parseArgs(fs, args, 2); err != nil {
+		return err
+	}
+	set, err := sf.load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	n, err := set.lookupStruct(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	src, err := io.ReadAll(stdin)
+	if err != nil {
+		return err
+	}
+	vals, err := parseText(string(src))
+	if err != nil {
+		return err
+	}
+	b := &textBuilder{src: string(src), nodes: set.nodes}
+	w := newMessageWriter(stdout, *flat, *pack)
+	for _, v := range vals {
+		msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			return err
+		}
+		root, err := b.newStruct(seg, n.Id(), v)
+		if err != nil {
+			return err
+		}
+		if err := msg.SetRoot(root.ToPtr()); err != nil {
+			return err
+		}
+		if err := w(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newMessageWriter returns a function that writes messages to w.
+// Flat messages must have a single segment.
+func newMessageWriter(w io.Writer, flat, pack bool) func(*capnp.Message) error {
+	if flat {
+		return func(msg *capnp.Message) error {
+			if msg.NumSegments() != 1 {
+				return errors.New("flat output requires a single-segment message")
+			}
+			seg, err := msg.Segment(0)
+			if err != nil {
+				return err
+			}
+			data := seg.Data()
+			if pack {
+				data = packed.Pack(nil, data)
+			}
+			_, err = w.Write(data)
+			return err
+		}
+	}
+	var enc *capnp.Encoder
+	if pack {
+		enc = capnp.NewPackedEncoder(w)
+	} else {
+		enc = capnp.NewEncoder(w)
+	}
+	return enc.Encode
+}
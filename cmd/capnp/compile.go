@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/internal/schemaload"
+)
+
+func compile(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	var outputs schemaload.StringsFlag
+	fs.Var(&outputs, "o", "run the plugin `PLUGIN[:DIR]`, writing its output to DIR; \"-\" writes the request to standard output (may be repeated)")
+	fs.Var(&outputs, "output", "same as -o")
+	var sf schemaFlags
+	sf.Register(fs)
+	fs.Var(&sf.SrcPrefix, "src-prefix", "remove the directory `PREFIX` from the names of the compiled files, which decide where plugins write their output (may be repeated)")
+	if err := parseArgs(fs, args, -1); err != nil {
+		return err
+	}
+	set, err := sf.load(fs.Args()...)
+	if err != nil {
+		return err
+	}
+	for _, o := range outputs {
+		if err := runPlugin(o, set.data, stdout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runPlugin runs the plugin described by the -o flag value spec with
+// the code generator request req as its input.  Like the reference
+// tool, a plugin named "lang" is looked up as capnpc-lang in $PATH,
+// unless the name contains a slash.
+func runPlugin(spec string, req []byte, stdout io.Writer) error {
+	if spec == "-" {
+		_, err := stdout.Write(req)
+		return err
+	}
+	name, dir, _ := strings.Cut(spec, ":")
+	path := name
+	if !strings.Contains(name, "/") {
+		path = "capnpc-" + name
+	}
+	cmd := exec.Command(path)
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(req)
+	cmd.Stdout = stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("plugin %s: %v", name, err)
+	}
+	return nil
+}
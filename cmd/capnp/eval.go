@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/text"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/strquote"
+)
+
+func eval(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	var binary bool
+	fs.BoolVar(&binary, "b", false, "same as --output=binary")
+	fs.BoolVar(&binary, "binary", false, "same as --output=binary")
+	flat := fs.Bool("flat", false, "same as --output=flat")
+	pack := fs.Bool("packed", false, "same as --output=packed")
+	var format string
+	fs.StringVar(&format, "o", "text", "same as --output")
+	fs.StringVar(&format, "output", "text", "write the value in `format`: text, binary, flat, packed or canonical")
+	fs.Bool("short", false, "write the value on a single line (always the case)")
+	var sf schemaFlags
+	sf.Register(fs)
+	if err := parseArgs(fs, args, 2); err != nil {
+		return err
+	}
+	switch {
+	case binary:
+		format = "binary"
+	case *flat:
+		format = "flat"
+	case *pack:
+		format = "packed"
+	}
+	set, err := sf.load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	n, err := set.lookup(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	if n.Which() != schema.Node_Which_const {
+		return errors.New(fs.Arg(1) + " is not a constant")
+	}
+	typ, err := n.Const().Type()
+	if err != nil {
+		return err
+	}
+	val, err := n.Const().Value()
+	if err != nil {
+		return err
+	}
+
+	if format == "text" {
+		var buf bytes.Buffer
+		if err := set.writeValue(&buf, typ, val); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		_, err := stdout.Write(buf.Bytes())
+		return err
+	}
+	if typ.Which() != schema.Type_Which_structType {
+		return fmt.Errorf("%s output requires a struct constant", format)
+	}
+	p, err := val.StructValue()
+	if err != nil {
+		return err
+	}
+	if format == "canonical" {
+		data, err := capnp.Canonicalize(p.Struct())
+		if err != nil {
+			return err
+		}
+		_, err = stdout.Write(data)
+		return err
+	}
+	var w func(*capnp.Message) error
+	switch format {
+	case "binary":
+		w = newMessageWriter(stdout, false, false)
+	case "flat":
+		w = newMessageWriter(stdout, true, false)
+	case "packed":
+		w = newMessageWriter(stdout, false, true)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+	msg, _, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return err
+	}
+	if err := msg.SetRoot(p); err != nil {
+		return err
+	}
+	return w(msg)
+}
+
+// writeValue writes the text representation of a value of type typ.
+func (set *schemaSet) writeValue(w *bytes.Buffer, typ schema.Type, val schema.Value) error {
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		w.WriteString("void")
+	case schema.Type_Which_bool:
+		w.WriteString(strconv.FormatBool(val.Bool()))
+	case schema.Type_Which_int8:
+		w.WriteString(strconv.FormatInt(int64(val.Int8()), 10))
+	case schema.Type_Which_int16:
+		w.WriteString(strconv.FormatInt(int64(val.Int16()), 10))
+	case schema.Type_Which_int32:
+		w.WriteString(strconv.FormatInt(int64(val.Int32()), 10))
+	case schema.Type_Which_int64:
+		w.WriteString(strconv.FormatInt(val.Int64(), 10))
+	case schema.Type_Which_uint8:
+		w.WriteString(strconv.FormatUint(uint64(val.Uint8()), 10))
+	case schema.Type_Which_uint16:
+		w.WriteString(strconv.FormatUint(uint64(val.Uint16()), 10))
+	case schema.Type_Which_uint32:
+		w.WriteString(strconv.FormatUint(uint64(val.Uint32()), 10))
+	case schema.Type_Which_uint64:
+		w.WriteString(strconv.FormatUint(val.Uint64(), 10))
+	case schema.Type_Which_float32:
+		w.WriteString(formatFloat(float64(val.Float32()), 32))
+	case schema.Type_Which_float64:
+		w.WriteString(formatFloat(val.Float64(), 64))
+	case schema.Type_Which_text:
+		t, err := val.TextBytes()
+		if err != nil {
+			return err
+		}
+		w.Write(strquote.Append(nil, t))
+	case schema.Type_Which_data:
+		d, err := val.Data()
+		if err != nil {
+			return err
+		}
+		w.Write(strquote.Append(nil, d))
+	case schema.Type_Which_enum:
+		return set.writeEnum(w, typ.Enum().TypeId(), val.Enum())
+	case schema.Type_Which_structType:
+		p, err := val.StructValue()
+		if err != nil {
+			return err
+		}
+		enc := text.NewEncoder(w)
+		enc.UseRegistry(set.reg)
+		return enc.Encode(typ.StructType().TypeId(), p.Struct())
+	case schema.Type_Which_list:
+		elem, err := typ.List().ElementType()
+		if err != nil {
+			return err
+		}
+		p, err := val.List()
+		if err != nil {
+			return err
+		}
+		return set.writeList(w, elem, p.List())
+	default:
+		return fmt.Errorf("cannot write %v constants", typ.Which())
+	}
+	return nil
+}
+
+// writeList writes the text representation of a list with elements of
+// type elem.
+func (set *schemaSet) writeList(w *bytes.Buffer, elem schema.Type, l capnp.List) error {
+	switch elem.Which() {
+	case schema.Type_Which_structType:
+		enc := text.NewEncoder(w)
+		enc.UseRegistry(set.reg)
+		return enc.EncodeList(elem.StructType().TypeId(), l)
+	case schema.Type_Which_enum:
+		w.WriteByte('[')
+		el := capnp.UInt16List(l)
+		for i := 0; i < el.Len(); i++ {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			if err := set.writeEnum(w, elem.Enum().TypeId(), el.At(i)); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+		return nil
+	case schema.Type_Which_list:
+		ee, err := elem.List().ElementType()
+		if err != nil {
+			return err
+		}
+		w.WriteByte('[')
+		pl := capnp.PointerList(l)
+		for i := 0; i < pl.Len(); i++ {
+			if i > 0 {
+				w.WriteString(", ")
+			}
+			p, err := pl.At(i)
+			if err != nil {
+				return err
+			}
+			if err := set.writeList(w, ee, p.List()); err != nil {
+				return err
+			}
+		}
+		w.WriteByte(']')
+		return nil
+	}
+	var s string
+	switch elem.Which() {
+	case schema.Type_Which_void:
+		s = capnp.VoidList(l).String()
+	case schema.Type_Which_bool:
+		s = capnp.BitList(l).String()
+	case schema.Type_Which_int8:
+		s = capnp.Int8List(l).String()
+	case schema.Type_Which_int16:
+		s = capnp.Int16List(l).String()
+	case schema.Type_Which_int32:
+		s = capnp.Int32List(l).String()
+	case schema.Type_Which_int64:
+		s = capnp.Int64List(l).String()
+	case schema.Type_Which_uint8:
+		s = capnp.UInt8List(l).String()
+	case schema.Type_Which_uint16:
+		s = capnp.UInt16List(l).String()
+	case schema.Type_Which_uint32:
+		s = capnp.UInt32List(l).String()
+	case schema.Type_Which_uint64:
+		s = capnp.UInt64List(l).String()
+	case schema.Type_Which_float32:
+		s = capnp.Float32List(l).String()
+	case schema.Type_Which_float64:
+		s = capnp.Float64List(l).String()
+	case schema.Type_Which_text:
+		s = capnp.TextList(l).String()
+	case schema.Type_Which_data:
+		s = capnp.DataList(l).String()
+	default:
+		return fmt.Errorf("cannot write lists of %v", elem.Which())
+	}
+	w.WriteString(s)
+	return nil
+}
+
+func (set *schemaSet) writeEnum(w *bytes.Buffer, typeID uint64, v uint16) error {
+	n, ok := set.nodes[typeID]
+	if !ok || n.Which() != schema.Node_Which_enum {
+		return fmt.Errorf("unknown enum type @%#x", typeID)
+	}
+	enums, err := n.Enum().Enumerants()
+	if err != nil {
+		return err
+	}
+	if int(v) >= enums.Len() {
+		w.WriteString(strconv.FormatUint(uint64(v), 10))
+		return nil
+	}
+	name, err := enums.At(int(v)).Name()
+	if err != nil {
+		return err
+	}
+	w.WriteString(name)
+	return nil
+}
+
+func formatFloat(f float64, bits int) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case math.IsNaN(f):
+		return "nan"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bits)
+}
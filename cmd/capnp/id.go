@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+)
+
+// id prints a new random file ID, or with -check, reports IDs that are
+// used by more than one declaration in the given schemas.
+func id(fset *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error {
+	check := fset.Bool("check", false, "report IDs that are used more than once in the schemas, searching directories for .capnp files")
+	var sf schemaFlags
+	sf.Register(fset)
+	if err := parseArgs(fset, args, -2); err != nil {
+		return err
+	}
+	if *check != (fset.NArg() > 0) {
+		fset.Usage()
+		return errUsage
+	}
+	if !*check {
+		newID, err := compiler.NewID()
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "@0x%016x;\n", newID)
+		return err
+	}
+
+	var files []string
+	for _, arg := range fset.Args() {
+		err := filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path == arg && !d.IsDir() || !d.IsDir() && strings.HasSuffix(path, ".capnp") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	dups, err := compiler.CheckIDs(sf.Options(), files...)
+	if err != nil {
+		return err
+	}
+	for _, d := range dups {
+		fmt.Fprintf(stdout, "@0x%016x is used by:\n", d.ID)
+		for _, name := range d.Names {
+			fmt.Fprintf(stdout, "\t%s\n", name)
+		}
+	}
+	if len(dups) > 0 {
+		return fmt.Errorf("found %d duplicate IDs", len(dups))
+	}
+	return nil
+}
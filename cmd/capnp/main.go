@@ -0,0 +1,160 @@
+// Command capnp is a Go implementation of the Cap'n Proto command-line
+// tool.  It provides the compile, decode, encode, eval and id subcommands
+// with the same flags as the reference capnp tool, so that basic
+// workflows do not need the C++ toolchain installed.
+//
+// Usage:
+//
+//	capnp compile [-I DIR]... [--src-prefix=PREFIX]... [-o PLUGIN[:DIR]]... SCHEMA...
+//	capnp decode [-I DIR]... [--flat] [--packed] [--short] SCHEMA TYPE
+//	capnp encode [-I DIR]... [--flat] [--packed] SCHEMA TYPE
+//	capnp eval [-I DIR]... [-b|--flat|--packed|--output=FORMAT] [--short] SCHEMA NAME
+//	capnp id [--check [-I DIR]... PATH...]
+//
+// SCHEMA is a schema language file ending in ".capnp", which is
+// compiled by the schemas/compiler package, or a compiled schema: a
+// CodeGeneratorRequest message in the standard framing, as written by
+// "capnp compile -o-".  Imports that start with a slash are looked up
+// in the directories given by -I, followed by /usr/local/include,
+// /usr/include and the standard schemas embedded in the binary unless
+// --no-standard-import is given.  TYPE and NAME are
+// names relative to the files requested in the schema, like "Foo.Bar".
+//
+// compile passes the compiled schema to each plugin.  --src-prefix
+// removes PREFIX from the names of the requested files, so that a
+// plugin writes its output relative to DIR rather than to the path the
+// schema was named by.
+//
+// decode reads binary messages from standard input and writes each one
+// in the text format on its own line.  encode does the opposite,
+// reading struct values in the text format.  eval writes the value of a
+// constant.
+//
+// id writes a new random ID, suitable for a file declaration.  With
+// --check, it instead compiles each schema file in PATH, searching
+// directories recursively, and reports IDs that are used by more than
+// one declaration, exiting with a non-zero status if any are found.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "capnp:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// errUsage is returned by subcommands whose arguments are invalid,
+// after printing their usage.
+var errUsage = errors.New("usage")
+
+// A command is a capnp subcommand.
+type command struct {
+	name  string
+	args  string
+	short string
+	run   func(fs *flag.FlagSet, args []string, stdin io.Reader, stdout io.Writer) error
+}
+
+var commands = []command{
+	{"compile", "[-I DIR]... [-o PLUGIN[:DIR]]... SCHEMA...", "generate source code from schemas", compile},
+	{"decode", "[-I DIR]... [--flat] [--packed] [--short] SCHEMA TYPE", "convert binary messages to text", decode},
+	{"encode", "[-I DIR]... [--flat] [--packed] SCHEMA TYPE", "convert text messages to binary", encode},
+	{"eval", "[-I DIR]... [-b|--flat|--packed|--output=FORMAT] [--short] SCHEMA NAME", "print the value of a constant", eval},
+	{"id", "[--check [-I DIR]... PATH...]", "generate a new ID, or check IDs for duplicates", id},
+}
+
+// run runs the subcommand named by args[0].
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		usage(stderr)
+		return errUsage
+	}
+	for _, c := range commands {
+		if c.name != args[0] {
+			continue
+		}
+		fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+		fs.SetOutput(stderr)
+		fs.Usage = func() {
+			fmt.Fprintf(stderr, "usage: capnp %s %s\n", c.name, c.args)
+			fs.PrintDefaults()
+		}
+		err := c.run(fs, args[1:], stdin, stdout)
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return nil
+	}
+	fmt.Fprintf(stderr, "capnp: unknown command %q\n", args[0])
+	usage(stderr)
+	return errUsage
+}
+
+func usage(w io.Writer) {
+	var sb strings.Builder
+	sb.WriteString("usage: capnp COMMAND [OPTIONS] ARGS...\n\ncommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(&sb, "  %-8s %s\n", c.name, c.short)
+	}
+	io.WriteString(w, sb.String())
+}
+
+// parseArgs parses the flags in args and checks that n positional
+// arguments remain, or at least one if n is -1.  If n is less than -1,
+// any number of arguments is allowed.
+func parseArgs(fs *flag.FlagSet, args []string, n int) error {
+	if err := fs.Parse(splitShortFlags(args)); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		// The flag package has already reported the error.
+		return errUsage
+	}
+	if n == -1 && fs.NArg() == 0 || n >= 0 && fs.NArg() != n {
+		fs.Usage()
+		return errUsage
+	}
+	return nil
+}
+
+// splitShortFlags splits flags like "-o-" and "-Isrc", which the
+// reference tool accepts, into a flag and its value.
+func splitShortFlags(args []string) []string {
+	var out []string
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if a == "--" || !strings.HasPrefix(a, "-") {
+			return append(out, args[i:]...)
+		}
+		switch {
+		case a == "-o" || a == "-I":
+			// The value is the next argument.
+			out = append(out, a)
+			if i+1 < len(args) {
+				i++
+				out = append(out, args[i])
+			}
+		case len(a) > 2 && (a[1] == 'o' || a[1] == 'I') && a[2] != '=' && !strings.HasPrefix(a, "-output"):
+			out = append(out, a[:2], a[2:])
+		default:
+			out = append(out, a)
+		}
+	}
+	return out
+}
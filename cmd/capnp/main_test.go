@@ -0,0 +1,331 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// txtSchema is a compiled schema shared with the encoding/text tests.
+var txtSchema = filepath.Join("..", "..", "encoding", "text", "testdata", "txt.capnp.out")
+
+func runCapnp(t *testing.T, stdin []byte, args ...string) []byte {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	if err := run(args, bytes.NewReader(stdin), &stdout, &stderr); err != nil {
+		t.Fatalf("capnp %s: %v; stderr:\n%s", strings.Join(args, " "), err, stderr.String())
+	}
+	return stdout.Bytes()
+}
+
+func TestEncodeDecode(t *testing.T) {
+	const input = `(key = "a" "b", value = (matrix = [[1, 2], [-3]]))
+# comment
+(key = "c", value = (cheeseList = [gouda, cheddar]))
+(key = "d", value = (data = 0x"dead beef"))
+(key = "e", value = (float64List = [0.5, -inf, 1e10]))
+(key = "f", value = (boolList = [true, false], ))
+(key = "g", value = (uint8 = 0xff))
+`
+	const want = `(key = "ab", value = (matrix = [[1, 2], [-3]]))
+(key = "c", value = (cheeseList = [gouda, cheddar]))
+(key = "d", value = (data = "\xde\xad\xbe\xef"))
+(key = "e", value = (float64List = [0.5, -Inf, 1e+10]))
+(key = "f", value = (boolList = [true, false]))
+(key = "g", value = (uint8 = 255))
+`
+	tests := [][]string{
+		nil,
+		{"--packed"},
+		{"--flat"},
+		{"--flat", "--packed"},
+	}
+	for _, flags := range tests {
+		lines := strings.SplitAfter(input, "\n")
+		if len(flags) > 0 && flags[0] == "--flat" {
+			// Flat input holds a single message.
+			lines = lines[:1]
+		}
+		var got []byte
+		for _, line := range lines {
+			if strings.HasPrefix(line, "#") {
+				continue
+			}
+			bin := runCapnp(t, []byte(line), append(append([]string{"encode"}, flags...), txtSchema, "KeyValue")...)
+			got = append(got, runCapnp(t, bin, append(append([]string{"decode"}, flags...), txtSchema, "KeyValue")...)...)
+		}
+		wantLines := strings.SplitAfter(want, "\n")
+		if len(flags) > 0 && flags[0] == "--flat" {
+			wantLines = wantLines[:1]
+		}
+		if string(got) != strings.Join(wantLines, "") {
+			t.Errorf("%v: decode(encode(input)) =\n%s\nwant:\n%s", flags, got, strings.Join(wantLines, ""))
+		}
+	}
+
+	// Several messages in one stream.
+	bin := runCapnp(t, []byte(input), "encode", txtSchema, "KeyValue")
+	if got := runCapnp(t, bin, "decode", txtSchema, "KeyValue"); string(got) != want {
+		t.Errorf("decode(encode(input)) =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{`(kye = "a")`, "1:2: unknown field kye"},
+		{`(key = 1)`, "1:8: expected text"},
+		{"(key = \"a\",\n value = (int8 = 200))", "2:18: invalid int8 200"},
+		{`(value = (cheese = brie))`, "1:20: unknown enumerant brie"},
+		{`(key = "a"`, "1:11: expected ')'"},
+		{`[1, 2]`, "1:1: expected struct value"},
+	}
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		err := run([]string{"encode", txtSchema, "KeyValue"}, strings.NewReader(test.input), &stdout, &stderr)
+		if err == nil {
+			t.Errorf("encode %q succeeded; want error %q", test.input, test.err)
+			continue
+		}
+		if err.Error() != test.err {
+			t.Errorf("encode %q: %v; want %q", test.input, err, test.err)
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"kv", `(key = "42", value = (int32 = -123))`},
+		{"escape", `(data = "\x00\n\"\\\xff")`},
+		{"kvList", `[(key = "foo", value = (void = void)), (key = "bar", value = (void = void))]`},
+	}
+	for _, test := range tests {
+		got := runCapnp(t, nil, "eval", txtSchema, test.name)
+		if string(got) != test.want+"\n" {
+			t.Errorf("eval %s = %q; want %q", test.name, got, test.want+"\n")
+		}
+	}
+
+	bin := runCapnp(t, nil, "eval", "--binary", txtSchema, "kv")
+	if got := runCapnp(t, bin, "decode", txtSchema, "KeyValue"); string(got) != tests[0].want+"\n" {
+		t.Errorf("decode(eval --binary kv) = %q; want %q", got, tests[0].want+"\n")
+	}
+}
+
+func TestCompileStdout(t *testing.T) {
+	want, err := os.ReadFile(txtSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := runCapnp(t, nil, "compile", "-o-", txtSchema)
+	if !bytes.Equal(got, want) {
+		t.Error("compile -o- did not write the compiled schema")
+	}
+}
+
+func TestCompileSource(t *testing.T) {
+	dir := t.TempDir()
+	inc := filepath.Join(dir, "include")
+	if err := os.Mkdir(inc, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(inc, "point.capnp"), []byte(`@0xd0b2a5b4c7a95b2d;
+struct Point {
+  x @0 :Int32;
+}
+`), 0666); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(dir, "shape.capnp")
+	if err := os.WriteFile(src, []byte(`@0xe6cd2b7f0b3b9f01;
+using Go = import "/go.capnp";
+$Go.package("shape");
+using import "/point.capnp".Point;
+struct Shape {
+  points @0 :List(Point);
+}
+`), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	// /go.capnp is found among the embedded standard schemas.
+	data := runCapnp(t, nil, "compile", "-I"+inc, "-o-", src)
+	set, err := newSchemaSet(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := set.req.RequestedFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files.Len() != 1 {
+		t.Fatalf("compile requested %d files; want 1", files.Len())
+	}
+	if name, _ := files.At(0).Filename(); name != src {
+		t.Errorf("requested file = %q; want %q", name, src)
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = run([]string{"compile", "--no-standard-import", "-I", inc, "-o-", src}, nil, &stdout, &stderr)
+	if err == nil {
+		t.Error("compile --no-standard-import found /go.capnp")
+	}
+}
+
+func TestCompileSrcPrefix(t *testing.T) {
+	dir := t.TempDir()
+	sub := filepath.Join(dir, "proto", "geo")
+	if err := os.MkdirAll(sub, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sub, "point.capnp"), []byte(`@0xd0b2a5b4c7a95b2d;
+struct Point {
+  x @0 :Int32;
+}
+`), 0666); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(sub, "shape.capnp")
+	if err := os.WriteFile(src, []byte(`@0xe6cd2b7f0b3b9f01;
+using import "point.capnp".Point;
+struct Shape {
+  points @0 :List(Point);
+}
+`), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		prefixes []string
+		want     string
+	}{
+		{nil, filepath.ToSlash(src)},
+		{[]string{dir}, "proto/geo/shape.capnp"},
+		{[]string{dir + "/"}, "proto/geo/shape.capnp"},
+		{[]string{dir, filepath.Join(dir, "proto")}, "geo/shape.capnp"},
+		{[]string{filepath.Join(dir, "pro")}, filepath.ToSlash(src)},
+	}
+	for _, test := range tests {
+		args := []string{"compile", "-o-"}
+		for _, p := range test.prefixes {
+			args = append(args, "--src-prefix="+p)
+		}
+		set, err := newSchemaSet(runCapnp(t, nil, append(args, src)...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files, err := set.req.RequestedFiles()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name, _ := files.At(0).Filename(); name != test.want {
+			t.Errorf("%v: requested file = %q; want %q", test.prefixes, name, test.want)
+		}
+		// The imported file's name is relative to the stripped name.
+		want := path.Join(path.Dir(test.want), "point.capnp")
+		if got, _ := set.nodes[0xd0b2a5b4c7a95b2d].DisplayName(); got != want {
+			t.Errorf("%v: imported file = %q; want %q", test.prefixes, got, want)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"decode", txtSchema, "Nope"}, nil, &stdout, &stderr)
+	if err == nil || !strings.Contains(err.Error(), `no declaration named "Nope"`) {
+		t.Errorf("decode of unknown type: %v", err)
+	}
+	err = run([]string{"decode", txtSchema, "Cheese"}, nil, &stdout, &stderr)
+	if err == nil || !strings.Contains(err.Error(), "not a struct type") {
+		t.Errorf("decode of enum type: %v", err)
+	}
+}
+
+func TestSchemaLanguage(t *testing.T) {
+	dir := t.TempDir()
+	inc := filepath.Join(dir, "include")
+	if err := os.Mkdir(inc, 0777); err != nil {
+		t.Fatal(err)
+	}
+	writeFile := func(name, src string) string {
+		t.Helper()
+		if err := os.WriteFile(name, []byte(src), 0666); err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+	writeFile(filepath.Join(inc, "point.capnp"), `@0xd0b2a5b4c7a95b2d;
+struct Point {
+  x @0 :Int32;
+  y @1 :Int32 = 5;
+}
+`)
+	shape := writeFile(filepath.Join(dir, "shape.capnp"), `@0xe6cd2b7f0b3b9f01;
+using import "/point.capnp".Point;
+struct Shape {
+  name @0 :Text;
+  points @1 :List(Point);
+}
+const square :Shape = (name = "square", points = [(x = 0, y = 0), (x = 1)]);
+`)
+
+	for _, opt := range []string{"-I" + inc, "--import-path=" + inc} {
+		got := runCapnp(t, nil, "eval", "--no-standard-import", opt, shape, "square")
+		const want = `(name = "square", points = [(x = 0, y = 0), (x = 1, y = 5)])` + "\n"
+		if string(got) != want {
+			t.Errorf("eval %s square = %q; want %q", opt, got, want)
+		}
+	}
+
+	bin := runCapnp(t, []byte(`(name = "tri", points = [(y = 2)])`), "encode", "-I", inc, shape, "Shape")
+	got := runCapnp(t, bin, "decode", "-I", inc, shape, "Shape")
+	if want := `(name = "tri", points = [(x = 0, y = 2)])` + "\n"; string(got) != want {
+		t.Errorf("decode(encode(...)) = %q; want %q", got, want)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"eval", "--no-standard-import", shape, "square"}, nil, &stdout, &stderr); err == nil {
+		t.Error("eval without import path succeeded")
+	}
+}
+
+func TestID(t *testing.T) {
+	got := string(runCapnp(t, nil, "id"))
+	if len(got) != len("@0x0123456789abcdef;\n") || !strings.HasPrefix(got, "@0x") || !strings.HasSuffix(got, ";\n") {
+		t.Errorf("capnp id = %q; want a new ID", got)
+	}
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"a.capnp": "@0xa93fc509624c72d9;\n",
+		"b.capnp": "@0xe6cd2b7f0b3b9f01;\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runCapnp(t, nil, "id", "--check", "--no-standard-import", dir)
+
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sub, "c.capnp"), []byte("@0xa93fc509624c72d9;\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"id", "--check", "--no-standard-import", dir}, nil, &stdout, &stderr); err == nil {
+		t.Error("id --check with a duplicate ID succeeded")
+	}
+	if got := stdout.String(); !strings.Contains(got, "@0xa93fc509624c72d9 is used by:") || !strings.Contains(got, "sub/c.capnp") {
+		t.Errorf("id --check output = %q; want the duplicate ID and its files", got)
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/schemaload"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+// A schemaSet is a compiled schema and an index of its nodes.
+type schemaSet struct {
+	req   schema.CodeGeneratorRequest
+	data  []byte // req in the standard framing
+	nodes map[uint64]schema.Node
+	reg   *schemas.Registry
+}
+
+// schemaFlags are the flags that control how schemas are loaded.
+type schemaFlags struct {
+	schemaload.Flags
+}
+
+// load compiles the named schema files, or reads a single compiled
+// schema, and indexes it.
+func (sf *schemaFlags) load(files ...string) (*schemaSet, error) {
+	data, err := sf.Load(files...)
+	if err != nil {
+		return nil, err
+	}
+	set, err := newSchemaSet(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", strings.Join(files, " "), err)
+	}
+	return set, nil
+}
+
+// newSchemaSet indexes the CodeGeneratorRequest in data.
+func newSchemaSet(data []byte) (*schemaSet, error) {
+	msg, err := capnp.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	req, err := schema.ReadRootCodeGeneratorRequest(msg)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := req.Nodes()
+	if err != nil {
+		return nil, err
+	}
+	set := &schemaSet{
+		req:   req,
+		data:  data,
+		nodes: make(map[uint64]schema.Node, nodes.Len()),
+		reg:   new(schemas.Registry),
+	}
+	ids := make([]uint64, 0, nodes.Len())
+	for i := 0; i < nodes.Len(); i++ {
+		n := nodes.At(i)
+		set.nodes[n.Id()] = n
+		ids = append(ids, n.Id())
+	}
+	if err := set.reg.Register(&schemas.Schema{Bytes: data, Nodes: ids}); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// lookup finds the node with the given dotted name, like "Foo.Bar",
+// in the files requested in the schema.
+func (set *schemaSet) lookup(name string) (schema.Node, error) {
+	files, err := set.req.RequestedFiles()
+	if err != nil {
+		return schema.Node{}, err
+	}
+	parts := strings.Split(name, ".")
+	for i := 0; i < files.Len(); i++ {
+		n, ok := set.nodes[files.At(i).Id()]
+		for _, part := range parts {
+			if !ok {
+				break
+			}
+			n, ok = set.nested(n, part)
+		}
+		if ok {
+			return n, nil
+		}
+	}
+	return schema.Node{}, fmt.Errorf("no declaration named %q", name)
+}
+
+// nested returns the node nested in n with the given name.
+func (set *schemaSet) nested(n schema.Node, name string) (schema.Node, bool) {
+	nested, err := n.NestedNodes()
+	if err != nil {
+		return schema.Node{}, false
+	}
+	for i := 0; i < nested.Len(); i++ {
+		nn := nested.At(i)
+		if got, _ := nn.Name(); got == name {
+			n, ok := set.nodes[nn.Id()]
+			return n, ok
+		}
+	}
+	return schema.Node{}, false
+}
+
+// lookupStruct is like lookup, but the node must be a struct.
+func (set *schemaSet) lookupStruct(name string) (schema.Node, error) {
+	n, err := set.lookup(name)
+	if err != nil {
+		return schema.Node{}, err
+	}
+	if n.Which() != schema.Node_Which_structNode {
+		return schema.Node{}, errors.New(name + " is not a struct type")
+	}
+	return n, nil
+}
+
+// structSize returns the size of structs of the struct node n.
+func structSize(n schema.Node) capnp.ObjectSize {
+	return capnp.ObjectSize{
+		DataSize:     capnp.Size(n.StructNode().DataWordCount()) * 8,
+		PointerCount: n.StructNode().PointerCount(),
+	}
+}
@@ -0,0 +1,786 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// This file parses the Cap'n Proto text format, as written by decode
+// and the encoding/text package, and builds messages from it.
+
+// Token kinds.  Punctuation tokens use the punctuation character.
+const (
+	tokEOF    = 0
+	tokIdent  = 'a'
+	tokNumber = '0'
+	tokString = '"'
+	tokBytes  = 'x'
+)
+
+type textToken struct {
+	kind byte
+	s    string // identifier, number or string contents
+	off  int
+}
+
+type textLexer struct {
+	src string
+	off int
+}
+
+func (lx *textLexer) errorf(off int, format string, args ...any) error {
+	return &textError{src: lx.src, off: off, msg: fmt.Sprintf(format, args...)}
+}
+
+// next returns the next token.  Adjacent string literals are joined.
+func (lx *textLexer) next() (textToken, error) {
+	lx.skipSpace()
+	start := lx.off
+	if lx.off >= len(lx.src) {
+		return textToken{kind: tokEOF, off: start}, nil
+	}
+	c := lx.src[lx.off]
+	switch {
+	case strings.HasPrefix(lx.src[lx.off:], `0x"`):
+		lx.off += 2
+		s, err := lx.str()
+		if err != nil {
+			return textToken{}, err
+		}
+		b, err := parseHexBytes(s)
+		if err != nil {
+			return textToken{}, lx.errorf(start, "%v", err)
+		}
+		return textToken{kind: tokBytes, s: string(b), off: start}, nil
+	case c == '"':
+		var sb strings.Builder
+		for lx.off < len(lx.src) && lx.src[lx.off] == '"' {
+			s, err := lx.str()
+			if err != nil {
+				return textToken{}, err
+			}
+			sb.WriteString(s)
+			lx.skipSpace()
+		}
+		return textToken{kind: tokString, s: sb.String(), off: start}, nil
+	case isDigit(c):
+		lx.off++
+		for lx.off < len(lx.src) {
+			c := lx.src[lx.off]
+			if isIdentPart(c) || c == '.' {
+				lx.off++
+				continue
+			}
+			prev := lx.src[lx.off-1]
+			isHex := strings.HasPrefix(lx.src[start:], "0x") || strings.HasPrefix(lx.src[start:], "0X")
+			if (c == '+' || c == '-') && (prev == 'e' || prev == 'E') && !isHex {
+				lx.off++
+				continue
+			}
+			break
+		}
+		return textToken{kind: tokNumber, s: lx.src[start:lx.off], off: start}, nil
+	case isIdentPart(c):
+		for lx.off < len(lx.src) && isIdentPart(lx.src[lx.off]) {
+			lx.off++
+		}
+		return textToken{kind: tokIdent, s: lx.src[start:lx.off], off: start}, nil
+	case strings.IndexByte("()[]=,-", c) >= 0:
+		lx.off++
+		return textToken{kind: c, off: start}, nil
+	default:
+		return textToken{}, lx.errorf(start, "unexpected %q", c)
+	}
+}
+
+func (lx *textLexer) skipSpace() {
+	for lx.off < len(lx.src) {
+		switch lx.src[lx.off] {
+		case ' ', '\t', '\r', '\n':
+			lx.off++
+		case '#':
+			for lx.off < len(lx.src) && lx.src[lx.off] != '\n' {
+				lx.off++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// str reads a quoted string literal starting at the current offset.
+func (lx *textLexer) str() (string, error) {
+	start := lx.off
+	lx.off++ // opening quote
+	var sb strings.Builder
+	for {
+		if lx.off >= len(lx.src) {
+			return "", lx.errorf(start, "unterminated string")
+		}
+		c := lx.src[lx.off]
+		switch c {
+		case '"':
+			lx.off++
+			return sb.String(), nil
+		case '\n':
+			return "", lx.errorf(start, "unterminated string")
+		case '\\':
+			b, n, err := unescape(lx.src[lx.off:])
+			if err != nil {
+				return "", lx.errorf(lx.off, "%v", err)
+			}
+			sb.WriteByte(b)
+			lx.off += n
+		default:
+			sb.WriteByte(c)
+			lx.off++
+		}
+	}
+}
+
+// unescape decodes the escape sequence at the start of s, returning the
+// byte it represents and the length of the sequence.
+func unescape(s string) (byte, int, error) {
+	if len(s) < 2 {
+		return 0, 0, errors.New("unterminated escape sequence")
+	}
+	switch s[1] {
+	case 'a':
+		return '\a', 2, nil
+	case 'b':
+		return '\b', 2, nil
+	case 'f':
+		return '\f', 2, nil
+	case 'n':
+		return '\n', 2, nil
+	case 'r':
+		return '\r', 2, nil
+	case 't':
+		return '\t', 2, nil
+	case 'v':
+		return '\v', 2, nil
+	case '\\', '\'', '"', '?':
+		return s[1], 2, nil
+	case 'x':
+		if len(s) < 4 || !isHexDigit(s[2]) || !isHexDigit(s[3]) {
+			return 0, 0, errors.New("invalid \\x escape")
+		}
+		v, _ := strconv.ParseUint(s[2:4], 16, 8)
+		return byte(v), 4, nil
+	}
+	n := 1
+	for n < 4 && n < len(s) && s[n] >= '0' && s[n] <= '7' {
+		n++
+	}
+	if n == 1 {
+		return 0, 0, fmt.Errorf("unknown escape sequence \\%c", s[1])
+	}
+	v, err := strconv.ParseUint(s[1:n], 8, 8)
+	if err != nil {
+		return 0, 0, errors.New("octal escape out of range")
+	}
+	return byte(v), n, nil
+}
+
+// parseHexBytes decodes the contents of a 0x"..." literal, which may
+// contain whitespace between bytes.
+func parseHexBytes(s string) ([]byte, error) {
+	s = strings.Join(strings.Fields(s), "")
+	if len(s)%2 != 0 {
+		return nil, errors.New("odd number of hex digits in bytes literal")
+	}
+	b := make([]byte, len(s)/2)
+	for i := range b {
+		v, err := strconv.ParseUint(s[2*i:2*i+2], 16, 8)
+		if err != nil {
+			return nil, errors.New("invalid hex digit in bytes literal")
+		}
+		b[i] = byte(v)
+	}
+	return b, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isIdentPart(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+// A textError is an error at a position in the text input.
+type textError struct {
+	src string
+	off int
+	msg string
+}
+
+func (e *textError) Error() string {
+	line := 1 + strings.Count(e.src[:e.off], "\n")
+	col := 1 + e.off - (strings.LastIndexByte(e.src[:e.off], '\n') + 1)
+	return fmt.Sprintf("%d:%d: %s", line, col, e.msg)
+}
+
+// A textValue is a parsed value.  Its kind is the kind of the token it
+// was parsed from, or '(' for a struct and '[' for a list.
+type textValue struct {
+	kind   byte
+	s      string
+	fields []textField
+	elems  []*textValue
+	off    int
+}
+
+type textField struct {
+	name string
+	val  *textValue
+	off  int
+}
+
+type textParser struct {
+	lx  textLexer
+	tok textToken
+}
+
+// parseText parses a sequence of values.
+func parseText(src string) ([]*textValue, error) {
+	p := &textParser{lx: textLexer{src: src}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	var vals []*textValue
+	for p.tok.kind != tokEOF {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
+
+func (p *textParser) advance() (err error) {
+	p.tok, err = p.lx.next()
+	return err
+}
+
+func (p *textParser) expect(kind byte) error {
+	if p.tok.kind != kind {
+		return p.lx.errorf(p.tok.off, "expected %q", kind)
+	}
+	return p.advance()
+}
+
+func (p *textParser) value() (*textValue, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokIdent, tokNumber, tokString, tokBytes:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return &textValue{kind: tok.kind, s: tok.s, off: tok.off}, nil
+	case '-':
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokNumber && !(p.tok.kind == tokIdent && p.tok.s == "inf") {
+			return nil, p.lx.errorf(p.tok.off, "expected number after '-'")
+		}
+		v := &textValue{kind: tokNumber, s: "-" + p.tok.s, off: tok.off}
+		return v, p.advance()
+	case '(':
+		return p.structValue()
+	case '[':
+		return p.listValue()
+	case tokEOF:
+		return nil, p.lx.errorf(tok.off, "unexpected end of input")
+	default:
+		return nil, p.lx.errorf(tok.off, "unexpected %q", tok.kind)
+	}
+}
+
+func (p *textParser) structValue() (*textValue, error) {
+	v := &textValue{kind: '(', off: p.tok.off}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	for p.tok.kind != ')' {
+		if p.tok.kind != tokIdent {
+			return nil, p.lx.errorf(p.tok.off, "expected field name")
+		}
+		f := textField{name: p.tok.s, off: p.tok.off}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+		var err error
+		if f.val, err = p.value(); err != nil {
+			return nil, err
+		}
+		v.fields = append(v.fields, f)
+		if p.tok.kind != ',' {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	return v, p.expect(')')
+}
+
+func (p *textParser) listValue() (*textValue, error) {
+	v := &textValue{kind: '[', off: p.tok.off}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	for p.tok.kind != ']' {
+		e, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		v.elems = append(v.elems, e)
+		if p.tok.kind != ',' {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	return v, p.expect(']')
+}
+
+// A textBuilder builds message objects from parsed values.
+type textBuilder struct {
+	src   string
+	nodes map[uint64]schema.Node
+}
+
+func (b *textBuilder) errorf(v *textValue, format string, args ...any) error {
+	return &textError{src: b.src, off: v.off, msg: fmt.Sprintf(format, args...)}
+}
+
+// newStruct allocates a struct of type typeID in seg and fills it from v.
+func (b *textBuilder) newStruct(seg *capnp.Segment, typeID uint64, v *textValue) (capnp.Struct, error) {
+	n, ok := b.nodes[typeID]
+	if !ok || n.Which() != schema.Node_Which_structNode {
+		return capnp.Struct{}, b.errorf(v, "unknown struct type @%#x", typeID)
+	}
+	s, err := capnp.NewStruct(seg, structSize(n))
+	if err != nil {
+		return capnp.Struct{}, err
+	}
+	return s, b.fillStruct(s, n, v)
+}
+
+// fillStruct sets the fields of s, a struct or group of the struct node
+// n, from the struct value v.
+func (b *textBuilder) fillStruct(s capnp.Struct, n schema.Node, v *textValue) error {
+	if v.kind != '(' {
+		return b.errorf(v, "expected struct value")
+	}
+	fields, err := n.StructNode().Fields()
+	if err != nil {
+		return err
+	}
+	for _, tf := range v.fields {
+		f, ok := findField(fields, tf.name)
+		if !ok {
+			return &textError{src: b.src, off: tf.off, msg: "unknown field " + tf.name}
+		}
+		if dv := f.DiscriminantValue(); dv != schema.Field_noDiscriminant {
+			s.SetUint16(capnp.DataOffset(n.StructNode().DiscriminantOffset()*2), dv)
+		}
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			err = b.setSlot(s, f, tf.val)
+		case schema.Field_Which_group:
+			g, ok := b.nodes[f.Group().TypeId()]
+			if !ok {
+				return b.errorf(tf.val, "missing group node for %s", tf.name)
+			}
+			err = b.fillStruct(s, g, tf.val)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findField(fields schema.Field_List, name string) (schema.Field, bool) {
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		if got, _ := f.Name(); got == name {
+			return f, true
+		}
+	}
+	return schema.Field{}, false
+}
+
+// setSlot sets the slot field f of s.  Data fields are stored XORed with
+// their default value.
+func (b *textBuilder) setSlot(s capnp.Struct, f schema.Field, v *textValue) error {
+	slot := f.Slot()
+	typ, err := slot.Type()
+	if err != nil {
+		return err
+	}
+	off := slot.Offset()
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		_, err := b.scalar(typ, v)
+		return err
+	case schema.Type_Which_text:
+		if v.kind != tokString {
+			return b.errorf(v, "expected text")
+		}
+		return s.SetNewText(uint16(off), v.s)
+	case schema.Type_Which_data:
+		if v.kind != tokString && v.kind != tokBytes {
+			return b.errorf(v, "expected data")
+		}
+		return s.SetData(uint16(off), []byte(v.s))
+	case schema.Type_Which_structType:
+		st, err := b.newStruct(s.Segment(), typ.StructType().TypeId(), v)
+		if err != nil {
+			return err
+		}
+		return s.SetPtr(uint16(off), st.ToPtr())
+	case schema.Type_Which_list:
+		elem, err := typ.List().ElementType()
+		if err != nil {
+			return err
+		}
+		p, err := b.newList(s.Segment(), elem, v)
+		if err != nil {
+			return err
+		}
+		return s.SetPtr(uint16(off), p)
+	case schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		if v.kind == tokIdent && v.s == "null" {
+			return nil
+		}
+		return b.errorf(v, "cannot set %v fields from text", typ.Which())
+	}
+	bits, err := b.scalar(typ, v)
+	if err != nil {
+		return err
+	}
+	dv, err := slot.DefaultValue()
+	if err != nil {
+		return err
+	}
+	bits ^= defaultBits(dv)
+	switch scalarWidth(typ.Which()) {
+	case 1:
+		s.SetBit(capnp.BitOffset(off), bits != 0)
+	case 8:
+		s.SetUint8(capnp.DataOffset(off), uint8(bits))
+	case 16:
+		s.SetUint16(capnp.DataOffset(off*2), uint16(bits))
+	case 32:
+		s.SetUint32(capnp.DataOffset(off*4), uint32(bits))
+	case 64:
+		s.SetUint64(capnp.DataOffset(off*8), bits)
+	}
+	return nil
+}
+
+// newList allocates a list with elements of type elem in seg and fills
+// it from v.
+func (b *textBuilder) newList(seg *capnp.Segment, elem schema.Type, v *textValue) (capnp.Ptr, error) {
+	if v.kind != '[' {
+		return capnp.Ptr{}, b.errorf(v, "expected list value")
+	}
+	n := int32(len(v.elems))
+	switch elem.Which() {
+	case schema.Type_Which_void:
+		for _, e := range v.elems {
+			if _, err := b.scalar(elem, e); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return capnp.NewVoidList(seg, n).ToPtr(), nil
+	case schema.Type_Which_text:
+		l, err := capnp.NewTextList(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, e := range v.elems {
+			if e.kind != tokString {
+				return capnp.Ptr{}, b.errorf(e, "expected text")
+			}
+			if err := l.Set(i, e.s); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_data:
+		l, err := capnp.NewDataList(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, e := range v.elems {
+			if e.kind != tokString && e.kind != tokBytes {
+				return capnp.Ptr{}, b.errorf(e, "expected data")
+			}
+			if err := l.Set(i, []byte(e.s)); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_structType:
+		sn, ok := b.nodes[elem.StructType().TypeId()]
+		if !ok {
+			return capnp.Ptr{}, b.errorf(v, "unknown struct type @%#x", elem.StructType().TypeId())
+		}
+		l, err := capnp.NewCompositeList(seg, structSize(sn), n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, e := range v.elems {
+			if err := b.fillStruct(l.Struct(i), sn, e); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_list:
+		ee, err := elem.List().ElementType()
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		l, err := capnp.NewPointerList(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, e := range v.elems {
+			p, err := b.newList(seg, ee, e)
+			if err != nil {
+				return capnp.Ptr{}, err
+			}
+			if err := l.Set(i, p); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		return capnp.Ptr{}, b.errorf(v, "cannot build lists of %v from text", elem.Which())
+	}
+
+	bits := make([]uint64, len(v.elems))
+	for i, e := range v.elems {
+		var err error
+		if bits[i], err = b.scalar(elem, e); err != nil {
+			return capnp.Ptr{}, err
+		}
+	}
+	switch scalarWidth(elem.Which()) {
+	case 1:
+		l, err := capnp.NewBitList(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, bits[i] != 0)
+		}
+		return l.ToPtr(), nil
+	case 8:
+		l, err := capnp.NewUInt8List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, uint8(bits[i]))
+		}
+		return l.ToPtr(), nil
+	case 16:
+		l, err := capnp.NewUInt16List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, uint16(bits[i]))
+		}
+		return l.ToPtr(), nil
+	case 32:
+		l, err := capnp.NewUInt32List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, uint32(bits[i]))
+		}
+		return l.ToPtr(), nil
+	default:
+		l, err := capnp.NewUInt64List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, bits[i])
+		}
+		return l.ToPtr(), nil
+	}
+}
+
+// scalar returns the bit pattern of v as a value of a non-pointer type.
+func (b *textBuilder) scalar(typ schema.Type, v *textValue) (uint64, error) {
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		if v.kind != tokIdent || v.s != "void" {
+			return 0, b.errorf(v, "expected void")
+		}
+		return 0, nil
+	case schema.Type_Which_bool:
+		if v.kind == tokIdent && v.s == "true" {
+			return 1, nil
+		}
+		if v.kind == tokIdent && v.s == "false" {
+			return 0, nil
+		}
+		return 0, b.errorf(v, "expected true or false")
+	case schema.Type_Which_enum:
+		return b.enumerant(typ.Enum().TypeId(), v)
+	case schema.Type_Which_float32, schema.Type_Which_float64:
+		var f float64
+		switch {
+		case v.kind == tokIdent && v.s == "inf":
+			f = math.Inf(1)
+		case v.kind == tokIdent && v.s == "nan":
+			f = math.NaN()
+		case v.kind == tokNumber && v.s == "-inf":
+			f = math.Inf(-1)
+		case v.kind == tokNumber:
+			var err error
+			if f, err = strconv.ParseFloat(v.s, 64); err != nil {
+				return 0, b.errorf(v, "invalid number %s", v.s)
+			}
+		default:
+			return 0, b.errorf(v, "expected number")
+		}
+		if typ.Which() == schema.Type_Which_float32 {
+			return uint64(math.Float32bits(float32(f))), nil
+		}
+		return math.Float64bits(f), nil
+	}
+	if v.kind != tokNumber {
+		return 0, b.errorf(v, "expected number")
+	}
+	width := scalarWidth(typ.Which())
+	switch typ.Which() {
+	case schema.Type_Which_int8, schema.Type_Which_int16, schema.Type_Which_int32, schema.Type_Which_int64:
+		i, err := strconv.ParseInt(v.s, 0, width)
+		if err != nil {
+			return 0, b.errorf(v, "invalid %v %s", typ.Which(), v.s)
+		}
+		return uint64(i) & widthMask(width), nil
+	default:
+		u, err := strconv.ParseUint(v.s, 0, width)
+		if err != nil {
+			return 0, b.errorf(v, "invalid %v %s", typ.Which(), v.s)
+		}
+		return u, nil
+	}
+}
+
+func (b *textBuilder) enumerant(typeID uint64, v *textValue) (uint64, error) {
+	if v.kind == tokNumber {
+		u, err := strconv.ParseUint(v.s, 0, 16)
+		if err != nil {
+			return 0, b.errorf(v, "invalid enum value %s", v.s)
+		}
+		return u, nil
+	}
+	if v.kind != tokIdent {
+		return 0, b.errorf(v, "expected enumerant")
+	}
+	n, ok := b.nodes[typeID]
+	if !ok || n.Which() != schema.Node_Which_enum {
+		return 0, b.errorf(v, "unknown enum type @%#x", typeID)
+	}
+	enums, err := n.Enum().Enumerants()
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < enums.Len(); i++ {
+		if name, _ := enums.At(i).Name(); name == v.s {
+			return uint64(i), nil
+		}
+	}
+	return 0, b.errorf(v, "unknown enumerant %s", v.s)
+}
+
+// scalarWidth returns the size in bits of values of a non-pointer type.
+func scalarWidth(w schema.Type_Which) int {
+	switch w {
+	case schema.Type_Which_void:
+		return 0
+	case schema.Type_Which_bool:
+		return 1
+	case schema.Type_Which_int8, schema.Type_Which_uint8:
+		return 8
+	case schema.Type_Which_int16, schema.Type_Which_uint16, schema.Type_Which_enum:
+		return 16
+	case schema.Type_Which_int32, schema.Type_Which_uint32, schema.Type_Which_float32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+func widthMask(width int) uint64 {
+	if width >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<uint(width) - 1
+}
+
+// defaultBits returns the bit pattern of a field's default value.
+func defaultBits(dv schema.Value) uint64 {
+	if !dv.IsValid() {
+		return 0
+	}
+	switch dv.Which() {
+	case schema.Value_Which_bool:
+		if dv.Bool() {
+			return 1
+		}
+		return 0
+	case schema.Value_Which_int8:
+		return uint64(uint8(dv.Int8()))
+	case schema.Value_Which_int16:
+		return uint64(uint16(dv.Int16()))
+	case schema.Value_Which_int32:
+		return uint64(uint32(dv.Int32()))
+	case schema.Value_Which_int64:
+		return uint64(dv.Int64())
+	case schema.Value_Which_uint8:
+		return uint64(dv.Uint8())
+	case schema.Value_Which_uint16:
+		return uint64(dv.Uint16())
+	case schema.Value_Which_uint32:
+		return uint64(dv.Uint32())
+	case schema.Value_Which_uint64:
+		return dv.Uint64()
+	case schema.Value_Which_float32:
+		return uint64(math.Float32bits(dv.Float32()))
+	case schema.Value_Which_float64:
+		return math.Float64bits(dv.Float64())
+	case schema.Value_Which_enum:
+		return uint64(dv.Enum())
+	default:
+		return 0
+	}
+}
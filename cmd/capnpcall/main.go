@@ -0,0 +1,196 @@
+// Command capnpcall makes a Cap'n Proto RPC call from the command line
+// and prints the results, like curl does for HTTP.  It is meant for
+// debugging and operating services, not for programs.
+//
+// Usage:
+//
+//	capnpcall [-network NET] [-schema SCHEMA [-I DIR]...] [-json] [-timeout D] ADDRESS METHOD [ARGS]
+//
+// capnpcall connects to the vat listening on ADDRESS, calls METHOD on
+// its bootstrap capability with ARGS, and writes the results in the
+// text format, or as JSON with -json.  METHOD is a method name, like
+// "echo", optionally qualified by its interface's name relative to its
+// file, like "Echo.echo".  ARGS is a JSON object holding the
+// parameters, or "-" to read it from standard input; it defaults to no
+// parameters.  JSON values are converted to fields as by the
+// encoding/dynamic package: Data fields take strings.  In JSON results,
+// Data fields are base64-encoded and capabilities are described by
+// strings.
+//
+// The method's schema is read from SCHEMA, either a schema language
+// file ending in ".capnp" or a compiled schema as written by
+// "capnp compile -o-".  Imports that start with a slash are looked up
+// in the directories given by -I, followed by /usr/local/include,
+// /usr/include and the standard schemas embedded in the binary unless
+// -no-standard-import is given.  Without -schema, capnpcall fetches
+// the schemas of the interfaces that the bootstrap capability
+// implements from the vat itself, which requires the server to have
+// introspection enabled with the std/introspect package.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/dynamic"
+	"capnproto.org/go/capnp/v3/encoding/text"
+	"capnproto.org/go/capnp/v3/internal/schemaload"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+func main() {
+	if err := run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "capnpcall:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// errUsage is returned when the arguments are invalid, after printing
+// the usage.
+var errUsage = errors.New("usage")
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("capnpcall", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: capnpcall [flags] ADDRESS METHOD [ARGS]")
+		fs.PrintDefaults()
+	}
+	network := fs.String("network", "tcp", "dial ADDRESS on `network`, like tcp or unix")
+	schemaFile := fs.String("schema", "", "read method schemas from `file` instead of fetching them from the vat")
+	var sf schemaload.Flags
+	sf.Register(fs)
+	asJSON := fs.Bool("json", false, "write the results as JSON instead of in the text format")
+	timeout := fs.Duration("timeout", 30*time.Second, "give up after `duration`")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if fs.NArg() < 2 || fs.NArg() > 3 {
+		fs.Usage()
+		return errUsage
+	}
+	params, err := readParams(fs.Arg(2), stdin)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	var d net.Dialer
+	nc, err := d.DialContext(ctx, *network, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	conn := rpc.NewConn(transport.NewStream(nc), nil)
+	defer conn.Close()
+	client := conn.Bootstrap(ctx)
+	defer client.Release()
+
+	var set *schemaSet
+	if *schemaFile != "" {
+		set, err = loadSchema(*schemaFile, &sf)
+	} else {
+		set, err = fetchSchema(ctx, client)
+	}
+	if err != nil {
+		return err
+	}
+	m, err := set.findMethod(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	var conv dynamic.Converter
+	conv.UseRegistry(set.reg)
+	ans, release := client.SendCall(ctx, capnp.Send{
+		Method:   m.method,
+		ArgsSize: structSize(m.params),
+		PlaceArgs: func(s capnp.Struct) error {
+			return conv.FromMap(m.params.Id(), s, params)
+		},
+	})
+	defer release()
+	res, err := ans.Struct()
+	if err != nil {
+		return err
+	}
+	if *asJSON {
+		fields, err := conv.ToMap(m.results.Id(), res)
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(jsonValue(fields), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = stdout.Write(append(data, '\n'))
+		return err
+	}
+	var buf bytes.Buffer
+	enc := text.NewEncoder(&buf)
+	enc.UseRegistry(set.reg)
+	if err := enc.Encode(m.results.Id(), res); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+	_, err = stdout.Write(buf.Bytes())
+	return err
+}
+
+// readParams parses the ARGS argument, reading it from stdin if it is
+// "-".
+func readParams(arg string, stdin io.Reader) (map[string]any, error) {
+	switch arg {
+	case "":
+		return map[string]any{}, nil
+	case "-":
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+		arg = string(data)
+	}
+	var params map[string]any
+	if err := json.Unmarshal([]byte(arg), &params); err != nil {
+		return nil, fmt.Errorf("parsing arguments: %v", err)
+	}
+	return params, nil
+}
+
+// jsonValue replaces the values in v that encoding/json cannot
+// represent, like capabilities, with descriptions of them.
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = jsonValue(e)
+		}
+		return v
+	case []any:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+		return v
+	case capnp.Client:
+		return v.String()
+	case capnp.Ptr:
+		return "<AnyPointer>"
+	default:
+		return v
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/std/introspect"
+)
+
+func init() {
+	air.RegisterSchema(schemas.DefaultRegistry)
+}
+
+type echoServer struct{}
+
+func (echoServer) Echo(ctx context.Context, call air.Echo_echo) error {
+	in, err := call.Args().In()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetOut(strings.ToUpper(in))
+}
+
+// serve serves an Echo on a local TCP address, with introspection
+// enabled, until the test ends.
+func serve(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			nc, err := l.Accept()
+			if err != nil {
+				return
+			}
+			srv := air.Echo_NewServer(echoServer{})
+			introspect.Enable(srv, nil)
+			conn := rpc.NewConn(transport.NewStream(nc), &rpc.Options{
+				BootstrapClient: capnp.NewClient(srv),
+			})
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	return l.Addr().String()
+}
+
+func runCall(t *testing.T, stdin string, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	err := run(context.Background(), args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), err
+}
+
+func TestCall(t *testing.T) {
+	addr := serve(t)
+	aircraft := filepath.Join("..", "..", "internal", "aircraftlib", "aircraft.capnp")
+	tests := []struct {
+		args  []string
+		stdin string
+		want  string
+	}{
+		{[]string{addr, "echo", `{"in": "hello"}`}, "", `(out = "HELLO")` + "\n"},
+		{[]string{addr, "Echo.echo", "-"}, `{"in": "stdin"}`, `(out = "STDIN")` + "\n"},
+		{[]string{"-json", addr, "echo", `{"in": "json"}`}, "", "{\n  \"out\": \"JSON\"\n}\n"},
+		{[]string{"-schema", aircraft, "-I", filepath.Join("..", "..", "std"), addr, "Echo.echo", `{"in": "file"}`}, "", `(out = "FILE")` + "\n"},
+		// /go.capnp is found among the embedded standard schemas.
+		{[]string{"-schema", aircraft, addr, "Echo.echo", `{"in": "std"}`}, "", `(out = "STD")` + "\n"},
+	}
+	for _, test := range tests {
+		got, err := runCall(t, test.stdin, test.args...)
+		if err != nil {
+			t.Errorf("capnpcall %s: %v", strings.Join(test.args, " "), err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("capnpcall %s = %q; want %q", strings.Join(test.args, " "), got, test.want)
+		}
+	}
+
+	for _, args := range [][]string{
+		{addr, "nosuchmethod"},
+		{addr, "Other.echo"},
+		{addr, "echo", "not json"},
+	} {
+		if _, err := runCall(t, "", args...); err == nil {
+			t.Errorf("capnpcall %s succeeded; want error", strings.Join(args, " "))
+		}
+	}
+}
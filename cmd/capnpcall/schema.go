@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/schemaload"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/std/introspect"
+)
+
+// A schemaSet holds the schemas of the interfaces that a method may be
+// looked up in.
+type schemaSet struct {
+	reg        *schemas.Registry
+	nodes      map[uint64]schema.Node
+	interfaces []schema.Node
+}
+
+// loadSchema reads a schema language file or a compiled schema.  The
+// interfaces in its requested files can be called.
+func loadSchema(name string, sf *schemaload.Flags) (*schemaSet, error) {
+	data, err := sf.Load(name)
+	if err != nil {
+		return nil, err
+	}
+	set := &schemaSet{
+		reg:   new(schemas.Registry),
+		nodes: make(map[uint64]schema.Node),
+	}
+	req, err := set.add(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	files, err := req.RequestedFiles()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < files.Len(); i++ {
+		if err := set.addInterfaces(files.At(i).Id()); err != nil {
+			return nil, err
+		}
+	}
+	return set, nil
+}
+
+// fetchSchema asks c for the interfaces that it implements and their
+// schemas.
+func fetchSchema(ctx context.Context, c capnp.Client) (*schemaSet, error) {
+	ids, err := introspect.ListInterfaces(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("listing interfaces (is introspection enabled?): %v", err)
+	}
+	set := &schemaSet{
+		reg:   new(schemas.Registry),
+		nodes: make(map[uint64]schema.Node),
+	}
+	var fetchErr error
+	for _, id := range ids {
+		if _, ok := set.nodes[id]; !ok {
+			// The vat may not have the schemas of all its interfaces,
+			// like Introspection's own, so skip the ones it lacks.
+			data, err := introspect.GetSchema(ctx, c, id)
+			if err != nil {
+				fetchErr = fmt.Errorf("fetching schema of interface %#x: %v", id, err)
+				continue
+			}
+			if _, err := set.add(data); err != nil {
+				return nil, fmt.Errorf("schema of interface %#x: %v", id, err)
+			}
+		}
+		if n, ok := set.nodes[id]; ok && n.Which() == schema.Node_Which_interface {
+			set.interfaces = append(set.interfaces, n)
+		}
+	}
+	if len(set.interfaces) == 0 && fetchErr != nil {
+		return nil, fetchErr
+	}
+	return set, nil
+}
+
+// add indexes and registers the CodeGeneratorRequest in data, skipping
+// nodes that the set already has.
+func (set *schemaSet) add(data []byte) (schema.CodeGeneratorRequest, error) {
+	msg, err := capnp.Unmarshal(data)
+	if err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	req, err := schema.ReadRootCodeGeneratorRequest(msg)
+	if err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	nodes, err := req.Nodes()
+	if err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	var ids []uint64
+	for i := 0; i < nodes.Len(); i++ {
+		n := nodes.At(i)
+		if _, dup := set.nodes[n.Id()]; !dup {
+			set.nodes[n.Id()] = n
+			ids = append(ids, n.Id())
+		}
+	}
+	err = set.reg.Register(&schemas.Schema{Bytes: data, Nodes: ids})
+	return req, err
+}
+
+// addInterfaces adds the interfaces declared in the node with the given
+// ID and the nodes nested in it.
+func (set *schemaSet) addInterfaces(id uint64) error {
+	n, ok := set.nodes[id]
+	if !ok {
+		return nil
+	}
+	if n.Which() == schema.Node_Which_interface {
+		set.interfaces = append(set.interfaces, n)
+	}
+	nested, err := n.NestedNodes()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < nested.Len(); i++ {
+		if err := set.addInterfaces(nested.At(i).Id()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A method is a method found by findMethod.
+type method struct {
+	method  capnp.Method
+	params  schema.Node
+	results schema.Node
+}
+
+// findMethod finds the method with the given name, like "echo" or
+// "Echo.echo", among the set's interfaces and their superclasses.  It
+// is an error if more than one interface has a method of that name.
+func (set *schemaSet) findMethod(name string) (method, error) {
+	iname, mname := "", name
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		iname, mname = name[:i], name[i+1:]
+	}
+	var found []method
+	seen := make(map[uint64]bool)
+	var search func(n schema.Node) error
+	search = func(n schema.Node) error {
+		if seen[n.Id()] {
+			return nil
+		}
+		seen[n.Id()] = true
+		dn, _ := n.DisplayName()
+		short := dn[n.DisplayNamePrefixLength():]
+		if iname == "" || iname == short || iname == dn {
+			methods, err := n.Interface().Methods()
+			if err != nil {
+				return err
+			}
+			for i := 0; i < methods.Len(); i++ {
+				m := methods.At(i)
+				if got, _ := m.Name(); got != mname {
+					continue
+				}
+				params, ok := set.nodes[m.ParamStructType()]
+				results, ok2 := set.nodes[m.ResultStructType()]
+				if !ok || !ok2 {
+					return fmt.Errorf("%s.%s: missing parameter or result schema", short, mname)
+				}
+				found = append(found, method{
+					method: capnp.Method{
+						InterfaceID:   n.Id(),
+						MethodID:      uint16(i),
+						InterfaceName: dn,
+						MethodName:    mname,
+					},
+					params:  params,
+					results: results,
+				})
+			}
+		}
+		supers, err := n.Interface().Superclasses()
+		if err != nil {
+			return err
+		}
+		for i := 0; i < supers.Len(); i++ {
+			if sn, ok := set.nodes[supers.At(i).Id()]; ok {
+				if err := search(sn); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	for _, n := range set.interfaces {
+		if err := search(n); err != nil {
+			return method{}, err
+		}
+	}
+	switch len(found) {
+	case 0:
+		return method{}, fmt.Errorf("no method named %q", name)
+	case 1:
+		return found[0], nil
+	default:
+		return method{}, fmt.Errorf("method name %q is ambiguous: qualify it with its interface's name", name)
+	}
+}
+
+// structSize returns the size of structs of the struct node n.
+func structSize(n schema.Node) capnp.ObjectSize {
+	return capnp.ObjectSize{
+		DataSize:     capnp.Size(n.StructNode().DataWordCount()) * 8,
+		PointerCount: n.StructNode().PointerCount(),
+	}
+}
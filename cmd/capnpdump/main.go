@@ -0,0 +1,152 @@
+// Command capnpdump prints the Cap'n Proto RPC messages in captured
+// traffic, one line per message, like tcpdump does for packets.
+//
+// Usage:
+//
+//	capnpdump [-port PORT] [-schema SCHEMA [-I DIR]...]... [-content] [FILE]
+//
+// FILE, or standard input if it is absent or "-", holds a pcap or
+// pcapng packet capture, as written by tcpdump or Wireshark, a
+// recording made by transport.NewRecorder, or the messages sent in one
+// direction of a connection in the standard framing.  The format is
+// detected from the first bytes.  Packet captures must include the
+// packets' payloads; with tcpdump, capture with "-s 0".  -port limits a
+// packet capture to the connections that have PORT on either end.
+//
+// The methods of calls and returns are named from the schemas given by
+// -schema, which may be repeated: schema language files ending in
+// ".capnp", which are compiled, or compiled schemas as written by
+// "capnp compile -o-".  Imports that start with a slash are looked up
+// in the directories given by -I, followed by /usr/local/include,
+// /usr/include and the standard schemas embedded in the binary unless
+// -no-standard-import is given.  With -content, the parameters of
+// calls and the results of returns are also printed, in the text
+// format.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/schemaload"
+	"capnproto.org/go/capnp/v3/rpc/dump"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "capnpdump:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// errUsage is returned when the arguments are invalid, after printing
+// the usage.
+var errUsage = errors.New("usage")
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("capnpdump", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: capnpdump [flags] [FILE]")
+		fs.PrintDefaults()
+	}
+	port := fs.Uint("port", 0, "only decode TCP connections with `port` on either end")
+	var schemaFiles schemaload.StringsFlag
+	fs.Var(&schemaFiles, "schema", "name methods from the schemas in `file` (may be repeated)")
+	var sf schemaload.Flags
+	sf.Register(fs)
+	content := fs.Bool("content", false, "print the parameters and results of calls")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return errUsage
+	}
+	if fs.NArg() > 1 || *port > 0xffff {
+		fs.Usage()
+		return errUsage
+	}
+
+	reg := new(schemas.Registry)
+	seen := make(map[uint64]bool)
+	for _, name := range schemaFiles {
+		if err := loadSchema(reg, seen, name, &sf); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	in := stdin
+	if name := fs.Arg(0); name != "" && name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	r, err := dump.NewReader(in, &dump.Options{Port: uint16(*port)})
+	if err != nil {
+		return err
+	}
+	out := bufio.NewWriter(stdout)
+	p := &dump.Printer{Registry: reg, Content: *content}
+	for {
+		m, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		var streamErr *dump.StreamError
+		if errors.As(err, &streamErr) {
+			// Keep going: other connections may be fine.
+			fmt.Fprintln(stderr, "capnpdump:", err)
+			continue
+		} else if err != nil {
+			out.Flush()
+			return err
+		}
+		err = p.Print(out, m)
+		m.Message.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return out.Flush()
+}
+
+// loadSchema registers the nodes of a schema language file or a
+// compiled schema in reg, skipping the nodes in seen.
+func loadSchema(reg *schemas.Registry, seen map[uint64]bool, name string, sf *schemaload.Flags) error {
+	data, err := sf.Load(name)
+	if err != nil {
+		return err
+	}
+	msg, err := capnp.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	req, err := schema.ReadRootCodeGeneratorRequest(msg)
+	if err != nil {
+		return err
+	}
+	nodes, err := req.Nodes()
+	if err != nil {
+		return err
+	}
+	var ids []uint64
+	for i := 0; i < nodes.Len(); i++ {
+		if id := nodes.At(i).Id(); !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return reg.Register(&schemas.Schema{Bytes: data, Nodes: ids})
+}
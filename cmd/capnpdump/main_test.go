@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+type echoServer struct{}
+
+func (echoServer) Echo(ctx context.Context, call air.Echo_echo) error {
+	in, err := call.Args().In()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetOut(strings.ToUpper(in))
+}
+
+// recordEcho writes a recording of an echo call to a file and returns
+// its name.
+func recordEcho(t *testing.T) string {
+	t.Helper()
+	var rec bytes.Buffer
+	c1, c2 := transport.NewPipe(1)
+	client := rpc.NewConn(transport.NewRecorder(transport.New(c1), &rec, nil), nil)
+	server := rpc.NewConn(transport.New(c2), &rpc.Options{
+		BootstrapClient: capnp.Client(air.Echo_ServerToClient(echoServer{})),
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	echo := air.Echo(client.Bootstrap(ctx))
+	if err := echo.Resolve(ctx); err != nil {
+		t.Fatal(err)
+	}
+	f, release := echo.Echo(ctx, func(p air.Echo_echo_Params) error {
+		return p.SetIn("hi")
+	})
+	if _, err := f.Struct(); err != nil {
+		t.Fatal(err)
+	}
+	release()
+	echo.Release()
+	if err := client.Close(); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(t.TempDir(), "echo.rec")
+	if err := os.WriteFile(name, rec.Bytes(), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func runDump(t *testing.T, stdin []byte, args ...string) (string, error) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	err := run(args, bytes.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), err
+}
+
+func TestDump(t *testing.T) {
+	rec := recordEcho(t)
+	aircraft := filepath.Join("..", "..", "internal", "aircraftlib", "aircraft.capnp")
+	std := filepath.Join("..", "..", "std")
+	data, err := os.ReadFile(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		args  []string
+		stdin []byte
+		want  string
+	}{
+		{[]string{rec}, nil, "local > remote: call q=0 @0x8e5322c1e9282534.@0 on import 0\n"},
+		{[]string{"-"}, data, "local > remote: call q=0 @0x8e5322c1e9282534.@0 on import 0\n"},
+		{[]string{"-schema", aircraft, "-I", std, rec}, nil, "aircraft.capnp:Echo.echo results\n"},
+		{[]string{"-schema", aircraft, "-I", std, "-content", rec}, nil, "aircraft.capnp:Echo.echo on import 0\n\t(in = \"hi\")\n"},
+		// /go.capnp is found among the embedded standard schemas.
+		{[]string{"-schema", aircraft, rec}, nil, "aircraft.capnp:Echo.echo results\n"},
+	}
+	for _, test := range tests {
+		got, err := runDump(t, test.stdin, test.args...)
+		if err != nil {
+			t.Errorf("capnpdump %s: %v", strings.Join(test.args, " "), err)
+			continue
+		}
+		if !strings.Contains(got, test.want) {
+			t.Errorf("capnpdump %s = %q; want it to contain %q", strings.Join(test.args, " "), got, test.want)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"-port", "70000", rec},
+		{rec, rec},
+		{filepath.Join(t.TempDir(), "missing")},
+		{"-schema", filepath.Join(t.TempDir(), "missing.capnp"), rec},
+	} {
+		if _, err := runDump(t, nil, args...); err == nil {
+			t.Errorf("capnpdump %s succeeded; want error", strings.Join(args, " "))
+		}
+	}
+}
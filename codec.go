@@ -2,11 +2,16 @@ package capnp
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"io"
+	"math"
 	"net"
+	"time"
 
+	"capnproto.org/go/capnp/v3/encoding/framing"
 	"capnproto.org/go/capnp/v3/exc"
 	"capnproto.org/go/capnp/v3/exp/bufferpool"
 	"capnproto.org/go/capnp/v3/internal/str"
@@ -16,7 +21,8 @@ import (
 // A Decoder represents a framer that deserializes a particular Cap'n
 // Proto input stream.
 type Decoder struct {
-	r io.Reader
+	r      io.Reader
+	framer framing.Framer
 
 	wordbuf [wordSize]byte
 	hdrbuf  []byte
@@ -24,11 +30,34 @@ type Decoder struct {
 	// Maximum number of bytes that can be read per call to Decode.
 	// If not set, a reasonable default is used.
 	MaxMessageSize uint64
+
+	// Budget, if not nil, limits the total size of the decoded messages
+	// that have not been released, across all the decoders that share
+	// it.  Messages that don't fit fail to decode with ErrOverBudget,
+	// which leaves the stream unusable.
+	Budget *MemoryBudget
+
+	// BudgetWait is how long Decode waits for a message to fit in
+	// Budget before failing.  If zero, Decode does not wait; if
+	// negative, it waits until BudgetContext is done.
+	BudgetWait time.Duration
+
+	// BudgetContext, if not nil, bounds how long Decode waits for a
+	// message to fit in Budget: Decode stops waiting and fails with the
+	// context's error once it is done.  Owners of a decoder can cancel
+	// it to interrupt a Decode that is blocked on the budget, as
+	// closing the underlying reader would interrupt a read.
+	BudgetContext context.Context
+
+	// ValidationCache, if not nil, is used to validate each decoded
+	// message.  Decode fails if the message is invalid.
+	ValidationCache *ValidationCache
 }
 
 // NewDecoder creates a new Cap'n Proto framer that reads from r.
 // The returned decoder will only read as much data as necessary to
-// decode the message.
+// decode the message.  If r is a BufferLender, decoded messages read
+// directly from the buffers that it lends.
 func NewDecoder(r io.Reader) *Decoder {
 	return &Decoder{r: r}
 }
@@ -40,6 +69,13 @@ func NewPackedDecoder(r io.Reader) *Decoder {
 	return NewDecoder(packed.NewReader(bufio.NewReader(r)))
 }
 
+// NewFramedDecoder creates a new Cap'n Proto framer that reads messages
+// wrapped in the frames of f from r.  If f is nil, it is equivalent to
+// NewDecoder.
+func NewFramedDecoder(r io.Reader, f framing.Framer) *Decoder {
+	return &Decoder{r: r, framer: f}
+}
+
 // Decode reads a message from the decoder stream.  The error is io.EOF
 // only if no bytes were read.
 func (d *Decoder) Decode() (*Message, error) {
@@ -49,8 +85,55 @@ func (d *Decoder) Decode() (*Message, error) {
 	} else if maxSize < uint64(len(d.wordbuf)) {
 		return nil, errors.New("decode: max message size is smaller than header size")
 	}
+	var msg *Message
+	var err error
+	if d.framer != nil {
+		msg, err = d.decodeFrame(maxSize)
+	} else {
+		msg, err = d.decode(d.r, maxSize)
+	}
+	if err != nil || d.ValidationCache == nil {
+		return msg, err
+	}
+	if err := d.ValidationCache.Validate(msg); err != nil {
+		msg.Release()
+		return nil, exc.WrapError("decode", err)
+	}
+	return msg, nil
+}
 
-	hdr, err := d.readHeader(maxSize)
+// decodeFrame reads a message in a frame of d's framer.
+func (d *Decoder) decodeFrame(maxSize uint64) (*Message, error) {
+	size, prefix, err := d.framer.ReadHeader(d.r, maxSize)
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, exc.WrapError("decode: read frame", err)
+	}
+	if uint64(len(prefix)) > size || size > math.MaxInt64 {
+		return nil, errors.New("decode: malformed frame header")
+	}
+	payload := &io.LimitedReader{R: d.r, N: int64(size) - int64(len(prefix))}
+	msg, err := d.decode(io.MultiReader(bytes.NewReader(prefix), payload), maxSize)
+	if err == io.EOF {
+		return nil, errors.New("decode: empty frame")
+	} else if err != nil {
+		return nil, err
+	}
+	if payload.N > 0 {
+		msg.Release()
+		return nil, errors.New("decode: frame is larger than its message")
+	}
+	if err := d.framer.ReadTrailer(d.r); err != nil {
+		msg.Release()
+		return nil, exc.WrapError("decode: read frame", err)
+	}
+	return msg, nil
+}
+
+// decode reads a message in the standard serialization from r.
+func (d *Decoder) decode(r io.Reader, maxSize uint64) (*Message, error) {
+	hdr, err := d.readHeader(r, maxSize)
 	if err != nil {
 		return nil, err
 	}
@@ -65,24 +148,73 @@ func (d *Decoder) Decode() (*Message, error) {
 		return nil, errors.New("decode: message too large")
 	}
 
-	// Read segments.
-	buf := bufferpool.Default.Get(int(total))
-	if _, err := io.ReadFull(d.r, buf); err != nil {
-		return nil, exc.WrapError("decode: read segments", err)
+	if d.Budget != nil {
+		ctx := d.BudgetContext
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if err := d.Budget.acquire(ctx, total, d.BudgetWait); err != nil {
+			return nil, err
+		}
 	}
 
-	arena := MultiSegment(nil)
-	if err = arena.demux(hdr, buf); err != nil {
+	// Read segments, borrowing the reader's buffer if it can lend it.
+	buf, lent, ok := borrow(r, int(total))
+	if !ok {
+		buf = bufferpool.Default.Get(int(total))
+		if _, err := io.ReadFull(r, buf); err != nil {
+			d.releaseBudget(total)
+			return nil, exc.WrapError("decode: read segments", err)
+		}
+	}
+
+	msa := MultiSegment(nil)
+	if err = msa.demux(hdr, buf); err != nil {
+		if lent != nil {
+			lent()
+		}
+		d.releaseBudget(total)
 		return nil, exc.WrapError("decode", err)
 	}
 
+	var arena Arena = msa
+	if lent != nil {
+		arena = &lentArena{msa, lent}
+	}
+	if d.Budget != nil {
+		return &Message{Arena: &budgetArena{arena, d.Budget, total}}, nil
+	}
 	return &Message{Arena: arena}, nil
 }
 
-func (d *Decoder) readHeader(maxSize uint64) (streamHeader, error) {
+// borrow asks r to lend the next n bytes of the stream.  ok is false
+// if r is not a BufferLender or could not lend them.
+func borrow(r io.Reader, n int) (buf []byte, release func(), ok bool) {
+	l, isLender := r.(BufferLender)
+	if !isLender {
+		return nil, nil, false
+	}
+	buf, release, ok = l.LendBuffer(n)
+	if !ok {
+		return nil, nil, false
+	}
+	if release == nil {
+		release = func() {}
+	}
+	return buf, release, true
+}
+
+// releaseBudget returns n bytes to d's budget, if it has one.
+func (d *Decoder) releaseBudget(n uint64) {
+	if d.Budget != nil {
+		d.Budget.Release(n)
+	}
+}
+
+func (d *Decoder) readHeader(r io.Reader, maxSize uint64) (streamHeader, error) {
 	// Read first word (number of segments and first segment size).
 	// For single-segment messages, this will be sufficient.
-	maxSeg, err := d.readMaxSeg()
+	maxSeg, err := d.readMaxSeg(r)
 	if err != nil {
 		return nil, err
 	}
@@ -100,15 +232,15 @@ func (d *Decoder) readHeader(maxSize uint64) (streamHeader, error) {
 
 	d.hdrbuf = resizeSlice(d.hdrbuf, int(hdrSize))
 	copy(d.hdrbuf, d.wordbuf[:])
-	if _, err := io.ReadFull(d.r, d.hdrbuf[len(d.wordbuf):]); err != nil {
+	if _, err := io.ReadFull(r, d.hdrbuf[len(d.wordbuf):]); err != nil {
 		return nil, exc.WrapError("decode: read header", err)
 	}
 
 	return d.hdrbuf, nil
 }
 
-func (d *Decoder) readMaxSeg() (SegmentID, error) {
-	if _, err := io.ReadFull(d.r, d.wordbuf[:]); err == io.EOF {
+func (d *Decoder) readMaxSeg(r io.Reader) (SegmentID, error) {
+	if _, err := io.ReadFull(r, d.wordbuf[:]); err == io.EOF {
 		return 0, io.EOF
 	} else if err != nil {
 		return 0, exc.WrapError("decode: read header", err)
@@ -198,9 +330,19 @@ func MustUnmarshalRoot(data []byte) Ptr {
 // An Encoder represents a framer for serializing a particular Cap'n
 // Proto stream.
 type Encoder struct {
-	w      io.Writer
-	hdrbuf []byte
-	bufs   [][]byte
+	w        io.Writer
+	framer   framing.Framer
+	hdrbuf   []byte
+	framebuf []byte
+	frames   []frameBufs
+	bufs     [][]byte
+}
+
+// frameBufs records where the frame header and trailer of a message are
+// in an Encoder's framebuf, and where they go in its bufs.
+type frameBufs struct {
+	hdr, trailer       int // indexes in bufs
+	hdrEnd, trailerEnd int // ends in framebuf
 }
 
 // NewEncoder creates a new Cap'n Proto framer that writes to w.
@@ -214,36 +356,89 @@ func NewPackedEncoder(w io.Writer) *Encoder {
 	return NewEncoder(&packed.Writer{Writer: w})
 }
 
+// NewFramedEncoder creates a new Cap'n Proto framer that writes each
+// message to w wrapped in a frame of f.  If f is nil, it is equivalent
+// to NewEncoder.
+func NewFramedEncoder(w io.Writer, f framing.Framer) *Encoder {
+	return &Encoder{w: w, framer: f}
+}
+
 // Encode writes a message to the encoder stream.
 func (e *Encoder) Encode(m *Message) error {
-	nsegs := m.NumSegments()
-	if nsegs == 0 {
-		return errors.New("encode: message has no segments")
-	}
-	e.bufs = append(e.bufs[:0], nil) // first element is placeholder for header
-	maxSeg := SegmentID(nsegs - 1)
-	hdrSize := streamHeaderSize(maxSeg)
-	if hdrSize > uint64(maxInt) {
-		return errors.New("encode: header size overflows int")
+	return e.EncodeAll(m)
+}
+
+// EncodeAll writes messages to the encoder stream, one after the
+// other.  The messages are gathered into a single net.Buffers write,
+// which is a single writev(2) system call on network connections
+// that support it.
+func (e *Encoder) EncodeAll(ms ...*Message) error {
+	// The headers of all the messages share hdrbuf, so compute its
+	// size first: appending must not reallocate it once e.bufs refers
+	// to it.
+	var hdrSize uint64
+	for _, m := range ms {
+		nsegs := m.NumSegments()
+		if nsegs == 0 {
+			return errors.New("encode: message has no segments")
+		}
+		hdrSize += streamHeaderSize(SegmentID(nsegs - 1))
+		if hdrSize > uint64(maxInt) {
+			return errors.New("encode: header size overflows int")
+		}
 	}
-	e.hdrbuf = resizeSlice(e.hdrbuf, int(hdrSize))
-	e.hdrbuf = appendUint32(e.hdrbuf[:0], uint32(maxSeg))
-	for i := int64(0); i < nsegs; i++ {
-		s, err := m.Segment(SegmentID(i))
-		if err != nil {
-			return exc.WrapError("encode", err)
+	e.hdrbuf = resizeSlice(e.hdrbuf, int(hdrSize))[:0]
+	e.framebuf = e.framebuf[:0]
+	e.frames = e.frames[:0]
+	e.bufs = e.bufs[:0]
+	for _, m := range ms {
+		var frame frameBufs
+		if e.framer != nil {
+			e.bufs = append(e.bufs, nil) // placeholder for frame header
+			frame.hdr = len(e.bufs) - 1
+		}
+		start := len(e.hdrbuf)
+		e.bufs = append(e.bufs, nil) // placeholder for header
+		hdrIndex := len(e.bufs) - 1
+		size := uint64(0)
+		nsegs := m.NumSegments()
+		e.hdrbuf = appendUint32(e.hdrbuf, uint32(nsegs-1))
+		for i := int64(0); i < nsegs; i++ {
+			s, err := m.Segment(SegmentID(i))
+			if err != nil {
+				return exc.WrapError("encode", err)
+			}
+			n := len(s.data)
+			if int64(n) > int64(maxSegmentSize) {
+				return errors.New("encode: segment " + str.Itod(i) + " too large")
+			}
+			e.hdrbuf = appendUint32(e.hdrbuf, uint32(Size(n)/wordSize))
+			e.bufs = append(e.bufs, s.data)
+			size += uint64(n)
+		}
+		if (len(e.hdrbuf)-start)%int(wordSize) != 0 {
+			e.hdrbuf = appendUint32(e.hdrbuf, 0)
 		}
-		n := len(s.data)
-		if int64(n) > int64(maxSegmentSize) {
-			return errors.New("encode: segment " + str.Itod(i) + " too large")
+		e.bufs[hdrIndex] = e.hdrbuf[start:]
+		if e.framer != nil {
+			size += uint64(len(e.hdrbuf) - start)
+			e.framebuf = e.framer.AppendHeader(e.framebuf, size)
+			frame.hdrEnd = len(e.framebuf)
+			e.framebuf = e.framer.AppendTrailer(e.framebuf)
+			frame.trailerEnd = len(e.framebuf)
+			e.bufs = append(e.bufs, nil) // placeholder for frame trailer
+			frame.trailer = len(e.bufs) - 1
+			e.frames = append(e.frames, frame)
 		}
-		e.hdrbuf = appendUint32(e.hdrbuf, uint32(Size(n)/wordSize))
-		e.bufs = append(e.bufs, s.data)
 	}
-	if len(e.hdrbuf)%int(wordSize) != 0 {
-		e.hdrbuf = appendUint32(e.hdrbuf, 0)
+	// framebuf may have been reallocated while it was appended to, so
+	// the frames can only be sliced from it now.
+	start := 0
+	for _, f := range e.frames {
+		e.bufs[f.hdr] = e.framebuf[start:f.hdrEnd]
+		e.bufs[f.trailer] = e.framebuf[f.hdrEnd:f.trailerEnd]
+		start = f.trailerEnd
 	}
-	e.bufs[0] = e.hdrbuf
 
 	if err := e.write(e.bufs); err != nil {
 		return exc.WrapError("encode", err)
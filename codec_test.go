@@ -3,7 +3,10 @@ package capnp
 import (
 	"bytes"
 	"io"
+	"strings"
 	"testing"
+
+	"capnproto.org/go/capnp/v3/encoding/framing"
 )
 
 func TestEncoder(t *testing.T) {
@@ -34,6 +37,29 @@ func TestEncoder(t *testing.T) {
 	}
 }
 
+func TestEncodeAll(t *testing.T) {
+	t.Parallel()
+
+	// Encoding all the messages at once must produce the same stream
+	// as encoding them one at a time.
+	var msgs []*Message
+	var want []byte
+	for _, test := range serializeTests {
+		if test.encodeFails || test.decodeFails {
+			continue
+		}
+		msgs = append(msgs, &Message{Arena: test.arena()})
+		want = append(want, test.out...)
+	}
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).EncodeAll(msgs...); err != nil {
+		t.Fatal("EncodeAll:", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("EncodeAll = % 02x; want % 02x", buf.Bytes(), want)
+	}
+}
+
 func TestDecoder(t *testing.T) {
 	t.Parallel()
 
@@ -200,3 +226,82 @@ func TestStreamHeaderPadding(t *testing.T) {
 		t.Errorf("Encode = % 02x; want % 02x", out, want)
 	}
 }
+
+func TestFramedCodec(t *testing.T) {
+	t.Parallel()
+
+	var msgs []*Message
+	var want [][]byte
+	for _, test := range serializeTests {
+		if test.encodeFails || test.decodeFails {
+			continue
+		}
+		msgs = append(msgs, &Message{Arena: test.arena()})
+		want = append(want, test.out)
+	}
+	framers := []struct {
+		name string
+		f    framing.Framer
+	}{
+		{"Standard", framing.Standard},
+		{"Varint", framing.Varint},
+		{"Netstring", framing.Netstring},
+	}
+	for _, fr := range framers {
+		var buf bytes.Buffer
+		if err := NewFramedEncoder(&buf, fr.f).EncodeAll(msgs...); err != nil {
+			t.Errorf("%s: EncodeAll: %v", fr.name, err)
+			continue
+		}
+		if fr.f == framing.Standard {
+			if got := buf.Bytes(); !bytes.Equal(got, bytes.Join(want, nil)) {
+				t.Errorf("%s: EncodeAll = % 02x; want % 02x", fr.name, got, bytes.Join(want, nil))
+			}
+		}
+		d := NewFramedDecoder(&buf, fr.f)
+		for i := range want {
+			msg, err := d.Decode()
+			if err != nil {
+				t.Errorf("%s: Decode #%d: %v", fr.name, i, err)
+				break
+			}
+			got, err := msg.Marshal()
+			if err != nil {
+				t.Errorf("%s: Marshal #%d: %v", fr.name, i, err)
+			} else if !bytes.Equal(got, want[i]) {
+				t.Errorf("%s: message #%d = % 02x; want % 02x", fr.name, i, got, want[i])
+			}
+		}
+		if _, err := d.Decode(); err != io.EOF {
+			t.Errorf("%s: Decode at end of stream = _, %v; want _, io.EOF", fr.name, err)
+		}
+	}
+}
+
+func TestFramedDecoder_BadFrame(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte{0, 0, 0, 0, 1, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"Truncated", "17:" + string(msg)},
+		{"TooLong", "17:" + string(msg) + "x,"},
+		{"NoTrailer", "16:" + string(msg) + "x"},
+		{"Empty", "0:,"},
+	}
+	for _, test := range tests {
+		_, err := NewFramedDecoder(strings.NewReader(test.in), framing.Netstring).Decode()
+		if err == nil || err == io.EOF {
+			t.Errorf("%s: Decode error = %v; want a decoding error", test.name, err)
+		}
+	}
+	m, err := NewFramedDecoder(strings.NewReader("16:"+string(msg)+","), framing.Netstring).Decode()
+	if err != nil {
+		t.Fatal("Decode:", err)
+	}
+	if data, _ := m.Arena.Data(0); !bytes.Equal(data, msg[8:]) {
+		t.Errorf("segment 0 = % 02x; want % 02x", data, msg[8:])
+	}
+}
@@ -0,0 +1,62 @@
+package capnp
+
+import (
+	"errors"
+	"math"
+	"sync"
+
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+var errReadOnly = errors.New("message is read-only")
+
+// defaults holds the default values of pointer fields that generated
+// getters have read, keyed by the encoded message that holds each.
+var defaults sync.Map // map[defaultKey]Ptr
+
+// defaultKey identifies an encoded default value by its location.
+// Generated code passes slices of its static data, so the same default
+// is always at the same location.
+type defaultKey struct {
+	p *byte
+	n int
+}
+
+// readDefault returns the root of the encoded message def, which holds
+// the default value of a pointer field.  The message is decoded on
+// first use and shared by all later calls with the same def, so it is
+// read-only: writing to the value returned panics, and setting its
+// pointers or allocating in its message fails.  Reading it is not
+// limited by a traversal limit, since it is decoded only once.
+func readDefault(def []byte) (Ptr, error) {
+	if len(def) == 0 {
+		return Ptr{}, nil
+	}
+	key := defaultKey{&def[0], len(def)}
+	if p, ok := defaults.Load(key); ok {
+		return p.(Ptr), nil
+	}
+	p, err := unmarshalDefault(def)
+	if err != nil {
+		return Ptr{}, err
+	}
+	msg := p.Message()
+	msg.readOnly = true
+	msg.ResetReadLimit(math.MaxUint64)
+	actual, _ := defaults.LoadOrStore(key, p)
+	return actual.(Ptr), nil
+}
+
+func unmarshalDefault(def []byte) (Ptr, error) {
+	// Limit the capacity of def, so that allocating in the message
+	// cannot overwrite the data that follows it.
+	msg, err := Unmarshal(def[:len(def):len(def)])
+	if err != nil {
+		return Ptr{}, exc.WrapError("read default", err)
+	}
+	p, err := msg.Root()
+	if err != nil {
+		return Ptr{}, exc.WrapError("read default", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,101 @@
+package capnp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encodedPoint returns an encoded message whose root is a point with
+// the value v, followed by trailer, as the static data of generated
+// code holds default values.
+func encodedPoint(t *testing.T, v uint64, trailer []byte) (def, static []byte) {
+	msg, seg := NewSingleSegmentMessage(nil)
+	st, err := NewRootStruct(seg, ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	st.SetUint64(0, v)
+	def, err = msg.Marshal()
+	require.NoError(t, err)
+	static = append(def, trailer...)
+	return static[:len(def)], static
+}
+
+func TestFieldDefaults(t *testing.T) {
+	t.Parallel()
+
+	trailer := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	def, static := encodedPoint(t, 42, trailer)
+
+	_, seg := NewSingleSegmentMessage(nil)
+	st, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+	require.NoError(t, err)
+	l := line(st)
+
+	p, err := GetStructFieldDefault[point](l, 0, "line.start", def)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), Struct(p).Uint64(0))
+
+	t.Run("Shared", func(t *testing.T) {
+		p2, err := GetStructFieldDefault[point](l, 0, "line.start", def)
+		require.NoError(t, err)
+		assert.Same(t, Struct(p).Message(), Struct(p2).Message())
+
+		ptr, err := GetPtrFieldDefault(l, 0, "line.start", def)
+		require.NoError(t, err)
+		assert.Same(t, Struct(p).Message(), ptr.Message())
+	})
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		assert.Panics(t, func() { Struct(p).SetUint64(0, 7) })
+		assert.Equal(t, uint64(42), Struct(p).Uint64(0))
+
+		_, err := NewStruct(Struct(p).Segment(), ObjectSize{DataSize: 8})
+		assert.Error(t, err, "allocating in a default")
+		assert.Equal(t, trailer, static[len(def):], "static data after the default was overwritten")
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		_, seg := NewSingleSegmentMessage(nil)
+		st, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+		l := line(st)
+
+		require.NoError(t, SetStructField(l, 0, p), "copying a default into a message")
+		got, err := GetStructFieldDefault[point](l, 0, "line.start", def)
+		require.NoError(t, err)
+		assert.NotSame(t, Struct(p).Message(), Struct(got).Message())
+		Struct(got).SetUint64(0, 7)
+		assert.Equal(t, uint64(7), Struct(got).Uint64(0))
+		assert.Equal(t, uint64(42), Struct(p).Uint64(0))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		msg, seg := NewSingleSegmentMessage(nil)
+		ints, err := NewInt64List(seg, 2)
+		require.NoError(t, err)
+		ints.Set(1, 9)
+		require.NoError(t, msg.SetRoot(ints.ToPtr()))
+		def, err := msg.Marshal()
+		require.NoError(t, err)
+
+		got, err := GetListFieldDefault[Int64List](l, 0, "line.ints", def)
+		require.NoError(t, err)
+		require.Equal(t, 2, got.Len())
+		assert.Equal(t, int64(9), got.At(1))
+		assert.Panics(t, func() { got.Set(1, 0) })
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := GetStructFieldDefault[point](l, 0, "line.start", []byte{0, 0})
+		var fe *FieldError
+		require.ErrorAs(t, err, &fe)
+		assert.Equal(t, "line.start", fe.Path)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		got, err := GetStructFieldDefault[point](l, 0, "line.start", nil)
+		require.NoError(t, err)
+		assert.False(t, Struct(got).IsValid())
+	})
+}
@@ -99,6 +99,11 @@ capnpc-go will generate:
 	// It remains the same across languages and schema changes.
 	const Foo_TypeID = 0x8423424e9b01c0af
 
+	// Foo_TypeName is the fully-qualified name of the type Foo.
+	// It is only generated with capnpc-go -typenames, along with a
+	// TypeNames map from the type IDs in the package to their names.
+	const Foo_TypeName = "foo.capnp:Foo"
+
 	// NewFoo creates a new orphaned Foo struct, preferring placement in
 	// s.  If there isn't enough space, then another segment in the
 	// message will be used or allocated.  You can set a field of type Foo
@@ -0,0 +1,840 @@
+// Package dynamic converts Cap'n Proto structs to and from Go maps
+// based on a schema, for code that works with messages whose types are
+// only known at runtime, such as templates, rule engines and scripts.
+//
+// A struct is represented as a map[string]any keyed by field name.
+// Field values have the following Go types:
+//
+//	Void                        nil
+//	Bool                        bool
+//	Int8, ..., UInt64           int8, ..., uint64
+//	Float32, Float64            float32, float64
+//	Text                        string
+//	Data                        []byte
+//	enums                       string (the enumerant name), or uint16
+//	                            for values not in the schema
+//	structs and groups          map[string]any
+//	List(T)                     []any
+//	interfaces                  capnp.Client
+//	AnyPointer                  capnp.Ptr
+//
+// Null struct, list, interface and AnyPointer fields without a default
+// value are nil.  Of the fields in a union, only the one that is set is
+// present in the map.
+package dynamic
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+// ToMap returns the fields of s, a struct of the type with the given ID,
+// using the schemas in the default registry.
+func ToMap(typeID uint64, s capnp.Struct) (map[string]any, error) {
+	return new(Converter).ToMap(typeID, s)
+}
+
+// FromMap sets the fields of s, a struct of the type with the given ID,
+// from m, using the schemas in the default registry.
+func FromMap(typeID uint64, s capnp.Struct, m map[string]any) error {
+	return new(Converter).FromMap(typeID, s, m)
+}
+
+// A Converter converts structs to and from maps.  The zero value uses
+// the schemas in the default registry.  A Converter caches the schema
+// nodes it has looked up, so it is cheaper to reuse one than to call
+// the package-level functions repeatedly.  It is not safe to use from
+// multiple goroutines.
+type Converter struct {
+	nodes nodemap.Map
+}
+
+// UseRegistry changes the registry that the converter consults for
+// schemas from the default registry.
+func (c *Converter) UseRegistry(reg *schemas.Registry) {
+	c.nodes.UseRegistry(reg)
+}
+
+// ToMap returns the fields of s, a struct of the type with the given
+// ID.  Interface fields are the clients in s's message's capability
+// table, so they must not be released and are only valid as long as
+// the message is.
+func (c *Converter) ToMap(typeID uint64, s capnp.Struct) (map[string]any, error) {
+	n, err := c.structNode(typeID)
+	if err != nil {
+		return nil, exc.WrapError("to map", err)
+	}
+	m, err := c.toMap(n, s)
+	if err != nil {
+		return nil, exc.WrapError("to map", err)
+	}
+	return m, nil
+}
+
+// FromMap sets the fields of s, a struct of the type with the given
+// ID, from m, accepting the types that ToMap produces.  Fields that
+// are not in m are left unchanged, and keys that are not fields are an
+// error.  Setting a field in a union selects it.
+//
+// Numeric fields also accept any Go integer or floating-point value
+// that fits the field without loss, such as the float64 values that
+// encoding/json produces.  Enum fields accept an enumerant name or a
+// number, Data fields accept a string, and list fields accept a slice
+// of any type.  An interface field set to a capnp.Client stores a new
+// reference to the client in s's message.
+func (c *Converter) FromMap(typeID uint64, s capnp.Struct, m map[string]any) error {
+	n, err := c.structNode(typeID)
+	if err != nil {
+		return exc.WrapError("from map", err)
+	}
+	if err := c.fromMap(n, s, m); err != nil {
+		return exc.WrapError("from map", err)
+	}
+	return nil
+}
+
+func (c *Converter) structNode(typeID uint64) (schema.Node, error) {
+	n, err := c.nodes.Find(typeID)
+	if err != nil {
+		return schema.Node{}, err
+	}
+	if !n.IsValid() || n.Which() != schema.Node_Which_structNode {
+		return schema.Node{}, errors.New("cannot find struct type " + str.UToHex(typeID))
+	}
+	return n, nil
+}
+
+func (c *Converter) toMap(n schema.Node, s capnp.Struct) (map[string]any, error) {
+	var discriminant uint16
+	if n.StructNode().DiscriminantCount() > 0 {
+		discriminant = s.Uint16(capnp.DataOffset(n.StructNode().DiscriminantOffset() * 2))
+	}
+	fields, err := n.StructNode().Fields()
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]any, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		if dv := f.DiscriminantValue(); !(dv == schema.Field_noDiscriminant || dv == discriminant) {
+			continue
+		}
+		name, err := f.Name()
+		if err != nil {
+			return nil, err
+		}
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			m[name], err = c.slotValue(s, f)
+		case schema.Field_Which_group:
+			var g schema.Node
+			if g, err = c.structNode(f.Group().TypeId()); err == nil {
+				m[name], err = c.toMap(g, s)
+			}
+		}
+		if err != nil {
+			return nil, exc.WrapError("field "+name, err)
+		}
+	}
+	return m, nil
+}
+
+func (c *Converter) slotValue(s capnp.Struct, f schema.Field) (any, error) {
+	typ, err := f.Slot().Type()
+	if err != nil {
+		return nil, err
+	}
+	dv, err := f.Slot().DefaultValue()
+	if err != nil {
+		return nil, err
+	}
+	off := f.Slot().Offset()
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		return nil, nil
+	case schema.Type_Which_bool:
+		return s.Bit(capnp.BitOffset(off)) != (dv.IsValid() && dv.Bool()), nil
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_structType,
+		schema.Type_Which_list, schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		p, err := s.Ptr(uint16(off))
+		if err != nil {
+			return nil, err
+		}
+		if !p.IsValid() && dv.IsValid() {
+			p = defaultPtr(dv)
+		}
+		return c.ptrValue(typ, p)
+	}
+	width := scalarWidth(typ.Which())
+	var bits uint64
+	switch width {
+	case 8:
+		bits = uint64(s.Uint8(capnp.DataOffset(off)))
+	case 16:
+		bits = uint64(s.Uint16(capnp.DataOffset(off * 2)))
+	case 32:
+		bits = uint64(s.Uint32(capnp.DataOffset(off * 4)))
+	case 64:
+		bits = s.Uint64(capnp.DataOffset(off * 8))
+	}
+	return c.scalarValue(typ, bits^defaultBits(dv))
+}
+
+// scalarValue returns the value of a non-pointer type with the given
+// bit pattern.
+func (c *Converter) scalarValue(typ schema.Type, bits uint64) (any, error) {
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		return nil, nil
+	case schema.Type_Which_bool:
+		return bits != 0, nil
+	case schema.Type_Which_int8:
+		return int8(bits), nil
+	case schema.Type_Which_int16:
+		return int16(bits), nil
+	case schema.Type_Which_int32:
+		return int32(bits), nil
+	case schema.Type_Which_int64:
+		return int64(bits), nil
+	case schema.Type_Which_uint8:
+		return uint8(bits), nil
+	case schema.Type_Which_uint16:
+		return uint16(bits), nil
+	case schema.Type_Which_uint32:
+		return uint32(bits), nil
+	case schema.Type_Which_uint64:
+		return bits, nil
+	case schema.Type_Which_float32:
+		return math.Float32frombits(uint32(bits)), nil
+	case schema.Type_Which_float64:
+		return math.Float64frombits(bits), nil
+	case schema.Type_Which_enum:
+		return c.enumValue(typ.Enum().TypeId(), uint16(bits))
+	default:
+		return nil, errors.New("unknown type " + typ.Which().String())
+	}
+}
+
+func (c *Converter) enumValue(typeID uint64, v uint16) (any, error) {
+	n, err := c.nodes.Find(typeID)
+	if err != nil {
+		return nil, err
+	}
+	if n.Which() != schema.Node_Which_enum {
+		return nil, errors.New("type " + str.UToHex(typeID) + " is not an enum")
+	}
+	enums, err := n.Enum().Enumerants()
+	if err != nil {
+		return nil, err
+	}
+	if int(v) >= enums.Len() {
+		return v, nil
+	}
+	return enums.At(int(v)).Name()
+}
+
+// ptrValue returns the value of a pointer type.
+func (c *Converter) ptrValue(typ schema.Type, p capnp.Ptr) (any, error) {
+	switch typ.Which() {
+	case schema.Type_Which_text:
+		return p.Text(), nil
+	case schema.Type_Which_data:
+		return p.Data(), nil
+	case schema.Type_Which_structType:
+		if !p.IsValid() {
+			return nil, nil
+		}
+		n, err := c.structNode(typ.StructType().TypeId())
+		if err != nil {
+			return nil, err
+		}
+		return c.toMap(n, p.Struct())
+	case schema.Type_Which_list:
+		if !p.IsValid() {
+			return nil, nil
+		}
+		elem, err := typ.List().ElementType()
+		if err != nil {
+			return nil, err
+		}
+		return c.listValue(elem, p.List())
+	case schema.Type_Which_interface:
+		if !p.IsValid() {
+			return nil, nil
+		}
+		return p.Interface().Client(), nil
+	case schema.Type_Which_anyPointer:
+		if !p.IsValid() {
+			return nil, nil
+		}
+		return p, nil
+	default:
+		return nil, errors.New("unknown type " + typ.Which().String())
+	}
+}
+
+func (c *Converter) listValue(elem schema.Type, l capnp.List) ([]any, error) {
+	vals := make([]any, l.Len())
+	switch elem.Which() {
+	case schema.Type_Which_structType:
+		n, err := c.structNode(elem.StructType().TypeId())
+		if err != nil {
+			return nil, err
+		}
+		for i := range vals {
+			if vals[i], err = c.toMap(n, l.Struct(i)); err != nil {
+				return nil, exc.WrapError("element "+str.Itod(i), err)
+			}
+		}
+		return vals, nil
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_list,
+		schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		for i := range vals {
+			p, err := capnp.PointerList(l).At(i)
+			if err == nil {
+				vals[i], err = c.ptrValue(elem, p)
+			}
+			if err != nil {
+				return nil, exc.WrapError("element "+str.Itod(i), err)
+			}
+		}
+		return vals, nil
+	}
+	for i := range vals {
+		var bits uint64
+		switch scalarWidth(elem.Which()) {
+		case 1:
+			if capnp.BitList(l).At(i) {
+				bits = 1
+			}
+		case 8:
+			bits = uint64(capnp.UInt8List(l).At(i))
+		case 16:
+			bits = uint64(capnp.UInt16List(l).At(i))
+		case 32:
+			bits = uint64(capnp.UInt32List(l).At(i))
+		case 64:
+			bits = capnp.UInt64List(l).At(i)
+		}
+		var err error
+		if vals[i], err = c.scalarValue(elem, bits); err != nil {
+			return nil, exc.WrapError("element "+str.Itod(i), err)
+		}
+	}
+	return vals, nil
+}
+
+func (c *Converter) fromMap(n schema.Node, s capnp.Struct, m map[string]any) error {
+	fields, err := n.StructNode().Fields()
+	if err != nil {
+		return err
+	}
+	for name, v := range m {
+		f, ok := findField(fields, name)
+		if !ok {
+			return errors.New("unknown field " + name)
+		}
+		if dv := f.DiscriminantValue(); dv != schema.Field_noDiscriminant {
+			s.SetUint16(capnp.DataOffset(n.StructNode().DiscriminantOffset()*2), dv)
+		}
+		switch f.Which() {
+		case schema.Field_Which_slot:
+			err = c.setSlot(s, f, v)
+		case schema.Field_Which_group:
+			var g schema.Node
+			if g, err = c.structNode(f.Group().TypeId()); err == nil {
+				gm, ok := v.(map[string]any)
+				if !ok {
+					err = errors.New("group value is " + typeName(v) + ", want map[string]any")
+				} else {
+					err = c.fromMap(g, s, gm)
+				}
+			}
+		}
+		if err != nil {
+			return exc.WrapError("field "+name, err)
+		}
+	}
+	return nil
+}
+
+func findField(fields schema.Field_List, name string) (schema.Field, bool) {
+	for i := 0; i < fields.Len(); i++ {
+		f := fields.At(i)
+		if got, _ := f.Name(); got == name {
+			return f, true
+		}
+	}
+	return schema.Field{}, false
+}
+
+// setSlot sets the slot field f of s to v.  Data fields are stored
+// XORed with their default value.
+func (c *Converter) setSlot(s capnp.Struct, f schema.Field, v any) error {
+	typ, err := f.Slot().Type()
+	if err != nil {
+		return err
+	}
+	off := f.Slot().Offset()
+	switch typ.Which() {
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_structType,
+		schema.Type_Which_list, schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		p, err := c.newPtr(s.Segment(), typ, v)
+		if err != nil {
+			return err
+		}
+		return s.SetPtr(uint16(off), p)
+	}
+	bits, err := c.scalarBits(typ, v)
+	if err != nil {
+		return err
+	}
+	dv, err := f.Slot().DefaultValue()
+	if err != nil {
+		return err
+	}
+	bits ^= defaultBits(dv)
+	switch scalarWidth(typ.Which()) {
+	case 1:
+		s.SetBit(capnp.BitOffset(off), bits != 0)
+	case 8:
+		s.SetUint8(capnp.DataOffset(off), uint8(bits))
+	case 16:
+		s.SetUint16(capnp.DataOffset(off*2), uint16(bits))
+	case 32:
+		s.SetUint32(capnp.DataOffset(off*4), uint32(bits))
+	case 64:
+		s.SetUint64(capnp.DataOffset(off*8), bits)
+	}
+	return nil
+}
+
+// newPtr allocates a value of a pointer type in seg.
+func (c *Converter) newPtr(seg *capnp.Segment, typ schema.Type, v any) (capnp.Ptr, error) {
+	if v == nil {
+		return capnp.Ptr{}, nil
+	}
+	switch typ.Which() {
+	case schema.Type_Which_text:
+		t, ok := v.(string)
+		if !ok {
+			return capnp.Ptr{}, errors.New("text value is " + typeName(v) + ", want string")
+		}
+		if t == "" {
+			return capnp.Ptr{}, nil
+		}
+		p, err := capnp.NewText(seg, t)
+		return p.ToPtr(), err
+	case schema.Type_Which_data:
+		var b []byte
+		switch v := v.(type) {
+		case []byte:
+			b = v
+		case string:
+			b = []byte(v)
+		default:
+			return capnp.Ptr{}, errors.New("data value is " + typeName(v) + ", want []byte or string")
+		}
+		if len(b) == 0 {
+			return capnp.Ptr{}, nil
+		}
+		p, err := capnp.NewData(seg, b)
+		return p.ToPtr(), err
+	case schema.Type_Which_structType:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return capnp.Ptr{}, errors.New("struct value is " + typeName(v) + ", want map[string]any")
+		}
+		n, err := c.structNode(typ.StructType().TypeId())
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		s, err := capnp.NewStruct(seg, structSize(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		return s.ToPtr(), c.fromMap(n, s, m)
+	case schema.Type_Which_list:
+		elem, err := typ.List().ElementType()
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		return c.newList(seg, elem, v)
+	case schema.Type_Which_interface:
+		client, ok := v.(capnp.Client)
+		if !ok {
+			return capnp.Ptr{}, errors.New("interface value is " + typeName(v) + ", want capnp.Client")
+		}
+		if (client == capnp.Client{}) {
+			return capnp.Ptr{}, nil
+		}
+		id := seg.Message().CapTable().Add(client.AddRef())
+		return capnp.NewInterface(seg, id).ToPtr(), nil
+	case schema.Type_Which_anyPointer:
+		p, ok := v.(capnp.Ptr)
+		if !ok {
+			return capnp.Ptr{}, errors.New("AnyPointer value is " + typeName(v) + ", want capnp.Ptr")
+		}
+		return p, nil
+	default:
+		return capnp.Ptr{}, errors.New("unknown type " + typ.Which().String())
+	}
+}
+
+// newList allocates a list with elements of type elem in seg and fills
+// it from v, which must be a slice.
+func (c *Converter) newList(seg *capnp.Segment, elem schema.Type, v any) (capnp.Ptr, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return capnp.Ptr{}, errors.New("list value is " + typeName(v) + ", want a slice")
+	}
+	n := rv.Len()
+	if n > math.MaxInt32 {
+		return capnp.Ptr{}, errors.New("list too long")
+	}
+	switch elem.Which() {
+	case schema.Type_Which_void:
+		return capnp.NewVoidList(seg, int32(n)).ToPtr(), nil
+	case schema.Type_Which_structType:
+		sn, err := c.structNode(elem.StructType().TypeId())
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		l, err := capnp.NewCompositeList(seg, structSize(sn), int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := 0; i < n; i++ {
+			e := rv.Index(i).Interface()
+			m, ok := e.(map[string]any)
+			if !ok {
+				return capnp.Ptr{}, errors.New("element " + str.Itod(i) + " is " + typeName(e) + ", want map[string]any")
+			}
+			if err := c.fromMap(sn, l.Struct(i), m); err != nil {
+				return capnp.Ptr{}, exc.WrapError("element "+str.Itod(i), err)
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_list,
+		schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		l, err := capnp.NewPointerList(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := 0; i < n; i++ {
+			p, err := c.newPtr(seg, elem, rv.Index(i).Interface())
+			if err == nil {
+				err = l.Set(i, p)
+			}
+			if err != nil {
+				return capnp.Ptr{}, exc.WrapError("element "+str.Itod(i), err)
+			}
+		}
+		return l.ToPtr(), nil
+	}
+
+	bits := make([]uint64, n)
+	for i := range bits {
+		var err error
+		if bits[i], err = c.scalarBits(elem, rv.Index(i).Interface()); err != nil {
+			return capnp.Ptr{}, exc.WrapError("element "+str.Itod(i), err)
+		}
+	}
+	switch scalarWidth(elem.Which()) {
+	case 1:
+		l, err := capnp.NewBitList(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, bits[i] != 0)
+		}
+		return l.ToPtr(), nil
+	case 8:
+		l, err := capnp.NewUInt8List(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, uint8(bits[i]))
+		}
+		return l.ToPtr(), nil
+	case 16:
+		l, err := capnp.NewUInt16List(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, uint16(bits[i]))
+		}
+		return l.ToPtr(), nil
+	case 32:
+		l, err := capnp.NewUInt32List(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, uint32(bits[i]))
+		}
+		return l.ToPtr(), nil
+	default:
+		l, err := capnp.NewUInt64List(seg, int32(n))
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i := range bits {
+			l.Set(i, bits[i])
+		}
+		return l.ToPtr(), nil
+	}
+}
+
+// scalarBits returns the bit pattern of v as a value of a non-pointer
+// type.
+func (c *Converter) scalarBits(typ schema.Type, v any) (uint64, error) {
+	switch typ.Which() {
+	case schema.Type_Which_void:
+		if v != nil {
+			return 0, errors.New("void value is " + typeName(v) + ", want nil")
+		}
+		return 0, nil
+	case schema.Type_Which_bool:
+		b, ok := v.(bool)
+		if !ok {
+			return 0, errors.New("bool value is " + typeName(v) + ", want bool")
+		}
+		if b {
+			return 1, nil
+		}
+		return 0, nil
+	case schema.Type_Which_enum:
+		if name, ok := v.(string); ok {
+			return c.enumerant(typ.Enum().TypeId(), name)
+		}
+		u, ok := toUint(v, 16)
+		if !ok {
+			return 0, errors.New("enum value is " + typeName(v) + ", want an enumerant name or a uint16")
+		}
+		return u, nil
+	case schema.Type_Which_float32:
+		f, ok := toFloat(v)
+		if !ok {
+			return 0, errors.New("float32 value is " + typeName(v) + ", want a number")
+		}
+		return uint64(math.Float32bits(float32(f))), nil
+	case schema.Type_Which_float64:
+		f, ok := toFloat(v)
+		if !ok {
+			return 0, errors.New("float64 value is " + typeName(v) + ", want a number")
+		}
+		return math.Float64bits(f), nil
+	}
+	width := scalarWidth(typ.Which())
+	switch typ.Which() {
+	case schema.Type_Which_int8, schema.Type_Which_int16, schema.Type_Which_int32, schema.Type_Which_int64:
+		i, ok := toInt(v, width)
+		if !ok {
+			return 0, errors.New(typ.Which().String() + " value " + valueString(v) + " does not fit")
+		}
+		return uint64(i) & widthMask(width), nil
+	default:
+		u, ok := toUint(v, width)
+		if !ok {
+			return 0, errors.New(typ.Which().String() + " value " + valueString(v) + " does not fit")
+		}
+		return u, nil
+	}
+}
+
+func (c *Converter) enumerant(typeID uint64, name string) (uint64, error) {
+	n, err := c.nodes.Find(typeID)
+	if err != nil {
+		return 0, err
+	}
+	if n.Which() != schema.Node_Which_enum {
+		return 0, errors.New("type " + str.UToHex(typeID) + " is not an enum")
+	}
+	enums, err := n.Enum().Enumerants()
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < enums.Len(); i++ {
+		if got, _ := enums.At(i).Name(); got == name {
+			return uint64(i), nil
+		}
+	}
+	return 0, errors.New("unknown enumerant " + name)
+}
+
+// toInt converts a Go number to a signed integer of the given width,
+// reporting whether it fits without loss.
+func toInt(v any, width int) (int64, bool) {
+	rv := reflect.ValueOf(v)
+	var i int64
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i = rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return 0, false
+		}
+		i = int64(u)
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+			return 0, false
+		}
+		i = int64(f)
+	default:
+		return 0, false
+	}
+	if width < 64 && (i < -1<<(width-1) || i >= 1<<(width-1)) {
+		return 0, false
+	}
+	return i, true
+}
+
+// toUint converts a Go number to an unsigned integer of the given
+// width, reporting whether it fits without loss.
+func toUint(v any, width int) (uint64, bool) {
+	rv := reflect.ValueOf(v)
+	var u uint64
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := rv.Int()
+		if i < 0 {
+			return 0, false
+		}
+		u = uint64(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u = rv.Uint()
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+			return 0, false
+		}
+		u = uint64(f)
+	default:
+		return 0, false
+	}
+	if u&^widthMask(width) != 0 {
+		return 0, false
+	}
+	return u, true
+}
+
+// toFloat converts a Go number to a float64.
+func toFloat(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func typeName(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(v).String()
+}
+
+func valueString(v any) string {
+	if f, ok := toFloat(v); ok {
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return typeName(v)
+}
+
+func structSize(n schema.Node) capnp.ObjectSize {
+	return capnp.ObjectSize{
+		DataSize:     capnp.Size(n.StructNode().DataWordCount()) * 8,
+		PointerCount: n.StructNode().PointerCount(),
+	}
+}
+
+// scalarWidth returns the size in bits of values of a non-pointer type.
+func scalarWidth(w schema.Type_Which) int {
+	switch w {
+	case schema.Type_Which_void:
+		return 0
+	case schema.Type_Which_bool:
+		return 1
+	case schema.Type_Which_int8, schema.Type_Which_uint8:
+		return 8
+	case schema.Type_Which_int16, schema.Type_Which_uint16, schema.Type_Which_enum:
+		return 16
+	case schema.Type_Which_int32, schema.Type_Which_uint32, schema.Type_Which_float32:
+		return 32
+	default:
+		return 64
+	}
+}
+
+func widthMask(width int) uint64 {
+	if width >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<uint(width) - 1
+}
+
+// defaultBits returns the bit pattern of a field's default value.
+func defaultBits(dv schema.Value) uint64 {
+	if !dv.IsValid() {
+		return 0
+	}
+	switch dv.Which() {
+	case schema.Value_Which_bool:
+		if dv.Bool() {
+			return 1
+		}
+		return 0
+	case schema.Value_Which_int8:
+		return uint64(uint8(dv.Int8()))
+	case schema.Value_Which_int16:
+		return uint64(uint16(dv.Int16()))
+	case schema.Value_Which_int32:
+		return uint64(uint32(dv.Int32()))
+	case schema.Value_Which_int64:
+		return uint64(dv.Int64())
+	case schema.Value_Which_uint8:
+		return uint64(dv.Uint8())
+	case schema.Value_Which_uint16:
+		return uint64(dv.Uint16())
+	case schema.Value_Which_uint32:
+		return uint64(dv.Uint32())
+	case schema.Value_Which_uint64:
+		return dv.Uint64()
+	case schema.Value_Which_float32:
+		return uint64(math.Float32bits(dv.Float32()))
+	case schema.Value_Which_float64:
+		return math.Float64bits(dv.Float64())
+	case schema.Value_Which_enum:
+		return uint64(dv.Enum())
+	default:
+		return 0
+	}
+}
+
+// defaultPtr returns the pointer in a field's default value, which is
+// the Value struct's only pointer for all pointer types.
+func defaultPtr(dv schema.Value) capnp.Ptr {
+	p, _ := capnp.Struct(dv).Ptr(0)
+	return p
+}
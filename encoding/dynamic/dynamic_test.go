@@ -0,0 +1,160 @@
+package dynamic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+)
+
+const testSchema = `
+@0xa93fc509624c72d9;
+
+struct Thing @0xd0b2a5b4c7a95b2d {
+  name @0 :Text;
+  count @1 :Int32 = 7;
+  ok @2 :Bool = true;
+  ratio @3 :Float64;
+  color @4 :Color;
+  blob @5 :Data;
+  tags @6 :List(Text);
+  nums @7 :List(UInt16);
+  child @8 :Thing;
+  children @9 :List(Thing);
+  greeting @10 :Text = "hello";
+  union {
+    none @11 :Void;
+    id @12 :UInt64;
+  }
+  pos :group {
+    x @13 :Int8;
+    y @14 :Int8 = -1;
+  }
+}
+
+enum Color @0xe6cd2b7f0b3b9f01 {
+  red @0;
+  green @1;
+}
+`
+
+const thingID = 0xd0b2a5b4c7a95b2d
+
+func newConverter(t *testing.T) *Converter {
+	req, err := compiler.CompileString(nil, "thing.capnp", testSchema)
+	require.NoError(t, err)
+	data, err := req.Message().Marshal()
+	require.NoError(t, err)
+	nodes, err := req.Nodes()
+	require.NoError(t, err)
+	var ids []uint64
+	for i := 0; i < nodes.Len(); i++ {
+		ids = append(ids, nodes.At(i).Id())
+	}
+	reg := new(schemas.Registry)
+	require.NoError(t, reg.Register(&schemas.Schema{Bytes: data, Nodes: ids}))
+	c := new(Converter)
+	c.UseRegistry(reg)
+	return c
+}
+
+func newThing(t *testing.T, c *Converter) capnp.Struct {
+	n, err := c.structNode(thingID)
+	require.NoError(t, err)
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	require.NoError(t, err)
+	s, err := capnp.NewRootStruct(seg, structSize(n))
+	require.NoError(t, err)
+	return s
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	c := newConverter(t)
+	in := map[string]any{
+		"name":     "widget",
+		"count":    int32(-3),
+		"ok":       false,
+		"ratio":    0.25,
+		"color":    "green",
+		"blob":     []byte{1, 2, 3},
+		"tags":     []any{"a", "b"},
+		"nums":     []any{uint16(1), uint16(2)},
+		"child":    map[string]any{"name": "kid"},
+		"children": []any{map[string]any{"id": uint64(9)}},
+		"greeting": "hi",
+		"id":       uint64(42),
+		"pos":      map[string]any{"x": int8(3)},
+	}
+	s := newThing(t, c)
+	require.NoError(t, c.FromMap(thingID, s, in))
+	out, err := c.ToMap(thingID, s)
+	require.NoError(t, err)
+
+	assert.Equal(t, "widget", out["name"])
+	assert.Equal(t, int32(-3), out["count"])
+	assert.Equal(t, false, out["ok"])
+	assert.Equal(t, 0.25, out["ratio"])
+	assert.Equal(t, "green", out["color"])
+	assert.Equal(t, []byte{1, 2, 3}, out["blob"])
+	assert.Equal(t, []any{"a", "b"}, out["tags"])
+	assert.Equal(t, []any{uint16(1), uint16(2)}, out["nums"])
+	assert.Equal(t, "hi", out["greeting"])
+	assert.Equal(t, uint64(42), out["id"])
+	assert.NotContains(t, out, "none", "only the active union member")
+	assert.Equal(t, map[string]any{"x": int8(3), "y": int8(-1)}, out["pos"])
+
+	child := out["child"].(map[string]any)
+	assert.Equal(t, "kid", child["name"])
+	assert.Equal(t, int32(7), child["count"], "default int")
+	assert.Equal(t, true, child["ok"], "default bool")
+	assert.Equal(t, "hello", child["greeting"], "default text")
+	assert.Nil(t, child["child"], "null struct")
+	assert.Contains(t, child, "none")
+	children := out["children"].([]any)
+	require.Len(t, children, 1)
+	assert.Equal(t, uint64(9), children[0].(map[string]any)["id"])
+}
+
+func TestFromJSON(t *testing.T) {
+	t.Parallel()
+
+	var in map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{"count": 12, "nums": [1, 65535], "color": 1, "blob": "hi"}`), &in))
+	c := newConverter(t)
+	s := newThing(t, c)
+	require.NoError(t, c.FromMap(thingID, s, in))
+	out, err := c.ToMap(thingID, s)
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), out["count"])
+	assert.Equal(t, []any{uint16(1), uint16(65535)}, out["nums"])
+	assert.Equal(t, "green", out["color"])
+	assert.Equal(t, []byte("hi"), out["blob"])
+}
+
+func TestFromMapErrors(t *testing.T) {
+	t.Parallel()
+
+	c := newConverter(t)
+	tests := []map[string]any{
+		{"bogus": 1},
+		{"count": 1.5},
+		{"count": int64(1) << 40},
+		{"nums": []any{-1}},
+		{"color": "blue"},
+		{"name": 42},
+		{"child": "kid"},
+		{"pos": map[string]any{"z": 1}},
+		{"tags": "a"},
+	}
+	for _, in := range tests {
+		assert.Error(t, c.FromMap(thingID, newThing(t, c), in), "%v", in)
+	}
+	assert.Error(t, c.FromMap(0x1234, newThing(t, c), nil), "unknown type")
+}
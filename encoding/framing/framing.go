@@ -0,0 +1,200 @@
+// Package framing delimits Cap'n Proto messages in byte streams.
+//
+// The standard serialization of a message starts with a segment table
+// that gives the sizes of the message's segments, so a stream of
+// messages needs no further framing.  Protocols that carry other data
+// besides Cap'n Proto messages usually delimit their payloads in some
+// way of their own, such as with a length prefix.  A Framer wraps each
+// serialized message in such a frame, so that messages can be embedded
+// in those protocols.
+//
+// capnp.NewFramedEncoder and capnp.NewFramedDecoder read and write
+// framed streams, and the rpc stream transports accept a Framer in
+// their options.
+package framing
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+)
+
+// ErrTooLarge is returned by ReadHeader when the payload of a frame is
+// larger than the maximum size.
+var ErrTooLarge = errors.New("framing: frame too large")
+
+// A Framer writes and reads the frames that delimit messages in a
+// stream.  The payload of each frame is a message in the standard
+// serialization, segment table included.  Framers have no state, so a
+// Framer may be used by many streams at once.
+type Framer interface {
+	// AppendHeader appends the bytes that start a frame with a
+	// payload of size bytes to dst and returns the result.
+	AppendHeader(dst []byte, size uint64) []byte
+
+	// AppendTrailer appends the bytes that end a frame to dst and
+	// returns the result.
+	AppendTrailer(dst []byte) []byte
+
+	// ReadHeader reads the start of a frame from r and returns the
+	// size of its payload.  If reading the header consumed the start
+	// of the payload, those bytes are returned in prefix; they count
+	// toward size.  The error is io.EOF only if no bytes were read,
+	// and ErrTooLarge if size would be greater than maxSize.
+	ReadHeader(r io.Reader, maxSize uint64) (size uint64, prefix []byte, err error)
+
+	// ReadTrailer reads the end of a frame, which follows its
+	// payload, from r.
+	ReadTrailer(r io.Reader) error
+}
+
+// Standard is the framing of the standard serialization, in which the
+// segment table delimits each message.  It adds no bytes of its own.
+var Standard Framer = standard{}
+
+// Varint prefixes each message with its size in bytes, encoded as an
+// unsigned base 128 varint as by binary.AppendUvarint.  This is the
+// framing of length-delimited Protocol Buffers streams.
+var Varint Framer = varint{}
+
+// Netstring frames each message as a netstring: its size in bytes as
+// a decimal number, then a colon, the message and a comma.
+var Netstring Framer = netstring{}
+
+type standard struct{}
+
+func (standard) AppendHeader(dst []byte, size uint64) []byte { return dst }
+func (standard) AppendTrailer(dst []byte) []byte             { return dst }
+func (standard) ReadTrailer(r io.Reader) error               { return nil }
+
+// maxSegments is the largest number of segments that the segment table
+// of a message may give.  It matches the limit of capnp.Decoder.
+const maxSegments = 512
+
+func (standard) ReadHeader(r io.Reader, maxSize uint64) (uint64, []byte, error) {
+	var first [8]byte
+	if _, err := io.ReadFull(r, first[:]); err != nil {
+		return 0, nil, err
+	}
+	nsegs := uint64(binary.LittleEndian.Uint32(first[:])) + 1
+	if nsegs > maxSegments {
+		return 0, nil, errors.New("framing: too many segments")
+	}
+	hdrSize := (4*(nsegs+1) + 7) &^ 7
+	if hdrSize > maxSize {
+		return 0, nil, ErrTooLarge
+	}
+	hdr := make([]byte, hdrSize)
+	copy(hdr, first[:])
+	if _, err := io.ReadFull(r, hdr[len(first):]); err != nil {
+		return 0, nil, unexpected(err)
+	}
+	size := hdrSize
+	for i := uint64(0); i < nsegs; i++ {
+		size += 8 * uint64(binary.LittleEndian.Uint32(hdr[4+4*i:]))
+	}
+	if size > maxSize {
+		return 0, nil, ErrTooLarge
+	}
+	return size, hdr, nil
+}
+
+type varint struct{}
+
+func (varint) AppendHeader(dst []byte, size uint64) []byte {
+	return binary.AppendUvarint(dst, size)
+}
+
+func (varint) AppendTrailer(dst []byte) []byte { return dst }
+func (varint) ReadTrailer(r io.Reader) error   { return nil }
+
+func (varint) ReadHeader(r io.Reader, maxSize uint64) (uint64, []byte, error) {
+	size, err := binary.ReadUvarint(byteReader(r))
+	if err != nil {
+		return 0, nil, err
+	}
+	if size > maxSize {
+		return 0, nil, ErrTooLarge
+	}
+	return size, nil, nil
+}
+
+type netstring struct{}
+
+func (netstring) AppendHeader(dst []byte, size uint64) []byte {
+	dst = strconv.AppendUint(dst, size, 10)
+	return append(dst, ':')
+}
+
+func (netstring) AppendTrailer(dst []byte) []byte {
+	return append(dst, ',')
+}
+
+func (netstring) ReadHeader(r io.Reader, maxSize uint64) (uint64, []byte, error) {
+	br := byteReader(r)
+	var size uint64
+	for n := 0; ; n++ {
+		c, err := br.ReadByte()
+		if err != nil {
+			if n > 0 {
+				err = unexpected(err)
+			}
+			return 0, nil, err
+		}
+		switch {
+		case c == ':' && n > 0:
+			return size, nil, nil
+		case c < '0' || c > '9':
+			return 0, nil, errors.New("framing: malformed netstring length")
+		case n > 0 && size == 0:
+			return 0, nil, errors.New("framing: netstring length has leading zero")
+		}
+		d := uint64(c - '0')
+		if d > maxSize || size > (maxSize-d)/10 {
+			return 0, nil, ErrTooLarge
+		}
+		size = size*10 + d
+	}
+}
+
+func (netstring) ReadTrailer(r io.Reader) error {
+	c, err := byteReader(r).ReadByte()
+	if err != nil {
+		return unexpected(err)
+	}
+	if c != ',' {
+		return errors.New("framing: netstring does not end with a comma")
+	}
+	return nil
+}
+
+// byteReader returns r as an io.ByteReader, reading a byte at a time
+// if r cannot do so itself.
+func byteReader(r io.Reader) io.ByteReader {
+	if br, ok := r.(io.ByteReader); ok {
+		return br
+	}
+	return &singleByteReader{r: r}
+}
+
+type singleByteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+func (sr *singleByteReader) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(sr.r, sr.buf[:]); err != nil {
+		return 0, err
+	}
+	return sr.buf[0], nil
+}
+
+// unexpected returns io.ErrUnexpectedEOF in place of io.EOF, for reads
+// in the middle of a frame.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,89 @@
+package framing
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestFramers(t *testing.T) {
+	t.Parallel()
+
+	// A message with one segment of one word.
+	msg := []byte{0, 0, 0, 0, 1, 0, 0, 0, 1, 2, 3, 4, 5, 6, 7, 8}
+	tests := []struct {
+		name   string
+		f      Framer
+		framed []byte
+	}{
+		{"Standard", Standard, msg},
+		{"Varint", Varint, append([]byte{16}, msg...)},
+		{"Netstring", Netstring, append(append([]byte("16:"), msg...), ',')},
+	}
+	for _, test := range tests {
+		frame := test.f.AppendHeader(nil, uint64(len(msg)))
+		frame = append(frame, msg...)
+		frame = test.f.AppendTrailer(frame)
+		if !bytes.Equal(frame, test.framed) {
+			t.Errorf("%s: frame = %q; want %q", test.name, frame, test.framed)
+		}
+
+		r := bytes.NewReader(test.framed)
+		size, prefix, err := test.f.ReadHeader(r, 1024)
+		if err != nil {
+			t.Errorf("%s: ReadHeader: %v", test.name, err)
+			continue
+		}
+		if size != uint64(len(msg)) {
+			t.Errorf("%s: ReadHeader size = %d; want %d", test.name, size, len(msg))
+		}
+		payload := make([]byte, int(size)-len(prefix))
+		if _, err := io.ReadFull(r, payload); err != nil {
+			t.Errorf("%s: reading payload: %v", test.name, err)
+			continue
+		}
+		if got := append(prefix, payload...); !bytes.Equal(got, msg) {
+			t.Errorf("%s: payload = %q; want %q", test.name, got, msg)
+		}
+		if err := test.f.ReadTrailer(r); err != nil {
+			t.Errorf("%s: ReadTrailer: %v", test.name, err)
+		}
+		if r.Len() != 0 {
+			t.Errorf("%s: %d bytes left after frame", test.name, r.Len())
+		}
+
+		if _, _, err := test.f.ReadHeader(bytes.NewReader(test.framed), 8); !errors.Is(err, ErrTooLarge) {
+			t.Errorf("%s: ReadHeader with small max size = %v; want ErrTooLarge", test.name, err)
+		}
+		if _, _, err := test.f.ReadHeader(bytes.NewReader(nil), 1024); err != io.EOF {
+			t.Errorf("%s: ReadHeader at end of stream = %v; want io.EOF", test.name, err)
+		}
+	}
+}
+
+func TestNetstringMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, in := range []string{":", "x:", "01:", "1x", "12"} {
+		if _, _, err := Netstring.ReadHeader(newOneByteReader(in), 1024); err == nil || err == io.EOF {
+			t.Errorf("ReadHeader(%q) = %v; want a malformed header error", in, err)
+		}
+	}
+	if err := Netstring.ReadTrailer(bytes.NewReader([]byte(";"))); err == nil {
+		t.Error("ReadTrailer(\";\") did not fail")
+	}
+}
+
+// oneByteReader is an io.Reader that is not an io.ByteReader.
+type oneByteReader struct {
+	r *bytes.Reader
+}
+
+func newOneByteReader(s string) io.Reader {
+	return oneByteReader{bytes.NewReader([]byte(s))}
+}
+
+func (r oneByteReader) Read(p []byte) (int, error) {
+	return r.r.Read(p[:1])
+}
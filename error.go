@@ -1,6 +1,9 @@
 package capnp
 
 import (
+	"errors"
+	"strconv"
+
 	"capnproto.org/go/capnp/v3/exc"
 )
 
@@ -28,3 +31,114 @@ func Disconnected(s string) error {
 func IsDisconnected(e error) bool {
 	return exc.TypeOf(e) == exc.Disconnected
 }
+
+// WithErrorDetail returns a copy of err with the struct v attached as
+// an exception detail, replacing any existing detail with the same id.
+// By convention, id is the type ID of v, e.g. Foo_TypeID.  Details are
+// sent along with the exception when err is returned from an RPC call,
+// and can be recovered on the other side with ErrorDetail.
+func WithErrorDetail[T ~StructKind](err error, id uint64, v T) error {
+	if err == nil {
+		return nil
+	}
+	data, err2 := Canonicalize(Struct(v))
+	if err2 != nil {
+		return exc.WrapError("attach error detail", err2)
+	}
+	return exc.WithDetail(err, id, data)
+}
+
+// ErrorDetail decodes the struct detail with the given id from the
+// first exception in err's chain.  ok is false if there is no such
+// detail.
+func ErrorDetail[T ~StructKind](err error, id uint64) (v T, ok bool, _ error) {
+	var e *exc.Exception
+	if !errors.As(err, &e) {
+		return T{}, false, nil
+	}
+	data, ok := e.Detail(id)
+	if !ok {
+		return T{}, false, nil
+	}
+	msg := &Message{Arena: SingleSegment(data)}
+	p, err := msg.Root()
+	if err != nil {
+		return T{}, true, exc.WrapError("read error detail", err)
+	}
+	return T(p.Struct()), true, nil
+}
+
+// A FieldError is an error reading a field of a struct, such as a
+// malformed or out-of-bounds pointer.  Accessors generated by
+// capnpc-go return FieldErrors, so that the error names the field
+// that could not be read.
+type FieldError struct {
+	// Path names the field as its struct's name in the schema file
+	// followed by the field's name, as in "Person.address".
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// An UnknownMemberError is returned by the Visit methods that capnpc-go
+// generates for unions when the union's discriminant names a member
+// that the generated code does not know, such as a member added in a
+// newer version of the schema.
+type UnknownMemberError struct {
+	// Union names the union as its struct's name in the schema file,
+	// followed by the union's name if it is a named union, as in
+	// "Shape" or "Person.employment".
+	Union string
+	Which uint16
+}
+
+func (e *UnknownMemberError) Error() string {
+	return e.Union + ": unknown union member " + strconv.FormatUint(uint64(e.Which), 10)
+}
+
+// A ValidationError is returned by the Validate methods that capnpc-go
+// generates for structs whose fields have validation annotations, such
+// as $Go.min or $Go.required, when a field's value breaks one of them.
+type ValidationError struct {
+	// Path names the field as FieldError.Path does.  For fields of
+	// nested structs, it is the path from the struct that Validate was
+	// called on, as in "Order.items[2].quantity".
+	Path   string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Reason
+}
+
+// ValidationErrorIn returns err, which was returned by the Validate
+// method of a struct of type typ, as an error of the struct that holds
+// it at path.  If err is a *ValidationError or a *FieldError, its Path
+// starts with typ, which is replaced with path; other errors are
+// returned unchanged.  Generated Validate methods call it for their
+// struct and list fields.
+func ValidationErrorIn(path, typ string, err error) error {
+	switch e := err.(type) {
+	case *ValidationError:
+		return &ValidationError{Path: reroot(path, typ, e.Path), Reason: e.Reason}
+	case *FieldError:
+		return &FieldError{Path: reroot(path, typ, e.Path), Err: e.Err}
+	default:
+		return err
+	}
+}
+
+// reroot replaces the prefix typ of p with path.
+func reroot(path, typ, p string) string {
+	if len(p) >= len(typ) && p[:len(typ)] == typ {
+		return path + p[len(typ):]
+	}
+	return path + "." + p
+}
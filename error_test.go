@@ -0,0 +1,61 @@
+package capnp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldPtr(t *testing.T) {
+	t.Parallel()
+
+	// The struct's second pointer is a far pointer to a segment that
+	// does not exist.
+	msg := &Message{Arena: SingleSegment(rawWords(
+		rawStructPointer(0, ObjectSize{PointerCount: 2}),
+		rawStructPointer(1, ObjectSize{}),
+		rawFarPointer(7, 0),
+	))}
+	p, err := msg.Root()
+	require.NoError(t, err)
+	s := p.Struct()
+
+	ptr, err := s.FieldPtr(0, "Person.name")
+	require.NoError(t, err)
+	assert.True(t, ptr.IsValid())
+
+	_, want := s.Ptr(1)
+	require.Error(t, want)
+	_, err = s.FieldPtr(1, "Person.address")
+	require.Error(t, err)
+	assert.Equal(t, "Person.address: "+want.Error(), err.Error())
+	var fe *FieldError
+	require.True(t, errors.As(err, &fe), "error should be a *FieldError")
+	assert.Equal(t, "Person.address", fe.Path)
+
+	ptr, err = s.FieldPtr(2, "Person.beyond")
+	assert.NoError(t, err, "pointers beyond the struct are null")
+	assert.False(t, ptr.IsValid())
+}
+
+func TestValidationErrorIn(t *testing.T) {
+	t.Parallel()
+
+	err := ValidationErrorIn("Order.items[2]", "Item", &ValidationError{Path: "Item.sku", Reason: "is required"})
+	var ve *ValidationError
+	require.True(t, errors.As(err, &ve), "error should be a *ValidationError")
+	assert.Equal(t, "Order.items[2].sku", ve.Path)
+	assert.Equal(t, "Order.items[2].sku: is required", err.Error())
+
+	inner := errors.New("bad pointer")
+	err = ValidationErrorIn("Order.customer", "Customer", &FieldError{Path: "Customer.name", Err: inner})
+	var fe *FieldError
+	require.True(t, errors.As(err, &fe), "error should be a *FieldError")
+	assert.Equal(t, "Order.customer.name", fe.Path)
+	assert.ErrorIs(t, err, inner)
+
+	other := errors.New("other")
+	assert.Same(t, other, ValidationErrorIn("Order.customer", "Customer", other))
+}
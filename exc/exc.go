@@ -11,6 +11,18 @@ type Exception struct {
 	Type   Type
 	Prefix string
 	Cause  error
+
+	// Details holds application-defined payloads that travel with
+	// the exception across RPC boundaries.  See WithDetail.
+	Details []Detail
+}
+
+// Detail is an application-defined payload attached to an exception.
+// ID identifies the kind of payload, and is usually the type ID of a
+// Cap'n Proto struct encoded in Value.
+type Detail struct {
+	ID    uint64
+	Value []byte
 }
 
 type wrappedError struct {
@@ -39,7 +51,7 @@ func WrapError(prefix string, err error) error {
 // New creates a new error that formats as "<prefix>: <msg>".
 // The type can be recovered using the TypeOf() function.
 func New(typ Type, prefix, msg string) *Exception {
-	return &Exception{typ, prefix, errors.New(msg)}
+	return &Exception{Type: typ, Prefix: prefix, Cause: errors.New(msg)}
 }
 
 func (e Exception) Error() string {
@@ -67,10 +79,10 @@ func (e Exception) GoString() string {
 // The returned Error.Type == e.Type.
 func (e Exception) Annotate(prefix, msg string) *Exception {
 	if prefix != e.Prefix {
-		return &Exception{e.Type, prefix, WrapError(msg, e)}
+		return &Exception{e.Type, prefix, WrapError(msg, e), e.Details}
 	}
 
-	return &Exception{e.Type, prefix, WrapError(msg, e.Cause)}
+	return &Exception{e.Type, prefix, WrapError(msg, e.Cause), e.Details}
 }
 
 // Annotate creates a new error that formats as "<prefix>: <msg>: <err>".
@@ -92,6 +104,51 @@ func Annotate(prefix, msg string, err error) *Exception {
 	}
 }
 
+// Detail returns the value of the first detail in e with the given ID.
+func (e Exception) Detail(id uint64) (value []byte, ok bool) {
+	for _, d := range e.Details {
+		if d.ID == id {
+			return d.Value, true
+		}
+	}
+	return nil, false
+}
+
+// WithDetail returns a copy of err with a detail attached.  Any detail
+// with the same ID is replaced.  If err is not an *Exception, it is
+// wrapped in one of type Failed.  WithDetail returns nil if err is nil.
+func WithDetail(err error, id uint64, value []byte) *Exception {
+	if err == nil {
+		return nil
+	}
+
+	var e Exception
+	if ce, ok := err.(*Exception); ok {
+		e = *ce
+	} else {
+		e = Exception{Type: Failed, Cause: err}
+	}
+
+	details := make([]Detail, 0, len(e.Details)+1)
+	for _, d := range e.Details {
+		if d.ID != id {
+			details = append(details, d)
+		}
+	}
+	e.Details = append(details, Detail{ID: id, Value: value})
+	return &e
+}
+
+// DetailsOf returns the details attached to the first Exception in
+// err's chain, or nil if there is none.
+func DetailsOf(err error) []Detail {
+	var e *Exception
+	if errors.As(err, &e) {
+		return e.Details
+	}
+	return nil
+}
+
 type Annotator string
 
 func (f Annotator) New(t Type, err error) *Exception {
@@ -119,3 +119,35 @@ func TestAnnotate(t *testing.T) {
 		assert.Equal(t, test.wantType, TypeOf(err))
 	}
 }
+
+func TestWithDetail(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, WithDetail(nil, 1, []byte("x")))
+
+	base := errors.New("boom")
+	e := WithDetail(base, 1, []byte("a"))
+	assert.Equal(t, Failed, e.Type)
+	assert.ErrorIs(t, e, base)
+
+	e = WithDetail(New(Overloaded, "pfx", "busy"), 1, []byte("a"))
+	e2 := WithDetail(e, 2, []byte("b"))
+	e2 = WithDetail(e2, 1, []byte("c"))
+	assert.Len(t, e.Details, 1, "original is not modified")
+	assert.Equal(t, Overloaded, e2.Type)
+	assert.EqualError(t, e2, "pfx: busy")
+
+	v, ok := e2.Detail(1)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("c"), v, "same ID is replaced")
+	v, ok = e2.Detail(2)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("b"), v)
+	_, ok = e2.Detail(3)
+	assert.False(t, ok)
+
+	annotated := Annotate("other", "context", e2)
+	assert.Equal(t, e2.Details, annotated.Details, "annotation keeps details")
+	assert.Equal(t, e2.Details, DetailsOf(WrapError("wrapped", annotated)))
+	assert.Nil(t, DetailsOf(base))
+}
@@ -0,0 +1,199 @@
+// Package envelope carries Cap'n Proto messages in the payloads of
+// event streaming systems, such as Kafka records and NATS messages.
+//
+// A payload holds one message in the standard serialization, or packed
+// if Options.Packed is set.  The message's options travel beside it in
+// the headers of the record, so that consumers can decode payloads
+// without knowing in advance how they were encoded, and can check that
+// a payload holds the type they expect.  The subpackages kafkaenv and
+// natsenv convert the options to and from the headers of each system.
+//
+// This package and its subpackages do not import any client library,
+// so that depending on them does not add one to a program.
+package envelope
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/packed"
+)
+
+// Header names.
+const (
+	// EncodingHeader names the encoding of the payload: "packed" for
+	// the packed encoding, or "standard".  A missing header means
+	// "standard".
+	EncodingHeader = "capnp-encoding"
+
+	// SchemaIDHeader holds the ID of the schema node of the message's
+	// root struct, in hexadecimal.
+	SchemaIDHeader = "capnp-schema-id"
+)
+
+// Values of EncodingHeader.
+const (
+	EncodingStandard = "standard"
+	EncodingPacked   = "packed"
+)
+
+// Options describe how a message is encoded in a payload.
+type Options struct {
+	// Packed selects the packed encoding, which is smaller for most
+	// messages.
+	Packed bool
+
+	// SchemaID is the ID of the schema node of the message's root
+	// struct, such as the TypeID constant that capnpc-go generates for
+	// it, or zero if the payload does not say.
+	SchemaID uint64
+}
+
+// Headers calls set with the name and value of each header that
+// describes o.
+func (o Options) Headers(set func(name, value string)) {
+	if o.Packed {
+		set(EncodingHeader, EncodingPacked)
+	}
+	if o.SchemaID != 0 {
+		set(SchemaIDHeader, strconv.FormatUint(o.SchemaID, 16))
+	}
+}
+
+// ParseHeaders returns the options that the headers of a payload
+// describe.  get returns the value of the named header, and whether the
+// payload has it.
+func ParseHeaders(get func(name string) (string, bool)) (Options, error) {
+	var o Options
+	if enc, ok := get(EncodingHeader); ok {
+		switch enc {
+		case EncodingStandard:
+		case EncodingPacked:
+			o.Packed = true
+		default:
+			return Options{}, errors.New("envelope: unknown encoding " + strconv.Quote(enc))
+		}
+	}
+	if id, ok := get(SchemaIDHeader); ok {
+		var err error
+		o.SchemaID, err = strconv.ParseUint(id, 16, 64)
+		if err != nil {
+			return Options{}, errors.New("envelope: malformed schema ID " + strconv.Quote(id))
+		}
+	}
+	return o, nil
+}
+
+// Marshal encodes msg as a payload with the options o.
+func Marshal(msg *capnp.Message, o Options) ([]byte, error) {
+	if o.Packed {
+		return msg.MarshalPacked()
+	}
+	return msg.Marshal()
+}
+
+// Unmarshal decodes the message in payload, which was encoded with the
+// options o.  Unless the payload is packed, the message reads directly
+// from payload.
+func Unmarshal(payload []byte, o Options) (*capnp.Message, error) {
+	if o.Packed {
+		return capnp.UnmarshalPacked(payload)
+	}
+	return capnp.Unmarshal(payload)
+}
+
+// A Reader decodes payloads for a consumer, reusing the same message,
+// arena and unpacking buffer for each, so that decoding a stream of
+// payloads does not allocate once the buffers have grown to fit.
+//
+// The zero value is a Reader that accepts payloads of any schema.
+type Reader struct {
+	// SchemaID, if not zero, is the ID of the schema node that the
+	// root struct of each payload must have.  Payloads whose options
+	// name a different schema are rejected; payloads whose options do
+	// not name one are accepted.
+	SchemaID uint64
+
+	msg   capnp.Message
+	arena readArena
+	buf   []byte
+}
+
+// Read decodes the message in payload, which was encoded with the
+// options o.  The message is only valid until the next call to Read:
+// it reads from payload, or from the Reader's buffer if payload is
+// packed, and the Reader reuses it.
+func (r *Reader) Read(payload []byte, o Options) (*capnp.Message, error) {
+	if r.SchemaID != 0 && o.SchemaID != 0 && o.SchemaID != r.SchemaID {
+		return nil, errors.New("envelope: payload has schema " + hexID(o.SchemaID) + ", want " + hexID(r.SchemaID))
+	}
+	data := payload
+	if o.Packed {
+		var err error
+		if r.buf, err = packed.Unpack(r.buf[:0], payload); err != nil {
+			return nil, exc.WrapError("envelope: unpack", err)
+		}
+		data = r.buf
+	}
+	if err := r.arena.demux(data); err != nil {
+		return nil, err
+	}
+	// The arena does not own its segments, so releasing it is safe.
+	r.msg.Release()
+	r.msg.Arena = &r.arena
+	return &r.msg, nil
+}
+
+func hexID(id uint64) string {
+	return "@0x" + strconv.FormatUint(id, 16)
+}
+
+// A readArena is an arena of the segments of a serialized message that
+// it does not own.  It cannot allocate.
+type readArena struct {
+	segs [][]byte
+}
+
+// demux slices the message in data into a's segments.
+func (a *readArena) demux(data []byte) error {
+	a.segs = a.segs[:0]
+	if len(data) < 8 {
+		return errors.New("envelope: short segment table")
+	}
+	nsegs := uint64(binary.LittleEndian.Uint32(data)) + 1
+	hdrSize := (4*(nsegs+1) + 7) &^ 7
+	if hdrSize > uint64(len(data)) {
+		return errors.New("envelope: short segment table")
+	}
+	hdr, body := data[:hdrSize], data[hdrSize:]
+	for i := uint64(0); i < nsegs; i++ {
+		sz := 8 * uint64(binary.LittleEndian.Uint32(hdr[4+4*i:]))
+		if sz > uint64(len(body)) {
+			a.segs = a.segs[:0]
+			return errors.New("envelope: segment " + strconv.FormatUint(i, 10) + " is truncated")
+		}
+		a.segs = append(a.segs, body[:sz:sz])
+		body = body[sz:]
+	}
+	return nil
+}
+
+func (a *readArena) NumSegments() int64 {
+	return int64(len(a.segs))
+}
+
+func (a *readArena) Data(id capnp.SegmentID) ([]byte, error) {
+	if int64(id) >= int64(len(a.segs)) {
+		return nil, errors.New("envelope: segment " + strconv.FormatUint(uint64(id), 10) + " does not exist")
+	}
+	return a.segs[id], nil
+}
+
+func (a *readArena) Allocate(capnp.Size, map[capnp.SegmentID]*capnp.Segment) (capnp.SegmentID, []byte, error) {
+	return 0, nil, errors.New("envelope: cannot allocate in a received message")
+}
+
+func (a *readArena) Release() {}
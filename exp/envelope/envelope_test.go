@@ -0,0 +1,114 @@
+package envelope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+const testSchemaID = 0xa1b2c3d4e5f60718
+
+// newMessage returns a message whose root struct holds n.
+func newMessage(t testing.TB, n uint64) *capnp.Message {
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	st, err := capnp.NewRootStruct(seg, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	require.NoError(t, err)
+	st.SetUint64(0, n)
+	require.NoError(t, st.SetNewText(0, "some text that packs well\x00\x00\x00\x00"))
+	return msg
+}
+
+func rootValue(t testing.TB, msg *capnp.Message) uint64 {
+	p, err := msg.Root()
+	require.NoError(t, err)
+	return p.Struct().Uint64(0)
+}
+
+func TestHeaders(t *testing.T) {
+	t.Parallel()
+
+	for _, o := range []Options{
+		{},
+		{Packed: true},
+		{SchemaID: testSchemaID},
+		{Packed: true, SchemaID: testSchemaID},
+	} {
+		headers := make(map[string]string)
+		o.Headers(func(name, value string) { headers[name] = value })
+		got, err := ParseHeaders(func(name string) (string, bool) {
+			v, ok := headers[name]
+			return v, ok
+		})
+		require.NoError(t, err)
+		assert.Equal(t, o, got, "headers %v", headers)
+	}
+
+	for _, bad := range []map[string]string{
+		{EncodingHeader: "zstd"},
+		{SchemaIDHeader: "xyz"},
+	} {
+		_, err := ParseHeaders(func(name string) (string, bool) {
+			v, ok := bad[name]
+			return v, ok
+		})
+		assert.Error(t, err, "headers %v", bad)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	for _, packed := range []bool{false, true} {
+		o := Options{Packed: packed}
+		payload, err := Marshal(newMessage(t, 42), o)
+		require.NoError(t, err)
+		msg, err := Unmarshal(payload, o)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), rootValue(t, msg), "packed = %t", packed)
+	}
+}
+
+func TestReader(t *testing.T) {
+	// Not parallel, since it counts allocations.
+
+	o := Options{Packed: true, SchemaID: testSchemaID}
+	payloads := make([][]byte, 3)
+	for i := range payloads {
+		var err error
+		payloads[i], err = Marshal(newMessage(t, uint64(i)), o)
+		require.NoError(t, err)
+	}
+
+	r := &Reader{SchemaID: testSchemaID}
+	for i, payload := range payloads {
+		msg, err := r.Read(payload, o)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(i), rootValue(t, msg))
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		msg, err := r.Read(payloads[1], o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := rootValue(t, msg); v != 1 {
+			t.Fatalf("root = %d; want 1", v)
+		}
+	})
+	assert.Zero(t, allocs, "allocations per Read")
+
+	_, err := r.Read(payloads[0], Options{Packed: true, SchemaID: 1})
+	assert.Error(t, err, "reading a payload of another schema")
+	_, err = r.Read([]byte{0, 0, 0, 0, 2, 0, 0, 0, 1, 2, 3, 4}, Options{})
+	assert.Error(t, err, "reading a truncated payload")
+
+	msg, err := r.Read(payloads[2], Options{Packed: true})
+	require.NoError(t, err, "reading a payload that does not name its schema")
+	seg, err := msg.Segment(0)
+	require.NoError(t, err)
+	_, err = capnp.NewStruct(seg, capnp.ObjectSize{DataSize: 8})
+	assert.Error(t, err, "allocating in a received message")
+}
@@ -0,0 +1,77 @@
+// Package kafkaenv carries Cap'n Proto messages in Kafka records, as
+// described in package envelope.
+//
+// Header has the same fields as the header types of the common Kafka
+// clients, such as kafka.Header in github.com/segmentio/kafka-go and
+// in github.com/confluentinc/confluent-kafka-go, so a slice of either
+// converts element by element to and from a slice of Header.
+package kafkaenv
+
+import (
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exp/envelope"
+)
+
+// A Header is a header of a Kafka record.
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Marshal encodes msg as the value of a record, and returns the headers
+// that describe how it was encoded, which must be sent with it.
+func Marshal(msg *capnp.Message, o envelope.Options) (value []byte, headers []Header, err error) {
+	value, err = envelope.Marshal(msg, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, AppendHeaders(nil, o), nil
+}
+
+// AppendHeaders appends the headers that describe o to headers.
+func AppendHeaders(headers []Header, o envelope.Options) []Header {
+	o.Headers(func(name, value string) {
+		headers = append(headers, Header{Key: name, Value: []byte(value)})
+	})
+	return headers
+}
+
+// ParseHeaders returns the options that headers describe.  Headers
+// that package envelope does not define are ignored.  If a header is
+// repeated, the last one counts.
+func ParseHeaders(headers []Header) (envelope.Options, error) {
+	return envelope.ParseHeaders(func(name string) (string, bool) {
+		for i := len(headers) - 1; i >= 0; i-- {
+			if headers[i].Key == name {
+				return string(headers[i].Value), true
+			}
+		}
+		return "", false
+	})
+}
+
+// Unmarshal decodes the message in the value of a record with the
+// given headers.
+func Unmarshal(value []byte, headers []Header) (*capnp.Message, error) {
+	o, err := ParseHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.Unmarshal(value, o)
+}
+
+// A Reader decodes the values of records for a consumer, reusing its
+// buffers as envelope.Reader does.
+type Reader struct {
+	envelope.Reader
+}
+
+// Read decodes the message in the value of a record with the given
+// headers.  The message is only valid until the next call to Read.
+func (r *Reader) Read(value []byte, headers []Header) (*capnp.Message, error) {
+	o, err := ParseHeaders(headers)
+	if err != nil {
+		return nil, err
+	}
+	return r.Reader.Read(value, o)
+}
@@ -0,0 +1,76 @@
+// Package natsenv carries Cap'n Proto messages in NATS messages, as
+// described in package envelope.
+//
+// Headers are passed as map[string][]string, the underlying type of
+// nats.Header in github.com/nats-io/nats.go, so a nats.Header converts
+// to and from it.
+package natsenv
+
+import (
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exp/envelope"
+)
+
+// Marshal encodes msg as the data of a NATS message, and returns the
+// headers that describe how it was encoded, which must be sent with it.
+// The headers are nil if there are none.
+func Marshal(msg *capnp.Message, o envelope.Options) (data []byte, header map[string][]string, err error) {
+	data, err = envelope.Marshal(msg, o)
+	if err != nil {
+		return nil, nil, err
+	}
+	o.Headers(func(name, value string) {
+		if header == nil {
+			header = make(map[string][]string)
+		}
+		header[name] = []string{value}
+	})
+	return data, header, nil
+}
+
+// SetHeaders sets the headers that describe o in header, replacing any
+// values that it had.
+func SetHeaders(header map[string][]string, o envelope.Options) {
+	o.Headers(func(name, value string) {
+		header[name] = []string{value}
+	})
+}
+
+// ParseHeaders returns the options that header describes.  Headers
+// that package envelope does not define are ignored.  If a header has
+// several values, the first one counts, as with nats.Header.Get.
+func ParseHeaders(header map[string][]string) (envelope.Options, error) {
+	return envelope.ParseHeaders(func(name string) (string, bool) {
+		vs := header[name]
+		if len(vs) == 0 {
+			return "", false
+		}
+		return vs[0], true
+	})
+}
+
+// Unmarshal decodes the message in the data of a NATS message with the
+// given headers.
+func Unmarshal(data []byte, header map[string][]string) (*capnp.Message, error) {
+	o, err := ParseHeaders(header)
+	if err != nil {
+		return nil, err
+	}
+	return envelope.Unmarshal(data, o)
+}
+
+// A Reader decodes the data of NATS messages for a subscriber, reusing
+// its buffers as envelope.Reader does.
+type Reader struct {
+	envelope.Reader
+}
+
+// Read decodes the message in the data of a NATS message with the given
+// headers.  The message is only valid until the next call to Read.
+func (r *Reader) Read(data []byte, header map[string][]string) (*capnp.Message, error) {
+	o, err := ParseHeaders(header)
+	if err != nil {
+		return nil, err
+	}
+	return r.Reader.Read(data, o)
+}
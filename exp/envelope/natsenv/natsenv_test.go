@@ -0,0 +1,50 @@
+package natsenv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exp/envelope"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	st, err := capnp.NewRootStruct(seg, capnp.ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	st.SetUint64(0, 42)
+
+	o := envelope.Options{Packed: true, SchemaID: 0xa1b2c3d4e5f60718}
+	value, headers, err := Marshal(msg, o)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		envelope.EncodingHeader: {envelope.EncodingPacked},
+		envelope.SchemaIDHeader: {"a1b2c3d4e5f60718"},
+	}, headers)
+
+	// Other headers of the message are ignored.
+	headers["trace-id"] = []string{"abc"}
+	got, err := ParseHeaders(headers)
+	require.NoError(t, err)
+	assert.Equal(t, o, got)
+
+	m, err := Unmarshal(value, headers)
+	require.NoError(t, err)
+	p, err := m.Root()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), p.Struct().Uint64(0))
+
+	var r Reader
+	m, err = r.Read(value, headers)
+	require.NoError(t, err)
+	p, err = m.Root()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), p.Struct().Uint64(0))
+
+	_, err = Unmarshal(value, map[string][]string{envelope.EncodingHeader: {"gzip"}})
+	assert.Error(t, err)
+}
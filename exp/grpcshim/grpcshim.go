@@ -0,0 +1,211 @@
+// Package grpcshim supports the code generated by capnpc-go's grpc
+// plugin, which serves Cap'n Proto interfaces as gRPC services and
+// calls gRPC services through Cap'n Proto clients, so that both RPC
+// systems can be run side by side while migrating from one to the
+// other.
+//
+// The generated services carry Cap'n Proto messages rather than
+// protocol buffers: each request holds a method's parameter struct and
+// each response its result struct, encoded with Codec.  Capabilities
+// cannot cross the bridge; messages that refer to any are rejected.
+//
+// This package does not import gRPC itself, so that depending on it
+// does not add gRPC to programs that do not use the generated code.
+package grpcshim
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+// CodecName is the name of Codec, which is also the gRPC content
+// subtype of the generated services.
+const CodecName = "capnp"
+
+// A Message is a Cap'n Proto message carried in a gRPC request or
+// response.  A nil Msg is sent as an empty message, whose root struct
+// reads as all defaults.
+type Message struct {
+	Msg *capnp.Message
+}
+
+// Codec encodes *Message values with the standard Cap'n Proto framing.
+// It implements the encoding.Codec interface of the gRPC module.
+type Codec struct{}
+
+// Name returns CodecName.
+func (Codec) Name() string {
+	return CodecName
+}
+
+// Marshal encodes v, which must be a *Message.
+func (Codec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(*Message)
+	if !ok {
+		return nil, errors.New("grpcshim: cannot marshal " + typeName(v))
+	}
+	if m.Msg == nil {
+		return nil, nil
+	}
+	if m.Msg.CapTable().Len() > 0 {
+		return nil, errors.New("grpcshim: cannot send capabilities over gRPC")
+	}
+	b, err := m.Msg.Marshal()
+	if err != nil {
+		return nil, exc.WrapError("grpcshim", err)
+	}
+	return b, nil
+}
+
+// Unmarshal decodes data into v, which must be a *Message.  The
+// decoded message holds a copy of data, since gRPC may reuse its
+// buffers once Unmarshal returns.
+func (Codec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(*Message)
+	if !ok {
+		return errors.New("grpcshim: cannot unmarshal into " + typeName(v))
+	}
+	if len(data) == 0 {
+		m.Msg = nil
+		return nil
+	}
+	msg, err := capnp.Unmarshal(append([]byte(nil), data...))
+	if err != nil {
+		return exc.WrapError("grpcshim", err)
+	}
+	m.Msg = msg
+	return nil
+}
+
+// Call calls method m on c with the root struct of in as its
+// parameters, and returns a new message whose root struct is a copy of
+// the results.  It is used by generated gRPC service handlers.
+func Call(ctx context.Context, c capnp.Client, m capnp.Method, in *Message) (*Message, error) {
+	args, err := root(in)
+	if err != nil {
+		return nil, err
+	}
+	ans, release := c.SendCall(ctx, capnp.Send{
+		Method:   m,
+		ArgsSize: args.Size(),
+		PlaceArgs: func(s capnp.Struct) error {
+			return s.CopyFrom(args)
+		},
+	})
+	defer release()
+	res, err := ans.Struct()
+	if err != nil {
+		return nil, err
+	}
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	out, err := capnp.NewRootStruct(seg, res.Size())
+	if err != nil {
+		return nil, exc.WrapError("grpcshim: copy results", err)
+	}
+	if err := out.CopyFrom(res); err != nil {
+		return nil, exc.WrapError("grpcshim: copy results", err)
+	}
+	return &Message{Msg: msg}, nil
+}
+
+// Forward passes the parameters of call to invoke and copies the
+// message that invoke fills in to call's results.  It is used by the
+// generated Cap'n Proto servers that forward calls to gRPC services,
+// with invoke calling the gRPC method.
+func Forward(ctx context.Context, call *server.Call, invoke func(ctx context.Context, in, out *Message) error) error {
+	args := call.Args()
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	in, err := capnp.NewRootStruct(seg, args.Size())
+	if err != nil {
+		return exc.WrapError("grpcshim: copy parameters", err)
+	}
+	if err := in.CopyFrom(args); err != nil {
+		return exc.WrapError("grpcshim: copy parameters", err)
+	}
+	out := new(Message)
+	if err := invoke(ctx, &Message{Msg: msg}, out); err != nil {
+		return err
+	}
+	if out.Msg == nil {
+		return nil
+	}
+	res, err := root(out)
+	if err != nil {
+		return err
+	}
+	results, err := call.AllocResults(res.Size())
+	if err != nil {
+		return err
+	}
+	return results.CopyFrom(res)
+}
+
+// root returns the root struct of m's message, or a zero-sized struct
+// if m has no message.
+func root(m *Message) (capnp.Struct, error) {
+	if m == nil || m.Msg == nil {
+		return capnp.Struct{}, nil
+	}
+	p, err := m.Msg.Root()
+	if err != nil {
+		return capnp.Struct{}, exc.WrapError("grpcshim: read root", err)
+	}
+	return p.Struct(), nil
+}
+
+// gRPC status codes, as defined by the codes package of the gRPC
+// module.
+const (
+	codeCanceled          = 1
+	codeUnknown           = 2
+	codeDeadlineExceeded  = 4
+	codeResourceExhausted = 8
+	codeUnimplemented     = 12
+	codeUnavailable       = 14
+)
+
+// Code returns the gRPC status code that corresponds to err, an error
+// returned by a Cap'n Proto call.
+func Code(err error) uint32 {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codeDeadlineExceeded
+	case exc.IsType(err, exc.Unimplemented):
+		return codeUnimplemented
+	case exc.IsType(err, exc.Overloaded):
+		return codeResourceExhausted
+	case exc.IsType(err, exc.Disconnected):
+		return codeUnavailable
+	default:
+		return codeUnknown
+	}
+}
+
+// Error returns the Cap'n Proto exception that corresponds to a gRPC
+// status with the given code and message.
+func Error(code uint32, msg string) error {
+	typ := exc.Failed
+	switch code {
+	case codeUnimplemented:
+		typ = exc.Unimplemented
+	case codeResourceExhausted:
+		typ = exc.Overloaded
+	case codeUnavailable:
+		typ = exc.Disconnected
+	}
+	return exc.New(typ, "grpc", msg)
+}
+
+func typeName(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	return reflect.TypeOf(v).String()
+}
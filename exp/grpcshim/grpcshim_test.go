@@ -0,0 +1,162 @@
+package grpcshim
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+var doubleMethod = capnp.Method{InterfaceID: 0xa1b2c3d4e5f60718, MethodID: 0}
+
+// newDoubler returns a client whose method doubles the integer in its
+// parameters, or fails with an unimplemented exception if it is zero.
+func newDoubler() capnp.Client {
+	return capnp.NewClient(server.New([]server.Method{{
+		Method: doubleMethod,
+		Impl: func(ctx context.Context, call *server.Call) error {
+			n := call.Args().Uint64(0)
+			if n == 0 {
+				return exc.New(exc.Unimplemented, "", "zero")
+			}
+			res, err := call.AllocResults(capnp.ObjectSize{DataSize: 8})
+			if err != nil {
+				return err
+			}
+			res.SetUint64(0, 2*n)
+			return nil
+		},
+	}}, nil, nil))
+}
+
+func newNumber(t *testing.T, n uint64) *Message {
+	msg, seg := capnp.NewSingleSegmentMessage(nil)
+	s, err := capnp.NewRootStruct(seg, capnp.ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	s.SetUint64(0, n)
+	return &Message{Msg: msg}
+}
+
+func number(t *testing.T, m *Message) uint64 {
+	s, err := root(m)
+	require.NoError(t, err)
+	return s.Uint64(0)
+}
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	var c Codec
+	assert.Equal(t, CodecName, c.Name())
+
+	b, err := c.Marshal(newNumber(t, 42))
+	require.NoError(t, err)
+	out := new(Message)
+	require.NoError(t, c.Unmarshal(b, out))
+	assert.Equal(t, uint64(42), number(t, out))
+
+	b, err = c.Marshal(new(Message))
+	require.NoError(t, err)
+	assert.Empty(t, b)
+	require.NoError(t, c.Unmarshal(b, out))
+	assert.Nil(t, out.Msg)
+
+	_, err = c.Marshal("hello")
+	assert.Error(t, err)
+	assert.Error(t, c.Unmarshal(b, new(string)))
+
+	withCap := newNumber(t, 1)
+	withCap.Msg.CapTable().Add(newDoubler())
+	_, err = c.Marshal(withCap)
+	assert.Error(t, err, "capabilities should be rejected")
+	withCap.Msg.Release()
+}
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	c := newDoubler()
+	defer c.Release()
+	ctx := context.Background()
+
+	out, err := Call(ctx, c, doubleMethod, newNumber(t, 21))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), number(t, out))
+
+	_, err = Call(ctx, c, doubleMethod, new(Message))
+	require.Error(t, err)
+	assert.Equal(t, uint32(codeUnimplemented), Code(err))
+}
+
+func TestForward(t *testing.T) {
+	t.Parallel()
+
+	// The forwarding client stands in for the code generated for a gRPC
+	// service, with invoke marshaling the messages as gRPC would.
+	backend := newDoubler()
+	defer backend.Release()
+	var codec Codec
+	invoke := func(ctx context.Context, in, out *Message) error {
+		b, err := codec.Marshal(in)
+		if err != nil {
+			return err
+		}
+		req := new(Message)
+		if err := codec.Unmarshal(b, req); err != nil {
+			return err
+		}
+		res, err := Call(ctx, backend, doubleMethod, req)
+		if err != nil {
+			return Error(Code(err), err.Error())
+		}
+		if b, err = codec.Marshal(res); err != nil {
+			return err
+		}
+		return codec.Unmarshal(b, out)
+	}
+	c := capnp.NewClient(server.New([]server.Method{{
+		Method: doubleMethod,
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return Forward(ctx, call, invoke)
+		},
+	}}, nil, nil))
+	defer c.Release()
+	ctx := context.Background()
+
+	out, err := Call(ctx, c, doubleMethod, newNumber(t, 4))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), number(t, out))
+
+	_, err = Call(ctx, c, doubleMethod, newNumber(t, 0))
+	require.Error(t, err)
+	assert.True(t, exc.IsType(err, exc.Unimplemented), "error type should survive the round trip: %v", err)
+}
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err  error
+		code uint32
+		typ  exc.Type
+	}{
+		{exc.New(exc.Failed, "", "x"), codeUnknown, exc.Failed},
+		{exc.New(exc.Overloaded, "", "x"), codeResourceExhausted, exc.Overloaded},
+		{exc.New(exc.Disconnected, "", "x"), codeUnavailable, exc.Disconnected},
+		{exc.New(exc.Unimplemented, "", "x"), codeUnimplemented, exc.Unimplemented},
+		{context.Canceled, codeCanceled, exc.Failed},
+		{context.DeadlineExceeded, codeDeadlineExceeded, exc.Failed},
+		{errors.New("x"), codeUnknown, exc.Failed},
+	}
+	for _, test := range tests {
+		code := Code(test.err)
+		assert.Equal(t, test.code, code, "Code(%v)", test.err)
+		assert.Equal(t, test.typ, exc.TypeOf(Error(code, "x")), "Error(%d)", code)
+	}
+}
@@ -0,0 +1,259 @@
+// Package httpbridge serves the methods of a capability as JSON
+// endpoints over HTTP, for debugging and for clients that do not speak
+// Cap'n Proto.
+//
+// Each method of the interface, including those inherited from
+// superclasses, is exposed as
+//
+//	POST /<Interface>/<method>
+//
+// where <Interface> is the interface's name as declared in its schema
+// file (for nested interfaces, qualified by the enclosing scopes, like
+// "Outer.Inner").  The request body is a JSON object holding the call's
+// parameters and the response body is a JSON object holding its
+// results, converted with the encoding/dynamic package.  Errors are
+// reported as a JSON object with a single "error" member.
+//
+// Values that JSON cannot represent are handled as follows.  Data
+// results are base64-encoded, as encoding/json does for []byte, but
+// Data parameters are taken as the bytes of a JSON string.  Integer
+// parameters must be exactly representable as float64.  Capability and
+// AnyPointer results are null, and setting them in parameters is an
+// error.
+package httpbridge
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/dynamic"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+// maxBodySize is the largest request body that a Handler accepts.
+const maxBodySize = 1 << 20
+
+// Options configures a Handler.
+type Options struct {
+	// Registry holds the schemas of the interface, its superclasses,
+	// and the types used by their methods.  If nil,
+	// schemas.DefaultRegistry is used.  Generated packages add their
+	// nodes to a registry with their RegisterSchema function.
+	Registry *schemas.Registry
+}
+
+// A Handler is an http.Handler that forwards requests to a capability.
+type Handler struct {
+	client  capnp.Client
+	methods map[string]method // keyed by "Interface/method"
+
+	mu   sync.Mutex // guards conv
+	conv dynamic.Converter
+}
+
+// A method is a method that a Handler serves.
+type method struct {
+	capnp.Method
+	params   uint64
+	results  uint64
+	argsSize capnp.ObjectSize
+}
+
+// New returns a Handler that forwards calls to c, a client for the
+// interface with the given ID.  opts may be nil.  New takes ownership
+// of c, which is released by the Handler's Release method.
+func New(c capnp.Client, interfaceID uint64, opts *Options) (*Handler, error) {
+	reg := schemas.DefaultRegistry
+	if opts != nil && opts.Registry != nil {
+		reg = opts.Registry
+	}
+	h := &Handler{
+		client:  c,
+		methods: make(map[string]method),
+	}
+	h.conv.UseRegistry(reg)
+	var nodes nodemap.Map
+	nodes.UseRegistry(reg)
+	if err := h.addInterface(&nodes, interfaceID, make(map[uint64]bool)); err != nil {
+		c.Release()
+		return nil, exc.WrapError("httpbridge", err)
+	}
+	return h, nil
+}
+
+// addInterface adds the methods of the interface with the given ID
+// and of its superclasses to h.methods.
+func (h *Handler) addInterface(nodes *nodemap.Map, id uint64, seen map[uint64]bool) error {
+	if seen[id] {
+		return nil
+	}
+	seen[id] = true
+	n, err := nodes.Find(id)
+	if err != nil {
+		return err
+	}
+	if !n.IsValid() || n.Which() != schema.Node_Which_interface {
+		return errors.New("cannot find interface type " + str.UToHex(id))
+	}
+	displayName, err := n.DisplayName()
+	if err != nil {
+		return err
+	}
+	ifaceName := displayName[strings.IndexByte(displayName, ':')+1:]
+	ms, err := n.Interface().Methods()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		name, err := m.Name()
+		if err != nil {
+			return err
+		}
+		pn, err := nodes.Find(m.ParamStructType())
+		if err != nil {
+			return err
+		}
+		if !pn.IsValid() || pn.Which() != schema.Node_Which_structNode {
+			return errors.New(ifaceName + "." + name + ": cannot find parameter type " + str.UToHex(m.ParamStructType()))
+		}
+		h.methods[ifaceName+"/"+name] = method{
+			Method: capnp.Method{
+				InterfaceID:   id,
+				MethodID:      uint16(i),
+				InterfaceName: displayName,
+				MethodName:    name,
+			},
+			params:  m.ParamStructType(),
+			results: m.ResultStructType(),
+			argsSize: capnp.ObjectSize{
+				DataSize:     capnp.Size(pn.StructNode().DataWordCount()) * 8,
+				PointerCount: pn.StructNode().PointerCount(),
+			},
+		}
+	}
+	supers, err := n.Interface().Superclasses()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < supers.Len(); i++ {
+		if err := h.addInterface(nodes, supers.At(i).Id(), seen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Release releases the capability that h forwards calls to.  h must
+// not be used afterward.
+func (h *Handler) Release() {
+	h.client.Release()
+}
+
+// ServeHTTP calls the method named by the request's path.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m, ok := h.methods[strings.TrimPrefix(r.URL.Path, "/")]
+	if !ok {
+		writeError(w, http.StatusNotFound, errors.New("unknown method "+r.URL.Path))
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method must be called with POST"))
+		return
+	}
+	var args map[string]any
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&args); err != nil {
+		writeError(w, http.StatusBadRequest, exc.WrapError("decode parameters", err))
+		return
+	}
+
+	var argsErr error
+	ans, release := h.client.SendCall(r.Context(), capnp.Send{
+		Method:   m.Method,
+		ArgsSize: m.argsSize,
+		PlaceArgs: func(s capnp.Struct) error {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			argsErr = h.conv.FromMap(m.params, s, args)
+			return argsErr
+		},
+	})
+	defer release()
+	s, err := ans.Struct()
+	if argsErr != nil {
+		writeError(w, http.StatusBadRequest, argsErr)
+		return
+	}
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	h.mu.Lock()
+	results, err := h.conv.ToMap(m.results, s)
+	h.mu.Unlock()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, jsonValue(results))
+}
+
+// errorStatus returns the HTTP status code for an error returned by a
+// call.
+func errorStatus(err error) int {
+	switch {
+	case exc.IsType(err, exc.Unimplemented):
+		return http.StatusNotImplemented
+	case exc.IsType(err, exc.Overloaded):
+		return http.StatusServiceUnavailable
+	case exc.IsType(err, exc.Disconnected):
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]any{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		b, _ = json.Marshal(map[string]any{"error": err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(append(b, '\n'))
+}
+
+// jsonValue replaces the values in v produced by the dynamic package
+// that JSON cannot represent with nil.
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = jsonValue(e)
+		}
+		return v
+	case []any:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+		return v
+	case capnp.Client, capnp.Ptr:
+		return nil
+	default:
+		return v
+	}
+}
@@ -0,0 +1,166 @@
+package httpbridge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+const testSchema = `
+@0xb4a1e3c2d6f70a19;
+
+interface Base @0xf1c0a2b3d4e5f607 {
+  ping @0 () -> (n :Int32);
+}
+
+interface Greeter @0xc2d3e4f5a6b70819 extends(Base) {
+  greet @0 (name :Text, times :UInt8) -> (msg :Text);
+  fail @1 () -> ();
+}
+`
+
+const (
+	baseID    = 0xf1c0a2b3d4e5f607
+	greeterID = 0xc2d3e4f5a6b70819
+)
+
+func newRegistry(t *testing.T) *schemas.Registry {
+	req, err := compiler.CompileString(nil, "greeter.capnp", testSchema)
+	require.NoError(t, err)
+	data, err := req.Message().Marshal()
+	require.NoError(t, err)
+	nodes, err := req.Nodes()
+	require.NoError(t, err)
+	var ids []uint64
+	for i := 0; i < nodes.Len(); i++ {
+		ids = append(ids, nodes.At(i).Id())
+	}
+	reg := new(schemas.Registry)
+	require.NoError(t, reg.Register(&schemas.Schema{Bytes: data, Nodes: ids}))
+	return reg
+}
+
+func newGreeter() capnp.Client {
+	methods := []server.Method{
+		{
+			Method: capnp.Method{InterfaceID: baseID, MethodID: 0},
+			Impl: func(ctx context.Context, call *server.Call) error {
+				res, err := call.AllocResults(capnp.ObjectSize{DataSize: 8})
+				if err != nil {
+					return err
+				}
+				res.SetUint32(0, uint32(42))
+				return nil
+			},
+		},
+		{
+			Method: capnp.Method{InterfaceID: greeterID, MethodID: 0},
+			Impl: func(ctx context.Context, call *server.Call) error {
+				p, err := call.Args().Ptr(0)
+				if err != nil {
+					return err
+				}
+				msg := strings.Repeat("hello "+p.Text()+"! ", int(call.Args().Uint8(0)))
+				res, err := call.AllocResults(capnp.ObjectSize{PointerCount: 1})
+				if err != nil {
+					return err
+				}
+				text, err := capnp.NewText(res.Segment(), msg)
+				if err != nil {
+					return err
+				}
+				return res.SetPtr(0, text.ToPtr())
+			},
+		},
+		{
+			Method: capnp.Method{InterfaceID: greeterID, MethodID: 1},
+			Impl: func(ctx context.Context, call *server.Call) error {
+				return exc.New(exc.Unimplemented, "", "not today")
+			},
+		},
+	}
+	return capnp.NewClient(server.New(methods, nil, nil))
+}
+
+func post(t *testing.T, h http.Handler, path, body string) (int, map[string]any) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var m map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &m))
+	return rec.Code, m
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	h, err := New(newGreeter(), greeterID, &Options{Registry: newRegistry(t)})
+	require.NoError(t, err)
+	defer h.Release()
+
+	code, m := post(t, h, "/Greeter/greet", `{"name": "world", "times": 2}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]any{"msg": "hello world! hello world! "}, m)
+
+	code, m = post(t, h, "/Base/ping", `{}`)
+	assert.Equal(t, http.StatusOK, code, "superclass methods should be served")
+	assert.Equal(t, map[string]any{"n": float64(42)}, m)
+
+	code, m = post(t, h, "/Greeter/fail", `{}`)
+	assert.Equal(t, http.StatusNotImplemented, code)
+	assert.Contains(t, m["error"], "not today")
+}
+
+func TestHandlerErrors(t *testing.T) {
+	t.Parallel()
+
+	h, err := New(newGreeter(), greeterID, &Options{Registry: newRegistry(t)})
+	require.NoError(t, err)
+	defer h.Release()
+
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+	}{
+		{"unknown method", "/Greeter/wave", `{}`, http.StatusNotFound},
+		{"unknown interface", "/Other/greet", `{}`, http.StatusNotFound},
+		{"bad JSON", "/Greeter/greet", `{"name":`, http.StatusBadRequest},
+		{"not an object", "/Greeter/greet", `[1, 2]`, http.StatusBadRequest},
+		{"unknown field", "/Greeter/greet", `{"nom": "world"}`, http.StatusBadRequest},
+		{"wrong type", "/Greeter/greet", `{"times": "twice"}`, http.StatusBadRequest},
+		{"out of range", "/Greeter/greet", `{"times": 256}`, http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		code, m := post(t, h, test.path, test.body)
+		assert.Equal(t, test.status, code, test.name)
+		assert.NotEmpty(t, m["error"], test.name)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/Greeter/greet", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.MethodPost, rec.Header().Get("Allow"))
+}
+
+func TestNewUnknownInterface(t *testing.T) {
+	t.Parallel()
+
+	c := newGreeter()
+	_, err := New(c, 0xdeadbeef, &Options{Registry: newRegistry(t)})
+	assert.Error(t, err)
+	assert.False(t, c.IsValid(), "client should be released")
+}
@@ -0,0 +1,182 @@
+package capnp
+
+// This file has the accessors of pointer fields that generated code
+// calls.  Generated getters and setters are thin wrappers around them,
+// so that the code for each kind of field is compiled once instead of
+// once per field.  The type parameters are constrained by the
+// underlying kinds of the generated types, so a single instantiation
+// of each function serves all of them.
+//
+// In each function, i is the index of the field in the struct's
+// pointer section, and path names the field in errors, as for
+// Struct.FieldPtr.
+
+// GetTextField returns the text of the i'th pointer of s, or the empty
+// string if the pointer is null.
+func GetTextField[S ~StructKind](s S, i uint16, path string) (string, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return p.Text(), err
+}
+
+// GetTextFieldDefault is like GetTextField, but returns def if the
+// pointer is null.
+func GetTextFieldDefault[S ~StructKind](s S, i uint16, path, def string) (string, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return p.TextDefault(def), err
+}
+
+// GetTextBytesField returns the text of the i'th pointer of s as a
+// byte slice that points into the message, or nil if the pointer is
+// null.
+func GetTextBytesField[S ~StructKind](s S, i uint16, path string) ([]byte, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return p.TextBytes(), err
+}
+
+// GetTextBytesFieldDefault is like GetTextBytesField, but returns def
+// if the pointer is null.
+func GetTextBytesFieldDefault[S ~StructKind](s S, i uint16, path, def string) ([]byte, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return p.TextBytesDefault(def), err
+}
+
+// GetDataField returns the data of the i'th pointer of s, which points
+// into the message, or nil if the pointer is null.
+func GetDataField[S ~StructKind](s S, i uint16, path string) ([]byte, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return p.Data(), err
+}
+
+// GetDataFieldDefault is like GetDataField, but returns def if the
+// pointer is null.
+func GetDataFieldDefault[S ~StructKind](s S, i uint16, path string, def []byte) ([]byte, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return p.DataDefault(def), err
+}
+
+// GetStructField returns the struct that the i'th pointer of s points
+// to, or the zero struct if the pointer is null.
+func GetStructField[T, S ~StructKind](s S, i uint16, path string) (T, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return T(p.Struct()), err
+}
+
+// GetStructFieldDefault is like GetStructField, but returns the struct
+// in the encoded message def if the pointer is null.  The default is
+// decoded once and shared, so it is read-only: writing to it panics.
+func GetStructFieldDefault[T, S ~StructKind](s S, i uint16, path string, def []byte) (T, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	if err != nil {
+		return T{}, err
+	}
+	if ss := p.Struct(); ss.IsValid() {
+		return T(ss), nil
+	}
+	if p, err = readDefault(def); err != nil {
+		return T{}, &FieldError{Path: path, Err: err}
+	}
+	return T(p.Struct()), nil
+}
+
+// SetStructField sets the i'th pointer of s to v.
+func SetStructField[S, T ~StructKind](s S, i uint16, v T) error {
+	return Struct(s).SetPtr(i, Struct(v).ToPtr())
+}
+
+// NewStructField sets the i'th pointer of s to a struct allocated by
+// newStruct, preferring placement in s's segment, and returns it.
+func NewStructField[T, S ~StructKind](s S, i uint16, newStruct func(*Segment) (T, error)) (T, error) {
+	ss, err := newStruct(Struct(s).Segment())
+	if err != nil {
+		return T{}, err
+	}
+	err = Struct(s).SetPtr(i, Struct(ss).ToPtr())
+	return ss, err
+}
+
+// GetListField returns the list that the i'th pointer of s points to,
+// or the zero list if the pointer is null.
+func GetListField[T ~ListKind, S ~StructKind](s S, i uint16, path string) (T, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	return T(p.List()), err
+}
+
+// GetListFieldDefault is like GetListField, but returns the list in the
+// encoded message def if the pointer is null.  The default is decoded
+// once and shared, so it is read-only: writing to it panics.
+func GetListFieldDefault[T ~ListKind, S ~StructKind](s S, i uint16, path string, def []byte) (T, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	if err != nil {
+		return T{}, err
+	}
+	if l := p.List(); l.IsValid() {
+		return T(l), nil
+	}
+	if p, err = readDefault(def); err != nil {
+		return T{}, &FieldError{Path: path, Err: err}
+	}
+	return T(p.List()), nil
+}
+
+// GetPtrFieldDefault returns the i'th pointer of s, or the root of the
+// encoded message def if the pointer is null.  The default is decoded
+// once and shared, so it is read-only: writing to it panics.
+func GetPtrFieldDefault[S ~StructKind](s S, i uint16, path string, def []byte) (Ptr, error) {
+	p, err := Struct(s).FieldPtr(i, path)
+	if err != nil || p.IsValid() {
+		return p, err
+	}
+	if p, err = readDefault(def); err != nil {
+		return Ptr{}, &FieldError{Path: path, Err: err}
+	}
+	return p, nil
+}
+
+// SetListField sets the i'th pointer of s to v.
+func SetListField[S ~StructKind, T ~ListKind](s S, i uint16, v T) error {
+	return Struct(s).SetPtr(i, List(v).ToPtr())
+}
+
+// NewListField sets the i'th pointer of s to a list of n elements
+// allocated by newList, preferring placement in s's segment, and
+// returns it.
+func NewListField[T ~ListKind, S ~StructKind](s S, i uint16, n int32, newList func(*Segment, int32) (T, error)) (T, error) {
+	l, err := newList(Struct(s).Segment(), n)
+	if err != nil {
+		return T{}, err
+	}
+	err = Struct(s).SetPtr(i, List(l).ToPtr())
+	return l, err
+}
+
+// SetListFieldFromSlice sets the i'th pointer of s to a list holding
+// the elements of v, allocated by newList, preferring placement in s's
+// segment.
+func SetListFieldFromSlice[S ~StructKind, T ~ListKind, E any](s S, i uint16, v []E, newList func(*Segment, []E) (T, error)) error {
+	l, err := newList(Struct(s).Segment(), v)
+	if err != nil {
+		return err
+	}
+	return Struct(s).SetPtr(i, List(l).ToPtr())
+}
+
+// GetInterfaceField returns the client of the capability that the i'th
+// pointer of s points to, or the null client if the pointer is null or
+// cannot be read.  The client is not a new reference: the caller must
+// not release it.
+func GetInterfaceField[T ~ClientKind, S ~StructKind](s S, i uint16) T {
+	p, _ := Struct(s).Ptr(i)
+	return T(p.Interface().Client())
+}
+
+// SetInterfaceField sets the i'th pointer of s to the capability v,
+// adding v to the message's capability table, or to null if v is the
+// null client.  It takes ownership of v.
+func SetInterfaceField[S ~StructKind, T ~ClientKind](s S, i uint16, v T) error {
+	if !Client(v).IsValid() {
+		return Struct(s).SetPtr(i, Ptr{})
+	}
+	seg := Struct(s).Segment()
+	in := NewInterface(seg, seg.Message().CapTable().Add(Client(v)))
+	return Struct(s).SetPtr(i, in.ToPtr())
+}
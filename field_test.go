@@ -0,0 +1,121 @@
+package capnp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// point and line stand in for generated struct types.
+type point Struct
+type line Struct
+
+func newPoint(seg *Segment) (point, error) {
+	st, err := NewStruct(seg, ObjectSize{DataSize: 8})
+	return point(st), err
+}
+
+func TestFieldAccessors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Text", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		st, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+		l := line(st)
+
+		v, err := GetTextField(l, 0, "line.name")
+		require.NoError(t, err)
+		assert.Equal(t, "", v)
+		v, err = GetTextFieldDefault(l, 0, "line.name", "unnamed")
+		require.NoError(t, err)
+		assert.Equal(t, "unnamed", v)
+
+		require.NoError(t, st.SetText(0, "hello"))
+		v, err = GetTextField(l, 0, "line.name")
+		require.NoError(t, err)
+		assert.Equal(t, "hello", v)
+		b, err := GetTextBytesField(l, 0, "line.name")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hello"), b)
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		st, err := NewRootStruct(seg, ObjectSize{PointerCount: 2})
+		require.NoError(t, err)
+		l := line(st)
+
+		p, err := GetStructField[point](l, 0, "line.start")
+		require.NoError(t, err)
+		assert.False(t, Struct(p).IsValid())
+
+		p, err = NewStructField(l, 0, newPoint)
+		require.NoError(t, err)
+		Struct(p).SetUint64(0, 42)
+		p, err = GetStructField[point](l, 0, "line.start")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), Struct(p).Uint64(0))
+
+		require.NoError(t, SetStructField(l, 1, p))
+		p, err = GetStructField[point](l, 1, "line.end")
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), Struct(p).Uint64(0))
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		st, err := NewRootStruct(seg, ObjectSize{PointerCount: 2})
+		require.NoError(t, err)
+		l := line(st)
+
+		ints, err := NewListField(l, 0, 3, NewInt64List)
+		require.NoError(t, err)
+		ints.Set(2, 7)
+		got, err := GetListField[Int64List](l, 0, "line.ints")
+		require.NoError(t, err)
+		require.Equal(t, 3, got.Len())
+		assert.Equal(t, int64(7), got.At(2))
+
+		require.NoError(t, SetListField(l, 1, got))
+		got, err = GetListField[Int64List](l, 1, "line.copy")
+		require.NoError(t, err)
+		assert.Equal(t, 3, got.Len())
+
+		require.NoError(t, SetListFieldFromSlice(l, 1, []int64{4, 5}, NewInt64ListFromSlice))
+		got, err = GetListField[Int64List](l, 1, "line.copy")
+		require.NoError(t, err)
+		require.Equal(t, 2, got.Len())
+		assert.Equal(t, int64(5), got.At(1))
+	})
+
+	t.Run("FieldError", func(t *testing.T) {
+		t.Parallel()
+
+		// A struct pointer that points past the end of its segment.
+		msg := &Message{Arena: SingleSegment([]byte{
+			0, 0, 0, 0, 0, 0, 1, 0,
+			0xfc, 0xff, 0xff, 0x7f, 1, 0, 0, 0,
+		})}
+		st, err := msg.Root()
+		require.NoError(t, err)
+		l := line(st.Struct())
+
+		_, err = GetTextField(l, 0, "line.name")
+		var fe *FieldError
+		require.True(t, errors.As(err, &fe), "error %v is not a *FieldError", err)
+		assert.Equal(t, "line.name", fe.Path)
+		_, err = GetStructField[point](l, 0, "line.start")
+		assert.True(t, errors.As(err, &fe))
+		_, err = GetListField[Int64List](l, 0, "line.ints")
+		assert.True(t, errors.As(err, &fe))
+	})
+}
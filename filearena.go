@@ -0,0 +1,215 @@
+package capnp
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// DefaultFileSegmentSize is the size of the segments created by a
+// FileArena if no size is given.
+const DefaultFileSegmentSize = 64 << 20
+
+// FileArena is a builder arena that stores its segments in a file,
+// so that messages larger than RAM can be built and serialized in
+// bounded memory.  Segments are memory-mapped, so the operating
+// system writes them to disk incrementally and may evict them from
+// memory once they are written.
+//
+// The file starts with space for a segment table with room for 512
+// segments.  Finish writes a table that is only as large as the
+// number of segments used, and moves the first segment back to just
+// after it, so that the finished file holds a message in the standard
+// stream framing.  A FileArena cannot create more than 512 segments;
+// use a segment size that is large enough for the message.
+//
+// The segments stay mapped until Finish or Release is called.  One of
+// them must be called, either directly or by releasing the message,
+// since the mappings are not freed by the garbage collector.
+//
+// FileArena is only supported on Unix systems that support mmap.
+type FileArena struct {
+	f       *os.File
+	segSize int
+	hdrSize int64
+	off     int64 // file offset of the next segment
+
+	segs [][]byte // segment data, with the segment's capacity
+	maps [][]byte // memory mappings, to unmap when done
+	done bool
+}
+
+// NewFileArena returns an arena that stores its segments in f, which
+// must be open for reading and writing.  Existing data in f is
+// overwritten.  segmentSize is the size of each segment in bytes, and
+// must be a multiple of 8; if zero, DefaultFileSegmentSize is used.
+// Objects larger than segmentSize get a segment of their own.
+//
+// The caller must call Finish to write the message's segment table,
+// or Release to discard the message, and remains responsible for
+// closing f.
+func NewFileArena(f *os.File, segmentSize int) (*FileArena, error) {
+	if segmentSize == 0 {
+		segmentSize = DefaultFileSegmentSize
+	}
+	if segmentSize < 0 || segmentSize%int(wordSize) != 0 || int64(segmentSize) > int64(maxSegmentSize) {
+		return nil, errors.New("new file arena: invalid segment size " + str.Itod(segmentSize))
+	}
+	if err := mmapSupported(); err != nil {
+		return nil, err
+	}
+	hdrSize := int64(streamHeaderSize(maxStreamSegments - 1))
+	if err := f.Truncate(hdrSize); err != nil {
+		return nil, err
+	}
+	return &FileArena{
+		f:       f,
+		segSize: segmentSize,
+		hdrSize: hdrSize,
+		off:     hdrSize,
+	}, nil
+}
+
+func (fa *FileArena) NumSegments() int64 {
+	return int64(len(fa.segs))
+}
+
+func (fa *FileArena) Data(id SegmentID) ([]byte, error) {
+	if int64(id) >= int64(len(fa.segs)) {
+		return nil, errors.New("segment " + str.Utod(id) + " requested (arena only has " +
+			str.Itod(len(fa.segs)) + " segments)")
+	}
+	return fa.segs[id], nil
+}
+
+func (fa *FileArena) Allocate(sz Size, segs map[SegmentID]*Segment) (SegmentID, []byte, error) {
+	if fa.done {
+		return 0, nil, errors.New("alloc " + sz.String() + ": file arena is finished")
+	}
+	for i, data := range fa.segs {
+		id := SegmentID(i)
+		if s := segs[id]; s != nil {
+			data = s.data
+		}
+		if hasCapacity(data, sz) {
+			return id, data, nil
+		}
+	}
+	if len(fa.segs) == maxStreamSegments {
+		return 0, nil, errors.New("alloc " + sz.String() + ": file arena is out of segments")
+	}
+	if sz > maxSegmentSize {
+		return 0, nil, errors.New("alloc " + sz.String() + ": too large")
+	}
+	n := int64(fa.segSize)
+	if int64(sz.padToWord()) > n {
+		n = int64(sz.padToWord())
+	}
+
+	// Mappings must start at a page boundary, so map the start of the
+	// page that the segment starts in as well.
+	start := fa.off &^ int64(os.Getpagesize()-1)
+	if err := fa.f.Truncate(fa.off + n); err != nil {
+		return 0, nil, err
+	}
+	m, err := mmapFile(fa.f, start, int(fa.off+n-start))
+	if err != nil {
+		return 0, nil, err
+	}
+	data := m[fa.off-start:]
+	data = data[:0:len(data)]
+
+	id := SegmentID(len(fa.segs))
+	fa.maps = append(fa.maps, m)
+	fa.segs = append(fa.segs, data)
+	fa.off += n
+	return id, data, nil
+}
+
+// Finish writes the segment table for msg, which must be the message
+// that uses fa, and truncates the file to the size of the message.
+// The file then holds msg in the standard stream framing.  The
+// message must not be used after Finish returns.
+func (fa *FileArena) Finish(msg *Message) error {
+	if msg.Arena != fa {
+		return errors.New("finish file arena: message does not use the arena")
+	}
+	if fa.done {
+		return errors.New("finish file arena: already finished")
+	}
+	if len(fa.segs) == 0 {
+		// A message has at least one segment, even if it is empty.
+		if _, err := fa.f.WriteAt(make([]byte, streamHeaderSize(0)), 0); err != nil {
+			return err
+		}
+		fa.done = true
+		return fa.f.Truncate(int64(streamHeaderSize(0)))
+	}
+
+	// The first segment moves back to just after the header and grows
+	// by the space that the header doesn't need, so that the other
+	// segments stay where they are.  The first mapping starts at the
+	// beginning of the file, since the reserved header is smaller
+	// than a page.
+	last := len(fa.segs) - 1
+	hdrSize := int64(streamHeaderSize(SegmentID(last)))
+	shift := fa.hdrSize - hdrSize
+	hdr := fa.maps[0][:hdrSize]
+	binary.LittleEndian.PutUint32(hdr, uint32(last))
+	size := hdrSize
+	for i, data := range fa.segs {
+		s, err := msg.Segment(SegmentID(i))
+		if err != nil {
+			return err
+		}
+		n := cap(data)
+		if i == last {
+			// The last segment ends where its data does; the others
+			// are followed by the next segment.
+			n = len(s.Data())
+		}
+		if i == 0 {
+			used := len(s.Data())
+			m := fa.maps[0]
+			copy(m[hdrSize:], m[fa.hdrSize:fa.hdrSize+int64(used)])
+			stale := m[hdrSize+int64(used) : fa.hdrSize+int64(used)]
+			for j := range stale {
+				stale[j] = 0
+			}
+			if i != last {
+				n += int(shift)
+			}
+		}
+		binary.LittleEndian.PutUint32(hdr[4+i*4:], uint32(n/int(wordSize)))
+		size += int64(n)
+	}
+	if err := fa.unmap(); err != nil {
+		return err
+	}
+	return fa.f.Truncate(size)
+}
+
+// Release unmaps the segments without writing the segment table.  It
+// does nothing if Finish has been called.
+func (fa *FileArena) Release() {
+	fa.unmap()
+}
+
+func (fa *FileArena) unmap() error {
+	fa.done = true
+	var err error
+	for _, m := range fa.maps {
+		if e := munmap(m); e != nil && err == nil {
+			err = e
+		}
+	}
+	fa.maps = nil
+	fa.segs = nil
+	return err
+}
+
+func (fa *FileArena) String() string {
+	return "file arena [" + str.Itod(len(fa.segs)) + " segments]"
+}
@@ -0,0 +1,24 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package capnp
+
+import (
+	"os"
+	"syscall"
+)
+
+func mmapSupported() error {
+	return nil
+}
+
+func mmapFile(f *os.File, off int64, n int) ([]byte, error) {
+	b, err := syscall.Mmap(int(f.Fd()), off, n, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, os.NewSyscallError("mmap", err)
+	}
+	return b, nil
+}
+
+func munmap(b []byte) error {
+	return os.NewSyscallError("munmap", syscall.Munmap(b))
+}
@@ -0,0 +1,20 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package capnp
+
+import (
+	"errors"
+	"os"
+)
+
+func mmapSupported() error {
+	return errors.New("new file arena: not supported on this platform")
+}
+
+func mmapFile(f *os.File, off int64, n int) ([]byte, error) {
+	return nil, mmapSupported()
+}
+
+func munmap(b []byte) error {
+	return nil
+}
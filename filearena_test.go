@@ -0,0 +1,136 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package capnp
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileArena(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "msg.bin"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	arena, err := NewFileArena(f, 1024)
+	require.NoError(t, err)
+	msg, seg, err := NewMessage(arena)
+	require.NoError(t, err)
+	root, err := NewRootStruct(seg, ObjectSize{PointerCount: 2})
+	require.NoError(t, err)
+
+	// Spread the list over several segments.
+	const n = 200
+	list, err := NewTextList(seg, n)
+	require.NoError(t, err)
+	for i := 0; i < n; i++ {
+		require.NoError(t, list.Set(i, "element "+strconv.Itoa(i)))
+	}
+	require.NoError(t, root.SetPtr(0, list.ToPtr()))
+	big := make([]byte, 4096)
+	for i := range big {
+		big[i] = byte(i)
+	}
+	require.NoError(t, root.SetData(1, big))
+	nsegs := arena.NumSegments()
+	require.Greater(t, nsegs, int64(2), "message should need several segments")
+
+	require.NoError(t, arena.Finish(msg))
+	msg.Release()
+
+	data, err := os.ReadFile(f.Name())
+	require.NoError(t, err)
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, nsegs, got.NumSegments())
+	size := streamHeaderSize(SegmentID(nsegs - 1))
+	for i := int64(0); i < nsegs; i++ {
+		s, err := got.Segment(SegmentID(i))
+		require.NoError(t, err)
+		size += uint64(len(s.Data()))
+	}
+	assert.Equal(t, size, uint64(len(data)), "file holds only the header and segments")
+
+	p, err := got.Root()
+	require.NoError(t, err)
+	gotList, err := p.Struct().Ptr(0)
+	require.NoError(t, err)
+	tl := TextList(gotList.List())
+	require.Equal(t, n, tl.Len())
+	for i := 0; i < n; i++ {
+		s, err := tl.At(i)
+		require.NoError(t, err)
+		assert.Equal(t, "element "+strconv.Itoa(i), s)
+	}
+	gotData, err := p.Struct().Ptr(1)
+	require.NoError(t, err)
+	assert.Equal(t, big, gotData.Data())
+}
+
+func TestFileArenaSingleSegment(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "msg.bin"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	arena, err := NewFileArena(f, 1024)
+	require.NoError(t, err)
+	msg, seg, err := NewMessage(arena)
+	require.NoError(t, err)
+	root, err := NewRootStruct(seg, ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	root.SetUint64(0, 42)
+	require.NoError(t, arena.Finish(msg))
+	msg.Release()
+
+	// The file holds exactly what Marshal would produce.
+	want, err := msgWithRoot(t, 42)
+	require.NoError(t, err)
+	data, err := os.ReadFile(f.Name())
+	require.NoError(t, err)
+	assert.Equal(t, want, data)
+}
+
+func msgWithRoot(t *testing.T, v uint64) ([]byte, error) {
+	msg, seg, err := NewMessage(SingleSegment(nil))
+	require.NoError(t, err)
+	root, err := NewRootStruct(seg, ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	root.SetUint64(0, v)
+	return msg.Marshal()
+}
+
+func TestFileArenaErrors(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "msg.bin"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = NewFileArena(f, 12)
+	assert.Error(t, err, "segment size that is not a multiple of the word size")
+
+	arena, err := NewFileArena(f, 8)
+	require.NoError(t, err)
+	defer arena.Release()
+	segs := make(map[SegmentID]*Segment)
+	for i := 0; i < maxStreamSegments; i++ {
+		id, data, err := arena.Allocate(8, segs)
+		require.NoError(t, err)
+		require.Equal(t, SegmentID(i), id)
+		segs[id] = &Segment{id: id, data: data[:8]}
+	}
+	_, _, err = arena.Allocate(8, segs)
+	assert.Error(t, err, "allocating more than the maximum number of segments")
+
+	msg := &Message{Arena: SingleSegment(nil)}
+	assert.Error(t, arena.Finish(msg), "finishing a message with another arena")
+}
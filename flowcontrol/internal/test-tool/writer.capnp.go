@@ -3,12 +3,14 @@
 package main
 
 import (
+	bytes "bytes"
 	capnp "capnproto.org/go/capnp/v3"
 	text "capnproto.org/go/capnp/v3/encoding/text"
 	fc "capnproto.org/go/capnp/v3/flowcontrol"
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	io "io"
 )
 
 type Writer capnp.Client
@@ -166,7 +168,7 @@ func (c Writer_write) AllocResults() (Writer_write_Results, error) {
 // Writer_List is a list of Writer.
 type Writer_List = capnp.CapList[Writer]
 
-// NewWriter creates a new list of Writer.
+// NewWriter_List creates a new list of Writer.
 func NewWriter_List(s *capnp.Segment, sz int32) (Writer_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
 	return capnp.CapList[Writer](l), err
@@ -220,8 +222,7 @@ func (s Writer_write_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 func (s Writer_write_Params) Data() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+	return capnp.GetDataField(s, 0, "Writer.write$Params.data")
 }
 
 func (s Writer_write_Params) HasData() bool {
@@ -232,6 +233,19 @@ func (s Writer_write_Params) SetData(v []byte) error {
 	return capnp.Struct(s).SetData(0, v)
 }
 
+// DataReader returns a reader of data's data, which
+// is read directly from the message.
+func (s Writer_write_Params) DataReader() (*bytes.Reader, error) {
+	v, err := s.Data()
+	return bytes.NewReader(v), err
+}
+
+// SetDataFromReader sets data to the next size bytes of r,
+// which are read directly into the message.
+func (s Writer_write_Params) SetDataFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
+}
+
 // Writer_write_Params_List is a list of Writer_write_Params.
 type Writer_write_Params_List = capnp.StructList[Writer_write_Params]
 
@@ -314,21 +328,21 @@ func (f Writer_write_Results_Future) Struct() (Writer_write_Results, error) {
 	return Writer_write_Results(p.Struct()), err
 }
 
-const schema_aca73f831c7ebfdd = "x\xda\x12\xa8u`1\xe4\xcdgb`\x0a\x94ae" +
-	"\xfb_~\xe4\xb1K\xfc\xd9\x1d\x0d\x0c\x82\"\x8c\x0c\x0c" +
-	"\xac\x8c\xec\x0c\x0c\xc6\xb2\x8c\\\x8c\x0c\x8c\xc2\xaa\x8c\xf6" +
-	"\x0c\x8c\xff\x7f<WI\xe8\xb9gy\x13\xa2\x80\x05$" +
-	"\xef\xca(\xc4\xc8\xc0\xf2?[\xb8\x7f\xf9\x96\x08\xbd\x1f" +
-	"\x0c\x82\xbc\xcc\xff\xef\xee\xaf\x93i\xb6_\xbe\x86\x81\x81" +
-	"QX\x97q\x91\xb0)\xc8$aCFw\xe1HF" +
-	"v\x06\x9d\xff\xe5E\x99%\xa9Ez\xc9\xcc\x89\x05y" +
-	"\x05V\xe1\x10\x1eXP% \xb1(1\xb7\x98\x81!" +
-	"\x90\x85\x99\x85\x81\x81\x85\x91\x81A\x90W\x8b\x81!\x90" +
-	"\x83\x991P\x84\x89\x91?%\xb1$\x91\x91\x97\x81\x89" +
-	"\x91\x97\x81\x11\x9f9A\xa9\xc5\xa59%\x8c\xc5p5" +
-	"\x8c05\xec%\xa9E\x01\x8c\x8c\x81,\xcc\xac\x0c\x0c" +
-	"p/3\xc2\xbc&(h\xc4\xc0$\xc8\xca.\x0f\xd6" +
-	"\xe8\xc0\x18\xc0\xc8\x08\x08\x00\x00\xff\xff\x1d\x88R\x0a"
+const schema_aca73f831c7ebfdd = "x\xda20gt`1\xe4u\xe7``\x0aL`" +
+	"e\xfb_~\xe4\xb1K\xfc\xd9\x1d\x0d\x0c\x82\"\x8c\x0c" +
+	"\x0c\xac\x8c\xec\x0c\x0c\xc6\x9c,\\\x8c\x0c\x8c\xc2\x82," +
+	"\xf6\x0c\x8c\xff\x7f<WI\xe8\xb9gy\x13\xa2\x80\x05" +
+	"$o\xc8\"\x04\x92\xb7dag`\xfc\x9f-\xdc\xbf" +
+	"|K\x84\xde\x0f\x06A^\xe6\xffw\xf7\xd7\xc94\xdb" +
+	"/_\xc3\xc0\xc0(,\xcb\xb2\x08L\xba\x0b;\xb2\xb0" +
+	"3<\xff_^\x94Y\x92Z\xa4\x97\xcc\x9cX\x90W" +
+	"`\x15\x0e\xe1\x81\x05U\x02\x12\x8b\x12s\x8b\x19\x18\x02" +
+	"Y\x98Y\x18\x18X\x18\x19\x18\x04y\xb5\x18\x18\x029" +
+	"\x98\x19\x03E\x98\x18\xf9S\x12K\x12\x19y\x19\x98\x18" +
+	"y\x19\x18\xf1\x99\x13\x94Z\\\x9aS\xc2X\x1c\xc0\xcc" +
+	"\x02W\xc6\x08S\xc6^\x92Z\x14\xc8\xc2\xcc\xca\xc0\x00" +
+	"\xf74#\xccs\x82\x82F\x0c\xcc\xf2`-\x01\x8c\x8c" +
+	"\x80\x01\x00\x9e>R\x0a"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -341,3 +355,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_aca73f831c7ebfdd,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -0,0 +1,206 @@
+// Package fuzz derives fuzz targets from generated struct types.
+//
+// A target decodes arbitrary bytes as a message whose root is the
+// struct type, reads it, and checks that encoding it again gives the
+// same canonical form.  It can be used with native Go fuzzing:
+//
+//	func FuzzBook(f *testing.F) {
+//		fuzz.AddSeed(f, exampleBookMessage())
+//		f.Fuzz(fuzz.Target[books.Book](nil))
+//	}
+//
+// or with go-fuzz:
+//
+//	func Fuzz(data []byte) int {
+//		return fuzz.GoFuzz[books.Book](data, nil)
+//	}
+//
+// Messages are read with a small traversal limit, so inputs that
+// claim to hold huge objects are rejected instead of exhausting the
+// fuzzer's memory, and the fuzzer finds panics rather than OOMs.
+package fuzz
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+// Default limits used when Options does not set them.
+const (
+	DefaultTraverseLimit = 1 << 20 // 1 MiB
+	DefaultDepthLimit    = 64
+	DefaultMaxSize       = 64 << 10 // 64 KiB
+)
+
+// Options limits the resources used to check an input.  A nil
+// *Options uses the defaults.
+type Options struct {
+	// TraverseLimit is the message's traversal limit for each pass
+	// over it.  Since every pass allocates at most in proportion to
+	// the bytes it traverses, this bounds the memory used per input.
+	// Zero means DefaultTraverseLimit.
+	TraverseLimit uint64
+
+	// DepthLimit is the message's depth limit.  Zero means
+	// DefaultDepthLimit.
+	DepthLimit uint
+
+	// MaxSize is the length of the longest input that is checked;
+	// longer inputs are skipped.  Zero means DefaultMaxSize.
+	MaxSize int
+}
+
+func (opts *Options) traverseLimit() uint64 {
+	if opts == nil || opts.TraverseLimit == 0 {
+		return DefaultTraverseLimit
+	}
+	return opts.TraverseLimit
+}
+
+func (opts *Options) depthLimit() uint {
+	if opts == nil || opts.DepthLimit == 0 {
+		return DefaultDepthLimit
+	}
+	return opts.DepthLimit
+}
+
+func (opts *Options) maxSize() int {
+	if opts == nil || opts.MaxSize == 0 {
+		return DefaultMaxSize
+	}
+	return opts.MaxSize
+}
+
+// Check decodes data as a serialized message whose root is a T and
+// exercises it.  ok reports whether data was a valid message, which
+// fuzzers use to prioritize inputs.  A non-nil error means that a
+// property that should hold for every valid message did not: the
+// message's canonical form changed when it was copied to a new
+// message, or canonicalizing the canonical form changed it.  Bugs
+// that cause panics are left to panic.
+//
+// If T has a String method, as generated types do by default, Check
+// calls it, which reads every field through the type's schema
+// regardless of whether data is valid.
+func Check[T ~capnp.StructKind](data []byte, opts *Options) (ok bool, err error) {
+	if len(data) > opts.maxSize() {
+		return false, nil
+	}
+	msg, err := capnp.Unmarshal(data)
+	if err != nil {
+		return false, nil
+	}
+	msg.TraverseLimit = opts.traverseLimit()
+	msg.DepthLimit = opts.depthLimit()
+	root, err := msg.Root()
+	if err != nil {
+		return false, nil
+	}
+	if s, ok := any(T(root.Struct())).(fmt.Stringer); ok {
+		_ = s.String()
+	}
+
+	msg.ResetReadLimit(opts.traverseLimit())
+	if err := msg.Validate(); err != nil {
+		return false, nil
+	}
+	msg.ResetReadLimit(opts.traverseLimit())
+	want, err := capnp.Canonicalize(root.Struct())
+	if err != nil {
+		// The message is too large to canonicalize within the
+		// limits.
+		return false, nil
+	}
+
+	// Copying capabilities renumbers them, so their canonical form
+	// is not preserved.
+	if st, err := msg.Stats(); err != nil || st.Capabilities > 0 {
+		return true, nil
+	}
+
+	// Copy the root to a new message, as a program re-encoding the
+	// struct would.
+	msg.ResetReadLimit(opts.traverseLimit())
+	copied, _, err := capnp.NewMessage(capnp.MultiSegment(nil))
+	if err != nil {
+		return true, exc.WrapError("fuzz: new message", err)
+	}
+	if err := copied.SetRoot(root.Struct().ToPtr()); err != nil {
+		return true, exc.WrapError("fuzz: copy valid message", err)
+	}
+	copied.TraverseLimit = opts.traverseLimit()
+	copied.DepthLimit = opts.depthLimit()
+	croot, err := copied.Root()
+	if err != nil {
+		return true, exc.WrapError("fuzz: read copied message", err)
+	}
+	got, err := capnp.Canonicalize(croot.Struct())
+	if err != nil {
+		return true, exc.WrapError("fuzz: canonicalize copied message", err)
+	}
+	if !bytes.Equal(got, want) {
+		return true, errors.New("fuzz: copying the message changed its canonical form")
+	}
+
+	// The canonical form is itself a message, which must be its own
+	// canonical form.
+	canon := &capnp.Message{
+		Arena:         capnp.SingleSegment(want),
+		TraverseLimit: opts.traverseLimit(),
+		DepthLimit:    opts.depthLimit(),
+	}
+	p, err := canon.Root()
+	if err != nil {
+		return true, exc.WrapError("fuzz: read canonical form", err)
+	}
+	got, err = capnp.Canonicalize(p.Struct())
+	if err != nil {
+		return true, exc.WrapError("fuzz: canonicalize canonical form", err)
+	}
+	if !bytes.Equal(got, want) {
+		return true, errors.New("fuzz: canonicalizing the canonical form changed it")
+	}
+	return true, nil
+}
+
+// Target returns a native fuzz target for T, to be passed to
+// testing.F.Fuzz.  It fails the test if Check returns an error.
+// Target enables capnp's invariant checks, so that the fuzzer also
+// finds inputs that make unchecked arithmetic overflow.
+func Target[T ~capnp.StructKind](opts *Options) func(*testing.T, []byte) {
+	capnp.SetInvariantChecks(true)
+	return func(t *testing.T, data []byte) {
+		if _, err := Check[T](data, opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// GoFuzz is the body of a go-fuzz Fuzz function for T.  It returns 1
+// if data was a valid message and 0 otherwise, and panics if Check
+// returns an error.
+func GoFuzz[T ~capnp.StructKind](data []byte, opts *Options) int {
+	ok, err := Check[T](data, opts)
+	if err != nil {
+		panic(err)
+	}
+	if !ok {
+		return 0
+	}
+	return 1
+}
+
+// AddSeed adds msg's serialized form to f's seed corpus.
+func AddSeed(f *testing.F, msg *capnp.Message) {
+	f.Helper()
+	b, err := msg.Marshal()
+	if err != nil {
+		f.Fatal("fuzz: add seed:", err)
+	}
+	f.Add(b)
+}
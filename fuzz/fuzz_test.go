@@ -0,0 +1,79 @@
+package fuzz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+)
+
+func newZ(t testing.TB) *capnp.Message {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	require.NoError(t, err)
+	z, err := air.NewRootZ(seg)
+	require.NoError(t, err)
+	l, err := z.NewTextvec(2)
+	require.NoError(t, err)
+	require.NoError(t, l.Set(0, "hello"))
+	require.NoError(t, l.Set(1, "world"))
+	return msg
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	b, err := newZ(t).Marshal()
+	require.NoError(t, err)
+	ok, err := Check[air.Z](b, nil)
+	assert.True(t, ok, "valid message")
+	assert.NoError(t, err)
+
+	for _, data := range [][]byte{
+		nil,
+		{1, 2, 3},
+		{0, 0, 0, 0, 0, 0, 0, 0}, // empty segment
+		b[:len(b)-8],
+	} {
+		ok, err := Check[air.Z](data, nil)
+		assert.False(t, ok, "invalid message %x", data)
+		assert.NoError(t, err)
+	}
+
+	ok, err = Check[air.Z](b, &Options{MaxSize: len(b) - 1})
+	assert.False(t, ok, "input longer than MaxSize")
+	assert.NoError(t, err)
+}
+
+func TestCheckTraverseLimit(t *testing.T) {
+	t.Parallel()
+
+	// The root struct's pointers all refer to the same large list, so
+	// a copy of the message would be much larger than the input.
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	require.NoError(t, err)
+	root, err := capnp.NewRootStruct(seg, capnp.ObjectSize{PointerCount: 64})
+	require.NoError(t, err)
+	list, err := capnp.NewData(seg, make([]byte, 4<<10))
+	require.NoError(t, err)
+	for i := uint16(0); i < 64; i++ {
+		require.NoError(t, root.SetPtr(i, list.ToPtr()))
+	}
+	b, err := msg.Marshal()
+	require.NoError(t, err)
+	require.Less(t, len(b), 64<<10)
+
+	ok, err := Check[capnp.Struct](b, &Options{TraverseLimit: 64 << 10})
+	assert.False(t, ok, "message exceeds the traversal limit")
+	assert.NoError(t, err)
+	ok, err = Check[capnp.Struct](b, nil)
+	assert.True(t, ok, "message fits the default traversal limit")
+	assert.NoError(t, err)
+}
+
+func FuzzZ(f *testing.F) {
+	AddSeed(f, newZ(f))
+	f.Fuzz(Target[air.Z](nil))
+}
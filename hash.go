@@ -0,0 +1,163 @@
+package capnp
+
+import (
+	"encoding/binary"
+	"hash"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// Tags that start each object in the stream that Hash writes.
+const (
+	hashNull      byte = 0
+	hashStruct    byte = 1
+	hashData      byte = 2 // list without pointers
+	hashPointers  byte = 3 // list of pointers
+	hashComposite byte = 4 // list of structs
+	hashInterface byte = 5
+)
+
+// Hash writes a digest of the object that p points to, and of its
+// descendants, to h.  Objects with the same canonical form, as
+// produced by Canonicalize, write the same bytes, so the sum of h can
+// key deduplication caches and content-addressed storage: it does not
+// change when a struct is encoded with a larger data or pointer
+// section, or when its objects are laid out in another order.
+//
+// Unlike hashing the result of Canonicalize, Hash visits each object
+// once and does not buffer the encoding, so the bytes written to h are
+// not the canonical form itself.  Each object is written as a tag byte
+// and its sizes as uvarints, followed by its data and then by its
+// pointers' objects in order:
+//
+//	null:      0
+//	struct:    1, data words, pointers, data, pointer objects
+//	data list: 2, element size in bits, length, data
+//	ptr list:  3, length, pointer objects
+//	composite: 4, length, each element as a struct
+//	interface: 5, capability index
+//
+// where the data and pointer counts of structs omit trailing zero
+// words and null pointers, as in the canonical form.  The stream will
+// not change in future versions of this package.
+//
+// Hash does not call h.Reset, so a digest may cover several objects.
+func Hash(p Ptr, h hash.Hash) error {
+	hw := &hashWriter{h: h}
+	if err := hw.ptr(p); err != nil {
+		return exc.WrapError("hash", err)
+	}
+	hw.flush()
+	return nil
+}
+
+// hashWriter batches the writes of Hash.
+type hashWriter struct {
+	h   hash.Hash
+	buf [512]byte
+	n   int
+}
+
+func (hw *hashWriter) write(b []byte) {
+	for len(b) > 0 {
+		if hw.n == len(hw.buf) {
+			hw.flush()
+		}
+		k := copy(hw.buf[hw.n:], b)
+		hw.n += k
+		b = b[k:]
+	}
+}
+
+// uvarint writes tag followed by vals as uvarints.
+func (hw *hashWriter) uvarint(tag byte, vals ...uint64) {
+	var b [1 + 3*binary.MaxVarintLen64]byte
+	b[0] = tag
+	n := 1
+	for _, v := range vals {
+		n += binary.PutUvarint(b[n:], v)
+	}
+	hw.write(b[:n])
+}
+
+// flush writes the buffered bytes to the hash, whose Write never
+// returns an error.
+func (hw *hashWriter) flush() {
+	hw.h.Write(hw.buf[:hw.n])
+	hw.n = 0
+}
+
+func (hw *hashWriter) ptr(p Ptr) error {
+	if !p.IsValid() {
+		hw.uvarint(hashNull)
+		return nil
+	}
+	switch p.flags.ptrType() {
+	case structPtrType:
+		return hw.structObj(p.Struct())
+	case listPtrType:
+		return hw.list(p.List())
+	case interfacePtrType:
+		hw.uvarint(hashInterface, uint64(p.Interface().Capability()))
+		return nil
+	default:
+		panic("unreachable")
+	}
+}
+
+func (hw *hashWriter) structObj(s Struct) error {
+	sz := canonicalStructSize(s)
+	hw.uvarint(hashStruct, uint64(sz.DataSize/wordSize), uint64(sz.PointerCount))
+	n := sz.DataSize
+	if s.size.DataSize < n {
+		n = s.size.DataSize
+	}
+	hw.write(s.seg.slice(s.off, n))
+	hw.write(zeroWords[:sz.DataSize-n])
+	for i := uint16(0); i < sz.PointerCount; i++ {
+		p, err := s.Ptr(i)
+		if err != nil {
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+		if err := hw.ptr(p); err != nil {
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+	}
+	return nil
+}
+
+func (hw *hashWriter) list(l List) error {
+	switch {
+	case l.flags&isCompositeList != 0:
+		hw.uvarint(hashComposite, uint64(l.length))
+		for i := 0; i < l.Len(); i++ {
+			if err := hw.structObj(l.Struct(i)); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+		}
+		return nil
+	case l.size.PointerCount == 0:
+		bits := uint64(l.size.DataSize) * 8
+		if l.flags&isBitList != 0 {
+			bits = 1
+		}
+		hw.uvarint(hashData, bits, uint64(l.length))
+		sz := l.allocSize()
+		end, _ := l.off.addSize(sz) // list was already validated
+		hw.write(l.seg.data[l.off:end])
+		return nil
+	default:
+		hw.uvarint(hashPointers, uint64(l.length))
+		for i := 0; i < l.Len(); i++ {
+			p, err := PointerList(l).At(i)
+			if err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+			if err := hw.ptr(p); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+package capnp
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordHash is a hash.Hash that records the bytes written to it.
+type recordHash struct{ bytes.Buffer }
+
+func (h *recordHash) Sum(b []byte) []byte { return append(b, h.Bytes()...) }
+func (h *recordHash) Size() int           { return h.Len() }
+func (h *recordHash) BlockSize() int      { return 1 }
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Stream", func(t *testing.T) {
+		_, seg := NewSingleSegmentMessage(nil)
+		s, err := NewStruct(seg, ObjectSize{DataSize: 16, PointerCount: 3})
+		require.NoError(t, err)
+		s.SetUint16(0, 0xbeef)
+		l, err := NewInt8List(seg, 2)
+		require.NoError(t, err)
+		l.Set(0, 1)
+		l.Set(1, 2)
+		require.NoError(t, s.SetPtr(1, l.ToPtr()))
+
+		var h recordHash
+		require.NoError(t, Hash(s.ToPtr(), &h))
+		assert.Equal(t, []byte{
+			1, 1, 2, // struct: 1 data word, 2 pointers
+			0xef, 0xbe, 0, 0, 0, 0, 0, 0,
+			0,             // null
+			2, 8, 2, 1, 2, // list of two bytes
+		}, h.Bytes())
+	})
+
+	t.Run("Canonical", func(t *testing.T) {
+		// The same content, with larger sections and laid out in
+		// another order.
+		_, seg := NewSingleSegmentMessage(nil)
+		a, err := NewStruct(seg, ObjectSize{DataSize: 8, PointerCount: 2})
+		require.NoError(t, err)
+		a.SetUint32(0, 7)
+		ta, err := NewText(seg, "hello")
+		require.NoError(t, err)
+		require.NoError(t, a.SetPtr(0, ta.ToPtr()))
+		ca, err := NewStruct(seg, ObjectSize{DataSize: 8})
+		require.NoError(t, err)
+		ca.SetUint64(0, 1)
+		require.NoError(t, a.SetPtr(1, ca.ToPtr()))
+
+		_, seg = NewSingleSegmentMessage(nil)
+		b, err := NewStruct(seg, ObjectSize{DataSize: 24, PointerCount: 4})
+		require.NoError(t, err)
+		cb, err := NewStruct(seg, ObjectSize{DataSize: 16, PointerCount: 1})
+		require.NoError(t, err)
+		cb.SetUint64(0, 1)
+		tb, err := NewText(seg, "hello")
+		require.NoError(t, err)
+		b.SetUint32(0, 7)
+		require.NoError(t, b.SetPtr(1, cb.ToPtr()))
+		require.NoError(t, b.SetPtr(0, tb.ToPtr()))
+
+		ha, hb := sha256.New(), sha256.New()
+		require.NoError(t, Hash(a.ToPtr(), ha))
+		require.NoError(t, Hash(b.ToPtr(), hb))
+		assert.Equal(t, ha.Sum(nil), hb.Sum(nil))
+
+		x, err := a.Hash64()
+		require.NoError(t, err)
+		y, err := b.Hash64()
+		require.NoError(t, err)
+		assert.Equal(t, x, y)
+
+		cb.SetUint64(0, 2)
+		y, err = b.Hash64()
+		require.NoError(t, err)
+		assert.NotEqual(t, x, y, "hash of changed struct")
+	})
+
+	t.Run("Lists", func(t *testing.T) {
+		// Lists with the same data but different element types must
+		// hash differently.
+		_, seg := NewSingleSegmentMessage(nil)
+		bits, err := NewBitList(seg, 8)
+		require.NoError(t, err)
+		u8s, err := NewUInt8List(seg, 1)
+		require.NoError(t, err)
+		structs, err := NewCompositeList(seg, ObjectSize{DataSize: 8}, 1)
+		require.NoError(t, err)
+		ptrs, err := NewPointerList(seg, 1)
+		require.NoError(t, err)
+
+		seen := make(map[string]string)
+		for name, p := range map[string]Ptr{
+			"null":      {},
+			"bits":      bits.ToPtr(),
+			"bytes":     u8s.ToPtr(),
+			"structs":   structs.ToPtr(),
+			"pointers":  ptrs.ToPtr(),
+			"interface": NewInterface(seg, 0).ToPtr(),
+		} {
+			h := sha256.New()
+			require.NoError(t, Hash(p, h), name)
+			key := string(h.Sum(nil))
+			if other, ok := seen[key]; ok {
+				t.Errorf("%s and %s have the same hash", name, other)
+			}
+			seen[key] = name
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		// The struct's pointer is a far pointer to a segment that does
+		// not exist.
+		msg := &Message{Arena: SingleSegment(rawWords(
+			rawStructPointer(0, ObjectSize{PointerCount: 1}),
+			rawFarPointer(7, 0),
+		))}
+		p, err := msg.Root()
+		require.NoError(t, err)
+		assert.Error(t, Hash(p, sha256.New()))
+	})
+}
@@ -0,0 +1,110 @@
+package capnp
+
+// SetTextInPlace sets the i'th pointer to a text holding v, like
+// SetText, but reuses the text or data that the pointer already refers
+// to if it has room for v and its NUL terminator.  The bytes of the
+// list are then overwritten and its length changed to fit v, instead
+// of a new list being allocated and the old one stranded in the
+// message.  Since lists are padded to a word, a list has room for its
+// length rounded up to a multiple of eight bytes.  Bytes that the list
+// no longer covers are zeroed, and are only freed by Message.Compact.
+//
+// SetTextInPlace reports whether the existing list was reused; if it
+// was not, a new text is allocated as by SetText.  An empty v sets the
+// pointer to null, as SetText does.
+//
+// Repeatedly setting a field to values that are no longer than its
+// first one, such as a status string, therefore keeps the message
+// from growing.  The existing list must not be referenced by any other
+// pointer, since they would all see the change.  Lists are only shared
+// like that in messages built by passing the same list to SetPtr more
+// than once.
+func (p Struct) SetTextInPlace(i uint16, v string) (reused bool, err error) {
+	if v == "" {
+		return false, p.SetPtr(i, Ptr{})
+	}
+	b, err := p.reuseBytes(i, len(v)+1)
+	if err != nil {
+		return false, err
+	}
+	if b != nil {
+		n := copy(b, v)
+		zeroBytes(b[n:])
+		return true, nil
+	}
+	return false, p.SetNewText(i, v)
+}
+
+// SetDataInPlace sets the i'th pointer to a data holding v, like
+// SetData, but reuses the data or text that the pointer already refers
+// to if it has room for len(v) bytes.  See SetTextInPlace for how
+// the list is reused.  SetDataInPlace reports whether the existing
+// list was reused.  A nil v sets the pointer to null, as SetData does.
+func (p Struct) SetDataInPlace(i uint16, v []byte) (reused bool, err error) {
+	if v == nil {
+		return false, p.SetPtr(i, Ptr{})
+	}
+	b, err := p.reuseBytes(i, len(v))
+	if err != nil {
+		return false, err
+	}
+	if b != nil {
+		n := copy(b, v)
+		zeroBytes(b[n:])
+		return true, nil
+	}
+	return false, p.SetData(i, v)
+}
+
+// reuseBytes resizes the list of bytes that the i'th pointer refers to
+// so that it has n elements, and returns the bytes allocated for it,
+// including its padding.  It returns nil if the pointer is not a valid
+// list of bytes with room for n elements, and an error if the struct
+// cannot be written to.  Like HasPtr, reuseBytes does not affect the
+// read limit.
+func (p Struct) reuseBytes(i uint16, n int) ([]byte, error) {
+	if p.seg == nil || i >= p.size.PointerCount {
+		return nil, nil
+	}
+	if p.flags&isViewMember != 0 {
+		return nil, errListView
+	}
+	if p.seg.msg != nil && p.seg.msg.readOnly {
+		return nil, errReadOnly
+	}
+	paddr := p.pointerAddress(i)
+	seg, base, val, err := p.seg.resolveFarPointer(paddr)
+	if err != nil || val.pointerType() != listPointer || val.listType() != byte1List {
+		return nil, nil
+	}
+	l, err := seg.readListPtr(base, val)
+	if err != nil {
+		return nil, nil
+	}
+	sz := Size(l.length).padToWord()
+	if int64(n) > int64(sz) {
+		return nil, nil
+	}
+
+	// Find the word that holds the list's length: the pointer itself,
+	// a far pointer's landing pad, or a double-far pointer's tag.
+	tagSeg, tagAddr := p.seg, paddr
+	switch far := p.seg.readRawPointer(paddr); far.pointerType() {
+	case farPointer:
+		tagSeg, tagAddr = seg, far.farAddress()
+	case doubleFarPointer:
+		if tagSeg, err = p.seg.lookupSegment(far.farSegment()); err != nil {
+			return nil, nil
+		}
+		tagAddr = far.farAddress().addOffset(DataOffset(wordSize))
+	}
+	tag := tagSeg.readRawPointer(tagAddr)
+	tagSeg.writeRawPointer(tagAddr, rawListPointer(tag.offset(), byte1List, int32(n)))
+	return l.seg.slice(l.off, sz), nil
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
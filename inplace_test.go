@@ -0,0 +1,147 @@
+package capnp
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTextInPlace(t *testing.T) {
+	t.Parallel()
+
+	msg, seg := NewSingleSegmentMessage(nil)
+	s, err := NewRootStruct(seg, ObjectSize{PointerCount: 2})
+	require.NoError(t, err)
+	require.NoError(t, s.SetText(0, "initial"))
+	size, err := msg.TotalSize()
+	require.NoError(t, err)
+
+	for _, v := range []string{"shorter", "tiny", "initial"} {
+		reused, err := s.SetTextInPlace(0, v)
+		require.NoError(t, err)
+		assert.True(t, reused, "%q not set in place", v)
+		p, err := s.Ptr(0)
+		require.NoError(t, err)
+		assert.Equal(t, v, p.Text())
+		assert.Len(t, p.Data(), len(v)+1)
+	}
+	after, err := msg.TotalSize()
+	require.NoError(t, err)
+	assert.Equal(t, size, after, "message grew")
+
+	reused, err := s.SetTextInPlace(0, "longer than before")
+	require.NoError(t, err)
+	assert.False(t, reused)
+	p, err := s.Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, "longer than before", p.Text())
+
+	// Null pointers and pointers to other objects are not reused.
+	reused, err = s.SetTextInPlace(1, "x")
+	require.NoError(t, err)
+	assert.False(t, reused)
+	l, err := NewInt16List(seg, 8)
+	require.NoError(t, err)
+	require.NoError(t, s.SetPtr(1, l.ToPtr()))
+	reused, err = s.SetTextInPlace(1, "x")
+	require.NoError(t, err)
+	assert.False(t, reused, "reused a list of int16")
+
+	reused, err = s.SetTextInPlace(0, "")
+	require.NoError(t, err)
+	assert.False(t, reused)
+	assert.False(t, s.HasPtr(0))
+}
+
+func TestSetInPlaceReadOnly(t *testing.T) {
+	t.Parallel()
+
+	// The default values of pointer fields are read-only, so setting
+	// them in place fails instead of panicking.
+	msg, seg := NewSingleSegmentMessage(nil)
+	s, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+	require.NoError(t, err)
+	require.NoError(t, s.SetText(0, "default"))
+	def, err := msg.Marshal()
+	require.NoError(t, err)
+	p, err := readDefault(def)
+	require.NoError(t, err)
+	st := p.Struct()
+
+	reused, err := st.SetTextInPlace(0, "x")
+	assert.ErrorIs(t, err, errReadOnly)
+	assert.False(t, reused)
+	reused, err = st.SetDataInPlace(0, []byte("x"))
+	assert.ErrorIs(t, err, errReadOnly)
+	assert.False(t, reused)
+	txt, err := st.Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, "default", txt.Text())
+}
+
+func TestSetDataInPlace(t *testing.T) {
+	t.Parallel()
+
+	_, seg := NewSingleSegmentMessage(nil)
+	s, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+	require.NoError(t, err)
+	require.NoError(t, s.SetData(0, []byte{1, 2, 3, 4}))
+	old, err := s.Ptr(0)
+	require.NoError(t, err)
+	b := old.Data()
+
+	reused, err := s.SetDataInPlace(0, []byte{9, 8})
+	require.NoError(t, err)
+	assert.True(t, reused)
+	p, err := s.Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{9, 8}, p.Data())
+	assert.Equal(t, []byte{9, 8, 0, 0}, b, "freed bytes not zeroed")
+
+	reused, err = s.SetDataInPlace(0, []byte{})
+	require.NoError(t, err)
+	assert.True(t, reused)
+	p, err = s.Ptr(0)
+	require.NoError(t, err)
+	assert.True(t, p.IsValid())
+	assert.Empty(t, p.Data())
+}
+
+func TestSetTextInPlaceFar(t *testing.T) {
+	t.Parallel()
+
+	text := rawPointer(binary.LittleEndian.Uint64([]byte("abcdefg\x00")))
+	tests := []struct {
+		name string
+		segs [][]byte
+	}{
+		{"Far", [][]byte{
+			rawWords(rawStructPointer(0, ObjectSize{PointerCount: 1}), rawFarPointer(1, 0)),
+			rawWords(rawListPointer(0, byte1List, 8), text),
+		}},
+		{"DoubleFar", [][]byte{
+			rawWords(rawStructPointer(0, ObjectSize{PointerCount: 1}), rawDoubleFarPointer(1, 0)),
+			rawWords(rawFarPointer(2, 0), rawListPointer(0, byte1List, 8)),
+			rawWords(text),
+		}},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			msg := &Message{Arena: MultiSegment(test.segs)}
+			root, err := msg.Root()
+			require.NoError(t, err)
+			reused, err := root.Struct().SetTextInPlace(0, "hi")
+			require.NoError(t, err)
+			assert.True(t, reused)
+			p, err := root.Struct().Ptr(0)
+			require.NoError(t, err)
+			assert.Equal(t, "hi", p.Text())
+			assert.Equal(t, []byte("hi\x00\x00\x00\x00\x00\x00"), test.segs[len(test.segs)-1][len(test.segs[len(test.segs)-1])-8:])
+		})
+	}
+}
@@ -3,12 +3,14 @@
 package aircraftlib
 
 import (
+	bytes "bytes"
 	capnp "capnproto.org/go/capnp/v3"
 	text "capnproto.org/go/capnp/v3/encoding/text"
 	fc "capnproto.org/go/capnp/v3/flowcontrol"
 	schemas "capnproto.org/go/capnp/v3/schemas"
 	server "capnproto.org/go/capnp/v3/server"
 	context "context"
+	io "io"
 	math "math"
 	strconv "strconv"
 )
@@ -24,207 +26,356 @@ var (
 	ConstList = Zdate_List(capnp.MustUnmarshalRoot(x_832bcc6686a26d56[24:64]).List())
 )
 
-type Zdate capnp.Struct
+type A320 capnp.Struct
 
-// Zdate_TypeID is the unique identifier for the type Zdate.
-const Zdate_TypeID = 0xde50aebbad57549d
+// A320_TypeID is the unique identifier for the type A320.
+const A320_TypeID = 0xd98c608877d9cb8d
 
-func NewZdate(s *capnp.Segment) (Zdate, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Zdate(st), err
+func NewA320(s *capnp.Segment) (A320, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return A320(st), err
 }
 
-func NewRootZdate(s *capnp.Segment) (Zdate, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Zdate(st), err
+func NewRootA320(s *capnp.Segment) (A320, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return A320(st), err
 }
 
-func ReadRootZdate(msg *capnp.Message) (Zdate, error) {
+func ReadRootA320(msg *capnp.Message) (A320, error) {
 	root, err := msg.Root()
-	return Zdate(root.Struct()), err
+	return A320(root.Struct()), err
 }
 
-func (s Zdate) String() string {
-	str, _ := text.Marshal(0xde50aebbad57549d, capnp.Struct(s))
+func (s A320) String() string {
+	str, _ := text.Marshal(0xd98c608877d9cb8d, capnp.Struct(s))
 	return str
 }
 
-func (s Zdate) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s A320) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Zdate) DecodeFromPtr(p capnp.Ptr) Zdate {
-	return Zdate(capnp.Struct{}.DecodeFromPtr(p))
+func (A320) DecodeFromPtr(p capnp.Ptr) A320 {
+	return A320(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Zdate) ToPtr() capnp.Ptr {
+func (s A320) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Zdate) IsValid() bool {
+func (s A320) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Zdate) Message() *capnp.Message {
+func (s A320) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Zdate) Segment() *capnp.Segment {
+func (s A320) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Zdate) Year() int16 {
-	return int16(capnp.Struct(s).Uint16(0))
+func (s A320) Base() (PlaneBase, error) {
+	return capnp.GetStructField[PlaneBase](s, 0, "A320.base")
 }
 
-func (s Zdate) SetYear(v int16) {
-	capnp.Struct(s).SetUint16(0, uint16(v))
+func (s A320) HasBase() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Zdate) Month() uint8 {
-	return capnp.Struct(s).Uint8(2)
+func (s A320) SetBase(v PlaneBase) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Zdate) SetMonth(v uint8) {
-	capnp.Struct(s).SetUint8(2, v)
+// NewBase sets the base field to a newly
+// allocated PlaneBase struct, preferring placement in s's segment.
+func (s A320) NewBase() (PlaneBase, error) {
+	return capnp.NewStructField(s, 0, NewPlaneBase)
 }
 
-func (s Zdate) Day() uint8 {
-	return capnp.Struct(s).Uint8(3)
-}
+// A320_List is a list of A320.
+type A320_List = capnp.StructList[A320]
 
-func (s Zdate) SetDay(v uint8) {
-	capnp.Struct(s).SetUint8(3, v)
+// NewA320 creates a new list of A320.
+func NewA320_List(s *capnp.Segment, sz int32) (A320_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[A320](l), err
 }
 
-// Zdate_List is a list of Zdate.
-type Zdate_List = capnp.StructList[Zdate]
+// A320_Future is a wrapper for a A320 promised by a client call.
+type A320_Future struct{ *capnp.Future }
 
-// NewZdate creates a new list of Zdate.
-func NewZdate_List(s *capnp.Segment, sz int32) (Zdate_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[Zdate](l), err
+func (f A320_Future) Struct() (A320, error) {
+	p, err := f.Future.Ptr()
+	return A320(p.Struct()), err
+}
+func (p A320_Future) Base() PlaneBase_Future {
+	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
 }
 
-// Zdate_Future is a wrapper for a Zdate promised by a client call.
-type Zdate_Future struct{ *capnp.Future }
+// so we can restrict
+//
+//	and specify a Plane is required in
+//	certain places.
+type Aircraft capnp.Struct
+type Aircraft_Which uint16
 
-func (f Zdate_Future) Struct() (Zdate, error) {
-	p, err := f.Future.Ptr()
-	return Zdate(p.Struct()), err
+const (
+	Aircraft_Which_void Aircraft_Which = 0
+	Aircraft_Which_b737 Aircraft_Which = 1
+	Aircraft_Which_a320 Aircraft_Which = 2
+	Aircraft_Which_f16  Aircraft_Which = 3
+)
+
+func (w Aircraft_Which) String() string {
+	const s = "voidb737a320f16"
+	switch w {
+	case Aircraft_Which_void:
+		return s[0:4]
+	case Aircraft_Which_b737:
+		return s[4:8]
+	case Aircraft_Which_a320:
+		return s[8:12]
+	case Aircraft_Which_f16:
+		return s[12:15]
+
+	}
+	return "Aircraft_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-type Zdata capnp.Struct
+// Aircraft_Visitor has a method for each member of the union of
+// Aircraft, which Visit calls with the member's value.
+type Aircraft_Visitor interface {
+	VisitVoid() error
+	VisitB737(B737) error
+	VisitA320(A320) error
+	VisitF16(F16) error
+}
 
-// Zdata_TypeID is the unique identifier for the type Zdata.
-const Zdata_TypeID = 0xc7da65f9a2f20ba2
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Aircraft) Visit(v Aircraft_Visitor) error {
+	switch w := s.Which(); w {
+	case Aircraft_Which_void:
+		return v.VisitVoid()
+	case Aircraft_Which_b737:
+		x, err := s.B737()
+		if err != nil {
+			return err
+		}
+		return v.VisitB737(x)
+	case Aircraft_Which_a320:
+		x, err := s.A320()
+		if err != nil {
+			return err
+		}
+		return v.VisitA320(x)
+	case Aircraft_Which_f16:
+		x, err := s.F16()
+		if err != nil {
+			return err
+		}
+		return v.VisitF16(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Aircraft", Which: uint16(w)}
+	}
+}
 
-func NewZdata(s *capnp.Segment) (Zdata, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Zdata(st), err
+// Aircraft_TypeID is the unique identifier for the type Aircraft.
+const Aircraft_TypeID = 0xe54e10aede55c7b1
+
+func NewAircraft(s *capnp.Segment) (Aircraft, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Aircraft(st), err
 }
 
-func NewRootZdata(s *capnp.Segment) (Zdata, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Zdata(st), err
+func NewRootAircraft(s *capnp.Segment) (Aircraft, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Aircraft(st), err
 }
 
-func ReadRootZdata(msg *capnp.Message) (Zdata, error) {
+func ReadRootAircraft(msg *capnp.Message) (Aircraft, error) {
 	root, err := msg.Root()
-	return Zdata(root.Struct()), err
+	return Aircraft(root.Struct()), err
 }
 
-func (s Zdata) String() string {
-	str, _ := text.Marshal(0xc7da65f9a2f20ba2, capnp.Struct(s))
+func (s Aircraft) String() string {
+	str, _ := text.Marshal(0xe54e10aede55c7b1, capnp.Struct(s))
 	return str
 }
 
-func (s Zdata) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Aircraft) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Zdata) DecodeFromPtr(p capnp.Ptr) Zdata {
-	return Zdata(capnp.Struct{}.DecodeFromPtr(p))
+func (Aircraft) DecodeFromPtr(p capnp.Ptr) Aircraft {
+	return Aircraft(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Zdata) ToPtr() capnp.Ptr {
+func (s Aircraft) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Zdata) IsValid() bool {
+
+func (s Aircraft) Which() Aircraft_Which {
+	return Aircraft_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Aircraft) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Zdata) Message() *capnp.Message {
+func (s Aircraft) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Zdata) Segment() *capnp.Segment {
+func (s Aircraft) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Zdata) Data() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
-}
+func (s Aircraft) SetVoid() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-func (s Zdata) HasData() bool {
-	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Zdata) SetData(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+func (s Aircraft) B737() (B737, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != b737")
+	}
+	return capnp.GetStructField[B737](s, 0, "Aircraft.b737")
 }
 
-// Zdata_List is a list of Zdata.
-type Zdata_List = capnp.StructList[Zdata]
+func (s Aircraft) HasB737() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewZdata creates a new list of Zdata.
-func NewZdata_List(s *capnp.Segment, sz int32) (Zdata_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Zdata](l), err
+func (s Aircraft) SetB737(v B737) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Zdata_Future is a wrapper for a Zdata promised by a client call.
-type Zdata_Future struct{ *capnp.Future }
+// NewB737 sets the b737 field to a newly
+// allocated B737 struct, preferring placement in s's segment.
+func (s Aircraft) NewB737() (B737, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.NewStructField(s, 0, NewB737)
+}
 
-func (f Zdata_Future) Struct() (Zdata, error) {
-	p, err := f.Future.Ptr()
-	return Zdata(p.Struct()), err
+func (s Aircraft) A320() (A320, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != a320")
+	}
+	return capnp.GetStructField[A320](s, 0, "Aircraft.a320")
 }
 
-type Airport uint16
+func (s Aircraft) HasA320() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// Airport_TypeID is the unique identifier for the type Airport.
-const Airport_TypeID = 0xe55d85fc1bf82f21
+func (s Aircraft) SetA320(v A320) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.SetStructField(s, 0, v)
+}
 
-// Values of Airport.
-const (
-	Airport_none Airport = 0
-	Airport_jfk  Airport = 1
-	Airport_lax  Airport = 2
-	Airport_sfo  Airport = 3
-	Airport_luv  Airport = 4
-	Airport_dfw  Airport = 5
-	Airport_test Airport = 6
-)
+// NewA320 sets the a320 field to a newly
+// allocated A320 struct, preferring placement in s's segment.
+func (s Aircraft) NewA320() (A320, error) {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.NewStructField(s, 0, NewA320)
+}
 
-// String returns the enum's constant name.
-func (c Airport) String() string {
-	switch c {
-	case Airport_none:
-		return "none"
-	case Airport_jfk:
-		return "jfk"
-	case Airport_lax:
-		return "lax"
-	case Airport_sfo:
-		return "sfo"
-	case Airport_luv:
-		return "luv"
-	case Airport_dfw:
-		return "dfw"
-	case Airport_test:
-		return "test"
+func (s Aircraft) F16() (F16, error) {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != f16")
+	}
+	return capnp.GetStructField[F16](s, 0, "Aircraft.f16")
+}
 
-	default:
-		return ""
+func (s Aircraft) HasF16() bool {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Aircraft) SetF16(v F16) error {
+	capnp.Struct(s).SetUint16(0, 3)
+	return capnp.SetStructField(s, 0, v)
+}
+
+// NewF16 sets the f16 field to a newly
+// allocated F16 struct, preferring placement in s's segment.
+func (s Aircraft) NewF16() (F16, error) {
+	capnp.Struct(s).SetUint16(0, 3)
+	return capnp.NewStructField(s, 0, NewF16)
+}
+
+// Aircraft_List is a list of Aircraft.
+type Aircraft_List = capnp.StructList[Aircraft]
+
+// NewAircraft creates a new list of Aircraft.
+func NewAircraft_List(s *capnp.Segment, sz int32) (Aircraft_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Aircraft](l), err
+}
+
+// Aircraft_Future is a wrapper for a Aircraft promised by a client call.
+type Aircraft_Future struct{ *capnp.Future }
+
+func (f Aircraft_Future) Struct() (Aircraft, error) {
+	p, err := f.Future.Ptr()
+	return Aircraft(p.Struct()), err
+}
+func (p Aircraft_Future) B737() B737_Future {
+	return B737_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Aircraft_Future) A320() A320_Future {
+	return A320_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Aircraft_Future) F16() F16_Future {
+	return F16_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Airport uint16
+
+// Airport_TypeID is the unique identifier for the type Airport.
+const Airport_TypeID = 0xe55d85fc1bf82f21
+
+// Values of Airport.
+const (
+	Airport_none Airport = 0
+	Airport_jfk  Airport = 1
+	Airport_lax  Airport = 2
+	Airport_sfo  Airport = 3
+	Airport_luv  Airport = 4
+	Airport_dfw  Airport = 5
+	// test must be last because we use it to count
+	// the number of elements in the Airport enum.
+	Airport_test Airport = 6
+)
+
+// String returns the enum's constant name.
+func (c Airport) String() string {
+	switch c {
+	case Airport_none:
+		return "none"
+	case Airport_jfk:
+		return "jfk"
+	case Airport_lax:
+		return "lax"
+	case Airport_sfo:
+		return "sfo"
+	case Airport_luv:
+		return "luv"
+	case Airport_dfw:
+		return "dfw"
+	case Airport_test:
+		return "test"
+
+	default:
+		return ""
 	}
 }
 
@@ -258,141 +409,166 @@ func NewAirport_List(s *capnp.Segment, sz int32) (Airport_List, error) {
 	return capnp.NewEnumList[Airport](s, sz)
 }
 
-type PlaneBase capnp.Struct
+type AllocBenchmark capnp.Struct
 
-// PlaneBase_TypeID is the unique identifier for the type PlaneBase.
-const PlaneBase_TypeID = 0xd8bccf6e60a73791
+// AllocBenchmark_TypeID is the unique identifier for the type AllocBenchmark.
+const AllocBenchmark_TypeID = 0xecea3e9ebcbe5655
 
-func NewPlaneBase(s *capnp.Segment) (PlaneBase, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2})
-	return PlaneBase(st), err
+func NewAllocBenchmark(s *capnp.Segment) (AllocBenchmark, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return AllocBenchmark(st), err
 }
 
-func NewRootPlaneBase(s *capnp.Segment) (PlaneBase, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2})
-	return PlaneBase(st), err
+func NewRootAllocBenchmark(s *capnp.Segment) (AllocBenchmark, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return AllocBenchmark(st), err
 }
 
-func ReadRootPlaneBase(msg *capnp.Message) (PlaneBase, error) {
+func ReadRootAllocBenchmark(msg *capnp.Message) (AllocBenchmark, error) {
 	root, err := msg.Root()
-	return PlaneBase(root.Struct()), err
+	return AllocBenchmark(root.Struct()), err
 }
 
-func (s PlaneBase) String() string {
-	str, _ := text.Marshal(0xd8bccf6e60a73791, capnp.Struct(s))
+func (s AllocBenchmark) String() string {
+	str, _ := text.Marshal(0xecea3e9ebcbe5655, capnp.Struct(s))
 	return str
 }
 
-func (s PlaneBase) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s AllocBenchmark) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (PlaneBase) DecodeFromPtr(p capnp.Ptr) PlaneBase {
-	return PlaneBase(capnp.Struct{}.DecodeFromPtr(p))
+func (AllocBenchmark) DecodeFromPtr(p capnp.Ptr) AllocBenchmark {
+	return AllocBenchmark(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s PlaneBase) ToPtr() capnp.Ptr {
+func (s AllocBenchmark) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s PlaneBase) IsValid() bool {
+func (s AllocBenchmark) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s PlaneBase) Message() *capnp.Message {
+func (s AllocBenchmark) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s PlaneBase) Segment() *capnp.Segment {
+func (s AllocBenchmark) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s PlaneBase) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s AllocBenchmark) Fields() (AllocBenchmark_Field_List, error) {
+	return capnp.GetListField[AllocBenchmark_Field_List](s, 0, "AllocBenchmark.fields")
 }
 
-func (s PlaneBase) HasName() bool {
+func (s AllocBenchmark) HasFields() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s PlaneBase) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s AllocBenchmark) SetFields(v AllocBenchmark_Field_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s PlaneBase) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// NewFields sets the fields field to a newly
+// allocated AllocBenchmark_Field_List, preferring placement in s's segment.
+func (s AllocBenchmark) NewFields(n int32) (AllocBenchmark_Field_List, error) {
+	return capnp.NewListField(s, 0, n, NewAllocBenchmark_Field_List)
 }
 
-func (s PlaneBase) Homes() (Airport_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Airport_List(p.List()), err
+// AllocBenchmark_List is a list of AllocBenchmark.
+type AllocBenchmark_List = capnp.StructList[AllocBenchmark]
+
+// NewAllocBenchmark creates a new list of AllocBenchmark.
+func NewAllocBenchmark_List(s *capnp.Segment, sz int32) (AllocBenchmark_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[AllocBenchmark](l), err
 }
 
-func (s PlaneBase) HasHomes() bool {
-	return capnp.Struct(s).HasPtr(1)
+// AllocBenchmark_Future is a wrapper for a AllocBenchmark promised by a client call.
+type AllocBenchmark_Future struct{ *capnp.Future }
+
+func (f AllocBenchmark_Future) Struct() (AllocBenchmark, error) {
+	p, err := f.Future.Ptr()
+	return AllocBenchmark(p.Struct()), err
 }
 
-func (s PlaneBase) SetHomes(v Airport_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+type AllocBenchmark_Field capnp.Struct
+
+// AllocBenchmark_Field_TypeID is the unique identifier for the type AllocBenchmark_Field.
+const AllocBenchmark_Field_TypeID = 0xb8fb64b8ed846ae6
+
+func NewAllocBenchmark_Field(s *capnp.Segment) (AllocBenchmark_Field, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return AllocBenchmark_Field(st), err
 }
 
-// NewHomes sets the homes field to a newly
-// allocated Airport_List, preferring placement in s's segment.
-func (s PlaneBase) NewHomes(n int32) (Airport_List, error) {
-	l, err := NewAirport_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Airport_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+func NewRootAllocBenchmark_Field(s *capnp.Segment) (AllocBenchmark_Field, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return AllocBenchmark_Field(st), err
 }
-func (s PlaneBase) Rating() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
+
+func ReadRootAllocBenchmark_Field(msg *capnp.Message) (AllocBenchmark_Field, error) {
+	root, err := msg.Root()
+	return AllocBenchmark_Field(root.Struct()), err
 }
 
-func (s PlaneBase) SetRating(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
+func (s AllocBenchmark_Field) String() string {
+	str, _ := text.Marshal(0xb8fb64b8ed846ae6, capnp.Struct(s))
+	return str
 }
 
-func (s PlaneBase) CanFly() bool {
-	return capnp.Struct(s).Bit(64)
+func (s AllocBenchmark_Field) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s PlaneBase) SetCanFly(v bool) {
-	capnp.Struct(s).SetBit(64, v)
+func (AllocBenchmark_Field) DecodeFromPtr(p capnp.Ptr) AllocBenchmark_Field {
+	return AllocBenchmark_Field(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s PlaneBase) Capacity() int64 {
-	return int64(capnp.Struct(s).Uint64(16))
+func (s AllocBenchmark_Field) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s AllocBenchmark_Field) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s PlaneBase) SetCapacity(v int64) {
-	capnp.Struct(s).SetUint64(16, uint64(v))
+func (s AllocBenchmark_Field) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s PlaneBase) MaxSpeed() float64 {
-	return math.Float64frombits(capnp.Struct(s).Uint64(24))
+func (s AllocBenchmark_Field) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s AllocBenchmark_Field) StringValue() (string, error) {
+	return capnp.GetTextField(s, 0, "AllocBenchmark.Field.stringValue")
 }
 
-func (s PlaneBase) SetMaxSpeed(v float64) {
-	capnp.Struct(s).SetUint64(24, math.Float64bits(v))
+func (s AllocBenchmark_Field) HasStringValue() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// PlaneBase_List is a list of PlaneBase.
-type PlaneBase_List = capnp.StructList[PlaneBase]
+func (s AllocBenchmark_Field) StringValueBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "AllocBenchmark.Field.stringValue")
+}
 
-// NewPlaneBase creates a new list of PlaneBase.
-func NewPlaneBase_List(s *capnp.Segment, sz int32) (PlaneBase_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2}, sz)
-	return capnp.StructList[PlaneBase](l), err
+func (s AllocBenchmark_Field) SetStringValue(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// PlaneBase_Future is a wrapper for a PlaneBase promised by a client call.
-type PlaneBase_Future struct{ *capnp.Future }
+// AllocBenchmark_Field_List is a list of AllocBenchmark_Field.
+type AllocBenchmark_Field_List = capnp.StructList[AllocBenchmark_Field]
 
-func (f PlaneBase_Future) Struct() (PlaneBase, error) {
+// NewAllocBenchmark_Field creates a new list of AllocBenchmark_Field.
+func NewAllocBenchmark_Field_List(s *capnp.Segment, sz int32) (AllocBenchmark_Field_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[AllocBenchmark_Field](l), err
+}
+
+// AllocBenchmark_Field_Future is a wrapper for a AllocBenchmark_Field promised by a client call.
+type AllocBenchmark_Field_Future struct{ *capnp.Future }
+
+func (f AllocBenchmark_Field_Future) Struct() (AllocBenchmark_Field, error) {
 	p, err := f.Future.Ptr()
-	return PlaneBase(p.Struct()), err
+	return AllocBenchmark_Field(p.Struct()), err
 }
 
 type B737 capnp.Struct
@@ -443,8 +619,7 @@ func (s B737) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 func (s B737) Base() (PlaneBase, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return PlaneBase(p.Struct()), err
+	return capnp.GetStructField[PlaneBase](s, 0, "B737.base")
 }
 
 func (s B737) HasBase() bool {
@@ -452,18 +627,13 @@ func (s B737) HasBase() bool {
 }
 
 func (s B737) SetBase(v PlaneBase) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+	return capnp.SetStructField(s, 0, v)
 }
 
 // NewBase sets the base field to a newly
 // allocated PlaneBase struct, preferring placement in s's segment.
 func (s B737) NewBase() (PlaneBase, error) {
-	ss, err := NewPlaneBase(capnp.Struct(s).Segment())
-	if err != nil {
-		return PlaneBase{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.NewStructField(s, 0, NewPlaneBase)
 }
 
 // B737_List is a list of B737.
@@ -486,6737 +656,6656 @@ func (p B737_Future) Base() PlaneBase_Future {
 	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
 }
 
-type A320 capnp.Struct
+type Bag capnp.Struct
 
-// A320_TypeID is the unique identifier for the type A320.
-const A320_TypeID = 0xd98c608877d9cb8d
+// Bag_TypeID is the unique identifier for the type Bag.
+const Bag_TypeID = 0xd636fba4f188dabe
 
-func NewA320(s *capnp.Segment) (A320, error) {
+func NewBag(s *capnp.Segment) (Bag, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return A320(st), err
+	return Bag(st), err
 }
 
-func NewRootA320(s *capnp.Segment) (A320, error) {
+func NewRootBag(s *capnp.Segment) (Bag, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return A320(st), err
+	return Bag(st), err
 }
 
-func ReadRootA320(msg *capnp.Message) (A320, error) {
+func ReadRootBag(msg *capnp.Message) (Bag, error) {
 	root, err := msg.Root()
-	return A320(root.Struct()), err
+	return Bag(root.Struct()), err
 }
 
-func (s A320) String() string {
-	str, _ := text.Marshal(0xd98c608877d9cb8d, capnp.Struct(s))
+func (s Bag) String() string {
+	str, _ := text.Marshal(0xd636fba4f188dabe, capnp.Struct(s))
 	return str
 }
 
-func (s A320) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Bag) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (A320) DecodeFromPtr(p capnp.Ptr) A320 {
-	return A320(capnp.Struct{}.DecodeFromPtr(p))
+func (Bag) DecodeFromPtr(p capnp.Ptr) Bag {
+	return Bag(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s A320) ToPtr() capnp.Ptr {
+func (s Bag) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s A320) IsValid() bool {
+func (s Bag) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s A320) Message() *capnp.Message {
+func (s Bag) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s A320) Segment() *capnp.Segment {
+func (s Bag) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s A320) Base() (PlaneBase, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return PlaneBase(p.Struct()), err
+func (s Bag) Counter() (Counter, error) {
+	return capnp.GetStructField[Counter](s, 0, "Bag.counter")
 }
 
-func (s A320) HasBase() bool {
+func (s Bag) HasCounter() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s A320) SetBase(v PlaneBase) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Bag) SetCounter(v Counter) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewBase sets the base field to a newly
-// allocated PlaneBase struct, preferring placement in s's segment.
-func (s A320) NewBase() (PlaneBase, error) {
-	ss, err := NewPlaneBase(capnp.Struct(s).Segment())
-	if err != nil {
-		return PlaneBase{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewCounter sets the counter field to a newly
+// allocated Counter struct, preferring placement in s's segment.
+func (s Bag) NewCounter() (Counter, error) {
+	return capnp.NewStructField(s, 0, NewCounter)
 }
 
-// A320_List is a list of A320.
-type A320_List = capnp.StructList[A320]
+// Bag_List is a list of Bag.
+type Bag_List = capnp.StructList[Bag]
 
-// NewA320 creates a new list of A320.
-func NewA320_List(s *capnp.Segment, sz int32) (A320_List, error) {
+// NewBag creates a new list of Bag.
+func NewBag_List(s *capnp.Segment, sz int32) (Bag_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[A320](l), err
+	return capnp.StructList[Bag](l), err
 }
 
-// A320_Future is a wrapper for a A320 promised by a client call.
-type A320_Future struct{ *capnp.Future }
+// Bag_Future is a wrapper for a Bag promised by a client call.
+type Bag_Future struct{ *capnp.Future }
 
-func (f A320_Future) Struct() (A320, error) {
+func (f Bag_Future) Struct() (Bag, error) {
 	p, err := f.Future.Ptr()
-	return A320(p.Struct()), err
+	return Bag(p.Struct()), err
 }
-func (p A320_Future) Base() PlaneBase_Future {
-	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
+func (p Bag_Future) Counter() Counter_Future {
+	return Counter_Future{Future: p.Future.Field(0, nil)}
 }
 
-type F16 capnp.Struct
+type BenchmarkA capnp.Struct
 
-// F16_TypeID is the unique identifier for the type F16.
-const F16_TypeID = 0xe1c9eac512335361
+// BenchmarkA_TypeID is the unique identifier for the type BenchmarkA.
+const BenchmarkA_TypeID = 0xde2a1a960863c11c
 
-func NewF16(s *capnp.Segment) (F16, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return F16(st), err
+func NewBenchmarkA(s *capnp.Segment) (BenchmarkA, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return BenchmarkA(st), err
 }
 
-func NewRootF16(s *capnp.Segment) (F16, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return F16(st), err
+func NewRootBenchmarkA(s *capnp.Segment) (BenchmarkA, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return BenchmarkA(st), err
 }
 
-func ReadRootF16(msg *capnp.Message) (F16, error) {
+func ReadRootBenchmarkA(msg *capnp.Message) (BenchmarkA, error) {
 	root, err := msg.Root()
-	return F16(root.Struct()), err
+	return BenchmarkA(root.Struct()), err
 }
 
-func (s F16) String() string {
-	str, _ := text.Marshal(0xe1c9eac512335361, capnp.Struct(s))
+func (s BenchmarkA) String() string {
+	str, _ := text.Marshal(0xde2a1a960863c11c, capnp.Struct(s))
 	return str
 }
 
-func (s F16) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s BenchmarkA) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (F16) DecodeFromPtr(p capnp.Ptr) F16 {
-	return F16(capnp.Struct{}.DecodeFromPtr(p))
+func (BenchmarkA) DecodeFromPtr(p capnp.Ptr) BenchmarkA {
+	return BenchmarkA(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s F16) ToPtr() capnp.Ptr {
+func (s BenchmarkA) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s F16) IsValid() bool {
+func (s BenchmarkA) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s F16) Message() *capnp.Message {
+func (s BenchmarkA) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s F16) Segment() *capnp.Segment {
+func (s BenchmarkA) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s F16) Base() (PlaneBase, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return PlaneBase(p.Struct()), err
+func (s BenchmarkA) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "BenchmarkA.name")
 }
 
-func (s F16) HasBase() bool {
+func (s BenchmarkA) HasName() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s F16) SetBase(v PlaneBase) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s BenchmarkA) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "BenchmarkA.name")
 }
 
-// NewBase sets the base field to a newly
-// allocated PlaneBase struct, preferring placement in s's segment.
-func (s F16) NewBase() (PlaneBase, error) {
-	ss, err := NewPlaneBase(capnp.Struct(s).Segment())
-	if err != nil {
-		return PlaneBase{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s BenchmarkA) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// F16_List is a list of F16.
-type F16_List = capnp.StructList[F16]
-
-// NewF16 creates a new list of F16.
-func NewF16_List(s *capnp.Segment, sz int32) (F16_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[F16](l), err
+func (s BenchmarkA) BirthDay() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
 }
 
-// F16_Future is a wrapper for a F16 promised by a client call.
-type F16_Future struct{ *capnp.Future }
-
-func (f F16_Future) Struct() (F16, error) {
-	p, err := f.Future.Ptr()
-	return F16(p.Struct()), err
-}
-func (p F16_Future) Base() PlaneBase_Future {
-	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
+func (s BenchmarkA) SetBirthDay(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
 }
 
-type Regression capnp.Struct
-
-// Regression_TypeID is the unique identifier for the type Regression.
-const Regression_TypeID = 0xb1f0385d845e367f
-
-func NewRegression(s *capnp.Segment) (Regression, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
-	return Regression(st), err
+func (s BenchmarkA) Phone() (string, error) {
+	return capnp.GetTextField(s, 1, "BenchmarkA.phone")
 }
 
-func NewRootRegression(s *capnp.Segment) (Regression, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
-	return Regression(st), err
+func (s BenchmarkA) HasPhone() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func ReadRootRegression(msg *capnp.Message) (Regression, error) {
-	root, err := msg.Root()
-	return Regression(root.Struct()), err
+func (s BenchmarkA) PhoneBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 1, "BenchmarkA.phone")
 }
 
-func (s Regression) String() string {
-	str, _ := text.Marshal(0xb1f0385d845e367f, capnp.Struct(s))
-	return str
+func (s BenchmarkA) SetPhone(v string) error {
+	return capnp.Struct(s).SetText(1, v)
 }
 
-func (s Regression) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s BenchmarkA) Siblings() int32 {
+	return int32(capnp.Struct(s).Uint32(8))
 }
 
-func (Regression) DecodeFromPtr(p capnp.Ptr) Regression {
-	return Regression(capnp.Struct{}.DecodeFromPtr(p))
+func (s BenchmarkA) SetSiblings(v int32) {
+	capnp.Struct(s).SetUint32(8, uint32(v))
 }
 
-func (s Regression) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Regression) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s BenchmarkA) Spouse() bool {
+	return capnp.Struct(s).Bit(96)
 }
 
-func (s Regression) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s BenchmarkA) SetSpouse(v bool) {
+	capnp.Struct(s).SetBit(96, v)
 }
 
-func (s Regression) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Regression) Base() (PlaneBase, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return PlaneBase(p.Struct()), err
+func (s BenchmarkA) Money() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(16))
 }
 
-func (s Regression) HasBase() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s BenchmarkA) SetMoney(v float64) {
+	capnp.Struct(s).SetUint64(16, math.Float64bits(v))
 }
 
-func (s Regression) SetBase(v PlaneBase) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
+// BenchmarkA_List is a list of BenchmarkA.
+type BenchmarkA_List = capnp.StructList[BenchmarkA]
 
-// NewBase sets the base field to a newly
-// allocated PlaneBase struct, preferring placement in s's segment.
-func (s Regression) NewBase() (PlaneBase, error) {
-	ss, err := NewPlaneBase(capnp.Struct(s).Segment())
-	if err != nil {
-		return PlaneBase{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewBenchmarkA creates a new list of BenchmarkA.
+func NewBenchmarkA_List(s *capnp.Segment, sz int32) (BenchmarkA_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
+	return capnp.StructList[BenchmarkA](l), err
 }
 
-func (s Regression) B0() float64 {
-	return math.Float64frombits(capnp.Struct(s).Uint64(0))
-}
+// BenchmarkA_Future is a wrapper for a BenchmarkA promised by a client call.
+type BenchmarkA_Future struct{ *capnp.Future }
 
-func (s Regression) SetB0(v float64) {
-	capnp.Struct(s).SetUint64(0, math.Float64bits(v))
+func (f BenchmarkA_Future) Struct() (BenchmarkA, error) {
+	p, err := f.Future.Ptr()
+	return BenchmarkA(p.Struct()), err
 }
 
-func (s Regression) Beta() (capnp.Float64List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return capnp.Float64List(p.List()), err
-}
+type CallSequence capnp.Client
 
-func (s Regression) HasBeta() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
+// CallSequence_TypeID is the unique identifier for the type CallSequence.
+const CallSequence_TypeID = 0xabaedf5f7817c820
 
-func (s Regression) SetBeta(v capnp.Float64List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
-}
+func (c CallSequence) GetNumber(ctx context.Context, params func(CallSequence_getNumber_Params) error) (CallSequence_getNumber_Results_Future, capnp.ReleaseFunc) {
 
-// NewBeta sets the beta field to a newly
-// allocated capnp.Float64List, preferring placement in s's segment.
-func (s Regression) NewBeta(n int32) (capnp.Float64List, error) {
-	l, err := capnp.NewFloat64List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Float64List{}, err
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xabaedf5f7817c820,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:CallSequence",
+			MethodName:    "getNumber",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CallSequence_getNumber_Params(s)) }
 	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-func (s Regression) Planes() (Aircraft_List, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return Aircraft_List(p.List()), err
-}
 
-func (s Regression) HasPlanes() bool {
-	return capnp.Struct(s).HasPtr(2)
-}
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CallSequence_getNumber_Results_Future{Future: ans.Future()}, release
 
-func (s Regression) SetPlanes(v Aircraft_List) error {
-	return capnp.Struct(s).SetPtr(2, v.ToPtr())
 }
 
-// NewPlanes sets the planes field to a newly
-// allocated Aircraft_List, preferring placement in s's segment.
-func (s Regression) NewPlanes(n int32) (Aircraft_List, error) {
-	l, err := NewAircraft_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Aircraft_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
-	return l, err
+func (c CallSequence) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
 }
-func (s Regression) Ymu() float64 {
-	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c CallSequence) String() string {
+	return "CallSequence(" + capnp.Client(c).String() + ")"
 }
 
-func (s Regression) SetYmu(v float64) {
-	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c CallSequence) AddRef() CallSequence {
+	return CallSequence(capnp.Client(c).AddRef())
 }
 
-func (s Regression) Ysd() float64 {
-	return math.Float64frombits(capnp.Struct(s).Uint64(16))
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c CallSequence) Release() {
+	capnp.Client(c).Release()
 }
 
-func (s Regression) SetYsd(v float64) {
-	capnp.Struct(s).SetUint64(16, math.Float64bits(v))
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c CallSequence) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-// Regression_List is a list of Regression.
-type Regression_List = capnp.StructList[Regression]
+func (c CallSequence) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
 
-// NewRegression creates a new list of Regression.
-func NewRegression_List(s *capnp.Segment, sz int32) (Regression_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
-	return capnp.StructList[Regression](l), err
+func (CallSequence) DecodeFromPtr(p capnp.Ptr) CallSequence {
+	return CallSequence(capnp.Client{}.DecodeFromPtr(p))
 }
 
-// Regression_Future is a wrapper for a Regression promised by a client call.
-type Regression_Future struct{ *capnp.Future }
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c CallSequence) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
 
-func (f Regression_Future) Struct() (Regression, error) {
-	p, err := f.Future.Ptr()
-	return Regression(p.Struct()), err
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c CallSequence) IsSame(other CallSequence) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
-func (p Regression_Future) Base() PlaneBase_Future {
-	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c CallSequence) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-type Aircraft capnp.Struct
-type Aircraft_Which uint16
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c CallSequence) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
 
-const (
-	Aircraft_Which_void Aircraft_Which = 0
-	Aircraft_Which_b737 Aircraft_Which = 1
-	Aircraft_Which_a320 Aircraft_Which = 2
-	Aircraft_Which_f16  Aircraft_Which = 3
-)
+// A CallSequence_Server is a CallSequence with a local implementation.
+type CallSequence_Server interface {
+	GetNumber(context.Context, CallSequence_getNumber) error
+}
 
-func (w Aircraft_Which) String() string {
-	const s = "voidb737a320f16"
-	switch w {
-	case Aircraft_Which_void:
-		return s[0:4]
-	case Aircraft_Which_b737:
-		return s[4:8]
-	case Aircraft_Which_a320:
-		return s[8:12]
-	case Aircraft_Which_f16:
-		return s[12:15]
+// CallSequence_NewServer creates a new Server from an implementation of CallSequence_Server.
+func CallSequence_NewServer(s CallSequence_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(CallSequence_Methods(nil, s), s, c)
+}
+
+// CallSequence_ServerToClient creates a new Client from an implementation of CallSequence_Server.
+// The caller is responsible for calling Release on the returned Client.
+func CallSequence_ServerToClient(s CallSequence_Server) CallSequence {
+	return CallSequence(capnp.NewClient(CallSequence_NewServer(s)))
+}
 
+// CallSequence_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func CallSequence_Methods(methods []server.Method, s CallSequence_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
 	}
-	return "Aircraft_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xabaedf5f7817c820,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:CallSequence",
+			MethodName:    "getNumber",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetNumber(ctx, CallSequence_getNumber{call})
+		},
+	})
+
+	return methods
 }
 
-// Aircraft_TypeID is the unique identifier for the type Aircraft.
-const Aircraft_TypeID = 0xe54e10aede55c7b1
+// CallSequence_getNumber holds the state for a server call to CallSequence.getNumber.
+// See server.Call for documentation.
+type CallSequence_getNumber struct {
+	*server.Call
+}
 
-func NewAircraft(s *capnp.Segment) (Aircraft, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Aircraft(st), err
+// Args returns the call's arguments.
+func (c CallSequence_getNumber) Args() CallSequence_getNumber_Params {
+	return CallSequence_getNumber_Params(c.Call.Args())
 }
 
-func NewRootAircraft(s *capnp.Segment) (Aircraft, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Aircraft(st), err
+// AllocResults allocates the results struct.
+func (c CallSequence_getNumber) AllocResults() (CallSequence_getNumber_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallSequence_getNumber_Results(r), err
 }
 
-func ReadRootAircraft(msg *capnp.Message) (Aircraft, error) {
+// CallSequence_List is a list of CallSequence.
+type CallSequence_List = capnp.CapList[CallSequence]
+
+// NewCallSequence_List creates a new list of CallSequence.
+func NewCallSequence_List(s *capnp.Segment, sz int32) (CallSequence_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[CallSequence](l), err
+}
+
+type CallSequence_getNumber_Params capnp.Struct
+
+// CallSequence_getNumber_Params_TypeID is the unique identifier for the type CallSequence_getNumber_Params.
+const CallSequence_getNumber_Params_TypeID = 0xf58782f48a121998
+
+func NewCallSequence_getNumber_Params(s *capnp.Segment) (CallSequence_getNumber_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CallSequence_getNumber_Params(st), err
+}
+
+func NewRootCallSequence_getNumber_Params(s *capnp.Segment) (CallSequence_getNumber_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CallSequence_getNumber_Params(st), err
+}
+
+func ReadRootCallSequence_getNumber_Params(msg *capnp.Message) (CallSequence_getNumber_Params, error) {
 	root, err := msg.Root()
-	return Aircraft(root.Struct()), err
+	return CallSequence_getNumber_Params(root.Struct()), err
 }
 
-func (s Aircraft) String() string {
-	str, _ := text.Marshal(0xe54e10aede55c7b1, capnp.Struct(s))
+func (s CallSequence_getNumber_Params) String() string {
+	str, _ := text.Marshal(0xf58782f48a121998, capnp.Struct(s))
 	return str
 }
 
-func (s Aircraft) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CallSequence_getNumber_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Aircraft) DecodeFromPtr(p capnp.Ptr) Aircraft {
-	return Aircraft(capnp.Struct{}.DecodeFromPtr(p))
+func (CallSequence_getNumber_Params) DecodeFromPtr(p capnp.Ptr) CallSequence_getNumber_Params {
+	return CallSequence_getNumber_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Aircraft) ToPtr() capnp.Ptr {
+func (s CallSequence_getNumber_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s Aircraft) Which() Aircraft_Which {
-	return Aircraft_Which(capnp.Struct(s).Uint16(0))
-}
-func (s Aircraft) IsValid() bool {
+func (s CallSequence_getNumber_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Aircraft) Message() *capnp.Message {
+func (s CallSequence_getNumber_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Aircraft) Segment() *capnp.Segment {
+func (s CallSequence_getNumber_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Aircraft) SetVoid() {
-	capnp.Struct(s).SetUint16(0, 0)
 
-}
+// CallSequence_getNumber_Params_List is a list of CallSequence_getNumber_Params.
+type CallSequence_getNumber_Params_List = capnp.StructList[CallSequence_getNumber_Params]
 
-func (s Aircraft) B737() (B737, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != b737")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return B737(p.Struct()), err
+// NewCallSequence_getNumber_Params creates a new list of CallSequence_getNumber_Params.
+func NewCallSequence_getNumber_Params_List(s *capnp.Segment, sz int32) (CallSequence_getNumber_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CallSequence_getNumber_Params](l), err
 }
 
-func (s Aircraft) HasB737() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
-}
+// CallSequence_getNumber_Params_Future is a wrapper for a CallSequence_getNumber_Params promised by a client call.
+type CallSequence_getNumber_Params_Future struct{ *capnp.Future }
 
-func (s Aircraft) SetB737(v B737) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (f CallSequence_getNumber_Params_Future) Struct() (CallSequence_getNumber_Params, error) {
+	p, err := f.Future.Ptr()
+	return CallSequence_getNumber_Params(p.Struct()), err
 }
 
-// NewB737 sets the b737 field to a newly
-// allocated B737 struct, preferring placement in s's segment.
-func (s Aircraft) NewB737() (B737, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewB737(capnp.Struct(s).Segment())
-	if err != nil {
-		return B737{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
+type CallSequence_getNumber_Results capnp.Struct
 
-func (s Aircraft) A320() (A320, error) {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != a320")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return A320(p.Struct()), err
-}
+// CallSequence_getNumber_Results_TypeID is the unique identifier for the type CallSequence_getNumber_Results.
+const CallSequence_getNumber_Results_TypeID = 0xa465f9502fd11e97
 
-func (s Aircraft) HasA320() bool {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func NewCallSequence_getNumber_Results(s *capnp.Segment) (CallSequence_getNumber_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallSequence_getNumber_Results(st), err
 }
 
-func (s Aircraft) SetA320(v A320) error {
-	capnp.Struct(s).SetUint16(0, 2)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func NewRootCallSequence_getNumber_Results(s *capnp.Segment) (CallSequence_getNumber_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CallSequence_getNumber_Results(st), err
 }
 
-// NewA320 sets the a320 field to a newly
-// allocated A320 struct, preferring placement in s's segment.
-func (s Aircraft) NewA320() (A320, error) {
-	capnp.Struct(s).SetUint16(0, 2)
-	ss, err := NewA320(capnp.Struct(s).Segment())
-	if err != nil {
-		return A320{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func ReadRootCallSequence_getNumber_Results(msg *capnp.Message) (CallSequence_getNumber_Results, error) {
+	root, err := msg.Root()
+	return CallSequence_getNumber_Results(root.Struct()), err
 }
 
-func (s Aircraft) F16() (F16, error) {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		panic("Which() != f16")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return F16(p.Struct()), err
+func (s CallSequence_getNumber_Results) String() string {
+	str, _ := text.Marshal(0xa465f9502fd11e97, capnp.Struct(s))
+	return str
 }
 
-func (s Aircraft) HasF16() bool {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s CallSequence_getNumber_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Aircraft) SetF16(v F16) error {
-	capnp.Struct(s).SetUint16(0, 3)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (CallSequence_getNumber_Results) DecodeFromPtr(p capnp.Ptr) CallSequence_getNumber_Results {
+	return CallSequence_getNumber_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// NewF16 sets the f16 field to a newly
-// allocated F16 struct, preferring placement in s's segment.
-func (s Aircraft) NewF16() (F16, error) {
-	capnp.Struct(s).SetUint16(0, 3)
-	ss, err := NewF16(capnp.Struct(s).Segment())
-	if err != nil {
-		return F16{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s CallSequence_getNumber_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-// Aircraft_List is a list of Aircraft.
-type Aircraft_List = capnp.StructList[Aircraft]
-
-// NewAircraft creates a new list of Aircraft.
-func NewAircraft_List(s *capnp.Segment, sz int32) (Aircraft_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Aircraft](l), err
+func (s CallSequence_getNumber_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// Aircraft_Future is a wrapper for a Aircraft promised by a client call.
-type Aircraft_Future struct{ *capnp.Future }
-
-func (f Aircraft_Future) Struct() (Aircraft, error) {
-	p, err := f.Future.Ptr()
-	return Aircraft(p.Struct()), err
+func (s CallSequence_getNumber_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
-func (p Aircraft_Future) B737() B737_Future {
-	return B737_Future{Future: p.Future.Field(0, nil)}
+
+func (s CallSequence_getNumber_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-func (p Aircraft_Future) A320() A320_Future {
-	return A320_Future{Future: p.Future.Field(0, nil)}
+func (s CallSequence_getNumber_Results) N() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
-func (p Aircraft_Future) F16() F16_Future {
-	return F16_Future{Future: p.Future.Field(0, nil)}
+
+func (s CallSequence_getNumber_Results) SetN(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-type Z capnp.Struct
-type Z_grp Z
-type Z_Which uint16
+// CallSequence_getNumber_Results_List is a list of CallSequence_getNumber_Results.
+type CallSequence_getNumber_Results_List = capnp.StructList[CallSequence_getNumber_Results]
 
-const (
-	Z_Which_void          Z_Which = 0
-	Z_Which_zz            Z_Which = 1
-	Z_Which_f64           Z_Which = 2
-	Z_Which_f32           Z_Which = 3
-	Z_Which_i64           Z_Which = 4
-	Z_Which_i32           Z_Which = 5
-	Z_Which_i16           Z_Which = 6
-	Z_Which_i8            Z_Which = 7
-	Z_Which_u64           Z_Which = 8
-	Z_Which_u32           Z_Which = 9
-	Z_Which_u16           Z_Which = 10
-	Z_Which_u8            Z_Which = 11
-	Z_Which_bool          Z_Which = 12
-	Z_Which_text          Z_Which = 13
-	Z_Which_blob          Z_Which = 14
-	Z_Which_f64vec        Z_Which = 15
-	Z_Which_f32vec        Z_Which = 16
-	Z_Which_i64vec        Z_Which = 17
-	Z_Which_i32vec        Z_Which = 18
-	Z_Which_i16vec        Z_Which = 19
-	Z_Which_i8vec         Z_Which = 20
-	Z_Which_u64vec        Z_Which = 21
-	Z_Which_u32vec        Z_Which = 22
-	Z_Which_u16vec        Z_Which = 23
-	Z_Which_u8vec         Z_Which = 24
-	Z_Which_boolvec       Z_Which = 39
-	Z_Which_datavec       Z_Which = 40
-	Z_Which_textvec       Z_Which = 41
-	Z_Which_zvec          Z_Which = 25
-	Z_Which_zvecvec       Z_Which = 26
-	Z_Which_zdate         Z_Which = 27
-	Z_Which_zdata         Z_Which = 28
-	Z_Which_aircraftvec   Z_Which = 29
-	Z_Which_aircraft      Z_Which = 30
-	Z_Which_regression    Z_Which = 31
-	Z_Which_planebase     Z_Which = 32
-	Z_Which_airport       Z_Which = 33
-	Z_Which_b737          Z_Which = 34
-	Z_Which_a320          Z_Which = 35
-	Z_Which_f16           Z_Which = 36
-	Z_Which_zdatevec      Z_Which = 37
-	Z_Which_zdatavec      Z_Which = 38
-	Z_Which_grp           Z_Which = 42
-	Z_Which_echo          Z_Which = 43
-	Z_Which_echoes        Z_Which = 44
-	Z_Which_anyPtr        Z_Which = 45
-	Z_Which_anyStruct     Z_Which = 46
-	Z_Which_anyList       Z_Which = 47
-	Z_Which_anyCapability Z_Which = 48
-)
+// NewCallSequence_getNumber_Results creates a new list of CallSequence_getNumber_Results.
+func NewCallSequence_getNumber_Results_List(s *capnp.Segment, sz int32) (CallSequence_getNumber_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[CallSequence_getNumber_Results](l), err
+}
 
-func (w Z_Which) String() string {
-	const s = "voidzzf64f32i64i32i16i8u64u32u16u8booltextblobf64vecf32veci64veci32veci16veci8vecu64vecu32vecu16vecu8vecboolvecdatavectextveczveczvecveczdatezdataaircraftvecaircraftregressionplanebaseairportb737a320f16zdateveczdatavecgrpechoechoesanyPtranyStructanyListanyCapability"
-	switch w {
-	case Z_Which_void:
-		return s[0:4]
-	case Z_Which_zz:
-		return s[4:6]
-	case Z_Which_f64:
-		return s[6:9]
-	case Z_Which_f32:
-		return s[9:12]
-	case Z_Which_i64:
-		return s[12:15]
-	case Z_Which_i32:
-		return s[15:18]
-	case Z_Which_i16:
-		return s[18:21]
-	case Z_Which_i8:
-		return s[21:23]
-	case Z_Which_u64:
-		return s[23:26]
-	case Z_Which_u32:
-		return s[26:29]
-	case Z_Which_u16:
-		return s[29:32]
-	case Z_Which_u8:
-		return s[32:34]
-	case Z_Which_bool:
-		return s[34:38]
-	case Z_Which_text:
-		return s[38:42]
-	case Z_Which_blob:
-		return s[42:46]
-	case Z_Which_f64vec:
-		return s[46:52]
-	case Z_Which_f32vec:
-		return s[52:58]
-	case Z_Which_i64vec:
-		return s[58:64]
-	case Z_Which_i32vec:
-		return s[64:70]
-	case Z_Which_i16vec:
-		return s[70:76]
-	case Z_Which_i8vec:
-		return s[76:81]
-	case Z_Which_u64vec:
-		return s[81:87]
-	case Z_Which_u32vec:
-		return s[87:93]
-	case Z_Which_u16vec:
-		return s[93:99]
-	case Z_Which_u8vec:
-		return s[99:104]
-	case Z_Which_boolvec:
-		return s[104:111]
-	case Z_Which_datavec:
-		return s[111:118]
-	case Z_Which_textvec:
-		return s[118:125]
-	case Z_Which_zvec:
-		return s[125:129]
-	case Z_Which_zvecvec:
-		return s[129:136]
-	case Z_Which_zdate:
-		return s[136:141]
-	case Z_Which_zdata:
-		return s[141:146]
-	case Z_Which_aircraftvec:
-		return s[146:157]
-	case Z_Which_aircraft:
-		return s[157:165]
-	case Z_Which_regression:
-		return s[165:175]
-	case Z_Which_planebase:
-		return s[175:184]
-	case Z_Which_airport:
-		return s[184:191]
-	case Z_Which_b737:
-		return s[191:195]
-	case Z_Which_a320:
-		return s[195:199]
-	case Z_Which_f16:
-		return s[199:202]
-	case Z_Which_zdatevec:
-		return s[202:210]
-	case Z_Which_zdatavec:
-		return s[210:218]
-	case Z_Which_grp:
-		return s[218:221]
-	case Z_Which_echo:
-		return s[221:225]
-	case Z_Which_echoes:
-		return s[225:231]
-	case Z_Which_anyPtr:
-		return s[231:237]
-	case Z_Which_anyStruct:
-		return s[237:246]
-	case Z_Which_anyList:
-		return s[246:253]
-	case Z_Which_anyCapability:
-		return s[253:266]
+// CallSequence_getNumber_Results_Future is a wrapper for a CallSequence_getNumber_Results promised by a client call.
+type CallSequence_getNumber_Results_Future struct{ *capnp.Future }
 
-	}
-	return "Z_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+func (f CallSequence_getNumber_Results_Future) Struct() (CallSequence_getNumber_Results, error) {
+	p, err := f.Future.Ptr()
+	return CallSequence_getNumber_Results(p.Struct()), err
 }
 
-// Z_TypeID is the unique identifier for the type Z.
-const Z_TypeID = 0xea26e9973bd6a0d9
+type Counter capnp.Struct
 
-func NewZ(s *capnp.Segment) (Z, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
-	return Z(st), err
+// Counter_TypeID is the unique identifier for the type Counter.
+const Counter_TypeID = 0x8748bc095e10cb5d
+
+func NewCounter(s *capnp.Segment) (Counter, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Counter(st), err
 }
 
-func NewRootZ(s *capnp.Segment) (Z, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
-	return Z(st), err
+func NewRootCounter(s *capnp.Segment) (Counter, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Counter(st), err
 }
 
-func ReadRootZ(msg *capnp.Message) (Z, error) {
+func ReadRootCounter(msg *capnp.Message) (Counter, error) {
 	root, err := msg.Root()
-	return Z(root.Struct()), err
+	return Counter(root.Struct()), err
 }
 
-func (s Z) String() string {
-	str, _ := text.Marshal(0xea26e9973bd6a0d9, capnp.Struct(s))
+func (s Counter) String() string {
+	str, _ := text.Marshal(0x8748bc095e10cb5d, capnp.Struct(s))
 	return str
 }
 
-func (s Z) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Counter) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Z) DecodeFromPtr(p capnp.Ptr) Z {
-	return Z(capnp.Struct{}.DecodeFromPtr(p))
+func (Counter) DecodeFromPtr(p capnp.Ptr) Counter {
+	return Counter(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z) ToPtr() capnp.Ptr {
+func (s Counter) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s Z) Which() Z_Which {
-	return Z_Which(capnp.Struct(s).Uint16(0))
-}
-func (s Z) IsValid() bool {
+func (s Counter) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Z) Message() *capnp.Message {
+func (s Counter) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Z) Segment() *capnp.Segment {
+func (s Counter) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Z) SetVoid() {
-	capnp.Struct(s).SetUint16(0, 0)
+func (s Counter) Size() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
 
+func (s Counter) SetSize(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
 }
 
-func (s Z) Zz() (Z, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != zz")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Z(p.Struct()), err
+func (s Counter) Words() (string, error) {
+	return capnp.GetTextField(s, 0, "Counter.words")
 }
 
-func (s Z) HasZz() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		return false
-	}
+func (s Counter) HasWords() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z) SetZz(v Z) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Counter) WordsBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Counter.words")
 }
 
-// NewZz sets the zz field to a newly
-// allocated Z struct, preferring placement in s's segment.
-func (s Z) NewZz() (Z, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewZ(capnp.Struct(s).Segment())
-	if err != nil {
-		return Z{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Counter) SetWords(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s Z) F64() float64 {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != f64")
-	}
-	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+func (s Counter) Wordlist() (capnp.TextList, error) {
+	return capnp.GetListField[capnp.TextList](s, 1, "Counter.wordlist")
 }
 
-func (s Z) SetF64(v float64) {
-	capnp.Struct(s).SetUint16(0, 2)
-	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+func (s Counter) HasWordlist() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s Z) F32() float32 {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		panic("Which() != f32")
-	}
-	return math.Float32frombits(capnp.Struct(s).Uint32(8))
+func (s Counter) SetWordlist(v capnp.TextList) error {
+	return capnp.SetListField(s, 1, v)
 }
 
-func (s Z) SetF32(v float32) {
-	capnp.Struct(s).SetUint16(0, 3)
-	capnp.Struct(s).SetUint32(8, math.Float32bits(v))
+// NewWordlist sets the wordlist field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Counter) NewWordlist(n int32) (capnp.TextList, error) {
+	return capnp.NewListField(s, 1, n, capnp.NewTextList)
 }
 
-func (s Z) I64() int64 {
-	if capnp.Struct(s).Uint16(0) != 4 {
-		panic("Which() != i64")
-	}
-	return int64(capnp.Struct(s).Uint64(8))
+// SetWordlistFromSlice sets the wordlist field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Counter) SetWordlistFromSlice(v []string) error {
+	return capnp.SetListFieldFromSlice(s, 1, v, capnp.NewTextListFromSlice)
 }
-
-func (s Z) SetI64(v int64) {
-	capnp.Struct(s).SetUint16(0, 4)
-	capnp.Struct(s).SetUint64(8, uint64(v))
+func (s Counter) Bitlist() (capnp.BitList, error) {
+	return capnp.GetListField[capnp.BitList](s, 2, "Counter.bitlist")
 }
 
-func (s Z) I32() int32 {
-	if capnp.Struct(s).Uint16(0) != 5 {
-		panic("Which() != i32")
-	}
-	return int32(capnp.Struct(s).Uint32(8))
+func (s Counter) HasBitlist() bool {
+	return capnp.Struct(s).HasPtr(2)
 }
 
-func (s Z) SetI32(v int32) {
-	capnp.Struct(s).SetUint16(0, 5)
-	capnp.Struct(s).SetUint32(8, uint32(v))
+func (s Counter) SetBitlist(v capnp.BitList) error {
+	return capnp.SetListField(s, 2, v)
 }
 
-func (s Z) I16() int16 {
-	if capnp.Struct(s).Uint16(0) != 6 {
-		panic("Which() != i16")
-	}
-	return int16(capnp.Struct(s).Uint16(8))
+// NewBitlist sets the bitlist field to a newly
+// allocated capnp.BitList, preferring placement in s's segment.
+func (s Counter) NewBitlist(n int32) (capnp.BitList, error) {
+	return capnp.NewListField(s, 2, n, capnp.NewBitList)
 }
 
-func (s Z) SetI16(v int16) {
-	capnp.Struct(s).SetUint16(0, 6)
-	capnp.Struct(s).SetUint16(8, uint16(v))
+// SetBitlistFromSlice sets the bitlist field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Counter) SetBitlistFromSlice(v []bool) error {
+	return capnp.SetListFieldFromSlice(s, 2, v, capnp.NewBitListFromSlice)
 }
 
-func (s Z) I8() int8 {
-	if capnp.Struct(s).Uint16(0) != 7 {
-		panic("Which() != i8")
-	}
-	return int8(capnp.Struct(s).Uint8(8))
-}
+// Counter_List is a list of Counter.
+type Counter_List = capnp.StructList[Counter]
 
-func (s Z) SetI8(v int8) {
-	capnp.Struct(s).SetUint16(0, 7)
-	capnp.Struct(s).SetUint8(8, uint8(v))
+// NewCounter creates a new list of Counter.
+func NewCounter_List(s *capnp.Segment, sz int32) (Counter_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[Counter](l), err
 }
 
-func (s Z) U64() uint64 {
-	if capnp.Struct(s).Uint16(0) != 8 {
-		panic("Which() != u64")
-	}
-	return capnp.Struct(s).Uint64(8)
-}
+// Counter_Future is a wrapper for a Counter promised by a client call.
+type Counter_Future struct{ *capnp.Future }
 
-func (s Z) SetU64(v uint64) {
-	capnp.Struct(s).SetUint16(0, 8)
-	capnp.Struct(s).SetUint64(8, v)
+func (f Counter_Future) Struct() (Counter, error) {
+	p, err := f.Future.Ptr()
+	return Counter(p.Struct()), err
 }
 
-func (s Z) U32() uint32 {
-	if capnp.Struct(s).Uint16(0) != 9 {
-		panic("Which() != u32")
-	}
-	return capnp.Struct(s).Uint32(8)
-}
+type Defaults capnp.Struct
 
-func (s Z) SetU32(v uint32) {
-	capnp.Struct(s).SetUint16(0, 9)
-	capnp.Struct(s).SetUint32(8, v)
-}
+// Defaults_TypeID is the unique identifier for the type Defaults.
+const Defaults_TypeID = 0x97e38948c61f878d
 
-func (s Z) U16() uint16 {
-	if capnp.Struct(s).Uint16(0) != 10 {
-		panic("Which() != u16")
-	}
-	return capnp.Struct(s).Uint16(8)
+func NewDefaults(s *capnp.Segment) (Defaults, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return Defaults(st), err
 }
 
-func (s Z) SetU16(v uint16) {
-	capnp.Struct(s).SetUint16(0, 10)
-	capnp.Struct(s).SetUint16(8, v)
+func NewRootDefaults(s *capnp.Segment) (Defaults, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return Defaults(st), err
 }
 
-func (s Z) U8() uint8 {
-	if capnp.Struct(s).Uint16(0) != 11 {
-		panic("Which() != u8")
-	}
-	return capnp.Struct(s).Uint8(8)
+func ReadRootDefaults(msg *capnp.Message) (Defaults, error) {
+	root, err := msg.Root()
+	return Defaults(root.Struct()), err
 }
 
-func (s Z) SetU8(v uint8) {
-	capnp.Struct(s).SetUint16(0, 11)
-	capnp.Struct(s).SetUint8(8, v)
+func (s Defaults) String() string {
+	str, _ := text.Marshal(0x97e38948c61f878d, capnp.Struct(s))
+	return str
 }
 
-func (s Z) Bool() bool {
-	if capnp.Struct(s).Uint16(0) != 12 {
-		panic("Which() != bool")
-	}
-	return capnp.Struct(s).Bit(64)
+func (s Defaults) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Z) SetBool(v bool) {
-	capnp.Struct(s).SetUint16(0, 12)
-	capnp.Struct(s).SetBit(64, v)
+func (Defaults) DecodeFromPtr(p capnp.Ptr) Defaults {
+	return Defaults(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z) Text() (string, error) {
-	if capnp.Struct(s).Uint16(0) != 13 {
-		panic("Which() != text")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s Defaults) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-func (s Z) HasText() bool {
-	if capnp.Struct(s).Uint16(0) != 13 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Defaults) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Z) TextBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Defaults) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Z) SetText(v string) error {
-	capnp.Struct(s).SetUint16(0, 13)
-	return capnp.Struct(s).SetText(0, v)
+func (s Defaults) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-
-func (s Z) Blob() ([]byte, error) {
-	if capnp.Struct(s).Uint16(0) != 14 {
-		panic("Which() != blob")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+func (s Defaults) Text() (string, error) {
+	return capnp.GetTextFieldDefault(s, 0, "Defaults.text", "foo")
 }
 
-func (s Z) HasBlob() bool {
-	if capnp.Struct(s).Uint16(0) != 14 {
-		return false
-	}
+func (s Defaults) HasText() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z) SetBlob(v []byte) error {
-	capnp.Struct(s).SetUint16(0, 14)
-	return capnp.Struct(s).SetData(0, v)
+func (s Defaults) TextBytes() ([]byte, error) {
+	return capnp.GetTextBytesFieldDefault(s, 0, "Defaults.text", "foo")
 }
 
-func (s Z) F64vec() (capnp.Float64List, error) {
-	if capnp.Struct(s).Uint16(0) != 15 {
-		panic("Which() != f64vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.Float64List(p.List()), err
+func (s Defaults) SetText(v string) error {
+	return capnp.Struct(s).SetNewText(0, v)
 }
 
-func (s Z) HasF64vec() bool {
-	if capnp.Struct(s).Uint16(0) != 15 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Defaults) Data() ([]byte, error) {
+	return capnp.GetDataFieldDefault(s, 1, "Defaults.data", []byte{0x62, 0x61, 0x72})
 }
 
-func (s Z) SetF64vec(v capnp.Float64List) error {
-	capnp.Struct(s).SetUint16(0, 15)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Defaults) HasData() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// NewF64vec sets the f64vec field to a newly
-// allocated capnp.Float64List, preferring placement in s's segment.
-func (s Z) NewF64vec(n int32) (capnp.Float64List, error) {
-	capnp.Struct(s).SetUint16(0, 15)
-	l, err := capnp.NewFloat64List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Float64List{}, err
+func (s Defaults) SetData(v []byte) error {
+	if v == nil {
+		v = []byte{}
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+	return capnp.Struct(s).SetData(1, v)
 }
-func (s Z) F32vec() (capnp.Float32List, error) {
-	if capnp.Struct(s).Uint16(0) != 16 {
-		panic("Which() != f32vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.Float32List(p.List()), err
+
+// DataReader returns a reader of data's data, which
+// is read directly from the message.
+func (s Defaults) DataReader() (*bytes.Reader, error) {
+	v, err := s.Data()
+	return bytes.NewReader(v), err
 }
 
-func (s Z) HasF32vec() bool {
-	if capnp.Struct(s).Uint16(0) != 16 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// SetDataFromReader sets data to the next size bytes of r,
+// which are read directly into the message.
+func (s Defaults) SetDataFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(1, r, size)
 }
 
-func (s Z) SetF32vec(v capnp.Float32List) error {
-	capnp.Struct(s).SetUint16(0, 16)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Defaults) Float() float32 {
+	return math.Float32frombits(capnp.Struct(s).Uint32(0) ^ 0x4048f5c3)
 }
 
-// NewF32vec sets the f32vec field to a newly
-// allocated capnp.Float32List, preferring placement in s's segment.
-func (s Z) NewF32vec(n int32) (capnp.Float32List, error) {
-	capnp.Struct(s).SetUint16(0, 16)
-	l, err := capnp.NewFloat32List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Float32List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Defaults) SetFloat(v float32) {
+	capnp.Struct(s).SetUint32(0, math.Float32bits(v)^0x4048f5c3)
 }
-func (s Z) I64vec() (capnp.Int64List, error) {
-	if capnp.Struct(s).Uint16(0) != 17 {
-		panic("Which() != i64vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.Int64List(p.List()), err
+
+func (s Defaults) Int() int32 {
+	return int32(capnp.Struct(s).Uint32(4) ^ 4294967173)
 }
 
-func (s Z) HasI64vec() bool {
-	if capnp.Struct(s).Uint16(0) != 17 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Defaults) SetInt(v int32) {
+	capnp.Struct(s).SetUint32(4, uint32(v)^4294967173)
 }
 
-func (s Z) SetI64vec(v capnp.Int64List) error {
-	capnp.Struct(s).SetUint16(0, 17)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Defaults) Uint() uint32 {
+	return capnp.Struct(s).Uint32(8) ^ 42
 }
 
-// NewI64vec sets the i64vec field to a newly
-// allocated capnp.Int64List, preferring placement in s's segment.
-func (s Z) NewI64vec(n int32) (capnp.Int64List, error) {
-	capnp.Struct(s).SetUint16(0, 17)
-	l, err := capnp.NewInt64List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Int64List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s Z) I32vec() (capnp.Int32List, error) {
-	if capnp.Struct(s).Uint16(0) != 18 {
-		panic("Which() != i32vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.Int32List(p.List()), err
+func (s Defaults) SetUint(v uint32) {
+	capnp.Struct(s).SetUint32(8, v^42)
 }
 
-func (s Z) HasI32vec() bool {
-	if capnp.Struct(s).Uint16(0) != 18 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
-}
+// Defaults_List is a list of Defaults.
+type Defaults_List = capnp.StructList[Defaults]
 
-func (s Z) SetI32vec(v capnp.Int32List) error {
-	capnp.Struct(s).SetUint16(0, 18)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// NewDefaults creates a new list of Defaults.
+func NewDefaults_List(s *capnp.Segment, sz int32) (Defaults_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	return capnp.StructList[Defaults](l), err
 }
 
-// NewI32vec sets the i32vec field to a newly
-// allocated capnp.Int32List, preferring placement in s's segment.
-func (s Z) NewI32vec(n int32) (capnp.Int32List, error) {
-	capnp.Struct(s).SetUint16(0, 18)
-	l, err := capnp.NewInt32List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Int32List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s Z) I16vec() (capnp.Int16List, error) {
-	if capnp.Struct(s).Uint16(0) != 19 {
-		panic("Which() != i16vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.Int16List(p.List()), err
-}
+// Defaults_Future is a wrapper for a Defaults promised by a client call.
+type Defaults_Future struct{ *capnp.Future }
 
-func (s Z) HasI16vec() bool {
-	if capnp.Struct(s).Uint16(0) != 19 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (f Defaults_Future) Struct() (Defaults, error) {
+	p, err := f.Future.Ptr()
+	return Defaults(p.Struct()), err
 }
 
-func (s Z) SetI16vec(v capnp.Int16List) error {
-	capnp.Struct(s).SetUint16(0, 19)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
-}
+type Echo capnp.Client
 
-// NewI16vec sets the i16vec field to a newly
-// allocated capnp.Int16List, preferring placement in s's segment.
-func (s Z) NewI16vec(n int32) (capnp.Int16List, error) {
-	capnp.Struct(s).SetUint16(0, 19)
-	l, err := capnp.NewInt16List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Int16List{}, err
+// Echo_TypeID is the unique identifier for the type Echo.
+const Echo_TypeID = 0x8e5322c1e9282534
+
+func (c Echo) Echo(ctx context.Context, params func(Echo_echo_Params) error) (Echo_echo_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8e5322c1e9282534,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:Echo",
+			MethodName:    "echo",
+		},
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s Z) I8vec() (capnp.Int8List, error) {
-	if capnp.Struct(s).Uint16(0) != 20 {
-		panic("Which() != i8vec")
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Echo_echo_Params(s)) }
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.Int8List(p.List()), err
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Echo_echo_Results_Future{Future: ans.Future()}, release
+
 }
 
-func (s Z) HasI8vec() bool {
-	if capnp.Struct(s).Uint16(0) != 20 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (c Echo) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
 }
 
-func (s Z) SetI8vec(v capnp.Int8List) error {
-	capnp.Struct(s).SetUint16(0, 20)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Echo) String() string {
+	return "Echo(" + capnp.Client(c).String() + ")"
 }
 
-// NewI8vec sets the i8vec field to a newly
-// allocated capnp.Int8List, preferring placement in s's segment.
-func (s Z) NewI8vec(n int32) (capnp.Int8List, error) {
-	capnp.Struct(s).SetUint16(0, 20)
-	l, err := capnp.NewInt8List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Int8List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Echo) AddRef() Echo {
+	return Echo(capnp.Client(c).AddRef())
 }
-func (s Z) U64vec() (capnp.UInt64List, error) {
-	if capnp.Struct(s).Uint16(0) != 21 {
-		panic("Which() != u64vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.UInt64List(p.List()), err
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Echo) Release() {
+	capnp.Client(c).Release()
 }
 
-func (s Z) HasU64vec() bool {
-	if capnp.Struct(s).Uint16(0) != 21 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Echo) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-func (s Z) SetU64vec(v capnp.UInt64List) error {
-	capnp.Struct(s).SetUint16(0, 21)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (c Echo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-// NewU64vec sets the u64vec field to a newly
-// allocated capnp.UInt64List, preferring placement in s's segment.
-func (s Z) NewU64vec(n int32) (capnp.UInt64List, error) {
-	capnp.Struct(s).SetUint16(0, 21)
-	l, err := capnp.NewUInt64List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.UInt64List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (Echo) DecodeFromPtr(p capnp.Ptr) Echo {
+	return Echo(capnp.Client{}.DecodeFromPtr(p))
 }
-func (s Z) U32vec() (capnp.UInt32List, error) {
-	if capnp.Struct(s).Uint16(0) != 22 {
-		panic("Which() != u32vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.UInt32List(p.List()), err
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Echo) IsValid() bool {
+	return capnp.Client(c).IsValid()
 }
 
-func (s Z) HasU32vec() bool {
-	if capnp.Struct(s).Uint16(0) != 22 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Echo) IsSame(other Echo) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
-func (s Z) SetU32vec(v capnp.UInt32List) error {
-	capnp.Struct(s).SetUint16(0, 22)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Echo) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-// NewU32vec sets the u32vec field to a newly
-// allocated capnp.UInt32List, preferring placement in s's segment.
-func (s Z) NewU32vec(n int32) (capnp.UInt32List, error) {
-	capnp.Struct(s).SetUint16(0, 22)
-	l, err := capnp.NewUInt32List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.UInt32List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Echo) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
-func (s Z) U16vec() (capnp.UInt16List, error) {
-	if capnp.Struct(s).Uint16(0) != 23 {
-		panic("Which() != u16vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.UInt16List(p.List()), err
+
+// A Echo_Server is a Echo with a local implementation.
+type Echo_Server interface {
+	Echo(context.Context, Echo_echo) error
 }
 
-func (s Z) HasU16vec() bool {
-	if capnp.Struct(s).Uint16(0) != 23 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// Echo_NewServer creates a new Server from an implementation of Echo_Server.
+func Echo_NewServer(s Echo_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Echo_Methods(nil, s), s, c)
 }
 
-func (s Z) SetU16vec(v capnp.UInt16List) error {
-	capnp.Struct(s).SetUint16(0, 23)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// Echo_ServerToClient creates a new Client from an implementation of Echo_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Echo_ServerToClient(s Echo_Server) Echo {
+	return Echo(capnp.NewClient(Echo_NewServer(s)))
 }
 
-// NewU16vec sets the u16vec field to a newly
-// allocated capnp.UInt16List, preferring placement in s's segment.
-func (s Z) NewU16vec(n int32) (capnp.UInt16List, error) {
-	capnp.Struct(s).SetUint16(0, 23)
-	l, err := capnp.NewUInt16List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.UInt16List{}, err
+// Echo_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Echo_Methods(methods []server.Method, s Echo_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8e5322c1e9282534,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:Echo",
+			MethodName:    "echo",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Echo(ctx, Echo_echo{call})
+		},
+	})
+
+	return methods
 }
-func (s Z) U8vec() (capnp.UInt8List, error) {
-	if capnp.Struct(s).Uint16(0) != 24 {
-		panic("Which() != u8vec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.UInt8List(p.List()), err
+
+// Echo_echo holds the state for a server call to Echo.echo.
+// See server.Call for documentation.
+type Echo_echo struct {
+	*server.Call
 }
 
-func (s Z) HasU8vec() bool {
-	if capnp.Struct(s).Uint16(0) != 24 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// Args returns the call's arguments.
+func (c Echo_echo) Args() Echo_echo_Params {
+	return Echo_echo_Params(c.Call.Args())
 }
 
-func (s Z) SetU8vec(v capnp.UInt8List) error {
-	capnp.Struct(s).SetUint16(0, 24)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// AllocResults allocates the results struct.
+func (c Echo_echo) AllocResults() (Echo_echo_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Echo_echo_Results(r), err
 }
 
-// NewU8vec sets the u8vec field to a newly
-// allocated capnp.UInt8List, preferring placement in s's segment.
-func (s Z) NewU8vec(n int32) (capnp.UInt8List, error) {
-	capnp.Struct(s).SetUint16(0, 24)
-	l, err := capnp.NewUInt8List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.UInt8List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s Z) Boolvec() (capnp.BitList, error) {
-	if capnp.Struct(s).Uint16(0) != 39 {
-		panic("Which() != boolvec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.BitList(p.List()), err
-}
+// Echo_List is a list of Echo.
+type Echo_List = capnp.CapList[Echo]
 
-func (s Z) HasBoolvec() bool {
-	if capnp.Struct(s).Uint16(0) != 39 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// NewEcho_List creates a new list of Echo.
+func NewEcho_List(s *capnp.Segment, sz int32) (Echo_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Echo](l), err
 }
 
-func (s Z) SetBoolvec(v capnp.BitList) error {
-	capnp.Struct(s).SetUint16(0, 39)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
-}
+type EchoBase capnp.Struct
 
-// NewBoolvec sets the boolvec field to a newly
-// allocated capnp.BitList, preferring placement in s's segment.
-func (s Z) NewBoolvec(n int32) (capnp.BitList, error) {
-	capnp.Struct(s).SetUint16(0, 39)
-	l, err := capnp.NewBitList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.BitList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// EchoBase_TypeID is the unique identifier for the type EchoBase.
+const EchoBase_TypeID = 0xa8bf13fef2674866
+
+func NewEchoBase(s *capnp.Segment) (EchoBase, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EchoBase(st), err
 }
-func (s Z) Datavec() (capnp.DataList, error) {
-	if capnp.Struct(s).Uint16(0) != 40 {
-		panic("Which() != datavec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.DataList(p.List()), err
+
+func NewRootEchoBase(s *capnp.Segment) (EchoBase, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EchoBase(st), err
 }
 
-func (s Z) HasDatavec() bool {
-	if capnp.Struct(s).Uint16(0) != 40 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func ReadRootEchoBase(msg *capnp.Message) (EchoBase, error) {
+	root, err := msg.Root()
+	return EchoBase(root.Struct()), err
 }
 
-func (s Z) SetDatavec(v capnp.DataList) error {
-	capnp.Struct(s).SetUint16(0, 40)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s EchoBase) String() string {
+	str, _ := text.Marshal(0xa8bf13fef2674866, capnp.Struct(s))
+	return str
 }
 
-// NewDatavec sets the datavec field to a newly
-// allocated capnp.DataList, preferring placement in s's segment.
-func (s Z) NewDatavec(n int32) (capnp.DataList, error) {
-	capnp.Struct(s).SetUint16(0, 40)
-	l, err := capnp.NewDataList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.DataList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s EchoBase) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
-func (s Z) Textvec() (capnp.TextList, error) {
-	if capnp.Struct(s).Uint16(0) != 41 {
-		panic("Which() != textvec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.TextList(p.List()), err
+
+func (EchoBase) DecodeFromPtr(p capnp.Ptr) EchoBase {
+	return EchoBase(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z) HasTextvec() bool {
-	if capnp.Struct(s).Uint16(0) != 41 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s EchoBase) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s EchoBase) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Z) SetTextvec(v capnp.TextList) error {
-	capnp.Struct(s).SetUint16(0, 41)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s EchoBase) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// NewTextvec sets the textvec field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Z) NewTextvec(n int32) (capnp.TextList, error) {
-	capnp.Struct(s).SetUint16(0, 41)
-	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s EchoBase) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-func (s Z) Zvec() (Z_List, error) {
-	if capnp.Struct(s).Uint16(0) != 25 {
-		panic("Which() != zvec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Z_List(p.List()), err
+func (s EchoBase) Echo() Echo {
+	return capnp.GetInterfaceField[Echo](s, 0)
 }
 
-func (s Z) HasZvec() bool {
-	if capnp.Struct(s).Uint16(0) != 25 {
-		return false
-	}
+func (s EchoBase) HasEcho() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z) SetZvec(v Z_List) error {
-	capnp.Struct(s).SetUint16(0, 25)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s EchoBase) SetEcho(v Echo) error {
+	return capnp.SetInterfaceField(s, 0, v)
 }
 
-// NewZvec sets the zvec field to a newly
-// allocated Z_List, preferring placement in s's segment.
-func (s Z) NewZvec(n int32) (Z_List, error) {
-	capnp.Struct(s).SetUint16(0, 25)
-	l, err := NewZ_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Z_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s Z) Zvecvec() (capnp.PointerList, error) {
-	if capnp.Struct(s).Uint16(0) != 26 {
-		panic("Which() != zvecvec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.PointerList(p.List()), err
-}
+// EchoBase_List is a list of EchoBase.
+type EchoBase_List = capnp.StructList[EchoBase]
 
-func (s Z) HasZvecvec() bool {
-	if capnp.Struct(s).Uint16(0) != 26 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// NewEchoBase creates a new list of EchoBase.
+func NewEchoBase_List(s *capnp.Segment, sz int32) (EchoBase_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[EchoBase](l), err
 }
 
-func (s Z) SetZvecvec(v capnp.PointerList) error {
-	capnp.Struct(s).SetUint16(0, 26)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
-}
+// EchoBase_Future is a wrapper for a EchoBase promised by a client call.
+type EchoBase_Future struct{ *capnp.Future }
 
-// NewZvecvec sets the zvecvec field to a newly
-// allocated capnp.PointerList, preferring placement in s's segment.
-func (s Z) NewZvecvec(n int32) (capnp.PointerList, error) {
-	capnp.Struct(s).SetUint16(0, 26)
-	l, err := capnp.NewPointerList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.PointerList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (f EchoBase_Future) Struct() (EchoBase, error) {
+	p, err := f.Future.Ptr()
+	return EchoBase(p.Struct()), err
 }
-func (s Z) Zdate() (Zdate, error) {
-	if capnp.Struct(s).Uint16(0) != 27 {
-		panic("Which() != zdate")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Zdate(p.Struct()), err
+func (p EchoBase_Future) Echo() Echo {
+	return Echo(p.Future.Field(0, nil).Client())
 }
 
-func (s Z) HasZdate() bool {
-	if capnp.Struct(s).Uint16(0) != 27 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
-}
+type Echo_echo_Params capnp.Struct
 
-func (s Z) SetZdate(v Zdate) error {
-	capnp.Struct(s).SetUint16(0, 27)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
+// Echo_echo_Params_TypeID is the unique identifier for the type Echo_echo_Params.
+const Echo_echo_Params_TypeID = 0x8a165fb4d71bf3a2
 
-// NewZdate sets the zdate field to a newly
-// allocated Zdate struct, preferring placement in s's segment.
-func (s Z) NewZdate() (Zdate, error) {
-	capnp.Struct(s).SetUint16(0, 27)
-	ss, err := NewZdate(capnp.Struct(s).Segment())
-	if err != nil {
-		return Zdate{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func NewEcho_echo_Params(s *capnp.Segment) (Echo_echo_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Echo_echo_Params(st), err
 }
 
-func (s Z) Zdata() (Zdata, error) {
-	if capnp.Struct(s).Uint16(0) != 28 {
-		panic("Which() != zdata")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Zdata(p.Struct()), err
+func NewRootEcho_echo_Params(s *capnp.Segment) (Echo_echo_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Echo_echo_Params(st), err
 }
 
-func (s Z) HasZdata() bool {
-	if capnp.Struct(s).Uint16(0) != 28 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func ReadRootEcho_echo_Params(msg *capnp.Message) (Echo_echo_Params, error) {
+	root, err := msg.Root()
+	return Echo_echo_Params(root.Struct()), err
 }
 
-func (s Z) SetZdata(v Zdata) error {
-	capnp.Struct(s).SetUint16(0, 28)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Echo_echo_Params) String() string {
+	str, _ := text.Marshal(0x8a165fb4d71bf3a2, capnp.Struct(s))
+	return str
 }
 
-// NewZdata sets the zdata field to a newly
-// allocated Zdata struct, preferring placement in s's segment.
-func (s Z) NewZdata() (Zdata, error) {
-	capnp.Struct(s).SetUint16(0, 28)
-	ss, err := NewZdata(capnp.Struct(s).Segment())
-	if err != nil {
-		return Zdata{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Echo_echo_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Z) Aircraftvec() (Aircraft_List, error) {
-	if capnp.Struct(s).Uint16(0) != 29 {
-		panic("Which() != aircraftvec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Aircraft_List(p.List()), err
+func (Echo_echo_Params) DecodeFromPtr(p capnp.Ptr) Echo_echo_Params {
+	return Echo_echo_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z) HasAircraftvec() bool {
-	if capnp.Struct(s).Uint16(0) != 29 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Echo_echo_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Echo_echo_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Z) SetAircraftvec(v Aircraft_List) error {
-	capnp.Struct(s).SetUint16(0, 29)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Echo_echo_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// NewAircraftvec sets the aircraftvec field to a newly
-// allocated Aircraft_List, preferring placement in s's segment.
-func (s Z) NewAircraftvec(n int32) (Aircraft_List, error) {
-	capnp.Struct(s).SetUint16(0, 29)
-	l, err := NewAircraft_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Aircraft_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Echo_echo_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-func (s Z) Aircraft() (Aircraft, error) {
-	if capnp.Struct(s).Uint16(0) != 30 {
-		panic("Which() != aircraft")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Aircraft(p.Struct()), err
+func (s Echo_echo_Params) In() (string, error) {
+	return capnp.GetTextField(s, 0, "Echo.echo$Params.in")
 }
 
-func (s Z) HasAircraft() bool {
-	if capnp.Struct(s).Uint16(0) != 30 {
-		return false
-	}
+func (s Echo_echo_Params) HasIn() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z) SetAircraft(v Aircraft) error {
-	capnp.Struct(s).SetUint16(0, 30)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Echo_echo_Params) InBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Echo.echo$Params.in")
 }
 
-// NewAircraft sets the aircraft field to a newly
-// allocated Aircraft struct, preferring placement in s's segment.
-func (s Z) NewAircraft() (Aircraft, error) {
-	capnp.Struct(s).SetUint16(0, 30)
-	ss, err := NewAircraft(capnp.Struct(s).Segment())
-	if err != nil {
-		return Aircraft{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Echo_echo_Params) SetIn(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s Z) Regression() (Regression, error) {
-	if capnp.Struct(s).Uint16(0) != 31 {
-		panic("Which() != regression")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Regression(p.Struct()), err
-}
+// Echo_echo_Params_List is a list of Echo_echo_Params.
+type Echo_echo_Params_List = capnp.StructList[Echo_echo_Params]
 
-func (s Z) HasRegression() bool {
-	if capnp.Struct(s).Uint16(0) != 31 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// NewEcho_echo_Params creates a new list of Echo_echo_Params.
+func NewEcho_echo_Params_List(s *capnp.Segment, sz int32) (Echo_echo_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Echo_echo_Params](l), err
 }
 
-func (s Z) SetRegression(v Regression) error {
-	capnp.Struct(s).SetUint16(0, 31)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
+// Echo_echo_Params_Future is a wrapper for a Echo_echo_Params promised by a client call.
+type Echo_echo_Params_Future struct{ *capnp.Future }
 
-// NewRegression sets the regression field to a newly
-// allocated Regression struct, preferring placement in s's segment.
-func (s Z) NewRegression() (Regression, error) {
-	capnp.Struct(s).SetUint16(0, 31)
-	ss, err := NewRegression(capnp.Struct(s).Segment())
-	if err != nil {
-		return Regression{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (f Echo_echo_Params_Future) Struct() (Echo_echo_Params, error) {
+	p, err := f.Future.Ptr()
+	return Echo_echo_Params(p.Struct()), err
 }
 
-func (s Z) Planebase() (PlaneBase, error) {
-	if capnp.Struct(s).Uint16(0) != 32 {
-		panic("Which() != planebase")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return PlaneBase(p.Struct()), err
-}
+type Echo_echo_Results capnp.Struct
 
-func (s Z) HasPlanebase() bool {
-	if capnp.Struct(s).Uint16(0) != 32 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
-}
+// Echo_echo_Results_TypeID is the unique identifier for the type Echo_echo_Results.
+const Echo_echo_Results_TypeID = 0x9b37d729b9dd7b9d
 
-func (s Z) SetPlanebase(v PlaneBase) error {
-	capnp.Struct(s).SetUint16(0, 32)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func NewEcho_echo_Results(s *capnp.Segment) (Echo_echo_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Echo_echo_Results(st), err
 }
 
-// NewPlanebase sets the planebase field to a newly
-// allocated PlaneBase struct, preferring placement in s's segment.
-func (s Z) NewPlanebase() (PlaneBase, error) {
-	capnp.Struct(s).SetUint16(0, 32)
-	ss, err := NewPlaneBase(capnp.Struct(s).Segment())
-	if err != nil {
-		return PlaneBase{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func NewRootEcho_echo_Results(s *capnp.Segment) (Echo_echo_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Echo_echo_Results(st), err
 }
 
-func (s Z) Airport() Airport {
-	if capnp.Struct(s).Uint16(0) != 33 {
-		panic("Which() != airport")
-	}
-	return Airport(capnp.Struct(s).Uint16(8))
+func ReadRootEcho_echo_Results(msg *capnp.Message) (Echo_echo_Results, error) {
+	root, err := msg.Root()
+	return Echo_echo_Results(root.Struct()), err
 }
 
-func (s Z) SetAirport(v Airport) {
-	capnp.Struct(s).SetUint16(0, 33)
-	capnp.Struct(s).SetUint16(8, uint16(v))
+func (s Echo_echo_Results) String() string {
+	str, _ := text.Marshal(0x9b37d729b9dd7b9d, capnp.Struct(s))
+	return str
 }
 
-func (s Z) B737() (B737, error) {
-	if capnp.Struct(s).Uint16(0) != 34 {
-		panic("Which() != b737")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return B737(p.Struct()), err
+func (s Echo_echo_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Z) HasB737() bool {
-	if capnp.Struct(s).Uint16(0) != 34 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (Echo_echo_Results) DecodeFromPtr(p capnp.Ptr) Echo_echo_Results {
+	return Echo_echo_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z) SetB737(v B737) error {
-	capnp.Struct(s).SetUint16(0, 34)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Echo_echo_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Echo_echo_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// NewB737 sets the b737 field to a newly
-// allocated B737 struct, preferring placement in s's segment.
-func (s Z) NewB737() (B737, error) {
-	capnp.Struct(s).SetUint16(0, 34)
-	ss, err := NewB737(capnp.Struct(s).Segment())
-	if err != nil {
-		return B737{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Echo_echo_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Z) A320() (A320, error) {
-	if capnp.Struct(s).Uint16(0) != 35 {
-		panic("Which() != a320")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return A320(p.Struct()), err
+func (s Echo_echo_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Echo_echo_Results) Out() (string, error) {
+	return capnp.GetTextField(s, 0, "Echo.echo$Results.out")
 }
 
-func (s Z) HasA320() bool {
-	if capnp.Struct(s).Uint16(0) != 35 {
-		return false
-	}
+func (s Echo_echo_Results) HasOut() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z) SetA320(v A320) error {
-	capnp.Struct(s).SetUint16(0, 35)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Echo_echo_Results) OutBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Echo.echo$Results.out")
 }
 
-// NewA320 sets the a320 field to a newly
-// allocated A320 struct, preferring placement in s's segment.
-func (s Z) NewA320() (A320, error) {
-	capnp.Struct(s).SetUint16(0, 35)
-	ss, err := NewA320(capnp.Struct(s).Segment())
-	if err != nil {
-		return A320{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Echo_echo_Results) SetOut(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s Z) F16() (F16, error) {
-	if capnp.Struct(s).Uint16(0) != 36 {
-		panic("Which() != f16")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return F16(p.Struct()), err
-}
+// Echo_echo_Results_List is a list of Echo_echo_Results.
+type Echo_echo_Results_List = capnp.StructList[Echo_echo_Results]
 
-func (s Z) HasF16() bool {
-	if capnp.Struct(s).Uint16(0) != 36 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// NewEcho_echo_Results creates a new list of Echo_echo_Results.
+func NewEcho_echo_Results_List(s *capnp.Segment, sz int32) (Echo_echo_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Echo_echo_Results](l), err
 }
 
-func (s Z) SetF16(v F16) error {
-	capnp.Struct(s).SetUint16(0, 36)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
+// Echo_echo_Results_Future is a wrapper for a Echo_echo_Results promised by a client call.
+type Echo_echo_Results_Future struct{ *capnp.Future }
 
-// NewF16 sets the f16 field to a newly
-// allocated F16 struct, preferring placement in s's segment.
-func (s Z) NewF16() (F16, error) {
-	capnp.Struct(s).SetUint16(0, 36)
-	ss, err := NewF16(capnp.Struct(s).Segment())
-	if err != nil {
-		return F16{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (f Echo_echo_Results_Future) Struct() (Echo_echo_Results, error) {
+	p, err := f.Future.Ptr()
+	return Echo_echo_Results(p.Struct()), err
 }
 
-func (s Z) Zdatevec() (Zdate_List, error) {
-	if capnp.Struct(s).Uint16(0) != 37 {
-		panic("Which() != zdatevec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Zdate_List(p.List()), err
-}
+type F16 capnp.Struct
 
-func (s Z) HasZdatevec() bool {
-	if capnp.Struct(s).Uint16(0) != 37 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
-}
+// F16_TypeID is the unique identifier for the type F16.
+const F16_TypeID = 0xe1c9eac512335361
 
-func (s Z) SetZdatevec(v Zdate_List) error {
-	capnp.Struct(s).SetUint16(0, 37)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func NewF16(s *capnp.Segment) (F16, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return F16(st), err
 }
 
-// NewZdatevec sets the zdatevec field to a newly
-// allocated Zdate_List, preferring placement in s's segment.
-func (s Z) NewZdatevec(n int32) (Zdate_List, error) {
-	capnp.Struct(s).SetUint16(0, 37)
-	l, err := NewZdate_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Zdate_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s Z) Zdatavec() (Zdata_List, error) {
-	if capnp.Struct(s).Uint16(0) != 38 {
-		panic("Which() != zdatavec")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Zdata_List(p.List()), err
+func NewRootF16(s *capnp.Segment) (F16, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return F16(st), err
 }
 
-func (s Z) HasZdatavec() bool {
-	if capnp.Struct(s).Uint16(0) != 38 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func ReadRootF16(msg *capnp.Message) (F16, error) {
+	root, err := msg.Root()
+	return F16(root.Struct()), err
 }
 
-func (s Z) SetZdatavec(v Zdata_List) error {
-	capnp.Struct(s).SetUint16(0, 38)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s F16) String() string {
+	str, _ := text.Marshal(0xe1c9eac512335361, capnp.Struct(s))
+	return str
 }
 
-// NewZdatavec sets the zdatavec field to a newly
-// allocated Zdata_List, preferring placement in s's segment.
-func (s Z) NewZdatavec(n int32) (Zdata_List, error) {
-	capnp.Struct(s).SetUint16(0, 38)
-	l, err := NewZdata_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Zdata_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s F16) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
-func (s Z) Grp() Z_grp { return Z_grp(s) }
 
-func (s Z) SetGrp() {
-	capnp.Struct(s).SetUint16(0, 42)
+func (F16) DecodeFromPtr(p capnp.Ptr) F16 {
+	return F16(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z_grp) IsValid() bool {
+func (s F16) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s F16) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Z_grp) Message() *capnp.Message {
+func (s F16) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Z_grp) Segment() *capnp.Segment {
+func (s F16) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Z_grp) First() uint64 {
-	return capnp.Struct(s).Uint64(8)
+func (s F16) Base() (PlaneBase, error) {
+	return capnp.GetStructField[PlaneBase](s, 0, "F16.base")
 }
 
-func (s Z_grp) SetFirst(v uint64) {
-	capnp.Struct(s).SetUint64(8, v)
+func (s F16) HasBase() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z_grp) Second() uint64 {
-	return capnp.Struct(s).Uint64(16)
+func (s F16) SetBase(v PlaneBase) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Z_grp) SetSecond(v uint64) {
-	capnp.Struct(s).SetUint64(16, v)
+// NewBase sets the base field to a newly
+// allocated PlaneBase struct, preferring placement in s's segment.
+func (s F16) NewBase() (PlaneBase, error) {
+	return capnp.NewStructField(s, 0, NewPlaneBase)
 }
 
-func (s Z) Echo() Echo {
-	if capnp.Struct(s).Uint16(0) != 43 {
-		panic("Which() != echo")
-	}
-	p, _ := capnp.Struct(s).Ptr(0)
-	return Echo(p.Interface().Client())
-}
+// F16_List is a list of F16.
+type F16_List = capnp.StructList[F16]
 
-func (s Z) HasEcho() bool {
-	if capnp.Struct(s).Uint16(0) != 43 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// NewF16 creates a new list of F16.
+func NewF16_List(s *capnp.Segment, sz int32) (F16_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[F16](l), err
 }
 
-func (s Z) SetEcho(v Echo) error {
-	capnp.Struct(s).SetUint16(0, 43)
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
-}
+// F16_Future is a wrapper for a F16 promised by a client call.
+type F16_Future struct{ *capnp.Future }
 
-func (s Z) Echoes() (Echo_List, error) {
-	if capnp.Struct(s).Uint16(0) != 44 {
-		panic("Which() != echoes")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Echo_List(p.List()), err
+func (f F16_Future) Struct() (F16, error) {
+	p, err := f.Future.Ptr()
+	return F16(p.Struct()), err
 }
-
-func (s Z) HasEchoes() bool {
-	if capnp.Struct(s).Uint16(0) != 44 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (p F16_Future) Base() PlaneBase_Future {
+	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Z) SetEchoes(v Echo_List) error {
-	capnp.Struct(s).SetUint16(0, 44)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
-}
+type HoldsText capnp.Struct
 
-// NewEchoes sets the echoes field to a newly
-// allocated Echo_List, preferring placement in s's segment.
-func (s Z) NewEchoes(n int32) (Echo_List, error) {
-	capnp.Struct(s).SetUint16(0, 44)
-	l, err := NewEcho_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Echo_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// HoldsText_TypeID is the unique identifier for the type HoldsText.
+const HoldsText_TypeID = 0xe5817f849ff906dc
+
+func NewHoldsText(s *capnp.Segment) (HoldsText, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return HoldsText(st), err
 }
-func (s Z) AnyPtr() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(0) != 45 {
-		panic("Which() != anyPtr")
-	}
-	return capnp.Struct(s).Ptr(0)
+
+func NewRootHoldsText(s *capnp.Segment) (HoldsText, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return HoldsText(st), err
 }
 
-func (s Z) HasAnyPtr() bool {
-	if capnp.Struct(s).Uint16(0) != 45 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func ReadRootHoldsText(msg *capnp.Message) (HoldsText, error) {
+	root, err := msg.Root()
+	return HoldsText(root.Struct()), err
 }
 
-func (s Z) SetAnyPtr(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(0, 45)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s HoldsText) String() string {
+	str, _ := text.Marshal(0xe5817f849ff906dc, capnp.Struct(s))
+	return str
 }
-func (s Z) AnyStruct() (capnp.Struct, error) {
-	if capnp.Struct(s).Uint16(0) != 46 {
-		panic("Which() != anyStruct")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Struct(), err
+
+func (s HoldsText) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Z) HasAnyStruct() bool {
-	if capnp.Struct(s).Uint16(0) != 46 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (HoldsText) DecodeFromPtr(p capnp.Ptr) HoldsText {
+	return HoldsText(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Z) SetAnyStruct(v capnp.Struct) error {
-	capnp.Struct(s).SetUint16(0, 46)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s HoldsText) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-func (s Z) AnyList() (capnp.List, error) {
-	if capnp.Struct(s).Uint16(0) != 47 {
-		panic("Which() != anyList")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.List(), err
+func (s HoldsText) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Z) HasAnyList() bool {
-	if capnp.Struct(s).Uint16(0) != 47 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s HoldsText) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Z) SetAnyList(v capnp.List) error {
-	capnp.Struct(s).SetUint16(0, 47)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s HoldsText) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-func (s Z) AnyCapability() capnp.Client {
-	if capnp.Struct(s).Uint16(0) != 48 {
-		panic("Which() != anyCapability")
-	}
-	p, _ := capnp.Struct(s).Ptr(0)
-	return p.Interface().Client()
+func (s HoldsText) Txt() (string, error) {
+	return capnp.GetTextField(s, 0, "HoldsText.txt")
 }
 
-func (s Z) HasAnyCapability() bool {
-	if capnp.Struct(s).Uint16(0) != 48 {
-		return false
-	}
+func (s HoldsText) HasTxt() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Z) SetAnyCapability(c capnp.Client) error {
-	capnp.Struct(s).SetUint16(0, 48)
-	if !c.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+func (s HoldsText) TxtBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "HoldsText.txt")
 }
 
-// Z_List is a list of Z.
-type Z_List = capnp.StructList[Z]
-
-// NewZ creates a new list of Z.
-func NewZ_List(s *capnp.Segment, sz int32) (Z_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
-	return capnp.StructList[Z](l), err
+func (s HoldsText) SetTxt(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// Z_Future is a wrapper for a Z promised by a client call.
-type Z_Future struct{ *capnp.Future }
-
-func (f Z_Future) Struct() (Z, error) {
-	p, err := f.Future.Ptr()
-	return Z(p.Struct()), err
-}
-func (p Z_Future) Zz() Z_Future {
-	return Z_Future{Future: p.Future.Field(0, nil)}
+func (s HoldsText) Lst() (capnp.TextList, error) {
+	return capnp.GetListField[capnp.TextList](s, 1, "HoldsText.lst")
 }
-func (p Z_Future) Zdate() Zdate_Future {
-	return Zdate_Future{Future: p.Future.Field(0, nil)}
+
+func (s HoldsText) HasLst() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
-func (p Z_Future) Zdata() Zdata_Future {
-	return Zdata_Future{Future: p.Future.Field(0, nil)}
+
+func (s HoldsText) SetLst(v capnp.TextList) error {
+	return capnp.SetListField(s, 1, v)
 }
-func (p Z_Future) Aircraft() Aircraft_Future {
-	return Aircraft_Future{Future: p.Future.Field(0, nil)}
+
+// NewLst sets the lst field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s HoldsText) NewLst(n int32) (capnp.TextList, error) {
+	return capnp.NewListField(s, 1, n, capnp.NewTextList)
 }
-func (p Z_Future) Regression() Regression_Future {
-	return Regression_Future{Future: p.Future.Field(0, nil)}
+
+// SetLstFromSlice sets the lst field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s HoldsText) SetLstFromSlice(v []string) error {
+	return capnp.SetListFieldFromSlice(s, 1, v, capnp.NewTextListFromSlice)
 }
-func (p Z_Future) Planebase() PlaneBase_Future {
-	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
+func (s HoldsText) Lstlst() (capnp.PointerList, error) {
+	return capnp.GetListField[capnp.PointerList](s, 2, "HoldsText.lstlst")
 }
-func (p Z_Future) B737() B737_Future {
-	return B737_Future{Future: p.Future.Field(0, nil)}
+
+func (s HoldsText) HasLstlst() bool {
+	return capnp.Struct(s).HasPtr(2)
 }
-func (p Z_Future) A320() A320_Future {
-	return A320_Future{Future: p.Future.Field(0, nil)}
+
+func (s HoldsText) SetLstlst(v capnp.PointerList) error {
+	return capnp.SetListField(s, 2, v)
 }
-func (p Z_Future) F16() F16_Future {
-	return F16_Future{Future: p.Future.Field(0, nil)}
+
+// NewLstlst sets the lstlst field to a newly
+// allocated capnp.PointerList, preferring placement in s's segment.
+func (s HoldsText) NewLstlst(n int32) (capnp.PointerList, error) {
+	return capnp.NewListField(s, 2, n, capnp.NewPointerList)
 }
-func (p Z_Future) Grp() Z_grp_Future { return Z_grp_Future{p.Future} }
 
-// Z_grp_Future is a wrapper for a Z_grp promised by a client call.
-type Z_grp_Future struct{ *capnp.Future }
+// HoldsText_List is a list of HoldsText.
+type HoldsText_List = capnp.StructList[HoldsText]
 
-func (f Z_grp_Future) Struct() (Z_grp, error) {
-	p, err := f.Future.Ptr()
-	return Z_grp(p.Struct()), err
-}
-func (p Z_Future) Echo() Echo {
-	return Echo(p.Future.Field(0, nil).Client())
+// NewHoldsText creates a new list of HoldsText.
+func NewHoldsText_List(s *capnp.Segment, sz int32) (HoldsText_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[HoldsText](l), err
 }
 
-func (p Z_Future) AnyPtr() *capnp.Future {
-	return p.Future.Field(0, nil)
-}
-func (p Z_Future) AnyStruct() *capnp.Future {
-	return p.Future.Field(0, nil)
-}
-func (p Z_Future) AnyList() *capnp.Future {
-	return p.Future.Field(0, nil)
-}
-func (p Z_Future) AnyCapability() capnp.Client {
-	return p.Future.Field(0, nil).Client()
+// HoldsText_Future is a wrapper for a HoldsText promised by a client call.
+type HoldsText_Future struct{ *capnp.Future }
+
+func (f HoldsText_Future) Struct() (HoldsText, error) {
+	p, err := f.Future.Ptr()
+	return HoldsText(p.Struct()), err
 }
 
-type Counter capnp.Struct
+type HoldsVerEmptyList capnp.Struct
 
-// Counter_TypeID is the unique identifier for the type Counter.
-const Counter_TypeID = 0x8748bc095e10cb5d
+// HoldsVerEmptyList_TypeID is the unique identifier for the type HoldsVerEmptyList.
+const HoldsVerEmptyList_TypeID = 0xde9ed43cfaa83093
 
-func NewCounter(s *capnp.Segment) (Counter, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
-	return Counter(st), err
+func NewHoldsVerEmptyList(s *capnp.Segment) (HoldsVerEmptyList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerEmptyList(st), err
 }
 
-func NewRootCounter(s *capnp.Segment) (Counter, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
-	return Counter(st), err
+func NewRootHoldsVerEmptyList(s *capnp.Segment) (HoldsVerEmptyList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerEmptyList(st), err
 }
 
-func ReadRootCounter(msg *capnp.Message) (Counter, error) {
+func ReadRootHoldsVerEmptyList(msg *capnp.Message) (HoldsVerEmptyList, error) {
 	root, err := msg.Root()
-	return Counter(root.Struct()), err
+	return HoldsVerEmptyList(root.Struct()), err
 }
 
-func (s Counter) String() string {
-	str, _ := text.Marshal(0x8748bc095e10cb5d, capnp.Struct(s))
+func (s HoldsVerEmptyList) String() string {
+	str, _ := text.Marshal(0xde9ed43cfaa83093, capnp.Struct(s))
 	return str
 }
 
-func (s Counter) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerEmptyList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Counter) DecodeFromPtr(p capnp.Ptr) Counter {
-	return Counter(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerEmptyList) DecodeFromPtr(p capnp.Ptr) HoldsVerEmptyList {
+	return HoldsVerEmptyList(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Counter) ToPtr() capnp.Ptr {
+func (s HoldsVerEmptyList) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Counter) IsValid() bool {
+func (s HoldsVerEmptyList) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Counter) Message() *capnp.Message {
+func (s HoldsVerEmptyList) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Counter) Segment() *capnp.Segment {
+func (s HoldsVerEmptyList) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Counter) Size() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
-}
-
-func (s Counter) SetSize(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
-}
-
-func (s Counter) Words() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s HoldsVerEmptyList) Mylist() (VerEmpty_List, error) {
+	return capnp.GetListField[VerEmpty_List](s, 0, "HoldsVerEmptyList.mylist")
 }
 
-func (s Counter) HasWords() bool {
+func (s HoldsVerEmptyList) HasMylist() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Counter) WordsBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s HoldsVerEmptyList) SetMylist(v VerEmpty_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s Counter) SetWords(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// NewMylist sets the mylist field to a newly
+// allocated VerEmpty_List, preferring placement in s's segment.
+func (s HoldsVerEmptyList) NewMylist(n int32) (VerEmpty_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerEmpty_List)
 }
 
-func (s Counter) Wordlist() (capnp.TextList, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return capnp.TextList(p.List()), err
-}
-
-func (s Counter) HasWordlist() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
-
-func (s Counter) SetWordlist(v capnp.TextList) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
-}
-
-// NewWordlist sets the wordlist field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Counter) NewWordlist(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-func (s Counter) Bitlist() (capnp.BitList, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return capnp.BitList(p.List()), err
-}
-
-func (s Counter) HasBitlist() bool {
-	return capnp.Struct(s).HasPtr(2)
-}
-
-func (s Counter) SetBitlist(v capnp.BitList) error {
-	return capnp.Struct(s).SetPtr(2, v.ToPtr())
-}
-
-// NewBitlist sets the bitlist field to a newly
-// allocated capnp.BitList, preferring placement in s's segment.
-func (s Counter) NewBitlist(n int32) (capnp.BitList, error) {
-	l, err := capnp.NewBitList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.BitList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
-	return l, err
-}
-
-// Counter_List is a list of Counter.
-type Counter_List = capnp.StructList[Counter]
+// HoldsVerEmptyList_List is a list of HoldsVerEmptyList.
+type HoldsVerEmptyList_List = capnp.StructList[HoldsVerEmptyList]
 
-// NewCounter creates a new list of Counter.
-func NewCounter_List(s *capnp.Segment, sz int32) (Counter_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
-	return capnp.StructList[Counter](l), err
+// NewHoldsVerEmptyList creates a new list of HoldsVerEmptyList.
+func NewHoldsVerEmptyList_List(s *capnp.Segment, sz int32) (HoldsVerEmptyList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[HoldsVerEmptyList](l), err
 }
 
-// Counter_Future is a wrapper for a Counter promised by a client call.
-type Counter_Future struct{ *capnp.Future }
+// HoldsVerEmptyList_Future is a wrapper for a HoldsVerEmptyList promised by a client call.
+type HoldsVerEmptyList_Future struct{ *capnp.Future }
 
-func (f Counter_Future) Struct() (Counter, error) {
+func (f HoldsVerEmptyList_Future) Struct() (HoldsVerEmptyList, error) {
 	p, err := f.Future.Ptr()
-	return Counter(p.Struct()), err
+	return HoldsVerEmptyList(p.Struct()), err
 }
 
-type Bag capnp.Struct
+type HoldsVerOneDataList capnp.Struct
 
-// Bag_TypeID is the unique identifier for the type Bag.
-const Bag_TypeID = 0xd636fba4f188dabe
+// HoldsVerOneDataList_TypeID is the unique identifier for the type HoldsVerOneDataList.
+const HoldsVerOneDataList_TypeID = 0xabd055422a4d7df1
 
-func NewBag(s *capnp.Segment) (Bag, error) {
+func NewHoldsVerOneDataList(s *capnp.Segment) (HoldsVerOneDataList, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Bag(st), err
+	return HoldsVerOneDataList(st), err
 }
 
-func NewRootBag(s *capnp.Segment) (Bag, error) {
+func NewRootHoldsVerOneDataList(s *capnp.Segment) (HoldsVerOneDataList, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Bag(st), err
+	return HoldsVerOneDataList(st), err
 }
 
-func ReadRootBag(msg *capnp.Message) (Bag, error) {
+func ReadRootHoldsVerOneDataList(msg *capnp.Message) (HoldsVerOneDataList, error) {
 	root, err := msg.Root()
-	return Bag(root.Struct()), err
+	return HoldsVerOneDataList(root.Struct()), err
 }
 
-func (s Bag) String() string {
-	str, _ := text.Marshal(0xd636fba4f188dabe, capnp.Struct(s))
+func (s HoldsVerOneDataList) String() string {
+	str, _ := text.Marshal(0xabd055422a4d7df1, capnp.Struct(s))
 	return str
 }
 
-func (s Bag) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerOneDataList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Bag) DecodeFromPtr(p capnp.Ptr) Bag {
-	return Bag(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerOneDataList) DecodeFromPtr(p capnp.Ptr) HoldsVerOneDataList {
+	return HoldsVerOneDataList(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Bag) ToPtr() capnp.Ptr {
+func (s HoldsVerOneDataList) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Bag) IsValid() bool {
+func (s HoldsVerOneDataList) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Bag) Message() *capnp.Message {
+func (s HoldsVerOneDataList) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Bag) Segment() *capnp.Segment {
+func (s HoldsVerOneDataList) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Bag) Counter() (Counter, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Counter(p.Struct()), err
+func (s HoldsVerOneDataList) Mylist() (VerOneData_List, error) {
+	return capnp.GetListField[VerOneData_List](s, 0, "HoldsVerOneDataList.mylist")
 }
 
-func (s Bag) HasCounter() bool {
+func (s HoldsVerOneDataList) HasMylist() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Bag) SetCounter(v Counter) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s HoldsVerOneDataList) SetMylist(v VerOneData_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-// NewCounter sets the counter field to a newly
-// allocated Counter struct, preferring placement in s's segment.
-func (s Bag) NewCounter() (Counter, error) {
-	ss, err := NewCounter(capnp.Struct(s).Segment())
-	if err != nil {
-		return Counter{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewMylist sets the mylist field to a newly
+// allocated VerOneData_List, preferring placement in s's segment.
+func (s HoldsVerOneDataList) NewMylist(n int32) (VerOneData_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerOneData_List)
 }
 
-// Bag_List is a list of Bag.
-type Bag_List = capnp.StructList[Bag]
+// HoldsVerOneDataList_List is a list of HoldsVerOneDataList.
+type HoldsVerOneDataList_List = capnp.StructList[HoldsVerOneDataList]
 
-// NewBag creates a new list of Bag.
-func NewBag_List(s *capnp.Segment, sz int32) (Bag_List, error) {
+// NewHoldsVerOneDataList creates a new list of HoldsVerOneDataList.
+func NewHoldsVerOneDataList_List(s *capnp.Segment, sz int32) (HoldsVerOneDataList_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Bag](l), err
+	return capnp.StructList[HoldsVerOneDataList](l), err
 }
 
-// Bag_Future is a wrapper for a Bag promised by a client call.
-type Bag_Future struct{ *capnp.Future }
+// HoldsVerOneDataList_Future is a wrapper for a HoldsVerOneDataList promised by a client call.
+type HoldsVerOneDataList_Future struct{ *capnp.Future }
 
-func (f Bag_Future) Struct() (Bag, error) {
+func (f HoldsVerOneDataList_Future) Struct() (HoldsVerOneDataList, error) {
 	p, err := f.Future.Ptr()
-	return Bag(p.Struct()), err
-}
-func (p Bag_Future) Counter() Counter_Future {
-	return Counter_Future{Future: p.Future.Field(0, nil)}
+	return HoldsVerOneDataList(p.Struct()), err
 }
 
-type Zserver capnp.Struct
+type HoldsVerOnePtrList capnp.Struct
 
-// Zserver_TypeID is the unique identifier for the type Zserver.
-const Zserver_TypeID = 0xcc4411e60ba9c498
+// HoldsVerOnePtrList_TypeID is the unique identifier for the type HoldsVerOnePtrList.
+const HoldsVerOnePtrList_TypeID = 0xe508a29c83a059f8
 
-func NewZserver(s *capnp.Segment) (Zserver, error) {
+func NewHoldsVerOnePtrList(s *capnp.Segment) (HoldsVerOnePtrList, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Zserver(st), err
+	return HoldsVerOnePtrList(st), err
 }
 
-func NewRootZserver(s *capnp.Segment) (Zserver, error) {
+func NewRootHoldsVerOnePtrList(s *capnp.Segment) (HoldsVerOnePtrList, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Zserver(st), err
+	return HoldsVerOnePtrList(st), err
 }
 
-func ReadRootZserver(msg *capnp.Message) (Zserver, error) {
+func ReadRootHoldsVerOnePtrList(msg *capnp.Message) (HoldsVerOnePtrList, error) {
 	root, err := msg.Root()
-	return Zserver(root.Struct()), err
+	return HoldsVerOnePtrList(root.Struct()), err
 }
 
-func (s Zserver) String() string {
-	str, _ := text.Marshal(0xcc4411e60ba9c498, capnp.Struct(s))
+func (s HoldsVerOnePtrList) String() string {
+	str, _ := text.Marshal(0xe508a29c83a059f8, capnp.Struct(s))
 	return str
 }
 
-func (s Zserver) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerOnePtrList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Zserver) DecodeFromPtr(p capnp.Ptr) Zserver {
-	return Zserver(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerOnePtrList) DecodeFromPtr(p capnp.Ptr) HoldsVerOnePtrList {
+	return HoldsVerOnePtrList(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Zserver) ToPtr() capnp.Ptr {
+func (s HoldsVerOnePtrList) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Zserver) IsValid() bool {
+func (s HoldsVerOnePtrList) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Zserver) Message() *capnp.Message {
+func (s HoldsVerOnePtrList) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Zserver) Segment() *capnp.Segment {
+func (s HoldsVerOnePtrList) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Zserver) Waitingjobs() (Zjob_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Zjob_List(p.List()), err
+func (s HoldsVerOnePtrList) Mylist() (VerOnePtr_List, error) {
+	return capnp.GetListField[VerOnePtr_List](s, 0, "HoldsVerOnePtrList.mylist")
 }
 
-func (s Zserver) HasWaitingjobs() bool {
+func (s HoldsVerOnePtrList) HasMylist() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Zserver) SetWaitingjobs(v Zjob_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s HoldsVerOnePtrList) SetMylist(v VerOnePtr_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-// NewWaitingjobs sets the waitingjobs field to a newly
-// allocated Zjob_List, preferring placement in s's segment.
-func (s Zserver) NewWaitingjobs(n int32) (Zjob_List, error) {
-	l, err := NewZjob_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Zjob_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// NewMylist sets the mylist field to a newly
+// allocated VerOnePtr_List, preferring placement in s's segment.
+func (s HoldsVerOnePtrList) NewMylist(n int32) (VerOnePtr_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerOnePtr_List)
 }
 
-// Zserver_List is a list of Zserver.
-type Zserver_List = capnp.StructList[Zserver]
+// HoldsVerOnePtrList_List is a list of HoldsVerOnePtrList.
+type HoldsVerOnePtrList_List = capnp.StructList[HoldsVerOnePtrList]
 
-// NewZserver creates a new list of Zserver.
-func NewZserver_List(s *capnp.Segment, sz int32) (Zserver_List, error) {
+// NewHoldsVerOnePtrList creates a new list of HoldsVerOnePtrList.
+func NewHoldsVerOnePtrList_List(s *capnp.Segment, sz int32) (HoldsVerOnePtrList_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Zserver](l), err
+	return capnp.StructList[HoldsVerOnePtrList](l), err
 }
 
-// Zserver_Future is a wrapper for a Zserver promised by a client call.
-type Zserver_Future struct{ *capnp.Future }
+// HoldsVerOnePtrList_Future is a wrapper for a HoldsVerOnePtrList promised by a client call.
+type HoldsVerOnePtrList_Future struct{ *capnp.Future }
 
-func (f Zserver_Future) Struct() (Zserver, error) {
+func (f HoldsVerOnePtrList_Future) Struct() (HoldsVerOnePtrList, error) {
 	p, err := f.Future.Ptr()
-	return Zserver(p.Struct()), err
+	return HoldsVerOnePtrList(p.Struct()), err
 }
 
-type Zjob capnp.Struct
+type HoldsVerTwoDataList capnp.Struct
 
-// Zjob_TypeID is the unique identifier for the type Zjob.
-const Zjob_TypeID = 0xddd1416669fb7613
+// HoldsVerTwoDataList_TypeID is the unique identifier for the type HoldsVerTwoDataList.
+const HoldsVerTwoDataList_TypeID = 0xcbdc765fd5dff7ba
 
-func NewZjob(s *capnp.Segment) (Zjob, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Zjob(st), err
+func NewHoldsVerTwoDataList(s *capnp.Segment) (HoldsVerTwoDataList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoDataList(st), err
 }
 
-func NewRootZjob(s *capnp.Segment) (Zjob, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Zjob(st), err
+func NewRootHoldsVerTwoDataList(s *capnp.Segment) (HoldsVerTwoDataList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoDataList(st), err
 }
 
-func ReadRootZjob(msg *capnp.Message) (Zjob, error) {
+func ReadRootHoldsVerTwoDataList(msg *capnp.Message) (HoldsVerTwoDataList, error) {
 	root, err := msg.Root()
-	return Zjob(root.Struct()), err
+	return HoldsVerTwoDataList(root.Struct()), err
 }
 
-func (s Zjob) String() string {
-	str, _ := text.Marshal(0xddd1416669fb7613, capnp.Struct(s))
+func (s HoldsVerTwoDataList) String() string {
+	str, _ := text.Marshal(0xcbdc765fd5dff7ba, capnp.Struct(s))
 	return str
 }
 
-func (s Zjob) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerTwoDataList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Zjob) DecodeFromPtr(p capnp.Ptr) Zjob {
-	return Zjob(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerTwoDataList) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoDataList {
+	return HoldsVerTwoDataList(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Zjob) ToPtr() capnp.Ptr {
+func (s HoldsVerTwoDataList) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Zjob) IsValid() bool {
+func (s HoldsVerTwoDataList) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Zjob) Message() *capnp.Message {
+func (s HoldsVerTwoDataList) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Zjob) Segment() *capnp.Segment {
+func (s HoldsVerTwoDataList) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Zjob) Cmd() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s HoldsVerTwoDataList) Mylist() (VerTwoData_List, error) {
+	return capnp.GetListField[VerTwoData_List](s, 0, "HoldsVerTwoDataList.mylist")
 }
 
-func (s Zjob) HasCmd() bool {
+func (s HoldsVerTwoDataList) HasMylist() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Zjob) CmdBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s HoldsVerTwoDataList) SetMylist(v VerTwoData_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s Zjob) SetCmd(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// NewMylist sets the mylist field to a newly
+// allocated VerTwoData_List, preferring placement in s's segment.
+func (s HoldsVerTwoDataList) NewMylist(n int32) (VerTwoData_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerTwoData_List)
 }
 
-func (s Zjob) Args() (capnp.TextList, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return capnp.TextList(p.List()), err
-}
+// HoldsVerTwoDataList_List is a list of HoldsVerTwoDataList.
+type HoldsVerTwoDataList_List = capnp.StructList[HoldsVerTwoDataList]
 
-func (s Zjob) HasArgs() bool {
-	return capnp.Struct(s).HasPtr(1)
+// NewHoldsVerTwoDataList creates a new list of HoldsVerTwoDataList.
+func NewHoldsVerTwoDataList_List(s *capnp.Segment, sz int32) (HoldsVerTwoDataList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[HoldsVerTwoDataList](l), err
 }
 
-func (s Zjob) SetArgs(v capnp.TextList) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
-}
+// HoldsVerTwoDataList_Future is a wrapper for a HoldsVerTwoDataList promised by a client call.
+type HoldsVerTwoDataList_Future struct{ *capnp.Future }
 
-// NewArgs sets the args field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Zjob) NewArgs(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-
-// Zjob_List is a list of Zjob.
-type Zjob_List = capnp.StructList[Zjob]
-
-// NewZjob creates a new list of Zjob.
-func NewZjob_List(s *capnp.Segment, sz int32) (Zjob_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[Zjob](l), err
-}
-
-// Zjob_Future is a wrapper for a Zjob promised by a client call.
-type Zjob_Future struct{ *capnp.Future }
-
-func (f Zjob_Future) Struct() (Zjob, error) {
+func (f HoldsVerTwoDataList_Future) Struct() (HoldsVerTwoDataList, error) {
 	p, err := f.Future.Ptr()
-	return Zjob(p.Struct()), err
+	return HoldsVerTwoDataList(p.Struct()), err
 }
 
-type VerEmpty capnp.Struct
+type HoldsVerTwoPtrList capnp.Struct
 
-// VerEmpty_TypeID is the unique identifier for the type VerEmpty.
-const VerEmpty_TypeID = 0x93c99951eacc72ff
+// HoldsVerTwoPtrList_TypeID is the unique identifier for the type HoldsVerTwoPtrList.
+const HoldsVerTwoPtrList_TypeID = 0xcf9beaca1cc180c8
 
-func NewVerEmpty(s *capnp.Segment) (VerEmpty, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return VerEmpty(st), err
+func NewHoldsVerTwoPtrList(s *capnp.Segment) (HoldsVerTwoPtrList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoPtrList(st), err
 }
 
-func NewRootVerEmpty(s *capnp.Segment) (VerEmpty, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return VerEmpty(st), err
+func NewRootHoldsVerTwoPtrList(s *capnp.Segment) (HoldsVerTwoPtrList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoPtrList(st), err
 }
 
-func ReadRootVerEmpty(msg *capnp.Message) (VerEmpty, error) {
+func ReadRootHoldsVerTwoPtrList(msg *capnp.Message) (HoldsVerTwoPtrList, error) {
 	root, err := msg.Root()
-	return VerEmpty(root.Struct()), err
+	return HoldsVerTwoPtrList(root.Struct()), err
 }
 
-func (s VerEmpty) String() string {
-	str, _ := text.Marshal(0x93c99951eacc72ff, capnp.Struct(s))
+func (s HoldsVerTwoPtrList) String() string {
+	str, _ := text.Marshal(0xcf9beaca1cc180c8, capnp.Struct(s))
 	return str
 }
 
-func (s VerEmpty) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerTwoPtrList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VerEmpty) DecodeFromPtr(p capnp.Ptr) VerEmpty {
-	return VerEmpty(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerTwoPtrList) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoPtrList {
+	return HoldsVerTwoPtrList(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerEmpty) ToPtr() capnp.Ptr {
+func (s HoldsVerTwoPtrList) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VerEmpty) IsValid() bool {
+func (s HoldsVerTwoPtrList) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VerEmpty) Message() *capnp.Message {
+func (s HoldsVerTwoPtrList) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VerEmpty) Segment() *capnp.Segment {
+func (s HoldsVerTwoPtrList) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s HoldsVerTwoPtrList) Mylist() (VerTwoPtr_List, error) {
+	return capnp.GetListField[VerTwoPtr_List](s, 0, "HoldsVerTwoPtrList.mylist")
+}
 
-// VerEmpty_List is a list of VerEmpty.
-type VerEmpty_List = capnp.StructList[VerEmpty]
+func (s HoldsVerTwoPtrList) HasMylist() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewVerEmpty creates a new list of VerEmpty.
-func NewVerEmpty_List(s *capnp.Segment, sz int32) (VerEmpty_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[VerEmpty](l), err
+func (s HoldsVerTwoPtrList) SetMylist(v VerTwoPtr_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-// VerEmpty_Future is a wrapper for a VerEmpty promised by a client call.
-type VerEmpty_Future struct{ *capnp.Future }
+// NewMylist sets the mylist field to a newly
+// allocated VerTwoPtr_List, preferring placement in s's segment.
+func (s HoldsVerTwoPtrList) NewMylist(n int32) (VerTwoPtr_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerTwoPtr_List)
+}
 
-func (f VerEmpty_Future) Struct() (VerEmpty, error) {
+// HoldsVerTwoPtrList_List is a list of HoldsVerTwoPtrList.
+type HoldsVerTwoPtrList_List = capnp.StructList[HoldsVerTwoPtrList]
+
+// NewHoldsVerTwoPtrList creates a new list of HoldsVerTwoPtrList.
+func NewHoldsVerTwoPtrList_List(s *capnp.Segment, sz int32) (HoldsVerTwoPtrList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[HoldsVerTwoPtrList](l), err
+}
+
+// HoldsVerTwoPtrList_Future is a wrapper for a HoldsVerTwoPtrList promised by a client call.
+type HoldsVerTwoPtrList_Future struct{ *capnp.Future }
+
+func (f HoldsVerTwoPtrList_Future) Struct() (HoldsVerTwoPtrList, error) {
 	p, err := f.Future.Ptr()
-	return VerEmpty(p.Struct()), err
+	return HoldsVerTwoPtrList(p.Struct()), err
 }
 
-type VerOneData capnp.Struct
+type HoldsVerTwoTwoList capnp.Struct
 
-// VerOneData_TypeID is the unique identifier for the type VerOneData.
-const VerOneData_TypeID = 0xfca3742893be4cde
+// HoldsVerTwoTwoList_TypeID is the unique identifier for the type HoldsVerTwoTwoList.
+const HoldsVerTwoTwoList_TypeID = 0x95befe3f14606e6b
 
-func NewVerOneData(s *capnp.Segment) (VerOneData, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return VerOneData(st), err
+func NewHoldsVerTwoTwoList(s *capnp.Segment) (HoldsVerTwoTwoList, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoTwoList(st), err
 }
 
-func NewRootVerOneData(s *capnp.Segment) (VerOneData, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return VerOneData(st), err
+func NewRootHoldsVerTwoTwoList(s *capnp.Segment) (HoldsVerTwoTwoList, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoTwoList(st), err
 }
 
-func ReadRootVerOneData(msg *capnp.Message) (VerOneData, error) {
+func ReadRootHoldsVerTwoTwoList(msg *capnp.Message) (HoldsVerTwoTwoList, error) {
 	root, err := msg.Root()
-	return VerOneData(root.Struct()), err
+	return HoldsVerTwoTwoList(root.Struct()), err
 }
 
-func (s VerOneData) String() string {
-	str, _ := text.Marshal(0xfca3742893be4cde, capnp.Struct(s))
+func (s HoldsVerTwoTwoList) String() string {
+	str, _ := text.Marshal(0x95befe3f14606e6b, capnp.Struct(s))
 	return str
 }
 
-func (s VerOneData) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerTwoTwoList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VerOneData) DecodeFromPtr(p capnp.Ptr) VerOneData {
-	return VerOneData(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerTwoTwoList) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoTwoList {
+	return HoldsVerTwoTwoList(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerOneData) ToPtr() capnp.Ptr {
+func (s HoldsVerTwoTwoList) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VerOneData) IsValid() bool {
+func (s HoldsVerTwoTwoList) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VerOneData) Message() *capnp.Message {
+func (s HoldsVerTwoTwoList) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VerOneData) Segment() *capnp.Segment {
+func (s HoldsVerTwoTwoList) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VerOneData) Val() int16 {
-	return int16(capnp.Struct(s).Uint16(0))
+func (s HoldsVerTwoTwoList) Mylist() (VerTwoDataTwoPtr_List, error) {
+	return capnp.GetListField[VerTwoDataTwoPtr_List](s, 0, "HoldsVerTwoTwoList.mylist")
 }
 
-func (s VerOneData) SetVal(v int16) {
-	capnp.Struct(s).SetUint16(0, uint16(v))
+func (s HoldsVerTwoTwoList) HasMylist() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// VerOneData_List is a list of VerOneData.
-type VerOneData_List = capnp.StructList[VerOneData]
+func (s HoldsVerTwoTwoList) SetMylist(v VerTwoDataTwoPtr_List) error {
+	return capnp.SetListField(s, 0, v)
+}
 
-// NewVerOneData creates a new list of VerOneData.
-func NewVerOneData_List(s *capnp.Segment, sz int32) (VerOneData_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[VerOneData](l), err
+// NewMylist sets the mylist field to a newly
+// allocated VerTwoDataTwoPtr_List, preferring placement in s's segment.
+func (s HoldsVerTwoTwoList) NewMylist(n int32) (VerTwoDataTwoPtr_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerTwoDataTwoPtr_List)
 }
 
-// VerOneData_Future is a wrapper for a VerOneData promised by a client call.
-type VerOneData_Future struct{ *capnp.Future }
+// HoldsVerTwoTwoList_List is a list of HoldsVerTwoTwoList.
+type HoldsVerTwoTwoList_List = capnp.StructList[HoldsVerTwoTwoList]
 
-func (f VerOneData_Future) Struct() (VerOneData, error) {
+// NewHoldsVerTwoTwoList creates a new list of HoldsVerTwoTwoList.
+func NewHoldsVerTwoTwoList_List(s *capnp.Segment, sz int32) (HoldsVerTwoTwoList_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[HoldsVerTwoTwoList](l), err
+}
+
+// HoldsVerTwoTwoList_Future is a wrapper for a HoldsVerTwoTwoList promised by a client call.
+type HoldsVerTwoTwoList_Future struct{ *capnp.Future }
+
+func (f HoldsVerTwoTwoList_Future) Struct() (HoldsVerTwoTwoList, error) {
 	p, err := f.Future.Ptr()
-	return VerOneData(p.Struct()), err
+	return HoldsVerTwoTwoList(p.Struct()), err
 }
 
-type VerTwoData capnp.Struct
+type HoldsVerTwoTwoPlus capnp.Struct
 
-// VerTwoData_TypeID is the unique identifier for the type VerTwoData.
-const VerTwoData_TypeID = 0xf705dc45c94766fd
+// HoldsVerTwoTwoPlus_TypeID is the unique identifier for the type HoldsVerTwoTwoPlus.
+const HoldsVerTwoTwoPlus_TypeID = 0x87c33f2330feb3d8
 
-func NewVerTwoData(s *capnp.Segment) (VerTwoData, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
-	return VerTwoData(st), err
+func NewHoldsVerTwoTwoPlus(s *capnp.Segment) (HoldsVerTwoTwoPlus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoTwoPlus(st), err
 }
 
-func NewRootVerTwoData(s *capnp.Segment) (VerTwoData, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
-	return VerTwoData(st), err
+func NewRootHoldsVerTwoTwoPlus(s *capnp.Segment) (HoldsVerTwoTwoPlus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return HoldsVerTwoTwoPlus(st), err
 }
 
-func ReadRootVerTwoData(msg *capnp.Message) (VerTwoData, error) {
+func ReadRootHoldsVerTwoTwoPlus(msg *capnp.Message) (HoldsVerTwoTwoPlus, error) {
 	root, err := msg.Root()
-	return VerTwoData(root.Struct()), err
+	return HoldsVerTwoTwoPlus(root.Struct()), err
 }
 
-func (s VerTwoData) String() string {
-	str, _ := text.Marshal(0xf705dc45c94766fd, capnp.Struct(s))
+func (s HoldsVerTwoTwoPlus) String() string {
+	str, _ := text.Marshal(0x87c33f2330feb3d8, capnp.Struct(s))
 	return str
 }
 
-func (s VerTwoData) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s HoldsVerTwoTwoPlus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VerTwoData) DecodeFromPtr(p capnp.Ptr) VerTwoData {
-	return VerTwoData(capnp.Struct{}.DecodeFromPtr(p))
+func (HoldsVerTwoTwoPlus) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoTwoPlus {
+	return HoldsVerTwoTwoPlus(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerTwoData) ToPtr() capnp.Ptr {
+func (s HoldsVerTwoTwoPlus) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VerTwoData) IsValid() bool {
+func (s HoldsVerTwoTwoPlus) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VerTwoData) Message() *capnp.Message {
+func (s HoldsVerTwoTwoPlus) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VerTwoData) Segment() *capnp.Segment {
+func (s HoldsVerTwoTwoPlus) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VerTwoData) Val() int16 {
-	return int16(capnp.Struct(s).Uint16(0))
+func (s HoldsVerTwoTwoPlus) Mylist() (VerTwoTwoPlus_List, error) {
+	return capnp.GetListField[VerTwoTwoPlus_List](s, 0, "HoldsVerTwoTwoPlus.mylist")
 }
 
-func (s VerTwoData) SetVal(v int16) {
-	capnp.Struct(s).SetUint16(0, uint16(v))
+func (s HoldsVerTwoTwoPlus) HasMylist() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s VerTwoData) Duo() int64 {
-	return int64(capnp.Struct(s).Uint64(8))
+func (s HoldsVerTwoTwoPlus) SetMylist(v VerTwoTwoPlus_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s VerTwoData) SetDuo(v int64) {
-	capnp.Struct(s).SetUint64(8, uint64(v))
+// NewMylist sets the mylist field to a newly
+// allocated VerTwoTwoPlus_List, preferring placement in s's segment.
+func (s HoldsVerTwoTwoPlus) NewMylist(n int32) (VerTwoTwoPlus_List, error) {
+	return capnp.NewListField(s, 0, n, NewVerTwoTwoPlus_List)
 }
 
-// VerTwoData_List is a list of VerTwoData.
-type VerTwoData_List = capnp.StructList[VerTwoData]
+// HoldsVerTwoTwoPlus_List is a list of HoldsVerTwoTwoPlus.
+type HoldsVerTwoTwoPlus_List = capnp.StructList[HoldsVerTwoTwoPlus]
 
-// NewVerTwoData creates a new list of VerTwoData.
-func NewVerTwoData_List(s *capnp.Segment, sz int32) (VerTwoData_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
-	return capnp.StructList[VerTwoData](l), err
+// NewHoldsVerTwoTwoPlus creates a new list of HoldsVerTwoTwoPlus.
+func NewHoldsVerTwoTwoPlus_List(s *capnp.Segment, sz int32) (HoldsVerTwoTwoPlus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[HoldsVerTwoTwoPlus](l), err
 }
 
-// VerTwoData_Future is a wrapper for a VerTwoData promised by a client call.
-type VerTwoData_Future struct{ *capnp.Future }
+// HoldsVerTwoTwoPlus_Future is a wrapper for a HoldsVerTwoTwoPlus promised by a client call.
+type HoldsVerTwoTwoPlus_Future struct{ *capnp.Future }
 
-func (f VerTwoData_Future) Struct() (VerTwoData, error) {
+func (f HoldsVerTwoTwoPlus_Future) Struct() (HoldsVerTwoTwoPlus, error) {
 	p, err := f.Future.Ptr()
-	return VerTwoData(p.Struct()), err
+	return HoldsVerTwoTwoPlus(p.Struct()), err
 }
 
-type VerOnePtr capnp.Struct
+type Hoth capnp.Struct
 
-// VerOnePtr_TypeID is the unique identifier for the type VerOnePtr.
-const VerOnePtr_TypeID = 0x94bf7df83408218d
+// Hoth_TypeID is the unique identifier for the type Hoth.
+const Hoth_TypeID = 0xad87da456fb0ebb9
 
-func NewVerOnePtr(s *capnp.Segment) (VerOnePtr, error) {
+func NewHoth(s *capnp.Segment) (Hoth, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return VerOnePtr(st), err
+	return Hoth(st), err
 }
 
-func NewRootVerOnePtr(s *capnp.Segment) (VerOnePtr, error) {
+func NewRootHoth(s *capnp.Segment) (Hoth, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return VerOnePtr(st), err
+	return Hoth(st), err
 }
 
-func ReadRootVerOnePtr(msg *capnp.Message) (VerOnePtr, error) {
+func ReadRootHoth(msg *capnp.Message) (Hoth, error) {
 	root, err := msg.Root()
-	return VerOnePtr(root.Struct()), err
+	return Hoth(root.Struct()), err
 }
 
-func (s VerOnePtr) String() string {
-	str, _ := text.Marshal(0x94bf7df83408218d, capnp.Struct(s))
+func (s Hoth) String() string {
+	str, _ := text.Marshal(0xad87da456fb0ebb9, capnp.Struct(s))
 	return str
 }
 
-func (s VerOnePtr) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Hoth) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VerOnePtr) DecodeFromPtr(p capnp.Ptr) VerOnePtr {
-	return VerOnePtr(capnp.Struct{}.DecodeFromPtr(p))
+func (Hoth) DecodeFromPtr(p capnp.Ptr) Hoth {
+	return Hoth(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerOnePtr) ToPtr() capnp.Ptr {
+func (s Hoth) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VerOnePtr) IsValid() bool {
+func (s Hoth) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VerOnePtr) Message() *capnp.Message {
+func (s Hoth) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VerOnePtr) Segment() *capnp.Segment {
+func (s Hoth) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VerOnePtr) Ptr() (VerOneData, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerOneData(p.Struct()), err
+func (s Hoth) Base() (EchoBase, error) {
+	return capnp.GetStructField[EchoBase](s, 0, "Hoth.base")
 }
 
-func (s VerOnePtr) HasPtr() bool {
+func (s Hoth) HasBase() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s VerOnePtr) SetPtr(v VerOneData) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Hoth) SetBase(v EchoBase) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewPtr sets the ptr field to a newly
-// allocated VerOneData struct, preferring placement in s's segment.
-func (s VerOnePtr) NewPtr() (VerOneData, error) {
-	ss, err := NewVerOneData(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerOneData{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewBase sets the base field to a newly
+// allocated EchoBase struct, preferring placement in s's segment.
+func (s Hoth) NewBase() (EchoBase, error) {
+	return capnp.NewStructField(s, 0, NewEchoBase)
 }
 
-// VerOnePtr_List is a list of VerOnePtr.
-type VerOnePtr_List = capnp.StructList[VerOnePtr]
+// Hoth_List is a list of Hoth.
+type Hoth_List = capnp.StructList[Hoth]
 
-// NewVerOnePtr creates a new list of VerOnePtr.
-func NewVerOnePtr_List(s *capnp.Segment, sz int32) (VerOnePtr_List, error) {
+// NewHoth creates a new list of Hoth.
+func NewHoth_List(s *capnp.Segment, sz int32) (Hoth_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[VerOnePtr](l), err
+	return capnp.StructList[Hoth](l), err
 }
 
-// VerOnePtr_Future is a wrapper for a VerOnePtr promised by a client call.
-type VerOnePtr_Future struct{ *capnp.Future }
+// Hoth_Future is a wrapper for a Hoth promised by a client call.
+type Hoth_Future struct{ *capnp.Future }
 
-func (f VerOnePtr_Future) Struct() (VerOnePtr, error) {
+func (f Hoth_Future) Struct() (Hoth, error) {
 	p, err := f.Future.Ptr()
-	return VerOnePtr(p.Struct()), err
+	return Hoth(p.Struct()), err
 }
-func (p VerOnePtr_Future) Ptr() VerOneData_Future {
-	return VerOneData_Future{Future: p.Future.Field(0, nil)}
+func (p Hoth_Future) Base() EchoBase_Future {
+	return EchoBase_Future{Future: p.Future.Field(0, nil)}
 }
 
-type VerTwoPtr capnp.Struct
+type ListStructCapn capnp.Struct
 
-// VerTwoPtr_TypeID is the unique identifier for the type VerTwoPtr.
-const VerTwoPtr_TypeID = 0xc95babe3bd394d2d
+// ListStructCapn_TypeID is the unique identifier for the type ListStructCapn.
+const ListStructCapn_TypeID = 0xb1ac056ed7647011
 
-func NewVerTwoPtr(s *capnp.Segment) (VerTwoPtr, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return VerTwoPtr(st), err
+func NewListStructCapn(s *capnp.Segment) (ListStructCapn, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ListStructCapn(st), err
 }
 
-func NewRootVerTwoPtr(s *capnp.Segment) (VerTwoPtr, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return VerTwoPtr(st), err
+func NewRootListStructCapn(s *capnp.Segment) (ListStructCapn, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ListStructCapn(st), err
 }
 
-func ReadRootVerTwoPtr(msg *capnp.Message) (VerTwoPtr, error) {
+func ReadRootListStructCapn(msg *capnp.Message) (ListStructCapn, error) {
 	root, err := msg.Root()
-	return VerTwoPtr(root.Struct()), err
+	return ListStructCapn(root.Struct()), err
 }
 
-func (s VerTwoPtr) String() string {
-	str, _ := text.Marshal(0xc95babe3bd394d2d, capnp.Struct(s))
+func (s ListStructCapn) String() string {
+	str, _ := text.Marshal(0xb1ac056ed7647011, capnp.Struct(s))
 	return str
 }
 
-func (s VerTwoPtr) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s ListStructCapn) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VerTwoPtr) DecodeFromPtr(p capnp.Ptr) VerTwoPtr {
-	return VerTwoPtr(capnp.Struct{}.DecodeFromPtr(p))
+func (ListStructCapn) DecodeFromPtr(p capnp.Ptr) ListStructCapn {
+	return ListStructCapn(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerTwoPtr) ToPtr() capnp.Ptr {
+func (s ListStructCapn) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VerTwoPtr) IsValid() bool {
+func (s ListStructCapn) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VerTwoPtr) Message() *capnp.Message {
+func (s ListStructCapn) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VerTwoPtr) Segment() *capnp.Segment {
+func (s ListStructCapn) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VerTwoPtr) Ptr1() (VerOneData, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerOneData(p.Struct()), err
+func (s ListStructCapn) Vec() (Nester1Capn_List, error) {
+	return capnp.GetListField[Nester1Capn_List](s, 0, "ListStructCapn.vec")
 }
 
-func (s VerTwoPtr) HasPtr1() bool {
+func (s ListStructCapn) HasVec() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s VerTwoPtr) SetPtr1(v VerOneData) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
-
-// NewPtr1 sets the ptr1 field to a newly
-// allocated VerOneData struct, preferring placement in s's segment.
-func (s VerTwoPtr) NewPtr1() (VerOneData, error) {
-	ss, err := NewVerOneData(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerOneData{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-func (s VerTwoPtr) Ptr2() (VerOneData, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return VerOneData(p.Struct()), err
-}
-
-func (s VerTwoPtr) HasPtr2() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
-
-func (s VerTwoPtr) SetPtr2(v VerOneData) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+func (s ListStructCapn) SetVec(v Nester1Capn_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-// NewPtr2 sets the ptr2 field to a newly
-// allocated VerOneData struct, preferring placement in s's segment.
-func (s VerTwoPtr) NewPtr2() (VerOneData, error) {
-	ss, err := NewVerOneData(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerOneData{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewVec sets the vec field to a newly
+// allocated Nester1Capn_List, preferring placement in s's segment.
+func (s ListStructCapn) NewVec(n int32) (Nester1Capn_List, error) {
+	return capnp.NewListField(s, 0, n, NewNester1Capn_List)
 }
 
-// VerTwoPtr_List is a list of VerTwoPtr.
-type VerTwoPtr_List = capnp.StructList[VerTwoPtr]
+// ListStructCapn_List is a list of ListStructCapn.
+type ListStructCapn_List = capnp.StructList[ListStructCapn]
 
-// NewVerTwoPtr creates a new list of VerTwoPtr.
-func NewVerTwoPtr_List(s *capnp.Segment, sz int32) (VerTwoPtr_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[VerTwoPtr](l), err
+// NewListStructCapn creates a new list of ListStructCapn.
+func NewListStructCapn_List(s *capnp.Segment, sz int32) (ListStructCapn_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[ListStructCapn](l), err
 }
 
-// VerTwoPtr_Future is a wrapper for a VerTwoPtr promised by a client call.
-type VerTwoPtr_Future struct{ *capnp.Future }
+// ListStructCapn_Future is a wrapper for a ListStructCapn promised by a client call.
+type ListStructCapn_Future struct{ *capnp.Future }
 
-func (f VerTwoPtr_Future) Struct() (VerTwoPtr, error) {
+func (f ListStructCapn_Future) Struct() (ListStructCapn, error) {
 	p, err := f.Future.Ptr()
-	return VerTwoPtr(p.Struct()), err
-}
-func (p VerTwoPtr_Future) Ptr1() VerOneData_Future {
-	return VerOneData_Future{Future: p.Future.Field(0, nil)}
-}
-func (p VerTwoPtr_Future) Ptr2() VerOneData_Future {
-	return VerOneData_Future{Future: p.Future.Field(1, nil)}
+	return ListStructCapn(p.Struct()), err
 }
 
-type VerTwoDataTwoPtr capnp.Struct
+type Nester1Capn capnp.Struct
 
-// VerTwoDataTwoPtr_TypeID is the unique identifier for the type VerTwoDataTwoPtr.
-const VerTwoDataTwoPtr_TypeID = 0xb61ee2ecff34ca73
+// Nester1Capn_TypeID is the unique identifier for the type Nester1Capn.
+const Nester1Capn_TypeID = 0xf14fad09425d081c
 
-func NewVerTwoDataTwoPtr(s *capnp.Segment) (VerTwoDataTwoPtr, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return VerTwoDataTwoPtr(st), err
+func NewNester1Capn(s *capnp.Segment) (Nester1Capn, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Nester1Capn(st), err
 }
 
-func NewRootVerTwoDataTwoPtr(s *capnp.Segment) (VerTwoDataTwoPtr, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return VerTwoDataTwoPtr(st), err
+func NewRootNester1Capn(s *capnp.Segment) (Nester1Capn, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Nester1Capn(st), err
 }
 
-func ReadRootVerTwoDataTwoPtr(msg *capnp.Message) (VerTwoDataTwoPtr, error) {
+func ReadRootNester1Capn(msg *capnp.Message) (Nester1Capn, error) {
 	root, err := msg.Root()
-	return VerTwoDataTwoPtr(root.Struct()), err
+	return Nester1Capn(root.Struct()), err
 }
 
-func (s VerTwoDataTwoPtr) String() string {
-	str, _ := text.Marshal(0xb61ee2ecff34ca73, capnp.Struct(s))
+func (s Nester1Capn) String() string {
+	str, _ := text.Marshal(0xf14fad09425d081c, capnp.Struct(s))
 	return str
 }
 
-func (s VerTwoDataTwoPtr) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Nester1Capn) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VerTwoDataTwoPtr) DecodeFromPtr(p capnp.Ptr) VerTwoDataTwoPtr {
-	return VerTwoDataTwoPtr(capnp.Struct{}.DecodeFromPtr(p))
+func (Nester1Capn) DecodeFromPtr(p capnp.Ptr) Nester1Capn {
+	return Nester1Capn(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerTwoDataTwoPtr) ToPtr() capnp.Ptr {
+func (s Nester1Capn) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VerTwoDataTwoPtr) IsValid() bool {
+func (s Nester1Capn) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VerTwoDataTwoPtr) Message() *capnp.Message {
+func (s Nester1Capn) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VerTwoDataTwoPtr) Segment() *capnp.Segment {
+func (s Nester1Capn) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VerTwoDataTwoPtr) Val() int16 {
-	return int16(capnp.Struct(s).Uint16(0))
-}
-
-func (s VerTwoDataTwoPtr) SetVal(v int16) {
-	capnp.Struct(s).SetUint16(0, uint16(v))
+func (s Nester1Capn) Strs() (capnp.TextList, error) {
+	return capnp.GetListField[capnp.TextList](s, 0, "Nester1Capn.strs")
 }
 
-func (s VerTwoDataTwoPtr) Duo() int64 {
-	return int64(capnp.Struct(s).Uint64(8))
+func (s Nester1Capn) HasStrs() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s VerTwoDataTwoPtr) SetDuo(v int64) {
-	capnp.Struct(s).SetUint64(8, uint64(v))
+func (s Nester1Capn) SetStrs(v capnp.TextList) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s VerTwoDataTwoPtr) Ptr1() (VerOneData, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerOneData(p.Struct()), err
+// NewStrs sets the strs field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Nester1Capn) NewStrs(n int32) (capnp.TextList, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewTextList)
 }
 
-func (s VerTwoDataTwoPtr) HasPtr1() bool {
-	return capnp.Struct(s).HasPtr(0)
+// SetStrsFromSlice sets the strs field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Nester1Capn) SetStrsFromSlice(v []string) error {
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewTextListFromSlice)
 }
 
-func (s VerTwoDataTwoPtr) SetPtr1(v VerOneData) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
+// Nester1Capn_List is a list of Nester1Capn.
+type Nester1Capn_List = capnp.StructList[Nester1Capn]
 
-// NewPtr1 sets the ptr1 field to a newly
-// allocated VerOneData struct, preferring placement in s's segment.
-func (s VerTwoDataTwoPtr) NewPtr1() (VerOneData, error) {
-	ss, err := NewVerOneData(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerOneData{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewNester1Capn creates a new list of Nester1Capn.
+func NewNester1Capn_List(s *capnp.Segment, sz int32) (Nester1Capn_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Nester1Capn](l), err
 }
 
-func (s VerTwoDataTwoPtr) Ptr2() (VerOneData, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return VerOneData(p.Struct()), err
-}
+// Nester1Capn_Future is a wrapper for a Nester1Capn promised by a client call.
+type Nester1Capn_Future struct{ *capnp.Future }
 
-func (s VerTwoDataTwoPtr) HasPtr2() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (f Nester1Capn_Future) Struct() (Nester1Capn, error) {
+	p, err := f.Future.Ptr()
+	return Nester1Capn(p.Struct()), err
 }
 
-func (s VerTwoDataTwoPtr) SetPtr2(v VerOneData) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
-}
+type Pipeliner capnp.Client
 
-// NewPtr2 sets the ptr2 field to a newly
-// allocated VerOneData struct, preferring placement in s's segment.
-func (s VerTwoDataTwoPtr) NewPtr2() (VerOneData, error) {
-	ss, err := NewVerOneData(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerOneData{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
+// Pipeliner_TypeID is the unique identifier for the type Pipeliner.
+const Pipeliner_TypeID = 0xd6514008f0f84ebc
 
-// VerTwoDataTwoPtr_List is a list of VerTwoDataTwoPtr.
-type VerTwoDataTwoPtr_List = capnp.StructList[VerTwoDataTwoPtr]
+func (c Pipeliner) NewPipeliner(ctx context.Context, params func(Pipeliner_newPipeliner_Params) error) (Pipeliner_newPipeliner_Results_Future, capnp.ReleaseFunc) {
 
-// NewVerTwoDataTwoPtr creates a new list of VerTwoDataTwoPtr.
-func NewVerTwoDataTwoPtr_List(s *capnp.Segment, sz int32) (VerTwoDataTwoPtr_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
-	return capnp.StructList[VerTwoDataTwoPtr](l), err
-}
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xd6514008f0f84ebc,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:Pipeliner",
+			MethodName:    "newPipeliner",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Pipeliner_newPipeliner_Params(s)) }
+	}
 
-// VerTwoDataTwoPtr_Future is a wrapper for a VerTwoDataTwoPtr promised by a client call.
-type VerTwoDataTwoPtr_Future struct{ *capnp.Future }
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Pipeliner_newPipeliner_Results_Future{Future: ans.Future()}, release
 
-func (f VerTwoDataTwoPtr_Future) Struct() (VerTwoDataTwoPtr, error) {
-	p, err := f.Future.Ptr()
-	return VerTwoDataTwoPtr(p.Struct()), err
-}
-func (p VerTwoDataTwoPtr_Future) Ptr1() VerOneData_Future {
-	return VerOneData_Future{Future: p.Future.Field(0, nil)}
 }
-func (p VerTwoDataTwoPtr_Future) Ptr2() VerOneData_Future {
-	return VerOneData_Future{Future: p.Future.Field(1, nil)}
+
+func (c Pipeliner) GetNumber(ctx context.Context, params func(CallSequence_getNumber_Params) error) (CallSequence_getNumber_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xabaedf5f7817c820,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:CallSequence",
+			MethodName:    "getNumber",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CallSequence_getNumber_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CallSequence_getNumber_Results_Future{Future: ans.Future()}, release
+
 }
 
-type HoldsVerEmptyList capnp.Struct
+// AsCallSequence returns c as a CallSequence, which Pipeliner extends.
+// The result refers to the same capability and shares c's reference,
+// so only one of them should be released.
+func (c Pipeliner) AsCallSequence() CallSequence {
+	return CallSequence(c)
+}
 
-// HoldsVerEmptyList_TypeID is the unique identifier for the type HoldsVerEmptyList.
-const HoldsVerEmptyList_TypeID = 0xde9ed43cfaa83093
+func (c Pipeliner) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
 
-func NewHoldsVerEmptyList(s *capnp.Segment) (HoldsVerEmptyList, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerEmptyList(st), err
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Pipeliner) String() string {
+	return "Pipeliner(" + capnp.Client(c).String() + ")"
 }
 
-func NewRootHoldsVerEmptyList(s *capnp.Segment) (HoldsVerEmptyList, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerEmptyList(st), err
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Pipeliner) AddRef() Pipeliner {
+	return Pipeliner(capnp.Client(c).AddRef())
 }
 
-func ReadRootHoldsVerEmptyList(msg *capnp.Message) (HoldsVerEmptyList, error) {
-	root, err := msg.Root()
-	return HoldsVerEmptyList(root.Struct()), err
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Pipeliner) Release() {
+	capnp.Client(c).Release()
 }
 
-func (s HoldsVerEmptyList) String() string {
-	str, _ := text.Marshal(0xde9ed43cfaa83093, capnp.Struct(s))
-	return str
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Pipeliner) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-func (s HoldsVerEmptyList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (c Pipeliner) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (HoldsVerEmptyList) DecodeFromPtr(p capnp.Ptr) HoldsVerEmptyList {
-	return HoldsVerEmptyList(capnp.Struct{}.DecodeFromPtr(p))
+func (Pipeliner) DecodeFromPtr(p capnp.Ptr) Pipeliner {
+	return Pipeliner(capnp.Client{}.DecodeFromPtr(p))
 }
 
-func (s HoldsVerEmptyList) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Pipeliner) IsValid() bool {
+	return capnp.Client(c).IsValid()
 }
-func (s HoldsVerEmptyList) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Pipeliner) IsSame(other Pipeliner) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
-func (s HoldsVerEmptyList) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Pipeliner) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-func (s HoldsVerEmptyList) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Pipeliner) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
-func (s HoldsVerEmptyList) Mylist() (VerEmpty_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerEmpty_List(p.List()), err
+
+// A Pipeliner_Server is a Pipeliner with a local implementation.
+type Pipeliner_Server interface {
+	CallSequence_Server
+	NewPipeliner(context.Context, Pipeliner_newPipeliner) error
 }
 
-func (s HoldsVerEmptyList) HasMylist() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Pipeliner_NewServer creates a new Server from an implementation of Pipeliner_Server.
+func Pipeliner_NewServer(s Pipeliner_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Pipeliner_Methods(nil, s), s, c)
 }
 
-func (s HoldsVerEmptyList) SetMylist(v VerEmpty_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// Pipeliner_ServerToClient creates a new Client from an implementation of Pipeliner_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Pipeliner_ServerToClient(s Pipeliner_Server) Pipeliner {
+	return Pipeliner(capnp.NewClient(Pipeliner_NewServer(s)))
 }
 
-// NewMylist sets the mylist field to a newly
-// allocated VerEmpty_List, preferring placement in s's segment.
-func (s HoldsVerEmptyList) NewMylist(n int32) (VerEmpty_List, error) {
-	l, err := NewVerEmpty_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerEmpty_List{}, err
+// Pipeliner_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Pipeliner_Methods(methods []server.Method, s Pipeliner_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xd6514008f0f84ebc,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:Pipeliner",
+			MethodName:    "newPipeliner",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.NewPipeliner(ctx, Pipeliner_newPipeliner{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xabaedf5f7817c820,
+			MethodID:      0,
+			InterfaceName: "aircraft.capnp:CallSequence",
+			MethodName:    "getNumber",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetNumber(ctx, CallSequence_getNumber{call})
+		},
+	})
+
+	return methods
 }
 
-// HoldsVerEmptyList_List is a list of HoldsVerEmptyList.
-type HoldsVerEmptyList_List = capnp.StructList[HoldsVerEmptyList]
+// Pipeliner_newPipeliner holds the state for a server call to Pipeliner.newPipeliner.
+// See server.Call for documentation.
+type Pipeliner_newPipeliner struct {
+	*server.Call
+}
 
-// NewHoldsVerEmptyList creates a new list of HoldsVerEmptyList.
-func NewHoldsVerEmptyList_List(s *capnp.Segment, sz int32) (HoldsVerEmptyList_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerEmptyList](l), err
+// Args returns the call's arguments.
+func (c Pipeliner_newPipeliner) Args() Pipeliner_newPipeliner_Params {
+	return Pipeliner_newPipeliner_Params(c.Call.Args())
 }
 
-// HoldsVerEmptyList_Future is a wrapper for a HoldsVerEmptyList promised by a client call.
-type HoldsVerEmptyList_Future struct{ *capnp.Future }
+// AllocResults allocates the results struct.
+func (c Pipeliner_newPipeliner) AllocResults() (Pipeliner_newPipeliner_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Pipeliner_newPipeliner_Results(r), err
+}
 
-func (f HoldsVerEmptyList_Future) Struct() (HoldsVerEmptyList, error) {
-	p, err := f.Future.Ptr()
-	return HoldsVerEmptyList(p.Struct()), err
+// Pipeliner_List is a list of Pipeliner.
+type Pipeliner_List = capnp.CapList[Pipeliner]
+
+// NewPipeliner_List creates a new list of Pipeliner.
+func NewPipeliner_List(s *capnp.Segment, sz int32) (Pipeliner_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Pipeliner](l), err
 }
 
-type HoldsVerOneDataList capnp.Struct
+type Pipeliner_newPipeliner_Params capnp.Struct
 
-// HoldsVerOneDataList_TypeID is the unique identifier for the type HoldsVerOneDataList.
-const HoldsVerOneDataList_TypeID = 0xabd055422a4d7df1
+// Pipeliner_newPipeliner_Params_TypeID is the unique identifier for the type Pipeliner_newPipeliner_Params.
+const Pipeliner_newPipeliner_Params_TypeID = 0xbaa7b3b1ca91f833
 
-func NewHoldsVerOneDataList(s *capnp.Segment) (HoldsVerOneDataList, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerOneDataList(st), err
+func NewPipeliner_newPipeliner_Params(s *capnp.Segment) (Pipeliner_newPipeliner_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Pipeliner_newPipeliner_Params(st), err
 }
 
-func NewRootHoldsVerOneDataList(s *capnp.Segment) (HoldsVerOneDataList, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerOneDataList(st), err
+func NewRootPipeliner_newPipeliner_Params(s *capnp.Segment) (Pipeliner_newPipeliner_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Pipeliner_newPipeliner_Params(st), err
 }
 
-func ReadRootHoldsVerOneDataList(msg *capnp.Message) (HoldsVerOneDataList, error) {
+func ReadRootPipeliner_newPipeliner_Params(msg *capnp.Message) (Pipeliner_newPipeliner_Params, error) {
 	root, err := msg.Root()
-	return HoldsVerOneDataList(root.Struct()), err
+	return Pipeliner_newPipeliner_Params(root.Struct()), err
 }
 
-func (s HoldsVerOneDataList) String() string {
-	str, _ := text.Marshal(0xabd055422a4d7df1, capnp.Struct(s))
+func (s Pipeliner_newPipeliner_Params) String() string {
+	str, _ := text.Marshal(0xbaa7b3b1ca91f833, capnp.Struct(s))
 	return str
 }
 
-func (s HoldsVerOneDataList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Pipeliner_newPipeliner_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (HoldsVerOneDataList) DecodeFromPtr(p capnp.Ptr) HoldsVerOneDataList {
-	return HoldsVerOneDataList(capnp.Struct{}.DecodeFromPtr(p))
+func (Pipeliner_newPipeliner_Params) DecodeFromPtr(p capnp.Ptr) Pipeliner_newPipeliner_Params {
+	return Pipeliner_newPipeliner_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s HoldsVerOneDataList) ToPtr() capnp.Ptr {
+func (s Pipeliner_newPipeliner_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s HoldsVerOneDataList) IsValid() bool {
+func (s Pipeliner_newPipeliner_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s HoldsVerOneDataList) Message() *capnp.Message {
+func (s Pipeliner_newPipeliner_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s HoldsVerOneDataList) Segment() *capnp.Segment {
+func (s Pipeliner_newPipeliner_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s HoldsVerOneDataList) Mylist() (VerOneData_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerOneData_List(p.List()), err
-}
 
-func (s HoldsVerOneDataList) HasMylist() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Pipeliner_newPipeliner_Params_List is a list of Pipeliner_newPipeliner_Params.
+type Pipeliner_newPipeliner_Params_List = capnp.StructList[Pipeliner_newPipeliner_Params]
+
+// NewPipeliner_newPipeliner_Params creates a new list of Pipeliner_newPipeliner_Params.
+func NewPipeliner_newPipeliner_Params_List(s *capnp.Segment, sz int32) (Pipeliner_newPipeliner_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Pipeliner_newPipeliner_Params](l), err
 }
 
-func (s HoldsVerOneDataList) SetMylist(v VerOneData_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
-}
-
-// NewMylist sets the mylist field to a newly
-// allocated VerOneData_List, preferring placement in s's segment.
-func (s HoldsVerOneDataList) NewMylist(n int32) (VerOneData_List, error) {
-	l, err := NewVerOneData_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerOneData_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-
-// HoldsVerOneDataList_List is a list of HoldsVerOneDataList.
-type HoldsVerOneDataList_List = capnp.StructList[HoldsVerOneDataList]
-
-// NewHoldsVerOneDataList creates a new list of HoldsVerOneDataList.
-func NewHoldsVerOneDataList_List(s *capnp.Segment, sz int32) (HoldsVerOneDataList_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerOneDataList](l), err
-}
-
-// HoldsVerOneDataList_Future is a wrapper for a HoldsVerOneDataList promised by a client call.
-type HoldsVerOneDataList_Future struct{ *capnp.Future }
+// Pipeliner_newPipeliner_Params_Future is a wrapper for a Pipeliner_newPipeliner_Params promised by a client call.
+type Pipeliner_newPipeliner_Params_Future struct{ *capnp.Future }
 
-func (f HoldsVerOneDataList_Future) Struct() (HoldsVerOneDataList, error) {
+func (f Pipeliner_newPipeliner_Params_Future) Struct() (Pipeliner_newPipeliner_Params, error) {
 	p, err := f.Future.Ptr()
-	return HoldsVerOneDataList(p.Struct()), err
+	return Pipeliner_newPipeliner_Params(p.Struct()), err
 }
 
-type HoldsVerTwoDataList capnp.Struct
+type Pipeliner_newPipeliner_Results capnp.Struct
 
-// HoldsVerTwoDataList_TypeID is the unique identifier for the type HoldsVerTwoDataList.
-const HoldsVerTwoDataList_TypeID = 0xcbdc765fd5dff7ba
+// Pipeliner_newPipeliner_Results_TypeID is the unique identifier for the type Pipeliner_newPipeliner_Results.
+const Pipeliner_newPipeliner_Results_TypeID = 0xbbcdbf4b4ae501fa
 
-func NewHoldsVerTwoDataList(s *capnp.Segment) (HoldsVerTwoDataList, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoDataList(st), err
+func NewPipeliner_newPipeliner_Results(s *capnp.Segment) (Pipeliner_newPipeliner_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Pipeliner_newPipeliner_Results(st), err
 }
 
-func NewRootHoldsVerTwoDataList(s *capnp.Segment) (HoldsVerTwoDataList, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoDataList(st), err
+func NewRootPipeliner_newPipeliner_Results(s *capnp.Segment) (Pipeliner_newPipeliner_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Pipeliner_newPipeliner_Results(st), err
 }
 
-func ReadRootHoldsVerTwoDataList(msg *capnp.Message) (HoldsVerTwoDataList, error) {
+func ReadRootPipeliner_newPipeliner_Results(msg *capnp.Message) (Pipeliner_newPipeliner_Results, error) {
 	root, err := msg.Root()
-	return HoldsVerTwoDataList(root.Struct()), err
+	return Pipeliner_newPipeliner_Results(root.Struct()), err
 }
 
-func (s HoldsVerTwoDataList) String() string {
-	str, _ := text.Marshal(0xcbdc765fd5dff7ba, capnp.Struct(s))
+func (s Pipeliner_newPipeliner_Results) String() string {
+	str, _ := text.Marshal(0xbbcdbf4b4ae501fa, capnp.Struct(s))
 	return str
 }
 
-func (s HoldsVerTwoDataList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Pipeliner_newPipeliner_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (HoldsVerTwoDataList) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoDataList {
-	return HoldsVerTwoDataList(capnp.Struct{}.DecodeFromPtr(p))
+func (Pipeliner_newPipeliner_Results) DecodeFromPtr(p capnp.Ptr) Pipeliner_newPipeliner_Results {
+	return Pipeliner_newPipeliner_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s HoldsVerTwoDataList) ToPtr() capnp.Ptr {
+func (s Pipeliner_newPipeliner_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s HoldsVerTwoDataList) IsValid() bool {
+func (s Pipeliner_newPipeliner_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s HoldsVerTwoDataList) Message() *capnp.Message {
+func (s Pipeliner_newPipeliner_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s HoldsVerTwoDataList) Segment() *capnp.Segment {
+func (s Pipeliner_newPipeliner_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s HoldsVerTwoDataList) Mylist() (VerTwoData_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoData_List(p.List()), err
+func (s Pipeliner_newPipeliner_Results) Extra() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(0, "Pipeliner.newPipeliner$Results.extra")
 }
 
-func (s HoldsVerTwoDataList) HasMylist() bool {
+func (s Pipeliner_newPipeliner_Results) HasExtra() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s HoldsVerTwoDataList) SetMylist(v VerTwoData_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Pipeliner_newPipeliner_Results) SetExtra(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(0, v)
+}
+func (s Pipeliner_newPipeliner_Results) Pipeliner() Pipeliner {
+	return capnp.GetInterfaceField[Pipeliner](s, 1)
 }
 
-// NewMylist sets the mylist field to a newly
-// allocated VerTwoData_List, preferring placement in s's segment.
-func (s HoldsVerTwoDataList) NewMylist(n int32) (VerTwoData_List, error) {
-	l, err := NewVerTwoData_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerTwoData_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Pipeliner_newPipeliner_Results) HasPipeliner() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// HoldsVerTwoDataList_List is a list of HoldsVerTwoDataList.
-type HoldsVerTwoDataList_List = capnp.StructList[HoldsVerTwoDataList]
+func (s Pipeliner_newPipeliner_Results) SetPipeliner(v Pipeliner) error {
+	return capnp.SetInterfaceField(s, 1, v)
+}
 
-// NewHoldsVerTwoDataList creates a new list of HoldsVerTwoDataList.
-func NewHoldsVerTwoDataList_List(s *capnp.Segment, sz int32) (HoldsVerTwoDataList_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerTwoDataList](l), err
+// Pipeliner_newPipeliner_Results_List is a list of Pipeliner_newPipeliner_Results.
+type Pipeliner_newPipeliner_Results_List = capnp.StructList[Pipeliner_newPipeliner_Results]
+
+// NewPipeliner_newPipeliner_Results creates a new list of Pipeliner_newPipeliner_Results.
+func NewPipeliner_newPipeliner_Results_List(s *capnp.Segment, sz int32) (Pipeliner_newPipeliner_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Pipeliner_newPipeliner_Results](l), err
 }
 
-// HoldsVerTwoDataList_Future is a wrapper for a HoldsVerTwoDataList promised by a client call.
-type HoldsVerTwoDataList_Future struct{ *capnp.Future }
+// Pipeliner_newPipeliner_Results_Future is a wrapper for a Pipeliner_newPipeliner_Results promised by a client call.
+type Pipeliner_newPipeliner_Results_Future struct{ *capnp.Future }
 
-func (f HoldsVerTwoDataList_Future) Struct() (HoldsVerTwoDataList, error) {
+func (f Pipeliner_newPipeliner_Results_Future) Struct() (Pipeliner_newPipeliner_Results, error) {
 	p, err := f.Future.Ptr()
-	return HoldsVerTwoDataList(p.Struct()), err
+	return Pipeliner_newPipeliner_Results(p.Struct()), err
+}
+func (p Pipeliner_newPipeliner_Results_Future) Extra() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+func (p Pipeliner_newPipeliner_Results_Future) Pipeliner() Pipeliner {
+	return Pipeliner(p.Future.Field(1, nil).Client())
 }
 
-type HoldsVerOnePtrList capnp.Struct
+type PlaneBase capnp.Struct
 
-// HoldsVerOnePtrList_TypeID is the unique identifier for the type HoldsVerOnePtrList.
-const HoldsVerOnePtrList_TypeID = 0xe508a29c83a059f8
+// PlaneBase_TypeID is the unique identifier for the type PlaneBase.
+const PlaneBase_TypeID = 0xd8bccf6e60a73791
 
-func NewHoldsVerOnePtrList(s *capnp.Segment) (HoldsVerOnePtrList, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerOnePtrList(st), err
+func NewPlaneBase(s *capnp.Segment) (PlaneBase, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2})
+	return PlaneBase(st), err
 }
 
-func NewRootHoldsVerOnePtrList(s *capnp.Segment) (HoldsVerOnePtrList, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerOnePtrList(st), err
+func NewRootPlaneBase(s *capnp.Segment) (PlaneBase, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2})
+	return PlaneBase(st), err
 }
 
-func ReadRootHoldsVerOnePtrList(msg *capnp.Message) (HoldsVerOnePtrList, error) {
+func ReadRootPlaneBase(msg *capnp.Message) (PlaneBase, error) {
 	root, err := msg.Root()
-	return HoldsVerOnePtrList(root.Struct()), err
+	return PlaneBase(root.Struct()), err
 }
 
-func (s HoldsVerOnePtrList) String() string {
-	str, _ := text.Marshal(0xe508a29c83a059f8, capnp.Struct(s))
+func (s PlaneBase) String() string {
+	str, _ := text.Marshal(0xd8bccf6e60a73791, capnp.Struct(s))
 	return str
 }
 
-func (s HoldsVerOnePtrList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PlaneBase) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (HoldsVerOnePtrList) DecodeFromPtr(p capnp.Ptr) HoldsVerOnePtrList {
-	return HoldsVerOnePtrList(capnp.Struct{}.DecodeFromPtr(p))
+func (PlaneBase) DecodeFromPtr(p capnp.Ptr) PlaneBase {
+	return PlaneBase(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s HoldsVerOnePtrList) ToPtr() capnp.Ptr {
+func (s PlaneBase) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s HoldsVerOnePtrList) IsValid() bool {
+func (s PlaneBase) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s HoldsVerOnePtrList) Message() *capnp.Message {
+func (s PlaneBase) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s HoldsVerOnePtrList) Segment() *capnp.Segment {
+func (s PlaneBase) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s HoldsVerOnePtrList) Mylist() (VerOnePtr_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerOnePtr_List(p.List()), err
+func (s PlaneBase) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "PlaneBase.name")
 }
 
-func (s HoldsVerOnePtrList) HasMylist() bool {
+func (s PlaneBase) HasName() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s HoldsVerOnePtrList) SetMylist(v VerOnePtr_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
-}
-
-// NewMylist sets the mylist field to a newly
-// allocated VerOnePtr_List, preferring placement in s's segment.
-func (s HoldsVerOnePtrList) NewMylist(n int32) (VerOnePtr_List, error) {
-	l, err := NewVerOnePtr_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerOnePtr_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s PlaneBase) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "PlaneBase.name")
 }
 
-// HoldsVerOnePtrList_List is a list of HoldsVerOnePtrList.
-type HoldsVerOnePtrList_List = capnp.StructList[HoldsVerOnePtrList]
-
-// NewHoldsVerOnePtrList creates a new list of HoldsVerOnePtrList.
-func NewHoldsVerOnePtrList_List(s *capnp.Segment, sz int32) (HoldsVerOnePtrList_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerOnePtrList](l), err
+func (s PlaneBase) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// HoldsVerOnePtrList_Future is a wrapper for a HoldsVerOnePtrList promised by a client call.
-type HoldsVerOnePtrList_Future struct{ *capnp.Future }
-
-func (f HoldsVerOnePtrList_Future) Struct() (HoldsVerOnePtrList, error) {
-	p, err := f.Future.Ptr()
-	return HoldsVerOnePtrList(p.Struct()), err
+func (s PlaneBase) Homes() (Airport_List, error) {
+	return capnp.GetListField[Airport_List](s, 1, "PlaneBase.homes")
 }
 
-type HoldsVerTwoPtrList capnp.Struct
-
-// HoldsVerTwoPtrList_TypeID is the unique identifier for the type HoldsVerTwoPtrList.
-const HoldsVerTwoPtrList_TypeID = 0xcf9beaca1cc180c8
-
-func NewHoldsVerTwoPtrList(s *capnp.Segment) (HoldsVerTwoPtrList, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoPtrList(st), err
+func (s PlaneBase) HasHomes() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func NewRootHoldsVerTwoPtrList(s *capnp.Segment) (HoldsVerTwoPtrList, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoPtrList(st), err
+func (s PlaneBase) SetHomes(v Airport_List) error {
+	return capnp.SetListField(s, 1, v)
 }
 
-func ReadRootHoldsVerTwoPtrList(msg *capnp.Message) (HoldsVerTwoPtrList, error) {
-	root, err := msg.Root()
-	return HoldsVerTwoPtrList(root.Struct()), err
+// NewHomes sets the homes field to a newly
+// allocated Airport_List, preferring placement in s's segment.
+func (s PlaneBase) NewHomes(n int32) (Airport_List, error) {
+	return capnp.NewListField(s, 1, n, NewAirport_List)
 }
 
-func (s HoldsVerTwoPtrList) String() string {
-	str, _ := text.Marshal(0xcf9beaca1cc180c8, capnp.Struct(s))
-	return str
+// SetHomesFromSlice sets the homes field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s PlaneBase) SetHomesFromSlice(v []Airport) error {
+	return capnp.SetListFieldFromSlice(s, 1, v, capnp.NewEnumListFromSlice[Airport])
 }
-
-func (s HoldsVerTwoPtrList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s PlaneBase) Rating() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
 }
 
-func (HoldsVerTwoPtrList) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoPtrList {
-	return HoldsVerTwoPtrList(capnp.Struct{}.DecodeFromPtr(p))
+func (s PlaneBase) SetRating(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
 }
 
-func (s HoldsVerTwoPtrList) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s HoldsVerTwoPtrList) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s PlaneBase) CanFly() bool {
+	return capnp.Struct(s).Bit(64)
 }
 
-func (s HoldsVerTwoPtrList) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s PlaneBase) SetCanFly(v bool) {
+	capnp.Struct(s).SetBit(64, v)
 }
 
-func (s HoldsVerTwoPtrList) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s HoldsVerTwoPtrList) Mylist() (VerTwoPtr_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoPtr_List(p.List()), err
+func (s PlaneBase) Capacity() int64 {
+	return int64(capnp.Struct(s).Uint64(16))
 }
 
-func (s HoldsVerTwoPtrList) HasMylist() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s PlaneBase) SetCapacity(v int64) {
+	capnp.Struct(s).SetUint64(16, uint64(v))
 }
 
-func (s HoldsVerTwoPtrList) SetMylist(v VerTwoPtr_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s PlaneBase) MaxSpeed() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(24))
 }
 
-// NewMylist sets the mylist field to a newly
-// allocated VerTwoPtr_List, preferring placement in s's segment.
-func (s HoldsVerTwoPtrList) NewMylist(n int32) (VerTwoPtr_List, error) {
-	l, err := NewVerTwoPtr_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerTwoPtr_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s PlaneBase) SetMaxSpeed(v float64) {
+	capnp.Struct(s).SetUint64(24, math.Float64bits(v))
 }
 
-// HoldsVerTwoPtrList_List is a list of HoldsVerTwoPtrList.
-type HoldsVerTwoPtrList_List = capnp.StructList[HoldsVerTwoPtrList]
+// PlaneBase_List is a list of PlaneBase.
+type PlaneBase_List = capnp.StructList[PlaneBase]
 
-// NewHoldsVerTwoPtrList creates a new list of HoldsVerTwoPtrList.
-func NewHoldsVerTwoPtrList_List(s *capnp.Segment, sz int32) (HoldsVerTwoPtrList_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerTwoPtrList](l), err
+// NewPlaneBase creates a new list of PlaneBase.
+func NewPlaneBase_List(s *capnp.Segment, sz int32) (PlaneBase_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 2}, sz)
+	return capnp.StructList[PlaneBase](l), err
 }
 
-// HoldsVerTwoPtrList_Future is a wrapper for a HoldsVerTwoPtrList promised by a client call.
-type HoldsVerTwoPtrList_Future struct{ *capnp.Future }
+// PlaneBase_Future is a wrapper for a PlaneBase promised by a client call.
+type PlaneBase_Future struct{ *capnp.Future }
 
-func (f HoldsVerTwoPtrList_Future) Struct() (HoldsVerTwoPtrList, error) {
+func (f PlaneBase_Future) Struct() (PlaneBase, error) {
 	p, err := f.Future.Ptr()
-	return HoldsVerTwoPtrList(p.Struct()), err
+	return PlaneBase(p.Struct()), err
 }
 
-type HoldsVerTwoTwoList capnp.Struct
+type RWTestCapn capnp.Struct
 
-// HoldsVerTwoTwoList_TypeID is the unique identifier for the type HoldsVerTwoTwoList.
-const HoldsVerTwoTwoList_TypeID = 0x95befe3f14606e6b
+// RWTestCapn_TypeID is the unique identifier for the type RWTestCapn.
+const RWTestCapn_TypeID = 0xf7ff4414476c186a
 
-func NewHoldsVerTwoTwoList(s *capnp.Segment) (HoldsVerTwoTwoList, error) {
+func NewRWTestCapn(s *capnp.Segment) (RWTestCapn, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoTwoList(st), err
+	return RWTestCapn(st), err
 }
 
-func NewRootHoldsVerTwoTwoList(s *capnp.Segment) (HoldsVerTwoTwoList, error) {
+func NewRootRWTestCapn(s *capnp.Segment) (RWTestCapn, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoTwoList(st), err
+	return RWTestCapn(st), err
 }
 
-func ReadRootHoldsVerTwoTwoList(msg *capnp.Message) (HoldsVerTwoTwoList, error) {
+func ReadRootRWTestCapn(msg *capnp.Message) (RWTestCapn, error) {
 	root, err := msg.Root()
-	return HoldsVerTwoTwoList(root.Struct()), err
+	return RWTestCapn(root.Struct()), err
 }
 
-func (s HoldsVerTwoTwoList) String() string {
-	str, _ := text.Marshal(0x95befe3f14606e6b, capnp.Struct(s))
+func (s RWTestCapn) String() string {
+	str, _ := text.Marshal(0xf7ff4414476c186a, capnp.Struct(s))
 	return str
 }
 
-func (s HoldsVerTwoTwoList) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s RWTestCapn) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (HoldsVerTwoTwoList) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoTwoList {
-	return HoldsVerTwoTwoList(capnp.Struct{}.DecodeFromPtr(p))
+func (RWTestCapn) DecodeFromPtr(p capnp.Ptr) RWTestCapn {
+	return RWTestCapn(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s HoldsVerTwoTwoList) ToPtr() capnp.Ptr {
+func (s RWTestCapn) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s HoldsVerTwoTwoList) IsValid() bool {
+func (s RWTestCapn) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s HoldsVerTwoTwoList) Message() *capnp.Message {
+func (s RWTestCapn) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s HoldsVerTwoTwoList) Segment() *capnp.Segment {
+func (s RWTestCapn) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s HoldsVerTwoTwoList) Mylist() (VerTwoDataTwoPtr_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoDataTwoPtr_List(p.List()), err
+func (s RWTestCapn) NestMatrix() (capnp.PointerList, error) {
+	return capnp.GetListField[capnp.PointerList](s, 0, "RWTestCapn.nestMatrix")
 }
 
-func (s HoldsVerTwoTwoList) HasMylist() bool {
+func (s RWTestCapn) HasNestMatrix() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s HoldsVerTwoTwoList) SetMylist(v VerTwoDataTwoPtr_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s RWTestCapn) SetNestMatrix(v capnp.PointerList) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-// NewMylist sets the mylist field to a newly
-// allocated VerTwoDataTwoPtr_List, preferring placement in s's segment.
-func (s HoldsVerTwoTwoList) NewMylist(n int32) (VerTwoDataTwoPtr_List, error) {
-	l, err := NewVerTwoDataTwoPtr_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerTwoDataTwoPtr_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// NewNestMatrix sets the nestMatrix field to a newly
+// allocated capnp.PointerList, preferring placement in s's segment.
+func (s RWTestCapn) NewNestMatrix(n int32) (capnp.PointerList, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewPointerList)
 }
 
-// HoldsVerTwoTwoList_List is a list of HoldsVerTwoTwoList.
-type HoldsVerTwoTwoList_List = capnp.StructList[HoldsVerTwoTwoList]
+// RWTestCapn_List is a list of RWTestCapn.
+type RWTestCapn_List = capnp.StructList[RWTestCapn]
 
-// NewHoldsVerTwoTwoList creates a new list of HoldsVerTwoTwoList.
-func NewHoldsVerTwoTwoList_List(s *capnp.Segment, sz int32) (HoldsVerTwoTwoList_List, error) {
+// NewRWTestCapn creates a new list of RWTestCapn.
+func NewRWTestCapn_List(s *capnp.Segment, sz int32) (RWTestCapn_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerTwoTwoList](l), err
+	return capnp.StructList[RWTestCapn](l), err
 }
 
-// HoldsVerTwoTwoList_Future is a wrapper for a HoldsVerTwoTwoList promised by a client call.
-type HoldsVerTwoTwoList_Future struct{ *capnp.Future }
+// RWTestCapn_Future is a wrapper for a RWTestCapn promised by a client call.
+type RWTestCapn_Future struct{ *capnp.Future }
 
-func (f HoldsVerTwoTwoList_Future) Struct() (HoldsVerTwoTwoList, error) {
+func (f RWTestCapn_Future) Struct() (RWTestCapn, error) {
 	p, err := f.Future.Ptr()
-	return HoldsVerTwoTwoList(p.Struct()), err
+	return RWTestCapn(p.Struct()), err
 }
 
-type HoldsVerTwoTwoPlus capnp.Struct
+type Regression capnp.Struct
 
-// HoldsVerTwoTwoPlus_TypeID is the unique identifier for the type HoldsVerTwoTwoPlus.
-const HoldsVerTwoTwoPlus_TypeID = 0x87c33f2330feb3d8
+// Regression_TypeID is the unique identifier for the type Regression.
+const Regression_TypeID = 0xb1f0385d845e367f
 
-func NewHoldsVerTwoTwoPlus(s *capnp.Segment) (HoldsVerTwoTwoPlus, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoTwoPlus(st), err
+func NewRegression(s *capnp.Segment) (Regression, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	return Regression(st), err
 }
 
-func NewRootHoldsVerTwoTwoPlus(s *capnp.Segment) (HoldsVerTwoTwoPlus, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return HoldsVerTwoTwoPlus(st), err
+func NewRootRegression(s *capnp.Segment) (Regression, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	return Regression(st), err
 }
 
-func ReadRootHoldsVerTwoTwoPlus(msg *capnp.Message) (HoldsVerTwoTwoPlus, error) {
+func ReadRootRegression(msg *capnp.Message) (Regression, error) {
 	root, err := msg.Root()
-	return HoldsVerTwoTwoPlus(root.Struct()), err
+	return Regression(root.Struct()), err
 }
 
-func (s HoldsVerTwoTwoPlus) String() string {
-	str, _ := text.Marshal(0x87c33f2330feb3d8, capnp.Struct(s))
+func (s Regression) String() string {
+	str, _ := text.Marshal(0xb1f0385d845e367f, capnp.Struct(s))
 	return str
 }
 
-func (s HoldsVerTwoTwoPlus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Regression) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (HoldsVerTwoTwoPlus) DecodeFromPtr(p capnp.Ptr) HoldsVerTwoTwoPlus {
-	return HoldsVerTwoTwoPlus(capnp.Struct{}.DecodeFromPtr(p))
+func (Regression) DecodeFromPtr(p capnp.Ptr) Regression {
+	return Regression(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s HoldsVerTwoTwoPlus) ToPtr() capnp.Ptr {
+func (s Regression) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s HoldsVerTwoTwoPlus) IsValid() bool {
+func (s Regression) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s HoldsVerTwoTwoPlus) Message() *capnp.Message {
+func (s Regression) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s HoldsVerTwoTwoPlus) Segment() *capnp.Segment {
+func (s Regression) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s HoldsVerTwoTwoPlus) Mylist() (VerTwoTwoPlus_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoTwoPlus_List(p.List()), err
+func (s Regression) Base() (PlaneBase, error) {
+	return capnp.GetStructField[PlaneBase](s, 0, "Regression.base")
 }
 
-func (s HoldsVerTwoTwoPlus) HasMylist() bool {
+func (s Regression) HasBase() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s HoldsVerTwoTwoPlus) SetMylist(v VerTwoTwoPlus_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Regression) SetBase(v PlaneBase) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewMylist sets the mylist field to a newly
-// allocated VerTwoTwoPlus_List, preferring placement in s's segment.
-func (s HoldsVerTwoTwoPlus) NewMylist(n int32) (VerTwoTwoPlus_List, error) {
-	l, err := NewVerTwoTwoPlus_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return VerTwoTwoPlus_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// NewBase sets the base field to a newly
+// allocated PlaneBase struct, preferring placement in s's segment.
+func (s Regression) NewBase() (PlaneBase, error) {
+	return capnp.NewStructField(s, 0, NewPlaneBase)
 }
 
-// HoldsVerTwoTwoPlus_List is a list of HoldsVerTwoTwoPlus.
-type HoldsVerTwoTwoPlus_List = capnp.StructList[HoldsVerTwoTwoPlus]
+// intercept
+func (s Regression) B0() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(0))
+}
 
-// NewHoldsVerTwoTwoPlus creates a new list of HoldsVerTwoTwoPlus.
-func NewHoldsVerTwoTwoPlus_List(s *capnp.Segment, sz int32) (HoldsVerTwoTwoPlus_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[HoldsVerTwoTwoPlus](l), err
+func (s Regression) SetB0(v float64) {
+	capnp.Struct(s).SetUint64(0, math.Float64bits(v))
 }
 
-// HoldsVerTwoTwoPlus_Future is a wrapper for a HoldsVerTwoTwoPlus promised by a client call.
-type HoldsVerTwoTwoPlus_Future struct{ *capnp.Future }
+func (s Regression) Beta() (capnp.Float64List, error) {
+	return capnp.GetListField[capnp.Float64List](s, 1, "Regression.beta")
+}
 
-func (f HoldsVerTwoTwoPlus_Future) Struct() (HoldsVerTwoTwoPlus, error) {
-	p, err := f.Future.Ptr()
-	return HoldsVerTwoTwoPlus(p.Struct()), err
+func (s Regression) HasBeta() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-type VerTwoTwoPlus capnp.Struct
+func (s Regression) SetBeta(v capnp.Float64List) error {
+	return capnp.SetListField(s, 1, v)
+}
 
-// VerTwoTwoPlus_TypeID is the unique identifier for the type VerTwoTwoPlus.
-const VerTwoTwoPlus_TypeID = 0xce44aee2d9e25049
+// NewBeta sets the beta field to a newly
+// allocated capnp.Float64List, preferring placement in s's segment.
+func (s Regression) NewBeta(n int32) (capnp.Float64List, error) {
+	return capnp.NewListField(s, 1, n, capnp.NewFloat64List)
+}
 
-func NewVerTwoTwoPlus(s *capnp.Segment) (VerTwoTwoPlus, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
-	return VerTwoTwoPlus(st), err
+// SetBetaFromSlice sets the beta field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Regression) SetBetaFromSlice(v []float64) error {
+	return capnp.SetListFieldFromSlice(s, 1, v, capnp.NewFloat64ListFromSlice)
+}
+func (s Regression) Planes() (Aircraft_List, error) {
+	return capnp.GetListField[Aircraft_List](s, 2, "Regression.planes")
 }
 
-func NewRootVerTwoTwoPlus(s *capnp.Segment) (VerTwoTwoPlus, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
-	return VerTwoTwoPlus(st), err
+func (s Regression) HasPlanes() bool {
+	return capnp.Struct(s).HasPtr(2)
 }
 
-func ReadRootVerTwoTwoPlus(msg *capnp.Message) (VerTwoTwoPlus, error) {
-	root, err := msg.Root()
-	return VerTwoTwoPlus(root.Struct()), err
+func (s Regression) SetPlanes(v Aircraft_List) error {
+	return capnp.SetListField(s, 2, v)
 }
 
-func (s VerTwoTwoPlus) String() string {
-	str, _ := text.Marshal(0xce44aee2d9e25049, capnp.Struct(s))
-	return str
+// NewPlanes sets the planes field to a newly
+// allocated Aircraft_List, preferring placement in s's segment.
+func (s Regression) NewPlanes(n int32) (Aircraft_List, error) {
+	return capnp.NewListField(s, 2, n, NewAircraft_List)
 }
 
-func (s VerTwoTwoPlus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// y-mean in original space
+func (s Regression) Ymu() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
 }
 
-func (VerTwoTwoPlus) DecodeFromPtr(p capnp.Ptr) VerTwoTwoPlus {
-	return VerTwoTwoPlus(capnp.Struct{}.DecodeFromPtr(p))
+func (s Regression) SetYmu(v float64) {
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
 }
 
-func (s VerTwoTwoPlus) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// y-standard deviation in original space
+func (s Regression) Ysd() float64 {
+	return math.Float64frombits(capnp.Struct(s).Uint64(16))
 }
-func (s VerTwoTwoPlus) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+func (s Regression) SetYsd(v float64) {
+	capnp.Struct(s).SetUint64(16, math.Float64bits(v))
 }
 
-func (s VerTwoTwoPlus) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Regression_List is a list of Regression.
+type Regression_List = capnp.StructList[Regression]
+
+// NewRegression creates a new list of Regression.
+func NewRegression_List(s *capnp.Segment, sz int32) (Regression_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
+	return capnp.StructList[Regression](l), err
 }
 
-func (s VerTwoTwoPlus) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Regression_Future is a wrapper for a Regression promised by a client call.
+type Regression_Future struct{ *capnp.Future }
+
+func (f Regression_Future) Struct() (Regression, error) {
+	p, err := f.Future.Ptr()
+	return Regression(p.Struct()), err
 }
-func (s VerTwoTwoPlus) Val() int16 {
-	return int16(capnp.Struct(s).Uint16(0))
+func (p Regression_Future) Base() PlaneBase_Future {
+	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s VerTwoTwoPlus) SetVal(v int16) {
-	capnp.Struct(s).SetUint16(0, uint16(v))
-}
+type StackingA capnp.Struct
 
-func (s VerTwoTwoPlus) Duo() int64 {
-	return int64(capnp.Struct(s).Uint64(8))
-}
+// StackingA_TypeID is the unique identifier for the type StackingA.
+const StackingA_TypeID = 0x9d3032ff86043b75
 
-func (s VerTwoTwoPlus) SetDuo(v int64) {
-	capnp.Struct(s).SetUint64(8, uint64(v))
+func NewStackingA(s *capnp.Segment) (StackingA, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return StackingA(st), err
 }
 
-func (s VerTwoTwoPlus) Ptr1() (VerTwoDataTwoPtr, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoDataTwoPtr(p.Struct()), err
+func NewRootStackingA(s *capnp.Segment) (StackingA, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return StackingA(st), err
 }
 
-func (s VerTwoTwoPlus) HasPtr1() bool {
-	return capnp.Struct(s).HasPtr(0)
+func ReadRootStackingA(msg *capnp.Message) (StackingA, error) {
+	root, err := msg.Root()
+	return StackingA(root.Struct()), err
 }
 
-func (s VerTwoTwoPlus) SetPtr1(v VerTwoDataTwoPtr) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s StackingA) String() string {
+	str, _ := text.Marshal(0x9d3032ff86043b75, capnp.Struct(s))
+	return str
 }
 
-// NewPtr1 sets the ptr1 field to a newly
-// allocated VerTwoDataTwoPtr struct, preferring placement in s's segment.
-func (s VerTwoTwoPlus) NewPtr1() (VerTwoDataTwoPtr, error) {
-	ss, err := NewVerTwoDataTwoPtr(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerTwoDataTwoPtr{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s StackingA) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s VerTwoTwoPlus) Ptr2() (VerTwoDataTwoPtr, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return VerTwoDataTwoPtr(p.Struct()), err
+func (StackingA) DecodeFromPtr(p capnp.Ptr) StackingA {
+	return StackingA(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VerTwoTwoPlus) HasPtr2() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s StackingA) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-func (s VerTwoTwoPlus) SetPtr2(v VerTwoDataTwoPtr) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+func (s StackingA) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// NewPtr2 sets the ptr2 field to a newly
-// allocated VerTwoDataTwoPtr struct, preferring placement in s's segment.
-func (s VerTwoTwoPlus) NewPtr2() (VerTwoDataTwoPtr, error) {
-	ss, err := NewVerTwoDataTwoPtr(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerTwoDataTwoPtr{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s StackingA) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s VerTwoTwoPlus) Tre() int64 {
-	return int64(capnp.Struct(s).Uint64(16))
+func (s StackingA) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s StackingA) Num() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
 }
 
-func (s VerTwoTwoPlus) SetTre(v int64) {
-	capnp.Struct(s).SetUint64(16, uint64(v))
+func (s StackingA) SetNum(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
 }
 
-func (s VerTwoTwoPlus) Lst3() (capnp.Int64List, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return capnp.Int64List(p.List()), err
+func (s StackingA) B() (StackingB, error) {
+	return capnp.GetStructField[StackingB](s, 0, "StackingA.b")
 }
 
-func (s VerTwoTwoPlus) HasLst3() bool {
-	return capnp.Struct(s).HasPtr(2)
+func (s StackingA) HasB() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s VerTwoTwoPlus) SetLst3(v capnp.Int64List) error {
-	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+func (s StackingA) SetB(v StackingB) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewLst3 sets the lst3 field to a newly
-// allocated capnp.Int64List, preferring placement in s's segment.
-func (s VerTwoTwoPlus) NewLst3(n int32) (capnp.Int64List, error) {
-	l, err := capnp.NewInt64List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.Int64List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
-	return l, err
+// NewB sets the b field to a newly
+// allocated StackingB struct, preferring placement in s's segment.
+func (s StackingA) NewB() (StackingB, error) {
+	return capnp.NewStructField(s, 0, NewStackingB)
 }
 
-// VerTwoTwoPlus_List is a list of VerTwoTwoPlus.
-type VerTwoTwoPlus_List = capnp.StructList[VerTwoTwoPlus]
+// StackingA_List is a list of StackingA.
+type StackingA_List = capnp.StructList[StackingA]
 
-// NewVerTwoTwoPlus creates a new list of VerTwoTwoPlus.
-func NewVerTwoTwoPlus_List(s *capnp.Segment, sz int32) (VerTwoTwoPlus_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
-	return capnp.StructList[VerTwoTwoPlus](l), err
+// NewStackingA creates a new list of StackingA.
+func NewStackingA_List(s *capnp.Segment, sz int32) (StackingA_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[StackingA](l), err
 }
 
-// VerTwoTwoPlus_Future is a wrapper for a VerTwoTwoPlus promised by a client call.
-type VerTwoTwoPlus_Future struct{ *capnp.Future }
+// StackingA_Future is a wrapper for a StackingA promised by a client call.
+type StackingA_Future struct{ *capnp.Future }
 
-func (f VerTwoTwoPlus_Future) Struct() (VerTwoTwoPlus, error) {
+func (f StackingA_Future) Struct() (StackingA, error) {
 	p, err := f.Future.Ptr()
-	return VerTwoTwoPlus(p.Struct()), err
-}
-func (p VerTwoTwoPlus_Future) Ptr1() VerTwoDataTwoPtr_Future {
-	return VerTwoDataTwoPtr_Future{Future: p.Future.Field(0, nil)}
+	return StackingA(p.Struct()), err
 }
-func (p VerTwoTwoPlus_Future) Ptr2() VerTwoDataTwoPtr_Future {
-	return VerTwoDataTwoPtr_Future{Future: p.Future.Field(1, nil)}
+func (p StackingA_Future) B() StackingB_Future {
+	return StackingB_Future{Future: p.Future.Field(0, nil)}
 }
 
-type HoldsText capnp.Struct
+type StackingB capnp.Struct
 
-// HoldsText_TypeID is the unique identifier for the type HoldsText.
-const HoldsText_TypeID = 0xe5817f849ff906dc
+// StackingB_TypeID is the unique identifier for the type StackingB.
+const StackingB_TypeID = 0x85257b30d6edf8c5
 
-func NewHoldsText(s *capnp.Segment) (HoldsText, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
-	return HoldsText(st), err
+func NewStackingB(s *capnp.Segment) (StackingB, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return StackingB(st), err
 }
 
-func NewRootHoldsText(s *capnp.Segment) (HoldsText, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
-	return HoldsText(st), err
+func NewRootStackingB(s *capnp.Segment) (StackingB, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return StackingB(st), err
 }
 
-func ReadRootHoldsText(msg *capnp.Message) (HoldsText, error) {
+func ReadRootStackingB(msg *capnp.Message) (StackingB, error) {
 	root, err := msg.Root()
-	return HoldsText(root.Struct()), err
+	return StackingB(root.Struct()), err
 }
 
-func (s HoldsText) String() string {
-	str, _ := text.Marshal(0xe5817f849ff906dc, capnp.Struct(s))
+func (s StackingB) String() string {
+	str, _ := text.Marshal(0x85257b30d6edf8c5, capnp.Struct(s))
 	return str
 }
 
-func (s HoldsText) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s StackingB) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (HoldsText) DecodeFromPtr(p capnp.Ptr) HoldsText {
-	return HoldsText(capnp.Struct{}.DecodeFromPtr(p))
+func (StackingB) DecodeFromPtr(p capnp.Ptr) StackingB {
+	return StackingB(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s HoldsText) ToPtr() capnp.Ptr {
+func (s StackingB) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s HoldsText) IsValid() bool {
+func (s StackingB) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s HoldsText) Message() *capnp.Message {
+func (s StackingB) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s HoldsText) Segment() *capnp.Segment {
+func (s StackingB) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s HoldsText) Txt() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
-
-func (s HoldsText) HasTxt() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s HoldsText) TxtBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s HoldsText) SetTxt(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
-
-func (s HoldsText) Lst() (capnp.TextList, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return capnp.TextList(p.List()), err
-}
-
-func (s HoldsText) HasLst() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
-
-func (s HoldsText) SetLst(v capnp.TextList) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
-}
-
-// NewLst sets the lst field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s HoldsText) NewLst(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-func (s HoldsText) Lstlst() (capnp.PointerList, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return capnp.PointerList(p.List()), err
-}
-
-func (s HoldsText) HasLstlst() bool {
-	return capnp.Struct(s).HasPtr(2)
-}
-
-func (s HoldsText) SetLstlst(v capnp.PointerList) error {
-	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+func (s StackingB) Num() int32 {
+	return int32(capnp.Struct(s).Uint32(0))
 }
 
-// NewLstlst sets the lstlst field to a newly
-// allocated capnp.PointerList, preferring placement in s's segment.
-func (s HoldsText) NewLstlst(n int32) (capnp.PointerList, error) {
-	l, err := capnp.NewPointerList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.PointerList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
-	return l, err
+func (s StackingB) SetNum(v int32) {
+	capnp.Struct(s).SetUint32(0, uint32(v))
 }
 
-// HoldsText_List is a list of HoldsText.
-type HoldsText_List = capnp.StructList[HoldsText]
+// StackingB_List is a list of StackingB.
+type StackingB_List = capnp.StructList[StackingB]
 
-// NewHoldsText creates a new list of HoldsText.
-func NewHoldsText_List(s *capnp.Segment, sz int32) (HoldsText_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
-	return capnp.StructList[HoldsText](l), err
+// NewStackingB creates a new list of StackingB.
+func NewStackingB_List(s *capnp.Segment, sz int32) (StackingB_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[StackingB](l), err
 }
 
-// HoldsText_Future is a wrapper for a HoldsText promised by a client call.
-type HoldsText_Future struct{ *capnp.Future }
+// StackingB_Future is a wrapper for a StackingB promised by a client call.
+type StackingB_Future struct{ *capnp.Future }
 
-func (f HoldsText_Future) Struct() (HoldsText, error) {
+func (f StackingB_Future) Struct() (StackingB, error) {
 	p, err := f.Future.Ptr()
-	return HoldsText(p.Struct()), err
+	return StackingB(p.Struct()), err
 }
 
-type WrapEmpty capnp.Struct
+type StackingRoot capnp.Struct
 
-// WrapEmpty_TypeID is the unique identifier for the type WrapEmpty.
-const WrapEmpty_TypeID = 0x9ab599979b02ac59
+// StackingRoot_TypeID is the unique identifier for the type StackingRoot.
+const StackingRoot_TypeID = 0x8fae7b41c61fc890
 
-func NewWrapEmpty(s *capnp.Segment) (WrapEmpty, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return WrapEmpty(st), err
+func NewStackingRoot(s *capnp.Segment) (StackingRoot, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return StackingRoot(st), err
 }
 
-func NewRootWrapEmpty(s *capnp.Segment) (WrapEmpty, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return WrapEmpty(st), err
+func NewRootStackingRoot(s *capnp.Segment) (StackingRoot, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return StackingRoot(st), err
 }
 
-func ReadRootWrapEmpty(msg *capnp.Message) (WrapEmpty, error) {
+func ReadRootStackingRoot(msg *capnp.Message) (StackingRoot, error) {
 	root, err := msg.Root()
-	return WrapEmpty(root.Struct()), err
+	return StackingRoot(root.Struct()), err
 }
 
-func (s WrapEmpty) String() string {
-	str, _ := text.Marshal(0x9ab599979b02ac59, capnp.Struct(s))
+func (s StackingRoot) String() string {
+	str, _ := text.Marshal(0x8fae7b41c61fc890, capnp.Struct(s))
 	return str
 }
 
-func (s WrapEmpty) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s StackingRoot) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (WrapEmpty) DecodeFromPtr(p capnp.Ptr) WrapEmpty {
-	return WrapEmpty(capnp.Struct{}.DecodeFromPtr(p))
+func (StackingRoot) DecodeFromPtr(p capnp.Ptr) StackingRoot {
+	return StackingRoot(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s WrapEmpty) ToPtr() capnp.Ptr {
+func (s StackingRoot) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s WrapEmpty) IsValid() bool {
+func (s StackingRoot) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s WrapEmpty) Message() *capnp.Message {
+func (s StackingRoot) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s WrapEmpty) Segment() *capnp.Segment {
+func (s StackingRoot) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s WrapEmpty) MightNotBeReallyEmpty() (VerEmpty, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerEmpty(p.Struct()), err
+func (s StackingRoot) A() (StackingA, error) {
+	return capnp.GetStructField[StackingA](s, 1, "StackingRoot.a")
 }
 
-func (s WrapEmpty) HasMightNotBeReallyEmpty() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s StackingRoot) HasA() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s WrapEmpty) SetMightNotBeReallyEmpty(v VerEmpty) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s StackingRoot) SetA(v StackingA) error {
+	return capnp.SetStructField(s, 1, v)
 }
 
-// NewMightNotBeReallyEmpty sets the mightNotBeReallyEmpty field to a newly
-// allocated VerEmpty struct, preferring placement in s's segment.
-func (s WrapEmpty) NewMightNotBeReallyEmpty() (VerEmpty, error) {
-	ss, err := NewVerEmpty(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerEmpty{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewA sets the a field to a newly
+// allocated StackingA struct, preferring placement in s's segment.
+func (s StackingRoot) NewA() (StackingA, error) {
+	return capnp.NewStructField(s, 1, NewStackingA)
 }
 
-// WrapEmpty_List is a list of WrapEmpty.
-type WrapEmpty_List = capnp.StructList[WrapEmpty]
-
-// NewWrapEmpty creates a new list of WrapEmpty.
-func NewWrapEmpty_List(s *capnp.Segment, sz int32) (WrapEmpty_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[WrapEmpty](l), err
+func (s StackingRoot) AWithDefault() (StackingA, error) {
+	return capnp.GetStructFieldDefault[StackingA](s, 0, "StackingRoot.aWithDefault", x_832bcc6686a26d56[64:96])
 }
 
-// WrapEmpty_Future is a wrapper for a WrapEmpty promised by a client call.
-type WrapEmpty_Future struct{ *capnp.Future }
-
-func (f WrapEmpty_Future) Struct() (WrapEmpty, error) {
-	p, err := f.Future.Ptr()
-	return WrapEmpty(p.Struct()), err
+func (s StackingRoot) HasAWithDefault() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
-func (p WrapEmpty_Future) MightNotBeReallyEmpty() VerEmpty_Future {
-	return VerEmpty_Future{Future: p.Future.Field(0, nil)}
+
+func (s StackingRoot) SetAWithDefault(v StackingA) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-type Wrap2x2 capnp.Struct
+// NewAWithDefault sets the aWithDefault field to a newly
+// allocated StackingA struct, preferring placement in s's segment.
+func (s StackingRoot) NewAWithDefault() (StackingA, error) {
+	return capnp.NewStructField(s, 0, NewStackingA)
+}
 
-// Wrap2x2_TypeID is the unique identifier for the type Wrap2x2.
-const Wrap2x2_TypeID = 0xe1a2d1d51107bead
+// StackingRoot_List is a list of StackingRoot.
+type StackingRoot_List = capnp.StructList[StackingRoot]
 
-func NewWrap2x2(s *capnp.Segment) (Wrap2x2, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Wrap2x2(st), err
+// NewStackingRoot creates a new list of StackingRoot.
+func NewStackingRoot_List(s *capnp.Segment, sz int32) (StackingRoot_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[StackingRoot](l), err
 }
 
-func NewRootWrap2x2(s *capnp.Segment) (Wrap2x2, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Wrap2x2(st), err
+// StackingRoot_Future is a wrapper for a StackingRoot promised by a client call.
+type StackingRoot_Future struct{ *capnp.Future }
+
+func (f StackingRoot_Future) Struct() (StackingRoot, error) {
+	p, err := f.Future.Ptr()
+	return StackingRoot(p.Struct()), err
+}
+func (p StackingRoot_Future) A() StackingA_Future {
+	return StackingA_Future{Future: p.Future.Field(1, nil)}
+}
+func (p StackingRoot_Future) AWithDefault() StackingA_Future {
+	return StackingA_Future{Future: p.Future.Field(0, x_832bcc6686a26d56[96:128])}
 }
 
-func ReadRootWrap2x2(msg *capnp.Message) (Wrap2x2, error) {
+type VerEmpty capnp.Struct
+
+// VerEmpty_TypeID is the unique identifier for the type VerEmpty.
+const VerEmpty_TypeID = 0x93c99951eacc72ff
+
+func NewVerEmpty(s *capnp.Segment) (VerEmpty, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return VerEmpty(st), err
+}
+
+func NewRootVerEmpty(s *capnp.Segment) (VerEmpty, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return VerEmpty(st), err
+}
+
+func ReadRootVerEmpty(msg *capnp.Message) (VerEmpty, error) {
 	root, err := msg.Root()
-	return Wrap2x2(root.Struct()), err
+	return VerEmpty(root.Struct()), err
 }
 
-func (s Wrap2x2) String() string {
-	str, _ := text.Marshal(0xe1a2d1d51107bead, capnp.Struct(s))
+func (s VerEmpty) String() string {
+	str, _ := text.Marshal(0x93c99951eacc72ff, capnp.Struct(s))
 	return str
 }
 
-func (s Wrap2x2) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VerEmpty) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Wrap2x2) DecodeFromPtr(p capnp.Ptr) Wrap2x2 {
-	return Wrap2x2(capnp.Struct{}.DecodeFromPtr(p))
+func (VerEmpty) DecodeFromPtr(p capnp.Ptr) VerEmpty {
+	return VerEmpty(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Wrap2x2) ToPtr() capnp.Ptr {
+func (s VerEmpty) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Wrap2x2) IsValid() bool {
+func (s VerEmpty) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Wrap2x2) Message() *capnp.Message {
+func (s VerEmpty) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Wrap2x2) Segment() *capnp.Segment {
+func (s VerEmpty) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Wrap2x2) MightNotBeReallyEmpty() (VerTwoDataTwoPtr, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoDataTwoPtr(p.Struct()), err
-}
-
-func (s Wrap2x2) HasMightNotBeReallyEmpty() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Wrap2x2) SetMightNotBeReallyEmpty(v VerTwoDataTwoPtr) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
-
-// NewMightNotBeReallyEmpty sets the mightNotBeReallyEmpty field to a newly
-// allocated VerTwoDataTwoPtr struct, preferring placement in s's segment.
-func (s Wrap2x2) NewMightNotBeReallyEmpty() (VerTwoDataTwoPtr, error) {
-	ss, err := NewVerTwoDataTwoPtr(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerTwoDataTwoPtr{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
 
-// Wrap2x2_List is a list of Wrap2x2.
-type Wrap2x2_List = capnp.StructList[Wrap2x2]
+// VerEmpty_List is a list of VerEmpty.
+type VerEmpty_List = capnp.StructList[VerEmpty]
 
-// NewWrap2x2 creates a new list of Wrap2x2.
-func NewWrap2x2_List(s *capnp.Segment, sz int32) (Wrap2x2_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Wrap2x2](l), err
+// NewVerEmpty creates a new list of VerEmpty.
+func NewVerEmpty_List(s *capnp.Segment, sz int32) (VerEmpty_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[VerEmpty](l), err
 }
 
-// Wrap2x2_Future is a wrapper for a Wrap2x2 promised by a client call.
-type Wrap2x2_Future struct{ *capnp.Future }
+// VerEmpty_Future is a wrapper for a VerEmpty promised by a client call.
+type VerEmpty_Future struct{ *capnp.Future }
 
-func (f Wrap2x2_Future) Struct() (Wrap2x2, error) {
+func (f VerEmpty_Future) Struct() (VerEmpty, error) {
 	p, err := f.Future.Ptr()
-	return Wrap2x2(p.Struct()), err
-}
-func (p Wrap2x2_Future) MightNotBeReallyEmpty() VerTwoDataTwoPtr_Future {
-	return VerTwoDataTwoPtr_Future{Future: p.Future.Field(0, nil)}
+	return VerEmpty(p.Struct()), err
 }
 
-type Wrap2x2plus capnp.Struct
+type VerOneData capnp.Struct
 
-// Wrap2x2plus_TypeID is the unique identifier for the type Wrap2x2plus.
-const Wrap2x2plus_TypeID = 0xe684eb3aef1a6859
+// VerOneData_TypeID is the unique identifier for the type VerOneData.
+const VerOneData_TypeID = 0xfca3742893be4cde
 
-func NewWrap2x2plus(s *capnp.Segment) (Wrap2x2plus, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Wrap2x2plus(st), err
+func NewVerOneData(s *capnp.Segment) (VerOneData, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return VerOneData(st), err
 }
 
-func NewRootWrap2x2plus(s *capnp.Segment) (Wrap2x2plus, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Wrap2x2plus(st), err
+func NewRootVerOneData(s *capnp.Segment) (VerOneData, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return VerOneData(st), err
 }
 
-func ReadRootWrap2x2plus(msg *capnp.Message) (Wrap2x2plus, error) {
+func ReadRootVerOneData(msg *capnp.Message) (VerOneData, error) {
 	root, err := msg.Root()
-	return Wrap2x2plus(root.Struct()), err
+	return VerOneData(root.Struct()), err
 }
 
-func (s Wrap2x2plus) String() string {
-	str, _ := text.Marshal(0xe684eb3aef1a6859, capnp.Struct(s))
+func (s VerOneData) String() string {
+	str, _ := text.Marshal(0xfca3742893be4cde, capnp.Struct(s))
 	return str
 }
 
-func (s Wrap2x2plus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VerOneData) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Wrap2x2plus) DecodeFromPtr(p capnp.Ptr) Wrap2x2plus {
-	return Wrap2x2plus(capnp.Struct{}.DecodeFromPtr(p))
+func (VerOneData) DecodeFromPtr(p capnp.Ptr) VerOneData {
+	return VerOneData(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Wrap2x2plus) ToPtr() capnp.Ptr {
+func (s VerOneData) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Wrap2x2plus) IsValid() bool {
+func (s VerOneData) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Wrap2x2plus) Message() *capnp.Message {
+func (s VerOneData) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Wrap2x2plus) Segment() *capnp.Segment {
+func (s VerOneData) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Wrap2x2plus) MightNotBeReallyEmpty() (VerTwoTwoPlus, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return VerTwoTwoPlus(p.Struct()), err
-}
-
-func (s Wrap2x2plus) HasMightNotBeReallyEmpty() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Wrap2x2plus) SetMightNotBeReallyEmpty(v VerTwoTwoPlus) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s VerOneData) Val() int16 {
+	return int16(capnp.Struct(s).Uint16(0))
 }
 
-// NewMightNotBeReallyEmpty sets the mightNotBeReallyEmpty field to a newly
-// allocated VerTwoTwoPlus struct, preferring placement in s's segment.
-func (s Wrap2x2plus) NewMightNotBeReallyEmpty() (VerTwoTwoPlus, error) {
-	ss, err := NewVerTwoTwoPlus(capnp.Struct(s).Segment())
-	if err != nil {
-		return VerTwoTwoPlus{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s VerOneData) SetVal(v int16) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-// Wrap2x2plus_List is a list of Wrap2x2plus.
-type Wrap2x2plus_List = capnp.StructList[Wrap2x2plus]
+// VerOneData_List is a list of VerOneData.
+type VerOneData_List = capnp.StructList[VerOneData]
 
-// NewWrap2x2plus creates a new list of Wrap2x2plus.
-func NewWrap2x2plus_List(s *capnp.Segment, sz int32) (Wrap2x2plus_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Wrap2x2plus](l), err
+// NewVerOneData creates a new list of VerOneData.
+func NewVerOneData_List(s *capnp.Segment, sz int32) (VerOneData_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[VerOneData](l), err
 }
 
-// Wrap2x2plus_Future is a wrapper for a Wrap2x2plus promised by a client call.
-type Wrap2x2plus_Future struct{ *capnp.Future }
+// VerOneData_Future is a wrapper for a VerOneData promised by a client call.
+type VerOneData_Future struct{ *capnp.Future }
 
-func (f Wrap2x2plus_Future) Struct() (Wrap2x2plus, error) {
+func (f VerOneData_Future) Struct() (VerOneData, error) {
 	p, err := f.Future.Ptr()
-	return Wrap2x2plus(p.Struct()), err
-}
-func (p Wrap2x2plus_Future) MightNotBeReallyEmpty() VerTwoTwoPlus_Future {
-	return VerTwoTwoPlus_Future{Future: p.Future.Field(0, nil)}
+	return VerOneData(p.Struct()), err
 }
 
-type VoidUnion capnp.Struct
-type VoidUnion_Which uint16
-
-const (
-	VoidUnion_Which_a VoidUnion_Which = 0
-	VoidUnion_Which_b VoidUnion_Which = 1
-)
-
-func (w VoidUnion_Which) String() string {
-	const s = "ab"
-	switch w {
-	case VoidUnion_Which_a:
-		return s[0:1]
-	case VoidUnion_Which_b:
-		return s[1:2]
-
-	}
-	return "VoidUnion_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
+type VerOnePtr capnp.Struct
 
-// VoidUnion_TypeID is the unique identifier for the type VoidUnion.
-const VoidUnion_TypeID = 0x8821cdb23640783a
+// VerOnePtr_TypeID is the unique identifier for the type VerOnePtr.
+const VerOnePtr_TypeID = 0x94bf7df83408218d
 
-func NewVoidUnion(s *capnp.Segment) (VoidUnion, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return VoidUnion(st), err
+func NewVerOnePtr(s *capnp.Segment) (VerOnePtr, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return VerOnePtr(st), err
 }
 
-func NewRootVoidUnion(s *capnp.Segment) (VoidUnion, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return VoidUnion(st), err
+func NewRootVerOnePtr(s *capnp.Segment) (VerOnePtr, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return VerOnePtr(st), err
 }
 
-func ReadRootVoidUnion(msg *capnp.Message) (VoidUnion, error) {
+func ReadRootVerOnePtr(msg *capnp.Message) (VerOnePtr, error) {
 	root, err := msg.Root()
-	return VoidUnion(root.Struct()), err
+	return VerOnePtr(root.Struct()), err
 }
 
-func (s VoidUnion) String() string {
-	str, _ := text.Marshal(0x8821cdb23640783a, capnp.Struct(s))
+func (s VerOnePtr) String() string {
+	str, _ := text.Marshal(0x94bf7df83408218d, capnp.Struct(s))
 	return str
 }
 
-func (s VoidUnion) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VerOnePtr) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VoidUnion) DecodeFromPtr(p capnp.Ptr) VoidUnion {
-	return VoidUnion(capnp.Struct{}.DecodeFromPtr(p))
+func (VerOnePtr) DecodeFromPtr(p capnp.Ptr) VerOnePtr {
+	return VerOnePtr(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VoidUnion) ToPtr() capnp.Ptr {
+func (s VerOnePtr) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s VoidUnion) Which() VoidUnion_Which {
-	return VoidUnion_Which(capnp.Struct(s).Uint16(0))
-}
-func (s VoidUnion) IsValid() bool {
+func (s VerOnePtr) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VoidUnion) Message() *capnp.Message {
+func (s VerOnePtr) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VoidUnion) Segment() *capnp.Segment {
+func (s VerOnePtr) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VoidUnion) SetA() {
-	capnp.Struct(s).SetUint16(0, 0)
-
+func (s VerOnePtr) Ptr() (VerOneData, error) {
+	return capnp.GetStructField[VerOneData](s, 0, "VerOnePtr.ptr")
 }
 
-func (s VoidUnion) SetB() {
-	capnp.Struct(s).SetUint16(0, 1)
+func (s VerOnePtr) HasPtr() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
+func (s VerOnePtr) SetPtr(v VerOneData) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// VoidUnion_List is a list of VoidUnion.
-type VoidUnion_List = capnp.StructList[VoidUnion]
+// NewPtr sets the ptr field to a newly
+// allocated VerOneData struct, preferring placement in s's segment.
+func (s VerOnePtr) NewPtr() (VerOneData, error) {
+	return capnp.NewStructField(s, 0, NewVerOneData)
+}
 
-// NewVoidUnion creates a new list of VoidUnion.
-func NewVoidUnion_List(s *capnp.Segment, sz int32) (VoidUnion_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[VoidUnion](l), err
+// VerOnePtr_List is a list of VerOnePtr.
+type VerOnePtr_List = capnp.StructList[VerOnePtr]
+
+// NewVerOnePtr creates a new list of VerOnePtr.
+func NewVerOnePtr_List(s *capnp.Segment, sz int32) (VerOnePtr_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[VerOnePtr](l), err
 }
 
-// VoidUnion_Future is a wrapper for a VoidUnion promised by a client call.
-type VoidUnion_Future struct{ *capnp.Future }
+// VerOnePtr_Future is a wrapper for a VerOnePtr promised by a client call.
+type VerOnePtr_Future struct{ *capnp.Future }
 
-func (f VoidUnion_Future) Struct() (VoidUnion, error) {
+func (f VerOnePtr_Future) Struct() (VerOnePtr, error) {
 	p, err := f.Future.Ptr()
-	return VoidUnion(p.Struct()), err
+	return VerOnePtr(p.Struct()), err
+}
+func (p VerOnePtr_Future) Ptr() VerOneData_Future {
+	return VerOneData_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Nester1Capn capnp.Struct
+type VerTwoData capnp.Struct
 
-// Nester1Capn_TypeID is the unique identifier for the type Nester1Capn.
-const Nester1Capn_TypeID = 0xf14fad09425d081c
+// VerTwoData_TypeID is the unique identifier for the type VerTwoData.
+const VerTwoData_TypeID = 0xf705dc45c94766fd
 
-func NewNester1Capn(s *capnp.Segment) (Nester1Capn, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Nester1Capn(st), err
+func NewVerTwoData(s *capnp.Segment) (VerTwoData, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return VerTwoData(st), err
 }
 
-func NewRootNester1Capn(s *capnp.Segment) (Nester1Capn, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Nester1Capn(st), err
+func NewRootVerTwoData(s *capnp.Segment) (VerTwoData, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return VerTwoData(st), err
 }
 
-func ReadRootNester1Capn(msg *capnp.Message) (Nester1Capn, error) {
+func ReadRootVerTwoData(msg *capnp.Message) (VerTwoData, error) {
 	root, err := msg.Root()
-	return Nester1Capn(root.Struct()), err
+	return VerTwoData(root.Struct()), err
 }
 
-func (s Nester1Capn) String() string {
-	str, _ := text.Marshal(0xf14fad09425d081c, capnp.Struct(s))
+func (s VerTwoData) String() string {
+	str, _ := text.Marshal(0xf705dc45c94766fd, capnp.Struct(s))
 	return str
 }
 
-func (s Nester1Capn) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VerTwoData) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Nester1Capn) DecodeFromPtr(p capnp.Ptr) Nester1Capn {
-	return Nester1Capn(capnp.Struct{}.DecodeFromPtr(p))
+func (VerTwoData) DecodeFromPtr(p capnp.Ptr) VerTwoData {
+	return VerTwoData(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Nester1Capn) ToPtr() capnp.Ptr {
+func (s VerTwoData) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Nester1Capn) IsValid() bool {
+func (s VerTwoData) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Nester1Capn) Message() *capnp.Message {
+func (s VerTwoData) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Nester1Capn) Segment() *capnp.Segment {
+func (s VerTwoData) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Nester1Capn) Strs() (capnp.TextList, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.TextList(p.List()), err
+func (s VerTwoData) Val() int16 {
+	return int16(capnp.Struct(s).Uint16(0))
 }
 
-func (s Nester1Capn) HasStrs() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s VerTwoData) SetVal(v int16) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-func (s Nester1Capn) SetStrs(v capnp.TextList) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s VerTwoData) Duo() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
 }
 
-// NewStrs sets the strs field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Nester1Capn) NewStrs(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s VerTwoData) SetDuo(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
 }
 
-// Nester1Capn_List is a list of Nester1Capn.
-type Nester1Capn_List = capnp.StructList[Nester1Capn]
+// VerTwoData_List is a list of VerTwoData.
+type VerTwoData_List = capnp.StructList[VerTwoData]
 
-// NewNester1Capn creates a new list of Nester1Capn.
-func NewNester1Capn_List(s *capnp.Segment, sz int32) (Nester1Capn_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Nester1Capn](l), err
+// NewVerTwoData creates a new list of VerTwoData.
+func NewVerTwoData_List(s *capnp.Segment, sz int32) (VerTwoData_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[VerTwoData](l), err
 }
 
-// Nester1Capn_Future is a wrapper for a Nester1Capn promised by a client call.
-type Nester1Capn_Future struct{ *capnp.Future }
+// VerTwoData_Future is a wrapper for a VerTwoData promised by a client call.
+type VerTwoData_Future struct{ *capnp.Future }
 
-func (f Nester1Capn_Future) Struct() (Nester1Capn, error) {
+func (f VerTwoData_Future) Struct() (VerTwoData, error) {
 	p, err := f.Future.Ptr()
-	return Nester1Capn(p.Struct()), err
+	return VerTwoData(p.Struct()), err
 }
 
-type RWTestCapn capnp.Struct
+type VerTwoDataTwoPtr capnp.Struct
 
-// RWTestCapn_TypeID is the unique identifier for the type RWTestCapn.
-const RWTestCapn_TypeID = 0xf7ff4414476c186a
+// VerTwoDataTwoPtr_TypeID is the unique identifier for the type VerTwoDataTwoPtr.
+const VerTwoDataTwoPtr_TypeID = 0xb61ee2ecff34ca73
 
-func NewRWTestCapn(s *capnp.Segment) (RWTestCapn, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return RWTestCapn(st), err
+func NewVerTwoDataTwoPtr(s *capnp.Segment) (VerTwoDataTwoPtr, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return VerTwoDataTwoPtr(st), err
 }
 
-func NewRootRWTestCapn(s *capnp.Segment) (RWTestCapn, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return RWTestCapn(st), err
+func NewRootVerTwoDataTwoPtr(s *capnp.Segment) (VerTwoDataTwoPtr, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return VerTwoDataTwoPtr(st), err
 }
 
-func ReadRootRWTestCapn(msg *capnp.Message) (RWTestCapn, error) {
+func ReadRootVerTwoDataTwoPtr(msg *capnp.Message) (VerTwoDataTwoPtr, error) {
 	root, err := msg.Root()
-	return RWTestCapn(root.Struct()), err
+	return VerTwoDataTwoPtr(root.Struct()), err
 }
 
-func (s RWTestCapn) String() string {
-	str, _ := text.Marshal(0xf7ff4414476c186a, capnp.Struct(s))
+func (s VerTwoDataTwoPtr) String() string {
+	str, _ := text.Marshal(0xb61ee2ecff34ca73, capnp.Struct(s))
 	return str
 }
 
-func (s RWTestCapn) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VerTwoDataTwoPtr) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (RWTestCapn) DecodeFromPtr(p capnp.Ptr) RWTestCapn {
-	return RWTestCapn(capnp.Struct{}.DecodeFromPtr(p))
+func (VerTwoDataTwoPtr) DecodeFromPtr(p capnp.Ptr) VerTwoDataTwoPtr {
+	return VerTwoDataTwoPtr(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s RWTestCapn) ToPtr() capnp.Ptr {
+func (s VerTwoDataTwoPtr) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s RWTestCapn) IsValid() bool {
+func (s VerTwoDataTwoPtr) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s RWTestCapn) Message() *capnp.Message {
+func (s VerTwoDataTwoPtr) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s RWTestCapn) Segment() *capnp.Segment {
+func (s VerTwoDataTwoPtr) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s RWTestCapn) NestMatrix() (capnp.PointerList, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return capnp.PointerList(p.List()), err
+func (s VerTwoDataTwoPtr) Val() int16 {
+	return int16(capnp.Struct(s).Uint16(0))
 }
 
-func (s RWTestCapn) HasNestMatrix() bool {
+func (s VerTwoDataTwoPtr) SetVal(v int16) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
+}
+
+func (s VerTwoDataTwoPtr) Duo() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
+}
+
+func (s VerTwoDataTwoPtr) SetDuo(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
+}
+
+func (s VerTwoDataTwoPtr) Ptr1() (VerOneData, error) {
+	return capnp.GetStructField[VerOneData](s, 0, "VerTwoDataTwoPtr.ptr1")
+}
+
+func (s VerTwoDataTwoPtr) HasPtr1() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s RWTestCapn) SetNestMatrix(v capnp.PointerList) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s VerTwoDataTwoPtr) SetPtr1(v VerOneData) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewNestMatrix sets the nestMatrix field to a newly
-// allocated capnp.PointerList, preferring placement in s's segment.
-func (s RWTestCapn) NewNestMatrix(n int32) (capnp.PointerList, error) {
-	l, err := capnp.NewPointerList(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return capnp.PointerList{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// NewPtr1 sets the ptr1 field to a newly
+// allocated VerOneData struct, preferring placement in s's segment.
+func (s VerTwoDataTwoPtr) NewPtr1() (VerOneData, error) {
+	return capnp.NewStructField(s, 0, NewVerOneData)
 }
 
-// RWTestCapn_List is a list of RWTestCapn.
-type RWTestCapn_List = capnp.StructList[RWTestCapn]
+func (s VerTwoDataTwoPtr) Ptr2() (VerOneData, error) {
+	return capnp.GetStructField[VerOneData](s, 1, "VerTwoDataTwoPtr.ptr2")
+}
 
-// NewRWTestCapn creates a new list of RWTestCapn.
-func NewRWTestCapn_List(s *capnp.Segment, sz int32) (RWTestCapn_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[RWTestCapn](l), err
+func (s VerTwoDataTwoPtr) HasPtr2() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// RWTestCapn_Future is a wrapper for a RWTestCapn promised by a client call.
-type RWTestCapn_Future struct{ *capnp.Future }
+func (s VerTwoDataTwoPtr) SetPtr2(v VerOneData) error {
+	return capnp.SetStructField(s, 1, v)
+}
 
-func (f RWTestCapn_Future) Struct() (RWTestCapn, error) {
+// NewPtr2 sets the ptr2 field to a newly
+// allocated VerOneData struct, preferring placement in s's segment.
+func (s VerTwoDataTwoPtr) NewPtr2() (VerOneData, error) {
+	return capnp.NewStructField(s, 1, NewVerOneData)
+}
+
+// VerTwoDataTwoPtr_List is a list of VerTwoDataTwoPtr.
+type VerTwoDataTwoPtr_List = capnp.StructList[VerTwoDataTwoPtr]
+
+// NewVerTwoDataTwoPtr creates a new list of VerTwoDataTwoPtr.
+func NewVerTwoDataTwoPtr_List(s *capnp.Segment, sz int32) (VerTwoDataTwoPtr_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	return capnp.StructList[VerTwoDataTwoPtr](l), err
+}
+
+// VerTwoDataTwoPtr_Future is a wrapper for a VerTwoDataTwoPtr promised by a client call.
+type VerTwoDataTwoPtr_Future struct{ *capnp.Future }
+
+func (f VerTwoDataTwoPtr_Future) Struct() (VerTwoDataTwoPtr, error) {
 	p, err := f.Future.Ptr()
-	return RWTestCapn(p.Struct()), err
+	return VerTwoDataTwoPtr(p.Struct()), err
+}
+func (p VerTwoDataTwoPtr_Future) Ptr1() VerOneData_Future {
+	return VerOneData_Future{Future: p.Future.Field(0, nil)}
+}
+func (p VerTwoDataTwoPtr_Future) Ptr2() VerOneData_Future {
+	return VerOneData_Future{Future: p.Future.Field(1, nil)}
 }
 
-type ListStructCapn capnp.Struct
+type VerTwoPtr capnp.Struct
 
-// ListStructCapn_TypeID is the unique identifier for the type ListStructCapn.
-const ListStructCapn_TypeID = 0xb1ac056ed7647011
+// VerTwoPtr_TypeID is the unique identifier for the type VerTwoPtr.
+const VerTwoPtr_TypeID = 0xc95babe3bd394d2d
 
-func NewListStructCapn(s *capnp.Segment) (ListStructCapn, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ListStructCapn(st), err
+func NewVerTwoPtr(s *capnp.Segment) (VerTwoPtr, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return VerTwoPtr(st), err
 }
 
-func NewRootListStructCapn(s *capnp.Segment) (ListStructCapn, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return ListStructCapn(st), err
+func NewRootVerTwoPtr(s *capnp.Segment) (VerTwoPtr, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return VerTwoPtr(st), err
 }
 
-func ReadRootListStructCapn(msg *capnp.Message) (ListStructCapn, error) {
+func ReadRootVerTwoPtr(msg *capnp.Message) (VerTwoPtr, error) {
 	root, err := msg.Root()
-	return ListStructCapn(root.Struct()), err
+	return VerTwoPtr(root.Struct()), err
 }
 
-func (s ListStructCapn) String() string {
-	str, _ := text.Marshal(0xb1ac056ed7647011, capnp.Struct(s))
+func (s VerTwoPtr) String() string {
+	str, _ := text.Marshal(0xc95babe3bd394d2d, capnp.Struct(s))
 	return str
 }
 
-func (s ListStructCapn) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VerTwoPtr) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (ListStructCapn) DecodeFromPtr(p capnp.Ptr) ListStructCapn {
-	return ListStructCapn(capnp.Struct{}.DecodeFromPtr(p))
+func (VerTwoPtr) DecodeFromPtr(p capnp.Ptr) VerTwoPtr {
+	return VerTwoPtr(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s ListStructCapn) ToPtr() capnp.Ptr {
+func (s VerTwoPtr) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s ListStructCapn) IsValid() bool {
+func (s VerTwoPtr) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s ListStructCapn) Message() *capnp.Message {
+func (s VerTwoPtr) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s ListStructCapn) Segment() *capnp.Segment {
+func (s VerTwoPtr) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s ListStructCapn) Vec() (Nester1Capn_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Nester1Capn_List(p.List()), err
+func (s VerTwoPtr) Ptr1() (VerOneData, error) {
+	return capnp.GetStructField[VerOneData](s, 0, "VerTwoPtr.ptr1")
 }
 
-func (s ListStructCapn) HasVec() bool {
+func (s VerTwoPtr) HasPtr1() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s ListStructCapn) SetVec(v Nester1Capn_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s VerTwoPtr) SetPtr1(v VerOneData) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewVec sets the vec field to a newly
-// allocated Nester1Capn_List, preferring placement in s's segment.
-func (s ListStructCapn) NewVec(n int32) (Nester1Capn_List, error) {
-	l, err := NewNester1Capn_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Nester1Capn_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+// NewPtr1 sets the ptr1 field to a newly
+// allocated VerOneData struct, preferring placement in s's segment.
+func (s VerTwoPtr) NewPtr1() (VerOneData, error) {
+	return capnp.NewStructField(s, 0, NewVerOneData)
 }
 
-// ListStructCapn_List is a list of ListStructCapn.
-type ListStructCapn_List = capnp.StructList[ListStructCapn]
+func (s VerTwoPtr) Ptr2() (VerOneData, error) {
+	return capnp.GetStructField[VerOneData](s, 1, "VerTwoPtr.ptr2")
+}
 
-// NewListStructCapn creates a new list of ListStructCapn.
-func NewListStructCapn_List(s *capnp.Segment, sz int32) (ListStructCapn_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[ListStructCapn](l), err
+func (s VerTwoPtr) HasPtr2() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// ListStructCapn_Future is a wrapper for a ListStructCapn promised by a client call.
-type ListStructCapn_Future struct{ *capnp.Future }
+func (s VerTwoPtr) SetPtr2(v VerOneData) error {
+	return capnp.SetStructField(s, 1, v)
+}
 
-func (f ListStructCapn_Future) Struct() (ListStructCapn, error) {
-	p, err := f.Future.Ptr()
-	return ListStructCapn(p.Struct()), err
+// NewPtr2 sets the ptr2 field to a newly
+// allocated VerOneData struct, preferring placement in s's segment.
+func (s VerTwoPtr) NewPtr2() (VerOneData, error) {
+	return capnp.NewStructField(s, 1, NewVerOneData)
 }
 
-type Echo capnp.Client
+// VerTwoPtr_List is a list of VerTwoPtr.
+type VerTwoPtr_List = capnp.StructList[VerTwoPtr]
 
-// Echo_TypeID is the unique identifier for the type Echo.
-const Echo_TypeID = 0x8e5322c1e9282534
+// NewVerTwoPtr creates a new list of VerTwoPtr.
+func NewVerTwoPtr_List(s *capnp.Segment, sz int32) (VerTwoPtr_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[VerTwoPtr](l), err
+}
 
-func (c Echo) Echo(ctx context.Context, params func(Echo_echo_Params) error) (Echo_echo_Results_Future, capnp.ReleaseFunc) {
+// VerTwoPtr_Future is a wrapper for a VerTwoPtr promised by a client call.
+type VerTwoPtr_Future struct{ *capnp.Future }
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0x8e5322c1e9282534,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:Echo",
-			MethodName:    "echo",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(Echo_echo_Params(s)) }
-	}
+func (f VerTwoPtr_Future) Struct() (VerTwoPtr, error) {
+	p, err := f.Future.Ptr()
+	return VerTwoPtr(p.Struct()), err
+}
+func (p VerTwoPtr_Future) Ptr1() VerOneData_Future {
+	return VerOneData_Future{Future: p.Future.Field(0, nil)}
+}
+func (p VerTwoPtr_Future) Ptr2() VerOneData_Future {
+	return VerOneData_Future{Future: p.Future.Field(1, nil)}
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return Echo_echo_Results_Future{Future: ans.Future()}, release
+type VerTwoTwoPlus capnp.Struct
 
-}
+// VerTwoTwoPlus_TypeID is the unique identifier for the type VerTwoTwoPlus.
+const VerTwoTwoPlus_TypeID = 0xce44aee2d9e25049
 
-func (c Echo) WaitStreaming() error {
-	return capnp.Client(c).WaitStreaming()
+func NewVerTwoTwoPlus(s *capnp.Segment) (VerTwoTwoPlus, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	return VerTwoTwoPlus(st), err
 }
 
-// String returns a string that identifies this capability for debugging
-// purposes.  Its format should not be depended on: in particular, it
-// should not be used to compare clients.  Use IsSame to compare clients
-// for equality.
-func (c Echo) String() string {
-	return "Echo(" + capnp.Client(c).String() + ")"
+func NewRootVerTwoTwoPlus(s *capnp.Segment) (VerTwoTwoPlus, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	return VerTwoTwoPlus(st), err
 }
 
-// AddRef creates a new Client that refers to the same capability as c.
-// If c is nil or has resolved to null, then AddRef returns nil.
-func (c Echo) AddRef() Echo {
-	return Echo(capnp.Client(c).AddRef())
+func ReadRootVerTwoTwoPlus(msg *capnp.Message) (VerTwoTwoPlus, error) {
+	root, err := msg.Root()
+	return VerTwoTwoPlus(root.Struct()), err
 }
 
-// Release releases a capability reference.  If this is the last
-// reference to the capability, then the underlying resources associated
-// with the capability will be released.
-//
-// Release will panic if c has already been released, but not if c is
-// nil or resolved to null.
-func (c Echo) Release() {
-	capnp.Client(c).Release()
+func (s VerTwoTwoPlus) String() string {
+	str, _ := text.Marshal(0xce44aee2d9e25049, capnp.Struct(s))
+	return str
 }
 
-// Resolve blocks until the capability is fully resolved or the Context
-// expires.
-func (c Echo) Resolve(ctx context.Context) error {
-	return capnp.Client(c).Resolve(ctx)
+func (s VerTwoTwoPlus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (c Echo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Client(c).EncodeAsPtr(seg)
+func (VerTwoTwoPlus) DecodeFromPtr(p capnp.Ptr) VerTwoTwoPlus {
+	return VerTwoTwoPlus(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (Echo) DecodeFromPtr(p capnp.Ptr) Echo {
-	return Echo(capnp.Client{}.DecodeFromPtr(p))
+func (s VerTwoTwoPlus) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-// IsValid reports whether c is a valid reference to a capability.
-// A reference is invalid if it is nil, has resolved to null, or has
-// been released.
-func (c Echo) IsValid() bool {
-	return capnp.Client(c).IsValid()
+func (s VerTwoTwoPlus) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// IsSame reports whether c and other refer to a capability created by the
-// same call to NewClient.  This can return false negatives if c or other
-// are not fully resolved: use Resolve if this is an issue.  If either
-// c or other are released, then IsSame panics.
-func (c Echo) IsSame(other Echo) bool {
-	return capnp.Client(c).IsSame(capnp.Client(other))
+func (s VerTwoTwoPlus) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// Update the flowcontrol.FlowLimiter used to manage flow control for
-// this client. This affects all future calls, but not calls already
-// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
-// which is also the default.
-func (c Echo) SetFlowLimiter(lim fc.FlowLimiter) {
-	capnp.Client(c).SetFlowLimiter(lim)
+func (s VerTwoTwoPlus) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c Echo) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
+func (s VerTwoTwoPlus) Val() int16 {
+	return int16(capnp.Struct(s).Uint16(0))
 }
 
-// A Echo_Server is a Echo with a local implementation.
-type Echo_Server interface {
-	Echo(context.Context, Echo_echo) error
+func (s VerTwoTwoPlus) SetVal(v int16) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-// Echo_NewServer creates a new Server from an implementation of Echo_Server.
-func Echo_NewServer(s Echo_Server) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(Echo_Methods(nil, s), s, c)
+func (s VerTwoTwoPlus) Duo() int64 {
+	return int64(capnp.Struct(s).Uint64(8))
 }
 
-// Echo_ServerToClient creates a new Client from an implementation of Echo_Server.
-// The caller is responsible for calling Release on the returned Client.
-func Echo_ServerToClient(s Echo_Server) Echo {
-	return Echo(capnp.NewClient(Echo_NewServer(s)))
+func (s VerTwoTwoPlus) SetDuo(v int64) {
+	capnp.Struct(s).SetUint64(8, uint64(v))
 }
 
-// Echo_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func Echo_Methods(methods []server.Method, s Echo_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 1)
-	}
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0x8e5322c1e9282534,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:Echo",
-			MethodName:    "echo",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Echo(ctx, Echo_echo{call})
-		},
-	})
-
-	return methods
+func (s VerTwoTwoPlus) Ptr1() (VerTwoDataTwoPtr, error) {
+	return capnp.GetStructField[VerTwoDataTwoPtr](s, 0, "VerTwoTwoPlus.ptr1")
 }
 
-// Echo_echo holds the state for a server call to Echo.echo.
-// See server.Call for documentation.
-type Echo_echo struct {
-	*server.Call
+func (s VerTwoTwoPlus) HasPtr1() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Args returns the call's arguments.
-func (c Echo_echo) Args() Echo_echo_Params {
-	return Echo_echo_Params(c.Call.Args())
+func (s VerTwoTwoPlus) SetPtr1(v VerTwoDataTwoPtr) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// AllocResults allocates the results struct.
-func (c Echo_echo) AllocResults() (Echo_echo_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Echo_echo_Results(r), err
+// NewPtr1 sets the ptr1 field to a newly
+// allocated VerTwoDataTwoPtr struct, preferring placement in s's segment.
+func (s VerTwoTwoPlus) NewPtr1() (VerTwoDataTwoPtr, error) {
+	return capnp.NewStructField(s, 0, NewVerTwoDataTwoPtr)
 }
 
-// Echo_List is a list of Echo.
-type Echo_List = capnp.CapList[Echo]
-
-// NewEcho creates a new list of Echo.
-func NewEcho_List(s *capnp.Segment, sz int32) (Echo_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[Echo](l), err
+func (s VerTwoTwoPlus) Ptr2() (VerTwoDataTwoPtr, error) {
+	return capnp.GetStructField[VerTwoDataTwoPtr](s, 1, "VerTwoTwoPlus.ptr2")
 }
 
-type Echo_echo_Params capnp.Struct
-
-// Echo_echo_Params_TypeID is the unique identifier for the type Echo_echo_Params.
-const Echo_echo_Params_TypeID = 0x8a165fb4d71bf3a2
-
-func NewEcho_echo_Params(s *capnp.Segment) (Echo_echo_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Echo_echo_Params(st), err
+func (s VerTwoTwoPlus) HasPtr2() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func NewRootEcho_echo_Params(s *capnp.Segment) (Echo_echo_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Echo_echo_Params(st), err
+func (s VerTwoTwoPlus) SetPtr2(v VerTwoDataTwoPtr) error {
+	return capnp.SetStructField(s, 1, v)
 }
 
-func ReadRootEcho_echo_Params(msg *capnp.Message) (Echo_echo_Params, error) {
-	root, err := msg.Root()
-	return Echo_echo_Params(root.Struct()), err
+// NewPtr2 sets the ptr2 field to a newly
+// allocated VerTwoDataTwoPtr struct, preferring placement in s's segment.
+func (s VerTwoTwoPlus) NewPtr2() (VerTwoDataTwoPtr, error) {
+	return capnp.NewStructField(s, 1, NewVerTwoDataTwoPtr)
 }
 
-func (s Echo_echo_Params) String() string {
-	str, _ := text.Marshal(0x8a165fb4d71bf3a2, capnp.Struct(s))
-	return str
+func (s VerTwoTwoPlus) Tre() int64 {
+	return int64(capnp.Struct(s).Uint64(16))
 }
 
-func (s Echo_echo_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s VerTwoTwoPlus) SetTre(v int64) {
+	capnp.Struct(s).SetUint64(16, uint64(v))
 }
 
-func (Echo_echo_Params) DecodeFromPtr(p capnp.Ptr) Echo_echo_Params {
-	return Echo_echo_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (s VerTwoTwoPlus) Lst3() (capnp.Int64List, error) {
+	return capnp.GetListField[capnp.Int64List](s, 2, "VerTwoTwoPlus.lst3")
 }
 
-func (s Echo_echo_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (s VerTwoTwoPlus) HasLst3() bool {
+	return capnp.Struct(s).HasPtr(2)
 }
-func (s Echo_echo_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+func (s VerTwoTwoPlus) SetLst3(v capnp.Int64List) error {
+	return capnp.SetListField(s, 2, v)
 }
 
-func (s Echo_echo_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// NewLst3 sets the lst3 field to a newly
+// allocated capnp.Int64List, preferring placement in s's segment.
+func (s VerTwoTwoPlus) NewLst3(n int32) (capnp.Int64List, error) {
+	return capnp.NewListField(s, 2, n, capnp.NewInt64List)
 }
 
-func (s Echo_echo_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// SetLst3FromSlice sets the lst3 field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s VerTwoTwoPlus) SetLst3FromSlice(v []int64) error {
+	return capnp.SetListFieldFromSlice(s, 2, v, capnp.NewInt64ListFromSlice)
 }
-func (s Echo_echo_Params) In() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+
+// VerTwoTwoPlus_List is a list of VerTwoTwoPlus.
+type VerTwoTwoPlus_List = capnp.StructList[VerTwoTwoPlus]
+
+// NewVerTwoTwoPlus creates a new list of VerTwoTwoPlus.
+func NewVerTwoTwoPlus_List(s *capnp.Segment, sz int32) (VerTwoTwoPlus_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
+	return capnp.StructList[VerTwoTwoPlus](l), err
 }
 
-func (s Echo_echo_Params) HasIn() bool {
-	return capnp.Struct(s).HasPtr(0)
+// VerTwoTwoPlus_Future is a wrapper for a VerTwoTwoPlus promised by a client call.
+type VerTwoTwoPlus_Future struct{ *capnp.Future }
+
+func (f VerTwoTwoPlus_Future) Struct() (VerTwoTwoPlus, error) {
+	p, err := f.Future.Ptr()
+	return VerTwoTwoPlus(p.Struct()), err
+}
+func (p VerTwoTwoPlus_Future) Ptr1() VerTwoDataTwoPtr_Future {
+	return VerTwoDataTwoPtr_Future{Future: p.Future.Field(0, nil)}
+}
+func (p VerTwoTwoPlus_Future) Ptr2() VerTwoDataTwoPtr_Future {
+	return VerTwoDataTwoPtr_Future{Future: p.Future.Field(1, nil)}
 }
 
-func (s Echo_echo_Params) InBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+type VoidUnion capnp.Struct
+type VoidUnion_Which uint16
+
+const (
+	VoidUnion_Which_a VoidUnion_Which = 0
+	VoidUnion_Which_b VoidUnion_Which = 1
+)
+
+func (w VoidUnion_Which) String() string {
+	const s = "ab"
+	switch w {
+	case VoidUnion_Which_a:
+		return s[0:1]
+	case VoidUnion_Which_b:
+		return s[1:2]
+
+	}
+	return "VoidUnion_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func (s Echo_echo_Params) SetIn(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// VoidUnion_Visitor has a method for each member of the union of
+// VoidUnion, which Visit calls with the member's value.
+type VoidUnion_Visitor interface {
+	VisitA() error
+	VisitB() error
 }
 
-// Echo_echo_Params_List is a list of Echo_echo_Params.
-type Echo_echo_Params_List = capnp.StructList[Echo_echo_Params]
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s VoidUnion) Visit(v VoidUnion_Visitor) error {
+	switch w := s.Which(); w {
+	case VoidUnion_Which_a:
+		return v.VisitA()
+	case VoidUnion_Which_b:
+		return v.VisitB()
+	default:
+		return &capnp.UnknownMemberError{Union: "VoidUnion", Which: uint16(w)}
+	}
+}
 
-// NewEcho_echo_Params creates a new list of Echo_echo_Params.
-func NewEcho_echo_Params_List(s *capnp.Segment, sz int32) (Echo_echo_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Echo_echo_Params](l), err
+// VoidUnion_TypeID is the unique identifier for the type VoidUnion.
+const VoidUnion_TypeID = 0x8821cdb23640783a
+
+func NewVoidUnion(s *capnp.Segment) (VoidUnion, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return VoidUnion(st), err
 }
 
-// Echo_echo_Params_Future is a wrapper for a Echo_echo_Params promised by a client call.
-type Echo_echo_Params_Future struct{ *capnp.Future }
+func NewRootVoidUnion(s *capnp.Segment) (VoidUnion, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return VoidUnion(st), err
+}
 
-func (f Echo_echo_Params_Future) Struct() (Echo_echo_Params, error) {
+func ReadRootVoidUnion(msg *capnp.Message) (VoidUnion, error) {
+	root, err := msg.Root()
+	return VoidUnion(root.Struct()), err
+}
+
+func (s VoidUnion) String() string {
+	str, _ := text.Marshal(0x8821cdb23640783a, capnp.Struct(s))
+	return str
+}
+
+func (s VoidUnion) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (VoidUnion) DecodeFromPtr(p capnp.Ptr) VoidUnion {
+	return VoidUnion(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s VoidUnion) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+
+func (s VoidUnion) Which() VoidUnion_Which {
+	return VoidUnion_Which(capnp.Struct(s).Uint16(0))
+}
+func (s VoidUnion) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s VoidUnion) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s VoidUnion) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s VoidUnion) SetA() {
+	capnp.Struct(s).SetUint16(0, 0)
+
+}
+
+func (s VoidUnion) SetB() {
+	capnp.Struct(s).SetUint16(0, 1)
+
+}
+
+// VoidUnion_List is a list of VoidUnion.
+type VoidUnion_List = capnp.StructList[VoidUnion]
+
+// NewVoidUnion creates a new list of VoidUnion.
+func NewVoidUnion_List(s *capnp.Segment, sz int32) (VoidUnion_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[VoidUnion](l), err
+}
+
+// VoidUnion_Future is a wrapper for a VoidUnion promised by a client call.
+type VoidUnion_Future struct{ *capnp.Future }
+
+func (f VoidUnion_Future) Struct() (VoidUnion, error) {
 	p, err := f.Future.Ptr()
-	return Echo_echo_Params(p.Struct()), err
+	return VoidUnion(p.Struct()), err
 }
 
-type Echo_echo_Results capnp.Struct
+type Wrap2x2 capnp.Struct
 
-// Echo_echo_Results_TypeID is the unique identifier for the type Echo_echo_Results.
-const Echo_echo_Results_TypeID = 0x9b37d729b9dd7b9d
+// Wrap2x2_TypeID is the unique identifier for the type Wrap2x2.
+const Wrap2x2_TypeID = 0xe1a2d1d51107bead
 
-func NewEcho_echo_Results(s *capnp.Segment) (Echo_echo_Results, error) {
+func NewWrap2x2(s *capnp.Segment) (Wrap2x2, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Echo_echo_Results(st), err
+	return Wrap2x2(st), err
 }
 
-func NewRootEcho_echo_Results(s *capnp.Segment) (Echo_echo_Results, error) {
+func NewRootWrap2x2(s *capnp.Segment) (Wrap2x2, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Echo_echo_Results(st), err
+	return Wrap2x2(st), err
 }
 
-func ReadRootEcho_echo_Results(msg *capnp.Message) (Echo_echo_Results, error) {
+func ReadRootWrap2x2(msg *capnp.Message) (Wrap2x2, error) {
 	root, err := msg.Root()
-	return Echo_echo_Results(root.Struct()), err
+	return Wrap2x2(root.Struct()), err
 }
 
-func (s Echo_echo_Results) String() string {
-	str, _ := text.Marshal(0x9b37d729b9dd7b9d, capnp.Struct(s))
+func (s Wrap2x2) String() string {
+	str, _ := text.Marshal(0xe1a2d1d51107bead, capnp.Struct(s))
 	return str
 }
 
-func (s Echo_echo_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Wrap2x2) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Echo_echo_Results) DecodeFromPtr(p capnp.Ptr) Echo_echo_Results {
-	return Echo_echo_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Wrap2x2) DecodeFromPtr(p capnp.Ptr) Wrap2x2 {
+	return Wrap2x2(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Echo_echo_Results) ToPtr() capnp.Ptr {
+func (s Wrap2x2) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Echo_echo_Results) IsValid() bool {
+func (s Wrap2x2) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Echo_echo_Results) Message() *capnp.Message {
+func (s Wrap2x2) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Echo_echo_Results) Segment() *capnp.Segment {
+func (s Wrap2x2) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Echo_echo_Results) Out() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s Wrap2x2) MightNotBeReallyEmpty() (VerTwoDataTwoPtr, error) {
+	return capnp.GetStructField[VerTwoDataTwoPtr](s, 0, "Wrap2x2.mightNotBeReallyEmpty")
 }
 
-func (s Echo_echo_Results) HasOut() bool {
+func (s Wrap2x2) HasMightNotBeReallyEmpty() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Echo_echo_Results) OutBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Wrap2x2) SetMightNotBeReallyEmpty(v VerTwoDataTwoPtr) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Echo_echo_Results) SetOut(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// NewMightNotBeReallyEmpty sets the mightNotBeReallyEmpty field to a newly
+// allocated VerTwoDataTwoPtr struct, preferring placement in s's segment.
+func (s Wrap2x2) NewMightNotBeReallyEmpty() (VerTwoDataTwoPtr, error) {
+	return capnp.NewStructField(s, 0, NewVerTwoDataTwoPtr)
 }
 
-// Echo_echo_Results_List is a list of Echo_echo_Results.
-type Echo_echo_Results_List = capnp.StructList[Echo_echo_Results]
+// Wrap2x2_List is a list of Wrap2x2.
+type Wrap2x2_List = capnp.StructList[Wrap2x2]
 
-// NewEcho_echo_Results creates a new list of Echo_echo_Results.
-func NewEcho_echo_Results_List(s *capnp.Segment, sz int32) (Echo_echo_Results_List, error) {
+// NewWrap2x2 creates a new list of Wrap2x2.
+func NewWrap2x2_List(s *capnp.Segment, sz int32) (Wrap2x2_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Echo_echo_Results](l), err
+	return capnp.StructList[Wrap2x2](l), err
 }
 
-// Echo_echo_Results_Future is a wrapper for a Echo_echo_Results promised by a client call.
-type Echo_echo_Results_Future struct{ *capnp.Future }
+// Wrap2x2_Future is a wrapper for a Wrap2x2 promised by a client call.
+type Wrap2x2_Future struct{ *capnp.Future }
 
-func (f Echo_echo_Results_Future) Struct() (Echo_echo_Results, error) {
+func (f Wrap2x2_Future) Struct() (Wrap2x2, error) {
 	p, err := f.Future.Ptr()
-	return Echo_echo_Results(p.Struct()), err
+	return Wrap2x2(p.Struct()), err
+}
+func (p Wrap2x2_Future) MightNotBeReallyEmpty() VerTwoDataTwoPtr_Future {
+	return VerTwoDataTwoPtr_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Hoth capnp.Struct
+type Wrap2x2plus capnp.Struct
 
-// Hoth_TypeID is the unique identifier for the type Hoth.
-const Hoth_TypeID = 0xad87da456fb0ebb9
+// Wrap2x2plus_TypeID is the unique identifier for the type Wrap2x2plus.
+const Wrap2x2plus_TypeID = 0xe684eb3aef1a6859
 
-func NewHoth(s *capnp.Segment) (Hoth, error) {
+func NewWrap2x2plus(s *capnp.Segment) (Wrap2x2plus, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Hoth(st), err
+	return Wrap2x2plus(st), err
 }
 
-func NewRootHoth(s *capnp.Segment) (Hoth, error) {
+func NewRootWrap2x2plus(s *capnp.Segment) (Wrap2x2plus, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Hoth(st), err
+	return Wrap2x2plus(st), err
 }
 
-func ReadRootHoth(msg *capnp.Message) (Hoth, error) {
+func ReadRootWrap2x2plus(msg *capnp.Message) (Wrap2x2plus, error) {
 	root, err := msg.Root()
-	return Hoth(root.Struct()), err
+	return Wrap2x2plus(root.Struct()), err
 }
 
-func (s Hoth) String() string {
-	str, _ := text.Marshal(0xad87da456fb0ebb9, capnp.Struct(s))
+func (s Wrap2x2plus) String() string {
+	str, _ := text.Marshal(0xe684eb3aef1a6859, capnp.Struct(s))
 	return str
 }
 
-func (s Hoth) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Wrap2x2plus) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Hoth) DecodeFromPtr(p capnp.Ptr) Hoth {
-	return Hoth(capnp.Struct{}.DecodeFromPtr(p))
+func (Wrap2x2plus) DecodeFromPtr(p capnp.Ptr) Wrap2x2plus {
+	return Wrap2x2plus(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Hoth) ToPtr() capnp.Ptr {
+func (s Wrap2x2plus) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Hoth) IsValid() bool {
+func (s Wrap2x2plus) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Hoth) Message() *capnp.Message {
+func (s Wrap2x2plus) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Hoth) Segment() *capnp.Segment {
+func (s Wrap2x2plus) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Hoth) Base() (EchoBase, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return EchoBase(p.Struct()), err
+func (s Wrap2x2plus) MightNotBeReallyEmpty() (VerTwoTwoPlus, error) {
+	return capnp.GetStructField[VerTwoTwoPlus](s, 0, "Wrap2x2plus.mightNotBeReallyEmpty")
 }
 
-func (s Hoth) HasBase() bool {
+func (s Wrap2x2plus) HasMightNotBeReallyEmpty() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Hoth) SetBase(v EchoBase) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Wrap2x2plus) SetMightNotBeReallyEmpty(v VerTwoTwoPlus) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewBase sets the base field to a newly
-// allocated EchoBase struct, preferring placement in s's segment.
-func (s Hoth) NewBase() (EchoBase, error) {
-	ss, err := NewEchoBase(capnp.Struct(s).Segment())
-	if err != nil {
-		return EchoBase{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewMightNotBeReallyEmpty sets the mightNotBeReallyEmpty field to a newly
+// allocated VerTwoTwoPlus struct, preferring placement in s's segment.
+func (s Wrap2x2plus) NewMightNotBeReallyEmpty() (VerTwoTwoPlus, error) {
+	return capnp.NewStructField(s, 0, NewVerTwoTwoPlus)
 }
 
-// Hoth_List is a list of Hoth.
-type Hoth_List = capnp.StructList[Hoth]
+// Wrap2x2plus_List is a list of Wrap2x2plus.
+type Wrap2x2plus_List = capnp.StructList[Wrap2x2plus]
 
-// NewHoth creates a new list of Hoth.
-func NewHoth_List(s *capnp.Segment, sz int32) (Hoth_List, error) {
+// NewWrap2x2plus creates a new list of Wrap2x2plus.
+func NewWrap2x2plus_List(s *capnp.Segment, sz int32) (Wrap2x2plus_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Hoth](l), err
+	return capnp.StructList[Wrap2x2plus](l), err
 }
 
-// Hoth_Future is a wrapper for a Hoth promised by a client call.
-type Hoth_Future struct{ *capnp.Future }
+// Wrap2x2plus_Future is a wrapper for a Wrap2x2plus promised by a client call.
+type Wrap2x2plus_Future struct{ *capnp.Future }
 
-func (f Hoth_Future) Struct() (Hoth, error) {
+func (f Wrap2x2plus_Future) Struct() (Wrap2x2plus, error) {
 	p, err := f.Future.Ptr()
-	return Hoth(p.Struct()), err
+	return Wrap2x2plus(p.Struct()), err
 }
-func (p Hoth_Future) Base() EchoBase_Future {
-	return EchoBase_Future{Future: p.Future.Field(0, nil)}
+func (p Wrap2x2plus_Future) MightNotBeReallyEmpty() VerTwoTwoPlus_Future {
+	return VerTwoTwoPlus_Future{Future: p.Future.Field(0, nil)}
+}
+
+type WrapEmpty capnp.Struct
+
+// WrapEmpty_TypeID is the unique identifier for the type WrapEmpty.
+const WrapEmpty_TypeID = 0x9ab599979b02ac59
+
+func NewWrapEmpty(s *capnp.Segment) (WrapEmpty, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return WrapEmpty(st), err
+}
+
+func NewRootWrapEmpty(s *capnp.Segment) (WrapEmpty, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return WrapEmpty(st), err
+}
+
+func ReadRootWrapEmpty(msg *capnp.Message) (WrapEmpty, error) {
+	root, err := msg.Root()
+	return WrapEmpty(root.Struct()), err
+}
+
+func (s WrapEmpty) String() string {
+	str, _ := text.Marshal(0x9ab599979b02ac59, capnp.Struct(s))
+	return str
+}
+
+func (s WrapEmpty) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (WrapEmpty) DecodeFromPtr(p capnp.Ptr) WrapEmpty {
+	return WrapEmpty(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s WrapEmpty) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s WrapEmpty) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s WrapEmpty) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s WrapEmpty) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s WrapEmpty) MightNotBeReallyEmpty() (VerEmpty, error) {
+	return capnp.GetStructField[VerEmpty](s, 0, "WrapEmpty.mightNotBeReallyEmpty")
+}
+
+func (s WrapEmpty) HasMightNotBeReallyEmpty() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s WrapEmpty) SetMightNotBeReallyEmpty(v VerEmpty) error {
+	return capnp.SetStructField(s, 0, v)
+}
+
+// NewMightNotBeReallyEmpty sets the mightNotBeReallyEmpty field to a newly
+// allocated VerEmpty struct, preferring placement in s's segment.
+func (s WrapEmpty) NewMightNotBeReallyEmpty() (VerEmpty, error) {
+	return capnp.NewStructField(s, 0, NewVerEmpty)
+}
+
+// WrapEmpty_List is a list of WrapEmpty.
+type WrapEmpty_List = capnp.StructList[WrapEmpty]
+
+// NewWrapEmpty creates a new list of WrapEmpty.
+func NewWrapEmpty_List(s *capnp.Segment, sz int32) (WrapEmpty_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[WrapEmpty](l), err
+}
+
+// WrapEmpty_Future is a wrapper for a WrapEmpty promised by a client call.
+type WrapEmpty_Future struct{ *capnp.Future }
+
+func (f WrapEmpty_Future) Struct() (WrapEmpty, error) {
+	p, err := f.Future.Ptr()
+	return WrapEmpty(p.Struct()), err
+}
+func (p WrapEmpty_Future) MightNotBeReallyEmpty() VerEmpty_Future {
+	return VerEmpty_Future{Future: p.Future.Field(0, nil)}
+}
+
+// Z must contain all types, as this is our
+// runtime type identification. It is a thin shim.
+type Z capnp.Struct
+type Z_grp Z
+type Z_Which uint16
+
+const (
+	Z_Which_void          Z_Which = 0
+	Z_Which_zz            Z_Which = 1
+	Z_Which_f64           Z_Which = 2
+	Z_Which_f32           Z_Which = 3
+	Z_Which_i64           Z_Which = 4
+	Z_Which_i32           Z_Which = 5
+	Z_Which_i16           Z_Which = 6
+	Z_Which_i8            Z_Which = 7
+	Z_Which_u64           Z_Which = 8
+	Z_Which_u32           Z_Which = 9
+	Z_Which_u16           Z_Which = 10
+	Z_Which_u8            Z_Which = 11
+	Z_Which_bool          Z_Which = 12
+	Z_Which_text          Z_Which = 13
+	Z_Which_blob          Z_Which = 14
+	Z_Which_f64vec        Z_Which = 15
+	Z_Which_f32vec        Z_Which = 16
+	Z_Which_i64vec        Z_Which = 17
+	Z_Which_i32vec        Z_Which = 18
+	Z_Which_i16vec        Z_Which = 19
+	Z_Which_i8vec         Z_Which = 20
+	Z_Which_u64vec        Z_Which = 21
+	Z_Which_u32vec        Z_Which = 22
+	Z_Which_u16vec        Z_Which = 23
+	Z_Which_u8vec         Z_Which = 24
+	Z_Which_boolvec       Z_Which = 39
+	Z_Which_datavec       Z_Which = 40
+	Z_Which_textvec       Z_Which = 41
+	Z_Which_zvec          Z_Which = 25
+	Z_Which_zvecvec       Z_Which = 26
+	Z_Which_zdate         Z_Which = 27
+	Z_Which_zdata         Z_Which = 28
+	Z_Which_aircraftvec   Z_Which = 29
+	Z_Which_aircraft      Z_Which = 30
+	Z_Which_regression    Z_Which = 31
+	Z_Which_planebase     Z_Which = 32
+	Z_Which_airport       Z_Which = 33
+	Z_Which_b737          Z_Which = 34
+	Z_Which_a320          Z_Which = 35
+	Z_Which_f16           Z_Which = 36
+	Z_Which_zdatevec      Z_Which = 37
+	Z_Which_zdatavec      Z_Which = 38
+	Z_Which_grp           Z_Which = 42
+	Z_Which_echo          Z_Which = 43
+	Z_Which_echoes        Z_Which = 44
+	Z_Which_anyPtr        Z_Which = 45
+	Z_Which_anyStruct     Z_Which = 46
+	Z_Which_anyList       Z_Which = 47
+	Z_Which_anyCapability Z_Which = 48
+)
+
+func (w Z_Which) String() string {
+	const s = "voidzzf64f32i64i32i16i8u64u32u16u8booltextblobf64vecf32veci64veci32veci16veci8vecu64vecu32vecu16vecu8vecboolvecdatavectextveczveczvecveczdatezdataaircraftvecaircraftregressionplanebaseairportb737a320f16zdateveczdatavecgrpechoechoesanyPtranyStructanyListanyCapability"
+	switch w {
+	case Z_Which_void:
+		return s[0:4]
+	case Z_Which_zz:
+		return s[4:6]
+	case Z_Which_f64:
+		return s[6:9]
+	case Z_Which_f32:
+		return s[9:12]
+	case Z_Which_i64:
+		return s[12:15]
+	case Z_Which_i32:
+		return s[15:18]
+	case Z_Which_i16:
+		return s[18:21]
+	case Z_Which_i8:
+		return s[21:23]
+	case Z_Which_u64:
+		return s[23:26]
+	case Z_Which_u32:
+		return s[26:29]
+	case Z_Which_u16:
+		return s[29:32]
+	case Z_Which_u8:
+		return s[32:34]
+	case Z_Which_bool:
+		return s[34:38]
+	case Z_Which_text:
+		return s[38:42]
+	case Z_Which_blob:
+		return s[42:46]
+	case Z_Which_f64vec:
+		return s[46:52]
+	case Z_Which_f32vec:
+		return s[52:58]
+	case Z_Which_i64vec:
+		return s[58:64]
+	case Z_Which_i32vec:
+		return s[64:70]
+	case Z_Which_i16vec:
+		return s[70:76]
+	case Z_Which_i8vec:
+		return s[76:81]
+	case Z_Which_u64vec:
+		return s[81:87]
+	case Z_Which_u32vec:
+		return s[87:93]
+	case Z_Which_u16vec:
+		return s[93:99]
+	case Z_Which_u8vec:
+		return s[99:104]
+	case Z_Which_boolvec:
+		return s[104:111]
+	case Z_Which_datavec:
+		return s[111:118]
+	case Z_Which_textvec:
+		return s[118:125]
+	case Z_Which_zvec:
+		return s[125:129]
+	case Z_Which_zvecvec:
+		return s[129:136]
+	case Z_Which_zdate:
+		return s[136:141]
+	case Z_Which_zdata:
+		return s[141:146]
+	case Z_Which_aircraftvec:
+		return s[146:157]
+	case Z_Which_aircraft:
+		return s[157:165]
+	case Z_Which_regression:
+		return s[165:175]
+	case Z_Which_planebase:
+		return s[175:184]
+	case Z_Which_airport:
+		return s[184:191]
+	case Z_Which_b737:
+		return s[191:195]
+	case Z_Which_a320:
+		return s[195:199]
+	case Z_Which_f16:
+		return s[199:202]
+	case Z_Which_zdatevec:
+		return s[202:210]
+	case Z_Which_zdatavec:
+		return s[210:218]
+	case Z_Which_grp:
+		return s[218:221]
+	case Z_Which_echo:
+		return s[221:225]
+	case Z_Which_echoes:
+		return s[225:231]
+	case Z_Which_anyPtr:
+		return s[231:237]
+	case Z_Which_anyStruct:
+		return s[237:246]
+	case Z_Which_anyList:
+		return s[246:253]
+	case Z_Which_anyCapability:
+		return s[253:266]
+
+	}
+	return "Z_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Z_Visitor has a method for each member of the union of
+// Z, which Visit calls with the member's value.
+type Z_Visitor interface {
+	VisitVoid() error
+	VisitZz(Z) error
+	VisitF64(float64) error
+	VisitF32(float32) error
+	VisitI64(int64) error
+	VisitI32(int32) error
+	VisitI16(int16) error
+	VisitI8(int8) error
+	VisitU64(uint64) error
+	VisitU32(uint32) error
+	VisitU16(uint16) error
+	VisitU8(uint8) error
+	VisitBool(bool) error
+	VisitText(string) error
+	VisitBlob([]byte) error
+	VisitF64vec(capnp.Float64List) error
+	VisitF32vec(capnp.Float32List) error
+	VisitI64vec(capnp.Int64List) error
+	VisitI32vec(capnp.Int32List) error
+	VisitI16vec(capnp.Int16List) error
+	VisitI8vec(capnp.Int8List) error
+	VisitU64vec(capnp.UInt64List) error
+	VisitU32vec(capnp.UInt32List) error
+	VisitU16vec(capnp.UInt16List) error
+	VisitU8vec(capnp.UInt8List) error
+	VisitBoolvec(capnp.BitList) error
+	VisitDatavec(capnp.DataList) error
+	VisitTextvec(capnp.TextList) error
+	VisitZvec(Z_List) error
+	VisitZvecvec(capnp.PointerList) error
+	VisitZdate(Zdate) error
+	VisitZdata(Zdata) error
+	VisitAircraftvec(Aircraft_List) error
+	VisitAircraft(Aircraft) error
+	VisitRegression(Regression) error
+	VisitPlanebase(PlaneBase) error
+	VisitAirport(Airport) error
+	VisitB737(B737) error
+	VisitA320(A320) error
+	VisitF16(F16) error
+	VisitZdatevec(Zdate_List) error
+	VisitZdatavec(Zdata_List) error
+	VisitGrp(Z_grp) error
+	VisitEcho(Echo) error
+	VisitEchoes(Echo_List) error
+	VisitAnyPtr(capnp.Ptr) error
+	VisitAnyStruct(capnp.Struct) error
+	VisitAnyList(capnp.List) error
+	VisitAnyCapability(capnp.Client) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Z) Visit(v Z_Visitor) error {
+	switch w := s.Which(); w {
+	case Z_Which_void:
+		return v.VisitVoid()
+	case Z_Which_zz:
+		x, err := s.Zz()
+		if err != nil {
+			return err
+		}
+		return v.VisitZz(x)
+	case Z_Which_f64:
+		return v.VisitF64(s.F64())
+	case Z_Which_f32:
+		return v.VisitF32(s.F32())
+	case Z_Which_i64:
+		return v.VisitI64(s.I64())
+	case Z_Which_i32:
+		return v.VisitI32(s.I32())
+	case Z_Which_i16:
+		return v.VisitI16(s.I16())
+	case Z_Which_i8:
+		return v.VisitI8(s.I8())
+	case Z_Which_u64:
+		return v.VisitU64(s.U64())
+	case Z_Which_u32:
+		return v.VisitU32(s.U32())
+	case Z_Which_u16:
+		return v.VisitU16(s.U16())
+	case Z_Which_u8:
+		return v.VisitU8(s.U8())
+	case Z_Which_bool:
+		return v.VisitBool(s.Bool())
+	case Z_Which_text:
+		x, err := s.Text()
+		if err != nil {
+			return err
+		}
+		return v.VisitText(x)
+	case Z_Which_blob:
+		x, err := s.Blob()
+		if err != nil {
+			return err
+		}
+		return v.VisitBlob(x)
+	case Z_Which_f64vec:
+		x, err := s.F64vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitF64vec(x)
+	case Z_Which_f32vec:
+		x, err := s.F32vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitF32vec(x)
+	case Z_Which_i64vec:
+		x, err := s.I64vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitI64vec(x)
+	case Z_Which_i32vec:
+		x, err := s.I32vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitI32vec(x)
+	case Z_Which_i16vec:
+		x, err := s.I16vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitI16vec(x)
+	case Z_Which_i8vec:
+		x, err := s.I8vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitI8vec(x)
+	case Z_Which_u64vec:
+		x, err := s.U64vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitU64vec(x)
+	case Z_Which_u32vec:
+		x, err := s.U32vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitU32vec(x)
+	case Z_Which_u16vec:
+		x, err := s.U16vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitU16vec(x)
+	case Z_Which_u8vec:
+		x, err := s.U8vec()
+		if err != nil {
+			return err
+		}
+		return v.VisitU8vec(x)
+	case Z_Which_boolvec:
+		x, err := s.Boolvec()
+		if err != nil {
+			return err
+		}
+		return v.VisitBoolvec(x)
+	case Z_Which_datavec:
+		x, err := s.Datavec()
+		if err != nil {
+			return err
+		}
+		return v.VisitDatavec(x)
+	case Z_Which_textvec:
+		x, err := s.Textvec()
+		if err != nil {
+			return err
+		}
+		return v.VisitTextvec(x)
+	case Z_Which_zvec:
+		x, err := s.Zvec()
+		if err != nil {
+			return err
+		}
+		return v.VisitZvec(x)
+	case Z_Which_zvecvec:
+		x, err := s.Zvecvec()
+		if err != nil {
+			return err
+		}
+		return v.VisitZvecvec(x)
+	case Z_Which_zdate:
+		x, err := s.Zdate()
+		if err != nil {
+			return err
+		}
+		return v.VisitZdate(x)
+	case Z_Which_zdata:
+		x, err := s.Zdata()
+		if err != nil {
+			return err
+		}
+		return v.VisitZdata(x)
+	case Z_Which_aircraftvec:
+		x, err := s.Aircraftvec()
+		if err != nil {
+			return err
+		}
+		return v.VisitAircraftvec(x)
+	case Z_Which_aircraft:
+		x, err := s.Aircraft()
+		if err != nil {
+			return err
+		}
+		return v.VisitAircraft(x)
+	case Z_Which_regression:
+		x, err := s.Regression()
+		if err != nil {
+			return err
+		}
+		return v.VisitRegression(x)
+	case Z_Which_planebase:
+		x, err := s.Planebase()
+		if err != nil {
+			return err
+		}
+		return v.VisitPlanebase(x)
+	case Z_Which_airport:
+		return v.VisitAirport(s.Airport())
+	case Z_Which_b737:
+		x, err := s.B737()
+		if err != nil {
+			return err
+		}
+		return v.VisitB737(x)
+	case Z_Which_a320:
+		x, err := s.A320()
+		if err != nil {
+			return err
+		}
+		return v.VisitA320(x)
+	case Z_Which_f16:
+		x, err := s.F16()
+		if err != nil {
+			return err
+		}
+		return v.VisitF16(x)
+	case Z_Which_zdatevec:
+		x, err := s.Zdatevec()
+		if err != nil {
+			return err
+		}
+		return v.VisitZdatevec(x)
+	case Z_Which_zdatavec:
+		x, err := s.Zdatavec()
+		if err != nil {
+			return err
+		}
+		return v.VisitZdatavec(x)
+	case Z_Which_grp:
+		return v.VisitGrp(s.Grp())
+	case Z_Which_echo:
+		return v.VisitEcho(s.Echo())
+	case Z_Which_echoes:
+		x, err := s.Echoes()
+		if err != nil {
+			return err
+		}
+		return v.VisitEchoes(x)
+	case Z_Which_anyPtr:
+		x, err := s.AnyPtr()
+		if err != nil {
+			return err
+		}
+		return v.VisitAnyPtr(x)
+	case Z_Which_anyStruct:
+		x, err := s.AnyStruct()
+		if err != nil {
+			return err
+		}
+		return v.VisitAnyStruct(x)
+	case Z_Which_anyList:
+		x, err := s.AnyList()
+		if err != nil {
+			return err
+		}
+		return v.VisitAnyList(x)
+	case Z_Which_anyCapability:
+		return v.VisitAnyCapability(s.AnyCapability())
+	default:
+		return &capnp.UnknownMemberError{Union: "Z", Which: uint16(w)}
+	}
 }
 
-type EchoBase capnp.Struct
-
-// EchoBase_TypeID is the unique identifier for the type EchoBase.
-const EchoBase_TypeID = 0xa8bf13fef2674866
+// Z_TypeID is the unique identifier for the type Z.
+const Z_TypeID = 0xea26e9973bd6a0d9
 
-func NewEchoBase(s *capnp.Segment) (EchoBase, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return EchoBase(st), err
+func NewZ(s *capnp.Segment) (Z, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Z(st), err
 }
 
-func NewRootEchoBase(s *capnp.Segment) (EchoBase, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return EchoBase(st), err
+func NewRootZ(s *capnp.Segment) (Z, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Z(st), err
 }
 
-func ReadRootEchoBase(msg *capnp.Message) (EchoBase, error) {
+func ReadRootZ(msg *capnp.Message) (Z, error) {
 	root, err := msg.Root()
-	return EchoBase(root.Struct()), err
+	return Z(root.Struct()), err
 }
 
-func (s EchoBase) String() string {
-	str, _ := text.Marshal(0xa8bf13fef2674866, capnp.Struct(s))
+func (s Z) String() string {
+	str, _ := text.Marshal(0xea26e9973bd6a0d9, capnp.Struct(s))
 	return str
 }
 
-func (s EchoBase) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Z) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (EchoBase) DecodeFromPtr(p capnp.Ptr) EchoBase {
-	return EchoBase(capnp.Struct{}.DecodeFromPtr(p))
+func (Z) DecodeFromPtr(p capnp.Ptr) Z {
+	return Z(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s EchoBase) ToPtr() capnp.Ptr {
+func (s Z) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s EchoBase) IsValid() bool {
+
+func (s Z) Which() Z_Which {
+	return Z_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Z) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s EchoBase) Message() *capnp.Message {
+func (s Z) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s EchoBase) Segment() *capnp.Segment {
+func (s Z) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s EchoBase) Echo() Echo {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return Echo(p.Interface().Client())
-}
+func (s Z) SetVoid() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-func (s EchoBase) HasEcho() bool {
-	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s EchoBase) SetEcho(v Echo) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+// any. fyi, this can't be 'z' alone.
+func (s Z) Zz() (Z, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != zz")
 	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+	return capnp.GetStructField[Z](s, 0, "Z.zz")
 }
 
-// EchoBase_List is a list of EchoBase.
-type EchoBase_List = capnp.StructList[EchoBase]
-
-// NewEchoBase creates a new list of EchoBase.
-func NewEchoBase_List(s *capnp.Segment, sz int32) (EchoBase_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[EchoBase](l), err
+func (s Z) HasZz() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// EchoBase_Future is a wrapper for a EchoBase promised by a client call.
-type EchoBase_Future struct{ *capnp.Future }
-
-func (f EchoBase_Future) Struct() (EchoBase, error) {
-	p, err := f.Future.Ptr()
-	return EchoBase(p.Struct()), err
-}
-func (p EchoBase_Future) Echo() Echo {
-	return Echo(p.Future.Field(0, nil).Client())
+func (s Z) SetZz(v Z) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-type StackingRoot capnp.Struct
-
-// StackingRoot_TypeID is the unique identifier for the type StackingRoot.
-const StackingRoot_TypeID = 0x8fae7b41c61fc890
-
-func NewStackingRoot(s *capnp.Segment) (StackingRoot, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return StackingRoot(st), err
+// NewZz sets the zz field to a newly
+// allocated Z struct, preferring placement in s's segment.
+func (s Z) NewZz() (Z, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.NewStructField(s, 0, NewZ)
 }
 
-func NewRootStackingRoot(s *capnp.Segment) (StackingRoot, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return StackingRoot(st), err
+func (s Z) F64() float64 {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != f64")
+	}
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
 }
 
-func ReadRootStackingRoot(msg *capnp.Message) (StackingRoot, error) {
-	root, err := msg.Root()
-	return StackingRoot(root.Struct()), err
+func (s Z) SetF64(v float64) {
+	capnp.Struct(s).SetUint16(0, 2)
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
 }
 
-func (s StackingRoot) String() string {
-	str, _ := text.Marshal(0x8fae7b41c61fc890, capnp.Struct(s))
-	return str
+func (s Z) F32() float32 {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != f32")
+	}
+	return math.Float32frombits(capnp.Struct(s).Uint32(8))
 }
 
-func (s StackingRoot) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Z) SetF32(v float32) {
+	capnp.Struct(s).SetUint16(0, 3)
+	capnp.Struct(s).SetUint32(8, math.Float32bits(v))
 }
 
-func (StackingRoot) DecodeFromPtr(p capnp.Ptr) StackingRoot {
-	return StackingRoot(capnp.Struct{}.DecodeFromPtr(p))
+func (s Z) I64() int64 {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		panic("Which() != i64")
+	}
+	return int64(capnp.Struct(s).Uint64(8))
 }
 
-func (s StackingRoot) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s StackingRoot) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Z) SetI64(v int64) {
+	capnp.Struct(s).SetUint16(0, 4)
+	capnp.Struct(s).SetUint64(8, uint64(v))
 }
 
-func (s StackingRoot) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Z) I32() int32 {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		panic("Which() != i32")
+	}
+	return int32(capnp.Struct(s).Uint32(8))
 }
 
-func (s StackingRoot) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s StackingRoot) A() (StackingA, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return StackingA(p.Struct()), err
+func (s Z) SetI32(v int32) {
+	capnp.Struct(s).SetUint16(0, 5)
+	capnp.Struct(s).SetUint32(8, uint32(v))
 }
 
-func (s StackingRoot) HasA() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Z) I16() int16 {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		panic("Which() != i16")
+	}
+	return int16(capnp.Struct(s).Uint16(8))
 }
 
-func (s StackingRoot) SetA(v StackingA) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+func (s Z) SetI16(v int16) {
+	capnp.Struct(s).SetUint16(0, 6)
+	capnp.Struct(s).SetUint16(8, uint16(v))
 }
 
-// NewA sets the a field to a newly
-// allocated StackingA struct, preferring placement in s's segment.
-func (s StackingRoot) NewA() (StackingA, error) {
-	ss, err := NewStackingA(capnp.Struct(s).Segment())
-	if err != nil {
-		return StackingA{}, err
+func (s Z) I8() int8 {
+	if capnp.Struct(s).Uint16(0) != 7 {
+		panic("Which() != i8")
 	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return int8(capnp.Struct(s).Uint8(8))
 }
 
-func (s StackingRoot) AWithDefault() (StackingA, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	if err != nil {
-		return StackingA{}, err
-	}
-	ss, err := p.StructDefault(x_832bcc6686a26d56[64:96])
-	return StackingA(ss), err
+func (s Z) SetI8(v int8) {
+	capnp.Struct(s).SetUint16(0, 7)
+	capnp.Struct(s).SetUint8(8, uint8(v))
 }
 
-func (s StackingRoot) HasAWithDefault() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Z) U64() uint64 {
+	if capnp.Struct(s).Uint16(0) != 8 {
+		panic("Which() != u64")
+	}
+	return capnp.Struct(s).Uint64(8)
 }
 
-func (s StackingRoot) SetAWithDefault(v StackingA) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Z) SetU64(v uint64) {
+	capnp.Struct(s).SetUint16(0, 8)
+	capnp.Struct(s).SetUint64(8, v)
 }
 
-// NewAWithDefault sets the aWithDefault field to a newly
-// allocated StackingA struct, preferring placement in s's segment.
-func (s StackingRoot) NewAWithDefault() (StackingA, error) {
-	ss, err := NewStackingA(capnp.Struct(s).Segment())
-	if err != nil {
-		return StackingA{}, err
+func (s Z) U32() uint32 {
+	if capnp.Struct(s).Uint16(0) != 9 {
+		panic("Which() != u32")
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.Struct(s).Uint32(8)
 }
 
-// StackingRoot_List is a list of StackingRoot.
-type StackingRoot_List = capnp.StructList[StackingRoot]
-
-// NewStackingRoot creates a new list of StackingRoot.
-func NewStackingRoot_List(s *capnp.Segment, sz int32) (StackingRoot_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[StackingRoot](l), err
+func (s Z) SetU32(v uint32) {
+	capnp.Struct(s).SetUint16(0, 9)
+	capnp.Struct(s).SetUint32(8, v)
 }
 
-// StackingRoot_Future is a wrapper for a StackingRoot promised by a client call.
-type StackingRoot_Future struct{ *capnp.Future }
-
-func (f StackingRoot_Future) Struct() (StackingRoot, error) {
-	p, err := f.Future.Ptr()
-	return StackingRoot(p.Struct()), err
-}
-func (p StackingRoot_Future) A() StackingA_Future {
-	return StackingA_Future{Future: p.Future.Field(1, nil)}
-}
-func (p StackingRoot_Future) AWithDefault() StackingA_Future {
-	return StackingA_Future{Future: p.Future.Field(0, x_832bcc6686a26d56[96:128])}
+func (s Z) U16() uint16 {
+	if capnp.Struct(s).Uint16(0) != 10 {
+		panic("Which() != u16")
+	}
+	return capnp.Struct(s).Uint16(8)
 }
 
-type StackingA capnp.Struct
-
-// StackingA_TypeID is the unique identifier for the type StackingA.
-const StackingA_TypeID = 0x9d3032ff86043b75
-
-func NewStackingA(s *capnp.Segment) (StackingA, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return StackingA(st), err
+func (s Z) SetU16(v uint16) {
+	capnp.Struct(s).SetUint16(0, 10)
+	capnp.Struct(s).SetUint16(8, v)
 }
 
-func NewRootStackingA(s *capnp.Segment) (StackingA, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return StackingA(st), err
+func (s Z) U8() uint8 {
+	if capnp.Struct(s).Uint16(0) != 11 {
+		panic("Which() != u8")
+	}
+	return capnp.Struct(s).Uint8(8)
 }
 
-func ReadRootStackingA(msg *capnp.Message) (StackingA, error) {
-	root, err := msg.Root()
-	return StackingA(root.Struct()), err
+func (s Z) SetU8(v uint8) {
+	capnp.Struct(s).SetUint16(0, 11)
+	capnp.Struct(s).SetUint8(8, v)
 }
 
-func (s StackingA) String() string {
-	str, _ := text.Marshal(0x9d3032ff86043b75, capnp.Struct(s))
-	return str
+func (s Z) Bool() bool {
+	if capnp.Struct(s).Uint16(0) != 12 {
+		panic("Which() != bool")
+	}
+	return capnp.Struct(s).Bit(64)
 }
 
-func (s StackingA) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Z) SetBool(v bool) {
+	capnp.Struct(s).SetUint16(0, 12)
+	capnp.Struct(s).SetBit(64, v)
 }
 
-func (StackingA) DecodeFromPtr(p capnp.Ptr) StackingA {
-	return StackingA(capnp.Struct{}.DecodeFromPtr(p))
+func (s Z) Text() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 13 {
+		panic("Which() != text")
+	}
+	return capnp.GetTextField(s, 0, "Z.text")
 }
 
-func (s StackingA) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s StackingA) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Z) HasText() bool {
+	if capnp.Struct(s).Uint16(0) != 13 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s StackingA) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Z) TextBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Z.text")
 }
 
-func (s StackingA) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Z) SetText(v string) error {
+	capnp.Struct(s).SetUint16(0, 13)
+	return capnp.Struct(s).SetText(0, v)
 }
-func (s StackingA) Num() int32 {
-	return int32(capnp.Struct(s).Uint32(0))
+
+func (s Z) Blob() ([]byte, error) {
+	if capnp.Struct(s).Uint16(0) != 14 {
+		panic("Which() != blob")
+	}
+	return capnp.GetDataField(s, 0, "Z.blob")
 }
 
-func (s StackingA) SetNum(v int32) {
-	capnp.Struct(s).SetUint32(0, uint32(v))
+func (s Z) HasBlob() bool {
+	if capnp.Struct(s).Uint16(0) != 14 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s StackingA) B() (StackingB, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return StackingB(p.Struct()), err
+func (s Z) SetBlob(v []byte) error {
+	capnp.Struct(s).SetUint16(0, 14)
+	return capnp.Struct(s).SetData(0, v)
 }
 
-func (s StackingA) HasB() bool {
-	return capnp.Struct(s).HasPtr(0)
+// BlobReader returns a reader of blob's data, which
+// is read directly from the message.
+func (s Z) BlobReader() (*bytes.Reader, error) {
+	v, err := s.Blob()
+	return bytes.NewReader(v), err
 }
 
-func (s StackingA) SetB(v StackingB) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// SetBlobFromReader sets blob to the next size bytes of r,
+// which are read directly into the message.
+func (s Z) SetBlobFromReader(r io.Reader, size int) error {
+	capnp.Struct(s).SetUint16(0, 14)
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
 }
 
-// NewB sets the b field to a newly
-// allocated StackingB struct, preferring placement in s's segment.
-func (s StackingA) NewB() (StackingB, error) {
-	ss, err := NewStackingB(capnp.Struct(s).Segment())
-	if err != nil {
-		return StackingB{}, err
+func (s Z) F64vec() (capnp.Float64List, error) {
+	if capnp.Struct(s).Uint16(0) != 15 {
+		panic("Which() != f64vec")
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.GetListField[capnp.Float64List](s, 0, "Z.f64vec")
 }
 
-// StackingA_List is a list of StackingA.
-type StackingA_List = capnp.StructList[StackingA]
+func (s Z) HasF64vec() bool {
+	if capnp.Struct(s).Uint16(0) != 15 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewStackingA creates a new list of StackingA.
-func NewStackingA_List(s *capnp.Segment, sz int32) (StackingA_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[StackingA](l), err
+func (s Z) SetF64vec(v capnp.Float64List) error {
+	capnp.Struct(s).SetUint16(0, 15)
+	return capnp.SetListField(s, 0, v)
 }
 
-// StackingA_Future is a wrapper for a StackingA promised by a client call.
-type StackingA_Future struct{ *capnp.Future }
+// NewF64vec sets the f64vec field to a newly
+// allocated capnp.Float64List, preferring placement in s's segment.
+func (s Z) NewF64vec(n int32) (capnp.Float64List, error) {
+	capnp.Struct(s).SetUint16(0, 15)
+	return capnp.NewListField(s, 0, n, capnp.NewFloat64List)
+}
 
-func (f StackingA_Future) Struct() (StackingA, error) {
-	p, err := f.Future.Ptr()
-	return StackingA(p.Struct()), err
+// SetF64vecFromSlice sets the f64vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetF64vecFromSlice(v []float64) error {
+	capnp.Struct(s).SetUint16(0, 15)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewFloat64ListFromSlice)
 }
-func (p StackingA_Future) B() StackingB_Future {
-	return StackingB_Future{Future: p.Future.Field(0, nil)}
+func (s Z) F32vec() (capnp.Float32List, error) {
+	if capnp.Struct(s).Uint16(0) != 16 {
+		panic("Which() != f32vec")
+	}
+	return capnp.GetListField[capnp.Float32List](s, 0, "Z.f32vec")
 }
 
-type StackingB capnp.Struct
-
-// StackingB_TypeID is the unique identifier for the type StackingB.
-const StackingB_TypeID = 0x85257b30d6edf8c5
-
-func NewStackingB(s *capnp.Segment) (StackingB, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return StackingB(st), err
+func (s Z) HasF32vec() bool {
+	if capnp.Struct(s).Uint16(0) != 16 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func NewRootStackingB(s *capnp.Segment) (StackingB, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return StackingB(st), err
+func (s Z) SetF32vec(v capnp.Float32List) error {
+	capnp.Struct(s).SetUint16(0, 16)
+	return capnp.SetListField(s, 0, v)
 }
 
-func ReadRootStackingB(msg *capnp.Message) (StackingB, error) {
-	root, err := msg.Root()
-	return StackingB(root.Struct()), err
+// NewF32vec sets the f32vec field to a newly
+// allocated capnp.Float32List, preferring placement in s's segment.
+func (s Z) NewF32vec(n int32) (capnp.Float32List, error) {
+	capnp.Struct(s).SetUint16(0, 16)
+	return capnp.NewListField(s, 0, n, capnp.NewFloat32List)
 }
 
-func (s StackingB) String() string {
-	str, _ := text.Marshal(0x85257b30d6edf8c5, capnp.Struct(s))
-	return str
+// SetF32vecFromSlice sets the f32vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetF32vecFromSlice(v []float32) error {
+	capnp.Struct(s).SetUint16(0, 16)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewFloat32ListFromSlice)
 }
-
-func (s StackingB) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Z) I64vec() (capnp.Int64List, error) {
+	if capnp.Struct(s).Uint16(0) != 17 {
+		panic("Which() != i64vec")
+	}
+	return capnp.GetListField[capnp.Int64List](s, 0, "Z.i64vec")
 }
 
-func (StackingB) DecodeFromPtr(p capnp.Ptr) StackingB {
-	return StackingB(capnp.Struct{}.DecodeFromPtr(p))
+func (s Z) HasI64vec() bool {
+	if capnp.Struct(s).Uint16(0) != 17 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s StackingB) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s StackingB) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Z) SetI64vec(v capnp.Int64List) error {
+	capnp.Struct(s).SetUint16(0, 17)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s StackingB) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// NewI64vec sets the i64vec field to a newly
+// allocated capnp.Int64List, preferring placement in s's segment.
+func (s Z) NewI64vec(n int32) (capnp.Int64List, error) {
+	capnp.Struct(s).SetUint16(0, 17)
+	return capnp.NewListField(s, 0, n, capnp.NewInt64List)
 }
 
-func (s StackingB) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// SetI64vecFromSlice sets the i64vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetI64vecFromSlice(v []int64) error {
+	capnp.Struct(s).SetUint16(0, 17)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewInt64ListFromSlice)
 }
-func (s StackingB) Num() int32 {
-	return int32(capnp.Struct(s).Uint32(0))
+func (s Z) I32vec() (capnp.Int32List, error) {
+	if capnp.Struct(s).Uint16(0) != 18 {
+		panic("Which() != i32vec")
+	}
+	return capnp.GetListField[capnp.Int32List](s, 0, "Z.i32vec")
 }
 
-func (s StackingB) SetNum(v int32) {
-	capnp.Struct(s).SetUint32(0, uint32(v))
+func (s Z) HasI32vec() bool {
+	if capnp.Struct(s).Uint16(0) != 18 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// StackingB_List is a list of StackingB.
-type StackingB_List = capnp.StructList[StackingB]
-
-// NewStackingB creates a new list of StackingB.
-func NewStackingB_List(s *capnp.Segment, sz int32) (StackingB_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[StackingB](l), err
+func (s Z) SetI32vec(v capnp.Int32List) error {
+	capnp.Struct(s).SetUint16(0, 18)
+	return capnp.SetListField(s, 0, v)
 }
 
-// StackingB_Future is a wrapper for a StackingB promised by a client call.
-type StackingB_Future struct{ *capnp.Future }
-
-func (f StackingB_Future) Struct() (StackingB, error) {
-	p, err := f.Future.Ptr()
-	return StackingB(p.Struct()), err
+// NewI32vec sets the i32vec field to a newly
+// allocated capnp.Int32List, preferring placement in s's segment.
+func (s Z) NewI32vec(n int32) (capnp.Int32List, error) {
+	capnp.Struct(s).SetUint16(0, 18)
+	return capnp.NewListField(s, 0, n, capnp.NewInt32List)
 }
 
-type CallSequence capnp.Client
-
-// CallSequence_TypeID is the unique identifier for the type CallSequence.
-const CallSequence_TypeID = 0xabaedf5f7817c820
-
-func (c CallSequence) GetNumber(ctx context.Context, params func(CallSequence_getNumber_Params) error) (CallSequence_getNumber_Results_Future, capnp.ReleaseFunc) {
-
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xabaedf5f7817c820,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:CallSequence",
-			MethodName:    "getNumber",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(CallSequence_getNumber_Params(s)) }
+// SetI32vecFromSlice sets the i32vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetI32vecFromSlice(v []int32) error {
+	capnp.Struct(s).SetUint16(0, 18)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewInt32ListFromSlice)
+}
+func (s Z) I16vec() (capnp.Int16List, error) {
+	if capnp.Struct(s).Uint16(0) != 19 {
+		panic("Which() != i16vec")
 	}
-
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return CallSequence_getNumber_Results_Future{Future: ans.Future()}, release
-
+	return capnp.GetListField[capnp.Int16List](s, 0, "Z.i16vec")
 }
 
-func (c CallSequence) WaitStreaming() error {
-	return capnp.Client(c).WaitStreaming()
+func (s Z) HasI16vec() bool {
+	if capnp.Struct(s).Uint16(0) != 19 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// String returns a string that identifies this capability for debugging
-// purposes.  Its format should not be depended on: in particular, it
-// should not be used to compare clients.  Use IsSame to compare clients
-// for equality.
-func (c CallSequence) String() string {
-	return "CallSequence(" + capnp.Client(c).String() + ")"
+func (s Z) SetI16vec(v capnp.Int16List) error {
+	capnp.Struct(s).SetUint16(0, 19)
+	return capnp.SetListField(s, 0, v)
 }
 
-// AddRef creates a new Client that refers to the same capability as c.
-// If c is nil or has resolved to null, then AddRef returns nil.
-func (c CallSequence) AddRef() CallSequence {
-	return CallSequence(capnp.Client(c).AddRef())
+// NewI16vec sets the i16vec field to a newly
+// allocated capnp.Int16List, preferring placement in s's segment.
+func (s Z) NewI16vec(n int32) (capnp.Int16List, error) {
+	capnp.Struct(s).SetUint16(0, 19)
+	return capnp.NewListField(s, 0, n, capnp.NewInt16List)
 }
 
-// Release releases a capability reference.  If this is the last
-// reference to the capability, then the underlying resources associated
-// with the capability will be released.
-//
-// Release will panic if c has already been released, but not if c is
-// nil or resolved to null.
-func (c CallSequence) Release() {
-	capnp.Client(c).Release()
+// SetI16vecFromSlice sets the i16vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetI16vecFromSlice(v []int16) error {
+	capnp.Struct(s).SetUint16(0, 19)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewInt16ListFromSlice)
 }
-
-// Resolve blocks until the capability is fully resolved or the Context
-// expires.
-func (c CallSequence) Resolve(ctx context.Context) error {
-	return capnp.Client(c).Resolve(ctx)
+func (s Z) I8vec() (capnp.Int8List, error) {
+	if capnp.Struct(s).Uint16(0) != 20 {
+		panic("Which() != i8vec")
+	}
+	return capnp.GetListField[capnp.Int8List](s, 0, "Z.i8vec")
 }
 
-func (c CallSequence) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Client(c).EncodeAsPtr(seg)
+func (s Z) HasI8vec() bool {
+	if capnp.Struct(s).Uint16(0) != 20 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (CallSequence) DecodeFromPtr(p capnp.Ptr) CallSequence {
-	return CallSequence(capnp.Client{}.DecodeFromPtr(p))
+func (s Z) SetI8vec(v capnp.Int8List) error {
+	capnp.Struct(s).SetUint16(0, 20)
+	return capnp.SetListField(s, 0, v)
 }
 
-// IsValid reports whether c is a valid reference to a capability.
-// A reference is invalid if it is nil, has resolved to null, or has
-// been released.
-func (c CallSequence) IsValid() bool {
-	return capnp.Client(c).IsValid()
+// NewI8vec sets the i8vec field to a newly
+// allocated capnp.Int8List, preferring placement in s's segment.
+func (s Z) NewI8vec(n int32) (capnp.Int8List, error) {
+	capnp.Struct(s).SetUint16(0, 20)
+	return capnp.NewListField(s, 0, n, capnp.NewInt8List)
 }
 
-// IsSame reports whether c and other refer to a capability created by the
-// same call to NewClient.  This can return false negatives if c or other
-// are not fully resolved: use Resolve if this is an issue.  If either
-// c or other are released, then IsSame panics.
-func (c CallSequence) IsSame(other CallSequence) bool {
-	return capnp.Client(c).IsSame(capnp.Client(other))
+// SetI8vecFromSlice sets the i8vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetI8vecFromSlice(v []int8) error {
+	capnp.Struct(s).SetUint16(0, 20)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewInt8ListFromSlice)
 }
-
-// Update the flowcontrol.FlowLimiter used to manage flow control for
-// this client. This affects all future calls, but not calls already
-// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
-// which is also the default.
-func (c CallSequence) SetFlowLimiter(lim fc.FlowLimiter) {
-	capnp.Client(c).SetFlowLimiter(lim)
+func (s Z) U64vec() (capnp.UInt64List, error) {
+	if capnp.Struct(s).Uint16(0) != 21 {
+		panic("Which() != u64vec")
+	}
+	return capnp.GetListField[capnp.UInt64List](s, 0, "Z.u64vec")
 }
 
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c CallSequence) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
+func (s Z) HasU64vec() bool {
+	if capnp.Struct(s).Uint16(0) != 21 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// A CallSequence_Server is a CallSequence with a local implementation.
-type CallSequence_Server interface {
-	GetNumber(context.Context, CallSequence_getNumber) error
+func (s Z) SetU64vec(v capnp.UInt64List) error {
+	capnp.Struct(s).SetUint16(0, 21)
+	return capnp.SetListField(s, 0, v)
 }
 
-// CallSequence_NewServer creates a new Server from an implementation of CallSequence_Server.
-func CallSequence_NewServer(s CallSequence_Server) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(CallSequence_Methods(nil, s), s, c)
+// NewU64vec sets the u64vec field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Z) NewU64vec(n int32) (capnp.UInt64List, error) {
+	capnp.Struct(s).SetUint16(0, 21)
+	return capnp.NewListField(s, 0, n, capnp.NewUInt64List)
 }
 
-// CallSequence_ServerToClient creates a new Client from an implementation of CallSequence_Server.
-// The caller is responsible for calling Release on the returned Client.
-func CallSequence_ServerToClient(s CallSequence_Server) CallSequence {
-	return CallSequence(capnp.NewClient(CallSequence_NewServer(s)))
+// SetU64vecFromSlice sets the u64vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetU64vecFromSlice(v []uint64) error {
+	capnp.Struct(s).SetUint16(0, 21)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewUInt64ListFromSlice)
 }
-
-// CallSequence_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func CallSequence_Methods(methods []server.Method, s CallSequence_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 1)
+func (s Z) U32vec() (capnp.UInt32List, error) {
+	if capnp.Struct(s).Uint16(0) != 22 {
+		panic("Which() != u32vec")
 	}
+	return capnp.GetListField[capnp.UInt32List](s, 0, "Z.u32vec")
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xabaedf5f7817c820,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:CallSequence",
-			MethodName:    "getNumber",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.GetNumber(ctx, CallSequence_getNumber{call})
-		},
-	})
+func (s Z) HasU32vec() bool {
+	if capnp.Struct(s).Uint16(0) != 22 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-	return methods
+func (s Z) SetU32vec(v capnp.UInt32List) error {
+	capnp.Struct(s).SetUint16(0, 22)
+	return capnp.SetListField(s, 0, v)
 }
 
-// CallSequence_getNumber holds the state for a server call to CallSequence.getNumber.
-// See server.Call for documentation.
-type CallSequence_getNumber struct {
-	*server.Call
+// NewU32vec sets the u32vec field to a newly
+// allocated capnp.UInt32List, preferring placement in s's segment.
+func (s Z) NewU32vec(n int32) (capnp.UInt32List, error) {
+	capnp.Struct(s).SetUint16(0, 22)
+	return capnp.NewListField(s, 0, n, capnp.NewUInt32List)
 }
 
-// Args returns the call's arguments.
-func (c CallSequence_getNumber) Args() CallSequence_getNumber_Params {
-	return CallSequence_getNumber_Params(c.Call.Args())
+// SetU32vecFromSlice sets the u32vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetU32vecFromSlice(v []uint32) error {
+	capnp.Struct(s).SetUint16(0, 22)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewUInt32ListFromSlice)
+}
+func (s Z) U16vec() (capnp.UInt16List, error) {
+	if capnp.Struct(s).Uint16(0) != 23 {
+		panic("Which() != u16vec")
+	}
+	return capnp.GetListField[capnp.UInt16List](s, 0, "Z.u16vec")
 }
 
-// AllocResults allocates the results struct.
-func (c CallSequence_getNumber) AllocResults() (CallSequence_getNumber_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return CallSequence_getNumber_Results(r), err
+func (s Z) HasU16vec() bool {
+	if capnp.Struct(s).Uint16(0) != 23 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// CallSequence_List is a list of CallSequence.
-type CallSequence_List = capnp.CapList[CallSequence]
+func (s Z) SetU16vec(v capnp.UInt16List) error {
+	capnp.Struct(s).SetUint16(0, 23)
+	return capnp.SetListField(s, 0, v)
+}
 
-// NewCallSequence creates a new list of CallSequence.
-func NewCallSequence_List(s *capnp.Segment, sz int32) (CallSequence_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[CallSequence](l), err
+// NewU16vec sets the u16vec field to a newly
+// allocated capnp.UInt16List, preferring placement in s's segment.
+func (s Z) NewU16vec(n int32) (capnp.UInt16List, error) {
+	capnp.Struct(s).SetUint16(0, 23)
+	return capnp.NewListField(s, 0, n, capnp.NewUInt16List)
 }
 
-type CallSequence_getNumber_Params capnp.Struct
+// SetU16vecFromSlice sets the u16vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetU16vecFromSlice(v []uint16) error {
+	capnp.Struct(s).SetUint16(0, 23)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewUInt16ListFromSlice)
+}
+func (s Z) U8vec() (capnp.UInt8List, error) {
+	if capnp.Struct(s).Uint16(0) != 24 {
+		panic("Which() != u8vec")
+	}
+	return capnp.GetListField[capnp.UInt8List](s, 0, "Z.u8vec")
+}
 
-// CallSequence_getNumber_Params_TypeID is the unique identifier for the type CallSequence_getNumber_Params.
-const CallSequence_getNumber_Params_TypeID = 0xf58782f48a121998
+func (s Z) HasU8vec() bool {
+	if capnp.Struct(s).Uint16(0) != 24 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-func NewCallSequence_getNumber_Params(s *capnp.Segment) (CallSequence_getNumber_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CallSequence_getNumber_Params(st), err
+func (s Z) SetU8vec(v capnp.UInt8List) error {
+	capnp.Struct(s).SetUint16(0, 24)
+	return capnp.SetListField(s, 0, v)
 }
 
-func NewRootCallSequence_getNumber_Params(s *capnp.Segment) (CallSequence_getNumber_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CallSequence_getNumber_Params(st), err
+// NewU8vec sets the u8vec field to a newly
+// allocated capnp.UInt8List, preferring placement in s's segment.
+func (s Z) NewU8vec(n int32) (capnp.UInt8List, error) {
+	capnp.Struct(s).SetUint16(0, 24)
+	return capnp.NewListField(s, 0, n, capnp.NewUInt8List)
 }
 
-func ReadRootCallSequence_getNumber_Params(msg *capnp.Message) (CallSequence_getNumber_Params, error) {
-	root, err := msg.Root()
-	return CallSequence_getNumber_Params(root.Struct()), err
+// SetU8vecFromSlice sets the u8vec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetU8vecFromSlice(v []uint8) error {
+	capnp.Struct(s).SetUint16(0, 24)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewUInt8ListFromSlice)
+}
+func (s Z) Boolvec() (capnp.BitList, error) {
+	if capnp.Struct(s).Uint16(0) != 39 {
+		panic("Which() != boolvec")
+	}
+	return capnp.GetListField[capnp.BitList](s, 0, "Z.boolvec")
 }
 
-func (s CallSequence_getNumber_Params) String() string {
-	str, _ := text.Marshal(0xf58782f48a121998, capnp.Struct(s))
-	return str
+func (s Z) HasBoolvec() bool {
+	if capnp.Struct(s).Uint16(0) != 39 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CallSequence_getNumber_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Z) SetBoolvec(v capnp.BitList) error {
+	capnp.Struct(s).SetUint16(0, 39)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (CallSequence_getNumber_Params) DecodeFromPtr(p capnp.Ptr) CallSequence_getNumber_Params {
-	return CallSequence_getNumber_Params(capnp.Struct{}.DecodeFromPtr(p))
+// NewBoolvec sets the boolvec field to a newly
+// allocated capnp.BitList, preferring placement in s's segment.
+func (s Z) NewBoolvec(n int32) (capnp.BitList, error) {
+	capnp.Struct(s).SetUint16(0, 39)
+	return capnp.NewListField(s, 0, n, capnp.NewBitList)
 }
 
-func (s CallSequence_getNumber_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// SetBoolvecFromSlice sets the boolvec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetBoolvecFromSlice(v []bool) error {
+	capnp.Struct(s).SetUint16(0, 39)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewBitListFromSlice)
 }
-func (s CallSequence_getNumber_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Z) Datavec() (capnp.DataList, error) {
+	if capnp.Struct(s).Uint16(0) != 40 {
+		panic("Which() != datavec")
+	}
+	return capnp.GetListField[capnp.DataList](s, 0, "Z.datavec")
 }
 
-func (s CallSequence_getNumber_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Z) HasDatavec() bool {
+	if capnp.Struct(s).Uint16(0) != 40 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CallSequence_getNumber_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Z) SetDatavec(v capnp.DataList) error {
+	capnp.Struct(s).SetUint16(0, 40)
+	return capnp.SetListField(s, 0, v)
 }
 
-// CallSequence_getNumber_Params_List is a list of CallSequence_getNumber_Params.
-type CallSequence_getNumber_Params_List = capnp.StructList[CallSequence_getNumber_Params]
-
-// NewCallSequence_getNumber_Params creates a new list of CallSequence_getNumber_Params.
-func NewCallSequence_getNumber_Params_List(s *capnp.Segment, sz int32) (CallSequence_getNumber_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[CallSequence_getNumber_Params](l), err
+// NewDatavec sets the datavec field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s Z) NewDatavec(n int32) (capnp.DataList, error) {
+	capnp.Struct(s).SetUint16(0, 40)
+	return capnp.NewListField(s, 0, n, capnp.NewDataList)
 }
 
-// CallSequence_getNumber_Params_Future is a wrapper for a CallSequence_getNumber_Params promised by a client call.
-type CallSequence_getNumber_Params_Future struct{ *capnp.Future }
+// SetDatavecFromSlice sets the datavec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetDatavecFromSlice(v [][]byte) error {
+	capnp.Struct(s).SetUint16(0, 40)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewDataListFromSlice)
+}
+func (s Z) Textvec() (capnp.TextList, error) {
+	if capnp.Struct(s).Uint16(0) != 41 {
+		panic("Which() != textvec")
+	}
+	return capnp.GetListField[capnp.TextList](s, 0, "Z.textvec")
+}
 
-func (f CallSequence_getNumber_Params_Future) Struct() (CallSequence_getNumber_Params, error) {
-	p, err := f.Future.Ptr()
-	return CallSequence_getNumber_Params(p.Struct()), err
+func (s Z) HasTextvec() bool {
+	if capnp.Struct(s).Uint16(0) != 41 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-type CallSequence_getNumber_Results capnp.Struct
+func (s Z) SetTextvec(v capnp.TextList) error {
+	capnp.Struct(s).SetUint16(0, 41)
+	return capnp.SetListField(s, 0, v)
+}
 
-// CallSequence_getNumber_Results_TypeID is the unique identifier for the type CallSequence_getNumber_Results.
-const CallSequence_getNumber_Results_TypeID = 0xa465f9502fd11e97
+// NewTextvec sets the textvec field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Z) NewTextvec(n int32) (capnp.TextList, error) {
+	capnp.Struct(s).SetUint16(0, 41)
+	return capnp.NewListField(s, 0, n, capnp.NewTextList)
+}
 
-func NewCallSequence_getNumber_Results(s *capnp.Segment) (CallSequence_getNumber_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return CallSequence_getNumber_Results(st), err
+// SetTextvecFromSlice sets the textvec field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Z) SetTextvecFromSlice(v []string) error {
+	capnp.Struct(s).SetUint16(0, 41)
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewTextListFromSlice)
+}
+func (s Z) Zvec() (Z_List, error) {
+	if capnp.Struct(s).Uint16(0) != 25 {
+		panic("Which() != zvec")
+	}
+	return capnp.GetListField[Z_List](s, 0, "Z.zvec")
 }
 
-func NewRootCallSequence_getNumber_Results(s *capnp.Segment) (CallSequence_getNumber_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return CallSequence_getNumber_Results(st), err
+func (s Z) HasZvec() bool {
+	if capnp.Struct(s).Uint16(0) != 25 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func ReadRootCallSequence_getNumber_Results(msg *capnp.Message) (CallSequence_getNumber_Results, error) {
-	root, err := msg.Root()
-	return CallSequence_getNumber_Results(root.Struct()), err
+func (s Z) SetZvec(v Z_List) error {
+	capnp.Struct(s).SetUint16(0, 25)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s CallSequence_getNumber_Results) String() string {
-	str, _ := text.Marshal(0xa465f9502fd11e97, capnp.Struct(s))
-	return str
+// NewZvec sets the zvec field to a newly
+// allocated Z_List, preferring placement in s's segment.
+func (s Z) NewZvec(n int32) (Z_List, error) {
+	capnp.Struct(s).SetUint16(0, 25)
+	return capnp.NewListField(s, 0, n, NewZ_List)
+}
+func (s Z) Zvecvec() (capnp.PointerList, error) {
+	if capnp.Struct(s).Uint16(0) != 26 {
+		panic("Which() != zvecvec")
+	}
+	return capnp.GetListField[capnp.PointerList](s, 0, "Z.zvecvec")
 }
 
-func (s CallSequence_getNumber_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Z) HasZvecvec() bool {
+	if capnp.Struct(s).Uint16(0) != 26 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (CallSequence_getNumber_Results) DecodeFromPtr(p capnp.Ptr) CallSequence_getNumber_Results {
-	return CallSequence_getNumber_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (s Z) SetZvecvec(v capnp.PointerList) error {
+	capnp.Struct(s).SetUint16(0, 26)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s CallSequence_getNumber_Results) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// NewZvecvec sets the zvecvec field to a newly
+// allocated capnp.PointerList, preferring placement in s's segment.
+func (s Z) NewZvecvec(n int32) (capnp.PointerList, error) {
+	capnp.Struct(s).SetUint16(0, 26)
+	return capnp.NewListField(s, 0, n, capnp.NewPointerList)
 }
-func (s CallSequence_getNumber_Results) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Z) Zdate() (Zdate, error) {
+	if capnp.Struct(s).Uint16(0) != 27 {
+		panic("Which() != zdate")
+	}
+	return capnp.GetStructField[Zdate](s, 0, "Z.zdate")
 }
 
-func (s CallSequence_getNumber_Results) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Z) HasZdate() bool {
+	if capnp.Struct(s).Uint16(0) != 27 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CallSequence_getNumber_Results) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s CallSequence_getNumber_Results) N() uint32 {
-	return capnp.Struct(s).Uint32(0)
+func (s Z) SetZdate(v Zdate) error {
+	capnp.Struct(s).SetUint16(0, 27)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s CallSequence_getNumber_Results) SetN(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+// NewZdate sets the zdate field to a newly
+// allocated Zdate struct, preferring placement in s's segment.
+func (s Z) NewZdate() (Zdate, error) {
+	capnp.Struct(s).SetUint16(0, 27)
+	return capnp.NewStructField(s, 0, NewZdate)
 }
 
-// CallSequence_getNumber_Results_List is a list of CallSequence_getNumber_Results.
-type CallSequence_getNumber_Results_List = capnp.StructList[CallSequence_getNumber_Results]
-
-// NewCallSequence_getNumber_Results creates a new list of CallSequence_getNumber_Results.
-func NewCallSequence_getNumber_Results_List(s *capnp.Segment, sz int32) (CallSequence_getNumber_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[CallSequence_getNumber_Results](l), err
+func (s Z) Zdata() (Zdata, error) {
+	if capnp.Struct(s).Uint16(0) != 28 {
+		panic("Which() != zdata")
+	}
+	return capnp.GetStructField[Zdata](s, 0, "Z.zdata")
 }
 
-// CallSequence_getNumber_Results_Future is a wrapper for a CallSequence_getNumber_Results promised by a client call.
-type CallSequence_getNumber_Results_Future struct{ *capnp.Future }
-
-func (f CallSequence_getNumber_Results_Future) Struct() (CallSequence_getNumber_Results, error) {
-	p, err := f.Future.Ptr()
-	return CallSequence_getNumber_Results(p.Struct()), err
+func (s Z) HasZdata() bool {
+	if capnp.Struct(s).Uint16(0) != 28 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-type Pipeliner capnp.Client
-
-// Pipeliner_TypeID is the unique identifier for the type Pipeliner.
-const Pipeliner_TypeID = 0xd6514008f0f84ebc
+func (s Z) SetZdata(v Zdata) error {
+	capnp.Struct(s).SetUint16(0, 28)
+	return capnp.SetStructField(s, 0, v)
+}
 
-func (c Pipeliner) NewPipeliner(ctx context.Context, params func(Pipeliner_newPipeliner_Params) error) (Pipeliner_newPipeliner_Results_Future, capnp.ReleaseFunc) {
+// NewZdata sets the zdata field to a newly
+// allocated Zdata struct, preferring placement in s's segment.
+func (s Z) NewZdata() (Zdata, error) {
+	capnp.Struct(s).SetUint16(0, 28)
+	return capnp.NewStructField(s, 0, NewZdata)
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xd6514008f0f84ebc,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:Pipeliner",
-			MethodName:    "newPipeliner",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(Pipeliner_newPipeliner_Params(s)) }
+func (s Z) Aircraftvec() (Aircraft_List, error) {
+	if capnp.Struct(s).Uint16(0) != 29 {
+		panic("Which() != aircraftvec")
 	}
+	return capnp.GetListField[Aircraft_List](s, 0, "Z.aircraftvec")
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return Pipeliner_newPipeliner_Results_Future{Future: ans.Future()}, release
-
+func (s Z) HasAircraftvec() bool {
+	if capnp.Struct(s).Uint16(0) != 29 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (c Pipeliner) GetNumber(ctx context.Context, params func(CallSequence_getNumber_Params) error) (CallSequence_getNumber_Results_Future, capnp.ReleaseFunc) {
+func (s Z) SetAircraftvec(v Aircraft_List) error {
+	capnp.Struct(s).SetUint16(0, 29)
+	return capnp.SetListField(s, 0, v)
+}
 
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xabaedf5f7817c820,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:CallSequence",
-			MethodName:    "getNumber",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(CallSequence_getNumber_Params(s)) }
+// NewAircraftvec sets the aircraftvec field to a newly
+// allocated Aircraft_List, preferring placement in s's segment.
+func (s Z) NewAircraftvec(n int32) (Aircraft_List, error) {
+	capnp.Struct(s).SetUint16(0, 29)
+	return capnp.NewListField(s, 0, n, NewAircraft_List)
+}
+func (s Z) Aircraft() (Aircraft, error) {
+	if capnp.Struct(s).Uint16(0) != 30 {
+		panic("Which() != aircraft")
 	}
+	return capnp.GetStructField[Aircraft](s, 0, "Z.aircraft")
+}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return CallSequence_getNumber_Results_Future{Future: ans.Future()}, release
+func (s Z) HasAircraft() bool {
+	if capnp.Struct(s).Uint16(0) != 30 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
+func (s Z) SetAircraft(v Aircraft) error {
+	capnp.Struct(s).SetUint16(0, 30)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (c Pipeliner) WaitStreaming() error {
-	return capnp.Client(c).WaitStreaming()
+// NewAircraft sets the aircraft field to a newly
+// allocated Aircraft struct, preferring placement in s's segment.
+func (s Z) NewAircraft() (Aircraft, error) {
+	capnp.Struct(s).SetUint16(0, 30)
+	return capnp.NewStructField(s, 0, NewAircraft)
 }
 
-// String returns a string that identifies this capability for debugging
-// purposes.  Its format should not be depended on: in particular, it
-// should not be used to compare clients.  Use IsSame to compare clients
-// for equality.
-func (c Pipeliner) String() string {
-	return "Pipeliner(" + capnp.Client(c).String() + ")"
+func (s Z) Regression() (Regression, error) {
+	if capnp.Struct(s).Uint16(0) != 31 {
+		panic("Which() != regression")
+	}
+	return capnp.GetStructField[Regression](s, 0, "Z.regression")
 }
 
-// AddRef creates a new Client that refers to the same capability as c.
-// If c is nil or has resolved to null, then AddRef returns nil.
-func (c Pipeliner) AddRef() Pipeliner {
-	return Pipeliner(capnp.Client(c).AddRef())
+func (s Z) HasRegression() bool {
+	if capnp.Struct(s).Uint16(0) != 31 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Release releases a capability reference.  If this is the last
-// reference to the capability, then the underlying resources associated
-// with the capability will be released.
-//
-// Release will panic if c has already been released, but not if c is
-// nil or resolved to null.
-func (c Pipeliner) Release() {
-	capnp.Client(c).Release()
+func (s Z) SetRegression(v Regression) error {
+	capnp.Struct(s).SetUint16(0, 31)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Resolve blocks until the capability is fully resolved or the Context
-// expires.
-func (c Pipeliner) Resolve(ctx context.Context) error {
-	return capnp.Client(c).Resolve(ctx)
+// NewRegression sets the regression field to a newly
+// allocated Regression struct, preferring placement in s's segment.
+func (s Z) NewRegression() (Regression, error) {
+	capnp.Struct(s).SetUint16(0, 31)
+	return capnp.NewStructField(s, 0, NewRegression)
 }
 
-func (c Pipeliner) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Client(c).EncodeAsPtr(seg)
+func (s Z) Planebase() (PlaneBase, error) {
+	if capnp.Struct(s).Uint16(0) != 32 {
+		panic("Which() != planebase")
+	}
+	return capnp.GetStructField[PlaneBase](s, 0, "Z.planebase")
 }
 
-func (Pipeliner) DecodeFromPtr(p capnp.Ptr) Pipeliner {
-	return Pipeliner(capnp.Client{}.DecodeFromPtr(p))
+func (s Z) HasPlanebase() bool {
+	if capnp.Struct(s).Uint16(0) != 32 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// IsValid reports whether c is a valid reference to a capability.
-// A reference is invalid if it is nil, has resolved to null, or has
-// been released.
-func (c Pipeliner) IsValid() bool {
-	return capnp.Client(c).IsValid()
+func (s Z) SetPlanebase(v PlaneBase) error {
+	capnp.Struct(s).SetUint16(0, 32)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// IsSame reports whether c and other refer to a capability created by the
-// same call to NewClient.  This can return false negatives if c or other
-// are not fully resolved: use Resolve if this is an issue.  If either
-// c or other are released, then IsSame panics.
-func (c Pipeliner) IsSame(other Pipeliner) bool {
-	return capnp.Client(c).IsSame(capnp.Client(other))
+// NewPlanebase sets the planebase field to a newly
+// allocated PlaneBase struct, preferring placement in s's segment.
+func (s Z) NewPlanebase() (PlaneBase, error) {
+	capnp.Struct(s).SetUint16(0, 32)
+	return capnp.NewStructField(s, 0, NewPlaneBase)
 }
 
-// Update the flowcontrol.FlowLimiter used to manage flow control for
-// this client. This affects all future calls, but not calls already
-// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
-// which is also the default.
-func (c Pipeliner) SetFlowLimiter(lim fc.FlowLimiter) {
-	capnp.Client(c).SetFlowLimiter(lim)
+func (s Z) Airport() Airport {
+	if capnp.Struct(s).Uint16(0) != 33 {
+		panic("Which() != airport")
+	}
+	return Airport(capnp.Struct(s).Uint16(8))
 }
 
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c Pipeliner) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
+func (s Z) SetAirport(v Airport) {
+	capnp.Struct(s).SetUint16(0, 33)
+	capnp.Struct(s).SetUint16(8, uint16(v))
 }
 
-// A Pipeliner_Server is a Pipeliner with a local implementation.
-type Pipeliner_Server interface {
-	NewPipeliner(context.Context, Pipeliner_newPipeliner) error
+func (s Z) B737() (B737, error) {
+	if capnp.Struct(s).Uint16(0) != 34 {
+		panic("Which() != b737")
+	}
+	return capnp.GetStructField[B737](s, 0, "Z.b737")
+}
 
-	GetNumber(context.Context, CallSequence_getNumber) error
+func (s Z) HasB737() bool {
+	if capnp.Struct(s).Uint16(0) != 34 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Pipeliner_NewServer creates a new Server from an implementation of Pipeliner_Server.
-func Pipeliner_NewServer(s Pipeliner_Server) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(Pipeliner_Methods(nil, s), s, c)
+func (s Z) SetB737(v B737) error {
+	capnp.Struct(s).SetUint16(0, 34)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Pipeliner_ServerToClient creates a new Client from an implementation of Pipeliner_Server.
-// The caller is responsible for calling Release on the returned Client.
-func Pipeliner_ServerToClient(s Pipeliner_Server) Pipeliner {
-	return Pipeliner(capnp.NewClient(Pipeliner_NewServer(s)))
+// NewB737 sets the b737 field to a newly
+// allocated B737 struct, preferring placement in s's segment.
+func (s Z) NewB737() (B737, error) {
+	capnp.Struct(s).SetUint16(0, 34)
+	return capnp.NewStructField(s, 0, NewB737)
 }
 
-// Pipeliner_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func Pipeliner_Methods(methods []server.Method, s Pipeliner_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+func (s Z) A320() (A320, error) {
+	if capnp.Struct(s).Uint16(0) != 35 {
+		panic("Which() != a320")
 	}
+	return capnp.GetStructField[A320](s, 0, "Z.a320")
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xd6514008f0f84ebc,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:Pipeliner",
-			MethodName:    "newPipeliner",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.NewPipeliner(ctx, Pipeliner_newPipeliner{call})
-		},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xabaedf5f7817c820,
-			MethodID:      0,
-			InterfaceName: "aircraft.capnp:CallSequence",
-			MethodName:    "getNumber",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.GetNumber(ctx, CallSequence_getNumber{call})
-		},
-	})
-
-	return methods
+func (s Z) HasA320() bool {
+	if capnp.Struct(s).Uint16(0) != 35 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Pipeliner_newPipeliner holds the state for a server call to Pipeliner.newPipeliner.
-// See server.Call for documentation.
-type Pipeliner_newPipeliner struct {
-	*server.Call
+func (s Z) SetA320(v A320) error {
+	capnp.Struct(s).SetUint16(0, 35)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Args returns the call's arguments.
-func (c Pipeliner_newPipeliner) Args() Pipeliner_newPipeliner_Params {
-	return Pipeliner_newPipeliner_Params(c.Call.Args())
+// NewA320 sets the a320 field to a newly
+// allocated A320 struct, preferring placement in s's segment.
+func (s Z) NewA320() (A320, error) {
+	capnp.Struct(s).SetUint16(0, 35)
+	return capnp.NewStructField(s, 0, NewA320)
 }
 
-// AllocResults allocates the results struct.
-func (c Pipeliner_newPipeliner) AllocResults() (Pipeliner_newPipeliner_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Pipeliner_newPipeliner_Results(r), err
+func (s Z) F16() (F16, error) {
+	if capnp.Struct(s).Uint16(0) != 36 {
+		panic("Which() != f16")
+	}
+	return capnp.GetStructField[F16](s, 0, "Z.f16")
 }
 
-// Pipeliner_List is a list of Pipeliner.
-type Pipeliner_List = capnp.CapList[Pipeliner]
+func (s Z) HasF16() bool {
+	if capnp.Struct(s).Uint16(0) != 36 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewPipeliner creates a new list of Pipeliner.
-func NewPipeliner_List(s *capnp.Segment, sz int32) (Pipeliner_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[Pipeliner](l), err
+func (s Z) SetF16(v F16) error {
+	capnp.Struct(s).SetUint16(0, 36)
+	return capnp.SetStructField(s, 0, v)
 }
 
-type Pipeliner_newPipeliner_Params capnp.Struct
+// NewF16 sets the f16 field to a newly
+// allocated F16 struct, preferring placement in s's segment.
+func (s Z) NewF16() (F16, error) {
+	capnp.Struct(s).SetUint16(0, 36)
+	return capnp.NewStructField(s, 0, NewF16)
+}
 
-// Pipeliner_newPipeliner_Params_TypeID is the unique identifier for the type Pipeliner_newPipeliner_Params.
-const Pipeliner_newPipeliner_Params_TypeID = 0xbaa7b3b1ca91f833
+func (s Z) Zdatevec() (Zdate_List, error) {
+	if capnp.Struct(s).Uint16(0) != 37 {
+		panic("Which() != zdatevec")
+	}
+	return capnp.GetListField[Zdate_List](s, 0, "Z.zdatevec")
+}
 
-func NewPipeliner_newPipeliner_Params(s *capnp.Segment) (Pipeliner_newPipeliner_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Pipeliner_newPipeliner_Params(st), err
+func (s Z) HasZdatevec() bool {
+	if capnp.Struct(s).Uint16(0) != 37 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func NewRootPipeliner_newPipeliner_Params(s *capnp.Segment) (Pipeliner_newPipeliner_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Pipeliner_newPipeliner_Params(st), err
+func (s Z) SetZdatevec(v Zdate_List) error {
+	capnp.Struct(s).SetUint16(0, 37)
+	return capnp.SetListField(s, 0, v)
 }
 
-func ReadRootPipeliner_newPipeliner_Params(msg *capnp.Message) (Pipeliner_newPipeliner_Params, error) {
-	root, err := msg.Root()
-	return Pipeliner_newPipeliner_Params(root.Struct()), err
+// NewZdatevec sets the zdatevec field to a newly
+// allocated Zdate_List, preferring placement in s's segment.
+func (s Z) NewZdatevec(n int32) (Zdate_List, error) {
+	capnp.Struct(s).SetUint16(0, 37)
+	return capnp.NewListField(s, 0, n, NewZdate_List)
+}
+func (s Z) Zdatavec() (Zdata_List, error) {
+	if capnp.Struct(s).Uint16(0) != 38 {
+		panic("Which() != zdatavec")
+	}
+	return capnp.GetListField[Zdata_List](s, 0, "Z.zdatavec")
 }
 
-func (s Pipeliner_newPipeliner_Params) String() string {
-	str, _ := text.Marshal(0xbaa7b3b1ca91f833, capnp.Struct(s))
-	return str
+func (s Z) HasZdatavec() bool {
+	if capnp.Struct(s).Uint16(0) != 38 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Pipeliner_newPipeliner_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Z) SetZdatavec(v Zdata_List) error {
+	capnp.Struct(s).SetUint16(0, 38)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (Pipeliner_newPipeliner_Params) DecodeFromPtr(p capnp.Ptr) Pipeliner_newPipeliner_Params {
-	return Pipeliner_newPipeliner_Params(capnp.Struct{}.DecodeFromPtr(p))
+// NewZdatavec sets the zdatavec field to a newly
+// allocated Zdata_List, preferring placement in s's segment.
+func (s Z) NewZdatavec(n int32) (Zdata_List, error) {
+	capnp.Struct(s).SetUint16(0, 38)
+	return capnp.NewListField(s, 0, n, NewZdata_List)
 }
+func (s Z) Grp() Z_grp { return Z_grp(s) }
 
-func (s Pipeliner_newPipeliner_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (s Z) SetGrp() {
+	capnp.Struct(s).SetUint16(0, 42)
 }
-func (s Pipeliner_newPipeliner_Params) IsValid() bool {
+
+func (s Z_grp) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Pipeliner_newPipeliner_Params) Message() *capnp.Message {
+func (s Z_grp) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Pipeliner_newPipeliner_Params) Segment() *capnp.Segment {
+func (s Z_grp) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s Z_grp) First() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
 
-// Pipeliner_newPipeliner_Params_List is a list of Pipeliner_newPipeliner_Params.
-type Pipeliner_newPipeliner_Params_List = capnp.StructList[Pipeliner_newPipeliner_Params]
-
-// NewPipeliner_newPipeliner_Params creates a new list of Pipeliner_newPipeliner_Params.
-func NewPipeliner_newPipeliner_Params_List(s *capnp.Segment, sz int32) (Pipeliner_newPipeliner_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Pipeliner_newPipeliner_Params](l), err
+func (s Z_grp) SetFirst(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
 }
 
-// Pipeliner_newPipeliner_Params_Future is a wrapper for a Pipeliner_newPipeliner_Params promised by a client call.
-type Pipeliner_newPipeliner_Params_Future struct{ *capnp.Future }
+func (s Z_grp) Second() uint64 {
+	return capnp.Struct(s).Uint64(16)
+}
 
-func (f Pipeliner_newPipeliner_Params_Future) Struct() (Pipeliner_newPipeliner_Params, error) {
-	p, err := f.Future.Ptr()
-	return Pipeliner_newPipeliner_Params(p.Struct()), err
+func (s Z_grp) SetSecond(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
 }
 
-type Pipeliner_newPipeliner_Results capnp.Struct
+func (s Z) Echo() Echo {
+	if capnp.Struct(s).Uint16(0) != 43 {
+		panic("Which() != echo")
+	}
+	return capnp.GetInterfaceField[Echo](s, 0)
+}
 
-// Pipeliner_newPipeliner_Results_TypeID is the unique identifier for the type Pipeliner_newPipeliner_Results.
-const Pipeliner_newPipeliner_Results_TypeID = 0xbbcdbf4b4ae501fa
+func (s Z) HasEcho() bool {
+	if capnp.Struct(s).Uint16(0) != 43 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
+}
 
-func NewPipeliner_newPipeliner_Results(s *capnp.Segment) (Pipeliner_newPipeliner_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Pipeliner_newPipeliner_Results(st), err
+func (s Z) SetEcho(v Echo) error {
+	capnp.Struct(s).SetUint16(0, 43)
+	return capnp.SetInterfaceField(s, 0, v)
 }
 
-func NewRootPipeliner_newPipeliner_Results(s *capnp.Segment) (Pipeliner_newPipeliner_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Pipeliner_newPipeliner_Results(st), err
+func (s Z) Echoes() (Echo_List, error) {
+	if capnp.Struct(s).Uint16(0) != 44 {
+		panic("Which() != echoes")
+	}
+	return capnp.GetListField[Echo_List](s, 0, "Z.echoes")
 }
 
-func ReadRootPipeliner_newPipeliner_Results(msg *capnp.Message) (Pipeliner_newPipeliner_Results, error) {
-	root, err := msg.Root()
-	return Pipeliner_newPipeliner_Results(root.Struct()), err
+func (s Z) HasEchoes() bool {
+	if capnp.Struct(s).Uint16(0) != 44 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Pipeliner_newPipeliner_Results) String() string {
-	str, _ := text.Marshal(0xbbcdbf4b4ae501fa, capnp.Struct(s))
-	return str
+func (s Z) SetEchoes(v Echo_List) error {
+	capnp.Struct(s).SetUint16(0, 44)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s Pipeliner_newPipeliner_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// NewEchoes sets the echoes field to a newly
+// allocated Echo_List, preferring placement in s's segment.
+func (s Z) NewEchoes(n int32) (Echo_List, error) {
+	capnp.Struct(s).SetUint16(0, 44)
+	return capnp.NewListField(s, 0, n, NewEcho_List)
+}
+func (s Z) AnyPtr() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(0) != 45 {
+		panic("Which() != anyPtr")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Z.anyPtr")
 }
 
-func (Pipeliner_newPipeliner_Results) DecodeFromPtr(p capnp.Ptr) Pipeliner_newPipeliner_Results {
-	return Pipeliner_newPipeliner_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (s Z) HasAnyPtr() bool {
+	if capnp.Struct(s).Uint16(0) != 45 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Pipeliner_newPipeliner_Results) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (s Z) SetAnyPtr(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(0, 45)
+	return capnp.Struct(s).SetPtr(0, v)
 }
-func (s Pipeliner_newPipeliner_Results) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Z) AnyStruct() (capnp.Struct, error) {
+	if capnp.Struct(s).Uint16(0) != 46 {
+		panic("Which() != anyStruct")
+	}
+	return capnp.GetStructField[capnp.Struct](s, 0, "Z.anyStruct")
 }
 
-func (s Pipeliner_newPipeliner_Results) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Z) HasAnyStruct() bool {
+	if capnp.Struct(s).Uint16(0) != 46 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Pipeliner_newPipeliner_Results) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Z) SetAnyStruct(v capnp.Struct) error {
+	capnp.Struct(s).SetUint16(0, 46)
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
-func (s Pipeliner_newPipeliner_Results) Extra() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
+func (s Z) AnyList() (capnp.List, error) {
+	if capnp.Struct(s).Uint16(0) != 47 {
+		panic("Which() != anyList")
+	}
+	return capnp.GetListField[capnp.List](s, 0, "Z.anyList")
 }
 
-func (s Pipeliner_newPipeliner_Results) HasExtra() bool {
+func (s Z) HasAnyList() bool {
+	if capnp.Struct(s).Uint16(0) != 47 {
+		return false
+	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Pipeliner_newPipeliner_Results) SetExtra(v capnp.Ptr) error {
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Z) SetAnyList(v capnp.List) error {
+	capnp.Struct(s).SetUint16(0, 47)
+	return capnp.Struct(s).SetPtr(0, v.ToPtr())
 }
-func (s Pipeliner_newPipeliner_Results) Pipeliner() Pipeliner {
-	p, _ := capnp.Struct(s).Ptr(1)
-	return Pipeliner(p.Interface().Client())
+func (s Z) AnyCapability() capnp.Client {
+	if capnp.Struct(s).Uint16(0) != 48 {
+		panic("Which() != anyCapability")
+	}
+	p, _ := capnp.Struct(s).Ptr(0)
+	return p.Interface().Client()
 }
 
-func (s Pipeliner_newPipeliner_Results) HasPipeliner() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Z) HasAnyCapability() bool {
+	if capnp.Struct(s).Uint16(0) != 48 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Pipeliner_newPipeliner_Results) SetPipeliner(v Pipeliner) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(1, capnp.Ptr{})
+func (s Z) SetAnyCapability(c capnp.Client) error {
+	capnp.Struct(s).SetUint16(0, 48)
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
 	}
 	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(1, in.ToPtr())
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
 }
 
-// Pipeliner_newPipeliner_Results_List is a list of Pipeliner_newPipeliner_Results.
-type Pipeliner_newPipeliner_Results_List = capnp.StructList[Pipeliner_newPipeliner_Results]
+// Z_List is a list of Z.
+type Z_List = capnp.StructList[Z]
 
-// NewPipeliner_newPipeliner_Results creates a new list of Pipeliner_newPipeliner_Results.
-func NewPipeliner_newPipeliner_Results_List(s *capnp.Segment, sz int32) (Pipeliner_newPipeliner_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[Pipeliner_newPipeliner_Results](l), err
+// NewZ creates a new list of Z.
+func NewZ_List(s *capnp.Segment, sz int32) (Z_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[Z](l), err
 }
 
-// Pipeliner_newPipeliner_Results_Future is a wrapper for a Pipeliner_newPipeliner_Results promised by a client call.
-type Pipeliner_newPipeliner_Results_Future struct{ *capnp.Future }
+// Z_Future is a wrapper for a Z promised by a client call.
+type Z_Future struct{ *capnp.Future }
 
-func (f Pipeliner_newPipeliner_Results_Future) Struct() (Pipeliner_newPipeliner_Results, error) {
+func (f Z_Future) Struct() (Z, error) {
 	p, err := f.Future.Ptr()
-	return Pipeliner_newPipeliner_Results(p.Struct()), err
+	return Z(p.Struct()), err
 }
-func (p Pipeliner_newPipeliner_Results_Future) Extra() *capnp.Future {
-	return p.Future.Field(0, nil)
+func (p Z_Future) Zz() Z_Future {
+	return Z_Future{Future: p.Future.Field(0, nil)}
 }
-func (p Pipeliner_newPipeliner_Results_Future) Pipeliner() Pipeliner {
-	return Pipeliner(p.Future.Field(1, nil).Client())
+func (p Z_Future) Zdate() Zdate_Future {
+	return Zdate_Future{Future: p.Future.Field(0, nil)}
 }
-
-type Defaults capnp.Struct
-
-// Defaults_TypeID is the unique identifier for the type Defaults.
-const Defaults_TypeID = 0x97e38948c61f878d
-
-func NewDefaults(s *capnp.Segment) (Defaults, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return Defaults(st), err
+func (p Z_Future) Zdata() Zdata_Future {
+	return Zdata_Future{Future: p.Future.Field(0, nil)}
 }
-
-func NewRootDefaults(s *capnp.Segment) (Defaults, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return Defaults(st), err
+func (p Z_Future) Aircraft() Aircraft_Future {
+	return Aircraft_Future{Future: p.Future.Field(0, nil)}
 }
-
-func ReadRootDefaults(msg *capnp.Message) (Defaults, error) {
-	root, err := msg.Root()
-	return Defaults(root.Struct()), err
+func (p Z_Future) Regression() Regression_Future {
+	return Regression_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (s Defaults) String() string {
-	str, _ := text.Marshal(0x97e38948c61f878d, capnp.Struct(s))
-	return str
+func (p Z_Future) Planebase() PlaneBase_Future {
+	return PlaneBase_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (s Defaults) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (p Z_Future) B737() B737_Future {
+	return B737_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (Defaults) DecodeFromPtr(p capnp.Ptr) Defaults {
-	return Defaults(capnp.Struct{}.DecodeFromPtr(p))
+func (p Z_Future) A320() A320_Future {
+	return A320_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Z_Future) F16() F16_Future {
+	return F16_Future{Future: p.Future.Field(0, nil)}
 }
+func (p Z_Future) Grp() Z_grp_Future { return Z_grp_Future{p.Future} }
 
-func (s Defaults) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// Z_grp_Future is a wrapper for a Z_grp promised by a client call.
+type Z_grp_Future struct{ *capnp.Future }
+
+func (f Z_grp_Future) Struct() (Z_grp, error) {
+	p, err := f.Future.Ptr()
+	return Z_grp(p.Struct()), err
 }
-func (s Defaults) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (p Z_Future) Echo() Echo {
+	return Echo(p.Future.Field(0, nil).Client())
 }
 
-func (s Defaults) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (p Z_Future) AnyPtr() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
-
-func (s Defaults) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (p Z_Future) AnyStruct() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
-func (s Defaults) Text() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextDefault("foo"), err
+func (p Z_Future) AnyList() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
-
-func (s Defaults) HasText() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (p Z_Future) AnyCapability() capnp.Client {
+	return p.Future.Field(0, nil).Client()
 }
 
-func (s Defaults) TextBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytesDefault("foo"), err
-}
+type Zdata capnp.Struct
 
-func (s Defaults) SetText(v string) error {
-	return capnp.Struct(s).SetNewText(0, v)
-}
+// Zdata_TypeID is the unique identifier for the type Zdata.
+const Zdata_TypeID = 0xc7da65f9a2f20ba2
 
-func (s Defaults) Data() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return []byte(p.DataDefault([]byte{0x62, 0x61, 0x72})), err
+func NewZdata(s *capnp.Segment) (Zdata, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Zdata(st), err
 }
 
-func (s Defaults) HasData() bool {
-	return capnp.Struct(s).HasPtr(1)
+func NewRootZdata(s *capnp.Segment) (Zdata, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Zdata(st), err
 }
 
-func (s Defaults) SetData(v []byte) error {
-	if v == nil {
-		v = []byte{}
-	}
-	return capnp.Struct(s).SetData(1, v)
+func ReadRootZdata(msg *capnp.Message) (Zdata, error) {
+	root, err := msg.Root()
+	return Zdata(root.Struct()), err
 }
 
-func (s Defaults) Float() float32 {
-	return math.Float32frombits(capnp.Struct(s).Uint32(0) ^ 0x4048f5c3)
+func (s Zdata) String() string {
+	str, _ := text.Marshal(0xc7da65f9a2f20ba2, capnp.Struct(s))
+	return str
 }
 
-func (s Defaults) SetFloat(v float32) {
-	capnp.Struct(s).SetUint32(0, math.Float32bits(v)^0x4048f5c3)
+func (s Zdata) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Defaults) Int() int32 {
-	return int32(capnp.Struct(s).Uint32(4) ^ 4294967173)
+func (Zdata) DecodeFromPtr(p capnp.Ptr) Zdata {
+	return Zdata(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Defaults) SetInt(v int32) {
-	capnp.Struct(s).SetUint32(4, uint32(v)^4294967173)
+func (s Zdata) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-func (s Defaults) Uint() uint32 {
-	return capnp.Struct(s).Uint32(8) ^ 42
+func (s Zdata) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Defaults) SetUint(v uint32) {
-	capnp.Struct(s).SetUint32(8, v^42)
+func (s Zdata) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// Defaults_List is a list of Defaults.
-type Defaults_List = capnp.StructList[Defaults]
-
-// NewDefaults creates a new list of Defaults.
-func NewDefaults_List(s *capnp.Segment, sz int32) (Defaults_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
-	return capnp.StructList[Defaults](l), err
+func (s Zdata) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-
-// Defaults_Future is a wrapper for a Defaults promised by a client call.
-type Defaults_Future struct{ *capnp.Future }
-
-func (f Defaults_Future) Struct() (Defaults, error) {
-	p, err := f.Future.Ptr()
-	return Defaults(p.Struct()), err
+func (s Zdata) Data() ([]byte, error) {
+	return capnp.GetDataField(s, 0, "Zdata.data")
 }
 
-type BenchmarkA capnp.Struct
-
-// BenchmarkA_TypeID is the unique identifier for the type BenchmarkA.
-const BenchmarkA_TypeID = 0xde2a1a960863c11c
-
-func NewBenchmarkA(s *capnp.Segment) (BenchmarkA, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
-	return BenchmarkA(st), err
+func (s Zdata) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func NewRootBenchmarkA(s *capnp.Segment) (BenchmarkA, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
-	return BenchmarkA(st), err
+func (s Zdata) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
 }
 
-func ReadRootBenchmarkA(msg *capnp.Message) (BenchmarkA, error) {
-	root, err := msg.Root()
-	return BenchmarkA(root.Struct()), err
+// DataReader returns a reader of data's data, which
+// is read directly from the message.
+func (s Zdata) DataReader() (*bytes.Reader, error) {
+	v, err := s.Data()
+	return bytes.NewReader(v), err
 }
 
-func (s BenchmarkA) String() string {
-	str, _ := text.Marshal(0xde2a1a960863c11c, capnp.Struct(s))
-	return str
+// SetDataFromReader sets data to the next size bytes of r,
+// which are read directly into the message.
+func (s Zdata) SetDataFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
 }
 
-func (s BenchmarkA) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
+// Zdata_List is a list of Zdata.
+type Zdata_List = capnp.StructList[Zdata]
 
-func (BenchmarkA) DecodeFromPtr(p capnp.Ptr) BenchmarkA {
-	return BenchmarkA(capnp.Struct{}.DecodeFromPtr(p))
+// NewZdata creates a new list of Zdata.
+func NewZdata_List(s *capnp.Segment, sz int32) (Zdata_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Zdata](l), err
 }
 
-func (s BenchmarkA) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s BenchmarkA) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+// Zdata_Future is a wrapper for a Zdata promised by a client call.
+type Zdata_Future struct{ *capnp.Future }
+
+func (f Zdata_Future) Struct() (Zdata, error) {
+	p, err := f.Future.Ptr()
+	return Zdata(p.Struct()), err
 }
 
-func (s BenchmarkA) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
+type Zdate capnp.Struct
 
-func (s BenchmarkA) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s BenchmarkA) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
+// Zdate_TypeID is the unique identifier for the type Zdate.
+const Zdate_TypeID = 0xde50aebbad57549d
 
-func (s BenchmarkA) HasName() bool {
-	return capnp.Struct(s).HasPtr(0)
+func NewZdate(s *capnp.Segment) (Zdate, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Zdate(st), err
 }
 
-func (s BenchmarkA) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func NewRootZdate(s *capnp.Segment) (Zdate, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Zdate(st), err
 }
 
-func (s BenchmarkA) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+func ReadRootZdate(msg *capnp.Message) (Zdate, error) {
+	root, err := msg.Root()
+	return Zdate(root.Struct()), err
 }
 
-func (s BenchmarkA) BirthDay() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
+func (s Zdate) String() string {
+	str, _ := text.Marshal(0xde50aebbad57549d, capnp.Struct(s))
+	return str
 }
 
-func (s BenchmarkA) SetBirthDay(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
+func (s Zdate) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s BenchmarkA) Phone() (string, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return p.Text(), err
+func (Zdate) DecodeFromPtr(p capnp.Ptr) Zdate {
+	return Zdate(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s BenchmarkA) HasPhone() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Zdate) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-func (s BenchmarkA) PhoneBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return p.TextBytes(), err
+func (s Zdate) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s BenchmarkA) SetPhone(v string) error {
-	return capnp.Struct(s).SetText(1, v)
+func (s Zdate) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s BenchmarkA) Siblings() int32 {
-	return int32(capnp.Struct(s).Uint32(8))
+func (s Zdate) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Zdate) Year() int16 {
+	return int16(capnp.Struct(s).Uint16(0))
 }
 
-func (s BenchmarkA) SetSiblings(v int32) {
-	capnp.Struct(s).SetUint32(8, uint32(v))
+func (s Zdate) SetYear(v int16) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-func (s BenchmarkA) Spouse() bool {
-	return capnp.Struct(s).Bit(96)
+func (s Zdate) Month() uint8 {
+	return capnp.Struct(s).Uint8(2)
 }
 
-func (s BenchmarkA) SetSpouse(v bool) {
-	capnp.Struct(s).SetBit(96, v)
+func (s Zdate) SetMonth(v uint8) {
+	capnp.Struct(s).SetUint8(2, v)
 }
 
-func (s BenchmarkA) Money() float64 {
-	return math.Float64frombits(capnp.Struct(s).Uint64(16))
+func (s Zdate) Day() uint8 {
+	return capnp.Struct(s).Uint8(3)
 }
 
-func (s BenchmarkA) SetMoney(v float64) {
-	capnp.Struct(s).SetUint64(16, math.Float64bits(v))
+func (s Zdate) SetDay(v uint8) {
+	capnp.Struct(s).SetUint8(3, v)
 }
 
-// BenchmarkA_List is a list of BenchmarkA.
-type BenchmarkA_List = capnp.StructList[BenchmarkA]
+// Zdate_List is a list of Zdate.
+type Zdate_List = capnp.StructList[Zdate]
 
-// NewBenchmarkA creates a new list of BenchmarkA.
-func NewBenchmarkA_List(s *capnp.Segment, sz int32) (BenchmarkA_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
-	return capnp.StructList[BenchmarkA](l), err
+// NewZdate creates a new list of Zdate.
+func NewZdate_List(s *capnp.Segment, sz int32) (Zdate_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Zdate](l), err
 }
 
-// BenchmarkA_Future is a wrapper for a BenchmarkA promised by a client call.
-type BenchmarkA_Future struct{ *capnp.Future }
+// Zdate_Future is a wrapper for a Zdate promised by a client call.
+type Zdate_Future struct{ *capnp.Future }
 
-func (f BenchmarkA_Future) Struct() (BenchmarkA, error) {
+func (f Zdate_Future) Struct() (Zdate, error) {
 	p, err := f.Future.Ptr()
-	return BenchmarkA(p.Struct()), err
+	return Zdate(p.Struct()), err
 }
 
-type AllocBenchmark capnp.Struct
+type Zjob capnp.Struct
 
-// AllocBenchmark_TypeID is the unique identifier for the type AllocBenchmark.
-const AllocBenchmark_TypeID = 0xecea3e9ebcbe5655
+// Zjob_TypeID is the unique identifier for the type Zjob.
+const Zjob_TypeID = 0xddd1416669fb7613
 
-func NewAllocBenchmark(s *capnp.Segment) (AllocBenchmark, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return AllocBenchmark(st), err
+func NewZjob(s *capnp.Segment) (Zjob, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Zjob(st), err
 }
 
-func NewRootAllocBenchmark(s *capnp.Segment) (AllocBenchmark, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return AllocBenchmark(st), err
+func NewRootZjob(s *capnp.Segment) (Zjob, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Zjob(st), err
 }
 
-func ReadRootAllocBenchmark(msg *capnp.Message) (AllocBenchmark, error) {
+func ReadRootZjob(msg *capnp.Message) (Zjob, error) {
 	root, err := msg.Root()
-	return AllocBenchmark(root.Struct()), err
+	return Zjob(root.Struct()), err
 }
 
-func (s AllocBenchmark) String() string {
-	str, _ := text.Marshal(0xecea3e9ebcbe5655, capnp.Struct(s))
+func (s Zjob) String() string {
+	str, _ := text.Marshal(0xddd1416669fb7613, capnp.Struct(s))
 	return str
 }
 
-func (s AllocBenchmark) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Zjob) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (AllocBenchmark) DecodeFromPtr(p capnp.Ptr) AllocBenchmark {
-	return AllocBenchmark(capnp.Struct{}.DecodeFromPtr(p))
+func (Zjob) DecodeFromPtr(p capnp.Ptr) Zjob {
+	return Zjob(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s AllocBenchmark) ToPtr() capnp.Ptr {
+func (s Zjob) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s AllocBenchmark) IsValid() bool {
+func (s Zjob) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s AllocBenchmark) Message() *capnp.Message {
+func (s Zjob) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s AllocBenchmark) Segment() *capnp.Segment {
+func (s Zjob) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s AllocBenchmark) Fields() (AllocBenchmark_Field_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return AllocBenchmark_Field_List(p.List()), err
+func (s Zjob) Cmd() (string, error) {
+	return capnp.GetTextField(s, 0, "Zjob.cmd")
 }
 
-func (s AllocBenchmark) HasFields() bool {
+func (s Zjob) HasCmd() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s AllocBenchmark) SetFields(v AllocBenchmark_Field_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Zjob) CmdBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Zjob.cmd")
 }
 
-// NewFields sets the fields field to a newly
-// allocated AllocBenchmark_Field_List, preferring placement in s's segment.
-func (s AllocBenchmark) NewFields(n int32) (AllocBenchmark_Field_List, error) {
-	l, err := NewAllocBenchmark_Field_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return AllocBenchmark_Field_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Zjob) SetCmd(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// AllocBenchmark_List is a list of AllocBenchmark.
-type AllocBenchmark_List = capnp.StructList[AllocBenchmark]
+func (s Zjob) Args() (capnp.TextList, error) {
+	return capnp.GetListField[capnp.TextList](s, 1, "Zjob.args")
+}
 
-// NewAllocBenchmark creates a new list of AllocBenchmark.
-func NewAllocBenchmark_List(s *capnp.Segment, sz int32) (AllocBenchmark_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[AllocBenchmark](l), err
+func (s Zjob) HasArgs() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// AllocBenchmark_Future is a wrapper for a AllocBenchmark promised by a client call.
-type AllocBenchmark_Future struct{ *capnp.Future }
+func (s Zjob) SetArgs(v capnp.TextList) error {
+	return capnp.SetListField(s, 1, v)
+}
 
-func (f AllocBenchmark_Future) Struct() (AllocBenchmark, error) {
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Zjob) NewArgs(n int32) (capnp.TextList, error) {
+	return capnp.NewListField(s, 1, n, capnp.NewTextList)
+}
+
+// SetArgsFromSlice sets the args field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Zjob) SetArgsFromSlice(v []string) error {
+	return capnp.SetListFieldFromSlice(s, 1, v, capnp.NewTextListFromSlice)
+}
+
+// Zjob_List is a list of Zjob.
+type Zjob_List = capnp.StructList[Zjob]
+
+// NewZjob creates a new list of Zjob.
+func NewZjob_List(s *capnp.Segment, sz int32) (Zjob_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Zjob](l), err
+}
+
+// Zjob_Future is a wrapper for a Zjob promised by a client call.
+type Zjob_Future struct{ *capnp.Future }
+
+func (f Zjob_Future) Struct() (Zjob, error) {
 	p, err := f.Future.Ptr()
-	return AllocBenchmark(p.Struct()), err
+	return Zjob(p.Struct()), err
 }
 
-type AllocBenchmark_Field capnp.Struct
+type Zserver capnp.Struct
 
-// AllocBenchmark_Field_TypeID is the unique identifier for the type AllocBenchmark_Field.
-const AllocBenchmark_Field_TypeID = 0xb8fb64b8ed846ae6
+// Zserver_TypeID is the unique identifier for the type Zserver.
+const Zserver_TypeID = 0xcc4411e60ba9c498
 
-func NewAllocBenchmark_Field(s *capnp.Segment) (AllocBenchmark_Field, error) {
+func NewZserver(s *capnp.Segment) (Zserver, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return AllocBenchmark_Field(st), err
+	return Zserver(st), err
 }
 
-func NewRootAllocBenchmark_Field(s *capnp.Segment) (AllocBenchmark_Field, error) {
+func NewRootZserver(s *capnp.Segment) (Zserver, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return AllocBenchmark_Field(st), err
+	return Zserver(st), err
 }
 
-func ReadRootAllocBenchmark_Field(msg *capnp.Message) (AllocBenchmark_Field, error) {
+func ReadRootZserver(msg *capnp.Message) (Zserver, error) {
 	root, err := msg.Root()
-	return AllocBenchmark_Field(root.Struct()), err
+	return Zserver(root.Struct()), err
 }
 
-func (s AllocBenchmark_Field) String() string {
-	str, _ := text.Marshal(0xb8fb64b8ed846ae6, capnp.Struct(s))
+func (s Zserver) String() string {
+	str, _ := text.Marshal(0xcc4411e60ba9c498, capnp.Struct(s))
 	return str
 }
 
-func (s AllocBenchmark_Field) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Zserver) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (AllocBenchmark_Field) DecodeFromPtr(p capnp.Ptr) AllocBenchmark_Field {
-	return AllocBenchmark_Field(capnp.Struct{}.DecodeFromPtr(p))
+func (Zserver) DecodeFromPtr(p capnp.Ptr) Zserver {
+	return Zserver(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s AllocBenchmark_Field) ToPtr() capnp.Ptr {
+func (s Zserver) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s AllocBenchmark_Field) IsValid() bool {
+func (s Zserver) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s AllocBenchmark_Field) Message() *capnp.Message {
+func (s Zserver) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s AllocBenchmark_Field) Segment() *capnp.Segment {
+func (s Zserver) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s AllocBenchmark_Field) StringValue() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s Zserver) Waitingjobs() (Zjob_List, error) {
+	return capnp.GetListField[Zjob_List](s, 0, "Zserver.waitingjobs")
 }
 
-func (s AllocBenchmark_Field) HasStringValue() bool {
+func (s Zserver) HasWaitingjobs() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s AllocBenchmark_Field) StringValueBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Zserver) SetWaitingjobs(v Zjob_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s AllocBenchmark_Field) SetStringValue(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// NewWaitingjobs sets the waitingjobs field to a newly
+// allocated Zjob_List, preferring placement in s's segment.
+func (s Zserver) NewWaitingjobs(n int32) (Zjob_List, error) {
+	return capnp.NewListField(s, 0, n, NewZjob_List)
 }
 
-// AllocBenchmark_Field_List is a list of AllocBenchmark_Field.
-type AllocBenchmark_Field_List = capnp.StructList[AllocBenchmark_Field]
+// Zserver_List is a list of Zserver.
+type Zserver_List = capnp.StructList[Zserver]
 
-// NewAllocBenchmark_Field creates a new list of AllocBenchmark_Field.
-func NewAllocBenchmark_Field_List(s *capnp.Segment, sz int32) (AllocBenchmark_Field_List, error) {
+// NewZserver creates a new list of Zserver.
+func NewZserver_List(s *capnp.Segment, sz int32) (Zserver_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[AllocBenchmark_Field](l), err
+	return capnp.StructList[Zserver](l), err
 }
 
-// AllocBenchmark_Field_Future is a wrapper for a AllocBenchmark_Field promised by a client call.
-type AllocBenchmark_Field_Future struct{ *capnp.Future }
+// Zserver_Future is a wrapper for a Zserver promised by a client call.
+type Zserver_Future struct{ *capnp.Future }
 
-func (f AllocBenchmark_Field_Future) Struct() (AllocBenchmark_Field, error) {
+func (f Zserver_Future) Struct() (Zserver, error) {
 	p, err := f.Future.Ptr()
-	return AllocBenchmark_Field(p.Struct()), err
+	return Zserver(p.Struct()), err
 }
 
-const schema_832bcc6686a26d56 = "x\xda\xacZ}t\x14U\x96\xbf\xb7\xaa;\x15\x92t" +
-	"\xba+\xaf\x80\x10\x12#\x11\x14\x1a\xc1\x900\x01\x99u" +
-	"\x93`\xa2\xe0\x82\xa6h\x10ue\xa4\x92T\x92\xc6N" +
-	"w\xd3]\x0d\x89\xcaAv`\x04\x8f\xec\xc8QGQ" +
-	"\x99uX\xdc\xf1\x03\\\xf1cW\x18Dq\xc0\x81\xa8" +
-	"\xab\xb2\xf8\x01\xab\xa8\xb8\xa8\xa0\xec\x883\xae\xa0`\xed" +
-	"\xb9\xaf\xbb\xba*\x9dnQ\xce\xfeC\xaa\xdf\xef\xd6}" +
-	"\xf7\xdd\xefw\x8b\xeaVO\x83k\x82g\xee`\x10\xd4" +
-	"\x13\xee<s\xd7\x89coW\xdf<j\x05\xa8^D" +
-	"\xf3\xea\xee\xf5\xbf\xeaxu\xec/\xc1%\x01\xb05\x05" +
-	"}l]\x01=\xad-\xa8\x074\xe7\xbd\xe2\xfb\xc5\xa0" +
-	"m\xd3n\xcb\xa0u\x8bD\xb2\xa5`+\xdb\xc1\x89\x9f" +
-	"/\xf8\x14\xd0|\xf7\xe9\xef\xab\xcf\xab\xff\xe3m {" +
-	"\x9d\xb4(\x01\xd4>[X\x82lW!\x11\xef($" +
-	"\xceSz\x1a\xea\x9ezm\xc4\xca\x0c\xceM\x92\x00\xc0" +
-	"\x8e\x17\xf6\xb1\xd3\x9c\xf8d\xe1b@s\xfd_\x86\xbf" +
-	"\xf3\xcc\x0dCn\x07YAHq\x9cS$  \xbb" +
-	"\xb6\x88\xb8M\x1c5\xfa\xc8\x8e\xaa\xc0?\x82\xec\x15m" +
-	"f\x80\xac\xb7h=[VD\x9c\x96\x14]\xce\x1e\xa6" +
-	"'\xf3\xce\xdd\x95/7\xde\xfc\xc4\xaf3\xe4\x14\x88j" +
-	"u\xd1!\xb6\x96\xd3\xdfSD;\x9b\xb1W\x8f\xaak" +
-	"\xf7\xdc\xd5\x9f\x96\xeb\xeat\xd1K\xcc\xed\x91@4\x8b" +
-	"\xf6\xfdfg\xc9\xe3\xd5w\x83\xecu\xf5\xdb\xfd\xa3\xa2" +
-	">v\x8c\xb8\x05>+\x121\xe0\xf2\x08\x08`\xae\x1e" +
-	"\x91?\xf1\xc4\x92\x17\xee\xce\xa2'\x86\x9e>\xe6\xf1\xd0" +
-	"\xd3 \x0f\x1d\xec\xc6\xf0|\xa5\xfe\xfb\xed\xf7d\xd3\xe9" +
-	"\x18O\x09\xb2\x8b9\xf1\xcf8\xf1\xea\xdb*_\x9e\xb6" +
-	"\xea\xe3{I\xa7B\xe6\xc9t\xcfK\xac\x9b\x88k\x83" +
-	"\x9eJ\x044\xaf\xdd(<p\xef\xdag\xef\xcf&\xc6" +
-	"\xb2\xe2>\xb6\xba\x98\x9eV\x15\x13\xe7u7\xbf\xbfe" +
-	"\xcc;\x93\x1ep\x1a`sq\x01\x19`\x0b'\xd8=" +
-	"\xe7\x90{\xeb\x05\xbf~`\x80\x0a\xf6\x17\xf7\xb1\xc3\xc4" +
-	")\xf0a\xb1\x88\x81/\x8a\xb9\x0a\x12?w\xfd\xca\xac" +
-	"\xa9^\x97\xe9W|\xf3\xf7\x8b\xfb\xd8\x11\xbe\xf9\xe1b" +
-	"\xb2\xc1\xbd\xe7\xbcyQ\xcbI}\x03\xa8\xe5\x88\xc0\x95" +
-	"_\xdb\xe8\x8d\xd1\xe63\xbd\xb4y\xc7\xb4\xce\xaf\xbeg" +
-	"/<\x92\xed$\xdd\xde\x97X\xc2KO\x0b9\xed\xb9" +
-	"\xbb\x87\xf6\xdc\xf0\xc1\x13\x8f\x0f\xf0\x945\xdeCl\x1d" +
-	"'\\\xeb\xbd\x9c\xed\xa2'\xf3\xf8\x92\x99\xfe\xa9s\xde" +
-	"x<\x9b\xf67y\xcb\x90=\xcf_\xd8\xc29o\xf9" +
-	"\xfc\xc9H\xf3\x81\xdb6e\x93\xe2\x88w=;\xcei" +
-	"\x8fqZ9\xda\xfeN\xd8\xbdqs6Z\x8f\xef+" +
-	"6\xccGO\x83}D\xbb\xb4\xee\x17\xcb\xe7M\xfer" +
-	"3\xe9J\xcc\x8c\xc1f\xdf^\xa6\x12q\xedL\xdf\\" +
-	"\xb2j\xbco\xa2\xf9\xc5\xa1s\xfe-\x9b\x0f\xd4n\x91" +
-	"\x05d\xbbd\x1e\x852\x85\xec\x86\x7f\xd9:\xea\xe5\xee" +
-	"\xb1\xff\x0e\xaa\x8c\xa2\xb9\xff\xa1\xb7\x7f~\xef\x91\xf3\x8f" +
-	"\xc2`\x94\x90B\xa0\xe41@vO\x09\x19\xe1\x93\x05" +
-	"\xcb\x8f=\xd7\xfe\xdds \x9f\x83\xe6\x9c\xab\xb7o\xfb" +
-	"\xed\xdf\x1e\xfd\"\xa5\x8a\xe3%U\xc8\x90\xf1\xa0(!" +
-	"\x91kO\xac\xe9\xdb\xfc\xf4\xef\xb7\x82<\xcc2\xd8\x08" +
-	"\xb6\x00\xc1e~\x8b\x87\xaf\xf8\xbb\x17^\xfbC\x12I" +
-	"J\xe5a\xdc\x96\xc3\x18\x0f\xf5\xc2\xaf\xd6\x9f\xd4\x0f\xfc" +
-	")\x9bfz\xd9cl\x19\xdff\x09\xa3m\xc6\xcd\xbc" +
-	"\xf8\xf9\x8f\x1f\xff\xfb=\xd9\x02y\x1d\xebc\x8fr\xda" +
-	"\x879\xdf\xad\xdf|\xf0\xd6\x0d\x8b\xde{%\x9b)Q" +
-	")C&+\\\xf9\x0a1\xbeo\xe7\xa3\x85\x9f\xc8M" +
-	"\xaff\x13\xe2\x12e+k\xe6\xb4\x8d\x9c\xf6\xc3\xf3'" +
-	"\xcf\xff\xf8\xa9\xa7\xb3\xd2&\x94\xf5l\x09\xa7\xed\xe5\xb4" +
-	"\xd3[\x0e\xed?\xf4D\xd3\x7fd5\xe5Z\xe5({" +
-	"\x98\x88k\x7f\xa7pS\xee\xbeuGy\xdf\xd1\x07^" +
-	"\xcf&\xf2\xe9\xc1%\xc8<Cx\xa2\x18B\xac\xb7\x1f" +
-	"Xy|\xc3wuog\x13\xe3\xe2!\xf7\xb3FN" +
-	"{\x09\xa7\xddv\xe5\x89/\xf3\x1b\xd4\xb7\x07\xc4\xc0\xbc" +
-	"!},\xc8\x09\xf5!\x97\xb35C\x86\x02\x98k&" +
-	"\xfd~~\xf8\xf5m\xef\x92\xcc\xaeL-/\x1b\xd2\xc7" +
-	"V\xd3\x0b\xb5\xab\x86p\x99W\xbf\xb2\x7f\xf1\xca\xf9w" +
-	"\xec\xcf&\xc6\xb1\xa1\xeb\xd9\xd7C\xe9\xe9\xf8P\x12\x83" +
-	"-\xfa.\xd8\xd1\xf8\xe6\xfb\xd9\xcc'\x97\xaeg\xc3J" +
-	"y\x10\x94\x92\xf9\xcaw\xb4\xe5\xff\xa6\xcc\x7f0Ss" +
-	"I)J\xf7\xb2\xd5\xa5\\\x8aR.\xc5\xba\xd9s7" +
-	"\xfd\xe1\x89\x96\x83\xd9J\xdc\xfb\xc3\x1ec\x87\x87\xd1\xd3" +
-	"G\xc3\xfe\x15\xd0\xbc\xab\xfa\x91o\xfff\xdfo\x0ff" +
-	"\xd3roY\x01\xb2UeD\xbc\xa2\x8cD\xde\xb4]" +
-	"\x92\xdfzs\xfdG\xd9\x8e\xf7l\xd9V\xf6<\xa7\xdd" +
-	"\xc2i\xb5@m\xc9\xae\xa3{\xb2\xd2~Tv?;" +
-	"\xc2i\x0fs\xda\x13\xd7>\xf4\xcb\x07\xd7\xe7\x1f\xce&" +
-	"\x84{x\x09\xb2\xc1\xc3\xb9V\x86\x13\xf1\xe6?\xcd9" +
-	"\xf8\x84\xef\xca\xc3\x19\xa7kF\xc9E^9\xfc%6" +
-	"\x9dS7\x0f\xa7\x10\x1fq\xd1\x89\xe1\xa7V\xcc#\xd6" +
-	"B?c\x0f+\xdf\xcaF\x94\x13aE9)\xe2\xbd" +
-	"\xbc\x93\xff\xb4|\xe9\xb2L\x19\xb8o.,\xefcK" +
-	"8m/\xa7\xbd\xb6\xab\xec\xcfS>_\xfeI\xb6\xb3" +
-	"5V\x1c`3+\xe8iz\x05/aom\xdf\xb0" +
-	"\xa9l\xe1\xa7\x03J\xc3\xc2\x8a>\xb6\x84\x08\x03=\x15" +
-	"\"\x06\x96W\xf0\xd2\x90NB\xfd-\xdd\x8c\xd2\x04\xda" +
-	"\xbe\xe2v\xb6\x8c\xde\xa9]R\xf1B\x018RQ\x16" +
-	"IF\xf8\xbfb\xe3\xfcC\xa9>\xfaI\x92\xf2\xfcy" +
-	"S\x07m\xba\xeax6Z\xdd\x7f\x80-\xf4\xf3\x8a\xc1" +
-	"i\xef\x1bVr\xfb_\xff\xe1\xb6\xafA.\xb7R\xd8" +
-	":?Oa\xa7;.\xdf\xd3\xfc\x9e\xfb\x9b\x8c\x0c\xcb" +
-	"\x9dk\x95\x7f/\xbb\x87sY\xe3'\xb7]P\x1a\xba" +
-	"\\i2\xbf\xc9\x1a\x0e\xfe\xbd\xec$\xa7\xfd\x9a\xefx" +
-	"p\xc6\xf6\xbbF\x1b\xff|*\x9b\xd3^<v/k" +
-	"\x1e\xcb\xb5;\xb6\x1e\xc6\x99Z0\xd6\x16\xd3:\x0ca" +
-	"|\x9b\x16\x0dG\xa7\x04\x0c\xad\xed\xc6`\xb8s*@" +
-	"\x0b\xa2\xea\x12]\x00.\x04\x90=U\x00j\xbe\x88\xaa" +
-	"\"\xa0\x14Nt\xa3\x0b\x04t\x91w\xa68`\x8a\xc3" +
-	"\xa5\xf5\x91D\xd8\xd0c\xf4\xba/\xfd\xba\xe6\x07P\xaf" +
-	"\x17Q\xed\x12\x10Q\xa1\xf2+\xeb5\x00\xea|\x11\xd5" +
-	"\x90\x80\xb2\x80\x0a\x0a\x00r\xf0\x0a\x00\xb5KDu\xb9" +
-	"\x80\xb2(((\x02\xc8\xcb\xa6\x02\xa8\xb7\x88\xa8\xde'" +
-	"\xa07\x1e\xbcIG7\x08\xe8\x06\xac\\\x1c\x89\xb5\xc7" +
-	"\xb1\x08\x04,\x024\xe9W(\x187\x00\x00\x8b\x01[" +
-	"D\xe4P1\xe0\xd2\xd6\xa0A\x88\xb5\x8c\xc9\xe5\xb4\xf4" +
-	"bJ\xfai\x91P{\xfcj=6{qd\xf6\xe2" +
-	"HK(\x81\xf1\x0c=LI\xe9a\xa4\x80\xf5\xdd\xbd" +
-	"N\x9e>;-\x03\xf6\xe3ni\xf7\xeaH\xb0}N" +
-	"8\x18\x09'\xb5\x9b/\xba\x8aL\x93\xb3\x1dS\x02\xa0" +
-	"\x8e\x14Q\xad\x16\xd0\x83\xdf\x9bI\x0d\x8d\xa3\xd5\xd1\"" +
-	"\xaa\x13\x05D\x0d\xf2\xb0\x15\xf2\x06\x88\xdc\xdc\xd6\x15\x19" +
-	"\xaf\xb7uEF\xb6h1\xad;\x0eNi\xcbl\xab" +
-	"\x89\xc1pZQ\x99Fk\x96\xda\xba\"\xc9\x83\xba\x01" +
-	"\xd2m2Z\xed\x9a,\xfbA\x90\xdd\x92\x97\xf6i\xc0" +
-	"\x16\xc4\x9c\x9e3K\x8aD\x8c\xd4\xe9\x10]\x88(\x8f" +
-	"Y`\x1f\xc3\x972\xfe\xc5t\xb4\x89\"\xaa\x0d\x02\x9a" +
-	"\xda\xdc\xa0\xd1\xd5\xa4w\x80WK\x84\x0c\xf4\xd9m\x1d" +
-	" \xfa\xb8\xb2\xd0\x0f\x80\xda\x00(\x8b\x8e\xf5Xsw" +
-	"\xd4\xe8\x85lB\xb6E\xc2qc\x06\xf7\x10\xe2\x996" +
-	"\\:\xcfs\xc3\x81\x8cC\xe5|\xf4~ \xe5\x0f\xa7" +
-	"\x7f\xca\xb3mrUXo1b?\x18&Q#\x86" +
-	">;\x1c3\x04\xce\xeer3\x82q4~\x82\xcb\xa5" +
-	"\xdb\xb4\x1c.\xd7\xa4w\x90N\xe3\\N%\xc9\x13Q" +
-	"^B\xf1\xd8\x93\x0a3\x1e\x90\x88\xf2\x0aZ\xbcUD" +
-	"\xf5\x0e\x01QPP@\x94WS\x90\xae\x14Q\xbd\x9b" +
-	"\xe2\x11\x15\x14\x11\xe55t\xca;\x92\xf1(\xbb\x04\x85" +
-	"\xcc,\xdfCo\xdf)\xa2\xfa\xa0\x80^C\xef1R" +
-	"\xde\x062VI\x1d\x91\x88\xb7]34\xf4\x80\x80\x1e" +
-	"Z+\x93Z\xb5XeG(\xa2\x19X\x00\xc2\xf1\x82" +
-	"?~=\xad\x01P\x0a\x86\x0d\xca-\xc7]+L\xd3" +
-	"\x04\xf4&h!\x1f\x049\xdf\x9f\xe5xscZ4" +
-	"i\xeeLC<\x05\xa0\xfaDT\xcb\x054\xbb\x83\x9d" +
-	"]\xc6\x95\x11\x03\xa7\xea\xb3t-\x14\xea\xad\xe4\xef\xa0" +
-	"\xcf\xbe\x96\xe50\x8e\x1d\\\xb3\xf48\xd7#\xe4\xb2v" +
-	"$a\x0c\x88\xaf~~\xd7\x1cNt'\xfd\xcek\x17" +
-	"S@\xb7\x17!w85\xa6SEj\xcf1Uv" +
-	"\xa6\xb02\xa93O8\x933\xb6\xa2\xcf\xbe\xa6g\x1c" +
-	"\xd1e%l-\x14\x0a\xe8\x0b\x13z\xb8M\x1f\xdf\xa9" +
-	"\x1bW&\xba[\xf5\xd8\xc8Yz%?\xb0\xf3\xb8%" +
-	"\xf6q1LF\xc1\xfc,\xa2\x93\xce\xa6jq=\xd3" +
-	"\"~\xfbm\x9eGP\xb6\xaf\xdb\x80(ga\x95\x96" +
-	"M\x0a\xb7\xe9v~\xb2j*Z7:Y\x9e\x05\x82" +
-	"<H2-\xf9\x01c\xfd\xd3Tf\xb4]\x15\xd6\x9b" +
-	"4C\x9b\x11\x14\xe3?%\xdc\x9c\xc1\\\x9c%\x91N" +
-	"\x93\"F\xd7\x0f\x9c\xbbU\x8b\xeb\xe8\xb3/\x9a9\x92" +
-	"\x18\xa5\xa8\x80\x11K\xb4U\x1a\x97j\xd1p\x8e\x1c3" +
-	"R@i\x91\xdefK\x97\xeeKr$\x83Yzg" +
-	"L\x8f\xc7\x83\x11\xe4,K\xd3,\xd7\x92\x8cw\x8b\xa8" +
-	">d;\xd5:\xaa\x1d\xf7\x89\xa8np\x94\xe7\xdf\x11" +
-	"\xe1\x83\"\xaa\xcf9\xca\xf3\xb3\xa4\xb1'ET_\xa5" +
-	"t\x80\x0a\xba\x00\xe4=$\xe5N\x11\xd57\x04\x94\xdd" +
-	"\x82\x82n\x00\xf95Z\xdc-\xa2\xba\xcf\xd6E\xfa^" +
-	"\x90\xd4\x85\xd8Z\x8d\x85 `!\xa0\xb7U74\xeb" +
-	"t\x85\xc9\x8a]\x1f\x0dia=n\x9f9\xdd\xc4&" +
-	"\xcf,\xf5v'\xac\xf7\xa5\xdex\xbb\xf5<\xc0\x11\x92" +
-	"\x19\x97|\x80\x0a\xbd\x11\x83\x8c\x86\xa5\xcanX\xe4t" +
-	"\xc7Rew,<AR\xc3B\x1ai\x17Q\x8dZ" +
-	"\x09\x12@\xee\xf6\xa7\xba\x18\x83L\xa4\x85P\x04\x01E" +
-	"@\xa9=\x11\xb1z\x17o\xd4\x88M\x18X\x1fh\xb9" +
-	"\xe6\x07\xca\x86\xe5i\xd7y\xc7w\xc6\xa2\x94\x18\xa8\xa4" +
-	"\xfa)3\xd4\xd8\x99A&\xf1\xc6Rj\x98b\xa7\x86" +
-	"\xca\x8e`,n\xe0 \x10p\x10`}\\o\x8b\x84" +
-	"\xdb\xad\x9f\x03\x14\xd4\x18\x0aE\xda\xa6\xea\xe1\xb6\xaen" +
-	"-v\xe3\xf8\xcb\x82\x92\x1ej\xcf\xf0\xc4V\x00\xb5H" +
-	"D\xb5T@3n\xc4\x82\xe1\xce\xab5\x90B\x09}" +
-	"@&\xb4\xb2MK0\xaa\x87\x82a=6>\xac/" +
-	"N\xff\x18\xd9\xa2y\xa9w\xf9\xb1\xe4\xe9\xe4\xe4\xc8\x8b" +
-	"\xfdNo%\xc6Y\x00\xea\x85\"\xaa\x93\x05\xac\xd4{" +
-	"\x8c\x98\x86% `\x09\xa0\x19M1\x03\x8c\xa1l\xdf" +
-	"d3\x12\x91\xadm\xaa^?\x10\xd8\x8e\xe2\x96\xb5'" +
-	"I9Yf2\xf7g\x13\xdao\x9b\xec,\x9d$K" +
-	"oq6\xd9.}C\xc9\x91\xed\xae\xab\x8f\xeb\xb1E" +
-	"\xc9^?\x8bW\x8c\x16\xd0\\\xac\x05\x8d`\xb8s\x01" +
-	"H\x91VG\xd4\xa6\xaf\xec98O\x95&\xd5N:" +
-	"s\x1e\xcd\xc8\x1d9\x14?{q\xc4\xdb\x12J\xc43" +
-	"r^\x95\x9d\xf3\xd2\xba_We'=+\xc2\xd39" +
-	"\xef\x11G\x84?L\x8b\x0f\x89\xa8n\xb4Z \x00\xf9" +
-	"Qz{\x83\x88\xea\x93\x8e\x9c\xb7\x89(\x1f\x11Q\xdd" +
-	"y\xe6\\\xe0\xec\xe6\x1cf\xceX\x96\x8cX\xfa\x06\xe4" +
-	"\x0d\xc5\x8dZK\xaf\xee3\xdfjZ\x8c\xd8Om1" +
-	"\xd3\xd3\xb1\\\xb6\x12\xb5\xce\x0cvSmS-mK" +
-	"\xde\x07\xd1g\x7f\x04\xc8a\xadt|[\xcd\x1c\xd5z" +
-	"k\x04\x88\xd6\xc4O\x96\x17$k\xbd\x95\x11\xc0K\xef" +
-	"4\xa0\xeaB\xc7X\x16` \x7f*\x1d\xbc3\x81\xdc" +
-	"\xe5\xcfv\x85\x9a\x94+<c\xbb\xc2f\xd2\xd3F\xab" +
-	"\xfc5d\x94\xbfm\x0eW\xd8B\xf7\xd8\xe7\x92V\x97" +
-	"\xddb\xd2\x15v\xd0\xe2\x8b\xc9B\xe9\x0dk\xdd\xe94" +
-	"Y\xd9\x15\xe9\xb6\x8bZ\xbf\xf6\x90\x17\xbd\x98FAd" +
-	"\xd9\xbc\xbeM\x0b_\x16\xea\xe5\x97X\xea\x1d\xdb\xb4\xa8" +
-	"\xd6\x16\xa4\x1e\x18,\x12\xb3[\xeb\x09Du\xbd\x9d\xd6" +
-	"2K\xa0e\xb8F\xa9\xb6\xa6\xfa\xec\x83,\x9d\x05\xa4" +
-	"\x05\x91\xd6\xdcM\xea\xc0\xbc\xd6$\xa0\xd4\xd6\xddn\x1d" +
-	"\xde\xab\xc5:\xe3\x19\xf7\xf5\x01\xb6K\x17\"l\xfc\x11" +
-	"\xad\xcb\x15\x8e\x88\xb5Z\x97\x87k\x1c\x11k\xb5.\x8f" +
-	"^\x91\x0a\xceg\xc8v\xf3\x93\xb6\xebge+\x8c\x9f" +
-	"\xad\xb1\xad\xdc\xcfvfk0ft5iN\xf5W" +
-	"F\xbb\"a\x9b\"\x1el\x0d\x05\xc3\x9dq\xa2H\xb5" +
-	"\xe6\xf5\xf1h$\x11\xd7-\x1bVvG\xc2zoN" +
-	"K\xf1\xf2\xc3\x1b\xe0\xa2\xf4\xc1\x9b\xe9\xe0\x0d\"\xaa3" +
-	"R\xe5\x9e\x16\xa7\x93\x94M\"\xaa-tr1y\xf2" +
-	"\x99d\x8di\"\xaa\xb3\x05\xf4\xf6\xeaZ\xccJA\xb4" +
-	"\xab\xd1\x85y `\x1e%$\xad\xd7z\xce\x99B\xf8" +
-	"\xc5)}\x83\xfe\xb1)\xc4y\xcd\xca\x96B\xe6\xd6\xc7" +
-	"\xb4hMO\xcdY\xdd\xe12\xb2\xe3\x00\xe6\x97\x89\x13" +
-	"\xea\xce\xde\xcb\xb3\\\x1c\xce\"\x87\xa6?\xd5\xe5\xe8\xcc" +
-	"\x1bS\xbf\xad.\xd4\x9a\x0b9\xe7f\xf6\\H\xf7\xdb" +
-	"}\xa8G8m\x0e\xecD=\xe2)3\xd5\x8aV\xd9" +
-	"\xad\xa8wQ$\xd8\x0ey\xde\xd6I\xb5\x93\xd0g\x7f" +
-	"qHU\x1b\xad\xb6\xa6\x9ad\xb5F\xef\xa9j\xd31" +
-	"\xa1\x0e}\xf6\x14:\x87\x96\x1b\xeb\x83\xb1h$\xc6\x95" +
-	"R\x9et;?\xef\xa6\x9a\xab\x00P\x90/\xa1?\xa2" +
-	"\xfc3\xfa\xe3\x92\xc7\xd1\x1f\xb7<\x8a\xfe\xe4\xc9\x15~" +
-	"\x00o8\x12\xd6\xa5\x05\x1d7J!\xadG\x8awD" +
-	"\xa4Pb\x91\xd4\xde\xb1\xd8k\xe8qc\x80\xc2\xb89" +
-	"f\xeb=)?t\x84E\x953,R\x09azU" +
-	"*,\xe6SX\xa4\x92\xf9<2\xd85\xc9\x19\x88d" +
-	"\xa4\xe7\x15(\x85l\xcb\xa5\xd2Q}(n8V\xcf" +
-	"\x90\xab\xe6&\x9d9\x1a\x12\x13\xf1\xb3\xf2h\xe7\x1c\xd1" +
-	"\x97k\x9c\xd0\xa4\x19\xc9B\x961\xbf\xf2\x01\xf8\x92\xb3" +
-	"\xab\x81i$\xa9\xac\x9di\xffb3\xdd~\x80\xc04" +
-	"\xb7\x88\x81\xd9n\x87\x8b1\xd5]\x06\x10\x98A\xc05" +
-	"n\x01+\x84\xd3f2\x8f\xb29\xee*\x80@\x0b!" +
-	"\xd7\x13\"\x9e2\x93\xc9\x94]\xcb\x91\xd9\x84\xcc'\xc4" +
-	"\xf5\x9d\x99\xbc\x0c\xb2y\x1c\xb9\x86\x90vB\xdc\xdf\x9a" +
-	"\xc9\xb4\xca4\x8e\\OH\x17!y'M\x97\x82y" +
-	"\x00L\xe7\xc8|BB\x84H'\xcc|\x85\x0f\xbc\x83" +
-	"\\\xb6vB\xa2\x84\xe4\x7fC\xfb\xe4\x03\xb0n\xfeN" +
-	"\x17!\x06!\x83\xfe\x97\xf6\x19\x04\xc0\x16r$DH" +
-	"\x0f!\x05_\xd3>\x05\x00,\xc1\x91(!\xb7\x10R" +
-	"\xf8W\xda\xa7\x10\x80\xf5\xf2}\x0cBn%\xa4\xe8/" +
-	"f\x83\x82E\x00l\x09W[\x0f!\xcbIm\x9e\xaf" +
-	"L\x05=\x00l\x19\x07n!`%\x01\xc5\xc7M\x05" +
-	"\x8b\x01\xd8\x0a\x0e\xdcJ\xc0\x1d\x04x\xbf4\x15\xf4\x02" +
-	"\xb0U\xee)\x00\x81\xe5\x04<D\x80\xef\xcf\xa6B6" +
-	"d\xeb8p\x1f\x01\xcf\x10 \xff\x8f\xa9\xa0\x0c\xc06" +
-	"s`#\x01\xbb\x09(9f*X\x02\xc0vq\xe0" +
-	"E\x02\xde#\x80}a*\xc8\x00\xd8~\x0e\xec#\xe0" +
-	"K\x02\x94\xcfM\x05\x15\x00v\xcc]\x03\x10\xf8\x8c\x00" +
-	"W\x9e\x80\x9e\xc1GM\x05\x07\x030\xcc\xa37N\x11" +
-	"PJ\xc0\x90#\xa6\x82C\x00\xd8`\x0e\xf8\xf2D\x0c" +
-	"\\H\xc0\xd0\xcfL\x05\x87\x02\xb01\x1c\x18I@\x03" +
-	"\x01\xa5\x9f\x9a\x0a\x96\x02\xb0K\xf2h\x8f\xc9\x04\xcc&" +
-	"\xa0\xfc\x13S\xc1a\xe4by\xa4\x92\x19\x04t\x11P" +
-	"q\xd8T\xb0\x8c,\x9f7\x95,O\xc0\x1d\x04\x9c\xf3" +
-	"\xdf\xa6\x82\xc3IW\x9c\xd5r\x02\xee$\xa0\xf2cS" +
-	"\xc1r\x00\xb6\x9a\x03+\x09\xb8\x9b\x80s\x0f\x99\x0aV" +
-	"\x00\xb05y\xad\x00\x81;\x09\xd8H\xc0\x88\x8fL\x05" +
-	"\xcf\x01`\x8f\xe6]\x01\x10x\x84\x80g\x08\xa8\xfa\xd0" +
-	"T\xb0\x92\xb4\x9bw\x1d@\xe0I\x02\xb6\x11p\xde\x07" +
-	"\xa6\x82\xe7\x02\xb0-y\xb3\x00\x02\xcf\x11\xb03O\xc0" +
-	"\x8a\x91\x07\xc9\x83F\x00\xb0\x1d\\\xdem\x84\xec\xa6W" +
-	"F\xbdo*XE\x06\xe1'|\x91\x80W\x098\xff" +
-	"=S\xc1\xf3\x00\xd8\x1e\x0e\xec$\xe0\x0d\x02.\xf8/" +
-	"S\xc1\x91\x00\xec\xb5<r\xc6\xdd\x04\xec#`\xf4\x01" +
-	"S\xc1Q\x00\xecM.\xef\x1b\x04|A\xc0\x98\xfd\xa6" +
-	"\x82\xe7\x03\xb0#\x1c\xf8\x8c\x00\x97$\xa0g\xd8\xbb\xa6" +
-	"\x82\x17\x90\x09%\x92\xea\x14\x01\xa5\x04\x94\xbdc*8" +
-	"\x9aL\xc8\x01\x9fD&$`\xf8\xdb\xa6\x82c\xc8\x84" +
-	"\x1c\x18I@\x03\x01\xfe\xb7Lt|\xdag\x97HU" +
-	" x\xc6\xee3\x15\xbc\x10\x80\x8d\x92\xe8\x10\xe7\xa6\xf9" +
-	"\\\xf8\x9f\xa6\x82\xe38\x9f)\xfd\xf8\x8c\xdbk*8" +
-	"\x9e\\\x81\x03\x93\x09h\"`\xfc\x9b\xa6\x82\x17\x01\xb0" +
-	"F\x89t\xdb@\xc0\x0c\x02.z\xc3T\xb0\x1a\x80M" +
-	"\xe7\"5\x11\xd0B@\xf5\xeb\xa6\x82\x13(qI1" +
-	"r\x1e\x02\xae\x91\xd2\x95M\xbc\xe9&\xf4\xd9_\xfe\xac" +
-	"\x02V71=\x12\xea\xa8\xad\xc1\x02\x10\xb0\x00P\x0a" +
-	"\xd6M\xb4\x1a6)X[c\xb5fRpB\x9d\xd5" +
-	"!\x89\xc1\xc9(\x80\x80\x02\xa0\x94\xa8\x9bh\x0dK\xa4" +
-	"Dm\x8d5\xff\x94\x12\x13\xeaP\x02\x01%@11" +
-	"\xd9\xea\xa0\xbc\xad\x91H\xc8j\xef\x9c\xf3p\xf4\xb6\x86" +
-	"\"\xad\xd6\xac\xa0\xbe\xa3n\xa2c\x86gM\xb9:j" +
-	"k\x1c\xab\x05\xa9\xd5`?Z\xb7\xb5\xda\x8f\xd6e\xad" +
-	"N\xa8s\xac\x8a\xc9\xd5\xca\xe0d\xc7\xa2\x90\"M\xf4" +
-	"c;\xc8Z\xed\xc76\xdfZ\xed\xc7VJ\xb1M8" +
-	"\xd9\xe6%\x17\xbd7\xf5\x9bM:\x8dR\x0c\xb8\x94P" +
-	"\x07A.\xba\xca\x9b\xa8\xf3\x1dP\xe2\x92\xeb\x1a\xfa\xec" +
-	"\xff\xe7\x91Q+\xa1\xffh4cLh\x93\x01d\xa0" +
-	"\xc4$\x96\x1a\x94\x82\x18\x09\xa3\xcf\xfe\x9f3)\x98\xcf" +
-	" [\xb58`\x96\xceq\xa9\x96l\x842\xaeq^" +
-	"2\xfc\xffC\xdf\xc5\xf5\xb1Hos|\xe3\xcc\xfc~" +
-	"\x95$\xd22\x89\x9c\x9a\xe2\x9fD#\x91\x90CG\xa9" +
-	"O\xa2KS\xafZ\xcb\x9e\xd42y\xb0c9\xd5\xfb" +
-	"H\x9d\xb1h\x8ea~=-\xdb\x97\xda\xfe8\xb9\x92" +
-	"\x16\xeem1b\xe9\x81\x9c\x16\xee\xe5#o@\x03K" +
-	"\\\x08H\xabK\xb50\xbfm`\x89KH\xae\x10\xdd" +
-	"\xa5ZTk\x85\xca`(h\xf4b\x89K\xb4\x90\xcc" +
-	"\xa6\xda\x9a`V\xf2\x9b#\x9f\x0f\xd8\xff\xfd\x08k*" +
-	"/\x0b\xea\xa1\xf6\\-|\x07\x81\x8e\x91U\xfa\xcd\x1c" +
-	"-\xfc\x95z\xdc\xd0c\x13.\xd5\xc4\x01\x03{\xbf\xcd" +
-	"\xd6\x1b7b9/\xbbg\xf8$\x939$\x152G" +
-	"\xd9\xa8\xfd\x98kx\x95\xe3cQ\x8ey\xd4\xc0/\x07" +
-	"sg\xebq\xe3R-\x8a\x99g\xbb.5\xec\x9b," +
-	"\xa0\x19\xd6\xe3\xc6L\xcd\x88\x81\x18\xec\x19\x10\xd8g\xfa" +
-	"8\x91\xfe(\x83\xda\x0f|Su\x08\xfc\x7f\x01\x00\x00" +
-	"\xff\xffX\x17OX"
+const schema_832bcc6686a26d56 = "x\xda\xacZ{x\x14U\x96?\xa7\xaa\xbb+<B" +
+	"uu\x15\x10BBK\x04\x85\x180\x0f& 3n" +
+	"\x02&\x8a.h\x8a\x06QWF*I%i\xect" +
+	"\xb7\xd5\xd5\x90\xf8\xf8\xd0\x1d\x18\xd1Ov\xf4SG\x18" +
+	"eVYfGWpEq\x17\x19\x06u\x06\x1dP" +
+	"Y\xc1\x05\x15Wq\xc4\xf5\x85\xba#\xce\xb8\x82\xa2\xb5" +
+	"\xdf\xb9\xdd\xd5U\xdd]\xbd\xa8\xdf\xfcC\xc8\xfd\x9d:" +
+	"\xf7\xde\xf3\xf8\xdds\xcfM\xfdG\xe5\xad\xbe\x86\xf2\xb3" +
+	"\xc7\x02\x17\xf9\x11\xfa\x03\xd6\xb3\xc7?y\xa5\xfe\xba\x89" +
+	"\xab@\x15\x11\xadK\xfb7\xfc\xb4\xe7\xc5\xb3~\x02>" +
+	"\x01@\xbe\xa1\xfcy@\xf9\xa6\xf2\x16@k\xf1\x0b\xc1" +
+	"\x1f\x0f\xd91\xe7\xe6\x029?O\x82\x0f\x94o\x07\x94" +
+	"\x1f(\x7f\x1f\xd0z\xed\xf1o\xeaOo\xf9\xfd\xcd " +
+	"\x89n9\x14\x00\x9a\xd6\x8d\x08!\x09\x8e \x8d3\x07" +
+	"Z\x9b\x1f\xdb;~u\x81\xc66\x81\x03\x90\xf7\x8f\xa0" +
+	"\xa9\x0f\x8eX\x0ehm\xf8\xf3\xd8W\xb7^5\xeaV" +
+	"\x90\x14\x84\xac\xa6\x06\x91#M?\x10I\xd3\xb4\x89\x93" +
+	">|\xa6&\xf2\x0f \x89\xbc\xa3\x08P^(n`" +
+	"\xff^ \x0f\x8a\x02\x80u\xfb\xee\xf0s\xb3\xae{\xe4" +
+	"g\x05k\xe3h\x0f\x9ax\x04P\xd6E\x9a\xd12^" +
+	"<\xaa\xae\xdbsg\xbe\x1c\xb3\xc9^\xf1w\x80\xf2^" +
+	"Q\x00\xb4\x86\x1f\xf8\xf9\xae\xd0\xc3\xf5w\x81$\xfa\xf2" +
+	"\xe6\xdd\">\x0f\x18\xd9*\xf2\x18\xd9G\x0b\x05k\xcd" +
+	"\xf8\xb2i\xc7ox\xea.\x0f\xab\xc8{IZ\xde\xcf" +
+	"\xb6ru|\x89\xd2\xf2\xcd\xce\xbb\xbd\xacwLd\xd6" +
+	";\xc1\x04\xd7\xdc\x1c~n\xce-\xef\xdcC\xd6\xe3\x0a" +
+	"\xf729\xf8;\xc0\xa6\xc9\xc10\x02Z\x97o\xe2\xee" +
+	"\xbdg\xdd\x13\xbf\xf0\x9az\x96DS\xb7K\xa4q\xfd" +
+	"uo>9\xf9\xd5\xe9\xf7\xba\xcd\xdc/\x0d\xa5)\xd3" +
+	"L`\xf7\xc2#\xfe\xedg\xfe\xec\xde\xa2\xed\xdeMZ" +
+	"\"k%\x1e#\x1b%\xb6\xdd\xf4\x0f}?\xb5\x1a\xeb" +
+	"\xd7\x17F\x0b\x9b\x94\x89\xcb\xeb$\xb2\xf4=\xe3\xf6\x9f" +
+	"\xddqB\xdf\x08j\x15\"0\x137}.\x194)" +
+	"\x86h\xd2\x9e9\xbd\x9f}#?\xf5\xa0\xd7\xea\xc7\x87" +
+	"\xc8\x17\xe3\x99\xdci\xbbG\x0f\\\xf5\xd6#\x0f\x17\xc5" +
+	"\xc0\xac\x10\xf9\xb5=t\x81\xdc\x1f\xa2\x188v\xc3\xbc" +
+	"\xda\xd9\x0b\xf7=\xece\xe1\x85\xa1J\x9ay1\xd3\xf8" +
+	"\xe4G\x8f&\xda_\xbfy\xb3\xd7\xcckB\x14Xk" +
+	"\x98\x9c\x94\xec~5\xee\xdf\xb4\xc5Kns\xe83\x0a" +
+	"\x07&\xb7\xa2\xf9\xc7+\x17\xcf\xf8t\x0b\xd9\x84/\xcc" +
+	"\xa0\xb7C/\x036\xbd\x1bZ\x84\x80V\xea\xf9i\xd6" +
+	"\xc7G\xc6\xfd\x9b\x97o\x9bT\x85E\xfeB\x85\x92m" +
+	"\xe3?o\x9f\xf8\\\xffY\xff\x0e\xaa\x84\xbcu\xe8\xfe" +
+	"W~x\xcf\x87g\x1c\x85\x91( \x85\xc1\xc8\x7f\x01" +
+	"\x94'\x8f$C\xbf\xb7t\xe5'\xdb\xba\xbf\xda\x06\xd2" +
+	"8\xb4\x16^\xbas\xc7/\xff\xe6\xe8\xc7\xd9m\xaf\x1a" +
+	"YC*\xd7\x8c\xa4e6\x1d\xbf\xe3\xf9-\x8f\xffz" +
+	";Hcl\x87l\x19\xb9\x94\xf0\xdf\x8e\xa4\xa0\xff\x12" +
+	"\xdf\xbd\xe8o\x9f\xda\xfb\x9b\x0c\x9eY\xd3\x03#\x99\xc7" +
+	"6\xb3\x996\x0c\xfbl\xc3\x09\xfd\xf5?x\xd9\xa3|" +
+	"\x14\xad\xa8|\x14M4e\xde9\xbf}\xe7\xe1\xbf\xdb" +
+	"\xe3\x95\x8cSFQ\x884\x8c\"}\xdb\xbfx\xeb\xe0" +
+	"U\xcb\xdex\xc1\xcbakF1\x87\xdd\xcd\x14\xae\xdd" +
+	"\xf5\xd0\xb0\xf7\xa4\xb6\x17\xbd&~v\x141\xd4\xb3L" +
+	"\xee\x8fg\xccX\xf2\xcec\x8f{\xca\x9d\x18E\x8e=" +
+	"\xc1\xe4.\xec8r\xe8\xc8#m\xff\xe1\xe9\xb01\xa3" +
+	"\x8f\x026U\x8ff\x0e\xdb}\xe33U\xcf\x1f\xbd\xf7" +
+	"%\xaf%\x0eV\xb0\xac\xbd\xa9\x82T\xee|}\xf5\xb1" +
+	"\x8d_5\xbf\xe25\xf5\x96\x8a_P\xac0\xb9\x1d\x17" +
+	"\x1f\xff\xb4\xacU}\xa5(\x9a\xf7W0^\xac\xb8@" +
+	"\xfe\xbab4\x80u\xc7\xf4_/\x89\xbf\xb4\xe35Z" +
+	"\xa3\xaf\xd0\x8a\x9f\x90p\xd3\xb1\x0a\xb6\xc65/\x1cZ" +
+	"\xbez\xc9m\x87\xbc\xa6^X\xc9x\xb2\x92\xa6\x96\x97" +
+	"}\x15\xed\x99\xb5\xffM/\xb7\xa4\x99\\\xba\x92\xdcR" +
+	"\xf5LW\xd9\xcf+k\x0f\x17Z\x87\x09\xbe]\xc9\xc2" +
+	"\xb9\x92\xcd\xbc~\xc1\xa2\xcd\xbfy\xa4\xe3\xb0\xd7\x11s" +
+	"N\x15\x05\xc49U\xff\x0ah\xddY\xff\xe0\x97?:" +
+	"\xf0\xcb\xc3^V\xdc_\xc5\x88\xe8P\x15-q\xf3N" +
+	"A:\xb8\x7f\xc3\xdb^[\x19RM\x8e\x1eRMr" +
+	"Z\xa4)\xf4\xec\xd1=\x9er\x0d\xd5d\xed\x06&w" +
+	"\xfc\xf2\xfb\x7fr\xdf\x86\xb2w\xbd&V\xab\x99\xfb." +
+	"g\x82[\xfe\xb0\xf0\xf0#\xc1\x8b\xdf-\xd8I;\x0a" +
+	">\x00\xf9\x96j\xa2\xa3[\xaa)1\xc7\x9f}|\xec" +
+	"\xc9U\x8bI%\x97\xe7\xc0\xc5\xe3\xb6\xb3\x7fi\xc3o" +
+	"\x04N\xfc\xe3\xca\x157\x15\xce\xcb\xe2k\xcb8r\xf4" +
+	"\x13L\xee\xf2\xbe\xca?\xcd\xfch\xe5{^\xfb\xb8!" +
+	"\xfc:EW\x98\x1d\x1e\x07wn\xdc\\y\xcd\xfbE" +
+	"\x04\xfdP\x98\x08zS\x98\xc7\xc8\xb60#\xe8\x1cU" +
+	"\xe4{\xaf\x1d\x85\x06\xa2\xad\xf0\xad\x80M\x9b\xc3O\x0d" +
+	"\x05\x17Yx\xcc\xae\xd6}&/\xae\x1b\x0d \xebu" +
+	"\xb4\x82\xaa\xb2\xc5\xb3\x87l\xbe\xe4\x98\x97\xec\xddu\xb4" +
+	"\xd2uLn\xed\x98\xd0\xad\x7f\xf9\xfb\x9b?\x07\xa9\xca" +
+	"&\x99=u\x8cd\x0e\xd6\x11\xc9|\xdds\xc1\x9e\xf6" +
+	"7\xfc_\x140 \x0b\x99'\xeb^&2\xaa\xa3 " +
+	"\\Z\x11\xbb@i\xb3\xbe\xf0\x9ao\xcc\x14\x92\xab\x9e" +
+	"B\xf3\x1d\x9e\xbb\xf3\xceI\xe6?\x9d\xf4\x0aA\x8d\xc9" +
+	"\xe9SZ\xe0\x03K\x8b\x1a]\x86\xd6crS\xbb\xb4" +
+	"d<93bj]WG\xe3\xbd\xb3\x01T\x1f\xef" +
+	"\x03\xf0!\x80T^\x03\xa0\x96\xf1HT,\xc4\xd3\xfd" +
+	"\xe8\x03\x0e}\x80\xb9\xcf1\xfb\xf9y-\x89t\xdc\xd4" +
+	"\x0d5\x98\xfbV\xab\x05P\xaf\xe4Q\xed\xe3\x10QA" +
+	"\x1a\xd3\x1b\x01\xd4%<\xaa1\x0e%\x0e\x15\xe4\x00\xa4" +
+	"\xe8E\x00j\x1f\x8f\xeaJ\x0e%\x9eS\x90\x07\x90n" +
+	"\xa2\x85\\\xcf\xa3\xba\x96C1\x15\xbdVG?p\xe8" +
+	"\x07\x0c/O\x18\xdd)\x1c\x0e\x1c\x0e\x07\xb4\xe8\xb7X" +
+	"4e\x02\x00\x8e\x00\xec\xe0\x91A#\x00WtFM" +
+	"B\xeca\xcc\x0c\xe7\x96\xceg\x97>'\x11\xebN]" +
+	"\xaa\x1b\x0b\x96'\x16,Ot\xc4\xd2\x98r[`f" +
+	"\xd6\x02\x138l\xe9\x1ft+\x0c:\xbc\x09\x98\xa7\xda" +
+	"6\xea\xa5\x89h\xf7\xc2x4\x11g:|\xc3-\x8b" +
+	"\xe9\x9c\x1c\x02P'\xf0\xa8\xd6sX\x8e\xdfX\x19\xdb" +
+	"L\xa1\xd1I<\xaa\xd38D\x0d\x02\xd8\x09\x81\xa2\xc5" +
+	"\xb6w\xf5%\xa6\xea]}\x89\x09\x1d\x9a\xa1\xf5\xa7\xf2" +
+	"\x9cU\xe98\x8b\x8f\xc6s&*\xf4U\xbb\xd0\xd5\x97" +
+	"P}\xbc\x1f Wq\xa2]\x13IR-\xf0\"\xcd" +
+	"\xd0\x81X2J\xe6\x0b\x89\x84I[B\xf4!\xa24" +
+	"y\xa9\xb3\xf6`\xd6\xd7\xe7\xd0~\xa6\xf1\xa8\xb6rh" +
+	"i\x8b\xa2f_\x9b\xde\x03\xa2\x96\x8e\x99\x18t\xea&" +
+	"@\x0c\x02t b-\x00jE\x90\x87Uu\xa3\xbd" +
+	"?i\x0eB\x07\xef+\x02\xbb\x12\xf1\x949\x97\x05\x84" +
+	"\xe3\xa7\x1c/3?\x81\x84\xa3\xa52\x14\xdf\x12\xca\xc6" +
+	"\xd2?U^3\\\x12\xd7;L\xa3t2$M\x03" +
+	"\x83N\xb6\x15,\xd5;\xb6\xe6FSh~\xdb\xd8\xca" +
+	"\x95F%b\xabM\xef!S\xa6@U2\x0a\x11\xa5" +
+	"\x1b(\xe5\x06\xb2\x99\xc4r\x0eQZE\x837\xf2\xa8" +
+	"\xde\xc6!r\x0ar\x88\xd2\x1a\xca\xc3\xd5<\xaawQ" +
+	"\xca\xa1\x82<\xa2t\x07\xed\xef\xb6L\xcaI>N!" +
+	"\xd7Jw\xd3\xd7\xb7\xf3\xa8\xde\xc7\xa1h\xea\x03f6" +
+	"\xac@\xc2\x1a\xa1'\x91\x10\xbb5S\xc3r\xe0\xb0\x9c" +
+	"\xc6*\x85N\xcd\x08\xf7\xc4\x12\x9a\x89C\x81;6\xf4" +
+	"\xf7\x9f\xcfi\x05\x14\xa2q\x93\xb8\xe3\x98o\x95eY" +
+	"\x80b\x9a\x06\xca\x80\x93\xcaj=\xf6\xb6\xc8\xd0\x92\x19" +
+	"\x17\xe7\xd9\xff1\x005\xc8\xa3Z\xc5\xa1\xd5\x1f\xed\xed" +
+	"3/N\x988[\x9f\xafk\xb1\xd8`\x98}\x80A" +
+	"\xe7JS\xc2'N\x0a\xcd\xd7S\xcc\x82%\x9d\x9cH" +
+	"\x9bEY\x94\x17h\xed\xf1t?\x00\x8a\xce)\x08\xe8" +
+	"\x17\x11Jg\xce\xac\x0c\x15dg\x9b\\\xe30\x01b" +
+	"1\x0f\xb89\x17;1\xe8\\b\x0b6\xe7\xb3yX" +
+	"\x8b\xc5\"\xfa5i=\xde\xa5O\xed\xd5\xcd\x8b\xd3\xfd" +
+	"\x9d\xba1a\xbe\x1ef[uo4\xe4l\x14\xe3\xe4" +
+	"\x0b,\xf3X7Yk\xb6\x96\xd2\xf3lT\xeb|\xca" +
+	"\xa8\x02%\xe7f\x0a\x88\x92\x87\x9e\xdc\xc2\x84x\x97\x9e" +
+	"%\x1f\xfblD\xfbn$I\xf3\x81\xb7\xece\x03\x1a" +
+	"\x1dX\xec?;\xa7.\x89\xebm\x9a\xa9\xcd\x8d\xf2\xa9" +
+	"o\x9dT\xee|\x1d\xe1A\x8ds\x84\x84\xd9Wj\xa7" +
+	"\x9dZJ\xc7\xa0sO+AOD>\x11\xd3Hw" +
+	"\x85\xcd\xf3\xb4d\xdc+\xb6&p(,\xd3\xbb\x9cu" +
+	"\xe5\xaa\x89\x12\xc9>_\xef5\xf4T*\x9a\xc0\xb8Z" +
+	"\x91\xd3\xb7\x8eVw\x17\x8f\xea\xfdN\xf4\xac\xa7C`" +
+	"-\x8f\xeaF\xd7\x09\xfb\x00\x09\xde\xc7\xa3\xba\xcdu\xc2" +
+	">A\x86z\x94G\xf5EJwT\xd0\x07 \xed\xa1" +
+	"%\xee\xe2Q\xdd\xc7\xa1\xe4\xe7\x14\xf4\x03H{ip" +
+	"7\x8f\xea\x01\xc7\x0a\xb9r<c\x05\xbe\xb3\x1e\x87\x01" +
+	"\x87\xc3\x00\xc5N\xdd\xd4\xec\xad\x0d\xcb\x1c\xba-\xc9\x98" +
+	"\x16\xd7S\xce\x86s\xf5ef\xc3\xc2`\x7f\xda\xfe^" +
+	"\x18Lu\xdb\xff/r~\x86K\xc9\xeftV\x9b\x06" +
+	"\xb8\x0b\x8e\x1a\xa7\xe0\x90r\x15G\x8dSq \x97-" +
+	"8\xc8\x1c\xdd<\xaaI\x9b\xfd\x00\xa4\xfe\xdal\x15b" +
+	"\x92s\xb4\x18\xf2\xc0!\x0f(t\xa7\x13v\xed!&" +
+	"M\xa3\xa1\x98\xf6i\xb8\xf1\xff9\x0d\xec\xe8\xbaB\x9c" +
+	"\xdak$)\xfd\xe9\x8c\xac\xa5\xfcot\xf2_\xa2\xe5" +
+	"\x9dE\x040\xd3!\x80pO\xd4H\x998\x048\x1c" +
+	"\x02\xd8\x92\xd2\xbb\x12\xf1n\xfb\xd7\"\xeb\xcc\x8a\xc5\x12" +
+	"]\xb3\xf5xW_\xbff\\=\xf5\xfc\xa8\xa0\xc7\xba" +
+	"\xdd\x01\xd8\x09\xa0\x0e\xe7Q\xad\xe0\xd0J\x99F4\xde" +
+	"{\xa9\x06B,\xad\x17\xd1\x9cM(\x1d\xd1\xa4\x1e\x8b" +
+	"\xc6ucj\\_\x9e\xfbeB\x87&R\xf9\xe1>" +
+	"\x81O\xf1E\x8e\x82\\\xec\x97\xb7{\x9b\xfe\xe6\x03\xa8" +
+	"u<\xaa38\x0c\xeb\x03\xa6\xa1a\x088\x0c\x01Z" +
+	"\xc9\xac2@\x03%\xe7\xe6X\xc08\x8e\xb5\xe9h*" +
+	"\x95\xcc\xaec\xcb\xb3\xc2\xc8\x86W\x1eY\xd7z-\xb7" +
+	"\xd6q\xd6\xf7\x0c\x0f\x8fb\xe1;\x13[\xee\x1aQ\x82" +
+	"\xd8\xaehI\xe9\xc62\xdd\xf0\x0a\x86I\x1cZ\xcb\xb5" +
+	"\xa8\x19\x8d\xf7.\x05!\xd1\xe9J\xd3\xdc\x15\xb9\x84\xda" +
+	"\xd9\xc2\xf4\xa6\xe9\xa7\xe0\xcb\x02\xa6(a\xec\x05\xcb\x13" +
+	"bG,\x9dr\xd3[\x8dCo9{\xaf\xafq\xf8" +
+	"\x0d\xb9\x02z{\xd0\x95\xcf\xbf\xa2\xc1\xfbyT7\xd9" +
+	"\xd5\x0c\x80\xf4\x10}\xbd\x91G\xf5Q\x17\xbdm&\xc9" +
+	"\x07yTw\x9d:\xf3\xddU\x99\xcb\xb5\x05\xc3\x82i" +
+	"\xe4\xee+b,e6\xd9\x16\xf5\x9f\xfa\x0e\xd2a\x1a" +
+	"\xdf\xa9N\xcc5\x97J\xb9\x88\xd7z\xdd\xbaf;\x1e" +
+	"Z\xd1\x95\xb9\xb4a\xd0iz\x97pR.\x8f\x01\xb2" +
+	"\x87\xb7\xdd=C\xbbM&IK\x81\xb7\xec\x9c\x07\x91" +
+	"\xa4U\x1f\xba\x1a\x96\x00\xc5z\xe9\\`\xf5\x05\x948" +
+	"\xd8\x1c\xcf7f=\xbf\xd5\xf1\xfc\x16\xb2\xcc&\xfb`" +
+	"k-8\xd8v\xb8<\xff$]2\xb7e\x9c,\xf9" +
+	"\xf9\x8c\xe7\x9f\xa1\xc1\xa73G\xa0\x18\xd7\xfas4\x18" +
+	"\xeeK\xf4;\xc7U^y\xc7\x8e3C\xa3l\xb1]" +
+	"\xdc\xd2\xa5\xc5\xcf\x8f\x0d\"\x02\x87\x08huiI\xad" +
+	"+J\xd5+\xd8\"V\xbf6\x10I\xeaz7\x8d\x15" +
+	"\x1en\xb6\xabf\x09M\x8d\xf5\xdf3\x9br\x89.," +
+	"Mt\x96\xa80\x8bI\xab\x8dC\xa1\xab\xbf\xdb\xde\xb6" +
+	"\xa8\x19\xbd\xa9\x82kt\x91\xcbr\xe7\x0b\xce:U-" +
+	"r\x91+/\xedZ\xe4W\x8d\xae\xbc\xb4k\x91\x87." +
+	"\xca\xa6\xe0Vr\xd9\x92\x8c\xcb\xf2\x9ck'\xeb\x13\x8d" +
+	"\x8es\xf3\\fuF\x0d\xb3\xafMs[=\x9c\xec" +
+	"K\xc4\x1d\x89T\xb43\x16\x8d\xf7\xa6H\"[T\xb7" +
+	"\xa4\x92\x89tJ\xb7]\x17\xeeO\xc4\xf5\xc1\x92\x0eb" +
+	"G\x8a\xae\x0e\xcf\xed\xba\x9dv\xdd\xca\xa3:7{~" +
+	"\xd3\xe0\x85\xb4\xc46\x1e\xd5\x0e\xda6\x9f\xd9\xf6<\xf2" +
+	"\xc3\x1c\x1e\xd5\x05\x1c\x8a\x83\xbaf\xd8,CS\x9a}" +
+	"\x18\x00\x0e\x03\xc49\xda\xa0\xfd\xff\x92,\xc1n:\x99" +
+	";\xee\xb7e\x09\xf7\xa5\xc8\x8b%\x16\xb5\x18Z\xb2q" +
+	"\xa0\xf1\xbb_\xb7\x0a\xa8\xafH\xf3\xf9|C\xf3\xf7\x8c" +
+	"i\x8f\x8a\xff\xbb\xb2c\xee5\xaaDa=+\xfb;" +
+	"\xab#\xed\xfe\x8c\xbbs\xe5\xf4g\xf4Z\xa7\x92,\xe7" +
+	"\xbe\xb6\x8ak\xc9r\xfe\xa4\x95-&k\x9cbR\\" +
+	"\x96\x88vC@\xec\x9c\xde4\x1d\x83NO>{\x82" +
+	"hM\x8d\xf5\x18t\x9a\xd6\x99a\xa1\xa7\xa1\x19\x83N" +
+	"_\xb7\x84qg\xb5D\x8dd\xc20\xd5\xaaL\x90\xd5" +
+	"\x92\xa0\xd4^\x03\x80\x9ct.\xfd\xe0\xa5\x1f\xd0\x0f\x9f" +
+	"4\x85~\xf8\xa5\x89\xf4# U\xd7\x02\x88\xf1D\\" +
+	"\x17\x96\xf6\\-\xc4\xb4\x01!\xd5\x93\x10b\xe9eB" +
+	"w\xcfr\xd1\xd4Sf\x91\xa9\x98\x17\x16\xe8\x03&+" +
+	"\x1br\x19P\xe3\xce\x80l\xe2_X\x93\xcd\x80%\x94" +
+	"\x01Y\xae^L~\xba,\xd3\x9c\x10\xcc\\#\x01\x85" +
+	"\x98\xe3\xb0,\xe7\xb4\xc4R\xa6k\xf4\x14\x84\xb4(\x13" +
+	"\xba\xc9\x18\x9fN}\xf7\xf8u\xf7\xf0\x82\xa5\xee\xf9m" +
+	"\x9a\xa9\x03\x14t\x92\x82\x00\xc1L\x17\xa9\x98(\xd8-" +
+	"\xca\x0e(y\x9e\xbf\x16 2\xc7\xcfcd\x81\xdf\x15" +
+	"S\xb2\xea\xaf\x04\x88\xcc%\xe02?\x87\xd5\xdc\xd7V" +
+	"\x86&\xe5\x85\xfe\x1a\x80H\x07!W\x12\xc2\x9f\xb42" +
+	"\\)_\xce\x90\x05\x84,!\xc4\xf7\x95\x95\xb9\xbc\xc9" +
+	"\x8b\x19r\x19!\xdd\x84\xf8\xbf\xb42\xac)k\x0c\xb9" +
+	"\x92\x90>B\x02',\x9f\x82\x01\x00Yg\xc8\x12B" +
+	"b\x84\x08\xc7\xad2\x85u\x95\xa3lm\xdd\x84$\x09" +
+	")\xfb\x82\xe6)\x03\x90\xfb\xd97}\x84\x98\x84\x0c\xf9" +
+	"_\x9ag\x08\x80|\x0dCb\x84\x0c\x102\xf4s\x9a" +
+	"g(=\xa80$I\xc8\xf5\x84\x0c\xfb\x0b\xcd3\x0c" +
+	"@\x1ed\xf3\x98\x84\xdcH\xc8\xf0?[\xad\x0a\x0e\xa7" +
+	"\x8e?3\xdb\x00!+\xc9l\xe5\x9fY\x0a\x96\x03\xc8" +
+	"71\xe0z\x02V\x130\xe2\x98\xa5\xe0\x08\x00y\x15" +
+	"\x03n$\xe06\x02\xc4O-\x05Ez\xb1\xf0\xcf\x04" +
+	"\x88\xac$\xe0~\x02\x82\x7f\xb2\x14r\xa0\xbc\x9e\x01k" +
+	"\x09\xd8J\x80\xf4?\x96\x82\x12=K0`\x13\x01\xbb" +
+	"\x09\x08}b)\x18\xa2\x076\x06<M\xc0\x1b\x04\xc8" +
+	"\x1f[\x0a\xca\x00\xf2!\x06\x1c \xe0S\x02\x94\x8f," +
+	"\x05\x15z\x9d\xf27\x02D> \xc0\x17\xe0\xb0|\xe4" +
+	"QK\xc1\x91\x002\x06\xe8\x8b\x93\x04T\x100\xeaC" +
+	"K\xc1Q\x00\xf2H\x06\x04\x03<F\xea\x08\x18\xfd\x81" +
+	"\xa5 =>Lf\xc0\x04\x02Z\x09\xa8x\xdfR\xb0" +
+	"\x02@>7@s\xcc `\x01\x01U\xefY\x0a\x8e" +
+	"\xa1\x10\x0b\x90I\xe6\x12\xd0G@\xf5\xbb\x96\x82\x95\xe4" +
+	"\xf9\xc0l\xf2<\x01\xb7\x110\xee\xbf-\x05\xc7\x92\xad" +
+	"\x98\xaa\x95\x04\xdcN@\xf8\x1dK\xc1*z\x0bf\xc0" +
+	"j\x02\xee\"\xe0\xb4#\x96\x82\xd5\x00\xf2\x1d\x81N\x80" +
+	"\xc8\xed\x04l\"`\xfc\xdb\x96\x82\xe3\x00\xe4\x87\x02\x17" +
+	"\x01D\x1e$`+\x015\x7f\xb4\x14\x0c\x93u\x03W" +
+	"\x00D\x1e%`\x07\x01\xa7\xbfe)x\x1a\xbdy\x04" +
+	"\xe6\x03D\xb6\x11\xb0+\xc0a\xf5\x84\xc3\x14A\xe3\x01" +
+	"\xe4g\xd8zw\x10\xb2\x9b>\x99\xf8\xa6\xa5`\x0d9" +
+	"\x84\xed\xf0i\x02^$\xe0\x8c7,\x05O\x07\x90\xf7" +
+	"0`\x17\x01\xfb\x088\xf3\xbf,\x05'\xd0\xdf!\x04" +
+	"(\x18w\x13p\x80\x80I\xaf[\x0aN\xa4?\xc6`" +
+	"\xeb\xddG\xc0\xc7\x04L>d)x\x06\x80\xfc!\x03" +
+	"> \xc0'pX>\xe65K\xc13\xc9\x85\x02\xad" +
+	"\xea$\x01\x15\x04T\xbej)8\x89\\\xc8\x80\xa0@" +
+	".$`\xec+\x96\x82\x93\xc9\x85\x0c\x98@@+\x01" +
+	"\xb5\x07-t\xbdo\xcb\xe7\x0a5\xc0\x95\x9fu\xc0R" +
+	"\xb0\x0e@\x9e(\xd0&N\xcb\xe9\xa9\xfbOK\xc1)" +
+	"L\xcf\xcc<=S^\xb6\x14\x9cJ\xa1\xc0\x80\x19\x04" +
+	"\xb4\x110u\xbf\xa5\xe0\xd9\xf47\x10\x02\xd9\xb6\x95\x80" +
+	"\xb9\x04\x9c\xbd\xcfR\xb0\x1e@\xbe\x90-\xa9\x8d\x80\x0e" +
+	"\x02\xea_\xb2\x14\xa4\xe7\xb1y\x82A\xc1C\xc0eB" +
+	"\xee(\xe3\xaf\xbd\x16\x83\xce\xc3\x9a}b5O\xcb\xb5" +
+	"pz\x9a\x1a\xa9\xfd\x8bC\x01\x85h\xf34\xbb\x1e\x13" +
+	"\xa2M\x8dv\xe5%D\x1b\x9a\xed\x1a\x88\x8f\xce@\x0e" +
+	"8\xe4\x00\x85t\xf34\xbb\xbf!\xa4\x9b\x1a\xed\xc6\xa4" +
+	"\x90nhF\x018\x14\x00\xf9\xf4\x0c\xbbF\x12;\x13" +
+	"\x89\x98]\xbd\xb9\xfb\xd3(v\xc6\x12\x9d\xf6\x0d\xbf\xa5" +
+	"\xa7y\x9a\xab\xe1fw\xa5z\x9a\x1a]\xa3C\xb3\xa3" +
+	"\xd1<Y\xbf=\x9a'\xeb\xb3G\x1b\x9a]\xa3|f" +
+	"4\x1c\x9d\xe1\x1a\xe4\xb2\xa2\xe9<\xb5C\xec\xd1<\xb5" +
+	"e\xf6h\x9eZ!\xab6\xedV\x1b\xc8\x0c\x8a\xd7\xe6" +
+	"5\x12\xddN\xa170B]\x02\xa5\xe4\xc2\xd7Ra" +
+	"[t\xbee\xc6\xe9M&\xf7\xa7\x0e\x05\xa7$\xe4\xf7" +
+	"1\x0b\xdaz\x8e\x18@\x01JJ\x8clW\x13\xf8D" +
+	"\x1c\x83\xce\x9f\x8dda\xd63\xec\xd4R\x80\x1eE\xe2" +
+	"\x0a-S\xf9\x14\\\xceD\xc0\xbfJ\xa1\xc5\xec\xb1L" +
+	"\xef\x82\xd2\xcfH\x19!\xadP\xc8m)\xf6\x0a\x99H" +
+	"\xc4\\6\xca\xbeB\xae\xc8~j\x0f\x97g\x87)\x82" +
+	"]\xc3\xd9\x92G\xe85\x92%\x1a\xed-4\xec\\U" +
+	"\xf3q\x0a%->\xd8a\x1a\xb9\x1e\x9a\x16\x1fd\xcd" +
+	"i@\x13C>\x04\xa4\xd1\x15Z\x9c\xdd'0\xe4\xe3" +
+	"\x00m\xb9\xf3\xb4\xa4\xd6\x09\xe1h,j\x0eb\xc8\xc7" +
+	"\xdbHa\x09m7\x1d\xc3\xecV\xc8\xae\xfc\xce\xdf\xe0" +
+	"`c\xf8\xfchA\x17\xd2]\xb0\xf7\x10\xe8\xea8\xe5" +
+	"\xbe,Q\xb0_\xac\xa7L\xddh8O\xe3\xf3[\xeb" +
+	"\xb5\x8eN1e\x1a%o\xb1\xa7x(\xf1\xe8kr" +
+	"\x85\xadg\xd4Ny\xbf\xaeq=\xe1\x94\xe8(\x15\xf7" +
+	"\xf8\x17-\xd0S\xf4d\x80y\x1b\xbb\"\xdb\xa5\x9b\xc1" +
+	"\xa1\x15\xd7S\xe6<\xcd4\x80\x8f\x0e\x14\xa5\xf4\xa9\xde" +
+	"\x10rO&\xa8\x95z\xefr-\xf5\xff\x06\x00\xbf\x82" +
+	"\"\xee"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -7285,6 +7374,18 @@ func RegisterSchema(reg *schemas.Registry) {
 	})
 }
 
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_832bcc6686a26d56,
+		Compressed: true,
+	}
+	return s.Request()
+}
+
 var x_832bcc6686a26d56 = []byte{
 	0, 0, 0, 0, 2, 0, 0, 0,
 	0, 0, 0, 0, 1, 0, 0, 0,
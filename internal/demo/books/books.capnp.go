@@ -55,9 +55,10 @@ func (s Book) Message() *capnp.Message {
 func (s Book) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+
+// Title of the book.
 func (s Book) Title() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return capnp.GetTextField(s, 0, "Book.title")
 }
 
 func (s Book) HasTitle() bool {
@@ -65,14 +66,14 @@ func (s Book) HasTitle() bool {
 }
 
 func (s Book) TitleBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+	return capnp.GetTextBytesField(s, 0, "Book.title")
 }
 
 func (s Book) SetTitle(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
+// Number of pages in the book.
 func (s Book) PageCount() int32 {
 	return int32(capnp.Struct(s).Uint32(0))
 }
@@ -98,17 +99,16 @@ func (f Book_Future) Struct() (Book, error) {
 	return Book(p.Struct()), err
 }
 
-const schema_85d3acc39d94e0f8 = "x\xda\x12Ht`1\xe4\xdd\xcf\xc8\xc0\x14(\xc2\xca" +
-	"\xb6\xbf\xe6\xca\x95\xeb\x1dg\x1a\x03y\x18\x19\xff\xffx" +
-	"0e\xee\xe15\x97[\x19X\x19\xd9\x19\x18\x04\x8fv" +
-	"\x09\x9e\x05\xd1'\xcb\x19t\xff'\xe5\xe7g\x17\xeb%" +
-	"'2\x16\xe4\x15X9\xe5\xe7g30\x0402\x06" +
-	"r0\xb300\xb0020\x08j\x1a10\x04\xaa" +
-	"03\x06\x1a0122\x8a0\x82\xc4t\x83\x18\x18" +
-	"\x02u\x98\x19\x03-\x98\x18\xe5K2KrR\x19y" +
-	"\x18\x98\x18y\x18\x18\xff\x17$\xa6\xa7:\xe7\x97\xe61" +
-	"0\x960\xb2001\xb200\x02\x02\x00\x00\xff\xff" +
-	"N\xd3$\xbc"
+const schema_85d3acc39d94e0f8 = "x\xda2\x90ft`1\xe4\x9d\xce\xce\xc0\x14\x18\xc1" +
+	"\xca\xb6\xbf\xe6\xca\x95\xeb\x1dg\x1a\x03y\x18\x19\xff\xff" +
+	"x0e\xee\xe15\x97[\x19X\x19\xd9\x19\x18\x847" +
+	"2w10\x0aod.gx\xf1?)??\xbb" +
+	"X/9\x91\xb1 \xaf\xc0\xca)??\x9b\x81!\x90" +
+	"\x83\x99\x85\x81\x81\x85\x91\x81AP\xd3\x88\x81!P\x85" +
+	"\x991\xd0\x80\x89\x91\x91Q\x84\x11$\xa6\x1b\xc4\xc0\x10" +
+	"\xa8\xc3\xcc\x18h\xc1\xc4(_\x92Y\x92\x93\xca\xc8\xc3" +
+	"\xc0\xc4\xc8\xc3\xc0\xf8\xbf 1=\xd59\xbf4\x8f\x81" +
+	"\xb1\x84\x91\x85\x81\x89\x91\x85\x81\x110\x00\xaf\x88$\x1c"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -119,3 +119,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_85d3acc39d94e0f8,
+		Compressed: true,
+	}
+	return s.Request()
+}
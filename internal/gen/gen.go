@@ -45,6 +45,10 @@ func (l {{.}}) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l {{.}}) Slice(i, j int) {{.}} {
+	return {{.}}(List(l).Slice(i, j))
+}
+
 func (l {{.}}) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -0,0 +1,84 @@
+// Package schemaload loads schemas for the command-line tools, either
+// by compiling schema language files or by reading compiled schemas.
+package schemaload
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+	"capnproto.org/go/capnp/v3/std"
+)
+
+// StandardImportPath is searched for imports after the directories
+// given with -I, like the reference tool does.
+var StandardImportPath = []string{"/usr/local/include", "/usr/include"}
+
+// Flags are the flags that control how schemas are loaded.
+type Flags struct {
+	ImportPath  StringsFlag
+	NoStdImport bool
+
+	// SrcPrefix is removed from the names of the compiled files.  Only
+	// the compile command registers a flag for it.
+	SrcPrefix StringsFlag
+}
+
+// Register defines the -I, -import-path and -no-standard-import flags
+// in fs.
+func (f *Flags) Register(fs *flag.FlagSet) {
+	fs.Var(&f.ImportPath, "I", "search `DIR` for imports that start with a slash (may be repeated)")
+	fs.Var(&f.ImportPath, "import-path", "same as -I")
+	fs.BoolVar(&f.NoStdImport, "no-standard-import", false, "do not search the standard import directories")
+}
+
+// Options returns the compiler options for the flags.  Unless
+// -no-standard-import is given, StandardImportPath and then the
+// standard schemas that are embedded in the std package are searched
+// last, so that schemas that import them compile without the C++
+// toolchain installed.
+func (f *Flags) Options() *compiler.Options {
+	opts := &compiler.Options{
+		ImportPath: append([]string(nil), f.ImportPath...),
+		SrcPrefix:  f.SrcPrefix,
+	}
+	if !f.NoStdImport {
+		opts.ImportPath = append(opts.ImportPath, StandardImportPath...)
+		opts.Std = std.Schemas
+	}
+	return opts
+}
+
+// Load compiles the named schema language files, which end in
+// ".capnp", or reads a single compiled schema, as written by
+// "capnp compile -o-".  It returns the CodeGeneratorRequest in the
+// standard framing.
+func (f *Flags) Load(files ...string) ([]byte, error) {
+	if len(files) == 1 && !strings.HasSuffix(files[0], ".capnp") {
+		return os.ReadFile(files[0])
+	}
+	for _, name := range files {
+		if !strings.HasSuffix(name, ".capnp") {
+			return nil, fmt.Errorf("%s: compiled schemas cannot be combined with other schemas", name)
+		}
+	}
+	req, err := compiler.Compile(f.Options(), files...)
+	if err != nil {
+		return nil, err
+	}
+	return req.Message().Marshal()
+}
+
+// StringsFlag collects the values of a repeated flag.
+type StringsFlag []string
+
+func (f *StringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *StringsFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
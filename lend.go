@@ -0,0 +1,41 @@
+package capnp
+
+import "io"
+
+// A BufferLender is an io.Reader that can lend its own buffers to a
+// Decoder.  When a Decoder reads from a BufferLender, it asks for each
+// message's segments to be lent instead of copying them into a new
+// allocation, which avoids a copy and an allocation per message for
+// transports that already hold received data in memory.
+type BufferLender interface {
+	io.Reader
+
+	// LendBuffer consumes the next n bytes of the stream and returns a
+	// buffer holding them.  The decoded message reads from, and may
+	// write to, buf directly.  release is called once the message is
+	// released; until then, the reader must not reuse buf.
+	//
+	// If the next n bytes can't be lent, for instance because they
+	// have not been received yet, LendBuffer returns ok == false
+	// without consuming anything, and the decoder reads the bytes with
+	// Read instead.
+	LendBuffer(n int) (buf []byte, release func(), ok bool)
+}
+
+// lentArena is an arena whose data buffer was lent by a BufferLender.
+// Releasing it returns the buffer to the lender instead of the buffer
+// pool.
+type lentArena struct {
+	*MultiSegmentArena
+	release func()
+}
+
+func (a *lentArena) Release() {
+	if a.release == nil {
+		return
+	}
+	a.MultiSegmentArena.buf = nil // owned by the lender
+	a.MultiSegmentArena.Release()
+	a.release()
+	a.release = nil
+}
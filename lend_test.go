@@ -0,0 +1,75 @@
+package capnp
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sliceLender lends buffers from a byte slice that holds the whole
+// stream.
+type sliceLender struct {
+	data     []byte
+	refuse   bool
+	lent     int
+	returned int
+}
+
+func (l *sliceLender) Read(p []byte) (int, error) {
+	if len(l.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, l.data)
+	l.data = l.data[n:]
+	return n, nil
+}
+
+func (l *sliceLender) LendBuffer(n int) ([]byte, func(), bool) {
+	if l.refuse || n > len(l.data) {
+		return nil, nil, false
+	}
+	buf := l.data[:n:n]
+	l.data = l.data[n:]
+	l.lent++
+	return buf, func() { l.returned++ }, true
+}
+
+func TestDecodeLentBuffer(t *testing.T) {
+	t.Parallel()
+
+	msg, seg := NewSingleSegmentMessage(nil)
+	root, err := NewStruct(seg, ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	root.SetUint64(0, 0xdeadbeef)
+	require.NoError(t, msg.SetRoot(root.ToPtr()))
+	data, err := msg.Marshal()
+	require.NoError(t, err)
+
+	l := &sliceLender{data: data}
+	dec := NewDecoder(l)
+	got, err := dec.Decode()
+	require.NoError(t, err)
+	gotSeg, err := got.Segment(0)
+	require.NoError(t, err)
+	assert.Same(t, &data[8], &gotSeg.Data()[0], "segment was copied")
+	p, err := got.Root()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0xdeadbeef), p.Struct().Uint64(0))
+
+	assert.Equal(t, 1, l.lent)
+	assert.Equal(t, 0, l.returned)
+	got.Release()
+	assert.Equal(t, 1, l.returned, "buffer not returned on release")
+
+	// A lender that can't lend falls back to reading.
+	l = &sliceLender{data: data, refuse: true}
+	got, err = NewDecoder(l).Decode()
+	require.NoError(t, err)
+	p, err = got.Root()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0xdeadbeef), p.Struct().Uint64(0))
+	got.Release()
+	assert.Equal(t, 0, l.lent)
+}
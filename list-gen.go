@@ -30,6 +30,10 @@ func (l VoidList) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l VoidList) Slice(i, j int) VoidList {
+	return VoidList(List(l).Slice(i, j))
+}
+
 func (l VoidList) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -62,6 +66,10 @@ func (l BitList) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l BitList) Slice(i, j int) BitList {
+	return BitList(List(l).Slice(i, j))
+}
+
 func (l BitList) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -94,6 +102,10 @@ func (l Float32List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l Float32List) Slice(i, j int) Float32List {
+	return Float32List(List(l).Slice(i, j))
+}
+
 func (l Float32List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -126,6 +138,10 @@ func (l Float64List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l Float64List) Slice(i, j int) Float64List {
+	return Float64List(List(l).Slice(i, j))
+}
+
 func (l Float64List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -158,6 +174,10 @@ func (l TextList) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l TextList) Slice(i, j int) TextList {
+	return TextList(List(l).Slice(i, j))
+}
+
 func (l TextList) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -190,6 +210,10 @@ func (l DataList) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l DataList) Slice(i, j int) DataList {
+	return DataList(List(l).Slice(i, j))
+}
+
 func (l DataList) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -222,6 +246,10 @@ func (l PointerList) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l PointerList) Slice(i, j int) PointerList {
+	return PointerList(List(l).Slice(i, j))
+}
+
 func (l PointerList) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -254,6 +282,10 @@ func (l EnumList[T]) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l EnumList[T]) Slice(i, j int) EnumList[T] {
+	return EnumList[T](List(l).Slice(i, j))
+}
+
 func (l EnumList[T]) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -286,6 +318,10 @@ func (l StructList[T]) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l StructList[T]) Slice(i, j int) StructList[T] {
+	return StructList[T](List(l).Slice(i, j))
+}
+
 func (l StructList[T]) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -318,6 +354,10 @@ func (l CapList[T]) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l CapList[T]) Slice(i, j int) CapList[T] {
+	return CapList[T](List(l).Slice(i, j))
+}
+
 func (l CapList[T]) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -350,6 +390,10 @@ func (l Int8List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l Int8List) Slice(i, j int) Int8List {
+	return Int8List(List(l).Slice(i, j))
+}
+
 func (l Int8List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -382,6 +426,10 @@ func (l UInt8List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l UInt8List) Slice(i, j int) UInt8List {
+	return UInt8List(List(l).Slice(i, j))
+}
+
 func (l UInt8List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -414,6 +462,10 @@ func (l Int16List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l Int16List) Slice(i, j int) Int16List {
+	return Int16List(List(l).Slice(i, j))
+}
+
 func (l Int16List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -446,6 +498,10 @@ func (l UInt16List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l UInt16List) Slice(i, j int) UInt16List {
+	return UInt16List(List(l).Slice(i, j))
+}
+
 func (l UInt16List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -478,6 +534,10 @@ func (l Int32List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l Int32List) Slice(i, j int) Int32List {
+	return Int32List(List(l).Slice(i, j))
+}
+
 func (l Int32List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -510,6 +570,10 @@ func (l UInt32List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l UInt32List) Slice(i, j int) UInt32List {
+	return UInt32List(List(l).Slice(i, j))
+}
+
 func (l UInt32List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -542,6 +606,10 @@ func (l Int64List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l Int64List) Slice(i, j int) Int64List {
+	return Int64List(List(l).Slice(i, j))
+}
+
 func (l Int64List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -574,6 +642,10 @@ func (l UInt64List) ToPtr() Ptr {
 	return List(l).ToPtr()
 }
 
+func (l UInt64List) Slice(i, j int) UInt64List {
+	return UInt64List(List(l).Slice(i, j))
+}
+
 func (l UInt64List) primitiveElem(i int, expectedSize ObjectSize) (address, error) {
 	return List(l).primitiveElem(i, expectedSize)
 }
@@ -2,6 +2,7 @@ package capnp
 
 import (
 	"errors"
+	"io"
 	"math"
 	"strconv"
 
@@ -88,6 +89,14 @@ func (p List) ToPtr() Ptr {
 	}
 }
 
+// Clone returns a deep copy of the list in seg's message, preferring
+// placement in seg.  Capabilities are copied by reference, as with
+// DeepCopy.
+func (p List) Clone(seg *Segment) (List, error) {
+	c, err := DeepCopy(seg, p.ToPtr())
+	return c.List(), err
+}
+
 // Segment returns the segment the referenced list is stored in or
 // nil if the pointer is invalid.
 func (p List) Segment() *Segment {
@@ -217,11 +226,15 @@ func (p List) Struct(i int) Struct {
 	if !ok {
 		return Struct{}
 	}
+	flags := isListMember
+	if p.flags&isListView != 0 {
+		flags |= isViewMember
+	}
 	return Struct{
 		seg:        p.seg,
 		off:        addr,
 		size:       p.size,
-		flags:      isListMember,
+		flags:      flags,
 		depthLimit: p.depthLimit - 1,
 	}
 }
@@ -231,12 +244,54 @@ func (p List) SetStruct(i int, s Struct) error {
 	if p.flags&isBitList != 0 {
 		return errors.New("SetStruct called on bit list")
 	}
+	if p.flags&isListView != 0 {
+		return errListView
+	}
 	if err := copyStruct(p.Struct(i), s); err != nil {
 		return exc.WrapError("set list element "+str.Itod(i), err)
 	}
 	return nil
 }
 
+// Slice returns a view of the elements [i, j) of the list.  The view
+// shares the list's backing data, so it is cheap to create regardless
+// of the list's size.  It is intended for reading windows of large
+// lists, so the view is read-only: setting its elements or the fields
+// of its structs panics or returns an error, as the setter allows.
+// Objects that the view's elements point to are not affected.  Storing
+// a view in a pointer field copies the view's elements, as with a list
+// from another message, and the copy can be modified.
+//
+// Slice panics if i and j are not in the range 0 <= i <= j <= Len(), or
+// if p is a bit list and i is not a multiple of 8.
+func (p List) Slice(i, j int) List {
+	if i < 0 || j < i || j > p.Len() {
+		// This is programmer error, not input error.
+		panic("list slice bounds out of range")
+	}
+	if p.seg == nil {
+		return p
+	}
+	if p.flags&isBitList != 0 {
+		if i%8 != 0 {
+			panic("bit list slice must start at a multiple of 8")
+		}
+		p.off = p.off.addOffset(DataOffset(i / 8))
+	} else {
+		p.off, _ = p.off.element(int32(i), p.size.totalSize()) // list was already validated
+	}
+	p.flags |= isListView
+	p.length = int32(j - i)
+	return p
+}
+
+// checkWritable panics if p is a view returned by Slice.
+func (p List) checkWritable() {
+	if p.flags&isListView != 0 {
+		panic("capnp: write to read-only list view")
+	}
+}
+
 // l.EncodeAsPtr is equivalent to l.ToPtr(); for implementing TypeParam.
 // The segment argument is ignored.
 func (l List) EncodeAsPtr(*Segment) Ptr { return l.ToPtr() }
@@ -270,9 +325,9 @@ func NewBitList(s *Segment, n int32) (BitList, error) {
 }
 
 // bitListSize returns the number of bytes needed for a bit list with n
-// elements.  It is only defined for n in [0, 1<<29).
+// elements.  It is only defined for n >= 0.
 func bitListSize(n int32) Size {
-	return Size((n + 7) / 8)
+	return Size((int64(n) + 7) / 8)
 }
 
 // At returns the i'th bit.
@@ -299,6 +354,7 @@ func (p BitList) Set(i int, v bool) {
 		// Again, programmer error.  Should have used NewBitList.
 		panic("BitList.Set called on a non-bit list")
 	}
+	List(p).checkWritable()
 	bit := BitOffset(i)
 	addr := p.off.addOffset(bit.offset())
 	b := p.seg.slice(addr, 1)
@@ -357,16 +413,30 @@ func (p PointerList) At(i int) (Ptr, error) {
 	if err != nil {
 		return Ptr{}, err
 	}
-	addr += address(p.size.DataSize)
+	addr = addr.addSizeUnchecked(p.size.DataSize)
 	return p.seg.readPtr(addr, p.depthLimit)
 }
 
+// Has reports whether the i'th pointer in the list is non-null.  It
+// does not affect the read limit.
+func (p PointerList) Has(i int) bool {
+	addr, err := p.primitiveElem(i, ObjectSize{PointerCount: 1})
+	if err != nil {
+		return false
+	}
+	addr = addr.addSizeUnchecked(p.size.DataSize)
+	return p.seg.readRawPointer(addr) != 0
+}
+
 // Set sets the i'th pointer in the list to v.
 func (p PointerList) Set(i int, v Ptr) error {
 	addr, err := p.primitiveElem(i, ObjectSize{PointerCount: 1})
 	if err != nil {
 		return err
 	}
+	if p.flags&isListView != 0 {
+		return errListView
+	}
 	return p.seg.writePtr(addr, v, false)
 }
 
@@ -417,6 +487,9 @@ func (l TextList) Set(i int, v string) error {
 	if err != nil {
 		return err
 	}
+	if l.flags&isListView != 0 {
+		return errListView
+	}
 	if v == "" {
 		return l.seg.writePtr(addr, Ptr{}, false)
 	}
@@ -479,6 +552,9 @@ func (l DataList) Set(i int, v []byte) error {
 	if err != nil {
 		return err
 	}
+	if l.flags&isListView != 0 {
+		return errListView
+	}
 	if len(v) == 0 {
 		return l.seg.writePtr(addr, Ptr{}, false)
 	}
@@ -587,6 +663,24 @@ func NewData(s *Segment, v []byte) (UInt8List, error) {
 	return l, nil
 }
 
+// NewDataFromReader creates a new list of UInt8 holding the next size
+// bytes of r, preferring placement in s.  The bytes are read directly
+// into the segment, without an intermediate copy.  If r has fewer than
+// size bytes, the space allocated for the list is not reclaimed.
+func NewDataFromReader(s *Segment, r io.Reader, size int) (UInt8List, error) {
+	if size < 0 || int64(size) > math.MaxInt32 {
+		return UInt8List{}, errors.New("new data: size out of range")
+	}
+	l, err := NewUInt8List(s, int32(size))
+	if err != nil {
+		return UInt8List{}, err
+	}
+	if _, err := io.ReadFull(r, l.seg.slice(l.off, Size(size))); err != nil {
+		return UInt8List{}, exc.WrapError("new data: read", err)
+	}
+	return l, nil
+}
+
 func isOneByteList(p Ptr) bool {
 	return p.seg != nil && p.flags.ptrType() == listPtrType && p.size.isOneByte() && p.flags.listFlags()&isCompositeList == 0
 }
@@ -606,6 +700,7 @@ func (l UInt8List) Set(i int, v uint8) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint8(addr, v)
 }
 
@@ -652,6 +747,7 @@ func (l Int8List) Set(i int, v int8) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint8(addr, uint8(v))
 }
 
@@ -698,6 +794,7 @@ func (l UInt16List) Set(i int, v uint16) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint16(addr, v)
 }
 
@@ -744,6 +841,7 @@ func (l Int16List) Set(i int, v int16) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint16(addr, uint16(v))
 }
 
@@ -790,6 +888,7 @@ func (l UInt32List) Set(i int, v uint32) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint32(addr, v)
 }
 
@@ -836,6 +935,7 @@ func (l Int32List) Set(i int, v int32) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint32(addr, uint32(v))
 }
 
@@ -882,6 +982,7 @@ func (l UInt64List) Set(i int, v uint64) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint64(addr, v)
 }
 
@@ -928,6 +1029,7 @@ func (l Int64List) Set(i int, v int64) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint64(addr, uint64(v))
 }
 
@@ -974,6 +1076,7 @@ func (l Float32List) Set(i int, v float32) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint32(addr, math.Float32bits(v))
 }
 
@@ -1020,6 +1123,7 @@ func (l Float64List) Set(i int, v float64) {
 	if err != nil {
 		panic(err)
 	}
+	List(l).checkWritable()
 	l.seg.writeUint64(addr, math.Float64bits(v))
 }
 
@@ -1091,6 +1195,9 @@ func (c CapList[T]) At(i int) (T, error) {
 
 func (c CapList[T]) Set(i int, v T) error {
 	pl := PointerList(c)
+	if pl.flags&isListView != 0 {
+		return errListView
+	}
 	seg := pl.Segment()
 	capId := seg.Message().CapTable().Add(Client(v))
 	return pl.Set(i, NewInterface(seg, capId).ToPtr())
@@ -1101,4 +1208,7 @@ type listFlags uint8
 const (
 	isCompositeList listFlags = 1 << iota
 	isBitList
+	isListView // read-only view returned by Slice; a composite view is not preceded by its tag word
 )
+
+var errListView = errors.New("list view is read-only")
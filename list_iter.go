@@ -0,0 +1,348 @@
+//go:build go1.23
+
+package capnp
+
+import "iter"
+
+// This file provides iterators over lists, for use with range-over-func:
+//
+//	for i, p := range people.All() {
+//		...
+//	}
+//
+// Lists whose elements can't be read without error, such as TextList,
+// have a Values method that yields each element's error along with it.
+
+// All returns an iterator over the indices and elements of the list.
+func (l BitList) All() iter.Seq2[int, bool] {
+	return func(yield func(int, bool) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l BitList) Values() iter.Seq[bool] {
+	return func(yield func(bool) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l UInt8List) All() iter.Seq2[int, uint8] {
+	return func(yield func(int, uint8) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l UInt8List) Values() iter.Seq[uint8] {
+	return func(yield func(uint8) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l Int8List) All() iter.Seq2[int, int8] {
+	return func(yield func(int, int8) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l Int8List) Values() iter.Seq[int8] {
+	return func(yield func(int8) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l UInt16List) All() iter.Seq2[int, uint16] {
+	return func(yield func(int, uint16) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l UInt16List) Values() iter.Seq[uint16] {
+	return func(yield func(uint16) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l Int16List) All() iter.Seq2[int, int16] {
+	return func(yield func(int, int16) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l Int16List) Values() iter.Seq[int16] {
+	return func(yield func(int16) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l UInt32List) All() iter.Seq2[int, uint32] {
+	return func(yield func(int, uint32) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l UInt32List) Values() iter.Seq[uint32] {
+	return func(yield func(uint32) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l Int32List) All() iter.Seq2[int, int32] {
+	return func(yield func(int, int32) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l Int32List) Values() iter.Seq[int32] {
+	return func(yield func(int32) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l UInt64List) All() iter.Seq2[int, uint64] {
+	return func(yield func(int, uint64) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l UInt64List) Values() iter.Seq[uint64] {
+	return func(yield func(uint64) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l Int64List) All() iter.Seq2[int, int64] {
+	return func(yield func(int, int64) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l Int64List) Values() iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l Float32List) All() iter.Seq2[int, float32] {
+	return func(yield func(int, float32) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l Float32List) Values() iter.Seq[float32] {
+	return func(yield func(float32) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l Float64List) All() iter.Seq2[int, float64] {
+	return func(yield func(int, float64) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l Float64List) Values() iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l EnumList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l EnumList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of the list.
+func (l StructList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(i, l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list.
+func (l StructList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list and the
+// errors encountered reading them.
+func (l TextList) Values() iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list and the
+// errors encountered reading them.
+func (l DataList) Values() iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list and the
+// errors encountered reading them.
+func (l PointerList) Values() iter.Seq2[Ptr, error] {
+	return func(yield func(Ptr, error) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of the list and the
+// errors encountered reading them.
+func (l CapList[T]) Values() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for i := 0; i < l.Len(); i++ {
+			if !yield(l.At(i)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+//go:build go1.23
+
+package capnp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIterators(t *testing.T) {
+	t.Parallel()
+
+	_, seg, err := NewMessage(SingleSegment(nil))
+	require.NoError(t, err)
+
+	ints, err := NewInt32List(seg, 4)
+	require.NoError(t, err)
+	for i := 0; i < ints.Len(); i++ {
+		ints.Set(i, int32(i*10))
+	}
+	var got []int32
+	for i, v := range ints.All() {
+		assert.Equal(t, int32(i*10), v)
+		got = append(got, v)
+	}
+	assert.Equal(t, []int32{0, 10, 20, 30}, got)
+	got = got[:0]
+	for v := range ints.Values() {
+		if v == 20 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int32{0, 10}, got, "break should stop iteration")
+
+	structs, err := NewCompositeList(seg, ObjectSize{DataSize: 8}, 3)
+	require.NoError(t, err)
+	sl := StructList[Struct](structs)
+	for i, s := range sl.All() {
+		s.SetUint64(0, uint64(i+1))
+	}
+	var sum uint64
+	for s := range sl.Values() {
+		sum += s.Uint64(0)
+	}
+	assert.Equal(t, uint64(6), sum)
+
+	texts, err := NewTextList(seg, 2)
+	require.NoError(t, err)
+	require.NoError(t, texts.Set(0, "foo"))
+	require.NoError(t, texts.Set(1, "bar"))
+	var strs []string
+	for s, err := range texts.Values() {
+		require.NoError(t, err)
+		strs = append(strs, s)
+	}
+	assert.Equal(t, []string{"foo", "bar"}, strs)
+
+	for range (Int32List{}).All() {
+		t.Error("iterating over an empty list yielded an element")
+	}
+}
@@ -2,9 +2,11 @@ package capnp
 
 import (
 	"bytes"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestToListDefault(t *testing.T) {
@@ -154,3 +156,185 @@ func TestListCastRegression(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, ptr.Text(), "Text")
 }
+
+func TestListSlice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Primitive", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg, err := NewMessage(SingleSegment(nil))
+		require.NoError(t, err)
+		l, err := NewInt32List(seg, 10)
+		require.NoError(t, err)
+		for i := 0; i < l.Len(); i++ {
+			l.Set(i, int32(i))
+		}
+
+		v := l.Slice(3, 7)
+		assert.Equal(t, 4, v.Len())
+		assert.Equal(t, "[3, 4, 5, 6]", v.String())
+		assert.Equal(t, 0, l.Slice(5, 5).Len())
+
+		l.Set(3, 42)
+		assert.Equal(t, int32(42), v.At(0), "view shares backing data")
+		assert.Panics(t, func() { v.Set(0, 1) }, "view is read-only")
+		assert.Equal(t, int32(42), l.At(3))
+	})
+
+	t.Run("Bit", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg, err := NewMessage(SingleSegment(nil))
+		require.NoError(t, err)
+		l, err := NewBitList(seg, 20)
+		require.NoError(t, err)
+		l.Set(8, true)
+		l.Set(11, true)
+
+		v := l.Slice(8, 12)
+		assert.Equal(t, "[true, false, false, true]", v.String())
+		assert.Panics(t, func() { v.Set(1, true) })
+		assert.Panics(t, func() { l.Slice(3, 12) })
+	})
+
+	t.Run("Composite", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg, err := NewMessage(SingleSegment(nil))
+		require.NoError(t, err)
+		l, err := NewCompositeList(seg, ObjectSize{DataSize: 8, PointerCount: 1}, 5)
+		require.NoError(t, err)
+		for i := 0; i < l.Len(); i++ {
+			s := l.Struct(i)
+			s.SetUint64(0, uint64(i))
+			txt, err := NewText(seg, strconv.Itoa(i))
+			require.NoError(t, err)
+			require.NoError(t, s.SetPtr(0, txt.ToPtr()))
+		}
+
+		v := l.Slice(1, 4)
+		require.Equal(t, 3, v.Len())
+		assert.Equal(t, uint64(2), v.Struct(1).Uint64(0))
+
+		// Neither the view nor its structs can be set.
+		assert.ErrorIs(t, v.SetStruct(0, l.Struct(0)), errListView)
+		assert.ErrorIs(t, v.Struct(0).SetPtr(0, Ptr{}), errListView)
+		assert.Panics(t, func() { v.Struct(0).SetUint64(0, 7) })
+		assert.Equal(t, uint64(1), l.Struct(1).Uint64(0))
+
+		// Nor can their pointers be disowned, adopted or rewritten in
+		// place.
+		_, err = v.Struct(0).Disown(0)
+		assert.ErrorIs(t, err, errListView)
+		orphan, err := NewText(seg, "orphan")
+		require.NoError(t, err)
+		assert.ErrorIs(t, v.Struct(0).Adopt(0, NewOrphan(orphan.ToPtr())), errListView)
+		reused, err := v.Struct(1).SetTextInPlace(0, "hi")
+		assert.ErrorIs(t, err, errListView)
+		assert.False(t, reused)
+		reused, err = v.Struct(1).SetDataInPlace(0, []byte("hi"))
+		assert.ErrorIs(t, err, errListView)
+		assert.False(t, reused)
+		for i := 1; i < 3; i++ {
+			txt, err := l.Struct(i).Ptr(0)
+			require.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(i), txt.Text(), "original element %d", i)
+		}
+
+		// Storing the view must write a list with its own tag word.
+		root, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+		require.NoError(t, root.SetPtr(0, v.ToPtr()))
+		p, err := root.Ptr(0)
+		require.NoError(t, err)
+		got := p.List()
+		require.Equal(t, 3, got.Len())
+		for i := 0; i < got.Len(); i++ {
+			assert.Equal(t, uint64(i+1), got.Struct(i).Uint64(0))
+			txt, err := got.Struct(i).Ptr(0)
+			require.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(i+1), txt.Text())
+		}
+		tag := seg.readRawPointer(got.off.subSizeUnchecked(wordSize))
+		assert.Equal(t, rawStructPointer(3, l.size), tag, "tag word describes the view")
+
+		// The stored copy can be modified without changing the original.
+		got.Struct(0).SetUint64(0, 7)
+		assert.Equal(t, uint64(1), l.Struct(1).Uint64(0))
+
+		// Round-trip through the wire format.
+		msg2, err := Unmarshal(mustMarshal(t, seg.Message()))
+		require.NoError(t, err)
+		rootPtr, err := msg2.Root()
+		require.NoError(t, err)
+		p, err = rootPtr.Struct().Ptr(0)
+		require.NoError(t, err)
+		got = p.List()
+		require.Equal(t, 3, got.Len())
+		assert.Equal(t, uint64(3), got.Struct(2).Uint64(0))
+	})
+
+	t.Run("Typed", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg, err := NewMessage(SingleSegment(nil))
+		require.NoError(t, err)
+		l, err := NewTextList(seg, 4)
+		require.NoError(t, err)
+		for i, s := range []string{"a", "b", "c", "d"} {
+			require.NoError(t, l.Set(i, s))
+		}
+		v := l.Slice(1, 3)
+		assert.Equal(t, `["b", "c"]`, v.String())
+		assert.ErrorIs(t, v.Set(0, "x"), errListView)
+		assert.ErrorIs(t, PointerList(v).Set(0, Ptr{}), errListView)
+		_, err = PointerList(v).Disown(0)
+		assert.ErrorIs(t, err, errListView)
+		orphan, err := NewText(seg, "orphan")
+		require.NoError(t, err)
+		assert.ErrorIs(t, PointerList(v).Adopt(0, NewOrphan(orphan.ToPtr())), errListView)
+		got, err := l.At(1)
+		require.NoError(t, err)
+		assert.Equal(t, "b", got)
+	})
+
+	t.Run("OutOfRange", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg, err := NewMessage(SingleSegment(nil))
+		require.NoError(t, err)
+		l, err := NewUInt8List(seg, 3)
+		require.NoError(t, err)
+		assert.Panics(t, func() { l.Slice(-1, 2) })
+		assert.Panics(t, func() { l.Slice(2, 1) })
+		assert.Panics(t, func() { l.Slice(0, 4) })
+		assert.Equal(t, 0, List{}.Slice(0, 0).Len())
+	})
+}
+
+func TestNewDataFromReader(t *testing.T) {
+	t.Parallel()
+
+	_, seg := NewSingleSegmentMessage(nil)
+	st, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+	require.NoError(t, err)
+
+	blob := bytes.Repeat([]byte("capnp"), 100)
+	require.NoError(t, st.SetDataFromReader(0, bytes.NewReader(blob), len(blob)))
+	p, err := st.Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, blob, p.Data())
+
+	// Only the first size bytes of the reader are read.
+	r := bytes.NewReader(blob)
+	l, err := NewDataFromReader(seg, r, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("capnp"), l.ToPtr().Data())
+	assert.Equal(t, len(blob)-5, r.Len())
+
+	_, err = NewDataFromReader(seg, bytes.NewReader(blob), len(blob)+1)
+	assert.Error(t, err, "short reader")
+	_, err = NewDataFromReader(seg, bytes.NewReader(blob), -1)
+	assert.Error(t, err, "negative size")
+}
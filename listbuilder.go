@@ -0,0 +1,269 @@
+package capnp
+
+import (
+	"errors"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// Lists cannot be resized once they are allocated, so a list whose
+// length is not known in advance is best accumulated in a Go slice and
+// then copied to a list of the right length.  The functions below do
+// the copying for lists of primitive values, text and data, and a
+// ListBuilder accumulates structs.
+
+// sliceLen returns n as a list length, or an error if it is too long.
+func sliceLen(n int) (int32, error) {
+	if n >= 1<<29 {
+		return 0, errors.New("new list: length out of range")
+	}
+	return int32(n), nil
+}
+
+// newListFromSlice allocates a list with newList and sets its elements
+// to those of v with set.
+func newListFromSlice[L any, E any](s *Segment, v []E, newList func(*Segment, int32) (L, error), set func(L, int, E)) (L, error) {
+	n, err := sliceLen(len(v))
+	if err != nil {
+		var zero L
+		return zero, err
+	}
+	l, err := newList(s, n)
+	if err != nil {
+		return l, err
+	}
+	for i := range v {
+		set(l, i, v[i])
+	}
+	return l, nil
+}
+
+// NewBitListFromSlice creates a new list of bools with the elements of
+// v, preferring placement in s.
+func NewBitListFromSlice(s *Segment, v []bool) (BitList, error) {
+	return newListFromSlice(s, v, NewBitList, BitList.Set)
+}
+
+// NewUInt8ListFromSlice creates a new list of UInt8 with the elements
+// of v, preferring placement in s.
+func NewUInt8ListFromSlice(s *Segment, v []uint8) (UInt8List, error) {
+	return newListFromSlice(s, v, NewUInt8List, UInt8List.Set)
+}
+
+// NewInt8ListFromSlice creates a new list of Int8 with the elements of
+// v, preferring placement in s.
+func NewInt8ListFromSlice(s *Segment, v []int8) (Int8List, error) {
+	return newListFromSlice(s, v, NewInt8List, Int8List.Set)
+}
+
+// NewUInt16ListFromSlice creates a new list of UInt16 with the elements
+// of v, preferring placement in s.
+func NewUInt16ListFromSlice(s *Segment, v []uint16) (UInt16List, error) {
+	return newListFromSlice(s, v, NewUInt16List, UInt16List.Set)
+}
+
+// NewInt16ListFromSlice creates a new list of Int16 with the elements
+// of v, preferring placement in s.
+func NewInt16ListFromSlice(s *Segment, v []int16) (Int16List, error) {
+	return newListFromSlice(s, v, NewInt16List, Int16List.Set)
+}
+
+// NewUInt32ListFromSlice creates a new list of UInt32 with the elements
+// of v, preferring placement in s.
+func NewUInt32ListFromSlice(s *Segment, v []uint32) (UInt32List, error) {
+	return newListFromSlice(s, v, NewUInt32List, UInt32List.Set)
+}
+
+// NewInt32ListFromSlice creates a new list of Int32 with the elements
+// of v, preferring placement in s.
+func NewInt32ListFromSlice(s *Segment, v []int32) (Int32List, error) {
+	return newListFromSlice(s, v, NewInt32List, Int32List.Set)
+}
+
+// NewUInt64ListFromSlice creates a new list of UInt64 with the elements
+// of v, preferring placement in s.
+func NewUInt64ListFromSlice(s *Segment, v []uint64) (UInt64List, error) {
+	return newListFromSlice(s, v, NewUInt64List, UInt64List.Set)
+}
+
+// NewInt64ListFromSlice creates a new list of Int64 with the elements
+// of v, preferring placement in s.
+func NewInt64ListFromSlice(s *Segment, v []int64) (Int64List, error) {
+	return newListFromSlice(s, v, NewInt64List, Int64List.Set)
+}
+
+// NewFloat32ListFromSlice creates a new list of Float32 with the
+// elements of v, preferring placement in s.
+func NewFloat32ListFromSlice(s *Segment, v []float32) (Float32List, error) {
+	return newListFromSlice(s, v, NewFloat32List, Float32List.Set)
+}
+
+// NewFloat64ListFromSlice creates a new list of Float64 with the
+// elements of v, preferring placement in s.
+func NewFloat64ListFromSlice(s *Segment, v []float64) (Float64List, error) {
+	return newListFromSlice(s, v, NewFloat64List, Float64List.Set)
+}
+
+// NewEnumListFromSlice creates a new list of T with the elements of v,
+// preferring placement in s.
+func NewEnumListFromSlice[T ~uint16](s *Segment, v []T) (EnumList[T], error) {
+	return newListFromSlice(s, v, NewEnumList[T], EnumList[T].Set)
+}
+
+// NewTextListFromSlice creates a new list of text with the elements of
+// v, preferring placement in s.  Empty strings are stored as null
+// pointers, as TextList.Set does.
+func NewTextListFromSlice(s *Segment, v []string) (TextList, error) {
+	n, err := sliceLen(len(v))
+	if err != nil {
+		return TextList{}, err
+	}
+	l, err := NewTextList(s, n)
+	if err != nil {
+		return TextList{}, err
+	}
+	for i := range v {
+		if err := l.Set(i, v[i]); err != nil {
+			return TextList{}, exc.WrapError("new text list", err)
+		}
+	}
+	return l, nil
+}
+
+// NewDataListFromSlice creates a new list of data with the elements of
+// v, preferring placement in s.  Empty elements are stored as null
+// pointers, as DataList.Set does.
+func NewDataListFromSlice(s *Segment, v [][]byte) (DataList, error) {
+	n, err := sliceLen(len(v))
+	if err != nil {
+		return DataList{}, err
+	}
+	l, err := NewDataList(s, n)
+	if err != nil {
+		return DataList{}, err
+	}
+	for i := range v {
+		if err := l.Set(i, v[i]); err != nil {
+			return DataList{}, exc.WrapError("new data list", err)
+		}
+	}
+	return l, nil
+}
+
+// A ListBuilder accumulates the elements of a list of structs whose
+// length is not known in advance.  Each element is allocated on its
+// own as it is added, so elements can be inserted and removed
+// anywhere, and Build moves them into a list of the final length.
+//
+// Moving an element copies its data and pointer sections, but not the
+// objects that its pointers refer to.  The space used by the elements
+// before they were moved is left unreachable in the message; use
+// Message.CloneCompact to reclaim it from messages that are kept.
+type ListBuilder[T ~StructKind] struct {
+	seg     *Segment
+	newElem func(*Segment) (T, error)
+	elems   []T
+}
+
+// NewListBuilder returns an empty builder whose elements are allocated
+// by newElem, preferring placement in s.  newElem is usually a
+// generated struct type's New function:
+//
+//	b := capnp.NewListBuilder(seg, books.NewBook)
+func NewListBuilder[T ~StructKind](s *Segment, newElem func(*Segment) (T, error)) *ListBuilder[T] {
+	return &ListBuilder[T]{seg: s, newElem: newElem}
+}
+
+// Len returns the number of elements in the builder.
+func (b *ListBuilder[T]) Len() int {
+	return len(b.elems)
+}
+
+// At returns the i'th element.
+func (b *ListBuilder[T]) At(i int) T {
+	return b.elems[i]
+}
+
+// Append allocates a new element at the end of the list and returns
+// it.
+func (b *ListBuilder[T]) Append() (T, error) {
+	return b.Insert(len(b.elems))
+}
+
+// Insert allocates a new element, inserts it before the i'th element,
+// and returns it.  i may be Len to append the element.
+func (b *ListBuilder[T]) Insert(i int) (T, error) {
+	if i < 0 || i > len(b.elems) {
+		panic("list builder: insert out of bounds")
+	}
+	e, err := b.newElem(b.seg)
+	if err != nil {
+		return e, exc.WrapError("list builder", err)
+	}
+	b.elems = append(b.elems, e)
+	copy(b.elems[i+1:], b.elems[i:])
+	b.elems[i] = e
+	return e, nil
+}
+
+// Remove removes the i'th element.
+func (b *ListBuilder[T]) Remove(i int) {
+	copy(b.elems[i:], b.elems[i+1:])
+	var zero T
+	b.elems[len(b.elems)-1] = zero
+	b.elems = b.elems[:len(b.elems)-1]
+}
+
+// Build allocates a list of the builder's length, moves the elements
+// into it, and empties the builder.  The structs that the builder
+// returned are not part of the list, and should not be used
+// afterwards.
+func (b *ListBuilder[T]) Build() (StructList[T], error) {
+	var sz ObjectSize
+	for _, e := range b.elems {
+		esz := Struct(e).Size()
+		if esz.DataSize > sz.DataSize {
+			sz.DataSize = esz.DataSize
+		}
+		if esz.PointerCount > sz.PointerCount {
+			sz.PointerCount = esz.PointerCount
+		}
+	}
+	n, err := sliceLen(len(b.elems))
+	if err != nil {
+		return StructList[T]{}, exc.WrapError("list builder", err)
+	}
+	l, err := NewCompositeList(b.seg, sz, n)
+	if err != nil {
+		return StructList[T]{}, exc.WrapError("list builder", err)
+	}
+	for i, e := range b.elems {
+		if err := moveStruct(l.Struct(i), Struct(e)); err != nil {
+			return StructList[T]{}, exc.WrapError("list builder: element "+str.Itod(i), err)
+		}
+	}
+	b.elems = nil
+	return StructList[T](l), nil
+}
+
+// moveStruct copies src's sections into dst, which must be at least as
+// large and in the same message, pointing dst's pointers at the
+// objects that src's pointers refer to.  src's pointers are cleared so
+// that each object has a single parent.
+func moveStruct(dst, src Struct) error {
+	if src.seg.msg != dst.seg.msg {
+		return errors.New("move struct: different messages")
+	}
+	copy(dst.seg.slice(dst.off, dst.size.DataSize), src.seg.slice(src.off, src.size.DataSize))
+	for i := uint16(0); i < src.size.PointerCount; i++ {
+		o, err := src.Disown(i)
+		if err != nil {
+			return err
+		}
+		if err := dst.Adopt(i, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
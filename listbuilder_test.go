@@ -0,0 +1,79 @@
+package capnp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFromSlice(t *testing.T) {
+	t.Parallel()
+
+	_, seg := NewSingleSegmentMessage(nil)
+
+	i32, err := NewInt32ListFromSlice(seg, []int32{1, -2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, "[1, -2, 3]", i32.String())
+
+	bits, err := NewBitListFromSlice(seg, []bool{true, false, true})
+	require.NoError(t, err)
+	assert.Equal(t, "[true, false, true]", bits.String())
+
+	f64, err := NewFloat64ListFromSlice(seg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, f64.Len())
+
+	enums, err := NewEnumListFromSlice(seg, []uint16{7, 9})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(9), enums.At(1))
+
+	text, err := NewTextListFromSlice(seg, []string{"foo", "", "bar"})
+	require.NoError(t, err)
+	assert.Equal(t, `["foo", "", "bar"]`, text.String())
+	assert.False(t, PointerList(text).Has(1), "empty string is not null")
+
+	data, err := NewDataListFromSlice(seg, [][]byte{{1, 2}, nil})
+	require.NoError(t, err)
+	d, err := data.At(0)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2}, d)
+}
+
+func TestListBuilder(t *testing.T) {
+	t.Parallel()
+
+	newElem := func(s *Segment) (Struct, error) {
+		return NewStruct(s, ObjectSize{DataSize: 8, PointerCount: 1})
+	}
+	_, seg := NewSingleSegmentMessage(nil)
+	b := NewListBuilder(seg, newElem)
+	for _, name := range []string{"b", "x", "d"} {
+		e, err := b.Append()
+		require.NoError(t, err)
+		require.NoError(t, e.SetText(0, name))
+	}
+	e, err := b.Insert(0)
+	require.NoError(t, err)
+	require.NoError(t, e.SetText(0, "a"))
+	e, err = b.Insert(2)
+	require.NoError(t, err)
+	require.NoError(t, e.SetText(0, "c"))
+	b.Remove(3)
+	require.Equal(t, 4, b.Len())
+	for i := 0; i < b.Len(); i++ {
+		b.At(i).SetUint64(0, uint64(i))
+	}
+
+	l, err := b.Build()
+	require.NoError(t, err)
+	assert.Equal(t, 0, b.Len(), "builder not emptied")
+	require.Equal(t, 4, l.Len())
+	for i, want := range []string{"a", "b", "c", "d"} {
+		s := l.At(i)
+		assert.Equal(t, uint64(i), s.Uint64(0))
+		p, err := s.Ptr(0)
+		require.NoError(t, err)
+		assert.Equal(t, want, p.Text())
+	}
+}
@@ -0,0 +1,247 @@
+// Package membrane wraps graphs of capabilities so that every
+// capability passing through a boundary is transformed by a policy.
+//
+// A membrane is created around a single capability with New.  The
+// capabilities returned by calls made through the membrane, and the
+// capabilities passed as arguments into it, are themselves wrapped in
+// the same membrane, so a caller on one side can never obtain an
+// unwrapped reference to an object on the other side.  This is the
+// building block for attenuating, auditing, or revoking access to an
+// entire object graph at once: a policy that returns revocable clients
+// can cut off everything that was reachable through the membrane.
+package membrane
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// Direction indicates which way a capability crosses a membrane.
+type Direction int
+
+const (
+	// Out is the direction of capabilities leaving the membrane: the
+	// capability passed to New and the capabilities returned by calls
+	// on it.
+	Out Direction = iota
+
+	// In is the direction of capabilities entering the membrane, such
+	// as those passed as arguments to a call on a capability that
+	// came out of it.
+	In
+)
+
+// String returns "out" or "in".
+func (d Direction) String() string {
+	if d == In {
+		return "in"
+	}
+	return "out"
+}
+
+func (d Direction) reverse() Direction {
+	return 1 - d
+}
+
+// A Policy is called for each capability that crosses a membrane,
+// before it is wrapped.  It takes ownership of c and returns the
+// client that calls through the membrane should be delivered to, which
+// may be c itself.  A policy can attenuate the capability, log the
+// calls made on it, or make it revocable with capnp.NewRevocableClient.
+//
+// A Policy may be called from multiple goroutines concurrently.
+type Policy func(c capnp.Client, dir Direction) capnp.Client
+
+// New returns a client that forwards calls to c through a membrane
+// governed by p.  p is applied to c itself with the Out direction.
+// New takes ownership of c.
+//
+// A capability that crosses back over the membrane is not wrapped a
+// second time: passing a capability that came out of the membrane back
+// in yields the client that p returned for it, and vice versa.
+func New(c capnp.Client, p Policy) capnp.Client {
+	m := &membrane{policy: p}
+	return m.wrap(c, Out)
+}
+
+type membrane struct {
+	policy Policy
+}
+
+// wrap returns c wrapped for crossing the membrane in direction dir.
+// It takes ownership of c.
+func (m *membrane) wrap(c capnp.Client, dir Direction) capnp.Client {
+	if !c.IsValid() {
+		return c
+	}
+	snapshot := c.Snapshot()
+	h, ok := snapshot.Brand().Value.(*hook)
+	snapshot.Release()
+	if ok && h.m == m && h.dir != dir {
+		unwrapped := h.client.AddRef()
+		c.Release()
+		return unwrapped
+	}
+	return capnp.NewClient(&hook{
+		m:      m,
+		client: m.policy(c, dir),
+		dir:    dir,
+	})
+}
+
+// wrapCaps wraps the capabilities in msg's capability table from index
+// start onward.
+func (m *membrane) wrapCaps(msg *capnp.Message, start int, dir Direction) {
+	ct := msg.CapTable()
+	for i := start; i < ct.Len(); i++ {
+		id := capnp.CapabilityID(i)
+		ct.Set(id, m.wrap(ct.At(i), dir))
+	}
+}
+
+// send makes a call with send to a target that crossed the membrane in
+// direction dir.  The capabilities in the arguments cross in the
+// opposite direction, and those in the results cross in dir.
+func (m *membrane) send(ctx context.Context, s capnp.Send, dir Direction, send sendFunc) (*capnp.Answer, capnp.ReleaseFunc) {
+	fwd := s
+	if s.PlaceArgs != nil {
+		fwd.PlaceArgs = func(args capnp.Struct) error {
+			msg := args.Message()
+			start := msg.CapTable().Len()
+			err := s.PlaceArgs(args)
+			m.wrapCaps(msg, start, dir.reverse())
+			return err
+		}
+	}
+	ans, release := send(ctx, fwd)
+
+	p := capnp.NewPromise(s.Method, pipeline{m: m, ans: ans, dir: dir}, nil)
+	var results *capnp.Message
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		res, err := ans.Struct()
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		r, err := m.copyResults(res, dir)
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		results = r.Message()
+		p.Fulfill(r.ToPtr())
+	}()
+	return p.Answer(), func() {
+		<-done
+		p.ReleaseClients()
+		if results != nil {
+			results.Release()
+		}
+		release()
+	}
+}
+
+// copyResults copies res into a new message, wrapping the capabilities
+// it references.
+func (m *membrane) copyResults(res capnp.Struct, dir Direction) (capnp.Struct, error) {
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return capnp.Struct{}, err
+	}
+	r, err := capnp.NewRootStruct(seg, res.Size())
+	if err == nil {
+		err = r.CopyFrom(res)
+	}
+	if err != nil {
+		seg.Message().Release()
+		return capnp.Struct{}, err
+	}
+	m.wrapCaps(seg.Message(), 0, dir)
+	return r, nil
+}
+
+// recv delivers a received call with send, like m.send, and returns its
+// results with r.Returner.
+func (m *membrane) recv(ctx context.Context, r capnp.Recv, dir Direction, send sendFunc) capnp.PipelineCaller {
+	ans, release := m.send(ctx, capnp.Send{
+		Method:   r.Method,
+		ArgsSize: r.Args.Size(),
+		PlaceArgs: func(args capnp.Struct) error {
+			defer r.ReleaseArgs()
+			return args.CopyFrom(r.Args)
+		},
+	}, dir, send)
+	go func() {
+		defer release()
+		res, err := ans.Struct()
+		if err != nil {
+			r.Reject(err)
+			return
+		}
+		out, err := r.AllocResults(res.Size())
+		if err == nil {
+			err = out.CopyFrom(res)
+		}
+		if err != nil {
+			r.Reject(err)
+			return
+		}
+		r.Return()
+	}()
+	return ans
+}
+
+type sendFunc func(context.Context, capnp.Send) (*capnp.Answer, capnp.ReleaseFunc)
+
+// hook is the client hook of a wrapped capability.
+type hook struct {
+	m      *membrane
+	client capnp.Client // returned by the policy
+	dir    Direction    // the direction the capability crossed in
+}
+
+func (h *hook) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	return h.m.send(ctx, s, h.dir, h.client.SendCall)
+}
+
+func (h *hook) Recv(ctx context.Context, r capnp.Recv) capnp.PipelineCaller {
+	return h.m.recv(ctx, r, h.dir, h.client.SendCall)
+}
+
+// Brand returns a brand that does not expose the wrapped client, so
+// that the RPC system cannot bypass the membrane by unwrapping it.
+func (h *hook) Brand() capnp.Brand {
+	return capnp.Brand{Value: h}
+}
+
+func (h *hook) Shutdown() {
+	h.client.Release()
+}
+
+func (h *hook) String() string {
+	return "membrane.hook{0x" + str.PtrToHex(h) + "}"
+}
+
+// pipeline forwards calls pipelined on the answer of a call made
+// through the membrane, before the answer resolves.
+type pipeline struct {
+	m   *membrane
+	ans *capnp.Answer
+	dir Direction
+}
+
+func (p pipeline) PipelineSend(ctx context.Context, transform []capnp.PipelineOp, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	return p.m.send(ctx, s, p.dir, func(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+		return p.ans.PipelineSend(ctx, transform, s)
+	})
+}
+
+func (p pipeline) PipelineRecv(ctx context.Context, transform []capnp.PipelineOp, r capnp.Recv) capnp.PipelineCaller {
+	return p.m.recv(ctx, r, p.dir, func(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+		return p.ans.PipelineSend(ctx, transform, s)
+	})
+}
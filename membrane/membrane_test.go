@@ -0,0 +1,209 @@
+package membrane_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/membrane"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+type pipeliner struct {
+	n uint32
+}
+
+func (p pipeliner) NewPipeliner(ctx context.Context, call air.Pipeliner_newPipeliner) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetPipeliner(air.Pipeliner_ServerToClient(pipeliner{n: p.n + 1}))
+}
+
+func (p pipeliner) GetNumber(ctx context.Context, call air.CallSequence_getNumber) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetN(p.n)
+	return nil
+}
+
+// recorder is a policy that records the directions it is called with.
+type recorder struct {
+	mu   sync.Mutex
+	dirs []membrane.Direction
+}
+
+func (r *recorder) policy(c capnp.Client, dir membrane.Direction) capnp.Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dirs = append(r.dirs, dir)
+	return c
+}
+
+func (r *recorder) directions() []membrane.Direction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]membrane.Direction(nil), r.dirs...)
+}
+
+func TestResults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var rec recorder
+	p := air.Pipeliner(membrane.New(capnp.Client(air.Pipeliner_ServerToClient(pipeliner{n: 1})), rec.policy))
+	defer p.Release()
+
+	fut, release := p.NewPipeliner(ctx, nil)
+	defer release()
+	pipelined, release := fut.Pipeliner().GetNumber(ctx, nil)
+	defer release()
+	res, err := pipelined.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), res.N(), "pipelined call")
+
+	results, err := fut.Struct()
+	require.NoError(t, err)
+	next, release := results.Pipeliner().GetNumber(ctx, nil)
+	defer release()
+	res, err = next.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), res.N(), "call on returned capability")
+
+	assert.Equal(t, []membrane.Direction{membrane.Out, membrane.Out}, rec.directions(),
+		"policy should be applied to the entry point and to the returned capability")
+}
+
+func TestOverRPC(t *testing.T) {
+	t.Parallel()
+
+	var rec recorder
+	p1, p2 := transport.NewPipe(1)
+	conn1 := rpc.NewConn(rpc.NewTransport(p1), &rpc.Options{
+		BootstrapClient: membrane.New(capnp.Client(air.Pipeliner_ServerToClient(pipeliner{n: 1})), rec.policy),
+	})
+	defer conn1.Close()
+	conn2 := rpc.NewConn(rpc.NewTransport(p2), nil)
+	defer conn2.Close()
+
+	ctx := context.Background()
+	p := air.Pipeliner(conn2.Bootstrap(ctx))
+	defer p.Release()
+	fut, release := p.NewPipeliner(ctx, nil)
+	defer release()
+	pipelined, release := fut.Pipeliner().GetNumber(ctx, nil)
+	defer release()
+	res, err := pipelined.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), res.N())
+	assert.Equal(t, []membrane.Direction{membrane.Out, membrane.Out}, rec.directions())
+}
+
+const echoInterfaceID = 0xc7b5ee43cd7d2f63
+
+// newCapEchoer returns a client whose only method returns the
+// capability in its first argument pointer, and sends the capability
+// it received on ch.
+func newCapEchoer(ch chan<- capnp.Client) capnp.Client {
+	return capnp.NewClient(server.New([]server.Method{{
+		Method: capnp.Method{InterfaceID: echoInterfaceID, MethodID: 0},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			p, err := call.Args().Ptr(0)
+			if err != nil {
+				return err
+			}
+			c := p.Interface().Client()
+			ch <- c.AddRef()
+			res, err := call.AllocResults(capnp.ObjectSize{PointerCount: 1})
+			if err != nil {
+				return err
+			}
+			return res.SetPtr(0, c.AddRef().EncodeAsPtr(res.Segment()))
+		},
+	}}, nil, nil))
+}
+
+func TestArguments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var rec recorder
+	received := make(chan capnp.Client, 1)
+	c := membrane.New(newCapEchoer(received), rec.policy)
+	defer c.Release()
+	arg := capnp.Client(air.Pipeliner_ServerToClient(pipeliner{n: 7}))
+	defer arg.Release()
+
+	ans, release := c.SendCall(ctx, capnp.Send{
+		Method:   capnp.Method{InterfaceID: echoInterfaceID, MethodID: 0},
+		ArgsSize: capnp.ObjectSize{PointerCount: 1},
+		PlaceArgs: func(args capnp.Struct) error {
+			return args.SetPtr(0, arg.AddRef().EncodeAsPtr(args.Segment()))
+		},
+	})
+	defer release()
+	res, err := ans.Struct()
+	require.NoError(t, err)
+
+	inside := <-received
+	defer inside.Release()
+	assert.False(t, inside.IsSame(arg), "argument should be wrapped inside the membrane")
+	n, release := air.Pipeliner(inside).GetNumber(ctx, nil)
+	defer release()
+	nres, err := n.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(7), nres.N(), "call on wrapped argument")
+
+	p, err := res.Ptr(0)
+	require.NoError(t, err)
+	assert.True(t, p.Interface().Client().IsSame(arg),
+		"capability crossing back should be unwrapped")
+	assert.Equal(t, []membrane.Direction{membrane.Out, membrane.In}, rec.directions())
+}
+
+func TestRevoke(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var (
+		mu      sync.Mutex
+		revokes []capnp.RevokeFunc
+	)
+	policy := func(c capnp.Client, dir membrane.Direction) capnp.Client {
+		c, revoke := capnp.NewRevocableClient(c)
+		mu.Lock()
+		revokes = append(revokes, revoke)
+		mu.Unlock()
+		return c
+	}
+	p := air.Pipeliner(membrane.New(capnp.Client(air.Pipeliner_ServerToClient(pipeliner{n: 1})), policy))
+	defer p.Release()
+
+	fut, release := p.NewPipeliner(ctx, nil)
+	defer release()
+	results, err := fut.Struct()
+	require.NoError(t, err)
+	child := results.Pipeliner()
+
+	mu.Lock()
+	for _, revoke := range revokes {
+		revoke()
+	}
+	mu.Unlock()
+
+	n, release := child.GetNumber(ctx, nil)
+	defer release()
+	_, err = n.Struct()
+	assert.True(t, errors.Is(err, capnp.ErrRevoked), "call on capability obtained through the membrane: %v", err)
+}
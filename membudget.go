@@ -0,0 +1,147 @@
+package capnp
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// ErrOverBudget is returned by Decode when a message does not fit in
+// the decoder's MemoryBudget.
+var ErrOverBudget = errors.New("decode: memory budget exceeded")
+
+// A MemoryBudget limits the total size of decoded messages that have
+// not been released yet.  A single budget can be shared by many
+// Decoders, such as those of all the connections in a server, so that
+// a coordinated burst of large messages cannot exhaust the process's
+// memory.
+//
+// A decoder acquires a message's size from its budget before it reads
+// the message's segments, and the size is returned to the budget when
+// the message is released.  Messages decoded with a budget must be
+// released, or their size is never returned.
+type MemoryBudget struct {
+	limit uint64
+
+	mu      sync.Mutex
+	used    uint64
+	changed chan struct{} // closed and replaced when used decreases
+}
+
+// NewMemoryBudget returns a budget that allows up to limit bytes of
+// decoded messages to be held at once.
+func NewMemoryBudget(limit uint64) *MemoryBudget {
+	return &MemoryBudget{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// Limit returns the maximum number of bytes that the budget allows.
+func (b *MemoryBudget) Limit() uint64 {
+	return b.limit
+}
+
+// Used returns the number of bytes that are currently acquired.
+func (b *MemoryBudget) Used() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used
+}
+
+// TryAcquire acquires n bytes if they are available, and reports
+// whether it did.
+func (b *MemoryBudget) TryAcquire(n uint64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if n > b.limit-b.used {
+		return false
+	}
+	b.used += n
+	return true
+}
+
+// Acquire waits until n bytes are available and acquires them.  It
+// returns ctx.Err() if ctx is done first, and ErrOverBudget without
+// waiting if n is larger than the budget's limit.
+func (b *MemoryBudget) Acquire(ctx context.Context, n uint64) error {
+	if n > b.limit {
+		return ErrOverBudget
+	}
+	for {
+		b.mu.Lock()
+		if n <= b.limit-b.used {
+			b.used += n
+			b.mu.Unlock()
+			return nil
+		}
+		changed := b.changed
+		b.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Release returns n bytes to the budget.  It panics if more bytes are
+// released than were acquired.
+func (b *MemoryBudget) Release(n uint64) {
+	if n == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if n > b.used {
+		panic("memory budget: released " + str.Utod(n) + " bytes, but only " + str.Utod(b.used) + " acquired")
+	}
+	b.used -= n
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// acquire acquires n bytes for a Decoder that waits up to wait for
+// them, or until ctx is done.  A zero wait does not wait, and a
+// negative wait waits until ctx is done.
+func (b *MemoryBudget) acquire(ctx context.Context, n uint64, wait time.Duration) error {
+	switch {
+	case wait == 0:
+		if !b.TryAcquire(n) {
+			return ErrOverBudget
+		}
+		return nil
+	case wait < 0:
+		return b.Acquire(ctx, n)
+	default:
+		ctx, cancel := context.WithTimeout(ctx, wait)
+		defer cancel()
+		if err := b.Acquire(ctx, n); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return ErrOverBudget
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+// budgetArena is an Arena whose size is returned to a budget when it
+// is released.
+type budgetArena struct {
+	Arena
+	budget *MemoryBudget
+	size   uint64
+}
+
+func (a *budgetArena) Release() {
+	a.Arena.Release()
+	if a.budget != nil {
+		a.budget.Release(a.size)
+		a.budget = nil
+	}
+}
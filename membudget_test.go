@@ -0,0 +1,72 @@
+package capnp
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBudget(t *testing.T) {
+	t.Parallel()
+
+	b := NewMemoryBudget(100)
+	assert.True(t, b.TryAcquire(60))
+	assert.False(t, b.TryAcquire(50), "acquired more than the limit")
+	assert.Equal(t, uint64(60), b.Used())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, b.Acquire(ctx, 50), context.DeadlineExceeded)
+	assert.ErrorIs(t, b.Acquire(context.Background(), 101), ErrOverBudget)
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- b.Acquire(context.Background(), 50) }()
+	b.Release(60)
+	require.NoError(t, <-acquired)
+	assert.Equal(t, uint64(50), b.Used())
+	assert.Panics(t, func() { b.Release(51) })
+}
+
+func TestDecoderBudget(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	for i := 0; i < 2; i++ {
+		msg, seg := NewSingleSegmentMessage(nil)
+		_, err := NewRootStruct(seg, ObjectSize{DataSize: 64})
+		require.NoError(t, err)
+		require.NoError(t, enc.Encode(msg))
+	}
+	size := uint64(buf.Len() / 2)
+
+	t.Run("Reject", func(t *testing.T) {
+		budget := NewMemoryBudget(size)
+		dec := NewDecoder(bytes.NewReader(buf.Bytes()))
+		dec.Budget = budget
+		msg, err := dec.Decode()
+		require.NoError(t, err)
+		assert.NotZero(t, budget.Used())
+		_, err = dec.Decode()
+		assert.ErrorIs(t, err, ErrOverBudget)
+		msg.Release()
+		assert.Zero(t, budget.Used(), "budget not returned on release")
+	})
+	t.Run("Wait", func(t *testing.T) {
+		budget := NewMemoryBudget(size)
+		dec := NewDecoder(bytes.NewReader(buf.Bytes()))
+		dec.Budget = budget
+		dec.BudgetWait = -1
+		msg, err := dec.Decode()
+		require.NoError(t, err)
+		time.AfterFunc(10*time.Millisecond, msg.Release)
+		msg2, err := dec.Decode()
+		require.NoError(t, err)
+		msg2.Release()
+		assert.Zero(t, budget.Used())
+	})
+}
@@ -52,6 +52,11 @@ type Message struct {
 	// If not set, this defaults to 64.
 	DepthLimit uint
 
+	// readOnly is set for the messages that hold the default values of
+	// pointer fields, which are shared by all readers.  Writing to a
+	// read-only message panics, and allocating in it fails.
+	readOnly bool
+
 	// mu protects the following fields:
 	mu       sync.Mutex
 	segs     map[SegmentID]*Segment
@@ -193,6 +198,9 @@ func (m *Message) Root() (Ptr, error) {
 	if err != nil {
 		return Ptr{}, exc.WrapError("read root", err)
 	}
+	if !s.regionInBounds(0, wordSize) {
+		return Ptr{}, errors.New("read root: root pointer out of bounds")
+	}
 	p, err := s.root().At(0)
 	if err != nil {
 		return Ptr{}, exc.WrapError("read root", err)
@@ -212,6 +220,89 @@ func (m *Message) SetRoot(p Ptr) error {
 	return nil
 }
 
+// CloneCompact returns a deep copy of the objects reachable from the
+// message's root in a new single-segment message whose segment is
+// sized to fit them.  The copy has none of the space that the original
+// wastes on objects that were overwritten or orphaned, on far pointers
+// between segments, or on unused segment capacity, which makes it
+// suitable for messages that are kept for a long time after being
+// edited.  The copy has the original's traversal and depth limits.
+//
+// Capabilities in the copy are added to its own capability table, so
+// the caller should release the copy when done with it.  Reading the
+// original to copy it counts against its read limit.
+func (m *Message) CloneCompact() (*Message, error) {
+	clone, err := m.cloneCompact()
+	if err != nil {
+		return nil, exc.WrapError("clone compact", err)
+	}
+	return clone, nil
+}
+
+// Compact rewrites the message in place so that it holds only the
+// objects reachable from its root, in a single segment sized to fit
+// them.  It frees the space that MessageStats.ReclaimableBytes
+// reports, such as objects stranded by setting a pointer field more
+// than once.  The message can still be modified afterwards.
+//
+// Like Reset, Compact invalidates every existing pointer into the
+// message, since the objects move: structs and lists must be obtained
+// again from the root.  Capabilities are kept, renumbered in the order
+// they are reached, and the current arena is released.
+func (m *Message) Compact() error {
+	if m.readOnly {
+		return errors.New("compact: read-only message")
+	}
+	clone, err := m.cloneCompact()
+	if err != nil {
+		return exc.WrapError("compact", err)
+	}
+	seg, err := clone.Segment(0)
+	if err != nil {
+		clone.Release()
+		return exc.WrapError("compact", err)
+	}
+
+	// Take over the clone's data and capabilities without releasing
+	// them.
+	caps := clone.capTable.cs
+	m.capTable.Reset(caps...)
+	m.mu.Lock()
+	for k := range m.segs {
+		delete(m.segs, k)
+	}
+	m.firstSeg = Segment{}
+	if m.Arena != nil {
+		m.Arena.Release()
+	}
+	m.Arena = SingleSegment(seg.data)
+	m.mu.Unlock()
+	return nil
+}
+
+// cloneCompact implements CloneCompact.
+func (m *Message) cloneCompact() (*Message, error) {
+	st, err := m.Stats()
+	if err != nil {
+		return nil, err
+	}
+	root, err := m.Root()
+	if err != nil {
+		return nil, err
+	}
+	clone, _, err := NewMessage(SingleSegment(make([]byte, 0, st.ObjectBytes-st.FarPointerBytes)))
+	if err != nil {
+		return nil, err
+	}
+	clone.TraverseLimit = m.TraverseLimit
+	clone.DepthLimit = m.DepthLimit
+	if err := clone.SetRoot(root); err != nil {
+		clone.Release()
+		return nil, err
+	}
+	return clone, nil
+}
+
 // CapTable is the indexed list of the clients referenced in the
 // message. Capability pointers inside the message will use this
 // table to map pointers to Clients.   The table is populated by
@@ -433,6 +524,9 @@ func (wc *writeCounter) Write(b []byte) (n int, err error) {
 // use a different segment in the same message if there's not sufficient
 // capacity.
 func alloc(s *Segment, sz Size) (*Segment, address, error) {
+	if s.msg.readOnly {
+		return nil, 0, errReadOnly
+	}
 	if sz > maxAllocSize() {
 		return nil, 0, errors.New("allocation: too large")
 	}
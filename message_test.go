@@ -643,3 +643,91 @@ func (readOnlyArena) Allocate(sz Size, segs map[SegmentID]*Segment) (SegmentID,
 }
 
 var errReadOnlyArena = errors.New("Allocate called on read-only arena")
+
+func TestCloneCompact(t *testing.T) {
+	t.Parallel()
+
+	msg, seg := NewMultiSegmentMessage(nil)
+	root, err := NewRootStruct(seg, ObjectSize{DataSize: 8, PointerCount: 2})
+	require.NoError(t, err)
+	root.SetUint64(0, 7)
+	for i := 0; i < 10; i++ {
+		// Each write orphans the previous text.
+		require.NoError(t, root.SetText(0, fmt.Sprintf("edit %d", i)))
+	}
+	child, err := NewStruct(seg, ObjectSize{DataSize: 8})
+	require.NoError(t, err)
+	child.SetUint64(0, 42)
+	require.NoError(t, root.SetPtr(1, child.ToPtr()))
+	orig, err := msg.Stats()
+	require.NoError(t, err)
+	require.NotZero(t, orig.UnreachableBytes)
+
+	clone, err := msg.CloneCompact()
+	require.NoError(t, err)
+	defer clone.Release()
+	assert.Equal(t, int64(1), clone.NumSegments())
+	st, err := clone.Stats()
+	require.NoError(t, err)
+	assert.Zero(t, st.UnreachableBytes, "clone has unreachable objects")
+	assert.Zero(t, st.UnusedBytes, "clone has unused capacity")
+	assert.Equal(t, st.ObjectBytes, st.SegmentSizes[0])
+
+	p, err := clone.Root()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), p.Struct().Uint64(0))
+	text, err := p.Struct().Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, "edit 9", text.Text())
+	c, err := p.Struct().Ptr(1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), c.Struct().Uint64(0))
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+
+	msg, seg := NewMultiSegmentMessage(nil)
+	root, err := NewRootStruct(seg, ObjectSize{DataSize: 8, PointerCount: 2})
+	require.NoError(t, err)
+	root.SetUint64(0, 7)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, root.SetText(0, fmt.Sprintf("edit %d", i)))
+	}
+	hook := new(dummyHook)
+	root.SetPtr(1, NewInterface(seg, seg.Message().CapTable().Add(NewClient(hook))).ToPtr())
+	// Strand a capability, too.
+	stranded := new(dummyHook)
+	seg.Message().CapTable().Add(NewClient(stranded))
+
+	before, err := msg.Stats()
+	require.NoError(t, err)
+	require.NotZero(t, before.ReclaimableBytes())
+
+	require.NoError(t, msg.Compact())
+	assert.Equal(t, int64(1), msg.NumSegments())
+	st, err := msg.Stats()
+	require.NoError(t, err)
+	assert.Zero(t, st.ReclaimableBytes())
+	assert.Equal(t, before.SegmentSizes[0]+before.UnusedBytes-before.ReclaimableBytes(), st.SegmentSizes[0])
+	assert.Equal(t, 1, msg.CapTable().Len())
+	assert.Equal(t, 1, stranded.shutdowns, "stranded capability not released")
+	assert.Zero(t, hook.shutdowns, "reachable capability released")
+
+	p, err := msg.Root()
+	require.NoError(t, err)
+	root = p.Struct()
+	assert.Equal(t, uint64(7), root.Uint64(0))
+	text, err := root.Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, "edit 9", text.Text())
+	c, err := root.Ptr(1)
+	require.NoError(t, err)
+	assert.True(t, msg.CapTable().Get(c.Interface()).IsValid())
+
+	// The message can still be modified.
+	require.NoError(t, root.SetText(0, "after"))
+	text, err = root.Ptr(0)
+	require.NoError(t, err)
+	assert.Equal(t, "after", text.Text())
+}
@@ -0,0 +1,151 @@
+package capnp
+
+import (
+	"errors"
+
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+// An Orphan is an object in a message that no pointer in the message
+// refers to: a struct or list that has been allocated but not yet set
+// in a field, or one that has been disowned by its parent.  Adopting
+// an orphan points a field or list element at it without copying it,
+// so a message can be built out of order: an object can be built
+// before its parent, or moved from one parent to another.
+//
+// Orphans are values, like the pointers they hold.  An orphan should
+// be adopted at most once; adopting it twice leaves two pointers to
+// the same object, which counts twice against readers' traversal
+// limits and which Canonicalize writes twice.  An orphan that is never
+// adopted still takes up space in its message.
+type Orphan struct {
+	p Ptr
+}
+
+// NewOrphan returns an orphan for p, which must point to an object
+// that no pointer in its message refers to, such as a struct returned
+// by NewStruct or a list returned by NewPointerList.
+func NewOrphan(p Ptr) Orphan {
+	return Orphan{p: p}
+}
+
+// IsValid reports whether the orphan holds an object.  Adopting an
+// invalid orphan sets the pointer to null.
+func (o Orphan) IsValid() bool {
+	return o.p.IsValid()
+}
+
+// Message returns the message that the orphan's object is in, or nil
+// if the orphan is invalid.
+func (o Orphan) Message() *Message {
+	return o.p.Message()
+}
+
+// Ptr returns a pointer to the orphan's object.
+func (o Orphan) Ptr() Ptr {
+	return o.p
+}
+
+// Struct returns the orphan's object as a struct, or the zero Struct
+// if it is not a struct.
+func (o Orphan) Struct() Struct {
+	return o.p.Struct()
+}
+
+// List returns the orphan's object as a list, or the zero List if it
+// is not a list.
+func (o Orphan) List() List {
+	return o.p.List()
+}
+
+// adoptable returns an error if writing a pointer to o into msg would
+// copy o's object.
+func (o Orphan) adoptable(msg *Message) error {
+	if !o.p.IsValid() {
+		return nil
+	}
+	if o.p.Message() != msg {
+		return errors.New("orphan is in a different message")
+	}
+	switch o.p.flags.ptrType() {
+	case structPtrType:
+		if o.p.flags.structFlags()&isListMember != 0 {
+			return errors.New("orphan is an element of a struct list")
+		}
+	case listPtrType:
+		if o.p.flags.listFlags()&isListView != 0 {
+			return errors.New("orphan is a list view")
+		}
+	}
+	return nil
+}
+
+// Disown sets the i'th pointer in the struct to null and returns the
+// object that it pointed to as an orphan.  The object stays in the
+// message, where it can be adopted by another field.  Disowning a null
+// or out-of-range pointer returns an invalid orphan.
+func (p Struct) Disown(i uint16) (Orphan, error) {
+	if p.flags&isViewMember != 0 {
+		return Orphan{}, exc.WrapError("disown", errListView)
+	}
+	ptr, err := p.Ptr(i)
+	if err != nil {
+		return Orphan{}, exc.WrapError("disown", err)
+	}
+	if ptr.IsValid() {
+		p.seg.writeRawPointer(p.pointerAddress(i), 0)
+	}
+	return Orphan{p: ptr}, nil
+}
+
+// Adopt sets the i'th pointer in the struct to the orphan's object
+// without copying it.  The orphan must be in the struct's message and
+// must not be an element of a struct list, which cannot be pointed to
+// on its own.  Use SetPtr to copy objects from other messages.
+func (p Struct) Adopt(i uint16, o Orphan) error {
+	if p.seg == nil || i >= p.size.PointerCount {
+		panic("capnp: set field outside struct boundaries")
+	}
+	if p.flags&isViewMember != 0 {
+		return exc.WrapError("adopt", errListView)
+	}
+	if err := o.adoptable(p.Message()); err != nil {
+		return exc.WrapError("adopt", err)
+	}
+	return p.seg.writePtr(p.pointerAddress(i), o.p, false)
+}
+
+// Disown sets the i'th pointer in the list to null and returns the
+// object that it pointed to as an orphan.
+func (p PointerList) Disown(i int) (Orphan, error) {
+	if p.flags&isListView != 0 {
+		return Orphan{}, exc.WrapError("disown", errListView)
+	}
+	ptr, err := p.At(i)
+	if err != nil {
+		return Orphan{}, exc.WrapError("disown", err)
+	}
+	if ptr.IsValid() {
+		addr, _ := p.primitiveElem(i, ObjectSize{PointerCount: 1})
+		p.seg.writeRawPointer(addr+address(p.size.DataSize), 0)
+	}
+	return Orphan{p: ptr}, nil
+}
+
+// Adopt sets the i'th pointer in the list to the orphan's object
+// without copying it, under the same conditions as Struct.Adopt.
+// Together with Disown, it can move elements of a list of pointers,
+// such as a list of lists or of text, without copying them.
+func (p PointerList) Adopt(i int, o Orphan) error {
+	if p.flags&isListView != 0 {
+		return exc.WrapError("adopt", errListView)
+	}
+	addr, err := p.primitiveElem(i, ObjectSize{PointerCount: 1})
+	if err != nil {
+		return exc.WrapError("adopt", err)
+	}
+	if err := o.adoptable(p.seg.msg); err != nil {
+		return exc.WrapError("adopt", err)
+	}
+	return p.seg.writePtr(addr+address(p.size.DataSize), o.p, false)
+}
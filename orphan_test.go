@@ -0,0 +1,116 @@
+package capnp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AdoptNew", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		child, err := NewStruct(seg, ObjectSize{DataSize: 8})
+		require.NoError(t, err)
+		child.SetUint64(0, 42)
+		parent, err := NewRootStruct(seg, ObjectSize{PointerCount: 2})
+		require.NoError(t, err)
+		before := len(seg.Data())
+
+		require.NoError(t, parent.Adopt(1, NewOrphan(child.ToPtr())))
+		assert.Equal(t, before, len(seg.Data()), "adopting allocated")
+		p, err := parent.Ptr(1)
+		require.NoError(t, err)
+		assert.Equal(t, child.off, p.Struct().off, "adopted struct was copied")
+		assert.Equal(t, uint64(42), p.Struct().Uint64(0))
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewMultiSegmentMessage(nil)
+		a, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+		b, err := NewStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+		require.NoError(t, a.SetText(0, "hello"))
+		size := len(seg.Data())
+
+		o, err := a.Disown(0)
+		require.NoError(t, err)
+		assert.True(t, o.IsValid())
+		assert.False(t, a.HasPtr(0), "disowned pointer is not null")
+		require.NoError(t, b.Adopt(0, o))
+		assert.Equal(t, size, len(seg.Data()), "moving allocated")
+		p, err := b.Ptr(0)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", p.Text())
+	})
+
+	t.Run("PointerList", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		l, err := NewTextList(seg, 3)
+		require.NoError(t, err)
+		require.NoError(t, l.Set(0, "a"))
+		require.NoError(t, l.Set(1, "b"))
+		pl := PointerList(l)
+
+		// Rotate the first two elements into the last two places.
+		o0, err := pl.Disown(0)
+		require.NoError(t, err)
+		o1, err := pl.Disown(1)
+		require.NoError(t, err)
+		require.NoError(t, pl.Adopt(1, o0))
+		require.NoError(t, pl.Adopt(2, o1))
+
+		assert.False(t, pl.Has(0))
+		s1, err := l.At(1)
+		require.NoError(t, err)
+		s2, err := l.At(2)
+		require.NoError(t, err)
+		assert.Equal(t, "a", s1)
+		assert.Equal(t, "b", s2)
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		s, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+		o, err := s.Disown(0)
+		require.NoError(t, err)
+		assert.False(t, o.IsValid())
+		o, err = s.Disown(5)
+		require.NoError(t, err)
+		assert.False(t, o.IsValid())
+
+		require.NoError(t, s.SetText(0, "x"))
+		require.NoError(t, s.Adopt(0, Orphan{}))
+		assert.False(t, s.HasPtr(0))
+	})
+
+	t.Run("NotAdoptable", func(t *testing.T) {
+		t.Parallel()
+
+		_, seg := NewSingleSegmentMessage(nil)
+		s, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+		require.NoError(t, err)
+
+		_, other := NewSingleSegmentMessage(nil)
+		foreign, err := NewStruct(other, ObjectSize{DataSize: 8})
+		require.NoError(t, err)
+		assert.Error(t, s.Adopt(0, NewOrphan(foreign.ToPtr())), "adopted from another message")
+
+		l, err := NewCompositeList(seg, ObjectSize{DataSize: 8}, 2)
+		require.NoError(t, err)
+		assert.Error(t, s.Adopt(0, NewOrphan(l.Struct(1).ToPtr())), "adopted a list element")
+		assert.False(t, s.HasPtr(0))
+	})
+}
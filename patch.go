@@ -0,0 +1,625 @@
+package capnp
+
+import (
+	"errors"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// A patch is a message describing the changes between two versions of
+// a struct.  It is produced by CreatePatch and consumed by ApplyPatch.
+// Since it only refers to the layout of the struct, the same functions
+// work for any struct type.  Its root is a StructPatch in the following
+// schema:
+//
+//	struct StructPatch {
+//	  dataSize @0 :UInt32;          # new data section size in bytes
+//	  pointerCount @1 :UInt16;      # new pointer section size
+//	  words @2 :List(WordChange);   # changed words of the data section
+//	  pointers @3 :List(PointerPatch);
+//	}
+//
+//	struct ListPatch {
+//	  length @0 :UInt32;
+//	  elementPointerCount @1 :UInt16;  # composite lists only
+//	  elementDataSize @2 :UInt32;      # composite lists only
+//	  words @3 :List(WordChange);      # changed words of a data list
+//	  elements @4 :List(PointerPatch); # changed elements otherwise
+//	}
+//
+//	struct WordChange {
+//	  index @0 :UInt32;
+//	  value @1 :UInt64;
+//	}
+//
+//	struct PointerPatch {
+//	  index @0 :UInt32;
+//	  union {
+//	    set @1 :AnyPointer;       # replaced by this value
+//	    struct @2 :StructPatch;   # same struct, modified
+//	    list @3 :ListPatch;       # same list, modified
+//	  }
+//	}
+//
+// Words beyond the end of a data section or list read as zero, so a
+// patch can shrink a struct's fields back to their defaults.
+
+const (
+	patchSet uint16 = iota
+	patchStruct
+	patchList
+)
+
+var (
+	structPatchSize  = ObjectSize{DataSize: 8, PointerCount: 2}
+	listPatchSize    = ObjectSize{DataSize: 16, PointerCount: 2}
+	pointerPatchSize = ObjectSize{DataSize: 8, PointerCount: 1}
+	wordChangeSize   = ObjectSize{DataSize: 16}
+)
+
+// CreatePatch returns a patch message that transforms old into new when
+// applied with ApplyPatch.  Unchanged data words, pointers and list
+// elements are omitted from the patch, so it is typically much smaller
+// than new when only a few fields of a large structure have changed.
+// The patch only contains data read from new; old is used for
+// comparison.  An invalid old struct is treated as an empty struct.
+//
+// Capabilities cannot be patched: CreatePatch returns an error if a
+// capability pointer differs between old and new.
+func CreatePatch(old, new Struct) (*Message, error) {
+	d, err := diffStruct(old, new)
+	if err != nil {
+		return nil, exc.WrapError("create patch", err)
+	}
+	msg, seg, _ := NewMessage(MultiSegment(nil))
+	root, err := NewRootStruct(seg, structPatchSize)
+	if err != nil {
+		return nil, exc.WrapError("create patch", err)
+	}
+	if err := d.encode(root); err != nil {
+		return nil, exc.WrapError("create patch", err)
+	}
+	if msg.CapTable().Len() > 0 {
+		return nil, errors.New("create patch: capabilities cannot be patched")
+	}
+	return msg, nil
+}
+
+// ApplyPatch applies a patch created by CreatePatch to base, which
+// must be equal to the old struct passed to CreatePatch.  The changes
+// are made in place where possible: objects that need to grow are
+// reallocated in base's message, leaving the old copies unreachable
+// until the message is copied.  If base itself needs to grow, the
+// returned struct is a new, patched copy of base that the caller must
+// store in place of base (for example with Message.SetRoot); otherwise
+// it is base.
+func ApplyPatch(base Struct, patch *Message) (Struct, error) {
+	if !base.IsValid() {
+		return Struct{}, errors.New("apply patch: invalid base struct")
+	}
+	p, err := patch.Root()
+	if err != nil {
+		return Struct{}, exc.WrapError("apply patch", err)
+	}
+	s, err := applyStructPatch(base, p.Struct())
+	if err != nil {
+		return Struct{}, exc.WrapError("apply patch", err)
+	}
+	return s, nil
+}
+
+type wordChange struct {
+	index uint32
+	value uint64
+}
+
+type structDiff struct {
+	size  ObjectSize
+	words []wordChange
+	ptrs  []ptrDiff
+}
+
+type listDiff struct {
+	length   int32
+	elemSize ObjectSize
+	words    []wordChange
+	elems    []ptrDiff
+}
+
+type ptrDiff struct {
+	index uint32
+	which uint16
+	value Ptr
+	s     *structDiff
+	l     *listDiff
+}
+
+func (d *structDiff) empty() bool {
+	return len(d.words) == 0 && len(d.ptrs) == 0
+}
+
+func (d *listDiff) empty(old List) bool {
+	return d.length == old.length && len(d.words) == 0 && len(d.elems) == 0
+}
+
+// structData returns the data section of s, or nil if s is invalid.
+func structData(s Struct) []byte {
+	if s.seg == nil {
+		return nil
+	}
+	return s.seg.slice(s.off, s.size.DataSize)
+}
+
+// diffWords compares two byte slices a word at a time, treating bytes
+// past the end of either slice as zero.
+func diffWords(old, new []byte) []wordChange {
+	n := len(old)
+	if len(new) > n {
+		n = len(new)
+	}
+	var changes []wordChange
+	for off := 0; off < n; off += int(wordSize) {
+		if v := wordAt(new, off); v != wordAt(old, off) {
+			changes = append(changes, wordChange{index: uint32(off / int(wordSize)), value: v})
+		}
+	}
+	return changes
+}
+
+// wordAt returns the little-endian word at off in b, padding b with
+// zeros as needed.
+func wordAt(b []byte, off int) uint64 {
+	var v uint64
+	for i := 0; i < int(wordSize) && off+i < len(b); i++ {
+		v |= uint64(b[off+i]) << (8 * i)
+	}
+	return v
+}
+
+func diffStruct(old, new Struct) (*structDiff, error) {
+	d := &structDiff{
+		size:  new.size,
+		words: diffWords(structData(old), structData(new)),
+	}
+	n := old.size.PointerCount
+	if new.size.PointerCount > n {
+		n = new.size.PointerCount
+	}
+	for i := uint16(0); i < n; i++ {
+		op, err := old.Ptr(i)
+		if err != nil {
+			return nil, exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+		np, err := new.Ptr(i)
+		if err != nil {
+			return nil, exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+		pd, changed, err := diffPtr(op, np)
+		if err != nil {
+			return nil, exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+		if changed {
+			pd.index = uint32(i)
+			d.ptrs = append(d.ptrs, pd)
+		}
+	}
+	return d, nil
+}
+
+func diffPtr(op, np Ptr) (d ptrDiff, changed bool, err error) {
+	if !op.IsValid() && !np.IsValid() {
+		return ptrDiff{}, false, nil
+	}
+	if !op.IsValid() || !np.IsValid() || op.flags.ptrType() != np.flags.ptrType() {
+		if np.IsValid() && np.flags.ptrType() == interfacePtrType {
+			return ptrDiff{}, false, errors.New("capabilities cannot be patched")
+		}
+		return ptrDiff{which: patchSet, value: np}, true, nil
+	}
+	switch np.flags.ptrType() {
+	case structPtrType:
+		sd, err := diffStruct(op.Struct(), np.Struct())
+		if err != nil || sd.empty() {
+			return ptrDiff{}, false, err
+		}
+		return ptrDiff{which: patchStruct, s: sd}, true, nil
+	case listPtrType:
+		ld, ok, err := diffList(op.List(), np.List())
+		if err != nil {
+			return ptrDiff{}, false, err
+		}
+		if !ok {
+			return ptrDiff{which: patchSet, value: np}, true, nil
+		}
+		if ld.empty(op.List()) {
+			return ptrDiff{}, false, nil
+		}
+		return ptrDiff{which: patchList, l: ld}, true, nil
+	case interfacePtrType:
+		if eq, err := Equal(op, np); err != nil || eq {
+			return ptrDiff{}, false, err
+		}
+		return ptrDiff{}, false, errors.New("capabilities cannot be patched")
+	default:
+		panic("unreachable")
+	}
+}
+
+// listKind classifies lists by how their elements can be patched.
+type listKind int
+
+const (
+	dataListKind listKind = iota
+	pointerListKind
+	compositeListKind
+)
+
+func kindOfList(l List) listKind {
+	switch {
+	case l.flags&isCompositeList != 0:
+		return compositeListKind
+	case l.size.PointerCount > 0:
+		return pointerListKind
+	default:
+		return dataListKind
+	}
+}
+
+// listData returns the elements of a data list.
+func listData(l List) []byte {
+	return l.seg.slice(l.off, l.allocSize())
+}
+
+// diffList returns the changes between two lists.  It reports false if
+// the lists' layouts are incompatible, in which case the list must be
+// replaced as a whole.
+func diffList(old, new List) (d *listDiff, ok bool, err error) {
+	kind := kindOfList(new)
+	if kindOfList(old) != kind {
+		return nil, false, nil
+	}
+	d = &listDiff{length: new.length}
+	switch kind {
+	case dataListKind:
+		if old.size != new.size || old.flags&isBitList != new.flags&isBitList {
+			return nil, false, nil
+		}
+		d.words = diffWords(listData(old), listData(new))
+	case pointerListKind:
+		for i := 0; i < new.Len(); i++ {
+			var op Ptr
+			if i < old.Len() {
+				if op, err = PointerList(old).At(i); err != nil {
+					return nil, false, exc.WrapError("list element "+str.Itod(i), err)
+				}
+			}
+			np, err := PointerList(new).At(i)
+			if err != nil {
+				return nil, false, exc.WrapError("list element "+str.Itod(i), err)
+			}
+			pd, changed, err := diffPtr(op, np)
+			if err != nil {
+				return nil, false, exc.WrapError("list element "+str.Itod(i), err)
+			}
+			if changed {
+				pd.index = uint32(i)
+				d.elems = append(d.elems, pd)
+			}
+		}
+	case compositeListKind:
+		d.elemSize = new.size
+		for i := 0; i < new.Len(); i++ {
+			var oe Struct
+			if i < old.Len() {
+				oe = old.Struct(i)
+			}
+			sd, err := diffStruct(oe, new.Struct(i))
+			if err != nil {
+				return nil, false, exc.WrapError("list element "+str.Itod(i), err)
+			}
+			if !sd.empty() {
+				d.elems = append(d.elems, ptrDiff{index: uint32(i), which: patchStruct, s: sd})
+			}
+		}
+	}
+	return d, true, nil
+}
+
+func (d *structDiff) encode(s Struct) error {
+	s.SetUint32(0, uint32(d.size.DataSize))
+	s.SetUint16(4, d.size.PointerCount)
+	if err := encodeWords(s, 0, d.words); err != nil {
+		return err
+	}
+	return encodePtrDiffs(s, 1, d.ptrs)
+}
+
+func (d *listDiff) encode(s Struct) error {
+	s.SetUint32(0, uint32(d.length))
+	s.SetUint16(4, d.elemSize.PointerCount)
+	s.SetUint32(8, uint32(d.elemSize.DataSize))
+	if err := encodeWords(s, 0, d.words); err != nil {
+		return err
+	}
+	return encodePtrDiffs(s, 1, d.elems)
+}
+
+func encodeWords(s Struct, i uint16, words []wordChange) error {
+	if len(words) == 0 {
+		return nil
+	}
+	l, err := NewCompositeList(s.seg, wordChangeSize, int32(len(words)))
+	if err != nil {
+		return err
+	}
+	for j, w := range words {
+		e := l.Struct(j)
+		e.SetUint32(0, w.index)
+		e.SetUint64(8, w.value)
+	}
+	return s.SetPtr(i, l.ToPtr())
+}
+
+func encodePtrDiffs(s Struct, i uint16, ptrs []ptrDiff) error {
+	if len(ptrs) == 0 {
+		return nil
+	}
+	l, err := NewCompositeList(s.seg, pointerPatchSize, int32(len(ptrs)))
+	if err != nil {
+		return err
+	}
+	for j, d := range ptrs {
+		e := l.Struct(j)
+		e.SetUint32(0, d.index)
+		e.SetUint16(4, d.which)
+		var v Ptr
+		switch d.which {
+		case patchSet:
+			v = d.value
+		case patchStruct:
+			ps, err := NewStruct(s.seg, structPatchSize)
+			if err != nil {
+				return err
+			}
+			if err := d.s.encode(ps); err != nil {
+				return err
+			}
+			v = ps.ToPtr()
+		case patchList:
+			ps, err := NewStruct(s.seg, listPatchSize)
+			if err != nil {
+				return err
+			}
+			if err := d.l.encode(ps); err != nil {
+				return err
+			}
+			v = ps.ToPtr()
+		}
+		if err := e.SetPtr(0, v); err != nil {
+			return err
+		}
+	}
+	return s.SetPtr(i, l.ToPtr())
+}
+
+var errPatchMismatch = errors.New("patch does not match base")
+
+// applyWords applies word changes to a data section or data list.
+func applyWords(data []byte, words List) error {
+	for i := 0; i < words.Len(); i++ {
+		w := words.Struct(i)
+		off := int(w.Uint32(0)) * int(wordSize)
+		v := w.Uint64(8)
+		for j := 0; j < int(wordSize); j, v = j+1, v>>8 {
+			if off+j < len(data) {
+				data[off+j] = byte(v)
+			} else if v != 0 {
+				return errPatchMismatch
+			}
+		}
+	}
+	return nil
+}
+
+// copyStructShallow copies src's data section and pointers to dst, a
+// struct in the same message, without copying the objects that the
+// pointers refer to.
+func copyStructShallow(dst, src Struct) error {
+	copy(structData(dst), structData(src))
+	for i := uint16(0); i < src.size.PointerCount && i < dst.size.PointerCount; i++ {
+		p, err := src.Ptr(i)
+		if err != nil {
+			return err
+		}
+		if err := dst.SetPtr(i, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyStructPatch(base, p Struct) (Struct, error) {
+	size := ObjectSize{DataSize: Size(p.Uint32(0)), PointerCount: p.Uint16(4)}
+	if size.DataSize > base.size.DataSize || size.PointerCount > base.size.PointerCount {
+		if base.flags&isListMember != 0 {
+			return Struct{}, errPatchMismatch
+		}
+		if base.size.DataSize > size.DataSize {
+			size.DataSize = base.size.DataSize
+		}
+		if base.size.PointerCount > size.PointerCount {
+			size.PointerCount = base.size.PointerCount
+		}
+		grown, err := NewStruct(base.seg, size)
+		if err != nil {
+			return Struct{}, err
+		}
+		if err := copyStructShallow(grown, base); err != nil {
+			return Struct{}, err
+		}
+		base = grown
+	}
+	words, err := p.Ptr(0)
+	if err != nil {
+		return Struct{}, err
+	}
+	if err := applyWords(structData(base), words.List()); err != nil {
+		return Struct{}, err
+	}
+	ptrs, err := p.Ptr(1)
+	if err != nil {
+		return Struct{}, err
+	}
+	pl := ptrs.List()
+	for i := 0; i < pl.Len(); i++ {
+		pp := pl.Struct(i)
+		idx := pp.Uint32(0)
+		if idx >= uint32(base.size.PointerCount) {
+			return Struct{}, errPatchMismatch
+		}
+		old, err := base.Ptr(uint16(idx))
+		if err != nil {
+			return Struct{}, exc.WrapError("struct pointer "+str.Utod(idx), err)
+		}
+		v, err := applyPtrPatch(old, pp)
+		if err != nil {
+			return Struct{}, exc.WrapError("struct pointer "+str.Utod(idx), err)
+		}
+		if err := base.SetPtr(uint16(idx), v); err != nil {
+			return Struct{}, exc.WrapError("struct pointer "+str.Utod(idx), err)
+		}
+	}
+	return base, nil
+}
+
+func applyPtrPatch(old Ptr, pp Struct) (Ptr, error) {
+	v, err := pp.Ptr(0)
+	if err != nil {
+		return Ptr{}, err
+	}
+	switch pp.Uint16(4) {
+	case patchSet:
+		return v, nil
+	case patchStruct:
+		if !old.IsValid() || old.flags.ptrType() != structPtrType {
+			return Ptr{}, errPatchMismatch
+		}
+		s, err := applyStructPatch(old.Struct(), v.Struct())
+		return s.ToPtr(), err
+	case patchList:
+		if !old.IsValid() || old.flags.ptrType() != listPtrType {
+			return Ptr{}, errPatchMismatch
+		}
+		l, err := applyListPatch(old.List(), v.Struct())
+		return l.ToPtr(), err
+	default:
+		return Ptr{}, errors.New("unknown pointer patch type")
+	}
+}
+
+func applyListPatch(base List, p Struct) (List, error) {
+	length := int32(p.Uint32(0))
+	words, err := p.Ptr(0)
+	if err != nil {
+		return List{}, err
+	}
+	elems, err := p.Ptr(1)
+	if err != nil {
+		return List{}, err
+	}
+	switch kindOfList(base) {
+	case dataListKind:
+		if length != base.length {
+			var l List
+			if base.flags&isBitList != 0 {
+				bl, err := NewBitList(base.seg, length)
+				if err != nil {
+					return List{}, err
+				}
+				l = List(bl)
+			} else if l, err = newPrimitiveList(base.seg, base.size.DataSize, length); err != nil {
+				return List{}, err
+			}
+			copy(listData(l), listData(base))
+			base = l
+		}
+		if err := applyWords(listData(base), words.List()); err != nil {
+			return List{}, err
+		}
+		return base, nil
+	case pointerListKind:
+		if length != base.length {
+			l, err := NewPointerList(base.seg, length)
+			if err != nil {
+				return List{}, err
+			}
+			for i := 0; i < l.Len() && i < base.Len(); i++ {
+				v, err := PointerList(base).At(i)
+				if err != nil {
+					return List{}, exc.WrapError("list element "+str.Itod(i), err)
+				}
+				if err := l.Set(i, v); err != nil {
+					return List{}, exc.WrapError("list element "+str.Itod(i), err)
+				}
+			}
+			base = List(l)
+		}
+		el := elems.List()
+		for i := 0; i < el.Len(); i++ {
+			pp := el.Struct(i)
+			idx := int(pp.Uint32(0))
+			if idx >= base.Len() {
+				return List{}, errPatchMismatch
+			}
+			old, err := PointerList(base).At(idx)
+			if err != nil {
+				return List{}, exc.WrapError("list element "+str.Itod(idx), err)
+			}
+			v, err := applyPtrPatch(old, pp)
+			if err != nil {
+				return List{}, exc.WrapError("list element "+str.Itod(idx), err)
+			}
+			if err := PointerList(base).Set(idx, v); err != nil {
+				return List{}, exc.WrapError("list element "+str.Itod(idx), err)
+			}
+		}
+		return base, nil
+	default:
+		size := ObjectSize{DataSize: Size(p.Uint32(8)), PointerCount: p.Uint16(4)}
+		if length != base.length || size.DataSize > base.size.DataSize || size.PointerCount > base.size.PointerCount {
+			if base.size.DataSize > size.DataSize {
+				size.DataSize = base.size.DataSize
+			}
+			if base.size.PointerCount > size.PointerCount {
+				size.PointerCount = base.size.PointerCount
+			}
+			l, err := NewCompositeList(base.seg, size, length)
+			if err != nil {
+				return List{}, err
+			}
+			for i := 0; i < l.Len() && i < base.Len(); i++ {
+				if err := copyStructShallow(l.Struct(i), base.Struct(i)); err != nil {
+					return List{}, exc.WrapError("list element "+str.Itod(i), err)
+				}
+			}
+			base = l
+		}
+		el := elems.List()
+		for i := 0; i < el.Len(); i++ {
+			pp := el.Struct(i)
+			idx := int(pp.Uint32(0))
+			if idx >= base.Len() || pp.Uint16(4) != patchStruct {
+				return List{}, errPatchMismatch
+			}
+			sp, err := pp.Ptr(0)
+			if err != nil {
+				return List{}, exc.WrapError("list element "+str.Itod(idx), err)
+			}
+			if _, err := applyStructPatch(base.Struct(idx), sp.Struct()); err != nil {
+				return List{}, exc.WrapError("list element "+str.Itod(idx), err)
+			}
+		}
+		return base, nil
+	}
+}
@@ -0,0 +1,210 @@
+package capnp_test
+
+import (
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+)
+
+// clonePtr returns a copy of p in a new message.
+func clonePtr(t *testing.T, p capnp.Ptr) *capnp.Message {
+	t.Helper()
+	msg, _, err := capnp.NewMessage(capnp.MultiSegment(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msg.SetRoot(p); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// checkPatch creates a patch from old to new, applies it to a copy of
+// old and checks that the result equals new.
+func checkPatch(t *testing.T, old, new capnp.Struct) *capnp.Message {
+	t.Helper()
+	patch, err := capnp.CreatePatch(old, new)
+	if err != nil {
+		t.Fatal("CreatePatch:", err)
+	}
+	data, err := patch.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err = capnp.Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := clonePtr(t, old.ToPtr())
+	root, err := base.Root()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := capnp.ApplyPatch(root.Struct(), patch)
+	if err != nil {
+		t.Fatal("ApplyPatch:", err)
+	}
+	if ok, err := capnp.Equal(got.ToPtr(), new.ToPtr()); err != nil {
+		t.Fatal("Equal:", err)
+	} else if !ok {
+		t.Error("patched struct does not equal new struct")
+	}
+	return patch
+}
+
+func newPlaneBase(t *testing.T, name string, homes ...air.Airport) air.PlaneBase {
+	t.Helper()
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pb, err := air.NewRootPlaneBase(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pb.SetName(name); err != nil {
+		t.Fatal(err)
+	}
+	hl, err := pb.NewHomes(int32(len(homes)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, h := range homes {
+		hl.Set(i, h)
+	}
+	pb.SetRating(100)
+	pb.SetCapacity(200)
+	pb.SetMaxSpeed(850.5)
+	return pb
+}
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Unchanged", func(t *testing.T) {
+		t.Parallel()
+		old := newPlaneBase(t, "Lucky", air.Airport_jfk, air.Airport_lax)
+		new := newPlaneBase(t, "Lucky", air.Airport_jfk, air.Airport_lax)
+		patch := checkPatch(t, capnp.Struct(old), capnp.Struct(new))
+		root, err := patch.Root()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := uint16(0); i < 2; i++ {
+			if root.Struct().HasPtr(i) {
+				t.Errorf("patch of equal structs has changes in pointer %d", i)
+			}
+		}
+	})
+	t.Run("Fields", func(t *testing.T) {
+		t.Parallel()
+		old := newPlaneBase(t, "Lucky", air.Airport_jfk, air.Airport_lax)
+		new := newPlaneBase(t, "Lucky Lady", air.Airport_jfk, air.Airport_sfo, air.Airport_luv)
+		new.SetCanFly(true)
+		new.SetRating(101)
+		checkPatch(t, capnp.Struct(old), capnp.Struct(new))
+	})
+	t.Run("ClearFields", func(t *testing.T) {
+		t.Parallel()
+		old := newPlaneBase(t, "Lucky", air.Airport_jfk)
+		new := newPlaneBase(t, "")
+		new.SetRating(0)
+		checkPatch(t, capnp.Struct(old), capnp.Struct(new))
+	})
+	t.Run("SmallPatch", func(t *testing.T) {
+		t.Parallel()
+		old := zdateFilledMessage(t, 1000)
+		new := zdateFilledMessage(t, 1000)
+		oldRoot, _ := old.Root()
+		newRoot, _ := new.Root()
+		z, _ := air.ReadRootZ(new)
+		dates, _ := z.Zdatevec()
+		dates.At(500).SetDay(8)
+		patch := checkPatch(t, oldRoot.Struct(), newRoot.Struct())
+		patchSize, _ := patch.TotalSize()
+		newSize, _ := new.TotalSize()
+		if patchSize*10 > newSize {
+			t.Errorf("patch is %d bytes; want much smaller than the %d byte message", patchSize, newSize)
+		}
+	})
+	t.Run("CompositeList", func(t *testing.T) {
+		t.Parallel()
+		old := zdateFilledMessage(t, 3)
+		new := zdateFilledMessage(t, 5)
+		z, _ := air.ReadRootZ(new)
+		dates, _ := z.Zdatevec()
+		dates.At(1).SetYear(1999)
+		oldRoot, _ := old.Root()
+		newRoot, _ := new.Root()
+		checkPatch(t, oldRoot.Struct(), newRoot.Struct())
+		checkPatch(t, newRoot.Struct(), oldRoot.Struct())
+	})
+	t.Run("TextList", func(t *testing.T) {
+		t.Parallel()
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		old, _ := air.NewRootNester1Capn(seg)
+		initNester(t, old, "a", "b", "c")
+		_, seg, _ = capnp.NewMessage(capnp.SingleSegment(nil))
+		new, _ := air.NewRootNester1Capn(seg)
+		initNester(t, new, "a", "bee")
+		checkPatch(t, capnp.Struct(old), capnp.Struct(new))
+	})
+	t.Run("Grow", func(t *testing.T) {
+		t.Parallel()
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		old, _ := air.NewRootVerOneData(seg)
+		old.SetVal(1)
+		_, seg, _ = capnp.NewMessage(capnp.SingleSegment(nil))
+		new, _ := air.NewRootVerTwoData(seg)
+		new.SetVal(2)
+		new.SetDuo(3)
+		checkPatch(t, capnp.Struct(old), capnp.Struct(new))
+	})
+	t.Run("GrowListElements", func(t *testing.T) {
+		t.Parallel()
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		old, _ := air.NewRootHoldsVerOneDataList(seg)
+		ol, _ := old.NewMylist(2)
+		ol.At(0).SetVal(1)
+		ol.At(1).SetVal(2)
+		_, seg, _ = capnp.NewMessage(capnp.SingleSegment(nil))
+		new, _ := air.NewRootHoldsVerTwoDataList(seg)
+		nl, _ := new.NewMylist(2)
+		nl.At(0).SetVal(1)
+		nl.At(1).SetVal(2)
+		nl.At(1).SetDuo(20)
+		checkPatch(t, capnp.Struct(old), capnp.Struct(new))
+	})
+	t.Run("NullBase", func(t *testing.T) {
+		t.Parallel()
+		new := newPlaneBase(t, "Lucky", air.Airport_jfk)
+		patch, err := capnp.CreatePatch(capnp.Struct{}, capnp.Struct(new))
+		if err != nil {
+			t.Fatal("CreatePatch:", err)
+		}
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		base, _ := capnp.NewRootStruct(seg, capnp.ObjectSize{})
+		got, err := capnp.ApplyPatch(base, patch)
+		if err != nil {
+			t.Fatal("ApplyPatch:", err)
+		}
+		if ok, _ := capnp.Equal(got.ToPtr(), new.ToPtr()); !ok {
+			t.Error("patched struct does not equal new struct")
+		}
+	})
+	t.Run("Mismatch", func(t *testing.T) {
+		t.Parallel()
+		old := newPlaneBase(t, "Lucky", air.Airport_jfk)
+		new := newPlaneBase(t, "Lucky", air.Airport_lax)
+		patch, err := capnp.CreatePatch(capnp.Struct(old), capnp.Struct(new))
+		if err != nil {
+			t.Fatal("CreatePatch:", err)
+		}
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		base, _ := air.NewRootPlaneBase(seg)
+		if _, err := capnp.ApplyPatch(capnp.Struct(base), patch); err == nil {
+			t.Error("ApplyPatch to a base without the patched list succeeded; want error")
+		}
+	})
+}
@@ -212,18 +212,6 @@ func (Ptr) DecodeFromPtr(p Ptr) Ptr { return p }
 
 var _ TypeParam[Ptr] = Ptr{}
 
-func unmarshalDefault(def []byte) (Ptr, error) {
-	msg, err := Unmarshal(def)
-	if err != nil {
-		return Ptr{}, exc.WrapError("read default", err)
-	}
-	p, err := msg.Root()
-	if err != nil {
-		return Ptr{}, exc.WrapError("read default", err)
-	}
-	return p, nil
-}
-
 type ptrFlags uint8
 
 const interfacePtrFlag ptrFlags = interfacePtrType << 6
@@ -265,6 +253,54 @@ func isZeroFilled(b []byte) bool {
 	return true
 }
 
+// DeepCopy copies the object that src points to, and everything it
+// points to in turn, into dst's message, preferring placement in dst,
+// and returns a pointer to the copy.  The copy is not referenced from
+// anywhere in dst's message until the returned pointer is stored in a
+// struct or list, or set as the message's root.
+//
+// Capabilities are copied by reference: the copy of an interface
+// pointer refers to the same capability as src.  If src is in a
+// different message than dst, the capability is added to dst's
+// message's capability table with a new reference.
+func DeepCopy(dst *Segment, src Ptr) (Ptr, error) {
+	if !src.IsValid() {
+		return Ptr{}, nil
+	}
+	switch src.flags.ptrType() {
+	case structPtrType:
+		st := src.Struct()
+		if st.size.isZero() {
+			st, err := NewStruct(dst, ObjectSize{})
+			if err != nil {
+				return Ptr{}, exc.WrapError("deep copy", err)
+			}
+			return st.ToPtr(), nil
+		}
+		st, err := dst.copyStruct(st)
+		if err != nil {
+			return Ptr{}, exc.WrapError("deep copy", err)
+		}
+		return st.ToPtr(), nil
+	case listPtrType:
+		l, err := dst.copyList(src.List())
+		if err != nil {
+			return Ptr{}, exc.WrapError("deep copy", err)
+		}
+		return l.ToPtr(), nil
+	case interfacePtrType:
+		i := src.Interface()
+		if src.seg.msg != dst.msg {
+			i = NewInterface(dst, dst.msg.CapTable().Add(i.Client().AddRef()))
+		} else {
+			i = NewInterface(dst, i.Capability())
+		}
+		return i.ToPtr(), nil
+	default:
+		panic("unreachable")
+	}
+}
+
 // Equal returns true iff p1 and p2 are equal.
 //
 // Equality is defined to be:
@@ -163,3 +163,83 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestDeepCopy(t *testing.T) {
+	srcMsg, srcSeg, _ := NewMessage(SingleSegment(nil))
+	hook := new(dummyHook)
+	capID := srcMsg.CapTable().Add(NewClient(hook))
+	src, _ := NewRootStruct(srcSeg, ObjectSize{DataSize: 8, PointerCount: 3})
+	src.SetUint64(0, 42)
+	src.SetText(0, "hello")
+	list, _ := NewCompositeList(srcSeg, ObjectSize{DataSize: 8}, 2)
+	list.Struct(0).SetUint64(0, 1)
+	list.Struct(1).SetUint64(0, 2)
+	src.SetPtr(1, list.ToPtr())
+	src.SetPtr(2, NewInterface(srcSeg, capID).ToPtr())
+
+	t.Run("OtherMessage", func(t *testing.T) {
+		dstMsg, dstSeg, _ := NewMessage(SingleSegment(nil))
+		defer dstMsg.Release()
+		p, err := DeepCopy(dstSeg, src.ToPtr())
+		if err != nil {
+			t.Fatal("DeepCopy:", err)
+		}
+		if p.Segment().Message() != dstMsg {
+			t.Error("copy is not in the destination message")
+		}
+		if eq, err := Equal(src.ToPtr(), p); err != nil || !eq {
+			t.Errorf("Equal(src, copy) = %t, %v; want true, <nil>", eq, err)
+		}
+		if n := dstMsg.CapTable().Len(); n != 1 {
+			t.Fatalf("destination capability table has %d entries; want 1", n)
+		}
+		iface, _ := p.Struct().Ptr(2)
+		if c := iface.Interface().Client(); !c.IsSame(srcMsg.CapTable().At(int(capID))) {
+			t.Error("copied capability does not refer to the original")
+		}
+	})
+	t.Run("SameMessage", func(t *testing.T) {
+		p, err := DeepCopy(srcSeg, src.ToPtr())
+		if err != nil {
+			t.Fatal("DeepCopy:", err)
+		}
+		c := p.Struct()
+		cl, _ := c.Ptr(1)
+		cl.List().Struct(0).SetUint64(0, 100)
+		if got := list.Struct(0).Uint64(0); got != 1 {
+			t.Errorf("modifying the copy changed the original list to %d", got)
+		}
+		iface, _ := c.Ptr(2)
+		if got := iface.Interface().Capability(); got != capID {
+			t.Errorf("copied capability ID = %d; want %d", got, capID)
+		}
+		if n := srcMsg.CapTable().Len(); n != 1 {
+			t.Errorf("capability table has %d entries; want 1", n)
+		}
+	})
+	t.Run("Clone", func(t *testing.T) {
+		_, dstSeg, _ := NewMessage(SingleSegment(nil))
+		cl, err := list.Clone(dstSeg)
+		if err != nil {
+			t.Fatal("List.Clone:", err)
+		}
+		if cl.Len() != 2 || cl.Struct(1).Uint64(0) != 2 {
+			t.Errorf("cloned list = %d elements, second %d; want 2 elements, second 2", cl.Len(), cl.Struct(1).Uint64(0))
+		}
+		st, err := list.Struct(1).Clone(dstSeg)
+		if err != nil {
+			t.Fatal("Struct.Clone:", err)
+		}
+		if st.Uint64(0) != 2 {
+			t.Errorf("cloned list element = %d; want 2", st.Uint64(0))
+		}
+		if st.flags&isListMember != 0 {
+			t.Error("cloned list element is still flagged as a list member")
+		}
+		empty, err := Struct{}.Clone(dstSeg)
+		if err != nil || empty.IsValid() {
+			t.Errorf("Struct{}.Clone = %v, %v; want invalid struct, <nil>", empty, err)
+		}
+	})
+	srcMsg.Release()
+}
@@ -0,0 +1,97 @@
+package capnp
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// ErrRevoked is the cause of the errors returned by calls on a revoked
+// capability.  Use errors.Is to detect it.
+var ErrRevoked = errors.New("capability revoked")
+
+// A RevokeFunc revokes a capability returned by NewRevocableClient.
+// Calling it more than once has no further effect.
+type RevokeFunc func()
+
+// NewRevocableClient returns a client that forwards calls to c until
+// revoke is called.  After that, calls made on the returned client
+// fail with a Failed exception wrapping ErrRevoked, and c is released.
+// Calls that were delivered before revocation are not interrupted.
+//
+// NewRevocableClient takes ownership of c.  Handing out the returned
+// client instead of c allows its authority to be withdrawn later, for
+// example to grant access for a limited time.
+func NewRevocableClient(c Client) (Client, RevokeFunc) {
+	h := &revocableHook{client: c}
+	return NewClient(h), h.revoke
+}
+
+type revocableHook struct {
+	mu      sync.Mutex
+	client  Client // zero once revoked or shut down
+	revoked bool
+}
+
+// revokedError is returned by calls on a revoked capability.
+var revokedError = &exc.Exception{
+	Type:   exc.Failed,
+	Prefix: "capnp",
+	Cause:  ErrRevoked,
+}
+
+// acquire returns a new reference to the underlying client, or an
+// error if the capability has been revoked.
+func (h *revocableHook) acquire() (Client, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.revoked {
+		return Client{}, revokedError
+	}
+	return h.client.AddRef(), nil
+}
+
+func (h *revocableHook) revoke() {
+	h.mu.Lock()
+	c := h.client
+	h.client = Client{}
+	h.revoked = true
+	h.mu.Unlock()
+	c.Release()
+}
+
+func (h *revocableHook) Send(ctx context.Context, s Send) (*Answer, ReleaseFunc) {
+	c, err := h.acquire()
+	if err != nil {
+		return ErrorAnswer(s.Method, err), func() {}
+	}
+	defer c.Release()
+	return c.SendCall(ctx, s)
+}
+
+func (h *revocableHook) Recv(ctx context.Context, r Recv) PipelineCaller {
+	c, err := h.acquire()
+	if err != nil {
+		r.Reject(err)
+		return nil
+	}
+	defer c.Release()
+	return c.RecvCall(ctx, r)
+}
+
+// Brand returns a brand that does not expose the underlying client,
+// so that the RPC system cannot bypass revocation by unwrapping it.
+func (h *revocableHook) Brand() Brand {
+	return Brand{Value: h}
+}
+
+func (h *revocableHook) Shutdown() {
+	h.revoke()
+}
+
+func (h *revocableHook) String() string {
+	return "revocableHook{0x" + str.PtrToHex(h) + "}"
+}
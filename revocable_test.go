@@ -0,0 +1,52 @@
+package capnp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+func TestRevocableClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	h := &dummyHook{}
+	inner := NewClient(h)
+	c, revoke := NewRevocableClient(inner.AddRef())
+	defer c.Release()
+	assert.False(t, c.IsSame(inner), "brand should not expose the underlying client")
+	inner.Release()
+
+	ans, release := c.SendCall(ctx, Send{})
+	_, err := ans.Struct()
+	release()
+	require.NoError(t, err)
+	assert.Equal(t, 1, h.calls)
+
+	revoke()
+	assert.Equal(t, 1, h.shutdowns, "underlying client should be released on revocation")
+	ans, release = c.SendCall(ctx, Send{})
+	_, err = ans.Struct()
+	release()
+	assert.ErrorIs(t, err, ErrRevoked)
+	assert.Equal(t, exc.Failed, exc.TypeOf(err))
+	assert.Equal(t, 1, h.calls, "call should not reach the revoked client")
+
+	revoke()
+	assert.Equal(t, 1, h.shutdowns, "revoking twice should be harmless")
+}
+
+func TestRevocableClientRelease(t *testing.T) {
+	t.Parallel()
+
+	h := &dummyHook{}
+	c, revoke := NewRevocableClient(NewClient(h))
+	c.Release()
+	assert.Equal(t, 1, h.shutdowns)
+	revoke()
+	assert.Equal(t, 1, h.shutdowns)
+}
@@ -49,6 +49,10 @@ type ansent struct {
 	// May be nil.
 	cancel context.CancelFunc
 
+	// method is the method that was called, or the zero Method for
+	// bootstrap answers.
+	method capnp.Method
+
 	// Unlike other fields in this struct, it is ok to hand out pointers
 	// to this that can be used while not holding the connection lock.
 	returner ansReturner
@@ -119,7 +123,10 @@ func errorAnswer(c *Conn, id answerID, err error) *ansent {
 // newReturn creates a new Return message. The returned Releaser will release the message when
 // all references to it are dropped; the caller is responsible for one reference. This will not
 // happen before the message is sent, as the returned send function retains a reference.
-func (c *Conn) newReturn() (_ rpccp.Return, sendMsg func(), _ *rc.Releaser, _ error) {
+//
+// m is the method being returned from, which is only used to report
+// the size of the message.
+func (c *Conn) newReturn(m capnp.Method) (_ rpccp.Return, sendMsg func(), _ *rc.Releaser, _ error) {
 	outMsg, err := c.transport.NewMessage()
 	if err != nil {
 		return rpccp.Return{}, nil, nil, rpcerr.WrapFailed("create return", err)
@@ -136,15 +143,27 @@ func (c *Conn) newReturn() (_ rpccp.Return, sendMsg func(), _ *rc.Releaser, _ er
 	// 'releaseMsg' is called.
 	releaser := rc.NewReleaser(2, outMsg.Release)
 
+	var check func() error
+	if c.sizes != nil {
+		check = func() error {
+			c.sizes.ret(m, messageSize(outMsg.Message()), true)
+			return nil
+		}
+	}
+
 	return ret, func() {
 		c.lk.sendTx.Send(asyncSend{
-			send:    outMsg.Send,
+			msgs: []queuedMessage{{
+				msg:   outMsg,
+				check: check,
+				onSent: func(err error) {
+					if err != nil {
+						c.er.ReportError(exc.WrapError("send return", err),
+							"answerID", ret.AnswerId(), "method", debugMethod(m))
+					}
+				},
+			}},
 			release: releaser.Decr,
-			onSent: func(err error) {
-				if err != nil {
-					c.er.ReportError(exc.WrapError("send return", err))
-				}
-			},
 		})
 	}, releaser, nil
 }
@@ -224,6 +243,7 @@ func (ans *ansReturner) Return() {
 	ans.c.withLocked(func(c *lockedConn) {
 		ent := c.lk.answers[ans.id]
 		pcallsWait = ent.pcalls.Wait
+		c.lk.runningAnswers--
 
 		if ent.err == nil {
 			err = ent.completeSendReturn(dq)
@@ -285,6 +305,7 @@ func (ans *ansent) prepareSendReturn(dq *deferred.Queue) {
 	select {
 	case <-c.bgctx.Done():
 		// We're not going to send the message after all, so don't forget to release it.
+		c.er.Debug("dropping return: connection is shut down", "answerID", ans.returner.id)
 		dq.Defer(ans.returner.msgReleaser.Decr)
 		ans.sendMsg = nil
 	default:
@@ -335,6 +356,8 @@ func (ans *ansent) prepareSendException(dq *deferred.Queue, ex error) {
 	c := ans.lockedConn()
 	select {
 	case <-c.bgctx.Done():
+		c.er.Debug("dropping exception return: connection is shut down",
+			"answerID", ans.returner.id, "error", ex)
 	default:
 		// Send exception.
 		if e, err := ans.returner.ret.NewException(); err != nil {
@@ -376,6 +399,7 @@ func (ans *ansent) destroy(dq *deferred.Queue) error {
 	dq.Defer(ans.returner.msgReleaser.Decr)
 	c := ans.lockedConn()
 	delete(c.lk.answers, ans.returner.id)
+	c.checkDrained()
 	for _, s := range ans.returner.resultsCapTable {
 		dq.Defer(s.Release)
 	}
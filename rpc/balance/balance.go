@@ -0,0 +1,411 @@
+// Package balance spreads calls across several connections to replicas
+// of the same service.
+//
+// A Balancer holds a pool of connections, called backends, and its
+// Client sends each call to the bootstrap capability of one of them,
+// chosen by the balancer's Strategy.  Backends whose connections shut
+// down, or that stop answering pings, are removed from the pool:
+//
+//	b := balance.New(&balance.Options{Strategy: balance.LeastOutstanding})
+//	defer b.Close()
+//	for _, addr := range replicas {
+//		conn, err := dial(addr)
+//		...
+//		b.Add(conn)
+//	}
+//	users := Users(b.Client())
+//	defer users.Release()
+//
+// Each call is sent to a single backend and is not retried elsewhere
+// if it fails; combine the balancer with a retry.Policy for that.
+// Capabilities returned by a call belong to the backend that returned
+// them, so calls on them are not balanced.
+package balance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/exp/clock"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/rpc"
+)
+
+// Default health checking parameters, used when the corresponding
+// Options field is zero.
+const (
+	DefaultHealthInterval = 5 * time.Second
+	DefaultHealthTimeout  = 2 * time.Second
+)
+
+// ErrNoBackends is the cause of the errors returned by calls made on a
+// balancer with no backends.  Use errors.Is to detect it.
+var ErrNoBackends = errors.New("no backends")
+
+// noBackendsError is returned by calls made on an empty balancer.  It
+// is a disconnected exception, so retry policies treat it as
+// transient.
+var noBackendsError = &exc.Exception{
+	Type:   exc.Disconnected,
+	Prefix: "balance",
+	Cause:  ErrNoBackends,
+}
+
+// A Strategy chooses the backend that each call is sent to.
+type Strategy int
+
+const (
+	// RoundRobin sends calls to each backend in turn.
+	RoundRobin Strategy = iota
+
+	// LeastOutstanding sends each call to the backend with the fewest
+	// calls that have not yet returned, taking backends in turn when
+	// several have the same number.
+	LeastOutstanding
+)
+
+// Options configures a Balancer.  The zero value balances round robin
+// and pings each backend every DefaultHealthInterval.
+type Options struct {
+	// Strategy chooses the backend for each call.
+	Strategy Strategy
+
+	// HealthInterval is the time between pings of each backend.  A
+	// backend that does not answer a ping is removed.  A negative
+	// value disables pings, so that backends are only removed when
+	// their connections shut down.
+	HealthInterval time.Duration
+
+	// HealthTimeout bounds the time to wait for each ping.
+	HealthTimeout time.Duration
+
+	// Clock is used to schedule pings.  If nil, the system clock is
+	// used.
+	Clock clock.Clock
+
+	// OnRemove, if not nil, is called after a backend is removed
+	// because its connection shut down or a ping failed, with the
+	// reason.  It is not called for backends removed with Remove or
+	// Close.
+	OnRemove func(conn *rpc.Conn, err error)
+}
+
+// A Balancer sends calls to a pool of connections.  It is safe to use
+// from multiple goroutines.
+type Balancer struct {
+	opts Options
+
+	mu       sync.Mutex
+	backends []*backend
+	next     int // index of the next backend to try
+	closed   bool
+
+	stop chan struct{} // closed by Close
+	wg   sync.WaitGroup
+}
+
+type backend struct {
+	conn        *rpc.Conn
+	boot        capnp.Client
+	outstanding atomic.Int64
+	removed     chan struct{} // closed when removed from the pool
+}
+
+// New returns an empty balancer.  If opts is nil, the defaults are
+// used.  Close must be called to stop health checking.
+func New(opts *Options) *Balancer {
+	b := &Balancer{stop: make(chan struct{})}
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.HealthInterval == 0 {
+		b.opts.HealthInterval = DefaultHealthInterval
+	}
+	if b.opts.HealthTimeout <= 0 {
+		b.opts.HealthTimeout = DefaultHealthTimeout
+	}
+	if b.opts.Clock == nil {
+		b.opts.Clock = clock.System
+	}
+	if b.opts.HealthInterval > 0 {
+		b.wg.Add(1)
+		go b.checkHealth()
+	}
+	return b
+}
+
+// Add adds conn to the pool, sending calls to its bootstrap
+// capability.  The balancer takes ownership of conn, and closes it
+// when it is removed.  Adding a connection to a closed balancer closes
+// the connection.
+func (b *Balancer) Add(conn *rpc.Conn) {
+	be := &backend{
+		conn:    conn,
+		boot:    conn.Bootstrap(context.Background()),
+		removed: make(chan struct{}),
+	}
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		be.boot.Release()
+		conn.Close()
+		return
+	}
+	b.backends = append(b.backends, be)
+	b.wg.Add(1)
+	b.mu.Unlock()
+
+	go func() {
+		defer b.wg.Done()
+		select {
+		case <-conn.Done():
+			b.drop(be, rpc.ExcClosed)
+		case <-be.removed:
+		}
+	}()
+}
+
+// Remove removes conn from the pool and closes it.  Calls already sent
+// to it are not interrupted.  Remove reports whether conn was in the
+// pool.
+func (b *Balancer) Remove(conn *rpc.Conn) bool {
+	b.mu.Lock()
+	for _, be := range b.backends {
+		if be.conn == conn {
+			b.removeLocked(be)
+			b.mu.Unlock()
+			be.close()
+			return true
+		}
+	}
+	b.mu.Unlock()
+	return false
+}
+
+// Conns returns the connections in the pool.
+func (b *Balancer) Conns() []*rpc.Conn {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	conns := make([]*rpc.Conn, len(b.backends))
+	for i, be := range b.backends {
+		conns[i] = be.conn
+	}
+	return conns
+}
+
+// Outstanding returns the number of calls sent to conn that have not
+// yet returned, or zero if conn is not in the pool.
+func (b *Balancer) Outstanding(conn *rpc.Conn) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, be := range b.backends {
+		if be.conn == conn {
+			return int(be.outstanding.Load())
+		}
+	}
+	return 0
+}
+
+// Client returns a capability that sends each call to the bootstrap
+// capability of one of the balancer's backends.  Calls fail with a
+// disconnected exception wrapping ErrNoBackends while the pool is
+// empty.  The client stays usable after it is released by others, but
+// it fails calls once the balancer is closed.
+func (b *Balancer) Client() capnp.Client {
+	return capnp.NewClient(&hook{b: b})
+}
+
+// Close stops health checking, and removes and closes every backend.
+func (b *Balancer) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	backends := b.backends
+	b.backends = nil
+	for _, be := range backends {
+		close(be.removed)
+	}
+	close(b.stop)
+	b.mu.Unlock()
+
+	for _, be := range backends {
+		be.close()
+	}
+	b.wg.Wait()
+	return nil
+}
+
+// pick returns the backend for the next call with its outstanding
+// count incremented, and a new reference to its bootstrap capability.
+// It returns nil if the pool is empty.
+func (b *Balancer) pick() (*backend, capnp.Client) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := len(b.backends)
+	if n == 0 {
+		return nil, capnp.Client{}
+	}
+	i := b.next % n
+	if b.opts.Strategy == LeastOutstanding {
+		least := b.backends[i].outstanding.Load()
+		for j := 1; j < n && least > 0; j++ {
+			k := (b.next + j) % n
+			if o := b.backends[k].outstanding.Load(); o < least {
+				i, least = k, o
+			}
+		}
+	}
+	b.next = i + 1
+	be := b.backends[i]
+	be.outstanding.Add(1)
+	return be, be.boot.AddRef()
+}
+
+// drop removes be from the pool because it failed, unless it was
+// already removed.
+func (b *Balancer) drop(be *backend, err error) {
+	b.mu.Lock()
+	ok := b.removeLocked(be)
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+	be.close()
+	if b.opts.OnRemove != nil {
+		b.opts.OnRemove(be.conn, err)
+	}
+}
+
+// removeLocked removes be from the pool, reporting whether it was in
+// it.  The caller must hold b.mu, and must close be if it returns true.
+func (b *Balancer) removeLocked(be *backend) bool {
+	for i, x := range b.backends {
+		if x == be {
+			b.backends = append(b.backends[:i], b.backends[i+1:]...)
+			close(be.removed)
+			if b.next > i {
+				b.next--
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (be *backend) close() {
+	be.boot.Release()
+	be.conn.Close()
+}
+
+// checkHealth pings every backend each health interval until the
+// balancer is closed.
+func (b *Balancer) checkHealth() {
+	defer b.wg.Done()
+	t := b.opts.Clock.NewTimer(b.opts.HealthInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.Chan():
+		case <-b.stop:
+			return
+		}
+		b.mu.Lock()
+		backends := append([]*backend(nil), b.backends...)
+		b.mu.Unlock()
+
+		var wg sync.WaitGroup
+		for _, be := range backends {
+			wg.Add(1)
+			go func(be *backend) {
+				defer wg.Done()
+				if err := b.ping(be); err != nil {
+					b.drop(be, err)
+				}
+			}(be)
+		}
+		wg.Wait()
+		t.Reset(b.opts.HealthInterval)
+	}
+}
+
+func (b *Balancer) ping(be *backend) error {
+	ctx, cancel := context.WithTimeout(context.Background(), b.opts.HealthTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-b.stop:
+			cancel()
+		case <-be.removed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	_, err := be.conn.Ping(ctx)
+	return err
+}
+
+// hook is the ClientHook of the balancer's clients.
+type hook struct {
+	b *Balancer
+}
+
+func (h *hook) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	be, c := h.b.pick()
+	if be == nil {
+		return capnp.ErrorAnswer(s.Method, noBackendsError), func() {}
+	}
+	defer c.Release()
+	ans, release := c.SendCall(ctx, s)
+	go func() {
+		<-ans.Done()
+		be.outstanding.Add(-1)
+	}()
+	return ans, release
+}
+
+func (h *hook) Recv(ctx context.Context, r capnp.Recv) capnp.PipelineCaller {
+	be, c := h.b.pick()
+	if be == nil {
+		r.Reject(noBackendsError)
+		return nil
+	}
+	defer c.Release()
+	r.Returner = &doneReturner{
+		Returner: r.Returner,
+		done:     func() { be.outstanding.Add(-1) },
+	}
+	return c.RecvCall(ctx, r)
+}
+
+// Brand returns a brand that does not expose the backends, so that the
+// RPC system sends calls through the balancer rather than to the
+// backend that a call happens to be forwarded to.
+func (h *hook) Brand() capnp.Brand {
+	return capnp.Brand{Value: h}
+}
+
+func (h *hook) Shutdown() {}
+
+func (h *hook) String() string {
+	return "balance.hook{0x" + str.PtrToHex(h) + "}"
+}
+
+// doneReturner calls done after the call returns.
+type doneReturner struct {
+	capnp.Returner
+	once sync.Once
+	done func()
+}
+
+func (r *doneReturner) Return() {
+	r.Returner.Return()
+	r.once.Do(r.done)
+}
@@ -0,0 +1,198 @@
+package balance_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/balance"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// addServer is a PingPong that adds a constant to the numbers it
+// echoes, so that tests can tell backends apart.
+type addServer struct {
+	n     int64
+	block chan struct{} // if not nil, calls wait for it to close
+}
+
+func (s addServer) EchoNum(ctx context.Context, call testcp.PingPong_echoNum) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	out, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out.SetN(call.Args().N() + s.n)
+	return nil
+}
+
+// dial returns a connection to a new vat serving srv, and the vat's
+// end of the connection.
+func dial(t *testing.T, srv addServer) (client, server *rpc.Conn) {
+	p1, p2 := net.Pipe()
+	server = rpc.NewConn(transport.NewStream(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(testcp.PingPong_ServerToClient(srv)),
+	})
+	t.Cleanup(func() { server.Close() })
+	return rpc.NewConn(transport.NewStream(p2), nil), server
+}
+
+func echo(ctx context.Context, pp testcp.PingPong, n int64) (int64, error) {
+	f, release := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(n)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	if err != nil {
+		return 0, err
+	}
+	return res.N(), nil
+}
+
+func TestRoundRobin(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	b := balance.New(&balance.Options{HealthInterval: -1})
+	defer b.Close()
+	for _, n := range []int64{0, 10, 20} {
+		c, _ := dial(t, addServer{n: n})
+		b.Add(c)
+	}
+	pp := testcp.PingPong(b.Client())
+	defer pp.Release()
+
+	var got []int64
+	for i := 0; i < 6; i++ {
+		n, err := echo(ctx, pp, 1)
+		require.NoError(t, err)
+		got = append(got, n)
+	}
+	assert.Equal(t, []int64{1, 11, 21, 1, 11, 21}, got)
+}
+
+func TestLeastOutstanding(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	b := balance.New(&balance.Options{
+		Strategy:       balance.LeastOutstanding,
+		HealthInterval: -1,
+	})
+	defer b.Close()
+	block := make(chan struct{})
+	slow, _ := dial(t, addServer{n: 0, block: block})
+	b.Add(slow)
+	fast, _ := dial(t, addServer{n: 10})
+	b.Add(fast)
+	pp := testcp.PingPong(b.Client())
+	defer pp.Release()
+
+	// The first call is sent to the slow backend, and is outstanding
+	// until block is closed, so the next calls all go to the fast one.
+	f, release := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(1)
+		return nil
+	})
+	defer release()
+	for i := 0; i < 3; i++ {
+		n, err := echo(ctx, pp, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), n, "call %d", i)
+		assert.Equal(t, 1, b.Outstanding(slow))
+
+		// The count is decremented after the caller sees the results.
+		require.Eventually(t, func() bool {
+			return b.Outstanding(fast) == 0
+		}, 5*time.Second, time.Millisecond)
+	}
+	close(block)
+	res, err := f.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), res.N())
+}
+
+func TestRemoveDead(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	removed := make(chan error, 2)
+	b := balance.New(&balance.Options{
+		HealthInterval: 10 * time.Millisecond,
+		HealthTimeout:  50 * time.Millisecond,
+		OnRemove: func(conn *rpc.Conn, err error) {
+			removed <- err
+		},
+	})
+	defer b.Close()
+
+	live, _ := dial(t, addServer{n: 10})
+	b.Add(live)
+	closed, server := dial(t, addServer{n: 20})
+	b.Add(closed)
+
+	// This peer reads everything that is sent to it, but never
+	// replies, so it fails its health check.
+	p1, p2 := net.Pipe()
+	go io.Copy(io.Discard, p2)
+	defer p2.Close()
+	b.Add(rpc.NewConn(transport.NewStream(p1), nil))
+
+	require.NoError(t, server.Close())
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-removed:
+			assert.True(t, exc.IsType(err, exc.Disconnected) || errors.Is(err, context.DeadlineExceeded), "removal reason: %v", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("dead backend not removed")
+		}
+	}
+	assert.Equal(t, []*rpc.Conn{live}, b.Conns())
+
+	pp := testcp.PingPong(b.Client())
+	defer pp.Release()
+	for i := 0; i < 3; i++ {
+		n, err := echo(ctx, pp, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(11), n)
+	}
+}
+
+func TestNoBackends(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	b := balance.New(nil)
+	pp := testcp.PingPong(b.Client())
+	defer pp.Release()
+
+	c, _ := dial(t, addServer{n: 10})
+	b.Add(c)
+	assert.True(t, b.Remove(c))
+	assert.False(t, b.Remove(c))
+
+	_, err := echo(ctx, pp, 1)
+	assert.ErrorIs(t, err, balance.ErrNoBackends)
+	assert.True(t, exc.IsType(err, exc.Disconnected))
+
+	require.NoError(t, b.Close())
+	_, err = echo(ctx, pp, 1)
+	assert.ErrorIs(t, err, balance.ErrNoBackends)
+}
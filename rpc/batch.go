@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// SendBatch implements capnp.BatchSender.  The Call messages are
+// queued together, so that no other message is sent between them, and
+// sent with a single call to SendBatch if the transport is a
+// transport.BatchTransport.  Calls in a batch are not retried.
+func (ic *importClient) SendBatch(calls []capnp.BatchCall) ([]*capnp.Answer, []capnp.ReleaseFunc) {
+	answers := make([]*capnp.Answer, len(calls))
+	releases := make([]capnp.ReleaseFunc, len(calls))
+	fail := func(err error) {
+		for i, call := range calls {
+			answers[i], releases[i] = capnp.ErrorAnswer(call.Send.Method, err), func() {}
+		}
+	}
+	ic.c.withLocked(func(c *lockedConn) {
+		if !c.startTask() {
+			fail(ExcClosed)
+			return
+		}
+		defer c.tasks.Done()
+		ent := c.lk.imports[ic.id]
+		if ent == nil || ic.generation != ent.generation {
+			fail(rpcerr.Disconnected(errors.New("send on closed import")))
+			return
+		}
+		if c.lk.draining {
+			fail(rpcerr.Disconnected(ErrConnDraining))
+			return
+		}
+
+		msgs := make([]queuedMessage, len(calls))
+		msgReleases := make([]capnp.ReleaseFunc, len(calls))
+		for i, call := range calls {
+			ctx, s := call.Ctx, call.Send
+			q := c.newQuestion(ctx, s.Method)
+			msgs[i], msgReleases[i] = c.newQueuedMessage(ctx, func(m rpccp.Message) error {
+				return c.newImportCallMessage(m, ic.id, q.id, s)
+			}, q.onCallSent(ctx))
+			answers[i], releases[i] = q.answer()
+		}
+		c.lk.sendTx.Send(asyncSend{
+			msgs: msgs,
+			release: func() {
+				for _, release := range msgReleases {
+					release()
+				}
+			},
+		})
+	})
+	return answers, releases
+}
@@ -0,0 +1,79 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// countingBatchTransport records the largest batch of messages sent
+// with SendBatch.
+type countingBatchTransport struct {
+	transport.BatchTransport
+	maxBatch atomic.Int32
+}
+
+func (t *countingBatchTransport) SendBatch(msgs []transport.OutgoingMessage) error {
+	for {
+		max := t.maxBatch.Load()
+		if int32(len(msgs)) <= max || t.maxBatch.CompareAndSwap(max, int32(len(msgs))) {
+			break
+		}
+	}
+	return t.BatchTransport.SendBatch(msgs)
+}
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+	})
+	defer serverConn.Close()
+	trans := &countingBatchTransport{
+		BatchTransport: transport.NewStream(clientNetConn).(transport.BatchTransport),
+	}
+	clientConn := rpc.NewConn(trans, nil)
+	defer clientConn.Close()
+	client := testcapnp.PingPong(clientConn.Bootstrap(ctx))
+	defer client.Release()
+	require.NoError(t, client.Resolve(ctx))
+
+	b := capnp.Client(client).Batch()
+	var futures []*capnp.Future
+	for n := int64(1); n <= 3; n++ {
+		n := n
+		f, release := b.Add(ctx, capnp.Send{
+			Method: capnp.Method{
+				InterfaceID: testcapnp.PingPong_TypeID,
+				MethodID:    0,
+			},
+			ArgsSize: capnp.ObjectSize{DataSize: 8},
+			PlaceArgs: func(s capnp.Struct) error {
+				testcapnp.PingPong_echoNum_Params(s).SetN(n)
+				return nil
+			},
+		})
+		defer release()
+		futures = append(futures, f)
+	}
+	b.Flush()
+
+	for i, f := range futures {
+		s, err := f.Struct()
+		require.NoError(t, err)
+		assert.Equal(t, int64(i+1), testcapnp.PingPong_echoNum_Results(s).N())
+	}
+	assert.GreaterOrEqual(t, trans.maxBatch.Load(), int32(3), "calls not sent with SendBatch")
+}
@@ -0,0 +1,37 @@
+package rpc
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/std/gateway"
+)
+
+// bootstrapClient returns the capability that a connection with the
+// given options serves as its bootstrap capability: a gateway for
+// opts.Bootstraps if there are any, and opts.BootstrapClient if not.
+func bootstrapClient(opts *Options) capnp.Client {
+	if len(opts.Bootstraps) == 0 {
+		return opts.BootstrapClient
+	}
+	var reg gateway.Registry
+	if opts.BootstrapClient.IsValid() {
+		reg.Add("", opts.BootstrapClient)
+	}
+	for name, c := range opts.Bootstraps {
+		reg.Add(name, c)
+	}
+	return capnp.Client(reg.Client())
+}
+
+// BootstrapNamed returns the capability that the remote vat serves
+// under name, as with Options.Bootstraps or any other gateway.Gateway
+// that it serves as its bootstrap capability.  Like Bootstrap, it
+// returns a promise, so calls on it can be made before the remote vat
+// has answered; they fail if the remote vat has no capability with
+// that name.  The caller must release the client.
+func (c *Conn) BootstrapNamed(ctx context.Context, name string) capnp.Client {
+	g := gateway.Gateway(c.Bootstrap(ctx))
+	defer g.Release()
+	return gateway.Restore(ctx, g, name)
+}
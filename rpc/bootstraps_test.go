@@ -0,0 +1,73 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// addServer is a PingPong that adds a constant to the numbers it
+// echoes, so that tests can tell servers apart.
+type addServer int64
+
+func (s addServer) EchoNum(ctx context.Context, call testcp.PingPong_echoNum) error {
+	out, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out.SetN(call.Args().N() + int64(s))
+	return nil
+}
+
+func TestBootstrapNamed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p1, p2 := net.Pipe()
+	server := rpc.NewConn(transport.NewStream(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(testcp.PingPong_ServerToClient(addServer(0))),
+		Bootstraps: map[string]capnp.Client{
+			"ten":     capnp.Client(testcp.PingPong_ServerToClient(addServer(10))),
+			"hundred": capnp.Client(testcp.PingPong_ServerToClient(addServer(100))),
+		},
+	})
+	defer server.Close()
+	client := rpc.NewConn(transport.NewStream(p2), nil)
+	defer client.Close()
+
+	echo := func(name string, n int64) (int64, error) {
+		pp := testcp.PingPong(client.BootstrapNamed(ctx, name))
+		defer pp.Release()
+		f, release := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+			p.SetN(n)
+			return nil
+		})
+		defer release()
+		res, err := f.Struct()
+		if err != nil {
+			return 0, err
+		}
+		return res.N(), nil
+	}
+
+	got, err := echo("ten", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), got)
+	got, err = echo("hundred", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), got)
+	got, err = echo("", 1)
+	require.NoError(t, err, "BootstrapClient is served under the empty name")
+	assert.Equal(t, int64(1), got)
+
+	_, err = echo("missing", 1)
+	assert.ErrorContains(t, err, `no capability named "missing"`)
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// WaitAcknowledged waits until the remote vat has acknowledged the end
+// of the call whose answer is ans, by sending the call's Return
+// message.  Generated futures give their answer with Future.Answer.
+//
+// Canceling a call's context makes the Conn send a Finish message at
+// once and reject the answer with the context's error, without waiting
+// for the remote vat.  On receiving the Finish, the remote vat cancels
+// the context passed to the method, and sends a Return once the method
+// is done, or right away if it had not started.  Callers that need to
+// know that the call is over on the remote vat, for instance before
+// retrying a call that is not idempotent, can wait for that Return with
+// WaitAcknowledged.  It can also be used on calls that were not
+// canceled.
+//
+// WaitAcknowledged returns nil once the Return has been received, or
+// right away if the Call message could not be sent, since the remote
+// vat never saw the call.  It returns ExcClosed if the connection shuts
+// down first, and ctx's error if ctx is done first.  It returns an
+// error wrapping ErrNotRemoteCall if ans is not the answer of a call
+// sent on c, such as a call on a local capability or one made on a
+// promise that had not resolved yet.
+func (c *Conn) WaitAcknowledged(ctx context.Context, ans *capnp.Answer) error {
+	q, ok := (*lockedConn)(c).getAnswerQuestion(ans)
+	if !ok {
+		return rpcerr.Failed(ErrNotRemoteCall)
+	}
+	select {
+	case <-q.returned:
+		return nil
+	default:
+	}
+	select {
+	case <-q.returned:
+		return nil
+	case <-c.bgctx.Done():
+		return ExcClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
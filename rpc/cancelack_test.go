@@ -0,0 +1,210 @@
+package rpc_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// cancelWatcher is a PingPong whose echoNum waits to be canceled,
+// reports the context's error, then returns once release is closed.
+type cancelWatcher struct {
+	started chan<- struct{}
+	ctxErrs chan<- error
+	release <-chan struct{}
+}
+
+func (w cancelWatcher) EchoNum(ctx context.Context, call testcapnp.PingPong_echoNum) error {
+	w.started <- struct{}{}
+	<-ctx.Done()
+	w.ctxErrs <- ctx.Err()
+	<-w.release
+	return ctx.Err()
+}
+
+// newCancelPair connects a client to a cancelWatcher over a pipe,
+// recording the client's messages in rec.
+func newCancelPair(t *testing.T, w cancelWatcher, rec io.Writer) (client *rpc.Conn, pp testcapnp.PingPong) {
+	t.Helper()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(w)),
+	})
+	t.Cleanup(func() { serverConn.Close() })
+	client = rpc.NewConn(transport.NewRecorder(transport.NewStream(clientNetConn), rec, nil), nil)
+	t.Cleanup(func() { client.Close() })
+	pp = testcapnp.PingPong(client.Bootstrap(context.Background()))
+	t.Cleanup(pp.Release)
+	require.NoError(t, pp.Resolve(context.Background()))
+	return client, pp
+}
+
+func TestCancelRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 1)
+	ctxErrs := make(chan error, 1)
+	release := make(chan struct{})
+	var rec bytes.Buffer
+	client, pp := newCancelPair(t, cancelWatcher{started, ctxErrs, release}, &rec)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f, finish := pp.EchoNum(ctx, nil)
+	defer finish()
+	<-started
+	cancel()
+
+	// The caller does not wait for the remote vat.
+	select {
+	case <-f.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("answer not rejected after cancel")
+	}
+	_, err := f.Struct()
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// The method sees the cancellation.
+	select {
+	case err := <-ctxErrs:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatal("method's context not canceled")
+	}
+
+	// The remote vat acknowledges the cancellation once the method
+	// returns.
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelWait()
+	assert.ErrorIs(t, client.WaitAcknowledged(waitCtx, f.Answer()), context.DeadlineExceeded)
+	close(release)
+	waitCtx, cancelWait = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelWait()
+	assert.NoError(t, client.WaitAcknowledged(waitCtx, f.Answer()))
+
+	// The Finish lets the remote vat cancel calls that it has not
+	// delivered yet.
+	require.NoError(t, client.Close())
+	fr := transport.NewFrameReader(&rec)
+	var (
+		callID    uint32
+		called    bool
+		sawFinish bool
+	)
+	for {
+		frame, err := fr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		msg, err := rpccp.ReadRootMessage(frame.Message)
+		require.NoError(t, err)
+		if frame.Direction == transport.Outgoing {
+			switch msg.Which() {
+			case rpccp.Message_Which_call:
+				call, err := msg.Call()
+				require.NoError(t, err)
+				callID, called = call.QuestionId(), true
+			case rpccp.Message_Which_finish:
+				fin, err := msg.Finish()
+				require.NoError(t, err)
+				if called && fin.QuestionId() == callID {
+					assert.False(t, fin.RequireEarlyCancellationWorkaround())
+					sawFinish = true
+				}
+			}
+		}
+		frame.Message.Release()
+	}
+	assert.True(t, sawFinish)
+}
+
+func TestWaitAcknowledged(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Local", func(t *testing.T) {
+		t.Parallel()
+
+		pp := testcapnp.PingPong_ServerToClient(pingPonger{})
+		defer pp.Release()
+		f, finish := pp.EchoNum(context.Background(), nil)
+		defer finish()
+		_, err := f.Struct()
+		require.NoError(t, err)
+		p1, p2 := net.Pipe()
+		defer p2.Close()
+		conn := rpc.NewConn(transport.NewStream(p1), nil)
+		defer conn.Close()
+		assert.ErrorIs(t, conn.WaitAcknowledged(context.Background(), f.Answer()), rpc.ErrNotRemoteCall)
+	})
+	t.Run("Returned", func(t *testing.T) {
+		t.Parallel()
+
+		serverNetConn, clientNetConn := net.Pipe()
+		serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+			BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+		})
+		defer serverConn.Close()
+		clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+		defer clientConn.Close()
+		pp := testcapnp.PingPong(clientConn.Bootstrap(context.Background()))
+		defer pp.Release()
+
+		f, finish := pp.EchoNum(context.Background(), nil)
+		defer finish()
+		_, err := f.Struct()
+		require.NoError(t, err)
+		assert.NoError(t, clientConn.WaitAcknowledged(context.Background(), f.Answer()))
+	})
+	t.Run("Closed", func(t *testing.T) {
+		t.Parallel()
+
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+		defer close(release)
+		client, pp := newCancelPair(t, cancelWatcher{started, make(chan error, 1), release}, io.Discard)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		f, finish := pp.EchoNum(ctx, nil)
+		defer finish()
+		<-started
+		cancel()
+		require.NoError(t, client.Close())
+		assert.Error(t, client.WaitAcknowledged(context.Background(), f.Answer()))
+	})
+	t.Run("Unsent", func(t *testing.T) {
+		t.Parallel()
+
+		serverNetConn, clientNetConn := net.Pipe()
+		serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+			BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+		})
+		defer serverConn.Close()
+		client := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+		defer client.Close()
+		pp := testcapnp.PingPong(client.Bootstrap(context.Background()))
+		defer pp.Release()
+		require.NoError(t, pp.Resolve(context.Background()))
+
+		// A call whose context is done before it is sent is dropped
+		// without a Call message, so there is nothing to wait for.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		f, finish := pp.EchoNum(ctx, nil)
+		defer finish()
+		waitCtx, cancelWait := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelWait()
+		assert.NoError(t, client.WaitAcknowledged(waitCtx, f.Answer()))
+	})
+}
@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"time"
+)
+
+// defaultCoalesceMaxMessages is the default for
+// Options.CoalesceMaxMessages.
+const defaultCoalesceMaxMessages = 64
+
+// coalesce adds the messages that are already on the outbound queue to
+// group, along with those queued within c.coalesceLatency if it is
+// positive, so that they are sent together.  It stops once group holds
+// c.coalesceMax messages.
+func (c *Conn) coalesce(ctx context.Context, group []asyncSend) []asyncSend {
+	n := 0
+	for _, as := range group {
+		n += len(as.msgs)
+	}
+	var wait context.Context
+	for n < c.coalesceMax {
+		async, ok := c.sendRx.TryRecv()
+		if !ok {
+			if c.coalesceLatency <= 0 {
+				break
+			}
+			if wait == nil {
+				var cancel context.CancelFunc
+				wait, cancel = context.WithTimeout(ctx, c.coalesceLatency)
+				defer cancel()
+			}
+			var err error
+			if async, err = c.sendRx.Recv(wait); err != nil {
+				break
+			}
+		}
+		group = append(group, async)
+		n += len(async.msgs)
+	}
+	return group
+}
+
+// coalesceOptions returns the coalescing parameters in opts, with
+// defaults filled in.
+func coalesceOptions(opts *Options) (latency time.Duration, max int) {
+	max = defaultCoalesceMaxMessages
+	if opts != nil {
+		latency = opts.CoalesceLatency
+		if opts.CoalesceMaxMessages > 0 {
+			max = opts.CoalesceMaxMessages
+		}
+	}
+	return latency, max
+}
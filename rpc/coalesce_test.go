@@ -0,0 +1,56 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+func TestCoalesce(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+	})
+	defer serverConn.Close()
+	trans := &countingBatchTransport{
+		BatchTransport: transport.NewStream(clientNetConn).(transport.BatchTransport),
+	}
+	clientConn := rpc.NewConn(trans, &rpc.Options{
+		CoalesceLatency: 100 * time.Millisecond,
+		// Stop waiting as soon as the three calls are queued.
+		CoalesceMaxMessages: 3,
+	})
+	defer clientConn.Close()
+	client := testcapnp.PingPong(clientConn.Bootstrap(ctx))
+	defer client.Release()
+	require.NoError(t, client.Resolve(ctx))
+
+	var futures []testcapnp.PingPong_echoNum_Results_Future
+	for n := int64(1); n <= 3; n++ {
+		n := n
+		f, release := client.EchoNum(ctx, func(p testcapnp.PingPong_echoNum_Params) error {
+			p.SetN(n)
+			return nil
+		})
+		defer release()
+		futures = append(futures, f)
+	}
+	for i, f := range futures {
+		res, err := f.Struct()
+		require.NoError(t, err)
+		assert.Equal(t, int64(i+1), res.N())
+	}
+	assert.Equal(t, int32(3), trans.maxBatch.Load(), "calls not coalesced")
+}
@@ -0,0 +1,110 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// TestCallDeadline verifies that the deadline of the caller's context
+// is propagated to the context of the call on the remote vat, through
+// the parameter annotated with $Go.timeout.
+func TestCallDeadline(t *testing.T) {
+	t.Parallel()
+
+	deadlines := make(chan deadline, 1)
+	client, cleanup := newDeadlinePair(t, deadlines)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	want, _ := ctx.Deadline()
+
+	future, release := client.Check(ctx, nil)
+	defer release()
+	_, err := future.Struct()
+	require.NoError(t, err)
+
+	got := <-deadlines
+	require.True(t, got.ok, "call context has no deadline")
+	assert.WithinDuration(t, want, got.t, time.Minute)
+}
+
+// TestCallNoDeadline verifies that calls without a deadline don't
+// acquire one on the remote vat.
+func TestCallNoDeadline(t *testing.T) {
+	t.Parallel()
+
+	deadlines := make(chan deadline, 1)
+	client, cleanup := newDeadlinePair(t, deadlines)
+	defer cleanup()
+
+	future, release := client.Check(context.Background(), nil)
+	defer release()
+	_, err := future.Struct()
+	require.NoError(t, err)
+
+	got := <-deadlines
+	assert.False(t, got.ok, "call context has deadline %v", got.t)
+}
+
+// TestCallExplicitTimeout verifies that a caller can set the timeout
+// parameter itself, overriding its context's deadline.
+func TestCallExplicitTimeout(t *testing.T) {
+	t.Parallel()
+
+	deadlines := make(chan deadline, 1)
+	client, cleanup := newDeadlinePair(t, deadlines)
+	defer cleanup()
+
+	start := time.Now()
+	future, release := client.Check(context.Background(), func(p testcapnp.DeadlineTest_check_Params) error {
+		p.SetTimeout(uint64(time.Hour))
+		return nil
+	})
+	defer release()
+	_, err := future.Struct()
+	require.NoError(t, err)
+
+	got := <-deadlines
+	require.True(t, got.ok, "call context has no deadline")
+	assert.WithinDuration(t, start.Add(time.Hour), got.t, time.Minute)
+}
+
+func newDeadlinePair(t *testing.T, deadlines chan<- deadline) (testcapnp.DeadlineTest, func()) {
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.DeadlineTest_ServerToClient(deadlineServer{deadlines})),
+	})
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+	client := testcapnp.DeadlineTest(clientConn.Bootstrap(context.Background()))
+	return client, func() {
+		client.Release()
+		assert.NoError(t, clientConn.Close())
+		<-serverConn.Done()
+	}
+}
+
+type deadline struct {
+	t  time.Time
+	ok bool
+}
+
+type deadlineServer struct {
+	deadlines chan<- deadline
+}
+
+func (s deadlineServer) Check(ctx context.Context, call testcapnp.DeadlineTest_check) error {
+	t, ok := ctx.Deadline()
+	s.deadlines <- deadline{t, ok}
+	return nil
+}
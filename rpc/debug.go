@@ -0,0 +1,236 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// DebugState is a snapshot of a connection's tables, for debugging
+// leaked capabilities and stuck calls.  Method names are filled in if
+// the interface's schema is registered in schemas.DefaultRegistry.
+type DebugState struct {
+	// Questions are the calls made to the remote vat that have not
+	// been finished.
+	Questions []DebugQuestion
+
+	// Answers are the calls received from the remote vat that have
+	// not been finished.
+	Answers []DebugAnswer
+
+	// Exports are the capabilities that the remote vat holds
+	// references to.
+	Exports []DebugExport
+
+	// Imports are the remote capabilities that the local vat holds
+	// references to.
+	Imports []DebugImport
+
+	// Embargoes is the number of embargoes that have not been lifted.
+	Embargoes int
+
+	// Draining is true if the connection has reached its maximum age.
+	Draining bool
+}
+
+// A DebugQuestion describes an entry in a connection's question table.
+type DebugQuestion struct {
+	ID     uint32
+	Method capnp.Method
+
+	// Finished is true if the call has been canceled or its results
+	// have been received, but the Finish message has not been sent
+	// yet.
+	Finished bool
+}
+
+// A DebugAnswer describes an entry in a connection's answer table.
+type DebugAnswer struct {
+	ID     uint32
+	Method capnp.Method
+
+	// Returned is true if the results are ready, and ReturnSent is true
+	// if they have been sent to the remote vat.
+	Returned   bool
+	ReturnSent bool
+}
+
+// A DebugExport describes an entry in a connection's export table.
+type DebugExport struct {
+	ID uint32
+
+	// Client identifies the exported capability.  Its format should not
+	// be depended on.
+	Client string
+
+	// WireRefs is the number of references that the remote vat holds.
+	WireRefs uint32
+}
+
+// A DebugImport describes an entry in a connection's import table.
+type DebugImport struct {
+	ID uint32
+
+	// WireRefs is the number of times the import has been received
+	// from the remote vat.
+	WireRefs int
+}
+
+// DebugState returns a snapshot of the connection's tables.
+func (c *Conn) DebugState() DebugState {
+	var (
+		s     DebugState
+		snaps []capnp.ClientSnapshot
+	)
+	c.withLocked(func(c *lockedConn) {
+		for _, q := range c.lk.questions {
+			if q == nil {
+				continue
+			}
+			s.Questions = append(s.Questions, DebugQuestion{
+				ID:       uint32(q.id),
+				Method:   q.method,
+				Finished: q.flags.Contains(finished),
+			})
+		}
+		for id, ans := range c.lk.answers {
+			s.Answers = append(s.Answers, DebugAnswer{
+				ID:         uint32(id),
+				Method:     ans.method,
+				Returned:   ans.flags.Contains(resultsReady),
+				ReturnSent: ans.flags.Contains(returnSent),
+			})
+		}
+		for id, ent := range c.lk.exports {
+			if ent == nil {
+				continue
+			}
+			s.Exports = append(s.Exports, DebugExport{
+				ID:       uint32(id),
+				WireRefs: ent.wireRefs,
+			})
+			snaps = append(snaps, ent.snapshot.AddRef())
+		}
+		for id, ent := range c.lk.imports {
+			s.Imports = append(s.Imports, DebugImport{
+				ID:       uint32(id),
+				WireRefs: ent.wireRefs,
+			})
+		}
+		for _, e := range c.lk.embargoes {
+			if e != nil {
+				s.Embargoes++
+			}
+		}
+		s.Draining = c.lk.draining
+	})
+
+	// Describing clients and looking up method names may take locks,
+	// so do it after releasing c.lk.
+	for i := range snaps {
+		s.Exports[i].Client = snaps[i].String()
+		snaps[i].Release()
+	}
+	for i := range s.Questions {
+		s.Questions[i].Method = c.names.resolve(s.Questions[i].Method)
+	}
+	for i := range s.Answers {
+		s.Answers[i].Method = c.names.resolve(s.Answers[i].Method)
+	}
+	sort.Slice(s.Answers, func(i, j int) bool { return s.Answers[i].ID < s.Answers[j].ID })
+	sort.Slice(s.Imports, func(i, j int) bool { return s.Imports[i].ID < s.Imports[j].ID })
+	return s
+}
+
+// WriteTo writes a human-readable description of the state to w.
+func (s DebugState) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	fmt.Fprintf(cw, "questions: %d\n", len(s.Questions))
+	for _, q := range s.Questions {
+		fmt.Fprintf(cw, "  %d %s", q.ID, debugMethod(q.Method))
+		if q.Finished {
+			io.WriteString(cw, " (finished)")
+		}
+		io.WriteString(cw, "\n")
+	}
+	fmt.Fprintf(cw, "answers: %d\n", len(s.Answers))
+	for _, a := range s.Answers {
+		fmt.Fprintf(cw, "  %d %s", a.ID, debugMethod(a.Method))
+		switch {
+		case a.ReturnSent:
+			io.WriteString(cw, " (returned)")
+		case a.Returned:
+			io.WriteString(cw, " (results ready)")
+		}
+		io.WriteString(cw, "\n")
+	}
+	fmt.Fprintf(cw, "exports: %d\n", len(s.Exports))
+	for _, e := range s.Exports {
+		fmt.Fprintf(cw, "  %d %s refs=%d\n", e.ID, e.Client, e.WireRefs)
+	}
+	fmt.Fprintf(cw, "imports: %d\n", len(s.Imports))
+	for _, i := range s.Imports {
+		fmt.Fprintf(cw, "  %d refs=%d\n", i.ID, i.WireRefs)
+	}
+	fmt.Fprintf(cw, "embargoes: %d\n", s.Embargoes)
+	if s.Draining {
+		io.WriteString(cw, "draining\n")
+	}
+	return cw.n, cw.err
+}
+
+// debugMethod returns the name of m, or "bootstrap" for the zero
+// Method.
+func debugMethod(m capnp.Method) string {
+	if m == (capnp.Method{}) {
+		return "bootstrap"
+	}
+	return m.String()
+}
+
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
+
+// DebugHandler returns an HTTP handler that renders the state of the
+// connections returned by conns, as text or, if the request has a
+// "format=json" query parameter, as JSON.  conns is called for each
+// request.
+//
+// The handler exposes details about the application's capabilities
+// and calls, so it should only be served to trusted clients.
+func DebugHandler(conns func() []*Conn) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cs := conns()
+		states := make([]DebugState, len(cs))
+		for i, c := range cs {
+			states[i] = c.DebugState()
+		}
+		if r.URL.Query().Get("format") == "json" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(states)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for i, s := range states {
+			fmt.Fprintf(w, "conn %d\n", i)
+			s.WriteTo(w)
+		}
+	})
+}
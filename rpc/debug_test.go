@@ -0,0 +1,78 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+)
+
+func TestDebugState(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	serverConn, clientConn, client := newMaxAgePair(t, &rpc.Options{}, blockingPingServer{
+		started: started,
+		release: release,
+	})
+	defer serverConn.Close()
+	defer clientConn.Close()
+	defer client.Release()
+
+	fut, rel := client.EchoNum(context.Background(), nil)
+	defer rel()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("call not started")
+	}
+
+	server := serverConn.DebugState()
+	require.Len(t, server.Answers, 1)
+	assert.Equal(t, "test.capnp:PingPong", server.Answers[0].Method.InterfaceName)
+	assert.Equal(t, "echoNum", server.Answers[0].Method.MethodName)
+	assert.False(t, server.Answers[0].ReturnSent)
+	require.Len(t, server.Exports, 1, "bootstrap capability")
+	assert.Equal(t, uint32(1), server.Exports[0].WireRefs)
+	assert.Empty(t, server.Questions)
+
+	clientState := clientConn.DebugState()
+	require.Len(t, clientState.Questions, 1)
+	assert.Equal(t, uint64(testcapnp.PingPong_TypeID), clientState.Questions[0].Method.InterfaceID)
+	assert.Equal(t, "echoNum", clientState.Questions[0].Method.MethodName)
+	assert.Len(t, clientState.Imports, 1)
+
+	var text strings.Builder
+	_, err := clientState.WriteTo(&text)
+	require.NoError(t, err)
+	assert.Contains(t, text.String(), "questions: 1\n  1 test.capnp:PingPong.echoNum\n")
+
+	h := rpc.DebugHandler(func() []*rpc.Conn { return []*rpc.Conn{serverConn, clientConn} })
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/?format=json", nil))
+	var states []rpc.DebugState
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &states))
+	require.Len(t, states, 2)
+	assert.Len(t, states[0].Answers, 1)
+	assert.Len(t, states[1].Questions, 1)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	assert.Contains(t, rec.Body.String(), "conn 1\nquestions: 1\n")
+
+	close(release)
+	_, err = fut.Struct()
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(serverConn.DebugState().Answers) == 0
+	}, 5*time.Second, time.Millisecond)
+}
@@ -0,0 +1,1653 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package debuginfo
+
+import (
+	bytes "bytes"
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	fc "capnproto.org/go/capnp/v3/flowcontrol"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	context "context"
+	io "io"
+)
+
+// A snapshot of a connection's tables.
+type ConnState capnp.Struct
+
+// ConnState_TypeID is the unique identifier for the type ConnState.
+const ConnState_TypeID = 0x95ab86c83a15d53b
+
+func NewConnState(s *capnp.Segment) (ConnState, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return ConnState(st), err
+}
+
+func NewRootConnState(s *capnp.Segment) (ConnState, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return ConnState(st), err
+}
+
+func ReadRootConnState(msg *capnp.Message) (ConnState, error) {
+	root, err := msg.Root()
+	return ConnState(root.Struct()), err
+}
+
+func (s ConnState) String() string {
+	str, _ := text.Marshal(0x95ab86c83a15d53b, capnp.Struct(s))
+	return str
+}
+
+func (s ConnState) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ConnState) DecodeFromPtr(p capnp.Ptr) ConnState {
+	return ConnState(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ConnState) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ConnState) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ConnState) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ConnState) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Calls made to the remote vat that have not been finished.
+func (s ConnState) Questions() (ConnState_Call_List, error) {
+	return capnp.GetListField[ConnState_Call_List](s, 0, "ConnState.questions")
+}
+
+func (s ConnState) HasQuestions() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ConnState) SetQuestions(v ConnState_Call_List) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewQuestions sets the questions field to a newly
+// allocated ConnState_Call_List, preferring placement in s's segment.
+func (s ConnState) NewQuestions(n int32) (ConnState_Call_List, error) {
+	return capnp.NewListField(s, 0, n, NewConnState_Call_List)
+}
+
+// Calls received from the remote vat that have not been finished.
+func (s ConnState) Answers() (ConnState_Call_List, error) {
+	return capnp.GetListField[ConnState_Call_List](s, 1, "ConnState.answers")
+}
+
+func (s ConnState) HasAnswers() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s ConnState) SetAnswers(v ConnState_Call_List) error {
+	return capnp.SetListField(s, 1, v)
+}
+
+// NewAnswers sets the answers field to a newly
+// allocated ConnState_Call_List, preferring placement in s's segment.
+func (s ConnState) NewAnswers(n int32) (ConnState_Call_List, error) {
+	return capnp.NewListField(s, 1, n, NewConnState_Call_List)
+}
+
+// Capabilities that the remote vat holds references to.
+func (s ConnState) Exports() (ConnState_Export_List, error) {
+	return capnp.GetListField[ConnState_Export_List](s, 2, "ConnState.exports")
+}
+
+func (s ConnState) HasExports() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s ConnState) SetExports(v ConnState_Export_List) error {
+	return capnp.SetListField(s, 2, v)
+}
+
+// NewExports sets the exports field to a newly
+// allocated ConnState_Export_List, preferring placement in s's segment.
+func (s ConnState) NewExports(n int32) (ConnState_Export_List, error) {
+	return capnp.NewListField(s, 2, n, NewConnState_Export_List)
+}
+
+// Remote capabilities that the vat holds references to.
+func (s ConnState) Imports() (ConnState_Import_List, error) {
+	return capnp.GetListField[ConnState_Import_List](s, 3, "ConnState.imports")
+}
+
+func (s ConnState) HasImports() bool {
+	return capnp.Struct(s).HasPtr(3)
+}
+
+func (s ConnState) SetImports(v ConnState_Import_List) error {
+	return capnp.SetListField(s, 3, v)
+}
+
+// NewImports sets the imports field to a newly
+// allocated ConnState_Import_List, preferring placement in s's segment.
+func (s ConnState) NewImports(n int32) (ConnState_Import_List, error) {
+	return capnp.NewListField(s, 3, n, NewConnState_Import_List)
+}
+
+// Number of embargoes that have not been lifted.
+func (s ConnState) Embargoes() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s ConnState) SetEmbargoes(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// Whether the connection has reached its maximum age.
+func (s ConnState) Draining() bool {
+	return capnp.Struct(s).Bit(32)
+}
+
+func (s ConnState) SetDraining(v bool) {
+	capnp.Struct(s).SetBit(32, v)
+}
+
+// ConnState_List is a list of ConnState.
+type ConnState_List = capnp.StructList[ConnState]
+
+// NewConnState creates a new list of ConnState.
+func NewConnState_List(s *capnp.Segment, sz int32) (ConnState_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[ConnState](l), err
+}
+
+// ConnState_Future is a wrapper for a ConnState promised by a client call.
+type ConnState_Future struct{ *capnp.Future }
+
+func (f ConnState_Future) Struct() (ConnState, error) {
+	p, err := f.Future.Ptr()
+	return ConnState(p.Struct()), err
+}
+
+type ConnState_Call capnp.Struct
+
+// ConnState_Call_TypeID is the unique identifier for the type ConnState_Call.
+const ConnState_Call_TypeID = 0xc393acba9050251a
+
+func NewConnState_Call(s *capnp.Segment) (ConnState_Call, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return ConnState_Call(st), err
+}
+
+func NewRootConnState_Call(s *capnp.Segment) (ConnState_Call, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return ConnState_Call(st), err
+}
+
+func ReadRootConnState_Call(msg *capnp.Message) (ConnState_Call, error) {
+	root, err := msg.Root()
+	return ConnState_Call(root.Struct()), err
+}
+
+func (s ConnState_Call) String() string {
+	str, _ := text.Marshal(0xc393acba9050251a, capnp.Struct(s))
+	return str
+}
+
+func (s ConnState_Call) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ConnState_Call) DecodeFromPtr(p capnp.Ptr) ConnState_Call {
+	return ConnState_Call(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ConnState_Call) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ConnState_Call) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ConnState_Call) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ConnState_Call) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ConnState_Call) Id() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s ConnState_Call) SetId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s ConnState_Call) InterfaceId() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s ConnState_Call) SetInterfaceId(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s ConnState_Call) MethodId() uint16 {
+	return capnp.Struct(s).Uint16(4)
+}
+
+func (s ConnState_Call) SetMethodId(v uint16) {
+	capnp.Struct(s).SetUint16(4, v)
+}
+
+func (s ConnState_Call) InterfaceName() (string, error) {
+	return capnp.GetTextField(s, 0, "ConnState.Call.interfaceName")
+}
+
+func (s ConnState_Call) HasInterfaceName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ConnState_Call) InterfaceNameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "ConnState.Call.interfaceName")
+}
+
+func (s ConnState_Call) SetInterfaceName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// Names are empty if the interface's schema is not registered.
+func (s ConnState_Call) MethodName() (string, error) {
+	return capnp.GetTextField(s, 1, "ConnState.Call.methodName")
+}
+
+func (s ConnState_Call) HasMethodName() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s ConnState_Call) MethodNameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 1, "ConnState.Call.methodName")
+}
+
+func (s ConnState_Call) SetMethodName(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+// For questions, whether the call has been canceled or returned.  For
+// answers, whether the results are ready.
+func (s ConnState_Call) Done() bool {
+	return capnp.Struct(s).Bit(48)
+}
+
+func (s ConnState_Call) SetDone(v bool) {
+	capnp.Struct(s).SetBit(48, v)
+}
+
+// ConnState_Call_List is a list of ConnState_Call.
+type ConnState_Call_List = capnp.StructList[ConnState_Call]
+
+// NewConnState_Call creates a new list of ConnState_Call.
+func NewConnState_Call_List(s *capnp.Segment, sz int32) (ConnState_Call_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	return capnp.StructList[ConnState_Call](l), err
+}
+
+// ConnState_Call_Future is a wrapper for a ConnState_Call promised by a client call.
+type ConnState_Call_Future struct{ *capnp.Future }
+
+func (f ConnState_Call_Future) Struct() (ConnState_Call, error) {
+	p, err := f.Future.Ptr()
+	return ConnState_Call(p.Struct()), err
+}
+
+type ConnState_Export capnp.Struct
+
+// ConnState_Export_TypeID is the unique identifier for the type ConnState_Export.
+const ConnState_Export_TypeID = 0xa02d1ed5a8c0ae04
+
+func NewConnState_Export(s *capnp.Segment) (ConnState_Export, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ConnState_Export(st), err
+}
+
+func NewRootConnState_Export(s *capnp.Segment) (ConnState_Export, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return ConnState_Export(st), err
+}
+
+func ReadRootConnState_Export(msg *capnp.Message) (ConnState_Export, error) {
+	root, err := msg.Root()
+	return ConnState_Export(root.Struct()), err
+}
+
+func (s ConnState_Export) String() string {
+	str, _ := text.Marshal(0xa02d1ed5a8c0ae04, capnp.Struct(s))
+	return str
+}
+
+func (s ConnState_Export) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ConnState_Export) DecodeFromPtr(p capnp.Ptr) ConnState_Export {
+	return ConnState_Export(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ConnState_Export) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ConnState_Export) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ConnState_Export) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ConnState_Export) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ConnState_Export) Id() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s ConnState_Export) SetId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// Describes the exported capability.  The format is not specified.
+func (s ConnState_Export) Client() (string, error) {
+	return capnp.GetTextField(s, 0, "ConnState.Export.client")
+}
+
+func (s ConnState_Export) HasClient() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ConnState_Export) ClientBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "ConnState.Export.client")
+}
+
+func (s ConnState_Export) SetClient(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s ConnState_Export) WireRefs() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s ConnState_Export) SetWireRefs(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+// ConnState_Export_List is a list of ConnState_Export.
+type ConnState_Export_List = capnp.StructList[ConnState_Export]
+
+// NewConnState_Export creates a new list of ConnState_Export.
+func NewConnState_Export_List(s *capnp.Segment, sz int32) (ConnState_Export_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[ConnState_Export](l), err
+}
+
+// ConnState_Export_Future is a wrapper for a ConnState_Export promised by a client call.
+type ConnState_Export_Future struct{ *capnp.Future }
+
+func (f ConnState_Export_Future) Struct() (ConnState_Export, error) {
+	p, err := f.Future.Ptr()
+	return ConnState_Export(p.Struct()), err
+}
+
+type ConnState_Import capnp.Struct
+
+// ConnState_Import_TypeID is the unique identifier for the type ConnState_Import.
+const ConnState_Import_TypeID = 0x8dbc0e1cb8479438
+
+func NewConnState_Import(s *capnp.Segment) (ConnState_Import, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ConnState_Import(st), err
+}
+
+func NewRootConnState_Import(s *capnp.Segment) (ConnState_Import, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ConnState_Import(st), err
+}
+
+func ReadRootConnState_Import(msg *capnp.Message) (ConnState_Import, error) {
+	root, err := msg.Root()
+	return ConnState_Import(root.Struct()), err
+}
+
+func (s ConnState_Import) String() string {
+	str, _ := text.Marshal(0x8dbc0e1cb8479438, capnp.Struct(s))
+	return str
+}
+
+func (s ConnState_Import) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ConnState_Import) DecodeFromPtr(p capnp.Ptr) ConnState_Import {
+	return ConnState_Import(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ConnState_Import) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ConnState_Import) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ConnState_Import) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ConnState_Import) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ConnState_Import) Id() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s ConnState_Import) SetId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s ConnState_Import) WireRefs() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s ConnState_Import) SetWireRefs(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+// ConnState_Import_List is a list of ConnState_Import.
+type ConnState_Import_List = capnp.StructList[ConnState_Import]
+
+// NewConnState_Import creates a new list of ConnState_Import.
+func NewConnState_Import_List(s *capnp.Segment, sz int32) (ConnState_Import_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[ConnState_Import](l), err
+}
+
+// ConnState_Import_Future is a wrapper for a ConnState_Import promised by a client call.
+type ConnState_Import_Future struct{ *capnp.Future }
+
+func (f ConnState_Import_Future) Struct() (ConnState_Import, error) {
+	p, err := f.Future.Ptr()
+	return ConnState_Import(p.Struct()), err
+}
+
+// Exposes a vat's connection state, runtime statistics, and registered
+// schemas.  The information can be sensitive, so this capability should
+// only be handed to trusted, authenticated peers.
+type DebugInfo capnp.Client
+
+// DebugInfo_TypeID is the unique identifier for the type DebugInfo.
+const DebugInfo_TypeID = 0xaacb8e48f40cdce0
+
+// Returns a snapshot of each of the vat's connections.
+func (c DebugInfo) Conns(ctx context.Context, params func(DebugInfo_conns_Params) error) (DebugInfo_conns_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      0,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "conns",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(DebugInfo_conns_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return DebugInfo_conns_Results_Future{Future: ans.Future()}, release
+
+}
+
+// Returns statistics about the process.
+func (c DebugInfo) Runtime(ctx context.Context, params func(DebugInfo_runtime_Params) error) (DebugInfo_runtime_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      1,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "runtime",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(DebugInfo_runtime_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return DebugInfo_runtime_Results_Future{Future: ans.Future()}, release
+
+}
+
+// Returns the IDs of all nodes in the vat's schema registry, in ascending
+// order.
+func (c DebugInfo) SchemaIds(ctx context.Context, params func(DebugInfo_schemaIds_Params) error) (DebugInfo_schemaIds_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      2,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "schemaIds",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(DebugInfo_schemaIds_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return DebugInfo_schemaIds_Results_Future{Future: ans.Future()}, release
+
+}
+
+// Returns the registered CodeGeneratorRequest message containing the node
+// with the given ID, in the standard unpacked framing format.
+func (c DebugInfo) Schema(ctx context.Context, params func(DebugInfo_schema_Params) error) (DebugInfo_schema_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      3,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "schema",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(DebugInfo_schema_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return DebugInfo_schema_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c DebugInfo) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c DebugInfo) String() string {
+	return "DebugInfo(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c DebugInfo) AddRef() DebugInfo {
+	return DebugInfo(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c DebugInfo) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c DebugInfo) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c DebugInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (DebugInfo) DecodeFromPtr(p capnp.Ptr) DebugInfo {
+	return DebugInfo(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c DebugInfo) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c DebugInfo) IsSame(other DebugInfo) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c DebugInfo) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c DebugInfo) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A DebugInfo_Server is a DebugInfo with a local implementation.
+type DebugInfo_Server interface {
+	// Returns a snapshot of each of the vat's connections.
+	Conns(context.Context, DebugInfo_conns) error
+	// Returns statistics about the process.
+	Runtime(context.Context, DebugInfo_runtime) error
+	// Returns the IDs of all nodes in the vat's schema registry, in ascending
+	// order.
+	SchemaIds(context.Context, DebugInfo_schemaIds) error
+	// Returns the registered CodeGeneratorRequest message containing the node
+	// with the given ID, in the standard unpacked framing format.
+	Schema(context.Context, DebugInfo_schema) error
+}
+
+// DebugInfo_NewServer creates a new Server from an implementation of DebugInfo_Server.
+func DebugInfo_NewServer(s DebugInfo_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(DebugInfo_Methods(nil, s), s, c)
+}
+
+// DebugInfo_ServerToClient creates a new Client from an implementation of DebugInfo_Server.
+// The caller is responsible for calling Release on the returned Client.
+func DebugInfo_ServerToClient(s DebugInfo_Server) DebugInfo {
+	return DebugInfo(capnp.NewClient(DebugInfo_NewServer(s)))
+}
+
+// DebugInfo_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func DebugInfo_Methods(methods []server.Method, s DebugInfo_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 4)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      0,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "conns",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Conns(ctx, DebugInfo_conns{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      1,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "runtime",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Runtime(ctx, DebugInfo_runtime{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      2,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "schemaIds",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SchemaIds(ctx, DebugInfo_schemaIds{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xaacb8e48f40cdce0,
+			MethodID:      3,
+			InterfaceName: "debuginfo.capnp:DebugInfo",
+			MethodName:    "schema",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Schema(ctx, DebugInfo_schema{call})
+		},
+	})
+
+	return methods
+}
+
+// DebugInfo_conns holds the state for a server call to DebugInfo.conns.
+// See server.Call for documentation.
+type DebugInfo_conns struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c DebugInfo_conns) Args() DebugInfo_conns_Params {
+	return DebugInfo_conns_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c DebugInfo_conns) AllocResults() (DebugInfo_conns_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_conns_Results(r), err
+}
+
+// DebugInfo_runtime holds the state for a server call to DebugInfo.runtime.
+// See server.Call for documentation.
+type DebugInfo_runtime struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c DebugInfo_runtime) Args() DebugInfo_runtime_Params {
+	return DebugInfo_runtime_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c DebugInfo_runtime) AllocResults() (DebugInfo_runtime_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_runtime_Results(r), err
+}
+
+// DebugInfo_schemaIds holds the state for a server call to DebugInfo.schemaIds.
+// See server.Call for documentation.
+type DebugInfo_schemaIds struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c DebugInfo_schemaIds) Args() DebugInfo_schemaIds_Params {
+	return DebugInfo_schemaIds_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c DebugInfo_schemaIds) AllocResults() (DebugInfo_schemaIds_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_schemaIds_Results(r), err
+}
+
+// DebugInfo_schema holds the state for a server call to DebugInfo.schema.
+// See server.Call for documentation.
+type DebugInfo_schema struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c DebugInfo_schema) Args() DebugInfo_schema_Params {
+	return DebugInfo_schema_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c DebugInfo_schema) AllocResults() (DebugInfo_schema_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_schema_Results(r), err
+}
+
+// DebugInfo_List is a list of DebugInfo.
+type DebugInfo_List = capnp.CapList[DebugInfo]
+
+// NewDebugInfo_List creates a new list of DebugInfo.
+func NewDebugInfo_List(s *capnp.Segment, sz int32) (DebugInfo_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[DebugInfo](l), err
+}
+
+type DebugInfo_conns_Params capnp.Struct
+
+// DebugInfo_conns_Params_TypeID is the unique identifier for the type DebugInfo_conns_Params.
+const DebugInfo_conns_Params_TypeID = 0x8f330522f85cc7cb
+
+func NewDebugInfo_conns_Params(s *capnp.Segment) (DebugInfo_conns_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DebugInfo_conns_Params(st), err
+}
+
+func NewRootDebugInfo_conns_Params(s *capnp.Segment) (DebugInfo_conns_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DebugInfo_conns_Params(st), err
+}
+
+func ReadRootDebugInfo_conns_Params(msg *capnp.Message) (DebugInfo_conns_Params, error) {
+	root, err := msg.Root()
+	return DebugInfo_conns_Params(root.Struct()), err
+}
+
+func (s DebugInfo_conns_Params) String() string {
+	str, _ := text.Marshal(0x8f330522f85cc7cb, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_conns_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_conns_Params) DecodeFromPtr(p capnp.Ptr) DebugInfo_conns_Params {
+	return DebugInfo_conns_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_conns_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_conns_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_conns_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_conns_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// DebugInfo_conns_Params_List is a list of DebugInfo_conns_Params.
+type DebugInfo_conns_Params_List = capnp.StructList[DebugInfo_conns_Params]
+
+// NewDebugInfo_conns_Params creates a new list of DebugInfo_conns_Params.
+func NewDebugInfo_conns_Params_List(s *capnp.Segment, sz int32) (DebugInfo_conns_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[DebugInfo_conns_Params](l), err
+}
+
+// DebugInfo_conns_Params_Future is a wrapper for a DebugInfo_conns_Params promised by a client call.
+type DebugInfo_conns_Params_Future struct{ *capnp.Future }
+
+func (f DebugInfo_conns_Params_Future) Struct() (DebugInfo_conns_Params, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_conns_Params(p.Struct()), err
+}
+
+type DebugInfo_conns_Results capnp.Struct
+
+// DebugInfo_conns_Results_TypeID is the unique identifier for the type DebugInfo_conns_Results.
+const DebugInfo_conns_Results_TypeID = 0x995a56043b22d722
+
+func NewDebugInfo_conns_Results(s *capnp.Segment) (DebugInfo_conns_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_conns_Results(st), err
+}
+
+func NewRootDebugInfo_conns_Results(s *capnp.Segment) (DebugInfo_conns_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_conns_Results(st), err
+}
+
+func ReadRootDebugInfo_conns_Results(msg *capnp.Message) (DebugInfo_conns_Results, error) {
+	root, err := msg.Root()
+	return DebugInfo_conns_Results(root.Struct()), err
+}
+
+func (s DebugInfo_conns_Results) String() string {
+	str, _ := text.Marshal(0x995a56043b22d722, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_conns_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_conns_Results) DecodeFromPtr(p capnp.Ptr) DebugInfo_conns_Results {
+	return DebugInfo_conns_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_conns_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_conns_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_conns_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_conns_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s DebugInfo_conns_Results) Conns() (ConnState_List, error) {
+	return capnp.GetListField[ConnState_List](s, 0, "DebugInfo.conns$Results.conns")
+}
+
+func (s DebugInfo_conns_Results) HasConns() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s DebugInfo_conns_Results) SetConns(v ConnState_List) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewConns sets the conns field to a newly
+// allocated ConnState_List, preferring placement in s's segment.
+func (s DebugInfo_conns_Results) NewConns(n int32) (ConnState_List, error) {
+	return capnp.NewListField(s, 0, n, NewConnState_List)
+}
+
+// DebugInfo_conns_Results_List is a list of DebugInfo_conns_Results.
+type DebugInfo_conns_Results_List = capnp.StructList[DebugInfo_conns_Results]
+
+// NewDebugInfo_conns_Results creates a new list of DebugInfo_conns_Results.
+func NewDebugInfo_conns_Results_List(s *capnp.Segment, sz int32) (DebugInfo_conns_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[DebugInfo_conns_Results](l), err
+}
+
+// DebugInfo_conns_Results_Future is a wrapper for a DebugInfo_conns_Results promised by a client call.
+type DebugInfo_conns_Results_Future struct{ *capnp.Future }
+
+func (f DebugInfo_conns_Results_Future) Struct() (DebugInfo_conns_Results, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_conns_Results(p.Struct()), err
+}
+
+type DebugInfo_runtime_Params capnp.Struct
+
+// DebugInfo_runtime_Params_TypeID is the unique identifier for the type DebugInfo_runtime_Params.
+const DebugInfo_runtime_Params_TypeID = 0xdeafe628c16e2c7e
+
+func NewDebugInfo_runtime_Params(s *capnp.Segment) (DebugInfo_runtime_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DebugInfo_runtime_Params(st), err
+}
+
+func NewRootDebugInfo_runtime_Params(s *capnp.Segment) (DebugInfo_runtime_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DebugInfo_runtime_Params(st), err
+}
+
+func ReadRootDebugInfo_runtime_Params(msg *capnp.Message) (DebugInfo_runtime_Params, error) {
+	root, err := msg.Root()
+	return DebugInfo_runtime_Params(root.Struct()), err
+}
+
+func (s DebugInfo_runtime_Params) String() string {
+	str, _ := text.Marshal(0xdeafe628c16e2c7e, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_runtime_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_runtime_Params) DecodeFromPtr(p capnp.Ptr) DebugInfo_runtime_Params {
+	return DebugInfo_runtime_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_runtime_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_runtime_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_runtime_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_runtime_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// DebugInfo_runtime_Params_List is a list of DebugInfo_runtime_Params.
+type DebugInfo_runtime_Params_List = capnp.StructList[DebugInfo_runtime_Params]
+
+// NewDebugInfo_runtime_Params creates a new list of DebugInfo_runtime_Params.
+func NewDebugInfo_runtime_Params_List(s *capnp.Segment, sz int32) (DebugInfo_runtime_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[DebugInfo_runtime_Params](l), err
+}
+
+// DebugInfo_runtime_Params_Future is a wrapper for a DebugInfo_runtime_Params promised by a client call.
+type DebugInfo_runtime_Params_Future struct{ *capnp.Future }
+
+func (f DebugInfo_runtime_Params_Future) Struct() (DebugInfo_runtime_Params, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_runtime_Params(p.Struct()), err
+}
+
+type DebugInfo_runtime_Results capnp.Struct
+
+// DebugInfo_runtime_Results_TypeID is the unique identifier for the type DebugInfo_runtime_Results.
+const DebugInfo_runtime_Results_TypeID = 0x8c6f1fd6ee86e84b
+
+func NewDebugInfo_runtime_Results(s *capnp.Segment) (DebugInfo_runtime_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_runtime_Results(st), err
+}
+
+func NewRootDebugInfo_runtime_Results(s *capnp.Segment) (DebugInfo_runtime_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_runtime_Results(st), err
+}
+
+func ReadRootDebugInfo_runtime_Results(msg *capnp.Message) (DebugInfo_runtime_Results, error) {
+	root, err := msg.Root()
+	return DebugInfo_runtime_Results(root.Struct()), err
+}
+
+func (s DebugInfo_runtime_Results) String() string {
+	str, _ := text.Marshal(0x8c6f1fd6ee86e84b, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_runtime_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_runtime_Results) DecodeFromPtr(p capnp.Ptr) DebugInfo_runtime_Results {
+	return DebugInfo_runtime_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_runtime_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_runtime_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_runtime_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_runtime_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s DebugInfo_runtime_Results) Info() (RuntimeInfo, error) {
+	return capnp.GetStructField[RuntimeInfo](s, 0, "DebugInfo.runtime$Results.info")
+}
+
+func (s DebugInfo_runtime_Results) HasInfo() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s DebugInfo_runtime_Results) SetInfo(v RuntimeInfo) error {
+	return capnp.SetStructField(s, 0, v)
+}
+
+// NewInfo sets the info field to a newly
+// allocated RuntimeInfo struct, preferring placement in s's segment.
+func (s DebugInfo_runtime_Results) NewInfo() (RuntimeInfo, error) {
+	return capnp.NewStructField(s, 0, NewRuntimeInfo)
+}
+
+// DebugInfo_runtime_Results_List is a list of DebugInfo_runtime_Results.
+type DebugInfo_runtime_Results_List = capnp.StructList[DebugInfo_runtime_Results]
+
+// NewDebugInfo_runtime_Results creates a new list of DebugInfo_runtime_Results.
+func NewDebugInfo_runtime_Results_List(s *capnp.Segment, sz int32) (DebugInfo_runtime_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[DebugInfo_runtime_Results](l), err
+}
+
+// DebugInfo_runtime_Results_Future is a wrapper for a DebugInfo_runtime_Results promised by a client call.
+type DebugInfo_runtime_Results_Future struct{ *capnp.Future }
+
+func (f DebugInfo_runtime_Results_Future) Struct() (DebugInfo_runtime_Results, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_runtime_Results(p.Struct()), err
+}
+func (p DebugInfo_runtime_Results_Future) Info() RuntimeInfo_Future {
+	return RuntimeInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type DebugInfo_schemaIds_Params capnp.Struct
+
+// DebugInfo_schemaIds_Params_TypeID is the unique identifier for the type DebugInfo_schemaIds_Params.
+const DebugInfo_schemaIds_Params_TypeID = 0xd7668fc6d8cf1530
+
+func NewDebugInfo_schemaIds_Params(s *capnp.Segment) (DebugInfo_schemaIds_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DebugInfo_schemaIds_Params(st), err
+}
+
+func NewRootDebugInfo_schemaIds_Params(s *capnp.Segment) (DebugInfo_schemaIds_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DebugInfo_schemaIds_Params(st), err
+}
+
+func ReadRootDebugInfo_schemaIds_Params(msg *capnp.Message) (DebugInfo_schemaIds_Params, error) {
+	root, err := msg.Root()
+	return DebugInfo_schemaIds_Params(root.Struct()), err
+}
+
+func (s DebugInfo_schemaIds_Params) String() string {
+	str, _ := text.Marshal(0xd7668fc6d8cf1530, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_schemaIds_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_schemaIds_Params) DecodeFromPtr(p capnp.Ptr) DebugInfo_schemaIds_Params {
+	return DebugInfo_schemaIds_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_schemaIds_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_schemaIds_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_schemaIds_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_schemaIds_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// DebugInfo_schemaIds_Params_List is a list of DebugInfo_schemaIds_Params.
+type DebugInfo_schemaIds_Params_List = capnp.StructList[DebugInfo_schemaIds_Params]
+
+// NewDebugInfo_schemaIds_Params creates a new list of DebugInfo_schemaIds_Params.
+func NewDebugInfo_schemaIds_Params_List(s *capnp.Segment, sz int32) (DebugInfo_schemaIds_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[DebugInfo_schemaIds_Params](l), err
+}
+
+// DebugInfo_schemaIds_Params_Future is a wrapper for a DebugInfo_schemaIds_Params promised by a client call.
+type DebugInfo_schemaIds_Params_Future struct{ *capnp.Future }
+
+func (f DebugInfo_schemaIds_Params_Future) Struct() (DebugInfo_schemaIds_Params, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_schemaIds_Params(p.Struct()), err
+}
+
+type DebugInfo_schemaIds_Results capnp.Struct
+
+// DebugInfo_schemaIds_Results_TypeID is the unique identifier for the type DebugInfo_schemaIds_Results.
+const DebugInfo_schemaIds_Results_TypeID = 0x9faa0a26bf2e2fae
+
+func NewDebugInfo_schemaIds_Results(s *capnp.Segment) (DebugInfo_schemaIds_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_schemaIds_Results(st), err
+}
+
+func NewRootDebugInfo_schemaIds_Results(s *capnp.Segment) (DebugInfo_schemaIds_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_schemaIds_Results(st), err
+}
+
+func ReadRootDebugInfo_schemaIds_Results(msg *capnp.Message) (DebugInfo_schemaIds_Results, error) {
+	root, err := msg.Root()
+	return DebugInfo_schemaIds_Results(root.Struct()), err
+}
+
+func (s DebugInfo_schemaIds_Results) String() string {
+	str, _ := text.Marshal(0x9faa0a26bf2e2fae, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_schemaIds_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_schemaIds_Results) DecodeFromPtr(p capnp.Ptr) DebugInfo_schemaIds_Results {
+	return DebugInfo_schemaIds_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_schemaIds_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_schemaIds_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_schemaIds_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_schemaIds_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s DebugInfo_schemaIds_Results) Ids() (capnp.UInt64List, error) {
+	return capnp.GetListField[capnp.UInt64List](s, 0, "DebugInfo.schemaIds$Results.ids")
+}
+
+func (s DebugInfo_schemaIds_Results) HasIds() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s DebugInfo_schemaIds_Results) SetIds(v capnp.UInt64List) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewIds sets the ids field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s DebugInfo_schemaIds_Results) NewIds(n int32) (capnp.UInt64List, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewUInt64List)
+}
+
+// SetIdsFromSlice sets the ids field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s DebugInfo_schemaIds_Results) SetIdsFromSlice(v []uint64) error {
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewUInt64ListFromSlice)
+}
+
+// DebugInfo_schemaIds_Results_List is a list of DebugInfo_schemaIds_Results.
+type DebugInfo_schemaIds_Results_List = capnp.StructList[DebugInfo_schemaIds_Results]
+
+// NewDebugInfo_schemaIds_Results creates a new list of DebugInfo_schemaIds_Results.
+func NewDebugInfo_schemaIds_Results_List(s *capnp.Segment, sz int32) (DebugInfo_schemaIds_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[DebugInfo_schemaIds_Results](l), err
+}
+
+// DebugInfo_schemaIds_Results_Future is a wrapper for a DebugInfo_schemaIds_Results promised by a client call.
+type DebugInfo_schemaIds_Results_Future struct{ *capnp.Future }
+
+func (f DebugInfo_schemaIds_Results_Future) Struct() (DebugInfo_schemaIds_Results, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_schemaIds_Results(p.Struct()), err
+}
+
+type DebugInfo_schema_Params capnp.Struct
+
+// DebugInfo_schema_Params_TypeID is the unique identifier for the type DebugInfo_schema_Params.
+const DebugInfo_schema_Params_TypeID = 0xcc43dcbe8a3bccac
+
+func NewDebugInfo_schema_Params(s *capnp.Segment) (DebugInfo_schema_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return DebugInfo_schema_Params(st), err
+}
+
+func NewRootDebugInfo_schema_Params(s *capnp.Segment) (DebugInfo_schema_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return DebugInfo_schema_Params(st), err
+}
+
+func ReadRootDebugInfo_schema_Params(msg *capnp.Message) (DebugInfo_schema_Params, error) {
+	root, err := msg.Root()
+	return DebugInfo_schema_Params(root.Struct()), err
+}
+
+func (s DebugInfo_schema_Params) String() string {
+	str, _ := text.Marshal(0xcc43dcbe8a3bccac, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_schema_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_schema_Params) DecodeFromPtr(p capnp.Ptr) DebugInfo_schema_Params {
+	return DebugInfo_schema_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_schema_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_schema_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_schema_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_schema_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s DebugInfo_schema_Params) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s DebugInfo_schema_Params) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// DebugInfo_schema_Params_List is a list of DebugInfo_schema_Params.
+type DebugInfo_schema_Params_List = capnp.StructList[DebugInfo_schema_Params]
+
+// NewDebugInfo_schema_Params creates a new list of DebugInfo_schema_Params.
+func NewDebugInfo_schema_Params_List(s *capnp.Segment, sz int32) (DebugInfo_schema_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[DebugInfo_schema_Params](l), err
+}
+
+// DebugInfo_schema_Params_Future is a wrapper for a DebugInfo_schema_Params promised by a client call.
+type DebugInfo_schema_Params_Future struct{ *capnp.Future }
+
+func (f DebugInfo_schema_Params_Future) Struct() (DebugInfo_schema_Params, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_schema_Params(p.Struct()), err
+}
+
+type DebugInfo_schema_Results capnp.Struct
+
+// DebugInfo_schema_Results_TypeID is the unique identifier for the type DebugInfo_schema_Results.
+const DebugInfo_schema_Results_TypeID = 0xe03e3a9bab449c63
+
+func NewDebugInfo_schema_Results(s *capnp.Segment) (DebugInfo_schema_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_schema_Results(st), err
+}
+
+func NewRootDebugInfo_schema_Results(s *capnp.Segment) (DebugInfo_schema_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return DebugInfo_schema_Results(st), err
+}
+
+func ReadRootDebugInfo_schema_Results(msg *capnp.Message) (DebugInfo_schema_Results, error) {
+	root, err := msg.Root()
+	return DebugInfo_schema_Results(root.Struct()), err
+}
+
+func (s DebugInfo_schema_Results) String() string {
+	str, _ := text.Marshal(0xe03e3a9bab449c63, capnp.Struct(s))
+	return str
+}
+
+func (s DebugInfo_schema_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DebugInfo_schema_Results) DecodeFromPtr(p capnp.Ptr) DebugInfo_schema_Results {
+	return DebugInfo_schema_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DebugInfo_schema_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DebugInfo_schema_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DebugInfo_schema_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DebugInfo_schema_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s DebugInfo_schema_Results) Request() ([]byte, error) {
+	return capnp.GetDataField(s, 0, "DebugInfo.schema$Results.request")
+}
+
+func (s DebugInfo_schema_Results) HasRequest() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s DebugInfo_schema_Results) SetRequest(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// RequestReader returns a reader of request's data, which
+// is read directly from the message.
+func (s DebugInfo_schema_Results) RequestReader() (*bytes.Reader, error) {
+	v, err := s.Request()
+	return bytes.NewReader(v), err
+}
+
+// SetRequestFromReader sets request to the next size bytes of r,
+// which are read directly into the message.
+func (s DebugInfo_schema_Results) SetRequestFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
+}
+
+// DebugInfo_schema_Results_List is a list of DebugInfo_schema_Results.
+type DebugInfo_schema_Results_List = capnp.StructList[DebugInfo_schema_Results]
+
+// NewDebugInfo_schema_Results creates a new list of DebugInfo_schema_Results.
+func NewDebugInfo_schema_Results_List(s *capnp.Segment, sz int32) (DebugInfo_schema_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[DebugInfo_schema_Results](l), err
+}
+
+// DebugInfo_schema_Results_Future is a wrapper for a DebugInfo_schema_Results promised by a client call.
+type DebugInfo_schema_Results_Future struct{ *capnp.Future }
+
+func (f DebugInfo_schema_Results_Future) Struct() (DebugInfo_schema_Results, error) {
+	p, err := f.Future.Ptr()
+	return DebugInfo_schema_Results(p.Struct()), err
+}
+
+type RuntimeInfo capnp.Struct
+
+// RuntimeInfo_TypeID is the unique identifier for the type RuntimeInfo.
+const RuntimeInfo_TypeID = 0xbccdb0299c6b006c
+
+func NewRuntimeInfo(s *capnp.Segment) (RuntimeInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
+	return RuntimeInfo(st), err
+}
+
+func NewRootRuntimeInfo(s *capnp.Segment) (RuntimeInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
+	return RuntimeInfo(st), err
+}
+
+func ReadRootRuntimeInfo(msg *capnp.Message) (RuntimeInfo, error) {
+	root, err := msg.Root()
+	return RuntimeInfo(root.Struct()), err
+}
+
+func (s RuntimeInfo) String() string {
+	str, _ := text.Marshal(0xbccdb0299c6b006c, capnp.Struct(s))
+	return str
+}
+
+func (s RuntimeInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (RuntimeInfo) DecodeFromPtr(p capnp.Ptr) RuntimeInfo {
+	return RuntimeInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s RuntimeInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s RuntimeInfo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s RuntimeInfo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s RuntimeInfo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s RuntimeInfo) GoVersion() (string, error) {
+	return capnp.GetTextField(s, 0, "RuntimeInfo.goVersion")
+}
+
+func (s RuntimeInfo) HasGoVersion() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s RuntimeInfo) GoVersionBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "RuntimeInfo.goVersion")
+}
+
+func (s RuntimeInfo) SetGoVersion(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s RuntimeInfo) Goroutines() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s RuntimeInfo) SetGoroutines(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s RuntimeInfo) Cpus() uint32 {
+	return capnp.Struct(s).Uint32(4)
+}
+
+func (s RuntimeInfo) SetCpus(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+func (s RuntimeInfo) HeapAllocBytes() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s RuntimeInfo) SetHeapAllocBytes(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+func (s RuntimeInfo) HeapObjects() uint64 {
+	return capnp.Struct(s).Uint64(16)
+}
+
+func (s RuntimeInfo) SetHeapObjects(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
+}
+
+func (s RuntimeInfo) GcCycles() uint32 {
+	return capnp.Struct(s).Uint32(24)
+}
+
+func (s RuntimeInfo) SetGcCycles(v uint32) {
+	capnp.Struct(s).SetUint32(24, v)
+}
+
+// RuntimeInfo_List is a list of RuntimeInfo.
+type RuntimeInfo_List = capnp.StructList[RuntimeInfo]
+
+// NewRuntimeInfo creates a new list of RuntimeInfo.
+func NewRuntimeInfo_List(s *capnp.Segment, sz int32) (RuntimeInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1}, sz)
+	return capnp.StructList[RuntimeInfo](l), err
+}
+
+// RuntimeInfo_Future is a wrapper for a RuntimeInfo promised by a client call.
+type RuntimeInfo_Future struct{ *capnp.Future }
+
+func (f RuntimeInfo_Future) Struct() (RuntimeInfo, error) {
+	p, err := f.Future.Ptr()
+	return RuntimeInfo(p.Struct()), err
+}
+
+const schema_87e5f7e4eccf381d = "x\xda\x8cUo\x88T\xd5\x1b~\x9fs\xe6\xaf\xce\xba" +
+	"s\xd9\x15~\xbf\xa52\x97-Rt[\xdd\x0f\xe9\x08" +
+	"\x8d\xed*5\xa6\xb6g\"\x8b\xa5/wg\xce\xac\xb7" +
+	"f\xee]\xe7\xde\xc1\xecC\xd2\x17\xa3\xd0\x0cRH2" +
+	"RP0]\xff\x81\x82\x84\x95\xd2?CE\x90\xd4\x02" +
+	"\x09\x8b\x0a?h\xf4\xc1\xc8\x82\xb8q\xee\x9d\xb9\xf7\xee" +
+	"&\xab\xdfv\xdf\xf3\xcc{\x9e\xf79\xcf\xfb\xdc\xbe\xcb" +
+	"|ilA[{\x86\x98\xd8\x1cO\xb8O_\xdf\xf4" +
+	"\xdb\xe5Y\xd6f\xd2\xba@\x14G\x92\xa8\xfff\xbc\x08" +
+	"B\xc7_\xf1<\xc1]\xb4\xed\xc9\x13\xf7\xcd8\xb9\x85" +
+	"D\x17\xe0.\xb943wf\xd3\x81\xed\x14S\xc0\xff" +
+	"'\xa6)\xe0\xec\xc4z\x82{\xf6\xeb\x17ow\xc7\xfb" +
+	"\xb7\xfa\x9d\xbc\xf3\xd7\x139u\xfef\"I\x91\x9f\x8a" +
+	",\xe0\xde\xbf\xe8\xc2\x8d\x9f\xff\xfc\xe5\x0d\x8a+dG" +
+	"-q\xb1cC\xe21\xa2\xfe\x1d\x89\xe7Ap\xbb\xaf" +
+	"t/\x89\xad\x19\xde\x11\xe5\xb5 5\xa0\xda-N)" +
+	"^\x87\x1e\xed\xfd\xec\xe1i\xfb?\x8c\x02\x8c\xd4\x88\x02" +
+	"4<@\xec\xd0\xa9}\x97\x1e\x98\xbfk\x12q\x1f\xb9" +
+	"7\xe51?\x98:Lp\xaf]\xcd\xdcz\xea\xed\xb3" +
+	"\xfbI\xcb\xf2\x90\x18\xa1\xa3\x90\xbeH\xe8_\x95N\xa2" +
+	"\xe3\xc7t\x92\xe8\x9f\xea\xcb;\xe7\x1c9\x7fRd\x11" +
+	"\x8bL\xa0:v|\x93\xfe\x89\xd0\x7f>\xed\xb1\xefz" +
+	"h\xe8\x9d\x8f\xc7\xdf\xfd\\\xdd\xcd\"w3\x85\x9c?" +
+	"\xfdoB\xff\x82\xe9\x1er\xfc\xdc\x92\xb7>\xbd:x" +
+	"\xcec\xd9\x94mK\xc6\x9bs{F\x8d\xd17\xf3\xc2" +
+	"w_m\xad\\\x89\xc8z<3\xac\xceOg\x94\xac" +
+	"\xaf\xcd3O?\xf2\xeb\xe1\x1f\"\xe7{3+\xd4\xf9" +
+	"Q\xef\xbc\xb4s\xd9\x81\xf7s\x8f_\x8b\xea\xb4\xdd\x07" +
+	"\xec\xce\xe4\xe9\xba[\x96#\x8dQ\xc3\xac\xc4\xac\xde\x92" +
+	">f\x8e\xe5\x96\xa9B\xc1\xacX\xbd\xf5\x86\xe9\x185" +
+	"\xd9S\x94v\xa3\xea\xc0\x161\x1e#\x8a\x81Hk\x9b" +
+	"K$R\x1c\xa2\x93\xa1\xdd0+\x16\xb2-u\x08\xc8" +
+	"\x12\x82\xbe\xbc\xd5w\xd02\xcdg\x1d\xdd\x91\xbd\x85\xda" +
+	"\x98Uw\xbc\x06\xad~s\xba\x88D\x0f\x87\xe8c\xd0" +
+	"\x80N\xa8\xe2\xfc\x15Db\x1e\x87X\xc4\xc0\x8d2R" +
+	"\xc4\x90\"\xb8\xeb\x8d\xba,\xca\x8aMDA\xed?\xd7" +
+	"\x85c\x94,\xd3\xb4{\xf2Cz]\xaf\xd9C<\x16" +
+	"`\xd9dj\x90\"\x83\xc8\xf3i\xda\xdc\xd0GZ[" +
+	".\xdc\x06-\x9dk\x1f\xd4\xab\xd5\xfc\xf2W\xd4,y" +
+	"\x7f$\xf1\xbf`\xa0\x1dE\"\xf1\x1e\x878\x16\x19\xe8" +
+	"\xe8\x00\x91\x18\xe7\x10g\x184\xc6:\xc1\x88\xb4/U" +
+	"\xf1\x14\x87\xb8\xca\xa0q\xde\x09N\xa4}\xaf\x8a\xdfr" +
+	"\x88\xdf\x19\x10\xebD\x8cH\xbb\xa9Z\xde\xe0\x10\xb7\x19" +
+	"\xb4\xf8\x83\x9d\x88\x13i\x7f(\x8dnq\x14\xc1\xe0\xae" +
+	"kH\xdb1,\x93`c\x06a\x88\x03\xd9p\x1a\x82" +
+	"*n\xd4M{\xbd\xacO\x01\x90\xdeH\x11@ A" +
+	"\x13`\xd4&\x01\x02Y|\x80+k#z}\xd4\x92" +
+	"\x8aG\xf0@u\xdd0\x0ds\x94\x14\x88\x18@\x98\xca" +
+	"{\xfe\xa3\xf9\xce\xb3)\xea\xbc\x85M\xe7\xf50\xcc\xf2" +
+	"P!\x8f`\xd3\x9a<\xa6\xe8o\x97\xd6\xca\x9a^(" +
+	"\xfbw$\xab\xce\x04ww\x87w$\x8drpC\x9a" +
+	"\x18f\xdc\xc9l\xa1\xb7}?\x10\x89L\xd0m\xb9\xf2" +
+	"\xf6R\x0e\xb1\x92\xa1\xe5\x84B\x8eH,\xe3\x10C\xca" +
+	"\x09\xf0\x9d\xb0J\xbd\xe5J\x0e\xf1\xc2\x04\xbf\xe7KU" +
+	"C\x9a\x0e2\xc4\x90\xb9\x8b\xfd\xd9\xe4Ia\x89,\x8f" +
+	"\x13\x05\xf9\x8cV\xb4j\xeb\x16\x12G\x98 h}\x0b" +
+	"\xb4\xe7\x06\x88\x83\x05\xd1\x83V\xd6j\x8b\x8b\xc4\xc1\x83" +
+	"\xd0B+\\\xb4\xd99\xe2\xfecll\x86\x86\xdb\x12" +
+	"\x98`\xe7\xfd\xbf\x87p\x07\x9eE\x1f^H\x9a\x15\xeb" +
+	"N\xeb\xb3'\xd4l\xf70\x91\xd8\xc5!\xc6#\x9a}" +
+	"\xa4\x82h\x0f\x878\xa2\xb6\x07\xfe\xf6\x1c|\xb5\xb9g" +
+	"'\x18\xb4\x18\xf3\xd7\xe7\xf8\x08\x918\xc6!N\xa9\xf5" +
+	"I\xf8\xeb\xf3\x89\x92\xfc\xa4\xbf\x91\xee\xa8\xb5F\xd6m" +
+	"\xc3\"\x98\x81\xd8\xa3V\xddj8\x86I\\\x06^n" +
+	"/\x8d5\x82\x7f\xdc\xb5R\x1f{\xa2Z\xb5(_\x1a" +
+	"\xd8\xe0H\xdbsI\xbay\xf0\xcc\xc8K\x92\x92%'" +
+	"\xac\x8e\x96\x067\x94\xaar\xea\xd7k\xf9)/{U" +
+	"\xcaD\x85Qf\xda\xc6!vEr\xe5\x035\xdaN" +
+	"\x0e\xb1/\x92+{W\x84\xca\xa0\x19+\x07\xebQa" +
+	"\xd0\x14f8*L_S\x18\xa5\xeb\x09\x0e\xf1\xc5\xc4" +
+	"\xec5LG\xd6+z\x89\x92\xb2P\x0e\x86\xaaIg" +
+	"\xadU.\x94\x89\x08IbHF\x91\xb3\xe4j\xbd&" +
+	"\x03E}\xecj\x9dxXl/[\xa6\xbc\x97X\xf0" +
+	"\x9d\xd4\xe3g\xf9\x84X\xe8\x0a?H\xdc\x08\x99\xddS" +
+	"\x04\xa8v|\xe2\xb7a\x8a\xcfa\xf3r\xba\x0b\xbcI" +
+	"\xb5\x15a\x13\xc8\x0e\x84d7\xd6\xa5\x17\xdbh#\x86" +
+	"6\xc2\xbf\x03\x00\x81\xe9w\xc6"
+
+func RegisterSchema(reg *schemas.Registry) {
+	reg.Register(&schemas.Schema{
+		String: schema_87e5f7e4eccf381d,
+		Nodes: []uint64{
+			0x8c6f1fd6ee86e84b,
+			0x8dbc0e1cb8479438,
+			0x8f330522f85cc7cb,
+			0x95ab86c83a15d53b,
+			0x995a56043b22d722,
+			0x9faa0a26bf2e2fae,
+			0xa02d1ed5a8c0ae04,
+			0xaacb8e48f40cdce0,
+			0xbccdb0299c6b006c,
+			0xc393acba9050251a,
+			0xcc43dcbe8a3bccac,
+			0xd7668fc6d8cf1530,
+			0xdeafe628c16e2c7e,
+			0xe03e3a9bab449c63,
+		},
+		Compressed: true,
+	})
+}
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_87e5f7e4eccf381d,
+		Compressed: true,
+	}
+	return s.Request()
+}
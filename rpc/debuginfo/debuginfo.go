@@ -0,0 +1,175 @@
+// Package debuginfo provides a capability that lets peers inspect a
+// vat's connections, runtime statistics, and schema registry over
+// Cap'n Proto itself, so that tooling can query vats uniformly.
+//
+// The information exposed can be sensitive.  The capability is never
+// offered automatically: applications should only hand it out to
+// trusted peers, for example from a method on their bootstrap
+// interface that requires authentication.
+package debuginfo
+
+import (
+	"context"
+	"runtime"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+// Options configures a DebugInfo server.
+type Options struct {
+	// Conns returns the connections to report on.  It is called for
+	// each request.  If nil, no connections are reported.
+	Conns func() []*rpc.Conn
+
+	// Registry is the schema registry to expose.  If nil,
+	// schemas.DefaultRegistry is used.  Generated packages add
+	// their nodes to a registry with their RegisterSchema function.
+	Registry *schemas.Registry
+}
+
+// New returns a DebugInfo capability that reports on the local vat.
+// opts may be nil.
+func New(opts *Options) DebugInfo {
+	s := &debugInfoServer{reg: schemas.DefaultRegistry}
+	if opts != nil {
+		s.conns = opts.Conns
+		if opts.Registry != nil {
+			s.reg = opts.Registry
+		}
+	}
+	return DebugInfo_ServerToClient(s)
+}
+
+type debugInfoServer struct {
+	conns func() []*rpc.Conn
+	reg   *schemas.Registry
+}
+
+func (s *debugInfoServer) Conns(ctx context.Context, call DebugInfo_conns) error {
+	var states []rpc.DebugState
+	if s.conns != nil {
+		for _, c := range s.conns() {
+			states = append(states, c.DebugState())
+		}
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	l, err := res.NewConns(int32(len(states)))
+	if err != nil {
+		return err
+	}
+	for i, st := range states {
+		if err := fillConnState(l.At(i), st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fillConnState(cs ConnState, st rpc.DebugState) error {
+	qs, err := cs.NewQuestions(int32(len(st.Questions)))
+	if err != nil {
+		return err
+	}
+	for i, q := range st.Questions {
+		if err := fillCall(qs.At(i), q.ID, q.Method, q.Finished); err != nil {
+			return err
+		}
+	}
+	as, err := cs.NewAnswers(int32(len(st.Answers)))
+	if err != nil {
+		return err
+	}
+	for i, a := range st.Answers {
+		if err := fillCall(as.At(i), a.ID, a.Method, a.Returned); err != nil {
+			return err
+		}
+	}
+	es, err := cs.NewExports(int32(len(st.Exports)))
+	if err != nil {
+		return err
+	}
+	for i, e := range st.Exports {
+		es.At(i).SetId(e.ID)
+		es.At(i).SetWireRefs(e.WireRefs)
+		if err := es.At(i).SetClient(e.Client); err != nil {
+			return err
+		}
+	}
+	is, err := cs.NewImports(int32(len(st.Imports)))
+	if err != nil {
+		return err
+	}
+	for i, imp := range st.Imports {
+		is.At(i).SetId(imp.ID)
+		is.At(i).SetWireRefs(uint32(imp.WireRefs))
+	}
+	cs.SetEmbargoes(uint32(st.Embargoes))
+	cs.SetDraining(st.Draining)
+	return nil
+}
+
+func fillCall(c ConnState_Call, id uint32, m capnp.Method, done bool) error {
+	c.SetId(id)
+	c.SetInterfaceId(m.InterfaceID)
+	c.SetMethodId(m.MethodID)
+	c.SetDone(done)
+	if err := c.SetInterfaceName(m.InterfaceName); err != nil {
+		return err
+	}
+	return c.SetMethodName(m.MethodName)
+}
+
+func (s *debugInfoServer) Runtime(ctx context.Context, call DebugInfo_runtime) error {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	info, err := res.NewInfo()
+	if err != nil {
+		return err
+	}
+	if err := info.SetGoVersion(runtime.Version()); err != nil {
+		return err
+	}
+	info.SetGoroutines(uint32(runtime.NumGoroutine()))
+	info.SetCpus(uint32(runtime.NumCPU()))
+	info.SetHeapAllocBytes(ms.HeapAlloc)
+	info.SetHeapObjects(ms.HeapObjects)
+	info.SetGcCycles(ms.NumGC)
+	return nil
+}
+
+func (s *debugInfoServer) SchemaIds(ctx context.Context, call DebugInfo_schemaIds) error {
+	ids := s.reg.IDs()
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	l, err := res.NewIds(int32(len(ids)))
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		l.Set(i, id)
+	}
+	return nil
+}
+
+func (s *debugInfoServer) Schema(ctx context.Context, call DebugInfo_schema) error {
+	data, err := s.reg.Find(call.Args().Id())
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetRequest(data)
+}
@@ -0,0 +1,117 @@
+package debuginfo_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/debuginfo"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/schemas"
+	schemacp "capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+func init() {
+	debuginfo.RegisterSchema(schemas.DefaultRegistry)
+}
+
+func TestDebugInfo(t *testing.T) {
+	t.Parallel()
+
+	var conn1 *rpc.Conn
+	srv := debuginfo.New(&debuginfo.Options{
+		Conns: func() []*rpc.Conn { return []*rpc.Conn{conn1} },
+	})
+	p1, p2 := transport.NewPipe(1)
+	conn1 = rpc.NewConn(rpc.NewTransport(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(srv),
+	})
+	defer conn1.Close()
+	conn2 := rpc.NewConn(rpc.NewTransport(p2), nil)
+	defer conn2.Close()
+
+	ctx := context.Background()
+	client := debuginfo.DebugInfo(conn2.Bootstrap(ctx))
+	defer client.Release()
+
+	t.Run("Conns", func(t *testing.T) {
+		fut, release := client.Conns(ctx, nil)
+		defer release()
+		res, err := fut.Struct()
+		require.NoError(t, err)
+		conns, err := res.Conns()
+		require.NoError(t, err)
+		require.Equal(t, 1, conns.Len())
+
+		answers, err := conns.At(0).Answers()
+		require.NoError(t, err)
+		var names []string
+		for i := 0; i < answers.Len(); i++ {
+			name, err := answers.At(i).MethodName()
+			require.NoError(t, err)
+			names = append(names, name)
+		}
+		assert.Contains(t, names, "conns", "the in-flight call should be reported")
+		exports, err := conns.At(0).Exports()
+		require.NoError(t, err)
+		assert.Equal(t, 1, exports.Len(), "bootstrap capability")
+	})
+
+	t.Run("Runtime", func(t *testing.T) {
+		fut, release := client.Runtime(ctx, nil)
+		defer release()
+		res, err := fut.Struct()
+		require.NoError(t, err)
+		info, err := res.Info()
+		require.NoError(t, err)
+		v, err := info.GoVersion()
+		require.NoError(t, err)
+		assert.Equal(t, runtime.Version(), v)
+		assert.NotZero(t, info.Goroutines())
+		assert.NotZero(t, info.HeapAllocBytes())
+	})
+
+	t.Run("Schemas", func(t *testing.T) {
+		fut, release := client.SchemaIds(ctx, nil)
+		defer release()
+		res, err := fut.Struct()
+		require.NoError(t, err)
+		ids, err := res.Ids()
+		require.NoError(t, err)
+		assert.Equal(t, len(schemas.DefaultRegistry.IDs()), ids.Len())
+
+		sfut, release := client.Schema(ctx, func(p debuginfo.DebugInfo_schema_Params) error {
+			p.SetId(debuginfo.DebugInfo_TypeID)
+			return nil
+		})
+		defer release()
+		sres, err := sfut.Struct()
+		require.NoError(t, err)
+		data, err := sres.Request()
+		require.NoError(t, err)
+		msg, err := capnp.Unmarshal(data)
+		require.NoError(t, err)
+		req, err := schemacp.ReadRootCodeGeneratorRequest(msg)
+		require.NoError(t, err)
+		nodes, err := req.Nodes()
+		require.NoError(t, err)
+		var found bool
+		for i := 0; i < nodes.Len(); i++ {
+			found = found || nodes.At(i).Id() == debuginfo.DebugInfo_TypeID
+		}
+		assert.True(t, found, "schema should contain the DebugInfo node")
+
+		bad, release := client.Schema(ctx, func(p debuginfo.DebugInfo_schema_Params) error {
+			p.SetId(0x1234)
+			return nil
+		})
+		defer release()
+		_, err = bad.Struct()
+		assert.Error(t, err, "unknown schema ID")
+	})
+}
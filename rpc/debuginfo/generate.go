@@ -0,0 +1,3 @@
+package debuginfo
+
+//go:generate capnp compile -I ../../std -ogo debuginfo.capnp
@@ -0,0 +1,145 @@
+// Package dump decodes captured Cap'n Proto RPC traffic and describes
+// its messages in a human-readable form, for debugging protocol
+// problems.  It reads packet captures in the pcap and pcapng formats
+// written by tcpdump and Wireshark, recordings made by
+// transport.NewRecorder, and plain streams of messages in the standard
+// framing.
+//
+// A Reader yields the messages of a capture, and a Printer describes
+// them, naming the methods of calls and returns from registered
+// schemas:
+//
+//	r, err := dump.NewReader(f, nil)
+//	if err != nil {
+//		return err
+//	}
+//	var p dump.Printer
+//	for {
+//		m, err := r.Next()
+//		if err == io.EOF {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//		p.Print(os.Stdout, m)
+//		m.Message.Release()
+//	}
+package dump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// A Message is an RPC message read from a capture.
+type Message struct {
+	// Time is when the message was captured, relative to the start of
+	// the capture.  It is zero if the capture has no timestamps.
+	Time time.Duration
+
+	// Conn identifies the connection that the message was sent on, and
+	// From and To identify its sender and receiver, like
+	// "127.0.0.1:4000".  Messages sent in either direction of a
+	// connection have the same Conn.  Conn is empty if the capture
+	// holds a single connection, and From and To are empty if it holds
+	// a single stream.
+	Conn     string
+	From, To string
+
+	// Message is an rpc.capnp Message.  The caller should release it
+	// when done with it.
+	Message *capnp.Message
+}
+
+// A Reader reads the messages of a capture, in the order that they
+// were captured.
+type Reader interface {
+	// Next returns the next message.  It returns io.EOF at the end of
+	// the capture.
+	Next() (Message, error)
+}
+
+// Options controls how captures are read.  The zero value reads every
+// connection.
+type Options struct {
+	// Port, if not zero, limits a packet capture to the TCP
+	// connections that have Port on either end.  Other captures only
+	// hold one connection.
+	Port uint16
+}
+
+// NewReader returns a Reader for the capture in r, which may be a
+// pcap or pcapng packet capture, a recording made by
+// transport.NewRecorder, or a stream of messages in the standard
+// framing.  The format is detected from the first bytes.  opts may be
+// nil.
+func NewReader(r io.Reader, opts *Options) (Reader, error) {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(8)
+	switch {
+	case isPcap(magic):
+		return NewPcapReader(br, opts)
+	case bytes.HasPrefix(magic, []byte("capnpre1")):
+		return &recordingReader{fr: transport.NewFrameReader(br)}, nil
+	default:
+		return NewStreamReader(br), nil
+	}
+}
+
+// NewStreamReader returns a Reader for a stream of messages in the
+// standard framing, as sent on a connection by transport.NewStream.
+func NewStreamReader(r io.Reader) Reader {
+	return streamReader{capnp.NewDecoder(r)}
+}
+
+type streamReader struct {
+	dec *capnp.Decoder
+}
+
+func (sr streamReader) Next() (Message, error) {
+	msg, err := sr.dec.Decode()
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Message: msg}, nil
+}
+
+// recordingReader reads a recording made by transport.NewRecorder.  The
+// recording vat is "local" and its peer is "remote".
+type recordingReader struct {
+	fr *transport.FrameReader
+}
+
+func (rr *recordingReader) Next() (Message, error) {
+	f, err := rr.fr.Next()
+	if err != nil {
+		return Message{}, err
+	}
+	m := Message{Time: f.Time, From: "remote", To: "local", Message: f.Message}
+	if f.Direction == transport.Outgoing {
+		m.From, m.To = m.To, m.From
+	}
+	return m, nil
+}
+
+// isPcap reports whether magic starts a pcap or pcapng file.
+func isPcap(magic []byte) bool {
+	if len(magic) < 4 {
+		return false
+	}
+	switch binary.LittleEndian.Uint32(magic) {
+	case pcapMagicMicro, pcapMagicNano, pcapngBlockSection:
+		return true
+	}
+	switch binary.BigEndian.Uint32(magic) {
+	case pcapMagicMicro, pcapMagicNano:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package dump_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/dump"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+func init() {
+	air.RegisterSchema(schemas.DefaultRegistry)
+}
+
+type echoServer struct{}
+
+func (echoServer) Echo(ctx context.Context, call air.Echo_echo) error {
+	in, err := call.Args().In()
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetOut(strings.ToUpper(in))
+}
+
+// recordEcho makes an echo call and returns a recording of it, made by
+// the calling vat.
+func recordEcho(t *testing.T) []byte {
+	t.Helper()
+	var rec bytes.Buffer
+	c1, c2 := transport.NewPipe(1)
+	client := rpc.NewConn(transport.NewRecorder(transport.New(c1), &rec, nil), nil)
+	server := rpc.NewConn(transport.New(c2), &rpc.Options{
+		BootstrapClient: capnp.Client(air.Echo_ServerToClient(echoServer{})),
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	echo := air.Echo(client.Bootstrap(ctx))
+	require.NoError(t, echo.Resolve(ctx))
+	f, release := echo.Echo(ctx, func(p air.Echo_echo_Params) error {
+		return p.SetIn("hi")
+	})
+	res, err := f.Struct()
+	require.NoError(t, err)
+	out, err := res.Out()
+	require.NoError(t, err)
+	require.Equal(t, "HI", out)
+	release()
+	echo.Release()
+	require.NoError(t, client.Close())
+	return rec.Bytes()
+}
+
+// readAll reads the messages of a capture.
+func readAll(t *testing.T, r dump.Reader) []dump.Message {
+	t.Helper()
+	var msgs []dump.Message
+	for {
+		m, err := r.Next()
+		if err == io.EOF {
+			return msgs
+		}
+		require.NoError(t, err)
+		msgs = append(msgs, m)
+	}
+}
+
+// printAll returns the descriptions of msgs.
+func printAll(t *testing.T, p *dump.Printer, msgs []dump.Message) string {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, m := range msgs {
+		require.NoError(t, p.Print(&buf, m))
+	}
+	return buf.String()
+}
+
+func TestRecording(t *testing.T) {
+	t.Parallel()
+
+	r, err := dump.NewReader(bytes.NewReader(recordEcho(t)), nil)
+	require.NoError(t, err)
+	msgs := readAll(t, r)
+	require.NotEmpty(t, msgs)
+	assert.Equal(t, "local", msgs[0].From)
+	assert.Equal(t, "remote", msgs[0].To)
+
+	out := printAll(t, &dump.Printer{Content: true}, msgs)
+	assert.Contains(t, out, "local > remote: bootstrap q=0\n")
+	assert.Contains(t, out, "remote > local: return a=0 bootstrap results caps=[senderHosted 0]\n")
+	assert.Contains(t, out, "local > remote: call q=0 aircraft.capnp:Echo.echo on import 0\n\t(in = \"hi\")\n")
+	assert.Contains(t, out, "remote > local: return a=0 aircraft.capnp:Echo.echo results\n\t(out = \"HI\")\n")
+	assert.Contains(t, out, "local > remote: finish q=0")
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	fr := transport.NewFrameReader(bytes.NewReader(recordEcho(t)))
+	var stream bytes.Buffer
+	enc := capnp.NewEncoder(&stream)
+	for {
+		f, err := fr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if f.Direction == transport.Outgoing {
+			require.NoError(t, enc.Encode(f.Message))
+		}
+	}
+
+	r, err := dump.NewReader(&stream, nil)
+	require.NoError(t, err)
+	msgs := readAll(t, r)
+	require.NotEmpty(t, msgs)
+	assert.Empty(t, msgs[0].From)
+
+	// Without a registry, methods are named by their IDs.
+	p := &dump.Printer{Registry: new(schemas.Registry)}
+	out := printAll(t, p, msgs)
+	assert.Contains(t, out, "bootstrap q=0\n")
+	assert.Contains(t, out, "call q=0 @0x8e5322c1e9282534.@0 on import 0\n")
+}
+
+func TestPrinterUnknownReturn(t *testing.T) {
+	t.Parallel()
+
+	r, err := dump.NewReader(bytes.NewReader(recordEcho(t)), nil)
+	require.NoError(t, err)
+	var returns []dump.Message
+	for _, m := range readAll(t, r) {
+		if m.From == "remote" {
+			returns = append(returns, m)
+		}
+	}
+	// Returns to calls that were not printed are not named.
+	out := printAll(t, new(dump.Printer), returns)
+	assert.Contains(t, out, "return a=0 results\n")
+	assert.NotContains(t, out, "Echo")
+}
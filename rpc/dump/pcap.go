@@ -0,0 +1,615 @@
+package dump
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// File magic numbers.
+const (
+	pcapMagicMicro     = 0xa1b2c3d4
+	pcapMagicNano      = 0xa1b23c4d
+	pcapngBlockSection = 0x0a0d0d0a
+	pcapngByteOrder    = 0x1a2b3c4d
+)
+
+// pcapng block types.
+const (
+	pcapngBlockInterface    = 1
+	pcapngBlockSimplePacket = 3
+	pcapngBlockEnhanced     = 6
+)
+
+// Link-layer header types, from https://www.tcpdump.org/linktypes.html.
+const (
+	linkNull      = 0
+	linkEthernet  = 1
+	linkRaw       = 101
+	linkLoop      = 108
+	linkLinuxSLL  = 113
+	linkIPv4      = 228
+	linkIPv6      = 229
+	linkLinuxSLL2 = 276
+)
+
+const (
+	// maxSegments is the number of out-of-order TCP segments buffered
+	// for a stream before it is considered to be missing data.
+	maxSegments = 1024
+
+	// maxMessageSize is the size above which a message header is
+	// considered to be garbage.
+	maxMessageSize = 64 << 20
+)
+
+// A StreamError reports that the messages sent in one direction of a
+// connection could not be decoded, typically because the capture
+// started in the middle of the connection or dropped packets.  The
+// stream is skipped from then on, and Next can be called again.
+type StreamError struct {
+	From, To string
+	Err      error
+}
+
+func (e *StreamError) Error() string {
+	return e.From + " > " + e.To + ": " + e.Err.Error()
+}
+
+func (e *StreamError) Unwrap() error {
+	return e.Err
+}
+
+// A PcapReader reads the RPC messages sent on the TCP connections of a
+// pcap or pcapng packet capture.  It reassembles each connection's
+// streams from the captured segments, so the capture must include the
+// packets' payloads: with tcpdump, capture with "-s 0".  IP fragments
+// are ignored.
+type PcapReader struct {
+	r    io.Reader
+	port uint16
+
+	ng    bool // whether the capture is in the pcapng format
+	order binary.ByteOrder
+
+	// link and nano describe the packets of a pcap capture.
+	link uint32
+	nano bool
+
+	// ifaces describes the interfaces of a pcapng capture's section.
+	ifaces []pcapngInterface
+
+	started bool
+	start   time.Duration // time of the first packet
+	streams map[flowKey]*tcpStream
+	ready   []Message
+	errs    []error
+}
+
+type pcapngInterface struct {
+	link uint32
+	unit time.Duration // of timestamps; zero means 1/resolution
+	res  uint64        // timestamps per second, if unit is zero
+}
+
+// NewPcapReader returns a reader for the pcap or pcapng capture in r.
+// opts may be nil.
+func NewPcapReader(r io.Reader, opts *Options) (*PcapReader, error) {
+	pr := &PcapReader{r: r, streams: make(map[flowKey]*tcpStream)}
+	if opts != nil {
+		pr.port = opts.Port
+	}
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, fmt.Errorf("read capture: %w", err)
+	}
+	switch {
+	case binary.LittleEndian.Uint32(magic[:]) == pcapngBlockSection:
+		pr.ng = true
+		if err := pr.readSectionHeader(); err != nil {
+			return nil, err
+		}
+		return pr, nil
+	case binary.LittleEndian.Uint32(magic[:]) == pcapMagicMicro:
+		pr.order = binary.LittleEndian
+	case binary.LittleEndian.Uint32(magic[:]) == pcapMagicNano:
+		pr.order, pr.nano = binary.LittleEndian, true
+	case binary.BigEndian.Uint32(magic[:]) == pcapMagicMicro:
+		pr.order = binary.BigEndian
+	case binary.BigEndian.Uint32(magic[:]) == pcapMagicNano:
+		pr.order, pr.nano = binary.BigEndian, true
+	default:
+		return nil, errors.New("read capture: not a pcap or pcapng file")
+	}
+	var hdr [20]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return nil, fmt.Errorf("read capture header: %w", unexpected(err))
+	}
+	// The upper bits of the link type hold FCS information.
+	pr.link = pr.order.Uint32(hdr[16:]) & 0xffff
+	return pr, nil
+}
+
+// Next returns the next message that was completely received, at the
+// time of the packet that completed it.  Errors decoding a stream are
+// returned as a *StreamError, after which Next can be called again.
+func (pr *PcapReader) Next() (Message, error) {
+	for len(pr.ready) == 0 && len(pr.errs) == 0 {
+		t, link, data, err := pr.readPacket()
+		if err != nil {
+			return Message{}, err
+		}
+		if !pr.started {
+			pr.started = true
+			pr.start = t
+		}
+		pr.handlePacket(t-pr.start, link, data)
+	}
+	if len(pr.errs) > 0 {
+		err := pr.errs[0]
+		pr.errs = pr.errs[1:]
+		return Message{}, err
+	}
+	m := pr.ready[0]
+	pr.ready[0] = Message{}
+	pr.ready = pr.ready[1:]
+	return m, nil
+}
+
+// readPacket reads the next packet of the capture, returning its
+// timestamp, link type and data.
+func (pr *PcapReader) readPacket() (time.Duration, uint32, []byte, error) {
+	if pr.ng {
+		return pr.readBlock()
+	}
+	var hdr [16]byte
+	if _, err := io.ReadFull(pr.r, hdr[:]); err == io.EOF {
+		return 0, 0, nil, io.EOF
+	} else if err != nil {
+		return 0, 0, nil, fmt.Errorf("read packet: %w", unexpected(err))
+	}
+	sec := time.Duration(pr.order.Uint32(hdr[0:]))
+	frac := time.Duration(pr.order.Uint32(hdr[4:]))
+	if !pr.nano {
+		frac *= time.Microsecond
+	}
+	n := pr.order.Uint32(hdr[8:])
+	if n > maxMessageSize {
+		return 0, 0, nil, errors.New("read packet: packet too large")
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(pr.r, data); err != nil {
+		return 0, 0, nil, fmt.Errorf("read packet: %w", unexpected(err))
+	}
+	return sec*time.Second + frac, pr.link, data, nil
+}
+
+// readSectionHeader reads a pcapng section header block, after its
+// block type.
+func (pr *PcapReader) readSectionHeader() error {
+	var hdr [8]byte
+	if _, err := io.ReadFull(pr.r, hdr[:]); err != nil {
+		return fmt.Errorf("read section header: %w", unexpected(err))
+	}
+	switch {
+	case binary.LittleEndian.Uint32(hdr[4:]) == pcapngByteOrder:
+		pr.order = binary.LittleEndian
+	case binary.BigEndian.Uint32(hdr[4:]) == pcapngByteOrder:
+		pr.order = binary.BigEndian
+	default:
+		return errors.New("read section header: bad byte-order magic")
+	}
+	n := pr.order.Uint32(hdr[:])
+	if n < 28 || n%4 != 0 {
+		return errors.New("read section header: bad block length")
+	}
+	// Skip the version, section length, options and trailing length.
+	if _, err := io.CopyN(io.Discard, pr.r, int64(n)-12); err != nil {
+		return fmt.Errorf("read section header: %w", unexpected(err))
+	}
+	pr.ifaces = pr.ifaces[:0]
+	return nil
+}
+
+// readBlock reads pcapng blocks until it finds a packet.
+func (pr *PcapReader) readBlock() (time.Duration, uint32, []byte, error) {
+	for {
+		var hdr [8]byte
+		if _, err := io.ReadFull(pr.r, hdr[:4]); err == io.EOF {
+			return 0, 0, nil, io.EOF
+		} else if err != nil {
+			return 0, 0, nil, fmt.Errorf("read block: %w", unexpected(err))
+		}
+		if binary.LittleEndian.Uint32(hdr[:]) == pcapngBlockSection {
+			if err := pr.readSectionHeader(); err != nil {
+				return 0, 0, nil, err
+			}
+			continue
+		}
+		if _, err := io.ReadFull(pr.r, hdr[4:]); err != nil {
+			return 0, 0, nil, fmt.Errorf("read block: %w", unexpected(err))
+		}
+		typ, n := pr.order.Uint32(hdr[:]), pr.order.Uint32(hdr[4:])
+		if n < 12 || n%4 != 0 || n > maxMessageSize {
+			return 0, 0, nil, errors.New("read block: bad block length")
+		}
+		body := make([]byte, n-8)
+		if _, err := io.ReadFull(pr.r, body); err != nil {
+			return 0, 0, nil, fmt.Errorf("read block: %w", unexpected(err))
+		}
+		body = body[:len(body)-4] // trailing length
+		switch typ {
+		case pcapngBlockInterface:
+			if len(body) < 8 {
+				return 0, 0, nil, errors.New("read interface block: too short")
+			}
+			pr.ifaces = append(pr.ifaces, pr.parseInterface(body))
+		case pcapngBlockEnhanced:
+			if len(body) < 20 {
+				return 0, 0, nil, errors.New("read packet block: too short")
+			}
+			id := pr.order.Uint32(body)
+			if int(id) >= len(pr.ifaces) {
+				return 0, 0, nil, errors.New("read packet block: unknown interface " + str.Utod(id))
+			}
+			iface := pr.ifaces[id]
+			ts := uint64(pr.order.Uint32(body[4:]))<<32 | uint64(pr.order.Uint32(body[8:]))
+			caplen := pr.order.Uint32(body[12:])
+			if uint64(caplen) > uint64(len(body)-20) {
+				return 0, 0, nil, errors.New("read packet block: bad captured length")
+			}
+			return iface.time(ts), iface.link, body[20 : 20+caplen], nil
+		case pcapngBlockSimplePacket:
+			if len(pr.ifaces) == 0 {
+				return 0, 0, nil, errors.New("read packet block: no interface")
+			}
+			if len(body) < 4 {
+				return 0, 0, nil, errors.New("read packet block: too short")
+			}
+			data := body[4:]
+			if orig := pr.order.Uint32(body); uint64(orig) < uint64(len(data)) {
+				data = data[:orig]
+			}
+			// Simple packets have no timestamp.
+			return 0, pr.ifaces[0].link, data, nil
+		}
+	}
+}
+
+// parseInterface parses the body of an interface description block.
+func (pr *PcapReader) parseInterface(body []byte) pcapngInterface {
+	iface := pcapngInterface{
+		link: uint32(pr.order.Uint16(body)),
+		unit: time.Microsecond,
+	}
+	opts := body[8:]
+	for len(opts) >= 4 {
+		code, n := pr.order.Uint16(opts), int(pr.order.Uint16(opts[2:]))
+		if code == 0 || 4+n > len(opts) {
+			break
+		}
+		if code == 9 && n >= 1 { // if_tsresol
+			r := opts[4]
+			iface.unit, iface.res = 0, 1
+			for i := 0; i < int(r&0x7f) && iface.res < 1<<62; i++ {
+				if r&0x80 != 0 {
+					iface.res *= 2
+				} else {
+					iface.res *= 10
+				}
+			}
+			if iface.res <= uint64(time.Second) && uint64(time.Second)%iface.res == 0 {
+				iface.unit = time.Second / time.Duration(iface.res)
+			}
+		}
+		opts = opts[4+(n+3)&^3:]
+	}
+	return iface
+}
+
+// time converts a timestamp in the interface's resolution.
+func (iface pcapngInterface) time(ts uint64) time.Duration {
+	if iface.unit != 0 {
+		return time.Duration(ts) * iface.unit
+	}
+	sec := ts / iface.res
+	frac := ts % iface.res
+	return time.Duration(sec)*time.Second + time.Duration(frac*uint64(time.Second)/iface.res)
+}
+
+// handlePacket feeds the TCP segment in a captured packet, if any, to
+// its stream.
+func (pr *PcapReader) handlePacket(t time.Duration, link uint32, data []byte) {
+	var ok bool
+	switch link {
+	case linkEthernet:
+		data, ok = skipEthernet(data)
+	case linkNull, linkLoop:
+		data, ok = skip(data, 4)
+	case linkRaw, linkIPv4, linkIPv6:
+		ok = true
+	case linkLinuxSLL:
+		data, ok = skip(data, 16)
+	case linkLinuxSLL2:
+		data, ok = skip(data, 20)
+	default:
+		pr.errs = append(pr.errs, errors.New("unsupported link type "+str.Utod(link)))
+		return
+	}
+	if !ok {
+		return
+	}
+	src, dst, seg, ok := parseIP(data)
+	if !ok || len(seg) < 20 {
+		return
+	}
+	srcPort := binary.BigEndian.Uint16(seg)
+	dstPort := binary.BigEndian.Uint16(seg[2:])
+	if pr.port != 0 && srcPort != pr.port && dstPort != pr.port {
+		return
+	}
+	off := int(seg[12]>>4) * 4
+	if off < 20 || off > len(seg) {
+		return
+	}
+	key := flowKey{
+		src: netip.AddrPortFrom(src, srcPort),
+		dst: netip.AddrPortFrom(dst, dstPort),
+	}
+	s := pr.streams[key]
+	if s == nil {
+		s = new(tcpStream)
+		pr.streams[key] = s
+	}
+	flags := seg[13]
+	msgs, err := s.add(binary.BigEndian.Uint32(seg[4:]), flags, seg[off:])
+	for _, msg := range msgs {
+		pr.ready = append(pr.ready, Message{
+			Time:    t,
+			Conn:    key.conn(),
+			From:    key.src.String(),
+			To:      key.dst.String(),
+			Message: msg,
+		})
+	}
+	if err != nil {
+		pr.errs = append(pr.errs, &StreamError{
+			From: key.src.String(),
+			To:   key.dst.String(),
+			Err:  err,
+		})
+	}
+	if flags&(tcpFIN|tcpRST) != 0 {
+		// The connection may be reused by a later one.
+		delete(pr.streams, key)
+	}
+}
+
+func skip(data []byte, n int) ([]byte, bool) {
+	if len(data) < n {
+		return nil, false
+	}
+	return data[n:], true
+}
+
+// skipEthernet returns the IP packet in an Ethernet frame.
+func skipEthernet(data []byte) ([]byte, bool) {
+	if len(data) < 14 {
+		return nil, false
+	}
+	typ, data := binary.BigEndian.Uint16(data[12:]), data[14:]
+	for typ == 0x8100 || typ == 0x88a8 { // VLAN tags
+		if len(data) < 4 {
+			return nil, false
+		}
+		typ, data = binary.BigEndian.Uint16(data[2:]), data[4:]
+	}
+	return data, typ == 0x0800 || typ == 0x86dd
+}
+
+// parseIP returns the addresses and TCP segment of an IP packet.  ok is
+// false if the packet does not hold a whole TCP segment.
+func parseIP(data []byte) (src, dst netip.Addr, seg []byte, ok bool) {
+	if len(data) < 1 {
+		return
+	}
+	switch data[0] >> 4 {
+	case 4:
+		if len(data) < 20 {
+			return
+		}
+		ihl := int(data[0]&0xf) * 4
+		total := int(binary.BigEndian.Uint16(data[2:]))
+		frag := binary.BigEndian.Uint16(data[6:])
+		if ihl < 20 || total < ihl || total > len(data) || frag&0x3fff != 0 || data[9] != 6 {
+			return
+		}
+		src = netip.AddrFrom4(*(*[4]byte)(data[12:16]))
+		dst = netip.AddrFrom4(*(*[4]byte)(data[16:20]))
+		return src, dst, data[ihl:total], true
+	case 6:
+		if len(data) < 40 {
+			return
+		}
+		end := 40 + int(binary.BigEndian.Uint16(data[4:]))
+		if end > len(data) {
+			return
+		}
+		src = netip.AddrFrom16(*(*[16]byte)(data[8:24]))
+		dst = netip.AddrFrom16(*(*[16]byte)(data[24:40]))
+		next, off := data[6], 40
+		// Skip the extension headers that may precede TCP.
+		for next == 0 || next == 43 || next == 60 {
+			if off+8 > end {
+				return
+			}
+			next, off = data[off], off+(int(data[off+1])+1)*8
+		}
+		if next != 6 || off > end {
+			return
+		}
+		return src, dst, data[off:end], true
+	}
+	return
+}
+
+// TCP flags.
+const (
+	tcpFIN = 0x01
+	tcpSYN = 0x02
+	tcpRST = 0x04
+)
+
+// A flowKey identifies one direction of a TCP connection.
+type flowKey struct {
+	src, dst netip.AddrPort
+}
+
+// conn returns the name of the connection, which is the same for both
+// directions.
+func (k flowKey) conn() string {
+	a, b := k.src.String(), k.dst.String()
+	if b < a {
+		a, b = b, a
+	}
+	return a + " <-> " + b
+}
+
+// A tcpStream reassembles the bytes sent in one direction of a TCP
+// connection and splits them into messages.
+type tcpStream struct {
+	started bool
+	next    uint32            // sequence number of the next byte
+	pending map[uint32][]byte // out-of-order segments
+	buf     []byte
+	err     error
+}
+
+// add adds a segment to the stream and returns the messages that it
+// completes.
+func (s *tcpStream) add(seq uint32, flags byte, payload []byte) ([]*capnp.Message, error) {
+	if s.err != nil {
+		return nil, nil
+	}
+	if flags&tcpSYN != 0 {
+		s.started, s.next = true, seq+1
+		s.pending, s.buf = nil, nil
+		return nil, nil
+	}
+	if len(payload) == 0 {
+		return nil, nil
+	}
+	if !s.started {
+		// The capture started after the handshake: hope that it
+		// started at a message boundary.
+		s.started, s.next = true, seq
+	}
+	if !s.insert(seq, payload) {
+		return nil, nil
+	}
+	for len(s.pending) > 0 {
+		progress := false
+		for seq, p := range s.pending {
+			if int32(seq-s.next) <= 0 {
+				delete(s.pending, seq)
+				s.insert(seq, p)
+				progress = true
+			}
+		}
+		if !progress {
+			break
+		}
+	}
+	if len(s.pending) > maxSegments {
+		return nil, s.fail(errors.New("missing TCP segments"))
+	}
+
+	var msgs []*capnp.Message
+	for {
+		n, err := messageSize(s.buf)
+		if err != nil {
+			return msgs, s.fail(err)
+		}
+		if n == 0 {
+			return msgs, nil
+		}
+		msg, err := capnp.Unmarshal(append([]byte(nil), s.buf[:n]...))
+		if err != nil {
+			return msgs, s.fail(err)
+		}
+		msgs = append(msgs, msg)
+		s.buf = append(s.buf[:0], s.buf[n:]...)
+	}
+}
+
+// insert appends the part of a segment that follows the received bytes
+// to the buffer, or saves it for later if bytes are missing before it.
+// It reports whether any bytes were appended.
+func (s *tcpStream) insert(seq uint32, payload []byte) bool {
+	d := int32(seq - s.next)
+	if d > 0 {
+		if s.pending == nil {
+			s.pending = make(map[uint32][]byte)
+		}
+		if p, ok := s.pending[seq]; !ok || len(p) < len(payload) {
+			s.pending[seq] = append([]byte(nil), payload...)
+		}
+		return false
+	}
+	if int(-d) >= len(payload) {
+		return false // retransmission
+	}
+	payload = payload[-d:]
+	s.buf = append(s.buf, payload...)
+	s.next += uint32(len(payload))
+	return true
+}
+
+// fail stops decoding the stream.
+func (s *tcpStream) fail(err error) error {
+	s.err = err
+	s.pending, s.buf = nil, nil
+	return err
+}
+
+// messageSize returns the size of the message at the start of b in the
+// standard framing, or zero if b does not hold all of it.
+func messageSize(b []byte) (int, error) {
+	if len(b) < 4 {
+		return 0, nil
+	}
+	nseg := uint64(binary.LittleEndian.Uint32(b)) + 1
+	hdr := (4 + 4*nseg + 7) &^ 7
+	if hdr > maxMessageSize {
+		return 0, errors.New("bad message header: too many segments")
+	}
+	if uint64(len(b)) < hdr {
+		return 0, nil
+	}
+	total := hdr
+	for i := uint64(0); i < nseg; i++ {
+		total += 8 * uint64(binary.LittleEndian.Uint32(b[4+4*i:]))
+		if total > maxMessageSize {
+			return 0, errors.New("bad message header: message too large")
+		}
+	}
+	if uint64(len(b)) < total {
+		return 0, nil
+	}
+	return int(total), nil
+}
+
+// unexpected converts io.EOF in the middle of a structure to
+// io.ErrUnexpectedEOF.
+func unexpected(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
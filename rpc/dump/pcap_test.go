@@ -0,0 +1,263 @@
+package dump_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3/rpc/dump"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+var (
+	clientAddr = [4]byte{10, 0, 0, 1}
+	serverAddr = [4]byte{10, 0, 0, 2}
+)
+
+const (
+	clientPort = 40000
+	serverPort = 4000
+)
+
+// A packet is a captured TCP segment.
+type packet struct {
+	t        time.Duration
+	src, dst [4]byte
+	sport    uint16
+	dport    uint16
+	seq      uint32
+	flags    byte
+	payload  []byte
+}
+
+// ethernet returns the packet as an Ethernet frame.
+func (p packet) ethernet() []byte {
+	b := make([]byte, 14+20+20, 14+20+20+len(p.payload))
+	binary.BigEndian.PutUint16(b[12:], 0x0800)
+	ip := b[14:]
+	ip[0] = 0x45
+	binary.BigEndian.PutUint16(ip[2:], uint16(40+len(p.payload)))
+	ip[8] = 64
+	ip[9] = 6
+	copy(ip[12:], p.src[:])
+	copy(ip[16:], p.dst[:])
+	tcp := ip[20:]
+	binary.BigEndian.PutUint16(tcp, p.sport)
+	binary.BigEndian.PutUint16(tcp[2:], p.dport)
+	binary.BigEndian.PutUint32(tcp[4:], p.seq)
+	tcp[12] = 5 << 4
+	tcp[13] = p.flags | 0x10 // ACK
+	return append(b, p.payload...)
+}
+
+// capture returns packets carrying the messages of a recording on a TCP
+// connection.  Each message is split in two segments, the segments of
+// the second message are swapped, and the first segment of the third
+// message is sent twice.  Unrelated traffic is captured on port 80.
+func capture(t *testing.T, rec []byte) (packets []packet, nmsgs int) {
+	t.Helper()
+	seqs := map[transport.Direction]uint32{
+		transport.Outgoing: 1000,
+		transport.Incoming: 5000,
+	}
+	now := time.Duration(0)
+	add := func(d transport.Direction, flags byte, payload []byte) {
+		p := packet{
+			t:       now,
+			src:     clientAddr,
+			dst:     serverAddr,
+			sport:   clientPort,
+			dport:   serverPort,
+			seq:     seqs[d],
+			flags:   flags,
+			payload: payload,
+		}
+		if d == transport.Incoming {
+			p.src, p.dst = p.dst, p.src
+			p.sport, p.dport = p.dport, p.sport
+		}
+		packets = append(packets, p)
+		now += time.Millisecond
+	}
+	add(transport.Outgoing, 0x02, nil) // SYN
+	add(transport.Incoming, 0x02, nil)
+	seqs[transport.Outgoing]++
+	seqs[transport.Incoming]++
+	packets = append(packets, packet{
+		src: clientAddr, dst: serverAddr, sport: 50000, dport: 80,
+		seq: 1, payload: []byte("GET / HTTP/1.1\r\n\r\n"),
+	})
+
+	fr := transport.NewFrameReader(bytes.NewReader(rec))
+	for ; ; nmsgs++ {
+		f, err := fr.Next()
+		if err == io.EOF {
+			return packets, nmsgs
+		}
+		require.NoError(t, err)
+		data, err := f.Message.Marshal()
+		require.NoError(t, err)
+		f.Message.Release()
+		half := len(data) / 2
+		seq := seqs[f.Direction]
+		switch nmsgs {
+		case 1:
+			seqs[f.Direction] = seq + uint32(half)
+			add(f.Direction, 0, data[half:])
+			seqs[f.Direction] = seq
+			add(f.Direction, 0, data[:half])
+		case 2:
+			add(f.Direction, 0, data[:half])
+			add(f.Direction, 0, data[:half])
+			seqs[f.Direction] = seq + uint32(half)
+			add(f.Direction, 0, data[half:])
+		default:
+			add(f.Direction, 0, data[:half])
+			seqs[f.Direction] = seq + uint32(half)
+			add(f.Direction, 0, data[half:])
+		}
+		seqs[f.Direction] = seq + uint32(len(data))
+	}
+}
+
+func writePcap(packets []packet) []byte {
+	var buf bytes.Buffer
+	hdr := make([]byte, 24)
+	binary.LittleEndian.PutUint32(hdr, 0xa1b2c3d4)
+	binary.LittleEndian.PutUint16(hdr[4:], 2)
+	binary.LittleEndian.PutUint16(hdr[6:], 4)
+	binary.LittleEndian.PutUint32(hdr[16:], 65535)
+	binary.LittleEndian.PutUint32(hdr[20:], 1) // Ethernet
+	buf.Write(hdr)
+	for _, p := range packets {
+		data := p.ethernet()
+		rec := make([]byte, 16)
+		t := p.t + 1700000000*time.Second
+		binary.LittleEndian.PutUint32(rec, uint32(t/time.Second))
+		binary.LittleEndian.PutUint32(rec[4:], uint32(t%time.Second/time.Microsecond))
+		binary.LittleEndian.PutUint32(rec[8:], uint32(len(data)))
+		binary.LittleEndian.PutUint32(rec[12:], uint32(len(data)))
+		buf.Write(rec)
+		buf.Write(data)
+	}
+	return buf.Bytes()
+}
+
+func writePcapng(packets []packet) []byte {
+	var buf bytes.Buffer
+	block := func(typ uint32, body []byte) {
+		for len(body)%4 != 0 {
+			body = append(body, 0)
+		}
+		n := uint32(12 + len(body))
+		binary.Write(&buf, binary.BigEndian, typ)
+		binary.Write(&buf, binary.BigEndian, n)
+		buf.Write(body)
+		binary.Write(&buf, binary.BigEndian, n)
+	}
+	// Section header: byte-order magic, version 1.0, unknown length.
+	block(0x0a0d0d0a, []byte{
+		0x1a, 0x2b, 0x3c, 0x4d, 0, 1, 0, 0,
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	})
+	// Interface: Ethernet, with nanosecond timestamps.
+	block(1, []byte{
+		0, 1, 0, 0, 0, 0, 0, 0,
+		0, 9, 0, 1, 9, 0, 0, 0, // if_tsresol
+		0, 0, 0, 0, // opt_endofopt
+	})
+	for _, p := range packets {
+		data := p.ethernet()
+		body := make([]byte, 20, 20+len(data))
+		ts := uint64(p.t + 1700000000*time.Second)
+		binary.BigEndian.PutUint32(body[4:], uint32(ts>>32))
+		binary.BigEndian.PutUint32(body[8:], uint32(ts))
+		binary.BigEndian.PutUint32(body[12:], uint32(len(data)))
+		binary.BigEndian.PutUint32(body[16:], uint32(len(data)))
+		block(6, append(body, data...))
+	}
+	return buf.Bytes()
+}
+
+func TestPcap(t *testing.T) {
+	t.Parallel()
+
+	packets, nmsgs := capture(t, recordEcho(t))
+	formats := []struct {
+		name string
+		data []byte
+	}{
+		{"pcap", writePcap(packets)},
+		{"pcapng", writePcapng(packets)},
+	}
+	for _, f := range formats {
+		f := f
+		t.Run(f.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := dump.NewReader(bytes.NewReader(f.data), &dump.Options{Port: serverPort})
+			require.NoError(t, err)
+			msgs := readAll(t, r)
+			require.Len(t, msgs, nmsgs)
+			assert.Equal(t, "10.0.0.1:40000", msgs[0].From)
+			assert.Equal(t, "10.0.0.2:4000", msgs[0].To)
+			assert.Equal(t, "10.0.0.1:40000 <-> 10.0.0.2:4000", msgs[0].Conn)
+			assert.Equal(t, 3*time.Millisecond, msgs[0].Time)
+
+			out := printAll(t, new(dump.Printer), msgs)
+			assert.Contains(t, out, "10.0.0.1:40000 > 10.0.0.2:4000: call q=0 aircraft.capnp:Echo.echo on import 0\n")
+			assert.Contains(t, out, "10.0.0.2:4000 > 10.0.0.1:40000: return a=0 aircraft.capnp:Echo.echo results\n")
+		})
+	}
+}
+
+func TestPcapStreamError(t *testing.T) {
+	t.Parallel()
+
+	packets, nmsgs := capture(t, recordEcho(t))
+	r, err := dump.NewReader(bytes.NewReader(writePcap(packets)), nil)
+	require.NoError(t, err)
+
+	// The HTTP request cannot be decoded, but the RPC connection can.
+	var streamErr *dump.StreamError
+	n := 0
+	for {
+		m, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if errors.As(err, &streamErr) {
+			continue
+		}
+		require.NoError(t, err)
+		m.Message.Release()
+		n++
+	}
+	require.NotNil(t, streamErr)
+	assert.Equal(t, "10.0.0.1:50000", streamErr.From)
+	assert.Equal(t, "10.0.0.2:80", streamErr.To)
+	assert.Equal(t, nmsgs, n)
+}
+
+func TestPcapTruncated(t *testing.T) {
+	t.Parallel()
+
+	packets, _ := capture(t, recordEcho(t))
+	data := writePcap(packets)
+	r, err := dump.NewReader(bytes.NewReader(data[:len(data)-3]), nil)
+	require.NoError(t, err)
+	for {
+		_, err = r.Next()
+		var streamErr *dump.StreamError
+		if !errors.As(err, &streamErr) && err != nil {
+			break
+		}
+	}
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
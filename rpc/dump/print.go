@@ -0,0 +1,400 @@
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/text"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/schemas"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// A Printer writes a line describing each RPC message, like:
+//
+//	0.001503 127.0.0.1:4000 > 127.0.0.1:37926: return a=1 aircraft.capnp:Echo.echo results
+//
+// Method names are looked up in the registry from the interface and
+// method IDs of calls.  A Printer remembers the calls that it has
+// printed so that it can name the methods that returns answer, so
+// messages should be printed in the order that they were captured.
+//
+// The zero value prints with schemas.DefaultRegistry.  A Printer must
+// not be used concurrently.
+type Printer struct {
+	// Registry holds the schemas of the interfaces whose methods are
+	// named.  If nil, schemas.DefaultRegistry is used.  It must not be
+	// changed after the first message is printed.
+	Registry *schemas.Registry
+
+	// Content, if true, also prints the parameters of calls and the
+	// results of returns in the text format on the following line, if
+	// the method's schema is registered.
+	Content bool
+
+	init  bool
+	nodes nodemap.Map
+	calls map[callKey]capnp.Method
+}
+
+// A callKey identifies a question on a connection.
+type callKey struct {
+	conn, caller string
+	id           uint32
+}
+
+// Print writes a description of m to w.
+func (p *Printer) Print(w io.Writer, m Message) error {
+	if !p.init {
+		p.init = true
+		if p.Registry != nil {
+			p.nodes.UseRegistry(p.Registry)
+		}
+		p.calls = make(map[callKey]capnp.Method)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(strconv.FormatFloat(m.Time.Seconds(), 'f', 6, 64))
+	if m.From != "" || m.To != "" {
+		fmt.Fprintf(&buf, " %s > %s:", m.From, m.To)
+	}
+	buf.WriteByte(' ')
+	rmsg, err := rpccp.ReadRootMessage(m.Message)
+	if err != nil {
+		fmt.Fprintf(&buf, "malformed message: %v\n", err)
+		_, err = w.Write(buf.Bytes())
+		return err
+	}
+	content := p.describe(&buf, m, rmsg)
+	buf.WriteByte('\n')
+	if content != nil {
+		buf.WriteByte('\t')
+		buf.Write(content)
+		buf.WriteByte('\n')
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// describe writes a description of msg to buf, returning its content
+// in the text format if it should be printed.
+func (p *Printer) describe(buf *bytes.Buffer, m Message, msg rpccp.Message) []byte {
+	switch msg.Which() {
+	case rpccp.Message_Which_unimplemented:
+		buf.WriteString("unimplemented: ")
+		inner, err := msg.Unimplemented()
+		if err != nil {
+			fmt.Fprintf(buf, "malformed message: %v", err)
+			return nil
+		}
+		// The reflected message was sent by the receiver.
+		reflected := m
+		reflected.From, reflected.To = m.To, m.From
+		p.describe(buf, reflected, inner)
+		return nil
+	case rpccp.Message_Which_abort:
+		buf.WriteString("abort: ")
+		e, err := msg.Abort()
+		describeException(buf, e, err)
+		return nil
+	case rpccp.Message_Which_bootstrap:
+		bs, _ := msg.Bootstrap()
+		fmt.Fprintf(buf, "bootstrap q=%d", bs.QuestionId())
+		p.remember(m, bs.QuestionId(), capnp.Method{})
+		return nil
+	case rpccp.Message_Which_call:
+		call, _ := msg.Call()
+		method := p.methodName(capnp.Method{
+			InterfaceID: call.InterfaceId(),
+			MethodID:    call.MethodId(),
+		})
+		fmt.Fprintf(buf, "call q=%d %v on ", call.QuestionId(), &method)
+		tgt, err := call.Target()
+		describeTarget(buf, tgt, err)
+		switch call.SendResultsTo().Which() {
+		case rpccp.Call_sendResultsTo_Which_yourself:
+			buf.WriteString(" sendResultsTo=yourself")
+		case rpccp.Call_sendResultsTo_Which_thirdParty:
+			buf.WriteString(" sendResultsTo=thirdParty")
+		}
+		p.remember(m, call.QuestionId(), method)
+		params, err := call.Params()
+		if err != nil {
+			fmt.Fprintf(buf, " malformed params: %v", err)
+			return nil
+		}
+		describeCaps(buf, params)
+		if !p.Content {
+			return nil
+		}
+		return p.content(method, params, func(sm schema.Method) uint64 {
+			return sm.ParamStructType()
+		})
+	case rpccp.Message_Which_return:
+		ret, _ := msg.Return()
+		fmt.Fprintf(buf, "return a=%d", ret.AnswerId())
+		method, known := p.answered(m, ret.AnswerId())
+		if known {
+			if method == (capnp.Method{}) {
+				buf.WriteString(" bootstrap")
+			} else {
+				fmt.Fprintf(buf, " %v", &method)
+			}
+		}
+		switch ret.Which() {
+		case rpccp.Return_Which_results:
+			buf.WriteString(" results")
+			res, err := ret.Results()
+			if err != nil {
+				fmt.Fprintf(buf, " malformed results: %v", err)
+				return nil
+			}
+			describeCaps(buf, res)
+			if !p.Content || !known || method == (capnp.Method{}) {
+				return nil
+			}
+			return p.content(method, res, func(sm schema.Method) uint64 {
+				return sm.ResultStructType()
+			})
+		case rpccp.Return_Which_exception:
+			buf.WriteString(" exception: ")
+			e, err := ret.Exception()
+			describeException(buf, e, err)
+		case rpccp.Return_Which_takeFromOtherQuestion:
+			fmt.Fprintf(buf, " takeFromOtherQuestion=%d", ret.TakeFromOtherQuestion())
+		default:
+			fmt.Fprintf(buf, " %v", ret.Which())
+		}
+		if !ret.ReleaseParamCaps() {
+			buf.WriteString(" releaseParamCaps=false")
+		}
+		return nil
+	case rpccp.Message_Which_finish:
+		fin, _ := msg.Finish()
+		fmt.Fprintf(buf, "finish q=%d", fin.QuestionId())
+		if !fin.ReleaseResultCaps() {
+			buf.WriteString(" releaseResultCaps=false")
+		}
+		return nil
+	case rpccp.Message_Which_resolve:
+		res, _ := msg.Resolve()
+		fmt.Fprintf(buf, "resolve promise=%d ", res.PromiseId())
+		switch res.Which() {
+		case rpccp.Resolve_Which_cap:
+			desc, err := res.Cap()
+			if err != nil {
+				fmt.Fprintf(buf, "malformed cap: %v", err)
+			} else {
+				describeCap(buf, desc)
+			}
+		case rpccp.Resolve_Which_exception:
+			buf.WriteString("exception: ")
+			e, err := res.Exception()
+			describeException(buf, e, err)
+		}
+		return nil
+	case rpccp.Message_Which_release:
+		rel, _ := msg.Release()
+		fmt.Fprintf(buf, "release import=%d count=%d", rel.Id(), rel.ReferenceCount())
+		return nil
+	case rpccp.Message_Which_disembargo:
+		d, _ := msg.Disembargo()
+		buf.WriteString("disembargo ")
+		tgt, err := d.Target()
+		describeTarget(buf, tgt, err)
+		ctx := d.Context()
+		switch ctx.Which() {
+		case rpccp.Disembargo_context_Which_senderLoopback:
+			fmt.Fprintf(buf, " senderLoopback=%d", ctx.SenderLoopback())
+		case rpccp.Disembargo_context_Which_receiverLoopback:
+			fmt.Fprintf(buf, " receiverLoopback=%d", ctx.ReceiverLoopback())
+		case rpccp.Disembargo_context_Which_accept:
+			buf.WriteString(" accept")
+		case rpccp.Disembargo_context_Which_provide:
+			fmt.Fprintf(buf, " provide=%d", ctx.Provide())
+		}
+		return nil
+	case rpccp.Message_Which_provide:
+		prov, _ := msg.Provide()
+		fmt.Fprintf(buf, "provide q=%d ", prov.QuestionId())
+		tgt, err := prov.Target()
+		describeTarget(buf, tgt, err)
+		return nil
+	case rpccp.Message_Which_accept:
+		acc, _ := msg.Accept()
+		fmt.Fprintf(buf, "accept q=%d", acc.QuestionId())
+		if acc.Embargo() {
+			buf.WriteString(" embargo")
+		}
+		return nil
+	case rpccp.Message_Which_join:
+		join, _ := msg.Join()
+		fmt.Fprintf(buf, "join q=%d ", join.QuestionId())
+		tgt, err := join.Target()
+		describeTarget(buf, tgt, err)
+		return nil
+	default:
+		buf.WriteString(msg.Which().String())
+		return nil
+	}
+}
+
+// remember records the method of a question sent in m.
+func (p *Printer) remember(m Message, id uint32, method capnp.Method) {
+	if m.From == m.To {
+		// The capture does not tell which side answers.
+		return
+	}
+	p.calls[callKey{m.Conn, m.From, id}] = method
+}
+
+// answered returns the method of the question that a return in m
+// answers, forgetting it.
+func (p *Printer) answered(m Message, id uint32) (method capnp.Method, ok bool) {
+	if m.From == m.To {
+		return capnp.Method{}, false
+	}
+	k := callKey{m.Conn, m.To, id}
+	method, ok = p.calls[k]
+	delete(p.calls, k)
+	return method, ok
+}
+
+// methodName fills in the names of m from the registry, if its schema
+// is registered.
+func (p *Printer) methodName(m capnp.Method) capnp.Method {
+	if sm, ok := p.schemaMethod(m); ok {
+		n, _ := p.nodes.Find(m.InterfaceID)
+		m.InterfaceName, _ = n.DisplayName()
+		m.MethodName, _ = sm.Name()
+	}
+	return m
+}
+
+// schemaMethod returns the schema of m.
+func (p *Printer) schemaMethod(m capnp.Method) (schema.Method, bool) {
+	n, err := p.nodes.Find(m.InterfaceID)
+	if err != nil || n.Which() != schema.Node_Which_interface {
+		return schema.Method{}, false
+	}
+	methods, err := n.Interface().Methods()
+	if err != nil || int(m.MethodID) >= methods.Len() {
+		return schema.Method{}, false
+	}
+	return methods.At(int(m.MethodID)), true
+}
+
+// content returns a payload's content in the text format, using the
+// struct type that typ picks from the method's schema.  It returns nil
+// if the schema is not registered.
+func (p *Printer) content(m capnp.Method, payload rpccp.Payload, typ func(schema.Method) uint64) []byte {
+	sm, ok := p.schemaMethod(m)
+	if !ok {
+		return nil
+	}
+	ptr, err := payload.Content()
+	if err != nil {
+		return []byte(fmt.Sprintf("malformed content: %v", err))
+	}
+	var buf bytes.Buffer
+	enc := text.NewEncoder(&buf)
+	if p.Registry != nil {
+		enc.UseRegistry(p.Registry)
+	}
+	if err := enc.Encode(typ(sm), ptr.Struct()); err != nil {
+		return []byte(fmt.Sprintf("malformed content: %v", err))
+	}
+	return buf.Bytes()
+}
+
+func describeTarget(buf *bytes.Buffer, tgt rpccp.MessageTarget, err error) {
+	if err != nil {
+		fmt.Fprintf(buf, "malformed target: %v", err)
+		return
+	}
+	switch tgt.Which() {
+	case rpccp.MessageTarget_Which_importedCap:
+		fmt.Fprintf(buf, "import %d", tgt.ImportedCap())
+	case rpccp.MessageTarget_Which_promisedAnswer:
+		pa, err := tgt.PromisedAnswer()
+		if err != nil {
+			fmt.Fprintf(buf, "malformed target: %v", err)
+			return
+		}
+		buf.WriteString("answer ")
+		describePromisedAnswer(buf, pa)
+	default:
+		fmt.Fprintf(buf, "target %v", tgt.Which())
+	}
+}
+
+// describePromisedAnswer writes the question ID and the pointer fields
+// of the transform, like "3.0.1".
+func describePromisedAnswer(buf *bytes.Buffer, pa rpccp.PromisedAnswer) {
+	fmt.Fprintf(buf, "%d", pa.QuestionId())
+	ops, err := pa.Transform()
+	if err != nil {
+		fmt.Fprintf(buf, " (malformed transform: %v)", err)
+		return
+	}
+	for i := 0; i < ops.Len(); i++ {
+		if op := ops.At(i); op.Which() == rpccp.PromisedAnswer_Op_Which_getPointerField {
+			fmt.Fprintf(buf, ".%d", op.GetPointerField())
+		}
+	}
+}
+
+// describeCaps writes the capabilities in a payload's cap table.
+func describeCaps(buf *bytes.Buffer, payload rpccp.Payload) {
+	caps, err := payload.CapTable()
+	if err != nil {
+		fmt.Fprintf(buf, " (malformed cap table: %v)", err)
+		return
+	}
+	if caps.Len() == 0 {
+		return
+	}
+	buf.WriteString(" caps=[")
+	for i := 0; i < caps.Len(); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		describeCap(buf, caps.At(i))
+	}
+	buf.WriteByte(']')
+}
+
+func describeCap(buf *bytes.Buffer, desc rpccp.CapDescriptor) {
+	switch desc.Which() {
+	case rpccp.CapDescriptor_Which_none:
+		buf.WriteString("null")
+	case rpccp.CapDescriptor_Which_senderHosted:
+		fmt.Fprintf(buf, "senderHosted %d", desc.SenderHosted())
+	case rpccp.CapDescriptor_Which_senderPromise:
+		fmt.Fprintf(buf, "senderPromise %d", desc.SenderPromise())
+	case rpccp.CapDescriptor_Which_receiverHosted:
+		fmt.Fprintf(buf, "receiverHosted %d", desc.ReceiverHosted())
+	case rpccp.CapDescriptor_Which_receiverAnswer:
+		pa, err := desc.ReceiverAnswer()
+		if err != nil {
+			fmt.Fprintf(buf, "malformed receiverAnswer: %v", err)
+			return
+		}
+		buf.WriteString("receiverAnswer ")
+		describePromisedAnswer(buf, pa)
+	default:
+		buf.WriteString(desc.Which().String())
+	}
+}
+
+func describeException(buf *bytes.Buffer, e rpccp.Exception, err error) {
+	if err != nil {
+		fmt.Fprintf(buf, "malformed exception: %v", err)
+		return
+	}
+	reason, _ := e.Reason()
+	fmt.Fprintf(buf, "%v: %s", e.Type(), strconv.Quote(reason))
+}
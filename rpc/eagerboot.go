@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// An EagerBootstrapPolicy determines whether a connection requests the
+// remote vat's bootstrap capability as soon as it starts, instead of
+// waiting for the first call to Bootstrap.  Requesting it eagerly puts
+// the Bootstrap message, and any calls pipelined on it by
+// Options.Prefetch, in the connection's first flight of messages, which
+// saves a round trip for clients that reconnect often.
+type EagerBootstrapPolicy int
+
+const (
+	// EagerBootstrapNever requests the bootstrap capability only when
+	// Bootstrap is called.  This is the default.
+	EagerBootstrapNever EagerBootstrapPolicy = iota
+
+	// EagerBootstrapOnResume requests the bootstrap capability eagerly
+	// if the transport is a transport.ResumableTransport that reports
+	// that it resumed a previous session, as a reconnecting client's
+	// TLS connection does when the server accepts its session ticket.
+	EagerBootstrapOnResume
+
+	// EagerBootstrapAlways always requests the bootstrap capability
+	// eagerly.
+	EagerBootstrapAlways
+)
+
+// String returns the name of p.
+func (p EagerBootstrapPolicy) String() string {
+	switch p {
+	case EagerBootstrapNever:
+		return "never"
+	case EagerBootstrapOnResume:
+		return "on resume"
+	case EagerBootstrapAlways:
+		return "always"
+	default:
+		return "EagerBootstrapPolicy(" + str.Itod(int(p)) + ")"
+	}
+}
+
+// wantEagerBootstrap reports whether p calls for requesting the
+// bootstrap capability of a connection over t as soon as it starts.
+func (p EagerBootstrapPolicy) wantEagerBootstrap(t Transport) bool {
+	switch p {
+	case EagerBootstrapAlways:
+		return true
+	case EagerBootstrapOnResume:
+		rt, ok := t.(transport.ResumableTransport)
+		return ok && rt.Resumed()
+	default:
+		return false
+	}
+}
+
+// startEagerBootstrap requests the bootstrap capability and keeps it
+// for the first call to Bootstrap, if opts asks for it.  It is called
+// by NewConn once the connection's background tasks have started.
+func (c *Conn) startEagerBootstrap(opts *Options) {
+	if opts == nil || !opts.EagerBootstrap.wantEagerBootstrap(c.transport) {
+		return
+	}
+	bc := c.Bootstrap(c.bgctx)
+	if opts.Prefetch != nil {
+		opts.Prefetch(bc)
+	}
+	c.withLocked(func(c *lockedConn) {
+		c.lk.eagerBootstrap = bc
+	})
+}
+
+// takeEagerBootstrap returns the eagerly requested bootstrap client and
+// removes it from the connection, if there is one.
+func (c *lockedConn) takeEagerBootstrap() (capnp.Client, bool) {
+	bc := c.lk.eagerBootstrap
+	if !bc.IsValid() {
+		return capnp.Client{}, false
+	}
+	c.lk.eagerBootstrap = capnp.Client{}
+	return bc, true
+}
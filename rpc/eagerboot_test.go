@@ -0,0 +1,129 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// resumedTransport is a transport whose connection claims to have
+// resumed a previous session.
+type resumedTransport struct {
+	transport.Transport
+	resumed bool
+}
+
+func (t resumedTransport) Resumed() bool { return t.resumed }
+
+// bootstrapCountingTransport counts the Bootstrap messages it receives.
+type bootstrapCountingTransport struct {
+	transport.Transport
+	bootstraps atomic.Int32
+}
+
+func (t *bootstrapCountingTransport) RecvMessage() (transport.IncomingMessage, error) {
+	in, err := t.Transport.RecvMessage()
+	if err == nil && in.Message().Which() == rpccp.Message_Which_bootstrap {
+		t.bootstraps.Add(1)
+	}
+	return in, err
+}
+
+func TestEagerBootstrap(t *testing.T) {
+	t.Parallel()
+
+	for _, resumed := range []bool{false, true} {
+		resumed := resumed
+		name := "NotResumed"
+		if resumed {
+			name = "Resumed"
+		}
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			serverNetConn, clientNetConn := net.Pipe()
+			serverTrans := &bootstrapCountingTransport{Transport: transport.NewStream(serverNetConn)}
+			serverConn := rpc.NewConn(serverTrans, &rpc.Options{
+				BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+			})
+			defer serverConn.Close()
+
+			var prefetched capnp.Client
+			clientConn := rpc.NewConn(resumedTransport{
+				Transport: transport.NewStream(clientNetConn),
+				resumed:   resumed,
+			}, &rpc.Options{
+				EagerBootstrap: rpc.EagerBootstrapOnResume,
+				Prefetch: func(bootstrap capnp.Client) {
+					prefetched = bootstrap.AddRef()
+				},
+			})
+			defer clientConn.Close()
+			assert.Equal(t, resumed, prefetched.IsValid(), "Prefetch called")
+			prefetched.Release()
+
+			client := testcapnp.PingPong(clientConn.Bootstrap(ctx))
+			defer client.Release()
+			require.NoError(t, client.Resolve(ctx))
+			ans, release := client.EchoNum(ctx, func(p testcapnp.PingPong_echoNum_Params) error {
+				p.SetN(42)
+				return nil
+			})
+			defer release()
+			res, err := ans.Struct()
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), res.N())
+			assert.Equal(t, int32(1), serverTrans.bootstraps.Load(), "bootstrap requests")
+		})
+	}
+}
+
+func TestEagerBootstrapAlways(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverTrans := &bootstrapCountingTransport{Transport: transport.NewStream(serverNetConn)}
+	serverConn := rpc.NewConn(serverTrans, &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+	})
+	defer serverConn.Close()
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), &rpc.Options{
+		EagerBootstrap: rpc.EagerBootstrapAlways,
+	})
+	defer clientConn.Close()
+
+	// The bootstrap request is sent without calling Bootstrap.
+	require.Eventually(t, func() bool {
+		return serverTrans.bootstraps.Load() == 1
+	}, time.Second, time.Millisecond)
+
+	client := clientConn.Bootstrap(ctx)
+	defer client.Release()
+	require.NoError(t, client.Resolve(ctx))
+	assert.Equal(t, int32(1), serverTrans.bootstraps.Load())
+}
+
+func TestStreamResumed(t *testing.T) {
+	t.Parallel()
+
+	p1, p2 := net.Pipe()
+	defer p2.Close()
+	trans := transport.NewStream(p1)
+	defer trans.Close()
+	rt, ok := trans.(transport.ResumableTransport)
+	require.True(t, ok, "stream transport is not a ResumableTransport")
+	assert.False(t, rt.Resumed(), "plain stream reports a resumed session")
+}
@@ -11,8 +11,15 @@ var (
 
 	// Base errors
 	ErrConnClosed        = errors.New("connection closed")
+	ErrConnDraining      = errors.New("connection draining")
+	ErrConnMaxAge        = errors.New("connection reached maximum age")
 	ErrNotACapability    = errors.New("not a capability")
 	ErrCapTablePopulated = errors.New("capability table already populated")
+	ErrRouterClosed      = errors.New("router closed")
+	ErrNoRoute           = errors.New("no connection to peer")
+	ErrTooManyCalls      = errors.New("too many concurrent calls")
+	ErrPeerUnresponsive  = errors.New("remote vat did not answer ping")
+	ErrNotRemoteCall     = errors.New("answer is not from a call on this connection")
 
 	// RPC exceptions
 	ExcClosed = rpcerr.Disconnected(ErrConnClosed)
@@ -46,8 +53,10 @@ func (er errReporter) Error(msg string, args ...any) {
 	}
 }
 
-func (er errReporter) ReportError(err error) {
+// ReportError logs err, if not nil, at the error level, with args as
+// additional key, value pairs describing where it happened.
+func (er errReporter) ReportError(err error, args ...any) {
 	if err != nil {
-		er.Error(err.Error())
+		er.Error(err.Error(), args...)
 	}
 }
@@ -0,0 +1,68 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// detailPingServer fails each call with an exception carrying the
+// call's argument as a structured detail.
+type detailPingServer struct{}
+
+func (detailPingServer) EchoNum(ctx context.Context, call testcapnp.PingPong_echoNum) error {
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return err
+	}
+	detail, err := testcapnp.NewRootPingPong_echoNum_Results(seg)
+	if err != nil {
+		return err
+	}
+	detail.SetN(call.Args().N())
+	return capnp.WithErrorDetail(exc.New(exc.Overloaded, "", "slow down"),
+		testcapnp.PingPong_echoNum_Results_TypeID, detail)
+}
+
+func TestExceptionDetails(t *testing.T) {
+	t.Parallel()
+
+	p1, p2 := transport.NewPipe(1)
+	srv := testcapnp.PingPong_ServerToClient(detailPingServer{})
+	conn1 := rpc.NewConn(rpc.NewTransport(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(srv),
+	})
+	defer conn1.Close()
+	conn2 := rpc.NewConn(rpc.NewTransport(p2), nil)
+	defer conn2.Close()
+
+	ctx := context.Background()
+	client := testcapnp.PingPong(conn2.Bootstrap(ctx))
+	defer client.Release()
+
+	fut, release := client.EchoNum(ctx, func(p testcapnp.PingPong_echoNum_Params) error {
+		p.SetN(42)
+		return nil
+	})
+	defer release()
+	_, err := fut.Struct()
+	require.Error(t, err)
+	assert.Equal(t, exc.Overloaded, exc.TypeOf(err))
+
+	detail, ok, err2 := capnp.ErrorDetail[testcapnp.PingPong_echoNum_Results](err, testcapnp.PingPong_echoNum_Results_TypeID)
+	require.NoError(t, err2)
+	require.True(t, ok, "detail should survive the round trip")
+	assert.Equal(t, int64(42), detail.N())
+
+	_, ok, err2 = capnp.ErrorDetail[testcapnp.PingPong_echoNum_Results](err, 0x1234)
+	require.NoError(t, err2)
+	assert.False(t, ok, "unknown detail ID")
+}
@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"capnproto.org/go/capnp/v3"
+)
+
+// execute runs f on the connection's executor, or on a new goroutine
+// if Options.Executor was not set.  The caller must not be holding
+// c.lk.
+func (c *Conn) execute(f func()) {
+	if c.executor == nil {
+		go f()
+		return
+	}
+	c.executor(f)
+}
+
+// returnAnswerAsync calls returnAnswer once ans is resolved.  Waiting
+// for the answer happens on a library goroutine, so that a
+// single-threaded executor is never blocked on a result that it would
+// itself have to deliver; only the delivery runs on the executor.
+func (c *Conn) returnAnswerAsync(ret capnp.Returner, ans *capnp.Answer, finish func()) {
+	if c.executor == nil {
+		go returnAnswer(ret, ans, finish)
+		return
+	}
+	go func() {
+		<-ans.Done()
+		c.executor(func() {
+			returnAnswer(ret, ans, finish)
+		})
+	}()
+}
@@ -0,0 +1,70 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+func TestExecutor(t *testing.T) {
+	t.Parallel()
+
+	// A single-threaded event loop.
+	loop := make(chan func(), 16)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case f := <-loop:
+				f()
+			case <-done:
+				return
+			}
+		}
+	}()
+	var ran atomic.Int32
+	executor := func(f func()) {
+		loop <- func() {
+			ran.Add(1)
+			f()
+		}
+	}
+
+	ctx := context.Background()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPonger{})),
+	})
+	defer serverConn.Close()
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), &rpc.Options{
+		Executor: executor,
+	})
+	defer clientConn.Close()
+	client := testcapnp.PingPong(clientConn.Bootstrap(ctx))
+	defer client.Release()
+
+	for n := int64(1); n <= 3; n++ {
+		n := n
+		f, release := client.EchoNum(ctx, func(p testcapnp.PingPong_echoNum_Params) error {
+			p.SetN(n)
+			return nil
+		})
+		res, err := f.Struct()
+		require.NoError(t, err)
+		assert.Equal(t, n, res.N())
+		release()
+	}
+	// The bootstrap answer and each of the calls were delivered on the
+	// executor.
+	assert.GreaterOrEqual(t, ran.Load(), int32(4))
+}
@@ -7,6 +7,7 @@ import (
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/internal/str"
 	"capnproto.org/go/capnp/v3/internal/syncutil"
+	"capnproto.org/go/capnp/v3/rpc/retry"
 	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
 )
 
@@ -105,6 +106,13 @@ func (ic *importClient) String() string {
 }
 
 func (ic *importClient) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+	if s.Idempotent && ic.c.retry != nil {
+		return ic.c.retry.Send(ctx, s, ic.send)
+	}
+	return ic.send(ctx, s)
+}
+
+func (ic *importClient) send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
 	return withLockedConn2(ic.c, func(c *lockedConn) (*capnp.Answer, capnp.ReleaseFunc) {
 		if !c.startTask() {
 			return capnp.ErrorAnswer(s.Method, ExcClosed), func() {}
@@ -114,37 +122,68 @@ func (ic *importClient) Send(ctx context.Context, s capnp.Send) (*capnp.Answer,
 		if ent == nil || ic.generation != ent.generation {
 			return capnp.ErrorAnswer(s.Method, rpcerr.Disconnected(errors.New("send on closed import"))), func() {}
 		}
-		q := c.newQuestion(s.Method)
+		if c.lk.draining {
+			return capnp.ErrorAnswer(s.Method, rpcerr.Disconnected(ErrConnDraining)), func() {}
+		}
+		q := c.newQuestion(ctx, s.Method)
 
 		// Send call message.
 		c.sendMessage(ctx, func(m rpccp.Message) error {
 			return c.newImportCallMessage(m, ic.id, q.id, s)
-		}, func(err error) {
-			if err != nil {
-				syncutil.With(&ic.c.lk, func() {
-					ic.c.lk.questions[q.id] = nil
-				})
-				q.p.Reject(rpcerr.WrapFailed("send message", err))
-				syncutil.With(&ic.c.lk, func() {
-					ic.c.lk.questionID.remove(q.id)
-				})
-				return
-			}
+		}, q.onCallSent(ctx))
 
-			q.c.tasks.Add(1)
-			go func() {
-				defer q.c.tasks.Done()
-				q.handleCancel(ctx)
-			}()
-		})
+		return q.answer()
+	})
+}
 
-		ans := q.p.Answer()
-		return ans, func() {
-			<-ans.Done()
-			q.p.ReleaseClients()
-			q.release()
+// onCallSent returns the function to call after attempting to send the
+// Call message for q, which was made with ctx.
+func (q *question) onCallSent(ctx context.Context) func(error) {
+	return func(err error) {
+		if err != nil {
+			syncutil.With(&q.c.lk, func() {
+				q.c.lk.questions[q.id] = nil
+				(*lockedConn)(q.c).checkDrained()
+			})
+			close(q.returned)
+			q.p.Reject(rpcerr.WrapFailed("send message", err))
+			syncutil.With(&q.c.lk, func() {
+				q.c.lk.questionID.remove(q.id)
+			})
+			return
 		}
-	})
+
+		q.c.tasks.Add(1)
+		go func() {
+			defer q.c.tasks.Done()
+			q.handleCancel(ctx)
+		}()
+	}
+}
+
+// answer returns q's answer and the function that releases it.
+func (q *question) answer() (*capnp.Answer, capnp.ReleaseFunc) {
+	ans := q.p.Answer()
+	return ans, func() {
+		<-ans.Done()
+		q.p.ReleaseClients()
+		q.release()
+	}
+}
+
+// connRetryPolicy returns a copy of p that stops retrying once c
+// starts shutting down, since calls on its imports can no longer
+// succeed.
+func (c *Conn) connRetryPolicy(p *retry.Policy) *retry.Policy {
+	cp := *p
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = retry.IsTransient
+	}
+	cp.Retryable = func(err error) bool {
+		return c.bgctx.Err() == nil && retryable(err)
+	}
+	return &cp
 }
 
 // newImportCallMessage builds a Call message targeted to an import.
@@ -203,7 +242,7 @@ func (ic *importClient) Recv(ctx context.Context, r capnp.Recv) capnp.PipelineCa
 		returnAnswer(r.Returner, ans, finish)
 		return nil
 	default:
-		go returnAnswer(r.Returner, ans, finish)
+		ic.c.returnAnswerAsync(r.Returner, ans, finish)
 		return ans
 	}
 }
@@ -259,7 +298,8 @@ func (ic *importClient) Shutdown() {
 			return err
 		}, func(err error) {
 			if err != nil {
-				ic.c.er.ReportError(rpcerr.Annotate(err, "send release"))
+				ic.c.er.ReportError(rpcerr.Annotate(err, "send release"),
+					"importID", ic.id, "count", ent.wireRefs)
 			}
 		})
 	})
@@ -3,6 +3,7 @@
 package testcapnp
 
 import (
+	bytes "bytes"
 	capnp "capnproto.org/go/capnp/v3"
 	text "capnproto.org/go/capnp/v3/encoding/text"
 	fc "capnproto.org/go/capnp/v3/flowcontrol"
@@ -10,145 +11,547 @@ import (
 	server "capnproto.org/go/capnp/v3/server"
 	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
 	context "context"
+	io "io"
 )
 
-type Empty capnp.Client
+type CapArgsTest capnp.Client
 
-// Empty_TypeID is the unique identifier for the type Empty.
-const Empty_TypeID = 0xc8b14e937b2cb741
+// CapArgsTest_TypeID is the unique identifier for the type CapArgsTest.
+const CapArgsTest_TypeID = 0xb86bce7f916a10cc
 
-func (c Empty) WaitStreaming() error {
+func (c CapArgsTest) Call(ctx context.Context, params func(CapArgsTest_call_Params) error) (CapArgsTest_call_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb86bce7f916a10cc,
+			MethodID:      0,
+			InterfaceName: "test.capnp:CapArgsTest",
+			MethodName:    "call",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapArgsTest_call_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapArgsTest_call_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c CapArgsTest) Self(ctx context.Context, params func(CapArgsTest_self_Params) error) (CapArgsTest_self_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb86bce7f916a10cc,
+			MethodID:      1,
+			InterfaceName: "test.capnp:CapArgsTest",
+			MethodName:    "self",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapArgsTest_self_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return CapArgsTest_self_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c CapArgsTest) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
-// String returns a string that identifies this capability for debugging
-// purposes.  Its format should not be depended on: in particular, it
-// should not be used to compare clients.  Use IsSame to compare clients
-// for equality.
-func (c Empty) String() string {
-	return "Empty(" + capnp.Client(c).String() + ")"
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c CapArgsTest) String() string {
+	return "CapArgsTest(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c CapArgsTest) AddRef() CapArgsTest {
+	return CapArgsTest(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c CapArgsTest) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c CapArgsTest) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c CapArgsTest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (CapArgsTest) DecodeFromPtr(p capnp.Ptr) CapArgsTest {
+	return CapArgsTest(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c CapArgsTest) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c CapArgsTest) IsSame(other CapArgsTest) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c CapArgsTest) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c CapArgsTest) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A CapArgsTest_Server is a CapArgsTest with a local implementation.
+type CapArgsTest_Server interface {
+	Call(context.Context, CapArgsTest_call) error
+	Self(context.Context, CapArgsTest_self) error
+}
+
+// CapArgsTest_NewServer creates a new Server from an implementation of CapArgsTest_Server.
+func CapArgsTest_NewServer(s CapArgsTest_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(CapArgsTest_Methods(nil, s), s, c)
+}
+
+// CapArgsTest_ServerToClient creates a new Client from an implementation of CapArgsTest_Server.
+// The caller is responsible for calling Release on the returned Client.
+func CapArgsTest_ServerToClient(s CapArgsTest_Server) CapArgsTest {
+	return CapArgsTest(capnp.NewClient(CapArgsTest_NewServer(s)))
+}
+
+// CapArgsTest_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func CapArgsTest_Methods(methods []server.Method, s CapArgsTest_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb86bce7f916a10cc,
+			MethodID:      0,
+			InterfaceName: "test.capnp:CapArgsTest",
+			MethodName:    "call",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Call(ctx, CapArgsTest_call{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb86bce7f916a10cc,
+			MethodID:      1,
+			InterfaceName: "test.capnp:CapArgsTest",
+			MethodName:    "self",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Self(ctx, CapArgsTest_self{call})
+		},
+	})
+
+	return methods
+}
+
+// CapArgsTest_call holds the state for a server call to CapArgsTest.call.
+// See server.Call for documentation.
+type CapArgsTest_call struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapArgsTest_call) Args() CapArgsTest_call_Params {
+	return CapArgsTest_call_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c CapArgsTest_call) AllocResults() (CapArgsTest_call_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapArgsTest_call_Results(r), err
+}
+
+// CapArgsTest_self holds the state for a server call to CapArgsTest.self.
+// See server.Call for documentation.
+type CapArgsTest_self struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapArgsTest_self) Args() CapArgsTest_self_Params {
+	return CapArgsTest_self_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c CapArgsTest_self) AllocResults() (CapArgsTest_self_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapArgsTest_self_Results(r), err
+}
+
+// CapArgsTest_List is a list of CapArgsTest.
+type CapArgsTest_List = capnp.CapList[CapArgsTest]
+
+// NewCapArgsTest_List creates a new list of CapArgsTest.
+func NewCapArgsTest_List(s *capnp.Segment, sz int32) (CapArgsTest_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[CapArgsTest](l), err
+}
+
+type CapArgsTest_call_Params capnp.Struct
+
+// CapArgsTest_call_Params_TypeID is the unique identifier for the type CapArgsTest_call_Params.
+const CapArgsTest_call_Params_TypeID = 0x80087e4e698768a2
+
+func NewCapArgsTest_call_Params(s *capnp.Segment) (CapArgsTest_call_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapArgsTest_call_Params(st), err
+}
+
+func NewRootCapArgsTest_call_Params(s *capnp.Segment) (CapArgsTest_call_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapArgsTest_call_Params(st), err
+}
+
+func ReadRootCapArgsTest_call_Params(msg *capnp.Message) (CapArgsTest_call_Params, error) {
+	root, err := msg.Root()
+	return CapArgsTest_call_Params(root.Struct()), err
+}
+
+func (s CapArgsTest_call_Params) String() string {
+	str, _ := text.Marshal(0x80087e4e698768a2, capnp.Struct(s))
+	return str
+}
+
+func (s CapArgsTest_call_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapArgsTest_call_Params) DecodeFromPtr(p capnp.Ptr) CapArgsTest_call_Params {
+	return CapArgsTest_call_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapArgsTest_call_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapArgsTest_call_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapArgsTest_call_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapArgsTest_call_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapArgsTest_call_Params) Cap() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return p.Interface().Client()
+}
+
+func (s CapArgsTest_call_Params) HasCap() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s CapArgsTest_call_Params) SetCap(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// CapArgsTest_call_Params_List is a list of CapArgsTest_call_Params.
+type CapArgsTest_call_Params_List = capnp.StructList[CapArgsTest_call_Params]
+
+// NewCapArgsTest_call_Params creates a new list of CapArgsTest_call_Params.
+func NewCapArgsTest_call_Params_List(s *capnp.Segment, sz int32) (CapArgsTest_call_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapArgsTest_call_Params](l), err
+}
+
+// CapArgsTest_call_Params_Future is a wrapper for a CapArgsTest_call_Params promised by a client call.
+type CapArgsTest_call_Params_Future struct{ *capnp.Future }
+
+func (f CapArgsTest_call_Params_Future) Struct() (CapArgsTest_call_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapArgsTest_call_Params(p.Struct()), err
+}
+func (p CapArgsTest_call_Params_Future) Cap() capnp.Client {
+	return p.Future.Field(0, nil).Client()
+}
+
+type CapArgsTest_call_Results capnp.Struct
+
+// CapArgsTest_call_Results_TypeID is the unique identifier for the type CapArgsTest_call_Results.
+const CapArgsTest_call_Results_TypeID = 0x96fbc50dc2f0200d
+
+func NewCapArgsTest_call_Results(s *capnp.Segment) (CapArgsTest_call_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapArgsTest_call_Results(st), err
+}
+
+func NewRootCapArgsTest_call_Results(s *capnp.Segment) (CapArgsTest_call_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapArgsTest_call_Results(st), err
+}
+
+func ReadRootCapArgsTest_call_Results(msg *capnp.Message) (CapArgsTest_call_Results, error) {
+	root, err := msg.Root()
+	return CapArgsTest_call_Results(root.Struct()), err
+}
+
+func (s CapArgsTest_call_Results) String() string {
+	str, _ := text.Marshal(0x96fbc50dc2f0200d, capnp.Struct(s))
+	return str
+}
+
+func (s CapArgsTest_call_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapArgsTest_call_Results) DecodeFromPtr(p capnp.Ptr) CapArgsTest_call_Results {
+	return CapArgsTest_call_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapArgsTest_call_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapArgsTest_call_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapArgsTest_call_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapArgsTest_call_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapArgsTest_call_Results_List is a list of CapArgsTest_call_Results.
+type CapArgsTest_call_Results_List = capnp.StructList[CapArgsTest_call_Results]
+
+// NewCapArgsTest_call_Results creates a new list of CapArgsTest_call_Results.
+func NewCapArgsTest_call_Results_List(s *capnp.Segment, sz int32) (CapArgsTest_call_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapArgsTest_call_Results](l), err
+}
+
+// CapArgsTest_call_Results_Future is a wrapper for a CapArgsTest_call_Results promised by a client call.
+type CapArgsTest_call_Results_Future struct{ *capnp.Future }
+
+func (f CapArgsTest_call_Results_Future) Struct() (CapArgsTest_call_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapArgsTest_call_Results(p.Struct()), err
+}
+
+type CapArgsTest_self_Params capnp.Struct
+
+// CapArgsTest_self_Params_TypeID is the unique identifier for the type CapArgsTest_self_Params.
+const CapArgsTest_self_Params_TypeID = 0xe2553e5a663abb7d
+
+func NewCapArgsTest_self_Params(s *capnp.Segment) (CapArgsTest_self_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapArgsTest_self_Params(st), err
+}
+
+func NewRootCapArgsTest_self_Params(s *capnp.Segment) (CapArgsTest_self_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapArgsTest_self_Params(st), err
+}
+
+func ReadRootCapArgsTest_self_Params(msg *capnp.Message) (CapArgsTest_self_Params, error) {
+	root, err := msg.Root()
+	return CapArgsTest_self_Params(root.Struct()), err
+}
+
+func (s CapArgsTest_self_Params) String() string {
+	str, _ := text.Marshal(0xe2553e5a663abb7d, capnp.Struct(s))
+	return str
+}
+
+func (s CapArgsTest_self_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (CapArgsTest_self_Params) DecodeFromPtr(p capnp.Ptr) CapArgsTest_self_Params {
+	return CapArgsTest_self_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s CapArgsTest_self_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapArgsTest_self_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s CapArgsTest_self_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s CapArgsTest_self_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// CapArgsTest_self_Params_List is a list of CapArgsTest_self_Params.
+type CapArgsTest_self_Params_List = capnp.StructList[CapArgsTest_self_Params]
+
+// NewCapArgsTest_self_Params creates a new list of CapArgsTest_self_Params.
+func NewCapArgsTest_self_Params_List(s *capnp.Segment, sz int32) (CapArgsTest_self_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[CapArgsTest_self_Params](l), err
 }
 
-// AddRef creates a new Client that refers to the same capability as c.
-// If c is nil or has resolved to null, then AddRef returns nil.
-func (c Empty) AddRef() Empty {
-	return Empty(capnp.Client(c).AddRef())
+// CapArgsTest_self_Params_Future is a wrapper for a CapArgsTest_self_Params promised by a client call.
+type CapArgsTest_self_Params_Future struct{ *capnp.Future }
+
+func (f CapArgsTest_self_Params_Future) Struct() (CapArgsTest_self_Params, error) {
+	p, err := f.Future.Ptr()
+	return CapArgsTest_self_Params(p.Struct()), err
 }
 
-// Release releases a capability reference.  If this is the last
-// reference to the capability, then the underlying resources associated
-// with the capability will be released.
-//
-// Release will panic if c has already been released, but not if c is
-// nil or resolved to null.
-func (c Empty) Release() {
-	capnp.Client(c).Release()
+type CapArgsTest_self_Results capnp.Struct
+
+// CapArgsTest_self_Results_TypeID is the unique identifier for the type CapArgsTest_self_Results.
+const CapArgsTest_self_Results_TypeID = 0x9746cc05cbff1132
+
+func NewCapArgsTest_self_Results(s *capnp.Segment) (CapArgsTest_self_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapArgsTest_self_Results(st), err
 }
 
-// Resolve blocks until the capability is fully resolved or the Context
-// expires.
-func (c Empty) Resolve(ctx context.Context) error {
-	return capnp.Client(c).Resolve(ctx)
+func NewRootCapArgsTest_self_Results(s *capnp.Segment) (CapArgsTest_self_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapArgsTest_self_Results(st), err
 }
 
-func (c Empty) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Client(c).EncodeAsPtr(seg)
+func ReadRootCapArgsTest_self_Results(msg *capnp.Message) (CapArgsTest_self_Results, error) {
+	root, err := msg.Root()
+	return CapArgsTest_self_Results(root.Struct()), err
 }
 
-func (Empty) DecodeFromPtr(p capnp.Ptr) Empty {
-	return Empty(capnp.Client{}.DecodeFromPtr(p))
+func (s CapArgsTest_self_Results) String() string {
+	str, _ := text.Marshal(0x9746cc05cbff1132, capnp.Struct(s))
+	return str
 }
 
-// IsValid reports whether c is a valid reference to a capability.
-// A reference is invalid if it is nil, has resolved to null, or has
-// been released.
-func (c Empty) IsValid() bool {
-	return capnp.Client(c).IsValid()
+func (s CapArgsTest_self_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-// IsSame reports whether c and other refer to a capability created by the
-// same call to NewClient.  This can return false negatives if c or other
-// are not fully resolved: use Resolve if this is an issue.  If either
-// c or other are released, then IsSame panics.
-func (c Empty) IsSame(other Empty) bool {
-	return capnp.Client(c).IsSame(capnp.Client(other))
+func (CapArgsTest_self_Results) DecodeFromPtr(p capnp.Ptr) CapArgsTest_self_Results {
+	return CapArgsTest_self_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// Update the flowcontrol.FlowLimiter used to manage flow control for
-// this client. This affects all future calls, but not calls already
-// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
-// which is also the default.
-func (c Empty) SetFlowLimiter(lim fc.FlowLimiter) {
-	capnp.Client(c).SetFlowLimiter(lim)
+func (s CapArgsTest_self_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s CapArgsTest_self_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c Empty) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
+func (s CapArgsTest_self_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-// A Empty_Server is a Empty with a local implementation.
-type Empty_Server interface {
+func (s CapArgsTest_self_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s CapArgsTest_self_Results) Self() CapArgsTest {
+	return capnp.GetInterfaceField[CapArgsTest](s, 0)
 }
 
-// Empty_NewServer creates a new Server from an implementation of Empty_Server.
-func Empty_NewServer(s Empty_Server) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(Empty_Methods(nil, s), s, c)
+func (s CapArgsTest_self_Results) HasSelf() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Empty_ServerToClient creates a new Client from an implementation of Empty_Server.
-// The caller is responsible for calling Release on the returned Client.
-func Empty_ServerToClient(s Empty_Server) Empty {
-	return Empty(capnp.NewClient(Empty_NewServer(s)))
+func (s CapArgsTest_self_Results) SetSelf(v CapArgsTest) error {
+	return capnp.SetInterfaceField(s, 0, v)
 }
 
-// Empty_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func Empty_Methods(methods []server.Method, s Empty_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 0)
-	}
+// CapArgsTest_self_Results_List is a list of CapArgsTest_self_Results.
+type CapArgsTest_self_Results_List = capnp.StructList[CapArgsTest_self_Results]
 
-	return methods
+// NewCapArgsTest_self_Results creates a new list of CapArgsTest_self_Results.
+func NewCapArgsTest_self_Results_List(s *capnp.Segment, sz int32) (CapArgsTest_self_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[CapArgsTest_self_Results](l), err
 }
 
-// Empty_List is a list of Empty.
-type Empty_List = capnp.CapList[Empty]
+// CapArgsTest_self_Results_Future is a wrapper for a CapArgsTest_self_Results promised by a client call.
+type CapArgsTest_self_Results_Future struct{ *capnp.Future }
 
-// NewEmpty creates a new list of Empty.
-func NewEmpty_List(s *capnp.Segment, sz int32) (Empty_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[Empty](l), err
+func (f CapArgsTest_self_Results_Future) Struct() (CapArgsTest_self_Results, error) {
+	p, err := f.Future.Ptr()
+	return CapArgsTest_self_Results(p.Struct()), err
+}
+func (p CapArgsTest_self_Results_Future) Self() CapArgsTest {
+	return CapArgsTest(p.Future.Field(0, nil).Client())
 }
 
-type EmptyProvider capnp.Client
+type DeadlineTest capnp.Client
 
-// EmptyProvider_TypeID is the unique identifier for the type EmptyProvider.
-const EmptyProvider_TypeID = 0xea38d4d6dca1e80e
+// DeadlineTest_TypeID is the unique identifier for the type DeadlineTest.
+const DeadlineTest_TypeID = 0xee61e8a2212713aa
 
-func (c EmptyProvider) GetEmpty(ctx context.Context, params func(EmptyProvider_getEmpty_Params) error) (EmptyProvider_getEmpty_Results_Future, capnp.ReleaseFunc) {
+func (c DeadlineTest) Check(ctx context.Context, params func(DeadlineTest_check_Params) error) (DeadlineTest_check_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xea38d4d6dca1e80e,
+			InterfaceID:   0xee61e8a2212713aa,
 			MethodID:      0,
-			InterfaceName: "test.capnp:EmptyProvider",
-			MethodName:    "getEmpty",
+			InterfaceName: "test.capnp:DeadlineTest",
+			MethodName:    "check",
 		},
 	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(EmptyProvider_getEmpty_Params(s)) }
+	s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+	s.SetTimeout = func(ctx context.Context, s capnp.Struct) {
+		s.SetUint64(0, server.CallTimeout(ctx))
+	}
+	s.PlaceArgs = func(s capnp.Struct) error {
+		s.SetUint64(0, server.CallTimeout(ctx))
+		if params == nil {
+			return nil
+		}
+		return params(DeadlineTest_check_Params(s))
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return EmptyProvider_getEmpty_Results_Future{Future: ans.Future()}, release
+	return DeadlineTest_check_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c EmptyProvider) WaitStreaming() error {
+func (c DeadlineTest) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -156,14 +559,14 @@ func (c EmptyProvider) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c EmptyProvider) String() string {
-	return "EmptyProvider(" + capnp.Client(c).String() + ")"
+func (c DeadlineTest) String() string {
+	return "DeadlineTest(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c EmptyProvider) AddRef() EmptyProvider {
-	return EmptyProvider(capnp.Client(c).AddRef())
+func (c DeadlineTest) AddRef() DeadlineTest {
+	return DeadlineTest(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -172,28 +575,28 @@ func (c EmptyProvider) AddRef() EmptyProvider {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c EmptyProvider) Release() {
+func (c DeadlineTest) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c EmptyProvider) Resolve(ctx context.Context) error {
+func (c DeadlineTest) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c EmptyProvider) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c DeadlineTest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (EmptyProvider) DecodeFromPtr(p capnp.Ptr) EmptyProvider {
-	return EmptyProvider(capnp.Client{}.DecodeFromPtr(p))
+func (DeadlineTest) DecodeFromPtr(p capnp.Ptr) DeadlineTest {
+	return DeadlineTest(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c EmptyProvider) IsValid() bool {
+func (c DeadlineTest) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -201,7 +604,7 @@ func (c EmptyProvider) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c EmptyProvider) IsSame(other EmptyProvider) bool {
+func (c DeadlineTest) IsSame(other DeadlineTest) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -209,257 +612,358 @@ func (c EmptyProvider) IsSame(other EmptyProvider) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c EmptyProvider) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c DeadlineTest) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
 // Get the current flowcontrol.FlowLimiter used to manage flow control
 // for this client.
-func (c EmptyProvider) GetFlowLimiter() fc.FlowLimiter {
+func (c DeadlineTest) GetFlowLimiter() fc.FlowLimiter {
 	return capnp.Client(c).GetFlowLimiter()
 }
 
-// A EmptyProvider_Server is a EmptyProvider with a local implementation.
-type EmptyProvider_Server interface {
-	GetEmpty(context.Context, EmptyProvider_getEmpty) error
+// A DeadlineTest_Server is a DeadlineTest with a local implementation.
+type DeadlineTest_Server interface {
+	Check(context.Context, DeadlineTest_check) error
 }
 
-// EmptyProvider_NewServer creates a new Server from an implementation of EmptyProvider_Server.
-func EmptyProvider_NewServer(s EmptyProvider_Server) *server.Server {
+// DeadlineTest_NewServer creates a new Server from an implementation of DeadlineTest_Server.
+func DeadlineTest_NewServer(s DeadlineTest_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(EmptyProvider_Methods(nil, s), s, c)
+	return server.New(DeadlineTest_Methods(nil, s), s, c)
 }
 
-// EmptyProvider_ServerToClient creates a new Client from an implementation of EmptyProvider_Server.
+// DeadlineTest_ServerToClient creates a new Client from an implementation of DeadlineTest_Server.
 // The caller is responsible for calling Release on the returned Client.
-func EmptyProvider_ServerToClient(s EmptyProvider_Server) EmptyProvider {
-	return EmptyProvider(capnp.NewClient(EmptyProvider_NewServer(s)))
+func DeadlineTest_ServerToClient(s DeadlineTest_Server) DeadlineTest {
+	return DeadlineTest(capnp.NewClient(DeadlineTest_NewServer(s)))
 }
 
-// EmptyProvider_Methods appends Methods to a slice that invoke the methods on s.
+// DeadlineTest_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func EmptyProvider_Methods(methods []server.Method, s EmptyProvider_Server) []server.Method {
+func DeadlineTest_Methods(methods []server.Method, s DeadlineTest_Server) []server.Method {
 	if cap(methods) == 0 {
 		methods = make([]server.Method, 0, 1)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xea38d4d6dca1e80e,
+			InterfaceID:   0xee61e8a2212713aa,
 			MethodID:      0,
-			InterfaceName: "test.capnp:EmptyProvider",
-			MethodName:    "getEmpty",
+			InterfaceName: "test.capnp:DeadlineTest",
+			MethodName:    "check",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.GetEmpty(ctx, EmptyProvider_getEmpty{call})
+			return s.Check(ctx, DeadlineTest_check{call})
 		},
+		Timeout: func(args capnp.Struct) uint64 { return args.Uint64(0) },
 	})
 
 	return methods
 }
 
-// EmptyProvider_getEmpty holds the state for a server call to EmptyProvider.getEmpty.
+// DeadlineTest_check holds the state for a server call to DeadlineTest.check.
 // See server.Call for documentation.
-type EmptyProvider_getEmpty struct {
+type DeadlineTest_check struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c EmptyProvider_getEmpty) Args() EmptyProvider_getEmpty_Params {
-	return EmptyProvider_getEmpty_Params(c.Call.Args())
+func (c DeadlineTest_check) Args() DeadlineTest_check_Params {
+	return DeadlineTest_check_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c EmptyProvider_getEmpty) AllocResults() (EmptyProvider_getEmpty_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return EmptyProvider_getEmpty_Results(r), err
+func (c DeadlineTest_check) AllocResults() (DeadlineTest_check_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return DeadlineTest_check_Results(r), err
 }
 
-// EmptyProvider_List is a list of EmptyProvider.
-type EmptyProvider_List = capnp.CapList[EmptyProvider]
+// DeadlineTest_List is a list of DeadlineTest.
+type DeadlineTest_List = capnp.CapList[DeadlineTest]
 
-// NewEmptyProvider creates a new list of EmptyProvider.
-func NewEmptyProvider_List(s *capnp.Segment, sz int32) (EmptyProvider_List, error) {
+// NewDeadlineTest_List creates a new list of DeadlineTest.
+func NewDeadlineTest_List(s *capnp.Segment, sz int32) (DeadlineTest_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[EmptyProvider](l), err
+	return capnp.CapList[DeadlineTest](l), err
 }
 
-type EmptyProvider_getEmpty_Params capnp.Struct
+type DeadlineTest_check_Params capnp.Struct
 
-// EmptyProvider_getEmpty_Params_TypeID is the unique identifier for the type EmptyProvider_getEmpty_Params.
-const EmptyProvider_getEmpty_Params_TypeID = 0x9a27082d77b8c289
+// DeadlineTest_check_Params_TypeID is the unique identifier for the type DeadlineTest_check_Params.
+const DeadlineTest_check_Params_TypeID = 0x89906d5dac968964
 
-func NewEmptyProvider_getEmpty_Params(s *capnp.Segment) (EmptyProvider_getEmpty_Params, error) {
+func NewDeadlineTest_check_Params(s *capnp.Segment) (DeadlineTest_check_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return DeadlineTest_check_Params(st), err
+}
+
+func NewRootDeadlineTest_check_Params(s *capnp.Segment) (DeadlineTest_check_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return DeadlineTest_check_Params(st), err
+}
+
+func ReadRootDeadlineTest_check_Params(msg *capnp.Message) (DeadlineTest_check_Params, error) {
+	root, err := msg.Root()
+	return DeadlineTest_check_Params(root.Struct()), err
+}
+
+func (s DeadlineTest_check_Params) String() string {
+	str, _ := text.Marshal(0x89906d5dac968964, capnp.Struct(s))
+	return str
+}
+
+func (s DeadlineTest_check_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DeadlineTest_check_Params) DecodeFromPtr(p capnp.Ptr) DeadlineTest_check_Params {
+	return DeadlineTest_check_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DeadlineTest_check_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DeadlineTest_check_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DeadlineTest_check_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DeadlineTest_check_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s DeadlineTest_check_Params) Timeout() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s DeadlineTest_check_Params) SetTimeout(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// DeadlineTest_check_Params_List is a list of DeadlineTest_check_Params.
+type DeadlineTest_check_Params_List = capnp.StructList[DeadlineTest_check_Params]
+
+// NewDeadlineTest_check_Params creates a new list of DeadlineTest_check_Params.
+func NewDeadlineTest_check_Params_List(s *capnp.Segment, sz int32) (DeadlineTest_check_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[DeadlineTest_check_Params](l), err
+}
+
+// DeadlineTest_check_Params_Future is a wrapper for a DeadlineTest_check_Params promised by a client call.
+type DeadlineTest_check_Params_Future struct{ *capnp.Future }
+
+func (f DeadlineTest_check_Params_Future) Struct() (DeadlineTest_check_Params, error) {
+	p, err := f.Future.Ptr()
+	return DeadlineTest_check_Params(p.Struct()), err
+}
+
+type DeadlineTest_check_Results capnp.Struct
+
+// DeadlineTest_check_Results_TypeID is the unique identifier for the type DeadlineTest_check_Results.
+const DeadlineTest_check_Results_TypeID = 0xa6d6cffa178fa7fe
+
+func NewDeadlineTest_check_Results(s *capnp.Segment) (DeadlineTest_check_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return EmptyProvider_getEmpty_Params(st), err
+	return DeadlineTest_check_Results(st), err
 }
 
-func NewRootEmptyProvider_getEmpty_Params(s *capnp.Segment) (EmptyProvider_getEmpty_Params, error) {
+func NewRootDeadlineTest_check_Results(s *capnp.Segment) (DeadlineTest_check_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return EmptyProvider_getEmpty_Params(st), err
+	return DeadlineTest_check_Results(st), err
 }
 
-func ReadRootEmptyProvider_getEmpty_Params(msg *capnp.Message) (EmptyProvider_getEmpty_Params, error) {
+func ReadRootDeadlineTest_check_Results(msg *capnp.Message) (DeadlineTest_check_Results, error) {
 	root, err := msg.Root()
-	return EmptyProvider_getEmpty_Params(root.Struct()), err
+	return DeadlineTest_check_Results(root.Struct()), err
 }
 
-func (s EmptyProvider_getEmpty_Params) String() string {
-	str, _ := text.Marshal(0x9a27082d77b8c289, capnp.Struct(s))
+func (s DeadlineTest_check_Results) String() string {
+	str, _ := text.Marshal(0xa6d6cffa178fa7fe, capnp.Struct(s))
 	return str
 }
 
-func (s EmptyProvider_getEmpty_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s DeadlineTest_check_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (EmptyProvider_getEmpty_Params) DecodeFromPtr(p capnp.Ptr) EmptyProvider_getEmpty_Params {
-	return EmptyProvider_getEmpty_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (DeadlineTest_check_Results) DecodeFromPtr(p capnp.Ptr) DeadlineTest_check_Results {
+	return DeadlineTest_check_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s EmptyProvider_getEmpty_Params) ToPtr() capnp.Ptr {
+func (s DeadlineTest_check_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s EmptyProvider_getEmpty_Params) IsValid() bool {
+func (s DeadlineTest_check_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s EmptyProvider_getEmpty_Params) Message() *capnp.Message {
+func (s DeadlineTest_check_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s EmptyProvider_getEmpty_Params) Segment() *capnp.Segment {
+func (s DeadlineTest_check_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 
-// EmptyProvider_getEmpty_Params_List is a list of EmptyProvider_getEmpty_Params.
-type EmptyProvider_getEmpty_Params_List = capnp.StructList[EmptyProvider_getEmpty_Params]
+// DeadlineTest_check_Results_List is a list of DeadlineTest_check_Results.
+type DeadlineTest_check_Results_List = capnp.StructList[DeadlineTest_check_Results]
 
-// NewEmptyProvider_getEmpty_Params creates a new list of EmptyProvider_getEmpty_Params.
-func NewEmptyProvider_getEmpty_Params_List(s *capnp.Segment, sz int32) (EmptyProvider_getEmpty_Params_List, error) {
+// NewDeadlineTest_check_Results creates a new list of DeadlineTest_check_Results.
+func NewDeadlineTest_check_Results_List(s *capnp.Segment, sz int32) (DeadlineTest_check_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[EmptyProvider_getEmpty_Params](l), err
+	return capnp.StructList[DeadlineTest_check_Results](l), err
 }
 
-// EmptyProvider_getEmpty_Params_Future is a wrapper for a EmptyProvider_getEmpty_Params promised by a client call.
-type EmptyProvider_getEmpty_Params_Future struct{ *capnp.Future }
+// DeadlineTest_check_Results_Future is a wrapper for a DeadlineTest_check_Results promised by a client call.
+type DeadlineTest_check_Results_Future struct{ *capnp.Future }
 
-func (f EmptyProvider_getEmpty_Params_Future) Struct() (EmptyProvider_getEmpty_Params, error) {
+func (f DeadlineTest_check_Results_Future) Struct() (DeadlineTest_check_Results, error) {
 	p, err := f.Future.Ptr()
-	return EmptyProvider_getEmpty_Params(p.Struct()), err
+	return DeadlineTest_check_Results(p.Struct()), err
 }
 
-type EmptyProvider_getEmpty_Results capnp.Struct
+// Empty interface, handy for testing shutdown hooks and stuff that just
+// needs an arbitrary capability.
+type Empty capnp.Client
+
+// Empty_TypeID is the unique identifier for the type Empty.
+const Empty_TypeID = 0xc8b14e937b2cb741
 
-// EmptyProvider_getEmpty_Results_TypeID is the unique identifier for the type EmptyProvider_getEmpty_Results.
-const EmptyProvider_getEmpty_Results_TypeID = 0x93281cc60d6060cd
+func (c Empty) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
 
-func NewEmptyProvider_getEmpty_Results(s *capnp.Segment) (EmptyProvider_getEmpty_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return EmptyProvider_getEmpty_Results(st), err
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Empty) String() string {
+	return "Empty(" + capnp.Client(c).String() + ")"
 }
 
-func NewRootEmptyProvider_getEmpty_Results(s *capnp.Segment) (EmptyProvider_getEmpty_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return EmptyProvider_getEmpty_Results(st), err
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Empty) AddRef() Empty {
+	return Empty(capnp.Client(c).AddRef())
 }
 
-func ReadRootEmptyProvider_getEmpty_Results(msg *capnp.Message) (EmptyProvider_getEmpty_Results, error) {
-	root, err := msg.Root()
-	return EmptyProvider_getEmpty_Results(root.Struct()), err
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Empty) Release() {
+	capnp.Client(c).Release()
 }
 
-func (s EmptyProvider_getEmpty_Results) String() string {
-	str, _ := text.Marshal(0x93281cc60d6060cd, capnp.Struct(s))
-	return str
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Empty) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
 }
 
-func (s EmptyProvider_getEmpty_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (c Empty) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (EmptyProvider_getEmpty_Results) DecodeFromPtr(p capnp.Ptr) EmptyProvider_getEmpty_Results {
-	return EmptyProvider_getEmpty_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (Empty) DecodeFromPtr(p capnp.Ptr) Empty {
+	return Empty(capnp.Client{}.DecodeFromPtr(p))
 }
 
-func (s EmptyProvider_getEmpty_Results) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Empty) IsValid() bool {
+	return capnp.Client(c).IsValid()
 }
-func (s EmptyProvider_getEmpty_Results) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Empty) IsSame(other Empty) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
-func (s EmptyProvider_getEmpty_Results) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Empty) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-func (s EmptyProvider_getEmpty_Results) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Empty) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
-func (s EmptyProvider_getEmpty_Results) Empty() Empty {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return Empty(p.Interface().Client())
+
+// A Empty_Server is a Empty with a local implementation.
+type Empty_Server interface {
 }
 
-func (s EmptyProvider_getEmpty_Results) HasEmpty() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Empty_NewServer creates a new Server from an implementation of Empty_Server.
+func Empty_NewServer(s Empty_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Empty_Methods(nil, s), s, c)
 }
 
-func (s EmptyProvider_getEmpty_Results) SetEmpty(v Empty) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+// Empty_ServerToClient creates a new Client from an implementation of Empty_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Empty_ServerToClient(s Empty_Server) Empty {
+	return Empty(capnp.NewClient(Empty_NewServer(s)))
 }
 
-// EmptyProvider_getEmpty_Results_List is a list of EmptyProvider_getEmpty_Results.
-type EmptyProvider_getEmpty_Results_List = capnp.StructList[EmptyProvider_getEmpty_Results]
+// Empty_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Empty_Methods(methods []server.Method, s Empty_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 0)
+	}
 
-// NewEmptyProvider_getEmpty_Results creates a new list of EmptyProvider_getEmpty_Results.
-func NewEmptyProvider_getEmpty_Results_List(s *capnp.Segment, sz int32) (EmptyProvider_getEmpty_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[EmptyProvider_getEmpty_Results](l), err
+	return methods
 }
 
-// EmptyProvider_getEmpty_Results_Future is a wrapper for a EmptyProvider_getEmpty_Results promised by a client call.
-type EmptyProvider_getEmpty_Results_Future struct{ *capnp.Future }
+// Empty_List is a list of Empty.
+type Empty_List = capnp.CapList[Empty]
 
-func (f EmptyProvider_getEmpty_Results_Future) Struct() (EmptyProvider_getEmpty_Results, error) {
-	p, err := f.Future.Ptr()
-	return EmptyProvider_getEmpty_Results(p.Struct()), err
-}
-func (p EmptyProvider_getEmpty_Results_Future) Empty() Empty {
-	return Empty(p.Future.Field(0, nil).Client())
+// NewEmpty_List creates a new list of Empty.
+func NewEmpty_List(s *capnp.Segment, sz int32) (Empty_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Empty](l), err
 }
 
-type PingPong capnp.Client
+type EmptyProvider capnp.Client
 
-// PingPong_TypeID is the unique identifier for the type PingPong.
-const PingPong_TypeID = 0xf004c474c2f8ee7a
+// EmptyProvider_TypeID is the unique identifier for the type EmptyProvider.
+const EmptyProvider_TypeID = 0xea38d4d6dca1e80e
 
-func (c PingPong) EchoNum(ctx context.Context, params func(PingPong_echoNum_Params) error) (PingPong_echoNum_Results_Future, capnp.ReleaseFunc) {
+func (c EmptyProvider) GetEmpty(ctx context.Context, params func(EmptyProvider_getEmpty_Params) error) (EmptyProvider_getEmpty_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xf004c474c2f8ee7a,
+			InterfaceID:   0xea38d4d6dca1e80e,
 			MethodID:      0,
-			InterfaceName: "test.capnp:PingPong",
-			MethodName:    "echoNum",
+			InterfaceName: "test.capnp:EmptyProvider",
+			MethodName:    "getEmpty",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(PingPong_echoNum_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(EmptyProvider_getEmpty_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return PingPong_echoNum_Results_Future{Future: ans.Future()}, release
+	return EmptyProvider_getEmpty_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c PingPong) WaitStreaming() error {
+func (c EmptyProvider) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -467,14 +971,14 @@ func (c PingPong) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c PingPong) String() string {
-	return "PingPong(" + capnp.Client(c).String() + ")"
+func (c EmptyProvider) String() string {
+	return "EmptyProvider(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c PingPong) AddRef() PingPong {
-	return PingPong(capnp.Client(c).AddRef())
+func (c EmptyProvider) AddRef() EmptyProvider {
+	return EmptyProvider(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -483,28 +987,28 @@ func (c PingPong) AddRef() PingPong {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c PingPong) Release() {
+func (c EmptyProvider) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c PingPong) Resolve(ctx context.Context) error {
+func (c EmptyProvider) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c PingPong) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c EmptyProvider) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (PingPong) DecodeFromPtr(p capnp.Ptr) PingPong {
-	return PingPong(capnp.Client{}.DecodeFromPtr(p))
+func (EmptyProvider) DecodeFromPtr(p capnp.Ptr) EmptyProvider {
+	return EmptyProvider(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c PingPong) IsValid() bool {
+func (c EmptyProvider) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -512,7 +1016,7 @@ func (c PingPong) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c PingPong) IsSame(other PingPong) bool {
+func (c EmptyProvider) IsSame(other EmptyProvider) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -520,249 +1024,251 @@ func (c PingPong) IsSame(other PingPong) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c PingPong) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c EmptyProvider) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
 // Get the current flowcontrol.FlowLimiter used to manage flow control
 // for this client.
-func (c PingPong) GetFlowLimiter() fc.FlowLimiter {
+func (c EmptyProvider) GetFlowLimiter() fc.FlowLimiter {
 	return capnp.Client(c).GetFlowLimiter()
 }
 
-// A PingPong_Server is a PingPong with a local implementation.
-type PingPong_Server interface {
-	EchoNum(context.Context, PingPong_echoNum) error
+// A EmptyProvider_Server is a EmptyProvider with a local implementation.
+type EmptyProvider_Server interface {
+	GetEmpty(context.Context, EmptyProvider_getEmpty) error
 }
 
-// PingPong_NewServer creates a new Server from an implementation of PingPong_Server.
-func PingPong_NewServer(s PingPong_Server) *server.Server {
+// EmptyProvider_NewServer creates a new Server from an implementation of EmptyProvider_Server.
+func EmptyProvider_NewServer(s EmptyProvider_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(PingPong_Methods(nil, s), s, c)
+	return server.New(EmptyProvider_Methods(nil, s), s, c)
 }
 
-// PingPong_ServerToClient creates a new Client from an implementation of PingPong_Server.
+// EmptyProvider_ServerToClient creates a new Client from an implementation of EmptyProvider_Server.
 // The caller is responsible for calling Release on the returned Client.
-func PingPong_ServerToClient(s PingPong_Server) PingPong {
-	return PingPong(capnp.NewClient(PingPong_NewServer(s)))
+func EmptyProvider_ServerToClient(s EmptyProvider_Server) EmptyProvider {
+	return EmptyProvider(capnp.NewClient(EmptyProvider_NewServer(s)))
 }
 
-// PingPong_Methods appends Methods to a slice that invoke the methods on s.
+// EmptyProvider_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func PingPong_Methods(methods []server.Method, s PingPong_Server) []server.Method {
+func EmptyProvider_Methods(methods []server.Method, s EmptyProvider_Server) []server.Method {
 	if cap(methods) == 0 {
 		methods = make([]server.Method, 0, 1)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xf004c474c2f8ee7a,
+			InterfaceID:   0xea38d4d6dca1e80e,
 			MethodID:      0,
-			InterfaceName: "test.capnp:PingPong",
-			MethodName:    "echoNum",
+			InterfaceName: "test.capnp:EmptyProvider",
+			MethodName:    "getEmpty",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.EchoNum(ctx, PingPong_echoNum{call})
+			return s.GetEmpty(ctx, EmptyProvider_getEmpty{call})
 		},
 	})
 
 	return methods
 }
 
-// PingPong_echoNum holds the state for a server call to PingPong.echoNum.
+// EmptyProvider_getEmpty holds the state for a server call to EmptyProvider.getEmpty.
 // See server.Call for documentation.
-type PingPong_echoNum struct {
+type EmptyProvider_getEmpty struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c PingPong_echoNum) Args() PingPong_echoNum_Params {
-	return PingPong_echoNum_Params(c.Call.Args())
+func (c EmptyProvider_getEmpty) Args() EmptyProvider_getEmpty_Params {
+	return EmptyProvider_getEmpty_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c PingPong_echoNum) AllocResults() (PingPong_echoNum_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PingPong_echoNum_Results(r), err
+func (c EmptyProvider_getEmpty) AllocResults() (EmptyProvider_getEmpty_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EmptyProvider_getEmpty_Results(r), err
 }
 
-// PingPong_List is a list of PingPong.
-type PingPong_List = capnp.CapList[PingPong]
+// EmptyProvider_List is a list of EmptyProvider.
+type EmptyProvider_List = capnp.CapList[EmptyProvider]
 
-// NewPingPong creates a new list of PingPong.
-func NewPingPong_List(s *capnp.Segment, sz int32) (PingPong_List, error) {
+// NewEmptyProvider_List creates a new list of EmptyProvider.
+func NewEmptyProvider_List(s *capnp.Segment, sz int32) (EmptyProvider_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[PingPong](l), err
+	return capnp.CapList[EmptyProvider](l), err
 }
 
-type PingPong_echoNum_Params capnp.Struct
+type EmptyProvider_getEmpty_Params capnp.Struct
 
-// PingPong_echoNum_Params_TypeID is the unique identifier for the type PingPong_echoNum_Params.
-const PingPong_echoNum_Params_TypeID = 0xd797e0a99edf0921
+// EmptyProvider_getEmpty_Params_TypeID is the unique identifier for the type EmptyProvider_getEmpty_Params.
+const EmptyProvider_getEmpty_Params_TypeID = 0x9a27082d77b8c289
 
-func NewPingPong_echoNum_Params(s *capnp.Segment) (PingPong_echoNum_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PingPong_echoNum_Params(st), err
+func NewEmptyProvider_getEmpty_Params(s *capnp.Segment) (EmptyProvider_getEmpty_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return EmptyProvider_getEmpty_Params(st), err
 }
 
-func NewRootPingPong_echoNum_Params(s *capnp.Segment) (PingPong_echoNum_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PingPong_echoNum_Params(st), err
+func NewRootEmptyProvider_getEmpty_Params(s *capnp.Segment) (EmptyProvider_getEmpty_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return EmptyProvider_getEmpty_Params(st), err
 }
 
-func ReadRootPingPong_echoNum_Params(msg *capnp.Message) (PingPong_echoNum_Params, error) {
+func ReadRootEmptyProvider_getEmpty_Params(msg *capnp.Message) (EmptyProvider_getEmpty_Params, error) {
 	root, err := msg.Root()
-	return PingPong_echoNum_Params(root.Struct()), err
+	return EmptyProvider_getEmpty_Params(root.Struct()), err
 }
 
-func (s PingPong_echoNum_Params) String() string {
-	str, _ := text.Marshal(0xd797e0a99edf0921, capnp.Struct(s))
+func (s EmptyProvider_getEmpty_Params) String() string {
+	str, _ := text.Marshal(0x9a27082d77b8c289, capnp.Struct(s))
 	return str
 }
 
-func (s PingPong_echoNum_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s EmptyProvider_getEmpty_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (PingPong_echoNum_Params) DecodeFromPtr(p capnp.Ptr) PingPong_echoNum_Params {
-	return PingPong_echoNum_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (EmptyProvider_getEmpty_Params) DecodeFromPtr(p capnp.Ptr) EmptyProvider_getEmpty_Params {
+	return EmptyProvider_getEmpty_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s PingPong_echoNum_Params) ToPtr() capnp.Ptr {
+func (s EmptyProvider_getEmpty_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s PingPong_echoNum_Params) IsValid() bool {
+func (s EmptyProvider_getEmpty_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s PingPong_echoNum_Params) Message() *capnp.Message {
+func (s EmptyProvider_getEmpty_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s PingPong_echoNum_Params) Segment() *capnp.Segment {
+func (s EmptyProvider_getEmpty_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s PingPong_echoNum_Params) N() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
-}
-
-func (s PingPong_echoNum_Params) SetN(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
-}
 
-// PingPong_echoNum_Params_List is a list of PingPong_echoNum_Params.
-type PingPong_echoNum_Params_List = capnp.StructList[PingPong_echoNum_Params]
+// EmptyProvider_getEmpty_Params_List is a list of EmptyProvider_getEmpty_Params.
+type EmptyProvider_getEmpty_Params_List = capnp.StructList[EmptyProvider_getEmpty_Params]
 
-// NewPingPong_echoNum_Params creates a new list of PingPong_echoNum_Params.
-func NewPingPong_echoNum_Params_List(s *capnp.Segment, sz int32) (PingPong_echoNum_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[PingPong_echoNum_Params](l), err
+// NewEmptyProvider_getEmpty_Params creates a new list of EmptyProvider_getEmpty_Params.
+func NewEmptyProvider_getEmpty_Params_List(s *capnp.Segment, sz int32) (EmptyProvider_getEmpty_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[EmptyProvider_getEmpty_Params](l), err
 }
 
-// PingPong_echoNum_Params_Future is a wrapper for a PingPong_echoNum_Params promised by a client call.
-type PingPong_echoNum_Params_Future struct{ *capnp.Future }
+// EmptyProvider_getEmpty_Params_Future is a wrapper for a EmptyProvider_getEmpty_Params promised by a client call.
+type EmptyProvider_getEmpty_Params_Future struct{ *capnp.Future }
 
-func (f PingPong_echoNum_Params_Future) Struct() (PingPong_echoNum_Params, error) {
+func (f EmptyProvider_getEmpty_Params_Future) Struct() (EmptyProvider_getEmpty_Params, error) {
 	p, err := f.Future.Ptr()
-	return PingPong_echoNum_Params(p.Struct()), err
+	return EmptyProvider_getEmpty_Params(p.Struct()), err
 }
 
-type PingPong_echoNum_Results capnp.Struct
+type EmptyProvider_getEmpty_Results capnp.Struct
 
-// PingPong_echoNum_Results_TypeID is the unique identifier for the type PingPong_echoNum_Results.
-const PingPong_echoNum_Results_TypeID = 0x85ddfd96db252600
+// EmptyProvider_getEmpty_Results_TypeID is the unique identifier for the type EmptyProvider_getEmpty_Results.
+const EmptyProvider_getEmpty_Results_TypeID = 0x93281cc60d6060cd
 
-func NewPingPong_echoNum_Results(s *capnp.Segment) (PingPong_echoNum_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PingPong_echoNum_Results(st), err
+func NewEmptyProvider_getEmpty_Results(s *capnp.Segment) (EmptyProvider_getEmpty_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EmptyProvider_getEmpty_Results(st), err
 }
 
-func NewRootPingPong_echoNum_Results(s *capnp.Segment) (PingPong_echoNum_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PingPong_echoNum_Results(st), err
+func NewRootEmptyProvider_getEmpty_Results(s *capnp.Segment) (EmptyProvider_getEmpty_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return EmptyProvider_getEmpty_Results(st), err
 }
 
-func ReadRootPingPong_echoNum_Results(msg *capnp.Message) (PingPong_echoNum_Results, error) {
+func ReadRootEmptyProvider_getEmpty_Results(msg *capnp.Message) (EmptyProvider_getEmpty_Results, error) {
 	root, err := msg.Root()
-	return PingPong_echoNum_Results(root.Struct()), err
+	return EmptyProvider_getEmpty_Results(root.Struct()), err
 }
 
-func (s PingPong_echoNum_Results) String() string {
-	str, _ := text.Marshal(0x85ddfd96db252600, capnp.Struct(s))
+func (s EmptyProvider_getEmpty_Results) String() string {
+	str, _ := text.Marshal(0x93281cc60d6060cd, capnp.Struct(s))
 	return str
 }
 
-func (s PingPong_echoNum_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s EmptyProvider_getEmpty_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (PingPong_echoNum_Results) DecodeFromPtr(p capnp.Ptr) PingPong_echoNum_Results {
-	return PingPong_echoNum_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (EmptyProvider_getEmpty_Results) DecodeFromPtr(p capnp.Ptr) EmptyProvider_getEmpty_Results {
+	return EmptyProvider_getEmpty_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s PingPong_echoNum_Results) ToPtr() capnp.Ptr {
+func (s EmptyProvider_getEmpty_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s PingPong_echoNum_Results) IsValid() bool {
+func (s EmptyProvider_getEmpty_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s PingPong_echoNum_Results) Message() *capnp.Message {
+func (s EmptyProvider_getEmpty_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s PingPong_echoNum_Results) Segment() *capnp.Segment {
+func (s EmptyProvider_getEmpty_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s PingPong_echoNum_Results) N() int64 {
-	return int64(capnp.Struct(s).Uint64(0))
+func (s EmptyProvider_getEmpty_Results) Empty() Empty {
+	return capnp.GetInterfaceField[Empty](s, 0)
 }
 
-func (s PingPong_echoNum_Results) SetN(v int64) {
-	capnp.Struct(s).SetUint64(0, uint64(v))
+func (s EmptyProvider_getEmpty_Results) HasEmpty() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// PingPong_echoNum_Results_List is a list of PingPong_echoNum_Results.
-type PingPong_echoNum_Results_List = capnp.StructList[PingPong_echoNum_Results]
+func (s EmptyProvider_getEmpty_Results) SetEmpty(v Empty) error {
+	return capnp.SetInterfaceField(s, 0, v)
+}
 
-// NewPingPong_echoNum_Results creates a new list of PingPong_echoNum_Results.
-func NewPingPong_echoNum_Results_List(s *capnp.Segment, sz int32) (PingPong_echoNum_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[PingPong_echoNum_Results](l), err
+// EmptyProvider_getEmpty_Results_List is a list of EmptyProvider_getEmpty_Results.
+type EmptyProvider_getEmpty_Results_List = capnp.StructList[EmptyProvider_getEmpty_Results]
+
+// NewEmptyProvider_getEmpty_Results creates a new list of EmptyProvider_getEmpty_Results.
+func NewEmptyProvider_getEmpty_Results_List(s *capnp.Segment, sz int32) (EmptyProvider_getEmpty_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[EmptyProvider_getEmpty_Results](l), err
 }
 
-// PingPong_echoNum_Results_Future is a wrapper for a PingPong_echoNum_Results promised by a client call.
-type PingPong_echoNum_Results_Future struct{ *capnp.Future }
+// EmptyProvider_getEmpty_Results_Future is a wrapper for a EmptyProvider_getEmpty_Results promised by a client call.
+type EmptyProvider_getEmpty_Results_Future struct{ *capnp.Future }
 
-func (f PingPong_echoNum_Results_Future) Struct() (PingPong_echoNum_Results, error) {
+func (f EmptyProvider_getEmpty_Results_Future) Struct() (EmptyProvider_getEmpty_Results, error) {
 	p, err := f.Future.Ptr()
-	return PingPong_echoNum_Results(p.Struct()), err
+	return EmptyProvider_getEmpty_Results(p.Struct()), err
+}
+func (p EmptyProvider_getEmpty_Results_Future) Empty() Empty {
+	return Empty(p.Future.Field(0, nil).Client())
 }
 
-type StreamTest capnp.Client
+type PingPong capnp.Client
 
-// StreamTest_TypeID is the unique identifier for the type StreamTest.
-const StreamTest_TypeID = 0xbb3ca85b01eea465
+// PingPong_TypeID is the unique identifier for the type PingPong.
+const PingPong_TypeID = 0xf004c474c2f8ee7a
+
+func (c PingPong) EchoNum(ctx context.Context, params func(PingPong_echoNum_Params) error) (PingPong_echoNum_Results_Future, capnp.ReleaseFunc) {
 
-func (c StreamTest) Push(ctx context.Context, params func(StreamTest_push_Params) error) error {
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xbb3ca85b01eea465,
+			InterfaceID:   0xf004c474c2f8ee7a,
 			MethodID:      0,
-			InterfaceName: "test.capnp:StreamTest",
-			MethodName:    "push",
+			InterfaceName: "test.capnp:PingPong",
+			MethodName:    "echoNum",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(StreamTest_push_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(PingPong_echoNum_Params(s)) }
 	}
 
-	return capnp.Client(c).SendStreamCall(ctx, s)
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return PingPong_echoNum_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c StreamTest) WaitStreaming() error {
+func (c PingPong) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -770,14 +1276,14 @@ func (c StreamTest) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c StreamTest) String() string {
-	return "StreamTest(" + capnp.Client(c).String() + ")"
+func (c PingPong) String() string {
+	return "PingPong(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c StreamTest) AddRef() StreamTest {
-	return StreamTest(capnp.Client(c).AddRef())
+func (c PingPong) AddRef() PingPong {
+	return PingPong(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -786,28 +1292,28 @@ func (c StreamTest) AddRef() StreamTest {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c StreamTest) Release() {
+func (c PingPong) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c StreamTest) Resolve(ctx context.Context) error {
+func (c PingPong) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c StreamTest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c PingPong) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (StreamTest) DecodeFromPtr(p capnp.Ptr) StreamTest {
-	return StreamTest(capnp.Client{}.DecodeFromPtr(p))
+func (PingPong) DecodeFromPtr(p capnp.Ptr) PingPong {
+	return PingPong(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c StreamTest) IsValid() bool {
+func (c PingPong) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -815,7 +1321,7 @@ func (c StreamTest) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c StreamTest) IsSame(other StreamTest) bool {
+func (c PingPong) IsSame(other PingPong) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -823,204 +1329,107 @@ func (c StreamTest) IsSame(other StreamTest) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c StreamTest) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c PingPong) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
-// Get the current flowcontrol.FlowLimiter used to manage flow control
-// for this client.
-func (c StreamTest) GetFlowLimiter() fc.FlowLimiter {
-	return capnp.Client(c).GetFlowLimiter()
-}
-
-// A StreamTest_Server is a StreamTest with a local implementation.
-type StreamTest_Server interface {
-	Push(context.Context, StreamTest_push) error
-}
-
-// StreamTest_NewServer creates a new Server from an implementation of StreamTest_Server.
-func StreamTest_NewServer(s StreamTest_Server) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(StreamTest_Methods(nil, s), s, c)
-}
-
-// StreamTest_ServerToClient creates a new Client from an implementation of StreamTest_Server.
-// The caller is responsible for calling Release on the returned Client.
-func StreamTest_ServerToClient(s StreamTest_Server) StreamTest {
-	return StreamTest(capnp.NewClient(StreamTest_NewServer(s)))
-}
-
-// StreamTest_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func StreamTest_Methods(methods []server.Method, s StreamTest_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 1)
-	}
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xbb3ca85b01eea465,
-			MethodID:      0,
-			InterfaceName: "test.capnp:StreamTest",
-			MethodName:    "push",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Push(ctx, StreamTest_push{call})
-		},
-	})
-
-	return methods
-}
-
-// StreamTest_push holds the state for a server call to StreamTest.push.
-// See server.Call for documentation.
-type StreamTest_push struct {
-	*server.Call
-}
-
-// Args returns the call's arguments.
-func (c StreamTest_push) Args() StreamTest_push_Params {
-	return StreamTest_push_Params(c.Call.Args())
-}
-
-// AllocResults allocates the results struct.
-func (c StreamTest_push) AllocResults() (stream.StreamResult, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return stream.StreamResult(r), err
-}
-
-// StreamTest_List is a list of StreamTest.
-type StreamTest_List = capnp.CapList[StreamTest]
-
-// NewStreamTest creates a new list of StreamTest.
-func NewStreamTest_List(s *capnp.Segment, sz int32) (StreamTest_List, error) {
-	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[StreamTest](l), err
-}
-
-type StreamTest_push_Params capnp.Struct
-
-// StreamTest_push_Params_TypeID is the unique identifier for the type StreamTest_push_Params.
-const StreamTest_push_Params_TypeID = 0xf838dca6c8721bdb
-
-func NewStreamTest_push_Params(s *capnp.Segment) (StreamTest_push_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return StreamTest_push_Params(st), err
-}
-
-func NewRootStreamTest_push_Params(s *capnp.Segment) (StreamTest_push_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return StreamTest_push_Params(st), err
-}
-
-func ReadRootStreamTest_push_Params(msg *capnp.Message) (StreamTest_push_Params, error) {
-	root, err := msg.Root()
-	return StreamTest_push_Params(root.Struct()), err
-}
-
-func (s StreamTest_push_Params) String() string {
-	str, _ := text.Marshal(0xf838dca6c8721bdb, capnp.Struct(s))
-	return str
-}
-
-func (s StreamTest_push_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (StreamTest_push_Params) DecodeFromPtr(p capnp.Ptr) StreamTest_push_Params {
-	return StreamTest_push_Params(capnp.Struct{}.DecodeFromPtr(p))
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c PingPong) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
 }
 
-func (s StreamTest_push_Params) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s StreamTest_push_Params) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+// A PingPong_Server is a PingPong with a local implementation.
+type PingPong_Server interface {
+	EchoNum(context.Context, PingPong_echoNum) error
 }
 
-func (s StreamTest_push_Params) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// PingPong_NewServer creates a new Server from an implementation of PingPong_Server.
+func PingPong_NewServer(s PingPong_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(PingPong_Methods(nil, s), s, c)
 }
 
-func (s StreamTest_push_Params) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s StreamTest_push_Params) Data() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+// PingPong_ServerToClient creates a new Client from an implementation of PingPong_Server.
+// The caller is responsible for calling Release on the returned Client.
+func PingPong_ServerToClient(s PingPong_Server) PingPong {
+	return PingPong(capnp.NewClient(PingPong_NewServer(s)))
 }
 
-func (s StreamTest_push_Params) HasData() bool {
-	return capnp.Struct(s).HasPtr(0)
+// PingPong_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func PingPong_Methods(methods []server.Method, s PingPong_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf004c474c2f8ee7a,
+			MethodID:      0,
+			InterfaceName: "test.capnp:PingPong",
+			MethodName:    "echoNum",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.EchoNum(ctx, PingPong_echoNum{call})
+		},
+	})
+
+	return methods
 }
 
-func (s StreamTest_push_Params) SetData(v []byte) error {
-	return capnp.Struct(s).SetData(0, v)
+// PingPong_echoNum holds the state for a server call to PingPong.echoNum.
+// See server.Call for documentation.
+type PingPong_echoNum struct {
+	*server.Call
 }
 
-// StreamTest_push_Params_List is a list of StreamTest_push_Params.
-type StreamTest_push_Params_List = capnp.StructList[StreamTest_push_Params]
+// Args returns the call's arguments.
+func (c PingPong_echoNum) Args() PingPong_echoNum_Params {
+	return PingPong_echoNum_Params(c.Call.Args())
+}
 
-// NewStreamTest_push_Params creates a new list of StreamTest_push_Params.
-func NewStreamTest_push_Params_List(s *capnp.Segment, sz int32) (StreamTest_push_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[StreamTest_push_Params](l), err
+// AllocResults allocates the results struct.
+func (c PingPong_echoNum) AllocResults() (PingPong_echoNum_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PingPong_echoNum_Results(r), err
 }
 
-// StreamTest_push_Params_Future is a wrapper for a StreamTest_push_Params promised by a client call.
-type StreamTest_push_Params_Future struct{ *capnp.Future }
+// PingPong_List is a list of PingPong.
+type PingPong_List = capnp.CapList[PingPong]
 
-func (f StreamTest_push_Params_Future) Struct() (StreamTest_push_Params, error) {
-	p, err := f.Future.Ptr()
-	return StreamTest_push_Params(p.Struct()), err
+// NewPingPong_List creates a new list of PingPong.
+func NewPingPong_List(s *capnp.Segment, sz int32) (PingPong_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[PingPong](l), err
 }
 
-type CapArgsTest capnp.Client
+type PingPongProvider capnp.Client
 
-// CapArgsTest_TypeID is the unique identifier for the type CapArgsTest.
-const CapArgsTest_TypeID = 0xb86bce7f916a10cc
+// PingPongProvider_TypeID is the unique identifier for the type PingPongProvider.
+const PingPongProvider_TypeID = 0x95b6142577e93239
 
-func (c CapArgsTest) Call(ctx context.Context, params func(CapArgsTest_call_Params) error) (CapArgsTest_call_Results_Future, capnp.ReleaseFunc) {
+func (c PingPongProvider) PingPong(ctx context.Context, params func(PingPongProvider_pingPong_Params) error) (PingPongProvider_pingPong_Results_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0xb86bce7f916a10cc,
+			InterfaceID:   0x95b6142577e93239,
 			MethodID:      0,
-			InterfaceName: "test.capnp:CapArgsTest",
-			MethodName:    "call",
-		},
-	}
-	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(CapArgsTest_call_Params(s)) }
-	}
-
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return CapArgsTest_call_Results_Future{Future: ans.Future()}, release
-
-}
-
-func (c CapArgsTest) Self(ctx context.Context, params func(CapArgsTest_self_Params) error) (CapArgsTest_self_Results_Future, capnp.ReleaseFunc) {
-
-	s := capnp.Send{
-		Method: capnp.Method{
-			InterfaceID:   0xb86bce7f916a10cc,
-			MethodID:      1,
-			InterfaceName: "test.capnp:CapArgsTest",
-			MethodName:    "self",
+			InterfaceName: "test.capnp:PingPongProvider",
+			MethodName:    "pingPong",
 		},
 	}
 	if params != nil {
 		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(CapArgsTest_self_Params(s)) }
+		s.PlaceArgs = func(s capnp.Struct) error { return params(PingPongProvider_pingPong_Params(s)) }
 	}
 
 	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return CapArgsTest_self_Results_Future{Future: ans.Future()}, release
+	return PingPongProvider_pingPong_Results_Future{Future: ans.Future()}, release
 
 }
 
-func (c CapArgsTest) WaitStreaming() error {
+func (c PingPongProvider) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -1028,14 +1437,14 @@ func (c CapArgsTest) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c CapArgsTest) String() string {
-	return "CapArgsTest(" + capnp.Client(c).String() + ")"
+func (c PingPongProvider) String() string {
+	return "PingPongProvider(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c CapArgsTest) AddRef() CapArgsTest {
-	return CapArgsTest(capnp.Client(c).AddRef())
+func (c PingPongProvider) AddRef() PingPongProvider {
+	return PingPongProvider(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -1044,28 +1453,28 @@ func (c CapArgsTest) AddRef() CapArgsTest {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c CapArgsTest) Release() {
+func (c PingPongProvider) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c CapArgsTest) Resolve(ctx context.Context) error {
+func (c PingPongProvider) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c CapArgsTest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c PingPongProvider) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (CapArgsTest) DecodeFromPtr(p capnp.Ptr) CapArgsTest {
-	return CapArgsTest(capnp.Client{}.DecodeFromPtr(p))
+func (PingPongProvider) DecodeFromPtr(p capnp.Ptr) PingPongProvider {
+	return PingPongProvider(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c CapArgsTest) IsValid() bool {
+func (c PingPongProvider) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -1073,7 +1482,7 @@ func (c CapArgsTest) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c CapArgsTest) IsSame(other CapArgsTest) bool {
+func (c PingPongProvider) IsSame(other PingPongProvider) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -1081,438 +1490,393 @@ func (c CapArgsTest) IsSame(other CapArgsTest) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c CapArgsTest) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c PingPongProvider) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
 // Get the current flowcontrol.FlowLimiter used to manage flow control
 // for this client.
-func (c CapArgsTest) GetFlowLimiter() fc.FlowLimiter {
+func (c PingPongProvider) GetFlowLimiter() fc.FlowLimiter {
 	return capnp.Client(c).GetFlowLimiter()
 }
 
-// A CapArgsTest_Server is a CapArgsTest with a local implementation.
-type CapArgsTest_Server interface {
-	Call(context.Context, CapArgsTest_call) error
-
-	Self(context.Context, CapArgsTest_self) error
+// A PingPongProvider_Server is a PingPongProvider with a local implementation.
+type PingPongProvider_Server interface {
+	PingPong(context.Context, PingPongProvider_pingPong) error
 }
 
-// CapArgsTest_NewServer creates a new Server from an implementation of CapArgsTest_Server.
-func CapArgsTest_NewServer(s CapArgsTest_Server) *server.Server {
+// PingPongProvider_NewServer creates a new Server from an implementation of PingPongProvider_Server.
+func PingPongProvider_NewServer(s PingPongProvider_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(CapArgsTest_Methods(nil, s), s, c)
+	return server.New(PingPongProvider_Methods(nil, s), s, c)
 }
 
-// CapArgsTest_ServerToClient creates a new Client from an implementation of CapArgsTest_Server.
+// PingPongProvider_ServerToClient creates a new Client from an implementation of PingPongProvider_Server.
 // The caller is responsible for calling Release on the returned Client.
-func CapArgsTest_ServerToClient(s CapArgsTest_Server) CapArgsTest {
-	return CapArgsTest(capnp.NewClient(CapArgsTest_NewServer(s)))
+func PingPongProvider_ServerToClient(s PingPongProvider_Server) PingPongProvider {
+	return PingPongProvider(capnp.NewClient(PingPongProvider_NewServer(s)))
 }
 
-// CapArgsTest_Methods appends Methods to a slice that invoke the methods on s.
+// PingPongProvider_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func CapArgsTest_Methods(methods []server.Method, s CapArgsTest_Server) []server.Method {
+func PingPongProvider_Methods(methods []server.Method, s PingPongProvider_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+		methods = make([]server.Method, 0, 1)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xb86bce7f916a10cc,
+			InterfaceID:   0x95b6142577e93239,
 			MethodID:      0,
-			InterfaceName: "test.capnp:CapArgsTest",
-			MethodName:    "call",
-		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Call(ctx, CapArgsTest_call{call})
-		},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xb86bce7f916a10cc,
-			MethodID:      1,
-			InterfaceName: "test.capnp:CapArgsTest",
-			MethodName:    "self",
+			InterfaceName: "test.capnp:PingPongProvider",
+			MethodName:    "pingPong",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Self(ctx, CapArgsTest_self{call})
+			return s.PingPong(ctx, PingPongProvider_pingPong{call})
 		},
 	})
 
 	return methods
 }
 
-// CapArgsTest_call holds the state for a server call to CapArgsTest.call.
-// See server.Call for documentation.
-type CapArgsTest_call struct {
-	*server.Call
-}
-
-// Args returns the call's arguments.
-func (c CapArgsTest_call) Args() CapArgsTest_call_Params {
-	return CapArgsTest_call_Params(c.Call.Args())
-}
-
-// AllocResults allocates the results struct.
-func (c CapArgsTest_call) AllocResults() (CapArgsTest_call_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapArgsTest_call_Results(r), err
-}
-
-// CapArgsTest_self holds the state for a server call to CapArgsTest.self.
+// PingPongProvider_pingPong holds the state for a server call to PingPongProvider.pingPong.
 // See server.Call for documentation.
-type CapArgsTest_self struct {
+type PingPongProvider_pingPong struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c CapArgsTest_self) Args() CapArgsTest_self_Params {
-	return CapArgsTest_self_Params(c.Call.Args())
+func (c PingPongProvider_pingPong) Args() PingPongProvider_pingPong_Params {
+	return PingPongProvider_pingPong_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c CapArgsTest_self) AllocResults() (CapArgsTest_self_Results, error) {
+func (c PingPongProvider_pingPong) AllocResults() (PingPongProvider_pingPong_Results, error) {
 	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapArgsTest_self_Results(r), err
+	return PingPongProvider_pingPong_Results(r), err
 }
 
-// CapArgsTest_List is a list of CapArgsTest.
-type CapArgsTest_List = capnp.CapList[CapArgsTest]
+// PingPongProvider_List is a list of PingPongProvider.
+type PingPongProvider_List = capnp.CapList[PingPongProvider]
 
-// NewCapArgsTest creates a new list of CapArgsTest.
-func NewCapArgsTest_List(s *capnp.Segment, sz int32) (CapArgsTest_List, error) {
+// NewPingPongProvider_List creates a new list of PingPongProvider.
+func NewPingPongProvider_List(s *capnp.Segment, sz int32) (PingPongProvider_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[CapArgsTest](l), err
+	return capnp.CapList[PingPongProvider](l), err
 }
 
-type CapArgsTest_call_Params capnp.Struct
+type PingPongProvider_pingPong_Params capnp.Struct
 
-// CapArgsTest_call_Params_TypeID is the unique identifier for the type CapArgsTest_call_Params.
-const CapArgsTest_call_Params_TypeID = 0x80087e4e698768a2
+// PingPongProvider_pingPong_Params_TypeID is the unique identifier for the type PingPongProvider_pingPong_Params.
+const PingPongProvider_pingPong_Params_TypeID = 0xd4e835c17f1ef32c
 
-func NewCapArgsTest_call_Params(s *capnp.Segment) (CapArgsTest_call_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapArgsTest_call_Params(st), err
+func NewPingPongProvider_pingPong_Params(s *capnp.Segment) (PingPongProvider_pingPong_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return PingPongProvider_pingPong_Params(st), err
 }
 
-func NewRootCapArgsTest_call_Params(s *capnp.Segment) (CapArgsTest_call_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapArgsTest_call_Params(st), err
+func NewRootPingPongProvider_pingPong_Params(s *capnp.Segment) (PingPongProvider_pingPong_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return PingPongProvider_pingPong_Params(st), err
 }
 
-func ReadRootCapArgsTest_call_Params(msg *capnp.Message) (CapArgsTest_call_Params, error) {
+func ReadRootPingPongProvider_pingPong_Params(msg *capnp.Message) (PingPongProvider_pingPong_Params, error) {
 	root, err := msg.Root()
-	return CapArgsTest_call_Params(root.Struct()), err
+	return PingPongProvider_pingPong_Params(root.Struct()), err
 }
 
-func (s CapArgsTest_call_Params) String() string {
-	str, _ := text.Marshal(0x80087e4e698768a2, capnp.Struct(s))
+func (s PingPongProvider_pingPong_Params) String() string {
+	str, _ := text.Marshal(0xd4e835c17f1ef32c, capnp.Struct(s))
 	return str
 }
 
-func (s CapArgsTest_call_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PingPongProvider_pingPong_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapArgsTest_call_Params) DecodeFromPtr(p capnp.Ptr) CapArgsTest_call_Params {
-	return CapArgsTest_call_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (PingPongProvider_pingPong_Params) DecodeFromPtr(p capnp.Ptr) PingPongProvider_pingPong_Params {
+	return PingPongProvider_pingPong_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapArgsTest_call_Params) ToPtr() capnp.Ptr {
+func (s PingPongProvider_pingPong_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CapArgsTest_call_Params) IsValid() bool {
+func (s PingPongProvider_pingPong_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapArgsTest_call_Params) Message() *capnp.Message {
+func (s PingPongProvider_pingPong_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapArgsTest_call_Params) Segment() *capnp.Segment {
+func (s PingPongProvider_pingPong_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s CapArgsTest_call_Params) Cap() capnp.Client {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return p.Interface().Client()
-}
-
-func (s CapArgsTest_call_Params) HasCap() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s CapArgsTest_call_Params) SetCap(c capnp.Client) error {
-	if !c.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
-}
 
-// CapArgsTest_call_Params_List is a list of CapArgsTest_call_Params.
-type CapArgsTest_call_Params_List = capnp.StructList[CapArgsTest_call_Params]
+// PingPongProvider_pingPong_Params_List is a list of PingPongProvider_pingPong_Params.
+type PingPongProvider_pingPong_Params_List = capnp.StructList[PingPongProvider_pingPong_Params]
 
-// NewCapArgsTest_call_Params creates a new list of CapArgsTest_call_Params.
-func NewCapArgsTest_call_Params_List(s *capnp.Segment, sz int32) (CapArgsTest_call_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[CapArgsTest_call_Params](l), err
+// NewPingPongProvider_pingPong_Params creates a new list of PingPongProvider_pingPong_Params.
+func NewPingPongProvider_pingPong_Params_List(s *capnp.Segment, sz int32) (PingPongProvider_pingPong_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[PingPongProvider_pingPong_Params](l), err
 }
 
-// CapArgsTest_call_Params_Future is a wrapper for a CapArgsTest_call_Params promised by a client call.
-type CapArgsTest_call_Params_Future struct{ *capnp.Future }
+// PingPongProvider_pingPong_Params_Future is a wrapper for a PingPongProvider_pingPong_Params promised by a client call.
+type PingPongProvider_pingPong_Params_Future struct{ *capnp.Future }
 
-func (f CapArgsTest_call_Params_Future) Struct() (CapArgsTest_call_Params, error) {
+func (f PingPongProvider_pingPong_Params_Future) Struct() (PingPongProvider_pingPong_Params, error) {
 	p, err := f.Future.Ptr()
-	return CapArgsTest_call_Params(p.Struct()), err
-}
-func (p CapArgsTest_call_Params_Future) Cap() capnp.Client {
-	return p.Future.Field(0, nil).Client()
+	return PingPongProvider_pingPong_Params(p.Struct()), err
 }
 
-type CapArgsTest_call_Results capnp.Struct
+type PingPongProvider_pingPong_Results capnp.Struct
 
-// CapArgsTest_call_Results_TypeID is the unique identifier for the type CapArgsTest_call_Results.
-const CapArgsTest_call_Results_TypeID = 0x96fbc50dc2f0200d
+// PingPongProvider_pingPong_Results_TypeID is the unique identifier for the type PingPongProvider_pingPong_Results.
+const PingPongProvider_pingPong_Results_TypeID = 0xf269473b6db8d0eb
 
-func NewCapArgsTest_call_Results(s *capnp.Segment) (CapArgsTest_call_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapArgsTest_call_Results(st), err
+func NewPingPongProvider_pingPong_Results(s *capnp.Segment) (PingPongProvider_pingPong_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PingPongProvider_pingPong_Results(st), err
 }
 
-func NewRootCapArgsTest_call_Results(s *capnp.Segment) (CapArgsTest_call_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapArgsTest_call_Results(st), err
+func NewRootPingPongProvider_pingPong_Results(s *capnp.Segment) (PingPongProvider_pingPong_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PingPongProvider_pingPong_Results(st), err
 }
 
-func ReadRootCapArgsTest_call_Results(msg *capnp.Message) (CapArgsTest_call_Results, error) {
+func ReadRootPingPongProvider_pingPong_Results(msg *capnp.Message) (PingPongProvider_pingPong_Results, error) {
 	root, err := msg.Root()
-	return CapArgsTest_call_Results(root.Struct()), err
+	return PingPongProvider_pingPong_Results(root.Struct()), err
 }
 
-func (s CapArgsTest_call_Results) String() string {
-	str, _ := text.Marshal(0x96fbc50dc2f0200d, capnp.Struct(s))
+func (s PingPongProvider_pingPong_Results) String() string {
+	str, _ := text.Marshal(0xf269473b6db8d0eb, capnp.Struct(s))
 	return str
 }
 
-func (s CapArgsTest_call_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PingPongProvider_pingPong_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapArgsTest_call_Results) DecodeFromPtr(p capnp.Ptr) CapArgsTest_call_Results {
-	return CapArgsTest_call_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (PingPongProvider_pingPong_Results) DecodeFromPtr(p capnp.Ptr) PingPongProvider_pingPong_Results {
+	return PingPongProvider_pingPong_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapArgsTest_call_Results) ToPtr() capnp.Ptr {
+func (s PingPongProvider_pingPong_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CapArgsTest_call_Results) IsValid() bool {
+func (s PingPongProvider_pingPong_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapArgsTest_call_Results) Message() *capnp.Message {
+func (s PingPongProvider_pingPong_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapArgsTest_call_Results) Segment() *capnp.Segment {
+func (s PingPongProvider_pingPong_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s PingPongProvider_pingPong_Results) PingPong() PingPong {
+	return capnp.GetInterfaceField[PingPong](s, 0)
+}
 
-// CapArgsTest_call_Results_List is a list of CapArgsTest_call_Results.
-type CapArgsTest_call_Results_List = capnp.StructList[CapArgsTest_call_Results]
+func (s PingPongProvider_pingPong_Results) HasPingPong() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-// NewCapArgsTest_call_Results creates a new list of CapArgsTest_call_Results.
-func NewCapArgsTest_call_Results_List(s *capnp.Segment, sz int32) (CapArgsTest_call_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[CapArgsTest_call_Results](l), err
+func (s PingPongProvider_pingPong_Results) SetPingPong(v PingPong) error {
+	return capnp.SetInterfaceField(s, 0, v)
 }
 
-// CapArgsTest_call_Results_Future is a wrapper for a CapArgsTest_call_Results promised by a client call.
-type CapArgsTest_call_Results_Future struct{ *capnp.Future }
+// PingPongProvider_pingPong_Results_List is a list of PingPongProvider_pingPong_Results.
+type PingPongProvider_pingPong_Results_List = capnp.StructList[PingPongProvider_pingPong_Results]
 
-func (f CapArgsTest_call_Results_Future) Struct() (CapArgsTest_call_Results, error) {
+// NewPingPongProvider_pingPong_Results creates a new list of PingPongProvider_pingPong_Results.
+func NewPingPongProvider_pingPong_Results_List(s *capnp.Segment, sz int32) (PingPongProvider_pingPong_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[PingPongProvider_pingPong_Results](l), err
+}
+
+// PingPongProvider_pingPong_Results_Future is a wrapper for a PingPongProvider_pingPong_Results promised by a client call.
+type PingPongProvider_pingPong_Results_Future struct{ *capnp.Future }
+
+func (f PingPongProvider_pingPong_Results_Future) Struct() (PingPongProvider_pingPong_Results, error) {
 	p, err := f.Future.Ptr()
-	return CapArgsTest_call_Results(p.Struct()), err
+	return PingPongProvider_pingPong_Results(p.Struct()), err
+}
+func (p PingPongProvider_pingPong_Results_Future) PingPong() PingPong {
+	return PingPong(p.Future.Field(0, nil).Client())
 }
 
-type CapArgsTest_self_Params capnp.Struct
+type PingPong_echoNum_Params capnp.Struct
 
-// CapArgsTest_self_Params_TypeID is the unique identifier for the type CapArgsTest_self_Params.
-const CapArgsTest_self_Params_TypeID = 0xe2553e5a663abb7d
+// PingPong_echoNum_Params_TypeID is the unique identifier for the type PingPong_echoNum_Params.
+const PingPong_echoNum_Params_TypeID = 0xd797e0a99edf0921
 
-func NewCapArgsTest_self_Params(s *capnp.Segment) (CapArgsTest_self_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapArgsTest_self_Params(st), err
+func NewPingPong_echoNum_Params(s *capnp.Segment) (PingPong_echoNum_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PingPong_echoNum_Params(st), err
 }
 
-func NewRootCapArgsTest_self_Params(s *capnp.Segment) (CapArgsTest_self_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return CapArgsTest_self_Params(st), err
+func NewRootPingPong_echoNum_Params(s *capnp.Segment) (PingPong_echoNum_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PingPong_echoNum_Params(st), err
 }
 
-func ReadRootCapArgsTest_self_Params(msg *capnp.Message) (CapArgsTest_self_Params, error) {
+func ReadRootPingPong_echoNum_Params(msg *capnp.Message) (PingPong_echoNum_Params, error) {
 	root, err := msg.Root()
-	return CapArgsTest_self_Params(root.Struct()), err
+	return PingPong_echoNum_Params(root.Struct()), err
 }
 
-func (s CapArgsTest_self_Params) String() string {
-	str, _ := text.Marshal(0xe2553e5a663abb7d, capnp.Struct(s))
+func (s PingPong_echoNum_Params) String() string {
+	str, _ := text.Marshal(0xd797e0a99edf0921, capnp.Struct(s))
 	return str
 }
 
-func (s CapArgsTest_self_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PingPong_echoNum_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapArgsTest_self_Params) DecodeFromPtr(p capnp.Ptr) CapArgsTest_self_Params {
-	return CapArgsTest_self_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (PingPong_echoNum_Params) DecodeFromPtr(p capnp.Ptr) PingPong_echoNum_Params {
+	return PingPong_echoNum_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapArgsTest_self_Params) ToPtr() capnp.Ptr {
+func (s PingPong_echoNum_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CapArgsTest_self_Params) IsValid() bool {
+func (s PingPong_echoNum_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapArgsTest_self_Params) Message() *capnp.Message {
+func (s PingPong_echoNum_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapArgsTest_self_Params) Segment() *capnp.Segment {
+func (s PingPong_echoNum_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+func (s PingPong_echoNum_Params) N() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
+}
 
-// CapArgsTest_self_Params_List is a list of CapArgsTest_self_Params.
-type CapArgsTest_self_Params_List = capnp.StructList[CapArgsTest_self_Params]
+func (s PingPong_echoNum_Params) SetN(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
+}
 
-// NewCapArgsTest_self_Params creates a new list of CapArgsTest_self_Params.
-func NewCapArgsTest_self_Params_List(s *capnp.Segment, sz int32) (CapArgsTest_self_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[CapArgsTest_self_Params](l), err
+// PingPong_echoNum_Params_List is a list of PingPong_echoNum_Params.
+type PingPong_echoNum_Params_List = capnp.StructList[PingPong_echoNum_Params]
+
+// NewPingPong_echoNum_Params creates a new list of PingPong_echoNum_Params.
+func NewPingPong_echoNum_Params_List(s *capnp.Segment, sz int32) (PingPong_echoNum_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[PingPong_echoNum_Params](l), err
 }
 
-// CapArgsTest_self_Params_Future is a wrapper for a CapArgsTest_self_Params promised by a client call.
-type CapArgsTest_self_Params_Future struct{ *capnp.Future }
+// PingPong_echoNum_Params_Future is a wrapper for a PingPong_echoNum_Params promised by a client call.
+type PingPong_echoNum_Params_Future struct{ *capnp.Future }
 
-func (f CapArgsTest_self_Params_Future) Struct() (CapArgsTest_self_Params, error) {
+func (f PingPong_echoNum_Params_Future) Struct() (PingPong_echoNum_Params, error) {
 	p, err := f.Future.Ptr()
-	return CapArgsTest_self_Params(p.Struct()), err
+	return PingPong_echoNum_Params(p.Struct()), err
 }
 
-type CapArgsTest_self_Results capnp.Struct
+type PingPong_echoNum_Results capnp.Struct
 
-// CapArgsTest_self_Results_TypeID is the unique identifier for the type CapArgsTest_self_Results.
-const CapArgsTest_self_Results_TypeID = 0x9746cc05cbff1132
+// PingPong_echoNum_Results_TypeID is the unique identifier for the type PingPong_echoNum_Results.
+const PingPong_echoNum_Results_TypeID = 0x85ddfd96db252600
 
-func NewCapArgsTest_self_Results(s *capnp.Segment) (CapArgsTest_self_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapArgsTest_self_Results(st), err
+func NewPingPong_echoNum_Results(s *capnp.Segment) (PingPong_echoNum_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PingPong_echoNum_Results(st), err
 }
 
-func NewRootCapArgsTest_self_Results(s *capnp.Segment) (CapArgsTest_self_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return CapArgsTest_self_Results(st), err
+func NewRootPingPong_echoNum_Results(s *capnp.Segment) (PingPong_echoNum_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PingPong_echoNum_Results(st), err
 }
 
-func ReadRootCapArgsTest_self_Results(msg *capnp.Message) (CapArgsTest_self_Results, error) {
+func ReadRootPingPong_echoNum_Results(msg *capnp.Message) (PingPong_echoNum_Results, error) {
 	root, err := msg.Root()
-	return CapArgsTest_self_Results(root.Struct()), err
+	return PingPong_echoNum_Results(root.Struct()), err
 }
 
-func (s CapArgsTest_self_Results) String() string {
-	str, _ := text.Marshal(0x9746cc05cbff1132, capnp.Struct(s))
+func (s PingPong_echoNum_Results) String() string {
+	str, _ := text.Marshal(0x85ddfd96db252600, capnp.Struct(s))
 	return str
 }
 
-func (s CapArgsTest_self_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PingPong_echoNum_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapArgsTest_self_Results) DecodeFromPtr(p capnp.Ptr) CapArgsTest_self_Results {
-	return CapArgsTest_self_Results(capnp.Struct{}.DecodeFromPtr(p))
+func (PingPong_echoNum_Results) DecodeFromPtr(p capnp.Ptr) PingPong_echoNum_Results {
+	return PingPong_echoNum_Results(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapArgsTest_self_Results) ToPtr() capnp.Ptr {
+func (s PingPong_echoNum_Results) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CapArgsTest_self_Results) IsValid() bool {
+func (s PingPong_echoNum_Results) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapArgsTest_self_Results) Message() *capnp.Message {
+func (s PingPong_echoNum_Results) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapArgsTest_self_Results) Segment() *capnp.Segment {
+func (s PingPong_echoNum_Results) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s CapArgsTest_self_Results) Self() CapArgsTest {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return CapArgsTest(p.Interface().Client())
-}
-
-func (s CapArgsTest_self_Results) HasSelf() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s PingPong_echoNum_Results) N() int64 {
+	return int64(capnp.Struct(s).Uint64(0))
 }
 
-func (s CapArgsTest_self_Results) SetSelf(v CapArgsTest) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+func (s PingPong_echoNum_Results) SetN(v int64) {
+	capnp.Struct(s).SetUint64(0, uint64(v))
 }
 
-// CapArgsTest_self_Results_List is a list of CapArgsTest_self_Results.
-type CapArgsTest_self_Results_List = capnp.StructList[CapArgsTest_self_Results]
+// PingPong_echoNum_Results_List is a list of PingPong_echoNum_Results.
+type PingPong_echoNum_Results_List = capnp.StructList[PingPong_echoNum_Results]
 
-// NewCapArgsTest_self_Results creates a new list of CapArgsTest_self_Results.
-func NewCapArgsTest_self_Results_List(s *capnp.Segment, sz int32) (CapArgsTest_self_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[CapArgsTest_self_Results](l), err
+// NewPingPong_echoNum_Results creates a new list of PingPong_echoNum_Results.
+func NewPingPong_echoNum_Results_List(s *capnp.Segment, sz int32) (PingPong_echoNum_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[PingPong_echoNum_Results](l), err
 }
 
-// CapArgsTest_self_Results_Future is a wrapper for a CapArgsTest_self_Results promised by a client call.
-type CapArgsTest_self_Results_Future struct{ *capnp.Future }
+// PingPong_echoNum_Results_Future is a wrapper for a PingPong_echoNum_Results promised by a client call.
+type PingPong_echoNum_Results_Future struct{ *capnp.Future }
 
-func (f CapArgsTest_self_Results_Future) Struct() (CapArgsTest_self_Results, error) {
+func (f PingPong_echoNum_Results_Future) Struct() (PingPong_echoNum_Results, error) {
 	p, err := f.Future.Ptr()
-	return CapArgsTest_self_Results(p.Struct()), err
-}
-func (p CapArgsTest_self_Results_Future) Self() CapArgsTest {
-	return CapArgsTest(p.Future.Field(0, nil).Client())
+	return PingPong_echoNum_Results(p.Struct()), err
 }
 
-type PingPongProvider capnp.Client
-
-// PingPongProvider_TypeID is the unique identifier for the type PingPongProvider.
-const PingPongProvider_TypeID = 0x95b6142577e93239
+type StreamTest capnp.Client
 
-func (c PingPongProvider) PingPong(ctx context.Context, params func(PingPongProvider_pingPong_Params) error) (PingPongProvider_pingPong_Results_Future, capnp.ReleaseFunc) {
+// StreamTest_TypeID is the unique identifier for the type StreamTest.
+const StreamTest_TypeID = 0xbb3ca85b01eea465
 
+func (c StreamTest) Push(ctx context.Context, params func(StreamTest_push_Params) error) error {
 	s := capnp.Send{
 		Method: capnp.Method{
-			InterfaceID:   0x95b6142577e93239,
+			InterfaceID:   0xbb3ca85b01eea465,
 			MethodID:      0,
-			InterfaceName: "test.capnp:PingPongProvider",
-			MethodName:    "pingPong",
+			InterfaceName: "test.capnp:StreamTest",
+			MethodName:    "push",
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		s.PlaceArgs = func(s capnp.Struct) error { return params(PingPongProvider_pingPong_Params(s)) }
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(StreamTest_push_Params(s)) }
 	}
 
-	ans, release := capnp.Client(c).SendCall(ctx, s)
-	return PingPongProvider_pingPong_Results_Future{Future: ans.Future()}, release
+	return capnp.Client(c).SendStreamCall(ctx, s)
 
 }
 
-func (c PingPongProvider) WaitStreaming() error {
+func (c StreamTest) WaitStreaming() error {
 	return capnp.Client(c).WaitStreaming()
 }
 
@@ -1520,14 +1884,14 @@ func (c PingPongProvider) WaitStreaming() error {
 // purposes.  Its format should not be depended on: in particular, it
 // should not be used to compare clients.  Use IsSame to compare clients
 // for equality.
-func (c PingPongProvider) String() string {
-	return "PingPongProvider(" + capnp.Client(c).String() + ")"
+func (c StreamTest) String() string {
+	return "StreamTest(" + capnp.Client(c).String() + ")"
 }
 
 // AddRef creates a new Client that refers to the same capability as c.
 // If c is nil or has resolved to null, then AddRef returns nil.
-func (c PingPongProvider) AddRef() PingPongProvider {
-	return PingPongProvider(capnp.Client(c).AddRef())
+func (c StreamTest) AddRef() StreamTest {
+	return StreamTest(capnp.Client(c).AddRef())
 }
 
 // Release releases a capability reference.  If this is the last
@@ -1536,28 +1900,28 @@ func (c PingPongProvider) AddRef() PingPongProvider {
 //
 // Release will panic if c has already been released, but not if c is
 // nil or resolved to null.
-func (c PingPongProvider) Release() {
+func (c StreamTest) Release() {
 	capnp.Client(c).Release()
 }
 
 // Resolve blocks until the capability is fully resolved or the Context
 // expires.
-func (c PingPongProvider) Resolve(ctx context.Context) error {
+func (c StreamTest) Resolve(ctx context.Context) error {
 	return capnp.Client(c).Resolve(ctx)
 }
 
-func (c PingPongProvider) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (c StreamTest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Client(c).EncodeAsPtr(seg)
 }
 
-func (PingPongProvider) DecodeFromPtr(p capnp.Ptr) PingPongProvider {
-	return PingPongProvider(capnp.Client{}.DecodeFromPtr(p))
+func (StreamTest) DecodeFromPtr(p capnp.Ptr) StreamTest {
+	return StreamTest(capnp.Client{}.DecodeFromPtr(p))
 }
 
 // IsValid reports whether c is a valid reference to a capability.
 // A reference is invalid if it is nil, has resolved to null, or has
 // been released.
-func (c PingPongProvider) IsValid() bool {
+func (c StreamTest) IsValid() bool {
 	return capnp.Client(c).IsValid()
 }
 
@@ -1565,7 +1929,7 @@ func (c PingPongProvider) IsValid() bool {
 // same call to NewClient.  This can return false negatives if c or other
 // are not fully resolved: use Resolve if this is an issue.  If either
 // c or other are released, then IsSame panics.
-func (c PingPongProvider) IsSame(other PingPongProvider) bool {
+func (c StreamTest) IsSame(other StreamTest) bool {
 	return capnp.Client(c).IsSame(capnp.Client(other))
 }
 
@@ -1573,286 +1937,233 @@ func (c PingPongProvider) IsSame(other PingPongProvider) bool {
 // this client. This affects all future calls, but not calls already
 // waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
 // which is also the default.
-func (c PingPongProvider) SetFlowLimiter(lim fc.FlowLimiter) {
+func (c StreamTest) SetFlowLimiter(lim fc.FlowLimiter) {
 	capnp.Client(c).SetFlowLimiter(lim)
 }
 
 // Get the current flowcontrol.FlowLimiter used to manage flow control
 // for this client.
-func (c PingPongProvider) GetFlowLimiter() fc.FlowLimiter {
+func (c StreamTest) GetFlowLimiter() fc.FlowLimiter {
 	return capnp.Client(c).GetFlowLimiter()
 }
 
-// A PingPongProvider_Server is a PingPongProvider with a local implementation.
-type PingPongProvider_Server interface {
-	PingPong(context.Context, PingPongProvider_pingPong) error
+// A StreamTest_Server is a StreamTest with a local implementation.
+type StreamTest_Server interface {
+	Push(context.Context, StreamTest_push) error
 }
 
-// PingPongProvider_NewServer creates a new Server from an implementation of PingPongProvider_Server.
-func PingPongProvider_NewServer(s PingPongProvider_Server) *server.Server {
+// StreamTest_NewServer creates a new Server from an implementation of StreamTest_Server.
+func StreamTest_NewServer(s StreamTest_Server) *server.Server {
 	c, _ := s.(server.Shutdowner)
-	return server.New(PingPongProvider_Methods(nil, s), s, c)
+	return server.New(StreamTest_Methods(nil, s), s, c)
 }
 
-// PingPongProvider_ServerToClient creates a new Client from an implementation of PingPongProvider_Server.
+// StreamTest_ServerToClient creates a new Client from an implementation of StreamTest_Server.
 // The caller is responsible for calling Release on the returned Client.
-func PingPongProvider_ServerToClient(s PingPongProvider_Server) PingPongProvider {
-	return PingPongProvider(capnp.NewClient(PingPongProvider_NewServer(s)))
+func StreamTest_ServerToClient(s StreamTest_Server) StreamTest {
+	return StreamTest(capnp.NewClient(StreamTest_NewServer(s)))
 }
 
-// PingPongProvider_Methods appends Methods to a slice that invoke the methods on s.
+// StreamTest_Methods appends Methods to a slice that invoke the methods on s.
 // This can be used to create a more complicated Server.
-func PingPongProvider_Methods(methods []server.Method, s PingPongProvider_Server) []server.Method {
+func StreamTest_Methods(methods []server.Method, s StreamTest_Server) []server.Method {
 	if cap(methods) == 0 {
 		methods = make([]server.Method, 0, 1)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0x95b6142577e93239,
+			InterfaceID:   0xbb3ca85b01eea465,
 			MethodID:      0,
-			InterfaceName: "test.capnp:PingPongProvider",
-			MethodName:    "pingPong",
+			InterfaceName: "test.capnp:StreamTest",
+			MethodName:    "push",
 		},
 		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.PingPong(ctx, PingPongProvider_pingPong{call})
+			return s.Push(ctx, StreamTest_push{call})
 		},
 	})
 
 	return methods
 }
 
-// PingPongProvider_pingPong holds the state for a server call to PingPongProvider.pingPong.
+// StreamTest_push holds the state for a server call to StreamTest.push.
 // See server.Call for documentation.
-type PingPongProvider_pingPong struct {
+type StreamTest_push struct {
 	*server.Call
 }
 
 // Args returns the call's arguments.
-func (c PingPongProvider_pingPong) Args() PingPongProvider_pingPong_Params {
-	return PingPongProvider_pingPong_Params(c.Call.Args())
+func (c StreamTest_push) Args() StreamTest_push_Params {
+	return StreamTest_push_Params(c.Call.Args())
 }
 
 // AllocResults allocates the results struct.
-func (c PingPongProvider_pingPong) AllocResults() (PingPongProvider_pingPong_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return PingPongProvider_pingPong_Results(r), err
+func (c StreamTest_push) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
 }
 
-// PingPongProvider_List is a list of PingPongProvider.
-type PingPongProvider_List = capnp.CapList[PingPongProvider]
+// StreamTest_List is a list of StreamTest.
+type StreamTest_List = capnp.CapList[StreamTest]
 
-// NewPingPongProvider creates a new list of PingPongProvider.
-func NewPingPongProvider_List(s *capnp.Segment, sz int32) (PingPongProvider_List, error) {
+// NewStreamTest_List creates a new list of StreamTest.
+func NewStreamTest_List(s *capnp.Segment, sz int32) (StreamTest_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
-	return capnp.CapList[PingPongProvider](l), err
+	return capnp.CapList[StreamTest](l), err
 }
 
-type PingPongProvider_pingPong_Params capnp.Struct
+type StreamTest_push_Params capnp.Struct
 
-// PingPongProvider_pingPong_Params_TypeID is the unique identifier for the type PingPongProvider_pingPong_Params.
-const PingPongProvider_pingPong_Params_TypeID = 0xd4e835c17f1ef32c
+// StreamTest_push_Params_TypeID is the unique identifier for the type StreamTest_push_Params.
+const StreamTest_push_Params_TypeID = 0xf838dca6c8721bdb
 
-func NewPingPongProvider_pingPong_Params(s *capnp.Segment) (PingPongProvider_pingPong_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return PingPongProvider_pingPong_Params(st), err
+func NewStreamTest_push_Params(s *capnp.Segment) (StreamTest_push_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return StreamTest_push_Params(st), err
 }
 
-func NewRootPingPongProvider_pingPong_Params(s *capnp.Segment) (PingPongProvider_pingPong_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return PingPongProvider_pingPong_Params(st), err
+func NewRootStreamTest_push_Params(s *capnp.Segment) (StreamTest_push_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return StreamTest_push_Params(st), err
 }
 
-func ReadRootPingPongProvider_pingPong_Params(msg *capnp.Message) (PingPongProvider_pingPong_Params, error) {
+func ReadRootStreamTest_push_Params(msg *capnp.Message) (StreamTest_push_Params, error) {
 	root, err := msg.Root()
-	return PingPongProvider_pingPong_Params(root.Struct()), err
+	return StreamTest_push_Params(root.Struct()), err
 }
 
-func (s PingPongProvider_pingPong_Params) String() string {
-	str, _ := text.Marshal(0xd4e835c17f1ef32c, capnp.Struct(s))
+func (s StreamTest_push_Params) String() string {
+	str, _ := text.Marshal(0xf838dca6c8721bdb, capnp.Struct(s))
 	return str
 }
 
-func (s PingPongProvider_pingPong_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s StreamTest_push_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (PingPongProvider_pingPong_Params) DecodeFromPtr(p capnp.Ptr) PingPongProvider_pingPong_Params {
-	return PingPongProvider_pingPong_Params(capnp.Struct{}.DecodeFromPtr(p))
+func (StreamTest_push_Params) DecodeFromPtr(p capnp.Ptr) StreamTest_push_Params {
+	return StreamTest_push_Params(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s PingPongProvider_pingPong_Params) ToPtr() capnp.Ptr {
+func (s StreamTest_push_Params) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s PingPongProvider_pingPong_Params) IsValid() bool {
+func (s StreamTest_push_Params) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s PingPongProvider_pingPong_Params) Message() *capnp.Message {
+func (s StreamTest_push_Params) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s PingPongProvider_pingPong_Params) Segment() *capnp.Segment {
+func (s StreamTest_push_Params) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-
-// PingPongProvider_pingPong_Params_List is a list of PingPongProvider_pingPong_Params.
-type PingPongProvider_pingPong_Params_List = capnp.StructList[PingPongProvider_pingPong_Params]
-
-// NewPingPongProvider_pingPong_Params creates a new list of PingPongProvider_pingPong_Params.
-func NewPingPongProvider_pingPong_Params_List(s *capnp.Segment, sz int32) (PingPongProvider_pingPong_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[PingPongProvider_pingPong_Params](l), err
-}
-
-// PingPongProvider_pingPong_Params_Future is a wrapper for a PingPongProvider_pingPong_Params promised by a client call.
-type PingPongProvider_pingPong_Params_Future struct{ *capnp.Future }
-
-func (f PingPongProvider_pingPong_Params_Future) Struct() (PingPongProvider_pingPong_Params, error) {
-	p, err := f.Future.Ptr()
-	return PingPongProvider_pingPong_Params(p.Struct()), err
-}
-
-type PingPongProvider_pingPong_Results capnp.Struct
-
-// PingPongProvider_pingPong_Results_TypeID is the unique identifier for the type PingPongProvider_pingPong_Results.
-const PingPongProvider_pingPong_Results_TypeID = 0xf269473b6db8d0eb
-
-func NewPingPongProvider_pingPong_Results(s *capnp.Segment) (PingPongProvider_pingPong_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return PingPongProvider_pingPong_Results(st), err
-}
-
-func NewRootPingPongProvider_pingPong_Results(s *capnp.Segment) (PingPongProvider_pingPong_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return PingPongProvider_pingPong_Results(st), err
-}
-
-func ReadRootPingPongProvider_pingPong_Results(msg *capnp.Message) (PingPongProvider_pingPong_Results, error) {
-	root, err := msg.Root()
-	return PingPongProvider_pingPong_Results(root.Struct()), err
-}
-
-func (s PingPongProvider_pingPong_Results) String() string {
-	str, _ := text.Marshal(0xf269473b6db8d0eb, capnp.Struct(s))
-	return str
-}
-
-func (s PingPongProvider_pingPong_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (PingPongProvider_pingPong_Results) DecodeFromPtr(p capnp.Ptr) PingPongProvider_pingPong_Results {
-	return PingPongProvider_pingPong_Results(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s PingPongProvider_pingPong_Results) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s PingPongProvider_pingPong_Results) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s StreamTest_push_Params) Data() ([]byte, error) {
+	return capnp.GetDataField(s, 0, "StreamTest.push$Params.data")
 }
 
-func (s PingPongProvider_pingPong_Results) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s StreamTest_push_Params) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s PingPongProvider_pingPong_Results) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s PingPongProvider_pingPong_Results) PingPong() PingPong {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return PingPong(p.Interface().Client())
+func (s StreamTest_push_Params) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
 }
 
-func (s PingPongProvider_pingPong_Results) HasPingPong() bool {
-	return capnp.Struct(s).HasPtr(0)
+// DataReader returns a reader of data's data, which
+// is read directly from the message.
+func (s StreamTest_push_Params) DataReader() (*bytes.Reader, error) {
+	v, err := s.Data()
+	return bytes.NewReader(v), err
 }
 
-func (s PingPongProvider_pingPong_Results) SetPingPong(v PingPong) error {
-	if !v.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(capnp.Client(v)))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+// SetDataFromReader sets data to the next size bytes of r,
+// which are read directly into the message.
+func (s StreamTest_push_Params) SetDataFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
 }
 
-// PingPongProvider_pingPong_Results_List is a list of PingPongProvider_pingPong_Results.
-type PingPongProvider_pingPong_Results_List = capnp.StructList[PingPongProvider_pingPong_Results]
+// StreamTest_push_Params_List is a list of StreamTest_push_Params.
+type StreamTest_push_Params_List = capnp.StructList[StreamTest_push_Params]
 
-// NewPingPongProvider_pingPong_Results creates a new list of PingPongProvider_pingPong_Results.
-func NewPingPongProvider_pingPong_Results_List(s *capnp.Segment, sz int32) (PingPongProvider_pingPong_Results_List, error) {
+// NewStreamTest_push_Params creates a new list of StreamTest_push_Params.
+func NewStreamTest_push_Params_List(s *capnp.Segment, sz int32) (StreamTest_push_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[PingPongProvider_pingPong_Results](l), err
+	return capnp.StructList[StreamTest_push_Params](l), err
 }
 
-// PingPongProvider_pingPong_Results_Future is a wrapper for a PingPongProvider_pingPong_Results promised by a client call.
-type PingPongProvider_pingPong_Results_Future struct{ *capnp.Future }
+// StreamTest_push_Params_Future is a wrapper for a StreamTest_push_Params promised by a client call.
+type StreamTest_push_Params_Future struct{ *capnp.Future }
 
-func (f PingPongProvider_pingPong_Results_Future) Struct() (PingPongProvider_pingPong_Results, error) {
+func (f StreamTest_push_Params_Future) Struct() (StreamTest_push_Params, error) {
 	p, err := f.Future.Ptr()
-	return PingPongProvider_pingPong_Results(p.Struct()), err
-}
-func (p PingPongProvider_pingPong_Results_Future) PingPong() PingPong {
-	return PingPong(p.Future.Field(0, nil).Client())
+	return StreamTest_push_Params(p.Struct()), err
 }
 
-const schema_ef12a34b9807e19c = "x\xda|T]h#U\x14>g\xe6^'E\xc7" +
-	"x3E\xcd\xbau\xed\xb2\xf1gY\x83\xe9RX\xeb" +
-	"O\xbb\x8a\x06+\x94\x99\xaa\x0f*\xe2\x8e\xcdl\x9a5" +
-	"I\x87\xcc\xc4\xa2\xa2\xf5eY\xf4mY\xdc\xd5E\x14" +
-	"W\x11}\xac\xa0VKA\x8bJ\xd5V\x14\x8a>\xb4" +
-	"E-\x08U\xa1V}\xa9\x88\x8e\xdc;\xb9\xc9$i" +
-	"\xf36\xcc\xfd\xeew\xbe\xef\x9c\xef\x9e\x9bnP\x86H" +
-	"F\x7f-\x06\x8a\x95\xa3\x17\x05\x17\xc6O\x15F\x9e\x8d" +
-	"=\x07\xec\x0a\x04\xa0\xa8\x01\x1c\xee'{\x10\xd0\xb8\x8d" +
-	"\x0c\x02\xfewmj\xe5\xec\xbfk'\xadnD\x00\xc2" +
-	"\x8f\x1f!\xfb\xf9\xb1\xc3\x8f\x83\xa5c\xc7\xf4\xcf\xf7^" +
-	"\x7f\x06X\xb2~\xff$\x19\xe5\x80\xd3\x02ps\xdf/" +
-	"\x93\xa9\xee\xf7_\x04v\xb1\x1a\xbc\xf2\x93\xf6\xd2\xbdo" +
-	"$~\x07@c\x9a\xac\x1bs\x9c\xd0\xf8\x90d\x8d\x9f" +
-	"\xf9W\xa0_\xb35\xaf\x7f\xf6\xcf\xd9P\x8d\xa8\xb6\xc4" +
-	"\xab\x91\xa0\x8f\x05_\xd1\xc5\xbb\xcfEu\xbe\x17\x0a\x99" +
-	"\x13u\x9e\x9f\x9f\x99\xbc1v\xdd\xf9P\x88\xb8\xbaF" +
-	"\x86\xf9\xd5\xc5\xcbN\x9c\x9e\xfa\xfa\xf1\x996\x05_\x90" +
-	"\x8f\x8co\x85\x82%r\xca\xe8\xa1\\\x81\xf3\xe6&>" +
-	"\xfc\xf6\xad\xb3m`J\xdf5t\x0e1\xbah\xd6\xc8" +
-	"\x08\xf0\xd1\x0f\x0e=}fdz\xa1\x0d\x9c\xa4/\x18" +
-	"\xbd\x02\xdcC\xb5\x1a\xf3\xa1\xbf\xae\x9e\xfa\xa4\x7fc\x19" +
-	"\xd8^)\x90\xd2\xc7\xb8\xc0\xde\xae\x1f^}\xe7\xc7s" +
-	"\xdfC\xa4\xc9\x7f\x843\xf8[x{fv\xe0\xf8C" +
-	"\xb7?\xb0\x1eiK\x92\xee\xe1W/\xddx}\xf5\xbb" +
-	"\xe5#\xbf\xb6)@\xfaeDn\xbfP\xf0\xd4\xe6\xf6" +
-	"\xbc\xff)\xd9j\x03\xf7\xd0\x0bFJ\x80{i\xd6\xb8" +
-	"G\x80\x7f\xfbf\xa6tK\xb6\xf0g(7lx\x86" +
-	"\x9e\x10\xc1\xa0\\\xd4\xcaU\x95\x85\xb7V\x8fl\x03\xbb" +
-	"\xbc\x0e\xb0i\x82\x03\x0at\x10\x1e\x0c|\xc7\xf3\xd3c" +
-	"\xb6\xab\x96\xdd\x81;m\xf7h%\xef\xdd\x1f\xfe*\x16" +
-	"\x0f\x98v\xc5VK\x9eET\x02@\x10\x80\xe9\xfb\x01" +
-	"\xac\x98\x8aV\xb7\x82\xda\x98\xedb\x82\xa8\x80\x98\x00l" +
-	"b2\x0b\xe5\xbc9Q\xce\xa7\x9d\xb1\xf1\x89\x91j\xe9" +
-	"\xc0\xa8\xe3U\xb5\xa2\xdfD\x95hPa\x19)(H" +
-	"#4\xa4\xec\x0e\xdcUr\xfd'\xcd\xca\xc4\x13\x85\x9c" +
-	"SI\xe7\x1d_\xfc\x10dE\x1f\x9b\xc8\xfa\x1ad\xfb" +
-	"\x1c\x8eB\xd6\x98< \xb2\x08\xb5\x12Q(\xd8\xb5\x9c" +
-	"S1\x11-\xa2\xd2H\x06Pv\x97\xb1aPX\x97" +
-	"\x16\xb8\xb5K\x000\x84&b\xe7\xeeI\xcf\xbb\xa2<" +
-	"\xa7x|\xc7\xce\x1cl\x98\x89s\x10\xb2\xc6\xfbh\xf1" +
-	"\xd2\xa1M|z%\x0f\xa0\x8eEY\x7f0\x14\xc0-" +
-	"\xc7\x84e\xb9`P\xbem\x969\x08\x0aKi\xd8\xc8" +
-	"5\xca\xd7\xcd\x92\xfcL\xd7\xe2\xdc\xe5P\xa8\xb0\xb9\x1d" +
-	"\xbc\xd0}~\xc5\xb1\xf7\x95d\x9d\xb0\xb52\x8eX\x9e" +
-	"\xfex\xf2\xf0\xf9G_f\x8csQ-\xeeV\xbd\xf1" +
-	"v\x12a\x04\xc0D4U\x1a=$\xad#\xe4\xce\xe5" +
-	"x\xb8s\xcd.y\x9d#\xb9C\xb8;'r\xc7\xf1" +
-	"\xd5X\x9a\xb2\xd54\x0f\xa1\xbef_\xae?\x94\x0b\xb9" +
-	"\x9e,9\xb4\xb6d\xa1\x94\xaeq\xa7u&\xb9\x8d\x10" +
-	"j\xab\x9f\xb1;D#\xa7j\xf6\x9aY:\xb7k\xd4" +
-	"\xf1\xe2\xd5\x96\x08\x0e\x03X\x97\xa8h]\xa9`4\xf6" +
-	"\xc8\x1a\x0b\xaa%\x89\xaa\x1c\xba\x98y\x9a\x0f4\x8c " +
-	"\xee\x9a\xed\x9c\xed\xdb\xa8\x83\x82:\xe0\xff\x01\x00\x00\xff" +
-	"\xff\xe4@\xf6j"
+const schema_ef12a34b9807e19c = "x\xda\x8cU[l\x14U\x18\xfe\xff9\xb3\xceit" +
+	"\xa8\xa7SlE\xe2Z\xd2\x8a6\xd0\xc06$\xb4^" +
+	"j\xf1\xb2IM\x9b\x9d\xd5>\xa012v\x0f\xdb\x85" +
+	"\xbd\xb13k\xa3\xa6\x96\x17C\xea\x83\x97\x10\x8a\x121" +
+	"\xb1\x0a^\xc2\x0b>h#/\xda\xa8!\x0a\xe2\x03\xc1" +
+	"\x180\x0aOUc%\xfaB4v\xcc9\xb3\xd3\x99" +
+	"\x9d-\xe8\xc3\x9e\x87\xfd\xbf\xf9\xce\xf7\x7f\xff\xe5lj" +
+	"#\xf7\xa9\x9b\xf53\xab@1\x8f\xc5\xaesg\xc7\xf7" +
+	"\xe5F\x9e\xa7{\x81\xb5!@\x0c5\x80\xdeam\x0d" +
+	"\x02\x1a\xa3\xda\x00\xe0\xd2\xed]\xe7g\xfe\xf9\xe1\x05\xb3" +
+	"\x15\x11@\x15\xe1\xaa\xb6N\x84'E\xd8\xcdL\xcf\x1c" +
+	"{\xa2\xf0\xca4\x98\xed\xcb\x80\xc3Z\xb7\x00\x1c\x91\x80" +
+	"\xd3;v\xe8_\xae\xbdc?\xb0\x9b\x97/\xb8\xa8\xa5" +
+	"\x05\xe07\x09\xe8K\xfc<\xd1\xd5\xfa\xd1\x01`\xd7\x13" +
+	"\xf7\x8d\x8b\xdak\x0f\xbf\xdd\xf2;\x00\x1a:\xbd\x04h" +
+	"0\x9a4\xfa\xa8\x06\xe0\xea\xb7]\x9e\xd7\xbf\xf8{\xc6" +
+	"\x93*o\xea\xa0R\xca\x9dT\x03t\x13\xcc\xfd:v" +
+	"\xea\xa1\x83\xe1Tt\x0f\xb0\x9a\x8a\x9b\xa6\xe7\xe7&6" +
+	"\xd2\xf5\x87<)\x92`\x0b\x1d\x12\xf1AI\xb0\xf4\xee" +
+	"\xcbm\x7f\x9d9w\x14X\xbb\x1f\xef\xa2\x09\x11\xdf(" +
+	"\xe3\xa7n\xdc\xf5\xea\xd47\xbb\xe7\x1a\x942\xfa\x89<" +
+	"\xf7\x19\xdb\xa5R\xfe\xce\">\xfe\xde\xdd'\x1a\x80\x83" +
+	"\xf4Cy&\x0d.\x81\x83\x1foxn\xff\xc8\xf1\x93" +
+	"\x0d\xc0a\xfa\xa2<5\xf1\x03p7\xfcy\xeb\xd4g" +
+	"[\x16\xce\x02[\x1bH\x7f*\x90\xde\xd1\xf4\xe3\x9b\xef" +
+	"\xfft\xf0;\x08\xd5\xa9\x8b\xae\xf1\xb4\x8b\xdc'O\xf4" +
+	"\xef|\xec\xde\xd1K!\xf3\x86\xbd\xf8\xa8$X\xb5\xf0" +
+	"\xd6\x85sg\xb7\xfe\xd2\xa0\xa4\x8f~\x05h\xdc\xb3," +
+	"\xf9\x03c}\xc7\xec\x82\xb5\xb8\x82\xe4yy&\x8d=" +
+	"\x12\xf8\xec\xe2\x95y\xe7s\xf5r\x03p;\x9d\x95g" +
+	"\xd2\x98\x94\xc0_\xbf\x9d+\xdc\x95\xcc\xfd\xe1\xe5\xe6\xd5" +
+	"\x8d\xd3]B\xdb\x1e\xa9\xfd\xfc-\x95\x93G/l\xbd" +
+	"\x02\xec\xa6e\xc0\x01\xda\"\x00\x87\xe9\x00,\xb9\x0e\xb7" +
+	"\x9d\x9e1\xabL\x8a\xe5\xfe\xfb\xad\xf2`%k?\xea" +
+	"\xfd\x95\xcfw\xa6\xac\x8aE\x0a\xb6\xa9\x12\x15@E\x00" +
+	"\xa6\xaf\x030)A\xb3UAm\xcc*c\x8bJ\x00" +
+	"\xb1\x05\xb0\x8e)\x95+fS\xa5b\xb6\x87\x8f\x8d\x97" +
+	"F\xaa\x85\xce4\xb7\xabZ\xde\xa9\xa3j\x09\xa8\xb0\x88" +
+	"1P0\x16\xa1y\x80[\x99|\xae\xc8=E\xe3|" +
+	"lwg\xcaj\xaeX\xf5\x92\xb61=nv\x124" +
+	"7)8\xe5\xe4\x0a\xbcTuL\x15\x157\xdd\xdbw" +
+	"\xe4\xfb\xd3/\xed\x05SU\x10\x086\x81\x82M\xa1+" +
+	"\xd4b\xb9\xff\xc1B\xd9y&U)=\x9d\xcb\xf0J" +
+	"O\x96;\xf2\x0f\xa97\xef`\xdd=\x89@o\x9c\x0b" +
+	"\x14\xb2\xa0\x0b\x01\x91\x85\xa8\x95\x90\x09\x92]\xcb\xf0\x8a" +
+	"\xa9\x92X\xa8!\xd1\xaf\x1ecC@\xdcr\x0d\x0e\x00" +
+	")\xc4k\x17\xc6\xb73E\xd4\xab\x02m\x9e\xdf\xb9\xa2" +
+	"\xef\xddA\x1e\xcd\x02\x84,\x18\xcfH\x1a\xd7pH\xf4" +
+	"F\xc1\x06\x88JX\xa1fi\x1e\xb7\xab\x11\xb5\xe8\xab" +
+	"\x1d\xf0\xe4\x9aTz\xe3\xefT\xf47\x16\xdb\xdc\x0d\x04" +
+	"\x83!D\x7fU\xb1\xd5\xdd@\x9a\x85\x1b2\x89\xb0e" +
+	"\x82\xfb\x11\xa7\xc2\xadxAR{\xb6\xfb\xa3\x80\xc5\xe3" +
+	"\x9fN\xf4\x1ez\xf2u\xc6\x04C\xb9j\x8fG?\x96" +
+	"\x19\x8a\xd4b)\xac7\xa3\xae\xa6\xc2\x0f\xbfj\xc2\x0f" +
+	"\xcd*4T\xa4a\x12V\x98\xa9\xff\x1e\x84\x86\xba\xd6" +
+	"X\xc2\xb7)\xd1Z\x01\xd42\xf7\x977\xfa\x0f\x8a\xd7" +
+	"p~)#\x0d\xa7D\x8a\xe8\xb3\xf8\xcf\x15\xfa\xbb\x9e" +
+	"\xb1\x04\x90\xb8,q\xd4@\x99\xb5V*fk\xdf\xfa" +
+	";\x16\xa1\xf6&2\xb6\x0d\xc8T\xcd\x93\xffor\x9a" +
+	"\xdb\xcd\xd5H;\x0f\x01\x987\x104\xdb\x15\x0c\xcf\x10" +
+	"\xb2`\x8dF\xba\x9a\xf8\xfd!\xdb\xa3Gt\x80\xd7\xce" +
+	"x\xd59\xc9X\x8e\x85:(\xa8\x03\xfe;\x00\xbd\xbd" +
+	"Nk"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -1860,11 +2171,13 @@ func RegisterSchema(reg *schemas.Registry) {
 		Nodes: []uint64{
 			0x80087e4e698768a2,
 			0x85ddfd96db252600,
+			0x89906d5dac968964,
 			0x93281cc60d6060cd,
 			0x95b6142577e93239,
 			0x96fbc50dc2f0200d,
 			0x9746cc05cbff1132,
 			0x9a27082d77b8c289,
+			0xa6d6cffa178fa7fe,
 			0xb86bce7f916a10cc,
 			0xbb3ca85b01eea465,
 			0xc8b14e937b2cb741,
@@ -1872,6 +2185,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xd797e0a99edf0921,
 			0xe2553e5a663abb7d,
 			0xea38d4d6dca1e80e,
+			0xee61e8a2212713aa,
 			0xf004c474c2f8ee7a,
 			0xf269473b6db8d0eb,
 			0xf838dca6c8721bdb,
@@ -1879,3 +2193,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_ef12a34b9807e19c,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -1,3 +0,0 @@
-package testnetwork
-
-//go:generate capnp compile -I ../../../std -ogo testnetwork.capnp
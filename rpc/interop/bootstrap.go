@@ -0,0 +1,116 @@
+package interop
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+// InterfaceID is the ID of the interface implemented by the capability
+// returned by NewBootstrap.  It corresponds to this schema:
+//
+//	interface Bootstrap {
+//	  echo @0 (value :AnyPointer) -> (value :AnyPointer);
+//	  promise @1 () -> (cap :Capability);
+//	  resolve @2 (cap :Capability) -> ();
+//	}
+const InterfaceID = 0xd9a4f1c3b2e5a607
+
+// Methods of the bootstrap interface.
+const (
+	// MethodEcho returns its parameters as its results.
+	MethodEcho uint16 = 0
+
+	// MethodPromise returns a promise that stays unresolved until
+	// MethodResolve is called.  It returns the same promise every
+	// time.
+	MethodPromise uint16 = 1
+
+	// MethodResolve resolves the promise returned by MethodPromise to
+	// the capability in its parameters.
+	MethodResolve uint16 = 2
+)
+
+// NewBootstrap returns a new capability to use as the bootstrap
+// capability of a Conn that vectors are played against.  Each Conn
+// needs its own.
+func NewBootstrap() capnp.Client {
+	b := new(bootstrap)
+	b.promise, b.resolver = capnp.NewLocalPromise[capnp.Client]()
+	methods := []server.Method{
+		{
+			Method: capnp.Method{InterfaceID: InterfaceID, MethodID: MethodEcho, MethodName: "echo"},
+			Impl:   b.echo,
+		},
+		{
+			Method: capnp.Method{InterfaceID: InterfaceID, MethodID: MethodPromise, MethodName: "promise"},
+			Impl:   b.getPromise,
+		},
+		{
+			Method: capnp.Method{InterfaceID: InterfaceID, MethodID: MethodResolve, MethodName: "resolve"},
+			Impl:   b.resolve,
+		},
+	}
+	return capnp.NewClient(server.New(methods, nil, b))
+}
+
+type bootstrap struct {
+	promise  capnp.Client
+	resolver capnp.Resolver[capnp.Client]
+
+	mu       sync.Mutex
+	resolved bool
+}
+
+// settle reports whether the promise was still unresolved, marking it
+// resolved if so.
+func (b *bootstrap) settle() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ok := !b.resolved
+	b.resolved = true
+	return ok
+}
+
+func (b *bootstrap) echo(ctx context.Context, call *server.Call) error {
+	res, err := call.AllocResults(call.Args().Size())
+	if err != nil {
+		return err
+	}
+	return res.CopyFrom(call.Args())
+}
+
+func (b *bootstrap) getPromise(ctx context.Context, call *server.Call) error {
+	res, err := call.AllocResults(capnp.ObjectSize{PointerCount: 1})
+	if err != nil {
+		return err
+	}
+	id := res.Message().CapTable().Add(b.promise.AddRef())
+	return res.SetPtr(0, capnp.NewInterface(res.Segment(), id).ToPtr())
+}
+
+func (b *bootstrap) resolve(ctx context.Context, call *server.Call) error {
+	p, err := call.Args().Ptr(0)
+	if err != nil {
+		return err
+	}
+	c := p.Interface().Client()
+	if !c.IsValid() {
+		return errors.New("resolve: no capability in parameters")
+	}
+	if !b.settle() {
+		return errors.New("resolve: promise already resolved")
+	}
+	b.resolver.Fulfill(c.AddRef())
+	return nil
+}
+
+func (b *bootstrap) Shutdown() {
+	if b.settle() {
+		b.resolver.Reject(errors.New("bootstrap capability shut down"))
+	}
+	b.promise.Release()
+}
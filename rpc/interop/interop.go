@@ -0,0 +1,206 @@
+// Package interop provides golden RPC exchanges that can be played
+// against a Conn to check that it speaks the protocol as expected.
+//
+// Each Vector is a sequence of wire messages: those the peer sends to
+// the Conn under test and those the Conn is expected to send back.  Run
+// acts as the peer, so the vectors can be used both to guard the rpc
+// package against regressions and by authors of alternative transports
+// to check that a Conn behaves the same over their transport as over
+// the ones in this module:
+//
+//	left, right := myTransportPair()
+//	conn := rpc.NewConn(left, &rpc.Options{
+//		BootstrapClient: interop.NewBootstrap(),
+//	})
+//	defer conn.Close()
+//	err := interop.Run(ctx, right, interop.Vectors[0])
+//
+// Messages are compared in canonical form, so they match regardless of
+// how the Conn lays them out in its arena.
+package interop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/text"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/schemas"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// A Vector is a recorded exchange between a Conn and its peer.  The
+// Conn must have been created with a capability returned by
+// NewBootstrap as its bootstrap capability, and must not have been
+// used for anything else.
+type Vector struct {
+	Name  string
+	Steps []Step
+}
+
+// A Step is a single message in a Vector.
+type Step struct {
+	// Direction is relative to the Conn under test, as in recordings
+	// made by transport.NewRecorder: Incoming messages are sent to the
+	// Conn by the peer and Outgoing messages are expected from it.
+	Direction transport.Direction
+
+	// Message is the canonical encoding of an rpc.capnp Message, as
+	// returned by capnp.Canonicalize.
+	Message []byte
+}
+
+// Decode returns the step's message.
+func (s Step) Decode() (rpccp.Message, error) {
+	msg := &capnp.Message{Arena: capnp.SingleSegment(s.Message)}
+	return rpccp.ReadRootMessage(msg)
+}
+
+// Run plays v against the Conn at the other end of t.  It sends each
+// Incoming step on t and checks that the messages the Conn sends match
+// the Outgoing steps.  Consecutive Outgoing steps may be matched in any
+// order, since a Conn may send replies to concurrent events in either
+// order.  Messages that the Conn sends after the last step are not
+// read.
+//
+// If ctx is canceled while Run is waiting for a message, Run closes t
+// to interrupt the wait.
+func Run(ctx context.Context, t transport.Transport, v Vector) error {
+	var (
+		mu       sync.Mutex
+		finished bool
+		done     = make(chan struct{})
+	)
+	defer func() {
+		mu.Lock()
+		finished = true
+		mu.Unlock()
+		close(done)
+	}()
+	go func() {
+		select {
+		case <-ctx.Done():
+			mu.Lock()
+			if !finished {
+				t.Close()
+			}
+			mu.Unlock()
+		case <-done:
+		}
+	}()
+
+	for i := 0; i < len(v.Steps); {
+		if v.Steps[i].Direction == transport.Incoming {
+			if err := send(t, v.Steps[i].Message); err != nil {
+				return runError(ctx, v, i, err)
+			}
+			i++
+			continue
+		}
+		j := i
+		for j < len(v.Steps) && v.Steps[j].Direction == transport.Outgoing {
+			j++
+		}
+		if k, err := expect(t, v.Steps[i:j]); err != nil {
+			return runError(ctx, v, i+k, err)
+		}
+		i = j
+	}
+	return nil
+}
+
+func runError(ctx context.Context, v Vector, step int, err error) error {
+	if ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	return fmt.Errorf("interop: %s: step %d: %w", v.Name, step+1, err)
+}
+
+// send sends the message whose canonical encoding is b on t.
+func send(t transport.Transport, b []byte) error {
+	src, err := Step{Message: b}.Decode()
+	if err != nil {
+		return err
+	}
+	out, err := t.NewMessage()
+	if err != nil {
+		return err
+	}
+	defer out.Release()
+	if err := capnp.Struct(out.Message()).CopyFrom(capnp.Struct(src)); err != nil {
+		return err
+	}
+	return out.Send()
+}
+
+// expect receives len(steps) messages from t and checks that each one
+// matches a different step.  On failure, it returns the index of the
+// message that did not match.
+func expect(t transport.Transport, steps []Step) (int, error) {
+	matched := make([]bool, len(steps))
+	for k := range steps {
+		in, err := t.RecvMessage()
+		if err != nil {
+			return k, err
+		}
+		got, err := capnp.Canonicalize(capnp.Struct(in.Message()))
+		if err != nil {
+			in.Release()
+			return k, err
+		}
+		found := false
+		for i, s := range steps {
+			if !matched[i] && string(s.Message) == string(got) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			err = mismatch(in.Message(), steps, matched)
+		}
+		in.Release()
+		if err != nil {
+			return k, err
+		}
+	}
+	return 0, nil
+}
+
+func mismatch(got rpccp.Message, steps []Step, matched []bool) error {
+	msg := "received " + format(got) + "; want "
+	sep := ""
+	for i, s := range steps {
+		if matched[i] {
+			continue
+		}
+		m, err := s.Decode()
+		if err != nil {
+			return err
+		}
+		msg += sep + format(m)
+		sep = " or "
+	}
+	return errors.New(msg)
+}
+
+// registry holds the rpc.capnp schema, so that mismatched messages can
+// be reported in text form without depending on the default registry.
+var registry = func() *schemas.Registry {
+	reg := new(schemas.Registry)
+	rpccp.RegisterSchema(reg)
+	return reg
+}()
+
+func format(m rpccp.Message) string {
+	var sb strings.Builder
+	enc := text.NewEncoder(&sb)
+	enc.UseRegistry(registry)
+	if err := enc.Encode(rpccp.Message_TypeID, capnp.Struct(m)); err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return sb.String()
+}
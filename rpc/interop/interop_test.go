@@ -0,0 +1,72 @@
+package interop_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/interop"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+func TestVectors(t *testing.T) {
+	t.Parallel()
+
+	transports := map[string]func() (rpc.Transport, rpc.Transport){
+		"pipe": func() (rpc.Transport, rpc.Transport) {
+			left, right := transport.NewPipe(1)
+			return rpc.NewTransport(left), rpc.NewTransport(right)
+		},
+		"stream": func() (rpc.Transport, rpc.Transport) {
+			left, right := net.Pipe()
+			return rpc.NewStreamTransport(left), rpc.NewStreamTransport(right)
+		},
+	}
+	for tname, newPair := range transports {
+		for _, v := range interop.Vectors {
+			tname, newPair, v := tname, newPair, v
+			t.Run(tname+"/"+v.Name, func(t *testing.T) {
+				t.Parallel()
+
+				left, right := newPair()
+				conn := rpc.NewConn(left, &rpc.Options{
+					BootstrapClient: interop.NewBootstrap(),
+				})
+				defer conn.Close()
+				defer right.Close()
+
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				assert.NoError(t, interop.Run(ctx, right, v))
+			})
+		}
+	}
+}
+
+func TestRunMismatch(t *testing.T) {
+	t.Parallel()
+
+	left, right := transport.NewPipe(1)
+	conn := rpc.NewConn(rpc.NewTransport(left), &rpc.Options{
+		BootstrapClient: interop.NewBootstrap(),
+	})
+	defer conn.Close()
+	p2 := rpc.NewTransport(right)
+	defer p2.Close()
+
+	// Expect the bootstrap return before sending the bootstrap message.
+	v := interop.Vectors[0]
+	steps := append([]interop.Step(nil), v.Steps...)
+	steps[0], steps[1] = steps[1], steps[0]
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := interop.Run(ctx, p2, interop.Vector{Name: "swapped", Steps: steps})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "swapped: step 1")
+}
@@ -0,0 +1,319 @@
+package interop
+
+import (
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// Vectors holds the exchanges that a Conn is checked against.  Each
+// starts from a fresh Conn.
+var Vectors = []Vector{
+	{
+		Name: "bootstrap",
+		Steps: []Step{
+			in(bootstrapMsg(0)),
+			out(returnMsg(0, bootstrapPayload)),
+			in(finishMsg(0, false)),
+		},
+	},
+	{
+		Name: "call",
+		Steps: []Step{
+			in(bootstrapMsg(0)),
+			out(returnMsg(0, bootstrapPayload)),
+			in(finishMsg(0, false)),
+			in(callMsg(1, importedCap(0), MethodEcho, textPayload("hello"))),
+			out(returnMsg(1, textPayload("hello"))),
+			in(finishMsg(1, true)),
+		},
+	},
+	{
+		// The call is made on the bootstrap answer before it returns,
+		// so both returns are expected in either order.
+		Name: "pipelined call",
+		Steps: []Step{
+			in(bootstrapMsg(0)),
+			in(callMsg(1, promisedAnswer(0), MethodEcho, textPayload("hello"))),
+			out(returnMsg(0, bootstrapPayload)),
+			out(returnMsg(1, textPayload("hello"))),
+			in(finishMsg(0, false)),
+			in(finishMsg(1, true)),
+		},
+	},
+	{
+		Name: "unimplemented method",
+		Steps: []Step{
+			in(bootstrapMsg(0)),
+			out(returnMsg(0, bootstrapPayload)),
+			in(finishMsg(0, false)),
+			in(callMsg(1, importedCap(0), 7, emptyPayload)),
+			out(exceptionMsg(1, rpccp.Exception_Type_unimplemented, "unimplemented")),
+			in(finishMsg(1, true)),
+		},
+	},
+	{
+		// The Conn exports a promise, resolves it to a capability
+		// that the peer hosts, and answers the peer's disembargo.
+		Name: "resolve and disembargo",
+		Steps: []Step{
+			in(bootstrapMsg(0)),
+			out(returnMsg(0, bootstrapPayload)),
+			in(finishMsg(0, false)),
+			in(callMsg(1, importedCap(0), MethodPromise, emptyPayload)),
+			out(returnMsg(1, capPayload(senderPromise(1)))),
+			in(finishMsg(1, false)),
+			in(callMsg(2, importedCap(0), MethodResolve, capPayload(senderHosted(5)))),
+			// The method does not allocate results, so the Conn
+			// sends a null payload.
+			out(returnMsg(2, nil)),
+			out(resolveMsg(1, receiverHosted(5))),
+			in(finishMsg(2, true)),
+			in(disembargoMsg(importedCap(1), 0)),
+			out(disembargoLoopbackMsg(importedCap(5), 0)),
+		},
+	},
+}
+
+func in(f func(rpccp.Message) error) Step {
+	return Step{Direction: transport.Incoming, Message: message(f)}
+}
+
+func out(f func(rpccp.Message) error) Step {
+	return Step{Direction: transport.Outgoing, Message: message(f)}
+}
+
+// message returns the canonical encoding of the message built by f.
+func message(f func(rpccp.Message) error) []byte {
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	m, err := rpccp.NewRootMessage(seg)
+	if err == nil {
+		err = f(m)
+	}
+	var b []byte
+	if err == nil {
+		b, err = capnp.Canonicalize(capnp.Struct(m))
+	}
+	if err != nil {
+		panic("interop: building vector: " + err.Error())
+	}
+	return b
+}
+
+func bootstrapMsg(qid uint32) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		b, err := m.NewBootstrap()
+		if err != nil {
+			return err
+		}
+		b.SetQuestionId(qid)
+		return nil
+	}
+}
+
+func finishMsg(qid uint32, releaseResultCaps bool) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		f, err := m.NewFinish()
+		if err != nil {
+			return err
+		}
+		f.SetQuestionId(qid)
+		f.SetReleaseResultCaps(releaseResultCaps)
+		return nil
+	}
+}
+
+func callMsg(qid uint32, target func(rpccp.MessageTarget) error, method uint16, params func(rpccp.Payload) error) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		c, err := m.NewCall()
+		if err != nil {
+			return err
+		}
+		c.SetQuestionId(qid)
+		c.SetInterfaceId(InterfaceID)
+		c.SetMethodId(method)
+		t, err := c.NewTarget()
+		if err != nil {
+			return err
+		}
+		if err := target(t); err != nil {
+			return err
+		}
+		p, err := c.NewParams()
+		if err != nil {
+			return err
+		}
+		return params(p)
+	}
+}
+
+// returnMsg returns a Return message with the given results, or with
+// a null payload if results is nil.
+func returnMsg(aid uint32, results func(rpccp.Payload) error) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		r, err := m.NewReturn()
+		if err != nil {
+			return err
+		}
+		r.SetAnswerId(aid)
+		r.SetReleaseParamCaps(false)
+		if results == nil {
+			return nil
+		}
+		p, err := r.NewResults()
+		if err != nil {
+			return err
+		}
+		return results(p)
+	}
+}
+
+func exceptionMsg(aid uint32, typ rpccp.Exception_Type, reason string) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		r, err := m.NewReturn()
+		if err != nil {
+			return err
+		}
+		r.SetAnswerId(aid)
+		r.SetReleaseParamCaps(false)
+		e, err := r.NewException()
+		if err != nil {
+			return err
+		}
+		e.SetType(typ)
+		return e.SetReason(reason)
+	}
+}
+
+func resolveMsg(promiseID uint32, cap func(rpccp.CapDescriptor)) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		r, err := m.NewResolve()
+		if err != nil {
+			return err
+		}
+		r.SetPromiseId(promiseID)
+		d, err := r.NewCap()
+		if err != nil {
+			return err
+		}
+		cap(d)
+		return nil
+	}
+}
+
+func disembargoMsg(target func(rpccp.MessageTarget) error, id uint32) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		d, err := m.NewDisembargo()
+		if err != nil {
+			return err
+		}
+		d.Context().SetSenderLoopback(id)
+		t, err := d.NewTarget()
+		if err != nil {
+			return err
+		}
+		return target(t)
+	}
+}
+
+func disembargoLoopbackMsg(target func(rpccp.MessageTarget) error, id uint32) func(rpccp.Message) error {
+	return func(m rpccp.Message) error {
+		d, err := m.NewDisembargo()
+		if err != nil {
+			return err
+		}
+		d.Context().SetReceiverLoopback(id)
+		t, err := d.NewTarget()
+		if err != nil {
+			return err
+		}
+		return target(t)
+	}
+}
+
+func importedCap(id uint32) func(rpccp.MessageTarget) error {
+	return func(t rpccp.MessageTarget) error {
+		t.SetImportedCap(id)
+		return nil
+	}
+}
+
+func promisedAnswer(qid uint32) func(rpccp.MessageTarget) error {
+	return func(t rpccp.MessageTarget) error {
+		pa, err := t.NewPromisedAnswer()
+		if err != nil {
+			return err
+		}
+		pa.SetQuestionId(qid)
+		return nil
+	}
+}
+
+// emptyPayload leaves a payload's content null.
+func emptyPayload(p rpccp.Payload) error {
+	return nil
+}
+
+// textPayload returns a payload whose content is a struct holding s in
+// its first pointer.
+func textPayload(s string) func(rpccp.Payload) error {
+	return func(p rpccp.Payload) error {
+		st, err := capnp.NewStruct(p.Segment(), capnp.ObjectSize{PointerCount: 1})
+		if err != nil {
+			return err
+		}
+		if err := st.SetNewText(0, s); err != nil {
+			return err
+		}
+		return p.SetContent(st.ToPtr())
+	}
+}
+
+// bootstrapPayload is the payload of a bootstrap return: its content is
+// the bootstrap capability itself.
+func bootstrapPayload(p rpccp.Payload) error {
+	if err := p.SetContent(capnp.NewInterface(p.Segment(), 0).ToPtr()); err != nil {
+		return err
+	}
+	caps, err := p.NewCapTable(1)
+	if err != nil {
+		return err
+	}
+	caps.At(0).SetSenderHosted(0)
+	return nil
+}
+
+// capPayload returns a payload whose content is a struct holding a
+// capability in its first pointer, described by cap.
+func capPayload(cap func(rpccp.CapDescriptor)) func(rpccp.Payload) error {
+	return func(p rpccp.Payload) error {
+		st, err := capnp.NewStruct(p.Segment(), capnp.ObjectSize{PointerCount: 1})
+		if err != nil {
+			return err
+		}
+		if err := st.SetPtr(0, capnp.NewInterface(p.Segment(), 0).ToPtr()); err != nil {
+			return err
+		}
+		if err := p.SetContent(st.ToPtr()); err != nil {
+			return err
+		}
+		caps, err := p.NewCapTable(1)
+		if err != nil {
+			return err
+		}
+		cap(caps.At(0))
+		return nil
+	}
+}
+
+func senderHosted(id uint32) func(rpccp.CapDescriptor) {
+	return func(d rpccp.CapDescriptor) { d.SetSenderHosted(id) }
+}
+
+func senderPromise(id uint32) func(rpccp.CapDescriptor) {
+	return func(d rpccp.CapDescriptor) { d.SetSenderPromise(id) }
+}
+
+func receiverHosted(id uint32) func(rpccp.CapDescriptor) {
+	return func(d rpccp.CapDescriptor) { d.SetReceiverHosted(id) }
+}
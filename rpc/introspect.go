@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/std/introspect"
+)
+
+// interfaceNodes indexes schemas.DefaultRegistry for
+// ImplementsInterface.
+var interfaceNodes struct {
+	sync.Mutex
+	m nodemap.Map
+}
+
+// ImplementsInterface implements capnp.InterfaceChecker for remote
+// capabilities by asking the remote vat with the Introspection
+// interface from std/introspect.  known is false if the capability does
+// not support introspection.  The remote vat only lists the interfaces
+// that it has methods of and their superclasses, so an interface with
+// no methods of its own is checked with its schema from
+// schemas.DefaultRegistry.
+func (ic *importClient) ImplementsInterface(ctx context.Context, id uint64) (ok, known bool, err error) {
+	ans, release := ic.Send(ctx, capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   introspect.Introspection_TypeID,
+			MethodID:      0,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "listInterfaces",
+		},
+	})
+	defer release()
+	res, err := introspect.Introspection_listInterfaces_Results_Future{Future: ans.Future()}.Struct()
+	if exc.IsType(err, exc.Unimplemented) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+	l, err := res.InterfaceIds()
+	if err != nil {
+		return false, false, err
+	}
+	ids := make(map[uint64]bool, l.Len())
+	for i := 0; i < l.Len(); i++ {
+		ids[l.At(i)] = true
+	}
+	interfaceNodes.Lock()
+	defer interfaceNodes.Unlock()
+	return implementsListed(ids, id), true, nil
+}
+
+// implementsListed reports whether a capability that implements the
+// interfaces in ids implements the interface with the given ID.  The
+// caller must hold interfaceNodes.
+func implementsListed(ids map[uint64]bool, id uint64) bool {
+	if ids[id] {
+		return true
+	}
+	n, err := interfaceNodes.m.Find(id)
+	if err != nil || n.Which() != schema.Node_Which_interface {
+		return false
+	}
+	if ms, err := n.Interface().Methods(); err != nil || ms.Len() > 0 {
+		return false
+	}
+	supers, err := n.Interface().Superclasses()
+	if err != nil {
+		return false
+	}
+	for i := 0; i < supers.Len(); i++ {
+		if !implementsListed(ids, supers.At(i).Id()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/std/introspect"
+)
+
+func TestCastRemoteClient(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	for _, enable := range []bool{true, false} {
+		srv := testcapnp.PingPong_NewServer(pingPonger{})
+		if enable {
+			introspect.Enable(srv, nil)
+		}
+		serverNetConn, clientNetConn := net.Pipe()
+		serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+			BootstrapClient: capnp.NewClient(srv),
+		})
+		defer serverConn.Close()
+		clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+		defer clientConn.Close()
+		client := clientConn.Bootstrap(ctx)
+		defer client.Release()
+
+		pp, err := capnp.CastClient[testcapnp.PingPong](ctx, client, testcapnp.PingPong_TypeID)
+		require.NoError(t, err, "introspection enabled = %t", enable)
+		assert.True(t, pp.IsValid())
+		_, err = capnp.CastClient[testcapnp.Empty](ctx, client, testcapnp.Empty_TypeID)
+		assert.NoError(t, err, "introspection enabled = %t", enable)
+
+		// Only a server with introspection enabled can tell that it
+		// does not implement StreamTest.
+		_, err = capnp.CastClient[testcapnp.StreamTest](ctx, client, testcapnp.StreamTest_TypeID)
+		if enable {
+			assert.ErrorIs(t, err, capnp.ErrWrongInterface)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/syncutil"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// Ping sends a ping to the remote vat and waits for the reply,
+// returning the round-trip time.
+//
+// The protocol has no ping message, so Ping sends a Bootstrap message,
+// which every implementation must answer.  Any Return counts as the
+// reply, even one that reports that the remote vat has no bootstrap
+// interface.  The bootstrap capability, if any, is released right away.
+func (c *Conn) Ping(ctx context.Context) (time.Duration, error) {
+	sent := make(chan error, 1)
+	start := time.Now()
+	q, err := withLockedConn2(c, func(c *lockedConn) (*question, error) {
+		if !c.startTask() {
+			return nil, ExcClosed
+		}
+		defer c.tasks.Done()
+
+		q := c.newQuestion(ctx, capnp.Method{})
+		c.sendMessage(ctx, func(m rpccp.Message) error {
+			boot, err := m.NewBootstrap()
+			if err == nil {
+				boot.SetQuestionId(uint32(q.id))
+			}
+			return err
+		}, func(err error) {
+			sent <- err
+			if err != nil {
+				syncutil.With(&c.lk, func() {
+					c.lk.questions[q.id] = nil
+					c.checkDrained()
+				})
+				close(q.returned)
+				q.p.Reject(exc.Annotate("rpc", "ping", err))
+				syncutil.With(&c.lk, func() {
+					c.lk.questionID.remove(q.id)
+				})
+				return
+			}
+
+			c.tasks.Add(1)
+			go func() {
+				defer c.tasks.Done()
+				q.handleCancel(ctx)
+			}()
+		})
+		return q, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		q.p.ReleaseClients()
+		q.release()
+	}()
+
+	select {
+	case <-q.returned:
+		// returned is also closed if the Bootstrap message could not
+		// be sent, in which case sent already holds the error.
+		select {
+		case err := <-sent:
+			if err != nil {
+				return 0, rpcerr.WrapFailed("send ping", err)
+			}
+		default:
+		}
+		return time.Since(start), nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-c.bgctx.Done():
+		return 0, ExcClosed
+	}
+}
+
+// keepalive pings the remote vat every interval until the connection is
+// shut down, and shuts the connection down if a ping is not answered
+// within timeout.
+func (c *Conn) keepalive(interval, timeout time.Duration, observe func(time.Duration), onDead func(*Conn)) {
+	if timeout <= 0 {
+		timeout = interval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-c.bgctx.Done():
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(c.bgctx, timeout)
+		rtt, err := c.Ping(ctx)
+		cancel()
+		if err == nil {
+			if observe != nil {
+				observe(rtt)
+			}
+			continue
+		}
+		if c.bgctx.Err() != nil {
+			return
+		}
+		c.er.Debug("remote vat did not answer ping; closing connection", "timeout", timeout)
+		if onDead != nil {
+			go onDead(c)
+		}
+		c.er.ReportError(c.shutdown(exc.Exception{ // NOTE:  omit "rpc" prefix
+			Type:  exc.Disconnected,
+			Cause: ErrPeerUnresponsive,
+		}))
+		return
+	}
+}
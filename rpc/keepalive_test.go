@@ -0,0 +1,114 @@
+package rpc_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+func TestPing(t *testing.T) {
+	t.Parallel()
+
+	roundTrips := make(chan time.Duration, 1)
+	p1, p2 := net.Pipe()
+	c1 := rpc.NewConn(transport.NewStream(p1), &rpc.Options{
+		KeepaliveInterval: 10 * time.Millisecond,
+		ObserveRoundTrip: func(rtt time.Duration) {
+			select {
+			case roundTrips <- rtt:
+			default:
+			}
+		},
+	})
+	defer c1.Close()
+	c2 := rpc.NewConn(transport.NewStream(p2), nil)
+	defer c2.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rtt, err := c2.Ping(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, rtt, time.Duration(0))
+
+	select {
+	case rtt := <-roundTrips:
+		assert.Greater(t, rtt, time.Duration(0))
+	case <-time.After(5 * time.Second):
+		t.Fatal("ObserveRoundTrip not called")
+	}
+	assert.NoError(t, c1.Close())
+}
+
+func TestPingBootstrap(t *testing.T) {
+	t.Parallel()
+
+	// The remote vat answers each ping with its bootstrap capability.
+	// Releasing it must not release the import held by the client
+	// returned from Bootstrap.
+	p1, p2 := net.Pipe()
+	srv := rpc.NewConn(transport.NewStream(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(testcp.PingPong_ServerToClient(pingPonger{})),
+	})
+	defer srv.Close()
+	conn := rpc.NewConn(transport.NewStream(p2), nil)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	pp := testcp.PingPong(conn.Bootstrap(ctx))
+	defer pp.Release()
+	require.NoError(t, capnp.Client(pp).Resolve(ctx))
+	for i := 0; i < 3; i++ {
+		_, err := conn.Ping(ctx)
+		require.NoError(t, err)
+	}
+
+	f, release := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(42)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), res.N())
+}
+
+func TestKeepaliveDeadPeer(t *testing.T) {
+	t.Parallel()
+
+	// The peer reads everything that is sent to it, but never replies.
+	p1, p2 := net.Pipe()
+	go io.Copy(io.Discard, p2)
+	defer p2.Close()
+
+	dead := make(chan *rpc.Conn, 1)
+	conn := rpc.NewConn(transport.NewStream(p1), &rpc.Options{
+		KeepaliveInterval: 10 * time.Millisecond,
+		KeepaliveTimeout:  20 * time.Millisecond,
+		OnDeadPeer:        func(c *rpc.Conn) { dead <- c },
+	})
+	select {
+	case c := <-dead:
+		assert.Equal(t, conn, c)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnDeadPeer not called")
+	}
+	select {
+	case <-conn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not closed after the peer stopped answering")
+	}
+
+	_, err := conn.Ping(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"time"
+
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+// enforceMaxAge waits until the connection is maxAge old, then drains
+// and closes it.  It returns early if the connection is shut down.
+func (c *Conn) enforceMaxAge(maxAge, grace time.Duration, onMaxAge func(*Conn)) {
+	timer := time.NewTimer(maxAge)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-c.bgctx.Done():
+		return
+	}
+
+	drained := withLockedConn1(c, func(c *lockedConn) <-chan struct{} {
+		c.lk.draining = true
+		drained := make(chan struct{})
+		c.lk.drained = drained
+		c.checkDrained()
+		return drained
+	})
+	c.er.Debug("connection reached maximum age; draining", "maxAge", maxAge)
+	if onMaxAge != nil {
+		go onMaxAge(c)
+	}
+
+	var deadline <-chan time.Time
+	if grace > 0 {
+		graceTimer := time.NewTimer(grace)
+		defer graceTimer.Stop()
+		deadline = graceTimer.C
+	}
+	select {
+	case <-drained:
+	case <-deadline:
+		c.er.Debug("connection did not drain within grace period", "grace", grace)
+	case <-c.bgctx.Done():
+		return
+	}
+	c.closeMaxAge()
+}
+
+func (c *Conn) closeMaxAge() {
+	c.er.ReportError(c.shutdown(exc.Exception{ // NOTE:  omit "rpc" prefix
+		Type:  exc.Disconnected,
+		Cause: ErrConnMaxAge,
+	}))
+}
+
+// Draining reports whether the connection has reached the maximum age
+// set in Options.MaxAge, and will be closed once its outstanding calls
+// have finished.
+func (c *Conn) Draining() bool {
+	return withLockedConn1(c, func(c *lockedConn) bool {
+		return c.lk.draining
+	})
+}
+
+// checkDrained closes c.lk.drained if the connection is draining and
+// has become idle.  It is called whenever a question or answer is
+// removed from its table.  Callers MUST hold c.lk.
+func (c *lockedConn) checkDrained() {
+	if c.lk.drained != nil && c.idle() {
+		close(c.lk.drained)
+		c.lk.drained = nil
+	}
+}
+
+// idle reports whether there are no outstanding questions or answers.
+// Callers MUST hold c.lk.
+func (c *lockedConn) idle() bool {
+	if len(c.lk.answers) > 0 {
+		return false
+	}
+	for _, q := range c.lk.questions {
+		if q != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,220 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// blockingPingServer blocks each call until release is closed.
+type blockingPingServer struct {
+	started chan<- struct{}
+	release <-chan struct{}
+}
+
+func (s blockingPingServer) EchoNum(ctx context.Context, p testcapnp.PingPong_echoNum) error {
+	s.started <- struct{}{}
+	select {
+	case <-s.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// maxAgeWithCall is the maximum age for tests that need a call to be in
+// progress when the connection starts draining.  It must be long enough
+// to set up the call.
+const maxAgeWithCall = 500 * time.Millisecond
+
+func newMaxAgePair(t *testing.T, opts *rpc.Options, srv blockingPingServer) (*rpc.Conn, *rpc.Conn, testcapnp.PingPong) {
+	serverNetConn, clientNetConn := net.Pipe()
+	opts.BootstrapClient = capnp.Client(testcapnp.PingPong_ServerToClient(srv))
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), opts)
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+	client := testcapnp.PingPong(clientConn.Bootstrap(context.Background()))
+	require.NoError(t, client.Resolve(context.Background()))
+	return serverConn, clientConn, client
+}
+
+func TestMaxAge(t *testing.T) {
+	t.Parallel()
+
+	onMaxAge := make(chan *rpc.Conn, 1)
+	serverConn, clientConn, client := newMaxAgePair(t, &rpc.Options{
+		MaxAge:   50 * time.Millisecond,
+		OnMaxAge: func(c *rpc.Conn) { onMaxAge <- c },
+	}, blockingPingServer{})
+	defer client.Release()
+
+	select {
+	case c := <-onMaxAge:
+		assert.Equal(t, serverConn, c)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnMaxAge not called")
+	}
+	assert.True(t, serverConn.Draining())
+	assert.False(t, clientConn.Draining())
+
+	select {
+	case <-serverConn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle connection not closed after reaching maximum age")
+	}
+	select {
+	case <-clientConn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("remote connection not closed")
+	}
+}
+
+func TestMaxAgeDrain(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	onMaxAge := make(chan struct{})
+	serverConn, clientConn, client := newMaxAgePair(t, &rpc.Options{
+		MaxAge:   maxAgeWithCall,
+		OnMaxAge: func(*rpc.Conn) { close(onMaxAge) },
+	}, blockingPingServer{started: started, release: release})
+	defer client.Release()
+	defer clientConn.Close()
+
+	future, rel := client.EchoNum(context.Background(), nil)
+	defer rel()
+	<-started
+	<-onMaxAge
+
+	// The outstanding call keeps the connection open.
+	select {
+	case <-serverConn.Done():
+		t.Fatal("connection closed with outstanding call")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.True(t, serverConn.Draining())
+
+	// New incoming calls are rejected.
+	f, rel2 := client.EchoNum(context.Background(), nil)
+	defer rel2()
+	_, err := f.Struct()
+	assert.True(t, capnp.IsDisconnected(err), "call while draining: %v; want disconnected", err)
+	assert.ErrorContains(t, err, rpc.ErrConnDraining.Error())
+
+	close(release)
+	_, err = future.Struct()
+	require.NoError(t, err)
+	select {
+	case <-serverConn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not closed after outstanding call finished")
+	}
+}
+
+func TestMaxAgeImportCalls(t *testing.T) {
+	t.Parallel()
+
+	// This time the connection that drains is the one making calls.
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	onMaxAge := make(chan struct{})
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(
+			blockingPingServer{started: started, release: release})),
+	})
+	defer serverConn.Close()
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), &rpc.Options{
+		MaxAge:   maxAgeWithCall,
+		OnMaxAge: func(*rpc.Conn) { close(onMaxAge) },
+	})
+	client := testcapnp.PingPong(clientConn.Bootstrap(context.Background()))
+	defer client.Release()
+	require.NoError(t, client.Resolve(context.Background()))
+
+	future, rel := client.EchoNum(context.Background(), nil)
+	defer rel()
+	<-started
+	<-onMaxAge
+
+	f, rel2 := client.EchoNum(context.Background(), nil)
+	defer rel2()
+	_, err := f.Struct()
+	assert.True(t, capnp.IsDisconnected(err), "call on import while draining: %v; want disconnected", err)
+	assert.ErrorContains(t, err, rpc.ErrConnDraining.Error())
+
+	close(release)
+	_, err = future.Struct()
+	require.NoError(t, err)
+	select {
+	case <-clientConn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not closed after outstanding call finished")
+	}
+}
+
+func TestMaxAgeGrace(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 1)
+	serverConn, clientConn, client := newMaxAgePair(t, &rpc.Options{
+		MaxAge:      maxAgeWithCall,
+		MaxAgeGrace: 50 * time.Millisecond,
+	}, blockingPingServer{started: started, release: make(chan struct{})})
+	defer client.Release()
+	defer clientConn.Close()
+
+	future, rel := client.EchoNum(context.Background(), nil)
+	defer rel()
+	<-started
+
+	select {
+	case <-serverConn.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection not closed after grace period")
+	}
+	_, err := future.Struct()
+	assert.True(t, capnp.IsDisconnected(err), "call error = %v; want disconnected", err)
+}
+
+func TestMaxAgeBootstrap(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	onMaxAge := make(chan struct{})
+	serverConn, clientConn, client := newMaxAgePair(t, &rpc.Options{
+		MaxAge:   maxAgeWithCall,
+		OnMaxAge: func(*rpc.Conn) { close(onMaxAge) },
+	}, blockingPingServer{started: started, release: release})
+	defer clientConn.Close()
+	defer client.Release()
+
+	// Keep a call outstanding so that the connection stays open while
+	// draining.
+	future, rel := client.EchoNum(context.Background(), nil)
+	defer rel()
+	<-started
+	<-onMaxAge
+
+	boot := testcapnp.PingPong(serverConn.Bootstrap(context.Background()))
+	defer boot.Release()
+	f, rel2 := boot.EchoNum(context.Background(), nil)
+	defer rel2()
+	_, err := f.Struct()
+	assert.True(t, capnp.IsDisconnected(err), "call on bootstrap while draining: %v; want disconnected", err)
+
+	close(release)
+	_, err = future.Struct()
+	require.NoError(t, err)
+	<-serverConn.Done()
+}
@@ -0,0 +1,14 @@
+package rpc
+
+// startAnswer records that an incoming call is being delivered.  The
+// call is finished by ansReturner.Return.
+func (c *lockedConn) startAnswer() {
+	c.tasks.Add(1)
+	c.lk.runningAnswers++
+}
+
+// answerLimitReached reports whether an incoming call must be rejected
+// because Options.MaxConcurrentAnswers calls are already running.
+func (c *lockedConn) answerLimitReached() bool {
+	return c.maxAnswers > 0 && c.lk.runningAnswers >= c.maxAnswers
+}
@@ -0,0 +1,50 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/rpc"
+)
+
+func TestMaxConcurrentAnswers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	serverConn, clientConn, client := newMaxAgePair(t, &rpc.Options{
+		MaxConcurrentAnswers: 1,
+	}, blockingPingServer{started: started, release: release})
+	defer serverConn.Close()
+	defer clientConn.Close()
+	defer client.Release()
+
+	first, finishFirst := client.EchoNum(ctx, nil)
+	defer finishFirst()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("first call not delivered")
+	}
+
+	second, finishSecond := client.EchoNum(ctx, nil)
+	defer finishSecond()
+	_, err := second.Struct()
+	assert.True(t, exc.IsType(err, exc.Overloaded), "call over the limit should be overloaded, got %v", err)
+
+	close(release)
+	_, err = first.Struct()
+	require.NoError(t, err)
+
+	// Once the first call returns, there is room for another.
+	third, finishThird := client.EchoNum(ctx, nil)
+	defer finishThird()
+	_, err = third.Struct()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,3 @@
+package memnet
+
+//go:generate capnp compile -I ../../std -ogo memnet.capnp
@@ -1,6 +1,6 @@
 // Code generated by capnpc-go. DO NOT EDIT.
 
-package testnetwork
+package memnet
 
 import (
 	capnp "capnproto.org/go/capnp/v3"
@@ -8,6 +8,9 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 )
 
+// A pair of peer ID and a nonce. This is the format for all
+// three of ProvisionId, RecipientId, and ThirdPartyCapId,
+// though which peer the id refers to differs.
 type PeerAndNonce capnp.Struct
 
 // PeerAndNonce_TypeID is the unique identifier for the type PeerAndNonce.
@@ -88,17 +91,16 @@ func (f PeerAndNonce_Future) Struct() (PeerAndNonce, error) {
 	return PeerAndNonce(p.Struct()), err
 }
 
-const schema_bcea0965c2a55c5b = "x\xda\x12Ht`1\xe4\xdd\xcf\xc8\xc0\x14(\xc2\xca" +
-	"\xf6\x7f\xeb\x81H\xe5b\xb9u\xf3\x19\x02\x85\x18\x99\xfe" +
-	"G\xc7,=\x94\xca\xf9j\x0f\x03\x0b;\x03\x83\xe0\xd1" +
-	"_\x82\x17A\xf4\xd9r\x06\xdd\xff%\xa9\xc5%y\xa9" +
-	"%\xe5L\xf9E\xd9z\xc9\x89\x05y\x05V\x01\xa9\xa9" +
-	"E\x8ey\xf6)~\xf9y\xc9\xa9\x01\x8c\x8c\x81\x1c\xcc" +
-	",\x0c\x0c,\x8c\x0c\x0c\x82\x9aV\x0c\x0c\x81*\xcc\x8c" +
-	"\x81\x06L\x8c\x82\x8c\x8c\"\x8c A]#\x06\x86@" +
-	"\x0df\xc6@\x13&F\xfb\x82\xd4\xd4\"\xcf\x14FN" +
-	"\x06&FN\x06F\xf9<\x90\x190\x1e \x00\x00\xff" +
-	"\xff\xe1\"&\xa5"
+const schema_bcea0965c2a55c5b = "x\xda2\x90ft`1\xe4\x9d\xce\xce\xc0\x14\x18\xc1" +
+	"\xca\xf6\x7f\xeb\x81H\xe5b\xb9u\xf3\x19\x02y\x19\x99" +
+	"\xfeG\xc7,=\x94\xca\xf9j\x0f\x03\x0b;\x03\x83\xf0" +
+	"F\xe6K\x0c\x8c\xc2[\x99\xcb\x19^\xfc\xcfM\xcd\xcd" +
+	"K-\xd1KfJ,\xc8+\xb0\x0aHM-r\xcc" +
+	"K\xf1\xcb\xcfKfL\x0d\xe4`fa``ad" +
+	"`\x10\xd4\xb4b`\x08Taf\x0c4`b\x14d" +
+	"d\x14a\x04\x09\xea\x1a10\x04j03\x06\x9a0" +
+	"1\xda\x17\xa4\xa6\x16y\xa60r201r20" +
+	"\xca\xe7\xe5\xe7%\xa7\xc2x\x80\x01\x00\xd0\x14#R"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -109,3 +111,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_bcea0965c2a55c5b,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -0,0 +1,286 @@
+// Package memnet provides an in-memory implementation of rpc.Network,
+// so that tests and embedded systems can run several vats that exchange
+// capabilities within one process.
+//
+// Each vat joins a Joiner, which connects the vats that join it:
+//
+//	j := memnet.NewJoiner()
+//	alice, bob := j.Join(), j.Join()
+//	conn, err := alice.Dial(bob.LocalID(), nil)
+//
+// and bob receives the other end of the connection from Accept.
+//
+// The rpc package does not implement third-party handoff yet, so the
+// connections that a Joiner creates are not marked as belonging to a
+// Network: a capability that one vat passes to another through a third
+// is proxied by the third vat.  The Network's Introduce, DialIntroduced
+// and AcceptIntroduced methods work, for use once it does.
+package memnet
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+)
+
+// PeerID is the implementation of peer ids used by an in-memory
+// network.  It is the Value of the rpc.PeerIDs that it uses.
+type PeerID uint64
+
+type edge struct {
+	To, From PeerID
+}
+
+func (e edge) Flip() edge {
+	return edge{
+		To:   e.From,
+		From: e.To,
+	}
+}
+
+type network struct {
+	myID   PeerID
+	global *Joiner
+}
+
+// A Joiner is a global view of an in-memory network, which can be
+// joined by a peer to acquire a Network.
+type Joiner struct {
+	mu          sync.Mutex
+	nextID      PeerID
+	nextNonce   uint64
+	connections map[edge]*connectionEntry
+
+	// incoming holds, for each peer, the peers that have dialed it and
+	// that it has not accepted yet.  arrived is closed and replaced
+	// when a peer is added to incoming.
+	incoming map[PeerID][]PeerID
+	arrived  map[PeerID]chan struct{}
+}
+
+// A connectionEntry is one side of a connection between two peers.
+type connectionEntry struct {
+	Transport rpc.Transport
+	Conn      *rpc.Conn // Might be nil, if we haven't initialized this yet.
+}
+
+// NewJoiner returns a new, empty network.
+func NewJoiner() *Joiner {
+	return &Joiner{
+		connections: make(map[edge]*connectionEntry),
+		incoming:    make(map[PeerID][]PeerID),
+		arrived:     make(map[PeerID]chan struct{}),
+	}
+}
+
+// Join adds a peer to the network, and returns its view of the network.
+func (j *Joiner) Join() rpc.Network {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	ret := network{
+		myID:   j.nextID,
+		global: j,
+	}
+	j.nextID++
+	return ret
+}
+
+// arrivedChan returns a channel that is closed when a peer next dials
+// id.  The caller must hold j.mu.
+func (j *Joiner) arrivedChan(id PeerID) chan struct{} {
+	ch, ok := j.arrived[id]
+	if !ok {
+		ch = make(chan struct{})
+		j.arrived[id] = ch
+	}
+	return ch
+}
+
+// startConn creates the Conn for the side of a connection that is
+// e.From, and arranges for the connection to be forgotten when the
+// Conn is closed.  The caller must hold j.mu.
+func (j *Joiner) startConn(e edge, ent *connectionEntry, opts *rpc.Options) *rpc.Conn {
+	var o rpc.Options
+	if opts != nil {
+		o = *opts
+	}
+	o.RemotePeerID = rpc.PeerID{Value: e.To}
+	ent.Conn = rpc.NewConn(ent.Transport, &o)
+	go func() {
+		<-ent.Conn.Done()
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		if j.connections[e] == ent {
+			delete(j.connections, e)
+		}
+	}()
+	return ent.Conn
+}
+
+func (n network) LocalID() rpc.PeerID {
+	return rpc.PeerID{Value: n.myID}
+}
+
+// Dial returns the connection to dst, creating it if there is none.
+// There is at most one connection between two peers, whichever of
+// them dialed it.  If the connection already exists, opts is not used
+// and its BootstrapClient is released.
+func (n network) Dial(dst rpc.PeerID, opts *rpc.Options) (*rpc.Conn, error) {
+	dstID, ok := dst.Value.(PeerID)
+	if !ok {
+		releaseBootstrap(opts)
+		return nil, errors.New("memnet: dial: peer ID is not a memnet.PeerID")
+	}
+	toEdge := edge{
+		From: n.myID,
+		To:   dstID,
+	}
+	fromEdge := toEdge.Flip()
+
+	n.global.mu.Lock()
+	defer n.global.mu.Unlock()
+	if dstID >= n.global.nextID {
+		releaseBootstrap(opts)
+		return nil, errors.New("memnet: dial: no such peer")
+	}
+	if dstID == n.myID {
+		releaseBootstrap(opts)
+		return nil, errors.New("memnet: dial: cannot dial self")
+	}
+	ent, ok := n.global.connections[toEdge]
+	if !ok {
+		c1, c2 := net.Pipe()
+		t1 := rpc.NewStreamTransport(c1)
+		t2 := rpc.NewStreamTransport(c2)
+		ent = &connectionEntry{Transport: t1}
+		n.global.connections[toEdge] = ent
+		n.global.connections[fromEdge] = &connectionEntry{Transport: t2}
+
+		n.global.incoming[dstID] = append(n.global.incoming[dstID], n.myID)
+		close(n.global.arrivedChan(dstID))
+		delete(n.global.arrived, dstID)
+	}
+	if ent.Conn != nil {
+		// There's already a connection, so we're not going to use this, but
+		// we own it. So drop it:
+		releaseBootstrap(opts)
+		return ent.Conn, nil
+	}
+	// The peer dialed us, and we haven't accepted yet; Accept skips
+	// connections that already have a Conn.
+	return n.global.startConn(toEdge, ent, opts), nil
+}
+
+// Accept returns the next connection that another peer dialed.
+func (n network) Accept(ctx context.Context, opts *rpc.Options) (*rpc.Conn, error) {
+	for {
+		n.global.mu.Lock()
+		for len(n.global.incoming[n.myID]) > 0 {
+			q := n.global.incoming[n.myID]
+			from := q[0]
+			n.global.incoming[n.myID] = q[1:]
+			toEdge := edge{
+				From: n.myID,
+				To:   from,
+			}
+			ent, ok := n.global.connections[toEdge]
+			if !ok || ent.Conn != nil {
+				// Already closed, or we dialed the peer ourselves.
+				continue
+			}
+			conn := n.global.startConn(toEdge, ent, opts)
+			n.global.mu.Unlock()
+			return conn, nil
+		}
+		arrived := n.global.arrivedChan(n.myID)
+		n.global.mu.Unlock()
+
+		select {
+		case <-arrived:
+		case <-ctx.Done():
+			releaseBootstrap(opts)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (n network) Introduce(provider, recipient *rpc.Conn) (rpc.IntroductionInfo, error) {
+	providerPeer := provider.RemotePeerID()
+	recipientPeer := recipient.RemotePeerID()
+	n.global.mu.Lock()
+	defer n.global.mu.Unlock()
+	nonce := n.global.nextNonce
+	n.global.nextNonce++
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	ret := rpc.IntroductionInfo{}
+	sendToRecipient, err := NewPeerAndNonce(seg)
+	if err != nil {
+		return ret, err
+	}
+	sendToProvider, err := NewPeerAndNonce(seg)
+	if err != nil {
+		return ret, err
+	}
+	sendToRecipient.SetPeerId(uint64(providerPeer.Value.(PeerID)))
+	sendToRecipient.SetNonce(nonce)
+	sendToProvider.SetPeerId(uint64(recipientPeer.Value.(PeerID)))
+	sendToProvider.SetNonce(nonce)
+	ret.SendToRecipient = rpc.ThirdPartyCapID(sendToRecipient.ToPtr())
+	ret.SendToProvider = rpc.RecipientID(sendToProvider.ToPtr())
+	return ret, nil
+}
+
+func (n network) DialIntroduced(capID rpc.ThirdPartyCapID, introducedBy *rpc.Conn) (*rpc.Conn, rpc.ProvisionID, error) {
+	cid := PeerAndNonce(capnp.Ptr(capID).Struct())
+
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	pid, err := NewPeerAndNonce(seg)
+	if err != nil {
+		return nil, rpc.ProvisionID{}, err
+	}
+	pid.SetPeerId(uint64(introducedBy.RemotePeerID().Value.(PeerID)))
+	pid.SetNonce(cid.Nonce())
+
+	conn, err := n.Dial(rpc.PeerID{Value: PeerID(cid.PeerId())}, nil)
+	return conn, rpc.ProvisionID(pid.ToPtr()), err
+}
+
+// AcceptIntroduced returns the connection to the recipient named by
+// recipientID, waiting for the recipient to dial if there is none.  It
+// gives up if introducedBy is closed first.
+func (n network) AcceptIntroduced(recipientID rpc.RecipientID, introducedBy *rpc.Conn) (*rpc.Conn, error) {
+	rid := PeerAndNonce(capnp.Ptr(recipientID).Struct())
+	toEdge := edge{
+		From: n.myID,
+		To:   PeerID(rid.PeerId()),
+	}
+	for {
+		n.global.mu.Lock()
+		if ent, ok := n.global.connections[toEdge]; ok {
+			conn := ent.Conn
+			if conn == nil {
+				conn = n.global.startConn(toEdge, ent, nil)
+			}
+			n.global.mu.Unlock()
+			return conn, nil
+		}
+		arrived := n.global.arrivedChan(n.myID)
+		n.global.mu.Unlock()
+
+		select {
+		case <-arrived:
+		case <-introducedBy.Done():
+			return nil, errors.New("memnet: accept introduced: introducing connection closed")
+		}
+	}
+}
+
+func releaseBootstrap(opts *rpc.Options) {
+	if opts != nil {
+		opts.BootstrapClient.Release()
+	}
+}
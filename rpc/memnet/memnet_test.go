@@ -0,0 +1,217 @@
+package memnet_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/memnet"
+)
+
+type pingPongServer struct{}
+
+func (pingPongServer) EchoNum(ctx context.Context, call testcapnp.PingPong_echoNum) error {
+	out, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out.SetN(call.Args().N())
+	return nil
+}
+
+// capArgsServer calls echoNum on the PingPong capabilities that it is
+// passed.
+type capArgsServer struct{}
+
+func (capArgsServer) Call(ctx context.Context, call testcapnp.CapArgsTest_call) error {
+	pp := testcapnp.PingPong(call.Args().Cap().AddRef())
+	defer pp.Release()
+	f, release := pp.EchoNum(ctx, func(p testcapnp.PingPong_echoNum_Params) error {
+		p.SetN(42)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	if err != nil {
+		return err
+	}
+	if res.N() != 42 {
+		return errors.New("wrong echo")
+	}
+	return nil
+}
+
+func (capArgsServer) Self(ctx context.Context, call testcapnp.CapArgsTest_self) error {
+	return errors.New("not implemented")
+}
+
+// serve accepts connections to n until the test ends, serving the
+// bootstrap capability returned by boot on each.
+func serve(t *testing.T, n rpc.Network, boot func() capnp.Client) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		for {
+			conn, err := n.Accept(ctx, &rpc.Options{BootstrapClient: boot()})
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+}
+
+func TestDialAccept(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	j := memnet.NewJoiner()
+	alice, bob := j.Join(), j.Join()
+	assert.NotEqual(t, alice.LocalID(), bob.LocalID())
+
+	accepted := make(chan *rpc.Conn, 1)
+	go func() {
+		conn, err := bob.Accept(ctx, &rpc.Options{
+			BootstrapClient: capnp.Client(testcapnp.PingPong_ServerToClient(pingPongServer{})),
+		})
+		assert.NoError(t, err)
+		accepted <- conn
+	}()
+
+	conn, err := alice.Dial(bob.LocalID(), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, bob.LocalID(), conn.RemotePeerID())
+
+	var bobConn *rpc.Conn
+	select {
+	case bobConn = <-accepted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Accept did not return")
+	}
+	assert.Equal(t, alice.LocalID(), bobConn.RemotePeerID())
+
+	pp := testcapnp.PingPong(conn.Bootstrap(ctx))
+	defer pp.Release()
+	f, release := pp.EchoNum(ctx, func(p testcapnp.PingPong_echoNum_Params) error {
+		p.SetN(7)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), res.N())
+
+	// There is one connection between two peers, whichever dials.
+	again, err := alice.Dial(bob.LocalID(), nil)
+	require.NoError(t, err)
+	assert.Same(t, conn, again)
+	back, err := bob.Dial(alice.LocalID(), nil)
+	require.NoError(t, err)
+	assert.Same(t, bobConn, back)
+
+	_, err = alice.Dial(alice.LocalID(), nil)
+	assert.Error(t, err, "dialing self")
+	_, err = alice.Dial(rpc.PeerID{Value: memnet.PeerID(99)}, nil)
+	assert.Error(t, err, "dialing a peer that hasn't joined")
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = bob.Accept(ctx, &rpc.Options{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestDialAfterClose(t *testing.T) {
+	t.Parallel()
+
+	j := memnet.NewJoiner()
+	alice, bob := j.Join(), j.Join()
+	serve(t, bob, func() capnp.Client { return capnp.Client{} })
+
+	conn, err := alice.Dial(bob.LocalID(), nil)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	<-conn.Done()
+
+	// Once the connection is closed, dialing creates a new one.
+	require.Eventually(t, func() bool {
+		c, err := alice.Dial(bob.LocalID(), nil)
+		return err == nil && c != conn
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestProxiedCapability(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	j := memnet.NewJoiner()
+	alice, bob, carol := j.Join(), j.Join(), j.Join()
+	serve(t, bob, func() capnp.Client {
+		return capnp.Client(testcapnp.PingPong_ServerToClient(pingPongServer{}))
+	})
+	serve(t, carol, func() capnp.Client {
+		return capnp.Client(testcapnp.CapArgsTest_ServerToClient(capArgsServer{}))
+	})
+
+	toBob, err := alice.Dial(bob.LocalID(), nil)
+	require.NoError(t, err)
+	defer toBob.Close()
+	toCarol, err := alice.Dial(carol.LocalID(), nil)
+	require.NoError(t, err)
+	defer toCarol.Close()
+
+	pp := testcapnp.PingPong(toBob.Bootstrap(ctx))
+	defer pp.Release()
+	require.NoError(t, pp.Resolve(ctx))
+	cat := testcapnp.CapArgsTest(toCarol.Bootstrap(ctx))
+	defer cat.Release()
+
+	// Carol calls Bob's capability through Alice.
+	f, release := cat.Call(ctx, func(p testcapnp.CapArgsTest_call_Params) error {
+		return p.SetCap(capnp.Client(pp).AddRef())
+	})
+	defer release()
+	_, err = f.Struct()
+	assert.NoError(t, err)
+}
+
+func TestIntroduce(t *testing.T) {
+	t.Parallel()
+
+	j := memnet.NewJoiner()
+	alice, bob, carol := j.Join(), j.Join(), j.Join()
+	serve(t, bob, func() capnp.Client { return capnp.Client{} })
+	serve(t, carol, func() capnp.Client { return capnp.Client{} })
+
+	toBob, err := alice.Dial(bob.LocalID(), nil)
+	require.NoError(t, err)
+	defer toBob.Close()
+	toCarol, err := alice.Dial(carol.LocalID(), nil)
+	require.NoError(t, err)
+	defer toCarol.Close()
+
+	// Alice introduces Carol to Bob, the provider.
+	info, err := alice.Introduce(toBob, toCarol)
+	require.NoError(t, err)
+
+	// Bob's side of the connection to Alice, as the introducer.
+	bobToAlice, err := bob.Dial(alice.LocalID(), nil)
+	require.NoError(t, err)
+	carolToAlice, err := carol.Dial(alice.LocalID(), nil)
+	require.NoError(t, err)
+
+	carolToBob, _, err := carol.DialIntroduced(info.SendToRecipient, carolToAlice)
+	require.NoError(t, err)
+	assert.Equal(t, bob.LocalID(), carolToBob.RemotePeerID())
+
+	bobToCarol, err := bob.AcceptIntroduced(info.SendToProvider, bobToAlice)
+	require.NoError(t, err)
+	assert.Equal(t, carol.LocalID(), bobToCarol.RemotePeerID())
+}
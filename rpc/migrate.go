@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/str"
+	"capnproto.org/go/capnp/v3/internal/syncutil"
+)
+
+// A Snapshot is the state of a connection's capability tables, as
+// exported by Conn.Snapshot.  RestoreConn re-establishes the tables
+// over a new transport, so that a session with a peer can move to a
+// new connection, such as when it migrates between frontends.  The
+// peer must restore its side of the connection from its own snapshot.
+//
+// A Snapshot holds no references to live objects, so it can be
+// serialized with encoding/json or encoding/gob and restored in
+// another process.
+//
+// EXPERIMENTAL: the API may change, and only the tables of exports
+// and imports are restored.
+type Snapshot struct {
+	Exports   []ExportSnapshot
+	Imports   []ImportSnapshot
+	Questions []QuestionSnapshot
+}
+
+// An ExportSnapshot is an entry of a connection's export table: a
+// capability that the remote peer holds references to.
+type ExportSnapshot struct {
+	ID uint32
+
+	// Refs is the number of references that the remote peer holds.
+	Refs uint32
+
+	// Ref identifies the capability, as returned by the SaveFunc
+	// passed to Conn.Snapshot.
+	Ref []byte
+}
+
+// An ImportSnapshot is an entry of a connection's import table: a
+// capability that the local vat holds references to.
+type ImportSnapshot struct {
+	ID uint32
+
+	// Refs is the number of times that the remote peer has sent the
+	// import, which the local vat releases all at once.
+	Refs uint32
+}
+
+// A QuestionSnapshot is a call that the local vat made over the
+// connection and that had not returned when the snapshot was taken.
+// Calls cannot move to a new connection, so they are listed for the
+// application to retry.
+type QuestionSnapshot struct {
+	ID     uint32
+	Method capnp.Method
+}
+
+// A SaveFunc returns a reference to the exported capability c that a
+// LoadFunc can restore it from, such as a sturdy ref.
+type SaveFunc func(c capnp.Client) ([]byte, error)
+
+// A LoadFunc restores a capability from a reference that a SaveFunc
+// returned.  The returned client must be resolved: exports cannot be
+// restored as promises.
+type LoadFunc func(ref []byte) (capnp.Client, error)
+
+// Snapshot returns the state of c's capability tables.  save is called
+// for each exported capability to get a reference that can restore it.
+//
+// The tables change as messages arrive, so for the snapshot to be
+// consistent, the application should stop making calls, and the
+// remote peer should stop sending messages, before it is taken.
+// Afterward, c should be closed: the restored connection takes over
+// the references of its tables.
+//
+// EXPERIMENTAL: see Snapshot.
+func (c *Conn) Snapshot(save SaveFunc) (*Snapshot, error) {
+	var (
+		snap    Snapshot
+		exports []capnp.ClientSnapshot
+		err     error
+	)
+	c.withLocked(func(c *lockedConn) {
+		if c.lk.closing {
+			err = ExcClosed
+			return
+		}
+		for id, ent := range c.lk.exports {
+			if ent == nil {
+				continue
+			}
+			snap.Exports = append(snap.Exports, ExportSnapshot{
+				ID:   uint32(id),
+				Refs: ent.wireRefs,
+			})
+			exports = append(exports, ent.snapshot.AddRef())
+		}
+		for id, ent := range c.lk.imports {
+			snap.Imports = append(snap.Imports, ImportSnapshot{
+				ID:   uint32(id),
+				Refs: uint32(ent.wireRefs),
+			})
+		}
+		for _, q := range c.lk.questions {
+			if q == nil || q.flags.Contains(finished) {
+				continue
+			}
+			snap.Questions = append(snap.Questions, QuestionSnapshot{
+				ID:     uint32(q.id),
+				Method: q.method,
+			})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, s := range exports {
+			s.Release()
+		}
+	}()
+	for i, s := range exports {
+		client := s.Client()
+		snap.Exports[i].Ref, err = save(client)
+		client.Release()
+		if err != nil {
+			return nil, rpcerr.WrapFailed("snapshot export "+str.Utod(snap.Exports[i].ID), err)
+		}
+	}
+	return &snap, nil
+}
+
+// RestoreConn is like NewConn, but starts the connection with the
+// capability tables in snap.  load is called for each export in snap
+// to restore its capability.  Imports are held by the connection until
+// RestoredImport claims them or the connection shuts down.
+//
+// EXPERIMENTAL: see Snapshot.
+func RestoreConn(t Transport, opts *Options, snap *Snapshot, load LoadFunc) (*Conn, error) {
+	if err := snap.validate(); err != nil {
+		return nil, rpcerr.WrapFailed("restore", err)
+	}
+	clients := make([]capnp.Client, 0, len(snap.Exports))
+	for _, e := range snap.Exports {
+		client, err := load(e.Ref)
+		if err != nil {
+			for _, c := range clients {
+				c.Release()
+			}
+			return nil, rpcerr.WrapFailed("restore export "+str.Utod(e.ID), err)
+		}
+		clients = append(clients, client)
+	}
+	return newConn(t, opts, func(c *lockedConn) {
+		c.restore(snap, clients)
+	}), nil
+}
+
+// validate returns an error if snap lists an ID twice.
+func (snap *Snapshot) validate() error {
+	exports := make(map[uint32]bool, len(snap.Exports))
+	for _, e := range snap.Exports {
+		if exports[e.ID] {
+			return errors.New("export ID " + str.Utod(e.ID) + " appears twice")
+		}
+		exports[e.ID] = true
+	}
+	imports := make(map[uint32]bool, len(snap.Imports))
+	for _, imp := range snap.Imports {
+		if imports[imp.ID] {
+			return errors.New("import ID " + str.Utod(imp.ID) + " appears twice")
+		}
+		imports[imp.ID] = true
+	}
+	return nil
+}
+
+// restore fills c's tables from snap, exporting clients at the IDs of
+// snap.Exports.  It steals the clients.
+func (c *lockedConn) restore(snap *Snapshot, clients []capnp.Client) {
+	for i, e := range snap.Exports {
+		id := exportID(e.ID)
+		for int64(len(c.lk.exports)) <= int64(id) {
+			c.lk.exports = append(c.lk.exports, nil)
+		}
+		ee := &expent{
+			snapshot: clients[i].Snapshot(),
+			wireRefs: e.Refs,
+			cancel:   func() {},
+		}
+		clients[i].Release()
+		c.lk.exports[id] = ee
+		if metadata := ee.snapshot.Metadata(); metadata != nil {
+			syncutil.With(metadata, func() {
+				c.setExportID(metadata, id)
+			})
+		}
+	}
+	c.lk.exportID.i = uint32(len(c.lk.exports))
+	for id, ee := range c.lk.exports {
+		if ee == nil {
+			c.lk.exportID.remove(exportID(id))
+		}
+	}
+
+	if len(snap.Imports) > 0 {
+		c.lk.restoredImports = make(map[importID]capnp.Client, len(snap.Imports))
+	}
+	for _, imp := range snap.Imports {
+		id := importID(imp.ID)
+		client := capnp.NewClient(&importClient{c: (*Conn)(c), id: id})
+		c.lk.imports[id] = &impent{
+			wc:       client.WeakRef(),
+			wireRefs: int(imp.Refs),
+		}
+		c.lk.restoredImports[id] = client
+	}
+}
+
+// RestoredImport returns the client for the import with the given ID
+// that RestoreConn restored, or false if there is no such import.
+// The first call for an ID hands over the connection's reference to
+// the import; later calls return new references.  The caller must
+// release the client.
+//
+// EXPERIMENTAL: see Snapshot.
+func (c *Conn) RestoredImport(id uint32) (capnp.Client, bool) {
+	return withLockedConn2(c, func(c *lockedConn) (capnp.Client, bool) {
+		if client, ok := c.lk.restoredImports[importID(id)]; ok {
+			delete(c.lk.restoredImports, importID(id))
+			return client, true
+		}
+		ent := c.lk.imports[importID(id)]
+		if ent == nil {
+			return capnp.Client{}, false
+		}
+		return ent.wc.AddRef()
+	})
+}
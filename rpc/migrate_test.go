@@ -0,0 +1,128 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// reserialize round-trips snap through JSON, as migrating it to another
+// process would.
+func reserialize(t *testing.T, snap *rpc.Snapshot) *rpc.Snapshot {
+	b, err := json.Marshal(snap)
+	require.NoError(t, err)
+	var out rpc.Snapshot
+	require.NoError(t, json.Unmarshal(b, &out))
+	return &out
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	srv := testcp.PingPong_ServerToClient(pingPongServer{})
+	defer srv.Release()
+
+	// save and load identify the only capability that the server
+	// exports by name.
+	save := func(c capnp.Client) ([]byte, error) {
+		if !c.IsSame(capnp.Client(srv)) {
+			return nil, errors.New("unknown capability")
+		}
+		return []byte("pingpong"), nil
+	}
+	load := func(ref []byte) (capnp.Client, error) {
+		if string(ref) != "pingpong" {
+			return capnp.Client{}, errors.New("unknown ref " + string(ref))
+		}
+		return capnp.Client(srv).AddRef(), nil
+	}
+
+	p1, p2 := net.Pipe()
+	server := rpc.NewConn(transport.NewStream(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(srv).AddRef(),
+	})
+	client := rpc.NewConn(transport.NewStream(p2), nil)
+
+	pp := testcp.PingPong(client.Bootstrap(ctx))
+	require.NoError(t, pp.Resolve(ctx))
+	checkEcho(ctx, t, pp, 1)
+
+	serverSnap, err := server.Snapshot(save)
+	require.NoError(t, err)
+	require.Len(t, serverSnap.Exports, 1)
+	assert.Equal(t, []byte("pingpong"), serverSnap.Exports[0].Ref)
+	clientSnap, err := client.Snapshot(save)
+	require.NoError(t, err)
+	require.Len(t, clientSnap.Imports, 1)
+	assert.Empty(t, clientSnap.Questions)
+	importID := clientSnap.Imports[0].ID
+
+	pp.Release()
+	require.NoError(t, client.Close())
+	<-server.Done()
+
+	// Move both ends to a new connection.
+	p1, p2 = net.Pipe()
+	server, err = rpc.RestoreConn(transport.NewStream(p1), nil, reserialize(t, serverSnap), load)
+	require.NoError(t, err)
+	defer server.Close()
+	client, err = rpc.RestoreConn(transport.NewStream(p2), nil, reserialize(t, clientSnap), load)
+	require.NoError(t, err)
+	defer client.Close()
+
+	c, ok := client.RestoredImport(importID)
+	require.True(t, ok)
+	pp = testcp.PingPong(c)
+	checkEcho(ctx, t, pp, 2)
+
+	_, ok = client.RestoredImport(importID + 1)
+	assert.False(t, ok, "RestoredImport of an ID that was not restored")
+
+	// Releasing the import releases the restored export.
+	pp.Release()
+	require.Eventually(t, func() bool {
+		snap, err := server.Snapshot(save)
+		return err == nil && len(snap.Exports) == 0
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestRestoreConnLoadFails(t *testing.T) {
+	t.Parallel()
+
+	snap := &rpc.Snapshot{Exports: []rpc.ExportSnapshot{{ID: 0, Refs: 1, Ref: []byte("gone")}}}
+	p1, p2 := net.Pipe()
+	defer p1.Close()
+	defer p2.Close()
+	_, err := rpc.RestoreConn(transport.NewStream(p1), nil, snap, func([]byte) (capnp.Client, error) {
+		return capnp.Client{}, errors.New("no such capability")
+	})
+	assert.Error(t, err)
+
+	snap.Exports = append(snap.Exports, snap.Exports[0])
+	_, err = rpc.RestoreConn(transport.NewStream(p1), nil, snap, nil)
+	assert.Error(t, err, "export ID appears twice")
+}
+
+// checkEcho checks that pp echoes n.
+func checkEcho(ctx context.Context, t *testing.T, pp testcp.PingPong, n int64) {
+	f, release := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(n)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, n, res.N())
+}
@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// methodNames looks up the names of methods in the default schema
+// registry.  The zero value is ready to use.
+type methodNames struct {
+	mu    sync.Mutex
+	nodes nodemap.Map
+	cache map[methodKey]capnp.Method
+}
+
+type methodKey struct {
+	interfaceID uint64
+	methodID    uint16
+}
+
+// resolve fills in the names of m from the default registry, if they
+// are missing.
+func (mn *methodNames) resolve(m capnp.Method) capnp.Method {
+	if m.InterfaceName != "" || m == (capnp.Method{}) {
+		return m
+	}
+	mn.mu.Lock()
+	defer mn.mu.Unlock()
+	k := methodKey{m.InterfaceID, m.MethodID}
+	if named, ok := mn.cache[k]; ok {
+		return named
+	}
+	named := m
+	if n, err := mn.nodes.Find(m.InterfaceID); err == nil && n.Which() == schema.Node_Which_interface {
+		named.InterfaceName, _ = n.DisplayName()
+		if methods, err := n.Interface().Methods(); err == nil && int(m.MethodID) < methods.Len() {
+			named.MethodName, _ = methods.At(int(m.MethodID)).Name()
+		}
+	}
+	if named.MethodName == "" {
+		// Don't cache misses: the IDs may come from a peer's calls, and
+		// caching them would let the peer grow the cache without bound.
+		// Hits are bounded by the methods in the registry.
+		return named
+	}
+	if mn.cache == nil {
+		mn.cache = make(map[methodKey]capnp.Method)
+	}
+	mn.cache[k] = named
+	return named
+}
@@ -0,0 +1,35 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/schemas"
+)
+
+func TestMethodNamesCache(t *testing.T) {
+	t.Parallel()
+
+	reg := new(schemas.Registry)
+	testcapnp.RegisterSchema(reg)
+	var mn methodNames
+	mn.nodes.UseRegistry(reg)
+
+	m := mn.resolve(capnp.Method{InterfaceID: testcapnp.PingPong_TypeID, MethodID: 0})
+	assert.Equal(t, "test.capnp:PingPong", m.InterfaceName)
+	assert.Equal(t, "echoNum", m.MethodName)
+
+	// Unknown interfaces and methods, as a peer may send, are not
+	// cached.
+	for i := uint64(1); i <= 100; i++ {
+		m := mn.resolve(capnp.Method{InterfaceID: i, MethodID: 0})
+		assert.Empty(t, m.InterfaceName)
+	}
+	m = mn.resolve(capnp.Method{InterfaceID: testcapnp.PingPong_TypeID, MethodID: 99})
+	assert.Equal(t, "test.capnp:PingPong", m.InterfaceName)
+	assert.Empty(t, m.MethodName)
+	assert.Len(t, mn.cache, 1)
+}
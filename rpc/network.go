@@ -6,8 +6,9 @@ import (
 	capnp "capnproto.org/go/capnp/v3"
 )
 
-// A PeerID identifies a peer on a Cap'n Proto network. The exact
-// format of this is network specific.
+// A PeerID identifies a peer on a Cap'n Proto network; it is what the
+// RPC protocol calls a VatId. The exact format of this is network
+// specific. PeerIDs used with a Router must have a comparable Value.
 type PeerID struct {
 	// Network specific value identifying the peer.
 	Value any
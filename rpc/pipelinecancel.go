@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// A PipelineCancelPolicy determines what happens to calls that were
+// pipelined on the results of a call when that call is canceled
+// before it returns.
+type PipelineCancelPolicy int
+
+const (
+	// PipelineCancelIndependent leaves pipelined calls running until
+	// they return or their own Contexts are done.  Canceling a call
+	// sends a Finish message for that call only; the remote vat may
+	// still fail the pipelined calls, since the capability that they
+	// target is never returned.  This is the default.
+	PipelineCancelIndependent PipelineCancelPolicy = iota
+
+	// PipelineCancelCascade cancels the calls pipelined on a call when
+	// it is canceled before it returns, sending a Finish message for
+	// each of them and rejecting them with the same error.  Calls that
+	// are pipelined on those calls are canceled in turn if their own
+	// policy is also PipelineCancelCascade.  Once a call has returned,
+	// the calls pipelined on it are independent of it.
+	PipelineCancelCascade
+)
+
+// String returns the name of p.
+func (p PipelineCancelPolicy) String() string {
+	switch p {
+	case PipelineCancelIndependent:
+		return "independent"
+	case PipelineCancelCascade:
+		return "cascade"
+	default:
+		return "PipelineCancelPolicy(" + str.Itod(int(p)) + ")"
+	}
+}
+
+type pipelineCancelKey struct{}
+
+// WithPipelineCancel returns a copy of ctx that overrides the
+// connection's PipelineCancel option for calls made with it.  The
+// policy applies to the calls pipelined on the results of such a call,
+// not to the call itself.
+func WithPipelineCancel(ctx context.Context, p PipelineCancelPolicy) context.Context {
+	return context.WithValue(ctx, pipelineCancelKey{}, p)
+}
+
+// pipelineCancel returns the policy for the calls pipelined on a call
+// made with ctx.
+func (c *Conn) pipelineCancel(ctx context.Context) PipelineCancelPolicy {
+	if p, ok := ctx.Value(pipelineCancelKey{}).(PipelineCancelPolicy); ok {
+		return p
+	}
+	return c.pipelineCancelDefault
+}
@@ -0,0 +1,117 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// stubbornProvider returns a PingPong once release is closed, even if
+// the call is canceled first.
+type stubbornProvider struct {
+	started chan<- struct{}
+	release <-chan struct{}
+}
+
+func (p stubbornProvider) PingPong(ctx context.Context, call testcapnp.PingPongProvider_pingPong) error {
+	p.started <- struct{}{}
+	<-p.release
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetPingPong(testcapnp.PingPong_ServerToClient(pingPonger{}))
+}
+
+func TestPipelineCancel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		opt     rpc.PipelineCancelPolicy
+		call    func(context.Context) context.Context
+		cascade bool
+	}{
+		{name: "Default"},
+		{name: "Option", opt: rpc.PipelineCancelCascade, cascade: true},
+		{
+			name: "OverrideCascade",
+			call: func(ctx context.Context) context.Context {
+				return rpc.WithPipelineCancel(ctx, rpc.PipelineCancelCascade)
+			},
+			cascade: true,
+		},
+		{
+			name: "OverrideIndependent",
+			opt:  rpc.PipelineCancelCascade,
+			call: func(ctx context.Context) context.Context {
+				return rpc.WithPipelineCancel(ctx, rpc.PipelineCancelIndependent)
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			started := make(chan struct{}, 1)
+			release := make(chan struct{})
+			serverNetConn, clientNetConn := net.Pipe()
+			serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+				BootstrapClient: capnp.Client(testcapnp.PingPongProvider_ServerToClient(stubbornProvider{started, release})),
+			})
+			defer serverConn.Close()
+			clientConn := rpc.NewConn(transport.NewStream(clientNetConn), &rpc.Options{
+				PipelineCancel: tt.opt,
+			})
+			defer clientConn.Close()
+			provider := testcapnp.PingPongProvider(clientConn.Bootstrap(context.Background()))
+			defer provider.Release()
+			require.NoError(t, provider.Resolve(context.Background()))
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			callCtx := ctx
+			if tt.call != nil {
+				callCtx = tt.call(ctx)
+			}
+			parent, releaseParent := provider.PingPong(callCtx, nil)
+			defer releaseParent()
+			child, releaseChild := parent.PingPong().EchoNum(context.Background(), func(p testcapnp.PingPong_echoNum_Params) error {
+				p.SetN(42)
+				return nil
+			})
+			defer releaseChild()
+			<-started
+
+			cancel()
+			_, err := parent.Struct()
+			require.ErrorIs(t, err, context.Canceled)
+
+			if tt.cascade {
+				_, err := child.Struct()
+				assert.ErrorIs(t, err, context.Canceled, "pipelined call not canceled with parent")
+				close(release)
+				return
+			}
+			select {
+			case <-child.Done():
+				t.Fatal("pipelined call canceled with parent")
+			case <-time.After(50 * time.Millisecond):
+			}
+			close(release)
+			res, err := child.Struct()
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), res.N())
+		})
+	}
+}
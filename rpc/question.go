@@ -12,12 +12,28 @@ import (
 type questionID uint32
 
 type question struct {
-	c  *Conn
-	id questionID
+	c      *Conn
+	id     questionID
+	method capnp.Method
 
 	p       *capnp.Promise
 	release capnp.ReleaseFunc // written before resolving p
 
+	// parent is the question whose results this question's call was
+	// pipelined on, or nil.
+	parent *question
+
+	// canceled is closed after cancelErr is set when the question is
+	// canceled before it returns.  It is nil unless the calls
+	// pipelined on this question are canceled with it.
+	canceled  chan struct{}
+	cancelErr error
+
+	// returned is closed when the Return message for the question is
+	// received, or when the question is dropped because its message
+	// could not be sent.  See Conn.WaitAcknowledged.
+	returned chan struct{}
+
 	// Protected by c.mu:
 
 	flags         questionFlags
@@ -47,13 +63,19 @@ func (flags questionFlags) Contains(flag questionFlags) bool {
 	return flags&flag != 0
 }
 
-// newQuestion adds a new question to c's table.
-func (c *lockedConn) newQuestion(method capnp.Method) *question {
+// newQuestion adds a new question to c's table.  ctx is the Context
+// of the call.
+func (c *lockedConn) newQuestion(ctx context.Context, method capnp.Method) *question {
 	q := &question{
 		c:             (*Conn)(c),
 		id:            c.lk.questionID.next(),
+		method:        method,
 		release:       func() {},
 		finishMsgSend: make(chan struct{}),
+		returned:      make(chan struct{}),
+	}
+	if (*Conn)(c).pipelineCancel(ctx) == PipelineCancelCascade {
+		q.canceled = make(chan struct{})
 	}
 	q.p = capnp.NewPromise(method, q, nil) // TODO(someday): customize error message for bootstrap
 	c.setAnswerQuestion(q.p.Answer(), q)
@@ -88,14 +110,21 @@ type questionKey struct {
 }
 
 // handleCancel rejects the question's promise upon cancelation of its
-// Context.
+// Context, or of its parent if the parent's policy is
+// PipelineCancelCascade.
 //
 // The caller MUST NOT hold q.c.lk.
 func (q *question) handleCancel(ctx context.Context) {
+	var parentCanceled <-chan struct{} // nil blocks forever
+	if q.parent != nil {
+		parentCanceled = q.parent.canceled
+	}
 	var rejectErr error
 	select {
 	case <-ctx.Done():
 		rejectErr = ctx.Err()
+	case <-parentCanceled:
+		rejectErr = q.parent.cancelErr
 	case <-q.c.bgctx.Done():
 		rejectErr = ExcClosed
 	case <-q.p.Answer().Done():
@@ -109,6 +138,10 @@ func (q *question) handleCancel(ctx context.Context) {
 		}
 		q.flags |= finished
 		q.release = func() {}
+		if q.canceled != nil {
+			q.cancelErr = rejectErr
+			close(q.canceled)
+		}
 
 		c.sendMessage(c.bgctx, func(m rpccp.Message) error {
 			fin, err := m.NewFinish()
@@ -117,12 +150,15 @@ func (q *question) handleCancel(ctx context.Context) {
 			}
 			fin.SetQuestionId(uint32(q.id))
 			fin.SetReleaseResultCaps(true)
+			// Let the remote vat cancel the call even if it has not
+			// been delivered yet, rather than deliver it first.
+			fin.SetRequireEarlyCancellationWorkaround(false)
 			return nil
 		}, func(err error) {
 			if err == nil {
 				syncutil.With(&q.c.lk, func() { q.flags |= finishSent })
 			} else if q.c.bgctx.Err() == nil {
-				q.c.er.ReportError(rpcerr.Annotate(err, "send finish"))
+				q.c.er.ReportError(rpcerr.Annotate(err, "send finish"), "questionID", q.id)
 			}
 			close(q.finishMsgSend)
 			q.p.Reject(rejectErr)
@@ -136,6 +172,9 @@ func (q *question) PipelineSend(ctx context.Context, transform []capnp.PipelineO
 			return capnp.ErrorAnswer(s.Method, ExcClosed), func() {}
 		}
 		defer c.tasks.Done()
+		if c.lk.draining {
+			return capnp.ErrorAnswer(s.Method, rpcerr.Disconnected(ErrConnDraining)), func() {}
+		}
 
 		// Mark this transform as having been used for a call ASAP.
 		// q's Return could be received while q2 is being sent.
@@ -144,7 +183,8 @@ func (q *question) PipelineSend(ctx context.Context, transform []capnp.PipelineO
 		// b) the transform isn't guaranteed to be an import, and
 		// c) the worst that happens is we trade bandwidth for code simplicity.
 		q.mark(transform)
-		q2 := c.newQuestion(s.Method)
+		q2 := c.newQuestion(ctx, s.Method)
+		q2.parent = q
 
 		// Send call message.
 		c.sendMessage(ctx, func(m rpccp.Message) error {
@@ -153,7 +193,9 @@ func (q *question) PipelineSend(ctx context.Context, transform []capnp.PipelineO
 			if err != nil {
 				syncutil.With(&q.c.lk, func() {
 					q.c.lk.questions[q2.id] = nil
+					c.checkDrained()
 				})
+				close(q2.returned)
 				q2.p.Reject(rpcerr.WrapFailed("send message", err))
 				syncutil.With(&q.c.lk, func() {
 					q.c.lk.questionID.remove(q2.id)
@@ -248,7 +290,7 @@ func (q *question) PipelineRecv(ctx context.Context, transform []capnp.PipelineO
 		returnAnswer(r.Returner, ans, finish)
 		return nil
 	default:
-		go returnAnswer(r.Returner, ans, finish)
+		q.c.returnAnswerAsync(r.Returner, ans, finish)
 		return ans
 	}
 }
@@ -0,0 +1,269 @@
+// Package retry provides backoff policies for retrying operations that
+// fail with transient Cap'n Proto exceptions, such as re-dialing a
+// dropped connection or re-issuing a call to an overloaded vat.
+//
+// The zero value of Policy is usable, and retries disconnected and
+// overloaded exceptions indefinitely with exponential backoff and
+// jitter, until the context passed to Do is canceled.
+//
+// Policy.Send applies a policy to calls of methods annotated with
+// $Go.idempotent.  Setting rpc.Options.Retry applies it to every call
+// made on the capabilities imported over a connection.
+package retry
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/exp/clock"
+)
+
+// Default backoff parameters, used when the corresponding Backoff
+// field is zero.
+const (
+	DefaultInitial    = 100 * time.Millisecond
+	DefaultMax        = 30 * time.Second
+	DefaultMultiplier = 2.0
+	DefaultJitter     = 0.2
+)
+
+// Backoff computes the delay between successive attempts of an
+// operation.  The delay before retry n (starting at zero) is
+// Initial * Multiplier^n, capped at Max, and then randomly perturbed
+// by up to ±Jitter of its value.
+type Backoff struct {
+	// Initial is the delay before the first retry.
+	Initial time.Duration
+
+	// Max is the upper bound on the delay before jitter is applied.
+	Max time.Duration
+
+	// Multiplier is the factor by which the delay grows after each
+	// attempt.  Values less than 1 are treated as 1.
+	Multiplier float64
+
+	// Jitter is the fraction of the delay to randomize, in the
+	// range [0, 1].  A negative value disables jitter.
+	Jitter float64
+}
+
+// Delay returns the delay before retry n.  rnd must return a value in
+// the range [0, 1); if rnd is nil, math/rand is used.
+func (b Backoff) Delay(n int, rnd func() float64) time.Duration {
+	initial := b.Initial
+	if initial <= 0 {
+		initial = DefaultInitial
+	}
+	max := b.Max
+	if max <= 0 {
+		max = DefaultMax
+	}
+	mult := b.Multiplier
+	if mult == 0 {
+		mult = DefaultMultiplier
+	} else if mult < 1 {
+		mult = 1
+	}
+	jitter := b.Jitter
+	if jitter == 0 {
+		jitter = DefaultJitter
+	} else if jitter < 0 {
+		jitter = 0
+	} else if jitter > 1 {
+		jitter = 1
+	}
+
+	d := float64(initial) * math.Pow(mult, float64(n))
+	if d > float64(max) || math.IsInf(d, 0) || math.IsNaN(d) {
+		d = float64(max)
+	}
+	if jitter > 0 {
+		if rnd == nil {
+			rnd = rand.Float64
+		}
+		d += d * jitter * (2*rnd() - 1)
+	}
+	if d < 0 {
+		return 0
+	}
+	return time.Duration(d)
+}
+
+// A Policy describes when and how often to retry an operation.
+type Policy struct {
+	Backoff
+
+	// MaxAttempts is the maximum number of times the operation is
+	// run, including the first attempt.  Zero means no limit.
+	MaxAttempts int
+
+	// Budget bounds the total time spent retrying, measured from the
+	// start of the first attempt.  No retry is scheduled if its delay
+	// would exceed the budget.  Zero means no limit.
+	Budget time.Duration
+
+	// Retryable reports whether an error returned by the operation
+	// should be retried.  If nil, IsTransient is used.
+	Retryable func(error) bool
+
+	// Clock is used to wait between attempts.  If nil, the system
+	// clock is used.
+	Clock clock.Clock
+
+	// Rand returns random values in [0, 1) for jitter.  If nil,
+	// math/rand is used.
+	Rand func() float64
+}
+
+// Do calls f until it returns nil or a non-retryable error, the
+// policy's attempt limit or budget is exhausted, or ctx is canceled.
+// Do returns the error from the last attempt, or ctx.Err() if the
+// context was canceled while waiting to retry.
+func (p *Policy) Do(ctx context.Context, f func(context.Context) error) error {
+	clk := p.Clock
+	if clk == nil {
+		clk = clock.System
+	}
+	retryable := p.Retryable
+	if retryable == nil {
+		retryable = IsTransient
+	}
+
+	start := clk.Now()
+	for n := 0; ; n++ {
+		err := f(ctx)
+		if err == nil || !retryable(err) {
+			return err
+		}
+		if p.MaxAttempts > 0 && n+1 >= p.MaxAttempts {
+			return err
+		}
+
+		d := p.Delay(n, p.Rand)
+		if p.Budget > 0 && clk.Now().Add(d).Sub(start) > p.Budget {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		t := clk.NewTimer(d)
+		select {
+		case <-t.Chan():
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// IsTransient reports whether err is a disconnected or overloaded
+// exception.  These are the exception types that the Cap'n Proto
+// protocol designates as safe to retry, possibly after reconnecting.
+func IsTransient(err error) bool {
+	return exc.IsType(err, exc.Disconnected) || exc.IsType(err, exc.Overloaded)
+}
+
+// On returns a function suitable for Policy.Retryable that reports
+// whether an error is an exception of one of the given types.
+func On(types ...exc.Type) func(error) bool {
+	return func(err error) bool {
+		for _, t := range types {
+			if exc.IsType(err, t) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// SendFunc sends a call, like capnp.Client.SendCall.
+type SendFunc func(context.Context, capnp.Send) (*capnp.Answer, capnp.ReleaseFunc)
+
+// Send sends s with send, retrying according to the policy if s is
+// idempotent and the call fails with a retryable error.  Calls that
+// are not idempotent are passed to send unchanged.
+//
+// The arguments are placed once and copied into each attempt.  If s has
+// a SetTimeout function, it is called for each attempt, so that the
+// callee is given the time left until ctx's deadline rather than the
+// time that was left when the arguments were placed.  The returned
+// answer resolves to the results of the first successful
+// attempt, or to the error of the last one.  Calls pipelined on the
+// answer are queued until then.  Releasing the answer stops retrying.
+func (p *Policy) Send(ctx context.Context, s capnp.Send, send SendFunc) (*capnp.Answer, capnp.ReleaseFunc) {
+	if !s.Idempotent {
+		return send(ctx, s)
+	}
+
+	args, err := placeArgs(s)
+	if err != nil {
+		return capnp.ErrorAnswer(s.Method, err), func() {}
+	}
+
+	aq := capnp.NewAnswerQueue(s.Method)
+	promise := capnp.NewPromise(s.Method, aq, aq)
+	var release capnp.ReleaseFunc // of the successful attempt
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer args.Message().Release()
+
+		var results capnp.Struct
+		err := p.Do(ctx, func(ctx context.Context) error {
+			fwd := s
+			fwd.PlaceArgs = func(dst capnp.Struct) error {
+				if err := dst.CopyFrom(args); err != nil {
+					return err
+				}
+				if s.SetTimeout != nil {
+					s.SetTimeout(ctx, dst)
+				}
+				return nil
+			}
+			ans, rel := send(ctx, fwd)
+			r, err := ans.Struct()
+			if err != nil {
+				rel()
+				return err
+			}
+			results, release = r, rel
+			return nil
+		})
+		promise.Resolve(results.ToPtr(), err)
+	}()
+	return promise.Answer(), func() {
+		cancel()
+		<-done
+		promise.ReleaseClients()
+		if release != nil {
+			release()
+		}
+	}
+}
+
+// placeArgs returns a struct in a new message filled in by s.PlaceArgs.
+func placeArgs(s capnp.Send) (capnp.Struct, error) {
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return capnp.Struct{}, exc.WrapError("retry: place arguments", err)
+	}
+	args, err := capnp.NewRootStruct(seg, s.ArgsSize)
+	if err != nil {
+		seg.Message().Release()
+		return capnp.Struct{}, exc.WrapError("retry: place arguments", err)
+	}
+	if s.PlaceArgs == nil {
+		return args, nil
+	}
+	if err := s.PlaceArgs(args); err != nil {
+		seg.Message().Release()
+		return capnp.Struct{}, exc.WrapError("retry: place arguments", err)
+	}
+	return args, nil
+}
@@ -0,0 +1,261 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	t.Parallel()
+
+	b := Backoff{
+		Initial:    10 * time.Millisecond,
+		Max:        100 * time.Millisecond,
+		Multiplier: 2,
+		Jitter:     -1,
+	}
+	assert.Equal(t, 10*time.Millisecond, b.Delay(0, nil))
+	assert.Equal(t, 20*time.Millisecond, b.Delay(1, nil))
+	assert.Equal(t, 80*time.Millisecond, b.Delay(3, nil))
+	assert.Equal(t, 100*time.Millisecond, b.Delay(4, nil), "delay should be capped at Max")
+	assert.Equal(t, 100*time.Millisecond, b.Delay(5000, nil), "huge attempt counts should not overflow")
+
+	b.Jitter = 0.5
+	assert.Equal(t, 5*time.Millisecond, b.Delay(0, func() float64 { return 0 }))
+	assert.Equal(t, 10*time.Millisecond, b.Delay(0, func() float64 { return 0.5 }))
+	assert.Equal(t, 15*time.Millisecond, b.Delay(0, func() float64 { return 1 }))
+
+	assert.Equal(t, DefaultInitial, Backoff{Jitter: -1}.Delay(0, nil), "zero value should use defaults")
+}
+
+func TestPolicyDo(t *testing.T) {
+	t.Parallel()
+
+	fast := Backoff{Initial: time.Microsecond, Max: time.Millisecond}
+
+	t.Run("SucceedsAfterTransient", func(t *testing.T) {
+		t.Parallel()
+
+		n := 0
+		p := &Policy{Backoff: fast}
+		err := p.Do(context.Background(), func(context.Context) error {
+			n++
+			if n < 3 {
+				return exc.New(exc.Disconnected, "test", "dropped")
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("PermanentError", func(t *testing.T) {
+		t.Parallel()
+
+		n := 0
+		p := &Policy{Backoff: fast}
+		err := p.Do(context.Background(), func(context.Context) error {
+			n++
+			return exc.New(exc.Failed, "test", "broken")
+		})
+		assert.True(t, exc.IsType(err, exc.Failed))
+		assert.Equal(t, 1, n, "failed exceptions should not be retried")
+	})
+
+	t.Run("MaxAttempts", func(t *testing.T) {
+		t.Parallel()
+
+		n := 0
+		p := &Policy{Backoff: fast, MaxAttempts: 4}
+		err := p.Do(context.Background(), func(context.Context) error {
+			n++
+			return exc.New(exc.Overloaded, "test", "busy")
+		})
+		assert.True(t, exc.IsType(err, exc.Overloaded))
+		assert.Equal(t, 4, n)
+	})
+
+	t.Run("Budget", func(t *testing.T) {
+		t.Parallel()
+
+		n := 0
+		p := &Policy{
+			Backoff: Backoff{Initial: time.Hour, Max: time.Hour},
+			Budget:  time.Minute,
+		}
+		err := p.Do(context.Background(), func(context.Context) error {
+			n++
+			return exc.New(exc.Disconnected, "test", "dropped")
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 1, n, "retry exceeding the budget should not be attempted")
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		p := &Policy{Backoff: Backoff{Initial: time.Hour, Max: time.Hour}}
+		err := p.Do(ctx, func(context.Context) error {
+			cancel()
+			return exc.New(exc.Disconnected, "test", "dropped")
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Retryable", func(t *testing.T) {
+		t.Parallel()
+
+		errFlaky := errors.New("flaky")
+		n := 0
+		p := &Policy{
+			Backoff:   fast,
+			Retryable: func(err error) bool { return errors.Is(err, errFlaky) },
+		}
+		err := p.Do(context.Background(), func(context.Context) error {
+			n++
+			if n < 2 {
+				return errFlaky
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+}
+
+func TestPolicySend(t *testing.T) {
+	t.Parallel()
+
+	p := &Policy{Backoff: Backoff{Initial: time.Microsecond, Max: time.Millisecond}}
+	s := capnp.Send{
+		ArgsSize: capnp.ObjectSize{DataSize: 8},
+		PlaceArgs: func(args capnp.Struct) error {
+			args.SetUint64(0, 42)
+			return nil
+		},
+		Idempotent: true,
+	}
+	var attempts []uint64
+	send := func(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+		args, err := placeArgs(s)
+		if err != nil {
+			return capnp.ErrorAnswer(s.Method, err), func() {}
+		}
+		attempts = append(attempts, args.Uint64(0))
+		args.Message().Release()
+		if len(attempts) < 3 {
+			return capnp.ErrorAnswer(s.Method, exc.New(exc.Overloaded, "test", "busy")), func() {}
+		}
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		res, _ := capnp.NewRootStruct(seg, capnp.ObjectSize{DataSize: 8})
+		res.SetUint64(0, 7)
+		return capnp.ImmediateAnswer(s.Method, res.ToPtr()), func() {}
+	}
+
+	ans, release := p.Send(context.Background(), s, send)
+	res, err := ans.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), res.Uint64(0))
+	release()
+	assert.Equal(t, []uint64{42, 42, 42}, attempts, "arguments should be copied into each attempt")
+
+	attempts = nil
+	s.Idempotent = false
+	ans, release = p.Send(context.Background(), s, send)
+	_, err = ans.Struct()
+	release()
+	assert.True(t, exc.IsType(err, exc.Overloaded))
+	assert.Len(t, attempts, 1, "calls that are not idempotent should not be retried")
+}
+
+func TestPolicySendTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	// The timeout is set again for each attempt, while the rest of the
+	// arguments are copied.
+	p := &Policy{Backoff: Backoff{Initial: time.Microsecond, Max: time.Millisecond}}
+	var timeouts uint64
+	s := capnp.Send{
+		ArgsSize: capnp.ObjectSize{DataSize: 16},
+		PlaceArgs: func(args capnp.Struct) error {
+			args.SetUint64(8, 42)
+			return nil
+		},
+		SetTimeout: func(ctx context.Context, args capnp.Struct) {
+			if d, ok := ctx.Deadline(); !ok || !d.Equal(deadline) {
+				t.Errorf("SetTimeout called with deadline %v; want %v", d, deadline)
+			}
+			timeouts++
+			args.SetUint64(0, timeouts)
+		},
+		Idempotent: true,
+	}
+	var attempts [][2]uint64
+	send := func(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+		args, err := placeArgs(s)
+		if err != nil {
+			return capnp.ErrorAnswer(s.Method, err), func() {}
+		}
+		attempts = append(attempts, [2]uint64{args.Uint64(0), args.Uint64(8)})
+		args.Message().Release()
+		if len(attempts) < 3 {
+			return capnp.ErrorAnswer(s.Method, exc.New(exc.Overloaded, "test", "busy")), func() {}
+		}
+		return capnp.ImmediateAnswer(s.Method, capnp.Ptr{}), func() {}
+	}
+
+	ans, release := p.Send(ctx, s, send)
+	_, err := ans.Struct()
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, [][2]uint64{{1, 42}, {2, 42}, {3, 42}}, attempts)
+}
+
+func TestPolicySendRelease(t *testing.T) {
+	t.Parallel()
+
+	// The zero Policy retries until the context is done, so releasing
+	// the answer must stop it.
+	var p Policy
+	s := capnp.Send{Idempotent: true}
+	send := func(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
+		return capnp.ErrorAnswer(s.Method, exc.New(exc.Disconnected, "test", "down")), func() {}
+	}
+	ans, release := p.Send(context.Background(), s, send)
+
+	released := make(chan struct{})
+	go func() {
+		defer close(released)
+		release()
+	}()
+	select {
+	case <-released:
+	case <-time.After(5 * time.Second):
+		t.Fatal("release did not stop retrying")
+	}
+	_, err := ans.Struct()
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestOn(t *testing.T) {
+	t.Parallel()
+
+	f := On(exc.Failed, exc.Unimplemented)
+	assert.True(t, f(exc.New(exc.Failed, "", "x")))
+	assert.True(t, f(exc.WrapError("wrapped", exc.New(exc.Unimplemented, "", "x"))))
+	assert.False(t, f(exc.New(exc.Disconnected, "", "x")))
+	assert.False(t, f(errors.New("plain")))
+}
@@ -0,0 +1,91 @@
+package rpc_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/retry"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// flakyPingServer fails calls with an overloaded exception until it
+// has been called failures times.
+type flakyPingServer struct {
+	calls    *int32
+	failures int32
+}
+
+func (s flakyPingServer) EchoNum(ctx context.Context, call testcapnp.PingPong_echoNum) error {
+	if atomic.AddInt32(s.calls, 1) <= s.failures {
+		return exc.New(exc.Overloaded, "", "busy")
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	res.SetN(call.Args().N())
+	return nil
+}
+
+func TestRetryIdempotent(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	p1, p2 := transport.NewPipe(1)
+	srv := testcapnp.PingPong_ServerToClient(flakyPingServer{calls: &calls, failures: 2})
+	conn1 := rpc.NewConn(rpc.NewTransport(p1), &rpc.Options{
+		BootstrapClient: capnp.Client(srv),
+	})
+	defer conn1.Close()
+	conn2 := rpc.NewConn(rpc.NewTransport(p2), &rpc.Options{
+		Retry: &retry.Policy{
+			Backoff:     retry.Backoff{Initial: time.Millisecond, Max: 10 * time.Millisecond},
+			MaxAttempts: 5,
+		},
+	})
+	defer conn2.Close()
+
+	ctx := context.Background()
+	client := testcapnp.PingPong(conn2.Bootstrap(ctx))
+	defer client.Release()
+	require.NoError(t, client.Resolve(ctx))
+
+	echo := func(idempotent bool) (int64, error) {
+		ans, release := capnp.Client(client).SendCall(ctx, capnp.Send{
+			Method: capnp.Method{
+				InterfaceID: testcapnp.PingPong_TypeID,
+				MethodID:    0,
+			},
+			ArgsSize: capnp.ObjectSize{DataSize: 8},
+			PlaceArgs: func(s capnp.Struct) error {
+				testcapnp.PingPong_echoNum_Params(s).SetN(42)
+				return nil
+			},
+			Idempotent: idempotent,
+		})
+		defer release()
+		res, err := ans.Struct()
+		if err != nil {
+			return 0, err
+		}
+		return testcapnp.PingPong_echoNum_Results(res).N(), nil
+	}
+
+	_, err := echo(false)
+	assert.True(t, exc.IsType(err, exc.Overloaded), "calls that are not idempotent should fail")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	n, err := echo(true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), n)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls), "idempotent call should be retried once")
+}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// A Router is a routing table of connections to other vats, keyed by
+// their PeerIDs.  It lets a framework address vats by identity while
+// supplying its own way of reaching them: Conn returns the connection
+// to a vat, dialing it on first use, and Accept adds connections that
+// other vats established.
+//
+// The connections are ordinary two-party connections, with their
+// RemotePeerID set but no Network: a capability that one vat passes to
+// another through this vat is proxied by this vat, not handed off as
+// it would be in a level 3 network.
+//
+// The zero value is an empty table that cannot dial.  The Dial and
+// ConnOptions fields must not be changed after the Router is first
+// used.
+type Router struct {
+	// Dial establishes a transport to the vat with the given ID.  It
+	// is called by Conn for vats that the table has no connection to.
+	// If nil, Conn only returns existing connections.
+	Dial func(ctx context.Context, id PeerID) (Transport, error)
+
+	// ConnOptions returns the options for a new connection to the vat
+	// with the given ID, whether dialed or accepted.  It is called for
+	// each connection, so that each gets its own BootstrapClient.  The
+	// returned options' RemotePeerID is overwritten.  If ConnOptions
+	// is nil, connections are created with the default options.
+	ConnOptions func(id PeerID) *Options
+
+	mu      sync.Mutex
+	closed  bool
+	conns   map[PeerID]*Conn
+	pending map[PeerID]*pendingDial
+}
+
+// pendingDial is a dial in progress, which concurrent callers of
+// Router.Conn for the same vat wait on.
+type pendingDial struct {
+	done chan struct{}
+	conn *Conn
+	err  error
+}
+
+// Conn returns the connection to the vat with the given ID, dialing it
+// if the table does not have an open connection to it.  Concurrent
+// calls for the same vat share a single dial.  If the Router has no
+// Dial function, Conn fails with ErrNoRoute for vats without a
+// connection.  The PeerID's Value must be comparable.
+func (r *Router) Conn(ctx context.Context, id PeerID) (*Conn, error) {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil, rpcerr.Disconnected(ErrRouterClosed)
+	}
+	if c := r.conns[id]; c != nil {
+		r.mu.Unlock()
+		return c, nil
+	}
+	if r.Dial == nil {
+		r.mu.Unlock()
+		return nil, rpcerr.Disconnected(ErrNoRoute)
+	}
+	pd := r.pending[id]
+	if pd == nil {
+		pd = &pendingDial{done: make(chan struct{})}
+		if r.pending == nil {
+			r.pending = make(map[PeerID]*pendingDial)
+		}
+		r.pending[id] = pd
+		r.mu.Unlock()
+		go r.dial(id, pd)
+	} else {
+		r.mu.Unlock()
+	}
+
+	select {
+	case <-pd.done:
+		return pd.conn, pd.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// dial dials the vat with the given ID and completes pd.  The dial is
+// not bound to the context of any one caller, since other callers may
+// be waiting on it.
+func (r *Router) dial(id PeerID, pd *pendingDial) {
+	t, err := r.Dial(context.Background(), id)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer close(pd.done)
+	delete(r.pending, id)
+	switch {
+	case err != nil:
+		pd.err = rpcerr.WrapDisconnected("router: dial", err)
+	case r.closed:
+		t.Close()
+		pd.err = rpcerr.Disconnected(ErrRouterClosed)
+	default:
+		pd.conn = r.addLocked(id, t)
+	}
+}
+
+// Accept adds a connection over t, established by the vat with the
+// given ID, to the table and returns it.  The caller is responsible
+// for having authenticated the vat's identity.  If the table already
+// has a connection to the vat, the new connection replaces it in the
+// table, but the old connection remains open.
+func (r *Router) Accept(id PeerID, t Transport) (*Conn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		t.Close()
+		return nil, rpcerr.Disconnected(ErrRouterClosed)
+	}
+	return r.addLocked(id, t), nil
+}
+
+func (r *Router) addLocked(id PeerID, t Transport) *Conn {
+	var opts Options
+	if r.ConnOptions != nil {
+		if o := r.ConnOptions(id); o != nil {
+			opts = *o
+		}
+	}
+	opts.RemotePeerID = id
+	c := NewConn(t, &opts)
+	if r.conns == nil {
+		r.conns = make(map[PeerID]*Conn)
+	}
+	r.conns[id] = c
+	go func() {
+		<-c.Done()
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.conns[id] == c {
+			delete(r.conns, id)
+		}
+	}()
+	return c
+}
+
+// Lookup returns the table's connection to the vat with the given ID,
+// without dialing it.
+func (r *Router) Lookup(id PeerID) (*Conn, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.conns[id]
+	return c, c != nil
+}
+
+// Bootstrap returns the bootstrap capability of the vat with the given
+// ID, dialing it if necessary.  The caller is responsible for releasing
+// the returned client.
+func (r *Router) Bootstrap(ctx context.Context, id PeerID) capnp.Client {
+	c, err := r.Conn(ctx, id)
+	if err != nil {
+		return capnp.ErrorClient(err)
+	}
+	return c.Bootstrap(ctx)
+}
+
+// Close closes all the connections in the table.  Subsequent calls to
+// Conn and Accept fail with ErrRouterClosed.
+func (r *Router) Close() error {
+	r.mu.Lock()
+	r.closed = true
+	conns := make([]*Conn, 0, len(r.conns))
+	for _, c := range r.conns {
+		conns = append(conns, c)
+	}
+	r.conns = nil
+	r.mu.Unlock()
+
+	var firstErr error
+	for _, c := range conns {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
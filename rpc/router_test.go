@@ -0,0 +1,136 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/rpc"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// routerPair returns a router for the vat "a" that dials the vat "b",
+// whose router accepts the connections and serves a PingPong.
+func routerPair(t *testing.T) (a, b *rpc.Router, dials *int32) {
+	b = &rpc.Router{
+		ConnOptions: func(rpc.PeerID) *rpc.Options {
+			return &rpc.Options{
+				BootstrapClient: capnp.Client(testcp.PingPong_ServerToClient(pingPongServer{})),
+			}
+		},
+	}
+	dials = new(int32)
+	a = &rpc.Router{
+		Dial: func(ctx context.Context, id rpc.PeerID) (rpc.Transport, error) {
+			atomic.AddInt32(dials, 1)
+			if id.Value != "b" {
+				return nil, errors.New("unknown vat")
+			}
+			p1, p2 := transport.NewPipe(1)
+			if _, err := b.Accept(rpc.PeerID{Value: "a"}, rpc.NewTransport(p2)); err != nil {
+				return nil, err
+			}
+			return rpc.NewTransport(p1), nil
+		},
+	}
+	t.Cleanup(func() {
+		a.Close()
+		b.Close()
+	})
+	return a, b, dials
+}
+
+func TestRouter(t *testing.T) {
+	t.Parallel()
+
+	a, b, dials := routerPair(t)
+	ctx := context.Background()
+	idB := rpc.PeerID{Value: "b"}
+
+	_, ok := a.Lookup(idB)
+	assert.False(t, ok, "no connection before the first call")
+
+	// Concurrent callers share a single dial.
+	var wg sync.WaitGroup
+	conns := make([]*rpc.Conn, 4)
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c, err := a.Conn(ctx, idB)
+			assert.NoError(t, err)
+			conns[i] = c
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(dials))
+	for _, c := range conns {
+		assert.Same(t, conns[0], c)
+	}
+	assert.Equal(t, idB, conns[0].RemotePeerID())
+	c, ok := a.Lookup(idB)
+	assert.True(t, ok)
+	assert.Same(t, conns[0], c)
+
+	back, ok := b.Lookup(rpc.PeerID{Value: "a"})
+	require.True(t, ok, "accepted connection is in the remote table")
+	assert.Equal(t, rpc.PeerID{Value: "a"}, back.RemotePeerID())
+
+	pp := testcp.PingPong(a.Bootstrap(ctx, idB))
+	defer pp.Release()
+	fut, rel := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(42)
+		return nil
+	})
+	defer rel()
+	res, err := fut.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), res.N())
+
+	// A closed connection leaves the table, and the next call redials.
+	require.NoError(t, conns[0].Close())
+	require.Eventually(t, func() bool {
+		_, ok := a.Lookup(idB)
+		return !ok
+	}, time.Second, time.Millisecond)
+	c, err = a.Conn(ctx, idB)
+	require.NoError(t, err)
+	assert.NotSame(t, conns[0], c)
+	assert.Equal(t, int32(2), atomic.LoadInt32(dials))
+}
+
+func TestRouterErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	a, _, _ := routerPair(t)
+	_, err := a.Conn(ctx, rpc.PeerID{Value: "c"})
+	require.Error(t, err)
+	assert.True(t, exc.IsType(err, exc.Disconnected), "dial failure is a disconnection: %v", err)
+
+	var table rpc.Router
+	_, err = table.Conn(ctx, rpc.PeerID{Value: "b"})
+	assert.ErrorIs(t, err, rpc.ErrNoRoute)
+
+	require.NoError(t, a.Close())
+	_, err = a.Conn(ctx, rpc.PeerID{Value: "b"})
+	assert.ErrorIs(t, err, rpc.ErrRouterClosed)
+	p1, _ := transport.NewPipe(1)
+	_, err = a.Accept(rpc.PeerID{Value: "b"}, rpc.NewTransport(p1))
+	assert.ErrorIs(t, err, rpc.ErrRouterClosed)
+	pp := testcp.PingPong(a.Bootstrap(ctx, rpc.PeerID{Value: "b"}))
+	defer pp.Release()
+	fut, rel := pp.EchoNum(ctx, nil)
+	defer rel()
+	_, err = fut.Struct()
+	assert.ErrorIs(t, err, rpc.ErrRouterClosed)
+}
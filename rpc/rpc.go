@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -15,7 +16,9 @@ import (
 	"capnproto.org/go/capnp/v3/exp/spsc"
 	"capnproto.org/go/capnp/v3/internal/str"
 	"capnproto.org/go/capnp/v3/internal/syncutil"
+	"capnproto.org/go/capnp/v3/rpc/retry"
 	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/server"
 	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
 	"capnproto.org/go/capnp/v3/util"
 	"capnproto.org/go/capnp/v3/util/deferred"
@@ -88,6 +91,28 @@ type Conn struct {
 	bootstrap    capnp.Client
 	er           errReporter
 	abortTimeout time.Duration
+	sizes        *sizeObserver // nil if sizes are not observed
+	names        methodNames
+	retry        *retry.Policy // nil if calls are not retried
+
+	pipelineCancelDefault PipelineCancelPolicy
+
+	// Coalescing of outgoing messages; see Options.
+	coalesceLatency time.Duration
+	coalesceMax     int
+
+	executor func(func()) // nil to run callbacks on new goroutines
+
+	maxAnswers int // zero if the number of running calls is not limited
+
+	// id identifies the connection in server.Peer.
+	id uint64
+
+	// peer describes the remote vat to the methods that the connection
+	// calls.  It is set when the first call arrives, since transports
+	// such as TLS only know about the peer after the handshake.
+	peerOnce sync.Once
+	peer     *server.Peer
 
 	// bgctx is a Context that is canceled when shutdown starts. Note
 	// that it's parent is context.Background(), so we can rely on this
@@ -118,7 +143,14 @@ type Conn struct {
 		// here to be sent by a dedicated goroutine.
 		sendTx *spsc.Tx[asyncSend]
 
+		// eagerBootstrap is the bootstrap client requested when the
+		// connection started, until Bootstrap takes it.  See
+		// Options.EagerBootstrap.
+		eagerBootstrap capnp.Client
+
 		closing  bool               // used to make shutdown() idempotent
+		draining bool               // set when the connection reaches its maximum age
+		drained  chan struct{}      // closed when a draining connection becomes idle; see checkDrained
 		bgcancel context.CancelFunc // bgcancel cancels bgctx.
 
 		// Tables
@@ -130,6 +162,14 @@ type Conn struct {
 		imports    map[importID]*impent
 		embargoes  []*embargo
 		embargoID  idgen[embargoID]
+
+		// restoredImports holds the clients of the imports that
+		// RestoreConn restored until RestoredImport claims them.
+		restoredImports map[importID]capnp.Client
+
+		// runningAnswers is the number of incoming calls that have
+		// been delivered and have not returned.
+		runningAnswers int
 	}
 }
 
@@ -183,7 +223,10 @@ type Options struct {
 	BootstrapClient capnp.Client
 
 	// Logger is used for logging by the RPC system, including errors that
-	// occur while the Conn is receiving messages from the remote vat.
+	// occur while the Conn is receiving messages from the remote vat,
+	// aborts, and failures to send returns, finishes and releases.
+	// Events are logged with key, value pairs such as "questionID" that
+	// tell which part of the protocol they concern.
 	Logger Logger
 
 	// AbortTimeout specifies how long to block on sending an abort message
@@ -197,29 +240,141 @@ type Options struct {
 	// Application code should not set this.
 	RemotePeerID PeerID
 
+	// HandshakeTimeout bounds how long ServeTLS waits for a client to
+	// complete the TLS handshake before closing the connection.  If
+	// zero, a reasonably short timeout is used.  NewConn ignores it.
+	HandshakeTimeout time.Duration
+
 	// A reference to the Network that this connection is a part of.  Can be
 	// left nil for point to point connections. Otherwise, this must be set
 	// by Dial or Accept on the Network itself; application code should not
 	// set this.
 	Network Network
-}
 
-// Logger is used for logging by the RPC system. Each method logs
-// messages at a different level, but otherwise has the same semantics:
-//
-//   - Message is a human-readable description of the log event.
-//   - Args is a sequenece of key, value pairs, where the keys must be strings
-//     and the values may be any type.
-//   - The methods may not block for long periods of time.
-//
-// This interface is designed such that it is satisfied by *slog.Logger.
-type Logger interface {
-	Debug(message string, args ...any)
-	Info(message string, args ...any)
-	Warn(message string, args ...any)
-	Error(message string, args ...any)
+	// MaxAge is the maximum lifetime of the connection.  Once the
+	// connection has been open for MaxAge, it starts draining: OnMaxAge
+	// is called, new calls in either direction fail with
+	// ErrConnDraining, and the connection is closed as soon as the
+	// calls that were already outstanding have finished.
+	// This is useful in environments that rotate credentials or
+	// certificates, where connections must periodically re-handshake.
+	// If zero, the connection is not closed because of its age.
+	MaxAge time.Duration
+
+	// MaxAgeGrace bounds how long a draining connection waits for
+	// outstanding calls to finish before it is closed anyway.  If zero,
+	// it waits indefinitely.
+	MaxAgeGrace time.Duration
+
+	// OnMaxAge is called in its own goroutine when the connection starts
+	// draining, typically to dial a replacement connection.
+	OnMaxAge func(*Conn)
+
+	// ObserveMessageSize is called with the size of every Call and
+	// Return message sent or received on the connection, so that
+	// operators can record size distributions per method.  It is called
+	// from the connection's send and receive goroutines, so it must not
+	// block or call methods on the Conn.
+	ObserveMessageSize func(MessageSize)
+
+	// Retry is the policy for retrying calls to idempotent methods
+	// (those annotated with $Go.idempotent) on capabilities imported
+	// from the remote vat, when they fail with an error that the
+	// policy considers retryable.  Calls are no longer retried once
+	// the connection starts shutting down.  Calls made on promised
+	// capabilities before they resolve are not retried.  If nil,
+	// calls are never retried.
+	Retry *retry.Policy
+
+	// PipelineCancel is the policy for calls pipelined on the results of
+	// a call that is canceled before it returns.  It can be overridden
+	// for individual calls with WithPipelineCancel.  The zero value is
+	// PipelineCancelIndependent.
+	PipelineCancel PipelineCancelPolicy
+
+	// CoalesceLatency is how long the connection may hold an outgoing
+	// message to send it together with messages that are queued after
+	// it, like Nagle's algorithm.  Messages that are already queued
+	// when the connection starts sending are always sent together, in
+	// a single write if the transport is a transport.BatchTransport.
+	// If zero, messages are not held.
+	CoalesceLatency time.Duration
+
+	// CoalesceMaxMessages is the maximum number of messages that are
+	// sent together.  If zero, a reasonable default is used.
+	CoalesceMaxMessages int
+
+	// EagerBootstrap determines whether the connection requests the
+	// remote vat's bootstrap capability as soon as it starts.  The
+	// first call to Bootstrap returns the eagerly requested client
+	// instead of sending another request.  The zero value is
+	// EagerBootstrapNever.
+	EagerBootstrap EagerBootstrapPolicy
+
+	// Prefetch, if not nil, is called with a borrowed reference to the
+	// eagerly requested bootstrap client before NewConn returns, so
+	// that calls pipelined on it, such as restoring sturdy refs that
+	// the application will need, are sent in the same first flight of
+	// messages.  It must not block.  Prefetch is not called if the
+	// bootstrap capability is not requested eagerly.
+	Prefetch func(bootstrap capnp.Client)
+
+	// Executor, if not nil, is called to run the callbacks that
+	// deliver the results of returned calls: resolving answers and the
+	// calls pipelined on them, and returning forwarded calls to their
+	// callers.  It lets applications with a strict threading model,
+	// such as an event loop or a bounded pool, control where those
+	// callbacks run.  Executor must eventually run every function it
+	// is given, and should not block the caller for long, since it is
+	// called from the connection's receive loop.  If nil, each
+	// callback runs on a new goroutine.
+	Executor func(f func())
+
+	// MaxConcurrentAnswers bounds the number of incoming calls that the
+	// connection runs at once, to protect the local vat from peers that
+	// send more calls than it can handle.  A call that arrives while
+	// that many are running fails with an Overloaded exception, which
+	// the caller may retry later.  Calls count as running until they
+	// return, including calls that are queued by the capability's
+	// server.  If zero, the number is not limited.
+	MaxConcurrentAnswers int
+
+	// KeepaliveInterval is how often the connection pings the remote
+	// vat, so that it notices promptly when the peer goes away without
+	// closing the connection, as happens when a TCP connection is
+	// dropped.  See Conn.Ping.  If zero, the connection doesn't ping.
+	KeepaliveInterval time.Duration
+
+	// KeepaliveTimeout is how long the connection waits for the reply
+	// to a ping.  If the reply doesn't arrive in time, OnDeadPeer is
+	// called and the connection is closed.  If zero, KeepaliveInterval
+	// is used.
+	KeepaliveTimeout time.Duration
+
+	// ObserveRoundTrip is called with the round-trip time of each ping
+	// that the remote vat answers.  It is called from the goroutine
+	// that sends the pings, so it must not block for long.
+	ObserveRoundTrip func(time.Duration)
+
+	// OnDeadPeer is called in its own goroutine when the remote vat
+	// doesn't answer a ping in time, just before the connection is
+	// closed.
+	OnDeadPeer func(*Conn)
+
+	// Bootstraps are capabilities that the connection serves by name.
+	// If not empty, the bootstrap capability returned to the remote
+	// peer is a gateway.Gateway that restores them by name, and
+	// BootstrapClient, if set, is served under the empty name.  The
+	// remote peer gets them with Conn.BootstrapNamed.  NewConn steals
+	// the references, like BootstrapClient.
+	Bootstraps map[string]capnp.Client
 }
 
+// Logger is used for logging by the RPC system.  It is the same type
+// as capnp.Logger, so that a single logger can be given to connections
+// and servers.
+type Logger = capnp.Logger
+
 // NewConn creates a new connection that communicates on a given transport.
 //
 // Closing the connection will close the transport and release the bootstrap
@@ -230,9 +385,17 @@ type Logger interface {
 // Once a connection is created, it will immediately start receiving
 // requests from the transport.
 func NewConn(t Transport, opts *Options) *Conn {
+	return newConn(t, opts, nil)
+}
+
+// newConn creates a connection as NewConn does.  If init is not nil, it
+// is called with the connection's tables before the connection starts
+// receiving messages.
+func newConn(t Transport, opts *Options, init func(*lockedConn)) *Conn {
 	c := &Conn{
 		transport: t,
 		closed:    make(chan struct{}),
+		id:        connIDs.Add(1),
 	}
 
 	sender := spsc.New[asyncSend]()
@@ -243,17 +406,36 @@ func NewConn(t Transport, opts *Options) *Conn {
 	c.lk.imports = make(map[importID]*impent)
 
 	if opts != nil {
-		c.bootstrap = opts.BootstrapClient
+		c.bootstrap = bootstrapClient(opts)
 		c.er = errReporter{opts.Logger}
 		c.abortTimeout = opts.AbortTimeout
 		c.network = opts.Network
 		c.remotePeerID = opts.RemotePeerID
+		c.sizes = newSizeObserver(opts.ObserveMessageSize, &c.names)
+		c.pipelineCancelDefault = opts.PipelineCancel
+		c.executor = opts.Executor
+		c.maxAnswers = opts.MaxConcurrentAnswers
 	}
 	if c.abortTimeout == 0 {
 		c.abortTimeout = 100 * time.Millisecond
 	}
+	c.coalesceLatency, c.coalesceMax = coalesceOptions(opts)
+	if init != nil {
+		c.withLocked(init)
+	}
 
 	c.startBackgroundTasks()
+	c.startEagerBootstrap(opts)
+
+	if opts != nil && opts.Retry != nil {
+		c.retry = c.connRetryPolicy(opts.Retry)
+	}
+	if opts != nil && opts.MaxAge > 0 {
+		go c.enforceMaxAge(opts.MaxAge, opts.MaxAgeGrace, opts.OnMaxAge)
+	}
+	if opts != nil && opts.KeepaliveInterval > 0 {
+		go c.keepalive(opts.KeepaliveInterval, opts.KeepaliveTimeout, opts.ObserveRoundTrip, opts.OnDeadPeer)
+	}
 
 	return c
 }
@@ -261,7 +443,9 @@ func NewConn(t Transport, opts *Options) *Conn {
 func (c *Conn) startBackgroundTasks() {
 	// We use an errgroup to link the lifetime of background tasks
 	// to each other.
-	ctx, cancel := context.WithCancel(context.Background())
+	// The context carries the Conn so that the methods that it calls
+	// can find it with ConnFromContext.
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), connContextKey{}, c))
 	g, ctx := errgroup.WithContext(ctx)
 
 	c.bgctx = ctx
@@ -304,11 +488,25 @@ func (c *Conn) backgroundTask(f func() error) func() error {
 
 // Return the peer ID for the remote side of the connection. Returns
 // the zero value if this connection was set up with NewConn instead
-// of via a Network.
+// of via a Network or Router.
 func (c *Conn) RemotePeerID() PeerID {
 	return c.remotePeerID
 }
 
+type connContextKey struct{}
+
+// ConnFromContext returns the connection that delivered the call whose
+// context is ctx, so that server methods can find out which peer they
+// are serving, for example with RemotePeerID.  The context of a call
+// that a method makes on a local capability carries the same
+// connection, since the call is made on the remote peer's behalf.
+// ConnFromContext returns false for calls that didn't arrive over a
+// connection.
+func ConnFromContext(ctx context.Context) (*Conn, bool) {
+	c, ok := ctx.Value(connContextKey{}).(*Conn)
+	return c, ok
+}
+
 // Bootstrap returns the remote vat's bootstrap interface.  This creates
 // a new client that the caller is responsible for releasing.
 func (c *Conn) Bootstrap(ctx context.Context) (bc capnp.Client) {
@@ -319,8 +517,15 @@ func (c *Conn) Bootstrap(ctx context.Context) (bc capnp.Client) {
 			return capnp.ErrorClient(rpcerr.Disconnected(errors.New("connection closed")))
 		}
 		defer c.tasks.Done()
+		if c.lk.draining {
+			return capnp.ErrorClient(rpcerr.Disconnected(ErrConnDraining))
+		}
+
+		if eager, ok := c.takeEagerBootstrap(); ok {
+			return eager
+		}
 
-		q := c.newQuestion(capnp.Method{})
+		q := c.newQuestion(ctx, capnp.Method{})
 		bc = q.p.Answer().Client().AddRef()
 		go func() {
 			q.p.ReleaseClients()
@@ -338,7 +543,9 @@ func (c *Conn) Bootstrap(ctx context.Context) (bc capnp.Client) {
 			if err != nil {
 				syncutil.With(&c.lk, func() {
 					c.lk.questions[q.id] = nil
+					c.checkDrained()
 				})
+				close(q.returned)
 				q.p.Reject(exc.Annotate("rpc", "bootstrap", err))
 				syncutil.With(&c.lk, func() {
 					c.lk.questionID.remove(q.id)
@@ -446,7 +653,9 @@ func (c *lockedConn) release(dq *deferred.Queue) {
 	embargoes := c.lk.embargoes
 	answers := c.lk.answers
 	questions := c.lk.questions
+	restoredImports := c.lk.restoredImports
 	c.lk.imports = nil
+	c.lk.restoredImports = nil
 	c.lk.exports = nil
 	c.lk.embargoes = nil
 	c.lk.questions = nil
@@ -457,12 +666,16 @@ func (c *lockedConn) release(dq *deferred.Queue) {
 	c.liftEmbargoes(dq, embargoes)
 	c.releaseAnswers(dq, answers)
 	c.releaseQuestions(dq, questions)
-
+	for _, client := range restoredImports {
+		dq.Defer(client.Release)
+	}
 }
 
 func (c *lockedConn) releaseBootstrap(dq *deferred.Queue) {
 	dq.Defer(c.bootstrap.Release)
 	c.bootstrap = capnp.Client{}
+	dq.Defer(c.lk.eagerBootstrap.Release)
+	c.lk.eagerBootstrap = capnp.Client{}
 }
 
 func (c *lockedConn) releaseExports(dq *deferred.Queue, exports []*expent) {
@@ -516,36 +729,47 @@ func (c *lockedConn) releaseQuestions(dq *deferred.Queue, questions []*question)
 	}
 }
 
-// If abortErr != nil, send abort message.  IO and alloc errors are ignored.
+// If abortErr != nil, send abort message.  Errors sending it are only
+// logged at the debug level, since the transport is often already
+// broken.
 // Called by 'shutdown'.  Callers MUST NOT hold c.lk.
 func (c *Conn) abort(abortErr error) {
-	// send abort message?
-	if abortErr != nil {
+	if abortErr == nil {
+		return
+	}
+	err := func() error {
 		outMsg, err := c.transport.NewMessage()
 		if err != nil {
-			return
+			return err
 		}
 		defer outMsg.Release()
 
-		// configure & send abort message
-		if abort, err := outMsg.Message().NewAbort(); err == nil {
-			abort.SetType(rpccp.Exception_Type(exc.TypeOf(abortErr)))
-			if err = abort.SetReason(abortErr.Error()); err == nil {
-				outMsg.Send()
-			}
+		abort, err := outMsg.Message().NewAbort()
+		if err != nil {
+			return err
+		}
+		abort.SetType(rpccp.Exception_Type(exc.TypeOf(abortErr)))
+		if err := abort.SetReason(abortErr.Error()); err != nil {
+			return err
 		}
+		return outMsg.Send()
+	}()
+	if err != nil {
+		c.er.Debug("failed to send abort", "reason", abortErr.Error(), "error", err)
 	}
 }
 
 func (c *Conn) send(ctx context.Context) func() error {
 	return c.backgroundTask(func() error {
+		var group []asyncSend
 		for {
 			async, err := c.sendRx.Recv(ctx)
 			if err != nil {
 				return err
 			}
 
-			async.Send()
+			group = c.coalesce(ctx, append(group[:0], async))
+			c.sendGroup(group)
 		}
 	})
 }
@@ -711,7 +935,7 @@ func (c *Conn) handleBootstrap(in transport.IncomingMessage) error {
 		},
 	}
 
-	ans.returner.ret, ans.sendMsg, ans.returner.msgReleaser, err = c.newReturn()
+	ans.returner.ret, ans.sendMsg, ans.returner.msgReleaser, err = c.newReturn(capnp.Method{})
 	if err == nil {
 		ans.returner.ret.SetAnswerId(uint32(ans.returner.id))
 		ans.returner.ret.SetReleaseParamCaps(false)
@@ -732,6 +956,10 @@ func (c *Conn) handleBootstrap(in transport.IncomingMessage) error {
 		}
 
 		c.lk.answers[ans.returner.id] = &ans
+		if c.lk.draining {
+			ans.sendException(dq, rpcerr.Disconnected(ErrConnDraining))
+			return
+		}
 		if !c.bootstrap.IsValid() {
 			ans.sendException(dq, exc.New(exc.Failed, "", "vat does not expose a public/bootstrap interface"))
 			return
@@ -758,6 +986,9 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 		c.er.ReportError(exc.WrapError("read call", err))
 		return nil
 	}
+	if c.sizes != nil {
+		c.sizes.call(in.Message(), false)
+	}
 
 	dq := &deferred.Queue{}
 	defer dq.Run()
@@ -811,7 +1042,7 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 	}
 
 	// Create return message.
-	ret, send, retReleaser, err := c.newReturn()
+	ret, send, retReleaser, err := c.newReturn(p.method)
 	if err != nil {
 		err = rpcerr.Annotate(err, "incoming call")
 		syncutil.With(&c.lk, func() {
@@ -833,6 +1064,7 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 			msgReleaser: retReleaser,
 		},
 		sendMsg: send,
+		method:  p.method,
 	}
 	return withLockedConn1(c, func(c *lockedConn) error {
 		c.lk.answers[id] = ans
@@ -846,6 +1078,17 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 			return nil
 		}
 
+		if c.answerLimitReached() {
+			ans.sendException(dq, rpcerr.New(exc.Overloaded, ErrTooManyCalls))
+			dq.Defer(in.Release)
+			return nil
+		}
+		if c.lk.draining {
+			ans.sendException(dq, rpcerr.Disconnected(ErrConnDraining))
+			dq.Defer(in.Release)
+			return nil
+		}
+
 		recv := capnp.Recv{
 			Args:        p.args,
 			Method:      p.method,
@@ -866,9 +1109,9 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 				})
 				return rpcerr.Failed(errors.New("incoming call: unknown export ID " + str.Utod(id)))
 			}
-			c.tasks.Add(1) // will be finished by answer.Return
+			c.startAnswer() // will be finished by answer.Return
 			var callCtx context.Context
-			callCtx, ans.cancel = context.WithCancel(c.bgctx)
+			callCtx, ans.cancel = c.newCallContext()
 			pcall := newPromisedPipelineCaller()
 			ans.setPipelineCaller(p.method, pcall)
 			dq.Defer(func() {
@@ -928,9 +1171,9 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 					}
 				}
 
-				c.tasks.Add(1) // will be finished by answer.Return
+				c.startAnswer() // will be finished by answer.Return
 				var callCtx context.Context
-				callCtx, ans.cancel = context.WithCancel(c.bgctx)
+				callCtx, ans.cancel = c.newCallContext()
 				pcall := newPromisedPipelineCaller()
 				ans.setPipelineCaller(p.method, pcall)
 				dq.Defer(func() {
@@ -941,9 +1184,9 @@ func (c *Conn) handleCall(ctx context.Context, in transport.IncomingMessage) err
 				// Results not ready, use pipeline caller.
 				tgtAns.pcalls.Add(1) // will be finished by answer.Return
 				var callCtx context.Context
-				callCtx, ans.cancel = context.WithCancel(c.bgctx)
+				callCtx, ans.cancel = c.newCallContext()
 				tgt := tgtAns.pcall
-				c.tasks.Add(1) // will be finished by answer.Return
+				c.startAnswer() // will be finished by answer.Return
 				pcall := newPromisedPipelineCaller()
 				ans.setPipelineCaller(p.method, pcall)
 				dq.Defer(func() {
@@ -1039,6 +1282,29 @@ func (c *lockedConn) parseCall(dq *deferred.Queue, p *parsedCall, call rpccp.Cal
 	return nil
 }
 
+// newCallContext returns the context for an incoming call.
+func (c *lockedConn) newCallContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(server.WithPeer(c.bgctx, (*Conn)(c).serverPeer()))
+}
+
+// connIDs is the source of Conn IDs.
+var connIDs atomic.Uint64
+
+// serverPeer returns the description of the remote vat that is passed
+// to the methods that the connection calls.
+func (c *Conn) serverPeer() *server.Peer {
+	c.peerOnce.Do(func() {
+		c.peer = &server.Peer{ConnID: c.id}
+		if pt, ok := c.transport.(transport.PeerTransport); ok {
+			c.peer.RemoteAddr = pt.RemoteAddr()
+			if state, ok := pt.TLSConnectionState(); ok {
+				c.peer.TLS = &state
+			}
+		}
+	})
+	return c.peer
+}
+
 func parseMessageTarget(pt *parsedMessageTarget, tgt rpccp.MessageTarget) error {
 	switch pt.which = tgt.Which(); pt.which {
 	case rpccp.MessageTarget_Which_importedCap:
@@ -1112,12 +1378,20 @@ func (c *Conn) handleReturn(ctx context.Context, in transport.IncomingMessage) e
 		// only time the remote vat will use it.
 		q := c.lk.questions[qid]
 		c.lk.questions[qid] = nil
+		c.checkDrained()
 		if q == nil {
 			dq.Defer(in.Release)
 			return rpcerr.Failed(errors.New(
 				"incoming return: question " + str.Utod(qid) + " does not exist",
 			))
 		}
+		close(q.returned)
+		if c.sizes != nil {
+			m, size := q.method, messageSize(in.Message())
+			dq.Defer(func() {
+				unlockedConn.sizes.ret(m, size, false)
+			})
+		}
 		canceled := q.flags.Contains(finished)
 		q.flags |= finished
 		if canceled {
@@ -1155,9 +1429,10 @@ func (c *Conn) handleReturn(ctx context.Context, in transport.IncomingMessage) e
 			// an error), so we save the ReleaseFunc for later:
 			q.release = in.Release
 		}
-		// We're going to potentially block fulfilling some promises so fork
-		// off a goroutine to avoid blocking the receive loop.
-		go func() {
+		// We're going to potentially block fulfilling some promises so hand
+		// off to the executor to avoid blocking the receive loop.  This is
+		// deferred so that the executor is never called with c.lk held.
+		deliver := func() {
 			c := unlockedConn
 			q.p.Resolve(pr.result, pr.err)
 			if pr.err != nil {
@@ -1202,7 +1477,8 @@ func (c *Conn) handleReturn(ctx context.Context, in transport.IncomingMessage) e
 					}
 				})
 			})
-		}()
+		}
+		dq.Defer(func() { unlockedConn.execute(deliver) })
 
 		return nil
 	})
@@ -1253,11 +1529,10 @@ func (c *lockedConn) parseReturn(dq *deferred.Queue, ret rpccp.Return, called []
 		if err != nil {
 			return parsedReturn{err: rpcerr.WrapFailed("parse return", err), parseFailed: true}
 		}
-		reason, err := e.Reason()
-		if err != nil {
+		if _, err := e.Reason(); err != nil {
 			return parsedReturn{err: rpcerr.WrapFailed("parse return", err), parseFailed: true}
 		}
-		return parsedReturn{err: exc.New(exc.Type(e.Type()), "", reason)}
+		return parsedReturn{err: e.ToError()}
 	case rpccp.Return_Which_acceptFromThirdParty:
 		// TODO: 3PH. Can wait until after the MVP, because we can keep
 		// setting allowThirdPartyTailCall = false
@@ -1870,12 +2145,14 @@ func (c *Conn) handleUnknownMessageType(ctx context.Context, in transport.Incomi
 
 	c.withLocked(func(c *lockedConn) {
 		c.sendMessage(ctx, func(m rpccp.Message) error {
-			defer in.Release()
 			if err := m.SetUnimplemented(in.Message()); err != nil {
 				return rpcerr.Annotate(err, "send unimplemented")
 			}
 			return nil
-		}, nil)
+		}, func(error) {
+			// Called even if the message could not be created.
+			in.Release()
+		})
 	})
 }
 
@@ -1901,38 +2178,54 @@ func (c *lockedConn) startTask() (ok bool) {
 // onSent will be called without holding c.lk.  Callers of
 // sendMessage MAY wish to reacquire the c.lk within the onSent.
 func (c *lockedConn) sendMessage(ctx context.Context, build func(rpccp.Message) error, onSent func(error)) {
-	outMsg, err := c.transport.NewMessage()
-	send := outMsg.Send
-	release := outMsg.Release
-
-	// If errors happen when allocating or building the message, set up dummy send/release
-	// functions so the error handling logic in onSent() runs as normal:
-	if err != nil {
-		release = func() {}
-		send = func() error {
-			return rpcerr.WrapFailed("create message", err)
-		}
-	} else if err = build(outMsg.Message()); err != nil {
-		send = func() error {
-			return rpcerr.WrapFailed("build message", err)
-		}
-	}
-
-	oldSend := send
-	send = func() error {
-		if ctx.Err() != nil {
-			return ctx.Err()
-		}
-		return oldSend()
-	}
-
+	qm, release := c.newQueuedMessage(ctx, build, onSent)
 	c.lk.sendTx.Send(asyncSend{
+		msgs:    []queuedMessage{qm},
 		release: release,
-		send:    send,
-		onSent:  onSent,
 	})
 }
 
+// newQueuedMessage creates a new message on the transport and calls
+// build to populate its fields, like sendMessage, but returns it and
+// the function that releases it instead of enqueuing it.
+func (c *lockedConn) newQueuedMessage(ctx context.Context, build func(rpccp.Message) error, onSent func(error)) (queuedMessage, capnp.ReleaseFunc) {
+	outMsg, err := c.transport.NewMessage()
+
+	// If errors happen when allocating or building the message, set up a dummy
+	// check function so the error handling logic in onSent() runs as normal:
+	if err != nil {
+		return queuedMessage{
+			check: func() error {
+				return rpcerr.WrapFailed("create message", err)
+			},
+			onSent: onSent,
+		}, func() {}
+	}
+	if err = build(outMsg.Message()); err != nil {
+		return queuedMessage{
+			check: func() error {
+				return rpcerr.WrapFailed("build message", err)
+			},
+			onSent: onSent,
+		}, outMsg.Release
+	}
+
+	sizes := c.sizes
+	return queuedMessage{
+		msg: outMsg,
+		check: func() error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if sizes != nil {
+				sizes.call(outMsg.Message(), true)
+			}
+			return nil
+		},
+		onSent: onSent,
+	}, outMsg.Release
+}
+
 // reader reads messages from the transport in a loop, and send them down the
 // 'in' channel, until the context is canceled or an error occurs. The first
 // time RecvMessage() returns an error, its results will still be sent on the
@@ -1957,28 +2250,83 @@ type incomingMessage struct {
 	err error
 }
 
+// An asyncSend is a group of messages on the outbound queue, which the
+// send goroutine sends together.
 type asyncSend struct {
-	send    func() error
-	onSent  func(error)
-	release capnp.ReleaseFunc
+	msgs    []queuedMessage
+	release capnp.ReleaseFunc // releases msgs after they are sent
+}
+
+// A queuedMessage is a message in an asyncSend.
+type queuedMessage struct {
+	msg transport.OutgoingMessage // nil if check always fails
+
+	// check is called by the send goroutine just before sending msg.
+	// If it returns an error, msg is not sent and onSent is called
+	// with the error.  check may be nil.
+	check func() error
+
+	onSent func(error) // may be nil
 }
 
 func (as asyncSend) Abort(err error) {
 	defer as.release()
 
-	if as.onSent != nil {
-		as.onSent(rpcerr.Disconnected(err))
+	for _, qm := range as.msgs {
+		if qm.onSent != nil {
+			qm.onSent(rpcerr.Disconnected(err))
+		}
 	}
 }
 
-func (as asyncSend) Send() {
-	defer as.release()
+// sendGroup sends the messages in group, gathering them into a single
+// call to SendBatch if the transport is a transport.BatchTransport,
+// and then releases them.
+func (c *Conn) sendGroup(group []asyncSend) {
+	defer func() {
+		for _, as := range group {
+			as.release()
+		}
+	}()
 
-	if err := as.send(); as.onSent != nil {
+	var qms []*queuedMessage
+	for i := range group {
+		for j := range group[i].msgs {
+			qms = append(qms, &group[i].msgs[j])
+		}
+	}
+	errs := make([]error, len(qms))
+	var msgs []transport.OutgoingMessage
+	var sent []int // indices in qms of msgs
+	for i, qm := range qms {
+		if qm.check != nil {
+			if errs[i] = qm.check(); errs[i] != nil {
+				continue
+			}
+		}
+		msgs = append(msgs, qm.msg)
+		sent = append(sent, i)
+	}
+	if bt, ok := c.transport.(transport.BatchTransport); ok && len(msgs) > 1 {
+		if err := bt.SendBatch(msgs); err != nil {
+			for _, i := range sent {
+				errs[i] = err
+			}
+		}
+	} else {
+		for k, msg := range msgs {
+			errs[sent[k]] = msg.Send()
+		}
+	}
+
+	for i, qm := range qms {
+		if qm.onSent == nil {
+			continue
+		}
+		err := errs[i]
 		if err != nil {
 			err = rpcerr.WrapFailed("send message", err)
 		}
-
-		as.onSent(err)
+		qm.onSent(err)
 	}
 }
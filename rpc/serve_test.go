@@ -6,10 +6,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/rpc"
 	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/server"
 )
 
 // Test connect/disconnect to a pingpong capability
@@ -128,3 +130,49 @@ func TestListenAndServe(t *testing.T) {
 	err = <-errChannel // Will hang if server does not return.
 	assert.ErrorIs(t, err, net.ErrClosed)
 }
+
+// peerPingPong sends the peer of each call to peers.
+type peerPingPong struct {
+	peers chan *server.Peer
+}
+
+func (pp peerPingPong) EchoNum(ctx context.Context, call testcp.PingPong_echoNum) error {
+	p, _ := call.Peer()
+	pp.peers <- p
+	return nil
+}
+
+func TestServePeer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	peers := make(chan *server.Peer, 1)
+	go rpc.Serve(lis, capnp.Client(testcp.PingPong_ServerToClient(peerPingPong{peers: peers})))
+
+	call := func() (*server.Peer, net.Addr) {
+		conn, err := net.Dial("tcp", lis.Addr().String())
+		require.NoError(t, err)
+		rpcConn := rpc.NewConn(rpc.NewStreamTransport(conn), nil)
+		defer rpcConn.Close()
+
+		pp := testcp.PingPong(rpcConn.Bootstrap(ctx))
+		defer pp.Release()
+		fut, rel := pp.EchoNum(ctx, nil)
+		defer rel()
+		_, err = fut.Struct()
+		require.NoError(t, err)
+		return <-peers, conn.LocalAddr()
+	}
+
+	p1, addr1 := call()
+	require.NotNil(t, p1, "calls from a connection should have a peer")
+	assert.Equal(t, addr1.String(), p1.RemoteAddr.String())
+	assert.Nil(t, p1.TLS)
+
+	p2, _ := call()
+	require.NotNil(t, p2)
+	assert.NotEqual(t, p1.ConnID, p2.ConnID, "connections should have different IDs")
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"capnproto.org/go/capnp/v3"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// A MessageSize describes the size of a Call or Return message that
+// was sent or received on a Conn.
+type MessageSize struct {
+	// Method is the method that was called.  If the schema for the
+	// interface is registered in schemas.DefaultRegistry, the interface
+	// and method names are filled in.  Returns to a Bootstrap message
+	// have a zero Method.
+	Method capnp.Method
+
+	// Return is true for Return messages and false for Call messages.
+	Return bool
+
+	// Outbound is true if the message was sent, false if it was
+	// received.
+	Outbound bool
+
+	// Size is the size of the message in bytes, as returned by
+	// capnp.Message.TotalSize.
+	Size uint64
+}
+
+// sizeObserver reports message sizes to Options.ObserveMessageSize.
+type sizeObserver struct {
+	observe func(MessageSize)
+	names   *methodNames
+}
+
+func newSizeObserver(observe func(MessageSize), names *methodNames) *sizeObserver {
+	if observe == nil {
+		return nil
+	}
+	return &sizeObserver{observe: observe, names: names}
+}
+
+// call reports the size of msg if it is a Call message.
+func (so *sizeObserver) call(msg rpccp.Message, outbound bool) {
+	if msg.Which() != rpccp.Message_Which_call {
+		return
+	}
+	call, err := msg.Call()
+	if err != nil {
+		return
+	}
+	so.observe(MessageSize{
+		Method: so.names.resolve(capnp.Method{
+			InterfaceID: call.InterfaceId(),
+			MethodID:    call.MethodId(),
+		}),
+		Outbound: outbound,
+		Size:     messageSize(msg),
+	})
+}
+
+// ret reports the size of a Return message for a call to m.
+func (so *sizeObserver) ret(m capnp.Method, size uint64, outbound bool) {
+	so.observe(MessageSize{
+		Method:   so.names.resolve(m),
+		Return:   true,
+		Outbound: outbound,
+		Size:     size,
+	})
+}
+
+// messageSize returns the size of msg in bytes.
+func messageSize(msg rpccp.Message) uint64 {
+	size, _ := msg.Message().TotalSize()
+	return size
+}
@@ -0,0 +1,83 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// sizeRecorder records the sizes of echoNum messages.
+type sizeRecorder struct {
+	mu    sync.Mutex
+	sizes []rpc.MessageSize
+}
+
+func (r *sizeRecorder) observe(s rpc.MessageSize) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s.Method.MethodName == "echoNum" {
+		r.sizes = append(r.sizes, s)
+	}
+}
+
+func (r *sizeRecorder) recorded() []rpc.MessageSize {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]rpc.MessageSize(nil), r.sizes...)
+}
+
+func TestObserveMessageSize(t *testing.T) {
+	t.Parallel()
+
+	var serverSizes, clientSizes sizeRecorder
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient:    capnp.Client(testcp.PingPong_ServerToClient(pingPongServer{})),
+		ObserveMessageSize: serverSizes.observe,
+	})
+	defer serverConn.Close()
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), &rpc.Options{
+		ObserveMessageSize: clientSizes.observe,
+	})
+	defer clientConn.Close()
+
+	ctx := context.Background()
+	client := testcp.PingPong(clientConn.Bootstrap(ctx))
+	defer client.Release()
+	fut, rel := client.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(42)
+		return nil
+	})
+	defer rel()
+	_, err := fut.Struct()
+	require.NoError(t, err)
+
+	for _, r := range []*sizeRecorder{&serverSizes, &clientSizes} {
+		assert.Eventually(t, func() bool {
+			return len(r.recorded()) == 2
+		}, 5*time.Second, time.Millisecond)
+	}
+
+	check := func(name string, sizes []rpc.MessageSize, callOutbound bool) {
+		require.Len(t, sizes, 2, name)
+		for _, s := range sizes {
+			assert.Equal(t, uint64(testcp.PingPong_TypeID), s.Method.InterfaceID, name)
+			assert.Equal(t, "test.capnp:PingPong", s.Method.InterfaceName, name)
+			assert.NotZero(t, s.Size, name)
+			assert.Equal(t, callOutbound != s.Return, s.Outbound, name)
+		}
+		assert.NotEqual(t, sizes[0].Return, sizes[1].Return, name)
+	}
+	check("server", serverSizes.recorded(), false)
+	check("client", clientSizes.recorded(), true)
+}
@@ -0,0 +1,191 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// A RestoreFunc restores a sturdy ref to a live capability over a
+// connection, typically by calling an application-defined restore
+// method on the connection's bootstrap capability.  The format of the
+// ref is application-defined; see persistent.capnp.
+type RestoreFunc func(ctx context.Context, ref capnp.Ptr) (capnp.Client, error)
+
+// An ImportCache maps sturdy refs to the capabilities that they were
+// restored to on a connection, so that restoring the same ref again
+// returns the same client instead of making another restore call and
+// creating another import.  Refs are compared by their canonical
+// encoding.
+//
+// An ImportCache is only valid for the lifetime of its connection:
+// once the connection is closed, Restore returns an error and the
+// cached clients are released.  It is safe to use from multiple
+// goroutines.
+type ImportCache struct {
+	conn    *Conn
+	restore RestoreFunc
+
+	mu      sync.Mutex
+	entries map[string]*importCacheEntry
+}
+
+type importCacheEntry struct {
+	ready  chan struct{} // closed once client or err is set
+	client capnp.Client
+	err    error
+
+	// canceled is set if the restore failed after the Context of the
+	// call that started it was done.  Other calls waiting for the
+	// entry retry the restore instead of returning err.
+	canceled bool
+}
+
+// NewImportCache returns an empty cache that restores refs that are
+// not in the cache by calling restore.  restore must return clients
+// that are imported from c.  The cache is cleared when c is done.
+func NewImportCache(c *Conn, restore RestoreFunc) *ImportCache {
+	ic := &ImportCache{
+		conn:    c,
+		restore: restore,
+		entries: make(map[string]*importCacheEntry),
+	}
+	go func() {
+		<-c.Done()
+		ic.Clear()
+	}()
+	return ic
+}
+
+// Restore returns the capability that ref refers to.  If ref has been
+// restored before on the connection, the cached client is returned;
+// otherwise ref is restored with the cache's RestoreFunc.  Concurrent
+// calls for the same ref share a single restore, and if it fails, they
+// all return its error and the ref is not cached.  If the restore
+// fails because the Context of the call that started it is done, the
+// other calls restore ref again instead.
+//
+// The caller must release the returned client.
+func (ic *ImportCache) Restore(ctx context.Context, ref capnp.Ptr) (capnp.Client, error) {
+	key, err := sturdyRefKey(ref)
+	if err != nil {
+		return capnp.Client{}, rpcerr.WrapFailed("restore", err)
+	}
+	for {
+		ic.mu.Lock()
+		select {
+		case <-ic.conn.Done():
+			// Checked with mu held, so that the entry added below is
+			// either cleared or never added.
+			ic.mu.Unlock()
+			return capnp.Client{}, ExcClosed
+		default:
+		}
+		ent := ic.entries[key]
+		if ent == nil {
+			ent = &importCacheEntry{ready: make(chan struct{})}
+			ic.entries[key] = ent
+			ic.mu.Unlock()
+			return ic.fill(ctx, key, ref, ent)
+		}
+		ic.mu.Unlock()
+
+		select {
+		case <-ent.ready:
+		case <-ctx.Done():
+			return capnp.Client{}, ctx.Err()
+		}
+		if ent.canceled && ctx.Err() == nil {
+			continue
+		}
+		if ent.err != nil {
+			return capnp.Client{}, ent.err
+		}
+		return ent.client.AddRef(), nil
+	}
+}
+
+// fill restores ref into ent, which the caller has added to the cache
+// under key.
+func (ic *ImportCache) fill(ctx context.Context, key string, ref capnp.Ptr, ent *importCacheEntry) (capnp.Client, error) {
+	ent.client, ent.err = ic.restore(ctx, ref)
+	if ent.err != nil {
+		ent.canceled = ctx.Err() != nil
+		ic.mu.Lock()
+		if ic.entries[key] == ent {
+			delete(ic.entries, key)
+		}
+		ic.mu.Unlock()
+		close(ent.ready)
+		return capnp.Client{}, ent.err
+	}
+	client := ent.client.AddRef()
+	close(ent.ready)
+	return client, nil
+}
+
+// Forget removes ref from the cache, so that the next call to Restore
+// restores it again.  This is useful if the cached capability has
+// failed, for example because the remote vat revoked it.
+func (ic *ImportCache) Forget(ref capnp.Ptr) {
+	key, err := sturdyRefKey(ref)
+	if err != nil {
+		return
+	}
+	ic.mu.Lock()
+	ent := ic.entries[key]
+	delete(ic.entries, key)
+	ic.mu.Unlock()
+	if ent != nil {
+		releaseEntry(ent)
+	}
+}
+
+// Clear removes all refs from the cache and releases the cached
+// clients.
+func (ic *ImportCache) Clear() {
+	ic.mu.Lock()
+	entries := ic.entries
+	ic.entries = make(map[string]*importCacheEntry)
+	ic.mu.Unlock()
+	for _, ent := range entries {
+		releaseEntry(ent)
+	}
+}
+
+// releaseEntry releases the cache's reference to ent's client once
+// the restore that ent is waiting for has finished.  The caller must
+// have removed ent from the cache.
+func releaseEntry(ent *importCacheEntry) {
+	select {
+	case <-ent.ready:
+		ent.client.Release()
+	default:
+		go func() {
+			<-ent.ready
+			ent.client.Release()
+		}()
+	}
+}
+
+// sturdyRefKey returns the canonical encoding of ref.
+func sturdyRefKey(ref capnp.Ptr) (string, error) {
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return "", err
+	}
+	defer seg.Message().Release()
+	s, err := capnp.NewRootStruct(seg, capnp.ObjectSize{PointerCount: 1})
+	if err != nil {
+		return "", err
+	}
+	if err := s.SetPtr(0, ref); err != nil {
+		return "", err
+	}
+	b, err := capnp.Canonicalize(s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,171 @@
+package rpc_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+)
+
+// countingEmptyProvider returns a new Empty capability from each call
+// to getEmpty.
+type countingEmptyProvider struct {
+	calls *int32
+}
+
+func (p countingEmptyProvider) GetEmpty(ctx context.Context, call testcapnp.EmptyProvider_getEmpty) error {
+	atomic.AddInt32(p.calls, 1)
+	results, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return results.SetEmpty(testcapnp.Empty_ServerToClient(struct{}{}))
+}
+
+func TestImportCache(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcapnp.EmptyProvider_ServerToClient(countingEmptyProvider{&calls})),
+	})
+	defer serverConn.Close()
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+
+	ctx := context.Background()
+	provider := testcapnp.EmptyProvider(clientConn.Bootstrap(ctx))
+	defer provider.Release()
+	cache := rpc.NewImportCache(clientConn, func(ctx context.Context, ref capnp.Ptr) (capnp.Client, error) {
+		fut, release := provider.GetEmpty(ctx, nil)
+		defer release()
+		res, err := fut.Struct()
+		if err != nil {
+			return capnp.Client{}, err
+		}
+		return capnp.Client(res.Empty()).AddRef(), nil
+	})
+	defer cache.Clear()
+
+	ref := func(s string) capnp.Ptr {
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		require.NoError(t, err)
+		txt, err := capnp.NewText(seg, s)
+		require.NoError(t, err)
+		return txt.ToPtr()
+	}
+
+	// Concurrent restores of the same ref share one call.
+	var (
+		wg      sync.WaitGroup
+		clients [8]capnp.Client
+	)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c, err := cache.Restore(ctx, ref("a"))
+			assert.NoError(t, err)
+			clients[i] = c
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, c := range clients[1:] {
+		assert.True(t, c.IsSame(clients[0]), "restores of the same ref should return the same client")
+	}
+
+	b, err := cache.Restore(ctx, ref("b"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.False(t, b.IsSame(clients[0]))
+	b.Release()
+
+	cache.Forget(ref("a"))
+	a, err := cache.Restore(ctx, ref("a"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls), "forgotten ref should be restored again")
+	a.Release()
+	for _, c := range clients {
+		c.Release()
+	}
+
+	require.NoError(t, clientConn.Close())
+	_, err = cache.Restore(ctx, ref("b"))
+	assert.Error(t, err, "restore after the connection is closed")
+}
+
+// shutdownEmpty is an Empty server that reports when it is shut down.
+type shutdownEmpty chan struct{}
+
+func (s shutdownEmpty) Shutdown() { close(s) }
+
+func TestImportCacheCanceledRestore(t *testing.T) {
+	t.Parallel()
+
+	p1, p2 := net.Pipe()
+	conn := rpc.NewConn(transport.NewStream(p1), nil)
+	defer conn.Close()
+	peer := rpc.NewConn(transport.NewStream(p2), nil)
+	defer peer.Close()
+
+	started := make(chan struct{}, 2)
+	shutdown := make(shutdownEmpty)
+	var calls int32
+	cache := rpc.NewImportCache(conn, func(ctx context.Context, ref capnp.Ptr) (capnp.Client, error) {
+		started <- struct{}{}
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// The first restore lasts until its caller gives up.
+			<-ctx.Done()
+			return capnp.Client{}, ctx.Err()
+		}
+		return capnp.Client(testcapnp.Empty_ServerToClient(shutdown)), nil
+	})
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	require.NoError(t, err)
+	txt, err := capnp.NewText(seg, "a")
+	require.NoError(t, err)
+	ref := txt.ToPtr()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := cache.Restore(ctx, ref)
+		first <- err
+	}()
+	<-started
+
+	// A second caller waits for the first restore.  When the first
+	// caller gives up, the second one restores the ref itself.
+	second := make(chan capnp.Client, 1)
+	go func() {
+		c, err := cache.Restore(context.Background(), ref)
+		assert.NoError(t, err)
+		second <- c
+	}()
+	time.Sleep(10 * time.Millisecond) // let the second caller start waiting
+	cancel()
+	assert.ErrorIs(t, <-first, context.Canceled)
+	c := <-second
+	assert.True(t, c.IsValid())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	c.Release()
+
+	// Closing the connection releases the cached client.
+	require.NoError(t, conn.Close())
+	select {
+	case <-shutdown:
+	case <-time.After(5 * time.Second):
+		t.Fatal("cached client not released when the connection closed")
+	}
+}
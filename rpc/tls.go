@@ -0,0 +1,168 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+// defaultHandshakeTimeout is the HandshakeTimeout used by ServeTLS when
+// none is given.
+const defaultHandshakeTimeout = 10 * time.Second
+
+// A TLSPeer identifies the remote end of a TLS connection.  It is the
+// Value of the RemotePeerID of connections created by DialTLS or
+// ServeTLS, and is returned by TLSPeerFromContext.
+type TLSPeer struct {
+	// Addr is the peer's network address.
+	Addr net.Addr
+
+	// State is the state of the TLS connection after the handshake.
+	State tls.ConnectionState
+}
+
+// Certificate returns the peer's verified leaf certificate, or nil if
+// the peer did not present a certificate that was verified during the
+// handshake.  Unverified certificates are never returned, so the result
+// is safe to use for authorization.
+func (p *TLSPeer) Certificate() *x509.Certificate {
+	if len(p.State.VerifiedChains) == 0 || len(p.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return p.State.VerifiedChains[0][0]
+}
+
+// TLSPeerFromContext returns the TLS identity of the peer that made the
+// call whose context is ctx.  It is derived from the call's server.Peer,
+// so it is available for every call that arrived over a TLS transport,
+// whether or not the connection was created by DialTLS or ServeTLS.  It
+// returns false if the call did not arrive over TLS.
+func TLSPeerFromContext(ctx context.Context) (*TLSPeer, bool) {
+	p, ok := server.PeerFromContext(ctx)
+	if !ok || p.TLS == nil {
+		return nil, false
+	}
+	return &TLSPeer{Addr: p.RemoteAddr, State: *p.TLS}, true
+}
+
+// A TLSBootstrapFunc chooses the bootstrap capability for a peer that
+// has completed the TLS handshake.  The connection takes ownership of
+// the returned client.  If it returns an error, the connection is
+// closed; to give the peer a reason instead, return a client created
+// with capnp.ErrorClient.
+type TLSBootstrapFunc func(peer *TLSPeer) (capnp.Client, error)
+
+// DialTLS connects to addr on the named network using TLS and returns
+// an RPC connection over it.  The handshake completes before DialTLS
+// returns, so the server's certificate has been verified as specified
+// by config.  The RemotePeerID of the returned connection holds a
+// *TLSPeer; the value in opts is ignored.
+func DialTLS(ctx context.Context, network, addr string, config *tls.Config, opts *Options) (*Conn, error) {
+	d := &tls.Dialer{Config: config}
+	conn, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, err
+	}
+	tc := conn.(*tls.Conn)
+
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	o.RemotePeerID = PeerID{Value: &TLSPeer{
+		Addr:  tc.RemoteAddr(),
+		State: tc.ConnectionState(),
+	}}
+	return NewConn(NewStreamTransport(tc), &o), nil
+}
+
+// ServeTLS serves a Cap'n Proto RPC to incoming connections, performing
+// a TLS handshake on each as specified by config.  Once the handshake
+// completes, bootstrap is called to choose the capability that the
+// connection serves as its bootstrap interface.  To choose it based on
+// the client's certificate, config.ClientAuth should be
+// tls.RequireAndVerifyClientCert or tls.VerifyClientCertIfGiven.
+//
+// Each connection is created with the options in opts, if not nil,
+// except that BootstrapClient, Bootstraps and RemotePeerID are ignored.
+// Connections whose handshake fails or takes longer than
+// opts.HandshakeTimeout are closed, as are connections whose bootstrap
+// function returns an error; the errors are reported to opts.Logger.
+//
+// ServeTLS exits with the listener error if the listener is closed by
+// the owner.
+func ServeTLS(lis net.Listener, config *tls.Config, bootstrap TLSBootstrapFunc, opts *Options) error {
+	if bootstrap == nil {
+		return errors.New("bootstrap function is nil")
+	}
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	o.BootstrapClient = capnp.Client{}
+	o.Bootstraps = nil
+	o.RemotePeerID = PeerID{}
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+		// Handshake in a separate goroutine, so that a slow client
+		// doesn't hold up the others.
+		go serveTLSConn(tls.Server(conn, config), bootstrap, o)
+	}
+}
+
+func serveTLSConn(tc *tls.Conn, bootstrap TLSBootstrapFunc, opts Options) {
+	er := errReporter{opts.Logger}
+	timeout := opts.HandshakeTimeout
+	if timeout == 0 {
+		timeout = defaultHandshakeTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	err := tc.HandshakeContext(ctx)
+	cancel()
+	if err != nil {
+		er.ReportError(exc.WrapError("TLS handshake", err), "remoteAddr", tc.RemoteAddr())
+		_ = tc.Close()
+		return
+	}
+	peer := &TLSPeer{
+		Addr:  tc.RemoteAddr(),
+		State: tc.ConnectionState(),
+	}
+	boot, err := bootstrap(peer)
+	if err != nil {
+		er.ReportError(exc.WrapError("TLS bootstrap", err), "remoteAddr", peer.Addr)
+		_ = tc.Close()
+		return
+	}
+	opts.BootstrapClient = boot
+	opts.RemotePeerID = PeerID{Value: peer}
+	_ = NewConn(NewStreamTransport(tc), &opts)
+}
+
+// ListenTLS opens a listener on the given address and serves a Cap'n
+// Proto RPC over TLS to incoming connections, as ServeTLS does.
+//
+// network and address are passed to net.Listen.  The listener is
+// closed when ctx is canceled.
+func ListenTLS(ctx context.Context, network, addr string, config *tls.Config, bootstrap TLSBootstrapFunc, opts *Options) error {
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	// to close this listener, close the context
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+	return ServeTLS(listener, config, bootstrap, opts)
+}
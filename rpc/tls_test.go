@@ -0,0 +1,262 @@
+package rpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	testcp "capnproto.org/go/capnp/v3/rpc/internal/testcapnp"
+)
+
+func TestServeTLS(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ca := newTestCA(t)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	serverConfig := &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "server", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    ca.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	go rpc.ServeTLS(lis, serverConfig, func(peer *rpc.TLSPeer) (capnp.Client, error) {
+		switch peer.Certificate().Subject.CommonName {
+		case "alice":
+			return capnp.Client(testcp.PingPong_ServerToClient(tlsPingPong{name: "alice", n: 1})), nil
+		case "carol":
+			return capnp.Client(testcp.PingPong_ServerToClient(tlsPingPong{name: "carol", n: 2})), nil
+		default:
+			return capnp.Client{}, errors.New("unknown client")
+		}
+	}, nil)
+
+	dial := func(t *testing.T, name string) *rpc.Conn {
+		conn, err := rpc.DialTLS(ctx, "tcp", lis.Addr().String(), &tls.Config{
+			Certificates: []tls.Certificate{ca.issue(t, name, x509.ExtKeyUsageClientAuth)},
+			RootCAs:      ca.pool,
+			ServerName:   "server",
+		}, nil)
+		require.NoError(t, err)
+		return conn
+	}
+	echo := func(conn *rpc.Conn) (int64, error) {
+		pp := testcp.PingPong(conn.Bootstrap(ctx))
+		defer pp.Release()
+		fut, rel := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+			p.SetN(10)
+			return nil
+		})
+		defer rel()
+		res, err := fut.Struct()
+		if err != nil {
+			return 0, err
+		}
+		return res.N(), nil
+	}
+
+	for _, test := range []struct {
+		name string
+		want int64
+	}{
+		{"alice", 11},
+		{"carol", 12},
+	} {
+		conn := dial(t, test.name)
+		peer, ok := conn.RemotePeerID().Value.(*rpc.TLSPeer)
+		if assert.True(t, ok, "RemotePeerID should be a TLS peer") {
+			assert.Equal(t, "server", peer.Certificate().Subject.CommonName)
+		}
+		n, err := echo(conn)
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.want, n, "%s should get its own bootstrap capability", test.name)
+		assert.NoError(t, conn.Close())
+	}
+
+	// The server closes connections from clients that the bootstrap
+	// function rejects.
+	conn := dial(t, "mallory")
+	_, err = echo(conn)
+	assert.Error(t, err)
+	conn.Close()
+}
+
+func TestServeTLSHandshakeTimeout(t *testing.T) {
+	t.Parallel()
+
+	ca := newTestCA(t)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	serverConfig := &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "server", x509.ExtKeyUsageServerAuth)},
+	}
+	logged := make(chan string, 1)
+	go rpc.ServeTLS(lis, serverConfig, func(peer *rpc.TLSPeer) (capnp.Client, error) {
+		return capnp.Client{}, errors.New("unexpected handshake")
+	}, &rpc.Options{
+		HandshakeTimeout: 50 * time.Millisecond,
+		Logger:           chanLogger(logged),
+	})
+
+	// A client that never starts the handshake is disconnected once
+	// the timeout expires.
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	assert.Error(t, err)
+	var netErr net.Error
+	assert.False(t, errors.As(err, &netErr) && netErr.Timeout(), "server did not close the connection")
+
+	select {
+	case msg := <-logged:
+		assert.Contains(t, msg, "TLS handshake")
+	case <-time.After(5 * time.Second):
+		t.Error("handshake failure was not logged")
+	}
+}
+
+func TestTLSPeerFromContextNewConn(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// A connection created with NewConn over a TLS stream gives its
+	// calls the same TLS identity as one created by ServeTLS.
+	ca := newTestCA(t)
+	p1, p2 := net.Pipe()
+	serverConn := tls.Server(p1, &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "server", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    ca.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	clientConn := tls.Client(p2, &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "alice", x509.ExtKeyUsageClientAuth)},
+		RootCAs:      ca.pool,
+		ServerName:   "server",
+	})
+	srv := rpc.NewConn(rpc.NewStreamTransport(serverConn), &rpc.Options{
+		BootstrapClient: capnp.Client(testcp.PingPong_ServerToClient(tlsPingPong{name: "alice", n: 1})),
+	})
+	defer srv.Close()
+	conn := rpc.NewConn(rpc.NewStreamTransport(clientConn), nil)
+	defer conn.Close()
+
+	pp := testcp.PingPong(conn.Bootstrap(ctx))
+	defer pp.Release()
+	fut, rel := pp.EchoNum(ctx, func(p testcp.PingPong_echoNum_Params) error {
+		p.SetN(10)
+		return nil
+	})
+	defer rel()
+	res, err := fut.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), res.N())
+}
+
+// chanLogger sends the messages logged at the error level to the
+// channel, dropping them if it is full.
+type chanLogger chan string
+
+func (chanLogger) Debug(string, ...any) {}
+func (chanLogger) Info(string, ...any)  {}
+func (chanLogger) Warn(string, ...any)  {}
+
+func (l chanLogger) Error(msg string, args ...any) {
+	select {
+	case l <- msg:
+	default:
+	}
+}
+
+// tlsPingPong adds n to the number it echoes, after checking that the
+// call came from the named peer.
+type tlsPingPong struct {
+	name string
+	n    int64
+}
+
+func (pp tlsPingPong) EchoNum(ctx context.Context, call testcp.PingPong_echoNum) error {
+	peer, ok := rpc.TLSPeerFromContext(ctx)
+	if !ok {
+		return errors.New("call has no TLS peer")
+	}
+	if cn := peer.Certificate().Subject.CommonName; cn != pp.name {
+		return errors.New("call from " + cn + ", want " + pp.name)
+	}
+	if p, ok := call.Peer(); !ok || p.TLS == nil || len(p.TLS.VerifiedChains) == 0 {
+		return errors.New("call's peer has no verified TLS state")
+	}
+	out, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	out.SetN(call.Args().N() + pp.n)
+	return nil
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
@@ -0,0 +1,69 @@
+package transport
+
+import (
+	"crypto/tls"
+	"net"
+)
+
+// A PeerTransport is a Transport that knows about the network
+// connection to the remote vat.  The rpc package uses it to describe
+// the caller to servers; see server.Peer.
+type PeerTransport interface {
+	Transport
+
+	// RemoteAddr returns the network address of the remote vat, or
+	// nil if it is unknown.
+	RemoteAddr() net.Addr
+
+	// TLSConnectionState returns the state of the transport's TLS
+	// connection.  It returns false if the transport does not use TLS.
+	TLSConnectionState() (tls.ConnectionState, bool)
+}
+
+// A PeerCodec is a Codec that knows about the network connection to the
+// remote vat.  A transport created by New reports the codec's answers
+// from its PeerTransport methods.
+type PeerCodec interface {
+	Codec
+	RemoteAddr() net.Addr
+	TLSConnectionState() (tls.ConnectionState, bool)
+}
+
+// RemoteAddr returns the codec's remote address.  It returns nil if the
+// codec is not a PeerCodec.
+func (s *transport) RemoteAddr() net.Addr {
+	if pc, ok := s.c.(PeerCodec); ok {
+		return pc.RemoteAddr()
+	}
+	return nil
+}
+
+// TLSConnectionState returns the state of the codec's TLS connection.
+// It returns false if the codec is not a PeerCodec.
+func (s *transport) TLSConnectionState() (tls.ConnectionState, bool) {
+	if pc, ok := s.c.(PeerCodec); ok {
+		return pc.TLSConnectionState()
+	}
+	return tls.ConnectionState{}, false
+}
+
+// RemoteAddr returns the remote address of the stream if it is a
+// net.Conn, or nil otherwise.
+func (c *streamCodec) RemoteAddr() net.Addr {
+	if nc, ok := c.Closer.(interface{ RemoteAddr() net.Addr }); ok {
+		return nc.RemoteAddr()
+	}
+	return nil
+}
+
+// TLSConnectionState returns the connection state of the stream if it
+// is a TLS connection, such as a *tls.Conn.
+func (c *streamCodec) TLSConnectionState() (tls.ConnectionState, bool) {
+	tc, ok := c.Closer.(interface {
+		ConnectionState() tls.ConnectionState
+	})
+	if !ok {
+		return tls.ConnectionState{}, false
+	}
+	return tc.ConnectionState(), true
+}
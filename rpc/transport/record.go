@@ -0,0 +1,456 @@
+package transport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	capnp "capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/exp/clock"
+	"capnproto.org/go/capnp/v3/internal/str"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+// recordMagic starts every recording, identifying the format version.
+var recordMagic = [8]byte{'c', 'a', 'p', 'n', 'p', 'r', 'e', '1'}
+
+// frameHeaderSize is the size of the header preceding each message in
+// a recording: a direction byte, seven reserved bytes, and a
+// little-endian int64 timestamp in nanoseconds since the recording
+// started.  The message follows in the standard stream framing.
+const frameHeaderSize = 16
+
+// A Direction tells which way a recorded message traveled.
+type Direction uint8
+
+const (
+	// Outgoing messages were sent by the recording vat.
+	Outgoing Direction = 1 + iota
+
+	// Incoming messages were received by the recording vat.
+	Incoming
+)
+
+// String returns "outgoing" or "incoming".
+func (d Direction) String() string {
+	switch d {
+	case Outgoing:
+		return "outgoing"
+	case Incoming:
+		return "incoming"
+	default:
+		return "Direction(" + str.Utod(d) + ")"
+	}
+}
+
+// A Frame is a message recorded by a transport returned from
+// NewRecorder.
+type Frame struct {
+	// Time is when the message was sent or received, relative to the
+	// start of the recording.
+	Time time.Duration
+
+	Direction Direction
+	Message   *capnp.Message
+}
+
+// NewRecorder returns a transport that forwards to t and writes every
+// message sent or received to w, along with a timestamp read from clk.
+// If clk is nil, the system clock is used.  Recordings can be read
+// with NewFrameReader or fed back into a Conn with NewReplay.
+//
+// If writing to w fails, recording stops and the error is returned
+// from Close; the transport itself keeps working.  Closing the
+// transport closes t but not w.
+func NewRecorder(t Transport, w io.Writer, clk clock.Clock) Transport {
+	if clk == nil {
+		clk = clock.System
+	}
+	r := &recorder{
+		t:     t,
+		w:     w,
+		enc:   capnp.NewEncoder(w),
+		clock: clk,
+		start: clk.Now(),
+	}
+	_, r.err = w.Write(recordMagic[:])
+	return r
+}
+
+type recorder struct {
+	t     Transport
+	clock clock.Clock
+	start time.Time
+
+	mu  sync.Mutex
+	w   io.Writer
+	enc *capnp.Encoder
+	err error // first write error
+}
+
+func (r *recorder) NewMessage() (OutgoingMessage, error) {
+	out, err := r.t.NewMessage()
+	if err != nil {
+		return nil, err
+	}
+	return recordedOutgoing{OutgoingMessage: out, r: r}, nil
+}
+
+func (r *recorder) RecvMessage() (IncomingMessage, error) {
+	in, err := r.t.RecvMessage()
+	if err != nil {
+		return nil, err
+	}
+	r.record(Incoming, in.Message().Message())
+	return in, nil
+}
+
+func (r *recorder) Close() error {
+	err := r.t.Close()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return transporterr.Annotate(exc.WrapError("record", r.err), "recorder")
+	}
+	return err
+}
+
+func (r *recorder) record(d Direction, msg *capnp.Message) {
+	t := r.clock.Now().Sub(r.start)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	var hdr [frameHeaderSize]byte
+	hdr[0] = byte(d)
+	binary.LittleEndian.PutUint64(hdr[8:], uint64(t))
+	if _, r.err = r.w.Write(hdr[:]); r.err != nil {
+		return
+	}
+	r.err = r.enc.Encode(msg)
+}
+
+type recordedOutgoing struct {
+	OutgoingMessage
+	r *recorder
+}
+
+func (o recordedOutgoing) Send() error {
+	if err := o.OutgoingMessage.Send(); err != nil {
+		return err
+	}
+	o.r.record(Outgoing, o.Message().Message())
+	return nil
+}
+
+// A FrameReader reads the frames of a recording made by a transport
+// returned from NewRecorder.
+type FrameReader struct {
+	r     io.Reader
+	dec   *capnp.Decoder
+	magic bool
+}
+
+// NewFrameReader returns a reader for the recording in r.
+func NewFrameReader(r io.Reader) *FrameReader {
+	return &FrameReader{r: r, dec: capnp.NewDecoder(r)}
+}
+
+// Next reads the next frame of the recording.  It returns io.EOF at
+// the end of the recording.
+func (fr *FrameReader) Next() (Frame, error) {
+	if !fr.magic {
+		var magic [len(recordMagic)]byte
+		if _, err := io.ReadFull(fr.r, magic[:]); err != nil {
+			return Frame{}, exc.WrapError("read recording", err)
+		}
+		if magic != recordMagic {
+			return Frame{}, errors.New("read recording: not a recording")
+		}
+		fr.magic = true
+	}
+	var hdr [frameHeaderSize]byte
+	if _, err := io.ReadFull(fr.r, hdr[:]); err == io.EOF {
+		return Frame{}, io.EOF
+	} else if err != nil {
+		return Frame{}, exc.WrapError("read frame", err)
+	}
+	msg, err := fr.dec.Decode()
+	if err != nil {
+		return Frame{}, exc.WrapError("read frame", err)
+	}
+	return Frame{
+		Time:      time.Duration(binary.LittleEndian.Uint64(hdr[8:])),
+		Direction: Direction(hdr[0]),
+		Message:   msg,
+	}, nil
+}
+
+// ReplayOptions controls how a recording is replayed.
+type ReplayOptions struct {
+	// Speed is the rate of the replay relative to the recording: 2
+	// replays twice as fast as the messages were originally received.
+	// Zero means 1.  Use math.Inf(1) to replay without any delays.
+	Speed float64
+
+	// Clock is used to wait between messages.  If nil, the system
+	// clock is used.
+	Clock clock.Clock
+
+	// CompareSent, if true, checks each message sent on the transport
+	// against the next outgoing message of the recording, and reports
+	// the first difference from Err.  This turns a recording of
+	// production traffic into a regression test: a Conn that handles
+	// the replayed messages must answer as the recording vat did.
+	CompareSent bool
+}
+
+// A Replay is a transport that plays back the incoming messages of a
+// recording, at the pace they were originally received.  Messages sent
+// on the transport are discarded, or compared with the recording if
+// ReplayOptions.CompareSent is set.  Passing a Replay to rpc.NewConn
+// reproduces the recording vat's side of the session, which is useful
+// for debugging protocol bugs offline.
+//
+// Once all messages have been delivered, RecvMessage blocks until the
+// transport is closed, so that the Conn is not torn down before it has
+// finished processing them.
+type Replay struct {
+	speed   float64
+	clock   clock.Clock
+	compare bool
+
+	// mu protects the recording, which both RecvMessage and Send read
+	// from when comparing.  Frames that one of them reads past are
+	// queued for the other.
+	mu       sync.Mutex
+	fr       *FrameReader
+	frErr    error
+	incoming []Frame
+	outgoing []Frame
+	sent     int   // number of messages sent
+	cmpErr   error // first difference found by Send
+
+	started   bool
+	start     time.Time
+	done      chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewReplay returns a transport that replays the recording in r.
+func NewReplay(r io.Reader, opts *ReplayOptions) *Replay {
+	rp := &Replay{
+		fr:     NewFrameReader(r),
+		speed:  1,
+		clock:  clock.System,
+		done:   make(chan struct{}),
+		closed: make(chan struct{}),
+	}
+	if opts != nil {
+		if opts.Speed > 0 {
+			rp.speed = opts.Speed
+		}
+		if opts.Clock != nil {
+			rp.clock = opts.Clock
+		}
+		rp.compare = opts.CompareSent
+	}
+	return rp
+}
+
+// Done returns a channel that is closed when RecvMessage reaches the
+// end of the recording, or reading the recording fails.  Since a Conn
+// only receives its next message after it has handled the previous
+// one, Done is a signal that the Conn has handled the whole recording.
+func (rp *Replay) Done() <-chan struct{} {
+	return rp.done
+}
+
+// Err returns the first difference between the messages sent on the
+// transport and the outgoing messages of the recording, if
+// ReplayOptions.CompareSent is set.  Once the transport is closed, it
+// also reports recorded messages that were never sent.
+func (rp *Replay) Err() error {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	if rp.cmpErr != nil || !rp.compare {
+		return rp.cmpErr
+	}
+	select {
+	case <-rp.closed:
+	default:
+		return nil
+	}
+	if f, err := rp.next(Outgoing); err == nil {
+		f.Message.Release()
+		return fmt.Errorf("replay: recorded outgoing message %d was not sent", rp.sent+1)
+	} else if err != io.EOF {
+		return transporterr.Annotate(err, "replay")
+	}
+	return nil
+}
+
+// next returns the next frame of the recording in direction d.  The
+// caller must hold rp.mu.
+func (rp *Replay) next(d Direction) (Frame, error) {
+	q := &rp.incoming
+	if d == Outgoing {
+		q = &rp.outgoing
+	}
+	for len(*q) == 0 {
+		if rp.frErr != nil {
+			return Frame{}, rp.frErr
+		}
+		f, err := rp.fr.Next()
+		if err != nil {
+			rp.frErr = err
+			return Frame{}, err
+		}
+		switch {
+		case f.Direction == Incoming:
+			rp.incoming = append(rp.incoming, f)
+		case f.Direction == Outgoing && rp.compare:
+			rp.outgoing = append(rp.outgoing, f)
+		default:
+			f.Message.Release()
+		}
+	}
+	f := (*q)[0]
+	(*q)[0] = Frame{}
+	*q = (*q)[1:]
+	return f, nil
+}
+
+// NewMessage returns a message whose Send method discards it, or
+// compares it with the recording.
+func (rp *Replay) NewMessage() (OutgoingMessage, error) {
+	_, seg, err := capnp.NewMessage(capnp.MultiSegment(nil))
+	if err != nil {
+		return nil, transporterr.Annotate(exc.WrapError("new message", err), "replay")
+	}
+	m, err := rpccp.NewRootMessage(seg)
+	if err != nil {
+		return nil, transporterr.Annotate(exc.WrapError("new message", err), "replay")
+	}
+	return &outgoingMsg{
+		message: m,
+		send:    rp.send,
+	}, nil
+}
+
+func (rp *Replay) send(msg *capnp.Message) error {
+	if !rp.compare {
+		return nil
+	}
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	rp.sent++
+	if rp.cmpErr != nil {
+		return nil
+	}
+	f, err := rp.next(Outgoing)
+	if err == io.EOF {
+		rp.cmpErr = fmt.Errorf("replay: sent message %d is not in the recording", rp.sent)
+		return nil
+	} else if err != nil {
+		rp.cmpErr = transporterr.Annotate(err, "replay")
+		return nil
+	}
+	defer f.Message.Release()
+	got, err := msg.Root()
+	if err != nil {
+		rp.cmpErr = fmt.Errorf("replay: sent message %d: %v", rp.sent, err)
+		return nil
+	}
+	want, err := f.Message.Root()
+	if err != nil {
+		rp.cmpErr = fmt.Errorf("replay: recorded message %d: %v", rp.sent, err)
+		return nil
+	}
+	if eq, err := capnp.Equal(got, want); err != nil || !eq {
+		rp.cmpErr = fmt.Errorf("replay: sent message %d (%v) differs from the recording (%v)",
+			rp.sent, whichMessage(got), whichMessage(want))
+	}
+	return nil
+}
+
+// whichMessage returns the type of the rpc.capnp Message in p.
+func whichMessage(p capnp.Ptr) rpccp.Message_Which {
+	return rpccp.Message(p.Struct()).Which()
+}
+
+// RecvMessage returns the next incoming message of the recording, once
+// its time has come.  It must not be called concurrently with itself.
+func (rp *Replay) RecvMessage() (IncomingMessage, error) {
+	if !rp.started {
+		rp.started = true
+		rp.start = rp.clock.Now()
+	}
+	select {
+	case <-rp.done:
+		return rp.waitClosed()
+	default:
+	}
+	rp.mu.Lock()
+	f, err := rp.next(Incoming)
+	rp.mu.Unlock()
+	if err == io.EOF {
+		close(rp.done)
+		return rp.waitClosed()
+	} else if err != nil {
+		close(rp.done)
+		return nil, transporterr.Annotate(err, "replay")
+	}
+	if err := rp.wait(f.Time); err != nil {
+		f.Message.Release()
+		return nil, err
+	}
+	rmsg, err := rpccp.ReadRootMessage(f.Message)
+	if err != nil {
+		return nil, transporterr.Annotate(exc.WrapError("receive", err), "replay")
+	}
+	return incomingMsg(rmsg), nil
+}
+
+// wait blocks until time t of the recording, scaled by the replay
+// speed, or until the transport is closed.
+func (rp *Replay) wait(t time.Duration) error {
+	if math.IsInf(rp.speed, 1) {
+		return nil
+	}
+	at := rp.start.Add(time.Duration(float64(t) / rp.speed))
+	d := at.Sub(rp.clock.Now())
+	if d <= 0 {
+		return nil
+	}
+	timer := rp.clock.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.Chan():
+		return nil
+	case <-rp.closed:
+		return rp.closedErr()
+	}
+}
+
+func (rp *Replay) waitClosed() (IncomingMessage, error) {
+	<-rp.closed
+	return nil, rp.closedErr()
+}
+
+func (rp *Replay) closedErr() error {
+	return transporterr.Disconnected(errors.New("closed")).Annotate("", "replay")
+}
+
+// Close interrupts any outstanding call to RecvMessage.
+func (rp *Replay) Close() error {
+	rp.closeOnce.Do(func() { close(rp.closed) })
+	return nil
+}
@@ -0,0 +1,218 @@
+package transport_test
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3/exp/clock"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
+)
+
+func sendBootstrap(t *testing.T, tr transport.Transport, qid uint32) {
+	t.Helper()
+	out, err := tr.NewMessage()
+	require.NoError(t, err)
+	defer out.Release()
+	bs, err := out.Message().NewBootstrap()
+	require.NoError(t, err)
+	bs.SetQuestionId(qid)
+	require.NoError(t, out.Send())
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	clk := clock.NewManual(time.Unix(0, 0))
+	c1, c2 := transport.NewPipe(1)
+	rec := transport.NewRecorder(transport.New(c1), &buf, clk)
+	remote := transport.New(c2)
+
+	clk.Advance(time.Second)
+	sendBootstrap(t, rec, 1)
+	in, err := remote.RecvMessage()
+	require.NoError(t, err)
+	in.Release()
+
+	clk.Advance(time.Second)
+	sendBootstrap(t, remote, 2)
+	in, err = rec.RecvMessage()
+	require.NoError(t, err)
+	in.Release()
+
+	require.NoError(t, rec.Close())
+	require.NoError(t, remote.Close())
+
+	fr := transport.NewFrameReader(&buf)
+	want := []struct {
+		time time.Duration
+		dir  transport.Direction
+		qid  uint32
+	}{
+		{time.Second, transport.Outgoing, 1},
+		{2 * time.Second, transport.Incoming, 2},
+	}
+	for _, w := range want {
+		f, err := fr.Next()
+		require.NoError(t, err)
+		assert.Equal(t, w.time, f.Time)
+		assert.Equal(t, w.dir, f.Direction)
+		msg, err := rpccp.ReadRootMessage(f.Message)
+		require.NoError(t, err)
+		require.Equal(t, rpccp.Message_Which_bootstrap, msg.Which())
+		bs, err := msg.Bootstrap()
+		require.NoError(t, err)
+		assert.Equal(t, w.qid, bs.QuestionId())
+	}
+	_, err = fr.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+// record returns a recording with an incoming bootstrap message at each
+// of the given times, and an outgoing message in between.
+func record(t *testing.T, times ...time.Duration) []byte {
+	var buf bytes.Buffer
+	clk := clock.NewManual(time.Unix(0, 0))
+	c1, c2 := transport.NewPipe(len(times))
+	rec := transport.NewRecorder(transport.New(c1), &buf, clk)
+	remote := transport.New(c2)
+	var now time.Duration
+	for i, d := range times {
+		clk.Advance(d - now)
+		now = d
+		sendBootstrap(t, remote, uint32(i))
+		in, err := rec.RecvMessage()
+		require.NoError(t, err)
+		in.Release()
+		sendBootstrap(t, rec, uint32(i))
+	}
+	require.NoError(t, rec.Close())
+	require.NoError(t, remote.Close())
+	return buf.Bytes()
+}
+
+func recvQuestionID(t *testing.T, tr transport.Transport) uint32 {
+	t.Helper()
+	in, err := tr.RecvMessage()
+	require.NoError(t, err)
+	defer in.Release()
+	bs, err := in.Message().Bootstrap()
+	require.NoError(t, err)
+	return bs.QuestionId()
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	rec := record(t, 0, time.Second, 2*time.Second)
+	rp := transport.NewReplay(bytes.NewReader(rec), &transport.ReplayOptions{
+		Speed: math.Inf(1),
+	})
+	for i := uint32(0); i < 3; i++ {
+		assert.Equal(t, i, recvQuestionID(t, rp))
+	}
+	// Further receives block until the transport is closed.
+	errs := make(chan error, 1)
+	go func() {
+		_, err := rp.RecvMessage()
+		errs <- err
+	}()
+	<-rp.Done()
+	select {
+	case err := <-errs:
+		t.Fatalf("RecvMessage returned %v before Close", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	require.NoError(t, rp.Close())
+	assert.Error(t, <-errs)
+}
+
+func TestReplaySpeed(t *testing.T) {
+	t.Parallel()
+
+	rec := record(t, 0, 4*time.Second)
+	clk := clock.NewManual(time.Unix(0, 0))
+	rp := transport.NewReplay(bytes.NewReader(rec), &transport.ReplayOptions{
+		Speed: 2,
+		Clock: clk,
+	})
+	defer rp.Close()
+
+	assert.Equal(t, uint32(0), recvQuestionID(t, rp))
+	qids := make(chan uint32, 1)
+	go func() {
+		qids <- recvQuestionID(t, rp)
+	}()
+
+	clk.Advance(time.Second)
+	select {
+	case <-qids:
+		t.Fatal("message delivered early")
+	case <-time.After(10 * time.Millisecond):
+	}
+	clk.Advance(time.Second)
+	select {
+	case qid := <-qids:
+		assert.Equal(t, uint32(1), qid)
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not delivered at scaled time")
+	}
+}
+
+func TestReplayCompareSent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Match", func(t *testing.T) {
+		t.Parallel()
+
+		rp := transport.NewReplay(bytes.NewReader(record(t, 0, time.Second)), &transport.ReplayOptions{
+			Speed:       math.Inf(1),
+			CompareSent: true,
+		})
+		for i := uint32(0); i < 2; i++ {
+			assert.Equal(t, i, recvQuestionID(t, rp))
+			sendBootstrap(t, rp, i)
+		}
+		require.NoError(t, rp.Close())
+		assert.NoError(t, rp.Err())
+	})
+	t.Run("Differs", func(t *testing.T) {
+		t.Parallel()
+
+		rp := transport.NewReplay(bytes.NewReader(record(t, 0, time.Second)), &transport.ReplayOptions{
+			Speed:       math.Inf(1),
+			CompareSent: true,
+		})
+		assert.Equal(t, uint32(0), recvQuestionID(t, rp))
+		sendBootstrap(t, rp, 42)
+		assert.Error(t, rp.Err())
+		require.NoError(t, rp.Close())
+	})
+	t.Run("Missing", func(t *testing.T) {
+		t.Parallel()
+
+		rp := transport.NewReplay(bytes.NewReader(record(t, 0, time.Second)), &transport.ReplayOptions{
+			Speed:       math.Inf(1),
+			CompareSent: true,
+		})
+		assert.Equal(t, uint32(0), recvQuestionID(t, rp))
+		sendBootstrap(t, rp, 0)
+		assert.NoError(t, rp.Err(), "Err before Close")
+		require.NoError(t, rp.Close())
+		assert.Error(t, rp.Err(), "Err after Close")
+	})
+}
+
+func TestFrameReaderBadMagic(t *testing.T) {
+	t.Parallel()
+
+	_, err := transport.NewFrameReader(bytes.NewReader(make([]byte, 32))).Next()
+	assert.Error(t, err)
+}
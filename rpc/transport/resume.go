@@ -0,0 +1,41 @@
+package transport
+
+import "crypto/tls"
+
+// A ResumableTransport is a Transport whose connection may have
+// resumed a session that an earlier connection to the same peer
+// established, such as a TLS connection that was set up with a session
+// ticket.  rpc.Options.EagerBootstrap uses it to decide whether to send
+// the bootstrap request in the connection's first flight of messages.
+type ResumableTransport interface {
+	Transport
+
+	// Resumed reports whether the transport's connection resumed a
+	// previous session.
+	Resumed() bool
+}
+
+// A ResumableCodec is a Codec that knows whether its stream resumed a
+// previous session.  A transport created by New reports the codec's
+// answer from its Resumed method.
+type ResumableCodec interface {
+	Codec
+	Resumed() bool
+}
+
+// Resumed reports whether the transport's codec resumed a previous
+// session.  It returns false if the codec is not a ResumableCodec.
+func (s *transport) Resumed() bool {
+	rc, ok := s.c.(ResumableCodec)
+	return ok && rc.Resumed()
+}
+
+// Resumed reports whether the stream is a TLS connection, such as a
+// *tls.Conn, that resumed a previous session.  The handshake must have
+// completed before the transport is used for this to be accurate.
+func (c *streamCodec) Resumed() bool {
+	tc, ok := c.Closer.(interface {
+		ConnectionState() tls.ConnectionState
+	})
+	return ok && tc.ConnectionState().DidResume
+}
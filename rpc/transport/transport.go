@@ -7,10 +7,13 @@
 package transport
 
 import (
+	"context"
 	"errors"
 	"io"
+	"time"
 
 	capnp "capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/framing"
 	"capnproto.org/go/capnp/v3/exc"
 	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
 )
@@ -56,6 +59,19 @@ type Transport interface {
 	Close() error
 }
 
+// A BatchTransport is a Transport that can send several messages
+// together, such as in a single write to a network connection.
+type BatchTransport interface {
+	Transport
+
+	// SendBatch sends msgs, in order, as if by calling their Send
+	// methods.  The messages must have been allocated by the
+	// transport's NewMessage method and not yet sent or released.
+	// If SendBatch returns an error, some of the messages may not
+	// have been sent.
+	SendBatch(msgs []OutgoingMessage) error
+}
+
 // OutgoingMessage is a message that can be sent at a later time.
 // Release() MUST be called when the OutgoingMessage is no longer in
 // use. Before releasing an ougoing message, Send() MAY be called at
@@ -91,6 +107,14 @@ type Codec interface {
 	Close() error
 }
 
+// A BatchCodec is a Codec that can encode several messages at once.
+// A transport created by New sends batches of messages with EncodeAll
+// if its codec is a BatchCodec.
+type BatchCodec interface {
+	Codec
+	EncodeAll(...*capnp.Message) error
+}
+
 // A transport serializes and deserializes Cap'n Proto using a Codec.
 // It adds no buffering beyond what is provided by the underlying
 // byte transfer mechanism.
@@ -108,6 +132,10 @@ func New(c Codec) Transport { return &transport{c: c} }
 //
 // rwc's Close method must interrupt any outstanding IO, and it must be safe
 // to call rwc.Read and rwc.Write concurrently.
+//
+// If rwc is a capnp.BufferLender, received messages read directly from
+// the buffers that it lends, and each buffer is returned to rwc when
+// the message's IncomingMessage is released.
 func NewStream(rwc io.ReadWriteCloser) Transport {
 	return New(newStreamCodec(rwc, basicEncoding{}))
 }
@@ -120,6 +148,48 @@ func NewPackedStream(rwc io.ReadWriteCloser) Transport {
 	return New(newStreamCodec(rwc, packedEncoding{}))
 }
 
+// StreamOptions are optional parameters for NewStreamWithOptions.
+type StreamOptions struct {
+	// Packed selects the packed encoding, as with NewPackedStream.
+	Packed bool
+
+	// Budget, if not nil, limits the memory held by messages received
+	// on the transport, together with any other decoders that share it.
+	// See capnp.Decoder.Budget.
+	Budget *capnp.MemoryBudget
+
+	// BudgetWait is how long to wait for a received message to fit in
+	// Budget.  See capnp.Decoder.BudgetWait.  If negative, RecvMessage
+	// waits until the message fits or the transport is closed.
+	BudgetWait time.Duration
+
+	// Framer, if not nil, wraps each message in a frame, as for
+	// embedding the rpc protocol in another protocol.  Packing works
+	// on whole words, which frames are not made of, so Framer cannot
+	// be combined with Packed.
+	Framer framing.Framer
+}
+
+// NewStreamWithOptions is like NewStream, but configures the transport
+// with opts.  If opts is nil, it is equivalent to NewStream.  It panics
+// if opts sets both Packed and Framer.
+func NewStreamWithOptions(rwc io.ReadWriteCloser, opts *StreamOptions) Transport {
+	if opts == nil {
+		return NewStream(rwc)
+	}
+	var f streamEncoding = basicEncoding{opts.Framer}
+	if opts.Packed {
+		if opts.Framer != nil {
+			panic("transport: StreamOptions.Framer cannot be combined with Packed")
+		}
+		f = packedEncoding{}
+	}
+	c := newStreamCodec(rwc, f)
+	c.Decoder.Budget = opts.Budget
+	c.Decoder.BudgetWait = opts.BudgetWait
+	return New(c)
+}
+
 // NewMessage allocates a new message to be sent.
 //
 // It is safe to call NewMessage concurrently with RecvMessage.
@@ -150,6 +220,40 @@ func (s *transport) NewMessage() (OutgoingMessage, error) {
 	}, nil
 }
 
+// SendBatch sends msgs with a single call to the codec's EncodeAll
+// method if it is a BatchCodec, or else one at a time.
+func (s *transport) SendBatch(msgs []OutgoingMessage) error {
+	bc, ok := s.c.(BatchCodec)
+	if !ok {
+		return sendEach(msgs)
+	}
+	ms := make([]*capnp.Message, len(msgs))
+	for i, o := range msgs {
+		om, ok := o.(*outgoingMsg)
+		if !ok {
+			return sendEach(msgs)
+		}
+		if om.released {
+			panic("call to SendBatch() after call to Release()")
+		}
+		ms[i] = om.message.Message()
+	}
+	if err := bc.EncodeAll(ms...); err != nil {
+		return transporterr.Annotate(exc.WrapError("send", err), "stream transport")
+	}
+	return nil
+}
+
+// sendEach sends msgs one at a time, stopping at the first error.
+func sendEach(msgs []OutgoingMessage) error {
+	for _, o := range msgs {
+		if err := o.Send(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RecvMessage reads the next message from the underlying reader.
 //
 // It is safe to call RecvMessage concurrently with NewMessage.
@@ -161,6 +265,7 @@ func (s *transport) RecvMessage() (IncomingMessage, error) {
 	}
 	rmsg, err := rpccp.ReadRootMessage(msg)
 	if err != nil {
+		msg.Release()
 		err = transporterr.Annotate(exc.WrapError("receive", err), "stream transport")
 		return nil, err
 	}
@@ -186,14 +291,29 @@ type streamCodec struct {
 	*capnp.Decoder
 	*capnp.Encoder
 	io.Closer
+
+	// cancel interrupts a Decode that is waiting for the decoder's
+	// memory budget.
+	cancel context.CancelFunc
 }
 
 func newStreamCodec(rwc io.ReadWriteCloser, f streamEncoding) *streamCodec {
-	return &streamCodec{
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &streamCodec{
 		Decoder: f.NewDecoder(rwc),
 		Encoder: f.NewEncoder(rwc),
 		Closer:  rwc,
+		cancel:  cancel,
 	}
+	c.Decoder.BudgetContext = ctx
+	return c
+}
+
+// Close interrupts any Decode that is waiting for the memory budget and
+// closes the stream.
+func (c *streamCodec) Close() error {
+	c.cancel()
+	return c.Closer.Close()
 }
 
 type streamEncoding interface {
@@ -201,10 +321,17 @@ type streamEncoding interface {
 	NewDecoder(io.Reader) *capnp.Decoder
 }
 
-type basicEncoding struct{}
+type basicEncoding struct {
+	framer framing.Framer
+}
+
+func (e basicEncoding) NewEncoder(w io.Writer) *capnp.Encoder {
+	return capnp.NewFramedEncoder(w, e.framer)
+}
 
-func (basicEncoding) NewEncoder(w io.Writer) *capnp.Encoder { return capnp.NewEncoder(w) }
-func (basicEncoding) NewDecoder(r io.Reader) *capnp.Decoder { return capnp.NewDecoder(r) }
+func (e basicEncoding) NewDecoder(r io.Reader) *capnp.Decoder {
+	return capnp.NewFramedDecoder(r, e.framer)
+}
 
 type packedEncoding struct{}
 
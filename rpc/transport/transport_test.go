@@ -8,6 +8,7 @@ import (
 	"time"
 
 	capnp "capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/framing"
 	rpccp "capnproto.org/go/capnp/v3/std/capnp/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -183,6 +184,22 @@ func TestTCPStreamTransport(t *testing.T) {
 
 		testTCPStreamTransport(t, NewPackedStream)
 	})
+
+	t.Run("Netstring", func(t *testing.T) {
+		t.Parallel()
+
+		testTCPStreamTransport(t, func(rwc io.ReadWriteCloser) Transport {
+			return NewStreamWithOptions(rwc, &StreamOptions{Framer: framing.Netstring})
+		})
+	})
+
+	t.Run("Varint", func(t *testing.T) {
+		t.Parallel()
+
+		testTCPStreamTransport(t, func(rwc io.ReadWriteCloser) Transport {
+			return NewStreamWithOptions(rwc, &StreamOptions{Framer: framing.Varint})
+		})
+	})
 }
 
 func testTCPStreamTransport(t *testing.T, newTransport func(io.ReadWriteCloser) Transport) {
@@ -237,3 +254,59 @@ func testTCPStreamTransport(t *testing.T, newTransport func(io.ReadWriteCloser)
 		})
 	})
 }
+
+func TestStreamBudget(t *testing.T) {
+	t.Run("InvalidRoot", func(t *testing.T) {
+		t.Parallel()
+
+		// A message whose root pointer is out of bounds is rejected,
+		// and its size is returned to the budget.
+		budget := capnp.NewMemoryBudget(1 << 20)
+		c1, c2 := net.Pipe()
+		tr := NewStreamWithOptions(c1, &StreamOptions{Budget: budget})
+		defer tr.Close()
+
+		go c2.Write([]byte{
+			0, 0, 0, 0, 1, 0, 0, 0, // one segment of one word
+			0x90, 0x01, 0, 0, 1, 0, 0, 0, // struct pointer at offset 100
+		})
+		defer c2.Close()
+
+		_, err := tr.RecvMessage()
+		assert.Error(t, err)
+		assert.Equal(t, uint64(0), budget.Used())
+	})
+
+	t.Run("CloseWhileWaiting", func(t *testing.T) {
+		t.Parallel()
+
+		// Closing the transport interrupts a RecvMessage that is
+		// waiting indefinitely for the budget.
+		budget := capnp.NewMemoryBudget(1 << 10)
+		require.True(t, budget.TryAcquire(budget.Limit()))
+		c1, c2 := net.Pipe()
+		tr := NewStreamWithOptions(c1, &StreamOptions{Budget: budget, BudgetWait: -1})
+
+		msg, seg := capnp.NewSingleSegmentMessage(nil)
+		_, err := rpccp.NewRootMessage(seg)
+		require.NoError(t, err)
+		go capnp.NewEncoder(c2).Encode(msg)
+		defer c2.Close()
+
+		errc := make(chan error, 1)
+		go func() {
+			_, err := tr.RecvMessage()
+			errc <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, tr.Close())
+		select {
+		case err := <-errc:
+			assert.Error(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("RecvMessage was not interrupted by Close")
+		}
+		budget.Release(budget.Limit())
+		assert.Equal(t, uint64(0), budget.Used())
+	})
+}
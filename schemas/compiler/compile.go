@@ -0,0 +1,376 @@
+// Package compiler compiles Cap'n Proto schema language files into
+// CodeGeneratorRequest messages, the same input that the capnp tool
+// passes to code generator plugins such as capnpc-go.
+//
+// The compiler assigns IDs and lays out structs the same way as the
+// reference implementation, so compiled schemas are compatible with
+// those compiled by capnp.  It is intended for generating code without
+// the capnp tool installed and for compiling small schemas at runtime,
+// such as in tests.
+package compiler // import "capnproto.org/go/capnp/v3/schemas/compiler"
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+// Options controls how schema files are located.
+type Options struct {
+	// ImportPath is the list of directories searched for imports that
+	// start with a slash, like "/capnp/c++.capnp".
+	ImportPath []string
+
+	// ReadFile reads the named schema file.  If nil, os.ReadFile is
+	// used.
+	ReadFile func(name string) ([]byte, error)
+
+	// Std, if not nil, is searched for absolute imports after
+	// ImportPath.  It is typically std.Schemas, so that imports like
+	// "/go.capnp" resolve without a system installation.
+	Std fs.FS
+
+	// SrcPrefix lists directories that are removed from the start of
+	// the names of the files passed to Compile, like the --src-prefix
+	// flag of the capnp tool.  The longest matching prefix is removed.
+	// Generators use the requested file names to decide where to write
+	// their output.
+	SrcPrefix []string
+}
+
+// An Error describes a problem found while compiling a schema.
+type Error struct {
+	Pos string // file:line:column
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return e.Pos + ": " + e.Msg
+}
+
+// Compile parses the named schema files along with everything they
+// import and returns a CodeGeneratorRequest that requests code for the
+// named files.
+func Compile(opts *Options, files ...string) (schema.CodeGeneratorRequest, error) {
+	c := newCompiler(opts)
+	var requested []*file
+	for _, name := range files {
+		f, err := c.load(c.stripSrcPrefix(path.Clean(filepathToSlash(name))), name, nil)
+		if err != nil {
+			return schema.CodeGeneratorRequest{}, err
+		}
+		requested = append(requested, f)
+	}
+	return c.compile(requested)
+}
+
+// CompileString compiles a single schema file whose contents are src.
+// The file may import other files using opts.  It is a convenience for
+// compiling small schemas in tests.
+func CompileString(opts *Options, name, src string) (schema.CodeGeneratorRequest, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	read := o.ReadFile
+	if read == nil {
+		read = os.ReadFile
+	}
+	clean := path.Clean(filepathToSlash(name))
+	o.ReadFile = func(n string) ([]byte, error) {
+		if n == name || path.Clean(filepathToSlash(n)) == clean {
+			return []byte(src), nil
+		}
+		return read(n)
+	}
+	return Compile(&o, name)
+}
+
+// stripSrcPrefix removes the longest of the SrcPrefix directories that
+// name is in from the start of name.
+func (c *compiler) stripSrcPrefix(name string) string {
+	best := name
+	for _, prefix := range c.opts.SrcPrefix {
+		dir := strings.TrimSuffix(path.Clean(filepathToSlash(prefix)), "/") + "/"
+		if strings.HasPrefix(name, dir) && len(name)-len(dir) < len(best) {
+			best = name[len(dir):]
+		}
+	}
+	return best
+}
+
+func filepathToSlash(name string) string {
+	return strings.ReplaceAll(name, string(os.PathSeparator), "/")
+}
+
+type compiler struct {
+	opts  Options
+	files map[string]*file // keyed by display name
+	order []*file
+	ids   map[uint64]*node
+
+	// structs holds the layouts of every struct, group, and parameter
+	// struct, keyed by node ID.
+	structs map[uint64]*structNode
+
+	methods map[*decl]*methodInfo
+}
+
+func newCompiler(opts *Options) *compiler {
+	c := &compiler{
+		files:   make(map[string]*file),
+		ids:     make(map[uint64]*node),
+		structs: make(map[uint64]*structNode),
+	}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.ReadFile == nil {
+		c.opts.ReadFile = os.ReadFile
+	}
+	return c
+}
+
+// A file is a parsed schema file.
+type file struct {
+	name    string // display name
+	path    string // the name it was read from
+	fsys    fs.FS  // where path was read from, or nil for ReadFile
+	decl    *decl
+	root    *node
+	imports []fileImport
+}
+
+type fileImport struct {
+	name string // as written in the import expression
+	file *file
+}
+
+// A node is a named declaration: a file, struct, enum, interface,
+// constant, annotation, or alias.
+type node struct {
+	kind   declKind
+	decl   *decl
+	file   *file
+	parent *node // nil for files
+	id     uint64
+	name   string
+
+	displayName string
+	prefixLen   int
+
+	nested  []*node // declaration order, excluding aliases
+	members map[string]*node
+
+	// Aliases
+	target    *ref
+	resolving bool
+}
+
+// isGeneric reports whether n or any of its enclosing scopes has
+// generic parameters.
+func (n *node) isGeneric() bool {
+	for ; n != nil; n = n.parent {
+		if len(n.decl.params) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *compiler) errorf(at pos, format string, args ...any) error {
+	return &Error{Pos: at.String(), Msg: fmt.Sprintf(format, args...)}
+}
+
+// load parses the file with the given display name, reading it from
+// filename in fsys (or with ReadFile if fsys is nil), and creates nodes
+// for its declarations.  Files are loaded at most once.
+func (c *compiler) load(name, filename string, fsys fs.FS) (*file, error) {
+	if f := c.files[name]; f != nil {
+		return f, nil
+	}
+	var src []byte
+	var err error
+	if fsys != nil {
+		src, err = fs.ReadFile(fsys, filename)
+	} else {
+		src, err = c.opts.ReadFile(filename)
+	}
+	if err != nil {
+		return nil, err
+	}
+	d, err := parseFile(name, string(src))
+	if err != nil {
+		return nil, err
+	}
+	f := &file{name: name, path: filename, fsys: fsys, decl: d}
+	c.files[name] = f
+	c.order = append(c.order, f)
+
+	dot := strings.LastIndexByte(name, '.')
+	f.root = &node{
+		kind:        declFile,
+		decl:        d,
+		file:        f,
+		id:          d.id,
+		name:        name,
+		displayName: name,
+		prefixLen:   dot + 1,
+	}
+	if err := c.register(f.root); err != nil {
+		return nil, err
+	}
+	if err := c.addNested(f.root); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// importFile resolves an import expression appearing in from.
+func (c *compiler) importFile(from *file, e *expr) (*file, error) {
+	type candidate struct {
+		path string
+		fsys fs.FS
+	}
+	var name string
+	var candidates []candidate
+	if strings.HasPrefix(e.str, "/") {
+		name = path.Clean(e.str[1:])
+		for _, dir := range c.opts.ImportPath {
+			candidates = append(candidates, candidate{path: path.Join(filepathToSlash(dir), name)})
+		}
+		if c.opts.Std != nil {
+			candidates = append(candidates, candidate{path: name, fsys: c.opts.Std})
+		}
+	} else {
+		name = path.Join(path.Dir(from.name), e.str)
+		candidates = []candidate{{path: path.Join(path.Dir(filepathToSlash(from.path)), e.str), fsys: from.fsys}}
+	}
+	f := c.files[name]
+	if f == nil {
+		var lastErr error = errors.New("no import path")
+		for _, cand := range candidates {
+			if f, lastErr = c.load(name, cand.path, cand.fsys); lastErr == nil {
+				break
+			}
+			var perr *Error
+			if errors.As(lastErr, &perr) {
+				return nil, lastErr
+			}
+		}
+		if f == nil {
+			return nil, c.errorf(e.pos, "import %q: %v", e.str, lastErr)
+		}
+	}
+	for _, imp := range from.imports {
+		if imp.file == f {
+			return f, nil
+		}
+	}
+	from.imports = append(from.imports, fileImport{name: e.str, file: f})
+	return f, nil
+}
+
+func (c *compiler) register(n *node) error {
+	if other := c.ids[n.id]; other != nil {
+		return c.errorf(n.decl.pos, "duplicate ID @0x%016x (also used by %s)", n.id, other.displayName)
+	}
+	c.ids[n.id] = n
+	return nil
+}
+
+// addNested creates nodes for the declarations nested in parent.
+// Declarations inside groups and unions belong to the enclosing
+// struct.
+func (c *compiler) addNested(parent *node) error {
+	parent.members = make(map[string]*node)
+	var walk func(members []*decl) error
+	walk = func(members []*decl) error {
+		for _, d := range members {
+			switch d.kind {
+			case declUnion, declGroup:
+				if err := walk(d.members); err != nil {
+					return err
+				}
+				continue
+			case declUsing, declConst, declEnum, declStruct, declInterface, declAnnotation:
+			default:
+				continue
+			}
+			if parent.members[d.name] != nil {
+				return c.errorf(d.pos, "%s is already defined in this scope", d.name)
+			}
+			n := &node{
+				kind:   d.kind,
+				decl:   d,
+				file:   parent.file,
+				parent: parent,
+				name:   d.name,
+			}
+			parent.members[d.name] = n
+			if d.kind == declUsing {
+				continue
+			}
+			n.id = d.id
+			if !d.hasID {
+				n.id = childID(parent.id, d.name)
+			}
+			sep := "."
+			if parent.kind == declFile {
+				sep = ":"
+			}
+			n.displayName = parent.displayName + sep + d.name
+			n.prefixLen = len(parent.displayName) + len(sep)
+			parent.nested = append(parent.nested, n)
+			if err := c.register(n); err != nil {
+				return err
+			}
+			if err := c.addNested(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(parent.decl.members)
+}
+
+// compile compiles every loaded file and builds the request.
+func (c *compiler) compile(requested []*file) (schema.CodeGeneratorRequest, error) {
+	// Resolve imports and aliases eagerly so that all files that are
+	// referenced are loaded before layout begins.
+	for i := 0; i < len(c.order); i++ {
+		if err := c.resolveAliases(c.order[i].root); err != nil {
+			return schema.CodeGeneratorRequest{}, err
+		}
+	}
+	for i := 0; i < len(c.order); i++ {
+		if err := c.layoutAll(c.order[i].root); err != nil {
+			return schema.CodeGeneratorRequest{}, err
+		}
+	}
+
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	req, err := schema.NewRootCodeGeneratorRequest(seg)
+	if err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	e := &emitter{c: c, seg: seg}
+	for i := 0; i < len(c.order); i++ {
+		if err := e.emitFile(c.order[i]); err != nil {
+			return schema.CodeGeneratorRequest{}, err
+		}
+	}
+	if err := e.finish(req, requested); err != nil {
+		return schema.CodeGeneratorRequest{}, err
+	}
+	return req, nil
+}
@@ -0,0 +1,279 @@
+package compiler_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/encoding/text"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/schemas/compiler"
+	"capnproto.org/go/capnp/v3/std"
+	"capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+func init() {
+	schema.RegisterSchema(schemas.DefaultRegistry)
+}
+
+// TestCompileMatchesReference compiles schemas whose requests were
+// produced by the reference capnp tool, and checks that the nodes and
+// source info are identical.
+func TestCompileMatchesReference(t *testing.T) {
+	std, err := filepath.Abs(filepath.Join("..", "..", "std"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		dir  string // directory that the reference tool ran in
+		file string
+		want string
+	}{
+		{"../../capnpc-go/testdata", "const.capnp", "const.capnp.out"},
+		{"../../capnpc-go/testdata", "generics.capnp", "generics.capnp.out"},
+		{"../../capnpc-go/testdata", "go.capnp", "go.capnp.out"},
+		{"../../capnpc-go/testdata", "group.capnp", "group.capnp.out"},
+		{"../../capnpc-go/testdata", "scopes.capnp", "scopes.capnp.out"},
+		{"../..", "encoding/text/testdata/txt.capnp", "encoding/text/testdata/txt.capnp.out"},
+	}
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			data, err := os.ReadFile(filepath.Join(test.dir, test.want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg, err := capnp.Unmarshal(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := schema.ReadRootCodeGeneratorRequest(msg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := compiler.Compile(&compiler.Options{
+				ImportPath: []string{std},
+				ReadFile: func(name string) ([]byte, error) {
+					if !filepath.IsAbs(name) {
+						name = filepath.Join(test.dir, name)
+					}
+					return os.ReadFile(name)
+				},
+			}, test.file)
+			if err != nil {
+				t.Fatal("Compile:", err)
+			}
+
+			wantNodes, wantInfo := dumpRequest(t, want)
+			gotNodes, gotInfo := dumpRequest(t, got)
+			for id, w := range wantNodes {
+				if g, ok := gotNodes[id]; !ok {
+					t.Errorf("missing node @%#x: %s", id, w)
+				} else if g != w {
+					t.Errorf("node @%#x =\n%s\nwant:\n%s", id, g, w)
+				}
+			}
+			for id, w := range wantInfo {
+				if _, ok := wantNodes[id]; !ok {
+					continue
+				}
+				if g := gotInfo[id]; g != w {
+					t.Errorf("source info @%#x =\n%s\nwant:\n%s", id, g, w)
+				}
+			}
+		})
+	}
+}
+
+// dumpRequest returns the text representation of the nodes and source
+// info in req, keyed by node ID.
+func dumpRequest(t *testing.T, req schema.CodeGeneratorRequest) (nodes, info map[uint64]string) {
+	t.Helper()
+	nodes = make(map[uint64]string)
+	info = make(map[uint64]string)
+	nl, err := req.Nodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < nl.Len(); i++ {
+		s, err := text.Marshal(schema.Node_TypeID, capnp.Struct(nl.At(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes[nl.At(i).Id()] = s
+	}
+	il, err := req.SourceInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < il.Len(); i++ {
+		s, err := text.Marshal(schema.Node_SourceInfo_TypeID, capnp.Struct(il.At(i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		info[il.At(i).Id()] = s
+	}
+	return nodes, info
+}
+
+func TestCompileString(t *testing.T) {
+	req, err := compiler.CompileString(nil, "foo.capnp", `
+@0xa93fc509624c72d9;
+
+# A person.
+struct Person {
+  name @0 :Text;
+  age @1 :UInt8 = 42;
+  union {
+    none @2 :Void;
+    email @3 :Text;
+  }
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files, err := req.RequestedFiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if files.Len() != 1 || files.At(0).Id() != 0xa93fc509624c72d9 {
+		t.Fatalf("requested files = %d, first ID @%#x; want 1 with ID @0xa93fc509624c72d9", files.Len(), files.At(0).Id())
+	}
+	nodes, _ := dumpRequest(t, req)
+
+	// The ID of an unannotated declaration is derived from its parent's
+	// ID and its name.
+	const personID = 0xb0adb1a7b904dbf3
+	got, ok := nodes[personID]
+	if !ok {
+		t.Fatalf("no node for Person with ID @%#x", uint64(personID))
+	}
+	for _, want := range []string{
+		`displayName = "foo.capnp:Person"`,
+		`dataWordCount = 1, pointerCount = 2`,
+		`discriminantCount = 2, discriminantOffset = 1`,
+		`(name = "age", codeOrder = 1, annotations = [], discriminantValue = 65535, slot = (offset = 0, type = (uint8 = void), defaultValue = (uint8 = 42), hadExplicitDefault = true), ordinal = (explicit = 1))`,
+		`(name = "email", codeOrder = 3, annotations = [], discriminantValue = 1, slot = (offset = 1, type = (text = void)`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Person node = %s; want it to contain %s", got, want)
+		}
+	}
+}
+
+func TestCompileStd(t *testing.T) {
+	src := `
+@0xd7a0c2d8e6d4a1b3;
+using Go = import "/go.capnp";
+$Go.package("foo");
+struct Foo {}
+`
+	if _, err := compiler.CompileString(nil, "foo.capnp", src); err == nil {
+		t.Error("CompileString without Std succeeded; want import error")
+	}
+	req, err := compiler.CompileString(&compiler.Options{Std: std.Schemas}, "foo.capnp", src)
+	if err != nil {
+		t.Fatal("CompileString with Std:", err)
+	}
+	nodes, _ := dumpRequest(t, req)
+	const goPackageID = 0xbea97f1023792be0
+	if _, ok := nodes[goPackageID]; !ok {
+		t.Errorf("no node for $Go.package with ID @%#x", uint64(goPackageID))
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "syntax",
+			src:  "@0xa93fc509624c72d9;\nstruct Foo {\n  x @0 Int32;\n}\n",
+			want: "bad.capnp:3:",
+		},
+		{
+			name: "missing file ID",
+			src:  "struct Foo {}\n",
+			want: "bad.capnp:1:",
+		},
+		{
+			name: "unknown type",
+			src:  "@0xa93fc509624c72d9;\nstruct Foo {\n  x @0 :Bar;\n}\n",
+			want: "bad.capnp:3:",
+		},
+		{
+			name: "duplicate name",
+			src:  "@0xa93fc509624c72d9;\nstruct Foo {}\nstruct Foo {}\n",
+			want: "bad.capnp:3:",
+		},
+	}
+	for _, test := range tests {
+		_, err := compiler.CompileString(nil, "bad.capnp", test.src)
+		var cerr *compiler.Error
+		if !errors.As(err, &cerr) {
+			t.Errorf("%s: error = %v; want *compiler.Error", test.name, err)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), test.want) {
+			t.Errorf("%s: error = %v; want position %s", test.name, err, test.want)
+		}
+	}
+}
+
+func TestNewID(t *testing.T) {
+	id, err := compiler.NewID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id&(1<<63) == 0 {
+		t.Errorf("NewID() = @%#x; want high bit set", id)
+	}
+}
+
+func TestDeriveID(t *testing.T) {
+	// Person in TestCompileString has no explicit ID.
+	const fileID, personID = 0xa93fc509624c72d9, 0xb0adb1a7b904dbf3
+	if got := compiler.DeriveID(fileID, "Person"); got != personID {
+		t.Errorf("DeriveID(@%#x, \"Person\") = @%#x; want @%#x", uint64(fileID), got, uint64(personID))
+	}
+}
+
+func TestCheckIDs(t *testing.T) {
+	files := map[string]string{
+		"a.capnp":          "@0xa93fc509624c72d9;\nusing import \"/common.capnp\".Common;\nstruct A @0xd0b2a5b4c7a95b2d {}\n",
+		"b.capnp":          "@0xe6cd2b7f0b3b9f01;\nusing import \"inc/common.capnp\".Common;\nstruct B @0xd0b2a5b4c7a95b2d {}\n",
+		"c.capnp":          "@0xa93fc509624c72d9;\nstruct C {}\n",
+		"inc/common.capnp": "@0xf00dbeefcafe1234;\nstruct Common {}\n",
+	}
+	opts := &compiler.Options{
+		ImportPath: []string{"inc"},
+		ReadFile: func(name string) ([]byte, error) {
+			src, ok := files[filepath.ToSlash(name)]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return []byte(src), nil
+		},
+	}
+	dups, err := compiler.CheckIDs(opts, "a.capnp", "b.capnp", "c.capnp", "inc/common.capnp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []compiler.DuplicateID{
+		{ID: 0xa93fc509624c72d9, Names: []string{"a.capnp", "c.capnp"}},
+		{ID: 0xd0b2a5b4c7a95b2d, Names: []string{"a.capnp:A", "b.capnp:B"}},
+	}
+	if len(dups) != len(want) {
+		t.Fatalf("CheckIDs(...) = %v; want %v", dups, want)
+	}
+	for i := range want {
+		if dups[i].ID != want[i].ID || strings.Join(dups[i].Names, " ") != strings.Join(want[i].Names, " ") {
+			t.Errorf("CheckIDs(...)[%d] = %v; want %v", i, dups[i], want[i])
+		}
+	}
+}
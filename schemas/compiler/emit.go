@@ -0,0 +1,656 @@
+package compiler
+
+import (
+	"sort"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+// An emitter writes compiled nodes to a CodeGeneratorRequest.
+type emitter struct {
+	c   *compiler
+	seg *capnp.Segment
+
+	nodes []func(schema.Node) error
+	infos []sourceInfo
+}
+
+type sourceInfo struct {
+	id      uint64
+	doc     string
+	members []string
+}
+
+func (e *emitter) emitFile(f *file) error {
+	if err := e.c.layoutAll(f.root); err != nil {
+		return err
+	}
+	return e.emitNode(f.root)
+}
+
+func (e *emitter) emitNode(n *node) error {
+	c := e.c
+	sc := scopeOf(n)
+	info := sourceInfo{id: n.id, doc: n.decl.doc}
+	switch n.kind {
+	case declFile:
+		e.add(n.id, n.displayName, n.prefixLen, 0, nil, false, n.nested, func(nb schema.Node) error {
+			nb.SetFile()
+			return e.annotations(sc, n.decl.annotations, "file", nb.NewAnnotations)
+		})
+
+	case declStruct:
+		sn, err := c.structOf(n)
+		if err != nil {
+			return err
+		}
+		return e.emitStruct(sn, n)
+
+	case declEnum:
+		enumerants := ordered(n.decl.members, declEnumerant)
+		for _, en := range enumerants {
+			info.members = append(info.members, en.d.doc)
+		}
+		e.add(n.id, n.displayName, n.prefixLen, n.parent.id, nil, n.isGeneric(), n.nested, func(nb schema.Node) error {
+			if err := e.annotations(sc, n.decl.annotations, "enum", nb.NewAnnotations); err != nil {
+				return err
+			}
+			nb.SetEnum()
+			list, err := nb.Enum().NewEnumerants(int32(len(enumerants)))
+			if err != nil {
+				return err
+			}
+			for i, en := range enumerants {
+				eb := list.At(i)
+				if err := eb.SetName(en.d.name); err != nil {
+					return err
+				}
+				eb.SetCodeOrder(uint16(en.codeOrder))
+				if err := e.annotations(sc, en.d.annotations, "enumerant", eb.NewAnnotations); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	case declInterface:
+		methods := ordered(n.decl.members, declMethod)
+		for _, m := range methods {
+			info.members = append(info.members, m.d.doc)
+		}
+		e.add(n.id, n.displayName, n.prefixLen, n.parent.id, n.decl.params, n.isGeneric(), n.nested, func(nb schema.Node) error {
+			if err := e.annotations(sc, n.decl.annotations, "interface", nb.NewAnnotations); err != nil {
+				return err
+			}
+			nb.SetInterface()
+			iface := nb.Interface()
+			supers, err := iface.NewSuperclasses(int32(len(n.decl.extends)))
+			if err != nil {
+				return err
+			}
+			for i, x := range n.decl.extends {
+				r, err := c.resolve(sc, x)
+				if err != nil {
+					return err
+				}
+				if r.kind != refNode || r.node.kind != declInterface {
+					return c.errorf(x.pos, "%s is not an interface", x)
+				}
+				sb := supers.At(i)
+				sb.SetId(r.node.id)
+				if err := e.brand(r.brand, sb.NewBrand); err != nil {
+					return err
+				}
+			}
+			list, err := iface.NewMethods(int32(len(methods)))
+			if err != nil {
+				return err
+			}
+			for i, m := range methods {
+				if err := e.method(n, m.d, m.codeOrder, list.At(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		e.infos = append(e.infos, info)
+		for _, m := range methods {
+			mi := c.methods[m.d]
+			for _, id := range []uint64{mi.paramID, mi.resultID} {
+				if ps := c.structs[id]; ps != nil && ps.isParam && ps.decl == m.d {
+					if err := e.emitStruct(ps, nil); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return e.emitNested(n)
+
+	case declConst:
+		psc := scopeOf(n.parent)
+		e.add(n.id, n.displayName, n.prefixLen, n.parent.id, nil, n.isGeneric(), n.nested, func(nb schema.Node) error {
+			if err := e.annotations(psc, n.decl.annotations, "const", nb.NewAnnotations); err != nil {
+				return err
+			}
+			t, err := c.typeOf(psc, n.decl.typ)
+			if err != nil {
+				return err
+			}
+			nb.SetConst()
+			tb, err := nb.Const().NewType()
+			if err != nil {
+				return err
+			}
+			if err := e.typ(t, tb); err != nil {
+				return err
+			}
+			v, err := nb.Const().NewValue()
+			if err != nil {
+				return err
+			}
+			return c.setValue(e.seg, psc, n.decl.value, t, v)
+		})
+
+	case declAnnotation:
+		psc := scopeOf(n.parent)
+		e.add(n.id, n.displayName, n.prefixLen, n.parent.id, nil, n.isGeneric(), n.nested, func(nb schema.Node) error {
+			if err := e.annotations(psc, n.decl.annotations, "annotation", nb.NewAnnotations); err != nil {
+				return err
+			}
+			t, err := c.typeOf(psc, n.decl.typ)
+			if err != nil {
+				return err
+			}
+			nb.SetAnnotation()
+			ab := nb.Annotation()
+			tb, err := ab.NewType()
+			if err != nil {
+				return err
+			}
+			if err := e.typ(t, tb); err != nil {
+				return err
+			}
+			for _, target := range n.decl.targets {
+				all := target == "*"
+				if !all && !validTarget(target) {
+					return c.errorf(n.decl.pos, "unknown annotation target %s", target)
+				}
+				set := func(name string, f func(bool)) {
+					if all || target == name {
+						f(true)
+					}
+				}
+				set("file", ab.SetTargetsFile)
+				set("const", ab.SetTargetsConst)
+				set("enum", ab.SetTargetsEnum)
+				set("enumerant", ab.SetTargetsEnumerant)
+				set("struct", ab.SetTargetsStruct)
+				set("field", ab.SetTargetsField)
+				set("union", ab.SetTargetsUnion)
+				set("group", ab.SetTargetsGroup)
+				set("interface", ab.SetTargetsInterface)
+				set("method", ab.SetTargetsMethod)
+				set("param", ab.SetTargetsParam)
+				set("annotation", ab.SetTargetsAnnotation)
+			}
+			return nil
+		})
+	}
+	e.infos = append(e.infos, info)
+	return e.emitNested(n)
+}
+
+func (e *emitter) emitNested(n *node) error {
+	for _, m := range n.nested {
+		if err := e.emitNode(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var annotationTargets = []string{
+	"file", "const", "enum", "enumerant", "struct", "field", "union",
+	"group", "interface", "method", "param", "annotation",
+}
+
+func validTarget(name string) bool {
+	for _, t := range annotationTargets {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+type orderedDecl struct {
+	d         *decl
+	codeOrder int
+}
+
+// ordered returns the members of the given kind sorted by ordinal,
+// along with their position in declaration order.
+func ordered(members []*decl, kind declKind) []orderedDecl {
+	var list []orderedDecl
+	for _, d := range members {
+		if d.kind == kind {
+			list = append(list, orderedDecl{d: d, codeOrder: len(list)})
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].d.ordinal < list[j].d.ordinal
+	})
+	return list
+}
+
+// emitStruct emits a struct and its groups.  n is nil for groups and
+// parameter structs.
+func (e *emitter) emitStruct(sn *structNode, n *node) error {
+	info := sourceInfo{id: sn.id, doc: sn.decl.doc}
+	if sn.isParam {
+		info.doc = ""
+	}
+	for _, f := range sn.fields {
+		info.members = append(info.members, f.decl.doc)
+	}
+	params := sn.params
+	var nested []*node
+	if n != nil {
+		params = n.decl.params
+		nested = n.nested
+	}
+	e.add(sn.id, sn.displayName, sn.prefixLen, sn.scopeID, params, sn.isGeneric, nested, func(nb schema.Node) error {
+		if n != nil {
+			if err := e.annotations(sn.sc, n.decl.annotations, "struct", nb.NewAnnotations); err != nil {
+				return err
+			}
+		}
+		nb.SetStructNode()
+		s := nb.StructNode()
+		s.SetDataWordCount(uint16(sn.top.dataWords))
+		s.SetPointerCount(uint16(sn.top.pointers))
+		s.SetPreferredListEncoding(schema.ElementSize_inlineComposite)
+		s.SetIsGroup(sn.isGroup)
+		if sn.hasUnion {
+			s.SetDiscriminantCount(sn.discriminantCount)
+			s.SetDiscriminantOffset(sn.discriminantOffset)
+		}
+		list, err := s.NewFields(int32(len(sn.fields)))
+		if err != nil {
+			return err
+		}
+		for i, f := range sn.fields {
+			if err := e.field(sn, f, list.At(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	e.infos = append(e.infos, info)
+	for _, g := range sn.groups {
+		if err := e.emitStruct(g, nil); err != nil {
+			return err
+		}
+	}
+	if n != nil {
+		return e.emitNested(n)
+	}
+	return nil
+}
+
+func (e *emitter) field(sn *structNode, f *field, fb schema.Field) error {
+	c := e.c
+	if err := fb.SetName(f.name); err != nil {
+		return err
+	}
+	fb.SetCodeOrder(uint16(f.codeOrder))
+	fb.SetDiscriminantValue(f.discValue)
+
+	target := "field"
+	switch {
+	case f.isParam:
+		target = "param"
+	case f.decl.kind == declGroup:
+		target = "group"
+	case f.decl.kind == declUnion:
+		target = "union"
+	}
+	if err := e.annotations(sn.sc, f.decl.annotations, target, fb.NewAnnotations); err != nil {
+		return err
+	}
+
+	if f.group != nil {
+		fb.SetGroup()
+		fb.Group().SetTypeId(f.group.id)
+		fb.Ordinal().SetImplicit()
+		return nil
+	}
+	fb.SetSlot()
+	slot := fb.Slot()
+	slot.SetOffset(f.offset)
+	tb, err := slot.NewType()
+	if err != nil {
+		return err
+	}
+	if err := e.typ(f.typ, tb); err != nil {
+		return err
+	}
+	v, err := slot.NewDefaultValue()
+	if err != nil {
+		return err
+	}
+	def := f.decl.value
+	if f.isParam && def != nil && def.kind == exprName && def.name == "null" {
+		if !f.typ.isPointer() {
+			return c.errorf(def.pos, "only pointer parameters can declare their default as null")
+		}
+		setZeroValue(f.typ, v)
+	} else if err := c.setValue(e.seg, sn.sc, def, f.typ, v); err != nil {
+		return err
+	}
+	slot.SetHadExplicitDefault(def != nil)
+	fb.Ordinal().SetExplicit(uint16(f.ordinal))
+	return nil
+}
+
+func (e *emitter) method(iface *node, d *decl, codeOrder int, mb schema.Method) error {
+	c := e.c
+	if err := mb.SetName(d.name); err != nil {
+		return err
+	}
+	mb.SetCodeOrder(uint16(codeOrder))
+	if len(d.implicitParams) > 0 {
+		list, err := mb.NewImplicitParameters(int32(len(d.implicitParams)))
+		if err != nil {
+			return err
+		}
+		for i, p := range d.implicitParams {
+			if err := list.At(i).SetName(p); err != nil {
+				return err
+			}
+		}
+	}
+	mi := c.methods[d]
+	mb.SetParamStructType(mi.paramID)
+	if err := e.brand(mi.paramBrand, mb.NewParamBrand); err != nil {
+		return err
+	}
+	mb.SetResultStructType(mi.resultID)
+	if err := e.brand(mi.resultBrand, mb.NewResultBrand); err != nil {
+		return err
+	}
+	return e.annotations(c.methodScope(iface, d), d.annotations, "method", mb.NewAnnotations)
+}
+
+// add queues a node to be built.
+func (e *emitter) add(id uint64, displayName string, prefixLen int, scopeID uint64, params []string, isGeneric bool, nested []*node, build func(schema.Node) error) {
+	e.nodes = append(e.nodes, func(nb schema.Node) error {
+		nb.SetId(id)
+		if err := nb.SetDisplayName(displayName); err != nil {
+			return err
+		}
+		nb.SetDisplayNamePrefixLength(uint32(prefixLen))
+		nb.SetScopeId(scopeID)
+		nb.SetIsGeneric(isGeneric)
+		if len(params) > 0 {
+			list, err := nb.NewParameters(int32(len(params)))
+			if err != nil {
+				return err
+			}
+			for i, p := range params {
+				if err := list.At(i).SetName(p); err != nil {
+					return err
+				}
+			}
+		}
+		if len(nested) > 0 {
+			list, err := nb.NewNestedNodes(int32(len(nested)))
+			if err != nil {
+				return err
+			}
+			for i, m := range nested {
+				if err := list.At(i).SetName(m.name); err != nil {
+					return err
+				}
+				list.At(i).SetId(m.id)
+			}
+		}
+		return build(nb)
+	})
+}
+
+// annotations compiles annotation applications on a declaration of
+// the given target kind.
+func (e *emitter) annotations(sc *scope, apps []*annotationApp, target string, newList func(int32) (schema.Annotation_List, error)) error {
+	if len(apps) == 0 {
+		return nil
+	}
+	c := e.c
+	list, err := newList(int32(len(apps)))
+	if err != nil {
+		return err
+	}
+	for i, a := range apps {
+		r, err := c.resolve(sc, a.name)
+		if err != nil {
+			return err
+		}
+		if r.kind != refNode || r.node.kind != declAnnotation {
+			return c.errorf(a.pos, "%s is not an annotation", a.name)
+		}
+		ann := r.node
+		allowed := false
+		for _, t := range ann.decl.targets {
+			if t == "*" || t == target {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return c.errorf(a.pos, "%s cannot be applied to this kind of declaration", a.name)
+		}
+		t, err := c.typeOf(scopeOf(ann.parent), ann.decl.typ)
+		if err != nil {
+			return err
+		}
+		ab := list.At(i)
+		ab.SetId(ann.id)
+		v, err := ab.NewValue()
+		if err != nil {
+			return err
+		}
+		if a.value == nil {
+			if t.which != schema.Type_Which_void {
+				return c.errorf(a.pos, "%s requires a value", a.name)
+			}
+			v.SetVoid()
+			continue
+		}
+		if err := c.setValue(e.seg, sc, a.value, t, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// brand writes the compiled form of b, if it binds or inherits any
+// generic parameters.
+func (e *emitter) brand(b *brandScope, newBrand func() (schema.Brand, error)) error {
+	levels := b.levels()
+	if len(levels) == 0 {
+		return nil
+	}
+	br, err := newBrand()
+	if err != nil {
+		return err
+	}
+	scopes, err := br.NewScopes(int32(len(levels)))
+	if err != nil {
+		return err
+	}
+	for i, l := range levels {
+		s := scopes.At(i)
+		s.SetScopeId(l.id)
+		if l.args == nil {
+			s.SetInherit()
+			continue
+		}
+		binds, err := s.NewBind(int32(len(l.args)))
+		if err != nil {
+			return err
+		}
+		for j, a := range l.args {
+			tb, err := binds.At(j).NewType()
+			if err != nil {
+				return err
+			}
+			if err := e.typ(a, tb); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// typ writes t to tb.
+func (e *emitter) typ(t *typ, tb schema.Type) error {
+	switch t.which {
+	case schema.Type_Which_void:
+		tb.SetVoid()
+	case schema.Type_Which_bool:
+		tb.SetBool()
+	case schema.Type_Which_int8:
+		tb.SetInt8()
+	case schema.Type_Which_int16:
+		tb.SetInt16()
+	case schema.Type_Which_int32:
+		tb.SetInt32()
+	case schema.Type_Which_int64:
+		tb.SetInt64()
+	case schema.Type_Which_uint8:
+		tb.SetUint8()
+	case schema.Type_Which_uint16:
+		tb.SetUint16()
+	case schema.Type_Which_uint32:
+		tb.SetUint32()
+	case schema.Type_Which_uint64:
+		tb.SetUint64()
+	case schema.Type_Which_float32:
+		tb.SetFloat32()
+	case schema.Type_Which_float64:
+		tb.SetFloat64()
+	case schema.Type_Which_text:
+		tb.SetText()
+	case schema.Type_Which_data:
+		tb.SetData()
+	case schema.Type_Which_list:
+		tb.SetList()
+		et, err := tb.List().NewElementType()
+		if err != nil {
+			return err
+		}
+		return e.typ(t.elem, et)
+	case schema.Type_Which_enum:
+		tb.SetEnum()
+		tb.Enum().SetTypeId(t.node.id)
+		return e.brand(t.brand, tb.Enum().NewBrand)
+	case schema.Type_Which_structType:
+		tb.SetStructType()
+		tb.StructType().SetTypeId(t.node.id)
+		return e.brand(t.brand, tb.StructType().NewBrand)
+	case schema.Type_Which_interface:
+		tb.SetInterface()
+		tb.Interface().SetTypeId(t.node.id)
+		return e.brand(t.brand, tb.Interface().NewBrand)
+	case schema.Type_Which_anyPointer:
+		tb.SetAnyPointer()
+		ap := tb.AnyPointer()
+		switch {
+		case t.param:
+			ap.SetParameter()
+			ap.Parameter().SetScopeId(t.paramScope)
+			ap.Parameter().SetParameterIndex(uint16(t.paramIndex))
+		case t.implicit:
+			ap.SetImplicitMethodParameter()
+			ap.ImplicitMethodParameter().SetParameterIndex(uint16(t.paramIndex))
+		default:
+			ap.SetUnconstrained()
+			u := ap.Unconstrained()
+			switch t.anyKind {
+			case schema.Type_anyPointer_unconstrained_Which_struct:
+				u.SetStruct()
+			case schema.Type_anyPointer_unconstrained_Which_list:
+				u.SetList()
+			case schema.Type_anyPointer_unconstrained_Which_capability:
+				u.SetCapability()
+			default:
+				u.SetAnyKind()
+			}
+		}
+	}
+	return nil
+}
+
+// finish builds the nodes and fills in the request.
+func (e *emitter) finish(req schema.CodeGeneratorRequest, requested []*file) error {
+	nodes, err := req.NewNodes(int32(len(e.nodes)))
+	if err != nil {
+		return err
+	}
+	for i, build := range e.nodes {
+		if err := build(nodes.At(i)); err != nil {
+			return err
+		}
+	}
+
+	infos, err := req.NewSourceInfo(int32(len(e.infos)))
+	if err != nil {
+		return err
+	}
+	for i, info := range e.infos {
+		ib := infos.At(i)
+		ib.SetId(info.id)
+		if info.doc != "" {
+			if err := ib.SetDocComment(info.doc); err != nil {
+				return err
+			}
+		}
+		members, err := ib.NewMembers(int32(len(info.members)))
+		if err != nil {
+			return err
+		}
+		for j, doc := range info.members {
+			if doc == "" {
+				continue
+			}
+			if err := members.At(j).SetDocComment(doc); err != nil {
+				return err
+			}
+		}
+	}
+
+	files, err := req.NewRequestedFiles(int32(len(requested)))
+	if err != nil {
+		return err
+	}
+	for i, f := range requested {
+		fb := files.At(i)
+		fb.SetId(f.root.id)
+		if err := fb.SetFilename(f.name); err != nil {
+			return err
+		}
+		imports, err := fb.NewImports(int32(len(f.imports)))
+		if err != nil {
+			return err
+		}
+		for j, imp := range f.imports {
+			ib := imports.At(j)
+			ib.SetId(imp.file.root.id)
+			if err := ib.SetName(imp.name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
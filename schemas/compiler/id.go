@@ -0,0 +1,129 @@
+package compiler
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// These functions derive IDs the same way as the reference capnp
+// compiler, so that schemas compiled by this package produce the same
+// node IDs as schemas compiled by capnp.
+
+func idFromHash(sum [md5.Size]byte) uint64 {
+	return binary.BigEndian.Uint64(sum[:8]) | 1<<63
+}
+
+// childID returns the ID of a named declaration nested in parent.
+func childID(parent uint64, name string) uint64 {
+	b := make([]byte, 8, 8+len(name))
+	binary.LittleEndian.PutUint64(b, parent)
+	b = append(b, name...)
+	return idFromHash(md5.Sum(b))
+}
+
+// groupID returns the ID of the group or named union at index in the
+// parent's field list.
+func groupID(parent uint64, index uint16) uint64 {
+	var b [10]byte
+	binary.LittleEndian.PutUint64(b[:8], parent)
+	binary.LittleEndian.PutUint16(b[8:], index)
+	return idFromHash(md5.Sum(b[:]))
+}
+
+// methodParamsID returns the ID of the implicit parameter or result
+// struct of the method with the given ordinal.
+func methodParamsID(parent uint64, ordinal uint16, isResults bool) uint64 {
+	var b [11]byte
+	binary.LittleEndian.PutUint64(b[:8], parent)
+	binary.LittleEndian.PutUint16(b[8:10], ordinal)
+	if isResults {
+		b[10] = 1
+	}
+	return idFromHash(md5.Sum(b[:]))
+}
+
+// NewID returns a new random ID suitable for a file declaration.
+func NewID() (uint64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b[:]) | 1<<63, nil
+}
+
+// DeriveID returns the ID that the compiler assigns to a declaration
+// named name, nested in the declaration with ID parent, that does not
+// have an explicit ID.  It can be used to predict the IDs of generated
+// types, or to give a declaration an explicit ID that keeps it stable
+// when it is renamed or moved.
+func DeriveID(parent uint64, name string) uint64 {
+	return childID(parent, name)
+}
+
+// A DuplicateID is an ID that is used by more than one declaration.
+type DuplicateID struct {
+	ID uint64
+
+	// Names are the declarations that use the ID, like
+	// "dir/foo.capnp:Bar", where the file name is the name it was read
+	// from.  They are sorted.
+	Names []string
+}
+
+// CheckIDs compiles each of the named files separately, along with the
+// files that it imports, and returns the IDs that are used by more than
+// one declaration across all of them, sorted by ID.  Unlike Compile,
+// which rejects duplicate IDs among the files it compiles together,
+// CheckIDs finds duplicates between files that do not import each
+// other, such as a file ID that was copied into a new file.
+//
+// A file that is imported under different names is recognized as the
+// same file if it is read from the same path.  CheckIDs returns an
+// error if a file fails to compile.
+func CheckIDs(opts *Options, files ...string) ([]DuplicateID, error) {
+	// names maps IDs to the declarations that use them, keyed by the
+	// absolute path of the file that they were read from.
+	names := make(map[uint64]map[string]string)
+	for _, name := range files {
+		c := newCompiler(opts)
+		f, err := c.load(path.Clean(filepathToSlash(name)), name, nil)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := c.compile([]*file{f}); err != nil {
+			return nil, err
+		}
+		for id, n := range c.ids {
+			if names[id] == nil {
+				names[id] = make(map[string]string)
+			}
+			decl := n.displayName[len(n.file.name):]
+			abs, err := filepath.Abs(n.file.path)
+			if err != nil {
+				abs = n.file.path
+			}
+			if _, ok := names[id][abs+decl]; !ok {
+				names[id][abs+decl] = path.Clean(filepathToSlash(n.file.path)) + decl
+			}
+		}
+	}
+
+	var dups []DuplicateID
+	for id, set := range names {
+		if len(set) < 2 {
+			continue
+		}
+		d := DuplicateID{ID: id}
+		for _, name := range set {
+			d.Names = append(d.Names, name)
+		}
+		sort.Strings(d.Names)
+		dups = append(dups, d)
+	}
+	sort.Slice(dups, func(i, j int) bool { return dups[i].ID < dups[j].ID })
+	return dups, nil
+}
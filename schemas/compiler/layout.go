@@ -0,0 +1,331 @@
+package compiler
+
+// This file implements struct field layout.  The algorithm must match
+// the reference capnp compiler exactly, since field offsets are part
+// of the wire format.
+
+const lgWordBits = 6
+
+// A holeSet records unused space in a data section: at most one hole
+// of each power-of-two size from 1 bit to 32 bits.  holes[lg] is the
+// offset of the hole of size 2^lg bits, in multiples of its size, or
+// zero if there is no such hole.  No hole can be at offset zero, since
+// the first field allocated is always placed at the start.
+type holeSet [lgWordBits]uint32
+
+func (h *holeSet) tryAllocate(lg uint) (uint32, bool) {
+	if lg >= uint(len(h)) {
+		return 0, false
+	}
+	if h[lg] != 0 {
+		off := h[lg]
+		h[lg] = 0
+		return off, true
+	}
+	next, ok := h.tryAllocate(lg + 1)
+	if !ok {
+		return 0, false
+	}
+	off := next * 2
+	h[lg] = off + 1
+	return off, true
+}
+
+// addHolesAtEnd adds holes of sizes [lg, limit) starting at offset,
+// after a field of size 2^lg was allocated from a larger space.
+func (h *holeSet) addHolesAtEnd(lg uint, offset uint32, limit uint) {
+	for lg < limit {
+		h[lg] = offset
+		lg++
+		offset = (offset + 1) / 2
+	}
+}
+
+// tryExpand tries to grow the value of size 2^oldLg at oldOffset by
+// 2^factor by combining it with the holes that follow it.
+func (h *holeSet) tryExpand(oldLg uint, oldOffset uint32, factor uint) bool {
+	if factor == 0 {
+		return true
+	}
+	if oldLg == uint(len(h)) {
+		return false
+	}
+	if h[oldLg] != oldOffset+1 {
+		return false
+	}
+	if !h.tryExpand(oldLg+1, oldOffset>>1, factor-1) {
+		return false
+	}
+	h[oldLg] = 0
+	return true
+}
+
+func (h *holeSet) smallestAtLeast(lg uint) (uint, bool) {
+	for i := lg; i < uint(len(h)); i++ {
+		if h[i] != 0 {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// A fieldScope is a struct or group to which fields can be added.
+// Offsets are returned in multiples of the field size.
+type fieldScope interface {
+	addVoid()
+	addData(lg uint) uint32
+	addPointer() uint32
+	tryExpandData(oldLg uint, oldOffset uint32, factor uint) bool
+}
+
+// topLayout is the layout of a struct's own sections.
+type topLayout struct {
+	dataWords uint32
+	pointers  uint32
+	holes     holeSet
+}
+
+func (t *topLayout) addVoid() {}
+
+func (t *topLayout) addData(lg uint) uint32 {
+	if off, ok := t.holes.tryAllocate(lg); ok {
+		return off
+	}
+	off := t.dataWords << (lgWordBits - lg)
+	t.dataWords++
+	t.holes.addHolesAtEnd(lg, off+1, lgWordBits)
+	return off
+}
+
+func (t *topLayout) addPointer() uint32 {
+	t.pointers++
+	return t.pointers - 1
+}
+
+func (t *topLayout) tryExpandData(oldLg uint, oldOffset uint32, factor uint) bool {
+	return t.holes.tryExpand(oldLg, oldOffset, factor)
+}
+
+type dataLocation struct {
+	lg     uint
+	offset uint32
+}
+
+func (loc *dataLocation) tryExpandTo(u *unionLayout, newLg uint) bool {
+	if newLg <= loc.lg {
+		return true
+	}
+	if !u.parent.tryExpandData(loc.lg, loc.offset, newLg-loc.lg) {
+		return false
+	}
+	loc.offset >>= newLg - loc.lg
+	loc.lg = newLg
+	return true
+}
+
+// unionLayout tracks the space shared by the members of a union.
+type unionLayout struct {
+	parent             fieldScope
+	groupCount         int
+	hasDiscriminant    bool
+	discriminantOffset uint32
+	dataLocations      []dataLocation
+	pointerLocations   []uint32
+}
+
+func (u *unionLayout) addNewDataLocation(lg uint) uint32 {
+	off := u.parent.addData(lg)
+	u.dataLocations = append(u.dataLocations, dataLocation{lg: lg, offset: off})
+	return off
+}
+
+func (u *unionLayout) addNewPointerLocation() uint32 {
+	off := u.parent.addPointer()
+	u.pointerLocations = append(u.pointerLocations, off)
+	return off
+}
+
+func (u *unionLayout) newGroupAddingFirstMember() {
+	u.groupCount++
+	if u.groupCount == 2 {
+		u.addDiscriminant()
+	}
+}
+
+func (u *unionLayout) addDiscriminant() bool {
+	if u.hasDiscriminant {
+		return false
+	}
+	u.discriminantOffset = u.parent.addData(4)
+	u.hasDiscriminant = true
+	return true
+}
+
+// locationUsage tracks how much of a union data location is used by a
+// single member group.
+type locationUsage struct {
+	used   bool
+	lgUsed uint
+	holes  holeSet
+}
+
+func (lu *locationUsage) smallestHoleAtLeast(loc *dataLocation, lg uint) (uint, bool) {
+	switch {
+	case !lu.used:
+		if lg <= loc.lg {
+			return loc.lg, true
+		}
+		return 0, false
+	case lg >= lu.lgUsed:
+		if lg < loc.lg {
+			return lg, true
+		}
+		return 0, false
+	}
+	if hole, ok := lu.holes.smallestAtLeast(lg); ok {
+		return hole, true
+	}
+	if lu.lgUsed < loc.lg {
+		return lu.lgUsed, true
+	}
+	return 0, false
+}
+
+func (lu *locationUsage) allocateFromHole(loc *dataLocation, lg uint) uint32 {
+	base := loc.offset << (loc.lg - lg)
+	switch {
+	case !lu.used:
+		lu.used = true
+		lu.lgUsed = lg
+		return base
+	case lg >= lu.lgUsed:
+		// Expand to double the requested size and return the second half.
+		lu.holes.addHolesAtEnd(lu.lgUsed, 1, lg)
+		lu.lgUsed = lg + 1
+		return base + 1
+	}
+	if off, ok := lu.holes.tryAllocate(lg); ok {
+		return base + off
+	}
+	// Double the used space and allocate from the new half.
+	off := uint32(1) << (lu.lgUsed - lg)
+	lu.holes.addHolesAtEnd(lg, off+1, lu.lgUsed)
+	lu.lgUsed++
+	return base + off
+}
+
+func (lu *locationUsage) tryAllocateByExpanding(u *unionLayout, loc *dataLocation, lg uint) (uint32, bool) {
+	if !lu.used {
+		if !loc.tryExpandTo(u, lg) {
+			return 0, false
+		}
+		lu.used = true
+		lu.lgUsed = lg
+		return loc.offset << (loc.lg - lg), true
+	}
+	newLg := lu.lgUsed
+	if lg > newLg {
+		newLg = lg
+	}
+	newLg++
+	if !lu.tryExpandUsage(u, loc, newLg, true) {
+		return 0, false
+	}
+	off, _ := lu.holes.tryAllocate(lg)
+	return loc.offset<<(loc.lg-lg) + off, true
+}
+
+func (lu *locationUsage) tryExpand(u *unionLayout, loc *dataLocation, oldLg uint, oldOffset uint32, factor uint) bool {
+	if oldOffset == 0 && lu.lgUsed == oldLg {
+		// The location holds exactly this value, so expand the whole thing.
+		return lu.tryExpandUsage(u, loc, oldLg+factor, false)
+	}
+	return lu.holes.tryExpand(oldLg, oldOffset, factor)
+}
+
+func (lu *locationUsage) tryExpandUsage(u *unionLayout, loc *dataLocation, lg uint, newHoles bool) bool {
+	if lg > loc.lg && !loc.tryExpandTo(u, lg) {
+		return false
+	}
+	if newHoles {
+		lu.holes.addHolesAtEnd(lu.lgUsed, 1, lg)
+	}
+	lu.lgUsed = lg
+	return true
+}
+
+// groupLayout is the layout of one member of a union.  It allocates
+// space from the union's locations, which are shared with the other
+// members.
+type groupLayout struct {
+	parent       *unionLayout
+	hasMembers   bool
+	dataUsage    []locationUsage
+	pointerUsage int
+}
+
+func (g *groupLayout) addMember() {
+	if !g.hasMembers {
+		g.hasMembers = true
+		g.parent.newGroupAddingFirstMember()
+	}
+}
+
+func (g *groupLayout) addVoid() {
+	g.addMember()
+	// A zero-size member still counts toward the outer union's
+	// discriminant allocation.
+	g.parent.parent.addVoid()
+}
+
+func (g *groupLayout) addData(lg uint) uint32 {
+	g.addMember()
+
+	best, bestSize := -1, ^uint(0)
+	for i := range g.parent.dataLocations {
+		if len(g.dataUsage) == i {
+			g.dataUsage = append(g.dataUsage, locationUsage{})
+		}
+		if hole, ok := g.dataUsage[i].smallestHoleAtLeast(&g.parent.dataLocations[i], lg); ok && hole < bestSize {
+			best, bestSize = i, hole
+		}
+	}
+	if best >= 0 {
+		return g.dataUsage[best].allocateFromHole(&g.parent.dataLocations[best], lg)
+	}
+
+	// No hole is big enough; try to expand an existing location.
+	for i := range g.dataUsage {
+		if off, ok := g.dataUsage[i].tryAllocateByExpanding(g.parent, &g.parent.dataLocations[i], lg); ok {
+			return off
+		}
+	}
+
+	off := g.parent.addNewDataLocation(lg)
+	g.dataUsage = append(g.dataUsage, locationUsage{used: true, lgUsed: lg})
+	return off
+}
+
+func (g *groupLayout) addPointer() uint32 {
+	g.addMember()
+	if g.pointerUsage < len(g.parent.pointerLocations) {
+		g.pointerUsage++
+		return g.parent.pointerLocations[g.pointerUsage-1]
+	}
+	g.pointerUsage++
+	return g.parent.addNewPointerLocation()
+}
+
+func (g *groupLayout) tryExpandData(oldLg uint, oldOffset uint32, factor uint) bool {
+	if oldLg+factor > lgWordBits || oldOffset&(1<<factor-1) != 0 {
+		return false
+	}
+	for i := range g.dataUsage {
+		loc := &g.parent.dataLocations[i]
+		if loc.lg >= oldLg && oldOffset>>(loc.lg-oldLg) == loc.offset {
+			local := oldOffset - loc.offset<<(loc.lg-oldLg)
+			return g.dataUsage[i].tryExpand(g.parent, loc, oldLg, local, factor)
+		}
+	}
+	return false
+}
@@ -0,0 +1,308 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokFloat
+	tokString
+	tokBinary // 0x"..." data literal
+	tokPunct
+)
+
+// A pos is a position in a source file.
+type pos struct {
+	file string
+	line int
+	col  int
+}
+
+func (p pos) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.file, p.line, p.col)
+}
+
+type token struct {
+	kind tokenKind
+	text string // identifier, punctuation, raw number, or decoded string
+	pos  pos
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of file"
+	case tokString:
+		return fmt.Sprintf("%q", t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// A comment is a single "#" comment line.
+type comment struct {
+	line int
+	text string
+	// own is true if the comment is the only thing on its line.
+	own bool
+}
+
+type lexer struct {
+	file string
+	src  string
+	off  int
+	line int
+	col  int
+
+	// lastLine is the line of the most recently emitted token.
+	lastLine int
+
+	tokens   []token
+	comments []comment
+}
+
+// lex splits src into tokens and comments.
+func lex(file, src string) ([]token, []comment, error) {
+	lx := &lexer{file: file, src: src, line: 1, col: 1}
+	for {
+		tok, err := lx.next()
+		if err != nil {
+			return nil, nil, err
+		}
+		lx.tokens = append(lx.tokens, tok)
+		if tok.kind == tokEOF {
+			return lx.tokens, lx.comments, nil
+		}
+	}
+}
+
+func (lx *lexer) pos() pos {
+	return pos{file: lx.file, line: lx.line, col: lx.col}
+}
+
+func (lx *lexer) errorf(p pos, format string, args ...any) error {
+	return &Error{Pos: p.String(), Msg: fmt.Sprintf(format, args...)}
+}
+
+func (lx *lexer) peekByte(n int) byte {
+	if lx.off+n >= len(lx.src) {
+		return 0
+	}
+	return lx.src[lx.off+n]
+}
+
+func (lx *lexer) advance(n int) {
+	for i := 0; i < n && lx.off < len(lx.src); i++ {
+		if lx.src[lx.off] == '\n' {
+			lx.line++
+			lx.col = 1
+		} else {
+			lx.col++
+		}
+		lx.off++
+	}
+}
+
+func (lx *lexer) next() (token, error) {
+	// Skip whitespace and comments.
+	for lx.off < len(lx.src) {
+		c := lx.src[lx.off]
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			lx.advance(1)
+			continue
+		}
+		if c == '#' {
+			end := strings.IndexByte(lx.src[lx.off:], '\n')
+			if end < 0 {
+				end = len(lx.src) - lx.off
+			}
+			text := lx.src[lx.off+1 : lx.off+end]
+			text = strings.TrimSuffix(text, "\r")
+			lx.comments = append(lx.comments, comment{
+				line: lx.line,
+				text: text,
+				own:  lx.lastLine != lx.line,
+			})
+			lx.advance(end)
+			continue
+		}
+		break
+	}
+	p := lx.pos()
+	if lx.off >= len(lx.src) {
+		return token{kind: tokEOF, pos: p}, nil
+	}
+	lx.lastLine = lx.line
+
+	c := lx.src[lx.off]
+	switch {
+	case isIdentStart(c):
+		start := lx.off
+		for lx.off < len(lx.src) && isIdentPart(lx.src[lx.off]) {
+			lx.advance(1)
+		}
+		return token{kind: tokIdent, text: lx.src[start:lx.off], pos: p}, nil
+
+	case c >= '0' && c <= '9':
+		if c == '0' && (lx.peekByte(1) == 'x' || lx.peekByte(1) == 'X') && lx.peekByte(2) == '"' {
+			lx.advance(2)
+			s, err := lx.lexString(p)
+			if err != nil {
+				return token{}, err
+			}
+			return token{kind: tokBinary, text: s, pos: p}, nil
+		}
+		return lx.lexNumber(p)
+
+	case c == '"':
+		s, err := lx.lexString(p)
+		if err != nil {
+			return token{}, err
+		}
+		return token{kind: tokString, text: s, pos: p}, nil
+
+	case c == '-' && lx.peekByte(1) == '>':
+		lx.advance(2)
+		return token{kind: tokPunct, text: "->", pos: p}, nil
+	}
+
+	if strings.IndexByte("{}()[];:,=.$@*-", c) >= 0 {
+		lx.advance(1)
+		return token{kind: tokPunct, text: string(c), pos: p}, nil
+	}
+	r, _ := utf8.DecodeRuneInString(lx.src[lx.off:])
+	return token{}, lx.errorf(p, "unexpected character %q", r)
+}
+
+func (lx *lexer) lexNumber(p pos) (token, error) {
+	start := lx.off
+	kind := tokInt
+	if lx.src[lx.off] == '0' && (lx.peekByte(1) == 'x' || lx.peekByte(1) == 'X') {
+		lx.advance(2)
+		for lx.off < len(lx.src) && isHexDigit(lx.src[lx.off]) {
+			lx.advance(1)
+		}
+		return token{kind: kind, text: lx.src[start:lx.off], pos: p}, nil
+	}
+	for lx.off < len(lx.src) && isDigit(lx.src[lx.off]) {
+		lx.advance(1)
+	}
+	if lx.off < len(lx.src) && lx.src[lx.off] == '.' && isDigit(lx.peekByte(1)) {
+		kind = tokFloat
+		lx.advance(1)
+		for lx.off < len(lx.src) && isDigit(lx.src[lx.off]) {
+			lx.advance(1)
+		}
+	}
+	if lx.off < len(lx.src) && (lx.src[lx.off] == 'e' || lx.src[lx.off] == 'E') {
+		n := 1
+		if c := lx.peekByte(1); c == '+' || c == '-' {
+			n++
+		}
+		if isDigit(lx.peekByte(n)) {
+			kind = tokFloat
+			lx.advance(n)
+			for lx.off < len(lx.src) && isDigit(lx.src[lx.off]) {
+				lx.advance(1)
+			}
+		}
+	}
+	return token{kind: kind, text: lx.src[start:lx.off], pos: p}, nil
+}
+
+func (lx *lexer) lexString(p pos) (string, error) {
+	lx.advance(1) // opening quote
+	var sb strings.Builder
+	for {
+		if lx.off >= len(lx.src) || lx.src[lx.off] == '\n' {
+			return "", lx.errorf(p, "unterminated string literal")
+		}
+		c := lx.src[lx.off]
+		if c == '"' {
+			lx.advance(1)
+			return sb.String(), nil
+		}
+		if c != '\\' {
+			sb.WriteByte(c)
+			lx.advance(1)
+			continue
+		}
+		e := lx.peekByte(1)
+		lx.advance(2)
+		switch e {
+		case 'a':
+			sb.WriteByte('\a')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case 'v':
+			sb.WriteByte('\v')
+		case '\'', '"', '\\', '?':
+			sb.WriteByte(e)
+		case 'x':
+			v, n := 0, 0
+			for n < 2 && isHexDigit(lx.peekByte(0)) {
+				v = v*16 + hexVal(lx.peekByte(0))
+				lx.advance(1)
+				n++
+			}
+			if n == 0 {
+				return "", lx.errorf(p, "invalid \\x escape")
+			}
+			sb.WriteByte(byte(v))
+		default:
+			if e >= '0' && e <= '7' {
+				v, n := int(e-'0'), 1
+				for n < 3 && lx.peekByte(0) >= '0' && lx.peekByte(0) <= '7' {
+					v = v*8 + int(lx.peekByte(0)-'0')
+					lx.advance(1)
+					n++
+				}
+				sb.WriteByte(byte(v))
+				continue
+			}
+			return "", lx.errorf(p, "invalid escape sequence \\%c", e)
+		}
+	}
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func hexVal(c byte) int {
+	switch {
+	case isDigit(c):
+		return int(c - '0')
+	case 'a' <= c && c <= 'f':
+		return int(c-'a') + 10
+	default:
+		return int(c-'A') + 10
+	}
+}
@@ -0,0 +1,1042 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type declKind int
+
+const (
+	declFile declKind = iota
+	declUsing
+	declConst
+	declEnum
+	declEnumerant
+	declStruct
+	declField
+	declUnion
+	declGroup
+	declInterface
+	declMethod
+	declAnnotation
+)
+
+func (k declKind) String() string {
+	switch k {
+	case declFile:
+		return "file"
+	case declUsing:
+		return "using"
+	case declConst:
+		return "const"
+	case declEnum:
+		return "enum"
+	case declEnumerant:
+		return "enumerant"
+	case declStruct:
+		return "struct"
+	case declField:
+		return "field"
+	case declUnion:
+		return "union"
+	case declGroup:
+		return "group"
+	case declInterface:
+		return "interface"
+	case declMethod:
+		return "method"
+	case declAnnotation:
+		return "annotation"
+	default:
+		return "declaration(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// A decl is a declaration in a schema file.
+type decl struct {
+	kind declKind
+	pos  pos
+	name string
+
+	id         uint64 // explicit @0x... ID
+	hasID      bool
+	ordinal    int // explicit @N ordinal
+	hasOrdinal bool
+
+	params []string // generic parameters
+
+	typ   *expr // field, const, and annotation types; using targets
+	value *expr // field defaults and const values
+
+	annotations []*annotationApp
+	members     []*decl // nested declarations, fields, enumerants, and methods
+
+	extends []*expr // interface superclasses
+
+	// Methods
+	implicitParams []string
+	paramList      []*decl // nil if paramType is set
+	paramType      *expr
+	resultList     []*decl
+	resultType     *expr
+	resultStream   bool
+
+	// Annotations
+	targets []string
+
+	doc string
+}
+
+type annotationApp struct {
+	pos   pos
+	name  *expr
+	value *expr // nil means void
+}
+
+type exprKind int
+
+const (
+	exprName exprKind = iota
+	exprMember
+	exprApply
+	exprImport
+	exprInt
+	exprFloat
+	exprString
+	exprBinary
+	exprTuple
+	exprList
+	exprNeg
+)
+
+// An expr is a type or value expression.
+type expr struct {
+	kind exprKind
+	pos  pos
+
+	name     string // exprName, exprMember
+	absolute bool   // exprName: a leading "." refers to the file scope
+
+	base *expr   // exprMember, exprApply, exprNeg
+	args []param // exprApply, exprTuple, exprList
+
+	str string // exprString, exprBinary, exprImport; raw text for numbers
+}
+
+type param struct {
+	name  string // empty for positional
+	value *expr
+}
+
+func (e *expr) String() string {
+	switch e.kind {
+	case exprName:
+		if e.absolute {
+			return "." + e.name
+		}
+		return e.name
+	case exprMember:
+		return e.base.String() + "." + e.name
+	case exprApply:
+		return e.base.String() + "(" + paramsString(e.args) + ")"
+	case exprImport:
+		return "import " + strconv.Quote(e.str)
+	case exprInt, exprFloat:
+		return e.str
+	case exprString:
+		return strconv.Quote(e.str)
+	case exprBinary:
+		return "0x" + strconv.Quote(e.str)
+	case exprTuple:
+		return "(" + paramsString(e.args) + ")"
+	case exprList:
+		return "[" + paramsString(e.args) + "]"
+	case exprNeg:
+		return "-" + e.base.String()
+	default:
+		return "?"
+	}
+}
+
+func paramsString(ps []param) string {
+	parts := make([]string, len(ps))
+	for i, p := range ps {
+		if p.name != "" {
+			parts[i] = p.name + " = " + p.value.String()
+		} else {
+			parts[i] = p.value.String()
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+type parser struct {
+	file   string
+	toks   []token
+	i      int
+	docs   map[int]comment
+	fileID uint64
+}
+
+// parseFile parses the source of a schema file.
+func parseFile(name, src string) (*decl, error) {
+	toks, comments, err := lex(name, src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{file: name, toks: toks, docs: make(map[int]comment, len(comments))}
+	for _, c := range comments {
+		p.docs[c.line] = c
+	}
+	return p.parseFile()
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) peekN(n int) token {
+	if p.i+n >= len(p.toks) {
+		return p.toks[len(p.toks)-1]
+	}
+	return p.toks[p.i+n]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) is(text string) bool {
+	t := p.peek()
+	return (t.kind == tokPunct || t.kind == tokIdent) && t.text == text
+}
+
+func (p *parser) accept(text string) bool {
+	if p.is(text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) (token, error) {
+	t := p.peek()
+	if !p.is(text) {
+		return t, p.errorf(t.pos, "expected %q, found %v", text, t)
+	}
+	return p.next(), nil
+}
+
+func (p *parser) errorf(at pos, format string, args ...any) error {
+	return &Error{Pos: at.String(), Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) ident() (token, error) {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return t, p.errorf(t.pos, "expected identifier, found %v", t)
+	}
+	return p.next(), nil
+}
+
+// docAfter returns the doc comment that trails a declaration whose
+// terminating token is on line: a comment on the same line, followed
+// by any comment lines immediately after it.
+func (p *parser) docAfter(line int) string {
+	var lines []string
+	if c, ok := p.docs[line]; ok && !c.own {
+		lines = append(lines, c.text)
+	} else if c, ok := p.docs[line+1]; !ok || !c.own {
+		return ""
+	}
+	for l := line + 1; ; l++ {
+		c, ok := p.docs[l]
+		if !ok || !c.own {
+			break
+		}
+		lines = append(lines, c.text)
+	}
+	var sb strings.Builder
+	for _, l := range lines {
+		sb.WriteString(strings.TrimPrefix(l, " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func (p *parser) parseFile() (*decl, error) {
+	f := &decl{kind: declFile, pos: pos{file: p.file, line: 1, col: 1}}
+	for p.peek().kind != tokEOF {
+		if p.is("@") {
+			at := p.next()
+			id, err := p.parseID()
+			if err != nil {
+				return nil, err
+			}
+			if f.hasID {
+				return nil, p.errorf(at.pos, "file ID already declared")
+			}
+			f.id, f.hasID = id, true
+			semi, err := p.expect(";")
+			if err != nil {
+				return nil, err
+			}
+			f.doc += p.docAfter(semi.pos.line)
+			continue
+		}
+		if p.is("$") {
+			a, err := p.parseAnnotationApp()
+			if err != nil {
+				return nil, err
+			}
+			f.annotations = append(f.annotations, a)
+			if _, err := p.expect(";"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		d, err := p.parseNestedDecl()
+		if err != nil {
+			return nil, err
+		}
+		f.members = append(f.members, d)
+	}
+	if !f.hasID {
+		return nil, p.errorf(f.pos, "file has no ID; add a line like: @0x%016x;", 0)
+	}
+	return f, nil
+}
+
+// parseID parses the integer following an "@" as a 64-bit ID.
+func (p *parser) parseID() (uint64, error) {
+	t := p.next()
+	if t.kind != tokInt {
+		return 0, p.errorf(t.pos, "expected ID, found %v", t)
+	}
+	id, err := strconv.ParseUint(t.text, 0, 64)
+	if err != nil {
+		return 0, p.errorf(t.pos, "invalid ID %s", t.text)
+	}
+	if id&(1<<63) == 0 {
+		return 0, p.errorf(t.pos, "invalid ID %s: the high-order bit of an ID must be set", t.text)
+	}
+	return id, nil
+}
+
+func (p *parser) parseOrdinal() (int, error) {
+	t := p.next()
+	if t.kind != tokInt {
+		return 0, p.errorf(t.pos, "expected ordinal, found %v", t)
+	}
+	n, err := strconv.ParseUint(t.text, 10, 16)
+	if err != nil {
+		return 0, p.errorf(t.pos, "invalid ordinal %s", t.text)
+	}
+	return int(n), nil
+}
+
+// parseNestedDecl parses a declaration that may appear at file scope
+// or nested inside a struct or interface.
+func (p *parser) parseNestedDecl() (*decl, error) {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return nil, p.errorf(t.pos, "expected declaration, found %v", t)
+	}
+	switch t.text {
+	case "using":
+		return p.parseUsing()
+	case "const":
+		return p.parseConst()
+	case "enum":
+		return p.parseEnum()
+	case "struct":
+		return p.parseStruct()
+	case "interface":
+		return p.parseInterface()
+	case "annotation":
+		return p.parseAnnotationDecl()
+	}
+	return nil, p.errorf(t.pos, "unexpected %v", t)
+}
+
+func (p *parser) parseUsing() (*decl, error) {
+	start := p.next()
+	d := &decl{kind: declUsing, pos: start.pos}
+	if p.peek().kind == tokIdent && p.peekN(1).text == "=" && p.peekN(1).kind == tokPunct {
+		d.name = p.next().text
+		p.next()
+	}
+	var err error
+	if d.typ, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if d.name == "" {
+		switch d.typ.kind {
+		case exprName, exprMember:
+			d.name = d.typ.name
+		default:
+			return nil, p.errorf(start.pos, "using declaration needs a name")
+		}
+	}
+	if _, err := p.expect(";"); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// parseDeclHeader parses "name [(params)] [@0xID]".
+func (p *parser) parseDeclHeader(d *decl, generic bool) error {
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	d.name = name.text
+	d.pos = name.pos
+	// The ID may appear before or after the generic parameters.
+	if err := p.parseDeclID(d); err != nil {
+		return err
+	}
+	if generic && p.is("(") {
+		p.next()
+		for !p.is(")") {
+			n, err := p.ident()
+			if err != nil {
+				return err
+			}
+			d.params = append(d.params, n.text)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if _, err := p.expect(")"); err != nil {
+			return err
+		}
+	}
+	return p.parseDeclID(d)
+}
+
+func (p *parser) parseDeclID(d *decl) error {
+	if !p.is("@") {
+		return nil
+	}
+	at := p.next()
+	if d.hasID {
+		return p.errorf(at.pos, "%s already has an ID", d.name)
+	}
+	var err error
+	if d.id, err = p.parseID(); err != nil {
+		return err
+	}
+	d.hasID = true
+	return nil
+}
+
+func (p *parser) parseConst() (*decl, error) {
+	p.next()
+	d := &decl{kind: declConst}
+	if err := p.parseDeclHeader(d, false); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	var err error
+	if d.typ, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect("="); err != nil {
+		return nil, err
+	}
+	if d.value, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	semi, err := p.expect(";")
+	if err != nil {
+		return nil, err
+	}
+	d.doc = p.docAfter(semi.pos.line)
+	return d, nil
+}
+
+func (p *parser) parseEnum() (*decl, error) {
+	p.next()
+	d := &decl{kind: declEnum}
+	if err := p.parseDeclHeader(d, false); err != nil {
+		return nil, err
+	}
+	var err error
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	brace, err := p.expect("{")
+	if err != nil {
+		return nil, err
+	}
+	d.doc = p.docAfter(brace.pos.line)
+	for !p.is("}") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		e := &decl{kind: declEnumerant, name: name.text, pos: name.pos}
+		if _, err := p.expect("@"); err != nil {
+			return nil, err
+		}
+		if e.ordinal, err = p.parseOrdinal(); err != nil {
+			return nil, err
+		}
+		e.hasOrdinal = true
+		if e.annotations, err = p.parseAnnotationApps(); err != nil {
+			return nil, err
+		}
+		semi, err := p.expect(";")
+		if err != nil {
+			return nil, err
+		}
+		e.doc = p.docAfter(semi.pos.line)
+		d.members = append(d.members, e)
+	}
+	p.next()
+	return d, nil
+}
+
+func (p *parser) parseStruct() (*decl, error) {
+	p.next()
+	d := &decl{kind: declStruct}
+	if err := p.parseDeclHeader(d, true); err != nil {
+		return nil, err
+	}
+	var err error
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	if err := p.parseStructBody(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (p *parser) parseStructBody(d *decl) error {
+	brace, err := p.expect("{")
+	if err != nil {
+		return err
+	}
+	d.doc = p.docAfter(brace.pos.line)
+	for !p.is("}") {
+		if p.peek().kind == tokEOF {
+			return p.errorf(p.peek().pos, "unexpected end of file in %s %s", d.kind, d.name)
+		}
+		m, err := p.parseStructMember()
+		if err != nil {
+			return err
+		}
+		d.members = append(d.members, m)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) parseStructMember() (*decl, error) {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return nil, p.errorf(t.pos, "expected struct member, found %v", t)
+	}
+	next := p.peekN(1)
+	isKeyword := next.kind == tokIdent || (next.kind == tokPunct && next.text == "{")
+	if isKeyword {
+		switch t.text {
+		case "union":
+			p.next()
+			return p.parseUnionBody(&decl{kind: declUnion, pos: t.pos})
+		case "using", "const", "enum", "struct", "interface", "annotation":
+			return p.parseNestedDecl()
+		}
+	}
+	if t.text == "union" && next.kind == tokPunct && next.text == "@" {
+		p.next()
+		d := &decl{kind: declUnion, pos: t.pos}
+		p.next()
+		var err error
+		if d.ordinal, err = p.parseOrdinal(); err != nil {
+			return nil, err
+		}
+		d.hasOrdinal = true
+		return p.parseUnionBody(d)
+	}
+
+	// A field, named union, or group.
+	name := p.next()
+	d := &decl{kind: declField, name: name.text, pos: name.pos}
+	if p.accept("@") {
+		var err error
+		if d.ordinal, err = p.parseOrdinal(); err != nil {
+			return nil, err
+		}
+		d.hasOrdinal = true
+	}
+	if _, err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	switch {
+	case p.is("union"):
+		p.next()
+		d.kind = declUnion
+		return p.parseUnionBody(d)
+	case p.is("group"):
+		p.next()
+		if d.hasOrdinal {
+			return nil, p.errorf(name.pos, "groups cannot have ordinals")
+		}
+		d.kind = declGroup
+		var err error
+		if d.annotations, err = p.parseAnnotationApps(); err != nil {
+			return nil, err
+		}
+		if err := p.parseStructBody(d); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+	if !d.hasOrdinal {
+		return nil, p.errorf(name.pos, "field %s is missing an ordinal", name.text)
+	}
+	var err error
+	if d.typ, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if p.accept("=") {
+		if d.value, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	semi, err := p.expect(";")
+	if err != nil {
+		return nil, err
+	}
+	d.doc = p.docAfter(semi.pos.line)
+	return d, nil
+}
+
+func (p *parser) parseUnionBody(d *decl) (*decl, error) {
+	var err error
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	if err := p.parseStructBody(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (p *parser) parseInterface() (*decl, error) {
+	p.next()
+	d := &decl{kind: declInterface}
+	if err := p.parseDeclHeader(d, true); err != nil {
+		return nil, err
+	}
+	if p.accept("extends") {
+		if _, err := p.expect("("); err != nil {
+			return nil, err
+		}
+		for !p.is(")") {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			d.extends = append(d.extends, e)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	brace, err := p.expect("{")
+	if err != nil {
+		return nil, err
+	}
+	d.doc = p.docAfter(brace.pos.line)
+	for !p.is("}") {
+		t := p.peek()
+		if t.kind != tokIdent {
+			return nil, p.errorf(t.pos, "expected interface member, found %v", t)
+		}
+		switch t.text {
+		case "using", "const", "enum", "struct", "interface", "annotation":
+			if p.peekN(1).kind == tokIdent {
+				m, err := p.parseNestedDecl()
+				if err != nil {
+					return nil, err
+				}
+				d.members = append(d.members, m)
+				continue
+			}
+		}
+		m, err := p.parseMethod()
+		if err != nil {
+			return nil, err
+		}
+		d.members = append(d.members, m)
+	}
+	p.next()
+	return d, nil
+}
+
+func (p *parser) parseMethod() (*decl, error) {
+	name := p.next()
+	d := &decl{kind: declMethod, name: name.text, pos: name.pos}
+	if _, err := p.expect("@"); err != nil {
+		return nil, err
+	}
+	var err error
+	if d.ordinal, err = p.parseOrdinal(); err != nil {
+		return nil, err
+	}
+	d.hasOrdinal = true
+	if p.accept("[") {
+		for !p.is("]") {
+			n, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			d.implicitParams = append(d.implicitParams, n.text)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if _, err := p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+	if p.is("(") {
+		if d.paramList, err = p.parseParamList(); err != nil {
+			return nil, err
+		}
+	} else if d.paramType, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if p.accept("->") {
+		switch {
+		case p.is("stream"):
+			p.next()
+			d.resultStream = true
+		case p.is("("):
+			if d.resultList, err = p.parseParamList(); err != nil {
+				return nil, err
+			}
+		default:
+			if d.resultType, err = p.parseExpr(); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		d.resultList = []*decl{}
+	}
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	semi, err := p.expect(";")
+	if err != nil {
+		return nil, err
+	}
+	d.doc = p.docAfter(semi.pos.line)
+	return d, nil
+}
+
+// parseParamList parses a parenthesized method parameter list.  Each
+// parameter becomes a field declaration with an implicit ordinal.
+func (p *parser) parseParamList() ([]*decl, error) {
+	if _, err := p.expect("("); err != nil {
+		return nil, err
+	}
+	list := []*decl{}
+	for !p.is(")") {
+		name, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		f := &decl{kind: declField, name: name.text, pos: name.pos, ordinal: len(list), hasOrdinal: true}
+		if _, err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		if f.typ, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		if p.accept("=") {
+			if f.value, err = p.parseExpr(); err != nil {
+				return nil, err
+			}
+		}
+		if f.annotations, err = p.parseAnnotationApps(); err != nil {
+			return nil, err
+		}
+		list = append(list, f)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (p *parser) parseAnnotationDecl() (*decl, error) {
+	p.next()
+	d := &decl{kind: declAnnotation}
+	if err := p.parseDeclHeader(d, false); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect("("); err != nil {
+		return nil, err
+	}
+	for !p.is(")") {
+		t := p.next()
+		if t.text != "*" && t.kind != tokIdent {
+			return nil, p.errorf(t.pos, "expected annotation target, found %v", t)
+		}
+		d.targets = append(d.targets, t.text)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if _, err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	var err error
+	if d.typ, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if d.annotations, err = p.parseAnnotationApps(); err != nil {
+		return nil, err
+	}
+	semi, err := p.expect(";")
+	if err != nil {
+		return nil, err
+	}
+	d.doc = p.docAfter(semi.pos.line)
+	return d, nil
+}
+
+func (p *parser) parseAnnotationApps() ([]*annotationApp, error) {
+	var apps []*annotationApp
+	for p.is("$") {
+		a, err := p.parseAnnotationApp()
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, a)
+	}
+	return apps, nil
+}
+
+func (p *parser) parseAnnotationApp() (*annotationApp, error) {
+	dollar := p.next()
+	a := &annotationApp{pos: dollar.pos}
+	var err error
+	if a.name, err = p.parseNamePath(); err != nil {
+		return nil, err
+	}
+	if p.is("(") {
+		args, err := p.parseArgs("(", ")")
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(args) == 1 && args[0].name == "":
+			a.value = args[0].value
+		default:
+			a.value = &expr{kind: exprTuple, pos: a.name.pos, args: args}
+		}
+	}
+	return a, nil
+}
+
+// parseNamePath parses a dotted name with no applications, as used by
+// annotation applications.
+func (p *parser) parseNamePath() (*expr, error) {
+	e, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.is(".") {
+		p.next()
+		n, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		e = &expr{kind: exprMember, pos: n.pos, name: n.text, base: e}
+	}
+	return e, nil
+}
+
+func (p *parser) parseExpr() (*expr, error) {
+	e, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.is("."):
+			p.next()
+			n, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			e = &expr{kind: exprMember, pos: n.pos, name: n.text, base: e}
+		case p.is("("):
+			at := p.peek().pos
+			args, err := p.parseArgs("(", ")")
+			if err != nil {
+				return nil, err
+			}
+			e = &expr{kind: exprApply, pos: at, base: e, args: args}
+		default:
+			return e, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (*expr, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokIdent:
+		p.next()
+		if t.text == "import" && p.peek().kind == tokString {
+			s := p.next()
+			return &expr{kind: exprImport, pos: t.pos, str: s.text}, nil
+		}
+		return &expr{kind: exprName, pos: t.pos, name: t.text}, nil
+	case tokInt:
+		p.next()
+		return &expr{kind: exprInt, pos: t.pos, str: t.text}, nil
+	case tokFloat:
+		p.next()
+		return &expr{kind: exprFloat, pos: t.pos, str: t.text}, nil
+	case tokString:
+		p.next()
+		s := t.text
+		// Adjacent string literals are concatenated.
+		for p.peek().kind == tokString {
+			s += p.next().text
+		}
+		return &expr{kind: exprString, pos: t.pos, str: s}, nil
+	case tokBinary:
+		p.next()
+		b, err := decodeHexLiteral(t.text)
+		if err != nil {
+			return nil, p.errorf(t.pos, "%v", err)
+		}
+		for p.peek().kind == tokBinary {
+			more, err := decodeHexLiteral(p.next().text)
+			if err != nil {
+				return nil, p.errorf(t.pos, "%v", err)
+			}
+			b += more
+		}
+		return &expr{kind: exprBinary, pos: t.pos, str: b}, nil
+	case tokPunct:
+		switch t.text {
+		case ".":
+			p.next()
+			n, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			return &expr{kind: exprName, pos: t.pos, name: n.text, absolute: true}, nil
+		case "-":
+			p.next()
+			e, err := p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			return &expr{kind: exprNeg, pos: t.pos, base: e}, nil
+		case "(":
+			args, err := p.parseArgs("(", ")")
+			if err != nil {
+				return nil, err
+			}
+			return &expr{kind: exprTuple, pos: t.pos, args: args}, nil
+		case "[":
+			args, err := p.parseArgs("[", "]")
+			if err != nil {
+				return nil, err
+			}
+			return &expr{kind: exprList, pos: t.pos, args: args}, nil
+		}
+	}
+	return nil, p.errorf(t.pos, "expected expression, found %v", t)
+}
+
+// parseArgs parses a delimited, comma-separated list of expressions,
+// each optionally preceded by "name =".
+func (p *parser) parseArgs(open, close string) ([]param, error) {
+	if _, err := p.expect(open); err != nil {
+		return nil, err
+	}
+	var args []param
+	for !p.is(close) {
+		var a param
+		if p.peek().kind == tokIdent && p.peekN(1).kind == tokPunct && p.peekN(1).text == "=" {
+			a.name = p.next().text
+			p.next()
+		}
+		var err error
+		if a.value, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if _, err := p.expect(close); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// decodeHexLiteral decodes the body of a 0x"..." literal, which is a
+// sequence of hex digit pairs optionally separated by whitespace.
+func decodeHexLiteral(s string) (string, error) {
+	var out []byte
+	digits := make([]byte, 0, 2)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			continue
+		}
+		if !isHexDigit(c) {
+			return "", fmt.Errorf("invalid character %q in binary literal", c)
+		}
+		digits = append(digits, c)
+		if len(digits) == 2 {
+			out = append(out, byte(hexVal(digits[0])<<4|hexVal(digits[1])))
+			digits = digits[:0]
+		}
+	}
+	if len(digits) != 0 {
+		return "", fmt.Errorf("binary literal has an odd number of hex digits")
+	}
+	return string(out), nil
+}
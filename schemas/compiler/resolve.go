@@ -0,0 +1,364 @@
+package compiler
+
+import (
+	"capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+type refKind int
+
+const (
+	refNode     refKind = iota // a declaration
+	refBuiltin                 // a builtin type, like Int32 or List
+	refParam                   // a generic parameter
+	refImplicit                // an implicit method parameter
+)
+
+// A ref is the result of resolving a name: a declaration together
+// with the brand it was referenced with.
+type ref struct {
+	kind  refKind
+	pos   pos
+	node  *node
+	brand *brandScope // leaf is node; nil for files
+
+	builtin string
+	args    []*typ // builtin List parameters
+
+	scope uint64 // refParam
+	index int    // refParam, refImplicit
+}
+
+// A brandScope records how the generic parameters of one scope are
+// bound.  Brand scopes are chained from the innermost scope outward.
+type brandScope struct {
+	id      uint64
+	nparams int
+	inherit bool   // parameters refer to the scope's own parameters
+	args    []*typ // non-nil if the parameters are bound
+	parent  *brandScope
+}
+
+// levels returns the scopes that appear in the compiled brand,
+// innermost first.
+func (b *brandScope) levels() []*brandScope {
+	var levels []*brandScope
+	for ; b != nil; b = b.parent {
+		if b.args != nil || (b.inherit && b.nparams > 0) {
+			levels = append(levels, b)
+		}
+	}
+	return levels
+}
+
+// A typ is a resolved type.
+type typ struct {
+	which schema.Type_Which
+	elem  *typ        // List
+	node  *node       // enum, struct, interface
+	brand *brandScope // enum, struct, interface
+
+	// AnyPointer
+	anyKind    schema.Type_anyPointer_unconstrained_Which
+	param      bool
+	paramScope uint64
+	implicit   bool
+	paramIndex int
+}
+
+func (t *typ) isPointer() bool {
+	switch t.which {
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_list,
+		schema.Type_Which_structType, schema.Type_Which_interface, schema.Type_Which_anyPointer:
+		return true
+	}
+	return false
+}
+
+// lgSize returns the log2 of the type's size in bits, or -1 for void
+// and -2 for pointer types.
+func (t *typ) lgSize() int {
+	switch t.which {
+	case schema.Type_Which_void:
+		return -1
+	case schema.Type_Which_bool:
+		return 0
+	case schema.Type_Which_int8, schema.Type_Which_uint8:
+		return 3
+	case schema.Type_Which_int16, schema.Type_Which_uint16, schema.Type_Which_enum:
+		return 4
+	case schema.Type_Which_int32, schema.Type_Which_uint32, schema.Type_Which_float32:
+		return 5
+	case schema.Type_Which_int64, schema.Type_Which_uint64, schema.Type_Which_float64:
+		return 6
+	default:
+		return -2
+	}
+}
+
+var builtinTypes = map[string]schema.Type_Which{
+	"Void":       schema.Type_Which_void,
+	"Bool":       schema.Type_Which_bool,
+	"Int8":       schema.Type_Which_int8,
+	"Int16":      schema.Type_Which_int16,
+	"Int32":      schema.Type_Which_int32,
+	"Int64":      schema.Type_Which_int64,
+	"UInt8":      schema.Type_Which_uint8,
+	"UInt16":     schema.Type_Which_uint16,
+	"UInt32":     schema.Type_Which_uint32,
+	"UInt64":     schema.Type_Which_uint64,
+	"Float32":    schema.Type_Which_float32,
+	"Float64":    schema.Type_Which_float64,
+	"Text":       schema.Type_Which_text,
+	"Data":       schema.Type_Which_data,
+	"List":       schema.Type_Which_list,
+	"AnyPointer": schema.Type_Which_anyPointer,
+	"AnyStruct":  schema.Type_Which_anyPointer,
+	"AnyList":    schema.Type_Which_anyPointer,
+	"Capability": schema.Type_Which_anyPointer,
+}
+
+// A scope is a context in which names are resolved.
+type scope struct {
+	node     *node
+	brand    *brandScope
+	implicit []string // implicit method parameters
+}
+
+// scopeOf returns the scope for names used inside n.
+func scopeOf(n *node) *scope {
+	return &scope{node: n, brand: localBrand(n)}
+}
+
+// localBrand returns the brand of n as seen from inside n, where its
+// generic parameters refer to themselves.
+func localBrand(n *node) *brandScope {
+	if n == nil {
+		return nil
+	}
+	return &brandScope{
+		id:      n.id,
+		nparams: len(n.decl.params),
+		inherit: true,
+		parent:  localBrand(n.parent),
+	}
+}
+
+// memberRef returns a reference to m, a member of a scope whose brand
+// is parent.
+func (c *compiler) memberRef(m *node, parent *brandScope, at pos) (*ref, error) {
+	if m.kind == declUsing {
+		r, err := c.resolveAlias(m)
+		if err != nil {
+			return nil, err
+		}
+		cp := *r
+		cp.pos = at
+		return &cp, nil
+	}
+	return &ref{
+		kind: refNode,
+		pos:  at,
+		node: m,
+		brand: &brandScope{
+			id:      m.id,
+			nparams: len(m.decl.params),
+			parent:  parent,
+		},
+	}, nil
+}
+
+func (c *compiler) resolveAlias(n *node) (*ref, error) {
+	if n.target != nil {
+		return n.target, nil
+	}
+	if n.resolving {
+		return nil, c.errorf(n.decl.pos, "alias %s refers to itself", n.name)
+	}
+	n.resolving = true
+	defer func() { n.resolving = false }()
+	r, err := c.resolve(scopeOf(n.parent), n.decl.typ)
+	if err != nil {
+		return nil, err
+	}
+	n.target = r
+	return r, nil
+}
+
+// resolveAliases resolves every alias declared in n and its nested
+// declarations, in declaration order, loading any imported files.
+func (c *compiler) resolveAliases(n *node) error {
+	var walk func(members []*decl) error
+	walk = func(members []*decl) error {
+		for _, d := range members {
+			switch d.kind {
+			case declUsing:
+				if _, err := c.resolveAlias(n.members[d.name]); err != nil {
+					return err
+				}
+			case declUnion, declGroup:
+				if err := walk(d.members); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(n.decl.members); err != nil {
+		return err
+	}
+	for _, m := range n.nested {
+		if err := c.resolveAliases(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookup resolves a single unqualified name.
+func (c *compiler) lookup(sc *scope, name string, at pos) (*ref, error) {
+	for i, p := range sc.implicit {
+		if p == name {
+			return &ref{kind: refImplicit, pos: at, index: i}, nil
+		}
+	}
+	b := sc.brand
+	for n := sc.node; n != nil; n = n.parent {
+		if m := n.members[name]; m != nil {
+			return c.memberRef(m, b, at)
+		}
+		for i, p := range n.decl.params {
+			if p == name {
+				return &ref{kind: refParam, pos: at, scope: n.id, index: i}, nil
+			}
+		}
+		if b != nil {
+			b = b.parent
+		}
+	}
+	if _, ok := builtinTypes[name]; ok {
+		return &ref{kind: refBuiltin, pos: at, builtin: name}, nil
+	}
+	return nil, c.errorf(at, "undefined name %s", name)
+}
+
+// resolve resolves a name expression.
+func (c *compiler) resolve(sc *scope, e *expr) (*ref, error) {
+	switch e.kind {
+	case exprName:
+		if e.absolute {
+			root := sc.node.file.root
+			m := root.members[e.name]
+			if m == nil {
+				return nil, c.errorf(e.pos, "undefined name .%s", e.name)
+			}
+			return c.memberRef(m, nil, e.pos)
+		}
+		return c.lookup(sc, e.name, e.pos)
+
+	case exprImport:
+		f, err := c.importFile(sc.node.file, e)
+		if err != nil {
+			return nil, err
+		}
+		return &ref{kind: refNode, pos: e.pos, node: f.root}, nil
+
+	case exprMember:
+		base, err := c.resolve(sc, e.base)
+		if err != nil {
+			return nil, err
+		}
+		if base.kind != refNode {
+			return nil, c.errorf(e.pos, "%s has no members", e.base)
+		}
+		m := base.node.members[e.name]
+		if m == nil {
+			return nil, c.errorf(e.pos, "%s has no member named %s", base.node.displayName, e.name)
+		}
+		return c.memberRef(m, base.brand, e.pos)
+
+	case exprApply:
+		base, err := c.resolve(sc, e.base)
+		if err != nil {
+			return nil, err
+		}
+		args := make([]*typ, len(e.args))
+		for i, a := range e.args {
+			if a.name != "" {
+				return nil, c.errorf(a.value.pos, "generic parameters cannot be named")
+			}
+			if args[i], err = c.typeOf(sc, a.value); err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case base.kind == refBuiltin && base.builtin == "List":
+			if len(args) != 1 {
+				return nil, c.errorf(e.pos, "List requires exactly one parameter")
+			}
+			return &ref{kind: refBuiltin, pos: e.pos, builtin: "List", args: args}, nil
+		case base.kind == refNode && base.brand != nil && base.brand.nparams > 0:
+			if len(args) != base.brand.nparams {
+				return nil, c.errorf(e.pos, "%s requires %d parameters, got %d", base.node.name, base.brand.nparams, len(args))
+			}
+			for i, a := range args {
+				if !a.isPointer() {
+					return nil, c.errorf(e.args[i].value.pos, "generic parameters must be pointer types")
+				}
+			}
+			b := *base.brand
+			b.args = args
+			b.inherit = false
+			r := *base
+			r.brand = &b
+			return &r, nil
+		}
+		return nil, c.errorf(e.pos, "%s does not take parameters", e.base)
+	}
+	return nil, c.errorf(e.pos, "expected a name, found %s", e)
+}
+
+// typeOf resolves a type expression.
+func (c *compiler) typeOf(sc *scope, e *expr) (*typ, error) {
+	r, err := c.resolve(sc, e)
+	if err != nil {
+		return nil, err
+	}
+	return c.refType(r)
+}
+
+func (c *compiler) refType(r *ref) (*typ, error) {
+	switch r.kind {
+	case refBuiltin:
+		t := &typ{which: builtinTypes[r.builtin]}
+		switch r.builtin {
+		case "List":
+			if len(r.args) == 0 {
+				return nil, c.errorf(r.pos, "List requires a parameter")
+			}
+			t.elem = r.args[0]
+		case "AnyStruct":
+			t.anyKind = schema.Type_anyPointer_unconstrained_Which_struct
+		case "AnyList":
+			t.anyKind = schema.Type_anyPointer_unconstrained_Which_list
+		case "Capability":
+			t.anyKind = schema.Type_anyPointer_unconstrained_Which_capability
+		}
+		return t, nil
+	case refParam:
+		return &typ{which: schema.Type_Which_anyPointer, param: true, paramScope: r.scope, paramIndex: r.index}, nil
+	case refImplicit:
+		return &typ{which: schema.Type_Which_anyPointer, implicit: true, paramIndex: r.index}, nil
+	}
+	t := &typ{node: r.node, brand: r.brand}
+	switch r.node.kind {
+	case declStruct:
+		t.which = schema.Type_Which_structType
+	case declEnum:
+		t.which = schema.Type_Which_enum
+	case declInterface:
+		t.which = schema.Type_Which_interface
+	default:
+		return nil, c.errorf(r.pos, "%s is not a type", r.node.displayName)
+	}
+	return t, nil
+}
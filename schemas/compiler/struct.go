@@ -0,0 +1,450 @@
+package compiler
+
+import (
+	"sort"
+
+	"capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+// A structNode is a struct, group, or method parameter struct after
+// layout.
+type structNode struct {
+	id          uint64
+	scopeID     uint64
+	displayName string
+	prefixLen   int
+	isGroup     bool
+	isParam     bool
+	isGeneric   bool
+	params      []string // parameter structs: the method's implicit parameters
+	decl        *decl
+	sc          *scope
+
+	fields []*field // in index order
+
+	hasUnion           bool
+	discriminantCount  uint16
+	discriminantOffset uint32
+
+	top    *topLayout
+	groups []*structNode // nested groups, in declaration order
+}
+
+// A field is a member of a struct or group.
+type field struct {
+	name      string
+	decl      *decl
+	index     int
+	codeOrder int
+	discValue uint16 // noDiscriminant if not in a union
+	isParam   bool
+
+	ordinal  int
+	explicit bool
+
+	group *structNode // groups and named unions
+
+	typ    *typ // slots
+	offset uint32
+}
+
+const noDiscriminant = 0xffff
+
+// A member tracks the layout state of a field, group, or union while
+// its struct is being laid out.
+type member struct {
+	parent    *member
+	codeOrder int
+	decl      *decl
+	isInUnion bool
+
+	scope fieldScope   // fields
+	union *unionLayout // members containing an unnamed union, and named unions
+	group *structNode  // the root, groups, and named unions
+
+	childCount int
+	discCount  uint16
+	field      *field
+}
+
+type ordinalEntry struct {
+	ordinal int
+	at      pos
+	m       *member
+	isUnion bool
+}
+
+type structLayouter struct {
+	c       *compiler
+	root    *structNode
+	all     []*member
+	ordered []ordinalEntry
+	isParam bool
+}
+
+// layoutAll lays out every struct and parameter struct declared in n.
+func (c *compiler) layoutAll(n *node) error {
+	switch n.kind {
+	case declStruct:
+		if _, err := c.structOf(n); err != nil {
+			return err
+		}
+	case declInterface:
+		if err := c.layoutMethods(n); err != nil {
+			return err
+		}
+	}
+	for _, m := range n.nested {
+		if err := c.layoutAll(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structOf returns the layout of the struct n, computing it if needed.
+func (c *compiler) structOf(n *node) (*structNode, error) {
+	if sn := c.structs[n.id]; sn != nil {
+		return sn, nil
+	}
+	sn := &structNode{
+		id:          n.id,
+		displayName: n.displayName,
+		prefixLen:   n.prefixLen,
+		isGeneric:   n.isGeneric(),
+		decl:        n.decl,
+		sc:          scopeOf(n),
+	}
+	if n.parent != nil {
+		sn.scopeID = n.parent.id
+	}
+	if err := c.layoutStruct(sn, n.decl.members, false); err != nil {
+		return nil, err
+	}
+	c.structs[n.id] = sn
+	return sn, nil
+}
+
+// layoutStruct assigns field positions for the struct sn with the
+// given members.
+func (c *compiler) layoutStruct(sn *structNode, members []*decl, isParam bool) error {
+	l := &structLayouter{c: c, root: sn, isParam: isParam}
+	sn.top = new(topLayout)
+	root := &member{group: sn}
+	if err := l.traverseTopOrGroup(members, root, sn.top); err != nil {
+		return err
+	}
+
+	sort.SliceStable(l.ordered, func(i, j int) bool {
+		return l.ordered[i].ordinal < l.ordered[j].ordinal
+	})
+	expect := 0
+	for _, ent := range l.ordered {
+		at := ent.at
+		if ent.ordinal < expect {
+			return c.errorf(at, "duplicate ordinal @%d", ent.ordinal)
+		}
+		if ent.ordinal > expect {
+			return c.errorf(at, "skipped ordinal @%d; ordinals must be sequential with no holes", expect)
+		}
+		expect = ent.ordinal + 1
+
+		if ent.isUnion {
+			if !ent.m.union.addDiscriminant() {
+				return c.errorf(at, "union ordinal, if specified, must be greater than no more than one of its member ordinals")
+			}
+			continue
+		}
+		f := l.fieldOf(ent.m)
+		f.ordinal = ent.ordinal
+		f.explicit = true
+		t, err := c.typeOf(sn.sc, ent.m.decl.typ)
+		if err != nil {
+			return err
+		}
+		f.typ = t
+		switch lg := t.lgSize(); lg {
+		case -2:
+			f.offset = ent.m.scope.addPointer()
+		case -1:
+			ent.m.scope.addVoid()
+		default:
+			f.offset = ent.m.scope.addData(uint(lg))
+		}
+	}
+
+	l.finishGroup(root)
+	for _, m := range l.all {
+		if m.group != nil {
+			l.finishGroup(m)
+		}
+	}
+	return nil
+}
+
+// fieldOf returns the field for m, adding it to its parent's field
+// list on first use.  Fields are therefore numbered in ordinal order.
+func (l *structLayouter) fieldOf(m *member) *field {
+	if m.field != nil {
+		return m.field
+	}
+	p := m.parent
+	if len(p.group.fields) == 0 && p.parent != nil {
+		// Make sure the group exists in its parent once its first
+		// member is added.
+		l.fieldOf(p)
+	}
+	f := &field{
+		name:      m.decl.name,
+		decl:      m.decl,
+		index:     len(p.group.fields),
+		codeOrder: m.codeOrder,
+		discValue: noDiscriminant,
+		isParam:   l.isParam,
+	}
+	if m.isInUnion {
+		f.discValue = p.discCount
+		p.discCount++
+	}
+	p.group.fields = append(p.group.fields, f)
+	m.field = f
+	return f
+}
+
+func (l *structLayouter) newGroup(parent *member, codeOrder int, d *decl, isInUnion bool) *member {
+	pg := parent.group
+	g := &structNode{
+		displayName: pg.displayName + "." + d.name,
+		prefixLen:   len(pg.displayName) + 1,
+		isGroup:     true,
+		isGeneric:   l.root.isGeneric,
+		decl:        d,
+		sc:          l.root.sc,
+		top:         l.root.top,
+	}
+	l.root.groups = append(l.root.groups, g)
+	return &member{parent: parent, codeOrder: codeOrder, decl: d, isInUnion: isInUnion, group: g}
+}
+
+func (l *structLayouter) traverseTopOrGroup(members []*decl, parent *member, layout fieldScope) error {
+	codeOrder := 0
+	for _, d := range members {
+		switch d.kind {
+		case declField:
+			parent.childCount++
+			m := &member{parent: parent, codeOrder: codeOrder, decl: d, scope: layout}
+			codeOrder++
+			l.all = append(l.all, m)
+			l.ordered = append(l.ordered, ordinalEntry{ordinal: d.ordinal, at: d.pos, m: m})
+
+		case declUnion:
+			u := &unionLayout{parent: layout}
+			var m *member
+			independent := 0
+			sub := &independent
+			if d.name == "" {
+				m = parent
+				sub = &codeOrder
+			} else {
+				parent.childCount++
+				m = l.newGroup(parent, codeOrder, d, false)
+				codeOrder++
+				l.all = append(l.all, m)
+			}
+			m.union = u
+			if err := l.traverseUnion(d, m, u, sub); err != nil {
+				return err
+			}
+			if d.hasOrdinal {
+				l.ordered = append(l.ordered, ordinalEntry{ordinal: d.ordinal, at: d.pos, m: m, isUnion: true})
+			}
+
+		case declGroup:
+			parent.childCount++
+			m := l.newGroup(parent, codeOrder, d, false)
+			codeOrder++
+			l.all = append(l.all, m)
+			if err := l.traverseGroup(d, m, layout); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *structLayouter) traverseUnion(d *decl, parent *member, u *unionLayout, codeOrder *int) error {
+	if countMembers(d.members) < 2 {
+		return l.c.errorf(d.pos, "union must have at least two members")
+	}
+	for _, md := range d.members {
+		switch md.kind {
+		case declField:
+			parent.childCount++
+			g := &groupLayout{parent: u}
+			m := &member{parent: parent, codeOrder: *codeOrder, decl: md, isInUnion: true, scope: g}
+			*codeOrder++
+			l.all = append(l.all, m)
+			l.ordered = append(l.ordered, ordinalEntry{ordinal: md.ordinal, at: md.pos, m: m})
+
+		case declUnion:
+			if md.name == "" {
+				return l.c.errorf(md.pos, "unions cannot contain unnamed unions")
+			}
+			parent.childCount++
+			g := &groupLayout{parent: u}
+			inner := &unionLayout{parent: g}
+			m := l.newGroup(parent, *codeOrder, md, true)
+			*codeOrder++
+			l.all = append(l.all, m)
+			m.union = inner
+			sub := 0
+			if err := l.traverseUnion(md, m, inner, &sub); err != nil {
+				return err
+			}
+			if md.hasOrdinal {
+				l.ordered = append(l.ordered, ordinalEntry{ordinal: md.ordinal, at: md.pos, m: m, isUnion: true})
+			}
+
+		case declGroup:
+			parent.childCount++
+			g := &groupLayout{parent: u}
+			m := l.newGroup(parent, *codeOrder, md, true)
+			*codeOrder++
+			l.all = append(l.all, m)
+			if err := l.traverseGroup(md, m, g); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *structLayouter) traverseGroup(d *decl, parent *member, layout fieldScope) error {
+	if countMembers(d.members) < 1 {
+		return l.c.errorf(d.pos, "group must have at least one member")
+	}
+	return l.traverseTopOrGroup(d.members, parent, layout)
+}
+
+func countMembers(members []*decl) int {
+	n := 0
+	for _, d := range members {
+		switch d.kind {
+		case declField, declUnion, declGroup:
+			n++
+		}
+	}
+	return n
+}
+
+func (l *structLayouter) finishGroup(m *member) {
+	g := m.group
+	if m.union != nil {
+		m.union.addDiscriminant()
+		g.hasUnion = true
+		g.discriminantCount = m.discCount
+		g.discriminantOffset = m.union.discriminantOffset
+	}
+	if m.parent != nil {
+		f := l.fieldOf(m)
+		g.id = groupID(m.parent.group.id, uint16(f.index))
+		g.scopeID = m.parent.group.id
+		f.group = g
+		l.c.structs[g.id] = g
+	}
+}
+
+// A methodInfo holds the parameter and result types of a method.
+type methodInfo struct {
+	paramID     uint64
+	paramBrand  *brandScope
+	resultID    uint64
+	resultBrand *brandScope
+}
+
+// layoutMethods creates the parameter and result structs for the
+// methods of the interface n.
+func (c *compiler) layoutMethods(n *node) error {
+	if c.methods == nil {
+		c.methods = make(map[*decl]*methodInfo)
+	}
+	for _, d := range n.decl.members {
+		if d.kind != declMethod || c.methods[d] != nil {
+			continue
+		}
+		mi := new(methodInfo)
+		var err error
+		mi.paramID, mi.paramBrand, err = c.paramList(n, d, d.paramList, d.paramType, false, false)
+		if err != nil {
+			return err
+		}
+		mi.resultID, mi.resultBrand, err = c.paramList(n, d, d.resultList, d.resultType, d.resultStream, true)
+		if err != nil {
+			return err
+		}
+		c.methods[d] = mi
+	}
+	return nil
+}
+
+func (c *compiler) methodScope(iface *node, m *decl) *scope {
+	sc := scopeOf(iface)
+	sc.implicit = m.implicitParams
+	return sc
+}
+
+func (c *compiler) paramList(iface *node, m *decl, list []*decl, typeExpr *expr, stream, isResults bool) (uint64, *brandScope, error) {
+	sc := c.methodScope(iface, m)
+	switch {
+	case stream:
+		r, err := c.resolve(sc, &expr{
+			kind: exprMember,
+			pos:  m.pos,
+			name: "StreamResult",
+			base: &expr{kind: exprImport, pos: m.pos, str: "/capnp/stream.capnp"},
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return r.node.id, nil, nil
+
+	case typeExpr != nil:
+		r, err := c.resolve(sc, typeExpr)
+		if err != nil {
+			return 0, nil, err
+		}
+		if r.kind != refNode || r.node.kind != declStruct {
+			return 0, nil, c.errorf(typeExpr.pos, "%s is not a struct type", typeExpr)
+		}
+		return r.node.id, r.brand, nil
+	}
+
+	suffix := "$Params"
+	if isResults {
+		suffix = "$Results"
+	}
+	typeName := m.name + suffix
+	sn := &structNode{
+		id:          methodParamsID(iface.id, uint16(m.ordinal), isResults),
+		displayName: iface.displayName + "." + typeName,
+		isParam:     true,
+		isGeneric:   iface.isGeneric() || len(m.implicitParams) > 0,
+		params:      m.implicitParams,
+		decl:        m,
+		sc:          sc,
+	}
+	sn.prefixLen = len(sn.displayName) - len(typeName)
+	if err := c.layoutStruct(sn, list, true); err != nil {
+		return 0, nil, err
+	}
+	c.structs[sn.id] = sn
+
+	b := &brandScope{id: sn.id, nparams: len(m.implicitParams), parent: sc.brand}
+	if len(m.implicitParams) > 0 {
+		b.args = make([]*typ, len(m.implicitParams))
+		for i := range b.args {
+			b.args[i] = &typ{which: schema.Type_Which_anyPointer, implicit: true, paramIndex: i}
+		}
+	}
+	return sn.id, b, nil
+}
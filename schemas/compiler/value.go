@@ -0,0 +1,527 @@
+package compiler
+
+import (
+	"math"
+	"strconv"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/std/capnp/schema"
+)
+
+// constRef reports whether e names a constant, and returns it.
+func (c *compiler) constRef(sc *scope, e *expr) (*node, bool) {
+	if e.kind != exprName && e.kind != exprMember {
+		return nil, false
+	}
+	r, err := c.resolve(sc, e)
+	if err != nil || r.kind != refNode || r.node.kind != declConst {
+		return nil, false
+	}
+	return r.node, true
+}
+
+// constValue returns the scope and expression defining the constant
+// n, after checking that its type is compatible with t.
+func (c *compiler) constValue(n *node, t *typ, at pos) (*scope, *expr, error) {
+	sc := scopeOf(n.parent)
+	ct, err := c.typeOf(sc, n.decl.typ)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ct.which != t.which {
+		return nil, nil, c.errorf(at, "constant %s has the wrong type", n.displayName)
+	}
+	return sc, n.decl.value, nil
+}
+
+// scalarBits evaluates a value of a non-pointer type, returning its
+// bit pattern.
+func (c *compiler) scalarBits(sc *scope, e *expr, t *typ) (uint64, error) {
+	return c.scalarBitsDepth(sc, e, t, 0)
+}
+
+func (c *compiler) scalarBitsDepth(sc *scope, e *expr, t *typ, depth int) (uint64, error) {
+	if depth > 100 {
+		return 0, c.errorf(e.pos, "constant refers to itself")
+	}
+	if e.kind == exprName && !e.absolute {
+		switch t.which {
+		case schema.Type_Which_void:
+			if e.name == "void" {
+				return 0, nil
+			}
+		case schema.Type_Which_bool:
+			switch e.name {
+			case "true":
+				return 1, nil
+			case "false":
+				return 0, nil
+			}
+		case schema.Type_Which_float32, schema.Type_Which_float64:
+			switch e.name {
+			case "inf":
+				return floatBits(t, math.Inf(1)), nil
+			case "nan":
+				return floatBits(t, math.NaN()), nil
+			}
+		case schema.Type_Which_enum:
+			for _, en := range t.node.decl.members {
+				if en.kind == declEnumerant && en.name == e.name {
+					return uint64(en.ordinal), nil
+				}
+			}
+		}
+	}
+	if n, ok := c.constRef(sc, e); ok {
+		csc, ce, err := c.constValue(n, t, e.pos)
+		if err != nil {
+			return 0, err
+		}
+		return c.scalarBitsDepth(csc, ce, t, depth+1)
+	}
+
+	neg := false
+	num := e
+	if e.kind == exprNeg {
+		neg = true
+		num = e.base
+	}
+	switch t.which {
+	case schema.Type_Which_float32, schema.Type_Which_float64:
+		var f float64
+		switch {
+		case num.kind == exprName && num.name == "inf":
+			f = math.Inf(1)
+		case num.kind == exprInt || num.kind == exprFloat:
+			var err error
+			if num.kind == exprInt {
+				var u uint64
+				u, err = strconv.ParseUint(num.str, 0, 64)
+				f = float64(u)
+			} else {
+				f, err = strconv.ParseFloat(num.str, 64)
+			}
+			if err != nil {
+				return 0, c.errorf(num.pos, "invalid number %s", num.str)
+			}
+		default:
+			return 0, c.errorf(e.pos, "expected a floating-point value, found %s", e)
+		}
+		if neg {
+			f = -f
+		}
+		return floatBits(t, f), nil
+
+	case schema.Type_Which_int8, schema.Type_Which_int16, schema.Type_Which_int32, schema.Type_Which_int64,
+		schema.Type_Which_uint8, schema.Type_Which_uint16, schema.Type_Which_uint32, schema.Type_Which_uint64:
+		if num.kind != exprInt {
+			return 0, c.errorf(e.pos, "expected an integer, found %s", e)
+		}
+		u, err := strconv.ParseUint(num.str, 0, 64)
+		if err != nil {
+			return 0, c.errorf(num.pos, "integer %s is out of range", num.str)
+		}
+		bits := uint(8) << (t.lgSize() - 3)
+		signed := t.which <= schema.Type_Which_int64
+		switch {
+		case signed && neg:
+			if u > 1<<(bits-1) {
+				return 0, c.errorf(e.pos, "integer -%s is out of range", num.str)
+			}
+			return -u & mask(bits), nil
+		case signed:
+			if u > 1<<(bits-1)-1 {
+				return 0, c.errorf(e.pos, "integer %s is out of range", num.str)
+			}
+		case neg && u != 0:
+			return 0, c.errorf(e.pos, "integer -%s is out of range for an unsigned type", num.str)
+		case u > mask(bits):
+			return 0, c.errorf(e.pos, "integer %s is out of range", num.str)
+		}
+		return u, nil
+	}
+	return 0, c.errorf(e.pos, "invalid value %s for this type", e)
+}
+
+func mask(bits uint) uint64 {
+	if bits >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bits - 1
+}
+
+func floatBits(t *typ, f float64) uint64 {
+	if t.which == schema.Type_Which_float32 {
+		return uint64(math.Float32bits(float32(f)))
+	}
+	return math.Float64bits(f)
+}
+
+func (c *compiler) textValue(sc *scope, e *expr, t *typ) (string, error) {
+	for depth := 0; depth < 100; depth++ {
+		switch e.kind {
+		case exprString:
+			return e.str, nil
+		case exprBinary:
+			if t.which == schema.Type_Which_data {
+				return e.str, nil
+			}
+		}
+		n, ok := c.constRef(sc, e)
+		if !ok {
+			return "", c.errorf(e.pos, "expected a string, found %s", e)
+		}
+		var err error
+		if sc, e, err = c.constValue(n, t, e.pos); err != nil {
+			return "", err
+		}
+	}
+	return "", c.errorf(e.pos, "constant refers to itself")
+}
+
+// setValue compiles the value e of type t into v.  A nil expression
+// sets the type's default value.
+func (c *compiler) setValue(seg *capnp.Segment, sc *scope, e *expr, t *typ, v schema.Value) error {
+	if e == nil {
+		setZeroValue(t, v)
+		return nil
+	}
+	switch t.which {
+	case schema.Type_Which_text:
+		s, err := c.textValue(sc, e, t)
+		if err != nil {
+			return err
+		}
+		v.SetText(s)
+		return capnp.Struct(v).SetNewText(0, s)
+	case schema.Type_Which_data:
+		s, err := c.textValue(sc, e, t)
+		if err != nil {
+			return err
+		}
+		return v.SetData([]byte(s))
+	case schema.Type_Which_list, schema.Type_Which_structType, schema.Type_Which_anyPointer:
+		p, err := c.buildPtr(seg, sc, e, t, 0)
+		if err != nil {
+			return err
+		}
+		switch t.which {
+		case schema.Type_Which_list:
+			return v.SetList(p)
+		case schema.Type_Which_structType:
+			return v.SetStructValue(p)
+		default:
+			return v.SetAnyPointer(p)
+		}
+	case schema.Type_Which_interface:
+		if e.kind != exprName || e.name != "null" {
+			return c.errorf(e.pos, "interface values can only be null")
+		}
+		v.SetInterface()
+		return nil
+	}
+
+	bits, err := c.scalarBits(sc, e, t)
+	if err != nil {
+		return err
+	}
+	switch t.which {
+	case schema.Type_Which_void:
+		v.SetVoid()
+	case schema.Type_Which_bool:
+		v.SetBool(bits != 0)
+	case schema.Type_Which_int8:
+		v.SetInt8(int8(bits))
+	case schema.Type_Which_int16:
+		v.SetInt16(int16(bits))
+	case schema.Type_Which_int32:
+		v.SetInt32(int32(bits))
+	case schema.Type_Which_int64:
+		v.SetInt64(int64(bits))
+	case schema.Type_Which_uint8:
+		v.SetUint8(uint8(bits))
+	case schema.Type_Which_uint16:
+		v.SetUint16(uint16(bits))
+	case schema.Type_Which_uint32:
+		v.SetUint32(uint32(bits))
+	case schema.Type_Which_uint64:
+		v.SetUint64(bits)
+	case schema.Type_Which_float32:
+		v.SetFloat32(math.Float32frombits(uint32(bits)))
+	case schema.Type_Which_float64:
+		v.SetFloat64(math.Float64frombits(bits))
+	case schema.Type_Which_enum:
+		v.SetEnum(uint16(bits))
+	}
+	return nil
+}
+
+// setZeroValue sets v to the default value for fields of type t that
+// do not declare one.
+func setZeroValue(t *typ, v schema.Value) {
+	switch t.which {
+	case schema.Type_Which_void:
+		v.SetVoid()
+	case schema.Type_Which_bool:
+		v.SetBool(false)
+	case schema.Type_Which_int8:
+		v.SetInt8(0)
+	case schema.Type_Which_int16:
+		v.SetInt16(0)
+	case schema.Type_Which_int32:
+		v.SetInt32(0)
+	case schema.Type_Which_int64:
+		v.SetInt64(0)
+	case schema.Type_Which_uint8:
+		v.SetUint8(0)
+	case schema.Type_Which_uint16:
+		v.SetUint16(0)
+	case schema.Type_Which_uint32:
+		v.SetUint32(0)
+	case schema.Type_Which_uint64:
+		v.SetUint64(0)
+	case schema.Type_Which_float32:
+		v.SetFloat32(0)
+	case schema.Type_Which_float64:
+		v.SetFloat64(0)
+	case schema.Type_Which_text:
+		v.SetText("")
+	case schema.Type_Which_data:
+		v.SetData(nil)
+	case schema.Type_Which_list:
+		v.SetList(capnp.Ptr{})
+	case schema.Type_Which_enum:
+		v.SetEnum(0)
+	case schema.Type_Which_structType:
+		v.SetStructValue(capnp.Ptr{})
+	case schema.Type_Which_interface:
+		v.SetInterface()
+	case schema.Type_Which_anyPointer:
+		v.SetAnyPointer(capnp.Ptr{})
+	}
+}
+
+// buildPtr builds the pointer value e of type t in seg.
+func (c *compiler) buildPtr(seg *capnp.Segment, sc *scope, e *expr, t *typ, depth int) (capnp.Ptr, error) {
+	if depth > 100 {
+		return capnp.Ptr{}, c.errorf(e.pos, "constant refers to itself")
+	}
+	if n, ok := c.constRef(sc, e); ok {
+		csc, ce, err := c.constValue(n, t, e.pos)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		return c.buildPtr(seg, csc, ce, t, depth+1)
+	}
+	switch t.which {
+	case schema.Type_Which_text:
+		s, err := c.textValue(sc, e, t)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		l, err := capnp.NewText(seg, s)
+		return l.ToPtr(), err
+	case schema.Type_Which_data:
+		s, err := c.textValue(sc, e, t)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		l, err := capnp.NewData(seg, []byte(s))
+		return l.ToPtr(), err
+	case schema.Type_Which_structType:
+		sn, err := c.structOf(t.node)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		st, err := capnp.NewStruct(seg, sn.size())
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		if err := c.fillStruct(sc, e, sn, st); err != nil {
+			return capnp.Ptr{}, err
+		}
+		return st.ToPtr(), nil
+	case schema.Type_Which_list:
+		return c.buildList(seg, sc, e, t)
+	}
+	return capnp.Ptr{}, c.errorf(e.pos, "cannot compile a value of this type")
+}
+
+func (sn *structNode) size() capnp.ObjectSize {
+	return capnp.ObjectSize{
+		DataSize:     capnp.Size(sn.top.dataWords * 8),
+		PointerCount: uint16(sn.top.pointers),
+	}
+}
+
+// fillStruct sets the fields of st, a struct or group described by sn,
+// from the struct literal e.
+func (c *compiler) fillStruct(sc *scope, e *expr, sn *structNode, st capnp.Struct) error {
+	if e.kind != exprTuple {
+		return c.errorf(e.pos, "expected a struct value, found %s", e)
+	}
+	for _, a := range e.args {
+		if a.name == "" {
+			return c.errorf(a.value.pos, "struct field values must be named")
+		}
+		var f *field
+		for _, ff := range sn.fields {
+			if ff.name == a.name {
+				f = ff
+				break
+			}
+		}
+		if f == nil {
+			return c.errorf(a.value.pos, "%s has no field named %s", sn.displayName, a.name)
+		}
+		if f.discValue != noDiscriminant {
+			st.SetUint16(capnp.DataOffset(sn.discriminantOffset*2), f.discValue)
+		}
+		if f.group != nil {
+			if err := c.fillStruct(sc, a.value, f.group, st); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.setField(sc, a.value, f, sn.sc, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setField sets the slot f of st to e.  Data fields are stored XORed
+// with their default value, which is evaluated in defScope.
+func (c *compiler) setField(sc *scope, e *expr, f *field, defScope *scope, st capnp.Struct) error {
+	t := f.typ
+	if t.isPointer() {
+		p, err := c.buildPtr(st.Segment(), sc, e, t, 0)
+		if err != nil {
+			return err
+		}
+		return st.SetPtr(uint16(f.offset), p)
+	}
+	bits, err := c.scalarBits(sc, e, t)
+	if err != nil {
+		return err
+	}
+	if f.decl.value != nil {
+		def, err := c.scalarBits(defScope, f.decl.value, t)
+		if err != nil {
+			return err
+		}
+		bits ^= def
+	}
+	switch t.lgSize() {
+	case 0:
+		st.SetBit(capnp.BitOffset(f.offset), bits != 0)
+	case 3:
+		st.SetUint8(capnp.DataOffset(f.offset), uint8(bits))
+	case 4:
+		st.SetUint16(capnp.DataOffset(f.offset*2), uint16(bits))
+	case 5:
+		st.SetUint32(capnp.DataOffset(f.offset*4), uint32(bits))
+	case 6:
+		st.SetUint64(capnp.DataOffset(f.offset*8), bits)
+	}
+	return nil
+}
+
+// buildList builds the list literal e of type t.
+func (c *compiler) buildList(seg *capnp.Segment, sc *scope, e *expr, t *typ) (capnp.Ptr, error) {
+	if e.kind != exprList {
+		return capnp.Ptr{}, c.errorf(e.pos, "expected a list value, found %s", e)
+	}
+	elem := t.elem
+	n := int32(len(e.args))
+	for _, a := range e.args {
+		if a.name != "" {
+			return capnp.Ptr{}, c.errorf(a.value.pos, "list elements cannot be named")
+		}
+	}
+	switch elem.which {
+	case schema.Type_Which_void:
+		return capnp.NewVoidList(seg, n).ToPtr(), nil
+	case schema.Type_Which_bool:
+		l, err := capnp.NewBitList(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, a := range e.args {
+			bits, err := c.scalarBits(sc, a.value, elem)
+			if err != nil {
+				return capnp.Ptr{}, err
+			}
+			l.Set(i, bits != 0)
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_text, schema.Type_Which_data, schema.Type_Which_list,
+		schema.Type_Which_anyPointer, schema.Type_Which_interface:
+		l, err := capnp.NewPointerList(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, a := range e.args {
+			p, err := c.buildPtr(seg, sc, a.value, elem, 0)
+			if err != nil {
+				return capnp.Ptr{}, err
+			}
+			if err := l.Set(i, p); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return l.ToPtr(), nil
+	case schema.Type_Which_structType:
+		sn, err := c.structOf(elem.node)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		l, err := capnp.NewCompositeList(seg, sn.size(), n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		for i, a := range e.args {
+			if err := c.fillStruct(sc, a.value, sn, l.Struct(i)); err != nil {
+				return capnp.Ptr{}, err
+			}
+		}
+		return l.ToPtr(), nil
+	}
+
+	var set func(i int, bits uint64)
+	var ptr capnp.Ptr
+	switch elem.lgSize() {
+	case 3:
+		l, err := capnp.NewUInt8List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		set, ptr = func(i int, bits uint64) { l.Set(i, uint8(bits)) }, l.ToPtr()
+	case 4:
+		l, err := capnp.NewUInt16List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		set, ptr = func(i int, bits uint64) { l.Set(i, uint16(bits)) }, l.ToPtr()
+	case 5:
+		l, err := capnp.NewUInt32List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		set, ptr = func(i int, bits uint64) { l.Set(i, uint32(bits)) }, l.ToPtr()
+	default:
+		l, err := capnp.NewUInt64List(seg, n)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		set, ptr = l.Set, l.ToPtr()
+	}
+	for i, a := range e.args {
+		bits, err := c.scalarBits(sc, a.value, elem)
+		if err != nil {
+			return capnp.Ptr{}, err
+		}
+		set(i, bits)
+	}
+	return ptr, nil
+}
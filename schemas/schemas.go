@@ -13,6 +13,7 @@ import (
 	"compress/zlib"
 	"errors"
 	"io"
+	"sort"
 	"strings"
 	"sync"
 
@@ -34,6 +35,20 @@ type Schema struct {
 	Nodes []uint64
 }
 
+// Request returns the CodeGeneratorRequest message in s, decompressing
+// it if needed, suitable for capnp.Unmarshal.
+func (s *Schema) Request() ([]byte, error) {
+	if len(s.String) > 0 && len(s.Bytes) > 0 {
+		return nil, errors.New("schemas: schema should have only one of string or bytes")
+	}
+	r := &record{
+		s:          s.String,
+		data:       s.Bytes,
+		compressed: s.Compressed,
+	}
+	return r.read()
+}
+
 // A Registry is a mapping of IDs to schema blobs.  It is safe to read
 // from multiple goroutines.  The zero value is an empty registry.
 type Registry struct {
@@ -79,6 +94,17 @@ func (reg *Registry) Find(id uint64) ([]byte, error) {
 	return b, nil
 }
 
+// IDs returns the IDs of all the nodes in the registry, in ascending
+// order.
+func (reg *Registry) IDs() []uint64 {
+	ids := make([]uint64, 0, len(reg.m))
+	for id := range reg.m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
 type record struct {
 	// All the fields are protected by once.
 	once       sync.Once
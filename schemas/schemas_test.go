@@ -1,6 +1,7 @@
 package schemas_test
 
 import (
+	"reflect"
 	"testing"
 
 	"capnproto.org/go/capnp/v3"
@@ -43,6 +44,31 @@ func TestDefaultFind(t *testing.T) {
 	t.Fatalf("could not find node %#x in registry", gocp.Package_)
 }
 
+func TestSchemaRequest(t *testing.T) {
+	data, err := gocp.SchemaRequest()
+	if err != nil {
+		t.Fatal("gocp.SchemaRequest():", err)
+	}
+	msg, err := capnp.Unmarshal(data)
+	if err != nil {
+		t.Fatal("capnp.Unmarshal(gocp.SchemaRequest()) error:", err)
+	}
+	req, err := schema.ReadRootCodeGeneratorRequest(msg)
+	if err != nil {
+		t.Fatalf("ReadRootCodeGeneratorRequest error: %v", err)
+	}
+	nodes, err := req.Nodes()
+	if err != nil {
+		t.Fatalf("req.Nodes() error: %v", err)
+	}
+	for i := 0; i < nodes.Len(); i++ {
+		if nodes.At(i).Id() == gocp.Package_ {
+			return
+		}
+	}
+	t.Errorf("could not find node %#x in gocp.SchemaRequest()", gocp.Package_)
+}
+
 func TestNotFound(t *testing.T) {
 	reg := new(schemas.Registry)
 	_, err := reg.Find(0)
@@ -53,3 +79,19 @@ func TestNotFound(t *testing.T) {
 		t.Errorf("new(schemas.Registry).Find(0) = %v; want not found error", err)
 	}
 }
+
+func TestIDs(t *testing.T) {
+	reg := new(schemas.Registry)
+	if ids := reg.IDs(); len(ids) != 0 {
+		t.Errorf("new(schemas.Registry).IDs() = %#x; want empty", ids)
+	}
+	if err := reg.Register(&schemas.Schema{Bytes: []byte{0}, Nodes: []uint64{3, 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.Register(&schemas.Schema{Bytes: []byte{0}, Nodes: []uint64{2}}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := reg.IDs(); !reflect.DeepEqual(ids, []uint64{1, 2, 3}) {
+		t.Errorf("reg.IDs() = %v; want [1 2 3]", ids)
+	}
+}
@@ -74,21 +74,32 @@ func (s *Segment) readRawPointer(addr address) rawPointer {
 }
 
 func (s *Segment) writeUint8(addr address, val uint8) {
+	s.checkWritable()
 	s.slice(addr, 1)[0] = val
 }
 
 func (s *Segment) writeUint16(addr address, val uint16) {
+	s.checkWritable()
 	binary.LittleEndian.PutUint16(s.slice(addr, 2), val)
 }
 
 func (s *Segment) writeUint32(addr address, val uint32) {
+	s.checkWritable()
 	binary.LittleEndian.PutUint32(s.slice(addr, 4), val)
 }
 
 func (s *Segment) writeUint64(addr address, val uint64) {
+	s.checkWritable()
 	binary.LittleEndian.PutUint64(s.slice(addr, 8), val)
 }
 
+// checkWritable panics if s is in a read-only message.
+func (s *Segment) checkWritable() {
+	if s.msg != nil && s.msg.readOnly {
+		panic("capnp: write to read-only message")
+	}
+}
+
 func (s *Segment) writeRawPointer(addr address, val rawPointer) {
 	s.writeUint64(addr, uint64(val))
 }
@@ -289,7 +300,70 @@ func (s *Segment) resolveFarPointer(paddr address) (dst *Segment, base address,
 	}
 }
 
+// copyStruct allocates a copy of st, preferring placement in s, and
+// copies st's contents and everything it points to into it.
+func (s *Segment) copyStruct(st Struct) (Struct, error) {
+	newSeg, newAddr, err := alloc(s, st.size.totalSize())
+	if err != nil {
+		return Struct{}, exc.WrapError("copy", err)
+	}
+	dst := Struct{
+		seg:        newSeg,
+		off:        newAddr,
+		size:       st.size,
+		depthLimit: maxDepth,
+		// clear flags
+	}
+	if err := copyStruct(dst, st); err != nil {
+		return Struct{}, err
+	}
+	return dst, nil
+}
+
+// copyList allocates a copy of l, preferring placement in s, and
+// copies l's elements and everything they point to into it.
+func (s *Segment) copyList(l List) (List, error) {
+	sz := l.allocSize()
+	newSeg, newAddr, err := alloc(s, sz)
+	if err != nil {
+		return List{}, exc.WrapError("copy", err)
+	}
+	dst := List{
+		seg:        newSeg,
+		off:        newAddr,
+		length:     l.length,
+		size:       l.size,
+		flags:      l.flags &^ isListView,
+		depthLimit: maxDepth,
+	}
+	if dst.flags&isCompositeList != 0 {
+		// Write tag word
+		newSeg.writeRawPointer(newAddr, rawStructPointer(pointerOffset(l.length), l.size))
+		var ok bool
+		dst.off, ok = dst.off.addSize(wordSize)
+		if !ok {
+			return List{}, errors.New("copy composite list: content address overflow")
+		}
+		sz -= wordSize
+	}
+	if dst.flags&isBitList != 0 || dst.size.PointerCount == 0 {
+		end, _ := l.off.addSize(sz) // list was already validated
+		copy(newSeg.data[dst.off:], l.seg.data[l.off:end])
+	} else {
+		for i := 0; i < l.Len(); i++ {
+			err := copyStruct(dst.Struct(i), l.Struct(i))
+			if err != nil {
+				return List{}, exc.WrapError("copy list element"+str.Itod(i), err)
+			}
+		}
+	}
+	return dst, nil
+}
+
 func (s *Segment) writePtr(off address, src Ptr, forceCopy bool) error {
+	if s.msg.readOnly {
+		return errReadOnly
+	}
 	if !src.IsValid() {
 		s.writeRawPointer(off, 0)
 		return nil
@@ -309,18 +383,8 @@ func (s *Segment) writePtr(off address, src Ptr, forceCopy bool) error {
 			return nil
 		}
 		if forceCopy || src.seg.msg != s.msg || st.flags&isListMember != 0 {
-			newSeg, newAddr, err := alloc(s, st.size.totalSize())
+			dst, err := s.copyStruct(st)
 			if err != nil {
-				return exc.WrapError("write pointer: copy", err)
-			}
-			dst := Struct{
-				seg:        newSeg,
-				off:        newAddr,
-				size:       st.size,
-				depthLimit: maxDepth,
-				// clear flags
-			}
-			if err := copyStruct(dst, st); err != nil {
 				return exc.WrapError("write pointer", err)
 			}
 			st = dst
@@ -330,47 +394,17 @@ func (s *Segment) writePtr(off address, src Ptr, forceCopy bool) error {
 		srcRaw = rawStructPointer(0, st.size)
 	case listPtrType:
 		l := src.List()
-		if forceCopy || src.seg.msg != s.msg {
-			sz := l.allocSize()
-			newSeg, newAddr, err := alloc(s, sz)
+		if forceCopy || src.seg.msg != s.msg || l.flags&isListView != 0 {
+			dst, err := s.copyList(l)
 			if err != nil {
-				return exc.WrapError("write pointer: copy", err)
-			}
-			dst := List{
-				seg:        newSeg,
-				off:        newAddr,
-				length:     l.length,
-				size:       l.size,
-				flags:      l.flags,
-				depthLimit: maxDepth,
-			}
-			if dst.flags&isCompositeList != 0 {
-				// Copy tag word
-				newSeg.writeRawPointer(newAddr, l.seg.readRawPointer(l.off-address(wordSize)))
-				var ok bool
-				dst.off, ok = dst.off.addSize(wordSize)
-				if !ok {
-					return errors.New("write pointer: copy composite list: content address overflow")
-				}
-				sz -= wordSize
-			}
-			if dst.flags&isBitList != 0 || dst.size.PointerCount == 0 {
-				end, _ := l.off.addSize(sz) // list was already validated
-				copy(newSeg.data[dst.off:], l.seg.data[l.off:end])
-			} else {
-				for i := 0; i < l.Len(); i++ {
-					err := copyStruct(dst.Struct(i), l.Struct(i))
-					if err != nil {
-						return exc.WrapError("write pointer: copy list element"+str.Itod(i), err)
-					}
-				}
+				return exc.WrapError("write pointer", err)
 			}
 			l = dst
 			src = dst.ToPtr()
 		}
 		srcAddr = l.off
 		if l.flags&isCompositeList != 0 {
-			srcAddr -= address(wordSize)
+			srcAddr = srcAddr.subSizeUnchecked(wordSize)
 		}
 		srcRaw = l.raw()
 	case interfacePtrType:
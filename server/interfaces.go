@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+)
+
+// schemaNodes indexes schemas.DefaultRegistry for Implements.
+var (
+	schemaNodesMu sync.Mutex
+	schemaNodes   nodemap.Map
+)
+
+// Implements reports whether srv implements the interface with the
+// given ID.  It does if it has a method of the interface, or if the
+// interface has no methods of its own and srv implements each of its
+// superclasses, which needs the interface's schema to be registered in
+// schemas.DefaultRegistry.  known is false if srv cannot tell: when
+// the schema is missing, or srv answers unknown methods.
+func (srv *Server) Implements(id uint64) (ok, known bool) {
+	if srv.HandleUnknownMethod != nil {
+		return false, false
+	}
+	schemaNodesMu.Lock()
+	defer schemaNodesMu.Unlock()
+	return srv.implements(id)
+}
+
+func (srv *Server) implements(id uint64) (ok, known bool) {
+	for _, m := range srv.methods {
+		if m.InterfaceID == id {
+			return true, true
+		}
+	}
+	n, err := schemaNodes.Find(id)
+	if err != nil || n.Which() != schema.Node_Which_interface {
+		return false, false
+	}
+	if ms, err := n.Interface().Methods(); err != nil {
+		return false, false
+	} else if ms.Len() > 0 {
+		return false, true
+	}
+	supers, err := n.Interface().Superclasses()
+	if err != nil {
+		return false, false
+	}
+	for i := 0; i < supers.Len(); i++ {
+		if ok, known := srv.implements(supers.At(i).Id()); !ok || !known {
+			return ok, known
+		}
+	}
+	return true, true
+}
+
+// ImplementsInterface implements capnp.InterfaceChecker.
+func (b serverBrand) ImplementsInterface(ctx context.Context, id uint64) (ok, known bool, err error) {
+	ok, known = b.srv.Implements(id)
+	return ok, known, nil
+}
+
+// Interfaces returns the IDs of the interfaces that srv implements, in
+// ascending order: those that it has methods of, and their superclasses
+// that Implements reports srv implements.  Superclasses can only be
+// found if the interfaces' schemas are registered in
+// schemas.DefaultRegistry.
+func (srv *Server) Interfaces() []uint64 {
+	schemaNodesMu.Lock()
+	defer schemaNodesMu.Unlock()
+	seen := make(map[uint64]bool)
+	var ids []uint64
+	var visit func(id uint64)
+	visit = func(id uint64) {
+		if seen[id] {
+			return
+		}
+		seen[id] = true
+		if ok, _ := srv.implements(id); !ok {
+			return
+		}
+		ids = append(ids, id)
+		n, err := schemaNodes.Find(id)
+		if err != nil || n.Which() != schema.Node_Which_interface {
+			return
+		}
+		supers, _ := n.Interface().Superclasses()
+		for i := 0; i < supers.Len(); i++ {
+			visit(supers.At(i).Id())
+		}
+	}
+	for _, m := range srv.methods {
+		visit(m.InterfaceID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,76 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+func init() {
+	air.RegisterSchema(schemas.DefaultRegistry)
+}
+
+func TestCastClient(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p := air.Pipeliner_ServerToClient(&pipeliner{})
+	defer p.Release()
+
+	// A Pipeliner is a CallSequence, since it has its methods.
+	seq, err := capnp.CastClient[air.CallSequence](ctx, capnp.Client(p), air.CallSequence_TypeID)
+	require.NoError(t, err)
+	res, release := seq.GetNumber(ctx, nil)
+	defer release()
+	_, err = res.Struct()
+	require.NoError(t, err)
+
+	_, err = capnp.CastClient[air.Echo](ctx, capnp.Client(p), air.Echo_TypeID)
+	assert.ErrorIs(t, err, capnp.ErrWrongInterface)
+	assert.True(t, exc.IsType(err, exc.Failed), "error type = %v", exc.TypeOf(err))
+
+	// Servers that answer unknown methods cannot be checked.
+	srv := server.New(nil, nil, nil)
+	srv.HandleUnknownMethod = func(m capnp.Method) *server.Method {
+		return &server.Method{
+			Method: m,
+			Impl:   func(ctx context.Context, call *server.Call) error { return nil },
+		}
+	}
+	c := capnp.NewClient(srv)
+	defer c.Release()
+	_, err = capnp.CastClient[air.Echo](ctx, c, air.Echo_TypeID)
+	assert.NoError(t, err)
+
+	null, err := capnp.CastClient[air.Echo](ctx, capnp.Client{}, air.Echo_TypeID)
+	require.NoError(t, err)
+	assert.False(t, null.IsValid())
+}
+
+func TestImplements(t *testing.T) {
+	t.Parallel()
+
+	srv := air.Pipeliner_NewServer(&pipeliner{})
+	defer srv.Shutdown()
+	for _, test := range []struct {
+		id        uint64
+		ok, known bool
+	}{
+		{air.Pipeliner_TypeID, true, true},
+		{air.CallSequence_TypeID, true, true},
+		{air.Echo_TypeID, false, true},
+		{0xdeadbeef, false, false},
+	} {
+		ok, known := srv.Implements(test.id)
+		assert.Equal(t, test.ok, ok, "Implements(%#x) ok", test.id)
+		assert.Equal(t, test.known, known, "Implements(%#x) known", test.id)
+	}
+}
@@ -0,0 +1,128 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+)
+
+// logEvent is an event received by a chanLogger.
+type logEvent struct {
+	level, msg string
+	fields     map[string]any
+}
+
+// chanLogger sends the events it receives on a channel.
+type chanLogger chan logEvent
+
+func (l chanLogger) log(level, msg string, args []any) {
+	fields := make(map[string]any)
+	for i := 0; i+1 < len(args); i += 2 {
+		fields[fmt.Sprint(args[i])] = args[i+1]
+	}
+	l <- logEvent{level, msg, fields}
+}
+
+func (l chanLogger) Debug(msg string, args ...any) { l.log("debug", msg, args) }
+func (l chanLogger) Info(msg string, args ...any)  { l.log("info", msg, args) }
+func (l chanLogger) Warn(msg string, args ...any)  { l.log("warn", msg, args) }
+func (l chanLogger) Error(msg string, args ...any) { l.log("error", msg, args) }
+
+func (l chanLogger) next(t *testing.T) logEvent {
+	t.Helper()
+	select {
+	case e := <-l:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event logged")
+		return logEvent{}
+	}
+}
+
+// lateErrorEcho returns its results with Call.Return, then fails.
+type lateErrorEcho struct{}
+
+func (lateErrorEcho) Echo(ctx context.Context, call air.Echo_echo) error {
+	if _, err := call.AllocResults(); err != nil {
+		return err
+	}
+	if _, err := call.Return(); err != nil {
+		return err
+	}
+	return errors.New("too late")
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("ErrorAfterReturn", func(t *testing.T) {
+		t.Parallel()
+
+		logs := make(chanLogger, 10)
+		srv := air.Echo_NewServer(lateErrorEcho{})
+		srv.Logger = logs
+		echo := air.Echo(capnp.NewClient(srv))
+		defer echo.Release()
+
+		f, release := echo.Echo(ctx, nil)
+		defer release()
+		_, err := f.Struct()
+		require.NoError(t, err)
+
+		e := logs.next(t)
+		assert.Equal(t, "warn", e.level)
+		assert.Equal(t, "too late", fmt.Sprint(e.fields["error"]))
+		assert.Contains(t, e.fields["method"], "Echo.echo")
+	})
+	t.Run("Panic", func(t *testing.T) {
+		t.Parallel()
+
+		logs := make(chanLogger, 10)
+		srv := air.Echo_NewServer(panicEchoImpl{})
+		srv.RecoverPanics = true
+		srv.Logger = logs
+		echo := air.Echo(capnp.NewClient(srv))
+		defer echo.Release()
+
+		f, release := echo.Echo(ctx, func(p air.Echo_echo_Params) error {
+			return p.SetIn("panic")
+		})
+		defer release()
+		_, err := f.Struct()
+		require.Error(t, err)
+
+		e := logs.next(t)
+		assert.Equal(t, "error", e.level)
+		assert.Equal(t, "secret internal state", e.fields["panic"])
+	})
+	t.Run("Unimplemented", func(t *testing.T) {
+		t.Parallel()
+
+		logs := make(chanLogger, 10)
+		srv := air.Echo_NewServer(echoImpl{})
+		srv.Logger = logs
+		c := capnp.NewClient(srv)
+		defer c.Release()
+
+		f, release := c.SendCall(ctx, capnp.Send{
+			Method: capnp.Method{InterfaceID: 0x1234, MethodID: 5},
+		})
+		defer release()
+		_, err := f.Struct()
+		require.Error(t, err)
+
+		e := logs.next(t)
+		assert.Equal(t, "debug", e.level)
+		assert.Equal(t, "@0x1234.@5", e.fields["method"])
+	})
+}
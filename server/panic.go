@@ -0,0 +1,57 @@
+package server
+
+import (
+	"errors"
+	"runtime/debug"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+// ErrPanicked is the cause of the errors returned by calls whose
+// methods panicked on a server with RecoverPanics set.  Use errors.Is
+// to detect it.
+var ErrPanicked = errors.New("method panicked")
+
+// A Panic describes a panic recovered from a method implementation.
+type Panic struct {
+	// Method is the method that panicked.
+	Method capnp.Method
+
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace of the goroutine at the time of the
+	// panic, as formatted by runtime/debug.Stack.
+	Stack []byte
+}
+
+// runMethod calls c's method implementation, recovering a panic if the
+// server is configured to.
+func (srv *Server) runMethod(c *Call) (err error) {
+	if !srv.RecoverPanics {
+		return c.method.Impl(c.ctx, c)
+	}
+	defer func() {
+		v := recover()
+		if v == nil {
+			return
+		}
+		p := Panic{
+			Method: c.recv.Method,
+			Value:  v,
+			Stack:  debug.Stack(),
+		}
+		srv.log().Error("recovered panic in method",
+			"method", p.Method.String(), "panic", v, "stack", string(p.Stack))
+		if srv.OnPanic != nil {
+			srv.OnPanic(p)
+		}
+		err = &exc.Exception{
+			Type:   exc.Failed,
+			Prefix: "capnp server",
+			Cause:  ErrPanicked,
+		}
+	}()
+	return c.method.Impl(c.ctx, c)
+}
@@ -0,0 +1,72 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+// panicEchoImpl is an Echo that panics when asked to echo "panic".
+type panicEchoImpl struct{}
+
+func (panicEchoImpl) Echo(ctx context.Context, call air.Echo_echo) error {
+	in, err := call.Args().In()
+	if err != nil {
+		return err
+	}
+	if in == "panic" {
+		panic("secret internal state")
+	}
+	r, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return r.SetOut(in)
+}
+
+func TestRecoverPanics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	panics := make(chan server.Panic, 1)
+	srv := air.Echo_NewServer(panicEchoImpl{})
+	srv.RecoverPanics = true
+	srv.OnPanic = func(p server.Panic) { panics <- p }
+	echo := air.Echo(capnp.NewClient(srv))
+	defer echo.Release()
+
+	call := func(in string) (string, error) {
+		f, release := echo.Echo(ctx, func(p air.Echo_echo_Params) error {
+			return p.SetIn(in)
+		})
+		defer release()
+		r, err := f.Struct()
+		if err != nil {
+			return "", err
+		}
+		return r.Out()
+	}
+
+	_, err := call("panic")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, server.ErrPanicked)
+	assert.Equal(t, exc.Failed, exc.TypeOf(err))
+	assert.NotContains(t, err.Error(), "secret", "panic value sent to caller")
+
+	p := <-panics
+	assert.Equal(t, "secret internal state", p.Value)
+	assert.Equal(t, uint64(air.Echo_TypeID), p.Method.InterfaceID)
+	assert.Contains(t, string(p.Stack), "panicEchoImpl.Echo")
+
+	// The server keeps servicing calls.
+	out, err := call("hello")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", out)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+)
+
+// A Peer describes the remote vat that made a call, so that servers
+// can authorize or rate-limit calls per peer.  The rpc package attaches
+// a Peer to the context of every call that it delivers.
+type Peer struct {
+	// ConnID identifies the connection that the call arrived on.  It
+	// is unique among the connections in the process.
+	ConnID uint64
+
+	// RemoteAddr is the network address of the peer, or nil if the
+	// transport does not know it.
+	RemoteAddr net.Addr
+
+	// TLS is the state of the peer's TLS connection, or nil if the
+	// call did not arrive over TLS.  Use TLS.VerifiedChains to check
+	// the peer's certificate.
+	TLS *tls.ConnectionState
+}
+
+type peerContextKey struct{}
+
+// WithPeer returns a copy of ctx that carries p.  Transports other than
+// the rpc package can use it to tell servers who is calling.
+func WithPeer(ctx context.Context, p *Peer) context.Context {
+	return context.WithValue(ctx, peerContextKey{}, p)
+}
+
+// PeerFromContext returns the peer attached to ctx by WithPeer.  It
+// returns false if there is none, as for calls made within the process.
+// The context of a call that a method makes on a local capability
+// carries the caller's peer, since the call is made on its behalf.
+func PeerFromContext(ctx context.Context) (*Peer, bool) {
+	p, ok := ctx.Value(peerContextKey{}).(*Peer)
+	return p, ok
+}
+
+// Peer returns the remote vat that made the call, as PeerFromContext
+// does for the call's context.
+func (c *Call) Peer() (*Peer, bool) {
+	return PeerFromContext(c.ctx)
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// A QueueSample records how long a call waited before its method
+// started running.  Calls are serviced one at a time until a method
+// returns or calls Call.Go, so long waits with short methods mean that
+// some method is running for a long time without calling Go.
+type QueueSample struct {
+	// Method is the method that was called.
+	Method capnp.Method
+
+	// Wait is the time from when the call was made until the method
+	// started.  It is zero for calls run inline by Send.
+	Wait time.Duration
+}
+
+// observeQueueTime reports the time that c spent in the queue.
+func (srv *Server) observeQueueTime(c *Call) {
+	if srv.ObserveQueueTime == nil {
+		return
+	}
+	var wait time.Duration
+	if !c.queuedAt.IsZero() {
+		wait = time.Since(c.queuedAt)
+	}
+	srv.ObserveQueueTime(QueueSample{Method: c.recv.Method, Wait: wait})
+}
+
+// A QueueTimeRecorder keeps the most recent queue times that it
+// observes and computes percentiles over them.  Its Observe method can
+// be used as a Server's ObserveQueueTime hook, and the same recorder
+// may be shared by several servers.  The zero value is not usable; use
+// NewQueueTimeRecorder.
+type QueueTimeRecorder struct {
+	mu      sync.Mutex
+	samples []time.Duration // ring buffer
+	next    int             // index of the next sample to overwrite
+	full    bool
+}
+
+// NewQueueTimeRecorder returns a recorder that keeps the last n queue
+// times.  n must be positive.
+func NewQueueTimeRecorder(n int) *QueueTimeRecorder {
+	if n <= 0 {
+		panic("server: queue time recorder size must be positive")
+	}
+	return &QueueTimeRecorder{samples: make([]time.Duration, n)}
+}
+
+// Observe records the wait time of s.
+func (r *QueueTimeRecorder) Observe(s QueueSample) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.samples[r.next] = s.Wait
+	r.next++
+	if r.next == len(r.samples) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// Percentiles returns the queue times at each of the percentiles in
+// ps, which are numbers between 0 and 100, over the recorded samples.
+// It uses the nearest-rank method: the p'th percentile is the smallest
+// recorded time that at least p percent of the samples are no greater
+// than.  If no samples have been recorded, all the times are zero.
+func (r *QueueTimeRecorder) Percentiles(ps ...float64) []time.Duration {
+	r.mu.Lock()
+	n := r.next
+	if r.full {
+		n = len(r.samples)
+	}
+	sorted := make([]time.Duration, n)
+	copy(sorted, r.samples[:n])
+	r.mu.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	res := make([]time.Duration, len(ps))
+	if n == 0 {
+		return res
+	}
+	for i, p := range ps {
+		rank := int(math.Ceil(p / 100 * float64(n)))
+		if rank < 1 {
+			rank = 1
+		} else if rank > n {
+			rank = n
+		}
+		res[i] = sorted[rank-1]
+	}
+	return res
+}
+
+// Count returns the number of samples that the recorder holds.
+func (r *QueueTimeRecorder) Count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.full {
+		return len(r.samples)
+	}
+	return r.next
+}
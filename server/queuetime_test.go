@@ -0,0 +1,66 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+func TestObserveQueueTime(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	impl := &gatedCallSeq{
+		started: make(chan struct{}),
+		unblock: make(chan struct{}),
+	}
+	srv := air.CallSequence_NewServer(impl)
+	samples := make(chan server.QueueSample, 2)
+	srv.ObserveQueueTime = func(s server.QueueSample) { samples <- s }
+	seq := air.CallSequence(capnp.NewClient(srv))
+	defer seq.Release()
+
+	first, release := seq.GetNumber(ctx, nil)
+	defer release()
+	<-impl.started
+	second, release := seq.GetNumber(ctx, nil)
+	defer release()
+	const blocked = 20 * time.Millisecond
+	time.Sleep(blocked)
+	close(impl.unblock)
+	_, err := first.Struct()
+	require.NoError(t, err)
+	_, err = second.Struct()
+	require.NoError(t, err)
+
+	s := <-samples
+	assert.Equal(t, uint64(air.CallSequence_TypeID), s.Method.InterfaceID)
+	s = <-samples
+	assert.GreaterOrEqual(t, s.Wait, blocked, "second call did not wait for the first")
+}
+
+func TestQueueTimeRecorder(t *testing.T) {
+	t.Parallel()
+
+	r := server.NewQueueTimeRecorder(10)
+	assert.Equal(t, []time.Duration{0, 0}, r.Percentiles(50, 99))
+
+	for i := 1; i <= 15; i++ {
+		r.Observe(server.QueueSample{Wait: time.Duration(i) * time.Millisecond})
+	}
+	// Only the last 10 samples, 6ms to 15ms, are kept.
+	assert.Equal(t, 10, r.Count())
+	assert.Equal(t, []time.Duration{
+		6 * time.Millisecond,
+		10 * time.Millisecond,
+		14 * time.Millisecond,
+		15 * time.Millisecond,
+	}, r.Percentiles(0, 50, 90, 100))
+}
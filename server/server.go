@@ -6,6 +6,7 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/exc"
@@ -17,12 +18,20 @@ import (
 type Method struct {
 	capnp.Method
 	Impl func(context.Context, *Call) error
+
+	// Timeout, if not nil, returns the timeout in nanoseconds that the
+	// caller sent in args, in the parameter annotated with $Go.timeout.
+	// If it is not zero, the call's context is canceled once the
+	// timeout has elapsed, counting from when the call was received.
+	// Generated code sets it for methods with such a parameter.
+	Timeout func(args capnp.Struct) uint64
 }
 
 // Call holds the state of an ongoing capability method call.
 // A Call cannot be used after the server method returns.
 type Call struct {
 	ctx    context.Context
+	cancel context.CancelFunc // set by applyTimeout
 	method *Method
 	recv   capnp.Recv
 	aq     *capnp.AnswerQueue
@@ -31,7 +40,12 @@ type Call struct {
 	alloced bool
 	results capnp.Struct
 
-	acked bool
+	acked    bool
+	returned bool
+	inline   bool // running on the caller's goroutine
+	pooled   bool // running on a worker from the pool
+
+	queuedAt time.Time // when the call was queued, if observed
 }
 
 // Args returns the call's arguments.  Args is not safe to
@@ -41,12 +55,22 @@ func (c *Call) Args() capnp.Struct {
 	return c.recv.Args
 }
 
+// Method returns the method being called.  This is mostly useful for
+// implementations returned by HandleUnknownMethod, which may be shared
+// by many methods.
+func (c *Call) Method() capnp.Method {
+	return c.recv.Method
+}
+
 // AllocResults allocates the results struct.  It is an error to call
 // AllocResults more than once.
 func (c *Call) AllocResults(sz capnp.ObjectSize) (capnp.Struct, error) {
 	if c.alloced {
 		return capnp.Struct{}, newError("multiple calls to AllocResults")
 	}
+	if c.returned {
+		return capnp.Struct{}, newError("AllocResults called after Return")
+	}
 	var err error
 	c.alloced = true
 	c.results, err = c.recv.Returner.AllocResults(sz)
@@ -69,9 +93,52 @@ func (c *Call) Go() {
 		return
 	}
 	c.acked = true
-	go c.srv.handleCalls()
+	if c.pooled {
+		return
+	}
+	c.srv.run.Unlock()
+	if !c.inline {
+		go c.srv.handleCalls()
+	}
+}
+
+// Return sends the results allocated by AllocResults to the caller
+// before the method implementation returns.  This lets a method return
+// capabilities, such as a stream, and keep sending into them after the
+// caller has the results: pipelined calls queued on the results are
+// delivered as soon as Return is called.
+//
+// Return implies Go, so that calls on the server, including calls on
+// returned capabilities that the server implements, are serviced while
+// the method runs.  Neither the arguments nor the results may be used
+// after Return, and the error returned by the method implementation is
+// only logged to Server.Logger.
+//
+// The call's context is canceled once the caller is done with the
+// results, which may be right after Return.  Return returns a context
+// with the same values that is never canceled, for the rest of the
+// method to use.
+func (c *Call) Return() (context.Context, error) {
+	if c.returned {
+		return nil, newError("multiple calls to Return")
+	}
+	c.returned = true
+	c.Go()
+	c.recv.ReleaseArgs()
+	c.finish(nil)
+	return detachedContext{c.ctx}, nil
 }
 
+// detachedContext carries the values of its parent, but is never
+// canceled.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
 // Shutdowner is the interface that wraps the Shutdown method.
 type Shutdowner interface {
 	Shutdown()
@@ -97,8 +164,99 @@ type Server struct {
 
 	// Arena implementation
 	NewArena func() capnp.Arena
+
+	// Inline, if true, runs calls made with Send on the caller's
+	// goroutine when the server is idle, instead of handing them to
+	// the server's goroutine.  Send then returns once the method
+	// returns or calls Call.Go, with the answer usually already
+	// resolved.  This avoids a goroutine switch and the promise
+	// machinery for each call, which matters for applications that
+	// use Cap'n Proto interfaces as their internal component model.
+	//
+	// Inline does not change how arguments are passed: as for any
+	// call made with Send, PlaceArgs writes them into a new message
+	// allocated with NewArena, which the method then reads.  Local
+	// calls are never serialized, inline or not.
+	//
+	// Calls are still serviced one at a time and in the order they
+	// were made: a call made while another is in progress or queued
+	// is queued as usual.  Calls received with Recv, such as those
+	// from an RPC connection, are always queued.  Inline is only
+	// suitable for servers whose callers can afford to block while a
+	// method runs; a method that waits on its caller will deadlock.
+	Inline bool
+
+	// ObserveQueueTime, if not nil, is called with the time each call
+	// waited before its method started, just before the method is
+	// run.  It is called while the server is servicing calls, so it
+	// must not block.  A QueueTimeRecorder's Observe method computes
+	// percentiles of the times.  ObserveQueueTime must be set before
+	// any calls are made.
+	ObserveQueueTime func(QueueSample)
+
+	// Workers, if positive, services calls on a pool of that many
+	// goroutines instead of one at a time.  Calls wait in a queue of
+	// at most QueueDepth calls for a free worker; a call that arrives
+	// when the queue is full fails at once with an overloaded
+	// exception, so that latency stays predictable under load and
+	// callers can back off.  If QueueDepth is zero, the queue holds
+	// as many calls as there are workers.
+	//
+	// Calls in a pool run concurrently and may start in any order,
+	// and Call.Go has no effect.  Inline is ignored.  Workers and
+	// QueueDepth must be set before any calls are made.
+	Workers    int
+	QueueDepth int
+
+	// RecoverPanics, if true, recovers panics in method
+	// implementations instead of letting them crash the process.  A
+	// call whose method panics fails with a Failed exception wrapping
+	// ErrPanicked; the panic value is not sent to the caller, since it
+	// may hold internal details.  Calls whose methods panic after
+	// Call.Go may still have made changes that other calls observe.
+	RecoverPanics bool
+
+	// OnPanic, if not nil, is called with each panic recovered because
+	// of RecoverPanics, on the goroutine that ran the method.
+	OnPanic func(Panic)
+
+	// Logger, if not nil, receives events that the server cannot
+	// report to any caller: errors returned by methods after
+	// Call.Return and recovered panics, and at the debug level, calls
+	// that were rejected or expired before running.  The same logger
+	// can be given to rpc.Options.  Logger must be set before any
+	// calls are made.
+	Logger capnp.Logger
+
+	// run is held while a call is being serviced, until the method
+	// returns or calls Call.Go.
+	run sync.Mutex
+
+	mu     sync.Mutex // guards the fields below
+	queued int        // calls in callQueue that have not acquired run
+
+	workQueue chan *Call // worker pool's queue, created on first call
+	poolSize  int        // capacity of workQueue
+	inPool    int        // calls accepted by the pool whose methods have not returned
+	closed    bool       // Shutdown has been called
 }
 
+// log returns srv.Logger, or a logger that discards events if it is
+// nil.
+func (srv *Server) log() capnp.Logger {
+	if srv.Logger == nil {
+		return discardLogger{}
+	}
+	return srv.Logger
+}
+
+type discardLogger struct{}
+
+func (discardLogger) Debug(string, ...any) {}
+func (discardLogger) Info(string, ...any)  {}
+func (discardLogger) Warn(string, ...any)  {}
+func (discardLogger) Error(string, ...any) {}
+
 func (s *Server) String() string {
 	return "*Server@0x" + str.PtrToHex(s)
 }
@@ -120,13 +278,36 @@ func New(methods []Method, brand any, shutdown Shutdowner) *Server {
 	return srv
 }
 
+// AddMethods adds methods for the server to answer, replacing any of
+// its methods with the same IDs.  Like the server's fields, it must be
+// called before the server receives its first call.
+func (srv *Server) AddMethods(methods ...Method) {
+	for _, m := range methods {
+		if mm := srv.methods.find(m.Method); mm != nil {
+			*mm = m
+		} else {
+			srv.methods = append(srv.methods, m)
+		}
+	}
+	sort.Sort(srv.methods)
+}
+
+// Methods returns the methods that the server implements, in ascending
+// order of interface and method ID.  It does not include methods that
+// HandleUnknownMethod would answer.
+func (srv *Server) Methods() []capnp.Method {
+	ms := make([]capnp.Method, len(srv.methods))
+	for i, m := range srv.methods {
+		ms[i] = m.Method
+	}
+	return ms
+}
+
 // Send starts a method call.
 func (srv *Server) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp.ReleaseFunc) {
-	mm := srv.methods.find(s.Method)
-	if mm == nil && srv.HandleUnknownMethod != nil {
-		mm = srv.HandleUnknownMethod(s.Method)
-	}
+	mm := srv.lookup(s.Method)
 	if mm == nil {
+		srv.log().Debug("unimplemented method called", "method", s.Method.String())
 		return capnp.ErrorAnswer(s.Method, capnp.Unimplemented("unimplemented")), func() {}
 	}
 	args, err := srv.sendArgsToStruct(s)
@@ -134,7 +315,7 @@ func (srv *Server) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp
 		return capnp.ErrorAnswer(mm.Method, err), func() {}
 	}
 	ret := new(capnp.StructReturner)
-	pcaller := srv.start(ctx, mm, capnp.Recv{
+	r := capnp.Recv{
 		Method: mm.Method, // pick up names from server method
 		Args:   args,
 		ReleaseArgs: func() {
@@ -144,23 +325,67 @@ func (srv *Server) Send(ctx context.Context, s capnp.Send) (*capnp.Answer, capnp
 			}
 		},
 		Returner: ret,
-	})
-	return ret.Answer(mm.Method, pcaller)
+	}
+	if srv.Inline && srv.Workers <= 0 && srv.tryRun() {
+		return ret.Answer(mm.Method, srv.runInline(ctx, mm, r))
+	}
+	return ret.Answer(mm.Method, srv.start(ctx, mm, r))
+}
+
+// tryRun acquires srv.run if no calls are in progress or queued.
+func (srv *Server) tryRun() bool {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	return srv.queued == 0 && srv.run.TryLock()
+}
+
+// runInline services a call on the current goroutine.  The caller must
+// hold srv.run.
+func (srv *Server) runInline(ctx context.Context, m *Method, r capnp.Recv) capnp.PipelineCaller {
+	srv.wg.Add(1)
+	aq := capnp.NewAnswerQueue(r.Method)
+	call := &Call{
+		ctx:    ctx,
+		method: m,
+		recv:   r,
+		aq:     aq,
+		srv:    srv,
+		inline: true,
+	}
+	call.applyTimeout()
+	srv.observeQueueTime(call)
+	srv.handleCall(call)
+	if !call.acked {
+		srv.run.Unlock()
+	}
+	return aq
 }
 
 // Recv starts a method call.
 func (srv *Server) Recv(ctx context.Context, r capnp.Recv) capnp.PipelineCaller {
-	mm := srv.methods.find(r.Method)
-	if mm == nil && srv.HandleUnknownMethod != nil {
-		mm = srv.HandleUnknownMethod(r.Method)
-	}
+	mm := srv.lookup(r.Method)
 	if mm == nil {
+		srv.log().Debug("unimplemented method called", "method", r.Method.String())
 		r.Reject(capnp.Unimplemented("unimplemented"))
 		return nil
 	}
 	return srv.start(ctx, mm, r)
 }
 
+// lookup returns the implementation of a method or nil if the server
+// does not implement it.
+func (srv *Server) lookup(id capnp.Method) *Method {
+	if mm := srv.methods.find(id); mm != nil {
+		return mm
+	}
+	if srv.HandleUnknownMethod != nil {
+		if mm := srv.HandleUnknownMethod(id); mm != nil {
+			return mm
+		}
+	}
+	return nil
+}
+
 func (srv *Server) handleCalls() {
 	ctx := context.Background()
 	for {
@@ -174,21 +399,56 @@ func (srv *Server) handleCalls() {
 			return
 		}
 
+		srv.run.Lock()
+		srv.mu.Lock()
+		srv.queued--
+		srv.mu.Unlock()
+
+		srv.observeQueueTime(call)
 		srv.handleCall(call)
 		if call.acked {
 			// Another goroutine has taken over; time
 			// to retire.
 			return
 		}
+		srv.run.Unlock()
 	}
 }
 
 func (srv *Server) handleCall(c *Call) {
 	defer srv.wg.Done()
+	defer c.releaseTimeout()
 
-	err := c.method.Impl(c.ctx, c)
+	var err error
+	switch c.ctx.Err() {
+	case context.DeadlineExceeded:
+		// The caller has given up waiting while the call was queued;
+		// don't bother running it.
+		srv.log().Debug("call expired in queue", "method", c.recv.Method.String())
+		err = exc.WrapError("capnp server: call expired in queue", c.ctx.Err())
+	case context.Canceled:
+		// Likewise, the caller canceled the call, as the rpc package
+		// does when it receives a Finish for it.
+		srv.log().Debug("call canceled in queue", "method", c.recv.Method.String())
+		err = exc.WrapError("capnp server: call canceled in queue", c.ctx.Err())
+	default:
+		err = srv.runMethod(c)
+	}
+	if c.returned {
+		// The results were sent by Call.Return.
+		if err != nil {
+			srv.log().Warn("method returned an error after Call.Return; discarding it",
+				"method", c.recv.Method.String(), "error", err)
+		}
+		return
+	}
 
 	c.recv.ReleaseArgs()
+	c.finish(err)
+}
+
+// finish resolves the call with its results or err.
+func (c *Call) finish(err error) {
 	c.recv.Returner.PrepareReturn(err)
 	if err == nil {
 		c.aq.Fulfill(c.results.ToPtr())
@@ -203,25 +463,43 @@ func (srv *Server) start(ctx context.Context, m *Method, r capnp.Recv) capnp.Pip
 	srv.wg.Add(1)
 
 	aq := capnp.NewAnswerQueue(r.Method)
-	srv.callQueue.Send(&Call{
+	call := &Call{
 		ctx:    ctx,
 		method: m,
 		recv:   r,
 		aq:     aq,
 		srv:    srv,
-	})
+	}
+	call.applyTimeout()
+	if srv.ObserveQueueTime != nil {
+		call.queuedAt = time.Now()
+	}
+	if srv.Workers > 0 {
+		srv.enqueueWork(call)
+		return aq
+	}
+	srv.mu.Lock()
+	srv.queued++
+	srv.callQueue.Send(call)
+	srv.mu.Unlock()
 	return aq
 }
 
 // Brand returns a value that will match IsServer.
 func (srv *Server) Brand() capnp.Brand {
-	return capnp.Brand{Value: serverBrand{srv.brand}}
+	return capnp.Brand{Value: serverBrand{srv.brand, srv}}
 }
 
 // Shutdown arranges for Shutdown to be called on the Shutdowner passed
 // into NewServer after outstanding all calls have been serviced.
 // Shutdown must not be called more than once.
 func (srv *Server) Shutdown() {
+	srv.mu.Lock()
+	srv.closed = true
+	if srv.workQueue != nil {
+		close(srv.workQueue)
+	}
+	srv.mu.Unlock()
 	srv.callQueue.Close()
 }
 
@@ -234,7 +512,8 @@ func IsServer(brand capnp.Brand) (_ any, ok bool) {
 }
 
 type serverBrand struct {
-	x any
+	x   any
+	srv *Server
 }
 
 func (srv *Server) sendArgsToStruct(s capnp.Send) (capnp.Struct, error) {
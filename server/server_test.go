@@ -136,6 +136,50 @@ func TestServerCall(t *testing.T) {
 		assert.Equal(t, echoText, answerOut)
 		assert.NoError(t, err, "echo.Echo() error != <nil>; want success")
 	})
+	t.Run("Unknown fallback", func(t *testing.T) {
+		t.Parallel()
+		var called atomic.Value
+
+		// A single implementation answers every unknown method.
+		fallback := func(ctx context.Context, call *server.Call) error {
+			called.Store(call.Method())
+			r, err := call.AllocResults(capnp.ObjectSize{DataSize: 8})
+			if err != nil {
+				return err
+			}
+			r.SetUint32(0, 42)
+			return nil
+		}
+		srv := air.Echo_NewServer(echoImpl{})
+		srv.HandleUnknownMethod = func(m capnp.Method) *server.Method {
+			return &server.Method{Method: m, Impl: fallback}
+		}
+		seq := air.CallSequence(capnp.NewClient(srv))
+		defer seq.Release()
+
+		// Known methods still go to their implementation.
+		echo := air.Echo(seq.AddRef())
+		defer echo.Release()
+		ans, finish := echo.Echo(context.Background(), func(p air.Echo_echo_Params) error {
+			return p.SetIn("hi")
+		})
+		defer finish()
+		resp, err := ans.Struct()
+		require.NoError(t, err)
+		out, _ := resp.Out()
+		assert.Equal(t, "hihi", out)
+		assert.Nil(t, called.Load(), "fallback called for known method")
+
+		// Anything else goes to the fallback.
+		num, finish := seq.GetNumber(context.Background(), nil)
+		defer finish()
+		res, err := num.Struct()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(42), res.N())
+		m, _ := called.Load().(capnp.Method)
+		assert.Equal(t, uint64(air.CallSequence_TypeID), m.InterfaceID)
+		assert.Equal(t, uint16(0), m.MethodID)
+	})
 }
 
 type callSeq uint32
@@ -194,6 +238,81 @@ func TestServerCallOrder(t *testing.T) {
 	}
 }
 
+func TestInline(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	t.Run("Resolved", func(t *testing.T) {
+		srv := air.CallSequence_NewServer(new(callSeq))
+		srv.Inline = true
+		seq := air.CallSequence(capnp.NewClient(srv))
+		defer seq.Release()
+		for i := uint32(0); i < 3; i++ {
+			fut, release := seq.GetNumber(ctx, nil)
+			select {
+			case <-fut.Done():
+			default:
+				t.Errorf("call %d not resolved when Send returned", i)
+			}
+			res, err := fut.Struct()
+			require.NoError(t, err)
+			assert.Equal(t, i, res.N())
+			release()
+		}
+	})
+	t.Run("QueuedWhileBusy", func(t *testing.T) {
+		impl := &gatedCallSeq{
+			started: make(chan struct{}),
+			unblock: make(chan struct{}),
+		}
+		srv := air.CallSequence_NewServer(impl)
+		srv.Inline = true
+		seq := air.CallSequence(capnp.NewClient(srv))
+		defer seq.Release()
+
+		first := make(chan air.CallSequence_getNumber_Results_Future, 1)
+		releaseFirst := make(chan capnp.ReleaseFunc, 1)
+		go func() {
+			fut, release := seq.GetNumber(ctx, nil)
+			first <- fut
+			releaseFirst <- release
+		}()
+		<-impl.started
+		second, release := seq.GetNumber(ctx, nil)
+		defer release()
+		select {
+		case <-second.Done():
+			t.Fatal("call made while the server was busy did not wait")
+		case <-time.After(10 * time.Millisecond):
+		}
+		close(impl.unblock)
+
+		res, err := (<-first).Struct()
+		defer (<-releaseFirst)()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(0), res.N())
+		res, err = second.Struct()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), res.N())
+	})
+}
+
+// gatedCallSeq is a CallSequence whose first call closes started and
+// then blocks until unblock is closed.
+type gatedCallSeq struct {
+	callSeq
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (seq *gatedCallSeq) GetNumber(ctx context.Context, call air.CallSequence_getNumber) error {
+	if seq.callSeq == 0 {
+		close(seq.started)
+		<-seq.unblock
+	}
+	return seq.callSeq.GetNumber(ctx, call)
+}
+
 func TestServerShutdown(t *testing.T) {
 	wait := make(chan struct{})
 	echo := air.Echo_ServerToClient(blockingEchoImpl{wait})
@@ -390,3 +509,197 @@ func (c *callSeqBlockN) GetNumber(ctx context.Context, p air.CallSequence_getNum
 		return ctx.Err()
 	}
 }
+
+// TestServerExpiredCall verifies that calls whose deadline passes while
+// they are queued are rejected without running the method.
+func TestServerExpiredCall(t *testing.T) {
+	t.Parallel()
+
+	impl := &blockingCallSeq{unblock: make(chan struct{})}
+	seq := air.CallSequence_ServerToClient(impl)
+	defer seq.Release()
+
+	first, finish := seq.GetNumber(context.Background(), nil)
+	defer finish()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	second, finish := seq.GetNumber(ctx, nil)
+	defer finish()
+
+	<-ctx.Done()
+	close(impl.unblock)
+
+	_, err := first.Struct()
+	require.NoError(t, err)
+	_, err = second.Struct()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, uint32(1), impl.n.Load(), "expired call was run")
+}
+
+// TestServerCanceledCall verifies that calls canceled while they are
+// queued are rejected without running the method.
+func TestServerCanceledCall(t *testing.T) {
+	t.Parallel()
+
+	impl := &blockingCallSeq{unblock: make(chan struct{})}
+	seq := air.CallSequence_ServerToClient(impl)
+	defer seq.Release()
+
+	first, finish := seq.GetNumber(context.Background(), nil)
+	defer finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	second, finish := seq.GetNumber(ctx, nil)
+	defer finish()
+
+	cancel()
+	close(impl.unblock)
+
+	_, err := first.Struct()
+	require.NoError(t, err)
+	_, err = second.Struct()
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, uint32(1), impl.n.Load(), "canceled call was run")
+}
+
+// blockingCallSeq is a CallSequence whose first call blocks the
+// server's queue until unblock is closed.
+type blockingCallSeq struct {
+	unblock chan struct{}
+	n       atomic.Uint32
+}
+
+func (seq *blockingCallSeq) GetNumber(ctx context.Context, call air.CallSequence_getNumber) error {
+	if seq.n.Add(1) == 1 {
+		<-seq.unblock
+	}
+	return nil
+}
+
+// streamingPipeliner returns its results early and keeps running until
+// done is closed.
+type streamingPipeliner struct {
+	callSeq
+	done   <-chan struct{}
+	ctxErr chan<- error
+}
+
+func (p *streamingPipeliner) NewPipeliner(ctx context.Context, call air.Pipeliner_newPipeliner) error {
+	r, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	r.SetPipeliner(air.Pipeliner_ServerToClient(new(pipeliner)))
+	ctx, err = call.Return()
+	if err != nil {
+		return err
+	}
+	if _, err := call.Return(); err == nil {
+		p.ctxErr <- errors.New("second Return succeeded")
+		return nil
+	}
+	<-p.done
+	p.ctxErr <- ctx.Err()
+	return errors.New("discarded")
+}
+
+func TestCallReturn(t *testing.T) {
+	t.Parallel()
+
+	done := make(chan struct{})
+	ctxErr := make(chan error, 1)
+	p := air.Pipeliner_ServerToClient(&streamingPipeliner{done: done, ctxErr: ctxErr})
+	defer p.Release()
+
+	ctx := context.Background()
+	ans, finish := p.NewPipeliner(ctx, nil)
+	pipelined, finishPipelined := ans.Pipeliner().GetNumber(ctx, nil)
+	defer finishPipelined()
+
+	// The results arrive while the method is still running.
+	res, err := ans.Struct()
+	require.NoError(t, err)
+	q := res.Pipeliner().AddRef()
+	defer q.Release()
+	n, err := pipelined.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), n.N())
+
+	// Other calls on the server are not blocked by the running method.
+	seq, finishSeq := p.GetNumber(ctx, nil)
+	defer finishSeq()
+	_, err = seq.Struct()
+	require.NoError(t, err)
+
+	finish()
+	close(done)
+	select {
+	case err := <-ctxErr:
+		assert.NoError(t, err, "context returned by Return")
+	case <-time.After(5 * time.Second):
+		t.Fatal("method did not finish")
+	}
+
+	n2, finish2 := q.GetNumber(ctx, nil)
+	defer finish2()
+	res2, err := n2.Struct()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), res2.N())
+}
+
+type peerEcho struct {
+	peers chan *server.Peer
+}
+
+func (e peerEcho) Echo(ctx context.Context, call air.Echo_echo) error {
+	p, _ := call.Peer()
+	e.peers <- p
+	return nil
+}
+
+func TestCallPeer(t *testing.T) {
+	t.Parallel()
+
+	peers := make(chan *server.Peer, 1)
+	echo := air.Echo_ServerToClient(peerEcho{peers: peers})
+	defer echo.Release()
+
+	call := func(ctx context.Context) *server.Peer {
+		ans, finish := echo.Echo(ctx, nil)
+		defer finish()
+		_, err := ans.Struct()
+		require.NoError(t, err)
+		return <-peers
+	}
+
+	assert.Nil(t, call(context.Background()), "local calls have no peer")
+
+	want := &server.Peer{ConnID: 42}
+	assert.Same(t, want, call(server.WithPeer(context.Background(), want)))
+}
+
+// TestMethodTimeout verifies that a call's context is bounded by the
+// timeout that Method.Timeout reads from the arguments.
+func TestMethodTimeout(t *testing.T) {
+	t.Parallel()
+
+	srv := server.New([]server.Method{{
+		Method: capnp.Method{InterfaceID: air.Echo_TypeID, MethodID: 0},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			if _, ok := ctx.Deadline(); !ok {
+				return errors.New("call context has no deadline")
+			}
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		Timeout: func(args capnp.Struct) uint64 { return uint64(10 * time.Millisecond) },
+	}}, nil, nil)
+	echo := air.Echo(capnp.NewClient(srv))
+	defer echo.Release()
+
+	ans, finish := echo.Echo(context.Background(), nil)
+	defer finish()
+	_, err := ans.Struct()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// CallTimeout returns the number of nanoseconds left until ctx's
+// deadline, for a parameter annotated with $Go.timeout.  It returns
+// zero if ctx has no deadline, and one if the deadline has passed, so
+// that the callee gives up at once instead of running the call without
+// a deadline.  Generated clients call it for methods with such a
+// parameter.
+func CallTimeout(ctx context.Context) uint64 {
+	d, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	timeout := time.Until(d)
+	if timeout <= 0 {
+		return 1
+	}
+	return uint64(timeout)
+}
+
+// applyTimeout bounds the call's context by the timeout that the
+// caller sent in its arguments, if the method has a parameter annotated
+// with $Go.timeout.
+func (c *Call) applyTimeout() {
+	if c.method.Timeout == nil {
+		return
+	}
+	t := c.method.Timeout(c.recv.Args)
+	if t == 0 || t > math.MaxInt64 {
+		return
+	}
+	c.ctx, c.cancel = context.WithTimeout(c.ctx, time.Duration(t))
+}
+
+// releaseTimeout releases the resources of the context made by
+// applyTimeout once the call is over.
+func (c *Call) releaseTimeout() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+}
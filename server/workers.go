@@ -0,0 +1,71 @@
+package server
+
+import (
+	"capnproto.org/go/capnp/v3/exc"
+)
+
+// errQueueFull is returned by calls made on a server whose worker pool
+// has no room for them.
+var errQueueFull = exc.New(exc.Overloaded, "capnp server", "call queue full")
+
+// startWorkers starts the server's worker pool if it has not been
+// started yet.  The caller must hold srv.mu.
+func (srv *Server) startWorkers() {
+	if srv.workQueue != nil || srv.closed {
+		return
+	}
+	depth := srv.QueueDepth
+	if depth <= 0 {
+		depth = srv.Workers
+	}
+	srv.poolSize = srv.Workers + depth
+	srv.workQueue = make(chan *Call, srv.poolSize)
+	for i := 0; i < srv.Workers; i++ {
+		go srv.work()
+	}
+}
+
+// enqueueWork hands c to the worker pool, rejecting it with an
+// overloaded exception if the queue is full.  Calls are counted from
+// when they are accepted until their methods return, so that a call is
+// only rejected when every worker is busy, and sends to srv.workQueue
+// never block.
+func (srv *Server) enqueueWork(c *Call) {
+	c.pooled = true
+	srv.mu.Lock()
+	srv.startWorkers()
+	if srv.closed {
+		srv.mu.Unlock()
+		srv.reject(c, newError("call on shut down server"))
+		return
+	}
+	if srv.inPool >= srv.poolSize {
+		srv.mu.Unlock()
+		srv.reject(c, errQueueFull)
+		return
+	}
+	srv.inPool++
+	srv.workQueue <- c
+	srv.mu.Unlock()
+}
+
+// reject resolves a call that never ran with err.
+func (srv *Server) reject(c *Call, err error) {
+	defer srv.wg.Done()
+	defer c.releaseTimeout()
+	srv.log().Debug("call rejected", "method", c.recv.Method.String(), "error", err)
+	c.recv.ReleaseArgs()
+	c.finish(err)
+}
+
+// work services calls from the worker pool's queue until the server
+// shuts down.
+func (srv *Server) work() {
+	for c := range srv.workQueue {
+		srv.observeQueueTime(c)
+		srv.handleCall(c)
+		srv.mu.Lock()
+		srv.inPool--
+		srv.mu.Unlock()
+	}
+}
@@ -0,0 +1,80 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+)
+
+// startedEchoImpl is an Echo that sends on started when a call starts,
+// and then waits for unblock to be closed.
+type startedEchoImpl struct {
+	started chan<- struct{}
+	unblock <-chan struct{}
+}
+
+func (e startedEchoImpl) Echo(ctx context.Context, call air.Echo_echo) error {
+	e.started <- struct{}{}
+	<-e.unblock
+	r, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return r.SetOut("done")
+}
+
+func TestWorkers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	srv := air.Echo_NewServer(startedEchoImpl{started: started, unblock: unblock})
+	srv.Workers = 2
+	srv.QueueDepth = 1
+	echo := air.Echo(capnp.NewClient(srv))
+	defer echo.Release()
+
+	// Two calls run at once, without calling Go.
+	var calls []air.Echo_echo_Results_Future
+	for i := 0; i < 2; i++ {
+		f, release := echo.Echo(ctx, nil)
+		defer release()
+		calls = append(calls, f)
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("calls not run concurrently")
+		}
+	}
+
+	// The next call waits in the queue, and the one after that
+	// overflows it.
+	f, release := echo.Echo(ctx, nil)
+	defer release()
+	calls = append(calls, f)
+	overflow, release := echo.Echo(ctx, nil)
+	defer release()
+	_, err := overflow.Struct()
+	require.Error(t, err)
+	assert.True(t, exc.IsType(err, exc.Overloaded), "error type = %v", exc.TypeOf(err))
+
+	close(unblock)
+	<-started
+	for i, f := range calls {
+		r, err := f.Struct()
+		require.NoError(t, err, "call %d", i)
+		out, err := r.Out()
+		require.NoError(t, err)
+		assert.Equal(t, "done", out)
+	}
+}
@@ -0,0 +1,232 @@
+package capnp
+
+import (
+	"errors"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// MessageStats describes how a message's space is used, for auditing
+// the size of messages.  Byte counts are for the message's segments,
+// not including the stream framing.
+type MessageStats struct {
+	// SegmentSizes is the number of bytes used in each segment, and
+	// SegmentCapacities is the number of bytes allocated for each
+	// segment by the arena.
+	SegmentSizes      []uint64
+	SegmentCapacities []uint64
+
+	// Structs and Lists are the number of objects reachable from the
+	// root pointer.
+	Structs int
+	Lists   int
+
+	// Pointers is the number of non-null pointers reachable from the
+	// root pointer, including the root pointer itself, and
+	// NullPointers is the number of null pointers in reachable
+	// objects.  FarPointers is the number of Pointers that point into
+	// another segment, and Capabilities is the number of Pointers that
+	// are capability pointers.
+	Pointers     int
+	NullPointers int
+	FarPointers  int
+	Capabilities int
+
+	// Depth is the length of the longest chain of pointers from the
+	// root pointer.  A message with an empty root struct has depth 1.
+	Depth int
+
+	// ObjectBytes is the size of the reachable objects, including the
+	// root pointer, list tags and far pointer landing pads.
+	ObjectBytes uint64
+
+	// FarPointerBytes is the size of the landing pads of far pointers.
+	// It is included in ObjectBytes.
+	FarPointerBytes uint64
+
+	// PaddingBytes is the number of bytes that were added to the ends
+	// of lists to fill a word.  It is included in ObjectBytes.
+	PaddingBytes uint64
+
+	// UnreachableBytes is the number of bytes in the segments that are
+	// not part of any reachable object, such as objects that were
+	// overwritten while building the message.
+	UnreachableBytes uint64
+
+	// UnusedBytes is the number of bytes allocated for the segments
+	// that are not used.  It is zero for messages that were read from
+	// a stream.
+	UnusedBytes uint64
+}
+
+// WastedBytes returns the number of bytes in the message that do not
+// hold data: padding, unreachable objects and unused segment space.
+func (s MessageStats) WastedBytes() uint64 {
+	return s.PaddingBytes + s.UnreachableBytes + s.UnusedBytes
+}
+
+// ReclaimableBytes returns the number of bytes that Message.Compact
+// would free: unreachable objects, unused segment space and far
+// pointer landing pads.  Padding is kept by compaction.
+func (s MessageStats) ReclaimableBytes() uint64 {
+	return s.UnreachableBytes + s.UnusedBytes + s.FarPointerBytes
+}
+
+// Stats walks the objects reachable from the message's root and
+// returns statistics about the message's layout.  Like Validate, Stats
+// is subject to DepthLimit and TraverseLimit but does not consume the
+// message's read limit.
+//
+// An object that is reachable by more than one pointer is counted
+// each time it is reached, which can only happen in non-canonical
+// messages.
+func (m *Message) Stats() (MessageStats, error) {
+	var st MessageStats
+	nsegs := m.NumSegments()
+	var total uint64
+	for i := int64(0); i < nsegs; i++ {
+		s, err := m.Segment(SegmentID(i))
+		if err != nil {
+			return MessageStats{}, exc.WrapError("stats", err)
+		}
+		size := uint64(len(s.data))
+		st.SegmentSizes = append(st.SegmentSizes, size)
+		st.SegmentCapacities = append(st.SegmentCapacities, uint64(cap(s.data)))
+		st.UnusedBytes += uint64(cap(s.data)) - size
+		total += size
+	}
+	s, err := m.Segment(0)
+	if err != nil {
+		return MessageStats{}, exc.WrapError("stats", err)
+	}
+	if !s.regionInBounds(0, wordSize) {
+		return MessageStats{}, errors.New("stats: root pointer out of bounds")
+	}
+	limit := m.TraverseLimit
+	if limit == 0 {
+		limit = defaultTraverseLimit
+	}
+	w := statsWalker{validator: validator{limit: limit}, st: &st}
+	st.ObjectBytes = uint64(wordSize)
+	if err := w.ptr(s, 0, m.depthLimit(), 0); err != nil {
+		return MessageStats{}, exc.WrapError("stats", err)
+	}
+	if st.ObjectBytes < total {
+		st.UnreachableBytes = total - st.ObjectBytes
+	}
+	return st, nil
+}
+
+// statsWalker walks a message's objects like validator, recording
+// statistics as it goes.
+type statsWalker struct {
+	validator
+	st *MessageStats
+}
+
+// ptr records the pointer at paddr in s, which is depth pointers away
+// from the root pointer, and the object it refers to.
+func (w *statsWalker) ptr(s *Segment, paddr address, depthLimit uint, depth int) error {
+	switch s.readRawPointer(paddr).pointerType() {
+	case farPointer:
+		w.st.FarPointers++
+		w.st.FarPointerBytes += uint64(wordSize)
+		w.st.ObjectBytes += uint64(wordSize)
+	case doubleFarPointer:
+		w.st.FarPointers++
+		w.st.FarPointerBytes += 2 * uint64(wordSize)
+		w.st.ObjectBytes += 2 * uint64(wordSize)
+	}
+	s, base, val, err := s.resolveFarPointer(paddr)
+	if err != nil {
+		return err
+	}
+	if val == 0 {
+		w.st.NullPointers++
+		return nil
+	}
+	w.st.Pointers++
+	if depthLimit == 0 {
+		return errors.New("depth limit reached")
+	}
+	switch val.pointerType() {
+	case structPointer:
+		sp, err := s.readStructPtr(base, val)
+		if err != nil {
+			return err
+		}
+		if !w.canRead(sp.readSize()) {
+			return errors.New("read traversal limit reached")
+		}
+		w.object(depth + 1)
+		w.st.Structs++
+		w.st.ObjectBytes += uint64(sp.size.totalSize())
+		return w.structPtrs(sp, depthLimit-1, depth+1)
+	case listPointer:
+		lp, err := s.readListPtr(base, val)
+		if err != nil {
+			return err
+		}
+		if !w.canRead(lp.readSize()) {
+			return errors.New("read traversal limit reached")
+		}
+		w.object(depth + 1)
+		w.st.Lists++
+		sz := lp.allocSize()
+		w.st.ObjectBytes += uint64(sz.padToWord())
+		w.st.PaddingBytes += uint64(sz.padToWord() - sz)
+		return w.list(lp, depthLimit-1, depth+1)
+	case otherPointer:
+		if val.otherPointerType() != 0 {
+			return errors.New("unknown pointer type")
+		}
+		w.st.Capabilities++
+		return nil
+	default:
+		// Only other types are far pointers.
+		return errors.New("far pointer landing pad is a far pointer")
+	}
+}
+
+// object records an object at the given depth.
+func (w *statsWalker) object(depth int) {
+	if depth > w.st.Depth {
+		w.st.Depth = depth
+	}
+}
+
+func (w *statsWalker) structPtrs(p Struct, depthLimit uint, depth int) error {
+	for i := uint16(0); i < p.size.PointerCount; i++ {
+		if err := w.ptr(p.seg, p.pointerAddress(i), depthLimit, depth); err != nil {
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+	}
+	return nil
+}
+
+func (w *statsWalker) list(l List, depthLimit uint, depth int) error {
+	switch {
+	case l.flags&isCompositeList != 0:
+		elemDepth := depthLimit
+		if elemDepth > 0 {
+			elemDepth--
+		}
+		for i := 0; i < l.Len(); i++ {
+			if err := w.structPtrs(l.Struct(i), elemDepth, depth); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+		}
+	case l.size.PointerCount > 0:
+		for i := 0; i < l.Len(); i++ {
+			addr, ok := l.off.element(int32(i), l.size.totalSize())
+			if !ok {
+				return errors.New("list element " + str.Itod(i) + ": address overflow")
+			}
+			if err := w.ptr(l.seg, addr, depthLimit, depth); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+		}
+	}
+	return nil
+}
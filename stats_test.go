@@ -0,0 +1,79 @@
+package capnp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageStats(t *testing.T) {
+	t.Parallel()
+
+	msg, seg, err := NewMessage(SingleSegment(nil))
+	require.NoError(t, err)
+	root, err := NewRootStruct(seg, ObjectSize{DataSize: 8, PointerCount: 2})
+	require.NoError(t, err)
+	require.NoError(t, root.SetText(0, "hello"))
+	require.NoError(t, root.SetText(0, "abc")) // orphans "hello"
+
+	st, err := msg.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{48}, st.SegmentSizes)
+	require.Len(t, st.SegmentCapacities, 1)
+	assert.Equal(t, st.SegmentCapacities[0]-48, st.UnusedBytes)
+	assert.Equal(t, 1, st.Structs)
+	assert.Equal(t, 1, st.Lists)
+	assert.Equal(t, 2, st.Pointers)
+	assert.Equal(t, 1, st.NullPointers)
+	assert.Equal(t, 0, st.FarPointers)
+	assert.Equal(t, 2, st.Depth)
+	assert.Equal(t, uint64(40), st.ObjectBytes, "root pointer, struct and text")
+	assert.Equal(t, uint64(4), st.PaddingBytes, `"abc\x00" padded to a word`)
+	assert.Equal(t, uint64(8), st.UnreachableBytes, `orphaned "hello\x00"`)
+	assert.Equal(t, 12+st.UnusedBytes, st.WastedBytes())
+}
+
+func TestMessageStatsRaw(t *testing.T) {
+	t.Parallel()
+
+	msg := &Message{Arena: MultiSegment([][]byte{
+		rawWords(rawFarPointer(1, 0)),
+		rawWords(
+			rawStructPointer(0, ObjectSize{PointerCount: 2}),
+			rawInterfacePointer(0),
+			rawListPointer(0, bit1List, 3),
+			0x5,
+		),
+	})}
+	st, err := msg.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{8, 32}, st.SegmentSizes)
+	assert.Equal(t, 1, st.FarPointers)
+	assert.Equal(t, 1, st.Capabilities)
+	assert.Equal(t, 3, st.Pointers)
+	assert.Equal(t, 0, st.NullPointers)
+	assert.Equal(t, uint64(40), st.ObjectBytes)
+	assert.Equal(t, uint64(8), st.FarPointerBytes, "landing pad")
+	assert.Equal(t, uint64(7), st.PaddingBytes, "3-bit list padded to a word")
+	assert.Zero(t, st.UnreachableBytes)
+	assert.Zero(t, st.UnusedBytes)
+	assert.Equal(t, uint64(8), st.ReclaimableBytes())
+
+	_, err = (&Message{Arena: MultiSegment([][]byte{structChain(4)}), DepthLimit: 3}).Stats()
+	assert.Error(t, err, "depth limit")
+}
+
+func TestPointerListHas(t *testing.T) {
+	t.Parallel()
+
+	_, seg, err := NewMessage(SingleSegment(nil))
+	require.NoError(t, err)
+	pl, err := NewPointerList(seg, 2)
+	require.NoError(t, err)
+	empty, err := NewStruct(seg, ObjectSize{})
+	require.NoError(t, err)
+	require.NoError(t, pl.Set(1, empty.ToPtr()))
+	assert.False(t, pl.Has(0))
+	assert.True(t, pl.Has(1), "empty struct is set")
+}
@@ -0,0 +1,501 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package bytestream
+
+import (
+	bytes "bytes"
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	fc "capnproto.org/go/capnp/v3/flowcontrol"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	stream "capnproto.org/go/capnp/v3/std/capnp/stream"
+	context "context"
+	io "io"
+)
+
+// A sink for a stream of bytes.  The sender calls write with each
+// chunk of the stream, in order, and then calls done.  write is a
+// streaming method, so the sender can have several chunks in flight
+// while the receiver applies backpressure.
+type ByteStream capnp.Client
+
+// ByteStream_TypeID is the unique identifier for the type ByteStream.
+const ByteStream_TypeID = 0xe70f74da5a6cf518
+
+// Appends a chunk to the stream.
+func (c ByteStream) Write(ctx context.Context, params func(ByteStream_write_Params) error) error {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe70f74da5a6cf518,
+			MethodID:      0,
+			InterfaceName: "bytestream.capnp:ByteStream",
+			MethodName:    "write",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(ByteStream_write_Params(s)) }
+	}
+
+	return capnp.Client(c).SendStreamCall(ctx, s)
+
+}
+
+// Signals that the whole stream has been written.  It returns once
+// the receiver has processed every chunk, so a successful return
+// means that the transfer is complete.
+func (c ByteStream) Done(ctx context.Context, params func(ByteStream_done_Params) error) (ByteStream_done_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe70f74da5a6cf518,
+			MethodID:      1,
+			InterfaceName: "bytestream.capnp:ByteStream",
+			MethodName:    "done",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(ByteStream_done_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return ByteStream_done_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c ByteStream) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c ByteStream) String() string {
+	return "ByteStream(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c ByteStream) AddRef() ByteStream {
+	return ByteStream(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c ByteStream) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c ByteStream) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c ByteStream) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (ByteStream) DecodeFromPtr(p capnp.Ptr) ByteStream {
+	return ByteStream(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c ByteStream) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c ByteStream) IsSame(other ByteStream) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c ByteStream) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c ByteStream) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A ByteStream_Server is a ByteStream with a local implementation.
+type ByteStream_Server interface {
+	// Appends a chunk to the stream.
+	Write(context.Context, ByteStream_write) error
+	// Signals that the whole stream has been written.  It returns once
+	// the receiver has processed every chunk, so a successful return
+	// means that the transfer is complete.
+	Done(context.Context, ByteStream_done) error
+}
+
+// ByteStream_NewServer creates a new Server from an implementation of ByteStream_Server.
+func ByteStream_NewServer(s ByteStream_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(ByteStream_Methods(nil, s), s, c)
+}
+
+// ByteStream_ServerToClient creates a new Client from an implementation of ByteStream_Server.
+// The caller is responsible for calling Release on the returned Client.
+func ByteStream_ServerToClient(s ByteStream_Server) ByteStream {
+	return ByteStream(capnp.NewClient(ByteStream_NewServer(s)))
+}
+
+// ByteStream_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func ByteStream_Methods(methods []server.Method, s ByteStream_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe70f74da5a6cf518,
+			MethodID:      0,
+			InterfaceName: "bytestream.capnp:ByteStream",
+			MethodName:    "write",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Write(ctx, ByteStream_write{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe70f74da5a6cf518,
+			MethodID:      1,
+			InterfaceName: "bytestream.capnp:ByteStream",
+			MethodName:    "done",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Done(ctx, ByteStream_done{call})
+		},
+	})
+
+	return methods
+}
+
+// ByteStream_write holds the state for a server call to ByteStream.write.
+// See server.Call for documentation.
+type ByteStream_write struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c ByteStream_write) Args() ByteStream_write_Params {
+	return ByteStream_write_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c ByteStream_write) AllocResults() (stream.StreamResult, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return stream.StreamResult(r), err
+}
+
+// ByteStream_done holds the state for a server call to ByteStream.done.
+// See server.Call for documentation.
+type ByteStream_done struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c ByteStream_done) Args() ByteStream_done_Params {
+	return ByteStream_done_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c ByteStream_done) AllocResults() (ByteStream_done_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ByteStream_done_Results(r), err
+}
+
+// ByteStream_List is a list of ByteStream.
+type ByteStream_List = capnp.CapList[ByteStream]
+
+// NewByteStream_List creates a new list of ByteStream.
+func NewByteStream_List(s *capnp.Segment, sz int32) (ByteStream_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[ByteStream](l), err
+}
+
+type ByteStream_done_Params capnp.Struct
+
+// ByteStream_done_Params_TypeID is the unique identifier for the type ByteStream_done_Params.
+const ByteStream_done_Params_TypeID = 0x936c9080becc27d2
+
+func NewByteStream_done_Params(s *capnp.Segment) (ByteStream_done_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ByteStream_done_Params(st), err
+}
+
+func NewRootByteStream_done_Params(s *capnp.Segment) (ByteStream_done_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ByteStream_done_Params(st), err
+}
+
+func ReadRootByteStream_done_Params(msg *capnp.Message) (ByteStream_done_Params, error) {
+	root, err := msg.Root()
+	return ByteStream_done_Params(root.Struct()), err
+}
+
+func (s ByteStream_done_Params) String() string {
+	str, _ := text.Marshal(0x936c9080becc27d2, capnp.Struct(s))
+	return str
+}
+
+func (s ByteStream_done_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ByteStream_done_Params) DecodeFromPtr(p capnp.Ptr) ByteStream_done_Params {
+	return ByteStream_done_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ByteStream_done_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ByteStream_done_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ByteStream_done_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ByteStream_done_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// ByteStream_done_Params_List is a list of ByteStream_done_Params.
+type ByteStream_done_Params_List = capnp.StructList[ByteStream_done_Params]
+
+// NewByteStream_done_Params creates a new list of ByteStream_done_Params.
+func NewByteStream_done_Params_List(s *capnp.Segment, sz int32) (ByteStream_done_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[ByteStream_done_Params](l), err
+}
+
+// ByteStream_done_Params_Future is a wrapper for a ByteStream_done_Params promised by a client call.
+type ByteStream_done_Params_Future struct{ *capnp.Future }
+
+func (f ByteStream_done_Params_Future) Struct() (ByteStream_done_Params, error) {
+	p, err := f.Future.Ptr()
+	return ByteStream_done_Params(p.Struct()), err
+}
+
+type ByteStream_done_Results capnp.Struct
+
+// ByteStream_done_Results_TypeID is the unique identifier for the type ByteStream_done_Results.
+const ByteStream_done_Results_TypeID = 0xf41afc20ef073203
+
+func NewByteStream_done_Results(s *capnp.Segment) (ByteStream_done_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ByteStream_done_Results(st), err
+}
+
+func NewRootByteStream_done_Results(s *capnp.Segment) (ByteStream_done_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return ByteStream_done_Results(st), err
+}
+
+func ReadRootByteStream_done_Results(msg *capnp.Message) (ByteStream_done_Results, error) {
+	root, err := msg.Root()
+	return ByteStream_done_Results(root.Struct()), err
+}
+
+func (s ByteStream_done_Results) String() string {
+	str, _ := text.Marshal(0xf41afc20ef073203, capnp.Struct(s))
+	return str
+}
+
+func (s ByteStream_done_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ByteStream_done_Results) DecodeFromPtr(p capnp.Ptr) ByteStream_done_Results {
+	return ByteStream_done_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ByteStream_done_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ByteStream_done_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ByteStream_done_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ByteStream_done_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// ByteStream_done_Results_List is a list of ByteStream_done_Results.
+type ByteStream_done_Results_List = capnp.StructList[ByteStream_done_Results]
+
+// NewByteStream_done_Results creates a new list of ByteStream_done_Results.
+func NewByteStream_done_Results_List(s *capnp.Segment, sz int32) (ByteStream_done_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[ByteStream_done_Results](l), err
+}
+
+// ByteStream_done_Results_Future is a wrapper for a ByteStream_done_Results promised by a client call.
+type ByteStream_done_Results_Future struct{ *capnp.Future }
+
+func (f ByteStream_done_Results_Future) Struct() (ByteStream_done_Results, error) {
+	p, err := f.Future.Ptr()
+	return ByteStream_done_Results(p.Struct()), err
+}
+
+type ByteStream_write_Params capnp.Struct
+
+// ByteStream_write_Params_TypeID is the unique identifier for the type ByteStream_write_Params.
+const ByteStream_write_Params_TypeID = 0xe9ffce5424f0138d
+
+func NewByteStream_write_Params(s *capnp.Segment) (ByteStream_write_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ByteStream_write_Params(st), err
+}
+
+func NewRootByteStream_write_Params(s *capnp.Segment) (ByteStream_write_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return ByteStream_write_Params(st), err
+}
+
+func ReadRootByteStream_write_Params(msg *capnp.Message) (ByteStream_write_Params, error) {
+	root, err := msg.Root()
+	return ByteStream_write_Params(root.Struct()), err
+}
+
+func (s ByteStream_write_Params) String() string {
+	str, _ := text.Marshal(0xe9ffce5424f0138d, capnp.Struct(s))
+	return str
+}
+
+func (s ByteStream_write_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (ByteStream_write_Params) DecodeFromPtr(p capnp.Ptr) ByteStream_write_Params {
+	return ByteStream_write_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s ByteStream_write_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s ByteStream_write_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s ByteStream_write_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s ByteStream_write_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s ByteStream_write_Params) Data() ([]byte, error) {
+	return capnp.GetDataField(s, 0, "ByteStream.write$Params.data")
+}
+
+func (s ByteStream_write_Params) HasData() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s ByteStream_write_Params) SetData(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// DataReader returns a reader of data's data, which
+// is read directly from the message.
+func (s ByteStream_write_Params) DataReader() (*bytes.Reader, error) {
+	v, err := s.Data()
+	return bytes.NewReader(v), err
+}
+
+// SetDataFromReader sets data to the next size bytes of r,
+// which are read directly into the message.
+func (s ByteStream_write_Params) SetDataFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
+}
+
+// ByteStream_write_Params_List is a list of ByteStream_write_Params.
+type ByteStream_write_Params_List = capnp.StructList[ByteStream_write_Params]
+
+// NewByteStream_write_Params creates a new list of ByteStream_write_Params.
+func NewByteStream_write_Params_List(s *capnp.Segment, sz int32) (ByteStream_write_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[ByteStream_write_Params](l), err
+}
+
+// ByteStream_write_Params_Future is a wrapper for a ByteStream_write_Params promised by a client call.
+type ByteStream_write_Params_Future struct{ *capnp.Future }
+
+func (f ByteStream_write_Params_Future) Struct() (ByteStream_write_Params, error) {
+	p, err := f.Future.Ptr()
+	return ByteStream_write_Params(p.Struct()), err
+}
+
+const schema_ad0ff3a080333572 = "x\xda\x8c\x8f\xb1K\xebP\x14\xc6\xbf\x93{\xfa\xd27" +
+	"\x84\x10\xf2\x1e\x88\x08:\x14\x84\x0e\xc5\xb6\xb8\xb8(\x1d" +
+	"]l\xd4\xc9E\xae\xf6\x0eJRKr\xa5t\x10\xba" +
+	"9\x15\x04q\xd0\xc9\x7f\xa0\x83\xa3\x8b\xb8\xb8\x89\x83\x9b" +
+	"\x8e\x82\x88\x93\x82\xba\x89Wbi)\x0e\xe2|\xbe\xf3" +
+	"}\xbf\xdf\xd4&\xcdq\xd1Y\xf8\x0b+\x083\x7f\xcc" +
+	"\xf5\xe4\xe5Y{/\xdc\x877F\x00\xdb@\xb9\xc3\x15" +
+	"\x02\xf9\x07l\x83\xcc\xc8[\xb8r\xab\xdd\x07x\x9e0" +
+	"\xf1t\xb9}\xfc\xe2v\x01\xf2[|\x07\xf2wx\xd7" +
+	"\xbfH\xbfL\xc7\x7f\xce-_\x99\xc7^Q\x86\xd2\xa6" +
+	".\xcf\xa7M\xa7<\x0b2\xa2d?M\xbc\x8f\xbe\x0e" +
+	"-\xdd\xf4\xee\xf7l\xe3\xc3\xac\xb5\xb4Jt\xacXF" +
+	"\x85u\xd9\xa87f*-\xad\x96t\xacdT\xa8m" +
+	"\xd5U\xae*c\x19%\xa8\x0a\x1e\x84\xad\xefa[\xc9" +
+	"(\xc8\x8a\xcc\x10\x11\xd5O\xce\x9b\xe5\xa3\xd5C\xafX" +
+	"\x82 \x1a8S\x1f\xc9\xfb\x9f\x87\x18o\xc6\x1bZ\xb9" +
+	"\xe9R\x95\xe8g\x9c\xafh\x9f\x07\x01\x0b\x06\x98\x00\xcf" +
+	"\xc9\x03AVP\xf0\xcf\"\xb7&\xb5$\x07\x169\xa0" +
+	"_\xe8-\xaad;\xd4\x09R\xc1\xcf\x01\x00V:\x80" +
+	"\x7f"
+
+func RegisterSchema(reg *schemas.Registry) {
+	reg.Register(&schemas.Schema{
+		String: schema_ad0ff3a080333572,
+		Nodes: []uint64{
+			0x936c9080becc27d2,
+			0xe70f74da5a6cf518,
+			0xe9ffce5424f0138d,
+			0xf41afc20ef073203,
+		},
+		Compressed: true,
+	})
+}
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_ad0ff3a080333572,
+		Compressed: true,
+	}
+	return s.Request()
+}
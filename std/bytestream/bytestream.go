@@ -0,0 +1,158 @@
+// Package bytestream streams bytes, such as the contents of a file,
+// over Cap'n Proto RPC using the ByteStream interface.
+//
+// The sender wraps a ByteStream client in a Writer, which splits what
+// is written to it into chunks and sends them with flow control; the
+// receiver serves a ByteStream that writes the chunks to an io.Writer.
+// Sending a file is then:
+//
+//	n, err := bytestream.Copy(ctx, stream, f)
+//
+// and receiving one is:
+//
+//	stream := bytestream.FromWriter(f)
+package bytestream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// DefaultChunkSize is the largest chunk that a Writer sends in a single
+// write call.
+const DefaultChunkSize = 64 * 1024
+
+// A Writer is an io.WriteCloser that sends what is written to it to a
+// ByteStream.  Write returns as soon as the chunks are sent, or blocks
+// if the stream's flow limiter has too many chunks in flight; errors
+// from the receiver are reported by later calls to Write or by Close.
+// A Writer is not safe to use from multiple goroutines.
+type Writer struct {
+	ctx       context.Context
+	bs        ByteStream
+	chunkSize int
+	closed    bool
+}
+
+// NewWriter returns a Writer that sends to bs, in chunks of up to
+// DefaultChunkSize bytes.  The Writer takes ownership of bs, which is
+// released by Close.
+func NewWriter(ctx context.Context, bs ByteStream) *Writer {
+	return &Writer{ctx: ctx, bs: bs, chunkSize: DefaultChunkSize}
+}
+
+// SetChunkSize sets the largest chunk that w sends in a single write
+// call.  It panics if n is not positive.
+func (w *Writer) SetChunkSize(n int) {
+	if n <= 0 {
+		panic("bytestream: chunk size must be positive")
+	}
+	w.chunkSize = n
+}
+
+// Write sends p to the stream.
+func (w *Writer) Write(p []byte) (n int, err error) {
+	if w.closed {
+		return 0, errors.New("bytestream: write after close")
+	}
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > w.chunkSize {
+			chunk = chunk[:w.chunkSize]
+		}
+		err := w.bs.Write(w.ctx, func(args ByteStream_write_Params) error {
+			return args.SetData(chunk)
+		})
+		if err != nil {
+			return n, err
+		}
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return n, nil
+}
+
+// Close calls done on the stream, waits for the receiver to process
+// every chunk, and releases the stream.  It returns the first error
+// that the receiver reported.
+func (w *Writer) Close() error {
+	if w.closed {
+		return errors.New("bytestream: already closed")
+	}
+	w.closed = true
+	defer w.bs.Release()
+	if err := w.bs.WaitStreaming(); err != nil {
+		return err
+	}
+	f, release := w.bs.Done(w.ctx, nil)
+	defer release()
+	_, err := f.Struct()
+	return err
+}
+
+// Copy sends the contents of r to bs and closes the stream.  It
+// returns the number of bytes sent and the first error encountered.
+// Like NewWriter, it takes ownership of bs.
+func Copy(ctx context.Context, bs ByteStream, r io.Reader) (int64, error) {
+	w := NewWriter(ctx, bs)
+	n, err := io.Copy(w, r)
+	if err != nil {
+		w.closed = true
+		bs.Release()
+		return n, err
+	}
+	return n, w.Close()
+}
+
+// FromWriter returns a ByteStream that writes the chunks it receives
+// to w, in order.  When done is called, FromWriter closes w if it is an
+// io.Closer, and returns the error from Close to the sender.  Once done
+// has been called or a write has failed, further writes fail.
+func FromWriter(w io.Writer) ByteStream {
+	return ByteStream_ServerToClient(&writerServer{w: w})
+}
+
+// writerServer implements ByteStream_Server by writing to an
+// io.Writer.
+type writerServer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	err  error // sticky error, set after done or a failed write
+	done bool
+}
+
+func (s *writerServer) Write(ctx context.Context, call ByteStream_write) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	data, err := call.Args().Data()
+	if err != nil {
+		return err
+	}
+	if _, err := s.w.Write(data); err != nil {
+		s.err = err
+		return err
+	}
+	return nil
+}
+
+func (s *writerServer) Done(ctx context.Context, call ByteStream_done) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return errors.New("bytestream: done called twice")
+	}
+	s.done = true
+	if s.err != nil {
+		return s.err
+	}
+	s.err = errors.New("bytestream: write after done")
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
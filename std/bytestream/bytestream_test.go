@@ -0,0 +1,82 @@
+package bytestream_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/std/bytestream"
+)
+
+// closeBuffer is a bytes.Buffer that records whether it was closed.
+type closeBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closeBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dst := new(closeBuffer)
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(bytestream.FromWriter(dst)),
+	})
+	defer serverConn.Close()
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+	defer clientConn.Close()
+
+	data := make([]byte, 3*bytestream.DefaultChunkSize+100)
+	rand.New(rand.NewSource(1)).Read(data)
+	stream := bytestream.ByteStream(clientConn.Bootstrap(ctx))
+	require.NoError(t, stream.Resolve(ctx))
+	n, err := bytestream.Copy(ctx, stream, bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), n)
+	assert.True(t, bytes.Equal(data, dst.Bytes()), "received data differs")
+	assert.True(t, dst.closed, "destination not closed by done")
+}
+
+type failWriter struct{ n int }
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if w.n == 0 {
+		return 0, errors.New("disk full")
+	}
+	w.n--
+	return len(p), nil
+}
+
+func TestWriterError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	w := bytestream.NewWriter(ctx, bytestream.FromWriter(&failWriter{n: 1}))
+	w.SetChunkSize(4)
+	_, err := w.Write([]byte("hello, world"))
+	if err == nil {
+		err = w.Close()
+	} else {
+		w.Close()
+	}
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disk full")
+
+	_, err = w.Write([]byte("again"))
+	assert.Error(t, err, "write after close")
+}
@@ -10,13 +10,198 @@ import (
 	strconv "strconv"
 )
 
-const Name_ = uint64(0xfa5b1fd61c2e7c3d)
-const Flatten_ = uint64(0x82d3e852af0336bf)
-const Discriminator_ = uint64(0xcfa794e8d19a0162)
 const Base64_ = uint64(0xd7d879450a253e4b)
+const Discriminator_ = uint64(0xcfa794e8d19a0162)
+const Flatten_ = uint64(0x82d3e852af0336bf)
 const Hex_ = uint64(0xf061e22f0ae5c7b5)
+const Name_ = uint64(0xfa5b1fd61c2e7c3d)
 const Notification_ = uint64(0xa0a054dea32fd98c)
 
+type DiscriminatorOptions capnp.Struct
+
+// DiscriminatorOptions_TypeID is the unique identifier for the type DiscriminatorOptions.
+const DiscriminatorOptions_TypeID = 0xc2f8c20c293e5319
+
+func NewDiscriminatorOptions(s *capnp.Segment) (DiscriminatorOptions, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return DiscriminatorOptions(st), err
+}
+
+func NewRootDiscriminatorOptions(s *capnp.Segment) (DiscriminatorOptions, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return DiscriminatorOptions(st), err
+}
+
+func ReadRootDiscriminatorOptions(msg *capnp.Message) (DiscriminatorOptions, error) {
+	root, err := msg.Root()
+	return DiscriminatorOptions(root.Struct()), err
+}
+
+func (s DiscriminatorOptions) String() string {
+	str, _ := text.Marshal(0xc2f8c20c293e5319, capnp.Struct(s))
+	return str
+}
+
+func (s DiscriminatorOptions) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (DiscriminatorOptions) DecodeFromPtr(p capnp.Ptr) DiscriminatorOptions {
+	return DiscriminatorOptions(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s DiscriminatorOptions) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s DiscriminatorOptions) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s DiscriminatorOptions) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s DiscriminatorOptions) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// The name of the discriminator field. Defaults to matching the name of the union.
+func (s DiscriminatorOptions) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "DiscriminatorOptions.name")
+}
+
+func (s DiscriminatorOptions) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s DiscriminatorOptions) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "DiscriminatorOptions.name")
+}
+
+func (s DiscriminatorOptions) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// If non-null, specifies that the union's value shall have the given field name, rather than the
+// value's name. In this case the union's variant can only be determined by looking at the
+// discriminant field, not by inspecting which value field is present.
+//
+// It is an error to use `valueName` while also declaring some variants as $flatten.
+func (s DiscriminatorOptions) ValueName() (string, error) {
+	return capnp.GetTextField(s, 1, "DiscriminatorOptions.valueName")
+}
+
+func (s DiscriminatorOptions) HasValueName() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s DiscriminatorOptions) ValueNameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 1, "DiscriminatorOptions.valueName")
+}
+
+func (s DiscriminatorOptions) SetValueName(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+// DiscriminatorOptions_List is a list of DiscriminatorOptions.
+type DiscriminatorOptions_List = capnp.StructList[DiscriminatorOptions]
+
+// NewDiscriminatorOptions creates a new list of DiscriminatorOptions.
+func NewDiscriminatorOptions_List(s *capnp.Segment, sz int32) (DiscriminatorOptions_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[DiscriminatorOptions](l), err
+}
+
+// DiscriminatorOptions_Future is a wrapper for a DiscriminatorOptions promised by a client call.
+type DiscriminatorOptions_Future struct{ *capnp.Future }
+
+func (f DiscriminatorOptions_Future) Struct() (DiscriminatorOptions, error) {
+	p, err := f.Future.Ptr()
+	return DiscriminatorOptions(p.Struct()), err
+}
+
+type FlattenOptions capnp.Struct
+
+// FlattenOptions_TypeID is the unique identifier for the type FlattenOptions.
+const FlattenOptions_TypeID = 0xc4df13257bc2ea61
+
+func NewFlattenOptions(s *capnp.Segment) (FlattenOptions, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return FlattenOptions(st), err
+}
+
+func NewRootFlattenOptions(s *capnp.Segment) (FlattenOptions, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return FlattenOptions(st), err
+}
+
+func ReadRootFlattenOptions(msg *capnp.Message) (FlattenOptions, error) {
+	root, err := msg.Root()
+	return FlattenOptions(root.Struct()), err
+}
+
+func (s FlattenOptions) String() string {
+	str, _ := text.Marshal(0xc4df13257bc2ea61, capnp.Struct(s))
+	return str
+}
+
+func (s FlattenOptions) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (FlattenOptions) DecodeFromPtr(p capnp.Ptr) FlattenOptions {
+	return FlattenOptions(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s FlattenOptions) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s FlattenOptions) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s FlattenOptions) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s FlattenOptions) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Optional: Adds the given prefix to flattened field names.
+func (s FlattenOptions) Prefix() (string, error) {
+	return capnp.GetTextField(s, 0, "FlattenOptions.prefix")
+}
+
+func (s FlattenOptions) HasPrefix() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s FlattenOptions) PrefixBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "FlattenOptions.prefix")
+}
+
+func (s FlattenOptions) SetPrefix(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// FlattenOptions_List is a list of FlattenOptions.
+type FlattenOptions_List = capnp.StructList[FlattenOptions]
+
+// NewFlattenOptions creates a new list of FlattenOptions.
+func NewFlattenOptions_List(s *capnp.Segment, sz int32) (FlattenOptions_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[FlattenOptions](l), err
+}
+
+// FlattenOptions_Future is a wrapper for a FlattenOptions promised by a client call.
+type FlattenOptions_Future struct{ *capnp.Future }
+
+func (f FlattenOptions_Future) Struct() (FlattenOptions, error) {
+	p, err := f.Future.Ptr()
+	return FlattenOptions(p.Struct()), err
+}
+
 type Value capnp.Struct
 type Value_Which uint16
 
@@ -55,6 +240,65 @@ func (w Value_Which) String() string {
 	return "Value_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
+// Value_Visitor has a method for each member of the union of
+// Value, which Visit calls with the member's value.
+type Value_Visitor interface {
+	VisitNull() error
+	VisitBoolean(bool) error
+	VisitNumber(float64) error
+	VisitString_(string) error
+	VisitArray(Value_List) error
+	VisitObject(Value_Field_List) error
+	VisitCall(Value_Call) error
+	VisitRaw(string) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Value) Visit(v Value_Visitor) error {
+	switch w := s.Which(); w {
+	case Value_Which_null:
+		return v.VisitNull()
+	case Value_Which_boolean:
+		return v.VisitBoolean(s.Boolean())
+	case Value_Which_number:
+		return v.VisitNumber(s.Number())
+	case Value_Which_string_:
+		x, err := s.String_()
+		if err != nil {
+			return err
+		}
+		return v.VisitString_(x)
+	case Value_Which_array:
+		x, err := s.Array()
+		if err != nil {
+			return err
+		}
+		return v.VisitArray(x)
+	case Value_Which_object:
+		x, err := s.Object()
+		if err != nil {
+			return err
+		}
+		return v.VisitObject(x)
+	case Value_Which_call:
+		x, err := s.Call()
+		if err != nil {
+			return err
+		}
+		return v.VisitCall(x)
+	case Value_Which_raw:
+		x, err := s.Raw()
+		if err != nil {
+			return err
+		}
+		return v.VisitRaw(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Value", Which: uint16(w)}
+	}
+}
+
 // Value_TypeID is the unique identifier for the type Value.
 const Value_TypeID = 0xa3fa7845f919dd83
 
@@ -137,8 +381,7 @@ func (s Value) String_() (string, error) {
 	if capnp.Struct(s).Uint16(0) != 3 {
 		panic("Which() != string_")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return capnp.GetTextField(s, 0, "Value.string")
 }
 
 func (s Value) HasString_() bool {
@@ -149,8 +392,7 @@ func (s Value) HasString_() bool {
 }
 
 func (s Value) String_Bytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+	return capnp.GetTextBytesField(s, 0, "Value.string")
 }
 
 func (s Value) SetString_(v string) error {
@@ -162,8 +404,7 @@ func (s Value) Array() (Value_List, error) {
 	if capnp.Struct(s).Uint16(0) != 4 {
 		panic("Which() != array")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Value_List(p.List()), err
+	return capnp.GetListField[Value_List](s, 0, "Value.array")
 }
 
 func (s Value) HasArray() bool {
@@ -175,26 +416,22 @@ func (s Value) HasArray() bool {
 
 func (s Value) SetArray(v Value_List) error {
 	capnp.Struct(s).SetUint16(0, 4)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+	return capnp.SetListField(s, 0, v)
 }
 
 // NewArray sets the array field to a newly
 // allocated Value_List, preferring placement in s's segment.
 func (s Value) NewArray(n int32) (Value_List, error) {
 	capnp.Struct(s).SetUint16(0, 4)
-	l, err := NewValue_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Value_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+	return capnp.NewListField(s, 0, n, NewValue_List)
 }
+
+// Standard JSON values.
 func (s Value) Object() (Value_Field_List, error) {
 	if capnp.Struct(s).Uint16(0) != 5 {
 		panic("Which() != object")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Value_Field_List(p.List()), err
+	return capnp.GetListField[Value_Field_List](s, 0, "Value.object")
 }
 
 func (s Value) HasObject() bool {
@@ -206,26 +443,31 @@ func (s Value) HasObject() bool {
 
 func (s Value) SetObject(v Value_Field_List) error {
 	capnp.Struct(s).SetUint16(0, 5)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+	return capnp.SetListField(s, 0, v)
 }
 
 // NewObject sets the object field to a newly
 // allocated Value_Field_List, preferring placement in s's segment.
 func (s Value) NewObject(n int32) (Value_Field_List, error) {
 	capnp.Struct(s).SetUint16(0, 5)
-	l, err := NewValue_Field_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Value_Field_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
+	return capnp.NewListField(s, 0, n, NewValue_Field_List)
+}
+
+// Non-standard: A "function call", applying a named function (named by a single identifier)
+// to a parameter list. Examples:
+//
+//	BinData(0, "Zm9vCg==")
+//	ISODate("2015-04-15T08:44:50.218Z")
+//
+// Mongo DB users will recognize the above as exactly the syntax Mongo uses to represent BSON
+// "binary" and "date" types in text, since JSON has no analog of these. This is basically the
+// reason this extension exists. We do NOT recommend using `call` unless you specifically need
+// to be compatible with some silly format that uses this syntax.
 func (s Value) Call() (Value_Call, error) {
 	if capnp.Struct(s).Uint16(0) != 6 {
 		panic("Which() != call")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Value_Call(p.Struct()), err
+	return capnp.GetStructField[Value_Call](s, 0, "Value.call")
 }
 
 func (s Value) HasCall() bool {
@@ -237,27 +479,31 @@ func (s Value) HasCall() bool {
 
 func (s Value) SetCall(v Value_Call) error {
 	capnp.Struct(s).SetUint16(0, 6)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+	return capnp.SetStructField(s, 0, v)
 }
 
 // NewCall sets the call field to a newly
 // allocated Value_Call struct, preferring placement in s's segment.
 func (s Value) NewCall() (Value_Call, error) {
 	capnp.Struct(s).SetUint16(0, 6)
-	ss, err := NewValue_Call(capnp.Struct(s).Segment())
-	if err != nil {
-		return Value_Call{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
+	return capnp.NewStructField(s, 0, NewValue_Call)
+}
+
+// Used to indicate that the text should be written directly to the output without
+// modifications. Use this if you have an already serialized JSON value and don't want
+// to feel the cost of deserializing the value just to serialize it again.
+//
+// The parser will never produce a `raw` value -- this is only useful for serialization.
+//
+// WARNING: You MUST ensure that the value is valid stand-alone JSOn. It will not be verified.
+// Invalid JSON could mjake the whole message unparsable. Worse, a malicious raw value could
+// perform JSON injection attacks. Make sure that the value was produced by a trustworthy JSON
+// encoder.
 func (s Value) Raw() (string, error) {
 	if capnp.Struct(s).Uint16(0) != 7 {
 		panic("Which() != raw")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return capnp.GetTextField(s, 0, "Value.raw")
 }
 
 func (s Value) HasRaw() bool {
@@ -268,8 +514,7 @@ func (s Value) HasRaw() bool {
 }
 
 func (s Value) RawBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+	return capnp.GetTextBytesField(s, 0, "Value.raw")
 }
 
 func (s Value) SetRaw(v string) error {
@@ -297,115 +542,6 @@ func (p Value_Future) Call() Value_Call_Future {
 	return Value_Call_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Value_Field capnp.Struct
-
-// Value_Field_TypeID is the unique identifier for the type Value_Field.
-const Value_Field_TypeID = 0xe31026e735d69ddf
-
-func NewValue_Field(s *capnp.Segment) (Value_Field, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Value_Field(st), err
-}
-
-func NewRootValue_Field(s *capnp.Segment) (Value_Field, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Value_Field(st), err
-}
-
-func ReadRootValue_Field(msg *capnp.Message) (Value_Field, error) {
-	root, err := msg.Root()
-	return Value_Field(root.Struct()), err
-}
-
-func (s Value_Field) String() string {
-	str, _ := text.Marshal(0xe31026e735d69ddf, capnp.Struct(s))
-	return str
-}
-
-func (s Value_Field) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (Value_Field) DecodeFromPtr(p capnp.Ptr) Value_Field {
-	return Value_Field(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s Value_Field) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Value_Field) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s Value_Field) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s Value_Field) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Value_Field) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
-
-func (s Value_Field) HasName() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Value_Field) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s Value_Field) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
-
-func (s Value_Field) Value() (Value, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Value(p.Struct()), err
-}
-
-func (s Value_Field) HasValue() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
-
-func (s Value_Field) SetValue(v Value) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
-}
-
-// NewValue sets the value field to a newly
-// allocated Value struct, preferring placement in s's segment.
-func (s Value_Field) NewValue() (Value, error) {
-	ss, err := NewValue(capnp.Struct(s).Segment())
-	if err != nil {
-		return Value{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-// Value_Field_List is a list of Value_Field.
-type Value_Field_List = capnp.StructList[Value_Field]
-
-// NewValue_Field creates a new list of Value_Field.
-func NewValue_Field_List(s *capnp.Segment, sz int32) (Value_Field_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[Value_Field](l), err
-}
-
-// Value_Field_Future is a wrapper for a Value_Field promised by a client call.
-type Value_Field_Future struct{ *capnp.Future }
-
-func (f Value_Field_Future) Struct() (Value_Field, error) {
-	p, err := f.Future.Ptr()
-	return Value_Field(p.Struct()), err
-}
-func (p Value_Field_Future) Value() Value_Future {
-	return Value_Future{Future: p.Future.Field(1, nil)}
-}
-
 type Value_Call capnp.Struct
 
 // Value_Call_TypeID is the unique identifier for the type Value_Call.
@@ -454,8 +590,7 @@ func (s Value_Call) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 func (s Value_Call) Function() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return capnp.GetTextField(s, 0, "Value.Call.function")
 }
 
 func (s Value_Call) HasFunction() bool {
@@ -463,8 +598,7 @@ func (s Value_Call) HasFunction() bool {
 }
 
 func (s Value_Call) FunctionBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+	return capnp.GetTextBytesField(s, 0, "Value.Call.function")
 }
 
 func (s Value_Call) SetFunction(v string) error {
@@ -472,8 +606,7 @@ func (s Value_Call) SetFunction(v string) error {
 }
 
 func (s Value_Call) Params() (Value_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Value_List(p.List()), err
+	return capnp.GetListField[Value_List](s, 1, "Value.Call.params")
 }
 
 func (s Value_Call) HasParams() bool {
@@ -481,18 +614,13 @@ func (s Value_Call) HasParams() bool {
 }
 
 func (s Value_Call) SetParams(v Value_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+	return capnp.SetListField(s, 1, v)
 }
 
 // NewParams sets the params field to a newly
 // allocated Value_List, preferring placement in s's segment.
 func (s Value_Call) NewParams(n int32) (Value_List, error) {
-	l, err := NewValue_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Value_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+	return capnp.NewListField(s, 1, n, NewValue_List)
 }
 
 // Value_Call_List is a list of Value_Call.
@@ -512,243 +640,160 @@ func (f Value_Call_Future) Struct() (Value_Call, error) {
 	return Value_Call(p.Struct()), err
 }
 
-type FlattenOptions capnp.Struct
-
-// FlattenOptions_TypeID is the unique identifier for the type FlattenOptions.
-const FlattenOptions_TypeID = 0xc4df13257bc2ea61
-
-func NewFlattenOptions(s *capnp.Segment) (FlattenOptions, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return FlattenOptions(st), err
-}
-
-func NewRootFlattenOptions(s *capnp.Segment) (FlattenOptions, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return FlattenOptions(st), err
-}
-
-func ReadRootFlattenOptions(msg *capnp.Message) (FlattenOptions, error) {
-	root, err := msg.Root()
-	return FlattenOptions(root.Struct()), err
-}
-
-func (s FlattenOptions) String() string {
-	str, _ := text.Marshal(0xc4df13257bc2ea61, capnp.Struct(s))
-	return str
-}
-
-func (s FlattenOptions) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (FlattenOptions) DecodeFromPtr(p capnp.Ptr) FlattenOptions {
-	return FlattenOptions(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s FlattenOptions) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s FlattenOptions) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s FlattenOptions) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s FlattenOptions) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s FlattenOptions) Prefix() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
-
-func (s FlattenOptions) HasPrefix() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s FlattenOptions) PrefixBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s FlattenOptions) SetPrefix(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
-
-// FlattenOptions_List is a list of FlattenOptions.
-type FlattenOptions_List = capnp.StructList[FlattenOptions]
-
-// NewFlattenOptions creates a new list of FlattenOptions.
-func NewFlattenOptions_List(s *capnp.Segment, sz int32) (FlattenOptions_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[FlattenOptions](l), err
-}
-
-// FlattenOptions_Future is a wrapper for a FlattenOptions promised by a client call.
-type FlattenOptions_Future struct{ *capnp.Future }
-
-func (f FlattenOptions_Future) Struct() (FlattenOptions, error) {
-	p, err := f.Future.Ptr()
-	return FlattenOptions(p.Struct()), err
-}
-
-type DiscriminatorOptions capnp.Struct
+type Value_Field capnp.Struct
 
-// DiscriminatorOptions_TypeID is the unique identifier for the type DiscriminatorOptions.
-const DiscriminatorOptions_TypeID = 0xc2f8c20c293e5319
+// Value_Field_TypeID is the unique identifier for the type Value_Field.
+const Value_Field_TypeID = 0xe31026e735d69ddf
 
-func NewDiscriminatorOptions(s *capnp.Segment) (DiscriminatorOptions, error) {
+func NewValue_Field(s *capnp.Segment) (Value_Field, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return DiscriminatorOptions(st), err
+	return Value_Field(st), err
 }
 
-func NewRootDiscriminatorOptions(s *capnp.Segment) (DiscriminatorOptions, error) {
+func NewRootValue_Field(s *capnp.Segment) (Value_Field, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return DiscriminatorOptions(st), err
+	return Value_Field(st), err
 }
 
-func ReadRootDiscriminatorOptions(msg *capnp.Message) (DiscriminatorOptions, error) {
+func ReadRootValue_Field(msg *capnp.Message) (Value_Field, error) {
 	root, err := msg.Root()
-	return DiscriminatorOptions(root.Struct()), err
+	return Value_Field(root.Struct()), err
 }
 
-func (s DiscriminatorOptions) String() string {
-	str, _ := text.Marshal(0xc2f8c20c293e5319, capnp.Struct(s))
+func (s Value_Field) String() string {
+	str, _ := text.Marshal(0xe31026e735d69ddf, capnp.Struct(s))
 	return str
 }
 
-func (s DiscriminatorOptions) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Value_Field) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (DiscriminatorOptions) DecodeFromPtr(p capnp.Ptr) DiscriminatorOptions {
-	return DiscriminatorOptions(capnp.Struct{}.DecodeFromPtr(p))
+func (Value_Field) DecodeFromPtr(p capnp.Ptr) Value_Field {
+	return Value_Field(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s DiscriminatorOptions) ToPtr() capnp.Ptr {
+func (s Value_Field) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s DiscriminatorOptions) IsValid() bool {
+func (s Value_Field) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s DiscriminatorOptions) Message() *capnp.Message {
+func (s Value_Field) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s DiscriminatorOptions) Segment() *capnp.Segment {
+func (s Value_Field) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s DiscriminatorOptions) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s Value_Field) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "Value.Field.name")
 }
 
-func (s DiscriminatorOptions) HasName() bool {
+func (s Value_Field) HasName() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s DiscriminatorOptions) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Value_Field) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Value.Field.name")
 }
 
-func (s DiscriminatorOptions) SetName(v string) error {
+func (s Value_Field) SetName(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s DiscriminatorOptions) ValueName() (string, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return p.Text(), err
+func (s Value_Field) Value() (Value, error) {
+	return capnp.GetStructField[Value](s, 1, "Value.Field.value")
 }
 
-func (s DiscriminatorOptions) HasValueName() bool {
+func (s Value_Field) HasValue() bool {
 	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s DiscriminatorOptions) ValueNameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return p.TextBytes(), err
+func (s Value_Field) SetValue(v Value) error {
+	return capnp.SetStructField(s, 1, v)
 }
 
-func (s DiscriminatorOptions) SetValueName(v string) error {
-	return capnp.Struct(s).SetText(1, v)
+// NewValue sets the value field to a newly
+// allocated Value struct, preferring placement in s's segment.
+func (s Value_Field) NewValue() (Value, error) {
+	return capnp.NewStructField(s, 1, NewValue)
 }
 
-// DiscriminatorOptions_List is a list of DiscriminatorOptions.
-type DiscriminatorOptions_List = capnp.StructList[DiscriminatorOptions]
+// Value_Field_List is a list of Value_Field.
+type Value_Field_List = capnp.StructList[Value_Field]
 
-// NewDiscriminatorOptions creates a new list of DiscriminatorOptions.
-func NewDiscriminatorOptions_List(s *capnp.Segment, sz int32) (DiscriminatorOptions_List, error) {
+// NewValue_Field creates a new list of Value_Field.
+func NewValue_Field_List(s *capnp.Segment, sz int32) (Value_Field_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[DiscriminatorOptions](l), err
+	return capnp.StructList[Value_Field](l), err
 }
 
-// DiscriminatorOptions_Future is a wrapper for a DiscriminatorOptions promised by a client call.
-type DiscriminatorOptions_Future struct{ *capnp.Future }
+// Value_Field_Future is a wrapper for a Value_Field promised by a client call.
+type Value_Field_Future struct{ *capnp.Future }
 
-func (f DiscriminatorOptions_Future) Struct() (DiscriminatorOptions, error) {
+func (f Value_Field_Future) Struct() (Value_Field, error) {
 	p, err := f.Future.Ptr()
-	return DiscriminatorOptions(p.Struct()), err
+	return Value_Field(p.Struct()), err
+}
+func (p Value_Field_Future) Value() Value_Future {
+	return Value_Future{Future: p.Future.Field(1, nil)}
 }
 
-const schema_8ef99297a43a5e34 = "x\xda\x8cT]h[e\x18~\x9e\xef\xcb\x97vM" +
-	"bs8\xf1b\xe0\xc8\x10\xa7\xaeh\xb7vu\xb8@" +
-	"\x17q\xeb\x18\x8a\x9a\xaf\x11o\x04\xe1$;\xd5\x8c\x93" +
-	"\x93\x90\xa4\xaeSA\x98w\"\x82C\x10\x07B\xc7\x14" +
-	"/Ea\x17\x0aC-\x961\x19h\xd4\xcd\x16V;" +
-	"\xff\xb0\x88\x17\xbbQ\xda\xfas\xe4;\xd9\xf2\xd3:\xf0" +
-	"\xee\xf0\xbd\x0f\xcf\xfb\xbc\xcf\xfb\xbcg7\xc5\x03\x91\x91" +
-	"\xc4\xb2\x82\xd0\x07U4\xf8x\xaf|or\xe5\xeb\x13" +
-	"\xd01u5\x18{*\xf3\xf6\x1b'\xd7^\x05h_" +
-	"\x12\xa7\xec%\xd1\x07\xe4\x17\x84$\x18\xbc\xb2\xb8\xeb\xcc" +
-	"w\x8f\xcf\xce\xe2\xb5\x98\x12=\xd0\x0bb\xcen\x86\xd0" +
-	"\x8b-\xe8\xe1\xf1\x0b\xa7\x7f\xfbcq\x16\x96\xc5\xe0\xa5" +
-	"\xa5\xadk\x133\xebg\xa0\x0c\xc4>'>\xb0\xe7\xc3" +
-	"\xafO\xc51t\x95u\x8c]\xb4\x13\xec\xeb\x07\xec\x11" +
-	"\xf9\xb2\xbdO\xde\x05\xecyD\x9e7\xd4[\xf3\xfbw" +
-	"\xc6\xe7V\xe7`\xc5\xd8A\x87\xd4{F\x94\xa0=\xae" +
-	"\x0c\xf7>e\xb8\x9d_\xe7\x9e\xdfa/\x7f\xb6\x01L" +
-	"\x83xS}e\xbf\x13bO\xab,\x18\x14x\xaa\xb9" +
-	"\xf2\xfa\xbb_\x18'r=\xe3\xcd\xab\xcf\xed\xa6\x0a\xc7" +
-	"S\xe1x\x0f\xef\xdf10q|\xe1[\x03\xdd\xde\x03" +
-	"=\xa7N\xda\xf3!\xf4\x93\x16t\xf9\xad\xcb\xf7\xfdr" +
-	"g\xf2\xc7\xffr\xe2}\xf5\x91\xfda\xa8\xe0l\xa8\xf6" +
-	"\xec\xf9\x9f\x07v\xfd\xe0\\\xdbLkE\x9f\xb3o\x8d" +
-	"\x1a\xdad4\xa4\x1d\x7fa\xf8\xb6\xcb\xe9'\xd7\xd1\x8c" +
-	"\xa9\x95\xdee0z\xc2V\x06;i\xa0\x07\x82\xa3\xf5" +
-	"\x8a?\\t\xaa\xf4\xab\x99)\xcfi4\xa4\xeb\xe7H" +
-	"&;\xee\x80m\x94\xf0\xab\x19\xbf\xd2(M\x95\x8aN" +
-	"\xa3T\xf1\x91#!{H\x9ep\xbci7=|\xc0" +
-	"\xf1\xbc\x1c\xa9\xfbe\x04\x88\x10\xb0v>\x04\xe8\xbb%" +
-	"\xf5\x98\xa0E\xa6h\x1eG2\x80\xbeGR\x1f\x16\x0c" +
-	"\xa6\xa6\xfdb\xc8\x0a0\x0e\xc18\x98\xad:5\xa7\\" +
-	"\xe7-`N\x1aUm\x93@\xf3\xb8\xb95\xa0\xfb\xd9" +
-	"\xed\xec\x96\xd1\xae\xc0\xa9\xa1\xf4\xa1\x92\xeb\x1d\x194\xfa" +
-	"\xf4v\x19\x89\x07A\xa8\xae9\x04\xe8\x8b\x92zAp" +
-	"\x1b\xff\x09\x92-}\x97\x1e\x04\xf4\x97\x92\xfa\x8a\xe06" +
-	"\xf1w\xc0\x14\x05`-\x1a\xd9\xdfH\xea\xab\x82\x09\xf9" +
-	"W\x90\xa2\x04\xac%\xf3\xba \xa9\x7f\x12LD\xfe\x0c" +
-	"R\x8c\x00\xd6\xf7\xa3\x80\xbe\"\xa9W\x05\x13j=H" +
-	"Q\x01\xd6\xef\x06{M2\x1f\xa7`\"\xba\x16\xa4\x18" +
-	"\x05\xec-\x1c\x02\xf2\x11J\xe6\x93\xa6\xd0\xb7\x1a\xa4\xc2" +
-	"<&x;\x90\xef7\x85\x14\x05\x07\xfdi\xcfC\xf4" +
-	"\xc5B\xa5\xe2\xb9\x8eOB\x90`\xd6\x9f.\x17\xdc\x1a" +
-	"c\x10\x8c\x81\xd9z\xa3V\xf2\x9f\xbeaf\xda\xa9\xd5" +
-	"\x9c\xe37\xf52[)\x1cu\x8b\x8dN\xbd\xeda\xab" +
-	">Xt<\x8f\xc9\x8e\x9b \x93`_\xcd9v\xa3" +
-	"C{\x1d\xd2\xaff\x0e\x96\xea\xc5Z\xa9\\\xf2\x9dF" +
-	"\xa5\xf6X\xd5l\xb6\x8e\x0d\x990\xae\xdf!\xa9ww" +
-	"e\xe2\xde\xc9\xeb\x99\xb8\xdf\xcc\xe9\x94\xdd6\xfb\xb3f" +
-	"\xc1\x8f:e\xd0\xdd\xd4\xd1D\xf3\x90\x09\xb0\xeb\xb7z" +
-	"\xb1nzEZ\xbdH+a\x1c\xef\x97\xd4)\xc1l" +
-	"\xb5\xe6N\x95f\xae\x93\xc0\xe2\x00\xd0\xc3t\xa4[;" +
-	"\xd0\xba\x88\xf6\xcfeC\xee\x0aN\xdd\xdd\xcb\xb1\x9b\xdc" +
-	"Bv8L\xdc\xff\x19|\xb4s!=\x83\xa7\xc3\xc1" +
-	"7l,\xd9\xa5\x02Y\xbf\x9ay\xc6\x9d\xd9,\xc1\xd0" +
-	"\x84\xf2\xe3\x10\xff\x06\x00\x00\xff\xff\xb9?\x99\x93"
+const schema_8ef99297a43a5e34 = "x\xda\x84T_h[e\x1c=\xe7\xfbr\x9b\xbb&" +
+	"\xb1\xb9\xdc\xbc\x0c,\x99\xe2\xd4\x16\xed\xb6\xae\x8e\x19\xd8" +
+	"\"jG\xa7\xa0\xfbvE\x14A\xf8\x92\xdd\xba;n" +
+	"nb\x92\xbaN\x05\xc7\xc4\x17\x11\xc1!\x08C\xa12" +
+	"\xc1GA\xf0a\x82\x88E\x18\x8a\xa0U7[\xd8\xdc" +
+	"\xfc\x87E|\xd8\x8b\xd2\xd6?W\xbedk\x92\xb6\xb2" +
+	"\xd7\xefwr\xce\xef\x9c\xdf\xb9\xd9\xfe\xa6\xb8'\xb1#" +
+	"\x93LA\xa8\xe3V_\xfc\xf1.\xf9\xde\xc1\xc5oN" +
+	"@\xa5\xac\xcb\xf1\xd8\x93\x85w\xde8\xb9\xfc*@7" +
+	"\xb0N\x81\xdeaK\x12\x8c_Y\xd8v\xfa\xfbGf" +
+	"f\xf0Z\xca\x12=\xb0\xc7\xadY\xd0{\xac\x0d\x9b\xd8" +
+	"\xf3\xd9\xdb\xbf\xff\xb90\x03\xc7a\xfc\xe2\xc5\xcd\xcb\xe3" +
+	"\xd3+\xa7a\x89$\xe0\xee\xb7\xde\x07\xdd\xfd\xd6Qt" +
+	"\x8dT\x8a]t\xe3L\xda\x80{\xc6z\xd9\xfd\xc4\xba" +
+	"\x0d\xd8y\xce:kh7{{\x87\xd2\xb3K\xb3p" +
+	"R\xec\xa0[\xb4;\xcf$\x05A\xf7\xa3\xa4\xe1\xd5\xbf" +
+	"\xcd>\xb7\xd5\xbd\xf4\xe9\x1a \x8d\xfe\xa0\xfd5\xe8\xde" +
+	"d\x17\xc1\xb8\xc4Ss\x8b\xaf\xbf\xfb\xa5q}\xa0\xc7" +
+	"\xce\xb8\xfd9\xe8M\xd8-;\x0f\xee\xdd\xda?~l" +
+	"\xfe;\x03\xdb\xd2\x03\xbb\xdb>\x09z\xbb\xdb\xb0Ko" +
+	"\x9d\xbf\xeb\xd7[\xb3?m\xe4z\xc8\xfe\x10t\x87l" +
+	"\xb3\xdd\x07g\x7f\xe9\xdf\xf6\xa3\xbe\xb2\x9e\xee%\xfbY" +
+	"\xd0;\xde\xa6\xdb\xf3\xfc\xc8\x8d\xe7\xf3O\xac`.e" +
+	"-\xf6\x86\xfd\xb4}\x02\xf4B\x83[\x8c\x8f4\xaa\xd1" +
+	"HY\xd7\x18\xd5\x0a\x93\xa1n6\xa5\x1f1\xdb\xc9\x00" +
+	"\\\x85\x88\xa8V\x88\xaa\xcd`2(\xebfP\x8d\x00" +
+	"\xd9\xf3\xf3Gu8\xe5\xe7G\xee\xd3a\xa8l\x99\x00" +
+	"\x12\x04\x9c\xa1\x07\x00u\xbb\xa4\x1a\x13t\xc8\x1c\xcd\xe3" +
+	"\x8e\x02\xa0\xee\x90T\x13\x82\xf1\xe4TTn\xf3\x81i" +
+	"\x08\xa6\xc1bM\xd7u\xa5\xc1\x1b\xc0\x03\x92\xccv\x02" +
+	"\x01\xcd\xe3z]@\xd9\xecNq\xd3hW\x91\xac\xe1" +
+	"\xfc\xbe\xc0\x0f\x0f\x0d\xb4\x96\xdb\"\x13\xe98nm7" +
+	"7\x0c\xa8/$\xd5\xbc\xe0 \xff\x8d\xb3\xed\xfd\xce\xdd" +
+	"\x0b\xa8\xaf$\xd5\x05\xc1A\xf1O\xcc\x1c\x05\xe0,\x98" +
+	"\xb5\xbf\x95T\x97\x053\xf2\xef8G\x098\x17\xcd\xeb" +
+	"\xbc\xa4\xfaY0\x93\xf8+\xce1\x018?\x8c\x02\xea" +
+	"\x82\xa4Z\x12\xccX+q\x8e\x16\xe0\xfca\xb0W$" +
+	"\xbd4\x053}\xcbq\x8e}\x80\xbb\x89\xc3\x80\x97\xa0" +
+	"\xa4\x975\x83\xe4R\x9ck\xf5-\xc3\x9b\x01\xcf6\x83" +
+	"\x1c\x05\x07\xa2\xa90D\xdf\x0b\xa5j5\xf4uDB" +
+	"\x90`1\x9a\xaa\x94\xfc:S\x10L\x81\xc5F\xb3\x1e" +
+	"DO]\x0b3\xaf\xebu}\xec\x7f\xb3,VKG" +
+	"\xfcr\xb33_\xcd\xb0=\x1f(\xeb0d\xb6\x93&" +
+	"\xc8,\x98\xac\xeb\xa3\xd7\x14V\xcf!\xa3Z\xe1\xfe\xa0" +
+	"Q\xae\x07\x95 \xd2\xcdj\xfd\xe1\x9a\xb9l\x03\xdd\x85" +
+	"0\x91\xdf\"\xa9\xb6w\x15\xe2\xce\x83W\x0b\xb1\xdb\x98" +
+	"\xd4\x15\x7f\x95\xfa\x19s\xdd\x87t\x05\xf4\xd7\xc9\x99F" +
+	"\xee3\xa5\xf5\xa3\xb6\x10\x1b*\xd1\x16\"\x9d\x8c\xc9\xda" +
+	"\x96T9\xc1b\xad\xeeO\x06\xd3W\x19\xe0\xb0\x1f\xe8" +
+	"\xa19\xd4\xbd5\xc0l\xe7\xffbM\xddJ\xba\xe1\xef" +
+	"\xe2\xd8F\xe5/\x8e\xb4Zv]\xb3\xa3\x9dO\xa2\xc7" +
+	"l\xbeev\xcd\x89\xb2]\xfa(F\xb5\xc2a\x7fz" +
+	"\x8d\xb8\xe1h};\xff\x0d\x00j\xf9\x8d\xfb"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -769,3 +814,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_8ef99297a43a5e34,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -6,21 +6,21 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 )
 
-const Namespace_ = uint64(0xb9c6f99ebf805f2c)
-const Name_ = uint64(0xf264a779fef191ce)
 const AllowCancellation_ = uint64(0xac7096ff8cfc9dce)
-const schema_bdf87d7bb8304e81 = "x\xda\x12\xc8v`1\xe4\xcdgb`\x0a\x94ae" +
-	"\xfb\x7fn\xee\x9f\x9e\xff\xd3\x0a\xd60\\\xe4bed" +
-	"\xfe\xdf\xe8g\xb0\xa3\xba\xf6\xc7^\x06\x06FaY\xc6" +
-	"G\xc2\x9a\x8c\xec\x0c\x0c\xc1*\x8c\xcc\x8c\x0c\x8c\xffu" +
-	"\xe2\x1b\xf6\xcf\xfbyl'C \x17+#\x8aRQ" +
-	"\xc6E\xc2\xb2`\xa5\x12\x10\xa5\xe7&~\xfcW\xb9<" +
-	"\xe5\x13\xc8\xd8?\xec(j9\x19\xab\x84y\xc1j9" +
-	"@ju\xff'kk\xeb%'\x16\xe41\x15X%" +
-	"\xe6\xe4\xe4\x97;'\xe6%\xa7\xe6\xe4$\x96\xb0g\xe6" +
-	"\xe7\x050220\xc3\x950\x16X\xe5%\xe6\xa6\x16" +
-	"\x17\xb0'&\xa7\x06022\xf200\xc1%\x19\xec" +
-	"!\xb2Pq@\x00\x00\x00\xff\xff\x08\x03GM"
+const Name_ = uint64(0xf264a779fef191ce)
+const Namespace_ = uint64(0xb9c6f99ebf805f2c)
+const schema_bdf87d7bb8304e81 = "x\xda2Pft`1\xe4u\xe7``\x0aL`" +
+	"e\xfb\x7fn\xee\x9f\x9e\xff\xd3\x0a\xd60\\\xe4be" +
+	"d\xfe\xdf\xe8g\xb0\xa3\xba\xf6\xc7^\x06\x06FaN" +
+	"\x96G\x0c\x8c\xc1<,\xcc\x8c\x0c\x8c\xffu\xe2\x1b\xf6" +
+	"\xcf\xfbyl'C \x17+#\x8a\xb2\xbf\xcc\x8b\x18" +
+	"\x18\x83\xff0\x83\x95\x9d\x9b\xf8\xf1_\xe5\xf2\x94O " +
+	"\xe3\xfe\xb0\xa3\xa8{\xcb\\\xc5\xc0\x18\xfc\x02\xa4\xee\xc5" +
+	"\xffdmm\xbd\xe4\xc4\x82<\xa6\x02\xab\xc4\x9c\x9c\xfc" +
+	"r\xe7\xc4\xbc\xe4\xd4\x9c\x9c\xc4\x12\xf6\xcc\xfc<\x06f" +
+	"\xb8<c\x81U^bnjq\x01{br*#" +
+	"\x0f\x03\x13\\\x86\xc1\x1e\"\x05\x12\x04\x0c\x00\x91\xd4I" +
+	"\xde"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -33,3 +33,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_bdf87d7bb8304e81,
+		Compressed: true,
+	}
+	return s.Request()
+}
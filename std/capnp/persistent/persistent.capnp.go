@@ -13,11 +13,78 @@ import (
 
 const Persistent_ = uint64(0xf622595091cafb67)
 
+// Interface implemented by capabilities that outlive a single connection. A client may save()
+// the capability, producing a SturdyRef. The SturdyRef can be stored to disk, then later used to
+// obtain a new reference to the capability on a future connection.
+//
+// The exact format of SturdyRef depends on the "realm" in which the SturdyRef appears. A "realm"
+// is an abstract space in which all SturdyRefs have the same format and refer to the same set of
+// resources. Every vat is in exactly one realm. All capability clients within that vat must
+// produce SturdyRefs of the format appropriate for the realm.
+//
+// Similarly, every VatNetwork also resides in a particular realm. Usually, a vat's "realm"
+// corresponds to the realm of its main VatNetwork. However, a Vat can in fact communicate over
+// a VatNetwork in a different realm -- in this case, all SturdyRefs need to be transformed when
+// coming or going through said VatNetwork. The RPC system has hooks for registering
+// transformation callbacks for this purpose.
+//
+// Since the format of SturdyRef is realm-dependent, it is not defined here. An application should
+// choose an appropriate realm for itself as part of its design. Note that under Sandstorm, every
+// application exists in its own realm and is therefore free to define its own SturdyRef format;
+// the Sandstorm platform handles translating between realms.
+//
+// Note that whether a capability is persistent is often orthogonal to its type. In these cases,
+// the capability's interface should NOT inherit `Persistent`; instead, just perform a cast at
+// runtime. It's not type-safe, but trying to be type-safe in these cases will likely lead to
+// tears. In cases where a particular interface only makes sense on persistent capabilities, it
+// still should not explicitly inherit Persistent because the `SturdyRef` and `Owner` types will
+// vary between realms (they may even be different at the call site than they are on the
+// implementation). Instead, mark persistent interfaces with the $persistent annotation (defined
+// below).
+//
+// Sealing
+// -------
+//
+// As an added security measure, SturdyRefs may be "sealed" to a particular owner, such that
+// if the SturdyRef itself leaks to a third party, that party cannot actually restore it because
+// they are not the owner. To restore a sealed capability, you must first prove to its host that
+// you are the rightful owner. The precise mechanism for this authentication is defined by the
+// realm.
+//
+// Sealing is a defense-in-depth mechanism meant to mitigate damage in the case of catastrophic
+// attacks. For example, say an attacker temporarily gains read access to a database full of
+// SturdyRefs: it would be unfortunate if it were then necessary to revoke every single reference
+// in the database to prevent the attacker from using them.
+//
+// In general, an "owner" is a course-grained identity. Because capability-based security is still
+// the primary mechanism of security, it is not necessary nor desirable to have a separate "owner"
+// identity for every single process or object; that is exactly what capabilities are supposed to
+// avoid! Instead, it makes sense for an "owner" to literally identify the owner of the machines
+// where the capability is stored. If untrusted third parties are able to run arbitrary code on
+// said machines, then the sandbox for that code should be designed using Distributed Confinement
+// such that the third-party code never sees the bits of the SturdyRefs and cannot directly
+// exercise the owner's power to restore refs. See:
+//
+//	http://www.erights.org/elib/capability/dist-confine.html
+//
+// Resist the urge to represent an Owner as a simple public key. The whole point of sealing is to
+// defend against leaked-storage attacks. Such attacks can easily result in the owner's private
+// key being stolen as well. A better solution is for `Owner` to contain a simple globally unique
+// identifier for the owner, and for everyone to separately maintain a mapping of owner IDs to
+// public keys. If an owner's private key is compromised, then humans will need to communicate
+// and agree on a replacement public key, then update the mapping.
+//
+// As a concrete example, an `Owner` could simply contain a domain name, and restoring a SturdyRef
+// would require signing a request using the domain's private key. Authenticating this key could
+// be accomplished through certificate authorities or web-of-trust techniques.
 type Persistent capnp.Client
 
 // Persistent_TypeID is the unique identifier for the type Persistent.
 const Persistent_TypeID = 0xc8cb212fcd9f5691
 
+// Save a capability persistently so that it can be restored by a future connection.  Not all
+// capabilities can be saved -- application interfaces should define which capabilities support
+// this and which do not.
 func (c Persistent) Save(ctx context.Context, params func(Persistent_SaveParams) error) (Persistent_SaveResults_Future, capnp.ReleaseFunc) {
 
 	s := capnp.Send{
@@ -111,6 +178,9 @@ func (c Persistent) GetFlowLimiter() fc.FlowLimiter {
 
 // A Persistent_Server is a Persistent with a local implementation.
 type Persistent_Server interface {
+	// Save a capability persistently so that it can be restored by a future connection.  Not all
+	// capabilities can be saved -- application interfaces should define which capabilities support
+	// this and which do not.
 	Save(context.Context, Persistent_save) error
 }
 
@@ -168,7 +238,7 @@ func (c Persistent_save) AllocResults() (Persistent_SaveResults, error) {
 // Persistent_List is a list of Persistent.
 type Persistent_List = capnp.CapList[Persistent]
 
-// NewPersistent creates a new list of Persistent.
+// NewPersistent_List creates a new list of Persistent.
 func NewPersistent_List(s *capnp.Segment, sz int32) (Persistent_List, error) {
 	l, err := capnp.NewPointerList(s, sz)
 	return capnp.CapList[Persistent](l), err
@@ -221,8 +291,15 @@ func (s Persistent_SaveParams) Message() *capnp.Message {
 func (s Persistent_SaveParams) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+
+// Seal the SturdyRef so that it can only be restored by the specified Owner. This is meant
+// to mitigate damage when a SturdyRef is leaked. See comments above.
+//
+// Leaving this value null may or may not be allowed; it is up to the realm to decide. If a
+// realm does allow a null owner, this should indicate that anyone is allowed to restore the
+// ref.
 func (s Persistent_SaveParams) SealFor() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
+	return capnp.Struct(s).FieldPtr(0, "Persistent.SaveParams.sealFor")
 }
 
 func (s Persistent_SaveParams) HasSealFor() bool {
@@ -301,7 +378,7 @@ func (s Persistent_SaveResults) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 func (s Persistent_SaveResults) SturdyRef() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
+	return capnp.Struct(s).FieldPtr(0, "Persistent.SaveResults.sturdyRef")
 }
 
 func (s Persistent_SaveResults) HasSturdyRef() bool {
@@ -332,32 +409,30 @@ func (p Persistent_SaveResults_Future) SturdyRef() *capnp.Future {
 	return p.Future.Field(0, nil)
 }
 
-const schema_b8630836983feed7 = "x\xdat\x90\xbfk\x14A\x1c\xc5\xdf\x9b\xd9u\xef " +
-	"\xc1\x9b\x1b\xc1\x14\x81\xa8\x8d\"\x18\x7f\x81E\x9a\xbb\x8d" +
-	"\xa0^!\xee\xec\xa1\x10\xbb5\x8e?\xe0\xb2Yv6" +
-	"\x09V\xfe\x036\xe9\xec\xc4\xc2\xd2\xc2J\xb1\x11;Q" +
-	",\x82\x85\x95M\xfe\x00\xed\x14\xb1\x18Y0\x97\xe5\xbc" +
-	"\xb43\xdf\xc7\xfb|^\xe7K?8?\xbb%!\xcc" +
-	"\xa9\xf0\x90\x7f\xf7\xa3\xff\xe4\xfd\xb5\x07\xaf\xa1\xe6\xe9\xb7" +
-	"o=\xfb|\xf6\xf8\xa7\x0f\x08\x19ux\xf19\x97\xa9" +
-	"_1\x02\xf4K\xf6\xd0\xf8WJ\xfa\xaf\xdf{O/" +
-	"\xb5V\xdf\x00\xe8P\xefpW\x7f\xe3I@\xff\xe6U" +
-	"=\x10\x91\x1e\x88\xa3\xfe\xfe\x9f\x8f\xdb\xc9\xca\x89\x9f\xd8" +
-	"Q\xe116\"\xd4\x03\xb1\xabo\x8a\x08\x18&B\x12" +
-	"\xf4/\xdc\xb9\xb9\x95\xb7\xeb\xbf\xa6\x91\xc4b\x89\xda\xd4" +
-	"\xd7\xfa\xba\xe8\xe1\xb2/l\xe9\x1e\xba\xca\x06y\xb5\xb8" +
-	"\x9a\x15y\xb1\x94\xfc{\xc9\xab\xc5a\xb6iS\xeb6" +
-	"F\x95CB\x9a@\x06@@@\xcd\xa6\x80\x99\x914" +
-	"s\x82\xdeU\x1b\xe5\xddG\xa9\x05\xef\xb1\xcbF'\xc0" +
-	".8\xee\x10\x93\x1d\x91\xcd+\xd3b\x13\xb9}\xbb\xb1" +
-	"d\xfb\x8e\xaf\x11\x92\xac\xcc \xd7\x9c\xdf\xe3A4\xaa" +
-	"\x9c\x09d\x08\x8c\xa3\xdc\x8b)u\x1a\x88g\x18\xcfS" +
-	"\x9d\x89\x0e\xbbl\xd3*.\x98@4\xc0X;L{" +
-	"\xec3!\xe3\x16U\x98\xaa\xf6\x05?\xdc7[\xb8\xb1" +
-	"\x95\xdbr\x8aK\xb1\xef\x92\x90\x90\xe3\x13y\xd0\xa4\xbd" +
-	"Zh\xcdM,\xba\x0c\x98\x96\xa49\"\xf8\xd8\xd9l" +
-	"te\xbdd7\xfc\x7f\xce\xbf\x01\x00\x00\xff\xff\x92\xdc" +
-	"\xc1$"
+const schema_b8630836983feed7 = "x\xdat\x90\xbdk\x14Q\x14\xc5\xcf\x99w\xc7\x9d\x85" +
+	"\x04\xf3v\x04S\x04\xa26\x82E\x8c\x8a\x16\x01\xd9l" +
+	"\x0a\xb5s\xdf,\x08\xb1\x1b\x93\xe7\x07\xecN\x86y/" +
+	"\x11+k\xc1&\x9d]*\xff\x06\xc5F\xec\xfc\xc0\"" +
+	"\xc5\xa2u*+\xed\xb4\xb0x2`6\x83n\xda{" +
+	"9\x9c\xdf\xef,{\xae\xca\xa5\xd9\xbd\x04\x91\xd9\x8cO" +
+	"\x84\xb7?V\x9f\xbf\xbb\xf5\xf0\x15\xf4\x02\xc3\xee\x9d\xbd" +
+	"\xcf\x17\xcf~z\x8f\x98\xad9^\xb9*k\x04\xd3\xeb" +
+	"\xd2E\xe3\xa7\xb5\x0a_\xbew_\\K6^\x03\x98" +
+	"c\x9a\xcbA:\x92\xf3@\xfaLn\xa6ci\xa5c" +
+	"9\x1d\x1e\xfc\xfe\xb8\xdb_?\xf7\x13\xfb:>\xc3F" +
+	"\x84\xe9X\x0e\xc0\xc1WQ\x04\xc3K\xb7<\xbf\xfef" +
+	"\xeb\xd74\x82\x0f\xb2R\x13\xecK\x17\xdfBi+\xf7" +
+	"\xc8y+\x85_\xda\xc8\xcb\xa2\\\xe9\xff\xbd\x14~i" +
+	"\x90\xef\xd8\xcc\xba\xed\xa1w0\xa2\x04\x10\x02z6\x03" +
+	"\xcc\x8c\xa2\x99\x8f\x18\x9c\xdf\xae6\x9fd\x16\xbc\xcf\x0e" +
+	"\x1be\x00;\xe0\xa4 \xfa\xb7\xa0e\x0bo\x126Y" +
+	"\xdbw\x1b\xd3\xb5\xef\x85\xba\xbf\x9fW9\xd4\xc8\x85C" +
+	"\x18\xb4\x86\xde\x19Q10\x89\xf20\xa6\xf5\x05\xa07" +
+	"\xc3\xde\x02\x81\x93.\xdf\xb1\x9a\x8bF\xa2\x06\x17k\x85" +
+	"i\xc7>\xd9K\xa8\xe3L\xb7/\x87\xc1\x91\xd6\xe2\xed" +
+	"\xc7\x85\xad\xa6\x88\x94G\"P\x93\xbf:n\xc9n\xad" +
+	"2r\xcd!\xd7\x00\x93(\x9aS\x11\x9f:\x9b\x0fo" +
+	"lU\xec\xc4\xff\xaf\xf8g\x00\xdf\xf3\xbf\xf8"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -371,3 +446,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_b8630836983feed7,
+		Compressed: true,
+	}
+	return s.Request()
+}
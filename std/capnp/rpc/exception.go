@@ -6,7 +6,25 @@ import "capnproto.org/go/capnp/v3/exc"
 // error if marshalling fails.
 func (e Exception) MarshalError(err error) error {
 	e.SetType(Exception_Type(exc.TypeOf(err)))
-	return e.SetReason(err.Error())
+	if err := e.SetReason(err.Error()); err != nil {
+		return err
+	}
+
+	details := exc.DetailsOf(err)
+	if len(details) == 0 {
+		return nil
+	}
+	l, err := e.NewDetails(int32(len(details)))
+	if err != nil {
+		return err
+	}
+	for i, d := range details {
+		l.At(i).SetId(d.ID)
+		if err := l.At(i).SetValue(d.Value); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ToError converts the exception to an error. If accessing the reason field
@@ -27,5 +45,34 @@ func (e Exception) ToError() error {
 			Cause:  err,
 		}
 	}
-	return exc.New(exc.Type(e.Type()), "", reason)
+	ex := exc.New(typ, "", reason)
+	if !e.HasDetails() {
+		return ex
+	}
+
+	l, err := e.Details()
+	if err != nil {
+		return &exc.Exception{
+			Type:   typ,
+			Prefix: "failed to read details",
+			Cause:  err,
+		}
+	}
+	ex.Details = make([]exc.Detail, l.Len())
+	for i := range ex.Details {
+		v, err := l.At(i).Value()
+		if err != nil {
+			return &exc.Exception{
+				Type:   typ,
+				Prefix: "failed to read details",
+				Cause:  err,
+			}
+		}
+		// Copy the value so that the error outlives the message.
+		ex.Details[i] = exc.Detail{
+			ID:    l.At(i).Id(),
+			Value: append([]byte(nil), v...),
+		}
+	}
+	return ex
 }
@@ -3,2655 +3,3852 @@
 package rpc
 
 import (
+	bytes "bytes"
 	capnp "capnproto.org/go/capnp/v3"
 	text "capnproto.org/go/capnp/v3/encoding/text"
 	schemas "capnproto.org/go/capnp/v3/schemas"
+	io "io"
 	strconv "strconv"
 )
 
-type Message capnp.Struct
-type Message_Which uint16
-
-const (
-	Message_Which_unimplemented  Message_Which = 0
-	Message_Which_abort          Message_Which = 1
-	Message_Which_bootstrap      Message_Which = 8
-	Message_Which_call           Message_Which = 2
-	Message_Which_return         Message_Which = 3
-	Message_Which_finish         Message_Which = 4
-	Message_Which_resolve        Message_Which = 5
-	Message_Which_release        Message_Which = 6
-	Message_Which_disembargo     Message_Which = 13
-	Message_Which_obsoleteSave   Message_Which = 7
-	Message_Which_obsoleteDelete Message_Which = 9
-	Message_Which_provide        Message_Which = 10
-	Message_Which_accept         Message_Which = 11
-	Message_Which_join           Message_Which = 12
-)
-
-func (w Message_Which) String() string {
-	const s = "unimplementedabortbootstrapcallreturnfinishresolvereleasedisembargoobsoleteSaveobsoleteDeleteprovideacceptjoin"
-	switch w {
-	case Message_Which_unimplemented:
-		return s[0:13]
-	case Message_Which_abort:
-		return s[13:18]
-	case Message_Which_bootstrap:
-		return s[18:27]
-	case Message_Which_call:
-		return s[27:31]
-	case Message_Which_return:
-		return s[31:37]
-	case Message_Which_finish:
-		return s[37:43]
-	case Message_Which_resolve:
-		return s[43:50]
-	case Message_Which_release:
-		return s[50:57]
-	case Message_Which_disembargo:
-		return s[57:67]
-	case Message_Which_obsoleteSave:
-		return s[67:79]
-	case Message_Which_obsoleteDelete:
-		return s[79:93]
-	case Message_Which_provide:
-		return s[93:100]
-	case Message_Which_accept:
-		return s[100:106]
-	case Message_Which_join:
-		return s[106:110]
-
-	}
-	return "Message_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
+// **(level 3)**
+//
+// Message type sent to pick up a capability hosted by the receiving vat and provided by a third
+// party.  The third party previously designated the capability using `Provide`.
+//
+// This message is also used to pick up a redirected return -- see `Return.acceptFromThirdParty`.
+type Accept capnp.Struct
 
-// Message_TypeID is the unique identifier for the type Message.
-const Message_TypeID = 0x91b79f1f808db032
+// Accept_TypeID is the unique identifier for the type Accept.
+const Accept_TypeID = 0xd4c9b56290554016
 
-func NewMessage(s *capnp.Segment) (Message, error) {
+func NewAccept(s *capnp.Segment) (Accept, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Message(st), err
+	return Accept(st), err
 }
 
-func NewRootMessage(s *capnp.Segment) (Message, error) {
+func NewRootAccept(s *capnp.Segment) (Accept, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Message(st), err
+	return Accept(st), err
 }
 
-func ReadRootMessage(msg *capnp.Message) (Message, error) {
+func ReadRootAccept(msg *capnp.Message) (Accept, error) {
 	root, err := msg.Root()
-	return Message(root.Struct()), err
+	return Accept(root.Struct()), err
 }
 
-func (s Message) String() string {
-	str, _ := text.Marshal(0x91b79f1f808db032, capnp.Struct(s))
+func (s Accept) String() string {
+	str, _ := text.Marshal(0xd4c9b56290554016, capnp.Struct(s))
 	return str
 }
 
-func (s Message) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Accept) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Message) DecodeFromPtr(p capnp.Ptr) Message {
-	return Message(capnp.Struct{}.DecodeFromPtr(p))
+func (Accept) DecodeFromPtr(p capnp.Ptr) Accept {
+	return Accept(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Message) ToPtr() capnp.Ptr {
+func (s Accept) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s Message) Which() Message_Which {
-	return Message_Which(capnp.Struct(s).Uint16(0))
-}
-func (s Message) IsValid() bool {
+func (s Accept) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Message) Message() *capnp.Message {
+func (s Accept) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Message) Segment() *capnp.Segment {
+func (s Accept) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Message) Unimplemented() (Message, error) {
-	if capnp.Struct(s).Uint16(0) != 0 {
-		panic("Which() != unimplemented")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Message(p.Struct()), err
+
+// A new question ID identifying this accept message, which will eventually receive a Return
+// message containing the provided capability (or the call result in the case of a redirected
+// return).
+func (s Accept) QuestionId() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s Message) HasUnimplemented() bool {
-	if capnp.Struct(s).Uint16(0) != 0 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Accept) SetQuestionId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s Message) SetUnimplemented(v Message) error {
-	capnp.Struct(s).SetUint16(0, 0)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// Identifies the provided object to be picked up.
+func (s Accept) Provision() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(0, "Accept.provision")
 }
 
-// NewUnimplemented sets the unimplemented field to a newly
-// allocated Message struct, preferring placement in s's segment.
-func (s Message) NewUnimplemented() (Message, error) {
-	capnp.Struct(s).SetUint16(0, 0)
-	ss, err := NewMessage(capnp.Struct(s).Segment())
-	if err != nil {
-		return Message{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Accept) HasProvision() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Message) Abort() (Exception, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != abort")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Exception(p.Struct()), err
+func (s Accept) SetProvision(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-func (s Message) HasAbort() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// If true, this accept shall be temporarily embargoed.  The resulting `Return` will not be sent,
+// and any pipelined calls will not be delivered, until the embargo is released.  The receiver
+// (the capability host) will expect the provider (the vat that sent the `Provide` message) to
+// eventually send a `Disembargo` message with the field `context.provide` set to the question ID
+// of the original `Provide` message.  At that point, the embargo is released and the queued
+// messages are delivered.
+//
+// For example:
+//   - Alice, in Vat A, holds a promise P, which currently points toward Vat B.
+//   - Alice calls foo() on P.  The `Call` message is sent to Vat B.
+//   - The promise P in Vat B ends up resolving to Carol, in Vat C.
+//   - Vat B sends a `Provide` message to Vat C, identifying Vat A as the recipient.
+//   - Vat B sends a `Resolve` message to Vat A, indicating that the promise has resolved to a
+//     `ThirdPartyCapId` identifying Carol in Vat C.
+//   - Vat A sends an `Accept` message to Vat C to pick up the capability.  Since Vat A knows that
+//     it has an outstanding call to the promise, it sets `embargo` to `true` in the `Accept`
+//     message.
+//   - Vat A sends a `Disembargo` message to Vat B on promise P, with `context.accept` set.
+//   - Alice makes a call bar() to promise P, which is now pointing towards Vat C.  Alice doesn't
+//     know anything about the mechanics of promise resolution happening under the hood, but she
+//     expects that bar() will be delivered after foo() because that is the order in which she
+//     initiated the calls.
+//   - Vat A sends the bar() call to Vat C, as a pipelined call on the result of the `Accept` (which
+//     hasn't returned yet, due to the embargo).  Since calls to the newly-accepted capability
+//     are embargoed, Vat C does not deliver the call yet.
+//   - At some point, Vat B forwards the foo() call from the beginning of this example on to Vat C.
+//   - Vat B forwards the `Disembargo` from Vat A on to vat C.  It sets `context.provide` to the
+//     question ID of the `Provide` message it had sent previously.
+//   - Vat C receives foo() before `Disembargo`, thus allowing it to correctly deliver foo()
+//     before delivering bar().
+//   - Vat C receives `Disembargo` from Vat B.  It can now send a `Return` for the `Accept` from
+//     Vat A, as well as deliver bar().
+func (s Accept) Embargo() bool {
+	return capnp.Struct(s).Bit(32)
 }
 
-func (s Message) SetAbort(v Exception) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Accept) SetEmbargo(v bool) {
+	capnp.Struct(s).SetBit(32, v)
 }
 
-// NewAbort sets the abort field to a newly
-// allocated Exception struct, preferring placement in s's segment.
-func (s Message) NewAbort() (Exception, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewException(capnp.Struct(s).Segment())
-	if err != nil {
-		return Exception{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// Accept_List is a list of Accept.
+type Accept_List = capnp.StructList[Accept]
+
+// NewAccept creates a new list of Accept.
+func NewAccept_List(s *capnp.Segment, sz int32) (Accept_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Accept](l), err
 }
 
-func (s Message) Bootstrap() (Bootstrap, error) {
-	if capnp.Struct(s).Uint16(0) != 8 {
-		panic("Which() != bootstrap")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Bootstrap(p.Struct()), err
+// Accept_Future is a wrapper for a Accept promised by a client call.
+type Accept_Future struct{ *capnp.Future }
+
+func (f Accept_Future) Struct() (Accept, error) {
+	p, err := f.Future.Ptr()
+	return Accept(p.Struct()), err
+}
+func (p Accept_Future) Provision() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
 
-func (s Message) HasBootstrap() bool {
-	if capnp.Struct(s).Uint16(0) != 8 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// **(level 0)**
+//
+// Get the "bootstrap" interface exported by the remote vat.
+//
+// For level 0, 1, and 2 implementations, the "bootstrap" interface is simply the main interface
+// exported by a vat. If the vat acts as a server fielding connections from clients, then the
+// bootstrap interface defines the basic functionality available to a client when it connects.
+// The exact interface definition obviously depends on the application.
+//
+// We call this a "bootstrap" because in an ideal Cap'n Proto world, bootstrap interfaces would
+// never be used. In such a world, any time you connect to a new vat, you do so because you
+// received an introduction from some other vat (see `ThirdPartyCapId`). Thus, the first message
+// you send is `Accept`, and further communications derive from there. `Bootstrap` is not used.
+//
+// In such an ideal world, DNS itself would support Cap'n Proto -- performing a DNS lookup would
+// actually return a new Cap'n Proto capability, thus introducing you to the target system via
+// level 3 RPC. Applications would receive the capability to talk to DNS in the first place as
+// an initial endowment or part of a Powerbox interaction. Therefore, an app can form arbitrary
+// connections without ever using `Bootstrap`.
+//
+// Of course, in the real world, DNS is not Cap'n-Proto-based, and we don't want Cap'n Proto to
+// require a whole new internet infrastructure to be useful. Therefore, we offer bootstrap
+// interfaces as a way to get up and running without a level 3 introduction. Thus, bootstrap
+// interfaces are used to "bootstrap" from other, non-Cap'n-Proto-based means of service discovery,
+// such as legacy DNS.
+//
+// Note that a vat need not provide a bootstrap interface, and in fact many vats (especially those
+// acting as clients) do not. In this case, the vat should either reply to `Bootstrap` with a
+// `Return` indicating an exception, or should return a dummy capability with no methods.
+type Bootstrap capnp.Struct
+
+// Bootstrap_TypeID is the unique identifier for the type Bootstrap.
+const Bootstrap_TypeID = 0xe94ccf8031176ec4
+
+func NewBootstrap(s *capnp.Segment) (Bootstrap, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Bootstrap(st), err
 }
 
-func (s Message) SetBootstrap(v Bootstrap) error {
-	capnp.Struct(s).SetUint16(0, 8)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func NewRootBootstrap(s *capnp.Segment) (Bootstrap, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Bootstrap(st), err
 }
 
-// NewBootstrap sets the bootstrap field to a newly
-// allocated Bootstrap struct, preferring placement in s's segment.
-func (s Message) NewBootstrap() (Bootstrap, error) {
-	capnp.Struct(s).SetUint16(0, 8)
-	ss, err := NewBootstrap(capnp.Struct(s).Segment())
-	if err != nil {
-		return Bootstrap{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func ReadRootBootstrap(msg *capnp.Message) (Bootstrap, error) {
+	root, err := msg.Root()
+	return Bootstrap(root.Struct()), err
 }
 
-func (s Message) Call() (Call, error) {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != call")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Call(p.Struct()), err
+func (s Bootstrap) String() string {
+	str, _ := text.Marshal(0xe94ccf8031176ec4, capnp.Struct(s))
+	return str
 }
 
-func (s Message) HasCall() bool {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Bootstrap) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Message) SetCall(v Call) error {
-	capnp.Struct(s).SetUint16(0, 2)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (Bootstrap) DecodeFromPtr(p capnp.Ptr) Bootstrap {
+	return Bootstrap(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// NewCall sets the call field to a newly
-// allocated Call struct, preferring placement in s's segment.
-func (s Message) NewCall() (Call, error) {
-	capnp.Struct(s).SetUint16(0, 2)
-	ss, err := NewCall(capnp.Struct(s).Segment())
-	if err != nil {
-		return Call{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Bootstrap) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Bootstrap) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Message) Return() (Return, error) {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		panic("Which() != return")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Return(p.Struct()), err
+func (s Bootstrap) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Message) HasReturn() bool {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Bootstrap) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
 
-func (s Message) SetReturn(v Return) error {
-	capnp.Struct(s).SetUint16(0, 3)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// A new question ID identifying this request, which will eventually receive a Return message
+// containing the restored capability.
+func (s Bootstrap) QuestionId() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-// NewReturn sets the return field to a newly
-// allocated Return struct, preferring placement in s's segment.
-func (s Message) NewReturn() (Return, error) {
-	capnp.Struct(s).SetUint16(0, 3)
-	ss, err := NewReturn(capnp.Struct(s).Segment())
-	if err != nil {
-		return Return{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Bootstrap) SetQuestionId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s Message) Finish() (Finish, error) {
-	if capnp.Struct(s).Uint16(0) != 4 {
-		panic("Which() != finish")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Finish(p.Struct()), err
+// ** DEPRECATED **
+//
+// A Vat may export multiple bootstrap interfaces. In this case, `deprecatedObjectId` specifies
+// which one to return. If this pointer is null, then the default bootstrap interface is returned.
+//
+// As of version 0.5, use of this field is deprecated. If a service wants to export multiple
+// bootstrap interfaces, it should instead define a single bootstrap interface that has methods
+// that return each of the other interfaces.
+//
+// **History**
+//
+// In the first version of Cap'n Proto RPC (0.4.x) the `Bootstrap` message was called `Restore`.
+// At the time, it was thought that this would eventually serve as the way to restore SturdyRefs
+// (level 2). Meanwhile, an application could offer its "main" interface on a well-known
+// (non-secret) SturdyRef.
+//
+// Since level 2 RPC was not implemented at the time, the `Restore` message was in practice only
+// used to obtain the main interface. Since most applications had only one main interface that
+// they wanted to restore, they tended to designate this with a null `objectId`.
+//
+// Unfortunately, the earliest version of the EZ RPC interfaces set a precedent of exporting
+// multiple main interfaces by allowing them to be exported under string names. In this case,
+// `objectId` was a Text value specifying the name.
+//
+// All of this proved problematic for several reasons:
+//
+//   - The arrangement assumed that a client wishing to restore a SturdyRef would know exactly what
+//     machine to connect to and would be able to immediately restore a SturdyRef on connection.
+//     However, in practice, the ability to restore SturdyRefs is itself a capability that may
+//     require going through an authentication process to obtain. Thus, it makes more sense to
+//     define a "restorer service" as a full Cap'n Proto interface. If this restorer interface is
+//     offered as the vat's bootstrap interface, then this is equivalent to the old arrangement.
+//
+//   - Overloading "Restore" for the purpose of obtaining well-known capabilities encouraged the
+//     practice of exporting singleton services with string names. If singleton services are desired,
+//     it is better to have one main interface that has methods that can be used to obtain each
+//     service, in order to get all the usual benefits of schemas and type checking.
+//
+//   - Overloading "Restore" also had a security problem: Often, "main" or "well-known"
+//     capabilities exported by a vat are in fact not public: they are intended to be accessed only
+//     by clients who are capable of forming a connection to the vat. This can lead to trouble if
+//     the client itself has other clients and wishes to forward some `Restore` requests from those
+//     external clients -- it has to be very careful not to allow through `Restore` requests
+//     addressing the default capability.
+//
+//     For example, consider the case of a sandboxed Sandstorm application and its supervisor. The
+//     application exports a default capability to its supervisor that provides access to
+//     functionality that only the supervisor is supposed to access. Meanwhile, though, applications
+//     may publish other capabilities that may be persistent, in which case the application needs
+//     to field `Restore` requests that could come from anywhere. These requests of course have to
+//     pass through the supervisor, as all communications with the outside world must. But, the
+//     supervisor has to be careful not to honor an external request addressing the application's
+//     default capability, since this capability is privileged. Unfortunately, the default
+//     capability cannot be given an unguessable name, because then the supervisor itself would not
+//     be able to address it!
+//
+// As of Cap'n Proto 0.5, `Restore` has been renamed to `Bootstrap` and is no longer planned for
+// use in restoring SturdyRefs.
+//
+// Note that 0.4 also defined a message type called `Delete` that, like `Restore`, addressed a
+// SturdyRef, but indicated that the client would not restore the ref again in the future. This
+// operation was never implemented, so it was removed entirely. If a "delete" operation is desired,
+// it should exist as a method on the same interface that handles restoring SturdyRefs. However,
+// the utility of such an operation is questionable. You wouldn't be able to rely on it for
+// garbage collection since a client could always disappear permanently without remembering to
+// delete all its SturdyRefs, thus leaving them dangling forever. Therefore, it is advisable to
+// design systems such that SturdyRefs never represent "owned" pointers.
+//
+// For example, say a SturdyRef points to an image file hosted on some server. That image file
+// should also live inside a collection (a gallery, perhaps) hosted on the same server, owned by
+// a user who can delete the image at any time. If the user deletes the image, the SturdyRef
+// stops working. On the other hand, if the SturdyRef is discarded, this has no effect on the
+// existence of the image in its collection.
+func (s Bootstrap) DeprecatedObjectId() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(0, "Bootstrap.deprecatedObjectId")
 }
 
-func (s Message) HasFinish() bool {
-	if capnp.Struct(s).Uint16(0) != 4 {
-		return false
-	}
+func (s Bootstrap) HasDeprecatedObjectId() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Message) SetFinish(v Finish) error {
-	capnp.Struct(s).SetUint16(0, 4)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Bootstrap) SetDeprecatedObjectId(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-// NewFinish sets the finish field to a newly
-// allocated Finish struct, preferring placement in s's segment.
-func (s Message) NewFinish() (Finish, error) {
-	capnp.Struct(s).SetUint16(0, 4)
-	ss, err := NewFinish(capnp.Struct(s).Segment())
-	if err != nil {
-		return Finish{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// Bootstrap_List is a list of Bootstrap.
+type Bootstrap_List = capnp.StructList[Bootstrap]
+
+// NewBootstrap creates a new list of Bootstrap.
+func NewBootstrap_List(s *capnp.Segment, sz int32) (Bootstrap_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Bootstrap](l), err
 }
 
-func (s Message) Resolve() (Resolve, error) {
-	if capnp.Struct(s).Uint16(0) != 5 {
-		panic("Which() != resolve")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Resolve(p.Struct()), err
+// Bootstrap_Future is a wrapper for a Bootstrap promised by a client call.
+type Bootstrap_Future struct{ *capnp.Future }
+
+func (f Bootstrap_Future) Struct() (Bootstrap, error) {
+	p, err := f.Future.Ptr()
+	return Bootstrap(p.Struct()), err
+}
+func (p Bootstrap_Future) DeprecatedObjectId() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
 
-func (s Message) HasResolve() bool {
-	if capnp.Struct(s).Uint16(0) != 5 {
-		return false
+// **(level 0)**
+//
+// Message type initiating a method call on a capability.
+type Call capnp.Struct
+
+// Where should the return message be sent?
+type Call_sendResultsTo Call
+type Call_sendResultsTo_Which uint16
+
+const (
+	Call_sendResultsTo_Which_caller     Call_sendResultsTo_Which = 0
+	Call_sendResultsTo_Which_yourself   Call_sendResultsTo_Which = 1
+	Call_sendResultsTo_Which_thirdParty Call_sendResultsTo_Which = 2
+)
+
+func (w Call_sendResultsTo_Which) String() string {
+	const s = "calleryourselfthirdParty"
+	switch w {
+	case Call_sendResultsTo_Which_caller:
+		return s[0:6]
+	case Call_sendResultsTo_Which_yourself:
+		return s[6:14]
+	case Call_sendResultsTo_Which_thirdParty:
+		return s[14:24]
+
 	}
-	return capnp.Struct(s).HasPtr(0)
+	return "Call_sendResultsTo_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func (s Message) SetResolve(v Resolve) error {
-	capnp.Struct(s).SetUint16(0, 5)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// Call_sendResultsTo_Visitor has a method for each member of the union of
+// Call_sendResultsTo, which Visit calls with the member's value.
+type Call_sendResultsTo_Visitor interface {
+	VisitCaller() error
+	VisitYourself() error
+	VisitThirdParty(capnp.Ptr) error
 }
 
-// NewResolve sets the resolve field to a newly
-// allocated Resolve struct, preferring placement in s's segment.
-func (s Message) NewResolve() (Resolve, error) {
-	capnp.Struct(s).SetUint16(0, 5)
-	ss, err := NewResolve(capnp.Struct(s).Segment())
-	if err != nil {
-		return Resolve{}, err
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Call_sendResultsTo) Visit(v Call_sendResultsTo_Visitor) error {
+	switch w := s.Which(); w {
+	case Call_sendResultsTo_Which_caller:
+		return v.VisitCaller()
+	case Call_sendResultsTo_Which_yourself:
+		return v.VisitYourself()
+	case Call_sendResultsTo_Which_thirdParty:
+		x, err := s.ThirdParty()
+		if err != nil {
+			return err
+		}
+		return v.VisitThirdParty(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Call.sendResultsTo", Which: uint16(w)}
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
 }
 
-func (s Message) Release() (Release, error) {
-	if capnp.Struct(s).Uint16(0) != 6 {
-		panic("Which() != release")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Release(p.Struct()), err
+// Call_TypeID is the unique identifier for the type Call.
+const Call_TypeID = 0x836a53ce789d4cd4
+
+func NewCall(s *capnp.Segment) (Call, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	return Call(st), err
 }
 
-func (s Message) HasRelease() bool {
-	if capnp.Struct(s).Uint16(0) != 6 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func NewRootCall(s *capnp.Segment) (Call, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	return Call(st), err
 }
 
-func (s Message) SetRelease(v Release) error {
-	capnp.Struct(s).SetUint16(0, 6)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func ReadRootCall(msg *capnp.Message) (Call, error) {
+	root, err := msg.Root()
+	return Call(root.Struct()), err
 }
 
-// NewRelease sets the release field to a newly
-// allocated Release struct, preferring placement in s's segment.
-func (s Message) NewRelease() (Release, error) {
-	capnp.Struct(s).SetUint16(0, 6)
-	ss, err := NewRelease(capnp.Struct(s).Segment())
-	if err != nil {
-		return Release{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Call) String() string {
+	str, _ := text.Marshal(0x836a53ce789d4cd4, capnp.Struct(s))
+	return str
 }
 
-func (s Message) Disembargo() (Disembargo, error) {
-	if capnp.Struct(s).Uint16(0) != 13 {
-		panic("Which() != disembargo")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Disembargo(p.Struct()), err
+func (s Call) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Message) HasDisembargo() bool {
-	if capnp.Struct(s).Uint16(0) != 13 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (Call) DecodeFromPtr(p capnp.Ptr) Call {
+	return Call(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Message) SetDisembargo(v Disembargo) error {
-	capnp.Struct(s).SetUint16(0, 13)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Call) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Call) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-// NewDisembargo sets the disembargo field to a newly
-// allocated Disembargo struct, preferring placement in s's segment.
-func (s Message) NewDisembargo() (Disembargo, error) {
-	capnp.Struct(s).SetUint16(0, 13)
-	ss, err := NewDisembargo(capnp.Struct(s).Segment())
-	if err != nil {
-		return Disembargo{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Call) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Message) ObsoleteSave() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(0) != 7 {
-		panic("Which() != obsoleteSave")
-	}
-	return capnp.Struct(s).Ptr(0)
+func (s Call) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
 
-func (s Message) HasObsoleteSave() bool {
-	if capnp.Struct(s).Uint16(0) != 7 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// A number, chosen by the caller, that identifies this call in future messages.  This number
+// must be different from all other calls originating from the same end of the connection (but
+// may overlap with question IDs originating from the opposite end).  A fine strategy is to use
+// sequential question IDs, but the recipient should not assume this.
+//
+// A question ID can be reused once both:
+// - A matching Return has been received from the callee.
+// - A matching Finish has been sent from the caller.
+func (s Call) QuestionId() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s Message) SetObsoleteSave(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(0, 7)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Call) SetQuestionId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
-func (s Message) ObsoleteDelete() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(0) != 9 {
-		panic("Which() != obsoleteDelete")
-	}
-	return capnp.Struct(s).Ptr(0)
+
+// The object that should receive this call.
+func (s Call) Target() (MessageTarget, error) {
+	return capnp.GetStructField[MessageTarget](s, 0, "Call.target")
 }
 
-func (s Message) HasObsoleteDelete() bool {
-	if capnp.Struct(s).Uint16(0) != 9 {
-		return false
-	}
+func (s Call) HasTarget() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Message) SetObsoleteDelete(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(0, 9)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Call) SetTarget(v MessageTarget) error {
+	return capnp.SetStructField(s, 0, v)
 }
-func (s Message) Provide() (Provide, error) {
-	if capnp.Struct(s).Uint16(0) != 10 {
-		panic("Which() != provide")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Provide(p.Struct()), err
+
+// NewTarget sets the target field to a newly
+// allocated MessageTarget struct, preferring placement in s's segment.
+func (s Call) NewTarget() (MessageTarget, error) {
+	return capnp.NewStructField(s, 0, NewMessageTarget)
 }
 
-func (s Message) HasProvide() bool {
-	if capnp.Struct(s).Uint16(0) != 10 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// The type ID of the interface being called.  Each capability may implement multiple interfaces.
+func (s Call) InterfaceId() uint64 {
+	return capnp.Struct(s).Uint64(8)
 }
 
-func (s Message) SetProvide(v Provide) error {
-	capnp.Struct(s).SetUint16(0, 10)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Call) SetInterfaceId(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
 }
 
-// NewProvide sets the provide field to a newly
-// allocated Provide struct, preferring placement in s's segment.
-func (s Message) NewProvide() (Provide, error) {
-	capnp.Struct(s).SetUint16(0, 10)
-	ss, err := NewProvide(capnp.Struct(s).Segment())
-	if err != nil {
-		return Provide{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// The ordinal number of the method to call within the requested interface.
+func (s Call) MethodId() uint16 {
+	return capnp.Struct(s).Uint16(4)
 }
 
-func (s Message) Accept() (Accept, error) {
-	if capnp.Struct(s).Uint16(0) != 11 {
-		panic("Which() != accept")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Accept(p.Struct()), err
+func (s Call) SetMethodId(v uint16) {
+	capnp.Struct(s).SetUint16(4, v)
 }
 
-func (s Message) HasAccept() bool {
-	if capnp.Struct(s).Uint16(0) != 11 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// Indicates whether or not the receiver is allowed to send a `Return` containing
+// `acceptFromThirdParty`.  Level 3 implementations should set this true.  Otherwise, the callee
+// will have to proxy the return in the case of a tail call to a third-party vat.
+func (s Call) AllowThirdPartyTailCall() bool {
+	return capnp.Struct(s).Bit(128)
 }
 
-func (s Message) SetAccept(v Accept) error {
-	capnp.Struct(s).SetUint16(0, 11)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Call) SetAllowThirdPartyTailCall(v bool) {
+	capnp.Struct(s).SetBit(128, v)
 }
 
-// NewAccept sets the accept field to a newly
-// allocated Accept struct, preferring placement in s's segment.
-func (s Message) NewAccept() (Accept, error) {
-	capnp.Struct(s).SetUint16(0, 11)
-	ss, err := NewAccept(capnp.Struct(s).Segment())
-	if err != nil {
-		return Accept{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// If true, the sender promises that it won't make any promise-pipelined calls on the results of
+// this call. If it breaks this promise, the receiver may throw an arbitrary error from such
+// calls.
+//
+// The receiver may use this as an optimization, by skipping the bookkeeping needed for pipelining
+// when no pipelined calls are expected. The sender typically sets this to false when the method's
+// schema does not specify any return capabilities.
+func (s Call) NoPromisePipelining() bool {
+	return capnp.Struct(s).Bit(129)
 }
 
-func (s Message) Join() (Join, error) {
-	if capnp.Struct(s).Uint16(0) != 12 {
-		panic("Which() != join")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Join(p.Struct()), err
+func (s Call) SetNoPromisePipelining(v bool) {
+	capnp.Struct(s).SetBit(129, v)
 }
 
-func (s Message) HasJoin() bool {
-	if capnp.Struct(s).Uint16(0) != 12 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+// If true, the sender only plans to use this call to make pipelined calls. The receiver need not
+// send a `Return` message (but is still allowed to do so).
+//
+// Since the sender does not know whether a `Return` will be sent, it must release all state
+// related to the call when it sends `Finish`. However, in the case that the callee does not
+// recognize this hint and chooses to send a `Return`, then technically the caller is not allowed
+// to reuse the question ID until it receives said `Return`. This creates a conundrum: How does
+// the caller decide when it's OK to reuse the ID? To sidestep the problem, the C++ implementation
+// uses high-numbered IDs (with the high-order bit set) for such calls, and cycles through the
+// IDs in order. If all 2^31 IDs in this space are used without ever seeing a `Return`, then the
+// implementation assumes that the other end is in fact honoring the hint, and the ID counter is
+// allowed to loop around. If a `Return` is ever seen when `onlyPromisePipeline` was set, then
+// the implementation stops using this hint.
+func (s Call) OnlyPromisePipeline() bool {
+	return capnp.Struct(s).Bit(130)
 }
 
-func (s Message) SetJoin(v Join) error {
-	capnp.Struct(s).SetUint16(0, 12)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Call) SetOnlyPromisePipeline(v bool) {
+	capnp.Struct(s).SetBit(130, v)
 }
 
-// NewJoin sets the join field to a newly
-// allocated Join struct, preferring placement in s's segment.
-func (s Message) NewJoin() (Join, error) {
-	capnp.Struct(s).SetUint16(0, 12)
-	ss, err := NewJoin(capnp.Struct(s).Segment())
-	if err != nil {
-		return Join{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// The call parameters.  `params.content` is a struct whose fields correspond to the parameters of
+// the method.
+func (s Call) Params() (Payload, error) {
+	return capnp.GetStructField[Payload](s, 1, "Call.params")
 }
 
-// Message_List is a list of Message.
-type Message_List = capnp.StructList[Message]
+func (s Call) HasParams() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
 
-// NewMessage creates a new list of Message.
-func NewMessage_List(s *capnp.Segment, sz int32) (Message_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Message](l), err
+func (s Call) SetParams(v Payload) error {
+	return capnp.SetStructField(s, 1, v)
 }
 
-// Message_Future is a wrapper for a Message promised by a client call.
-type Message_Future struct{ *capnp.Future }
+// NewParams sets the params field to a newly
+// allocated Payload struct, preferring placement in s's segment.
+func (s Call) NewParams() (Payload, error) {
+	return capnp.NewStructField(s, 1, NewPayload)
+}
 
-func (f Message_Future) Struct() (Message, error) {
-	p, err := f.Future.Ptr()
-	return Message(p.Struct()), err
+// Where should the return message be sent?
+func (s Call) SendResultsTo() Call_sendResultsTo { return Call_sendResultsTo(s) }
+
+func (s Call_sendResultsTo) Which() Call_sendResultsTo_Which {
+	return Call_sendResultsTo_Which(capnp.Struct(s).Uint16(6))
 }
-func (p Message_Future) Unimplemented() Message_Future {
-	return Message_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Abort() Exception_Future {
-	return Exception_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Bootstrap() Bootstrap_Future {
-	return Bootstrap_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Call() Call_Future {
-	return Call_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Return() Return_Future {
-	return Return_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Finish() Finish_Future {
-	return Finish_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Resolve() Resolve_Future {
-	return Resolve_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Release() Release_Future {
-	return Release_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Disembargo() Disembargo_Future {
-	return Disembargo_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) ObsoleteSave() *capnp.Future {
-	return p.Future.Field(0, nil)
-}
-func (p Message_Future) ObsoleteDelete() *capnp.Future {
-	return p.Future.Field(0, nil)
-}
-func (p Message_Future) Provide() Provide_Future {
-	return Provide_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Accept() Accept_Future {
-	return Accept_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Message_Future) Join() Join_Future {
-	return Join_Future{Future: p.Future.Field(0, nil)}
+func (s Call_sendResultsTo) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-type Bootstrap capnp.Struct
-
-// Bootstrap_TypeID is the unique identifier for the type Bootstrap.
-const Bootstrap_TypeID = 0xe94ccf8031176ec4
-
-func NewBootstrap(s *capnp.Segment) (Bootstrap, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Bootstrap(st), err
+func (s Call_sendResultsTo) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func NewRootBootstrap(s *capnp.Segment) (Bootstrap, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Bootstrap(st), err
+func (s Call_sendResultsTo) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
+func (s Call_sendResultsTo) SetCaller() {
+	capnp.Struct(s).SetUint16(6, 0)
 
-func ReadRootBootstrap(msg *capnp.Message) (Bootstrap, error) {
-	root, err := msg.Root()
-	return Bootstrap(root.Struct()), err
 }
 
-func (s Bootstrap) String() string {
-	str, _ := text.Marshal(0xe94ccf8031176ec4, capnp.Struct(s))
-	return str
-}
+func (s Call_sendResultsTo) SetYourself() {
+	capnp.Struct(s).SetUint16(6, 1)
 
-func (s Bootstrap) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Bootstrap) DecodeFromPtr(p capnp.Ptr) Bootstrap {
-	return Bootstrap(capnp.Struct{}.DecodeFromPtr(p))
+// **(level 3)**
+//
+// The call's result should be returned to a different vat.  The receiver (the callee) expects
+// to receive an `Accept` message from the indicated vat, and should return the call's result
+// to it, rather than to the sender of the `Call`.
+//
+// This operates much like `yourself`, above, except that Carol is in a separate Vat C.  `Call`
+// messages are sent from Vat A -> Vat B and Vat B -> Vat C.  A `Return` message is sent from
+// Vat B -> Vat A that contains `acceptFromThirdParty` in place of results.  When Vat A sends
+// an `Accept` to Vat C, it receives back a `Return` containing the call's actual result.  Vat C
+// also sends a `Return` to Vat B with `resultsSentElsewhere`.
+func (s Call_sendResultsTo) ThirdParty() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(6) != 2 {
+		panic("Which() != thirdParty")
+	}
+	return capnp.Struct(s).FieldPtr(2, "Call.sendResultsTo.thirdParty")
 }
 
-func (s Bootstrap) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Bootstrap) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Call_sendResultsTo) HasThirdParty() bool {
+	if capnp.Struct(s).Uint16(6) != 2 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(2)
 }
 
-func (s Bootstrap) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Call_sendResultsTo) SetThirdParty(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(6, 2)
+	return capnp.Struct(s).SetPtr(2, v)
 }
 
-func (s Bootstrap) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Bootstrap) QuestionId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
+// Call_List is a list of Call.
+type Call_List = capnp.StructList[Call]
 
-func (s Bootstrap) SetQuestionId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+// NewCall creates a new list of Call.
+func NewCall_List(s *capnp.Segment, sz int32) (Call_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
+	return capnp.StructList[Call](l), err
 }
 
-func (s Bootstrap) DeprecatedObjectId() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
-}
+// Call_Future is a wrapper for a Call promised by a client call.
+type Call_Future struct{ *capnp.Future }
 
-func (s Bootstrap) HasDeprecatedObjectId() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (f Call_Future) Struct() (Call, error) {
+	p, err := f.Future.Ptr()
+	return Call(p.Struct()), err
 }
-
-func (s Bootstrap) SetDeprecatedObjectId(v capnp.Ptr) error {
-	return capnp.Struct(s).SetPtr(0, v)
+func (p Call_Future) Target() MessageTarget_Future {
+	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
 }
-
-// Bootstrap_List is a list of Bootstrap.
-type Bootstrap_List = capnp.StructList[Bootstrap]
-
-// NewBootstrap creates a new list of Bootstrap.
-func NewBootstrap_List(s *capnp.Segment, sz int32) (Bootstrap_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Bootstrap](l), err
+func (p Call_Future) Params() Payload_Future {
+	return Payload_Future{Future: p.Future.Field(1, nil)}
+}
+func (p Call_Future) SendResultsTo() Call_sendResultsTo_Future {
+	return Call_sendResultsTo_Future{p.Future}
 }
 
-// Bootstrap_Future is a wrapper for a Bootstrap promised by a client call.
-type Bootstrap_Future struct{ *capnp.Future }
+// Call_sendResultsTo_Future is a wrapper for a Call_sendResultsTo promised by a client call.
+type Call_sendResultsTo_Future struct{ *capnp.Future }
 
-func (f Bootstrap_Future) Struct() (Bootstrap, error) {
+func (f Call_sendResultsTo_Future) Struct() (Call_sendResultsTo, error) {
 	p, err := f.Future.Ptr()
-	return Bootstrap(p.Struct()), err
+	return Call_sendResultsTo(p.Struct()), err
 }
-func (p Bootstrap_Future) DeprecatedObjectId() *capnp.Future {
-	return p.Future.Field(0, nil)
+func (p Call_sendResultsTo_Future) ThirdParty() *capnp.Future {
+	return p.Future.Field(2, nil)
 }
 
-type Call capnp.Struct
-type Call_sendResultsTo Call
-type Call_sendResultsTo_Which uint16
+// **(level 1)**
+//
+// When an application-defined type contains an interface pointer, that pointer contains an index
+// into the message's capability table -- i.e. the `capTable` part of the `Payload`.  Each
+// capability in the table is represented as a `CapDescriptor`.  The runtime API should not reveal
+// the CapDescriptor directly to the application, but should instead wrap it in some kind of
+// callable object with methods corresponding to the interface that the capability implements.
+//
+// Keep in mind that `ExportIds` in a `CapDescriptor` are subject to reference counting.  See the
+// description of `ExportId`.
+//
+// Note that it is currently not possible to include a broken capability in the CapDescriptor
+// table.  Instead, create a new export (`senderPromise`) for each broken capability and then
+// immediately follow the payload-bearing Call or Return message with one Resolve message for each
+// broken capability, resolving it to an exception.
+type CapDescriptor capnp.Struct
+type CapDescriptor_Which uint16
 
 const (
-	Call_sendResultsTo_Which_caller     Call_sendResultsTo_Which = 0
-	Call_sendResultsTo_Which_yourself   Call_sendResultsTo_Which = 1
-	Call_sendResultsTo_Which_thirdParty Call_sendResultsTo_Which = 2
+	CapDescriptor_Which_none             CapDescriptor_Which = 0
+	CapDescriptor_Which_senderHosted     CapDescriptor_Which = 1
+	CapDescriptor_Which_senderPromise    CapDescriptor_Which = 2
+	CapDescriptor_Which_receiverHosted   CapDescriptor_Which = 3
+	CapDescriptor_Which_receiverAnswer   CapDescriptor_Which = 4
+	CapDescriptor_Which_thirdPartyHosted CapDescriptor_Which = 5
 )
 
-func (w Call_sendResultsTo_Which) String() string {
-	const s = "calleryourselfthirdParty"
+func (w CapDescriptor_Which) String() string {
+	const s = "nonesenderHostedsenderPromisereceiverHostedreceiverAnswerthirdPartyHosted"
 	switch w {
-	case Call_sendResultsTo_Which_caller:
-		return s[0:6]
-	case Call_sendResultsTo_Which_yourself:
-		return s[6:14]
-	case Call_sendResultsTo_Which_thirdParty:
-		return s[14:24]
+	case CapDescriptor_Which_none:
+		return s[0:4]
+	case CapDescriptor_Which_senderHosted:
+		return s[4:16]
+	case CapDescriptor_Which_senderPromise:
+		return s[16:29]
+	case CapDescriptor_Which_receiverHosted:
+		return s[29:43]
+	case CapDescriptor_Which_receiverAnswer:
+		return s[43:57]
+	case CapDescriptor_Which_thirdPartyHosted:
+		return s[57:73]
 
 	}
-	return "Call_sendResultsTo_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "CapDescriptor_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// Call_TypeID is the unique identifier for the type Call.
-const Call_TypeID = 0x836a53ce789d4cd4
+// CapDescriptor_Visitor has a method for each member of the union of
+// CapDescriptor, which Visit calls with the member's value.
+type CapDescriptor_Visitor interface {
+	VisitNone() error
+	VisitSenderHosted(uint32) error
+	VisitSenderPromise(uint32) error
+	VisitReceiverHosted(uint32) error
+	VisitReceiverAnswer(PromisedAnswer) error
+	VisitThirdPartyHosted(ThirdPartyCapDescriptor) error
+}
 
-func NewCall(s *capnp.Segment) (Call, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
-	return Call(st), err
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s CapDescriptor) Visit(v CapDescriptor_Visitor) error {
+	switch w := s.Which(); w {
+	case CapDescriptor_Which_none:
+		return v.VisitNone()
+	case CapDescriptor_Which_senderHosted:
+		return v.VisitSenderHosted(s.SenderHosted())
+	case CapDescriptor_Which_senderPromise:
+		return v.VisitSenderPromise(s.SenderPromise())
+	case CapDescriptor_Which_receiverHosted:
+		return v.VisitReceiverHosted(s.ReceiverHosted())
+	case CapDescriptor_Which_receiverAnswer:
+		x, err := s.ReceiverAnswer()
+		if err != nil {
+			return err
+		}
+		return v.VisitReceiverAnswer(x)
+	case CapDescriptor_Which_thirdPartyHosted:
+		x, err := s.ThirdPartyHosted()
+		if err != nil {
+			return err
+		}
+		return v.VisitThirdPartyHosted(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "CapDescriptor", Which: uint16(w)}
+	}
 }
 
-func NewRootCall(s *capnp.Segment) (Call, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
-	return Call(st), err
+// CapDescriptor_TypeID is the unique identifier for the type CapDescriptor.
+const CapDescriptor_TypeID = 0x8523ddc40b86b8b0
+
+func NewCapDescriptor(s *capnp.Segment) (CapDescriptor, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return CapDescriptor(st), err
 }
 
-func ReadRootCall(msg *capnp.Message) (Call, error) {
+func NewRootCapDescriptor(s *capnp.Segment) (CapDescriptor, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return CapDescriptor(st), err
+}
+
+func ReadRootCapDescriptor(msg *capnp.Message) (CapDescriptor, error) {
 	root, err := msg.Root()
-	return Call(root.Struct()), err
+	return CapDescriptor(root.Struct()), err
 }
 
-func (s Call) String() string {
-	str, _ := text.Marshal(0x836a53ce789d4cd4, capnp.Struct(s))
+func (s CapDescriptor) String() string {
+	str, _ := text.Marshal(0x8523ddc40b86b8b0, capnp.Struct(s))
 	return str
 }
 
-func (s Call) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CapDescriptor) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Call) DecodeFromPtr(p capnp.Ptr) Call {
-	return Call(capnp.Struct{}.DecodeFromPtr(p))
+func (CapDescriptor) DecodeFromPtr(p capnp.Ptr) CapDescriptor {
+	return CapDescriptor(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Call) ToPtr() capnp.Ptr {
+func (s CapDescriptor) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Call) IsValid() bool {
+
+func (s CapDescriptor) Which() CapDescriptor_Which {
+	return CapDescriptor_Which(capnp.Struct(s).Uint16(0))
+}
+func (s CapDescriptor) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Call) Message() *capnp.Message {
+func (s CapDescriptor) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Call) Segment() *capnp.Segment {
+func (s CapDescriptor) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Call) QuestionId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
+func (s CapDescriptor) SetNone() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-func (s Call) SetQuestionId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s Call) Target() (MessageTarget, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return MessageTarget(p.Struct()), err
+// The ID of a capability in the sender's export table (receiver's import table).  It may be a
+// newly allocated table entry, or an existing entry (increments the reference count).
+func (s CapDescriptor) SenderHosted() uint32 {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != senderHosted")
+	}
+	return capnp.Struct(s).Uint32(4)
 }
 
-func (s Call) HasTarget() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s CapDescriptor) SetSenderHosted(v uint32) {
+	capnp.Struct(s).SetUint16(0, 1)
+	capnp.Struct(s).SetUint32(4, v)
 }
 
-func (s Call) SetTarget(v MessageTarget) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// A promise that the sender will resolve later.  The sender will send exactly one Resolve
+// message at a future point in time to replace this promise.  Note that even if the same
+// `senderPromise` is received multiple times, only one `Resolve` is sent to cover all of
+// them.  If `senderPromise` is released before the `Resolve` is sent, the sender (of this
+// `CapDescriptor`) may choose not to send the `Resolve` at all.
+func (s CapDescriptor) SenderPromise() uint32 {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != senderPromise")
+	}
+	return capnp.Struct(s).Uint32(4)
 }
 
-// NewTarget sets the target field to a newly
-// allocated MessageTarget struct, preferring placement in s's segment.
-func (s Call) NewTarget() (MessageTarget, error) {
-	ss, err := NewMessageTarget(capnp.Struct(s).Segment())
-	if err != nil {
-		return MessageTarget{}, err
+func (s CapDescriptor) SetSenderPromise(v uint32) {
+	capnp.Struct(s).SetUint16(0, 2)
+	capnp.Struct(s).SetUint32(4, v)
+}
+
+// A capability (or promise) previously exported by the receiver (imported by the sender).
+func (s CapDescriptor) ReceiverHosted() uint32 {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != receiverHosted")
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.Struct(s).Uint32(4)
 }
 
-func (s Call) InterfaceId() uint64 {
-	return capnp.Struct(s).Uint64(8)
+func (s CapDescriptor) SetReceiverHosted(v uint32) {
+	capnp.Struct(s).SetUint16(0, 3)
+	capnp.Struct(s).SetUint32(4, v)
 }
 
-func (s Call) SetInterfaceId(v uint64) {
-	capnp.Struct(s).SetUint64(8, v)
+// A capability expected to be returned in the results of a currently-outstanding call posed
+// by the sender.
+func (s CapDescriptor) ReceiverAnswer() (PromisedAnswer, error) {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		panic("Which() != receiverAnswer")
+	}
+	return capnp.GetStructField[PromisedAnswer](s, 0, "CapDescriptor.receiverAnswer")
 }
 
-func (s Call) MethodId() uint16 {
-	return capnp.Struct(s).Uint16(4)
+func (s CapDescriptor) HasReceiverAnswer() bool {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Call) SetMethodId(v uint16) {
-	capnp.Struct(s).SetUint16(4, v)
+func (s CapDescriptor) SetReceiverAnswer(v PromisedAnswer) error {
+	capnp.Struct(s).SetUint16(0, 4)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Call) AllowThirdPartyTailCall() bool {
-	return capnp.Struct(s).Bit(128)
+// NewReceiverAnswer sets the receiverAnswer field to a newly
+// allocated PromisedAnswer struct, preferring placement in s's segment.
+func (s CapDescriptor) NewReceiverAnswer() (PromisedAnswer, error) {
+	capnp.Struct(s).SetUint16(0, 4)
+	return capnp.NewStructField(s, 0, NewPromisedAnswer)
 }
 
-func (s Call) SetAllowThirdPartyTailCall(v bool) {
-	capnp.Struct(s).SetBit(128, v)
+// **(level 3)**
+//
+// A capability that lives in neither the sender's nor the receiver's vat.  The sender needs
+// to form a direct connection to a third party to pick up the capability.
+//
+// Level 1 and 2 implementations that receive a `thirdPartyHosted` may simply send calls to its
+// `vine` instead.
+func (s CapDescriptor) ThirdPartyHosted() (ThirdPartyCapDescriptor, error) {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		panic("Which() != thirdPartyHosted")
+	}
+	return capnp.GetStructField[ThirdPartyCapDescriptor](s, 0, "CapDescriptor.thirdPartyHosted")
 }
 
-func (s Call) NoPromisePipelining() bool {
-	return capnp.Struct(s).Bit(129)
+func (s CapDescriptor) HasThirdPartyHosted() bool {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Call) SetNoPromisePipelining(v bool) {
-	capnp.Struct(s).SetBit(129, v)
+func (s CapDescriptor) SetThirdPartyHosted(v ThirdPartyCapDescriptor) error {
+	capnp.Struct(s).SetUint16(0, 5)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Call) OnlyPromisePipeline() bool {
-	return capnp.Struct(s).Bit(130)
+// NewThirdPartyHosted sets the thirdPartyHosted field to a newly
+// allocated ThirdPartyCapDescriptor struct, preferring placement in s's segment.
+func (s CapDescriptor) NewThirdPartyHosted() (ThirdPartyCapDescriptor, error) {
+	capnp.Struct(s).SetUint16(0, 5)
+	return capnp.NewStructField(s, 0, NewThirdPartyCapDescriptor)
+}
+
+// If the RPC message in which this CapDescriptor was delivered also had file descriptors
+// attached, and `fd` is a valid index into the list of attached file descriptors, then
+// that file descriptor should be attached to this capability. If `attachedFd` is out-of-bounds
+// for said list, then no FD is attached.
+//
+// For example, if the RPC message arrived over a Unix socket, then file descriptors may be
+// attached by sending an SCM_RIGHTS ancillary message attached to the data bytes making up the
+// raw message. Receivers who wish to opt into FD passing should arrange to receive SCM_RIGHTS
+// whenever receiving an RPC message. Senders who wish to send FDs need not verify whether the
+// receiver knows how to receive them, because the operating system will automatically discard
+// ancillary messages like SCM_RIGHTS if the receiver doesn't ask to receive them, including
+// automatically closing any FDs.
+//
+// It is up to the application protocol to define what capabilities are expected to have file
+// descriptors attached, and what those FDs mean. But, for example, an application could use this
+// to open a file on disk and then transmit the open file descriptor to a sandboxed process that
+// does not otherwise have permission to access the filesystem directly. This is usually an
+// optimization: the sending process could instead provide an RPC interface supporting all the
+// operations needed (such as reading and writing a file), but by passing the file descriptor
+// directly, the recipient can often perform operations much more efficiently. Application
+// designers are encouraged to provide such RPC interfaces and automatically fall back to them
+// when FD passing is not available, so that the application can still work when the parties are
+// remote over a network.
+//
+// An attached FD is most often associated with a `senderHosted` descriptor. It could also make
+// sense in the case of `thirdPartyHosted`: in this case, the sender is forwarding the FD that
+// they received from the third party, so that the receiver can start using it without first
+// interacting with the third party. This is an optional optimization -- the middleman may choose
+// not to forward capabilities, in which case the receiver will need to complete the handshake
+// with the third party directly before receiving the FD. If an implementation receives a second
+// attached FD after having already received one previously (e.g. both in a `thirdPartyHosted`
+// CapDescriptor and then later again when receiving the final capability directly from the
+// third party), the implementation should discard the later FD and stick with the original. At
+// present, there is no known reason why other capability types (e.g. `receiverHosted`) would want
+// to carry an attached FD, but we reserve the right to define a meaning for this in the future.
+//
+// Each file descriptor attached to the message must be used in no more than one CapDescriptor,
+// so that the receiver does not need to use dup() or refcounting to handle the possibility of
+// multiple capabilities using the same descriptor. If multiple CapDescriptors do point to the
+// same FD index, then the receiver can arbitrarily choose which capability ends up having the
+// FD attached.
+//
+// To mitigate DoS attacks, RPC implementations should limit the number of FDs they are willing to
+// receive in a single message to a small value. If a message happens to contain more than that,
+// the list is truncated. Moreover, in some cases, FD passing needs to be blocked entirely for
+// security or implementation reasons, in which case the list may be truncated to zero. Hence,
+// `attachedFd` might point past the end of the list, which the implementation should treat as if
+// no FD was attached at all.
+//
+// The type of this field was chosen to be UInt8 because Linux supports sending only a maximum
+// of 253 file descriptors in an SCM_RIGHTS message anyway, and CapDescriptor had two bytes of
+// padding left -- so after adding this, there is still one byte for a future feature.
+// Conveniently, this also means we're able to use 0xff as the default value, which will always
+// be out-of-range (of course, the implementation should explicitly enforce that 255 descriptors
+// cannot be sent at once, rather than relying on Linux to do so).
+func (s CapDescriptor) AttachedFd() uint8 {
+	return capnp.Struct(s).Uint8(2) ^ 255
 }
 
-func (s Call) SetOnlyPromisePipeline(v bool) {
-	capnp.Struct(s).SetBit(130, v)
+func (s CapDescriptor) SetAttachedFd(v uint8) {
+	capnp.Struct(s).SetUint8(2, v^255)
 }
 
-func (s Call) Params() (Payload, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Payload(p.Struct()), err
+// CapDescriptor_List is a list of CapDescriptor.
+type CapDescriptor_List = capnp.StructList[CapDescriptor]
+
+// NewCapDescriptor creates a new list of CapDescriptor.
+func NewCapDescriptor_List(s *capnp.Segment, sz int32) (CapDescriptor_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[CapDescriptor](l), err
 }
 
-func (s Call) HasParams() bool {
-	return capnp.Struct(s).HasPtr(1)
+// CapDescriptor_Future is a wrapper for a CapDescriptor promised by a client call.
+type CapDescriptor_Future struct{ *capnp.Future }
+
+func (f CapDescriptor_Future) Struct() (CapDescriptor, error) {
+	p, err := f.Future.Ptr()
+	return CapDescriptor(p.Struct()), err
+}
+func (p CapDescriptor_Future) ReceiverAnswer() PromisedAnswer_Future {
+	return PromisedAnswer_Future{Future: p.Future.Field(0, nil)}
+}
+func (p CapDescriptor_Future) ThirdPartyHosted() ThirdPartyCapDescriptor_Future {
+	return ThirdPartyCapDescriptor_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Call) SetParams(v Payload) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+// **(level 1)**
+//
+// Message sent to indicate that an embargo on a recently-resolved promise may now be lifted.
+//
+// Embargos are used to enforce E-order in the presence of promise resolution.  That is, if an
+// application makes two calls foo() and bar() on the same capability reference, in that order,
+// the calls should be delivered in the order in which they were made.  But if foo() is called
+// on a promise, and that promise happens to resolve before bar() is called, then the two calls
+// may travel different paths over the network, and thus could arrive in the wrong order.  In
+// this case, the call to `bar()` must be embargoed, and a `Disembargo` message must be sent along
+// the same path as `foo()` to ensure that the `Disembargo` arrives after `foo()`.  Once the
+// `Disembargo` arrives, `bar()` can then be delivered.
+//
+// There are two particular cases where embargos are important.  Consider object Alice, in Vat A,
+// who holds a promise P, pointing towards Vat B, that eventually resolves to Carol.  The two
+// cases are:
+//   - Carol lives in Vat A, i.e. next to Alice.  In this case, Vat A needs to send a `Disembargo`
+//     message that echos through Vat B and back, to ensure that all pipelined calls on the promise
+//     have been delivered.
+//   - Carol lives in a different Vat C.  When the promise resolves, a three-party handoff occurs
+//     (see `Provide` and `Accept`, which constitute level 3 of the protocol).  In this case, we
+//     piggyback on the state that has already been set up to handle the handoff:  the `Accept`
+//     message (from Vat A to Vat C) is embargoed, as are all pipelined messages sent to it, while
+//     a `Disembargo` message is sent from Vat A through Vat B to Vat C.  See `Accept.embargo` for
+//     an example.
+//
+// Note that in the case where Carol actually lives in Vat B (i.e., the same vat that the promise
+// already pointed at), no embargo is needed, because the pipelined calls are delivered over the
+// same path as the later direct calls.
+//
+// Keep in mind that promise resolution happens both in the form of Resolve messages as well as
+// Return messages (which resolve PromisedAnswers). Embargos apply in both cases.
+//
+// An alternative strategy for enforcing E-order over promise resolution could be for Vat A to
+// implement the embargo internally.  When Vat A is notified of promise resolution, it could
+// send a dummy no-op call to promise P and wait for it to complete.  Until that call completes,
+// all calls to the capability are queued locally.  This strategy works, but is pessimistic:
+// in the three-party case, it requires an A -> B -> C -> B -> A round trip before calls can start
+// being delivered directly to from Vat A to Vat C.  The `Disembargo` message allows latency to be
+// reduced.  (In the two-party loopback case, the `Disembargo` message is just a more explicit way
+// of accomplishing the same thing as a no-op call, but isn't any faster.)
+//
+// *The Tribble 4-way Race Condition*
+//
+// Any implementation of promise resolution and embargos must be aware of what we call the
+// "Tribble 4-way race condition", after Dean Tribble, who explained the problem in a lively
+// Friam meeting.
+//
+// Embargos are designed to work in the case where a two-hop path is being shortened to one hop.
+// But sometimes there are more hops. Imagine that Alice has a reference to a remote promise P1
+// that eventually resolves to _another_ remote promise P2 (in a third vat), which _at the same
+// time_ happens to resolve to Bob (in a fourth vat). In this case, we're shortening from a 3-hop
+// path (with four parties) to a 1-hop path (Alice -> Bob).
+//
+// Extending the embargo/disembargo protocol to be able to shorted multiple hops at once seems
+// difficult. Instead, we make a rule that prevents this case from coming up:
+//
+// One a promise P has been resolved to a remote object reference R, then all further messages
+// received addressed to P will be forwarded strictly to R. Even if it turns out later that R is
+// itself a promise, and has resolved to some other object Q, messages sent to P will still be
+// forwarded to R, not directly to Q (R will of course further forward the messages to Q).
+//
+// This rule does not cause a significant performance burden because once P has resolved to R, it
+// is expected that people sending messages to P will shortly start sending them to R instead and
+// drop P. P is at end-of-life anyway, so it doesn't matter if it ignores chances to further
+// optimize its path.
+//
+// Note well: the Tribble 4-way race condition does not require each vat to be *distinct*; as long
+// as each resolution crosses a network boundary the race can occur -- so this concerns even level
+// 1 implementations, not just level 3 implementations.
+type Disembargo capnp.Struct
+type Disembargo_context Disembargo
+type Disembargo_context_Which uint16
+
+const (
+	Disembargo_context_Which_senderLoopback   Disembargo_context_Which = 0
+	Disembargo_context_Which_receiverLoopback Disembargo_context_Which = 1
+	Disembargo_context_Which_accept           Disembargo_context_Which = 2
+	Disembargo_context_Which_provide          Disembargo_context_Which = 3
+)
+
+func (w Disembargo_context_Which) String() string {
+	const s = "senderLoopbackreceiverLoopbackacceptprovide"
+	switch w {
+	case Disembargo_context_Which_senderLoopback:
+		return s[0:14]
+	case Disembargo_context_Which_receiverLoopback:
+		return s[14:30]
+	case Disembargo_context_Which_accept:
+		return s[30:36]
+	case Disembargo_context_Which_provide:
+		return s[36:43]
+
+	}
+	return "Disembargo_context_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// NewParams sets the params field to a newly
-// allocated Payload struct, preferring placement in s's segment.
-func (s Call) NewParams() (Payload, error) {
-	ss, err := NewPayload(capnp.Struct(s).Segment())
-	if err != nil {
-		return Payload{}, err
+// Disembargo_context_Visitor has a method for each member of the union of
+// Disembargo_context, which Visit calls with the member's value.
+type Disembargo_context_Visitor interface {
+	VisitSenderLoopback(uint32) error
+	VisitReceiverLoopback(uint32) error
+	VisitAccept() error
+	VisitProvide(uint32) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Disembargo_context) Visit(v Disembargo_context_Visitor) error {
+	switch w := s.Which(); w {
+	case Disembargo_context_Which_senderLoopback:
+		return v.VisitSenderLoopback(s.SenderLoopback())
+	case Disembargo_context_Which_receiverLoopback:
+		return v.VisitReceiverLoopback(s.ReceiverLoopback())
+	case Disembargo_context_Which_accept:
+		return v.VisitAccept()
+	case Disembargo_context_Which_provide:
+		return v.VisitProvide(s.Provide())
+	default:
+		return &capnp.UnknownMemberError{Union: "Disembargo.context", Which: uint16(w)}
 	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
 }
 
-func (s Call) SendResultsTo() Call_sendResultsTo { return Call_sendResultsTo(s) }
+// Disembargo_TypeID is the unique identifier for the type Disembargo.
+const Disembargo_TypeID = 0xf964368b0fbd3711
 
-func (s Call_sendResultsTo) Which() Call_sendResultsTo_Which {
-	return Call_sendResultsTo_Which(capnp.Struct(s).Uint16(6))
+func NewDisembargo(s *capnp.Segment) (Disembargo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Disembargo(st), err
 }
-func (s Call_sendResultsTo) IsValid() bool {
+
+func NewRootDisembargo(s *capnp.Segment) (Disembargo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Disembargo(st), err
+}
+
+func ReadRootDisembargo(msg *capnp.Message) (Disembargo, error) {
+	root, err := msg.Root()
+	return Disembargo(root.Struct()), err
+}
+
+func (s Disembargo) String() string {
+	str, _ := text.Marshal(0xf964368b0fbd3711, capnp.Struct(s))
+	return str
+}
+
+func (s Disembargo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Disembargo) DecodeFromPtr(p capnp.Ptr) Disembargo {
+	return Disembargo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Disembargo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Disembargo) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Call_sendResultsTo) Message() *capnp.Message {
+func (s Disembargo) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Call_sendResultsTo) Segment() *capnp.Segment {
+func (s Disembargo) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Call_sendResultsTo) SetCaller() {
-	capnp.Struct(s).SetUint16(6, 0)
 
+// What is to be disembargoed.
+func (s Disembargo) Target() (MessageTarget, error) {
+	return capnp.GetStructField[MessageTarget](s, 0, "Disembargo.target")
 }
 
-func (s Call_sendResultsTo) SetYourself() {
-	capnp.Struct(s).SetUint16(6, 1)
+func (s Disembargo) HasTarget() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Disembargo) SetTarget(v MessageTarget) error {
+	return capnp.SetStructField(s, 0, v)
+}
 
+// NewTarget sets the target field to a newly
+// allocated MessageTarget struct, preferring placement in s's segment.
+func (s Disembargo) NewTarget() (MessageTarget, error) {
+	return capnp.NewStructField(s, 0, NewMessageTarget)
 }
 
-func (s Call_sendResultsTo) ThirdParty() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(6) != 2 {
-		panic("Which() != thirdParty")
+func (s Disembargo) Context() Disembargo_context { return Disembargo_context(s) }
+
+func (s Disembargo_context) Which() Disembargo_context_Which {
+	return Disembargo_context_Which(capnp.Struct(s).Uint16(4))
+}
+func (s Disembargo_context) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Disembargo_context) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Disembargo_context) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// The sender is requesting a disembargo on a promise that is known to resolve back to a
+// capability hosted by the sender.  As soon as the receiver has echoed back all pipelined calls
+// on this promise, it will deliver the Disembargo back to the sender with `receiverLoopback`
+// set to the same value as `senderLoopback`.  This value is chosen by the sender, and since
+// it is also consumed be the sender, the sender can use whatever strategy it wants to make sure
+// the value is unambiguous.
+//
+// The receiver must verify that the target capability actually resolves back to the sender's
+// vat.  Otherwise, the sender has committed a protocol error and should be disconnected.
+func (s Disembargo_context) SenderLoopback() uint32 {
+	if capnp.Struct(s).Uint16(4) != 0 {
+		panic("Which() != senderLoopback")
 	}
-	return capnp.Struct(s).Ptr(2)
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s Call_sendResultsTo) HasThirdParty() bool {
-	if capnp.Struct(s).Uint16(6) != 2 {
-		return false
+func (s Disembargo_context) SetSenderLoopback(v uint32) {
+	capnp.Struct(s).SetUint16(4, 0)
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// The receiver previously sent a `senderLoopback` Disembargo towards a promise resolving to
+// this capability, and that Disembargo is now being echoed back.
+func (s Disembargo_context) ReceiverLoopback() uint32 {
+	if capnp.Struct(s).Uint16(4) != 1 {
+		panic("Which() != receiverLoopback")
 	}
-	return capnp.Struct(s).HasPtr(2)
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Disembargo_context) SetReceiverLoopback(v uint32) {
+	capnp.Struct(s).SetUint16(4, 1)
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+func (s Disembargo_context) SetAccept() {
+	capnp.Struct(s).SetUint16(4, 2)
+
+}
+
+// **(level 3)**
+//
+// The sender is requesting a disembargo on a capability currently being provided to a third
+// party.  The question ID identifies the `Provide` message previously sent by the sender to
+// this capability.  On receipt, the receiver (the capability host) shall release the embargo
+// on the `Accept` message that it has received from the third party.  See `Accept.embargo` for
+// an example.
+func (s Disembargo_context) Provide() uint32 {
+	if capnp.Struct(s).Uint16(4) != 3 {
+		panic("Which() != provide")
+	}
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Disembargo_context) SetProvide(v uint32) {
+	capnp.Struct(s).SetUint16(4, 3)
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// Disembargo_List is a list of Disembargo.
+type Disembargo_List = capnp.StructList[Disembargo]
+
+// NewDisembargo creates a new list of Disembargo.
+func NewDisembargo_List(s *capnp.Segment, sz int32) (Disembargo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Disembargo](l), err
+}
+
+// Disembargo_Future is a wrapper for a Disembargo promised by a client call.
+type Disembargo_Future struct{ *capnp.Future }
+
+func (f Disembargo_Future) Struct() (Disembargo, error) {
+	p, err := f.Future.Ptr()
+	return Disembargo(p.Struct()), err
+}
+func (p Disembargo_Future) Target() MessageTarget_Future {
+	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Disembargo_Future) Context() Disembargo_context_Future {
+	return Disembargo_context_Future{p.Future}
+}
+
+// Disembargo_context_Future is a wrapper for a Disembargo_context promised by a client call.
+type Disembargo_context_Future struct{ *capnp.Future }
+
+func (f Disembargo_context_Future) Struct() (Disembargo_context, error) {
+	p, err := f.Future.Ptr()
+	return Disembargo_context(p.Struct()), err
+}
+
+// **(level 0)**
+//
+// Describes an arbitrary error that prevented an operation (e.g. a call) from completing.
+//
+// Cap'n Proto exceptions always indicate that something went wrong. In other words, in a fantasy
+// world where everything always works as expected, no exceptions would ever be thrown. Clients
+// should only ever catch exceptions as a means to implement fault-tolerance, where "fault" can
+// mean:
+// - Bugs.
+// - Invalid input.
+// - Configuration errors.
+// - Network problems.
+// - Insufficient resources.
+// - Version skew (unimplemented functionality).
+// - Other logistical problems.
+//
+// Exceptions should NOT be used to flag application-specific conditions that a client is expected
+// to handle in an application-specific way. Put another way, in the Cap'n Proto world,
+// "checked exceptions" (where an interface explicitly defines the exceptions it throws and
+// clients are forced by the type system to handle those exceptions) do NOT make sense.
+type Exception capnp.Struct
+
+// Exception_TypeID is the unique identifier for the type Exception.
+const Exception_TypeID = 0xd625b7063acf691a
+
+func NewException(s *capnp.Segment) (Exception, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Exception(st), err
+}
+
+func NewRootException(s *capnp.Segment) (Exception, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Exception(st), err
+}
+
+func ReadRootException(msg *capnp.Message) (Exception, error) {
+	root, err := msg.Root()
+	return Exception(root.Struct()), err
+}
+
+func (s Exception) String() string {
+	str, _ := text.Marshal(0xd625b7063acf691a, capnp.Struct(s))
+	return str
+}
+
+func (s Exception) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Exception) DecodeFromPtr(p capnp.Ptr) Exception {
+	return Exception(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Exception) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Exception) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Exception) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Exception) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Human-readable failure description.
+func (s Exception) Reason() (string, error) {
+	return capnp.GetTextField(s, 0, "Exception.reason")
+}
+
+func (s Exception) HasReason() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Exception) ReasonBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Exception.reason")
+}
+
+func (s Exception) SetReason(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// The type of the error. The purpose of this enum is not to describe the error itself, but
+// rather to describe how the client might want to respond to the error.
+func (s Exception) Type() Exception_Type {
+	return Exception_Type(capnp.Struct(s).Uint16(4))
+}
+
+func (s Exception) SetType(v Exception_Type) {
+	capnp.Struct(s).SetUint16(4, uint16(v))
+}
+
+// OBSOLETE. Ignore.
+func (s Exception) ObsoleteIsCallersFault() bool {
+	return capnp.Struct(s).Bit(0)
+}
+
+func (s Exception) SetObsoleteIsCallersFault(v bool) {
+	capnp.Struct(s).SetBit(0, v)
+}
+
+// OBSOLETE. See `type` instead.
+func (s Exception) ObsoleteDurability() uint16 {
+	return capnp.Struct(s).Uint16(2)
+}
+
+func (s Exception) SetObsoleteDurability(v uint16) {
+	capnp.Struct(s).SetUint16(2, v)
+}
+
+// Stack trace text from the remote server. The format is not specified. By default,
+// implementations do not provide stack traces; the application must explicitly enable them
+// when desired.
+func (s Exception) Trace() (string, error) {
+	return capnp.GetTextField(s, 1, "Exception.trace")
+}
+
+func (s Exception) HasTrace() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Exception) TraceBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 1, "Exception.trace")
+}
+
+func (s Exception) SetTrace(v string) error {
+	return capnp.Struct(s).SetText(1, v)
+}
+
+// Application-defined structured details about the error. Each detail is tagged with an ID
+// chosen by the application (typically the type ID of the struct it contains) so that the
+// receiver can recognize the payloads it understands and ignore the rest.
+func (s Exception) Details() (Exception_Detail_List, error) {
+	return capnp.GetListField[Exception_Detail_List](s, 2, "Exception.details")
+}
+
+func (s Exception) HasDetails() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
+
+func (s Exception) SetDetails(v Exception_Detail_List) error {
+	return capnp.SetListField(s, 2, v)
+}
+
+// NewDetails sets the details field to a newly
+// allocated Exception_Detail_List, preferring placement in s's segment.
+func (s Exception) NewDetails(n int32) (Exception_Detail_List, error) {
+	return capnp.NewListField(s, 2, n, NewException_Detail_List)
+}
+
+// Exception_List is a list of Exception.
+type Exception_List = capnp.StructList[Exception]
+
+// NewException creates a new list of Exception.
+func NewException_List(s *capnp.Segment, sz int32) (Exception_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[Exception](l), err
+}
+
+// Exception_Future is a wrapper for a Exception promised by a client call.
+type Exception_Future struct{ *capnp.Future }
+
+func (f Exception_Future) Struct() (Exception, error) {
+	p, err := f.Future.Ptr()
+	return Exception(p.Struct()), err
+}
+
+type Exception_Detail capnp.Struct
+
+// Exception_Detail_TypeID is the unique identifier for the type Exception_Detail.
+const Exception_Detail_TypeID = 0xd6c14f121d44f8dd
+
+func NewException_Detail(s *capnp.Segment) (Exception_Detail, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Exception_Detail(st), err
+}
+
+func NewRootException_Detail(s *capnp.Segment) (Exception_Detail, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Exception_Detail(st), err
+}
+
+func ReadRootException_Detail(msg *capnp.Message) (Exception_Detail, error) {
+	root, err := msg.Root()
+	return Exception_Detail(root.Struct()), err
+}
+
+func (s Exception_Detail) String() string {
+	str, _ := text.Marshal(0xd6c14f121d44f8dd, capnp.Struct(s))
+	return str
+}
+
+func (s Exception_Detail) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Exception_Detail) DecodeFromPtr(p capnp.Ptr) Exception_Detail {
+	return Exception_Detail(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Exception_Detail) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Exception_Detail) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Exception_Detail) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Exception_Detail) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Identifies the kind of detail. Usually the type ID of the struct stored in `value`.
+func (s Exception_Detail) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Exception_Detail) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// The detail payload. For struct details, this is a canonical single-segment message whose
+// root is the struct.
+func (s Exception_Detail) Value() ([]byte, error) {
+	return capnp.GetDataField(s, 0, "Exception.Detail.value")
+}
+
+func (s Exception_Detail) HasValue() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Exception_Detail) SetValue(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// ValueReader returns a reader of value's data, which
+// is read directly from the message.
+func (s Exception_Detail) ValueReader() (*bytes.Reader, error) {
+	v, err := s.Value()
+	return bytes.NewReader(v), err
+}
+
+// SetValueFromReader sets value to the next size bytes of r,
+// which are read directly into the message.
+func (s Exception_Detail) SetValueFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
+}
+
+// Exception_Detail_List is a list of Exception_Detail.
+type Exception_Detail_List = capnp.StructList[Exception_Detail]
+
+// NewException_Detail creates a new list of Exception_Detail.
+func NewException_Detail_List(s *capnp.Segment, sz int32) (Exception_Detail_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Exception_Detail](l), err
+}
+
+// Exception_Detail_Future is a wrapper for a Exception_Detail promised by a client call.
+type Exception_Detail_Future struct{ *capnp.Future }
+
+func (f Exception_Detail_Future) Struct() (Exception_Detail, error) {
+	p, err := f.Future.Ptr()
+	return Exception_Detail(p.Struct()), err
+}
+
+type Exception_Type uint16
+
+// Exception_Type_TypeID is the unique identifier for the type Exception_Type.
+const Exception_Type_TypeID = 0xb28c96e23f4cbd58
+
+// Values of Exception_Type.
+const (
+	// A generic problem occurred, and it is believed that if the operation were repeated without
+	// any change in the state of the world, the problem would occur again.
+	//
+	// A client might respond to this error by logging it for investigation by the developer and/or
+	// displaying it to the user.
+	Exception_Type_failed Exception_Type = 0
+	// The request was rejected due to a temporary lack of resources.
+	//
+	// Examples include:
+	// - There's not enough CPU time to keep up with incoming requests, so some are rejected.
+	// - The server ran out of RAM or disk space during the request.
+	// - The operation timed out (took significantly longer than it should have).
+	//
+	// A client might respond to this error by scheduling to retry the operation much later. The
+	// client should NOT retry again immediately since this would likely exacerbate the problem.
+	Exception_Type_overloaded Exception_Type = 1
+	// The method failed because a connection to some necessary capability was lost.
+	//
+	// Examples include:
+	// - The client introduced the server to a third-party capability, the connection to that third
+	//   party was subsequently lost, and then the client requested that the server use the dead
+	//   capability for something.
+	// - The client previously requested that the server obtain a capability from some third party.
+	//   The server returned a capability to an object wrapping the third-party capability. Later,
+	//   the server's connection to the third party was lost.
+	// - The capability has been revoked. Revocation does not necessarily mean that the client is
+	//   no longer authorized to use the capability; it is often used simply as a way to force the
+	//   client to repeat the setup process, perhaps to efficiently move them to a new back-end or
+	//   get them to recognize some other change that has occurred.
+	//
+	// A client should normally respond to this error by releasing all capabilities it is currently
+	// holding related to the one it called and then re-creating them by restoring SturdyRefs and/or
+	// repeating the method calls used to create them originally. In other words, disconnect and
+	// start over. This should in turn cause the server to obtain a new copy of the capability that
+	// it lost, thus making everything work.
+	//
+	// If the client receives another `disconnected` error in the process of rebuilding the
+	// capability and retrying the call, it should treat this as an `overloaded` error: the network
+	// is currently unreliable, possibly due to load or other temporary issues.
+	Exception_Type_disconnected Exception_Type = 2
+	// The server doesn't implement the requested method. If there is some other method that the
+	// client could call (perhaps an older and/or slower interface), it should try that instead.
+	// Otherwise, this should be treated like `failed`.
+	Exception_Type_unimplemented Exception_Type = 3
+)
+
+// String returns the enum's constant name.
+func (c Exception_Type) String() string {
+	switch c {
+	case Exception_Type_failed:
+		return "failed"
+	case Exception_Type_overloaded:
+		return "overloaded"
+	case Exception_Type_disconnected:
+		return "disconnected"
+	case Exception_Type_unimplemented:
+		return "unimplemented"
+
+	default:
+		return ""
+	}
+}
+
+// Exception_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Exception_TypeFromString(c string) Exception_Type {
+	switch c {
+	case "failed":
+		return Exception_Type_failed
+	case "overloaded":
+		return Exception_Type_overloaded
+	case "disconnected":
+		return Exception_Type_disconnected
+	case "unimplemented":
+		return Exception_Type_unimplemented
+
+	default:
+		return 0
+	}
+}
+
+type Exception_Type_List = capnp.EnumList[Exception_Type]
+
+func NewException_Type_List(s *capnp.Segment, sz int32) (Exception_Type_List, error) {
+	return capnp.NewEnumList[Exception_Type](s, sz)
+}
+
+// **(level 0)**
+//
+// Message type sent from the caller to the callee to indicate:
+//  1. The questionId will no longer be used in any messages sent by the callee (no further
+//     pipelined requests).
+//  2. If the call has not returned yet, the caller no longer cares about the result.  If nothing
+//     else cares about the result either (e.g. there are no other outstanding calls pipelined on
+//     the result of this one) then the callee may wish to immediately cancel the operation and
+//     send back a Return message with "canceled" set.  However, implementations are not required
+//     to support premature cancellation -- instead, the implementation may wait until the call
+//     actually completes and send a normal `Return` message.
+//
+// TODO(someday): Should we separate (1) and implicitly releasing result capabilities?  It would be
+//
+//	possible and useful to notify the server that it doesn't need to keep around the response to
+//	service pipeline requests even though the caller still wants to receive it / hasn't yet
+//	finished processing it.  It could also be useful to notify the server that it need not marshal
+//	the results because the caller doesn't want them anyway, even if the caller is still sending
+//	pipelined calls, although this seems less useful (just saving some bytes on the wire).
+type Finish capnp.Struct
+
+// Finish_TypeID is the unique identifier for the type Finish.
+const Finish_TypeID = 0xd37d2eb2c2f80e63
+
+func NewFinish(s *capnp.Segment) (Finish, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Finish(st), err
+}
+
+func NewRootFinish(s *capnp.Segment) (Finish, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Finish(st), err
+}
+
+func ReadRootFinish(msg *capnp.Message) (Finish, error) {
+	root, err := msg.Root()
+	return Finish(root.Struct()), err
+}
+
+func (s Finish) String() string {
+	str, _ := text.Marshal(0xd37d2eb2c2f80e63, capnp.Struct(s))
+	return str
+}
+
+func (s Finish) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Finish) DecodeFromPtr(p capnp.Ptr) Finish {
+	return Finish(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Finish) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Finish) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Finish) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Finish) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// ID of the call whose result is to be released.
+func (s Finish) QuestionId() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Finish) SetQuestionId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// If true, all capabilities that were in the results should be considered released.  The sender
+// must not send separate `Release` messages for them.  Level 0 implementations in particular
+// should always set this true.  This defaults true because if level 0 implementations forget to
+// set it they'll never notice (just silently leak caps), but if level >=1 implementations forget
+// set it false they'll quickly get errors.
+func (s Finish) ReleaseResultCaps() bool {
+	return !capnp.Struct(s).Bit(32)
+}
+
+func (s Finish) SetReleaseResultCaps(v bool) {
+	capnp.Struct(s).SetBit(32, !v)
+}
+
+// If true, if the RPC system receives this Finish message before the original call has even been
+// delivered, it should defer cancellation util after delivery. In particular, this gives the
+// destination object a chance to opt out of cancellation, e.g. as controlled by the
+// `allowCancellation` annotation defined in `c++.capnp`.
+//
+// This is a work-around. Versions 1.0 and up of Cap'n Proto always set this to false. However,
+// older versions of Cap'n Proto unintentionally exhibited this errant behavior by default, and
+// as a result programs built with older versions could be inadvertently relying on their peers
+// to implement the behavior. The purpose of this flag is to let newer versions know when the
+// peer is an older version, so that it can attempt to work around the issue.
+//
+// See also comments in handleFinish() in rpc.c++ for more details.
+func (s Finish) RequireEarlyCancellationWorkaround() bool {
+	return !capnp.Struct(s).Bit(33)
+}
+
+func (s Finish) SetRequireEarlyCancellationWorkaround(v bool) {
+	capnp.Struct(s).SetBit(33, !v)
+}
+
+// Finish_List is a list of Finish.
+type Finish_List = capnp.StructList[Finish]
+
+// NewFinish creates a new list of Finish.
+func NewFinish_List(s *capnp.Segment, sz int32) (Finish_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Finish](l), err
+}
+
+// Finish_Future is a wrapper for a Finish promised by a client call.
+type Finish_Future struct{ *capnp.Future }
+
+func (f Finish_Future) Struct() (Finish, error) {
+	p, err := f.Future.Ptr()
+	return Finish(p.Struct()), err
+}
+
+// **(level 4)**
+//
+// Message type sent to implement E.join(), which, given a number of capabilities that are
+// expected to be equivalent, finds the underlying object upon which they all agree and forms a
+// direct connection to it, skipping any proxies that may have been constructed by other vats
+// while transmitting the capability.  See:
+//
+//	http://erights.org/elib/equality/index.html
+//
+// Note that this should only serve to bypass fully-transparent proxies -- proxies that were
+// created merely for convenience, without any intention of hiding the underlying object.
+//
+// For example, say Bob holds two capabilities hosted by Alice and Carol, but he expects that both
+// are simply proxies for a capability hosted elsewhere.  He then issues a join request, which
+// operates as follows:
+//   - Bob issues Join requests on both Alice and Carol.  Each request contains a different piece
+//     of the JoinKey.
+//   - Alice is proxying a capability hosted by Dana, so forwards the request to Dana's cap.
+//   - Dana receives the first request and sees that the JoinKeyPart is one of two.  She notes that
+//     she doesn't have the other part yet, so she records the request and responds with a
+//     JoinResult.
+//   - Alice relays the JoinAnswer back to Bob.
+//   - Carol is also proxying a capability from Dana, and so forwards her Join request to Dana as
+//     well.
+//   - Dana receives Carol's request and notes that she now has both parts of a JoinKey.  She
+//     combines them in order to form information needed to form a secure connection to Bob.  She
+//     also responds with another JoinResult.
+//   - Bob receives the responses from Alice and Carol.  He uses the returned JoinResults to
+//     determine how to connect to Dana and attempts to form the connection.  Since Bob and Dana now
+//     agree on a secret key that neither Alice nor Carol ever saw, this connection can be made
+//     securely even if Alice or Carol is conspiring against the other.  (If Alice and Carol are
+//     conspiring _together_, they can obviously reproduce the key, but this doesn't matter because
+//     the whole point of the join is to verify that Alice and Carol agree on what capability they
+//     are proxying.)
+//
+// If the two capabilities aren't actually proxies of the same object, then the join requests
+// will come back with conflicting `hostId`s and the join will fail before attempting to form any
+// connection.
+type Join capnp.Struct
+
+// Join_TypeID is the unique identifier for the type Join.
+const Join_TypeID = 0xfbe1980490e001af
+
+func NewJoin(s *capnp.Segment) (Join, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Join(st), err
+}
+
+func NewRootJoin(s *capnp.Segment) (Join, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Join(st), err
+}
+
+func ReadRootJoin(msg *capnp.Message) (Join, error) {
+	root, err := msg.Root()
+	return Join(root.Struct()), err
+}
+
+func (s Join) String() string {
+	str, _ := text.Marshal(0xfbe1980490e001af, capnp.Struct(s))
+	return str
+}
+
+func (s Join) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Join) DecodeFromPtr(p capnp.Ptr) Join {
+	return Join(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Join) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Join) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Join) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Join) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Question ID used to respond to this Join.  (Note that this ID only identifies one part of the
+// request for one hop; each part has a different ID and relayed copies of the request have
+// (probably) different IDs still.)
+//
+// The receiver will reply with a `Return` whose `results` is a JoinResult.  This `JoinResult`
+// is relayed from the joined object's host, possibly with transformation applied as needed
+// by the network.
+//
+// Like any return, the result must be released using a `Finish`.  However, this release
+// should not occur until the joiner has either successfully connected to the joined object.
+// Vats relaying a `Join` message similarly must not release the result they receive until the
+// return they relayed back towards the joiner has itself been released.  This allows the
+// joined object's host to detect when the Join operation is canceled before completing -- if
+// it receives a `Finish` for one of the join results before the joiner successfully
+// connects.  It can then free any resources it had allocated as part of the join.
+func (s Join) QuestionId() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Join) SetQuestionId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// The capability to join.
+func (s Join) Target() (MessageTarget, error) {
+	return capnp.GetStructField[MessageTarget](s, 0, "Join.target")
+}
+
+func (s Join) HasTarget() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Join) SetTarget(v MessageTarget) error {
+	return capnp.SetStructField(s, 0, v)
+}
+
+// NewTarget sets the target field to a newly
+// allocated MessageTarget struct, preferring placement in s's segment.
+func (s Join) NewTarget() (MessageTarget, error) {
+	return capnp.NewStructField(s, 0, NewMessageTarget)
+}
+
+// A part of the join key.  These combine to form the complete join key, which is used to establish
+// a direct connection.
+func (s Join) KeyPart() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(1, "Join.keyPart")
+}
+
+func (s Join) HasKeyPart() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s Call_sendResultsTo) SetThirdParty(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(6, 2)
-	return capnp.Struct(s).SetPtr(2, v)
+func (s Join) SetKeyPart(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(1, v)
 }
 
-// Call_List is a list of Call.
-type Call_List = capnp.StructList[Call]
+// Join_List is a list of Join.
+type Join_List = capnp.StructList[Join]
 
-// NewCall creates a new list of Call.
-func NewCall_List(s *capnp.Segment, sz int32) (Call_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
-	return capnp.StructList[Call](l), err
+// NewJoin creates a new list of Join.
+func NewJoin_List(s *capnp.Segment, sz int32) (Join_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Join](l), err
 }
 
-// Call_Future is a wrapper for a Call promised by a client call.
-type Call_Future struct{ *capnp.Future }
+// Join_Future is a wrapper for a Join promised by a client call.
+type Join_Future struct{ *capnp.Future }
 
-func (f Call_Future) Struct() (Call, error) {
+func (f Join_Future) Struct() (Join, error) {
 	p, err := f.Future.Ptr()
-	return Call(p.Struct()), err
+	return Join(p.Struct()), err
 }
-func (p Call_Future) Target() MessageTarget_Future {
+func (p Join_Future) Target() MessageTarget_Future {
 	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
 }
-func (p Call_Future) Params() Payload_Future {
-	return Payload_Future{Future: p.Future.Field(1, nil)}
-}
-func (p Call_Future) SendResultsTo() Call_sendResultsTo_Future {
-	return Call_sendResultsTo_Future{p.Future}
-}
-
-// Call_sendResultsTo_Future is a wrapper for a Call_sendResultsTo promised by a client call.
-type Call_sendResultsTo_Future struct{ *capnp.Future }
-
-func (f Call_sendResultsTo_Future) Struct() (Call_sendResultsTo, error) {
-	p, err := f.Future.Ptr()
-	return Call_sendResultsTo(p.Struct()), err
-}
-func (p Call_sendResultsTo_Future) ThirdParty() *capnp.Future {
-	return p.Future.Field(2, nil)
+func (p Join_Future) KeyPart() *capnp.Future {
+	return p.Future.Field(1, nil)
 }
 
-type Return capnp.Struct
-type Return_Which uint16
+// An RPC connection is a bi-directional stream of Messages.
+type Message capnp.Struct
+type Message_Which uint16
 
 const (
-	Return_Which_results               Return_Which = 0
-	Return_Which_exception             Return_Which = 1
-	Return_Which_canceled              Return_Which = 2
-	Return_Which_resultsSentElsewhere  Return_Which = 3
-	Return_Which_takeFromOtherQuestion Return_Which = 4
-	Return_Which_acceptFromThirdParty  Return_Which = 5
+	Message_Which_unimplemented  Message_Which = 0
+	Message_Which_abort          Message_Which = 1
+	Message_Which_bootstrap      Message_Which = 8
+	Message_Which_call           Message_Which = 2
+	Message_Which_return         Message_Which = 3
+	Message_Which_finish         Message_Which = 4
+	Message_Which_resolve        Message_Which = 5
+	Message_Which_release        Message_Which = 6
+	Message_Which_disembargo     Message_Which = 13
+	Message_Which_obsoleteSave   Message_Which = 7
+	Message_Which_obsoleteDelete Message_Which = 9
+	Message_Which_provide        Message_Which = 10
+	Message_Which_accept         Message_Which = 11
+	Message_Which_join           Message_Which = 12
 )
 
-func (w Return_Which) String() string {
-	const s = "resultsexceptioncanceledresultsSentElsewheretakeFromOtherQuestionacceptFromThirdParty"
+func (w Message_Which) String() string {
+	const s = "unimplementedabortbootstrapcallreturnfinishresolvereleasedisembargoobsoleteSaveobsoleteDeleteprovideacceptjoin"
 	switch w {
-	case Return_Which_results:
-		return s[0:7]
-	case Return_Which_exception:
-		return s[7:16]
-	case Return_Which_canceled:
-		return s[16:24]
-	case Return_Which_resultsSentElsewhere:
-		return s[24:44]
-	case Return_Which_takeFromOtherQuestion:
-		return s[44:65]
-	case Return_Which_acceptFromThirdParty:
-		return s[65:85]
+	case Message_Which_unimplemented:
+		return s[0:13]
+	case Message_Which_abort:
+		return s[13:18]
+	case Message_Which_bootstrap:
+		return s[18:27]
+	case Message_Which_call:
+		return s[27:31]
+	case Message_Which_return:
+		return s[31:37]
+	case Message_Which_finish:
+		return s[37:43]
+	case Message_Which_resolve:
+		return s[43:50]
+	case Message_Which_release:
+		return s[50:57]
+	case Message_Which_disembargo:
+		return s[57:67]
+	case Message_Which_obsoleteSave:
+		return s[67:79]
+	case Message_Which_obsoleteDelete:
+		return s[79:93]
+	case Message_Which_provide:
+		return s[93:100]
+	case Message_Which_accept:
+		return s[100:106]
+	case Message_Which_join:
+		return s[106:110]
 
 	}
-	return "Return_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "Message_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// Return_TypeID is the unique identifier for the type Return.
-const Return_TypeID = 0x9e19b28d3db3573a
+// Message_Visitor has a method for each member of the union of
+// Message, which Visit calls with the member's value.
+type Message_Visitor interface {
+	VisitUnimplemented(Message) error
+	VisitAbort(Exception) error
+	VisitBootstrap(Bootstrap) error
+	VisitCall(Call) error
+	VisitReturn(Return) error
+	VisitFinish(Finish) error
+	VisitResolve(Resolve) error
+	VisitRelease(Release) error
+	VisitDisembargo(Disembargo) error
+	VisitObsoleteSave(capnp.Ptr) error
+	VisitObsoleteDelete(capnp.Ptr) error
+	VisitProvide(Provide) error
+	VisitAccept(Accept) error
+	VisitJoin(Join) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Message) Visit(v Message_Visitor) error {
+	switch w := s.Which(); w {
+	case Message_Which_unimplemented:
+		x, err := s.Unimplemented()
+		if err != nil {
+			return err
+		}
+		return v.VisitUnimplemented(x)
+	case Message_Which_abort:
+		x, err := s.Abort()
+		if err != nil {
+			return err
+		}
+		return v.VisitAbort(x)
+	case Message_Which_bootstrap:
+		x, err := s.Bootstrap()
+		if err != nil {
+			return err
+		}
+		return v.VisitBootstrap(x)
+	case Message_Which_call:
+		x, err := s.Call()
+		if err != nil {
+			return err
+		}
+		return v.VisitCall(x)
+	case Message_Which_return:
+		x, err := s.Return()
+		if err != nil {
+			return err
+		}
+		return v.VisitReturn(x)
+	case Message_Which_finish:
+		x, err := s.Finish()
+		if err != nil {
+			return err
+		}
+		return v.VisitFinish(x)
+	case Message_Which_resolve:
+		x, err := s.Resolve()
+		if err != nil {
+			return err
+		}
+		return v.VisitResolve(x)
+	case Message_Which_release:
+		x, err := s.Release()
+		if err != nil {
+			return err
+		}
+		return v.VisitRelease(x)
+	case Message_Which_disembargo:
+		x, err := s.Disembargo()
+		if err != nil {
+			return err
+		}
+		return v.VisitDisembargo(x)
+	case Message_Which_obsoleteSave:
+		x, err := s.ObsoleteSave()
+		if err != nil {
+			return err
+		}
+		return v.VisitObsoleteSave(x)
+	case Message_Which_obsoleteDelete:
+		x, err := s.ObsoleteDelete()
+		if err != nil {
+			return err
+		}
+		return v.VisitObsoleteDelete(x)
+	case Message_Which_provide:
+		x, err := s.Provide()
+		if err != nil {
+			return err
+		}
+		return v.VisitProvide(x)
+	case Message_Which_accept:
+		x, err := s.Accept()
+		if err != nil {
+			return err
+		}
+		return v.VisitAccept(x)
+	case Message_Which_join:
+		x, err := s.Join()
+		if err != nil {
+			return err
+		}
+		return v.VisitJoin(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Message", Which: uint16(w)}
+	}
+}
 
-func NewReturn(s *capnp.Segment) (Return, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return Return(st), err
+// Message_TypeID is the unique identifier for the type Message.
+const Message_TypeID = 0x91b79f1f808db032
+
+func NewMessage(s *capnp.Segment) (Message, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Message(st), err
 }
 
-func NewRootReturn(s *capnp.Segment) (Return, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return Return(st), err
+func NewRootMessage(s *capnp.Segment) (Message, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Message(st), err
 }
 
-func ReadRootReturn(msg *capnp.Message) (Return, error) {
+func ReadRootMessage(msg *capnp.Message) (Message, error) {
 	root, err := msg.Root()
-	return Return(root.Struct()), err
+	return Message(root.Struct()), err
 }
 
-func (s Return) String() string {
-	str, _ := text.Marshal(0x9e19b28d3db3573a, capnp.Struct(s))
+func (s Message) String() string {
+	str, _ := text.Marshal(0x91b79f1f808db032, capnp.Struct(s))
 	return str
 }
 
-func (s Return) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Message) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Return) DecodeFromPtr(p capnp.Ptr) Return {
-	return Return(capnp.Struct{}.DecodeFromPtr(p))
+func (Message) DecodeFromPtr(p capnp.Ptr) Message {
+	return Message(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Return) ToPtr() capnp.Ptr {
+func (s Message) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s Return) Which() Return_Which {
-	return Return_Which(capnp.Struct(s).Uint16(6))
+func (s Message) Which() Message_Which {
+	return Message_Which(capnp.Struct(s).Uint16(0))
 }
-func (s Return) IsValid() bool {
+func (s Message) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Return) Message() *capnp.Message {
+func (s Message) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Return) Segment() *capnp.Segment {
+func (s Message) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Return) AnswerId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
-
-func (s Return) SetAnswerId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
-}
-
-func (s Return) ReleaseParamCaps() bool {
-	return !capnp.Struct(s).Bit(32)
-}
-
-func (s Return) SetReleaseParamCaps(v bool) {
-	capnp.Struct(s).SetBit(32, !v)
-}
-
-func (s Return) NoFinishNeeded() bool {
-	return capnp.Struct(s).Bit(33)
-}
-
-func (s Return) SetNoFinishNeeded(v bool) {
-	capnp.Struct(s).SetBit(33, v)
-}
 
-func (s Return) Results() (Payload, error) {
-	if capnp.Struct(s).Uint16(6) != 0 {
-		panic("Which() != results")
+// The sender previously received this message from the peer but didn't understand it or doesn't
+// yet implement the functionality that was requested.  So, the sender is echoing the message
+// back.  In some cases, the receiver may be able to recover from this by pretending the sender
+// had taken some appropriate "null" action.
+//
+// For example, say `resolve` is received by a level 0 implementation (because a previous call
+// or return happened to contain a promise).  The level 0 implementation will echo it back as
+// `unimplemented`.  The original sender can then simply release the cap to which the promise
+// had resolved, thus avoiding a leak.
+//
+// For any message type that introduces a question, if the message comes back unimplemented,
+// the original sender may simply treat it as if the question failed with an exception.
+//
+// In cases where there is no sensible way to react to an `unimplemented` message (without
+// resource leaks or other serious problems), the connection may need to be aborted.  This is
+// a gray area; different implementations may take different approaches.
+func (s Message) Unimplemented() (Message, error) {
+	if capnp.Struct(s).Uint16(0) != 0 {
+		panic("Which() != unimplemented")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Payload(p.Struct()), err
+	return capnp.GetStructField[Message](s, 0, "Message.unimplemented")
 }
 
-func (s Return) HasResults() bool {
-	if capnp.Struct(s).Uint16(6) != 0 {
+func (s Message) HasUnimplemented() bool {
+	if capnp.Struct(s).Uint16(0) != 0 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Return) SetResults(v Payload) error {
-	capnp.Struct(s).SetUint16(6, 0)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Message) SetUnimplemented(v Message) error {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewResults sets the results field to a newly
-// allocated Payload struct, preferring placement in s's segment.
-func (s Return) NewResults() (Payload, error) {
-	capnp.Struct(s).SetUint16(6, 0)
-	ss, err := NewPayload(capnp.Struct(s).Segment())
-	if err != nil {
-		return Payload{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewUnimplemented sets the unimplemented field to a newly
+// allocated Message struct, preferring placement in s's segment.
+func (s Message) NewUnimplemented() (Message, error) {
+	capnp.Struct(s).SetUint16(0, 0)
+	return capnp.NewStructField(s, 0, NewMessage)
 }
 
-func (s Return) Exception() (Exception, error) {
-	if capnp.Struct(s).Uint16(6) != 1 {
-		panic("Which() != exception")
+// Sent when a connection is being aborted due to an unrecoverable error.  This could be e.g.
+// because the sender received an invalid or nonsensical message or because the sender had an
+// internal error.  The sender will shut down the outgoing half of the connection after `abort`
+// and will completely close the connection shortly thereafter (it's up to the sender how much
+// of a time buffer they want to offer for the client to receive the `abort` before the
+// connection is reset).
+func (s Message) Abort() (Exception, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != abort")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Exception(p.Struct()), err
+	return capnp.GetStructField[Exception](s, 0, "Message.abort")
 }
 
-func (s Return) HasException() bool {
-	if capnp.Struct(s).Uint16(6) != 1 {
+func (s Message) HasAbort() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Return) SetException(v Exception) error {
-	capnp.Struct(s).SetUint16(6, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Message) SetAbort(v Exception) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewException sets the exception field to a newly
+// NewAbort sets the abort field to a newly
 // allocated Exception struct, preferring placement in s's segment.
-func (s Return) NewException() (Exception, error) {
-	capnp.Struct(s).SetUint16(6, 1)
-	ss, err := NewException(capnp.Struct(s).Segment())
-	if err != nil {
-		return Exception{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-func (s Return) SetCanceled() {
-	capnp.Struct(s).SetUint16(6, 2)
-
+func (s Message) NewAbort() (Exception, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.NewStructField(s, 0, NewException)
 }
 
-func (s Return) SetResultsSentElsewhere() {
-	capnp.Struct(s).SetUint16(6, 3)
-
+// Request the peer's bootstrap interface.
+func (s Message) Bootstrap() (Bootstrap, error) {
+	if capnp.Struct(s).Uint16(0) != 8 {
+		panic("Which() != bootstrap")
+	}
+	return capnp.GetStructField[Bootstrap](s, 0, "Message.bootstrap")
 }
 
-func (s Return) TakeFromOtherQuestion() uint32 {
-	if capnp.Struct(s).Uint16(6) != 4 {
-		panic("Which() != takeFromOtherQuestion")
+func (s Message) HasBootstrap() bool {
+	if capnp.Struct(s).Uint16(0) != 8 {
+		return false
 	}
-	return capnp.Struct(s).Uint32(8)
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Return) SetTakeFromOtherQuestion(v uint32) {
-	capnp.Struct(s).SetUint16(6, 4)
-	capnp.Struct(s).SetUint32(8, v)
+func (s Message) SetBootstrap(v Bootstrap) error {
+	capnp.Struct(s).SetUint16(0, 8)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Return) AcceptFromThirdParty() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(6) != 5 {
-		panic("Which() != acceptFromThirdParty")
+// NewBootstrap sets the bootstrap field to a newly
+// allocated Bootstrap struct, preferring placement in s's segment.
+func (s Message) NewBootstrap() (Bootstrap, error) {
+	capnp.Struct(s).SetUint16(0, 8)
+	return capnp.NewStructField(s, 0, NewBootstrap)
+}
+
+// Begin a method call.
+func (s Message) Call() (Call, error) {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != call")
 	}
-	return capnp.Struct(s).Ptr(0)
+	return capnp.GetStructField[Call](s, 0, "Message.call")
 }
 
-func (s Return) HasAcceptFromThirdParty() bool {
-	if capnp.Struct(s).Uint16(6) != 5 {
+func (s Message) HasCall() bool {
+	if capnp.Struct(s).Uint16(0) != 2 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Return) SetAcceptFromThirdParty(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(6, 5)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Message) SetCall(v Call) error {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Return_List is a list of Return.
-type Return_List = capnp.StructList[Return]
-
-// NewReturn creates a new list of Return.
-func NewReturn_List(s *capnp.Segment, sz int32) (Return_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
-	return capnp.StructList[Return](l), err
+// NewCall sets the call field to a newly
+// allocated Call struct, preferring placement in s's segment.
+func (s Message) NewCall() (Call, error) {
+	capnp.Struct(s).SetUint16(0, 2)
+	return capnp.NewStructField(s, 0, NewCall)
 }
 
-// Return_Future is a wrapper for a Return promised by a client call.
-type Return_Future struct{ *capnp.Future }
-
-func (f Return_Future) Struct() (Return, error) {
-	p, err := f.Future.Ptr()
-	return Return(p.Struct()), err
-}
-func (p Return_Future) Results() Payload_Future {
-	return Payload_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Return_Future) Exception() Exception_Future {
-	return Exception_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Return_Future) AcceptFromThirdParty() *capnp.Future {
-	return p.Future.Field(0, nil)
+// Complete a method call.
+func (s Message) Return() (Return, error) {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != return")
+	}
+	return capnp.GetStructField[Return](s, 0, "Message.return")
 }
 
-type Finish capnp.Struct
-
-// Finish_TypeID is the unique identifier for the type Finish.
-const Finish_TypeID = 0xd37d2eb2c2f80e63
-
-func NewFinish(s *capnp.Segment) (Finish, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Finish(st), err
+func (s Message) HasReturn() bool {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func NewRootFinish(s *capnp.Segment) (Finish, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Finish(st), err
+func (s Message) SetReturn(v Return) error {
+	capnp.Struct(s).SetUint16(0, 3)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func ReadRootFinish(msg *capnp.Message) (Finish, error) {
-	root, err := msg.Root()
-	return Finish(root.Struct()), err
+// NewReturn sets the return field to a newly
+// allocated Return struct, preferring placement in s's segment.
+func (s Message) NewReturn() (Return, error) {
+	capnp.Struct(s).SetUint16(0, 3)
+	return capnp.NewStructField(s, 0, NewReturn)
 }
 
-func (s Finish) String() string {
-	str, _ := text.Marshal(0xd37d2eb2c2f80e63, capnp.Struct(s))
-	return str
+// Release a returned answer / cancel a call.
+func (s Message) Finish() (Finish, error) {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		panic("Which() != finish")
+	}
+	return capnp.GetStructField[Finish](s, 0, "Message.finish")
 }
 
-func (s Finish) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Message) HasFinish() bool {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (Finish) DecodeFromPtr(p capnp.Ptr) Finish {
-	return Finish(capnp.Struct{}.DecodeFromPtr(p))
+func (s Message) SetFinish(v Finish) error {
+	capnp.Struct(s).SetUint16(0, 4)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Finish) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Finish) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+// NewFinish sets the finish field to a newly
+// allocated Finish struct, preferring placement in s's segment.
+func (s Message) NewFinish() (Finish, error) {
+	capnp.Struct(s).SetUint16(0, 4)
+	return capnp.NewStructField(s, 0, NewFinish)
 }
 
-func (s Finish) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Resolve a previously-sent promise.
+func (s Message) Resolve() (Resolve, error) {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		panic("Which() != resolve")
+	}
+	return capnp.GetStructField[Resolve](s, 0, "Message.resolve")
 }
 
-func (s Finish) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Finish) QuestionId() uint32 {
-	return capnp.Struct(s).Uint32(0)
+func (s Message) HasResolve() bool {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Finish) SetQuestionId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+func (s Message) SetResolve(v Resolve) error {
+	capnp.Struct(s).SetUint16(0, 5)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Finish) ReleaseResultCaps() bool {
-	return !capnp.Struct(s).Bit(32)
+// NewResolve sets the resolve field to a newly
+// allocated Resolve struct, preferring placement in s's segment.
+func (s Message) NewResolve() (Resolve, error) {
+	capnp.Struct(s).SetUint16(0, 5)
+	return capnp.NewStructField(s, 0, NewResolve)
 }
 
-func (s Finish) SetReleaseResultCaps(v bool) {
-	capnp.Struct(s).SetBit(32, !v)
+// Release a capability so that the remote object can be deallocated.
+func (s Message) Release() (Release, error) {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		panic("Which() != release")
+	}
+	return capnp.GetStructField[Release](s, 0, "Message.release")
 }
 
-func (s Finish) RequireEarlyCancellationWorkaround() bool {
-	return !capnp.Struct(s).Bit(33)
+func (s Message) HasRelease() bool {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Finish) SetRequireEarlyCancellationWorkaround(v bool) {
-	capnp.Struct(s).SetBit(33, !v)
+func (s Message) SetRelease(v Release) error {
+	capnp.Struct(s).SetUint16(0, 6)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Finish_List is a list of Finish.
-type Finish_List = capnp.StructList[Finish]
-
-// NewFinish creates a new list of Finish.
-func NewFinish_List(s *capnp.Segment, sz int32) (Finish_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[Finish](l), err
+// NewRelease sets the release field to a newly
+// allocated Release struct, preferring placement in s's segment.
+func (s Message) NewRelease() (Release, error) {
+	capnp.Struct(s).SetUint16(0, 6)
+	return capnp.NewStructField(s, 0, NewRelease)
 }
 
-// Finish_Future is a wrapper for a Finish promised by a client call.
-type Finish_Future struct{ *capnp.Future }
-
-func (f Finish_Future) Struct() (Finish, error) {
-	p, err := f.Future.Ptr()
-	return Finish(p.Struct()), err
+// Lift an embargo used to enforce E-order over promise resolution.
+func (s Message) Disembargo() (Disembargo, error) {
+	if capnp.Struct(s).Uint16(0) != 13 {
+		panic("Which() != disembargo")
+	}
+	return capnp.GetStructField[Disembargo](s, 0, "Message.disembargo")
 }
 
-type Resolve capnp.Struct
-type Resolve_Which uint16
-
-const (
-	Resolve_Which_cap       Resolve_Which = 0
-	Resolve_Which_exception Resolve_Which = 1
-)
-
-func (w Resolve_Which) String() string {
-	const s = "capexception"
-	switch w {
-	case Resolve_Which_cap:
-		return s[0:3]
-	case Resolve_Which_exception:
-		return s[3:12]
-
+func (s Message) HasDisembargo() bool {
+	if capnp.Struct(s).Uint16(0) != 13 {
+		return false
 	}
-	return "Resolve_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// Resolve_TypeID is the unique identifier for the type Resolve.
-const Resolve_TypeID = 0xbbc29655fa89086e
-
-func NewResolve(s *capnp.Segment) (Resolve, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Resolve(st), err
+func (s Message) SetDisembargo(v Disembargo) error {
+	capnp.Struct(s).SetUint16(0, 13)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func NewRootResolve(s *capnp.Segment) (Resolve, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Resolve(st), err
+// NewDisembargo sets the disembargo field to a newly
+// allocated Disembargo struct, preferring placement in s's segment.
+func (s Message) NewDisembargo() (Disembargo, error) {
+	capnp.Struct(s).SetUint16(0, 13)
+	return capnp.NewStructField(s, 0, NewDisembargo)
 }
 
-func ReadRootResolve(msg *capnp.Message) (Resolve, error) {
-	root, err := msg.Root()
-	return Resolve(root.Struct()), err
+// Obsolete request to save a capability, resulting in a SturdyRef. This has been replaced
+// by the `Persistent` interface defined in `persistent.capnp`. This operation was never
+// implemented.
+func (s Message) ObsoleteSave() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(0) != 7 {
+		panic("Which() != obsoleteSave")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Message.obsoleteSave")
 }
 
-func (s Resolve) String() string {
-	str, _ := text.Marshal(0xbbc29655fa89086e, capnp.Struct(s))
-	return str
+func (s Message) HasObsoleteSave() bool {
+	if capnp.Struct(s).Uint16(0) != 7 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Resolve) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Message) SetObsoleteSave(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(0, 7)
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-func (Resolve) DecodeFromPtr(p capnp.Ptr) Resolve {
-	return Resolve(capnp.Struct{}.DecodeFromPtr(p))
+// Obsolete way to delete a SturdyRef. This operation was never implemented.
+func (s Message) ObsoleteDelete() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(0) != 9 {
+		panic("Which() != obsoleteDelete")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Message.obsoleteDelete")
 }
 
-func (s Resolve) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (s Message) HasObsoleteDelete() bool {
+	if capnp.Struct(s).Uint16(0) != 9 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Resolve) Which() Resolve_Which {
-	return Resolve_Which(capnp.Struct(s).Uint16(4))
-}
-func (s Resolve) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Message) SetObsoleteDelete(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(0, 9)
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-func (s Resolve) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Provide a capability to a third party.
+func (s Message) Provide() (Provide, error) {
+	if capnp.Struct(s).Uint16(0) != 10 {
+		panic("Which() != provide")
+	}
+	return capnp.GetStructField[Provide](s, 0, "Message.provide")
 }
 
-func (s Resolve) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Message) HasProvide() bool {
+	if capnp.Struct(s).Uint16(0) != 10 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
-func (s Resolve) PromiseId() uint32 {
-	return capnp.Struct(s).Uint32(0)
+
+func (s Message) SetProvide(v Provide) error {
+	capnp.Struct(s).SetUint16(0, 10)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Resolve) SetPromiseId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+// NewProvide sets the provide field to a newly
+// allocated Provide struct, preferring placement in s's segment.
+func (s Message) NewProvide() (Provide, error) {
+	capnp.Struct(s).SetUint16(0, 10)
+	return capnp.NewStructField(s, 0, NewProvide)
 }
 
-func (s Resolve) Cap() (CapDescriptor, error) {
-	if capnp.Struct(s).Uint16(4) != 0 {
-		panic("Which() != cap")
+// Accept a capability provided by a third party.
+func (s Message) Accept() (Accept, error) {
+	if capnp.Struct(s).Uint16(0) != 11 {
+		panic("Which() != accept")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return CapDescriptor(p.Struct()), err
+	return capnp.GetStructField[Accept](s, 0, "Message.accept")
 }
 
-func (s Resolve) HasCap() bool {
-	if capnp.Struct(s).Uint16(4) != 0 {
+func (s Message) HasAccept() bool {
+	if capnp.Struct(s).Uint16(0) != 11 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Resolve) SetCap(v CapDescriptor) error {
-	capnp.Struct(s).SetUint16(4, 0)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Message) SetAccept(v Accept) error {
+	capnp.Struct(s).SetUint16(0, 11)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewCap sets the cap field to a newly
-// allocated CapDescriptor struct, preferring placement in s's segment.
-func (s Resolve) NewCap() (CapDescriptor, error) {
-	capnp.Struct(s).SetUint16(4, 0)
-	ss, err := NewCapDescriptor(capnp.Struct(s).Segment())
-	if err != nil {
-		return CapDescriptor{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewAccept sets the accept field to a newly
+// allocated Accept struct, preferring placement in s's segment.
+func (s Message) NewAccept() (Accept, error) {
+	capnp.Struct(s).SetUint16(0, 11)
+	return capnp.NewStructField(s, 0, NewAccept)
 }
 
-func (s Resolve) Exception() (Exception, error) {
-	if capnp.Struct(s).Uint16(4) != 1 {
-		panic("Which() != exception")
+// Directly connect to the common root of two or more proxied caps.
+func (s Message) Join() (Join, error) {
+	if capnp.Struct(s).Uint16(0) != 12 {
+		panic("Which() != join")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Exception(p.Struct()), err
+	return capnp.GetStructField[Join](s, 0, "Message.join")
 }
 
-func (s Resolve) HasException() bool {
-	if capnp.Struct(s).Uint16(4) != 1 {
+func (s Message) HasJoin() bool {
+	if capnp.Struct(s).Uint16(0) != 12 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Resolve) SetException(v Exception) error {
-	capnp.Struct(s).SetUint16(4, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Message) SetJoin(v Join) error {
+	capnp.Struct(s).SetUint16(0, 12)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewException sets the exception field to a newly
-// allocated Exception struct, preferring placement in s's segment.
-func (s Resolve) NewException() (Exception, error) {
-	capnp.Struct(s).SetUint16(4, 1)
-	ss, err := NewException(capnp.Struct(s).Segment())
-	if err != nil {
-		return Exception{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewJoin sets the join field to a newly
+// allocated Join struct, preferring placement in s's segment.
+func (s Message) NewJoin() (Join, error) {
+	capnp.Struct(s).SetUint16(0, 12)
+	return capnp.NewStructField(s, 0, NewJoin)
 }
 
-// Resolve_List is a list of Resolve.
-type Resolve_List = capnp.StructList[Resolve]
+// Message_List is a list of Message.
+type Message_List = capnp.StructList[Message]
 
-// NewResolve creates a new list of Resolve.
-func NewResolve_List(s *capnp.Segment, sz int32) (Resolve_List, error) {
+// NewMessage creates a new list of Message.
+func NewMessage_List(s *capnp.Segment, sz int32) (Message_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Resolve](l), err
+	return capnp.StructList[Message](l), err
 }
 
-// Resolve_Future is a wrapper for a Resolve promised by a client call.
-type Resolve_Future struct{ *capnp.Future }
+// Message_Future is a wrapper for a Message promised by a client call.
+type Message_Future struct{ *capnp.Future }
 
-func (f Resolve_Future) Struct() (Resolve, error) {
+func (f Message_Future) Struct() (Message, error) {
 	p, err := f.Future.Ptr()
-	return Resolve(p.Struct()), err
+	return Message(p.Struct()), err
 }
-func (p Resolve_Future) Cap() CapDescriptor_Future {
-	return CapDescriptor_Future{Future: p.Future.Field(0, nil)}
+func (p Message_Future) Unimplemented() Message_Future {
+	return Message_Future{Future: p.Future.Field(0, nil)}
 }
-func (p Resolve_Future) Exception() Exception_Future {
+func (p Message_Future) Abort() Exception_Future {
 	return Exception_Future{Future: p.Future.Field(0, nil)}
 }
-
-type Release capnp.Struct
-
-// Release_TypeID is the unique identifier for the type Release.
-const Release_TypeID = 0xad1a6c0d7dd07497
-
-func NewRelease(s *capnp.Segment) (Release, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Release(st), err
-}
-
-func NewRootRelease(s *capnp.Segment) (Release, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Release(st), err
-}
-
-func ReadRootRelease(msg *capnp.Message) (Release, error) {
-	root, err := msg.Root()
-	return Release(root.Struct()), err
-}
-
-func (s Release) String() string {
-	str, _ := text.Marshal(0xad1a6c0d7dd07497, capnp.Struct(s))
-	return str
-}
-
-func (s Release) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (p Message_Future) Bootstrap() Bootstrap_Future {
+	return Bootstrap_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (Release) DecodeFromPtr(p capnp.Ptr) Release {
-	return Release(capnp.Struct{}.DecodeFromPtr(p))
+func (p Message_Future) Call() Call_Future {
+	return Call_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (s Release) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (p Message_Future) Return() Return_Future {
+	return Return_Future{Future: p.Future.Field(0, nil)}
 }
-func (s Release) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (p Message_Future) Finish() Finish_Future {
+	return Finish_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (s Release) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (p Message_Future) Resolve() Resolve_Future {
+	return Resolve_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (s Release) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (p Message_Future) Release() Release_Future {
+	return Release_Future{Future: p.Future.Field(0, nil)}
 }
-func (s Release) Id() uint32 {
-	return capnp.Struct(s).Uint32(0)
+func (p Message_Future) Disembargo() Disembargo_Future {
+	return Disembargo_Future{Future: p.Future.Field(0, nil)}
 }
-
-func (s Release) SetId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
+func (p Message_Future) ObsoleteSave() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
-
-func (s Release) ReferenceCount() uint32 {
-	return capnp.Struct(s).Uint32(4)
+func (p Message_Future) ObsoleteDelete() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
-
-func (s Release) SetReferenceCount(v uint32) {
-	capnp.Struct(s).SetUint32(4, v)
+func (p Message_Future) Provide() Provide_Future {
+	return Provide_Future{Future: p.Future.Field(0, nil)}
 }
-
-// Release_List is a list of Release.
-type Release_List = capnp.StructList[Release]
-
-// NewRelease creates a new list of Release.
-func NewRelease_List(s *capnp.Segment, sz int32) (Release_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[Release](l), err
+func (p Message_Future) Accept() Accept_Future {
+	return Accept_Future{Future: p.Future.Field(0, nil)}
 }
-
-// Release_Future is a wrapper for a Release promised by a client call.
-type Release_Future struct{ *capnp.Future }
-
-func (f Release_Future) Struct() (Release, error) {
-	p, err := f.Future.Ptr()
-	return Release(p.Struct()), err
+func (p Message_Future) Join() Join_Future {
+	return Join_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Disembargo capnp.Struct
-type Disembargo_context Disembargo
-type Disembargo_context_Which uint16
+// The target of a `Call` or other messages that target a capability.
+type MessageTarget capnp.Struct
+type MessageTarget_Which uint16
 
 const (
-	Disembargo_context_Which_senderLoopback   Disembargo_context_Which = 0
-	Disembargo_context_Which_receiverLoopback Disembargo_context_Which = 1
-	Disembargo_context_Which_accept           Disembargo_context_Which = 2
-	Disembargo_context_Which_provide          Disembargo_context_Which = 3
+	MessageTarget_Which_importedCap    MessageTarget_Which = 0
+	MessageTarget_Which_promisedAnswer MessageTarget_Which = 1
 )
 
-func (w Disembargo_context_Which) String() string {
-	const s = "senderLoopbackreceiverLoopbackacceptprovide"
+func (w MessageTarget_Which) String() string {
+	const s = "importedCappromisedAnswer"
 	switch w {
-	case Disembargo_context_Which_senderLoopback:
-		return s[0:14]
-	case Disembargo_context_Which_receiverLoopback:
-		return s[14:30]
-	case Disembargo_context_Which_accept:
-		return s[30:36]
-	case Disembargo_context_Which_provide:
-		return s[36:43]
+	case MessageTarget_Which_importedCap:
+		return s[0:11]
+	case MessageTarget_Which_promisedAnswer:
+		return s[11:25]
 
 	}
-	return "Disembargo_context_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
-
-// Disembargo_TypeID is the unique identifier for the type Disembargo.
-const Disembargo_TypeID = 0xf964368b0fbd3711
-
-func NewDisembargo(s *capnp.Segment) (Disembargo, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Disembargo(st), err
-}
-
-func NewRootDisembargo(s *capnp.Segment) (Disembargo, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Disembargo(st), err
-}
-
-func ReadRootDisembargo(msg *capnp.Message) (Disembargo, error) {
-	root, err := msg.Root()
-	return Disembargo(root.Struct()), err
-}
-
-func (s Disembargo) String() string {
-	str, _ := text.Marshal(0xf964368b0fbd3711, capnp.Struct(s))
-	return str
+	return "MessageTarget_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func (s Disembargo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+// MessageTarget_Visitor has a method for each member of the union of
+// MessageTarget, which Visit calls with the member's value.
+type MessageTarget_Visitor interface {
+	VisitImportedCap(uint32) error
+	VisitPromisedAnswer(PromisedAnswer) error
 }
 
-func (Disembargo) DecodeFromPtr(p capnp.Ptr) Disembargo {
-	return Disembargo(capnp.Struct{}.DecodeFromPtr(p))
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s MessageTarget) Visit(v MessageTarget_Visitor) error {
+	switch w := s.Which(); w {
+	case MessageTarget_Which_importedCap:
+		return v.VisitImportedCap(s.ImportedCap())
+	case MessageTarget_Which_promisedAnswer:
+		x, err := s.PromisedAnswer()
+		if err != nil {
+			return err
+		}
+		return v.VisitPromisedAnswer(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "MessageTarget", Which: uint16(w)}
+	}
 }
 
-func (s Disembargo) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Disembargo) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
+// MessageTarget_TypeID is the unique identifier for the type MessageTarget.
+const MessageTarget_TypeID = 0x95bc14545813fbc1
 
-func (s Disembargo) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func NewMessageTarget(s *capnp.Segment) (MessageTarget, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return MessageTarget(st), err
 }
 
-func (s Disembargo) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func NewRootMessageTarget(s *capnp.Segment) (MessageTarget, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return MessageTarget(st), err
 }
-func (s Disembargo) Target() (MessageTarget, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return MessageTarget(p.Struct()), err
+
+func ReadRootMessageTarget(msg *capnp.Message) (MessageTarget, error) {
+	root, err := msg.Root()
+	return MessageTarget(root.Struct()), err
 }
 
-func (s Disembargo) HasTarget() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s MessageTarget) String() string {
+	str, _ := text.Marshal(0x95bc14545813fbc1, capnp.Struct(s))
+	return str
 }
 
-func (s Disembargo) SetTarget(v MessageTarget) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s MessageTarget) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-// NewTarget sets the target field to a newly
-// allocated MessageTarget struct, preferring placement in s's segment.
-func (s Disembargo) NewTarget() (MessageTarget, error) {
-	ss, err := NewMessageTarget(capnp.Struct(s).Segment())
-	if err != nil {
-		return MessageTarget{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (MessageTarget) DecodeFromPtr(p capnp.Ptr) MessageTarget {
+	return MessageTarget(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Disembargo) Context() Disembargo_context { return Disembargo_context(s) }
+func (s MessageTarget) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
 
-func (s Disembargo_context) Which() Disembargo_context_Which {
-	return Disembargo_context_Which(capnp.Struct(s).Uint16(4))
+func (s MessageTarget) Which() MessageTarget_Which {
+	return MessageTarget_Which(capnp.Struct(s).Uint16(4))
 }
-func (s Disembargo_context) IsValid() bool {
+func (s MessageTarget) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Disembargo_context) Message() *capnp.Message {
+func (s MessageTarget) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Disembargo_context) Segment() *capnp.Segment {
+func (s MessageTarget) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Disembargo_context) SenderLoopback() uint32 {
+
+// This message is to a capability or promise previously imported by the caller (exported by
+// the receiver).
+func (s MessageTarget) ImportedCap() uint32 {
 	if capnp.Struct(s).Uint16(4) != 0 {
-		panic("Which() != senderLoopback")
+		panic("Which() != importedCap")
 	}
 	return capnp.Struct(s).Uint32(0)
 }
 
-func (s Disembargo_context) SetSenderLoopback(v uint32) {
+func (s MessageTarget) SetImportedCap(v uint32) {
 	capnp.Struct(s).SetUint16(4, 0)
 	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s Disembargo_context) ReceiverLoopback() uint32 {
+// This message is to a capability that is expected to be returned by another call that has not
+// yet been completed.
+//
+// At level 0, this is supported only for addressing the result of a previous `Bootstrap`, so
+// that initial startup doesn't require a round trip.
+func (s MessageTarget) PromisedAnswer() (PromisedAnswer, error) {
 	if capnp.Struct(s).Uint16(4) != 1 {
-		panic("Which() != receiverLoopback")
+		panic("Which() != promisedAnswer")
 	}
-	return capnp.Struct(s).Uint32(0)
-}
-
-func (s Disembargo_context) SetReceiverLoopback(v uint32) {
-	capnp.Struct(s).SetUint16(4, 1)
-	capnp.Struct(s).SetUint32(0, v)
+	return capnp.GetStructField[PromisedAnswer](s, 0, "MessageTarget.promisedAnswer")
 }
 
-func (s Disembargo_context) SetAccept() {
-	capnp.Struct(s).SetUint16(4, 2)
-
+func (s MessageTarget) HasPromisedAnswer() bool {
+	if capnp.Struct(s).Uint16(4) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Disembargo_context) Provide() uint32 {
-	if capnp.Struct(s).Uint16(4) != 3 {
-		panic("Which() != provide")
-	}
-	return capnp.Struct(s).Uint32(0)
+func (s MessageTarget) SetPromisedAnswer(v PromisedAnswer) error {
+	capnp.Struct(s).SetUint16(4, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Disembargo_context) SetProvide(v uint32) {
-	capnp.Struct(s).SetUint16(4, 3)
-	capnp.Struct(s).SetUint32(0, v)
+// NewPromisedAnswer sets the promisedAnswer field to a newly
+// allocated PromisedAnswer struct, preferring placement in s's segment.
+func (s MessageTarget) NewPromisedAnswer() (PromisedAnswer, error) {
+	capnp.Struct(s).SetUint16(4, 1)
+	return capnp.NewStructField(s, 0, NewPromisedAnswer)
 }
 
-// Disembargo_List is a list of Disembargo.
-type Disembargo_List = capnp.StructList[Disembargo]
+// MessageTarget_List is a list of MessageTarget.
+type MessageTarget_List = capnp.StructList[MessageTarget]
 
-// NewDisembargo creates a new list of Disembargo.
-func NewDisembargo_List(s *capnp.Segment, sz int32) (Disembargo_List, error) {
+// NewMessageTarget creates a new list of MessageTarget.
+func NewMessageTarget_List(s *capnp.Segment, sz int32) (MessageTarget_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Disembargo](l), err
+	return capnp.StructList[MessageTarget](l), err
 }
 
-// Disembargo_Future is a wrapper for a Disembargo promised by a client call.
-type Disembargo_Future struct{ *capnp.Future }
+// MessageTarget_Future is a wrapper for a MessageTarget promised by a client call.
+type MessageTarget_Future struct{ *capnp.Future }
 
-func (f Disembargo_Future) Struct() (Disembargo, error) {
+func (f MessageTarget_Future) Struct() (MessageTarget, error) {
 	p, err := f.Future.Ptr()
-	return Disembargo(p.Struct()), err
-}
-func (p Disembargo_Future) Target() MessageTarget_Future {
-	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Disembargo_Future) Context() Disembargo_context_Future {
-	return Disembargo_context_Future{p.Future}
+	return MessageTarget(p.Struct()), err
 }
-
-// Disembargo_context_Future is a wrapper for a Disembargo_context promised by a client call.
-type Disembargo_context_Future struct{ *capnp.Future }
-
-func (f Disembargo_context_Future) Struct() (Disembargo_context, error) {
-	p, err := f.Future.Ptr()
-	return Disembargo_context(p.Struct()), err
+func (p MessageTarget_Future) PromisedAnswer() PromisedAnswer_Future {
+	return PromisedAnswer_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Provide capnp.Struct
+// Represents some data structure that might contain capabilities.
+type Payload capnp.Struct
 
-// Provide_TypeID is the unique identifier for the type Provide.
-const Provide_TypeID = 0x9c6a046bfbc1ac5a
+// Payload_TypeID is the unique identifier for the type Payload.
+const Payload_TypeID = 0x9a0e61223d96743b
 
-func NewProvide(s *capnp.Segment) (Provide, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Provide(st), err
+func NewPayload(s *capnp.Segment) (Payload, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Payload(st), err
 }
 
-func NewRootProvide(s *capnp.Segment) (Provide, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Provide(st), err
+func NewRootPayload(s *capnp.Segment) (Payload, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Payload(st), err
 }
 
-func ReadRootProvide(msg *capnp.Message) (Provide, error) {
+func ReadRootPayload(msg *capnp.Message) (Payload, error) {
 	root, err := msg.Root()
-	return Provide(root.Struct()), err
+	return Payload(root.Struct()), err
 }
 
-func (s Provide) String() string {
-	str, _ := text.Marshal(0x9c6a046bfbc1ac5a, capnp.Struct(s))
+func (s Payload) String() string {
+	str, _ := text.Marshal(0x9a0e61223d96743b, capnp.Struct(s))
 	return str
 }
 
-func (s Provide) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Payload) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Provide) DecodeFromPtr(p capnp.Ptr) Provide {
-	return Provide(capnp.Struct{}.DecodeFromPtr(p))
+func (Payload) DecodeFromPtr(p capnp.Ptr) Payload {
+	return Payload(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Provide) ToPtr() capnp.Ptr {
+func (s Payload) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Provide) IsValid() bool {
+func (s Payload) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Provide) Message() *capnp.Message {
+func (s Payload) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Provide) Segment() *capnp.Segment {
+func (s Payload) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Provide) QuestionId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
 
-func (s Provide) SetQuestionId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
-}
-
-func (s Provide) Target() (MessageTarget, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return MessageTarget(p.Struct()), err
+// Some Cap'n Proto data structure.  Capability pointers embedded in this structure index into
+// `capTable`.
+func (s Payload) Content() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(0, "Payload.content")
 }
 
-func (s Provide) HasTarget() bool {
+func (s Payload) HasContent() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Provide) SetTarget(v MessageTarget) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Payload) SetContent(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-// NewTarget sets the target field to a newly
-// allocated MessageTarget struct, preferring placement in s's segment.
-func (s Provide) NewTarget() (MessageTarget, error) {
-	ss, err := NewMessageTarget(capnp.Struct(s).Segment())
-	if err != nil {
-		return MessageTarget{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// Descriptors corresponding to the cap pointers in `content`.
+func (s Payload) CapTable() (CapDescriptor_List, error) {
+	return capnp.GetListField[CapDescriptor_List](s, 1, "Payload.capTable")
 }
 
-func (s Provide) Recipient() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(1)
+func (s Payload) HasCapTable() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s Provide) HasRecipient() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Payload) SetCapTable(v CapDescriptor_List) error {
+	return capnp.SetListField(s, 1, v)
 }
 
-func (s Provide) SetRecipient(v capnp.Ptr) error {
-	return capnp.Struct(s).SetPtr(1, v)
+// NewCapTable sets the capTable field to a newly
+// allocated CapDescriptor_List, preferring placement in s's segment.
+func (s Payload) NewCapTable(n int32) (CapDescriptor_List, error) {
+	return capnp.NewListField(s, 1, n, NewCapDescriptor_List)
 }
 
-// Provide_List is a list of Provide.
-type Provide_List = capnp.StructList[Provide]
+// Payload_List is a list of Payload.
+type Payload_List = capnp.StructList[Payload]
 
-// NewProvide creates a new list of Provide.
-func NewProvide_List(s *capnp.Segment, sz int32) (Provide_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Provide](l), err
+// NewPayload creates a new list of Payload.
+func NewPayload_List(s *capnp.Segment, sz int32) (Payload_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Payload](l), err
 }
 
-// Provide_Future is a wrapper for a Provide promised by a client call.
-type Provide_Future struct{ *capnp.Future }
+// Payload_Future is a wrapper for a Payload promised by a client call.
+type Payload_Future struct{ *capnp.Future }
 
-func (f Provide_Future) Struct() (Provide, error) {
+func (f Payload_Future) Struct() (Payload, error) {
 	p, err := f.Future.Ptr()
-	return Provide(p.Struct()), err
-}
-func (p Provide_Future) Target() MessageTarget_Future {
-	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
+	return Payload(p.Struct()), err
 }
-func (p Provide_Future) Recipient() *capnp.Future {
-	return p.Future.Field(1, nil)
+func (p Payload_Future) Content() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
 
-type Accept capnp.Struct
+// **(mostly level 1)**
+//
+// Specifies how to derive a promise from an unanswered question, by specifying the path of fields
+// to follow from the root of the eventual result struct to get to the desired capability.  Used
+// to address method calls to a not-yet-returned capability or to pass such a capability as an
+// input to some other method call.
+//
+// Level 0 implementations must support `PromisedAnswer` only for the case where the answer is
+// to a `Bootstrap` message.  In this case, `path` is always empty since `Bootstrap` always returns
+// a raw capability.
+type PromisedAnswer capnp.Struct
 
-// Accept_TypeID is the unique identifier for the type Accept.
-const Accept_TypeID = 0xd4c9b56290554016
+// PromisedAnswer_TypeID is the unique identifier for the type PromisedAnswer.
+const PromisedAnswer_TypeID = 0xd800b1d6cd6f1ca0
 
-func NewAccept(s *capnp.Segment) (Accept, error) {
+func NewPromisedAnswer(s *capnp.Segment) (PromisedAnswer, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Accept(st), err
+	return PromisedAnswer(st), err
 }
 
-func NewRootAccept(s *capnp.Segment) (Accept, error) {
+func NewRootPromisedAnswer(s *capnp.Segment) (PromisedAnswer, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Accept(st), err
+	return PromisedAnswer(st), err
 }
 
-func ReadRootAccept(msg *capnp.Message) (Accept, error) {
+func ReadRootPromisedAnswer(msg *capnp.Message) (PromisedAnswer, error) {
 	root, err := msg.Root()
-	return Accept(root.Struct()), err
+	return PromisedAnswer(root.Struct()), err
 }
 
-func (s Accept) String() string {
-	str, _ := text.Marshal(0xd4c9b56290554016, capnp.Struct(s))
+func (s PromisedAnswer) String() string {
+	str, _ := text.Marshal(0xd800b1d6cd6f1ca0, capnp.Struct(s))
 	return str
 }
 
-func (s Accept) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PromisedAnswer) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Accept) DecodeFromPtr(p capnp.Ptr) Accept {
-	return Accept(capnp.Struct{}.DecodeFromPtr(p))
+func (PromisedAnswer) DecodeFromPtr(p capnp.Ptr) PromisedAnswer {
+	return PromisedAnswer(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Accept) ToPtr() capnp.Ptr {
+func (s PromisedAnswer) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Accept) IsValid() bool {
+func (s PromisedAnswer) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Accept) Message() *capnp.Message {
+func (s PromisedAnswer) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Accept) Segment() *capnp.Segment {
+func (s PromisedAnswer) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Accept) QuestionId() uint32 {
+
+// ID of the question (in the sender's question table / receiver's answer table) whose answer is
+// expected to contain the capability.
+func (s PromisedAnswer) QuestionId() uint32 {
 	return capnp.Struct(s).Uint32(0)
 }
 
-func (s Accept) SetQuestionId(v uint32) {
+func (s PromisedAnswer) SetQuestionId(v uint32) {
 	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s Accept) Provision() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
+// Operations / transformations to apply to the result in order to get the capability actually
+// being addressed.  E.g. if the result is a struct and you want to call a method on a capability
+// pointed to by a field of the struct, you need a `getPointerField` op.
+func (s PromisedAnswer) Transform() (PromisedAnswer_Op_List, error) {
+	return capnp.GetListField[PromisedAnswer_Op_List](s, 0, "PromisedAnswer.transform")
 }
 
-func (s Accept) HasProvision() bool {
+func (s PromisedAnswer) HasTransform() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Accept) SetProvision(v capnp.Ptr) error {
-	return capnp.Struct(s).SetPtr(0, v)
-}
-func (s Accept) Embargo() bool {
-	return capnp.Struct(s).Bit(32)
+func (s PromisedAnswer) SetTransform(v PromisedAnswer_Op_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s Accept) SetEmbargo(v bool) {
-	capnp.Struct(s).SetBit(32, v)
+// NewTransform sets the transform field to a newly
+// allocated PromisedAnswer_Op_List, preferring placement in s's segment.
+func (s PromisedAnswer) NewTransform(n int32) (PromisedAnswer_Op_List, error) {
+	return capnp.NewListField(s, 0, n, NewPromisedAnswer_Op_List)
 }
 
-// Accept_List is a list of Accept.
-type Accept_List = capnp.StructList[Accept]
+// PromisedAnswer_List is a list of PromisedAnswer.
+type PromisedAnswer_List = capnp.StructList[PromisedAnswer]
 
-// NewAccept creates a new list of Accept.
-func NewAccept_List(s *capnp.Segment, sz int32) (Accept_List, error) {
+// NewPromisedAnswer creates a new list of PromisedAnswer.
+func NewPromisedAnswer_List(s *capnp.Segment, sz int32) (PromisedAnswer_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Accept](l), err
+	return capnp.StructList[PromisedAnswer](l), err
 }
 
-// Accept_Future is a wrapper for a Accept promised by a client call.
-type Accept_Future struct{ *capnp.Future }
+// PromisedAnswer_Future is a wrapper for a PromisedAnswer promised by a client call.
+type PromisedAnswer_Future struct{ *capnp.Future }
 
-func (f Accept_Future) Struct() (Accept, error) {
+func (f PromisedAnswer_Future) Struct() (PromisedAnswer, error) {
 	p, err := f.Future.Ptr()
-	return Accept(p.Struct()), err
+	return PromisedAnswer(p.Struct()), err
 }
-func (p Accept_Future) Provision() *capnp.Future {
-	return p.Future.Field(0, nil)
+
+type PromisedAnswer_Op capnp.Struct
+type PromisedAnswer_Op_Which uint16
+
+const (
+	PromisedAnswer_Op_Which_noop            PromisedAnswer_Op_Which = 0
+	PromisedAnswer_Op_Which_getPointerField PromisedAnswer_Op_Which = 1
+)
+
+func (w PromisedAnswer_Op_Which) String() string {
+	const s = "noopgetPointerField"
+	switch w {
+	case PromisedAnswer_Op_Which_noop:
+		return s[0:4]
+	case PromisedAnswer_Op_Which_getPointerField:
+		return s[4:19]
+
+	}
+	return "PromisedAnswer_Op_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-type Join capnp.Struct
+// PromisedAnswer_Op_Visitor has a method for each member of the union of
+// PromisedAnswer_Op, which Visit calls with the member's value.
+type PromisedAnswer_Op_Visitor interface {
+	VisitNoop() error
+	VisitGetPointerField(uint16) error
+}
 
-// Join_TypeID is the unique identifier for the type Join.
-const Join_TypeID = 0xfbe1980490e001af
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s PromisedAnswer_Op) Visit(v PromisedAnswer_Op_Visitor) error {
+	switch w := s.Which(); w {
+	case PromisedAnswer_Op_Which_noop:
+		return v.VisitNoop()
+	case PromisedAnswer_Op_Which_getPointerField:
+		return v.VisitGetPointerField(s.GetPointerField())
+	default:
+		return &capnp.UnknownMemberError{Union: "PromisedAnswer.Op", Which: uint16(w)}
+	}
+}
 
-func NewJoin(s *capnp.Segment) (Join, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Join(st), err
+// PromisedAnswer_Op_TypeID is the unique identifier for the type PromisedAnswer_Op.
+const PromisedAnswer_Op_TypeID = 0xf316944415569081
+
+func NewPromisedAnswer_Op(s *capnp.Segment) (PromisedAnswer_Op, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PromisedAnswer_Op(st), err
 }
 
-func NewRootJoin(s *capnp.Segment) (Join, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Join(st), err
+func NewRootPromisedAnswer_Op(s *capnp.Segment) (PromisedAnswer_Op, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return PromisedAnswer_Op(st), err
 }
 
-func ReadRootJoin(msg *capnp.Message) (Join, error) {
+func ReadRootPromisedAnswer_Op(msg *capnp.Message) (PromisedAnswer_Op, error) {
 	root, err := msg.Root()
-	return Join(root.Struct()), err
+	return PromisedAnswer_Op(root.Struct()), err
 }
 
-func (s Join) String() string {
-	str, _ := text.Marshal(0xfbe1980490e001af, capnp.Struct(s))
+func (s PromisedAnswer_Op) String() string {
+	str, _ := text.Marshal(0xf316944415569081, capnp.Struct(s))
 	return str
 }
 
-func (s Join) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s PromisedAnswer_Op) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Join) DecodeFromPtr(p capnp.Ptr) Join {
-	return Join(capnp.Struct{}.DecodeFromPtr(p))
+func (PromisedAnswer_Op) DecodeFromPtr(p capnp.Ptr) PromisedAnswer_Op {
+	return PromisedAnswer_Op(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Join) ToPtr() capnp.Ptr {
+func (s PromisedAnswer_Op) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Join) IsValid() bool {
+
+func (s PromisedAnswer_Op) Which() PromisedAnswer_Op_Which {
+	return PromisedAnswer_Op_Which(capnp.Struct(s).Uint16(0))
+}
+func (s PromisedAnswer_Op) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Join) Message() *capnp.Message {
+func (s PromisedAnswer_Op) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Join) Segment() *capnp.Segment {
+func (s PromisedAnswer_Op) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Join) QuestionId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
-
-func (s Join) SetQuestionId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
-}
-
-func (s Join) Target() (MessageTarget, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return MessageTarget(p.Struct()), err
-}
-
-func (s Join) HasTarget() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
+func (s PromisedAnswer_Op) SetNoop() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-func (s Join) SetTarget(v MessageTarget) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
 }
 
-// NewTarget sets the target field to a newly
-// allocated MessageTarget struct, preferring placement in s's segment.
-func (s Join) NewTarget() (MessageTarget, error) {
-	ss, err := NewMessageTarget(capnp.Struct(s).Segment())
-	if err != nil {
-		return MessageTarget{}, err
+// Get a pointer field within a struct.  The number is an index into the pointer section, NOT
+// a field ordinal, so that the receiver does not need to understand the schema.
+func (s PromisedAnswer_Op) GetPointerField() uint16 {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != getPointerField")
 	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-func (s Join) KeyPart() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(1)
-}
-
-func (s Join) HasKeyPart() bool {
-	return capnp.Struct(s).HasPtr(1)
+	return capnp.Struct(s).Uint16(2)
 }
 
-func (s Join) SetKeyPart(v capnp.Ptr) error {
-	return capnp.Struct(s).SetPtr(1, v)
+func (s PromisedAnswer_Op) SetGetPointerField(v uint16) {
+	capnp.Struct(s).SetUint16(0, 1)
+	capnp.Struct(s).SetUint16(2, v)
 }
 
-// Join_List is a list of Join.
-type Join_List = capnp.StructList[Join]
+// PromisedAnswer_Op_List is a list of PromisedAnswer_Op.
+type PromisedAnswer_Op_List = capnp.StructList[PromisedAnswer_Op]
 
-// NewJoin creates a new list of Join.
-func NewJoin_List(s *capnp.Segment, sz int32) (Join_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Join](l), err
+// NewPromisedAnswer_Op creates a new list of PromisedAnswer_Op.
+func NewPromisedAnswer_Op_List(s *capnp.Segment, sz int32) (PromisedAnswer_Op_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[PromisedAnswer_Op](l), err
 }
 
-// Join_Future is a wrapper for a Join promised by a client call.
-type Join_Future struct{ *capnp.Future }
+// PromisedAnswer_Op_Future is a wrapper for a PromisedAnswer_Op promised by a client call.
+type PromisedAnswer_Op_Future struct{ *capnp.Future }
 
-func (f Join_Future) Struct() (Join, error) {
+func (f PromisedAnswer_Op_Future) Struct() (PromisedAnswer_Op, error) {
 	p, err := f.Future.Ptr()
-	return Join(p.Struct()), err
-}
-func (p Join_Future) Target() MessageTarget_Future {
-	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
-}
-func (p Join_Future) KeyPart() *capnp.Future {
-	return p.Future.Field(1, nil)
+	return PromisedAnswer_Op(p.Struct()), err
 }
 
-type MessageTarget capnp.Struct
-type MessageTarget_Which uint16
-
-const (
-	MessageTarget_Which_importedCap    MessageTarget_Which = 0
-	MessageTarget_Which_promisedAnswer MessageTarget_Which = 1
-)
-
-func (w MessageTarget_Which) String() string {
-	const s = "importedCappromisedAnswer"
-	switch w {
-	case MessageTarget_Which_importedCap:
-		return s[0:11]
-	case MessageTarget_Which_promisedAnswer:
-		return s[11:25]
-
-	}
-	return "MessageTarget_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
+// **(level 3)**
+//
+// Message type sent to indicate that the sender wishes to make a particular capability implemented
+// by the receiver available to a third party for direct access (without the need for the third
+// party to proxy through the sender).
+//
+// (In CapTP, `Provide` and `Accept` are methods of the global `NonceLocator` object exported by
+// every vat.  In Cap'n Proto, we bake this into the core protocol.)
+type Provide capnp.Struct
 
-// MessageTarget_TypeID is the unique identifier for the type MessageTarget.
-const MessageTarget_TypeID = 0x95bc14545813fbc1
+// Provide_TypeID is the unique identifier for the type Provide.
+const Provide_TypeID = 0x9c6a046bfbc1ac5a
 
-func NewMessageTarget(s *capnp.Segment) (MessageTarget, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return MessageTarget(st), err
+func NewProvide(s *capnp.Segment) (Provide, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Provide(st), err
 }
 
-func NewRootMessageTarget(s *capnp.Segment) (MessageTarget, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return MessageTarget(st), err
+func NewRootProvide(s *capnp.Segment) (Provide, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Provide(st), err
 }
 
-func ReadRootMessageTarget(msg *capnp.Message) (MessageTarget, error) {
+func ReadRootProvide(msg *capnp.Message) (Provide, error) {
 	root, err := msg.Root()
-	return MessageTarget(root.Struct()), err
+	return Provide(root.Struct()), err
 }
 
-func (s MessageTarget) String() string {
-	str, _ := text.Marshal(0x95bc14545813fbc1, capnp.Struct(s))
+func (s Provide) String() string {
+	str, _ := text.Marshal(0x9c6a046bfbc1ac5a, capnp.Struct(s))
 	return str
 }
 
-func (s MessageTarget) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Provide) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (MessageTarget) DecodeFromPtr(p capnp.Ptr) MessageTarget {
-	return MessageTarget(capnp.Struct{}.DecodeFromPtr(p))
+func (Provide) DecodeFromPtr(p capnp.Ptr) Provide {
+	return Provide(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s MessageTarget) ToPtr() capnp.Ptr {
+func (s Provide) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s MessageTarget) Which() MessageTarget_Which {
-	return MessageTarget_Which(capnp.Struct(s).Uint16(4))
-}
-func (s MessageTarget) IsValid() bool {
+func (s Provide) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s MessageTarget) Message() *capnp.Message {
+func (s Provide) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s MessageTarget) Segment() *capnp.Segment {
+func (s Provide) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s MessageTarget) ImportedCap() uint32 {
-	if capnp.Struct(s).Uint16(4) != 0 {
-		panic("Which() != importedCap")
-	}
+
+// Question ID to be held open until the recipient has received the capability.  A result will be
+// returned once the third party has successfully received the capability.  The sender must at some
+// point send a `Finish` message as with any other call, and that message can be used to cancel the
+// whole operation.
+func (s Provide) QuestionId() uint32 {
 	return capnp.Struct(s).Uint32(0)
 }
 
-func (s MessageTarget) SetImportedCap(v uint32) {
-	capnp.Struct(s).SetUint16(4, 0)
+func (s Provide) SetQuestionId(v uint32) {
 	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s MessageTarget) PromisedAnswer() (PromisedAnswer, error) {
-	if capnp.Struct(s).Uint16(4) != 1 {
-		panic("Which() != promisedAnswer")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return PromisedAnswer(p.Struct()), err
+// What is to be provided to the third party.
+func (s Provide) Target() (MessageTarget, error) {
+	return capnp.GetStructField[MessageTarget](s, 0, "Provide.target")
 }
 
-func (s MessageTarget) HasPromisedAnswer() bool {
-	if capnp.Struct(s).Uint16(4) != 1 {
-		return false
-	}
+func (s Provide) HasTarget() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s MessageTarget) SetPromisedAnswer(v PromisedAnswer) error {
-	capnp.Struct(s).SetUint16(4, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Provide) SetTarget(v MessageTarget) error {
+	return capnp.SetStructField(s, 0, v)
+}
+
+// NewTarget sets the target field to a newly
+// allocated MessageTarget struct, preferring placement in s's segment.
+func (s Provide) NewTarget() (MessageTarget, error) {
+	return capnp.NewStructField(s, 0, NewMessageTarget)
+}
+
+// Identity of the third party that is expected to pick up the capability.
+func (s Provide) Recipient() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(1, "Provide.recipient")
 }
 
-// NewPromisedAnswer sets the promisedAnswer field to a newly
-// allocated PromisedAnswer struct, preferring placement in s's segment.
-func (s MessageTarget) NewPromisedAnswer() (PromisedAnswer, error) {
-	capnp.Struct(s).SetUint16(4, 1)
-	ss, err := NewPromisedAnswer(capnp.Struct(s).Segment())
-	if err != nil {
-		return PromisedAnswer{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Provide) HasRecipient() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// MessageTarget_List is a list of MessageTarget.
-type MessageTarget_List = capnp.StructList[MessageTarget]
+func (s Provide) SetRecipient(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(1, v)
+}
 
-// NewMessageTarget creates a new list of MessageTarget.
-func NewMessageTarget_List(s *capnp.Segment, sz int32) (MessageTarget_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[MessageTarget](l), err
+// Provide_List is a list of Provide.
+type Provide_List = capnp.StructList[Provide]
+
+// NewProvide creates a new list of Provide.
+func NewProvide_List(s *capnp.Segment, sz int32) (Provide_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Provide](l), err
 }
 
-// MessageTarget_Future is a wrapper for a MessageTarget promised by a client call.
-type MessageTarget_Future struct{ *capnp.Future }
+// Provide_Future is a wrapper for a Provide promised by a client call.
+type Provide_Future struct{ *capnp.Future }
 
-func (f MessageTarget_Future) Struct() (MessageTarget, error) {
+func (f Provide_Future) Struct() (Provide, error) {
 	p, err := f.Future.Ptr()
-	return MessageTarget(p.Struct()), err
+	return Provide(p.Struct()), err
 }
-func (p MessageTarget_Future) PromisedAnswer() PromisedAnswer_Future {
-	return PromisedAnswer_Future{Future: p.Future.Field(0, nil)}
+func (p Provide_Future) Target() MessageTarget_Future {
+	return MessageTarget_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Provide_Future) Recipient() *capnp.Future {
+	return p.Future.Field(1, nil)
 }
 
-type Payload capnp.Struct
+// **(level 1)**
+//
+// Message type sent to indicate that the sender is done with the given capability and the receiver
+// can free resources allocated to it.
+type Release capnp.Struct
 
-// Payload_TypeID is the unique identifier for the type Payload.
-const Payload_TypeID = 0x9a0e61223d96743b
+// Release_TypeID is the unique identifier for the type Release.
+const Release_TypeID = 0xad1a6c0d7dd07497
 
-func NewPayload(s *capnp.Segment) (Payload, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Payload(st), err
+func NewRelease(s *capnp.Segment) (Release, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Release(st), err
 }
 
-func NewRootPayload(s *capnp.Segment) (Payload, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return Payload(st), err
+func NewRootRelease(s *capnp.Segment) (Release, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Release(st), err
 }
 
-func ReadRootPayload(msg *capnp.Message) (Payload, error) {
+func ReadRootRelease(msg *capnp.Message) (Release, error) {
 	root, err := msg.Root()
-	return Payload(root.Struct()), err
+	return Release(root.Struct()), err
 }
 
-func (s Payload) String() string {
-	str, _ := text.Marshal(0x9a0e61223d96743b, capnp.Struct(s))
+func (s Release) String() string {
+	str, _ := text.Marshal(0xad1a6c0d7dd07497, capnp.Struct(s))
 	return str
 }
 
-func (s Payload) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Release) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Payload) DecodeFromPtr(p capnp.Ptr) Payload {
-	return Payload(capnp.Struct{}.DecodeFromPtr(p))
+func (Release) DecodeFromPtr(p capnp.Ptr) Release {
+	return Release(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Payload) ToPtr() capnp.Ptr {
+func (s Release) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Payload) IsValid() bool {
+func (s Release) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Payload) Message() *capnp.Message {
+func (s Release) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Payload) Segment() *capnp.Segment {
+func (s Release) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Payload) Content() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
-}
-
-func (s Payload) HasContent() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
 
-func (s Payload) SetContent(v capnp.Ptr) error {
-	return capnp.Struct(s).SetPtr(0, v)
-}
-func (s Payload) CapTable() (CapDescriptor_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return CapDescriptor_List(p.List()), err
+// What to release.
+func (s Release) Id() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s Payload) HasCapTable() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Release) SetId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s Payload) SetCapTable(v CapDescriptor_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+// The amount by which to decrement the reference count.  The export is only actually released
+// when the reference count reaches zero.
+func (s Release) ReferenceCount() uint32 {
+	return capnp.Struct(s).Uint32(4)
 }
 
-// NewCapTable sets the capTable field to a newly
-// allocated CapDescriptor_List, preferring placement in s's segment.
-func (s Payload) NewCapTable(n int32) (CapDescriptor_List, error) {
-	l, err := NewCapDescriptor_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return CapDescriptor_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+func (s Release) SetReferenceCount(v uint32) {
+	capnp.Struct(s).SetUint32(4, v)
 }
 
-// Payload_List is a list of Payload.
-type Payload_List = capnp.StructList[Payload]
+// Release_List is a list of Release.
+type Release_List = capnp.StructList[Release]
 
-// NewPayload creates a new list of Payload.
-func NewPayload_List(s *capnp.Segment, sz int32) (Payload_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return capnp.StructList[Payload](l), err
+// NewRelease creates a new list of Release.
+func NewRelease_List(s *capnp.Segment, sz int32) (Release_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Release](l), err
 }
 
-// Payload_Future is a wrapper for a Payload promised by a client call.
-type Payload_Future struct{ *capnp.Future }
+// Release_Future is a wrapper for a Release promised by a client call.
+type Release_Future struct{ *capnp.Future }
 
-func (f Payload_Future) Struct() (Payload, error) {
+func (f Release_Future) Struct() (Release, error) {
 	p, err := f.Future.Ptr()
-	return Payload(p.Struct()), err
-}
-func (p Payload_Future) Content() *capnp.Future {
-	return p.Future.Field(0, nil)
+	return Release(p.Struct()), err
 }
 
-type CapDescriptor capnp.Struct
-type CapDescriptor_Which uint16
+// **(level 1)**
+//
+// Message type sent to indicate that a previously-sent promise has now been resolved to some other
+// object (possibly another promise) -- or broken, or canceled.
+//
+// Keep in mind that it's possible for a `Resolve` to be sent to a level 0 implementation that
+// doesn't implement it.  For example, a method call or return might contain a capability in the
+// payload.  Normally this is fine even if the receiver is level 0, because they will implicitly
+// release all such capabilities on return / finish.  But if the cap happens to be a promise, then
+// a follow-up `Resolve` may be sent regardless of this release.  The level 0 receiver will reply
+// with an `unimplemented` message, and the sender (of the `Resolve`) can respond to this as if the
+// receiver had immediately released any capability to which the promise resolved.
+//
+// When implementing promise resolution, it's important to understand how embargos work and the
+// tricky case of the Tribble 4-way race condition. See the comments for the Disembargo message,
+// below.
+type Resolve capnp.Struct
+type Resolve_Which uint16
 
 const (
-	CapDescriptor_Which_none             CapDescriptor_Which = 0
-	CapDescriptor_Which_senderHosted     CapDescriptor_Which = 1
-	CapDescriptor_Which_senderPromise    CapDescriptor_Which = 2
-	CapDescriptor_Which_receiverHosted   CapDescriptor_Which = 3
-	CapDescriptor_Which_receiverAnswer   CapDescriptor_Which = 4
-	CapDescriptor_Which_thirdPartyHosted CapDescriptor_Which = 5
+	Resolve_Which_cap       Resolve_Which = 0
+	Resolve_Which_exception Resolve_Which = 1
 )
 
-func (w CapDescriptor_Which) String() string {
-	const s = "nonesenderHostedsenderPromisereceiverHostedreceiverAnswerthirdPartyHosted"
+func (w Resolve_Which) String() string {
+	const s = "capexception"
 	switch w {
-	case CapDescriptor_Which_none:
-		return s[0:4]
-	case CapDescriptor_Which_senderHosted:
-		return s[4:16]
-	case CapDescriptor_Which_senderPromise:
-		return s[16:29]
-	case CapDescriptor_Which_receiverHosted:
-		return s[29:43]
-	case CapDescriptor_Which_receiverAnswer:
-		return s[43:57]
-	case CapDescriptor_Which_thirdPartyHosted:
-		return s[57:73]
+	case Resolve_Which_cap:
+		return s[0:3]
+	case Resolve_Which_exception:
+		return s[3:12]
 
 	}
-	return "CapDescriptor_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "Resolve_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// CapDescriptor_TypeID is the unique identifier for the type CapDescriptor.
-const CapDescriptor_TypeID = 0x8523ddc40b86b8b0
+// Resolve_Visitor has a method for each member of the union of
+// Resolve, which Visit calls with the member's value.
+type Resolve_Visitor interface {
+	VisitCap(CapDescriptor) error
+	VisitException(Exception) error
+}
 
-func NewCapDescriptor(s *capnp.Segment) (CapDescriptor, error) {
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Resolve) Visit(v Resolve_Visitor) error {
+	switch w := s.Which(); w {
+	case Resolve_Which_cap:
+		x, err := s.Cap()
+		if err != nil {
+			return err
+		}
+		return v.VisitCap(x)
+	case Resolve_Which_exception:
+		x, err := s.Exception()
+		if err != nil {
+			return err
+		}
+		return v.VisitException(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Resolve", Which: uint16(w)}
+	}
+}
+
+// Resolve_TypeID is the unique identifier for the type Resolve.
+const Resolve_TypeID = 0xbbc29655fa89086e
+
+func NewResolve(s *capnp.Segment) (Resolve, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return CapDescriptor(st), err
+	return Resolve(st), err
 }
 
-func NewRootCapDescriptor(s *capnp.Segment) (CapDescriptor, error) {
+func NewRootResolve(s *capnp.Segment) (Resolve, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return CapDescriptor(st), err
+	return Resolve(st), err
 }
 
-func ReadRootCapDescriptor(msg *capnp.Message) (CapDescriptor, error) {
+func ReadRootResolve(msg *capnp.Message) (Resolve, error) {
 	root, err := msg.Root()
-	return CapDescriptor(root.Struct()), err
+	return Resolve(root.Struct()), err
 }
 
-func (s CapDescriptor) String() string {
-	str, _ := text.Marshal(0x8523ddc40b86b8b0, capnp.Struct(s))
+func (s Resolve) String() string {
+	str, _ := text.Marshal(0xbbc29655fa89086e, capnp.Struct(s))
 	return str
 }
 
-func (s CapDescriptor) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Resolve) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CapDescriptor) DecodeFromPtr(p capnp.Ptr) CapDescriptor {
-	return CapDescriptor(capnp.Struct{}.DecodeFromPtr(p))
+func (Resolve) DecodeFromPtr(p capnp.Ptr) Resolve {
+	return Resolve(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CapDescriptor) ToPtr() capnp.Ptr {
+func (s Resolve) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s CapDescriptor) Which() CapDescriptor_Which {
-	return CapDescriptor_Which(capnp.Struct(s).Uint16(0))
+func (s Resolve) Which() Resolve_Which {
+	return Resolve_Which(capnp.Struct(s).Uint16(4))
 }
-func (s CapDescriptor) IsValid() bool {
+func (s Resolve) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CapDescriptor) Message() *capnp.Message {
+func (s Resolve) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CapDescriptor) Segment() *capnp.Segment {
+func (s Resolve) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s CapDescriptor) SetNone() {
-	capnp.Struct(s).SetUint16(0, 0)
-
-}
-
-func (s CapDescriptor) SenderHosted() uint32 {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != senderHosted")
-	}
-	return capnp.Struct(s).Uint32(4)
-}
-
-func (s CapDescriptor) SetSenderHosted(v uint32) {
-	capnp.Struct(s).SetUint16(0, 1)
-	capnp.Struct(s).SetUint32(4, v)
-}
-
-func (s CapDescriptor) SenderPromise() uint32 {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != senderPromise")
-	}
-	return capnp.Struct(s).Uint32(4)
-}
-
-func (s CapDescriptor) SetSenderPromise(v uint32) {
-	capnp.Struct(s).SetUint16(0, 2)
-	capnp.Struct(s).SetUint32(4, v)
-}
 
-func (s CapDescriptor) ReceiverHosted() uint32 {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		panic("Which() != receiverHosted")
-	}
-	return capnp.Struct(s).Uint32(4)
+// The ID of the promise to be resolved.
+//
+// Unlike all other instances of `ExportId` sent from the exporter, the `Resolve` message does
+// _not_ increase the reference count of `promiseId`.  In fact, it is expected that the receiver
+// will release the export soon after receiving `Resolve`, and the sender will not send this
+// `ExportId` again until it has been released and recycled.
+//
+// When an export ID sent over the wire (e.g. in a `CapDescriptor`) is indicated to be a promise,
+// this indicates that the sender will follow up at some point with a `Resolve` message.  If the
+// same `promiseId` is sent again before `Resolve`, still only one `Resolve` is sent.  If the
+// same ID is sent again later _after_ a `Resolve`, it can only be because the export's
+// reference count hit zero in the meantime and the ID was re-assigned to a new export, therefore
+// this later promise does _not_ correspond to the earlier `Resolve`.
+//
+// If a promise ID's reference count reaches zero before a `Resolve` is sent, the `Resolve`
+// message may or may not still be sent (the `Resolve` may have already been in-flight when
+// `Release` was sent, but if the `Release` is received before `Resolve` then there is no longer
+// any reason to send a `Resolve`).  Thus a `Resolve` may be received for a promise of which
+// the receiver has no knowledge, because it already released it earlier.  In this case, the
+// receiver should simply release the capability to which the promise resolved.
+func (s Resolve) PromiseId() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s CapDescriptor) SetReceiverHosted(v uint32) {
-	capnp.Struct(s).SetUint16(0, 3)
-	capnp.Struct(s).SetUint32(4, v)
+func (s Resolve) SetPromiseId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s CapDescriptor) ReceiverAnswer() (PromisedAnswer, error) {
-	if capnp.Struct(s).Uint16(0) != 4 {
-		panic("Which() != receiverAnswer")
+// The object to which the promise resolved.
+//
+// The sender promises that from this point forth, until `promiseId` is released, it shall
+// simply forward all messages to the capability designated by `cap`.  This is true even if
+// `cap` itself happens to designate another promise, and that other promise later resolves --
+// messages sent to `promiseId` shall still go to that other promise, not to its resolution.
+// This is important in the case that the receiver of the `Resolve` ends up sending a
+// `Disembargo` message towards `promiseId` in order to control message ordering -- that
+// `Disembargo` really needs to reflect back to exactly the object designated by `cap` even
+// if that object is itself a promise.
+func (s Resolve) Cap() (CapDescriptor, error) {
+	if capnp.Struct(s).Uint16(4) != 0 {
+		panic("Which() != cap")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return PromisedAnswer(p.Struct()), err
+	return capnp.GetStructField[CapDescriptor](s, 0, "Resolve.cap")
 }
 
-func (s CapDescriptor) HasReceiverAnswer() bool {
-	if capnp.Struct(s).Uint16(0) != 4 {
+func (s Resolve) HasCap() bool {
+	if capnp.Struct(s).Uint16(4) != 0 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CapDescriptor) SetReceiverAnswer(v PromisedAnswer) error {
-	capnp.Struct(s).SetUint16(0, 4)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Resolve) SetCap(v CapDescriptor) error {
+	capnp.Struct(s).SetUint16(4, 0)
+	return capnp.SetStructField(s, 0, v)
 }
 
-// NewReceiverAnswer sets the receiverAnswer field to a newly
-// allocated PromisedAnswer struct, preferring placement in s's segment.
-func (s CapDescriptor) NewReceiverAnswer() (PromisedAnswer, error) {
-	capnp.Struct(s).SetUint16(0, 4)
-	ss, err := NewPromisedAnswer(capnp.Struct(s).Segment())
-	if err != nil {
-		return PromisedAnswer{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewCap sets the cap field to a newly
+// allocated CapDescriptor struct, preferring placement in s's segment.
+func (s Resolve) NewCap() (CapDescriptor, error) {
+	capnp.Struct(s).SetUint16(4, 0)
+	return capnp.NewStructField(s, 0, NewCapDescriptor)
 }
 
-func (s CapDescriptor) ThirdPartyHosted() (ThirdPartyCapDescriptor, error) {
-	if capnp.Struct(s).Uint16(0) != 5 {
-		panic("Which() != thirdPartyHosted")
+// Indicates that the promise was broken.
+func (s Resolve) Exception() (Exception, error) {
+	if capnp.Struct(s).Uint16(4) != 1 {
+		panic("Which() != exception")
 	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return ThirdPartyCapDescriptor(p.Struct()), err
+	return capnp.GetStructField[Exception](s, 0, "Resolve.exception")
 }
 
-func (s CapDescriptor) HasThirdPartyHosted() bool {
-	if capnp.Struct(s).Uint16(0) != 5 {
+func (s Resolve) HasException() bool {
+	if capnp.Struct(s).Uint16(4) != 1 {
 		return false
 	}
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CapDescriptor) SetThirdPartyHosted(v ThirdPartyCapDescriptor) error {
-	capnp.Struct(s).SetUint16(0, 5)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
-
-// NewThirdPartyHosted sets the thirdPartyHosted field to a newly
-// allocated ThirdPartyCapDescriptor struct, preferring placement in s's segment.
-func (s CapDescriptor) NewThirdPartyHosted() (ThirdPartyCapDescriptor, error) {
-	capnp.Struct(s).SetUint16(0, 5)
-	ss, err := NewThirdPartyCapDescriptor(capnp.Struct(s).Segment())
-	if err != nil {
-		return ThirdPartyCapDescriptor{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-func (s CapDescriptor) AttachedFd() uint8 {
-	return capnp.Struct(s).Uint8(2) ^ 255
+func (s Resolve) SetException(v Exception) error {
+	capnp.Struct(s).SetUint16(4, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s CapDescriptor) SetAttachedFd(v uint8) {
-	capnp.Struct(s).SetUint8(2, v^255)
+// NewException sets the exception field to a newly
+// allocated Exception struct, preferring placement in s's segment.
+func (s Resolve) NewException() (Exception, error) {
+	capnp.Struct(s).SetUint16(4, 1)
+	return capnp.NewStructField(s, 0, NewException)
 }
 
-// CapDescriptor_List is a list of CapDescriptor.
-type CapDescriptor_List = capnp.StructList[CapDescriptor]
+// Resolve_List is a list of Resolve.
+type Resolve_List = capnp.StructList[Resolve]
 
-// NewCapDescriptor creates a new list of CapDescriptor.
-func NewCapDescriptor_List(s *capnp.Segment, sz int32) (CapDescriptor_List, error) {
+// NewResolve creates a new list of Resolve.
+func NewResolve_List(s *capnp.Segment, sz int32) (Resolve_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[CapDescriptor](l), err
+	return capnp.StructList[Resolve](l), err
 }
 
-// CapDescriptor_Future is a wrapper for a CapDescriptor promised by a client call.
-type CapDescriptor_Future struct{ *capnp.Future }
+// Resolve_Future is a wrapper for a Resolve promised by a client call.
+type Resolve_Future struct{ *capnp.Future }
 
-func (f CapDescriptor_Future) Struct() (CapDescriptor, error) {
+func (f Resolve_Future) Struct() (Resolve, error) {
 	p, err := f.Future.Ptr()
-	return CapDescriptor(p.Struct()), err
+	return Resolve(p.Struct()), err
 }
-func (p CapDescriptor_Future) ReceiverAnswer() PromisedAnswer_Future {
-	return PromisedAnswer_Future{Future: p.Future.Field(0, nil)}
+func (p Resolve_Future) Cap() CapDescriptor_Future {
+	return CapDescriptor_Future{Future: p.Future.Field(0, nil)}
 }
-func (p CapDescriptor_Future) ThirdPartyHosted() ThirdPartyCapDescriptor_Future {
-	return ThirdPartyCapDescriptor_Future{Future: p.Future.Field(0, nil)}
+func (p Resolve_Future) Exception() Exception_Future {
+	return Exception_Future{Future: p.Future.Field(0, nil)}
 }
 
-type PromisedAnswer capnp.Struct
+// **(level 0)**
+//
+// Message type sent from callee to caller indicating that the call has completed.
+type Return capnp.Struct
+type Return_Which uint16
 
-// PromisedAnswer_TypeID is the unique identifier for the type PromisedAnswer.
-const PromisedAnswer_TypeID = 0xd800b1d6cd6f1ca0
+const (
+	Return_Which_results               Return_Which = 0
+	Return_Which_exception             Return_Which = 1
+	Return_Which_canceled              Return_Which = 2
+	Return_Which_resultsSentElsewhere  Return_Which = 3
+	Return_Which_takeFromOtherQuestion Return_Which = 4
+	Return_Which_acceptFromThirdParty  Return_Which = 5
+)
 
-func NewPromisedAnswer(s *capnp.Segment) (PromisedAnswer, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return PromisedAnswer(st), err
+func (w Return_Which) String() string {
+	const s = "resultsexceptioncanceledresultsSentElsewheretakeFromOtherQuestionacceptFromThirdParty"
+	switch w {
+	case Return_Which_results:
+		return s[0:7]
+	case Return_Which_exception:
+		return s[7:16]
+	case Return_Which_canceled:
+		return s[16:24]
+	case Return_Which_resultsSentElsewhere:
+		return s[24:44]
+	case Return_Which_takeFromOtherQuestion:
+		return s[44:65]
+	case Return_Which_acceptFromThirdParty:
+		return s[65:85]
+
+	}
+	return "Return_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func NewRootPromisedAnswer(s *capnp.Segment) (PromisedAnswer, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return PromisedAnswer(st), err
+// Return_Visitor has a method for each member of the union of
+// Return, which Visit calls with the member's value.
+type Return_Visitor interface {
+	VisitResults(Payload) error
+	VisitException(Exception) error
+	VisitCanceled() error
+	VisitResultsSentElsewhere() error
+	VisitTakeFromOtherQuestion(uint32) error
+	VisitAcceptFromThirdParty(capnp.Ptr) error
 }
 
-func ReadRootPromisedAnswer(msg *capnp.Message) (PromisedAnswer, error) {
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Return) Visit(v Return_Visitor) error {
+	switch w := s.Which(); w {
+	case Return_Which_results:
+		x, err := s.Results()
+		if err != nil {
+			return err
+		}
+		return v.VisitResults(x)
+	case Return_Which_exception:
+		x, err := s.Exception()
+		if err != nil {
+			return err
+		}
+		return v.VisitException(x)
+	case Return_Which_canceled:
+		return v.VisitCanceled()
+	case Return_Which_resultsSentElsewhere:
+		return v.VisitResultsSentElsewhere()
+	case Return_Which_takeFromOtherQuestion:
+		return v.VisitTakeFromOtherQuestion(s.TakeFromOtherQuestion())
+	case Return_Which_acceptFromThirdParty:
+		x, err := s.AcceptFromThirdParty()
+		if err != nil {
+			return err
+		}
+		return v.VisitAcceptFromThirdParty(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Return", Which: uint16(w)}
+	}
+}
+
+// Return_TypeID is the unique identifier for the type Return.
+const Return_TypeID = 0x9e19b28d3db3573a
+
+func NewReturn(s *capnp.Segment) (Return, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Return(st), err
+}
+
+func NewRootReturn(s *capnp.Segment) (Return, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Return(st), err
+}
+
+func ReadRootReturn(msg *capnp.Message) (Return, error) {
 	root, err := msg.Root()
-	return PromisedAnswer(root.Struct()), err
+	return Return(root.Struct()), err
 }
 
-func (s PromisedAnswer) String() string {
-	str, _ := text.Marshal(0xd800b1d6cd6f1ca0, capnp.Struct(s))
+func (s Return) String() string {
+	str, _ := text.Marshal(0x9e19b28d3db3573a, capnp.Struct(s))
 	return str
 }
 
-func (s PromisedAnswer) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Return) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (PromisedAnswer) DecodeFromPtr(p capnp.Ptr) PromisedAnswer {
-	return PromisedAnswer(capnp.Struct{}.DecodeFromPtr(p))
+func (Return) DecodeFromPtr(p capnp.Ptr) Return {
+	return Return(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s PromisedAnswer) ToPtr() capnp.Ptr {
+func (s Return) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s PromisedAnswer) IsValid() bool {
+
+func (s Return) Which() Return_Which {
+	return Return_Which(capnp.Struct(s).Uint16(6))
+}
+func (s Return) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s PromisedAnswer) Message() *capnp.Message {
+func (s Return) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s PromisedAnswer) Segment() *capnp.Segment {
+func (s Return) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s PromisedAnswer) QuestionId() uint32 {
+
+// Equal to the QuestionId of the corresponding `Call` message.
+func (s Return) AnswerId() uint32 {
 	return capnp.Struct(s).Uint32(0)
 }
 
-func (s PromisedAnswer) SetQuestionId(v uint32) {
+func (s Return) SetAnswerId(v uint32) {
 	capnp.Struct(s).SetUint32(0, v)
 }
 
-func (s PromisedAnswer) Transform() (PromisedAnswer_Op_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return PromisedAnswer_Op_List(p.List()), err
-}
-
-func (s PromisedAnswer) HasTransform() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s PromisedAnswer) SetTransform(v PromisedAnswer_Op_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+// If true, all capabilities that were in the params should be considered released.  The sender
+// must not send separate `Release` messages for them.  Level 0 implementations in particular
+// should always set this true.  This defaults true because if level 0 implementations forget to
+// set it they'll never notice (just silently leak caps), but if level >=1 implementations forget
+// to set it to false they'll quickly get errors.
+//
+// The receiver should act as if the sender had sent a release message with count=1 for each
+// CapDescriptor in the original Call message.
+func (s Return) ReleaseParamCaps() bool {
+	return !capnp.Struct(s).Bit(32)
 }
 
-// NewTransform sets the transform field to a newly
-// allocated PromisedAnswer_Op_List, preferring placement in s's segment.
-func (s PromisedAnswer) NewTransform(n int32) (PromisedAnswer_Op_List, error) {
-	l, err := NewPromisedAnswer_Op_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return PromisedAnswer_Op_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Return) SetReleaseParamCaps(v bool) {
+	capnp.Struct(s).SetBit(32, !v)
 }
 
-// PromisedAnswer_List is a list of PromisedAnswer.
-type PromisedAnswer_List = capnp.StructList[PromisedAnswer]
-
-// NewPromisedAnswer creates a new list of PromisedAnswer.
-func NewPromisedAnswer_List(s *capnp.Segment, sz int32) (PromisedAnswer_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[PromisedAnswer](l), err
+// If true, the sender does not need the receiver to send a `Finish` message; its answer table
+// entry has already been cleaned up. This implies that the results do not contain any
+// capabilities, since the `Finish` message would normally release those capabilities from
+// promise pipelining responsibility. The caller may still send a `Finish` message if it wants,
+// which will be silently ignored by the callee.
+func (s Return) NoFinishNeeded() bool {
+	return capnp.Struct(s).Bit(33)
 }
 
-// PromisedAnswer_Future is a wrapper for a PromisedAnswer promised by a client call.
-type PromisedAnswer_Future struct{ *capnp.Future }
-
-func (f PromisedAnswer_Future) Struct() (PromisedAnswer, error) {
-	p, err := f.Future.Ptr()
-	return PromisedAnswer(p.Struct()), err
+func (s Return) SetNoFinishNeeded(v bool) {
+	capnp.Struct(s).SetBit(33, v)
 }
 
-type PromisedAnswer_Op capnp.Struct
-type PromisedAnswer_Op_Which uint16
-
-const (
-	PromisedAnswer_Op_Which_noop            PromisedAnswer_Op_Which = 0
-	PromisedAnswer_Op_Which_getPointerField PromisedAnswer_Op_Which = 1
-)
-
-func (w PromisedAnswer_Op_Which) String() string {
-	const s = "noopgetPointerField"
-	switch w {
-	case PromisedAnswer_Op_Which_noop:
-		return s[0:4]
-	case PromisedAnswer_Op_Which_getPointerField:
-		return s[4:19]
-
+// The result.
+//
+// For regular method calls, `results.content` points to the result struct.
+//
+// For a `Return` in response to an `Accept` or `Bootstrap`, `results` contains a single
+// capability (rather than a struct), and `results.content` is just a capability pointer with
+// index 0.  A `Finish` is still required in this case.
+func (s Return) Results() (Payload, error) {
+	if capnp.Struct(s).Uint16(6) != 0 {
+		panic("Which() != results")
 	}
-	return "PromisedAnswer_Op_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return capnp.GetStructField[Payload](s, 0, "Return.results")
 }
 
-// PromisedAnswer_Op_TypeID is the unique identifier for the type PromisedAnswer_Op.
-const PromisedAnswer_Op_TypeID = 0xf316944415569081
-
-func NewPromisedAnswer_Op(s *capnp.Segment) (PromisedAnswer_Op, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PromisedAnswer_Op(st), err
+func (s Return) HasResults() bool {
+	if capnp.Struct(s).Uint16(6) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func NewRootPromisedAnswer_Op(s *capnp.Segment) (PromisedAnswer_Op, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return PromisedAnswer_Op(st), err
+func (s Return) SetResults(v Payload) error {
+	capnp.Struct(s).SetUint16(6, 0)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func ReadRootPromisedAnswer_Op(msg *capnp.Message) (PromisedAnswer_Op, error) {
-	root, err := msg.Root()
-	return PromisedAnswer_Op(root.Struct()), err
+// NewResults sets the results field to a newly
+// allocated Payload struct, preferring placement in s's segment.
+func (s Return) NewResults() (Payload, error) {
+	capnp.Struct(s).SetUint16(6, 0)
+	return capnp.NewStructField(s, 0, NewPayload)
 }
 
-func (s PromisedAnswer_Op) String() string {
-	str, _ := text.Marshal(0xf316944415569081, capnp.Struct(s))
-	return str
+// Indicates that the call failed and explains why.
+func (s Return) Exception() (Exception, error) {
+	if capnp.Struct(s).Uint16(6) != 1 {
+		panic("Which() != exception")
+	}
+	return capnp.GetStructField[Exception](s, 0, "Return.exception")
 }
 
-func (s PromisedAnswer_Op) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Return) HasException() bool {
+	if capnp.Struct(s).Uint16(6) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (PromisedAnswer_Op) DecodeFromPtr(p capnp.Ptr) PromisedAnswer_Op {
-	return PromisedAnswer_Op(capnp.Struct{}.DecodeFromPtr(p))
+func (s Return) SetException(v Exception) error {
+	capnp.Struct(s).SetUint16(6, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s PromisedAnswer_Op) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// NewException sets the exception field to a newly
+// allocated Exception struct, preferring placement in s's segment.
+func (s Return) NewException() (Exception, error) {
+	capnp.Struct(s).SetUint16(6, 1)
+	return capnp.NewStructField(s, 0, NewException)
 }
 
-func (s PromisedAnswer_Op) Which() PromisedAnswer_Op_Which {
-	return PromisedAnswer_Op_Which(capnp.Struct(s).Uint16(0))
+func (s Return) SetCanceled() {
+	capnp.Struct(s).SetUint16(6, 2)
+
 }
-func (s PromisedAnswer_Op) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+
+func (s Return) SetResultsSentElsewhere() {
+	capnp.Struct(s).SetUint16(6, 3)
+
 }
 
-func (s PromisedAnswer_Op) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// The sender has also sent (before this message) a `Call` with the given question ID and with
+// `sendResultsTo.yourself` set, and the results of that other call should be used as the
+// results here.  `takeFromOtherQuestion` can only used once per question.
+func (s Return) TakeFromOtherQuestion() uint32 {
+	if capnp.Struct(s).Uint16(6) != 4 {
+		panic("Which() != takeFromOtherQuestion")
+	}
+	return capnp.Struct(s).Uint32(8)
 }
 
-func (s PromisedAnswer_Op) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Return) SetTakeFromOtherQuestion(v uint32) {
+	capnp.Struct(s).SetUint16(6, 4)
+	capnp.Struct(s).SetUint32(8, v)
 }
-func (s PromisedAnswer_Op) SetNoop() {
-	capnp.Struct(s).SetUint16(0, 0)
 
+// **(level 3)**
+//
+// The caller should contact a third-party vat to pick up the results.  An `Accept` message
+// sent to the vat will return the result.  This pairs with `Call.sendResultsTo.thirdParty`.
+// It should only be used if the corresponding `Call` had `allowThirdPartyTailCall` set.
+func (s Return) AcceptFromThirdParty() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(6) != 5 {
+		panic("Which() != acceptFromThirdParty")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Return.acceptFromThirdParty")
 }
 
-func (s PromisedAnswer_Op) GetPointerField() uint16 {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != getPointerField")
+func (s Return) HasAcceptFromThirdParty() bool {
+	if capnp.Struct(s).Uint16(6) != 5 {
+		return false
 	}
-	return capnp.Struct(s).Uint16(2)
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s PromisedAnswer_Op) SetGetPointerField(v uint16) {
-	capnp.Struct(s).SetUint16(0, 1)
-	capnp.Struct(s).SetUint16(2, v)
+func (s Return) SetAcceptFromThirdParty(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(6, 5)
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-// PromisedAnswer_Op_List is a list of PromisedAnswer_Op.
-type PromisedAnswer_Op_List = capnp.StructList[PromisedAnswer_Op]
+// Return_List is a list of Return.
+type Return_List = capnp.StructList[Return]
 
-// NewPromisedAnswer_Op creates a new list of PromisedAnswer_Op.
-func NewPromisedAnswer_Op_List(s *capnp.Segment, sz int32) (PromisedAnswer_Op_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[PromisedAnswer_Op](l), err
+// NewReturn creates a new list of Return.
+func NewReturn_List(s *capnp.Segment, sz int32) (Return_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Return](l), err
 }
 
-// PromisedAnswer_Op_Future is a wrapper for a PromisedAnswer_Op promised by a client call.
-type PromisedAnswer_Op_Future struct{ *capnp.Future }
+// Return_Future is a wrapper for a Return promised by a client call.
+type Return_Future struct{ *capnp.Future }
 
-func (f PromisedAnswer_Op_Future) Struct() (PromisedAnswer_Op, error) {
+func (f Return_Future) Struct() (Return, error) {
 	p, err := f.Future.Ptr()
-	return PromisedAnswer_Op(p.Struct()), err
+	return Return(p.Struct()), err
+}
+func (p Return_Future) Results() Payload_Future {
+	return Payload_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Return_Future) Exception() Exception_Future {
+	return Exception_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Return_Future) AcceptFromThirdParty() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
 
+// **(level 3)**
+//
+// Identifies a capability in a third-party vat that the sender wants the receiver to pick up.
 type ThirdPartyCapDescriptor capnp.Struct
 
 // ThirdPartyCapDescriptor_TypeID is the unique identifier for the type ThirdPartyCapDescriptor.
@@ -2699,8 +3896,10 @@ func (s ThirdPartyCapDescriptor) Message() *capnp.Message {
 func (s ThirdPartyCapDescriptor) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+
+// Identifies the third-party host and the specific capability to accept from it.
 func (s ThirdPartyCapDescriptor) Id() (capnp.Ptr, error) {
-	return capnp.Struct(s).Ptr(0)
+	return capnp.Struct(s).FieldPtr(0, "ThirdPartyCapDescriptor.id")
 }
 
 func (s ThirdPartyCapDescriptor) HasId() bool {
@@ -2710,6 +3909,21 @@ func (s ThirdPartyCapDescriptor) HasId() bool {
 func (s ThirdPartyCapDescriptor) SetId(v capnp.Ptr) error {
 	return capnp.Struct(s).SetPtr(0, v)
 }
+
+// A proxy for the third-party object exported by the sender.  In CapTP terminology this is called
+// a "vine", because it is an indirect reference to the third-party object that snakes through the
+// sender vat.  This serves two purposes:
+//
+//   - Level 1 and 2 implementations that don't understand how to connect to a third party may
+//     simply send calls to the vine.  Such calls will be forwarded to the third-party by the
+//     sender.
+//
+//   - Level 3 implementations must release the vine only once they have successfully picked up the
+//     object from the third party.  This ensures that the capability is not released by the sender
+//     prematurely.
+//
+// The sender will close the `Provide` request that it has sent to the third party as soon as
+// it receives either a `Call` or a `Release` message directed at the vine.
 func (s ThirdPartyCapDescriptor) VineId() uint32 {
 	return capnp.Struct(s).Uint32(0)
 }
@@ -2738,354 +3952,177 @@ func (p ThirdPartyCapDescriptor_Future) Id() *capnp.Future {
 	return p.Future.Field(0, nil)
 }
 
-type Exception capnp.Struct
-
-// Exception_TypeID is the unique identifier for the type Exception.
-const Exception_TypeID = 0xd625b7063acf691a
-
-func NewException(s *capnp.Segment) (Exception, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Exception(st), err
-}
-
-func NewRootException(s *capnp.Segment) (Exception, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Exception(st), err
-}
-
-func ReadRootException(msg *capnp.Message) (Exception, error) {
-	root, err := msg.Root()
-	return Exception(root.Struct()), err
-}
-
-func (s Exception) String() string {
-	str, _ := text.Marshal(0xd625b7063acf691a, capnp.Struct(s))
-	return str
-}
-
-func (s Exception) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (Exception) DecodeFromPtr(p capnp.Ptr) Exception {
-	return Exception(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s Exception) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Exception) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s Exception) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s Exception) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Exception) Reason() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
-
-func (s Exception) HasReason() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Exception) ReasonBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s Exception) SetReason(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
-
-func (s Exception) Type() Exception_Type {
-	return Exception_Type(capnp.Struct(s).Uint16(4))
-}
-
-func (s Exception) SetType(v Exception_Type) {
-	capnp.Struct(s).SetUint16(4, uint16(v))
-}
-
-func (s Exception) ObsoleteIsCallersFault() bool {
-	return capnp.Struct(s).Bit(0)
-}
-
-func (s Exception) SetObsoleteIsCallersFault(v bool) {
-	capnp.Struct(s).SetBit(0, v)
-}
-
-func (s Exception) ObsoleteDurability() uint16 {
-	return capnp.Struct(s).Uint16(2)
-}
-
-func (s Exception) SetObsoleteDurability(v uint16) {
-	capnp.Struct(s).SetUint16(2, v)
-}
-
-func (s Exception) Trace() (string, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return p.Text(), err
-}
-
-func (s Exception) HasTrace() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
-
-func (s Exception) TraceBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return p.TextBytes(), err
-}
-
-func (s Exception) SetTrace(v string) error {
-	return capnp.Struct(s).SetText(1, v)
-}
-
-// Exception_List is a list of Exception.
-type Exception_List = capnp.StructList[Exception]
-
-// NewException creates a new list of Exception.
-func NewException_List(s *capnp.Segment, sz int32) (Exception_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Exception](l), err
-}
-
-// Exception_Future is a wrapper for a Exception promised by a client call.
-type Exception_Future struct{ *capnp.Future }
-
-func (f Exception_Future) Struct() (Exception, error) {
-	p, err := f.Future.Ptr()
-	return Exception(p.Struct()), err
-}
-
-type Exception_Type uint16
-
-// Exception_Type_TypeID is the unique identifier for the type Exception_Type.
-const Exception_Type_TypeID = 0xb28c96e23f4cbd58
-
-// Values of Exception_Type.
-const (
-	Exception_Type_failed        Exception_Type = 0
-	Exception_Type_overloaded    Exception_Type = 1
-	Exception_Type_disconnected  Exception_Type = 2
-	Exception_Type_unimplemented Exception_Type = 3
-)
-
-// String returns the enum's constant name.
-func (c Exception_Type) String() string {
-	switch c {
-	case Exception_Type_failed:
-		return "failed"
-	case Exception_Type_overloaded:
-		return "overloaded"
-	case Exception_Type_disconnected:
-		return "disconnected"
-	case Exception_Type_unimplemented:
-		return "unimplemented"
-
-	default:
-		return ""
-	}
-}
-
-// Exception_TypeFromString returns the enum value with a name,
-// or the zero value if there's no such value.
-func Exception_TypeFromString(c string) Exception_Type {
-	switch c {
-	case "failed":
-		return Exception_Type_failed
-	case "overloaded":
-		return Exception_Type_overloaded
-	case "disconnected":
-		return Exception_Type_disconnected
-	case "unimplemented":
-		return Exception_Type_unimplemented
-
-	default:
-		return 0
-	}
-}
-
-type Exception_Type_List = capnp.EnumList[Exception_Type]
-
-func NewException_Type_List(s *capnp.Segment, sz int32) (Exception_Type_List, error) {
-	return capnp.NewEnumList[Exception_Type](s, sz)
-}
-
-const schema_b312981b2552a250 = "x\xda\x9cX}l\x1c\xd5\xb5?\xe7\xde\xf5\xae\x9dx" +
-	"\xb3;\x99\x89\x03yD&\xbc \xbdD/Q\x02\xe8" +
-	"=p\x896\x1fv\x14GN\xe3\xebu\x0a\xa4\xad\xda" +
-	"\xf1\xee\x8d=\xcexf\x98\x1d'\xde\x88(\x09M*" +
-	"\x92b\xd5D@\x13DZ\x88\xa8T(\x15!\x80\x80" +
-	"6\x08\x88\xf8\x03P[\xa8\xf8P\xa9\x88ZPQ\xa1" +
-	"*\x12\x94\x86&\xe4c\xaa33;\xb3\xb1\xd7E\xf4" +
-	"/\x8f\xee\xef\xec\xbd\xe7\x9e\x8f\xdf\xef\\/{>\xbd" +
-	"2\xb5<\xbb\xb1\x05\x980\x9b\xd2\xfe\x9b=G\xc6~" +
-	"[\x1c\xfe\x1e\x88\x19\xc8\xfd\xde\xa3}W\xff\xd7\xa1\xd9" +
-	"O@\x13\xcf\x00\xa8\xe3\xa9\x1d\xea]\xa9\x0c\xc0\xb5\xe3" +
-	")\x1f\x01\xfdc\xcf|\x7f\xe6K\xa7\xfe{\x1fYc" +
-	"b\xdd\x85\x994\x80z8}R}0M\xe6G\xd2" +
-	"?$\xf3k\x8e\x8d\xefn\xff\xc9\xd3wM5\x9f\x05" +
-	"\xa0\xaej>\xa8v7\x93yW\xf3\\\x0e\xe8\xbfx" +
-	"N\xbd\xb9_;q\xcfTs\x86L\xdd7\xf3\xa4:" +
-	">\x93\xdc\xda?s;\xa0\xff5\xef\xde\x15W\xe9\xb3" +
-	"\xee\x03eF\x9dq\x13#\x8b\x8fg\x1eTO\x07\xb6" +
-	"\x9f\x06\xb6\x9b\x1f}\xf1\xdc\xd6\xd4\xf0\xfd\x93v\x0e\x8d" +
-	"\xf5\xd6\x83\xaa\xd1J_\xb2\xf51@\xbf\xe3\xa6'V" +
-	"\x8c\x1f\xbf\xfc\xc7d\xcc.\xbd$r\xb5){@\xcd" +
-	"f\xc9\xeb\x96l\x10\x93\x1fy\xaf\xef\xcc\x9a\xf3~1" +
-	"io\x0a\x9bzC\xee\xa0\xba*G_+r\xe4\xc7" +
-	"\xcd\xcf\xf5\x14\xde\xbf\xf7\xce\xe3\xa0h\xcc\x9fg\xbc\xd6" +
-	"\x91~\xfa\xea\xb7\x01P=\x92{U}80\xfci" +
-	"n\x10\xd0\xb7\x9a\xf7\x7f\xb1\xe9\xde\x93\xbfj\x1c\x8a\xb7" +
-	"r\x07\xd5S\x81\xf5;9\xf2x'\xfb\xe4\xbd\x0b\x19" +
-	"\xe7\x8d\xc9\xd7Cr\xb3\x9a\x9f\x8d\xea\xfe<Y\xef\xcb" +
-	"\x93\x13\xa5YgN\x1e_\xba\xf3\x8dF\x0e\xbf\x97?" +
-	"\xa0~\x14\xd8~\x90\xa7\x9d\xdbVn\x9a\x18x\xea\x95" +
-	"7\x1b\xed\xac\x1eV\x0e\xa8\x0f*\xf4uD!\xe3\x0d" +
-	"\xa7\xbe)\xff\xf8\xe4\xc0[ \xe6 \xfa\xca\xff?\x97" +
-	"\xfb\xc1\xff\x95\xcf\xc2&\xcc`\x0a\x99\xbab\xf6_\x01" +
-	"\xd5U\xb3\xff\x02\x98\xdc\xbdQB\x16\xa8G\xd5E\xea" +
-	"\\\x80k\x97\xab\xed\x08\xf8\xfc\x03W\xd8\xbfy\xfb\xf1" +
-	"\xdf7\xf2Ah\xaf\xaa\xdf\xd6\xe6R\xf24\xba\xdc\xe1" +
-	"\xef\xfc|\xde\xe7\xc7>\xfc\x03\x88\x1c\xf2\xa4\xba7\xf1" +
-	"\x0cr\xe4\xea;\x1a\xf9pJ#w_\xb2\xe6.\xdf" +
-	"\xfdZ\xcfG\x0d\xef\xb6s\xceQu\xdf\x1c\xfa\xda3" +
-	"\x87\xf6\xdd3\xf1\x8d9\x9dw\xb7}\x06\xe2r\x8c\x1d" +
-	"\xea\xcc0\x00\xf5\xa39\xef\xab\xa7\x03\xd3O\x03\xd3\xf8" +
-	"\xe2\x0d\xfdm{D\xbd\xa5\x8d\xbe6\xb5\x91\xf1c\xf8" +
-	"\xa7\x89\xd4\xa1\xf7\xce5\x0c\xc4=m;\xd4\xc3m\xe1" +
-	"\xd7c\xb0\xc6w\x9d\xd2\xd2\x92\xeeXPp:\xd6\xe8" +
-	"\xa6\xd9\x8b(\x16\xf2\x14@\x0a\x01\x94\x8f7\x03\x88\xbf" +
-	"q\x14g\x18\"jHk\xa7;\x00\xc4'\x1c\xc5y" +
-	"\x86\x0aC\x0d\x19\x80rv\x00@\x9c\xe1XL!C" +
-	"\x853\x0d9\x80\x8a\xb8\x1e\xa0\x0f9\x16[i9\x83" +
-	"\x1a\xa6\x00\xd4\x16\xec\x00(\xa6h=\x8f\x0c\xb1\x19\xeb" +
-	"\x82\xacf\xd1\x05\xa6\xa4vk\xb4\xae\x9c=\x09 \xce" +
-	"s,6\xd3\x0eM{4lAT\x9b\xf0(@\xb1" +
-	"\x99v\xd0h=}\xbb\x863\x10U%X\xd7h\xfd" +
-	"Jd\xe8\xdf:*+\x9ea[\xc0\xbb\xcb\xd8\x0c\x0c" +
-	"\x9b\x01\x0b\x9e\xee\x0eJ\x0f\xf3\x099\x00b\x1e\xd07" +
-	",O\xba[\xf4\x12ddw\x19[\x80a\x0b\xa0?" +
-	"\"\xbd!\xbb\xdc]\x06\x00\xcc\x00\xc3\x0c`\xc1\xd1]" +
-	"}\xa4\x82\xf9\x841\xa2-*\xd2*\xf7\xc9\xca(\xb4" +
-	"\x9b^\xa5\xdf\xf6u\xd3\xb4\xb7\xf7\x0f\x19\xcc-\xf7\xea" +
-	"\xaeW\xed\xd7\x0d\x93\xc2\x0c\x88\xc0\x90z\xdd\xb2{]" +
-	"{\xc4\xa8\xa0\xec5\x1ci\x1aV\xc6\xb0\x06c\xd4\xb6" +
-	"\xcc*\xe1hTB<cX2Fk\xc9c\x94;" +
-	"\xa7SVJ\xae\xe1x\xb6\x0b\x94\xc5+x\xaa\xd5\xf7" +
-	"\x834>\xb5\x18@\x1c\xe3(N0\x9c\x8f\x17\xfd(" +
-	"\x93\xcf\x0e\x03\x88g8\x8a\x97\x18\xceg\x17\xfc(\x97" +
-	"/\xba\x00\xe2\x05\x8e\xe2\xd7\x0c\xe7\xf3\xf3\xb4\xcc\x01\x94" +
-	"Wv\x00\x88\x979\x8a7\x19fS\xe7\xfc \x97\xca" +
-	"\xefh\xf5u\x8e\xe2]\x86\xd9\xa6/|\x0d\x9b\x00\x94" +
-	"w\x0e\x00\x88w9\x8a\x0f)9L\xc34\xa2\xf2\x01" +
-	"\x15\xd3\x9f9\x8aO\x18\xe6,\xdb\x92\x90\x0e\xe2%\xdd" +
-	"u6\xe4*\x9e\x8cS\x14-\xf7\xba\xd0N\xa1\x91\xf1" +
-	"\xba+K\xd2\xd8&](\xac\xb3/\xf9A\x02\xac\xb2" +
-	"*\xdb\xa5\x8b\xf9ZCE\x89\xf1\x86\x8c \x05\xe8U" +
-	"\xc3\x9f\x02`>a\xb9\xc8J\xf7<\xbd4$\xcb\xc0" +
-	"\xd7\x961\x0d\xac)\xed\xd7\x85\x19\x9d\x8e\x0d\xb2R\xd1" +
-	"\x07QR\x80\xaf\x8f\x03\xacV\xd1\x05(\x8eQ\xdd\xed" +
-	"E\x86Y\xbc\xe8\x07!V\xf7\xe05\x00\xc5\xdb\x08\xb8" +
-	"\x83\x00~\xc1\x0f\x82\xac\xee\xc3\xc5\x00\xc5\xdd\x04\xdcI" +
-	"@\xea\xbc\x1f\xf6\xcc\xfe\xa09\xf6\x120A@S\x14" +
-	"iu<\x00\xee \xe0n\x02\xd2Q\xb0\xd5\xbbp5" +
-	"@\xf1N\x02\x0e\x11\x909\xebkH\xe2yO\x00L" +
-	"\x10p?\x01-g|-dY\x1c\x06(\x1e\"\xe0" +
-	"!\x02\xd8?}\x0d\x9b\x01\xd4\x07\xb1\x0f\xa0\xf8\x00\x01" +
-	"\x8f\x120\xe3s_\xc3\x16\x00\xf5a\xdc\x01P\xfc\x19" +
-	"\x01O\x120\xf3\xb4\xaf\xe1\x0c\x00\xf5\xf1\xe0\x8cG\x09" +
-	"x\x86\x80\xd6\x7f\xf8\x1a\xce\x04P\x9f\x0a\xdc=F\xc0" +
-	"\x09\x02\xb2\x9f\xf9\x1a\xb6\x02\xa8\xcf\x067\x7f\x92\x80\x17" +
-	"\x08h\xfe\xbb\xafa\x16@}\x0e7\x03\x14O\x10\xf0" +
-	"25\xef\xa8e\x8c8\xa6\x1c\x81viQ\xae\xf3\x89" +
-	"\xf8\x87\xe9j\xd7\x07l\x97\x1a\xb9N\xf6h=W\xd2" +
-	"M\x13\xf3\x09U\x87\xcb\x05Wz\xa3\xae\x85\xf9D\x8e" +
-	"#`\x8ba\x19\x95!\xcc':\x16\x02\xbb\\Y\xb1" +
-	"\xcdm\x12\xf3\x89z\xc6\x88)\xf5\x0a!\xb1XG5" +
-	"d\x0fTlSz\x12rE}\x9b\xc4\xd9\xc0p6" +
-	"\xa0?`\xdb^\xc5su@\x07\xf3\x89PL\xfeQ" +
-	"\xa1S\xd2\xdf\xda\xcfv9\xae\xbd\xcd(\xd39\xf1\xc0" +
-	"\x119\xad\x97J\xd2\xa1\xdb\xc7\x82\x1a\xdd~\xd86\xe8" +
-	"\x92\xb1\x0cDG\x94\x8d\x8a\x1c\x19\xd0]\xe0\x836\xe6" +
-	"\x13I\x89\xe0:.\x09\x8b\\\xf6\x07<\x19pIs" +
-	"\xc2%\x8b\x88\xe9\xff\x87\xa3\xb8\xae\xae\xce\x95\xe5D\x03" +
-	"\xcb8\x8a\x1b\x19\xfa\xc6\x88c\xbb\xd4b\x995\xba\x13" +
-	"\xb7\xa8\x13\xd0\x9c,O\xdb\xa2um\xd6\xabWM[" +
-	"\xc7rtv\xa4F\x8bV\x03\x88\x85\x1c\xc52\x86J" +
-	"M\x8e\x96\xac\x07\x10\xff\xcbQ\xacc\xb8\xabd[\x9e" +
-	"\xb4\xbc8\xe8%\xdd\xe9\xd7\x07LI\xdc=\x0b\xb0\x97" +
-	"#\xe6\x93\x89\x13\x90\x16/97\x88v\xd8\xde\xad\xf1" +
-	"\xb9]D\\\x9d\x1cEo\xa2\x82\x1bH\x05\xd7q\x14" +
-	"\xfdu*(\xfa\x00D/G\xf1\xad\xaf\xac=\xae," +
-	"\x19\x8e!-\xc0\xc4\xfb:\xc7\xfa\x82\xd2\x05\x98$\xcf" +
-	"\xeb\x13yV\xf0J\x0d\x11Q9}\xa0N\x8a\xb3\xdc" +
-	"\x8f\x08\x07\xa9Qc%\xcd\xa6.F|\xd3\x14\xb4|" +
-	",\xc6\xd9\xa6\x0b\x11\xdfdI\xbc\x8b\xad\x04\\\x16\xf0" +
-	"\xcd\xf9\x88o\xe6\xe0#\x00\xc5\xcb\x08X\x88\x0c\xe7g" +
-	"\xce\xf9,$\x9c\x05x\x1c\xa0\xb8\x90\x90eAk\x7f" +
-	"\x11\x11\xce\x92\xe0'\xcb\x08\xb8\x91\xf4\x9a-\x08\xd4]" +
-	"\xbd!\xa0\x95\xebi\xbd\x93Z^\x0f*#\xd4\xda\x84" +
-	"\xda\x83N\xebE\xd2\xdc5\xbaS\x81@<\x9bH\x01" +
-	"\xa9AGM\xaf\x91\x12\xcb1j\x0f\xc3\x06\xb4\xa62" +
-	"\x84_\xd2\xad\x924I\x052~\xb4G\x11\xa5\xe5u" +
-	"\x99\x15\xb9=7$]\x12'O\xdf*\xd7\x92\xf8n" +
-	"\xf4\x86\xa4+Fe{\x90\xd0\xd8\xb3\xb0\x03\xd7\xbah" +
-	"\x8f\xf4\x07\xf2\x92#\x89\x8f\xd3g\xd9k\x03^\x81\xc2" +
-	"\xd7\xa5,\xcb\xf2\x14\xd9\x0e\xf2J\x97\x0b\x0b\xae\xae\xd0" +
-	"\xe75*\xf4\x1dQ\xa1_\xcf\x90\x1b\xf5\xd2\xb7E\xba" +
-	"\xd2*AA\xae\xb1G-/\x01\x92\x8e\xee\x8a\x82a" +
-	"-\xed\xaf:2,\xa3|P\xb2\x8b:($\xca\x82" +
-	"\xcd\x00\xc8\x94\xf9\xc3\x00\xc8\x95\xcb]\x80\xc2\x16\xdd0" +
-	"e\xd9\xb7\xb7I\xd7\xb4\xf52pY&\x0e)\xd9\x96" +
-	"%!W\xf2dy2C_z1b\xce)\x9d\xd4" +
-	"\x97tR\x16\xfd\x88<6\\\x95\xf4R\x96]\xf4\x1b" +
-	"4SD\x1e\xdd\x80\xf1\xc53%\xdd\x99\xd4\xcd_\x9e" +
-	"\xf7\x9a\x87\xdc\xe9\xe8\x8f&\x02\xafZ?;\xa1;}" +
-	"*\xe2Lt$\x14H\x99\x88\x12^\xd8fX\xb2\xbb" +
-	"<%\xfe\xe8tD\x85\x00\xd3\xf3J\xdc\xbe\x1b\x0e&" +
-	"\xd7\x0e\xfa\x84!.\xbfe\x1e\x02\x882G\xe1L\xc3" +
-	",\xb56\xe9\xc3\xa0\x9a\x89x+q\x9b\xf8\xae\xbcu" +
-	"\xd4pe\x17\xd7]\xb3\xba&\xa8}S\xa7-n\xb2" +
-	"\xdd\xad\xbak\x8fr\xab\\g\x9d8\xbe*(\xf1\x7f" +
-	"\xe7xL\x88\x94\xae\x1e\x8e\xe2f\xf2\xfb\xca0\x87\x9b" +
-	"V\x7f\x09!\xfa\x81\xbeU\xc2t\xd54/\x90\xa9A" +
-	"\xbb\xd1\x98\xdb\x19\x89\xd8\xa0\xbd\xb4d[9O\x8ey" +
-	"\"\x1f\x88S\xe8\x85NM\xf2]\x8e\xc2\xac\xa9\x13\xb9" +
-	"a\x10%\x9a\x1c\xc5\x18\x15\xd8\x85\x90\xf9\x94QJ\xa3" +
-	"\xc3Q\xdcF<y>\x1ah\xab\xe4\xb2\xc7Q\xecf" +
-	"\xb59\xb4\xc7\x86\x82\xed\x0c\xe8\xa5\xadS\xe6M\xec\xb1" +
-	"C$!\xacH\x98!\x1dkw\x83\x82\x08\x1b2c" +
-	"\xd8\x96Ha\xfd#\x1e\x17\xe7\xa8E\x85\x16\x07{'" +
-	"\xb99\xc6Q\xece\x88,\xbc\xe6\x9e_\x02\x88\xbd\x1c" +
-	"\xc5\x04\xbd\xb6\"\xf5\x19\xbf\x0f@Lp\x14\xf7S9" +
-	"\x85O0\xe50\x8d\xfews\x14\x0f0TR\xe1\x03" +
-	"L9r\x0d\x808\xc4Q<\xc4h*\xd2+\xb6\x85" +
-	"\xad\xc0\xb0\xb5n\x12\xc1\xee\x0a=T\xa4[\xa8\xac\xd5" +
-	"GM/y\x92\xd4\x0c:G]}\xc00\x0d\xeeU" +
-	"k\x0f\xa3\x9cWu$\xe6\x92\xfb\x00b\x0e\xb0\xdds" +
-	"\xf5\x92\x8c\x8f\xa8\xcbgo4\x14\x84#\x01@\x10\x8d" +
-	"\xf8a\xac\xe0<\xbe\xd1\xa9\xef\xc6\xcdI\xe7\xd5\x0ao" +
-	"y_4z\xf4LWc\x9e\xab[\x95-\xb6\x0b8" +
-	"\x92L\x01\xf1!\x93\xa6\x00\x16\xbe\x83\x97\xd6^rf" +
-	"\x8e\x1erT\xfcA\x91\xd1\xeb\xa6\x8b2\xb22<1" +
-	",\xb24\x80\xd2\xbd>a1zM\xb1@\xfa\x14\xb1" +
-	"9i\x81B)\x88(\xa4\xfd\xaa=\xeaV\xa4\xb9\x85" +
-	"\xf4\xa7\xf64\x01^'\x1eu\xb5\xb2:\x98\x1c3\xae" +
-	"\xeeL\xa2\xa6F\xc1\xa0\x1a\xb8\x8e\xa3X9]0\xca" +
-	"\xd2qeI\xf7P\x967\x0e\x0c\xcb\x92G\xe0\xe4S" +
-	"\xa7d&\xb3t\xa33y\x10\\\x9c0c\xdd\xa3r" +
-	"\xc9\xed\x89L\xe5,\xdbv \xed\x0fJ\xaf\xd76," +
-	"\x0f\xa5\xbb\xd6\x90f9~H\xd7_3l\xed\x1c\xf5" +
-	"\xf6\xa4{v\xd4S0\xd6\xfdSHY\xb2\x1a\xd8\xb4" +
-	"3U8\x0d\x8ey\x97\xfc\x8fc\xbdmX\xff\xe9t" +
-	"\xb7:a\xb8\xaf6\xdd\xed\xda*\xab\xa44\xb58\xff" +
-	"+\x00\x00\xff\xffo\xa6F\xe8"
+const schema_b312981b2552a250 = "x\xda\x9cX\x7f\x8cT\xd5\xbd\xff~\xee\x99\xddY`" +
+	"\x87\x99\xd9;\xc0\xb2O\xb2\xe2\x83\xbc'y\xf0\xf8a" +
+	"\xde\xf3\xed\x93\x0c.\x0b\x01\x02\x8f=;\xcbSiM" +
+	"{w\xe6\xb0\\\xb8{\xefp\xe7.0D\x02Zm" +
+	"\x94J\x8aF-\x1am)\xe9\x1f\xd5\xd2\x88\x88\x11[" +
+	"H\x85\xfa\x87\x9aZ5RS\x8d\xa4\xd5\xd4T\x9b\x9a" +
+	"\xd8Z-\xc8\x8f\xdb|\xef\xdc\xb9w\xf6W\x88\xfdk" +
+	"\x92\xf39\xf7\x9c\xef\xcf\xcf\xe7{f\xe1/\x93\xcb\x12" +
+	"\x8bR\xff\x99!M\x9elj\xf6\xcf\xae}b\xe7\xaf" +
+	"\x0b[\xbeEr2\x84\xdf{\xb8o\xee\xbf\x1cl{" +
+	"\x96\x9aD\x92H\xbf\x9c\xdcEXr!\xe9\x83\xe0\x1f" +
+	"=\xf1\xed)/\x9d\xfb\xd7{x'\xe2\x9d+\x90l" +
+	"&\xd2\x9b&\x9f!,i\x9a\xfc]\xde\xba\xf8\xe8\xfe" +
+	"\xbd\x9d?x\xfe\x81\xb1[\xa7\x12\xe9ONy\x90\xb0" +
+	"\xe4\xc9)3\x04\xc1?}Q\xbf\xb5?w\xf2\xe1\xb1" +
+	"[5h\xfa\xc7S\xcf\x10\xf4\x8f\xa7\xee \xf8\xff\xeb" +
+	"=\xb2\xf4:c\xeac\x94\x9d\xdc\xb0\xb1IcC\xd7" +
+	"\xa5\x1f$\xe8\xeb\xd2\xbco\xe3\x91\xd3\x17\xb7&\xb6<" +
+	">\xea\xc4\xda\xc6S\xc1\xc6S\xe9\xa7\x09~\xd7-\xcf" +
+	".\xdd\x7fl\xe6\xf7y\xa36\xd2!\x08\xfd\xf6\xcc>" +
+	"\xc2\x92\xdb3\x81\xef\xdf\xf3\xde\xd8\x9d\xb2:~:\xea" +
+	"\xcc\x04\x1f\xb9\xbf\x8d\x8f\xdc\xdf\xc6w\xdfzjm\xfe" +
+	"\x83G\xee?F\xd9\x9c\xe6w\x98\xafw5??\xf7" +
+	"m\"\xe8\x9f\xb4\xbdJ\xd0\xff\xd26H\xf0\xed\x96\xfb" +
+	"\xbe\xdc\xf0\xc8\x99\x9f\x8f\xef\xf2l\x9d\x8f\x9b\xad\xb3\x85" +
+	"\xbb\xb5O\xdf\xbf\x9c,\xbf5\xda\x15$\x89\x96\x9c\xd6" +
+	"\xdb@\xd0_\xd1\xf9\xe2\xe2\xd4\xf3g\x8e-\xd8\xfd\xd6" +
+	"x\x06\xce\xcd\xed#\xe8ss|\xe2\xf4e\x1b\x0e\x0c" +
+	"<\xf7\xca\xd9\xf1N\xd4\xdf\x096\xbe\x13l\\w\xee" +
+	"k\xeaw\xc7\x07~Cr\x1a\xe0g\xff\xfbT\xfa;" +
+	"\xffU\xba@\x1b\x90D\x02\x9a^\x9d\xf6'\x82\xbe{" +
+	"\xda\x1f\x09\xb1\x9f\xa3\xce\x0cJ\xe8\xf6\xe9\x87u5\xfd" +
+	"\xdf\x88\x96T\xa7\xdf\x02\x82\x7f\xee|\xcf\xac\xb6\xf5\xa7" +
+	"\xdf&\x99C\xc3\xb75\x0b>\x9c\xf1.\xe7{\xc6\x0e" +
+	"\xc2/\x0e]\xe3\xbc\xf6\xf63\xbf\x1d\xcf\xd0\x15\xed\xaf" +
+	"\xea\xb2}\x06\x91~[;{\xff\xe87~\xd2\xf1\xc5" +
+	"\xd1\x8f\xde%\x99\x86\x88Kz\x83HB@\xe8\xaf\xb5" +
+	"\xb3\xb1o\xb6\xb3_/\xd93\x16\xed}}\xed\xc7\xe3" +
+	"\x06`\xdb\xcc\xc3\x04}\xdbL>\xf3\xce\x03\xff?\xad" +
+	"\xe7\xa1\xe9\x9f\x91\x9c\x89\xc8\x98\x9e\xa4\xc6q\x9a\xf9\x01" +
+	"A?\x17l\x8b\"3\xdeyK;\x9e\"\xe8K;" +
+	"x\xe3\xd3\xf8\xfd\x81\xc4\xc1\xf7/\x8e[\x96\xbb;v" +
+	"\x11\xf4j\xc7\xd3\xe4S\xcew\xcb\xc5\x05E\xa3lS" +
+	"\xbe\xdc\xb5\xdc\xb0,9G$\x88\x12 \xca~\xb2\x91" +
+	"H\xfeY@\x9e\xd7\x00\xe4\xc0k\x9fw\x11\xc9O\x05" +
+	"\xe4%\x0dY\x0d9hD\xd9\x0b\x03D\xf2\xbc@!" +
+	"\x01\x0dY\xa1\xe5 \x88t`\x0dQ\x1f\x04\x0a\xad\xbc" +
+	"\x9cD\x0e\x09\"}\x12\xba\x88\x0a\x09^\xcf@\x03Z" +
+	"\xd0\x10S=\x05\x97\xb4lbo\x8e\xd7\xb3\x17\xce\x10" +
+	"\xc9K\x02\x85\x16>\xa1\xe9\xce\x1c&\x01z\x13\x0e\x13" +
+	"\x15Z\xf8\x84\x1c\xaf7\xdf\x95\xc3d@\xcf\x06\xeb9" +
+	"^\xbf\x16\x1a\xfcm\xc3\xaa\xe2\x99\x8eMbu\x09-" +
+	"\xa4\xa1\x85\x90\xf7\x0cwPy\xc8\xc4d@@\x86\xe0" +
+	"\x9b\xb6\xa7\xdcMF\x91\x92ju\x09\x93H\xc3$\x82" +
+	"?\xa4\xbc\xcdNiu\x89\x88\x90$\x0dIB\xbel" +
+	"\xb8\xc6P\x05\x99\x98%\xc2#*\xca.\xf5\xa9\xca0" +
+	"uZ^\xa5\xdf\xf1\x0d\xcbrv\xf4o65\xb7\xd4" +
+	"k\xb8^\xb5\xdf0-\x8e1\x01\xa4\x01\xdc\x9eN\xaf" +
+	"\xeb\x0c\x99\x15\xa8^\xb3\xac,\xd3N\x9a\xf6`\x84:" +
+	"\xb6Ue\x1cf\xa5\x86'M[Eh=s\x1a'" +
+	"\xae\xdc\xa3*E\xd7,{\x8eK\xf2\x1a\x91h\xf5\xfd" +
+	" \x87\xcf\xcd#\x92G\x05\xe4I\x0d\xb3p\xc5\x0f\xd3" +
+	"\xf8\xc2\x16\"yB@\xbe\xa4a\x96v\xd9\x0f\x13y" +
+	"\xda%\x92/\x0a\xc8_i\x98%.\xf1\xb2 \xca\xbe" +
+	"\xb2\x8bH\xbe, \xcfjH%.\xfaA\"\xb3o" +
+	"\xf2\xea\x1b\x02\xf2=\x0d\xa9\xa6/\xfd\x1c\x9a\x88\xb2\xef" +
+	"\xec#\x92\xef\x09\xc8\x8f83Z\x0e\xcd@\xf6C\xae" +
+	"\xa4?\x08\xc8O5\xa4m\xc7V\xd4\x1c\x04K\xb9\xab" +
+	"\x1cJW<\x15\xe5'\\\xeeu\xa9\x93\xe3\xa2\xa2u" +
+	"W\x15\x95\xb9]\xb9\x94_\xe5\x8c\xf8 \x06n\xb6+" +
+	";\x94\x8bL\xbd\x81\xc2\xacx\x9b\xcd \xfe\xf0\xaa\xb5" +
+	"O\x89\x90\x89\xe9.\xdcex\x9eQ\xdc\xacJ$V" +
+	"\x96\xd0LZS\xb3\xdf\x10c\x94\xbb\xd6\xa9J\xc5\x18" +
+	"\x84\x927F\xd1\xd5\xabp\x89\x0a;\xb9\xe2\xee\x86\x86" +
+	"\x14\xae\xf8A|\xf5;\xb1\x98\xa8p\x07\x03\xf72 " +
+	".\xfbA\x84\xf5{0\x8f\xa8\xb0\x97\x81\xfb\x19H\\" +
+	"\xf2k\xddr_\xd0\x16w3p\x80\x81\xa60\xcc\xfa" +
+	"\xfe\x00\xb8\x97\x81\x87\x18h\x0e#\xad?\x80n\xa2\xc2" +
+	"\xfd\x0c\x1cd y\xc1\xcf\x81\xe5\xf1\xe1\x008\xc0\xc0" +
+	"\xe3\x0cL:\xef\xe7\x02\x8ax\x14[\x88\x0a\x07\x19\xf8" +
+	"\x11\x03\xda\xdf\xfd\x1cZ\x88\xf4\x1f\xa2\x8f\xa8p\x88\x81" +
+	"#\x0cL\xfe\xc2\xcfa\x12\xcb'v\x11\x15~\xcc\xc0" +
+	"q\x06\xa6|\xee\xe70\x99H\x7f&\xb8\xe3\x08\x03'" +
+	"\x18h\xfd\x9b\x9f\xc3\x14\"\xfd\xb9\xc0\xdc\xa3\x0c\x9cd" +
+	" \xf5\x99\x9fC+\x91\xfeB\xe0\xf9q\x06^d\xa0" +
+	"\xe5\xaf~\x0e)\x96Il$*\x9cd\xe0en\xdb" +
+	"a\xdb\x1c*[j\x88:\x95\xcd\x89\xce\xc4\x12_\xcb" +
+	"U\xa71\xe0\xb8\xdc\xc2\x0d\xa2\xc7\xeb\xe9\xa2aY\xc8" +
+	"\xc4\x9c\\[\xce\xbb\xca\x1bvmdb\x11\x0e\x81M" +
+	"\xa6mV6#\x13+Z\x0d\xd8\xe3\xaa\x8acmW" +
+	"\xc8\xc4\xfa\x19!\x962*\x8cD2]C|g\xa0" +
+	"\xe2X\xcaS\x94.\x18\xdb\x15\xdaHC\x1b\xc1\x1fp" +
+	"\x1c\xaf\xe2\xb9\x06\xa1\x8cL\xac\x08\xa3?\xca\xf7(\xfe" +
+	"\xad\x7f\xb6\xa7\xec:\xdb\xcd\x12\xdf\x13\x8d\x18\xa1\xd1F" +
+	"\xb1\xa8\xca\xec}$\xaf\xa1\xf7[\x1c\x93\x9d\x8c\xb8?" +
+	"\xbc\xa2dV\xd4\xd0\x80\xe1\x92\x18t\x90\x895$\x84" +
+	"\x1bX\xa4V\xe1\xaa?`H\x92-1\x8b\\\xcf\x04" +
+	"\xff\xef\x02\xf2\x86\x86\"\xcf.b\x02X( o\xd2" +
+	"\xe0\x9bCe\xc7\xe5\xe6J.7\xcaQs\x96\x03v" +
+	"S\xa5\x09\x9b\xb3\xa1\xc1z\x8d\xaa\xe5\x18(\xc9\x96H" +
+	"\x81\xae\xef&\x92s\x04\xe4B\x0d\xd9\xba\x04\xcd_C" +
+	"$\xffC@\xae\xd2\xb0\xa7\xe8\xd8\x9e\xb2\xbd(\xdcE" +
+	"\xa3\xdco\x0cX\x8a\x880\x95\xd0+\x80L<M\x12" +
+	"0u\xd4\xa5A\x9c\xa1dkt\xe9\x0a&\xab\x1e\x01" +
+	"\xd9\x1b\xcb\xde:\x96\xbdU\x02\xb2\xbfA\xf6d\x1f\x91" +
+	"\xec\x15\x90_\xff\xcab\xe3\xaa\xa2Y6\x95M\x88M" +
+	"o\xb0\xaa/\xa8\xd8\xc0\xf7H\x8c\xd7\xc4b\x9c\xc5\xb5" +
+	"9\x00\xc8~\xbe\xafAxS\xc2\x0fI\x06\xe8n\xd0" +
+	"\xcdT\xe2J\xc81M\xe8k\x94\xdeT\xd3\xe5\x90c" +
+	"R,\xd5\x85V\x06\xda\x03\x8e\xb9\x14r\xcc4<E" +
+	"Thg`\x0e4\xccJ^\xf4\xb5\x1a\xc9\xcc\xc61" +
+	"\xa2\xc2\x1cF\x16\x06\xed\xfceH2\xf3\x83O\x162" +
+	"p\x13\xab\xb36;\xd0r\xfd\x7f\x02*\xb9\x91\xd7{" +
+	"\xb8\xcd\x8d\xa0 j\xca\x1asy\xd0]\xbd`\x85]" +
+	"n\x94+\x14He\x13Pk\xcaa\xcb\x1bOw\xd5" +
+	"Nn\x09\xd3!\xd8cY\xc1/\x1avQYL\xfb" +
+	"I?<\xa3\x00e{+\xac\x8a\xda\x91\xde\xac\\V" +
+	"#\xcf\xd8\xaaV\xb2\xd4\xae\xf76+W\x0e\xab\xce " +
+	"\x9b\x91e\xb5\xae[\xe9\xc2\x19\xea\x0f\xf4$\xcd\x82\x1e" +
+	"\xe5\xcevV\x06\\B\xf9\xffS\xaa\xa4JcD:" +
+	"H*;\x07\xd5X\xdf\x1d\xe3\xd5\xf7\xae\xb0\xbeo\xd4" +
+	" \xccF\xa1\xdb\xa4\\e\x17)\xaf\x96;\xc3\xb6\x17" +
+	"\x03q\x0b\xaf\x08#a/\xe8\xaf\x96\x15\x91\xcc\x04\x95" +
+	"z}\x17\x11\x90\x9d\xbd\x91\x08Zv\xd6\x16\"\x88\xec" +
+	"L\x97(\xbf\xc90-U\xf2\x9d\xed\xca\xb5\x1c\xa3D" +
+	"B\x95\x981\x8a\x8em+J\x17=U\x1a\xcd\xc7#" +
+	"]b\x9e\x1c\xd9=}q\xf7\xa4\xe0\x87T\xb1\xee\xba" +
+	"\xb8\x7fR\xda\x15\x7f\x9c\x06\x0a\xa9b5!r9Y" +
+	"4\xca\xa3\xda\xf7\xea\xe9\xae\x9b'\xca]\xfd\xa1\xf2{" +
+	"\xd5\xc6\x01\x09\xee\x04\x19\x88\x12\xd0\x15\xb3\x1d' L" +
+	"r~\xbbi\xab\xd5\xa51aG\xb9+L>M\xc0" +
+	"\"Q\xbf\xae{0v8h\x0c\x0dXt[\x07\x88" +
+	"dI@\x96'\xe0\x91z_\xf4!(_&\xd8J" +
+	"\xd4\x17\xbe\xab\xb6\x0d\x9b\xaeZ!\x0c\xd7\xaa.\x0f\x8a" +
+	"\xdd2\xf8\x88[\x1cw\xab\xe1:\xc3\xc2.5\xec\x8e" +
+	"\xad\xbe9\xa8\xe9\x09\xad\x8e\xb8\x8f\xb3\xb4V@\xde\xca" +
+	"F_[K\xdd\x86\xee\xabp\x9f\x1f(X\xa5\x96\xa5" +
+	"\xba\xaa\x05B4\xe8\x8c7\xc2\xf6\x8425\xe8,(" +
+	":v\xdaS;=\x99\x09\x14\xa8f\x85\xc1]\xf1M" +
+	"\x01i\xd5%\x88\xcd0\x99\x00-\x01\xb9\x93\xeb\xear" +
+	"\x8d\xe7\xb2\xc3\x9c\xc0\xb2\x80\xbc\x83Y\xf1R8\xafV" +
+	"\xd9dO@\xee\xd5\xeac\xe6Z\x87\xf2Ny\xc0(" +
+	"n\x1d3Nb\xadSCbz\x0a\xa5\x97\x9a#u" +
+	"\x1e\xa7\x14j\x1d\x984\x1d[\xb6\xa0\xf1\x91>i^" +
+	"\xfc\x1c\xcd6u\xa5\xb9A\xf3=\xca3LK\xb6G" +
+	"\x09x\x94M\x7fH@\x1e\xd2\x00\xad\xe6\xfa\x13?#" +
+	"\x92\x87\x04\xe4\x11~]\x85\xe2\xf3\xe4cD\xf2\x88\x80" +
+	"<\xc1\xf5U{r\x8d\x98\xf6\xb3\x89\xda\x83+\xfb\xc2" +
+	"b\"y\\@\xbe\xc8o(\xad6\xa6\x9f\xea\x0e\x1f" +
+	"\x00g5\x1e\x90\x8c\x8ac\xa3\x954\xb46\x0c%X" +
+	"]\xe1\xd7\x8ar\xf3\x95\x95\xc6\xb0\xe5\xc5\xef\x92\xfa\x86" +
+	"\x9ea\xd7\x180-Sx\xd5\xfa\xeb(\xedU\xcb\x0a" +
+	"\xe9\xd8q\x02\xd2\x84N\xcf5\x8a\xaa~\xc5\x9eR\xe0" +
+	"w%\x96\xe8(4\xa3$z\x04\xa7q\xb4\x84i]" +
+	"\xad}\x17\x8fl\xdf\xf0)\xd7\xb9\xdd\xb0\x86\x15R\xa4" +
+	"!5\xf2\x82\xdepH\xa9\x8d(D2\x81\x86'y" +
+	"\x16\x1db}\xb9\xf1\xce\x8d\xf1\xf9\xf5;\x17\xf5\x85\xa3" +
+	"\xd0\xda\x89\xda\xc1s\x0d\xbb\xb2\xc9q\x09C\xb1\xd7\xd1" +
+	"%c\xbd\xe6\xc8/\xa8?(\xad4\xbf'ek\xd8" +
+	"\x0f\x9c\xc0\x15\\(\xcbj7\xd6\xfa\xa1\x99(\xbbz" +
+	"M\xcc\xb3\xfc\xae\xd3\x02M\xce\xca\x8dq\xb7\xe6\x8bA" +
+	"N\xa9\xd9\xaf:\xc3nEY\x9bX\x18\xeb\x8f$\x12" +
+	"\x0d\xaa\xd6P\xd6\xdd\xc1\x18\x9bt\x8d\xabF\x82\xeb\xf2" +
+	"\x06\x01\xb9l\xa2H\x94T\xd9UE\xc3\x83*\xad\x1f" +
+	"\xd8\xa2\x8a\x1e\x83\xa3\xaf\x1c\x93\x96\xe4\x82Z\x12\xa2\x91" +
+	"t^\x9c\xf9\x86\x87\xed\xfc\xbbb\xf1L\xdb\x8eS\xa6" +
+	"f\x7fPy\xbd\x8ei{P\xeeJSY\xa5\xe8%" +
+	"\xdf\xe8`\x8d\x7f\xd2L@\x8d\x1ev5\xd6\x17\x1a\xfe" +
+	"\xaa\xca\xce\xef&m\xc2\x01\xaf6\x97\xee\xf4F\xfc\xbd" +
+	"\xb2\xc61\xed\x7fj\xce\xec\x8e\x09\xf8\xab\xcd\x99{\xb6" +
+	"\xaa*\xeb_=\xbc\xff\x18\x00]`mH"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -3106,6 +4143,7 @@ func RegisterSchema(reg *schemas.Registry) {
 			0xd4c9b56290554016,
 			0xd562b4df655bdd4d,
 			0xd625b7063acf691a,
+			0xd6c14f121d44f8dd,
 			0xd800b1d6cd6f1ca0,
 			0xdae8b0f61aab5f99,
 			0xe94ccf8031176ec4,
@@ -3116,3 +4154,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_b312981b2552a250,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -8,122 +8,237 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 )
 
-type Side uint16
+// Joins in the two-party case are simplified by a few observations.
+//
+// First, on a two-party network, a Join only ever makes sense if the receiving end is also
+// connected to other networks.  A vat which is not connected to any other network can safely
+// reject all joins.
+//
+// Second, since a two-party connection bisects the network -- there can be no other connections
+// between the networks at either end of the connection -- if one part of a join crosses the
+// connection, then _all_ parts must cross it.  Therefore, a vat which is receiving a Join request
+// off some other network which needs to be forwarded across the two-party connection can
+// collect all the parts on its end and only forward them across the two-party connection when all
+// have been received.
+//
+// For example, imagine that Alice and Bob are vats connected over a two-party connection, and
+// each is also connected to other networks.  At some point, Alice receives one part of a Join
+// request off her network.  The request is addressed to a capability that Alice received from
+// Bob and is proxying to her other network.  Alice goes ahead and responds to the Join part as
+// if she hosted the capability locally (this is important so that if not all the Join parts end
+// up at Alice, the original sender can detect the failed Join without hanging).  As other parts
+// trickle in, Alice verifies that each part is addressed to a capability from Bob and continues
+// to respond to each one.  Once the complete set of join parts is received, Alice checks if they
+// were all for the exact same capability.  If so, she doesn't need to send anything to Bob at
+// all.  Otherwise, she collects the set of capabilities (from Bob) to which the join parts were
+// addressed and essentially initiates a _new_ Join request on those capabilities to Bob.  Alice
+// does not forward the Join parts she received herself, but essentially forwards the Join as a
+// whole.
+//
+// On Bob's end, since he knows that Alice will always send all parts of a Join together, he
+// simply waits until he's received them all, then performs a join on the respective capabilities
+// as if it had been requested locally.
+type JoinKeyPart capnp.Struct
 
-// Side_TypeID is the unique identifier for the type Side.
-const Side_TypeID = 0x9fd69ebc87b9719c
+// JoinKeyPart_TypeID is the unique identifier for the type JoinKeyPart.
+const JoinKeyPart_TypeID = 0x95b29059097fca83
 
-// Values of Side.
-const (
-	Side_server Side = 0
-	Side_client Side = 1
-)
+func NewJoinKeyPart(s *capnp.Segment) (JoinKeyPart, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return JoinKeyPart(st), err
+}
 
-// String returns the enum's constant name.
-func (c Side) String() string {
-	switch c {
-	case Side_server:
-		return "server"
-	case Side_client:
-		return "client"
+func NewRootJoinKeyPart(s *capnp.Segment) (JoinKeyPart, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return JoinKeyPart(st), err
+}
 
-	default:
-		return ""
-	}
+func ReadRootJoinKeyPart(msg *capnp.Message) (JoinKeyPart, error) {
+	root, err := msg.Root()
+	return JoinKeyPart(root.Struct()), err
 }
 
-// SideFromString returns the enum value with a name,
-// or the zero value if there's no such value.
-func SideFromString(c string) Side {
-	switch c {
-	case "server":
-		return Side_server
-	case "client":
-		return Side_client
+func (s JoinKeyPart) String() string {
+	str, _ := text.Marshal(0x95b29059097fca83, capnp.Struct(s))
+	return str
+}
 
-	default:
-		return 0
-	}
+func (s JoinKeyPart) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-type Side_List = capnp.EnumList[Side]
+func (JoinKeyPart) DecodeFromPtr(p capnp.Ptr) JoinKeyPart {
+	return JoinKeyPart(capnp.Struct{}.DecodeFromPtr(p))
+}
 
-func NewSide_List(s *capnp.Segment, sz int32) (Side_List, error) {
-	return capnp.NewEnumList[Side](s, sz)
+func (s JoinKeyPart) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s JoinKeyPart) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-type VatId capnp.Struct
+func (s JoinKeyPart) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
 
-// VatId_TypeID is the unique identifier for the type VatId.
-const VatId_TypeID = 0xd20b909fee733a8e
+func (s JoinKeyPart) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
 
-func NewVatId(s *capnp.Segment) (VatId, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return VatId(st), err
+// A number identifying this join, chosen by the sender.  May be reused once `Finish` messages are
+// sent corresponding to all of the `Join` messages.
+func (s JoinKeyPart) JoinId() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func NewRootVatId(s *capnp.Segment) (VatId, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return VatId(st), err
+func (s JoinKeyPart) SetJoinId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-func ReadRootVatId(msg *capnp.Message) (VatId, error) {
+// The number of capabilities to be joined.
+func (s JoinKeyPart) PartCount() uint16 {
+	return capnp.Struct(s).Uint16(4)
+}
+
+func (s JoinKeyPart) SetPartCount(v uint16) {
+	capnp.Struct(s).SetUint16(4, v)
+}
+
+// Which part this request targets -- a number in the range [0, partCount).
+func (s JoinKeyPart) PartNum() uint16 {
+	return capnp.Struct(s).Uint16(6)
+}
+
+func (s JoinKeyPart) SetPartNum(v uint16) {
+	capnp.Struct(s).SetUint16(6, v)
+}
+
+// JoinKeyPart_List is a list of JoinKeyPart.
+type JoinKeyPart_List = capnp.StructList[JoinKeyPart]
+
+// NewJoinKeyPart creates a new list of JoinKeyPart.
+func NewJoinKeyPart_List(s *capnp.Segment, sz int32) (JoinKeyPart_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[JoinKeyPart](l), err
+}
+
+// JoinKeyPart_Future is a wrapper for a JoinKeyPart promised by a client call.
+type JoinKeyPart_Future struct{ *capnp.Future }
+
+func (f JoinKeyPart_Future) Struct() (JoinKeyPart, error) {
+	p, err := f.Future.Ptr()
+	return JoinKeyPart(p.Struct()), err
+}
+
+type JoinResult capnp.Struct
+
+// JoinResult_TypeID is the unique identifier for the type JoinResult.
+const JoinResult_TypeID = 0x9d263a3630b7ebee
+
+func NewJoinResult(s *capnp.Segment) (JoinResult, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return JoinResult(st), err
+}
+
+func NewRootJoinResult(s *capnp.Segment) (JoinResult, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return JoinResult(st), err
+}
+
+func ReadRootJoinResult(msg *capnp.Message) (JoinResult, error) {
 	root, err := msg.Root()
-	return VatId(root.Struct()), err
+	return JoinResult(root.Struct()), err
 }
 
-func (s VatId) String() string {
-	str, _ := text.Marshal(0xd20b909fee733a8e, capnp.Struct(s))
+func (s JoinResult) String() string {
+	str, _ := text.Marshal(0x9d263a3630b7ebee, capnp.Struct(s))
 	return str
 }
 
-func (s VatId) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s JoinResult) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (VatId) DecodeFromPtr(p capnp.Ptr) VatId {
-	return VatId(capnp.Struct{}.DecodeFromPtr(p))
+func (JoinResult) DecodeFromPtr(p capnp.Ptr) JoinResult {
+	return JoinResult(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s VatId) ToPtr() capnp.Ptr {
+func (s JoinResult) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s VatId) IsValid() bool {
+func (s JoinResult) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s VatId) Message() *capnp.Message {
+func (s JoinResult) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s VatId) Segment() *capnp.Segment {
+func (s JoinResult) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s VatId) Side() Side {
-	return Side(capnp.Struct(s).Uint16(0))
+
+// Matches `JoinKeyPart`.
+func (s JoinResult) JoinId() uint32 {
+	return capnp.Struct(s).Uint32(0)
 }
 
-func (s VatId) SetSide(v Side) {
-	capnp.Struct(s).SetUint16(0, uint16(v))
+func (s JoinResult) SetJoinId(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
 }
 
-// VatId_List is a list of VatId.
-type VatId_List = capnp.StructList[VatId]
+// All JoinResults in the set will have the same value for `succeeded`.  The receiver actually
+// implements the join by waiting for all the `JoinKeyParts` and then performing its own join on
+// them, then going back and answering all the join requests afterwards.
+func (s JoinResult) Succeeded() bool {
+	return capnp.Struct(s).Bit(32)
+}
 
-// NewVatId creates a new list of VatId.
-func NewVatId_List(s *capnp.Segment, sz int32) (VatId_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[VatId](l), err
+func (s JoinResult) SetSucceeded(v bool) {
+	capnp.Struct(s).SetBit(32, v)
 }
 
-// VatId_Future is a wrapper for a VatId promised by a client call.
-type VatId_Future struct{ *capnp.Future }
+// One of the JoinResults will have a non-null `cap` which is the joined capability.
+func (s JoinResult) Cap() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return p.Interface().Client()
+}
 
-func (f VatId_Future) Struct() (VatId, error) {
+func (s JoinResult) HasCap() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s JoinResult) SetCap(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// JoinResult_List is a list of JoinResult.
+type JoinResult_List = capnp.StructList[JoinResult]
+
+// NewJoinResult creates a new list of JoinResult.
+func NewJoinResult_List(s *capnp.Segment, sz int32) (JoinResult_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[JoinResult](l), err
+}
+
+// JoinResult_Future is a wrapper for a JoinResult promised by a client call.
+type JoinResult_Future struct{ *capnp.Future }
+
+func (f JoinResult_Future) Struct() (JoinResult, error) {
 	p, err := f.Future.Ptr()
-	return VatId(p.Struct()), err
+	return JoinResult(p.Struct()), err
+}
+func (p JoinResult_Future) Cap() capnp.Client {
+	return p.Future.Field(0, nil).Client()
 }
 
+// Only used for joins, since three-way introductions never happen on a two-party network.
 type ProvisionId capnp.Struct
 
 // ProvisionId_TypeID is the unique identifier for the type ProvisionId.
@@ -171,6 +286,8 @@ func (s ProvisionId) Message() *capnp.Message {
 func (s ProvisionId) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+
+// The ID from `JoinKeyPart`.
 func (s ProvisionId) JoinId() uint32 {
 	return capnp.Struct(s).Uint32(0)
 }
@@ -196,6 +313,7 @@ func (f ProvisionId_Future) Struct() (ProvisionId, error) {
 	return ProvisionId(p.Struct()), err
 }
 
+// Never used, because there are only two parties.
 type RecipientId capnp.Struct
 
 // RecipientId_TypeID is the unique identifier for the type RecipientId.
@@ -261,6 +379,63 @@ func (f RecipientId_Future) Struct() (RecipientId, error) {
 	return RecipientId(p.Struct()), err
 }
 
+type Side uint16
+
+// Side_TypeID is the unique identifier for the type Side.
+const Side_TypeID = 0x9fd69ebc87b9719c
+
+// Values of Side.
+const (
+	// The object lives on the "server" or "supervisor" end of the connection. Only the
+	// server/supervisor knows how to interpret the ref; to the client, it is opaque.
+	//
+	// Note that containers intending to implement strong confinement should rewrite SturdyRefs
+	// received from the external network before passing them on to the confined app. The confined
+	// app thus does not ever receive the raw bits of the SturdyRef (which it could perhaps
+	// maliciously leak), but instead receives only a thing that it can pass back to the container
+	// later to restore the ref. See:
+	// http://www.erights.org/elib/capability/dist-confine.html
+	Side_server Side = 0
+	// The object lives on the "client" or "confined app" end of the connection. Only the client
+	// knows how to interpret the ref; to the server/supervisor, it is opaque. Most clients do not
+	// actually know how to persist capabilities at all, so use of this is unusual.
+	Side_client Side = 1
+)
+
+// String returns the enum's constant name.
+func (c Side) String() string {
+	switch c {
+	case Side_server:
+		return "server"
+	case Side_client:
+		return "client"
+
+	default:
+		return ""
+	}
+}
+
+// SideFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func SideFromString(c string) Side {
+	switch c {
+	case "server":
+		return Side_server
+	case "client":
+		return Side_client
+
+	default:
+		return 0
+	}
+}
+
+type Side_List = capnp.EnumList[Side]
+
+func NewSide_List(s *capnp.Segment, sz int32) (Side_List, error) {
+	return capnp.NewEnumList[Side](s, sz)
+}
+
+// Never used, because there is no third party.
 type ThirdPartyCapId capnp.Struct
 
 // ThirdPartyCapId_TypeID is the unique identifier for the type ThirdPartyCapId.
@@ -326,228 +501,110 @@ func (f ThirdPartyCapId_Future) Struct() (ThirdPartyCapId, error) {
 	return ThirdPartyCapId(p.Struct()), err
 }
 
-type JoinKeyPart capnp.Struct
+type VatId capnp.Struct
 
-// JoinKeyPart_TypeID is the unique identifier for the type JoinKeyPart.
-const JoinKeyPart_TypeID = 0x95b29059097fca83
+// VatId_TypeID is the unique identifier for the type VatId.
+const VatId_TypeID = 0xd20b909fee733a8e
 
-func NewJoinKeyPart(s *capnp.Segment) (JoinKeyPart, error) {
+func NewVatId(s *capnp.Segment) (VatId, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return JoinKeyPart(st), err
+	return VatId(st), err
 }
 
-func NewRootJoinKeyPart(s *capnp.Segment) (JoinKeyPart, error) {
+func NewRootVatId(s *capnp.Segment) (VatId, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return JoinKeyPart(st), err
+	return VatId(st), err
 }
 
-func ReadRootJoinKeyPart(msg *capnp.Message) (JoinKeyPart, error) {
+func ReadRootVatId(msg *capnp.Message) (VatId, error) {
 	root, err := msg.Root()
-	return JoinKeyPart(root.Struct()), err
+	return VatId(root.Struct()), err
 }
 
-func (s JoinKeyPart) String() string {
-	str, _ := text.Marshal(0x95b29059097fca83, capnp.Struct(s))
+func (s VatId) String() string {
+	str, _ := text.Marshal(0xd20b909fee733a8e, capnp.Struct(s))
 	return str
 }
 
-func (s JoinKeyPart) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s VatId) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (JoinKeyPart) DecodeFromPtr(p capnp.Ptr) JoinKeyPart {
-	return JoinKeyPart(capnp.Struct{}.DecodeFromPtr(p))
+func (VatId) DecodeFromPtr(p capnp.Ptr) VatId {
+	return VatId(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s JoinKeyPart) ToPtr() capnp.Ptr {
+func (s VatId) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s JoinKeyPart) IsValid() bool {
+func (s VatId) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s JoinKeyPart) Message() *capnp.Message {
+func (s VatId) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s JoinKeyPart) Segment() *capnp.Segment {
+func (s VatId) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s JoinKeyPart) JoinId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
-
-func (s JoinKeyPart) SetJoinId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
-}
-
-func (s JoinKeyPart) PartCount() uint16 {
-	return capnp.Struct(s).Uint16(4)
-}
-
-func (s JoinKeyPart) SetPartCount(v uint16) {
-	capnp.Struct(s).SetUint16(4, v)
-}
-
-func (s JoinKeyPart) PartNum() uint16 {
-	return capnp.Struct(s).Uint16(6)
+func (s VatId) Side() Side {
+	return Side(capnp.Struct(s).Uint16(0))
 }
 
-func (s JoinKeyPart) SetPartNum(v uint16) {
-	capnp.Struct(s).SetUint16(6, v)
+func (s VatId) SetSide(v Side) {
+	capnp.Struct(s).SetUint16(0, uint16(v))
 }
 
-// JoinKeyPart_List is a list of JoinKeyPart.
-type JoinKeyPart_List = capnp.StructList[JoinKeyPart]
+// VatId_List is a list of VatId.
+type VatId_List = capnp.StructList[VatId]
 
-// NewJoinKeyPart creates a new list of JoinKeyPart.
-func NewJoinKeyPart_List(s *capnp.Segment, sz int32) (JoinKeyPart_List, error) {
+// NewVatId creates a new list of VatId.
+func NewVatId_List(s *capnp.Segment, sz int32) (VatId_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[JoinKeyPart](l), err
-}
-
-// JoinKeyPart_Future is a wrapper for a JoinKeyPart promised by a client call.
-type JoinKeyPart_Future struct{ *capnp.Future }
-
-func (f JoinKeyPart_Future) Struct() (JoinKeyPart, error) {
-	p, err := f.Future.Ptr()
-	return JoinKeyPart(p.Struct()), err
-}
-
-type JoinResult capnp.Struct
-
-// JoinResult_TypeID is the unique identifier for the type JoinResult.
-const JoinResult_TypeID = 0x9d263a3630b7ebee
-
-func NewJoinResult(s *capnp.Segment) (JoinResult, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return JoinResult(st), err
-}
-
-func NewRootJoinResult(s *capnp.Segment) (JoinResult, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return JoinResult(st), err
-}
-
-func ReadRootJoinResult(msg *capnp.Message) (JoinResult, error) {
-	root, err := msg.Root()
-	return JoinResult(root.Struct()), err
-}
-
-func (s JoinResult) String() string {
-	str, _ := text.Marshal(0x9d263a3630b7ebee, capnp.Struct(s))
-	return str
-}
-
-func (s JoinResult) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (JoinResult) DecodeFromPtr(p capnp.Ptr) JoinResult {
-	return JoinResult(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s JoinResult) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s JoinResult) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s JoinResult) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s JoinResult) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s JoinResult) JoinId() uint32 {
-	return capnp.Struct(s).Uint32(0)
-}
-
-func (s JoinResult) SetJoinId(v uint32) {
-	capnp.Struct(s).SetUint32(0, v)
-}
-
-func (s JoinResult) Succeeded() bool {
-	return capnp.Struct(s).Bit(32)
-}
-
-func (s JoinResult) SetSucceeded(v bool) {
-	capnp.Struct(s).SetBit(32, v)
-}
-
-func (s JoinResult) Cap() capnp.Client {
-	p, _ := capnp.Struct(s).Ptr(0)
-	return p.Interface().Client()
-}
-
-func (s JoinResult) HasCap() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s JoinResult) SetCap(c capnp.Client) error {
-	if !c.IsValid() {
-		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
-	}
-	seg := s.Segment()
-	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
-	return capnp.Struct(s).SetPtr(0, in.ToPtr())
-}
-
-// JoinResult_List is a list of JoinResult.
-type JoinResult_List = capnp.StructList[JoinResult]
-
-// NewJoinResult creates a new list of JoinResult.
-func NewJoinResult_List(s *capnp.Segment, sz int32) (JoinResult_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[JoinResult](l), err
+	return capnp.StructList[VatId](l), err
 }
 
-// JoinResult_Future is a wrapper for a JoinResult promised by a client call.
-type JoinResult_Future struct{ *capnp.Future }
+// VatId_Future is a wrapper for a VatId promised by a client call.
+type VatId_Future struct{ *capnp.Future }
 
-func (f JoinResult_Future) Struct() (JoinResult, error) {
+func (f VatId_Future) Struct() (VatId, error) {
 	p, err := f.Future.Ptr()
-	return JoinResult(p.Struct()), err
-}
-func (p JoinResult_Future) Cap() capnp.Client {
-	return p.Future.Field(0, nil).Client()
+	return VatId(p.Struct()), err
 }
 
-const schema_a184c7885cdaf2a1 = "x\xda|\x92\xcfk\xd4@\x14\xc7\xdfw&u\xb7\xe8" +
-	"\xb2\xa4\xb3\"zQ\x04=\x88J\x8b\xa2\x90K\x16\x17" +
-	"\xc1h\x91\x9d\xb5\x15\x0b\xf6\x10\x92\xa0\x916I\x93l" +
-	"e\x0f\xb2\xa0\x82\xf6\xa0\xf4\"x\xb0\x96\x1e=\x89\xe2" +
-	"\xaf\x82\x1e\x14D\xe8\xd1\x83\x07\xff\x05\xa1\x87\xf6\xb6 " +
-	"\x91\x09\xd8\x95\xfd\xe1-y|\xdew>\xef\xcd\x8c\x9b" +
-	"\xa8j\x13\xa5\xb6FL\x9e\x1e\xd9\x95}\xbe[\xfd\xfd" +
-	"ni{\x89t\x81lm\xeb\xe7\xf5\x87\xdf\xee\xaf\x91" +
-	"V \x12GXGL\xb0\x02\xf1\xec\xdeF{tf" +
-	"\xf9\xf5\x13\x92\x02\xbdT\x89u\xc4~\xa6\xbe\xf6\xb2\x97" +
-	"\x84l\xf3\xd7\xfb\xf13\xc6\xd1\x95\x1ev\x04\x0ay\xc5" +
-	"\xb6\xc4\xa7\x1c^\xcf\xe1g\x0b\xeb\x0f>>\xff\xb1J" +
-	"\xba`]\x96 \xa6\xf9\x171\xcb\x158\xc3\xcf\x12\xb2" +
-	"\x95\xb7\xc7o\xb4\xac\xf6\x9b~\xcdS\xb3\xfc\x00\xc4<" +
-	"W\x9e\xfb\x9en\x7f}1\xfa\xe8\xc3 O\x8bw\xc4" +
-	"t\x9e(\xb9I\xc8\x1e\x1b\xc9\xe6\xea\xf2\xee\xef\x83\xd8" +
-	"\x05\xbe!\xee\xe4l\x8b\x9bT\xcb\xe2\xc89\x91\xde\x0e" +
-	"#f\xc7i\xeb\xa4cGAd4<\xc7\x8fL\xdf" +
-	"\x0bR\xcb\xad\x03\x03\x99\x8b\xa1\x1f\\2\xbdV\xdd\x8e" +
-	"\xd3: \xf7p\x8dH\x03\x91~\xde \x92U\x0e9" +
-	"\xc9\xa0\x83U\xa0\x8aV\x83H^\xe0\x90S\x0c:\xe3" +
-	"\x150\"]\x9e#\x92\x93\x1c\xf2\x1a\x83y+\xf4\x03" +
-	"\xcbE\x91\x18\x8a\x84,\xb2\xe3\xb4\x166\x03B\x8a\x02" +
-	"1\x14\x08mU\xbb\xdc\x9c\xff\xfb?\xd4\xabq\xd0K" +
-	"\x9as\xff\xd5:\xd4\xaf\xa5Ts\xab\xc3\xc3\xad\x92\xa6" +
-	"\xe3x\x9e\xeb\x11\\\x80\x18@(8v\x841\x8d\x13" +
-	"06\xc4\xe9\x8a\xefz\xa4l\x8a\xf9\x01\xbaA\x04\xe8" +
-	"\xa3\x06\x91\x99x\xf1\xa2\x17\x9b\xce\x9c\xda\xf6N3\xff" +
-	"\xa7y\xea\xa6\x1f\xbbj\xcb\xad\x9a\x1d\xf1\xe1\x17R\x8f" +
-	"\xc3E\xdfL\xfc0\xc8\x19\xa9\xedL^R\x93\x179" +
-	"d\xa5\x7f\xa0AIW\xed\xd4r\x89zB\x8euC" +
-	"\xca\x89\xefz(w\x9f8\x01e\xc2\x9f\x00\x00\x00\xff" +
-	"\xff\xbe\xb4\xf3\xbd"
+const schema_a184c7885cdaf2a1 = "x\xdat\x92\xcdk\xd4\\\x14\xc6\x9f\xe7\xe6kJ\xdf" +
+	"R\xd2\x0c\xbc\xe0Fq\xe1BT*\x82B6)\x16" +
+	"\xc1h\x91\xdeZE\xc1MH\x82F\xda$&\x99\xca" +
+	"\xac\xea'\xda\x85R\x17\x05\x17\x8e\xa5\x0b\x17]\x09\xe2" +
+	"'\xb8Q\x10\xa1K\x17.\xfc\x17\x84\x82-n\x04\x89" +
+	"d\xd0N\xe9\xccl.\xdc\xc3\xef<\xfc\xce\xb9wt" +
+	"P\x8c\xa9\x87\x87\x8cA\x08yC\xd3\xcb\x0f\xb7\xc6~" +
+	"\xbf^\xd8\\\x80i\xb1\\\xd9\xf8v\xe9\xfe\xe7;+" +
+	"P\x0d\xc0\x8a\xb4_\xa05\xab\x19`y{m~\xe0" +
+	"\xe2\xe2\x8b%H\x8b;9\xd9\xe6\xcei\xcf\xc1r\xfd" +
+	"\xfb\x9b\xd1\xa3\xf6\xbe\xd6\x0eNc\x05\xfe\xd06@\xeb" +
+	"g\x1b|r\xed\xdd\xbd\xf7O\xbf.\xc3\xb4D\x87\x03" +
+	"\xadG\xfa\xc7\xf6y\x0c,[\xaf\x0e\\n\xba\xf3/" +
+	"\xbb\xe5\x8e,\xe9\xbb\x08Z-\xbd\xb2\xfb\xff\xf1\xe6\xa7" +
+	"\xd5\x81\x07o{\xd9\xdd\xd4+\xbb\xbb\xba\x03\x96\x0f\xed" +
+	"|}yq\xf0K/\xee\x99\xbe\x06Z\xab\xba\x83\x12" +
+	"\xf52K\xfd\x83\xc5\xf5$\x15^V4\x0f\xf9^\x1a" +
+	"\xa7\xf6T\xe8G\xa9\x13\x85q\xe1\x06\x93\x8a\xda\x939" +
+	"\x95D\xf1i'lNzY!\xffST@%`" +
+	"\x9e\xb0\x019\xa6PN\x08\x9a\x14uVEw\x0a\x90" +
+	"'\x15\xcaiAS(u\x0a\xc0\x94\xc7\x019\xa1P" +
+	"^\x10t\xae&Q\xec\x06\xacA\xb0\x06\x96\xa9\x97\x15" +
+	"\xe3I#\x06\x0b\x1a\x104\xc0\xf9\xaav\xa61\xfb\xef" +
+	"\xdeWjjw\x987f\xfa;\xed\xe9v\xa2\xf8\xab" +
+	"\xb4\xb7\xbfR\xde\xf0\xfd0\x0cB0 !H\xd0\xf0" +
+	"\xbd\x94#\xaa\x02r\xa4\x8f\xd0\xd9(\x08!k\xedt" +
+	"\xd3\x06Hs\xc0\x06\x9c<\xcc\xe6\xc2\xcc\xf1g\xaa%" +
+	"ou*\xdb:\xa7\xafDYP-\xb79\xee\xa5J" +
+	"\xffw\x98\xcc\x92\xb9\xc8\xc9\xa3$v\x03\xa9n\xcd<" +
+	"T\xcd\\S(\xeb\xdd\xa3\xf4\x8a9\xef\x15n\x00l" +
+	"O\xd8\xdfI\x18\xce\xa3 \xe4p\xe7/\x83\x1c\x06\xff" +
+	"\x0c\x00\xf2\xc3\xf3j"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -564,3 +621,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_a184c7885cdaf2a1,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -3,9 +3,11 @@
 package schema
 
 import (
+	bytes "bytes"
 	capnp "capnproto.org/go/capnp/v3"
 	text "capnproto.org/go/capnp/v3/encoding/text"
 	schemas "capnproto.org/go/capnp/v3/schemas"
+	io "io"
 	math "math"
 	strconv "strconv"
 )
@@ -15,1081 +17,1203 @@ const (
 	Field_noDiscriminant = uint16(65535)
 )
 
-type Node capnp.Struct
-type Node_structNode Node
-type Node_enum Node
-type Node_interface Node
-type Node_const Node
-type Node_annotation Node
-type Node_Which uint16
-
-const (
-	Node_Which_file       Node_Which = 0
-	Node_Which_structNode Node_Which = 1
-	Node_Which_enum       Node_Which = 2
-	Node_Which_interface  Node_Which = 3
-	Node_Which_const      Node_Which = 4
-	Node_Which_annotation Node_Which = 5
-)
-
-func (w Node_Which) String() string {
-	const s = "filestructNodeenuminterfaceconstannotation"
-	switch w {
-	case Node_Which_file:
-		return s[0:4]
-	case Node_Which_structNode:
-		return s[4:14]
-	case Node_Which_enum:
-		return s[14:18]
-	case Node_Which_interface:
-		return s[18:27]
-	case Node_Which_const:
-		return s[27:32]
-	case Node_Which_annotation:
-		return s[32:42]
-
-	}
-	return "Node_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
+// Describes an annotation applied to a declaration.  Note AnnotationNode describes the
+// annotation's declaration, while this describes a use of the annotation.
+type Annotation capnp.Struct
 
-// Node_TypeID is the unique identifier for the type Node.
-const Node_TypeID = 0xe682ab4cf923a417
+// Annotation_TypeID is the unique identifier for the type Annotation.
+const Annotation_TypeID = 0xf1c8950dab257542
 
-func NewNode(s *capnp.Segment) (Node, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 6})
-	return Node(st), err
+func NewAnnotation(s *capnp.Segment) (Annotation, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Annotation(st), err
 }
 
-func NewRootNode(s *capnp.Segment) (Node, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 6})
-	return Node(st), err
+func NewRootAnnotation(s *capnp.Segment) (Annotation, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Annotation(st), err
 }
 
-func ReadRootNode(msg *capnp.Message) (Node, error) {
+func ReadRootAnnotation(msg *capnp.Message) (Annotation, error) {
 	root, err := msg.Root()
-	return Node(root.Struct()), err
+	return Annotation(root.Struct()), err
 }
 
-func (s Node) String() string {
-	str, _ := text.Marshal(0xe682ab4cf923a417, capnp.Struct(s))
+func (s Annotation) String() string {
+	str, _ := text.Marshal(0xf1c8950dab257542, capnp.Struct(s))
 	return str
 }
 
-func (s Node) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Annotation) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Node) DecodeFromPtr(p capnp.Ptr) Node {
-	return Node(capnp.Struct{}.DecodeFromPtr(p))
+func (Annotation) DecodeFromPtr(p capnp.Ptr) Annotation {
+	return Annotation(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node) ToPtr() capnp.Ptr {
+func (s Annotation) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s Node) Which() Node_Which {
-	return Node_Which(capnp.Struct(s).Uint16(12))
-}
-func (s Node) IsValid() bool {
+func (s Annotation) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Node) Message() *capnp.Message {
+func (s Annotation) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Node) Segment() *capnp.Segment {
+func (s Annotation) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Node) Id() uint64 {
+
+// ID of the annotation node.
+func (s Annotation) Id() uint64 {
 	return capnp.Struct(s).Uint64(0)
 }
 
-func (s Node) SetId(v uint64) {
+func (s Annotation) SetId(v uint64) {
 	capnp.Struct(s).SetUint64(0, v)
 }
 
-func (s Node) DisplayName() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+// Brand of the annotation.
+//
+// Note that the annotation itself is not allowed to be parameterized, but its scope might be.
+func (s Annotation) Brand() (Brand, error) {
+	return capnp.GetStructField[Brand](s, 1, "Annotation.brand")
 }
 
-func (s Node) HasDisplayName() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Annotation) HasBrand() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s Node) DisplayNameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Annotation) SetBrand(v Brand) error {
+	return capnp.SetStructField(s, 1, v)
 }
 
-func (s Node) SetDisplayName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+// NewBrand sets the brand field to a newly
+// allocated Brand struct, preferring placement in s's segment.
+func (s Annotation) NewBrand() (Brand, error) {
+	return capnp.NewStructField(s, 1, NewBrand)
 }
 
-func (s Node) DisplayNamePrefixLength() uint32 {
-	return capnp.Struct(s).Uint32(8)
+func (s Annotation) Value() (Value, error) {
+	return capnp.GetStructField[Value](s, 0, "Annotation.value")
 }
 
-func (s Node) SetDisplayNamePrefixLength(v uint32) {
-	capnp.Struct(s).SetUint32(8, v)
+func (s Annotation) HasValue() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Node) ScopeId() uint64 {
-	return capnp.Struct(s).Uint64(16)
+func (s Annotation) SetValue(v Value) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Node) SetScopeId(v uint64) {
-	capnp.Struct(s).SetUint64(16, v)
+// NewValue sets the value field to a newly
+// allocated Value struct, preferring placement in s's segment.
+func (s Annotation) NewValue() (Value, error) {
+	return capnp.NewStructField(s, 0, NewValue)
 }
 
-func (s Node) Parameters() (Node_Parameter_List, error) {
-	p, err := capnp.Struct(s).Ptr(5)
-	return Node_Parameter_List(p.List()), err
-}
+// Annotation_List is a list of Annotation.
+type Annotation_List = capnp.StructList[Annotation]
 
-func (s Node) HasParameters() bool {
-	return capnp.Struct(s).HasPtr(5)
+// NewAnnotation creates a new list of Annotation.
+func NewAnnotation_List(s *capnp.Segment, sz int32) (Annotation_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Annotation](l), err
 }
 
-func (s Node) SetParameters(v Node_Parameter_List) error {
-	return capnp.Struct(s).SetPtr(5, v.ToPtr())
-}
+// Annotation_Future is a wrapper for a Annotation promised by a client call.
+type Annotation_Future struct{ *capnp.Future }
 
-// NewParameters sets the parameters field to a newly
-// allocated Node_Parameter_List, preferring placement in s's segment.
-func (s Node) NewParameters(n int32) (Node_Parameter_List, error) {
-	l, err := NewNode_Parameter_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Node_Parameter_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(5, l.ToPtr())
-	return l, err
+func (f Annotation_Future) Struct() (Annotation, error) {
+	p, err := f.Future.Ptr()
+	return Annotation(p.Struct()), err
 }
-func (s Node) IsGeneric() bool {
-	return capnp.Struct(s).Bit(288)
+func (p Annotation_Future) Brand() Brand_Future {
+	return Brand_Future{Future: p.Future.Field(1, nil)}
 }
-
-func (s Node) SetIsGeneric(v bool) {
-	capnp.Struct(s).SetBit(288, v)
+func (p Annotation_Future) Value() Value_Future {
+	return Value_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Node) NestedNodes() (Node_NestedNode_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Node_NestedNode_List(p.List()), err
-}
+// Specifies bindings for parameters of generics. Since these bindings turn a generic into a
+// non-generic, we call it the "brand".
+type Brand capnp.Struct
 
-func (s Node) HasNestedNodes() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
+// Brand_TypeID is the unique identifier for the type Brand.
+const Brand_TypeID = 0x903455f06065422b
 
-func (s Node) SetNestedNodes(v Node_NestedNode_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+func NewBrand(s *capnp.Segment) (Brand, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Brand(st), err
 }
 
-// NewNestedNodes sets the nestedNodes field to a newly
-// allocated Node_NestedNode_List, preferring placement in s's segment.
-func (s Node) NewNestedNodes(n int32) (Node_NestedNode_List, error) {
-	l, err := NewNode_NestedNode_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Node_NestedNode_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-func (s Node) Annotations() (Annotation_List, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return Annotation_List(p.List()), err
+func NewRootBrand(s *capnp.Segment) (Brand, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Brand(st), err
 }
 
-func (s Node) HasAnnotations() bool {
-	return capnp.Struct(s).HasPtr(2)
+func ReadRootBrand(msg *capnp.Message) (Brand, error) {
+	root, err := msg.Root()
+	return Brand(root.Struct()), err
 }
 
-func (s Node) SetAnnotations(v Annotation_List) error {
-	return capnp.Struct(s).SetPtr(2, v.ToPtr())
+func (s Brand) String() string {
+	str, _ := text.Marshal(0x903455f06065422b, capnp.Struct(s))
+	return str
 }
 
-// NewAnnotations sets the annotations field to a newly
-// allocated Annotation_List, preferring placement in s's segment.
-func (s Node) NewAnnotations(n int32) (Annotation_List, error) {
-	l, err := NewAnnotation_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Annotation_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, l.ToPtr())
-	return l, err
+func (s Brand) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
-func (s Node) SetFile() {
-	capnp.Struct(s).SetUint16(12, 0)
 
+func (Brand) DecodeFromPtr(p capnp.Ptr) Brand {
+	return Brand(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node) StructNode() Node_structNode { return Node_structNode(s) }
-
-func (s Node) SetStructNode() {
-	capnp.Struct(s).SetUint16(12, 1)
+func (s Brand) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-func (s Node_structNode) IsValid() bool {
+func (s Brand) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_structNode) Message() *capnp.Message {
+func (s Brand) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Node_structNode) Segment() *capnp.Segment {
+func (s Brand) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Node_structNode) DataWordCount() uint16 {
-	return capnp.Struct(s).Uint16(14)
-}
 
-func (s Node_structNode) SetDataWordCount(v uint16) {
-	capnp.Struct(s).SetUint16(14, v)
+// For each of the target type and each of its parent scopes, a parameterization may be included
+// in this list. If no parameterization is included for a particular relevant scope, then either
+// that scope has no parameters or all parameters should be considered to be `AnyPointer`.
+func (s Brand) Scopes() (Brand_Scope_List, error) {
+	return capnp.GetListField[Brand_Scope_List](s, 0, "Brand.scopes")
 }
 
-func (s Node_structNode) PointerCount() uint16 {
-	return capnp.Struct(s).Uint16(24)
+func (s Brand) HasScopes() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Node_structNode) SetPointerCount(v uint16) {
-	capnp.Struct(s).SetUint16(24, v)
+func (s Brand) SetScopes(v Brand_Scope_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s Node_structNode) PreferredListEncoding() ElementSize {
-	return ElementSize(capnp.Struct(s).Uint16(26))
+// NewScopes sets the scopes field to a newly
+// allocated Brand_Scope_List, preferring placement in s's segment.
+func (s Brand) NewScopes(n int32) (Brand_Scope_List, error) {
+	return capnp.NewListField(s, 0, n, NewBrand_Scope_List)
 }
 
-func (s Node_structNode) SetPreferredListEncoding(v ElementSize) {
-	capnp.Struct(s).SetUint16(26, uint16(v))
-}
+// Brand_List is a list of Brand.
+type Brand_List = capnp.StructList[Brand]
 
-func (s Node_structNode) IsGroup() bool {
-	return capnp.Struct(s).Bit(224)
+// NewBrand creates a new list of Brand.
+func NewBrand_List(s *capnp.Segment, sz int32) (Brand_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Brand](l), err
 }
 
-func (s Node_structNode) SetIsGroup(v bool) {
-	capnp.Struct(s).SetBit(224, v)
-}
+// Brand_Future is a wrapper for a Brand promised by a client call.
+type Brand_Future struct{ *capnp.Future }
 
-func (s Node_structNode) DiscriminantCount() uint16 {
-	return capnp.Struct(s).Uint16(30)
+func (f Brand_Future) Struct() (Brand, error) {
+	p, err := f.Future.Ptr()
+	return Brand(p.Struct()), err
 }
 
-func (s Node_structNode) SetDiscriminantCount(v uint16) {
-	capnp.Struct(s).SetUint16(30, v)
+type Brand_Binding capnp.Struct
+type Brand_Binding_Which uint16
+
+const (
+	Brand_Binding_Which_unbound Brand_Binding_Which = 0
+	Brand_Binding_Which_type    Brand_Binding_Which = 1
+)
+
+func (w Brand_Binding_Which) String() string {
+	const s = "unboundtype"
+	switch w {
+	case Brand_Binding_Which_unbound:
+		return s[0:7]
+	case Brand_Binding_Which_type:
+		return s[7:11]
+
+	}
+	return "Brand_Binding_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func (s Node_structNode) DiscriminantOffset() uint32 {
-	return capnp.Struct(s).Uint32(32)
+// Brand_Binding_Visitor has a method for each member of the union of
+// Brand_Binding, which Visit calls with the member's value.
+type Brand_Binding_Visitor interface {
+	VisitUnbound() error
+	VisitType(Type) error
 }
 
-func (s Node_structNode) SetDiscriminantOffset(v uint32) {
-	capnp.Struct(s).SetUint32(32, v)
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Brand_Binding) Visit(v Brand_Binding_Visitor) error {
+	switch w := s.Which(); w {
+	case Brand_Binding_Which_unbound:
+		return v.VisitUnbound()
+	case Brand_Binding_Which_type:
+		x, err := s.Type()
+		if err != nil {
+			return err
+		}
+		return v.VisitType(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Brand.Binding", Which: uint16(w)}
+	}
 }
 
-func (s Node_structNode) Fields() (Field_List, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Field_List(p.List()), err
+// Brand_Binding_TypeID is the unique identifier for the type Brand_Binding.
+const Brand_Binding_TypeID = 0xc863cd16969ee7fc
+
+func NewBrand_Binding(s *capnp.Segment) (Brand_Binding, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Brand_Binding(st), err
 }
 
-func (s Node_structNode) HasFields() bool {
-	return capnp.Struct(s).HasPtr(3)
+func NewRootBrand_Binding(s *capnp.Segment) (Brand_Binding, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Brand_Binding(st), err
 }
 
-func (s Node_structNode) SetFields(v Field_List) error {
-	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+func ReadRootBrand_Binding(msg *capnp.Message) (Brand_Binding, error) {
+	root, err := msg.Root()
+	return Brand_Binding(root.Struct()), err
 }
 
-// NewFields sets the fields field to a newly
-// allocated Field_List, preferring placement in s's segment.
-func (s Node_structNode) NewFields(n int32) (Field_List, error) {
-	l, err := NewField_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Field_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
-	return l, err
+func (s Brand_Binding) String() string {
+	str, _ := text.Marshal(0xc863cd16969ee7fc, capnp.Struct(s))
+	return str
 }
-func (s Node) Enum() Node_enum { return Node_enum(s) }
 
-func (s Node) SetEnum() {
-	capnp.Struct(s).SetUint16(12, 2)
+func (s Brand_Binding) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Node_enum) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (Brand_Binding) DecodeFromPtr(p capnp.Ptr) Brand_Binding {
+	return Brand_Binding(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node_enum) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Brand_Binding) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
 
-func (s Node_enum) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Brand_Binding) Which() Brand_Binding_Which {
+	return Brand_Binding_Which(capnp.Struct(s).Uint16(0))
 }
-func (s Node_enum) Enumerants() (Enumerant_List, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Enumerant_List(p.List()), err
+func (s Brand_Binding) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_enum) HasEnumerants() bool {
-	return capnp.Struct(s).HasPtr(3)
+func (s Brand_Binding) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Node_enum) SetEnumerants(v Enumerant_List) error {
-	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+func (s Brand_Binding) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
+func (s Brand_Binding) SetUnbound() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-// NewEnumerants sets the enumerants field to a newly
-// allocated Enumerant_List, preferring placement in s's segment.
-func (s Node_enum) NewEnumerants(n int32) (Enumerant_List, error) {
-	l, err := NewEnumerant_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Enumerant_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
-	return l, err
 }
-func (s Node) Interface() Node_interface { return Node_interface(s) }
 
-func (s Node) SetInterface() {
-	capnp.Struct(s).SetUint16(12, 3)
+func (s Brand_Binding) Type() (Type, error) {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != type")
+	}
+	return capnp.GetStructField[Type](s, 0, "Brand.Binding.type")
 }
 
-func (s Node_interface) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Brand_Binding) HasType() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Node_interface) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Brand_Binding) SetType(v Type) error {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Node_interface) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Node_interface) Methods() (Method_List, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Method_List(p.List()), err
+// NewType sets the type field to a newly
+// allocated Type struct, preferring placement in s's segment.
+func (s Brand_Binding) NewType() (Type, error) {
+	capnp.Struct(s).SetUint16(0, 1)
+	return capnp.NewStructField(s, 0, NewType)
 }
 
-func (s Node_interface) HasMethods() bool {
-	return capnp.Struct(s).HasPtr(3)
-}
+// Brand_Binding_List is a list of Brand_Binding.
+type Brand_Binding_List = capnp.StructList[Brand_Binding]
 
-func (s Node_interface) SetMethods(v Method_List) error {
-	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+// NewBrand_Binding creates a new list of Brand_Binding.
+func NewBrand_Binding_List(s *capnp.Segment, sz int32) (Brand_Binding_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Brand_Binding](l), err
 }
 
-// NewMethods sets the methods field to a newly
-// allocated Method_List, preferring placement in s's segment.
-func (s Node_interface) NewMethods(n int32) (Method_List, error) {
-	l, err := NewMethod_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Method_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
-	return l, err
+// Brand_Binding_Future is a wrapper for a Brand_Binding promised by a client call.
+type Brand_Binding_Future struct{ *capnp.Future }
+
+func (f Brand_Binding_Future) Struct() (Brand_Binding, error) {
+	p, err := f.Future.Ptr()
+	return Brand_Binding(p.Struct()), err
 }
-func (s Node_interface) Superclasses() (Superclass_List, error) {
-	p, err := capnp.Struct(s).Ptr(4)
-	return Superclass_List(p.List()), err
+func (p Brand_Binding_Future) Type() Type_Future {
+	return Type_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Node_interface) HasSuperclasses() bool {
-	return capnp.Struct(s).HasPtr(4)
-}
+type Brand_Scope capnp.Struct
+type Brand_Scope_Which uint16
 
-func (s Node_interface) SetSuperclasses(v Superclass_List) error {
-	return capnp.Struct(s).SetPtr(4, v.ToPtr())
-}
+const (
+	Brand_Scope_Which_bind    Brand_Scope_Which = 0
+	Brand_Scope_Which_inherit Brand_Scope_Which = 1
+)
 
-// NewSuperclasses sets the superclasses field to a newly
-// allocated Superclass_List, preferring placement in s's segment.
-func (s Node_interface) NewSuperclasses(n int32) (Superclass_List, error) {
-	l, err := NewSuperclass_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Superclass_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(4, l.ToPtr())
-	return l, err
-}
-func (s Node) Const() Node_const { return Node_const(s) }
+func (w Brand_Scope_Which) String() string {
+	const s = "bindinherit"
+	switch w {
+	case Brand_Scope_Which_bind:
+		return s[0:4]
+	case Brand_Scope_Which_inherit:
+		return s[4:11]
 
-func (s Node) SetConst() {
-	capnp.Struct(s).SetUint16(12, 4)
+	}
+	return "Brand_Scope_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func (s Node_const) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+// Brand_Scope_Visitor has a method for each member of the union of
+// Brand_Scope, which Visit calls with the member's value.
+type Brand_Scope_Visitor interface {
+	VisitBind(Brand_Binding_List) error
+	VisitInherit() error
 }
 
-func (s Node_const) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Brand_Scope) Visit(v Brand_Scope_Visitor) error {
+	switch w := s.Which(); w {
+	case Brand_Scope_Which_bind:
+		x, err := s.Bind()
+		if err != nil {
+			return err
+		}
+		return v.VisitBind(x)
+	case Brand_Scope_Which_inherit:
+		return v.VisitInherit()
+	default:
+		return &capnp.UnknownMemberError{Union: "Brand.Scope", Which: uint16(w)}
+	}
 }
 
-func (s Node_const) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Node_const) Type() (Type, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Type(p.Struct()), err
-}
+// Brand_Scope_TypeID is the unique identifier for the type Brand_Scope.
+const Brand_Scope_TypeID = 0xabd73485a9636bc9
 
-func (s Node_const) HasType() bool {
-	return capnp.Struct(s).HasPtr(3)
+func NewBrand_Scope(s *capnp.Segment) (Brand_Scope, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Brand_Scope(st), err
 }
 
-func (s Node_const) SetType(v Type) error {
-	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+func NewRootBrand_Scope(s *capnp.Segment) (Brand_Scope, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Brand_Scope(st), err
 }
 
-// NewType sets the type field to a newly
-// allocated Type struct, preferring placement in s's segment.
-func (s Node_const) NewType() (Type, error) {
-	ss, err := NewType(capnp.Struct(s).Segment())
-	if err != nil {
-		return Type{}, err
-	}
-	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
-	return ss, err
+func ReadRootBrand_Scope(msg *capnp.Message) (Brand_Scope, error) {
+	root, err := msg.Root()
+	return Brand_Scope(root.Struct()), err
 }
 
-func (s Node_const) Value() (Value, error) {
-	p, err := capnp.Struct(s).Ptr(4)
-	return Value(p.Struct()), err
+func (s Brand_Scope) String() string {
+	str, _ := text.Marshal(0xabd73485a9636bc9, capnp.Struct(s))
+	return str
 }
 
-func (s Node_const) HasValue() bool {
-	return capnp.Struct(s).HasPtr(4)
+func (s Brand_Scope) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Node_const) SetValue(v Value) error {
-	return capnp.Struct(s).SetPtr(4, capnp.Struct(v).ToPtr())
+func (Brand_Scope) DecodeFromPtr(p capnp.Ptr) Brand_Scope {
+	return Brand_Scope(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-// NewValue sets the value field to a newly
-// allocated Value struct, preferring placement in s's segment.
-func (s Node_const) NewValue() (Value, error) {
-	ss, err := NewValue(capnp.Struct(s).Segment())
-	if err != nil {
-		return Value{}, err
-	}
-	err = capnp.Struct(s).SetPtr(4, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Brand_Scope) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
 
-func (s Node) Annotation() Node_annotation { return Node_annotation(s) }
-
-func (s Node) SetAnnotation() {
-	capnp.Struct(s).SetUint16(12, 5)
+func (s Brand_Scope) Which() Brand_Scope_Which {
+	return Brand_Scope_Which(capnp.Struct(s).Uint16(8))
 }
-
-func (s Node_annotation) IsValid() bool {
+func (s Brand_Scope) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_annotation) Message() *capnp.Message {
+func (s Brand_Scope) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Node_annotation) Segment() *capnp.Segment {
+func (s Brand_Scope) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Node_annotation) Type() (Type, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Type(p.Struct()), err
-}
 
-func (s Node_annotation) HasType() bool {
-	return capnp.Struct(s).HasPtr(3)
+// ID of the scope to which these params apply.
+func (s Brand_Scope) ScopeId() uint64 {
+	return capnp.Struct(s).Uint64(0)
 }
 
-func (s Node_annotation) SetType(v Type) error {
-	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+func (s Brand_Scope) SetScopeId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
 }
 
-// NewType sets the type field to a newly
-// allocated Type struct, preferring placement in s's segment.
-func (s Node_annotation) NewType() (Type, error) {
-	ss, err := NewType(capnp.Struct(s).Segment())
-	if err != nil {
-		return Type{}, err
+// List of parameter bindings.
+func (s Brand_Scope) Bind() (Brand_Binding_List, error) {
+	if capnp.Struct(s).Uint16(8) != 0 {
+		panic("Which() != bind")
 	}
-	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.GetListField[Brand_Binding_List](s, 0, "Brand.Scope.bind")
 }
 
-func (s Node_annotation) TargetsFile() bool {
-	return capnp.Struct(s).Bit(112)
+func (s Brand_Scope) HasBind() bool {
+	if capnp.Struct(s).Uint16(8) != 0 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Node_annotation) SetTargetsFile(v bool) {
-	capnp.Struct(s).SetBit(112, v)
+func (s Brand_Scope) SetBind(v Brand_Binding_List) error {
+	capnp.Struct(s).SetUint16(8, 0)
+	return capnp.SetListField(s, 0, v)
 }
 
-func (s Node_annotation) TargetsConst() bool {
-	return capnp.Struct(s).Bit(113)
+// NewBind sets the bind field to a newly
+// allocated Brand_Binding_List, preferring placement in s's segment.
+func (s Brand_Scope) NewBind(n int32) (Brand_Binding_List, error) {
+	capnp.Struct(s).SetUint16(8, 0)
+	return capnp.NewListField(s, 0, n, NewBrand_Binding_List)
 }
+func (s Brand_Scope) SetInherit() {
+	capnp.Struct(s).SetUint16(8, 1)
 
-func (s Node_annotation) SetTargetsConst(v bool) {
-	capnp.Struct(s).SetBit(113, v)
 }
 
-func (s Node_annotation) TargetsEnum() bool {
-	return capnp.Struct(s).Bit(114)
-}
+// Brand_Scope_List is a list of Brand_Scope.
+type Brand_Scope_List = capnp.StructList[Brand_Scope]
 
-func (s Node_annotation) SetTargetsEnum(v bool) {
-	capnp.Struct(s).SetBit(114, v)
+// NewBrand_Scope creates a new list of Brand_Scope.
+func NewBrand_Scope_List(s *capnp.Segment, sz int32) (Brand_Scope_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Brand_Scope](l), err
 }
 
-func (s Node_annotation) TargetsEnumerant() bool {
-	return capnp.Struct(s).Bit(115)
-}
+// Brand_Scope_Future is a wrapper for a Brand_Scope promised by a client call.
+type Brand_Scope_Future struct{ *capnp.Future }
 
-func (s Node_annotation) SetTargetsEnumerant(v bool) {
-	capnp.Struct(s).SetBit(115, v)
-}
-
-func (s Node_annotation) TargetsStruct() bool {
-	return capnp.Struct(s).Bit(116)
-}
-
-func (s Node_annotation) SetTargetsStruct(v bool) {
-	capnp.Struct(s).SetBit(116, v)
-}
-
-func (s Node_annotation) TargetsField() bool {
-	return capnp.Struct(s).Bit(117)
+func (f Brand_Scope_Future) Struct() (Brand_Scope, error) {
+	p, err := f.Future.Ptr()
+	return Brand_Scope(p.Struct()), err
 }
 
-func (s Node_annotation) SetTargetsField(v bool) {
-	capnp.Struct(s).SetBit(117, v)
-}
+type CapnpVersion capnp.Struct
 
-func (s Node_annotation) TargetsUnion() bool {
-	return capnp.Struct(s).Bit(118)
-}
+// CapnpVersion_TypeID is the unique identifier for the type CapnpVersion.
+const CapnpVersion_TypeID = 0xd85d305b7d839963
 
-func (s Node_annotation) SetTargetsUnion(v bool) {
-	capnp.Struct(s).SetBit(118, v)
+func NewCapnpVersion(s *capnp.Segment) (CapnpVersion, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CapnpVersion(st), err
 }
 
-func (s Node_annotation) TargetsGroup() bool {
-	return capnp.Struct(s).Bit(119)
+func NewRootCapnpVersion(s *capnp.Segment) (CapnpVersion, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CapnpVersion(st), err
 }
 
-func (s Node_annotation) SetTargetsGroup(v bool) {
-	capnp.Struct(s).SetBit(119, v)
+func ReadRootCapnpVersion(msg *capnp.Message) (CapnpVersion, error) {
+	root, err := msg.Root()
+	return CapnpVersion(root.Struct()), err
 }
 
-func (s Node_annotation) TargetsInterface() bool {
-	return capnp.Struct(s).Bit(120)
+func (s CapnpVersion) String() string {
+	str, _ := text.Marshal(0xd85d305b7d839963, capnp.Struct(s))
+	return str
 }
 
-func (s Node_annotation) SetTargetsInterface(v bool) {
-	capnp.Struct(s).SetBit(120, v)
+func (s CapnpVersion) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (s Node_annotation) TargetsMethod() bool {
-	return capnp.Struct(s).Bit(121)
+func (CapnpVersion) DecodeFromPtr(p capnp.Ptr) CapnpVersion {
+	return CapnpVersion(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node_annotation) SetTargetsMethod(v bool) {
-	capnp.Struct(s).SetBit(121, v)
+func (s CapnpVersion) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
 }
-
-func (s Node_annotation) TargetsParam() bool {
-	return capnp.Struct(s).Bit(122)
+func (s CapnpVersion) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_annotation) SetTargetsParam(v bool) {
-	capnp.Struct(s).SetBit(122, v)
+func (s CapnpVersion) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Node_annotation) TargetsAnnotation() bool {
-	return capnp.Struct(s).Bit(123)
+func (s CapnpVersion) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-
-func (s Node_annotation) SetTargetsAnnotation(v bool) {
-	capnp.Struct(s).SetBit(123, v)
+func (s CapnpVersion) Major() uint16 {
+	return capnp.Struct(s).Uint16(0)
 }
 
-// Node_List is a list of Node.
-type Node_List = capnp.StructList[Node]
-
-// NewNode creates a new list of Node.
-func NewNode_List(s *capnp.Segment, sz int32) (Node_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 6}, sz)
-	return capnp.StructList[Node](l), err
+func (s CapnpVersion) SetMajor(v uint16) {
+	capnp.Struct(s).SetUint16(0, v)
 }
 
-// Node_Future is a wrapper for a Node promised by a client call.
-type Node_Future struct{ *capnp.Future }
-
-func (f Node_Future) Struct() (Node, error) {
-	p, err := f.Future.Ptr()
-	return Node(p.Struct()), err
+func (s CapnpVersion) Minor() uint8 {
+	return capnp.Struct(s).Uint8(2)
 }
-func (p Node_Future) StructNode() Node_structNode_Future { return Node_structNode_Future{p.Future} }
-
-// Node_structNode_Future is a wrapper for a Node_structNode promised by a client call.
-type Node_structNode_Future struct{ *capnp.Future }
 
-func (f Node_structNode_Future) Struct() (Node_structNode, error) {
-	p, err := f.Future.Ptr()
-	return Node_structNode(p.Struct()), err
+func (s CapnpVersion) SetMinor(v uint8) {
+	capnp.Struct(s).SetUint8(2, v)
 }
-func (p Node_Future) Enum() Node_enum_Future { return Node_enum_Future{p.Future} }
-
-// Node_enum_Future is a wrapper for a Node_enum promised by a client call.
-type Node_enum_Future struct{ *capnp.Future }
 
-func (f Node_enum_Future) Struct() (Node_enum, error) {
-	p, err := f.Future.Ptr()
-	return Node_enum(p.Struct()), err
+func (s CapnpVersion) Micro() uint8 {
+	return capnp.Struct(s).Uint8(3)
 }
-func (p Node_Future) Interface() Node_interface_Future { return Node_interface_Future{p.Future} }
-
-// Node_interface_Future is a wrapper for a Node_interface promised by a client call.
-type Node_interface_Future struct{ *capnp.Future }
 
-func (f Node_interface_Future) Struct() (Node_interface, error) {
-	p, err := f.Future.Ptr()
-	return Node_interface(p.Struct()), err
+func (s CapnpVersion) SetMicro(v uint8) {
+	capnp.Struct(s).SetUint8(3, v)
 }
-func (p Node_Future) Const() Node_const_Future { return Node_const_Future{p.Future} }
 
-// Node_const_Future is a wrapper for a Node_const promised by a client call.
-type Node_const_Future struct{ *capnp.Future }
+// CapnpVersion_List is a list of CapnpVersion.
+type CapnpVersion_List = capnp.StructList[CapnpVersion]
 
-func (f Node_const_Future) Struct() (Node_const, error) {
-	p, err := f.Future.Ptr()
-	return Node_const(p.Struct()), err
-}
-func (p Node_const_Future) Type() Type_Future {
-	return Type_Future{Future: p.Future.Field(3, nil)}
-}
-func (p Node_const_Future) Value() Value_Future {
-	return Value_Future{Future: p.Future.Field(4, nil)}
+// NewCapnpVersion creates a new list of CapnpVersion.
+func NewCapnpVersion_List(s *capnp.Segment, sz int32) (CapnpVersion_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[CapnpVersion](l), err
 }
-func (p Node_Future) Annotation() Node_annotation_Future { return Node_annotation_Future{p.Future} }
 
-// Node_annotation_Future is a wrapper for a Node_annotation promised by a client call.
-type Node_annotation_Future struct{ *capnp.Future }
+// CapnpVersion_Future is a wrapper for a CapnpVersion promised by a client call.
+type CapnpVersion_Future struct{ *capnp.Future }
 
-func (f Node_annotation_Future) Struct() (Node_annotation, error) {
+func (f CapnpVersion_Future) Struct() (CapnpVersion, error) {
 	p, err := f.Future.Ptr()
-	return Node_annotation(p.Struct()), err
-}
-func (p Node_annotation_Future) Type() Type_Future {
-	return Type_Future{Future: p.Future.Field(3, nil)}
+	return CapnpVersion(p.Struct()), err
 }
 
-type Node_Parameter capnp.Struct
+type CodeGeneratorRequest capnp.Struct
 
-// Node_Parameter_TypeID is the unique identifier for the type Node_Parameter.
-const Node_Parameter_TypeID = 0xb9521bccf10fa3b1
+// CodeGeneratorRequest_TypeID is the unique identifier for the type CodeGeneratorRequest.
+const CodeGeneratorRequest_TypeID = 0xbfc546f6210ad7ce
 
-func NewNode_Parameter(s *capnp.Segment) (Node_Parameter, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Node_Parameter(st), err
+func NewCodeGeneratorRequest(s *capnp.Segment) (CodeGeneratorRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4})
+	return CodeGeneratorRequest(st), err
 }
 
-func NewRootNode_Parameter(s *capnp.Segment) (Node_Parameter, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Node_Parameter(st), err
+func NewRootCodeGeneratorRequest(s *capnp.Segment) (CodeGeneratorRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4})
+	return CodeGeneratorRequest(st), err
 }
 
-func ReadRootNode_Parameter(msg *capnp.Message) (Node_Parameter, error) {
+func ReadRootCodeGeneratorRequest(msg *capnp.Message) (CodeGeneratorRequest, error) {
 	root, err := msg.Root()
-	return Node_Parameter(root.Struct()), err
+	return CodeGeneratorRequest(root.Struct()), err
 }
 
-func (s Node_Parameter) String() string {
-	str, _ := text.Marshal(0xb9521bccf10fa3b1, capnp.Struct(s))
+func (s CodeGeneratorRequest) String() string {
+	str, _ := text.Marshal(0xbfc546f6210ad7ce, capnp.Struct(s))
 	return str
 }
 
-func (s Node_Parameter) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CodeGeneratorRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Node_Parameter) DecodeFromPtr(p capnp.Ptr) Node_Parameter {
-	return Node_Parameter(capnp.Struct{}.DecodeFromPtr(p))
+func (CodeGeneratorRequest) DecodeFromPtr(p capnp.Ptr) CodeGeneratorRequest {
+	return CodeGeneratorRequest(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node_Parameter) ToPtr() capnp.Ptr {
+func (s CodeGeneratorRequest) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Node_Parameter) IsValid() bool {
+func (s CodeGeneratorRequest) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_Parameter) Message() *capnp.Message {
+func (s CodeGeneratorRequest) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Node_Parameter) Segment() *capnp.Segment {
+func (s CodeGeneratorRequest) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Node_Parameter) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
 
-func (s Node_Parameter) HasName() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Version of the `capnp` executable. Generally, code generators should ignore this, but the code
+// generators that ship with `capnp` itself will print a warning if this mismatches since that
+// probably indicates something is misconfigured.
+//
+// The first version of 'capnp' to set this was 0.6.0. So, if it's missing, the compiler version
+// is older than that.
+func (s CodeGeneratorRequest) CapnpVersion() (CapnpVersion, error) {
+	return capnp.GetStructField[CapnpVersion](s, 2, "CodeGeneratorRequest.capnpVersion")
 }
 
-func (s Node_Parameter) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s CodeGeneratorRequest) HasCapnpVersion() bool {
+	return capnp.Struct(s).HasPtr(2)
 }
 
-func (s Node_Parameter) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+func (s CodeGeneratorRequest) SetCapnpVersion(v CapnpVersion) error {
+	return capnp.SetStructField(s, 2, v)
 }
 
-// Node_Parameter_List is a list of Node_Parameter.
-type Node_Parameter_List = capnp.StructList[Node_Parameter]
+// NewCapnpVersion sets the capnpVersion field to a newly
+// allocated CapnpVersion struct, preferring placement in s's segment.
+func (s CodeGeneratorRequest) NewCapnpVersion() (CapnpVersion, error) {
+	return capnp.NewStructField(s, 2, NewCapnpVersion)
+}
 
-// NewNode_Parameter creates a new list of Node_Parameter.
-func NewNode_Parameter_List(s *capnp.Segment, sz int32) (Node_Parameter_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Node_Parameter](l), err
+// All nodes parsed by the compiler, including for the files on the command line and their
+// imports.
+func (s CodeGeneratorRequest) Nodes() (Node_List, error) {
+	return capnp.GetListField[Node_List](s, 0, "CodeGeneratorRequest.nodes")
 }
 
-// Node_Parameter_Future is a wrapper for a Node_Parameter promised by a client call.
-type Node_Parameter_Future struct{ *capnp.Future }
+func (s CodeGeneratorRequest) HasNodes() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
-func (f Node_Parameter_Future) Struct() (Node_Parameter, error) {
-	p, err := f.Future.Ptr()
-	return Node_Parameter(p.Struct()), err
+func (s CodeGeneratorRequest) SetNodes(v Node_List) error {
+	return capnp.SetListField(s, 0, v)
 }
 
-type Node_NestedNode capnp.Struct
+// NewNodes sets the nodes field to a newly
+// allocated Node_List, preferring placement in s's segment.
+func (s CodeGeneratorRequest) NewNodes(n int32) (Node_List, error) {
+	return capnp.NewListField(s, 0, n, NewNode_List)
+}
 
-// Node_NestedNode_TypeID is the unique identifier for the type Node_NestedNode.
-const Node_NestedNode_TypeID = 0xdebf55bbfa0fc242
+// Information about the original source code for each node, where available. This array may be
+// omitted or may be missing some nodes if no info is available for them.
+func (s CodeGeneratorRequest) SourceInfo() (Node_SourceInfo_List, error) {
+	return capnp.GetListField[Node_SourceInfo_List](s, 3, "CodeGeneratorRequest.sourceInfo")
+}
 
-func NewNode_NestedNode(s *capnp.Segment) (Node_NestedNode, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Node_NestedNode(st), err
+func (s CodeGeneratorRequest) HasSourceInfo() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
 
-func NewRootNode_NestedNode(s *capnp.Segment) (Node_NestedNode, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Node_NestedNode(st), err
+func (s CodeGeneratorRequest) SetSourceInfo(v Node_SourceInfo_List) error {
+	return capnp.SetListField(s, 3, v)
 }
 
-func ReadRootNode_NestedNode(msg *capnp.Message) (Node_NestedNode, error) {
-	root, err := msg.Root()
-	return Node_NestedNode(root.Struct()), err
+// NewSourceInfo sets the sourceInfo field to a newly
+// allocated Node_SourceInfo_List, preferring placement in s's segment.
+func (s CodeGeneratorRequest) NewSourceInfo(n int32) (Node_SourceInfo_List, error) {
+	return capnp.NewListField(s, 3, n, NewNode_SourceInfo_List)
 }
 
-func (s Node_NestedNode) String() string {
-	str, _ := text.Marshal(0xdebf55bbfa0fc242, capnp.Struct(s))
-	return str
+// Files which were listed on the command line.
+func (s CodeGeneratorRequest) RequestedFiles() (CodeGeneratorRequest_RequestedFile_List, error) {
+	return capnp.GetListField[CodeGeneratorRequest_RequestedFile_List](s, 1, "CodeGeneratorRequest.requestedFiles")
 }
 
-func (s Node_NestedNode) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s CodeGeneratorRequest) HasRequestedFiles() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-func (Node_NestedNode) DecodeFromPtr(p capnp.Ptr) Node_NestedNode {
-	return Node_NestedNode(capnp.Struct{}.DecodeFromPtr(p))
+func (s CodeGeneratorRequest) SetRequestedFiles(v CodeGeneratorRequest_RequestedFile_List) error {
+	return capnp.SetListField(s, 1, v)
 }
 
-func (s Node_NestedNode) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Node_NestedNode) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s Node_NestedNode) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s Node_NestedNode) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Node_NestedNode) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
-
-func (s Node_NestedNode) HasName() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Node_NestedNode) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s Node_NestedNode) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
-
-func (s Node_NestedNode) Id() uint64 {
-	return capnp.Struct(s).Uint64(0)
-}
-
-func (s Node_NestedNode) SetId(v uint64) {
-	capnp.Struct(s).SetUint64(0, v)
+// NewRequestedFiles sets the requestedFiles field to a newly
+// allocated CodeGeneratorRequest_RequestedFile_List, preferring placement in s's segment.
+func (s CodeGeneratorRequest) NewRequestedFiles(n int32) (CodeGeneratorRequest_RequestedFile_List, error) {
+	return capnp.NewListField(s, 1, n, NewCodeGeneratorRequest_RequestedFile_List)
 }
 
-// Node_NestedNode_List is a list of Node_NestedNode.
-type Node_NestedNode_List = capnp.StructList[Node_NestedNode]
+// CodeGeneratorRequest_List is a list of CodeGeneratorRequest.
+type CodeGeneratorRequest_List = capnp.StructList[CodeGeneratorRequest]
 
-// NewNode_NestedNode creates a new list of Node_NestedNode.
-func NewNode_NestedNode_List(s *capnp.Segment, sz int32) (Node_NestedNode_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Node_NestedNode](l), err
+// NewCodeGeneratorRequest creates a new list of CodeGeneratorRequest.
+func NewCodeGeneratorRequest_List(s *capnp.Segment, sz int32) (CodeGeneratorRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4}, sz)
+	return capnp.StructList[CodeGeneratorRequest](l), err
 }
 
-// Node_NestedNode_Future is a wrapper for a Node_NestedNode promised by a client call.
-type Node_NestedNode_Future struct{ *capnp.Future }
+// CodeGeneratorRequest_Future is a wrapper for a CodeGeneratorRequest promised by a client call.
+type CodeGeneratorRequest_Future struct{ *capnp.Future }
 
-func (f Node_NestedNode_Future) Struct() (Node_NestedNode, error) {
+func (f CodeGeneratorRequest_Future) Struct() (CodeGeneratorRequest, error) {
 	p, err := f.Future.Ptr()
-	return Node_NestedNode(p.Struct()), err
+	return CodeGeneratorRequest(p.Struct()), err
+}
+func (p CodeGeneratorRequest_Future) CapnpVersion() CapnpVersion_Future {
+	return CapnpVersion_Future{Future: p.Future.Field(2, nil)}
 }
 
-type Node_SourceInfo capnp.Struct
+type CodeGeneratorRequest_RequestedFile capnp.Struct
 
-// Node_SourceInfo_TypeID is the unique identifier for the type Node_SourceInfo.
-const Node_SourceInfo_TypeID = 0xf38e1de3041357ae
+// CodeGeneratorRequest_RequestedFile_TypeID is the unique identifier for the type CodeGeneratorRequest_RequestedFile.
+const CodeGeneratorRequest_RequestedFile_TypeID = 0xcfea0eb02e810062
 
-func NewNode_SourceInfo(s *capnp.Segment) (Node_SourceInfo, error) {
+func NewCodeGeneratorRequest_RequestedFile(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Node_SourceInfo(st), err
+	return CodeGeneratorRequest_RequestedFile(st), err
 }
 
-func NewRootNode_SourceInfo(s *capnp.Segment) (Node_SourceInfo, error) {
+func NewRootCodeGeneratorRequest_RequestedFile(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Node_SourceInfo(st), err
+	return CodeGeneratorRequest_RequestedFile(st), err
 }
 
-func ReadRootNode_SourceInfo(msg *capnp.Message) (Node_SourceInfo, error) {
+func ReadRootCodeGeneratorRequest_RequestedFile(msg *capnp.Message) (CodeGeneratorRequest_RequestedFile, error) {
 	root, err := msg.Root()
-	return Node_SourceInfo(root.Struct()), err
+	return CodeGeneratorRequest_RequestedFile(root.Struct()), err
 }
 
-func (s Node_SourceInfo) String() string {
-	str, _ := text.Marshal(0xf38e1de3041357ae, capnp.Struct(s))
+func (s CodeGeneratorRequest_RequestedFile) String() string {
+	str, _ := text.Marshal(0xcfea0eb02e810062, capnp.Struct(s))
 	return str
 }
 
-func (s Node_SourceInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CodeGeneratorRequest_RequestedFile) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Node_SourceInfo) DecodeFromPtr(p capnp.Ptr) Node_SourceInfo {
-	return Node_SourceInfo(capnp.Struct{}.DecodeFromPtr(p))
+func (CodeGeneratorRequest_RequestedFile) DecodeFromPtr(p capnp.Ptr) CodeGeneratorRequest_RequestedFile {
+	return CodeGeneratorRequest_RequestedFile(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node_SourceInfo) ToPtr() capnp.Ptr {
+func (s CodeGeneratorRequest_RequestedFile) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Node_SourceInfo) IsValid() bool {
+func (s CodeGeneratorRequest_RequestedFile) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_SourceInfo) Message() *capnp.Message {
+func (s CodeGeneratorRequest_RequestedFile) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Node_SourceInfo) Segment() *capnp.Segment {
+func (s CodeGeneratorRequest_RequestedFile) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Node_SourceInfo) Id() uint64 {
+
+// ID of the file.
+func (s CodeGeneratorRequest_RequestedFile) Id() uint64 {
 	return capnp.Struct(s).Uint64(0)
 }
 
-func (s Node_SourceInfo) SetId(v uint64) {
+func (s CodeGeneratorRequest_RequestedFile) SetId(v uint64) {
 	capnp.Struct(s).SetUint64(0, v)
 }
 
-func (s Node_SourceInfo) DocComment() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+// Name of the file as it appeared on the command-line (minus the src-prefix).  You may use
+// this to decide where to write the output.
+func (s CodeGeneratorRequest_RequestedFile) Filename() (string, error) {
+	return capnp.GetTextField(s, 0, "CodeGeneratorRequest.RequestedFile.filename")
 }
 
-func (s Node_SourceInfo) HasDocComment() bool {
+func (s CodeGeneratorRequest_RequestedFile) HasFilename() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Node_SourceInfo) DocCommentBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s CodeGeneratorRequest_RequestedFile) FilenameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "CodeGeneratorRequest.RequestedFile.filename")
 }
 
-func (s Node_SourceInfo) SetDocComment(v string) error {
+func (s CodeGeneratorRequest_RequestedFile) SetFilename(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
-func (s Node_SourceInfo) Members() (Node_SourceInfo_Member_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Node_SourceInfo_Member_List(p.List()), err
+// List of all imported paths seen in this file.
+func (s CodeGeneratorRequest_RequestedFile) Imports() (CodeGeneratorRequest_RequestedFile_Import_List, error) {
+	return capnp.GetListField[CodeGeneratorRequest_RequestedFile_Import_List](s, 1, "CodeGeneratorRequest.RequestedFile.imports")
 }
 
-func (s Node_SourceInfo) HasMembers() bool {
+func (s CodeGeneratorRequest_RequestedFile) HasImports() bool {
 	return capnp.Struct(s).HasPtr(1)
 }
 
-func (s Node_SourceInfo) SetMembers(v Node_SourceInfo_Member_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+func (s CodeGeneratorRequest_RequestedFile) SetImports(v CodeGeneratorRequest_RequestedFile_Import_List) error {
+	return capnp.SetListField(s, 1, v)
 }
 
-// NewMembers sets the members field to a newly
-// allocated Node_SourceInfo_Member_List, preferring placement in s's segment.
-func (s Node_SourceInfo) NewMembers(n int32) (Node_SourceInfo_Member_List, error) {
-	l, err := NewNode_SourceInfo_Member_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Node_SourceInfo_Member_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+// NewImports sets the imports field to a newly
+// allocated CodeGeneratorRequest_RequestedFile_Import_List, preferring placement in s's segment.
+func (s CodeGeneratorRequest_RequestedFile) NewImports(n int32) (CodeGeneratorRequest_RequestedFile_Import_List, error) {
+	return capnp.NewListField(s, 1, n, NewCodeGeneratorRequest_RequestedFile_Import_List)
 }
 
-// Node_SourceInfo_List is a list of Node_SourceInfo.
-type Node_SourceInfo_List = capnp.StructList[Node_SourceInfo]
+// CodeGeneratorRequest_RequestedFile_List is a list of CodeGeneratorRequest_RequestedFile.
+type CodeGeneratorRequest_RequestedFile_List = capnp.StructList[CodeGeneratorRequest_RequestedFile]
 
-// NewNode_SourceInfo creates a new list of Node_SourceInfo.
-func NewNode_SourceInfo_List(s *capnp.Segment, sz int32) (Node_SourceInfo_List, error) {
+// NewCodeGeneratorRequest_RequestedFile creates a new list of CodeGeneratorRequest_RequestedFile.
+func NewCodeGeneratorRequest_RequestedFile_List(s *capnp.Segment, sz int32) (CodeGeneratorRequest_RequestedFile_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Node_SourceInfo](l), err
+	return capnp.StructList[CodeGeneratorRequest_RequestedFile](l), err
 }
 
-// Node_SourceInfo_Future is a wrapper for a Node_SourceInfo promised by a client call.
-type Node_SourceInfo_Future struct{ *capnp.Future }
+// CodeGeneratorRequest_RequestedFile_Future is a wrapper for a CodeGeneratorRequest_RequestedFile promised by a client call.
+type CodeGeneratorRequest_RequestedFile_Future struct{ *capnp.Future }
 
-func (f Node_SourceInfo_Future) Struct() (Node_SourceInfo, error) {
+func (f CodeGeneratorRequest_RequestedFile_Future) Struct() (CodeGeneratorRequest_RequestedFile, error) {
 	p, err := f.Future.Ptr()
-	return Node_SourceInfo(p.Struct()), err
+	return CodeGeneratorRequest_RequestedFile(p.Struct()), err
 }
 
-type Node_SourceInfo_Member capnp.Struct
+type CodeGeneratorRequest_RequestedFile_Import capnp.Struct
 
-// Node_SourceInfo_Member_TypeID is the unique identifier for the type Node_SourceInfo_Member.
-const Node_SourceInfo_Member_TypeID = 0xc2ba9038898e1fa2
+// CodeGeneratorRequest_RequestedFile_Import_TypeID is the unique identifier for the type CodeGeneratorRequest_RequestedFile_Import.
+const CodeGeneratorRequest_RequestedFile_Import_TypeID = 0xae504193122357e5
 
-func NewNode_SourceInfo_Member(s *capnp.Segment) (Node_SourceInfo_Member, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Node_SourceInfo_Member(st), err
+func NewCodeGeneratorRequest_RequestedFile_Import(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile_Import, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return CodeGeneratorRequest_RequestedFile_Import(st), err
 }
 
-func NewRootNode_SourceInfo_Member(s *capnp.Segment) (Node_SourceInfo_Member, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Node_SourceInfo_Member(st), err
+func NewRootCodeGeneratorRequest_RequestedFile_Import(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile_Import, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return CodeGeneratorRequest_RequestedFile_Import(st), err
 }
 
-func ReadRootNode_SourceInfo_Member(msg *capnp.Message) (Node_SourceInfo_Member, error) {
+func ReadRootCodeGeneratorRequest_RequestedFile_Import(msg *capnp.Message) (CodeGeneratorRequest_RequestedFile_Import, error) {
 	root, err := msg.Root()
-	return Node_SourceInfo_Member(root.Struct()), err
+	return CodeGeneratorRequest_RequestedFile_Import(root.Struct()), err
 }
 
-func (s Node_SourceInfo_Member) String() string {
-	str, _ := text.Marshal(0xc2ba9038898e1fa2, capnp.Struct(s))
+func (s CodeGeneratorRequest_RequestedFile_Import) String() string {
+	str, _ := text.Marshal(0xae504193122357e5, capnp.Struct(s))
 	return str
 }
 
-func (s Node_SourceInfo_Member) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s CodeGeneratorRequest_RequestedFile_Import) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Node_SourceInfo_Member) DecodeFromPtr(p capnp.Ptr) Node_SourceInfo_Member {
-	return Node_SourceInfo_Member(capnp.Struct{}.DecodeFromPtr(p))
+func (CodeGeneratorRequest_RequestedFile_Import) DecodeFromPtr(p capnp.Ptr) CodeGeneratorRequest_RequestedFile_Import {
+	return CodeGeneratorRequest_RequestedFile_Import(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Node_SourceInfo_Member) ToPtr() capnp.Ptr {
+func (s CodeGeneratorRequest_RequestedFile_Import) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Node_SourceInfo_Member) IsValid() bool {
+func (s CodeGeneratorRequest_RequestedFile_Import) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Node_SourceInfo_Member) Message() *capnp.Message {
+func (s CodeGeneratorRequest_RequestedFile_Import) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Node_SourceInfo_Member) Segment() *capnp.Segment {
+func (s CodeGeneratorRequest_RequestedFile_Import) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Node_SourceInfo_Member) DocComment() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+
+// ID of the imported file.
+func (s CodeGeneratorRequest_RequestedFile_Import) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
 }
 
-func (s Node_SourceInfo_Member) HasDocComment() bool {
+func (s CodeGeneratorRequest_RequestedFile_Import) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// Name which *this* file used to refer to the foreign file.  This may be a relative name.
+// This information is provided because it might be useful for code generation, e.g. to
+// generate #include directives in C++.  We don't put this in Node.file because this
+// information is only meaningful at compile time anyway.
+//
+// (On Zooko's triangle, this is the import's petname according to the importing file.)
+func (s CodeGeneratorRequest_RequestedFile_Import) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "CodeGeneratorRequest.RequestedFile.Import.name")
+}
+
+func (s CodeGeneratorRequest_RequestedFile_Import) HasName() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Node_SourceInfo_Member) DocCommentBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s CodeGeneratorRequest_RequestedFile_Import) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "CodeGeneratorRequest.RequestedFile.Import.name")
 }
 
-func (s Node_SourceInfo_Member) SetDocComment(v string) error {
+func (s CodeGeneratorRequest_RequestedFile_Import) SetName(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
-// Node_SourceInfo_Member_List is a list of Node_SourceInfo_Member.
-type Node_SourceInfo_Member_List = capnp.StructList[Node_SourceInfo_Member]
+// CodeGeneratorRequest_RequestedFile_Import_List is a list of CodeGeneratorRequest_RequestedFile_Import.
+type CodeGeneratorRequest_RequestedFile_Import_List = capnp.StructList[CodeGeneratorRequest_RequestedFile_Import]
 
-// NewNode_SourceInfo_Member creates a new list of Node_SourceInfo_Member.
-func NewNode_SourceInfo_Member_List(s *capnp.Segment, sz int32) (Node_SourceInfo_Member_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Node_SourceInfo_Member](l), err
+// NewCodeGeneratorRequest_RequestedFile_Import creates a new list of CodeGeneratorRequest_RequestedFile_Import.
+func NewCodeGeneratorRequest_RequestedFile_Import_List(s *capnp.Segment, sz int32) (CodeGeneratorRequest_RequestedFile_Import_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[CodeGeneratorRequest_RequestedFile_Import](l), err
 }
 
-// Node_SourceInfo_Member_Future is a wrapper for a Node_SourceInfo_Member promised by a client call.
-type Node_SourceInfo_Member_Future struct{ *capnp.Future }
+// CodeGeneratorRequest_RequestedFile_Import_Future is a wrapper for a CodeGeneratorRequest_RequestedFile_Import promised by a client call.
+type CodeGeneratorRequest_RequestedFile_Import_Future struct{ *capnp.Future }
 
-func (f Node_SourceInfo_Member_Future) Struct() (Node_SourceInfo_Member, error) {
+func (f CodeGeneratorRequest_RequestedFile_Import_Future) Struct() (CodeGeneratorRequest_RequestedFile_Import, error) {
 	p, err := f.Future.Ptr()
-	return Node_SourceInfo_Member(p.Struct()), err
+	return CodeGeneratorRequest_RequestedFile_Import(p.Struct()), err
 }
 
-type Field capnp.Struct
-type Field_slot Field
-type Field_group Field
-type Field_ordinal Field
-type Field_Which uint16
-
-const (
-	Field_Which_slot  Field_Which = 0
-	Field_Which_group Field_Which = 1
-)
-
-func (w Field_Which) String() string {
-	const s = "slotgroup"
-	switch w {
-	case Field_Which_slot:
-		return s[0:4]
-	case Field_Which_group:
-		return s[4:9]
-
-	}
-	return "Field_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
-}
+// Possible element sizes for encoded lists.  These correspond exactly to the possible values of
+// the 3-bit element size component of a list pointer.
+type ElementSize uint16
 
-type Field_ordinal_Which uint16
+// ElementSize_TypeID is the unique identifier for the type ElementSize.
+const ElementSize_TypeID = 0xd1958f7dba521926
 
+// Values of ElementSize.
 const (
-	Field_ordinal_Which_implicit Field_ordinal_Which = 0
-	Field_ordinal_Which_explicit Field_ordinal_Which = 1
+	// aka "void", but that's a keyword.
+	ElementSize_empty           ElementSize = 0
+	ElementSize_bit             ElementSize = 1
+	ElementSize_byte            ElementSize = 2
+	ElementSize_twoBytes        ElementSize = 3
+	ElementSize_fourBytes       ElementSize = 4
+	ElementSize_eightBytes      ElementSize = 5
+	ElementSize_pointer         ElementSize = 6
+	ElementSize_inlineComposite ElementSize = 7
 )
 
-func (w Field_ordinal_Which) String() string {
-	const s = "implicitexplicit"
-	switch w {
+// String returns the enum's constant name.
+func (c ElementSize) String() string {
+	switch c {
+	case ElementSize_empty:
+		return "empty"
+	case ElementSize_bit:
+		return "bit"
+	case ElementSize_byte:
+		return "byte"
+	case ElementSize_twoBytes:
+		return "twoBytes"
+	case ElementSize_fourBytes:
+		return "fourBytes"
+	case ElementSize_eightBytes:
+		return "eightBytes"
+	case ElementSize_pointer:
+		return "pointer"
+	case ElementSize_inlineComposite:
+		return "inlineComposite"
+
+	default:
+		return ""
+	}
+}
+
+// ElementSizeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func ElementSizeFromString(c string) ElementSize {
+	switch c {
+	case "empty":
+		return ElementSize_empty
+	case "bit":
+		return ElementSize_bit
+	case "byte":
+		return ElementSize_byte
+	case "twoBytes":
+		return ElementSize_twoBytes
+	case "fourBytes":
+		return ElementSize_fourBytes
+	case "eightBytes":
+		return ElementSize_eightBytes
+	case "pointer":
+		return ElementSize_pointer
+	case "inlineComposite":
+		return ElementSize_inlineComposite
+
+	default:
+		return 0
+	}
+}
+
+type ElementSize_List = capnp.EnumList[ElementSize]
+
+func NewElementSize_List(s *capnp.Segment, sz int32) (ElementSize_List, error) {
+	return capnp.NewEnumList[ElementSize](s, sz)
+}
+
+// Schema for member of an enum.
+type Enumerant capnp.Struct
+
+// Enumerant_TypeID is the unique identifier for the type Enumerant.
+const Enumerant_TypeID = 0x978a7cebdc549a4d
+
+func NewEnumerant(s *capnp.Segment) (Enumerant, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Enumerant(st), err
+}
+
+func NewRootEnumerant(s *capnp.Segment) (Enumerant, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Enumerant(st), err
+}
+
+func ReadRootEnumerant(msg *capnp.Message) (Enumerant, error) {
+	root, err := msg.Root()
+	return Enumerant(root.Struct()), err
+}
+
+func (s Enumerant) String() string {
+	str, _ := text.Marshal(0x978a7cebdc549a4d, capnp.Struct(s))
+	return str
+}
+
+func (s Enumerant) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Enumerant) DecodeFromPtr(p capnp.Ptr) Enumerant {
+	return Enumerant(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Enumerant) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Enumerant) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Enumerant) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Enumerant) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Enumerant) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "Enumerant.name")
+}
+
+func (s Enumerant) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Enumerant) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Enumerant.name")
+}
+
+func (s Enumerant) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// Specifies order in which the enumerants were declared in the code.
+// Like Struct.Field.codeOrder.
+func (s Enumerant) CodeOrder() uint16 {
+	return capnp.Struct(s).Uint16(0)
+}
+
+func (s Enumerant) SetCodeOrder(v uint16) {
+	capnp.Struct(s).SetUint16(0, v)
+}
+
+func (s Enumerant) Annotations() (Annotation_List, error) {
+	return capnp.GetListField[Annotation_List](s, 1, "Enumerant.annotations")
+}
+
+func (s Enumerant) HasAnnotations() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Enumerant) SetAnnotations(v Annotation_List) error {
+	return capnp.SetListField(s, 1, v)
+}
+
+// NewAnnotations sets the annotations field to a newly
+// allocated Annotation_List, preferring placement in s's segment.
+func (s Enumerant) NewAnnotations(n int32) (Annotation_List, error) {
+	return capnp.NewListField(s, 1, n, NewAnnotation_List)
+}
+
+// Enumerant_List is a list of Enumerant.
+type Enumerant_List = capnp.StructList[Enumerant]
+
+// NewEnumerant creates a new list of Enumerant.
+func NewEnumerant_List(s *capnp.Segment, sz int32) (Enumerant_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Enumerant](l), err
+}
+
+// Enumerant_Future is a wrapper for a Enumerant promised by a client call.
+type Enumerant_Future struct{ *capnp.Future }
+
+func (f Enumerant_Future) Struct() (Enumerant, error) {
+	p, err := f.Future.Ptr()
+	return Enumerant(p.Struct()), err
+}
+
+// Schema for a field of a struct.
+type Field capnp.Struct
+
+// A regular, non-group, non-fixed-list field.
+type Field_slot Field
+
+// A group.
+type Field_group Field
+type Field_ordinal Field
+type Field_Which uint16
+
+const (
+	Field_Which_slot  Field_Which = 0
+	Field_Which_group Field_Which = 1
+)
+
+func (w Field_Which) String() string {
+	const s = "slotgroup"
+	switch w {
+	case Field_Which_slot:
+		return s[0:4]
+	case Field_Which_group:
+		return s[4:9]
+
+	}
+	return "Field_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Field_Visitor has a method for each member of the union of
+// Field, which Visit calls with the member's value.
+type Field_Visitor interface {
+	VisitSlot(Field_slot) error
+	VisitGroup(Field_group) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Field) Visit(v Field_Visitor) error {
+	switch w := s.Which(); w {
+	case Field_Which_slot:
+		return v.VisitSlot(s.Slot())
+	case Field_Which_group:
+		return v.VisitGroup(s.Group())
+	default:
+		return &capnp.UnknownMemberError{Union: "Field", Which: uint16(w)}
+	}
+}
+
+type Field_ordinal_Which uint16
+
+const (
+	Field_ordinal_Which_implicit Field_ordinal_Which = 0
+	Field_ordinal_Which_explicit Field_ordinal_Which = 1
+)
+
+func (w Field_ordinal_Which) String() string {
+	const s = "implicitexplicit"
+	switch w {
 	case Field_ordinal_Which_implicit:
 		return s[0:8]
 	case Field_ordinal_Which_explicit:
@@ -1099,6 +1223,27 @@ func (w Field_ordinal_Which) String() string {
 	return "Field_ordinal_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
+// Field_ordinal_Visitor has a method for each member of the union of
+// Field_ordinal, which Visit calls with the member's value.
+type Field_ordinal_Visitor interface {
+	VisitImplicit() error
+	VisitExplicit(uint16) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Field_ordinal) Visit(v Field_ordinal_Visitor) error {
+	switch w := s.Which(); w {
+	case Field_ordinal_Which_implicit:
+		return v.VisitImplicit()
+	case Field_ordinal_Which_explicit:
+		return v.VisitExplicit(s.Explicit())
+	default:
+		return &capnp.UnknownMemberError{Union: "Field.ordinal", Which: uint16(w)}
+	}
+}
+
 // Field_TypeID is the unique identifier for the type Field.
 const Field_TypeID = 0x9aad50a41f4af45f
 
@@ -1149,8 +1294,7 @@ func (s Field) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 func (s Field) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return capnp.GetTextField(s, 0, "Field.name")
 }
 
 func (s Field) HasName() bool {
@@ -1158,14 +1302,19 @@ func (s Field) HasName() bool {
 }
 
 func (s Field) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+	return capnp.GetTextBytesField(s, 0, "Field.name")
 }
 
 func (s Field) SetName(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
+// Indicates where this member appeared in the code, relative to other members.
+// Code ordering may have semantic relevance -- programmers tend to place related fields
+// together.  So, using code ordering makes sense in human-readable formats where ordering is
+// otherwise irrelevant, like JSON.  The values of codeOrder are tightly-packed, so the maximum
+// value is count(members) - 1.  Fields that are members of a union are only ordered relative to
+// the other members of that union, so the maximum value there is count(union.members).
 func (s Field) CodeOrder() uint16 {
 	return capnp.Struct(s).Uint16(0)
 }
@@ -1175,8 +1324,7 @@ func (s Field) SetCodeOrder(v uint16) {
 }
 
 func (s Field) Annotations() (Annotation_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Annotation_List(p.List()), err
+	return capnp.GetListField[Annotation_List](s, 1, "Field.annotations")
 }
 
 func (s Field) HasAnnotations() bool {
@@ -1184,19 +1332,17 @@ func (s Field) HasAnnotations() bool {
 }
 
 func (s Field) SetAnnotations(v Annotation_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+	return capnp.SetListField(s, 1, v)
 }
 
 // NewAnnotations sets the annotations field to a newly
 // allocated Annotation_List, preferring placement in s's segment.
 func (s Field) NewAnnotations(n int32) (Annotation_List, error) {
-	l, err := NewAnnotation_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Annotation_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+	return capnp.NewListField(s, 1, n, NewAnnotation_List)
 }
+
+// If the field is in a union, this is the value which the union's discriminant should take when
+// the field is active.  If the field is not in a union, this is 0xffff.
 func (s Field) DiscriminantValue() uint16 {
 	return capnp.Struct(s).Uint16(2) ^ 65535
 }
@@ -1205,6 +1351,7 @@ func (s Field) SetDiscriminantValue(v uint16) {
 	capnp.Struct(s).SetUint16(2, v^65535)
 }
 
+// A regular, non-group, non-fixed-list field.
 func (s Field) Slot() Field_slot { return Field_slot(s) }
 
 func (s Field) SetSlot() {
@@ -1222,6 +1369,10 @@ func (s Field_slot) Message() *capnp.Message {
 func (s Field_slot) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+
+// Offset, in units of the field's size, from the beginning of the section in which the field
+// resides.  E.g. for a UInt32 field, multiply this by 4 to get the byte offset from the
+// beginning of the data section.
 func (s Field_slot) Offset() uint32 {
 	return capnp.Struct(s).Uint32(4)
 }
@@ -1231,8 +1382,7 @@ func (s Field_slot) SetOffset(v uint32) {
 }
 
 func (s Field_slot) Type() (Type, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return Type(p.Struct()), err
+	return capnp.GetStructField[Type](s, 2, "Field.slot.type")
 }
 
 func (s Field_slot) HasType() bool {
@@ -1240,23 +1390,17 @@ func (s Field_slot) HasType() bool {
 }
 
 func (s Field_slot) SetType(v Type) error {
-	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+	return capnp.SetStructField(s, 2, v)
 }
 
 // NewType sets the type field to a newly
 // allocated Type struct, preferring placement in s's segment.
 func (s Field_slot) NewType() (Type, error) {
-	ss, err := NewType(capnp.Struct(s).Segment())
-	if err != nil {
-		return Type{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.NewStructField(s, 2, NewType)
 }
 
 func (s Field_slot) DefaultValue() (Value, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Value(p.Struct()), err
+	return capnp.GetStructField[Value](s, 3, "Field.slot.defaultValue")
 }
 
 func (s Field_slot) HasDefaultValue() bool {
@@ -1264,20 +1408,19 @@ func (s Field_slot) HasDefaultValue() bool {
 }
 
 func (s Field_slot) SetDefaultValue(v Value) error {
-	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+	return capnp.SetStructField(s, 3, v)
 }
 
 // NewDefaultValue sets the defaultValue field to a newly
 // allocated Value struct, preferring placement in s's segment.
 func (s Field_slot) NewDefaultValue() (Value, error) {
-	ss, err := NewValue(capnp.Struct(s).Segment())
-	if err != nil {
-		return Value{}, err
-	}
-	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.NewStructField(s, 3, NewValue)
 }
 
+// Whether the default value was specified explicitly.  Non-explicit default values are always
+// zero or empty values.  Usually, whether the default value was explicit shouldn't matter.
+// The main use case for this flag is for structs representing method parameters:
+// explicitly-defaulted parameters may be allowed to be omitted when calling the method.
 func (s Field_slot) HadExplicitDefault() bool {
 	return capnp.Struct(s).Bit(128)
 }
@@ -1286,6 +1429,7 @@ func (s Field_slot) SetHadExplicitDefault(v bool) {
 	capnp.Struct(s).SetBit(128, v)
 }
 
+// A group.
 func (s Field) Group() Field_group { return Field_group(s) }
 
 func (s Field) SetGroup() {
@@ -1303,6 +1447,8 @@ func (s Field_group) Message() *capnp.Message {
 func (s Field_group) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
+
+// The ID of the group's node.
 func (s Field_group) TypeId() uint64 {
 	return capnp.Struct(s).Uint64(16)
 }
@@ -1332,6 +1478,10 @@ func (s Field_ordinal) SetImplicit() {
 
 }
 
+// The original ordinal number given to the field.  You probably should NOT use this; if you need
+// a numeric identifier for a field, use its position within the field array for its scope.
+// The ordinal is given here mainly just so that the original schema text can be reproduced given
+// the compiled version -- i.e. so that `capnp compile -ocapnp` can do its job.
 func (s Field_ordinal) Explicit() uint16 {
 	if capnp.Struct(s).Uint16(10) != 1 {
 		panic("Which() != explicit")
@@ -1394,260 +1544,48 @@ func (f Field_ordinal_Future) Struct() (Field_ordinal, error) {
 	return Field_ordinal(p.Struct()), err
 }
 
-type Enumerant capnp.Struct
+// Schema for method of an interface.
+type Method capnp.Struct
 
-// Enumerant_TypeID is the unique identifier for the type Enumerant.
-const Enumerant_TypeID = 0x978a7cebdc549a4d
+// Method_TypeID is the unique identifier for the type Method.
+const Method_TypeID = 0x9500cce23b334d80
 
-func NewEnumerant(s *capnp.Segment) (Enumerant, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Enumerant(st), err
+func NewMethod(s *capnp.Segment) (Method, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
+	return Method(st), err
 }
 
-func NewRootEnumerant(s *capnp.Segment) (Enumerant, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Enumerant(st), err
+func NewRootMethod(s *capnp.Segment) (Method, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
+	return Method(st), err
 }
 
-func ReadRootEnumerant(msg *capnp.Message) (Enumerant, error) {
+func ReadRootMethod(msg *capnp.Message) (Method, error) {
 	root, err := msg.Root()
-	return Enumerant(root.Struct()), err
+	return Method(root.Struct()), err
 }
 
-func (s Enumerant) String() string {
-	str, _ := text.Marshal(0x978a7cebdc549a4d, capnp.Struct(s))
+func (s Method) String() string {
+	str, _ := text.Marshal(0x9500cce23b334d80, capnp.Struct(s))
 	return str
 }
 
-func (s Enumerant) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Method) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Enumerant) DecodeFromPtr(p capnp.Ptr) Enumerant {
-	return Enumerant(capnp.Struct{}.DecodeFromPtr(p))
+func (Method) DecodeFromPtr(p capnp.Ptr) Method {
+	return Method(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Enumerant) ToPtr() capnp.Ptr {
+func (s Method) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Enumerant) IsValid() bool {
+func (s Method) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Enumerant) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s Enumerant) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Enumerant) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
-
-func (s Enumerant) HasName() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Enumerant) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s Enumerant) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
-}
-
-func (s Enumerant) CodeOrder() uint16 {
-	return capnp.Struct(s).Uint16(0)
-}
-
-func (s Enumerant) SetCodeOrder(v uint16) {
-	capnp.Struct(s).SetUint16(0, v)
-}
-
-func (s Enumerant) Annotations() (Annotation_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Annotation_List(p.List()), err
-}
-
-func (s Enumerant) HasAnnotations() bool {
-	return capnp.Struct(s).HasPtr(1)
-}
-
-func (s Enumerant) SetAnnotations(v Annotation_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
-}
-
-// NewAnnotations sets the annotations field to a newly
-// allocated Annotation_List, preferring placement in s's segment.
-func (s Enumerant) NewAnnotations(n int32) (Annotation_List, error) {
-	l, err := NewAnnotation_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Annotation_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-
-// Enumerant_List is a list of Enumerant.
-type Enumerant_List = capnp.StructList[Enumerant]
-
-// NewEnumerant creates a new list of Enumerant.
-func NewEnumerant_List(s *capnp.Segment, sz int32) (Enumerant_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Enumerant](l), err
-}
-
-// Enumerant_Future is a wrapper for a Enumerant promised by a client call.
-type Enumerant_Future struct{ *capnp.Future }
-
-func (f Enumerant_Future) Struct() (Enumerant, error) {
-	p, err := f.Future.Ptr()
-	return Enumerant(p.Struct()), err
-}
-
-type Superclass capnp.Struct
-
-// Superclass_TypeID is the unique identifier for the type Superclass.
-const Superclass_TypeID = 0xa9962a9ed0a4d7f8
-
-func NewSuperclass(s *capnp.Segment) (Superclass, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Superclass(st), err
-}
-
-func NewRootSuperclass(s *capnp.Segment) (Superclass, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Superclass(st), err
-}
-
-func ReadRootSuperclass(msg *capnp.Message) (Superclass, error) {
-	root, err := msg.Root()
-	return Superclass(root.Struct()), err
-}
-
-func (s Superclass) String() string {
-	str, _ := text.Marshal(0xa9962a9ed0a4d7f8, capnp.Struct(s))
-	return str
-}
-
-func (s Superclass) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (Superclass) DecodeFromPtr(p capnp.Ptr) Superclass {
-	return Superclass(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s Superclass) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Superclass) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s Superclass) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
-
-func (s Superclass) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s Superclass) Id() uint64 {
-	return capnp.Struct(s).Uint64(0)
-}
-
-func (s Superclass) SetId(v uint64) {
-	capnp.Struct(s).SetUint64(0, v)
-}
-
-func (s Superclass) Brand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Brand(p.Struct()), err
-}
-
-func (s Superclass) HasBrand() bool {
-	return capnp.Struct(s).HasPtr(0)
-}
-
-func (s Superclass) SetBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
-}
-
-// NewBrand sets the brand field to a newly
-// allocated Brand struct, preferring placement in s's segment.
-func (s Superclass) NewBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-// Superclass_List is a list of Superclass.
-type Superclass_List = capnp.StructList[Superclass]
-
-// NewSuperclass creates a new list of Superclass.
-func NewSuperclass_List(s *capnp.Segment, sz int32) (Superclass_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Superclass](l), err
-}
-
-// Superclass_Future is a wrapper for a Superclass promised by a client call.
-type Superclass_Future struct{ *capnp.Future }
-
-func (f Superclass_Future) Struct() (Superclass, error) {
-	p, err := f.Future.Ptr()
-	return Superclass(p.Struct()), err
-}
-func (p Superclass_Future) Brand() Brand_Future {
-	return Brand_Future{Future: p.Future.Field(0, nil)}
-}
-
-type Method capnp.Struct
-
-// Method_TypeID is the unique identifier for the type Method.
-const Method_TypeID = 0x9500cce23b334d80
-
-func NewMethod(s *capnp.Segment) (Method, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
-	return Method(st), err
-}
-
-func NewRootMethod(s *capnp.Segment) (Method, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
-	return Method(st), err
-}
-
-func ReadRootMethod(msg *capnp.Message) (Method, error) {
-	root, err := msg.Root()
-	return Method(root.Struct()), err
-}
-
-func (s Method) String() string {
-	str, _ := text.Marshal(0x9500cce23b334d80, capnp.Struct(s))
-	return str
-}
-
-func (s Method) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
-}
-
-func (Method) DecodeFromPtr(p capnp.Ptr) Method {
-	return Method(capnp.Struct{}.DecodeFromPtr(p))
-}
-
-func (s Method) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s Method) IsValid() bool {
-	return capnp.Struct(s).IsValid()
-}
-
-func (s Method) Message() *capnp.Message {
+func (s Method) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
@@ -1655,8 +1593,7 @@ func (s Method) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
 func (s Method) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+	return capnp.GetTextField(s, 0, "Method.name")
 }
 
 func (s Method) HasName() bool {
@@ -1664,14 +1601,15 @@ func (s Method) HasName() bool {
 }
 
 func (s Method) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+	return capnp.GetTextBytesField(s, 0, "Method.name")
 }
 
 func (s Method) SetName(v string) error {
 	return capnp.Struct(s).SetText(0, v)
 }
 
+// Specifies order in which the methods were declared in the code.
+// Like Struct.Field.codeOrder.
 func (s Method) CodeOrder() uint16 {
 	return capnp.Struct(s).Uint16(0)
 }
@@ -1680,9 +1618,10 @@ func (s Method) SetCodeOrder(v uint16) {
 	capnp.Struct(s).SetUint16(0, v)
 }
 
+// The parameters listed in [] (typically, type / generic parameters), whose bindings are intended
+// to be inferred rather than specified explicitly, although not all languages support this.
 func (s Method) ImplicitParameters() (Node_Parameter_List, error) {
-	p, err := capnp.Struct(s).Ptr(4)
-	return Node_Parameter_List(p.List()), err
+	return capnp.GetListField[Node_Parameter_List](s, 4, "Method.implicitParameters")
 }
 
 func (s Method) HasImplicitParameters() bool {
@@ -1690,19 +1629,22 @@ func (s Method) HasImplicitParameters() bool {
 }
 
 func (s Method) SetImplicitParameters(v Node_Parameter_List) error {
-	return capnp.Struct(s).SetPtr(4, v.ToPtr())
+	return capnp.SetListField(s, 4, v)
 }
 
 // NewImplicitParameters sets the implicitParameters field to a newly
 // allocated Node_Parameter_List, preferring placement in s's segment.
 func (s Method) NewImplicitParameters(n int32) (Node_Parameter_List, error) {
-	l, err := NewNode_Parameter_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Node_Parameter_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(4, l.ToPtr())
-	return l, err
+	return capnp.NewListField(s, 4, n, NewNode_Parameter_List)
 }
+
+// ID of the parameter struct type.  If a named parameter list was specified in the method
+// declaration (rather than a single struct parameter type) then a corresponding struct type is
+// auto-generated.  Such an auto-generated type will not be listed in the interface's
+// `nestedNodes` and its `scopeId` will be zero -- it is completely detached from the namespace.
+// (Awkwardly, it does of course inherit generic parameters from the method's scope, which makes
+// this a situation where you can't just climb the scope chain to find where a particular
+// generic parameter was introduced. Making the `scopeId` zero was a mistake.)
 func (s Method) ParamStructType() uint64 {
 	return capnp.Struct(s).Uint64(8)
 }
@@ -1711,9 +1653,9 @@ func (s Method) SetParamStructType(v uint64) {
 	capnp.Struct(s).SetUint64(8, v)
 }
 
+// Brand of param struct type.
 func (s Method) ParamBrand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return Brand(p.Struct()), err
+	return capnp.GetStructField[Brand](s, 2, "Method.paramBrand")
 }
 
 func (s Method) HasParamBrand() bool {
@@ -1721,20 +1663,16 @@ func (s Method) HasParamBrand() bool {
 }
 
 func (s Method) SetParamBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
+	return capnp.SetStructField(s, 2, v)
 }
 
 // NewParamBrand sets the paramBrand field to a newly
 // allocated Brand struct, preferring placement in s's segment.
 func (s Method) NewParamBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.NewStructField(s, 2, NewBrand)
 }
 
+// ID of the return struct type; similar to `paramStructType`.
 func (s Method) ResultStructType() uint64 {
 	return capnp.Struct(s).Uint64(16)
 }
@@ -1743,9 +1681,9 @@ func (s Method) SetResultStructType(v uint64) {
 	capnp.Struct(s).SetUint64(16, v)
 }
 
+// Brand of result struct type.
 func (s Method) ResultBrand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Brand(p.Struct()), err
+	return capnp.GetStructField[Brand](s, 3, "Method.resultBrand")
 }
 
 func (s Method) HasResultBrand() bool {
@@ -1753,23 +1691,17 @@ func (s Method) HasResultBrand() bool {
 }
 
 func (s Method) SetResultBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(3, capnp.Struct(v).ToPtr())
+	return capnp.SetStructField(s, 3, v)
 }
 
 // NewResultBrand sets the resultBrand field to a newly
 // allocated Brand struct, preferring placement in s's segment.
 func (s Method) NewResultBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(3, capnp.Struct(ss).ToPtr())
-	return ss, err
+	return capnp.NewStructField(s, 3, NewBrand)
 }
 
 func (s Method) Annotations() (Annotation_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Annotation_List(p.List()), err
+	return capnp.GetListField[Annotation_List](s, 1, "Method.annotations")
 }
 
 func (s Method) HasAnnotations() bool {
@@ -1777,18 +1709,13 @@ func (s Method) HasAnnotations() bool {
 }
 
 func (s Method) SetAnnotations(v Annotation_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+	return capnp.SetListField(s, 1, v)
 }
 
 // NewAnnotations sets the annotations field to a newly
 // allocated Annotation_List, preferring placement in s's segment.
 func (s Method) NewAnnotations(n int32) (Annotation_List, error) {
-	l, err := NewAnnotation_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Annotation_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+	return capnp.NewListField(s, 1, n, NewAnnotation_List)
 }
 
 // Method_List is a list of Method.
@@ -1814,2337 +1741,2737 @@ func (p Method_Future) ResultBrand() Brand_Future {
 	return Brand_Future{Future: p.Future.Field(3, nil)}
 }
 
-type Type capnp.Struct
-type Type_list Type
-type Type_enum Type
-type Type_structType Type
-type Type_interface Type
-type Type_anyPointer Type
-type Type_anyPointer_unconstrained Type
-type Type_anyPointer_parameter Type
-type Type_anyPointer_implicitMethodParameter Type
-type Type_Which uint16
+type Node capnp.Struct
+type Node_structNode Node
+type Node_enum Node
+type Node_interface Node
+type Node_const Node
+type Node_annotation Node
+type Node_Which uint16
 
 const (
-	Type_Which_void       Type_Which = 0
-	Type_Which_bool       Type_Which = 1
-	Type_Which_int8       Type_Which = 2
-	Type_Which_int16      Type_Which = 3
-	Type_Which_int32      Type_Which = 4
-	Type_Which_int64      Type_Which = 5
-	Type_Which_uint8      Type_Which = 6
-	Type_Which_uint16     Type_Which = 7
-	Type_Which_uint32     Type_Which = 8
-	Type_Which_uint64     Type_Which = 9
-	Type_Which_float32    Type_Which = 10
-	Type_Which_float64    Type_Which = 11
-	Type_Which_text       Type_Which = 12
-	Type_Which_data       Type_Which = 13
-	Type_Which_list       Type_Which = 14
-	Type_Which_enum       Type_Which = 15
-	Type_Which_structType Type_Which = 16
-	Type_Which_interface  Type_Which = 17
-	Type_Which_anyPointer Type_Which = 18
+	Node_Which_file       Node_Which = 0
+	Node_Which_structNode Node_Which = 1
+	Node_Which_enum       Node_Which = 2
+	Node_Which_interface  Node_Which = 3
+	Node_Which_const      Node_Which = 4
+	Node_Which_annotation Node_Which = 5
 )
 
-func (w Type_Which) String() string {
-	const s = "voidboolint8int16int32int64uint8uint16uint32uint64float32float64textdatalistenumstructTypeinterfaceanyPointer"
+func (w Node_Which) String() string {
+	const s = "filestructNodeenuminterfaceconstannotation"
 	switch w {
-	case Type_Which_void:
+	case Node_Which_file:
 		return s[0:4]
-	case Type_Which_bool:
-		return s[4:8]
-	case Type_Which_int8:
-		return s[8:12]
-	case Type_Which_int16:
-		return s[12:17]
-	case Type_Which_int32:
-		return s[17:22]
-	case Type_Which_int64:
-		return s[22:27]
-	case Type_Which_uint8:
+	case Node_Which_structNode:
+		return s[4:14]
+	case Node_Which_enum:
+		return s[14:18]
+	case Node_Which_interface:
+		return s[18:27]
+	case Node_Which_const:
 		return s[27:32]
-	case Type_Which_uint16:
-		return s[32:38]
-	case Type_Which_uint32:
-		return s[38:44]
-	case Type_Which_uint64:
-		return s[44:50]
-	case Type_Which_float32:
-		return s[50:57]
-	case Type_Which_float64:
-		return s[57:64]
-	case Type_Which_text:
-		return s[64:68]
-	case Type_Which_data:
-		return s[68:72]
-	case Type_Which_list:
-		return s[72:76]
-	case Type_Which_enum:
-		return s[76:80]
-	case Type_Which_structType:
-		return s[80:90]
-	case Type_Which_interface:
-		return s[90:99]
-	case Type_Which_anyPointer:
-		return s[99:109]
+	case Node_Which_annotation:
+		return s[32:42]
 
 	}
-	return "Type_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "Node_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-type Type_anyPointer_Which uint16
-
-const (
-	Type_anyPointer_Which_unconstrained           Type_anyPointer_Which = 0
-	Type_anyPointer_Which_parameter               Type_anyPointer_Which = 1
-	Type_anyPointer_Which_implicitMethodParameter Type_anyPointer_Which = 2
-)
-
-func (w Type_anyPointer_Which) String() string {
-	const s = "unconstrainedparameterimplicitMethodParameter"
-	switch w {
-	case Type_anyPointer_Which_unconstrained:
-		return s[0:13]
-	case Type_anyPointer_Which_parameter:
-		return s[13:22]
-	case Type_anyPointer_Which_implicitMethodParameter:
-		return s[22:45]
-
-	}
-	return "Type_anyPointer_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+// Node_Visitor has a method for each member of the union of
+// Node, which Visit calls with the member's value.
+type Node_Visitor interface {
+	VisitFile() error
+	VisitStructNode(Node_structNode) error
+	VisitEnum(Node_enum) error
+	VisitInterface(Node_interface) error
+	VisitConst(Node_const) error
+	VisitAnnotation(Node_annotation) error
 }
 
-type Type_anyPointer_unconstrained_Which uint16
-
-const (
-	Type_anyPointer_unconstrained_Which_anyKind    Type_anyPointer_unconstrained_Which = 0
-	Type_anyPointer_unconstrained_Which_struct     Type_anyPointer_unconstrained_Which = 1
-	Type_anyPointer_unconstrained_Which_list       Type_anyPointer_unconstrained_Which = 2
-	Type_anyPointer_unconstrained_Which_capability Type_anyPointer_unconstrained_Which = 3
-)
-
-func (w Type_anyPointer_unconstrained_Which) String() string {
-	const s = "anyKindstructlistcapability"
-	switch w {
-	case Type_anyPointer_unconstrained_Which_anyKind:
-		return s[0:7]
-	case Type_anyPointer_unconstrained_Which_struct:
-		return s[7:13]
-	case Type_anyPointer_unconstrained_Which_list:
-		return s[13:17]
-	case Type_anyPointer_unconstrained_Which_capability:
-		return s[17:27]
-
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Node) Visit(v Node_Visitor) error {
+	switch w := s.Which(); w {
+	case Node_Which_file:
+		return v.VisitFile()
+	case Node_Which_structNode:
+		return v.VisitStructNode(s.StructNode())
+	case Node_Which_enum:
+		return v.VisitEnum(s.Enum())
+	case Node_Which_interface:
+		return v.VisitInterface(s.Interface())
+	case Node_Which_const:
+		return v.VisitConst(s.Const())
+	case Node_Which_annotation:
+		return v.VisitAnnotation(s.Annotation())
+	default:
+		return &capnp.UnknownMemberError{Union: "Node", Which: uint16(w)}
 	}
-	return "Type_anyPointer_unconstrained_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// Type_TypeID is the unique identifier for the type Type.
-const Type_TypeID = 0xd07378ede1f9cc60
+// Node_TypeID is the unique identifier for the type Node.
+const Node_TypeID = 0xe682ab4cf923a417
 
-func NewType(s *capnp.Segment) (Type, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
-	return Type(st), err
+func NewNode(s *capnp.Segment) (Node, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 6})
+	return Node(st), err
 }
 
-func NewRootType(s *capnp.Segment) (Type, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
-	return Type(st), err
+func NewRootNode(s *capnp.Segment) (Node, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 6})
+	return Node(st), err
 }
 
-func ReadRootType(msg *capnp.Message) (Type, error) {
+func ReadRootNode(msg *capnp.Message) (Node, error) {
 	root, err := msg.Root()
-	return Type(root.Struct()), err
+	return Node(root.Struct()), err
 }
 
-func (s Type) String() string {
-	str, _ := text.Marshal(0xd07378ede1f9cc60, capnp.Struct(s))
+func (s Node) String() string {
+	str, _ := text.Marshal(0xe682ab4cf923a417, capnp.Struct(s))
 	return str
 }
 
-func (s Type) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Node) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Type) DecodeFromPtr(p capnp.Ptr) Type {
-	return Type(capnp.Struct{}.DecodeFromPtr(p))
+func (Node) DecodeFromPtr(p capnp.Ptr) Node {
+	return Node(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Type) ToPtr() capnp.Ptr {
+func (s Node) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s Type) Which() Type_Which {
-	return Type_Which(capnp.Struct(s).Uint16(0))
+func (s Node) Which() Node_Which {
+	return Node_Which(capnp.Struct(s).Uint16(12))
 }
-func (s Type) IsValid() bool {
+func (s Node) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Type) Message() *capnp.Message {
+func (s Node) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Type) Segment() *capnp.Segment {
+func (s Node) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Type) SetVoid() {
-	capnp.Struct(s).SetUint16(0, 0)
-
+func (s Node) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
 }
 
-func (s Type) SetBool() {
-	capnp.Struct(s).SetUint16(0, 1)
-
+func (s Node) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
 }
 
-func (s Type) SetInt8() {
-	capnp.Struct(s).SetUint16(0, 2)
-
+// Name to present to humans to identify this Node.  You should not attempt to parse this.  Its
+// format could change.  It is not guaranteed to be unique.
+//
+// (On Zooko's triangle, this is the node's nickname.)
+func (s Node) DisplayName() (string, error) {
+	return capnp.GetTextField(s, 0, "Node.displayName")
 }
 
-func (s Type) SetInt16() {
-	capnp.Struct(s).SetUint16(0, 3)
+func (s Node) HasDisplayName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
 
+func (s Node) DisplayNameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Node.displayName")
 }
 
-func (s Type) SetInt32() {
-	capnp.Struct(s).SetUint16(0, 4)
+func (s Node) SetDisplayName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
 
+// If you want a shorter version of `displayName` (just naming this node, without its surrounding
+// scope), chop off this many characters from the beginning of `displayName`.
+func (s Node) DisplayNamePrefixLength() uint32 {
+	return capnp.Struct(s).Uint32(8)
 }
 
-func (s Type) SetInt64() {
-	capnp.Struct(s).SetUint16(0, 5)
+func (s Node) SetDisplayNamePrefixLength(v uint32) {
+	capnp.Struct(s).SetUint32(8, v)
+}
 
+// ID of the lexical parent node.  Typically, the scope node will have a NestedNode pointing back
+// at this node, but robust code should avoid relying on this (and, in fact, group nodes are not
+// listed in the outer struct's nestedNodes, since they are listed in the fields).  `scopeId` is
+// zero if the node has no parent, which is normally only the case with files, but should be
+// allowed for any kind of node (in order to make runtime type generation easier).
+func (s Node) ScopeId() uint64 {
+	return capnp.Struct(s).Uint64(16)
 }
 
-func (s Type) SetUint8() {
-	capnp.Struct(s).SetUint16(0, 6)
+func (s Node) SetScopeId(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
+}
 
+// If this node is parameterized (generic), the list of parameters. Empty for non-generic types.
+func (s Node) Parameters() (Node_Parameter_List, error) {
+	return capnp.GetListField[Node_Parameter_List](s, 5, "Node.parameters")
 }
 
-func (s Type) SetUint16() {
-	capnp.Struct(s).SetUint16(0, 7)
+func (s Node) HasParameters() bool {
+	return capnp.Struct(s).HasPtr(5)
+}
 
+func (s Node) SetParameters(v Node_Parameter_List) error {
+	return capnp.SetListField(s, 5, v)
 }
 
-func (s Type) SetUint32() {
-	capnp.Struct(s).SetUint16(0, 8)
+// NewParameters sets the parameters field to a newly
+// allocated Node_Parameter_List, preferring placement in s's segment.
+func (s Node) NewParameters(n int32) (Node_Parameter_List, error) {
+	return capnp.NewListField(s, 5, n, NewNode_Parameter_List)
+}
 
+// True if this node is generic, meaning that it or one of its parent scopes has a non-empty
+// `parameters`.
+func (s Node) IsGeneric() bool {
+	return capnp.Struct(s).Bit(288)
 }
 
-func (s Type) SetUint64() {
-	capnp.Struct(s).SetUint16(0, 9)
+func (s Node) SetIsGeneric(v bool) {
+	capnp.Struct(s).SetBit(288, v)
+}
 
+// List of nodes nested within this node, along with the names under which they were declared.
+func (s Node) NestedNodes() (Node_NestedNode_List, error) {
+	return capnp.GetListField[Node_NestedNode_List](s, 1, "Node.nestedNodes")
 }
 
-func (s Type) SetFloat32() {
-	capnp.Struct(s).SetUint16(0, 10)
+func (s Node) HasNestedNodes() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
 
+func (s Node) SetNestedNodes(v Node_NestedNode_List) error {
+	return capnp.SetListField(s, 1, v)
 }
 
-func (s Type) SetFloat64() {
-	capnp.Struct(s).SetUint16(0, 11)
+// NewNestedNodes sets the nestedNodes field to a newly
+// allocated Node_NestedNode_List, preferring placement in s's segment.
+func (s Node) NewNestedNodes(n int32) (Node_NestedNode_List, error) {
+	return capnp.NewListField(s, 1, n, NewNode_NestedNode_List)
+}
 
+// Annotations applied to this node.
+func (s Node) Annotations() (Annotation_List, error) {
+	return capnp.GetListField[Annotation_List](s, 2, "Node.annotations")
 }
 
-func (s Type) SetText() {
-	capnp.Struct(s).SetUint16(0, 12)
+func (s Node) HasAnnotations() bool {
+	return capnp.Struct(s).HasPtr(2)
+}
 
+func (s Node) SetAnnotations(v Annotation_List) error {
+	return capnp.SetListField(s, 2, v)
 }
 
-func (s Type) SetData() {
-	capnp.Struct(s).SetUint16(0, 13)
+// NewAnnotations sets the annotations field to a newly
+// allocated Annotation_List, preferring placement in s's segment.
+func (s Node) NewAnnotations(n int32) (Annotation_List, error) {
+	return capnp.NewListField(s, 2, n, NewAnnotation_List)
+}
+func (s Node) SetFile() {
+	capnp.Struct(s).SetUint16(12, 0)
 
 }
 
-func (s Type) List() Type_list { return Type_list(s) }
+func (s Node) StructNode() Node_structNode { return Node_structNode(s) }
 
-func (s Type) SetList() {
-	capnp.Struct(s).SetUint16(0, 14)
+func (s Node) SetStructNode() {
+	capnp.Struct(s).SetUint16(12, 1)
 }
 
-func (s Type_list) IsValid() bool {
+func (s Node_structNode) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Type_list) Message() *capnp.Message {
+func (s Node_structNode) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Type_list) Segment() *capnp.Segment {
+func (s Node_structNode) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Type_list) ElementType() (Type, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Type(p.Struct()), err
-}
 
-func (s Type_list) HasElementType() bool {
-	return capnp.Struct(s).HasPtr(0)
+// Size of the data section, in words.
+func (s Node_structNode) DataWordCount() uint16 {
+	return capnp.Struct(s).Uint16(14)
 }
 
-func (s Type_list) SetElementType(v Type) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Node_structNode) SetDataWordCount(v uint16) {
+	capnp.Struct(s).SetUint16(14, v)
 }
 
-// NewElementType sets the elementType field to a newly
-// allocated Type struct, preferring placement in s's segment.
-func (s Type_list) NewElementType() (Type, error) {
-	ss, err := NewType(capnp.Struct(s).Segment())
-	if err != nil {
-		return Type{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// Size of the pointer section, in pointers (which are one word each).
+func (s Node_structNode) PointerCount() uint16 {
+	return capnp.Struct(s).Uint16(24)
 }
 
-func (s Type) Enum() Type_enum { return Type_enum(s) }
+func (s Node_structNode) SetPointerCount(v uint16) {
+	capnp.Struct(s).SetUint16(24, v)
+}
 
-func (s Type) SetEnum() {
-	capnp.Struct(s).SetUint16(0, 15)
+// The preferred element size to use when encoding a list of this struct.  If this is anything
+// other than `inlineComposite` then the struct is one word or less in size and is a candidate
+// for list packing optimization.
+func (s Node_structNode) PreferredListEncoding() ElementSize {
+	return ElementSize(capnp.Struct(s).Uint16(26))
 }
 
-func (s Type_enum) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Node_structNode) SetPreferredListEncoding(v ElementSize) {
+	capnp.Struct(s).SetUint16(26, uint16(v))
 }
 
-func (s Type_enum) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+// If true, then this "struct" node is actually not an independent node, but merely represents
+// some named union or group within a particular parent struct.  This node's scopeId refers
+// to the parent struct, which may itself be a union/group in yet another struct.
+//
+// All group nodes share the same dataWordCount and pointerCount as the top-level
+// struct, and their fields live in the same ordinal and offset spaces as all other fields in
+// the struct.
+//
+// Note that a named union is considered a special kind of group -- in fact, a named union
+// is exactly equivalent to a group that contains nothing but an unnamed union.
+func (s Node_structNode) IsGroup() bool {
+	return capnp.Struct(s).Bit(224)
 }
 
-func (s Type_enum) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Node_structNode) SetIsGroup(v bool) {
+	capnp.Struct(s).SetBit(224, v)
 }
-func (s Type_enum) TypeId() uint64 {
-	return capnp.Struct(s).Uint64(8)
+
+// Number of fields in this struct which are members of an anonymous union, and thus may
+// overlap.  If this is non-zero, then a 16-bit discriminant is present indicating which
+// of the overlapping fields is active.  This can never be 1 -- if it is non-zero, it must be
+// two or more.
+//
+// Note that the fields of an unnamed union are considered fields of the scope containing the
+// union -- an unnamed union is not its own group.  So, a top-level struct may contain a
+// non-zero discriminant count.  Named unions, on the other hand, are equivalent to groups
+// containing unnamed unions.  So, a named union has its own independent schema node, with
+// `isGroup` = true.
+func (s Node_structNode) DiscriminantCount() uint16 {
+	return capnp.Struct(s).Uint16(30)
 }
 
-func (s Type_enum) SetTypeId(v uint64) {
-	capnp.Struct(s).SetUint64(8, v)
+func (s Node_structNode) SetDiscriminantCount(v uint16) {
+	capnp.Struct(s).SetUint16(30, v)
 }
 
-func (s Type_enum) Brand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Brand(p.Struct()), err
+// If `discriminantCount` is non-zero, this is the offset of the union discriminant, in
+// multiples of 16 bits.
+func (s Node_structNode) DiscriminantOffset() uint32 {
+	return capnp.Struct(s).Uint32(32)
 }
 
-func (s Type_enum) HasBrand() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Node_structNode) SetDiscriminantOffset(v uint32) {
+	capnp.Struct(s).SetUint32(32, v)
 }
 
-func (s Type_enum) SetBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// Fields defined within this scope (either the struct's top-level fields, or the fields of
+// a particular group; see `isGroup`).
+//
+// The fields are sorted by ordinal number, but note that because groups share the same
+// ordinal space, the field's index in this list is not necessarily exactly its ordinal.
+// On the other hand, the field's position in this list does remain the same even as the
+// protocol evolves, since it is not possible to insert or remove an earlier ordinal.
+// Therefore, for most use cases, if you want to identify a field by number, it may make the
+// most sense to use the field's index in this list rather than its ordinal.
+func (s Node_structNode) Fields() (Field_List, error) {
+	return capnp.GetListField[Field_List](s, 3, "Node.struct.fields")
 }
 
-// NewBrand sets the brand field to a newly
-// allocated Brand struct, preferring placement in s's segment.
-func (s Type_enum) NewBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Node_structNode) HasFields() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
 
-func (s Type) StructType() Type_structType { return Type_structType(s) }
+func (s Node_structNode) SetFields(v Field_List) error {
+	return capnp.SetListField(s, 3, v)
+}
 
-func (s Type) SetStructType() {
-	capnp.Struct(s).SetUint16(0, 16)
+// NewFields sets the fields field to a newly
+// allocated Field_List, preferring placement in s's segment.
+func (s Node_structNode) NewFields(n int32) (Field_List, error) {
+	return capnp.NewListField(s, 3, n, NewField_List)
 }
+func (s Node) Enum() Node_enum { return Node_enum(s) }
 
-func (s Type_structType) IsValid() bool {
+func (s Node) SetEnum() {
+	capnp.Struct(s).SetUint16(12, 2)
+}
+
+func (s Node_enum) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Type_structType) Message() *capnp.Message {
+func (s Node_enum) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Type_structType) Segment() *capnp.Segment {
+func (s Node_enum) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Type_structType) TypeId() uint64 {
-	return capnp.Struct(s).Uint64(8)
-}
-
-func (s Type_structType) SetTypeId(v uint64) {
-	capnp.Struct(s).SetUint64(8, v)
-}
 
-func (s Type_structType) Brand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Brand(p.Struct()), err
+// Enumerants ordered by numeric value (ordinal).
+func (s Node_enum) Enumerants() (Enumerant_List, error) {
+	return capnp.GetListField[Enumerant_List](s, 3, "Node.enum.enumerants")
 }
 
-func (s Type_structType) HasBrand() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Node_enum) HasEnumerants() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
 
-func (s Type_structType) SetBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Node_enum) SetEnumerants(v Enumerant_List) error {
+	return capnp.SetListField(s, 3, v)
 }
 
-// NewBrand sets the brand field to a newly
-// allocated Brand struct, preferring placement in s's segment.
-func (s Type_structType) NewBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+// NewEnumerants sets the enumerants field to a newly
+// allocated Enumerant_List, preferring placement in s's segment.
+func (s Node_enum) NewEnumerants(n int32) (Enumerant_List, error) {
+	return capnp.NewListField(s, 3, n, NewEnumerant_List)
 }
+func (s Node) Interface() Node_interface { return Node_interface(s) }
 
-func (s Type) Interface() Type_interface { return Type_interface(s) }
-
-func (s Type) SetInterface() {
-	capnp.Struct(s).SetUint16(0, 17)
+func (s Node) SetInterface() {
+	capnp.Struct(s).SetUint16(12, 3)
 }
 
-func (s Type_interface) IsValid() bool {
+func (s Node_interface) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Type_interface) Message() *capnp.Message {
+func (s Node_interface) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Type_interface) Segment() *capnp.Segment {
+func (s Node_interface) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Type_interface) TypeId() uint64 {
-	return capnp.Struct(s).Uint64(8)
+
+// Methods ordered by ordinal.
+func (s Node_interface) Methods() (Method_List, error) {
+	return capnp.GetListField[Method_List](s, 3, "Node.interface.methods")
 }
 
-func (s Type_interface) SetTypeId(v uint64) {
-	capnp.Struct(s).SetUint64(8, v)
+func (s Node_interface) HasMethods() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
 
-func (s Type_interface) Brand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Brand(p.Struct()), err
+func (s Node_interface) SetMethods(v Method_List) error {
+	return capnp.SetListField(s, 3, v)
 }
 
-func (s Type_interface) HasBrand() bool {
-	return capnp.Struct(s).HasPtr(0)
+// NewMethods sets the methods field to a newly
+// allocated Method_List, preferring placement in s's segment.
+func (s Node_interface) NewMethods(n int32) (Method_List, error) {
+	return capnp.NewListField(s, 3, n, NewMethod_List)
 }
 
-func (s Type_interface) SetBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+// Superclasses of this interface.
+func (s Node_interface) Superclasses() (Superclass_List, error) {
+	return capnp.GetListField[Superclass_List](s, 4, "Node.interface.superclasses")
 }
 
-// NewBrand sets the brand field to a newly
-// allocated Brand struct, preferring placement in s's segment.
-func (s Type_interface) NewBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Node_interface) HasSuperclasses() bool {
+	return capnp.Struct(s).HasPtr(4)
 }
 
-func (s Type) AnyPointer() Type_anyPointer { return Type_anyPointer(s) }
+func (s Node_interface) SetSuperclasses(v Superclass_List) error {
+	return capnp.SetListField(s, 4, v)
+}
 
-func (s Type) SetAnyPointer() {
-	capnp.Struct(s).SetUint16(0, 18)
+// NewSuperclasses sets the superclasses field to a newly
+// allocated Superclass_List, preferring placement in s's segment.
+func (s Node_interface) NewSuperclasses(n int32) (Superclass_List, error) {
+	return capnp.NewListField(s, 4, n, NewSuperclass_List)
 }
+func (s Node) Const() Node_const { return Node_const(s) }
 
-func (s Type_anyPointer) Which() Type_anyPointer_Which {
-	return Type_anyPointer_Which(capnp.Struct(s).Uint16(8))
+func (s Node) SetConst() {
+	capnp.Struct(s).SetUint16(12, 4)
 }
-func (s Type_anyPointer) IsValid() bool {
+
+func (s Node_const) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Type_anyPointer) Message() *capnp.Message {
+func (s Node_const) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Type_anyPointer) Segment() *capnp.Segment {
+func (s Node_const) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Type_anyPointer) Unconstrained() Type_anyPointer_unconstrained {
-	return Type_anyPointer_unconstrained(s)
-}
-
-func (s Type_anyPointer) SetUnconstrained() {
-	capnp.Struct(s).SetUint16(8, 0)
+func (s Node_const) Type() (Type, error) {
+	return capnp.GetStructField[Type](s, 3, "Node.const.type")
 }
 
-func (s Type_anyPointer_unconstrained) Which() Type_anyPointer_unconstrained_Which {
-	return Type_anyPointer_unconstrained_Which(capnp.Struct(s).Uint16(10))
-}
-func (s Type_anyPointer_unconstrained) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Node_const) HasType() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
 
-func (s Type_anyPointer_unconstrained) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Node_const) SetType(v Type) error {
+	return capnp.SetStructField(s, 3, v)
 }
 
-func (s Type_anyPointer_unconstrained) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+// NewType sets the type field to a newly
+// allocated Type struct, preferring placement in s's segment.
+func (s Node_const) NewType() (Type, error) {
+	return capnp.NewStructField(s, 3, NewType)
 }
-func (s Type_anyPointer_unconstrained) SetAnyKind() {
-	capnp.Struct(s).SetUint16(10, 0)
 
+func (s Node_const) Value() (Value, error) {
+	return capnp.GetStructField[Value](s, 4, "Node.const.value")
 }
 
-func (s Type_anyPointer_unconstrained) SetStruct() {
-	capnp.Struct(s).SetUint16(10, 1)
-
+func (s Node_const) HasValue() bool {
+	return capnp.Struct(s).HasPtr(4)
 }
 
-func (s Type_anyPointer_unconstrained) SetList() {
-	capnp.Struct(s).SetUint16(10, 2)
-
+func (s Node_const) SetValue(v Value) error {
+	return capnp.SetStructField(s, 4, v)
 }
 
-func (s Type_anyPointer_unconstrained) SetCapability() {
-	capnp.Struct(s).SetUint16(10, 3)
-
+// NewValue sets the value field to a newly
+// allocated Value struct, preferring placement in s's segment.
+func (s Node_const) NewValue() (Value, error) {
+	return capnp.NewStructField(s, 4, NewValue)
 }
 
-func (s Type_anyPointer) Parameter() Type_anyPointer_parameter { return Type_anyPointer_parameter(s) }
+func (s Node) Annotation() Node_annotation { return Node_annotation(s) }
 
-func (s Type_anyPointer) SetParameter() {
-	capnp.Struct(s).SetUint16(8, 1)
+func (s Node) SetAnnotation() {
+	capnp.Struct(s).SetUint16(12, 5)
 }
 
-func (s Type_anyPointer_parameter) IsValid() bool {
+func (s Node_annotation) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Type_anyPointer_parameter) Message() *capnp.Message {
+func (s Node_annotation) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Type_anyPointer_parameter) Segment() *capnp.Segment {
+func (s Node_annotation) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Type_anyPointer_parameter) ScopeId() uint64 {
-	return capnp.Struct(s).Uint64(16)
+func (s Node_annotation) Type() (Type, error) {
+	return capnp.GetStructField[Type](s, 3, "Node.annotation.type")
 }
 
-func (s Type_anyPointer_parameter) SetScopeId(v uint64) {
-	capnp.Struct(s).SetUint64(16, v)
+func (s Node_annotation) HasType() bool {
+	return capnp.Struct(s).HasPtr(3)
 }
 
-func (s Type_anyPointer_parameter) ParameterIndex() uint16 {
-	return capnp.Struct(s).Uint16(10)
+func (s Node_annotation) SetType(v Type) error {
+	return capnp.SetStructField(s, 3, v)
 }
 
-func (s Type_anyPointer_parameter) SetParameterIndex(v uint16) {
-	capnp.Struct(s).SetUint16(10, v)
+// NewType sets the type field to a newly
+// allocated Type struct, preferring placement in s's segment.
+func (s Node_annotation) NewType() (Type, error) {
+	return capnp.NewStructField(s, 3, NewType)
 }
 
-func (s Type_anyPointer) ImplicitMethodParameter() Type_anyPointer_implicitMethodParameter {
-	return Type_anyPointer_implicitMethodParameter(s)
+func (s Node_annotation) TargetsFile() bool {
+	return capnp.Struct(s).Bit(112)
 }
 
-func (s Type_anyPointer) SetImplicitMethodParameter() {
-	capnp.Struct(s).SetUint16(8, 2)
+func (s Node_annotation) SetTargetsFile(v bool) {
+	capnp.Struct(s).SetBit(112, v)
 }
 
-func (s Type_anyPointer_implicitMethodParameter) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Node_annotation) TargetsConst() bool {
+	return capnp.Struct(s).Bit(113)
 }
 
-func (s Type_anyPointer_implicitMethodParameter) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Node_annotation) SetTargetsConst(v bool) {
+	capnp.Struct(s).SetBit(113, v)
 }
 
-func (s Type_anyPointer_implicitMethodParameter) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Node_annotation) TargetsEnum() bool {
+	return capnp.Struct(s).Bit(114)
 }
-func (s Type_anyPointer_implicitMethodParameter) ParameterIndex() uint16 {
-	return capnp.Struct(s).Uint16(10)
+
+func (s Node_annotation) SetTargetsEnum(v bool) {
+	capnp.Struct(s).SetBit(114, v)
 }
 
-func (s Type_anyPointer_implicitMethodParameter) SetParameterIndex(v uint16) {
-	capnp.Struct(s).SetUint16(10, v)
+func (s Node_annotation) TargetsEnumerant() bool {
+	return capnp.Struct(s).Bit(115)
 }
 
-// Type_List is a list of Type.
-type Type_List = capnp.StructList[Type]
+func (s Node_annotation) SetTargetsEnumerant(v bool) {
+	capnp.Struct(s).SetBit(115, v)
+}
 
-// NewType creates a new list of Type.
-func NewType_List(s *capnp.Segment, sz int32) (Type_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
-	return capnp.StructList[Type](l), err
+func (s Node_annotation) TargetsStruct() bool {
+	return capnp.Struct(s).Bit(116)
 }
 
-// Type_Future is a wrapper for a Type promised by a client call.
-type Type_Future struct{ *capnp.Future }
+func (s Node_annotation) SetTargetsStruct(v bool) {
+	capnp.Struct(s).SetBit(116, v)
+}
 
-func (f Type_Future) Struct() (Type, error) {
-	p, err := f.Future.Ptr()
-	return Type(p.Struct()), err
+func (s Node_annotation) TargetsField() bool {
+	return capnp.Struct(s).Bit(117)
 }
-func (p Type_Future) List() Type_list_Future { return Type_list_Future{p.Future} }
 
-// Type_list_Future is a wrapper for a Type_list promised by a client call.
-type Type_list_Future struct{ *capnp.Future }
+func (s Node_annotation) SetTargetsField(v bool) {
+	capnp.Struct(s).SetBit(117, v)
+}
 
-func (f Type_list_Future) Struct() (Type_list, error) {
-	p, err := f.Future.Ptr()
-	return Type_list(p.Struct()), err
+func (s Node_annotation) TargetsUnion() bool {
+	return capnp.Struct(s).Bit(118)
 }
-func (p Type_list_Future) ElementType() Type_Future {
-	return Type_Future{Future: p.Future.Field(0, nil)}
+
+func (s Node_annotation) SetTargetsUnion(v bool) {
+	capnp.Struct(s).SetBit(118, v)
 }
-func (p Type_Future) Enum() Type_enum_Future { return Type_enum_Future{p.Future} }
 
-// Type_enum_Future is a wrapper for a Type_enum promised by a client call.
-type Type_enum_Future struct{ *capnp.Future }
+func (s Node_annotation) TargetsGroup() bool {
+	return capnp.Struct(s).Bit(119)
+}
 
-func (f Type_enum_Future) Struct() (Type_enum, error) {
-	p, err := f.Future.Ptr()
-	return Type_enum(p.Struct()), err
+func (s Node_annotation) SetTargetsGroup(v bool) {
+	capnp.Struct(s).SetBit(119, v)
 }
-func (p Type_enum_Future) Brand() Brand_Future {
-	return Brand_Future{Future: p.Future.Field(0, nil)}
+
+func (s Node_annotation) TargetsInterface() bool {
+	return capnp.Struct(s).Bit(120)
 }
-func (p Type_Future) StructType() Type_structType_Future { return Type_structType_Future{p.Future} }
 
-// Type_structType_Future is a wrapper for a Type_structType promised by a client call.
-type Type_structType_Future struct{ *capnp.Future }
+func (s Node_annotation) SetTargetsInterface(v bool) {
+	capnp.Struct(s).SetBit(120, v)
+}
 
-func (f Type_structType_Future) Struct() (Type_structType, error) {
-	p, err := f.Future.Ptr()
-	return Type_structType(p.Struct()), err
+func (s Node_annotation) TargetsMethod() bool {
+	return capnp.Struct(s).Bit(121)
 }
-func (p Type_structType_Future) Brand() Brand_Future {
-	return Brand_Future{Future: p.Future.Field(0, nil)}
+
+func (s Node_annotation) SetTargetsMethod(v bool) {
+	capnp.Struct(s).SetBit(121, v)
 }
-func (p Type_Future) Interface() Type_interface_Future { return Type_interface_Future{p.Future} }
 
-// Type_interface_Future is a wrapper for a Type_interface promised by a client call.
-type Type_interface_Future struct{ *capnp.Future }
+func (s Node_annotation) TargetsParam() bool {
+	return capnp.Struct(s).Bit(122)
+}
 
-func (f Type_interface_Future) Struct() (Type_interface, error) {
-	p, err := f.Future.Ptr()
-	return Type_interface(p.Struct()), err
+func (s Node_annotation) SetTargetsParam(v bool) {
+	capnp.Struct(s).SetBit(122, v)
 }
-func (p Type_interface_Future) Brand() Brand_Future {
-	return Brand_Future{Future: p.Future.Field(0, nil)}
+
+func (s Node_annotation) TargetsAnnotation() bool {
+	return capnp.Struct(s).Bit(123)
 }
-func (p Type_Future) AnyPointer() Type_anyPointer_Future { return Type_anyPointer_Future{p.Future} }
 
-// Type_anyPointer_Future is a wrapper for a Type_anyPointer promised by a client call.
-type Type_anyPointer_Future struct{ *capnp.Future }
+func (s Node_annotation) SetTargetsAnnotation(v bool) {
+	capnp.Struct(s).SetBit(123, v)
+}
 
-func (f Type_anyPointer_Future) Struct() (Type_anyPointer, error) {
+// Node_List is a list of Node.
+type Node_List = capnp.StructList[Node]
+
+// NewNode creates a new list of Node.
+func NewNode_List(s *capnp.Segment, sz int32) (Node_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 6}, sz)
+	return capnp.StructList[Node](l), err
+}
+
+// Node_Future is a wrapper for a Node promised by a client call.
+type Node_Future struct{ *capnp.Future }
+
+func (f Node_Future) Struct() (Node, error) {
 	p, err := f.Future.Ptr()
-	return Type_anyPointer(p.Struct()), err
+	return Node(p.Struct()), err
 }
-func (p Type_anyPointer_Future) Unconstrained() Type_anyPointer_unconstrained_Future {
-	return Type_anyPointer_unconstrained_Future{p.Future}
+func (p Node_Future) StructNode() Node_structNode_Future { return Node_structNode_Future{p.Future} }
+
+// Node_structNode_Future is a wrapper for a Node_structNode promised by a client call.
+type Node_structNode_Future struct{ *capnp.Future }
+
+func (f Node_structNode_Future) Struct() (Node_structNode, error) {
+	p, err := f.Future.Ptr()
+	return Node_structNode(p.Struct()), err
 }
+func (p Node_Future) Enum() Node_enum_Future { return Node_enum_Future{p.Future} }
 
-// Type_anyPointer_unconstrained_Future is a wrapper for a Type_anyPointer_unconstrained promised by a client call.
-type Type_anyPointer_unconstrained_Future struct{ *capnp.Future }
+// Node_enum_Future is a wrapper for a Node_enum promised by a client call.
+type Node_enum_Future struct{ *capnp.Future }
 
-func (f Type_anyPointer_unconstrained_Future) Struct() (Type_anyPointer_unconstrained, error) {
+func (f Node_enum_Future) Struct() (Node_enum, error) {
 	p, err := f.Future.Ptr()
-	return Type_anyPointer_unconstrained(p.Struct()), err
+	return Node_enum(p.Struct()), err
 }
-func (p Type_anyPointer_Future) Parameter() Type_anyPointer_parameter_Future {
-	return Type_anyPointer_parameter_Future{p.Future}
+func (p Node_Future) Interface() Node_interface_Future { return Node_interface_Future{p.Future} }
+
+// Node_interface_Future is a wrapper for a Node_interface promised by a client call.
+type Node_interface_Future struct{ *capnp.Future }
+
+func (f Node_interface_Future) Struct() (Node_interface, error) {
+	p, err := f.Future.Ptr()
+	return Node_interface(p.Struct()), err
 }
+func (p Node_Future) Const() Node_const_Future { return Node_const_Future{p.Future} }
 
-// Type_anyPointer_parameter_Future is a wrapper for a Type_anyPointer_parameter promised by a client call.
-type Type_anyPointer_parameter_Future struct{ *capnp.Future }
+// Node_const_Future is a wrapper for a Node_const promised by a client call.
+type Node_const_Future struct{ *capnp.Future }
 
-func (f Type_anyPointer_parameter_Future) Struct() (Type_anyPointer_parameter, error) {
+func (f Node_const_Future) Struct() (Node_const, error) {
 	p, err := f.Future.Ptr()
-	return Type_anyPointer_parameter(p.Struct()), err
+	return Node_const(p.Struct()), err
 }
-func (p Type_anyPointer_Future) ImplicitMethodParameter() Type_anyPointer_implicitMethodParameter_Future {
-	return Type_anyPointer_implicitMethodParameter_Future{p.Future}
+func (p Node_const_Future) Type() Type_Future {
+	return Type_Future{Future: p.Future.Field(3, nil)}
+}
+func (p Node_const_Future) Value() Value_Future {
+	return Value_Future{Future: p.Future.Field(4, nil)}
 }
+func (p Node_Future) Annotation() Node_annotation_Future { return Node_annotation_Future{p.Future} }
 
-// Type_anyPointer_implicitMethodParameter_Future is a wrapper for a Type_anyPointer_implicitMethodParameter promised by a client call.
-type Type_anyPointer_implicitMethodParameter_Future struct{ *capnp.Future }
+// Node_annotation_Future is a wrapper for a Node_annotation promised by a client call.
+type Node_annotation_Future struct{ *capnp.Future }
 
-func (f Type_anyPointer_implicitMethodParameter_Future) Struct() (Type_anyPointer_implicitMethodParameter, error) {
+func (f Node_annotation_Future) Struct() (Node_annotation, error) {
 	p, err := f.Future.Ptr()
-	return Type_anyPointer_implicitMethodParameter(p.Struct()), err
+	return Node_annotation(p.Struct()), err
+}
+func (p Node_annotation_Future) Type() Type_Future {
+	return Type_Future{Future: p.Future.Field(3, nil)}
 }
 
-type Brand capnp.Struct
+type Node_NestedNode capnp.Struct
 
-// Brand_TypeID is the unique identifier for the type Brand.
-const Brand_TypeID = 0x903455f06065422b
+// Node_NestedNode_TypeID is the unique identifier for the type Node_NestedNode.
+const Node_NestedNode_TypeID = 0xdebf55bbfa0fc242
 
-func NewBrand(s *capnp.Segment) (Brand, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Brand(st), err
+func NewNode_NestedNode(s *capnp.Segment) (Node_NestedNode, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Node_NestedNode(st), err
 }
 
-func NewRootBrand(s *capnp.Segment) (Brand, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Brand(st), err
+func NewRootNode_NestedNode(s *capnp.Segment) (Node_NestedNode, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Node_NestedNode(st), err
 }
 
-func ReadRootBrand(msg *capnp.Message) (Brand, error) {
+func ReadRootNode_NestedNode(msg *capnp.Message) (Node_NestedNode, error) {
 	root, err := msg.Root()
-	return Brand(root.Struct()), err
+	return Node_NestedNode(root.Struct()), err
 }
 
-func (s Brand) String() string {
-	str, _ := text.Marshal(0x903455f06065422b, capnp.Struct(s))
+func (s Node_NestedNode) String() string {
+	str, _ := text.Marshal(0xdebf55bbfa0fc242, capnp.Struct(s))
 	return str
 }
 
-func (s Brand) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Node_NestedNode) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Brand) DecodeFromPtr(p capnp.Ptr) Brand {
-	return Brand(capnp.Struct{}.DecodeFromPtr(p))
+func (Node_NestedNode) DecodeFromPtr(p capnp.Ptr) Node_NestedNode {
+	return Node_NestedNode(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Brand) ToPtr() capnp.Ptr {
+func (s Node_NestedNode) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s Brand) IsValid() bool {
+func (s Node_NestedNode) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Brand) Message() *capnp.Message {
+func (s Node_NestedNode) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Brand) Segment() *capnp.Segment {
+func (s Node_NestedNode) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Brand) Scopes() (Brand_Scope_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Brand_Scope_List(p.List()), err
+
+// Unqualified symbol name.  Unlike Node.displayName, this *can* be used programmatically.
+//
+// (On Zooko's triangle, this is the node's petname according to its parent scope.)
+func (s Node_NestedNode) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "Node.NestedNode.name")
 }
 
-func (s Brand) HasScopes() bool {
+func (s Node_NestedNode) HasName() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Brand) SetScopes(v Brand_Scope_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Node_NestedNode) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Node.NestedNode.name")
 }
 
-// NewScopes sets the scopes field to a newly
-// allocated Brand_Scope_List, preferring placement in s's segment.
-func (s Brand) NewScopes(n int32) (Brand_Scope_List, error) {
-	l, err := NewBrand_Scope_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Brand_Scope_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Node_NestedNode) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// Brand_List is a list of Brand.
-type Brand_List = capnp.StructList[Brand]
-
-// NewBrand creates a new list of Brand.
-func NewBrand_List(s *capnp.Segment, sz int32) (Brand_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Brand](l), err
+// ID of the nested node.  Typically, the target node's scopeId points back to this node, but
+// robust code should avoid relying on this.
+func (s Node_NestedNode) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
 }
 
-// Brand_Future is a wrapper for a Brand promised by a client call.
-type Brand_Future struct{ *capnp.Future }
-
-func (f Brand_Future) Struct() (Brand, error) {
-	p, err := f.Future.Ptr()
-	return Brand(p.Struct()), err
+func (s Node_NestedNode) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
 }
 
-type Brand_Scope capnp.Struct
-type Brand_Scope_Which uint16
+// Node_NestedNode_List is a list of Node_NestedNode.
+type Node_NestedNode_List = capnp.StructList[Node_NestedNode]
 
-const (
-	Brand_Scope_Which_bind    Brand_Scope_Which = 0
-	Brand_Scope_Which_inherit Brand_Scope_Which = 1
-)
+// NewNode_NestedNode creates a new list of Node_NestedNode.
+func NewNode_NestedNode_List(s *capnp.Segment, sz int32) (Node_NestedNode_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Node_NestedNode](l), err
+}
 
-func (w Brand_Scope_Which) String() string {
-	const s = "bindinherit"
-	switch w {
-	case Brand_Scope_Which_bind:
-		return s[0:4]
-	case Brand_Scope_Which_inherit:
-		return s[4:11]
+// Node_NestedNode_Future is a wrapper for a Node_NestedNode promised by a client call.
+type Node_NestedNode_Future struct{ *capnp.Future }
 
-	}
-	return "Brand_Scope_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+func (f Node_NestedNode_Future) Struct() (Node_NestedNode, error) {
+	p, err := f.Future.Ptr()
+	return Node_NestedNode(p.Struct()), err
 }
 
-// Brand_Scope_TypeID is the unique identifier for the type Brand_Scope.
-const Brand_Scope_TypeID = 0xabd73485a9636bc9
+// Information about one of the node's parameters.
+type Node_Parameter capnp.Struct
 
-func NewBrand_Scope(s *capnp.Segment) (Brand_Scope, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return Brand_Scope(st), err
+// Node_Parameter_TypeID is the unique identifier for the type Node_Parameter.
+const Node_Parameter_TypeID = 0xb9521bccf10fa3b1
+
+func NewNode_Parameter(s *capnp.Segment) (Node_Parameter, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Node_Parameter(st), err
 }
 
-func NewRootBrand_Scope(s *capnp.Segment) (Brand_Scope, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return Brand_Scope(st), err
+func NewRootNode_Parameter(s *capnp.Segment) (Node_Parameter, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Node_Parameter(st), err
 }
 
-func ReadRootBrand_Scope(msg *capnp.Message) (Brand_Scope, error) {
+func ReadRootNode_Parameter(msg *capnp.Message) (Node_Parameter, error) {
 	root, err := msg.Root()
-	return Brand_Scope(root.Struct()), err
+	return Node_Parameter(root.Struct()), err
 }
 
-func (s Brand_Scope) String() string {
-	str, _ := text.Marshal(0xabd73485a9636bc9, capnp.Struct(s))
+func (s Node_Parameter) String() string {
+	str, _ := text.Marshal(0xb9521bccf10fa3b1, capnp.Struct(s))
 	return str
 }
 
-func (s Brand_Scope) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Node_Parameter) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Brand_Scope) DecodeFromPtr(p capnp.Ptr) Brand_Scope {
-	return Brand_Scope(capnp.Struct{}.DecodeFromPtr(p))
+func (Node_Parameter) DecodeFromPtr(p capnp.Ptr) Node_Parameter {
+	return Node_Parameter(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Brand_Scope) ToPtr() capnp.Ptr {
+func (s Node_Parameter) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
+func (s Node_Parameter) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
 
-func (s Brand_Scope) Which() Brand_Scope_Which {
-	return Brand_Scope_Which(capnp.Struct(s).Uint16(8))
+func (s Node_Parameter) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
-func (s Brand_Scope) IsValid() bool {
+
+func (s Node_Parameter) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Node_Parameter) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "Node.Parameter.name")
+}
+
+func (s Node_Parameter) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Node_Parameter) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Node.Parameter.name")
+}
+
+func (s Node_Parameter) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// Node_Parameter_List is a list of Node_Parameter.
+type Node_Parameter_List = capnp.StructList[Node_Parameter]
+
+// NewNode_Parameter creates a new list of Node_Parameter.
+func NewNode_Parameter_List(s *capnp.Segment, sz int32) (Node_Parameter_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Node_Parameter](l), err
+}
+
+// Node_Parameter_Future is a wrapper for a Node_Parameter promised by a client call.
+type Node_Parameter_Future struct{ *capnp.Future }
+
+func (f Node_Parameter_Future) Struct() (Node_Parameter, error) {
+	p, err := f.Future.Ptr()
+	return Node_Parameter(p.Struct()), err
+}
+
+// Additional information about a node which is not needed at runtime, but may be useful for
+// documentation or debugging purposes. This is kept in a separate struct to make sure it
+// doesn't accidentally get included in contexts where it is not needed. The
+// `CodeGeneratorRequest` includes this information in a separate array.
+type Node_SourceInfo capnp.Struct
+
+// Node_SourceInfo_TypeID is the unique identifier for the type Node_SourceInfo.
+const Node_SourceInfo_TypeID = 0xf38e1de3041357ae
+
+func NewNode_SourceInfo(s *capnp.Segment) (Node_SourceInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Node_SourceInfo(st), err
+}
+
+func NewRootNode_SourceInfo(s *capnp.Segment) (Node_SourceInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Node_SourceInfo(st), err
+}
+
+func ReadRootNode_SourceInfo(msg *capnp.Message) (Node_SourceInfo, error) {
+	root, err := msg.Root()
+	return Node_SourceInfo(root.Struct()), err
+}
+
+func (s Node_SourceInfo) String() string {
+	str, _ := text.Marshal(0xf38e1de3041357ae, capnp.Struct(s))
+	return str
+}
+
+func (s Node_SourceInfo) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Node_SourceInfo) DecodeFromPtr(p capnp.Ptr) Node_SourceInfo {
+	return Node_SourceInfo(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Node_SourceInfo) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Node_SourceInfo) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Brand_Scope) Message() *capnp.Message {
+func (s Node_SourceInfo) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Brand_Scope) Segment() *capnp.Segment {
+func (s Node_SourceInfo) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Brand_Scope) ScopeId() uint64 {
+
+// ID of the Node which this info describes.
+func (s Node_SourceInfo) Id() uint64 {
 	return capnp.Struct(s).Uint64(0)
 }
 
-func (s Brand_Scope) SetScopeId(v uint64) {
+func (s Node_SourceInfo) SetId(v uint64) {
 	capnp.Struct(s).SetUint64(0, v)
 }
 
-func (s Brand_Scope) Bind() (Brand_Binding_List, error) {
-	if capnp.Struct(s).Uint16(8) != 0 {
-		panic("Which() != bind")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Brand_Binding_List(p.List()), err
+// The top-level doc comment for the Node.
+func (s Node_SourceInfo) DocComment() (string, error) {
+	return capnp.GetTextField(s, 0, "Node.SourceInfo.docComment")
 }
 
-func (s Brand_Scope) HasBind() bool {
-	if capnp.Struct(s).Uint16(8) != 0 {
-		return false
-	}
+func (s Node_SourceInfo) HasDocComment() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Brand_Scope) SetBind(v Brand_Binding_List) error {
-	capnp.Struct(s).SetUint16(8, 0)
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Node_SourceInfo) DocCommentBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Node.SourceInfo.docComment")
 }
 
-// NewBind sets the bind field to a newly
-// allocated Brand_Binding_List, preferring placement in s's segment.
-func (s Brand_Scope) NewBind(n int32) (Brand_Binding_List, error) {
-	capnp.Struct(s).SetUint16(8, 0)
-	l, err := NewBrand_Binding_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Brand_Binding_List{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
+func (s Node_SourceInfo) SetDocComment(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
-func (s Brand_Scope) SetInherit() {
-	capnp.Struct(s).SetUint16(8, 1)
 
+// Information about each member -- i.e. fields (for structs), enumerants (for enums), or
+// methods (for interfaces).
+//
+// This list is the same length and order as the corresponding list in the Node, i.e.
+// Node.struct.fields, Node.enum.enumerants, or Node.interface.methods.
+func (s Node_SourceInfo) Members() (Node_SourceInfo_Member_List, error) {
+	return capnp.GetListField[Node_SourceInfo_Member_List](s, 1, "Node.SourceInfo.members")
 }
 
-// Brand_Scope_List is a list of Brand_Scope.
-type Brand_Scope_List = capnp.StructList[Brand_Scope]
-
-// NewBrand_Scope creates a new list of Brand_Scope.
-func NewBrand_Scope_List(s *capnp.Segment, sz int32) (Brand_Scope_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
-	return capnp.StructList[Brand_Scope](l), err
+func (s Node_SourceInfo) HasMembers() bool {
+	return capnp.Struct(s).HasPtr(1)
 }
 
-// Brand_Scope_Future is a wrapper for a Brand_Scope promised by a client call.
-type Brand_Scope_Future struct{ *capnp.Future }
+func (s Node_SourceInfo) SetMembers(v Node_SourceInfo_Member_List) error {
+	return capnp.SetListField(s, 1, v)
+}
 
-func (f Brand_Scope_Future) Struct() (Brand_Scope, error) {
-	p, err := f.Future.Ptr()
-	return Brand_Scope(p.Struct()), err
+// NewMembers sets the members field to a newly
+// allocated Node_SourceInfo_Member_List, preferring placement in s's segment.
+func (s Node_SourceInfo) NewMembers(n int32) (Node_SourceInfo_Member_List, error) {
+	return capnp.NewListField(s, 1, n, NewNode_SourceInfo_Member_List)
 }
 
-type Brand_Binding capnp.Struct
-type Brand_Binding_Which uint16
+// Node_SourceInfo_List is a list of Node_SourceInfo.
+type Node_SourceInfo_List = capnp.StructList[Node_SourceInfo]
 
-const (
-	Brand_Binding_Which_unbound Brand_Binding_Which = 0
-	Brand_Binding_Which_type    Brand_Binding_Which = 1
-)
+// NewNode_SourceInfo creates a new list of Node_SourceInfo.
+func NewNode_SourceInfo_List(s *capnp.Segment, sz int32) (Node_SourceInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Node_SourceInfo](l), err
+}
 
-func (w Brand_Binding_Which) String() string {
-	const s = "unboundtype"
-	switch w {
-	case Brand_Binding_Which_unbound:
-		return s[0:7]
-	case Brand_Binding_Which_type:
-		return s[7:11]
+// Node_SourceInfo_Future is a wrapper for a Node_SourceInfo promised by a client call.
+type Node_SourceInfo_Future struct{ *capnp.Future }
 
-	}
-	return "Brand_Binding_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+func (f Node_SourceInfo_Future) Struct() (Node_SourceInfo, error) {
+	p, err := f.Future.Ptr()
+	return Node_SourceInfo(p.Struct()), err
 }
 
-// Brand_Binding_TypeID is the unique identifier for the type Brand_Binding.
-const Brand_Binding_TypeID = 0xc863cd16969ee7fc
+type Node_SourceInfo_Member capnp.Struct
 
-func NewBrand_Binding(s *capnp.Segment) (Brand_Binding, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Brand_Binding(st), err
+// Node_SourceInfo_Member_TypeID is the unique identifier for the type Node_SourceInfo_Member.
+const Node_SourceInfo_Member_TypeID = 0xc2ba9038898e1fa2
+
+func NewNode_SourceInfo_Member(s *capnp.Segment) (Node_SourceInfo_Member, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Node_SourceInfo_Member(st), err
 }
 
-func NewRootBrand_Binding(s *capnp.Segment) (Brand_Binding, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Brand_Binding(st), err
+func NewRootNode_SourceInfo_Member(s *capnp.Segment) (Node_SourceInfo_Member, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Node_SourceInfo_Member(st), err
 }
 
-func ReadRootBrand_Binding(msg *capnp.Message) (Brand_Binding, error) {
+func ReadRootNode_SourceInfo_Member(msg *capnp.Message) (Node_SourceInfo_Member, error) {
 	root, err := msg.Root()
-	return Brand_Binding(root.Struct()), err
+	return Node_SourceInfo_Member(root.Struct()), err
 }
 
-func (s Brand_Binding) String() string {
-	str, _ := text.Marshal(0xc863cd16969ee7fc, capnp.Struct(s))
+func (s Node_SourceInfo_Member) String() string {
+	str, _ := text.Marshal(0xc2ba9038898e1fa2, capnp.Struct(s))
 	return str
 }
 
-func (s Brand_Binding) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Node_SourceInfo_Member) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Brand_Binding) DecodeFromPtr(p capnp.Ptr) Brand_Binding {
-	return Brand_Binding(capnp.Struct{}.DecodeFromPtr(p))
+func (Node_SourceInfo_Member) DecodeFromPtr(p capnp.Ptr) Node_SourceInfo_Member {
+	return Node_SourceInfo_Member(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Brand_Binding) ToPtr() capnp.Ptr {
+func (s Node_SourceInfo_Member) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-
-func (s Brand_Binding) Which() Brand_Binding_Which {
-	return Brand_Binding_Which(capnp.Struct(s).Uint16(0))
-}
-func (s Brand_Binding) IsValid() bool {
+func (s Node_SourceInfo_Member) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Brand_Binding) Message() *capnp.Message {
+func (s Node_SourceInfo_Member) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Brand_Binding) Segment() *capnp.Segment {
+func (s Node_SourceInfo_Member) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Brand_Binding) SetUnbound() {
-	capnp.Struct(s).SetUint16(0, 0)
-
-}
 
-func (s Brand_Binding) Type() (Type, error) {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != type")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return Type(p.Struct()), err
+// Doc comment on the member.
+func (s Node_SourceInfo_Member) DocComment() (string, error) {
+	return capnp.GetTextField(s, 0, "Node.SourceInfo.Member.docComment")
 }
 
-func (s Brand_Binding) HasType() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		return false
-	}
+func (s Node_SourceInfo_Member) HasDocComment() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Brand_Binding) SetType(v Type) error {
-	capnp.Struct(s).SetUint16(0, 1)
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Node_SourceInfo_Member) DocCommentBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Node.SourceInfo.Member.docComment")
 }
 
-// NewType sets the type field to a newly
-// allocated Type struct, preferring placement in s's segment.
-func (s Brand_Binding) NewType() (Type, error) {
-	capnp.Struct(s).SetUint16(0, 1)
-	ss, err := NewType(capnp.Struct(s).Segment())
-	if err != nil {
-		return Type{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Node_SourceInfo_Member) SetDocComment(v string) error {
+	return capnp.Struct(s).SetText(0, v)
 }
 
-// Brand_Binding_List is a list of Brand_Binding.
-type Brand_Binding_List = capnp.StructList[Brand_Binding]
+// Node_SourceInfo_Member_List is a list of Node_SourceInfo_Member.
+type Node_SourceInfo_Member_List = capnp.StructList[Node_SourceInfo_Member]
 
-// NewBrand_Binding creates a new list of Brand_Binding.
-func NewBrand_Binding_List(s *capnp.Segment, sz int32) (Brand_Binding_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Brand_Binding](l), err
+// NewNode_SourceInfo_Member creates a new list of Node_SourceInfo_Member.
+func NewNode_SourceInfo_Member_List(s *capnp.Segment, sz int32) (Node_SourceInfo_Member_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Node_SourceInfo_Member](l), err
 }
 
-// Brand_Binding_Future is a wrapper for a Brand_Binding promised by a client call.
-type Brand_Binding_Future struct{ *capnp.Future }
+// Node_SourceInfo_Member_Future is a wrapper for a Node_SourceInfo_Member promised by a client call.
+type Node_SourceInfo_Member_Future struct{ *capnp.Future }
 
-func (f Brand_Binding_Future) Struct() (Brand_Binding, error) {
+func (f Node_SourceInfo_Member_Future) Struct() (Node_SourceInfo_Member, error) {
 	p, err := f.Future.Ptr()
-	return Brand_Binding(p.Struct()), err
+	return Node_SourceInfo_Member(p.Struct()), err
 }
-func (p Brand_Binding_Future) Type() Type_Future {
-	return Type_Future{Future: p.Future.Field(0, nil)}
+
+type Superclass capnp.Struct
+
+// Superclass_TypeID is the unique identifier for the type Superclass.
+const Superclass_TypeID = 0xa9962a9ed0a4d7f8
+
+func NewSuperclass(s *capnp.Segment) (Superclass, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Superclass(st), err
 }
 
-type Value capnp.Struct
-type Value_Which uint16
+func NewRootSuperclass(s *capnp.Segment) (Superclass, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Superclass(st), err
+}
+
+func ReadRootSuperclass(msg *capnp.Message) (Superclass, error) {
+	root, err := msg.Root()
+	return Superclass(root.Struct()), err
+}
+
+func (s Superclass) String() string {
+	str, _ := text.Marshal(0xa9962a9ed0a4d7f8, capnp.Struct(s))
+	return str
+}
+
+func (s Superclass) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Superclass) DecodeFromPtr(p capnp.Ptr) Superclass {
+	return Superclass(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Superclass) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Superclass) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Superclass) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Superclass) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Superclass) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Superclass) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+func (s Superclass) Brand() (Brand, error) {
+	return capnp.GetStructField[Brand](s, 0, "Superclass.brand")
+}
+
+func (s Superclass) HasBrand() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Superclass) SetBrand(v Brand) error {
+	return capnp.SetStructField(s, 0, v)
+}
+
+// NewBrand sets the brand field to a newly
+// allocated Brand struct, preferring placement in s's segment.
+func (s Superclass) NewBrand() (Brand, error) {
+	return capnp.NewStructField(s, 0, NewBrand)
+}
+
+// Superclass_List is a list of Superclass.
+type Superclass_List = capnp.StructList[Superclass]
+
+// NewSuperclass creates a new list of Superclass.
+func NewSuperclass_List(s *capnp.Segment, sz int32) (Superclass_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Superclass](l), err
+}
+
+// Superclass_Future is a wrapper for a Superclass promised by a client call.
+type Superclass_Future struct{ *capnp.Future }
+
+func (f Superclass_Future) Struct() (Superclass, error) {
+	p, err := f.Future.Ptr()
+	return Superclass(p.Struct()), err
+}
+func (p Superclass_Future) Brand() Brand_Future {
+	return Brand_Future{Future: p.Future.Field(0, nil)}
+}
+
+// Represents a type expression.
+type Type capnp.Struct
+type Type_list Type
+type Type_enum Type
+type Type_structType Type
+type Type_interface Type
+type Type_anyPointer Type
+
+// A regular AnyPointer.
+//
+// The name "unconstrained" means as opposed to constraining it to match a type parameter.
+// In retrospect this name is probably a poor choice given that it may still be constrained
+// to be a struct, list, or capability.
+type Type_anyPointer_unconstrained Type
+
+// This is actually a reference to a type parameter defined within this scope.
+type Type_anyPointer_parameter Type
+
+// This is actually a reference to an implicit (generic) parameter of a method. The only
+// legal context for this type to appear is inside Method.paramBrand or Method.resultBrand.
+type Type_anyPointer_implicitMethodParameter Type
+type Type_Which uint16
 
 const (
-	Value_Which_void        Value_Which = 0
-	Value_Which_bool        Value_Which = 1
-	Value_Which_int8        Value_Which = 2
-	Value_Which_int16       Value_Which = 3
-	Value_Which_int32       Value_Which = 4
-	Value_Which_int64       Value_Which = 5
-	Value_Which_uint8       Value_Which = 6
-	Value_Which_uint16      Value_Which = 7
-	Value_Which_uint32      Value_Which = 8
-	Value_Which_uint64      Value_Which = 9
-	Value_Which_float32     Value_Which = 10
-	Value_Which_float64     Value_Which = 11
-	Value_Which_text        Value_Which = 12
-	Value_Which_data        Value_Which = 13
-	Value_Which_list        Value_Which = 14
-	Value_Which_enum        Value_Which = 15
-	Value_Which_structValue Value_Which = 16
-	Value_Which_interface   Value_Which = 17
-	Value_Which_anyPointer  Value_Which = 18
+	Type_Which_void       Type_Which = 0
+	Type_Which_bool       Type_Which = 1
+	Type_Which_int8       Type_Which = 2
+	Type_Which_int16      Type_Which = 3
+	Type_Which_int32      Type_Which = 4
+	Type_Which_int64      Type_Which = 5
+	Type_Which_uint8      Type_Which = 6
+	Type_Which_uint16     Type_Which = 7
+	Type_Which_uint32     Type_Which = 8
+	Type_Which_uint64     Type_Which = 9
+	Type_Which_float32    Type_Which = 10
+	Type_Which_float64    Type_Which = 11
+	Type_Which_text       Type_Which = 12
+	Type_Which_data       Type_Which = 13
+	Type_Which_list       Type_Which = 14
+	Type_Which_enum       Type_Which = 15
+	Type_Which_structType Type_Which = 16
+	Type_Which_interface  Type_Which = 17
+	Type_Which_anyPointer Type_Which = 18
 )
 
-func (w Value_Which) String() string {
-	const s = "voidboolint8int16int32int64uint8uint16uint32uint64float32float64textdatalistenumstructValueinterfaceanyPointer"
+func (w Type_Which) String() string {
+	const s = "voidboolint8int16int32int64uint8uint16uint32uint64float32float64textdatalistenumstructTypeinterfaceanyPointer"
 	switch w {
-	case Value_Which_void:
+	case Type_Which_void:
 		return s[0:4]
-	case Value_Which_bool:
+	case Type_Which_bool:
 		return s[4:8]
-	case Value_Which_int8:
+	case Type_Which_int8:
 		return s[8:12]
-	case Value_Which_int16:
+	case Type_Which_int16:
 		return s[12:17]
-	case Value_Which_int32:
+	case Type_Which_int32:
 		return s[17:22]
-	case Value_Which_int64:
+	case Type_Which_int64:
 		return s[22:27]
-	case Value_Which_uint8:
+	case Type_Which_uint8:
 		return s[27:32]
-	case Value_Which_uint16:
+	case Type_Which_uint16:
 		return s[32:38]
-	case Value_Which_uint32:
+	case Type_Which_uint32:
 		return s[38:44]
-	case Value_Which_uint64:
+	case Type_Which_uint64:
 		return s[44:50]
-	case Value_Which_float32:
+	case Type_Which_float32:
 		return s[50:57]
-	case Value_Which_float64:
+	case Type_Which_float64:
 		return s[57:64]
-	case Value_Which_text:
+	case Type_Which_text:
 		return s[64:68]
-	case Value_Which_data:
+	case Type_Which_data:
 		return s[68:72]
-	case Value_Which_list:
+	case Type_Which_list:
 		return s[72:76]
-	case Value_Which_enum:
+	case Type_Which_enum:
 		return s[76:80]
-	case Value_Which_structValue:
-		return s[80:91]
-	case Value_Which_interface:
-		return s[91:100]
-	case Value_Which_anyPointer:
-		return s[100:110]
+	case Type_Which_structType:
+		return s[80:90]
+	case Type_Which_interface:
+		return s[90:99]
+	case Type_Which_anyPointer:
+		return s[99:109]
 
 	}
-	return "Value_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+	return "Type_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-// Value_TypeID is the unique identifier for the type Value.
-const Value_TypeID = 0xce23dcd2d7b00c9b
+// Type_Visitor has a method for each member of the union of
+// Type, which Visit calls with the member's value.
+type Type_Visitor interface {
+	VisitVoid() error
+	VisitBool() error
+	VisitInt8() error
+	VisitInt16() error
+	VisitInt32() error
+	VisitInt64() error
+	VisitUint8() error
+	VisitUint16() error
+	VisitUint32() error
+	VisitUint64() error
+	VisitFloat32() error
+	VisitFloat64() error
+	VisitText() error
+	VisitData() error
+	VisitList(Type_list) error
+	VisitEnum(Type_enum) error
+	VisitStructType(Type_structType) error
+	VisitInterface(Type_interface) error
+	VisitAnyPointer(Type_anyPointer) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Type) Visit(v Type_Visitor) error {
+	switch w := s.Which(); w {
+	case Type_Which_void:
+		return v.VisitVoid()
+	case Type_Which_bool:
+		return v.VisitBool()
+	case Type_Which_int8:
+		return v.VisitInt8()
+	case Type_Which_int16:
+		return v.VisitInt16()
+	case Type_Which_int32:
+		return v.VisitInt32()
+	case Type_Which_int64:
+		return v.VisitInt64()
+	case Type_Which_uint8:
+		return v.VisitUint8()
+	case Type_Which_uint16:
+		return v.VisitUint16()
+	case Type_Which_uint32:
+		return v.VisitUint32()
+	case Type_Which_uint64:
+		return v.VisitUint64()
+	case Type_Which_float32:
+		return v.VisitFloat32()
+	case Type_Which_float64:
+		return v.VisitFloat64()
+	case Type_Which_text:
+		return v.VisitText()
+	case Type_Which_data:
+		return v.VisitData()
+	case Type_Which_list:
+		return v.VisitList(s.List())
+	case Type_Which_enum:
+		return v.VisitEnum(s.Enum())
+	case Type_Which_structType:
+		return v.VisitStructType(s.StructType())
+	case Type_Which_interface:
+		return v.VisitInterface(s.Interface())
+	case Type_Which_anyPointer:
+		return v.VisitAnyPointer(s.AnyPointer())
+	default:
+		return &capnp.UnknownMemberError{Union: "Type", Which: uint16(w)}
+	}
+}
 
-func NewValue(s *capnp.Segment) (Value, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return Value(st), err
+type Type_anyPointer_Which uint16
+
+const (
+	Type_anyPointer_Which_unconstrained           Type_anyPointer_Which = 0
+	Type_anyPointer_Which_parameter               Type_anyPointer_Which = 1
+	Type_anyPointer_Which_implicitMethodParameter Type_anyPointer_Which = 2
+)
+
+func (w Type_anyPointer_Which) String() string {
+	const s = "unconstrainedparameterimplicitMethodParameter"
+	switch w {
+	case Type_anyPointer_Which_unconstrained:
+		return s[0:13]
+	case Type_anyPointer_Which_parameter:
+		return s[13:22]
+	case Type_anyPointer_Which_implicitMethodParameter:
+		return s[22:45]
+
+	}
+	return "Type_anyPointer_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-func NewRootValue(s *capnp.Segment) (Value, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
-	return Value(st), err
+// Type_anyPointer_Visitor has a method for each member of the union of
+// Type_anyPointer, which Visit calls with the member's value.
+type Type_anyPointer_Visitor interface {
+	VisitUnconstrained(Type_anyPointer_unconstrained) error
+	VisitParameter(Type_anyPointer_parameter) error
+	VisitImplicitMethodParameter(Type_anyPointer_implicitMethodParameter) error
 }
 
-func ReadRootValue(msg *capnp.Message) (Value, error) {
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Type_anyPointer) Visit(v Type_anyPointer_Visitor) error {
+	switch w := s.Which(); w {
+	case Type_anyPointer_Which_unconstrained:
+		return v.VisitUnconstrained(s.Unconstrained())
+	case Type_anyPointer_Which_parameter:
+		return v.VisitParameter(s.Parameter())
+	case Type_anyPointer_Which_implicitMethodParameter:
+		return v.VisitImplicitMethodParameter(s.ImplicitMethodParameter())
+	default:
+		return &capnp.UnknownMemberError{Union: "Type.anyPointer", Which: uint16(w)}
+	}
+}
+
+type Type_anyPointer_unconstrained_Which uint16
+
+const (
+	Type_anyPointer_unconstrained_Which_anyKind    Type_anyPointer_unconstrained_Which = 0
+	Type_anyPointer_unconstrained_Which_struct     Type_anyPointer_unconstrained_Which = 1
+	Type_anyPointer_unconstrained_Which_list       Type_anyPointer_unconstrained_Which = 2
+	Type_anyPointer_unconstrained_Which_capability Type_anyPointer_unconstrained_Which = 3
+)
+
+func (w Type_anyPointer_unconstrained_Which) String() string {
+	const s = "anyKindstructlistcapability"
+	switch w {
+	case Type_anyPointer_unconstrained_Which_anyKind:
+		return s[0:7]
+	case Type_anyPointer_unconstrained_Which_struct:
+		return s[7:13]
+	case Type_anyPointer_unconstrained_Which_list:
+		return s[13:17]
+	case Type_anyPointer_unconstrained_Which_capability:
+		return s[17:27]
+
+	}
+	return "Type_anyPointer_unconstrained_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
+}
+
+// Type_anyPointer_unconstrained_Visitor has a method for each member of the union of
+// Type_anyPointer_unconstrained, which Visit calls with the member's value.
+type Type_anyPointer_unconstrained_Visitor interface {
+	VisitAnyKind() error
+	VisitStruct() error
+	VisitList() error
+	VisitCapability() error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Type_anyPointer_unconstrained) Visit(v Type_anyPointer_unconstrained_Visitor) error {
+	switch w := s.Which(); w {
+	case Type_anyPointer_unconstrained_Which_anyKind:
+		return v.VisitAnyKind()
+	case Type_anyPointer_unconstrained_Which_struct:
+		return v.VisitStruct()
+	case Type_anyPointer_unconstrained_Which_list:
+		return v.VisitList()
+	case Type_anyPointer_unconstrained_Which_capability:
+		return v.VisitCapability()
+	default:
+		return &capnp.UnknownMemberError{Union: "Type.anyPointer.unconstrained", Which: uint16(w)}
+	}
+}
+
+// Type_TypeID is the unique identifier for the type Type.
+const Type_TypeID = 0xd07378ede1f9cc60
+
+func NewType(s *capnp.Segment) (Type, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Type(st), err
+}
+
+func NewRootType(s *capnp.Segment) (Type, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Type(st), err
+}
+
+func ReadRootType(msg *capnp.Message) (Type, error) {
 	root, err := msg.Root()
-	return Value(root.Struct()), err
+	return Type(root.Struct()), err
 }
 
-func (s Value) String() string {
-	str, _ := text.Marshal(0xce23dcd2d7b00c9b, capnp.Struct(s))
+func (s Type) String() string {
+	str, _ := text.Marshal(0xd07378ede1f9cc60, capnp.Struct(s))
 	return str
 }
 
-func (s Value) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Type) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (Value) DecodeFromPtr(p capnp.Ptr) Value {
-	return Value(capnp.Struct{}.DecodeFromPtr(p))
+func (Type) DecodeFromPtr(p capnp.Ptr) Type {
+	return Type(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s Value) ToPtr() capnp.Ptr {
+func (s Type) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
 
-func (s Value) Which() Value_Which {
-	return Value_Which(capnp.Struct(s).Uint16(0))
+func (s Type) Which() Type_Which {
+	return Type_Which(capnp.Struct(s).Uint16(0))
 }
-func (s Value) IsValid() bool {
+func (s Type) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Value) Message() *capnp.Message {
+func (s Type) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Value) Segment() *capnp.Segment {
+func (s Type) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Value) SetVoid() {
+func (s Type) SetVoid() {
 	capnp.Struct(s).SetUint16(0, 0)
 
 }
 
-func (s Value) Bool() bool {
-	if capnp.Struct(s).Uint16(0) != 1 {
-		panic("Which() != bool")
-	}
-	return capnp.Struct(s).Bit(16)
+func (s Type) SetBool() {
+	capnp.Struct(s).SetUint16(0, 1)
+
 }
 
-func (s Value) SetBool(v bool) {
-	capnp.Struct(s).SetUint16(0, 1)
-	capnp.Struct(s).SetBit(16, v)
+func (s Type) SetInt8() {
+	capnp.Struct(s).SetUint16(0, 2)
+
 }
 
-func (s Value) Int8() int8 {
-	if capnp.Struct(s).Uint16(0) != 2 {
-		panic("Which() != int8")
-	}
-	return int8(capnp.Struct(s).Uint8(2))
+func (s Type) SetInt16() {
+	capnp.Struct(s).SetUint16(0, 3)
+
 }
 
-func (s Value) SetInt8(v int8) {
-	capnp.Struct(s).SetUint16(0, 2)
-	capnp.Struct(s).SetUint8(2, uint8(v))
+func (s Type) SetInt32() {
+	capnp.Struct(s).SetUint16(0, 4)
+
 }
 
-func (s Value) Int16() int16 {
-	if capnp.Struct(s).Uint16(0) != 3 {
-		panic("Which() != int16")
-	}
-	return int16(capnp.Struct(s).Uint16(2))
+func (s Type) SetInt64() {
+	capnp.Struct(s).SetUint16(0, 5)
+
 }
 
-func (s Value) SetInt16(v int16) {
-	capnp.Struct(s).SetUint16(0, 3)
-	capnp.Struct(s).SetUint16(2, uint16(v))
+func (s Type) SetUint8() {
+	capnp.Struct(s).SetUint16(0, 6)
+
 }
 
-func (s Value) Int32() int32 {
-	if capnp.Struct(s).Uint16(0) != 4 {
-		panic("Which() != int32")
-	}
-	return int32(capnp.Struct(s).Uint32(4))
+func (s Type) SetUint16() {
+	capnp.Struct(s).SetUint16(0, 7)
+
 }
 
-func (s Value) SetInt32(v int32) {
-	capnp.Struct(s).SetUint16(0, 4)
-	capnp.Struct(s).SetUint32(4, uint32(v))
+func (s Type) SetUint32() {
+	capnp.Struct(s).SetUint16(0, 8)
+
 }
 
-func (s Value) Int64() int64 {
-	if capnp.Struct(s).Uint16(0) != 5 {
-		panic("Which() != int64")
-	}
-	return int64(capnp.Struct(s).Uint64(8))
+func (s Type) SetUint64() {
+	capnp.Struct(s).SetUint16(0, 9)
+
 }
 
-func (s Value) SetInt64(v int64) {
-	capnp.Struct(s).SetUint16(0, 5)
-	capnp.Struct(s).SetUint64(8, uint64(v))
+func (s Type) SetFloat32() {
+	capnp.Struct(s).SetUint16(0, 10)
+
+}
+
+func (s Type) SetFloat64() {
+	capnp.Struct(s).SetUint16(0, 11)
+
+}
+
+func (s Type) SetText() {
+	capnp.Struct(s).SetUint16(0, 12)
+
+}
+
+func (s Type) SetData() {
+	capnp.Struct(s).SetUint16(0, 13)
+
+}
+
+func (s Type) List() Type_list { return Type_list(s) }
+
+func (s Type) SetList() {
+	capnp.Struct(s).SetUint16(0, 14)
+}
+
+func (s Type_list) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Type_list) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Type_list) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Type_list) ElementType() (Type, error) {
+	return capnp.GetStructField[Type](s, 0, "Type.list.elementType")
 }
 
-func (s Value) Uint8() uint8 {
-	if capnp.Struct(s).Uint16(0) != 6 {
-		panic("Which() != uint8")
-	}
-	return capnp.Struct(s).Uint8(2)
+func (s Type_list) HasElementType() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Value) SetUint8(v uint8) {
-	capnp.Struct(s).SetUint16(0, 6)
-	capnp.Struct(s).SetUint8(2, v)
+func (s Type_list) SetElementType(v Type) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Value) Uint16() uint16 {
-	if capnp.Struct(s).Uint16(0) != 7 {
-		panic("Which() != uint16")
-	}
-	return capnp.Struct(s).Uint16(2)
+// NewElementType sets the elementType field to a newly
+// allocated Type struct, preferring placement in s's segment.
+func (s Type_list) NewElementType() (Type, error) {
+	return capnp.NewStructField(s, 0, NewType)
 }
 
-func (s Value) SetUint16(v uint16) {
-	capnp.Struct(s).SetUint16(0, 7)
-	capnp.Struct(s).SetUint16(2, v)
+func (s Type) Enum() Type_enum { return Type_enum(s) }
+
+func (s Type) SetEnum() {
+	capnp.Struct(s).SetUint16(0, 15)
 }
 
-func (s Value) Uint32() uint32 {
-	if capnp.Struct(s).Uint16(0) != 8 {
-		panic("Which() != uint32")
-	}
-	return capnp.Struct(s).Uint32(4)
+func (s Type_enum) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Value) SetUint32(v uint32) {
-	capnp.Struct(s).SetUint16(0, 8)
-	capnp.Struct(s).SetUint32(4, v)
+func (s Type_enum) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Value) Uint64() uint64 {
-	if capnp.Struct(s).Uint16(0) != 9 {
-		panic("Which() != uint64")
-	}
+func (s Type_enum) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Type_enum) TypeId() uint64 {
 	return capnp.Struct(s).Uint64(8)
 }
 
-func (s Value) SetUint64(v uint64) {
-	capnp.Struct(s).SetUint16(0, 9)
+func (s Type_enum) SetTypeId(v uint64) {
 	capnp.Struct(s).SetUint64(8, v)
 }
 
-func (s Value) Float32() float32 {
-	if capnp.Struct(s).Uint16(0) != 10 {
-		panic("Which() != float32")
-	}
-	return math.Float32frombits(capnp.Struct(s).Uint32(4))
+func (s Type_enum) Brand() (Brand, error) {
+	return capnp.GetStructField[Brand](s, 0, "Type.enum.brand")
 }
 
-func (s Value) SetFloat32(v float32) {
-	capnp.Struct(s).SetUint16(0, 10)
-	capnp.Struct(s).SetUint32(4, math.Float32bits(v))
+func (s Type_enum) HasBrand() bool {
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Value) Float64() float64 {
-	if capnp.Struct(s).Uint16(0) != 11 {
-		panic("Which() != float64")
-	}
-	return math.Float64frombits(capnp.Struct(s).Uint64(8))
+func (s Type_enum) SetBrand(v Brand) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-func (s Value) SetFloat64(v float64) {
-	capnp.Struct(s).SetUint16(0, 11)
-	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
+// NewBrand sets the brand field to a newly
+// allocated Brand struct, preferring placement in s's segment.
+func (s Type_enum) NewBrand() (Brand, error) {
+	return capnp.NewStructField(s, 0, NewBrand)
 }
 
-func (s Value) Text() (string, error) {
-	if capnp.Struct(s).Uint16(0) != 12 {
-		panic("Which() != text")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
-}
+func (s Type) StructType() Type_structType { return Type_structType(s) }
 
-func (s Value) HasText() bool {
-	if capnp.Struct(s).Uint16(0) != 12 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Type) SetStructType() {
+	capnp.Struct(s).SetUint16(0, 16)
 }
 
-func (s Value) TextBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Type_structType) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Value) SetText(v string) error {
-	capnp.Struct(s).SetUint16(0, 12)
-	return capnp.Struct(s).SetText(0, v)
+func (s Type_structType) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Value) Data() ([]byte, error) {
-	if capnp.Struct(s).Uint16(0) != 13 {
-		panic("Which() != data")
-	}
-	p, err := capnp.Struct(s).Ptr(0)
-	return []byte(p.Data()), err
+func (s Type_structType) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
 }
-
-func (s Value) HasData() bool {
-	if capnp.Struct(s).Uint16(0) != 13 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Type_structType) TypeId() uint64 {
+	return capnp.Struct(s).Uint64(8)
 }
 
-func (s Value) SetData(v []byte) error {
-	capnp.Struct(s).SetUint16(0, 13)
-	return capnp.Struct(s).SetData(0, v)
+func (s Type_structType) SetTypeId(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
 }
 
-func (s Value) List() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(0) != 14 {
-		panic("Which() != list")
-	}
-	return capnp.Struct(s).Ptr(0)
+func (s Type_structType) Brand() (Brand, error) {
+	return capnp.GetStructField[Brand](s, 0, "Type.struct.brand")
 }
 
-func (s Value) HasList() bool {
-	if capnp.Struct(s).Uint16(0) != 14 {
-		return false
-	}
+func (s Type_structType) HasBrand() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Value) SetList(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(0, 14)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Type_structType) SetBrand(v Brand) error {
+	return capnp.SetStructField(s, 0, v)
 }
-func (s Value) Enum() uint16 {
-	if capnp.Struct(s).Uint16(0) != 15 {
-		panic("Which() != enum")
-	}
-	return capnp.Struct(s).Uint16(2)
+
+// NewBrand sets the brand field to a newly
+// allocated Brand struct, preferring placement in s's segment.
+func (s Type_structType) NewBrand() (Brand, error) {
+	return capnp.NewStructField(s, 0, NewBrand)
 }
 
-func (s Value) SetEnum(v uint16) {
-	capnp.Struct(s).SetUint16(0, 15)
-	capnp.Struct(s).SetUint16(2, v)
+func (s Type) Interface() Type_interface { return Type_interface(s) }
+
+func (s Type) SetInterface() {
+	capnp.Struct(s).SetUint16(0, 17)
 }
 
-func (s Value) StructValue() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(0) != 16 {
-		panic("Which() != structValue")
-	}
-	return capnp.Struct(s).Ptr(0)
+func (s Type_interface) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Value) HasStructValue() bool {
-	if capnp.Struct(s).Uint16(0) != 16 {
-		return false
-	}
-	return capnp.Struct(s).HasPtr(0)
+func (s Type_interface) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Value) SetStructValue(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(0, 16)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Type_interface) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Type_interface) TypeId() uint64 {
+	return capnp.Struct(s).Uint64(8)
 }
-func (s Value) SetInterface() {
-	capnp.Struct(s).SetUint16(0, 17)
 
+func (s Type_interface) SetTypeId(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
 }
 
-func (s Value) AnyPointer() (capnp.Ptr, error) {
-	if capnp.Struct(s).Uint16(0) != 18 {
-		panic("Which() != anyPointer")
-	}
-	return capnp.Struct(s).Ptr(0)
+func (s Type_interface) Brand() (Brand, error) {
+	return capnp.GetStructField[Brand](s, 0, "Type.interface.brand")
 }
 
-func (s Value) HasAnyPointer() bool {
-	if capnp.Struct(s).Uint16(0) != 18 {
-		return false
-	}
+func (s Type_interface) HasBrand() bool {
 	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s Value) SetAnyPointer(v capnp.Ptr) error {
-	capnp.Struct(s).SetUint16(0, 18)
-	return capnp.Struct(s).SetPtr(0, v)
+func (s Type_interface) SetBrand(v Brand) error {
+	return capnp.SetStructField(s, 0, v)
 }
 
-// Value_List is a list of Value.
-type Value_List = capnp.StructList[Value]
-
-// NewValue creates a new list of Value.
-func NewValue_List(s *capnp.Segment, sz int32) (Value_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
-	return capnp.StructList[Value](l), err
+// NewBrand sets the brand field to a newly
+// allocated Brand struct, preferring placement in s's segment.
+func (s Type_interface) NewBrand() (Brand, error) {
+	return capnp.NewStructField(s, 0, NewBrand)
 }
 
-// Value_Future is a wrapper for a Value promised by a client call.
-type Value_Future struct{ *capnp.Future }
+func (s Type) AnyPointer() Type_anyPointer { return Type_anyPointer(s) }
 
-func (f Value_Future) Struct() (Value, error) {
-	p, err := f.Future.Ptr()
-	return Value(p.Struct()), err
+func (s Type) SetAnyPointer() {
+	capnp.Struct(s).SetUint16(0, 18)
 }
-func (p Value_Future) List() *capnp.Future {
-	return p.Future.Field(0, nil)
+
+func (s Type_anyPointer) Which() Type_anyPointer_Which {
+	return Type_anyPointer_Which(capnp.Struct(s).Uint16(8))
 }
-func (p Value_Future) StructValue() *capnp.Future {
-	return p.Future.Field(0, nil)
+func (s Type_anyPointer) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
-func (p Value_Future) AnyPointer() *capnp.Future {
-	return p.Future.Field(0, nil)
+
+func (s Type_anyPointer) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-type Annotation capnp.Struct
+func (s Type_anyPointer) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
 
-// Annotation_TypeID is the unique identifier for the type Annotation.
-const Annotation_TypeID = 0xf1c8950dab257542
+// A regular AnyPointer.
+//
+// The name "unconstrained" means as opposed to constraining it to match a type parameter.
+// In retrospect this name is probably a poor choice given that it may still be constrained
+// to be a struct, list, or capability.
+func (s Type_anyPointer) Unconstrained() Type_anyPointer_unconstrained {
+	return Type_anyPointer_unconstrained(s)
+}
 
-func NewAnnotation(s *capnp.Segment) (Annotation, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Annotation(st), err
+func (s Type_anyPointer) SetUnconstrained() {
+	capnp.Struct(s).SetUint16(8, 0)
 }
 
-func NewRootAnnotation(s *capnp.Segment) (Annotation, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Annotation(st), err
+func (s Type_anyPointer_unconstrained) Which() Type_anyPointer_unconstrained_Which {
+	return Type_anyPointer_unconstrained_Which(capnp.Struct(s).Uint16(10))
+}
+func (s Type_anyPointer_unconstrained) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func ReadRootAnnotation(msg *capnp.Message) (Annotation, error) {
-	root, err := msg.Root()
-	return Annotation(root.Struct()), err
+func (s Type_anyPointer_unconstrained) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Annotation) String() string {
-	str, _ := text.Marshal(0xf1c8950dab257542, capnp.Struct(s))
-	return str
+func (s Type_anyPointer_unconstrained) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Type_anyPointer_unconstrained) SetAnyKind() {
+	capnp.Struct(s).SetUint16(10, 0)
+
+}
+
+func (s Type_anyPointer_unconstrained) SetStruct() {
+	capnp.Struct(s).SetUint16(10, 1)
+
 }
 
-func (s Annotation) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Type_anyPointer_unconstrained) SetList() {
+	capnp.Struct(s).SetUint16(10, 2)
+
 }
 
-func (Annotation) DecodeFromPtr(p capnp.Ptr) Annotation {
-	return Annotation(capnp.Struct{}.DecodeFromPtr(p))
+func (s Type_anyPointer_unconstrained) SetCapability() {
+	capnp.Struct(s).SetUint16(10, 3)
+
 }
 
-func (s Annotation) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+// This is actually a reference to a type parameter defined within this scope.
+func (s Type_anyPointer) Parameter() Type_anyPointer_parameter { return Type_anyPointer_parameter(s) }
+
+func (s Type_anyPointer) SetParameter() {
+	capnp.Struct(s).SetUint16(8, 1)
 }
-func (s Annotation) IsValid() bool {
+
+func (s Type_anyPointer_parameter) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s Annotation) Message() *capnp.Message {
+func (s Type_anyPointer_parameter) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s Annotation) Segment() *capnp.Segment {
+func (s Type_anyPointer_parameter) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s Annotation) Id() uint64 {
-	return capnp.Struct(s).Uint64(0)
+
+// ID of the generic type whose parameter we're referencing. This should be a parent of the
+// current scope.
+func (s Type_anyPointer_parameter) ScopeId() uint64 {
+	return capnp.Struct(s).Uint64(16)
 }
 
-func (s Annotation) SetId(v uint64) {
-	capnp.Struct(s).SetUint64(0, v)
+func (s Type_anyPointer_parameter) SetScopeId(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
 }
 
-func (s Annotation) Brand() (Brand, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return Brand(p.Struct()), err
+// Index of the parameter within the generic type's parameter list.
+func (s Type_anyPointer_parameter) ParameterIndex() uint16 {
+	return capnp.Struct(s).Uint16(10)
 }
 
-func (s Annotation) HasBrand() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Type_anyPointer_parameter) SetParameterIndex(v uint16) {
+	capnp.Struct(s).SetUint16(10, v)
 }
 
-func (s Annotation) SetBrand(v Brand) error {
-	return capnp.Struct(s).SetPtr(1, capnp.Struct(v).ToPtr())
+// This is actually a reference to an implicit (generic) parameter of a method. The only
+// legal context for this type to appear is inside Method.paramBrand or Method.resultBrand.
+func (s Type_anyPointer) ImplicitMethodParameter() Type_anyPointer_implicitMethodParameter {
+	return Type_anyPointer_implicitMethodParameter(s)
 }
 
-// NewBrand sets the brand field to a newly
-// allocated Brand struct, preferring placement in s's segment.
-func (s Annotation) NewBrand() (Brand, error) {
-	ss, err := NewBrand(capnp.Struct(s).Segment())
-	if err != nil {
-		return Brand{}, err
-	}
-	err = capnp.Struct(s).SetPtr(1, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Type_anyPointer) SetImplicitMethodParameter() {
+	capnp.Struct(s).SetUint16(8, 2)
 }
 
-func (s Annotation) Value() (Value, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Value(p.Struct()), err
+func (s Type_anyPointer_implicitMethodParameter) IsValid() bool {
+	return capnp.Struct(s).IsValid()
 }
 
-func (s Annotation) HasValue() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Type_anyPointer_implicitMethodParameter) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
 }
 
-func (s Annotation) SetValue(v Value) error {
-	return capnp.Struct(s).SetPtr(0, capnp.Struct(v).ToPtr())
+func (s Type_anyPointer_implicitMethodParameter) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Type_anyPointer_implicitMethodParameter) ParameterIndex() uint16 {
+	return capnp.Struct(s).Uint16(10)
 }
 
-// NewValue sets the value field to a newly
-// allocated Value struct, preferring placement in s's segment.
-func (s Annotation) NewValue() (Value, error) {
-	ss, err := NewValue(capnp.Struct(s).Segment())
-	if err != nil {
-		return Value{}, err
-	}
-	err = capnp.Struct(s).SetPtr(0, capnp.Struct(ss).ToPtr())
-	return ss, err
+func (s Type_anyPointer_implicitMethodParameter) SetParameterIndex(v uint16) {
+	capnp.Struct(s).SetUint16(10, v)
 }
 
-// Annotation_List is a list of Annotation.
-type Annotation_List = capnp.StructList[Annotation]
+// Type_List is a list of Type.
+type Type_List = capnp.StructList[Type]
 
-// NewAnnotation creates a new list of Annotation.
-func NewAnnotation_List(s *capnp.Segment, sz int32) (Annotation_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Annotation](l), err
+// NewType creates a new list of Type.
+func NewType_List(s *capnp.Segment, sz int32) (Type_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[Type](l), err
 }
 
-// Annotation_Future is a wrapper for a Annotation promised by a client call.
-type Annotation_Future struct{ *capnp.Future }
+// Type_Future is a wrapper for a Type promised by a client call.
+type Type_Future struct{ *capnp.Future }
 
-func (f Annotation_Future) Struct() (Annotation, error) {
+func (f Type_Future) Struct() (Type, error) {
 	p, err := f.Future.Ptr()
-	return Annotation(p.Struct()), err
-}
-func (p Annotation_Future) Brand() Brand_Future {
-	return Brand_Future{Future: p.Future.Field(1, nil)}
-}
-func (p Annotation_Future) Value() Value_Future {
-	return Value_Future{Future: p.Future.Field(0, nil)}
+	return Type(p.Struct()), err
 }
+func (p Type_Future) List() Type_list_Future { return Type_list_Future{p.Future} }
 
-type ElementSize uint16
-
-// ElementSize_TypeID is the unique identifier for the type ElementSize.
-const ElementSize_TypeID = 0xd1958f7dba521926
-
-// Values of ElementSize.
-const (
-	ElementSize_empty           ElementSize = 0
-	ElementSize_bit             ElementSize = 1
-	ElementSize_byte            ElementSize = 2
-	ElementSize_twoBytes        ElementSize = 3
-	ElementSize_fourBytes       ElementSize = 4
-	ElementSize_eightBytes      ElementSize = 5
-	ElementSize_pointer         ElementSize = 6
-	ElementSize_inlineComposite ElementSize = 7
-)
-
-// String returns the enum's constant name.
-func (c ElementSize) String() string {
-	switch c {
-	case ElementSize_empty:
-		return "empty"
-	case ElementSize_bit:
-		return "bit"
-	case ElementSize_byte:
-		return "byte"
-	case ElementSize_twoBytes:
-		return "twoBytes"
-	case ElementSize_fourBytes:
-		return "fourBytes"
-	case ElementSize_eightBytes:
-		return "eightBytes"
-	case ElementSize_pointer:
-		return "pointer"
-	case ElementSize_inlineComposite:
-		return "inlineComposite"
+// Type_list_Future is a wrapper for a Type_list promised by a client call.
+type Type_list_Future struct{ *capnp.Future }
 
-	default:
-		return ""
-	}
+func (f Type_list_Future) Struct() (Type_list, error) {
+	p, err := f.Future.Ptr()
+	return Type_list(p.Struct()), err
 }
-
-// ElementSizeFromString returns the enum value with a name,
-// or the zero value if there's no such value.
-func ElementSizeFromString(c string) ElementSize {
-	switch c {
-	case "empty":
-		return ElementSize_empty
-	case "bit":
-		return ElementSize_bit
-	case "byte":
-		return ElementSize_byte
-	case "twoBytes":
-		return ElementSize_twoBytes
-	case "fourBytes":
-		return ElementSize_fourBytes
-	case "eightBytes":
-		return ElementSize_eightBytes
-	case "pointer":
-		return ElementSize_pointer
-	case "inlineComposite":
-		return ElementSize_inlineComposite
-
-	default:
-		return 0
-	}
+func (p Type_list_Future) ElementType() Type_Future {
+	return Type_Future{Future: p.Future.Field(0, nil)}
 }
+func (p Type_Future) Enum() Type_enum_Future { return Type_enum_Future{p.Future} }
 
-type ElementSize_List = capnp.EnumList[ElementSize]
+// Type_enum_Future is a wrapper for a Type_enum promised by a client call.
+type Type_enum_Future struct{ *capnp.Future }
 
-func NewElementSize_List(s *capnp.Segment, sz int32) (ElementSize_List, error) {
-	return capnp.NewEnumList[ElementSize](s, sz)
+func (f Type_enum_Future) Struct() (Type_enum, error) {
+	p, err := f.Future.Ptr()
+	return Type_enum(p.Struct()), err
 }
+func (p Type_enum_Future) Brand() Brand_Future {
+	return Brand_Future{Future: p.Future.Field(0, nil)}
+}
+func (p Type_Future) StructType() Type_structType_Future { return Type_structType_Future{p.Future} }
 
-type CapnpVersion capnp.Struct
-
-// CapnpVersion_TypeID is the unique identifier for the type CapnpVersion.
-const CapnpVersion_TypeID = 0xd85d305b7d839963
+// Type_structType_Future is a wrapper for a Type_structType promised by a client call.
+type Type_structType_Future struct{ *capnp.Future }
 
-func NewCapnpVersion(s *capnp.Segment) (CapnpVersion, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return CapnpVersion(st), err
+func (f Type_structType_Future) Struct() (Type_structType, error) {
+	p, err := f.Future.Ptr()
+	return Type_structType(p.Struct()), err
 }
-
-func NewRootCapnpVersion(s *capnp.Segment) (CapnpVersion, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return CapnpVersion(st), err
+func (p Type_structType_Future) Brand() Brand_Future {
+	return Brand_Future{Future: p.Future.Field(0, nil)}
 }
+func (p Type_Future) Interface() Type_interface_Future { return Type_interface_Future{p.Future} }
 
-func ReadRootCapnpVersion(msg *capnp.Message) (CapnpVersion, error) {
-	root, err := msg.Root()
-	return CapnpVersion(root.Struct()), err
-}
+// Type_interface_Future is a wrapper for a Type_interface promised by a client call.
+type Type_interface_Future struct{ *capnp.Future }
 
-func (s CapnpVersion) String() string {
-	str, _ := text.Marshal(0xd85d305b7d839963, capnp.Struct(s))
-	return str
+func (f Type_interface_Future) Struct() (Type_interface, error) {
+	p, err := f.Future.Ptr()
+	return Type_interface(p.Struct()), err
 }
-
-func (s CapnpVersion) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (p Type_interface_Future) Brand() Brand_Future {
+	return Brand_Future{Future: p.Future.Field(0, nil)}
 }
+func (p Type_Future) AnyPointer() Type_anyPointer_Future { return Type_anyPointer_Future{p.Future} }
 
-func (CapnpVersion) DecodeFromPtr(p capnp.Ptr) CapnpVersion {
-	return CapnpVersion(capnp.Struct{}.DecodeFromPtr(p))
-}
+// Type_anyPointer_Future is a wrapper for a Type_anyPointer promised by a client call.
+type Type_anyPointer_Future struct{ *capnp.Future }
 
-func (s CapnpVersion) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (f Type_anyPointer_Future) Struct() (Type_anyPointer, error) {
+	p, err := f.Future.Ptr()
+	return Type_anyPointer(p.Struct()), err
 }
-func (s CapnpVersion) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (p Type_anyPointer_Future) Unconstrained() Type_anyPointer_unconstrained_Future {
+	return Type_anyPointer_unconstrained_Future{p.Future}
 }
 
-func (s CapnpVersion) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
-}
+// Type_anyPointer_unconstrained_Future is a wrapper for a Type_anyPointer_unconstrained promised by a client call.
+type Type_anyPointer_unconstrained_Future struct{ *capnp.Future }
 
-func (s CapnpVersion) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s CapnpVersion) Major() uint16 {
-	return capnp.Struct(s).Uint16(0)
+func (f Type_anyPointer_unconstrained_Future) Struct() (Type_anyPointer_unconstrained, error) {
+	p, err := f.Future.Ptr()
+	return Type_anyPointer_unconstrained(p.Struct()), err
 }
-
-func (s CapnpVersion) SetMajor(v uint16) {
-	capnp.Struct(s).SetUint16(0, v)
+func (p Type_anyPointer_Future) Parameter() Type_anyPointer_parameter_Future {
+	return Type_anyPointer_parameter_Future{p.Future}
 }
 
-func (s CapnpVersion) Minor() uint8 {
-	return capnp.Struct(s).Uint8(2)
-}
+// Type_anyPointer_parameter_Future is a wrapper for a Type_anyPointer_parameter promised by a client call.
+type Type_anyPointer_parameter_Future struct{ *capnp.Future }
 
-func (s CapnpVersion) SetMinor(v uint8) {
-	capnp.Struct(s).SetUint8(2, v)
+func (f Type_anyPointer_parameter_Future) Struct() (Type_anyPointer_parameter, error) {
+	p, err := f.Future.Ptr()
+	return Type_anyPointer_parameter(p.Struct()), err
 }
-
-func (s CapnpVersion) Micro() uint8 {
-	return capnp.Struct(s).Uint8(3)
+func (p Type_anyPointer_Future) ImplicitMethodParameter() Type_anyPointer_implicitMethodParameter_Future {
+	return Type_anyPointer_implicitMethodParameter_Future{p.Future}
 }
 
-func (s CapnpVersion) SetMicro(v uint8) {
-	capnp.Struct(s).SetUint8(3, v)
+// Type_anyPointer_implicitMethodParameter_Future is a wrapper for a Type_anyPointer_implicitMethodParameter promised by a client call.
+type Type_anyPointer_implicitMethodParameter_Future struct{ *capnp.Future }
+
+func (f Type_anyPointer_implicitMethodParameter_Future) Struct() (Type_anyPointer_implicitMethodParameter, error) {
+	p, err := f.Future.Ptr()
+	return Type_anyPointer_implicitMethodParameter(p.Struct()), err
 }
 
-// CapnpVersion_List is a list of CapnpVersion.
-type CapnpVersion_List = capnp.StructList[CapnpVersion]
+// Represents a value, e.g. a field default value, constant value, or annotation value.
+type Value capnp.Struct
+type Value_Which uint16
 
-// NewCapnpVersion creates a new list of CapnpVersion.
-func NewCapnpVersion_List(s *capnp.Segment, sz int32) (CapnpVersion_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[CapnpVersion](l), err
-}
+const (
+	Value_Which_void        Value_Which = 0
+	Value_Which_bool        Value_Which = 1
+	Value_Which_int8        Value_Which = 2
+	Value_Which_int16       Value_Which = 3
+	Value_Which_int32       Value_Which = 4
+	Value_Which_int64       Value_Which = 5
+	Value_Which_uint8       Value_Which = 6
+	Value_Which_uint16      Value_Which = 7
+	Value_Which_uint32      Value_Which = 8
+	Value_Which_uint64      Value_Which = 9
+	Value_Which_float32     Value_Which = 10
+	Value_Which_float64     Value_Which = 11
+	Value_Which_text        Value_Which = 12
+	Value_Which_data        Value_Which = 13
+	Value_Which_list        Value_Which = 14
+	Value_Which_enum        Value_Which = 15
+	Value_Which_structValue Value_Which = 16
+	Value_Which_interface   Value_Which = 17
+	Value_Which_anyPointer  Value_Which = 18
+)
 
-// CapnpVersion_Future is a wrapper for a CapnpVersion promised by a client call.
-type CapnpVersion_Future struct{ *capnp.Future }
+func (w Value_Which) String() string {
+	const s = "voidboolint8int16int32int64uint8uint16uint32uint64float32float64textdatalistenumstructValueinterfaceanyPointer"
+	switch w {
+	case Value_Which_void:
+		return s[0:4]
+	case Value_Which_bool:
+		return s[4:8]
+	case Value_Which_int8:
+		return s[8:12]
+	case Value_Which_int16:
+		return s[12:17]
+	case Value_Which_int32:
+		return s[17:22]
+	case Value_Which_int64:
+		return s[22:27]
+	case Value_Which_uint8:
+		return s[27:32]
+	case Value_Which_uint16:
+		return s[32:38]
+	case Value_Which_uint32:
+		return s[38:44]
+	case Value_Which_uint64:
+		return s[44:50]
+	case Value_Which_float32:
+		return s[50:57]
+	case Value_Which_float64:
+		return s[57:64]
+	case Value_Which_text:
+		return s[64:68]
+	case Value_Which_data:
+		return s[68:72]
+	case Value_Which_list:
+		return s[72:76]
+	case Value_Which_enum:
+		return s[76:80]
+	case Value_Which_structValue:
+		return s[80:91]
+	case Value_Which_interface:
+		return s[91:100]
+	case Value_Which_anyPointer:
+		return s[100:110]
 
-func (f CapnpVersion_Future) Struct() (CapnpVersion, error) {
-	p, err := f.Future.Ptr()
-	return CapnpVersion(p.Struct()), err
+	}
+	return "Value_Which(" + strconv.FormatUint(uint64(w), 10) + ")"
 }
 
-type CodeGeneratorRequest capnp.Struct
+// Value_Visitor has a method for each member of the union of
+// Value, which Visit calls with the member's value.
+type Value_Visitor interface {
+	VisitVoid() error
+	VisitBool(bool) error
+	VisitInt8(int8) error
+	VisitInt16(int16) error
+	VisitInt32(int32) error
+	VisitInt64(int64) error
+	VisitUint8(uint8) error
+	VisitUint16(uint16) error
+	VisitUint32(uint32) error
+	VisitUint64(uint64) error
+	VisitFloat32(float32) error
+	VisitFloat64(float64) error
+	VisitText(string) error
+	VisitData([]byte) error
+	VisitList(capnp.Ptr) error
+	VisitEnum(uint16) error
+	VisitStructValue(capnp.Ptr) error
+	VisitInterface() error
+	VisitAnyPointer(capnp.Ptr) error
+}
+
+// Visit calls the method of v for the member of the union that is set
+// and returns its error.  If the union has a member that is not in this
+// version of the schema, Visit returns a *capnp.UnknownMemberError.
+func (s Value) Visit(v Value_Visitor) error {
+	switch w := s.Which(); w {
+	case Value_Which_void:
+		return v.VisitVoid()
+	case Value_Which_bool:
+		return v.VisitBool(s.Bool())
+	case Value_Which_int8:
+		return v.VisitInt8(s.Int8())
+	case Value_Which_int16:
+		return v.VisitInt16(s.Int16())
+	case Value_Which_int32:
+		return v.VisitInt32(s.Int32())
+	case Value_Which_int64:
+		return v.VisitInt64(s.Int64())
+	case Value_Which_uint8:
+		return v.VisitUint8(s.Uint8())
+	case Value_Which_uint16:
+		return v.VisitUint16(s.Uint16())
+	case Value_Which_uint32:
+		return v.VisitUint32(s.Uint32())
+	case Value_Which_uint64:
+		return v.VisitUint64(s.Uint64())
+	case Value_Which_float32:
+		return v.VisitFloat32(s.Float32())
+	case Value_Which_float64:
+		return v.VisitFloat64(s.Float64())
+	case Value_Which_text:
+		x, err := s.Text()
+		if err != nil {
+			return err
+		}
+		return v.VisitText(x)
+	case Value_Which_data:
+		x, err := s.Data()
+		if err != nil {
+			return err
+		}
+		return v.VisitData(x)
+	case Value_Which_list:
+		x, err := s.List()
+		if err != nil {
+			return err
+		}
+		return v.VisitList(x)
+	case Value_Which_enum:
+		return v.VisitEnum(s.Enum())
+	case Value_Which_structValue:
+		x, err := s.StructValue()
+		if err != nil {
+			return err
+		}
+		return v.VisitStructValue(x)
+	case Value_Which_interface:
+		return v.VisitInterface()
+	case Value_Which_anyPointer:
+		x, err := s.AnyPointer()
+		if err != nil {
+			return err
+		}
+		return v.VisitAnyPointer(x)
+	default:
+		return &capnp.UnknownMemberError{Union: "Value", Which: uint16(w)}
+	}
+}
 
-// CodeGeneratorRequest_TypeID is the unique identifier for the type CodeGeneratorRequest.
-const CodeGeneratorRequest_TypeID = 0xbfc546f6210ad7ce
+// Value_TypeID is the unique identifier for the type Value.
+const Value_TypeID = 0xce23dcd2d7b00c9b
 
-func NewCodeGeneratorRequest(s *capnp.Segment) (CodeGeneratorRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4})
-	return CodeGeneratorRequest(st), err
+func NewValue(s *capnp.Segment) (Value, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Value(st), err
 }
 
-func NewRootCodeGeneratorRequest(s *capnp.Segment) (CodeGeneratorRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4})
-	return CodeGeneratorRequest(st), err
+func NewRootValue(s *capnp.Segment) (Value, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Value(st), err
 }
 
-func ReadRootCodeGeneratorRequest(msg *capnp.Message) (CodeGeneratorRequest, error) {
+func ReadRootValue(msg *capnp.Message) (Value, error) {
 	root, err := msg.Root()
-	return CodeGeneratorRequest(root.Struct()), err
+	return Value(root.Struct()), err
 }
 
-func (s CodeGeneratorRequest) String() string {
-	str, _ := text.Marshal(0xbfc546f6210ad7ce, capnp.Struct(s))
+func (s Value) String() string {
+	str, _ := text.Marshal(0xce23dcd2d7b00c9b, capnp.Struct(s))
 	return str
 }
 
-func (s CodeGeneratorRequest) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+func (s Value) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
 	return capnp.Struct(s).EncodeAsPtr(seg)
 }
 
-func (CodeGeneratorRequest) DecodeFromPtr(p capnp.Ptr) CodeGeneratorRequest {
-	return CodeGeneratorRequest(capnp.Struct{}.DecodeFromPtr(p))
+func (Value) DecodeFromPtr(p capnp.Ptr) Value {
+	return Value(capnp.Struct{}.DecodeFromPtr(p))
 }
 
-func (s CodeGeneratorRequest) ToPtr() capnp.Ptr {
+func (s Value) ToPtr() capnp.Ptr {
 	return capnp.Struct(s).ToPtr()
 }
-func (s CodeGeneratorRequest) IsValid() bool {
+
+func (s Value) Which() Value_Which {
+	return Value_Which(capnp.Struct(s).Uint16(0))
+}
+func (s Value) IsValid() bool {
 	return capnp.Struct(s).IsValid()
 }
 
-func (s CodeGeneratorRequest) Message() *capnp.Message {
+func (s Value) Message() *capnp.Message {
 	return capnp.Struct(s).Message()
 }
 
-func (s CodeGeneratorRequest) Segment() *capnp.Segment {
+func (s Value) Segment() *capnp.Segment {
 	return capnp.Struct(s).Segment()
 }
-func (s CodeGeneratorRequest) CapnpVersion() (CapnpVersion, error) {
-	p, err := capnp.Struct(s).Ptr(2)
-	return CapnpVersion(p.Struct()), err
-}
-
-func (s CodeGeneratorRequest) HasCapnpVersion() bool {
-	return capnp.Struct(s).HasPtr(2)
-}
+func (s Value) SetVoid() {
+	capnp.Struct(s).SetUint16(0, 0)
 
-func (s CodeGeneratorRequest) SetCapnpVersion(v CapnpVersion) error {
-	return capnp.Struct(s).SetPtr(2, capnp.Struct(v).ToPtr())
 }
 
-// NewCapnpVersion sets the capnpVersion field to a newly
-// allocated CapnpVersion struct, preferring placement in s's segment.
-func (s CodeGeneratorRequest) NewCapnpVersion() (CapnpVersion, error) {
-	ss, err := NewCapnpVersion(capnp.Struct(s).Segment())
-	if err != nil {
-		return CapnpVersion{}, err
+func (s Value) Bool() bool {
+	if capnp.Struct(s).Uint16(0) != 1 {
+		panic("Which() != bool")
 	}
-	err = capnp.Struct(s).SetPtr(2, capnp.Struct(ss).ToPtr())
-	return ss, err
-}
-
-func (s CodeGeneratorRequest) Nodes() (Node_List, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return Node_List(p.List()), err
-}
-
-func (s CodeGeneratorRequest) HasNodes() bool {
-	return capnp.Struct(s).HasPtr(0)
+	return capnp.Struct(s).Bit(16)
 }
 
-func (s CodeGeneratorRequest) SetNodes(v Node_List) error {
-	return capnp.Struct(s).SetPtr(0, v.ToPtr())
+func (s Value) SetBool(v bool) {
+	capnp.Struct(s).SetUint16(0, 1)
+	capnp.Struct(s).SetBit(16, v)
 }
 
-// NewNodes sets the nodes field to a newly
-// allocated Node_List, preferring placement in s's segment.
-func (s CodeGeneratorRequest) NewNodes(n int32) (Node_List, error) {
-	l, err := NewNode_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Node_List{}, err
+func (s Value) Int8() int8 {
+	if capnp.Struct(s).Uint16(0) != 2 {
+		panic("Which() != int8")
 	}
-	err = capnp.Struct(s).SetPtr(0, l.ToPtr())
-	return l, err
-}
-func (s CodeGeneratorRequest) SourceInfo() (Node_SourceInfo_List, error) {
-	p, err := capnp.Struct(s).Ptr(3)
-	return Node_SourceInfo_List(p.List()), err
-}
-
-func (s CodeGeneratorRequest) HasSourceInfo() bool {
-	return capnp.Struct(s).HasPtr(3)
+	return int8(capnp.Struct(s).Uint8(2))
 }
 
-func (s CodeGeneratorRequest) SetSourceInfo(v Node_SourceInfo_List) error {
-	return capnp.Struct(s).SetPtr(3, v.ToPtr())
+func (s Value) SetInt8(v int8) {
+	capnp.Struct(s).SetUint16(0, 2)
+	capnp.Struct(s).SetUint8(2, uint8(v))
 }
 
-// NewSourceInfo sets the sourceInfo field to a newly
-// allocated Node_SourceInfo_List, preferring placement in s's segment.
-func (s CodeGeneratorRequest) NewSourceInfo(n int32) (Node_SourceInfo_List, error) {
-	l, err := NewNode_SourceInfo_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return Node_SourceInfo_List{}, err
+func (s Value) Int16() int16 {
+	if capnp.Struct(s).Uint16(0) != 3 {
+		panic("Which() != int16")
 	}
-	err = capnp.Struct(s).SetPtr(3, l.ToPtr())
-	return l, err
-}
-func (s CodeGeneratorRequest) RequestedFiles() (CodeGeneratorRequest_RequestedFile_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return CodeGeneratorRequest_RequestedFile_List(p.List()), err
-}
-
-func (s CodeGeneratorRequest) HasRequestedFiles() bool {
-	return capnp.Struct(s).HasPtr(1)
+	return int16(capnp.Struct(s).Uint16(2))
 }
 
-func (s CodeGeneratorRequest) SetRequestedFiles(v CodeGeneratorRequest_RequestedFile_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+func (s Value) SetInt16(v int16) {
+	capnp.Struct(s).SetUint16(0, 3)
+	capnp.Struct(s).SetUint16(2, uint16(v))
 }
 
-// NewRequestedFiles sets the requestedFiles field to a newly
-// allocated CodeGeneratorRequest_RequestedFile_List, preferring placement in s's segment.
-func (s CodeGeneratorRequest) NewRequestedFiles(n int32) (CodeGeneratorRequest_RequestedFile_List, error) {
-	l, err := NewCodeGeneratorRequest_RequestedFile_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return CodeGeneratorRequest_RequestedFile_List{}, err
+func (s Value) Int32() int32 {
+	if capnp.Struct(s).Uint16(0) != 4 {
+		panic("Which() != int32")
 	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
-}
-
-// CodeGeneratorRequest_List is a list of CodeGeneratorRequest.
-type CodeGeneratorRequest_List = capnp.StructList[CodeGeneratorRequest]
-
-// NewCodeGeneratorRequest creates a new list of CodeGeneratorRequest.
-func NewCodeGeneratorRequest_List(s *capnp.Segment, sz int32) (CodeGeneratorRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 4}, sz)
-	return capnp.StructList[CodeGeneratorRequest](l), err
-}
-
-// CodeGeneratorRequest_Future is a wrapper for a CodeGeneratorRequest promised by a client call.
-type CodeGeneratorRequest_Future struct{ *capnp.Future }
-
-func (f CodeGeneratorRequest_Future) Struct() (CodeGeneratorRequest, error) {
-	p, err := f.Future.Ptr()
-	return CodeGeneratorRequest(p.Struct()), err
-}
-func (p CodeGeneratorRequest_Future) CapnpVersion() CapnpVersion_Future {
-	return CapnpVersion_Future{Future: p.Future.Field(2, nil)}
-}
-
-type CodeGeneratorRequest_RequestedFile capnp.Struct
-
-// CodeGeneratorRequest_RequestedFile_TypeID is the unique identifier for the type CodeGeneratorRequest_RequestedFile.
-const CodeGeneratorRequest_RequestedFile_TypeID = 0xcfea0eb02e810062
-
-func NewCodeGeneratorRequest_RequestedFile(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return CodeGeneratorRequest_RequestedFile(st), err
+	return int32(capnp.Struct(s).Uint32(4))
 }
 
-func NewRootCodeGeneratorRequest_RequestedFile(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return CodeGeneratorRequest_RequestedFile(st), err
+func (s Value) SetInt32(v int32) {
+	capnp.Struct(s).SetUint16(0, 4)
+	capnp.Struct(s).SetUint32(4, uint32(v))
 }
 
-func ReadRootCodeGeneratorRequest_RequestedFile(msg *capnp.Message) (CodeGeneratorRequest_RequestedFile, error) {
-	root, err := msg.Root()
-	return CodeGeneratorRequest_RequestedFile(root.Struct()), err
+func (s Value) Int64() int64 {
+	if capnp.Struct(s).Uint16(0) != 5 {
+		panic("Which() != int64")
+	}
+	return int64(capnp.Struct(s).Uint64(8))
 }
 
-func (s CodeGeneratorRequest_RequestedFile) String() string {
-	str, _ := text.Marshal(0xcfea0eb02e810062, capnp.Struct(s))
-	return str
+func (s Value) SetInt64(v int64) {
+	capnp.Struct(s).SetUint16(0, 5)
+	capnp.Struct(s).SetUint64(8, uint64(v))
 }
 
-func (s CodeGeneratorRequest_RequestedFile) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Value) Uint8() uint8 {
+	if capnp.Struct(s).Uint16(0) != 6 {
+		panic("Which() != uint8")
+	}
+	return capnp.Struct(s).Uint8(2)
 }
 
-func (CodeGeneratorRequest_RequestedFile) DecodeFromPtr(p capnp.Ptr) CodeGeneratorRequest_RequestedFile {
-	return CodeGeneratorRequest_RequestedFile(capnp.Struct{}.DecodeFromPtr(p))
+func (s Value) SetUint8(v uint8) {
+	capnp.Struct(s).SetUint16(0, 6)
+	capnp.Struct(s).SetUint8(2, v)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
-}
-func (s CodeGeneratorRequest_RequestedFile) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Value) Uint16() uint16 {
+	if capnp.Struct(s).Uint16(0) != 7 {
+		panic("Which() != uint16")
+	}
+	return capnp.Struct(s).Uint16(2)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Value) SetUint16(v uint16) {
+	capnp.Struct(s).SetUint16(0, 7)
+	capnp.Struct(s).SetUint16(2, v)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
-}
-func (s CodeGeneratorRequest_RequestedFile) Id() uint64 {
-	return capnp.Struct(s).Uint64(0)
+func (s Value) Uint32() uint32 {
+	if capnp.Struct(s).Uint16(0) != 8 {
+		panic("Which() != uint32")
+	}
+	return capnp.Struct(s).Uint32(4)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) SetId(v uint64) {
-	capnp.Struct(s).SetUint64(0, v)
+func (s Value) SetUint32(v uint32) {
+	capnp.Struct(s).SetUint16(0, 8)
+	capnp.Struct(s).SetUint32(4, v)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) Filename() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
+func (s Value) Uint64() uint64 {
+	if capnp.Struct(s).Uint16(0) != 9 {
+		panic("Which() != uint64")
+	}
+	return capnp.Struct(s).Uint64(8)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) HasFilename() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Value) SetUint64(v uint64) {
+	capnp.Struct(s).SetUint16(0, 9)
+	capnp.Struct(s).SetUint64(8, v)
 }
 
-func (s CodeGeneratorRequest_RequestedFile) FilenameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Value) Float32() float32 {
+	if capnp.Struct(s).Uint16(0) != 10 {
+		panic("Which() != float32")
+	}
+	return math.Float32frombits(capnp.Struct(s).Uint32(4))
 }
 
-func (s CodeGeneratorRequest_RequestedFile) SetFilename(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+func (s Value) SetFloat32(v float32) {
+	capnp.Struct(s).SetUint16(0, 10)
+	capnp.Struct(s).SetUint32(4, math.Float32bits(v))
 }
 
-func (s CodeGeneratorRequest_RequestedFile) Imports() (CodeGeneratorRequest_RequestedFile_Import_List, error) {
-	p, err := capnp.Struct(s).Ptr(1)
-	return CodeGeneratorRequest_RequestedFile_Import_List(p.List()), err
+func (s Value) Float64() float64 {
+	if capnp.Struct(s).Uint16(0) != 11 {
+		panic("Which() != float64")
+	}
+	return math.Float64frombits(capnp.Struct(s).Uint64(8))
 }
 
-func (s CodeGeneratorRequest_RequestedFile) HasImports() bool {
-	return capnp.Struct(s).HasPtr(1)
+func (s Value) SetFloat64(v float64) {
+	capnp.Struct(s).SetUint16(0, 11)
+	capnp.Struct(s).SetUint64(8, math.Float64bits(v))
 }
 
-func (s CodeGeneratorRequest_RequestedFile) SetImports(v CodeGeneratorRequest_RequestedFile_Import_List) error {
-	return capnp.Struct(s).SetPtr(1, v.ToPtr())
+func (s Value) Text() (string, error) {
+	if capnp.Struct(s).Uint16(0) != 12 {
+		panic("Which() != text")
+	}
+	return capnp.GetTextField(s, 0, "Value.text")
 }
 
-// NewImports sets the imports field to a newly
-// allocated CodeGeneratorRequest_RequestedFile_Import_List, preferring placement in s's segment.
-func (s CodeGeneratorRequest_RequestedFile) NewImports(n int32) (CodeGeneratorRequest_RequestedFile_Import_List, error) {
-	l, err := NewCodeGeneratorRequest_RequestedFile_Import_List(capnp.Struct(s).Segment(), n)
-	if err != nil {
-		return CodeGeneratorRequest_RequestedFile_Import_List{}, err
+func (s Value) HasText() bool {
+	if capnp.Struct(s).Uint16(0) != 12 {
+		return false
 	}
-	err = capnp.Struct(s).SetPtr(1, l.ToPtr())
-	return l, err
+	return capnp.Struct(s).HasPtr(0)
 }
 
-// CodeGeneratorRequest_RequestedFile_List is a list of CodeGeneratorRequest_RequestedFile.
-type CodeGeneratorRequest_RequestedFile_List = capnp.StructList[CodeGeneratorRequest_RequestedFile]
-
-// NewCodeGeneratorRequest_RequestedFile creates a new list of CodeGeneratorRequest_RequestedFile.
-func NewCodeGeneratorRequest_RequestedFile_List(s *capnp.Segment, sz int32) (CodeGeneratorRequest_RequestedFile_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[CodeGeneratorRequest_RequestedFile](l), err
+func (s Value) TextBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Value.text")
 }
 
-// CodeGeneratorRequest_RequestedFile_Future is a wrapper for a CodeGeneratorRequest_RequestedFile promised by a client call.
-type CodeGeneratorRequest_RequestedFile_Future struct{ *capnp.Future }
-
-func (f CodeGeneratorRequest_RequestedFile_Future) Struct() (CodeGeneratorRequest_RequestedFile, error) {
-	p, err := f.Future.Ptr()
-	return CodeGeneratorRequest_RequestedFile(p.Struct()), err
+func (s Value) SetText(v string) error {
+	capnp.Struct(s).SetUint16(0, 12)
+	return capnp.Struct(s).SetText(0, v)
 }
 
-type CodeGeneratorRequest_RequestedFile_Import capnp.Struct
-
-// CodeGeneratorRequest_RequestedFile_Import_TypeID is the unique identifier for the type CodeGeneratorRequest_RequestedFile_Import.
-const CodeGeneratorRequest_RequestedFile_Import_TypeID = 0xae504193122357e5
+func (s Value) Data() ([]byte, error) {
+	if capnp.Struct(s).Uint16(0) != 13 {
+		panic("Which() != data")
+	}
+	return capnp.GetDataField(s, 0, "Value.data")
+}
 
-func NewCodeGeneratorRequest_RequestedFile_Import(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile_Import, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return CodeGeneratorRequest_RequestedFile_Import(st), err
+func (s Value) HasData() bool {
+	if capnp.Struct(s).Uint16(0) != 13 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func NewRootCodeGeneratorRequest_RequestedFile_Import(s *capnp.Segment) (CodeGeneratorRequest_RequestedFile_Import, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return CodeGeneratorRequest_RequestedFile_Import(st), err
+func (s Value) SetData(v []byte) error {
+	capnp.Struct(s).SetUint16(0, 13)
+	return capnp.Struct(s).SetData(0, v)
 }
 
-func ReadRootCodeGeneratorRequest_RequestedFile_Import(msg *capnp.Message) (CodeGeneratorRequest_RequestedFile_Import, error) {
-	root, err := msg.Root()
-	return CodeGeneratorRequest_RequestedFile_Import(root.Struct()), err
+// DataReader returns a reader of data's data, which
+// is read directly from the message.
+func (s Value) DataReader() (*bytes.Reader, error) {
+	v, err := s.Data()
+	return bytes.NewReader(v), err
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) String() string {
-	str, _ := text.Marshal(0xae504193122357e5, capnp.Struct(s))
-	return str
+// SetDataFromReader sets data to the next size bytes of r,
+// which are read directly into the message.
+func (s Value) SetDataFromReader(r io.Reader, size int) error {
+	capnp.Struct(s).SetUint16(0, 13)
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
-	return capnp.Struct(s).EncodeAsPtr(seg)
+func (s Value) List() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(0) != 14 {
+		panic("Which() != list")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Value.list")
 }
 
-func (CodeGeneratorRequest_RequestedFile_Import) DecodeFromPtr(p capnp.Ptr) CodeGeneratorRequest_RequestedFile_Import {
-	return CodeGeneratorRequest_RequestedFile_Import(capnp.Struct{}.DecodeFromPtr(p))
+func (s Value) HasList() bool {
+	if capnp.Struct(s).Uint16(0) != 14 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) ToPtr() capnp.Ptr {
-	return capnp.Struct(s).ToPtr()
+func (s Value) SetList(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(0, 14)
+	return capnp.Struct(s).SetPtr(0, v)
 }
-func (s CodeGeneratorRequest_RequestedFile_Import) IsValid() bool {
-	return capnp.Struct(s).IsValid()
+func (s Value) Enum() uint16 {
+	if capnp.Struct(s).Uint16(0) != 15 {
+		panic("Which() != enum")
+	}
+	return capnp.Struct(s).Uint16(2)
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) Message() *capnp.Message {
-	return capnp.Struct(s).Message()
+func (s Value) SetEnum(v uint16) {
+	capnp.Struct(s).SetUint16(0, 15)
+	capnp.Struct(s).SetUint16(2, v)
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) Segment() *capnp.Segment {
-	return capnp.Struct(s).Segment()
+func (s Value) StructValue() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(0) != 16 {
+		panic("Which() != structValue")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Value.struct")
 }
-func (s CodeGeneratorRequest_RequestedFile_Import) Id() uint64 {
-	return capnp.Struct(s).Uint64(0)
+
+func (s Value) HasStructValue() bool {
+	if capnp.Struct(s).Uint16(0) != 16 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) SetId(v uint64) {
-	capnp.Struct(s).SetUint64(0, v)
+func (s Value) SetStructValue(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(0, 16)
+	return capnp.Struct(s).SetPtr(0, v)
 }
+func (s Value) SetInterface() {
+	capnp.Struct(s).SetUint16(0, 17)
 
-func (s CodeGeneratorRequest_RequestedFile_Import) Name() (string, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.Text(), err
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) HasName() bool {
-	return capnp.Struct(s).HasPtr(0)
+func (s Value) AnyPointer() (capnp.Ptr, error) {
+	if capnp.Struct(s).Uint16(0) != 18 {
+		panic("Which() != anyPointer")
+	}
+	return capnp.Struct(s).FieldPtr(0, "Value.anyPointer")
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) NameBytes() ([]byte, error) {
-	p, err := capnp.Struct(s).Ptr(0)
-	return p.TextBytes(), err
+func (s Value) HasAnyPointer() bool {
+	if capnp.Struct(s).Uint16(0) != 18 {
+		return false
+	}
+	return capnp.Struct(s).HasPtr(0)
 }
 
-func (s CodeGeneratorRequest_RequestedFile_Import) SetName(v string) error {
-	return capnp.Struct(s).SetText(0, v)
+func (s Value) SetAnyPointer(v capnp.Ptr) error {
+	capnp.Struct(s).SetUint16(0, 18)
+	return capnp.Struct(s).SetPtr(0, v)
 }
 
-// CodeGeneratorRequest_RequestedFile_Import_List is a list of CodeGeneratorRequest_RequestedFile_Import.
-type CodeGeneratorRequest_RequestedFile_Import_List = capnp.StructList[CodeGeneratorRequest_RequestedFile_Import]
+// Value_List is a list of Value.
+type Value_List = capnp.StructList[Value]
 
-// NewCodeGeneratorRequest_RequestedFile_Import creates a new list of CodeGeneratorRequest_RequestedFile_Import.
-func NewCodeGeneratorRequest_RequestedFile_Import_List(s *capnp.Segment, sz int32) (CodeGeneratorRequest_RequestedFile_Import_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[CodeGeneratorRequest_RequestedFile_Import](l), err
+// NewValue creates a new list of Value.
+func NewValue_List(s *capnp.Segment, sz int32) (Value_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Value](l), err
 }
 
-// CodeGeneratorRequest_RequestedFile_Import_Future is a wrapper for a CodeGeneratorRequest_RequestedFile_Import promised by a client call.
-type CodeGeneratorRequest_RequestedFile_Import_Future struct{ *capnp.Future }
+// Value_Future is a wrapper for a Value promised by a client call.
+type Value_Future struct{ *capnp.Future }
 
-func (f CodeGeneratorRequest_RequestedFile_Import_Future) Struct() (CodeGeneratorRequest_RequestedFile_Import, error) {
+func (f Value_Future) Struct() (Value, error) {
 	p, err := f.Future.Ptr()
-	return CodeGeneratorRequest_RequestedFile_Import(p.Struct()), err
+	return Value(p.Struct()), err
+}
+func (p Value_Future) List() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+func (p Value_Future) StructValue() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+func (p Value_Future) AnyPointer() *capnp.Future {
+	return p.Future.Field(0, nil)
 }
 
-const schema_a93fc509624c72d9 = "x\xda\xacz{\x90\x1c\xd5u\xf79\xb7\xe7\xb1\xaff" +
-	"\xb6\xe7\xce\"\x10\xe8\x9b\x15\x92>$\x05\xd6\xd2\xae\x90" +
-	"\xc5\x1a\xb2h\xc5\x0aK\x91\xf0\xb6F\x08\xd8\x84\xb2z" +
-	"gz\xb5\x8dg\xbaG==\xa0Q\xa0\x16\x88)l" +
-	"b@&\xc8`\xd9\xa2l\x02UV\x00[J\xa0\xca" +
-	"\x92I\x80-\x08\xa0\x02\x1b\x1c(\xc0\x86\xc4\x10c\x88" +
-	"\x12\x05\x14\xf3\x16\xa8S\xe7vOw\xef\xec,\x8f\x0a" +
-	"\x7f\xed\xcc9w\xee=\xf7\x9c\xf3;\xaf\xbbK\x8e\xb6" +
-	"\x9f\x13[*\x9f\x9a\x02\xa6\xee\x8d'\xdc[\x0fmn" +
-	"[p\xe6\xeb\xd7\x81\x9aF\xc9\xdd\xfc\xe4\x07\xaf\x1c\xde" +
-	"Vy\x1a\xba0\x89\x00\xbc\x96<\x00\xc8k\xc9\x01@" +
-	"w\xd3\xf2\x8b\x8f\xd5\xbe\xfe\x95\x1bA\x9d\x83\x92{d" +
-	"\xcd\x9f\xde\xf1\xc6\xc0\x85\x93p\x01&1\x86\xf1\xbe\xdd" +
-	"\xc9\x11\x04\xe4{\x92\xaf\x03\xba\x7f2\xa8o~\xeb\x82" +
-	"e;@\x91\xd1}\xd1^7\xda\xfa\xe8\xc0\x1e\x88c" +
-	"\x12\x80_\xdd\xb2\x8b\x7f\xbb\xe5T\x00\xbe\xbbe\x00\xf0" +
-	"\xc1\xab\xd6\xf7}\xe5\xd5'w\xaa2J\x91\xa5qZ" +
-	"\xfaT\xcb\x1d\xfc\xb9\x96$@\xdf3-\x8f!\xa0\xbb" +
-	"~\xd7\xc6\x97\xfe\xf3\x8a\xebo\x05U\xc6\xe8\xc6\x8cV" +
-	"\xbf\xd8v\x80\xbf\xd2F\x9f^n\xfb\x19\xa0\x9b~\xec" +
-	"\xd8\x15\xff\xb4n\xdf\xad\xa0\xf0\x98\xfb\xf5\xb7\xd7f\xef" +
-	"\x1c\xbew\x17\x00\xf6\xdd\xd0\x9eF\xbe\xbb=\x09\x90\xbb" +
-	"\xad]\xc2\xdc\x9d\xed\x0c\x01\xc2%SE\x19\x8a%\x19" +
-	"\xc6\xf8\xce\xf6]|w\xfb,\x80\xbe=\xed7\x91," +
-	"\xd7\xae\xd5\xde\x9e\xff\xde3\xbb\x1b\xf4\xe1i\xae\xef\xe3" +
-	"\x8e~RG\\\xbe\x1c\xd0\xdds\xdd\xc4\xec\xad\xdb\x8e" +
-	"\xbb\xbd\xb9\x92u\x99\x94\xac\x8b\x95g\x9c{\xf4\xcb?" +
-	"\xd8\xf7#\xb12\xee\xce\xbas\xde\x07\xeb\xee\xbe\xe6\x0f" +
-	"\xd0\x95\x10+\x1f\x96\x0f\x02\xf6=*\x8b\xf3\xdf\x7f\xfe" +
-	"\xce\xa7o_\xfc\xbd=\x8d\xba\x10J\xbe25\xc9\xaf" +
-	"M\x09u\xa7h\xdf'\xbe\x91\xdfs\xed\xb2\xe7\xef\x06" +
-	"\x95#\x0b\xcd3\x84\xe2n/\xa7\x0e\xf2\xff\x10\xab_" +
-	"K\x91\xe6\xfe\xe5\x9f\xd7\xbd\xb9\xd9\xea\xbf\xa7\xb9\xbc7" +
-	"t\x1e\x04\xe4\xdf\xed\xa4}_\xbbp^\xfaoV\x0e" +
-	"\xff\x14\xd4%\x88\x1f\x8f^\xdd\xb3\xf7\xb8C\xbf\x12\"" +
-	"\xf4\x1d\xee<\x80<\xae\xd0\xae\xa8\xd0\xda\xee[\xe4\xed" +
-	"\xf7\xdcu\xfd\xbe\xe6w\xbbD\x99\x04\xe4\x97\x88\x95\xb7" +
-	"\xbd\xd3\xb7j\xf9?\xac\xbd\xbf\xf9\xca\xfd\x0a\xe9k\xbf" +
-	"BN\xb9\xefoSG\x9e<i\xc3~P\xd2\x18." +
-	"\xf4tpXy\x95\x7f \xce\x7fG\xacu^\xdd\xd4" +
-	"\x91~\xe2\xcd\x03\xcd\x0d\xd6\x95\xfe;2\xd8\x824-" +
-	"\xfdC\xfbu\xd7M>\xb7\xe3\x17\xa4.)t\x8c\x0b" +
-	"bId\x18\xe7k\xd2\xbf\x01\xe4\xeb\xd3$\xeb/\x9f" +
-	"o\x9b\xfb\xee\xeaG\x1flp\xf5\x18\xa9`_:\x8d" +
-	"\xfc\xe1\xf4,r\xe54\xe1\"8t\xaab\x09C\x12" +
-	"\xd9\x81\x1f\x02\xe4\xafp\xb2\xc1\x1d\xd9\x1b\xbf\xbdb\xc7" +
-	"\x81IP\xe6\xa0\xfb\xd3\x0by\xec\xdf\xe7\xdc\xf8G\xef" +
-	"f}gdNA>\x94\xa1\xab\xad\xcc\x90\xbc\x96s" +
-	"\xdf7\xce\x8b\xcf{\xa4A\xde\xae\x98\xe7`\x19R\xad" +
-	"\x9e!\x09>z\xfd\xf6\xef\x1d\xffT\xfeqZ\x89S" +
-	"\x1d\x01\x80\x0fu\xfd\x86\xab]\xb4\xef\xfa.\xba\x9c2" +
-	"\xe7\xb7\xe3\xbf}\xea\xa3\x83\xcd\xf7\xdd\xd3E\x8epo" +
-	"\x17I\xf0\x83\x8e\xbd\xcf\xff\xfa\xa5y\xbf$od\x11" +
-	"\xf0`\x92\xd3\xed\xbbv\xf1\xe7\xba\x04\x90\xbb\xbe\x14\x83" +
-	"\xc0S\xd4S0\xa2A\x01\xe3\xbe\x8bg_\x83\xbc4" +
-	"\x9b\x94V\x9dM\x9a\x08\xd4\xd4\x80Ko\xeb\xb9'\xdd" +
-	"\xcc\x17\x9dD?\\p\x12m\xed\xfe\xff\x137\x1c\xb8" +
-	"\xf2\xa6\x9d\xcf\x80\"G\x04\x01\xe4s\xb3\x07\xf9\xe9Y" +
-	"\xba\xdc\xa2\xecc\x80n\xfe\xfb\x7fu\xe5\x9f/\xb9\xe4" +
-	"\x85\x06\x00\x91\xe5\xf8\xee\xec\xaf\xf9\x1e\xb1\xf6\xae,\xc9" +
-	"08\x99\xfa\xf0\x17\x17<\xf8\xafd\xb8i\x8evv" +
-	"\xf7!\xbe\xa6\x9b>\x0du\x93\xd6\x02\xb6*c<\"" +
-	"p\"\x99\xc0\x04\xffq\xf7\xcd|O\xf7\x97\x01\xfa\x9e" +
-	"\xeb\x9e%\x01\xba7\xcd\x9d|\xebW\xb9S\xdfh\xee" +
-	"\xedG\xe6\xbd\x0a\xc8\xdf\x99G\x1b\xef`m\xe7<{" +
-	"\xe2\xf1\xff\xd5|eu\xfe!\xc0\xbe\xda\xfc\x7fc\x80" +
-	"\xee\x83\x1dG\xdf3\x0e\xfe\xf5\xe1\xe6\x10\xbe\xebT\xda" +
-	"t\xcf\xa9\xb4\xe9`u\xc1\xdd\xf2\xce\xc7\x8f4\x8d\xa9" +
-	"\xf1\x85\x93\\^H\x9fZ\x17\x92\x1e\x02Gl\xd0\x83" +
-	"X\xfc\xe3\x85\x87\xf8\xbd\x0b\xc9p\xf7/\xfc\x19\x9c\xef" +
-	"V\xf2\xe3zI\xeb\xc9\xa3V6\xcb\xfd\x1bk\xe5\x01" +
-	"\xbd\xa7hT\x1c5&\xc5\x002x\x1c\x80\"\x8f\x02" +
-	"\xa8\x1d\x12\xaa'0t\xf5\xa2^\xd2Mg#$k" +
-	"e\x1d;C\xb1\x01\xb1\x130\xd80V\xdfP\xef\xd1" +
-	"\xcc\xda\xb0e\x98\x8en\xf7T\xcd\xbceV\x1c[3" +
-	"LI/\xa8\x9dR\xac\xc3u3\x98\x06P\xb4A\x00" +
-	"\xf5/$T\xc7\x19\xcax\xcc\xcd\xe0\x89\x00\x8a\xde\x0f" +
-	"\xa0n\x96P-2\x94\xd9\xc7n\x06g\x03(\xc6b" +
-	"\x00\xb5 \xa1Zf(K\x1f\xb9\x19<\x09@)\x8d" +
-	"\x00\xa8E\x09\xd5m\x0c'4\xb3\xf6g\x86Y\x80\xc4" +
-	"@\xc5\xb1\xaby\x07\x12)\xba\x17$\xdc\xbcV\xd6F" +
-	"\x8d\xa2\x01\x92S\x83D\x83\x06\x06mM2\x0bj\x0b" +
-	"F\xe2\xb1\xd2\xda\x1bbR\x89\x0ffsy\xab\xacO" +
-	"\x0c\x1af\xc10\xb7x\x9a\x8a!)\x8a\x84m\x91P" +
-	"\x9d\xcfp\xa0B\x8b*x\x1c\xe0\xb0\x84\xd8\x19n\x07" +
-	"H\xc4\x86s\xd7\xebNr\xdc*\x0c#\xaa\xdd\xc1~" +
-	"\xcf\xd05\x9f\x94P}\x81!b\x06\x89\xf6\xdc\x06\x00" +
-	"\xf5Y\x09\xd5\xdf1T$\xcc \x03P^\xbe\x06@" +
-	"}IB\xf5\x0d\x86J\x9ceP\x02P^\xbb\x1e@" +
-	"}CB\xf5m\x86J\x123\x18\x03P\x8e\x90-\xdf" +
-	"\x920\xd7\x81\x0c\x95\x18\xcb`\x9c\x1c\x07G\x00r-" +
-	"(a.C\xf4\x84\x94\xc1\x04\x00Wp\x14 \xd7I" +
-	"\xf4\x93\x89\xceb\x19\x81\xa6\x13q\x17@\xeed\xa2\xaf" +
-	"@\x86)S+\xe9\xd8\x01\x0c;\x08\xb3VA\xff\x9a" +
-	"]\xd0\x01mL\x02\xc3$\xa0[\xd6l\xad\x94sl" +
-	"\xac\xe6\x1d\xf2\x09\xc0V`\xd8\x0a\xe8\xdaz\xa5Zt" +
-	"r\x0e\xdauV\xc8\xd3L\xd3r4\xc7\x80\xa4eF" +
-	"4\x19\xa0\xc1\xd7\xa4\xd8|\xd0\xd6@2\x0b\xd8\x19\x06" +
-	"L\xdf#\xbd\x13\x06mHjM\xf9F\xa9\\4\xf2" +
-	"\x86\x83\xc3\xb4\x8f\xee\xe8\x92\x1d9,\xc8^M\xcd6" +
-	"dV\x07J\xba\xad\x99\x0eY\xae#\xb0\xdc\x10Y\xee" +
-	"\x1c\x09\xd5u\xa1\xe5\xd6\x90\xe5\xbe*\xa1\xba\x914\xe9" +
-	"[N%{\x0c{\xfe\xfd\xe9j\xfc\x8c\x0a\xa9\xcb(" +
-	"\x09\x19W\x1bz\xb1\xd0cZ\xe7\x1a\x95\xbcm\x94\x0c" +
-	"S3\x91\xc4\xa5]\xe5\xa4\xebN\xbb\xd4jC\x97\x8a" +
-	"\x055\x86\xd1\xfa\x0c\xb7\xbb\xf5-`@l\xe2\xa8'" +
-	"\x07\xf7\xbd\x9f\xee\xbbWB\xf5\x81\xf0\xbe\xfb\xe9\xbe?" +
-	"\x97P}$r\xdf\x87\xe9\xbe\x0fI\xa8\xbe\xe4\xbb\xaf" +
-	"\x84\xa8\xbcxs\xe8\xber\xccu1\x92-\x95\xd7\x16" +
-	"\x03\x93\xe3\xc7\x88\x18\xa4:\xe5\xa9^`\x98\xc0H\x15" +
-	"\xa0\xdc?\x08\xec\x0b\xd3`\xc1\xd7\x15\xd2=7i\xc5" +
-	"*\xea\xa1\xbaR\x95\xa2\xe5d\xb7\xd8V\xb5<a\xd9" +
-	"\x05\xc3\xd4\x8a\x0d*o\x0c{e\xcd\x1e\x10\xaee\xab" +
-	"-R\xac\x93e\x90\x03(\x8b(\xe2\xcd\x97P]\xc2" +
-	"P\xc1x\x063\x00\xca\xe9\xdb\x01\xd4\xd3$TW0" +
-	"\x9c\x101dM!\x00D\xd9\xf7P\x18\xb0\xd7\x98\x05" +
-	"}[p\xadfa\\7\xab%q\x1cf0E\xc7" +
-	"\xf5\x87\xc7\x91\x8d\xba\xe8\xb4^\x00u\xa1\x84\xea2\x86" +
-	"\x03N-zXv\xd4n\x8e\x97\xfaYL\x9cu\xbe" +
-	"U\xd0{\xfc\x10\x0b\xe4\x11\x9dI\x11#\x94\xfbm\x00" +
-	"\xf5>\x09\xd5\x87\xe8v\x1d\x19l\x01P\xfe\xf1R\x00" +
-	"\xf5\x01\x09\xd5\xc7\xc9'\xe4\x0c\xb6\x02(\x8f\xfe=\x80" +
-	"\xfa\xb8\x84\xea\xb3\xe4\x13\xbf\xcb`\x1b\xc5\xbe\xc10\xf6" +
-	")\xb1T\x06\xdb)\xf8\x91\xa3\xbc \xa1\xfa{\x8as" +
-	"-\x19\xec\x00P^\xd9\x05\xa0\xfe^B\xf5-?r" +
-	"\xc9\x00\xca\xe1~/\xf8\xe5b\xc8\xd0-h\x8ev\xa1" +
-	"e\x17 \xbb\xca\xaa\x9aN\x18\x96<\xfb\xac\x82\xd4T" +
-	"\xb2\xad\x8f\xe9\xb6\xadca\x9dQq\x86\xcc|\xd6\xa2" +
-	"H\x8f\xa9\xb0p\x01\xc4\x14\xe0\x84Q9\x8f\xdc\x00\x11" +
-	"\x18b\xa3\xdf\xd0Y\x18\xee:\x85\xf7\xb5\xb1\xb1\x8a\xa4" +
-	";\xd8\x02\x0c[\x00\x07\xc6\x08\xa4\x11\x87\x8c\xf4BS" +
-	" \xed)=W-\xebv\xbe\xa8U*@q\xa7%" +
-	"\xc0\xe1\xa2\xd9Sm\x8c\x0d6\x96\x8c\xcfk\xdfAZ" +
-	"\xd5#2\x1e@C\x90\x1b\x0c\x83\x9c\x8c\x94\xc7E\x98" +
-	"\xa3Pp\xae\x84\xeaf\xca\xd8\x94\xc7\x09\xf7\x97\xd0\xda" +
-	"\x8b$T\x0b\xd3\xdd:5j\x98\x85\xf0\xeaA\xaa\xf5" +
-	"\xae>a\x98\xe3\xbam8\x91T\xcdB\x90\x05\x9e\xe7" +
-	";zg\x13G?\xfe\xff\xe8\xe8qq\xdc*\xab\xa0" +
-	"\x9f\xa7\x9b\xba\xad9\x96\xbdA\xdfZ\xd5+N\x8f\xff" +
-	"W/\xac6\x8az\xcf\xc0\x9aR\xd9\xb2\x9d\xcf`\x92" +
-	"\xc5MM25|5\xc1\x99(\x9e\xbc\xbbJ\xfe]" +
-	"\x17GcH,\x83J\xc3eSN\xd3:-{\x99" +
-	"V\xac\x12=h\x0a\x1a.\x8d\xf5S\xeb\x91$\xe6\x1d" +
-	"*\x0a\xc2\x11\xbf \\H\x05\xa1Y\x15\x19\x10$'" +
-	"\xe2\xbf\xc1\x14\xa0\xa9\xff\x8a\xcbx\xe96\xe9\xe86)" +
-	",RE-\xf6\xab\xa8\x0c\x9bA#\xf1\xa6A\xb6\x9e" +
-	"\xc7\xd7\xeb\x0eUR\xb4{J\x84\\\x92<\x9e\xc1\x13" +
-	"h\xef\xed\x91R\xf6S\xa3)\x8b\xe4O\x11\xe8%\xad" +
-	"H\xca\x175+\x85\xb3EkCE\xcf\xc1cn\xc2" +
-	"\x0bhK\x89\xbcDB\xf5,\x16\x96\x17\x00IW\xdf" +
-	"V\xff\x0c\xd3\x0e\x93f\xf22t(\x15\xd7\xdb1\x05" +
-	"m\xb7\xeeu\x90\x15~Ge4\xa2\xd0\x9d\xd6\xeb\x17" +
-	"\xd1\xdb\xfc\xf4J\xc4*]\xda\x91P\xdd\xc1\x90r\x0f" +
-	"\xe1\xf1\x06\x0a\xc4\xdf\x91P\xbd\x8d\x02\xb1\xe4U\x8c;" +
-	"\xc9\xae\xb7H\xa8\xeee\x985\xadB\xb4|\x0d\xfa\x08" +
-	"\xdf\xa0v]\x86\x01!C\xb8\xb2.\xa8\xbf.O\xd7" +
-	"\xda\xa4\xdb\x90\xaa\x18\x96\x89\x9daKWw8\xabj" +
-	"\xe7\xf55&HcVx\\\xd0\xc44\xf5\x9f\x88\xe9" +
-	"Sd{\x0aJ\x1d\xa2n\x08&`\xca\x90\x0d\x8c\xfa" +
-	"\x08\x8c\x8c\x81\x94\xa5\x1b\x80Q\x1b\x81\x91Q\x832g" +
-	"\x12\x98[\xefL \xab\x19\xa6^\x08\x9d\x03\xed\xc0\x84" +
-	"\x89\x88k\x91gA]\xaa\xd0\xa9s\xfem\xc6\xac\x9e" +
-	"\xf5z)9:\xcd\xb9G\"\x0eX\xb0\xf2\xab\xacR" +
-	"I\x07\xc9tf\x80\xbd\xe7|Tp\x00\x99\xb9\xd3/" +
-	"\xe3\xb5\xfe\xb0YR\xd0\xab\xe2\x15}q\xd8+\x09\x9b" +
-	"&\xa8U\"C\x8fK\xa8:\xe4\x11Wy\xc9u\xeb" +
-	".\xdf#\xaeb8`\x8d\x8dU\xc2L4C\xbcp" +
-	"\x0b\xfa\x98V-:\x9b 5C\xe0\x18\xd7\x0aC\xe4" +
-	"\xde\x987\x9csi\xb1Tt\x82\xbc\xd8,\xa7\x88\xfe" +
-	"I2\xb7\xf8\xe1\xb2\xc3u\xbdx\x19\xa9\x8a\xbc>\xb0" +
-	"1bNT\xcdQ\xabJ\xbd\xddL\xc26\xd3\xa1\xa8" +
-	"\xd7\x00D4`^\x89\x12\xf4k\x99iI\xa1!\x0e" +
-	"n\xd2\x8aRU'I\xd7\x05\x92\xf28[\x0c\xb0\x81" +
-	"Q3\xc5<\xfcw\x0aay+1r1\xe2t\x12" +
-	"\x87}\xecz\xb0\xe3\xb2\xe0\xb4\x10'C\x1c\xe9#\x17" +
-	"\x05\xf6\xb8\xc2z\x01r\x1d\xc49\x818\xb1\xa3\xaeg" +
-	"k\xde%8\x9d\xc49\x998\xf1\x0f\x89Cm\xdb\x89" +
-	"\x82\x93!N7q\x12\x1f\xd09\xd4\xb8\xcd\x11\x9c\x13" +
-	"\x883\x9f8\xc9\xf7\xe97\xd4\xba\xcde\xfd\xd4\xba\x11" +
-	"g!qZ\xde#N\x0b\x00_ 8\xdd\xc49\x8d" +
-	"8\xad\xef\x12\xa7\x15\x80/\x12\x9c\xf9\xc4YB\x9c\xb6" +
-	"w\x88\xd3\x06\xc0Og\x83\x00\xb9\x85\xc4YF\x9c\xf6" +
-	"\xb7\x89\xd3\x0e\xc0\x97\x0a\xcei\xc4Y\xc1\x18\xca\x1d\x7f" +
-	"tE\xb9\xc6\xcf\x10*XB\x8c\xb3\x88!\xff\x8f+" +
-	"J6~\xa6`,#\xc69\xc48\xee\x88+\xd2\x0d" +
-	"?[0V\x10\xe3\\:$\xf5\x96\xeb\x95\xb4|\xa5" +
-	"\xe0\x9cE\x9c\xaf\xd2O:\xdftEZ\xe4C\xac\x9f" +
-	"\x0f\xb1ln\x9cX\x0e\xb1\x94\xffvEr\xe4[\xd9" +
-	"\x06\x80\\\x99\x18W\x10#}\xd8\x1b@\xf0\x1a\xa3&" +
-	"x\x1b1\xbe\xc9\x18\xa6.\xb3\x0cr\xb1Q\xcb*\xd6" +
-	"\xfd8e\x98\xce\x0ad\xc0\x90\x01f\x0d\xd3Y\xba\x1c" +
-	"%`(y\xdf\xfaz1\x06\x0cc\xde\xb7\xe5\xcb0" +
-	"\x0e\x0c\xe3\x80\xd9\xaa\xf8]\x82z\x16\xc0\x81\xaa\xf7C" +
-	"?\x01\x88\xaf}\xbdA%X\xf5~\xea\xfb\xe1\xc4X" +
-	"\xd1\xd2\x88\xdd\x06\x0c\xdb\xea\xdf\x97/\xc3v`\xd8N" +
-	"p\xd5\xb7\x05\xb1#Eu.\xca\xc0P\x06\x14\xe3\x0e" +
-	"L\x03\xc34`\x8a\xb2tp\xa2W4\xa91d\xee" +
-	"\x91\x1b\xbe4+\xbdy\xff$\xa81\x86+3(*" +
-	"j\x1cu\xbd%\x9b4H\x16\xab\xd4\x92\xfb\xfb\xb8\"" +
-	"\xd5\x8eiy@\x1d\x12n=\xfb\x82\xa4\xdb\xc1\x92\xcf" +
-	"[6\x81\xe84\x83)\xb5\x82\xfd~\x1d\x15-5g" +
-	"7\xe9\xa7\xd76\xeb\xa7)z\xac\xf3\x02c\xa4\xb2r" +
-	"\xc7\x8c\xa2N\xb5\x04e^_[\x13\x868%\x92\xe3" +
-	"\x02\x19\x9a\xf6\xfa\x1bke\xfc\xe4\x10\x10\x84\xab\xc6\x08" +
-	"\xe0M\xae\x9a\x04\x00ox\xd5\x04\xffr\xec\xa8\xdb\x14" +
-	"\xfer\xfcC\xb7)\xfa\xe5\xc4\x07nS\xf0\xcb\xc9\xf7" +
-	"\xdd\xa6\xd8\x97[\xdes\x9bB_n}\xd7m\x8a|" +
-	"\xb9\xed\x1d\xb7)\xf0\xe5\xf6\xb7\xdd/\x10\xf7\x88\xe1\xa3" +
-	"\x97\x80?\x93\x09\xf4\x91W\x1a\xbe@P;\xdf$j" +
-	"\xf0\x16\xc2e\xd6\xcfe\x96\x05\x81w\x8c\x8cX\xf9\x1c" +
-	"F\xa9?}\x98\xa8\xc1\x88\x9f\xb7\xb2\x11`Q\xa4C" +
-	"B`\x1c\x12\x1e\xba\xbd\xbf}\xbd\xde\xdf\xe5\xcb \xe1" +
-	"a\x19\x12>\x8a\xfd\x0f\xb4\xc2G.$\xea\x98\xad\x7f" +
-	"\"\x9a\xc0)$\x04B\xfdQ\xa4\xc0\xe4\xa7cq\xc4" +
-	"\xc7\xe2\xc6\x1aH\xd4\x83E!8\x05\x80\x0dYo\xc8" +
-	"\x1b\xd4\xe6\x8c\xed~\xe3\xd6-\x00rq/y\xb7\xa2" +
-	"\x9e\x02\x80L4k()+\xd7\x02`L9{\x03" +
-	"\x00\xc6\x953G\x000\xa1\x9c1HU\xaar\xfa5" +
-	"\x00Y\xbdTvj\xc9Q\xc3I\x8d\xd6\x1c\xddu." +
-	"\xb7\x06k\x8e^\x01\x00w\xcc\xaa\xda\xf4\x05\xb0\xe2\xea" +
-	"\xc6\x96qg\xb0F\xe2T&\xfc\xe6\xda5\xcc\xa2a" +
-	"\xea\xab\xacX\xa9lU\x0cG\x9fZ5\xad\xf2\xeb\xc3" +
-	"\x8aa\xa1\xd9\xd0_\xf6\x86\xa0\x17E\x8e@}\xaf\xdf" +
-	"^\x0e\xfbE\x0e]j}o\x18\x0a\xb2%\xedR+" +
-	"\x18\xf8dK\x86i\xd9\xf5\xd0\x9b-\x19y\xdb\xaa\x7f" +
-	"k\xd6\x94\x9c/\xc2R\x8a>7\xb4q\x8b\x9b\xb4q" +
-	"\xb3#}V\xb4S\x89F\x9ei\x0d\x15\xeaj\x07F" +
-	"\x9f\xc5\x94\x0d\xe1\xdb\x85\xa2\x8c\x84\xb5/}\x19\x8e\xd4" +
-	"\xa1\x9ep\xe7[ \x15t7\x17\xa9\x9b\xd5\x15u9" +
-	"y\x0dg\x03\xe4\x1c\x940w\x15\x06\xa2\xf2+\xc5$" +
-	"\xf7\x0a\"\x7fKLr\xfd\x82\xe4Z\x9c\x04\xc8}\x8b" +
-	"\xe8\xb7\x10]\xf2\x86\xc7\xfc\xbbH0\xfe\x0e\xd1o\x13" +
-	"\x13a\xbf\x18\xd9)\xf6\xb9\x85\xe8{\x89\x9e\xf4'\xc8" +
-	"\xf7\x0a\xfa=D\x7f\x1c)\xb4\xb8~0z\x14\x09\xdd" +
-	"\x0f\x11\xe3Ib\xb4\x8a\x8a<xC\xe5O`?\x7f" +
-	"\x02\x09\xb1m\xa2,\x0f\xde\x15\xf9\x8bH\x08o\xff\x88" +
-	"\xa8\xc1\xfb\x0b\x7f\x18\x09\xc7\x1dG\x89\x1a\xbcV\xf2{" +
-	"\xb1\x17\x98,\x7fH\xd4\xe0\x05\x86\xef\xc4\x11`J," +
-	"\x9e\xc1n\x00~\xb5\x18r_E\x92\xfc\x10\x19.\x8d" +
-	"wc\x06\xe7\x02\xf0\xef#U\x04\xb7\x11\xe3N\x9c\x9a" +
-	"6\x0aF\xa5\\\xd4j\xe7C2\xda\x87\xd6\xa9L+" +
-	"\xe9\xc3\xb6>fl[\xa7\x9b[\x9cq\xa8'\xf1i" +
-	"\x83;\xb3n\xb9\xe4\x94\xa6*0\xbb\x9fp>\xdbt" +
-	"2E\xc9,x\xd8\xf8\x0c\xa1\xc3\xf3\x18\x00\x11q\xa2" +
-	"\xf1#+z\x9e\xf0X\xc92\xc3\xc6\xe7\x13\xc7\xe0F" +
-	"E$u\x030?C\x91/\xe0\xe4\x9d\x95\xd4\xf2z" +
-	"}h\x91\x8a\x96\xf8\xe7\xf8C\x8b,\x80r65*" +
-	"gI\xa8^\xc4p\xa2$\x1a\xad\xf0\xfc\xfa\x7f \xd4" +
-	"\x13\xb3?\xff\x82T\xa5\x12\xd5g\xf0\xde>\xf3\xd4\xc1" +
-	"\xbbk\xca1,S]\xe6\x89D\xc5\xdf%\xc2M/" +
-	"\"\x1f(\x90_cY\xcch\xb9&\xfcz3\xd1\x8b" +
-	"\x027[\xc5\x98\x96\x1bx)@n\x9c\xe8\x8e\xc0\x8d" +
-	"-\x06\xaa|\xabX_&\xfa\x15\xe2\x85\xa5\"\xe6O" +
-	"\xbc\x86\xd7O\xc1_\xdc\xc9\xe0,\x81?\x1b \xf7M" +
-	"\xa2\xef\x108\xab\x8aA\x05\xbfA\xec\x1f\xe2/y\x99" +
-	"x\x0f\xe3;\x05]\xe0\xefGDo\xb9\\\xbc\x88\xf1" +
-	"\xdd\x82\xfeC\xa2\xff\x84\xe8\xad\xdb\xc4\x9b\x18\xbfK\x9c" +
-	"\xfb\x13\xa2\xdfG\xf4\xb6Z\x06O\x06\xe0\xfb\xc4\xb9{" +
-	"\x89\xfe\x00\xd1\xdb\xb7gp\x0e\x00\xdf/\xf6\xf99\xd1" +
-	"\x1f!z\xc7_f\xf0\xff\x01\xc1\xeef\x80\xdc#D" +
-	"\x7f\x1ag\x9c*\xb9\x8efo\xd1\x9d\xcajH\x1aE" +
-	"=p\x0c\x9f\xba\x0aR\xe4n\x8d\xe4!HRq\xda" +
-	"HE\x7f\xae\xe4\xd0\xdeSy9\xc8\x0a\xbfn\xa4\xaf" +
-	"\x86\x14\xb5z\x8d\xe4\x0b e\x1a\x96\xd9H>\x0fR" +
-	"Sf\xb7>y\x0d\xfa\xf8\xd0\xa7\x1f\xbc\x1e\xb2\xc21" +
-	"\x1b\xe9\xc3\x90\"\xd44\x92W\xa2\x0f,\x0b\xcd\x19P" +
-	"\"&\x19\x0d(Ao\x8a\xd78\xc6\x9c\xf5\x85\xcc\xeb" +
-	"W\xd6E2\x1b\xa7\xb9\xd1\x12\xbbY\xb2E\x9c\x9el" +
-	"\xa3\xf3\xe4\x19&\x8a\x9f\xe7\x1d\xc1\xcbi\xa95\xe6\x98" +
-	"%\x9a\x82\xf0\x1f,\xb0\x7f`\xbd^\x1a\xf5F=\x9f" +
-	"\xd4\x14\x8c|\x8e\xa6\xa0\xd9\xfce\xa2$\x8e\x89\x04\x95" +
-	"@\x08/\xa8\xfco\x00\x00\x00\xff\xffn\xcfm\xe2"
+const schema_a93fc509624c72d9 = "x\xda\xacZ{t\x1c\xd5y\xff\xbe;\xfb\xd0k\xb4" +
+	";\x9a\x11\x92\x89\xc5\xfa\xc5K\x80bK\xc61\x0aT" +
+	"\xb6l\x99\xd8\xb5\x89F\xeb\x07\xa8\xf5\x89G\xbb#k" +
+	"\x9c\xdd\x99\xf5\xec,H.>\x02Z\x0e\x81\x060\x14" +
+	"\x07\xe2\x14N\xa0\xe4\x9c\xb8@\xb0[|N\xec@\x01" +
+	"\x1f\\l\x8eI \x85\x03nH\x9b\xd08P\xb7." +
+	"vc\x9e6\x9e\x9e\xef\xce\xec\xeeh\xb5\x0ap\xca_" +
+	"\xda\xfd\xbe\xbb\xf7\xde\xef\xf1\xfb^Ws\x9flX\x14" +
+	"\x9a'\xaek\x06\xa6~\x14\x8e\xb8\xf7\x1f\xdbPw\xfe" +
+	"\x15\xef\xdc\x06j\x13\x0a\xee\x86\xc3\x1f\xff\xf6\xf8h\xfe" +
+	"\x15h\xc6(\x02\xc8\xf7\xd4\xed\x03\x94\xef\xa9\xeb\x01t" +
+	"\xd7.\xb8\xee\xec\xd8\xb7\xbe~\x17\xa8m(\xb8'\x97" +
+	"\xff\xc9#\xef\xf6\xac\xdb\x0fk0\x8a!\x0cw\xed\xa9" +
+	"\x1bD@\xf9\xf9\xbaw\x00\xddKz\xf5\x0d'\xd6\xcc" +
+	"\xdf\x06\x92\x88\xee\x11{\xe5P\xed\x81\x9e\x9d\x10\xc6(" +
+	"\x80\xfc\xfd\xfa\x1d\xf2\xc3\xf5\x17\x02\xc8{\xea{\x00\x9f" +
+	"\xbdiU\xd7\xd7\xdf>\xbc]\x15Q\x08,\x0d\xd3\xd2" +
+	"\xa3\xf5\x8f\x00v\x1d\xad\x7f\x11\x01\xddU;V\xbf\xf5" +
+	"_7\xdeq?\xa8\"\x067e\xb4\xf2\xb8H\x17=" +
+	".>\x09\xe86\xbdx\xf6\xc6\x7fZ\xb9\xfb~\x90\xe4" +
+	"\x90\xfb\xadS+\x12\x8f\xf6?\xb1\x03\x00\xbb\x1ell" +
+	"B\xc0\xe4\xa3\x8d\x02&w52\x04(\xb3'\x1e\xdf" +
+	"\x17\x8a2\x0c\xc9\x0f7\xee\x90w6\xb6\x00t\xedi" +
+	"\xbc\x9b\xeep\xeb\x0a\xed\xd4\x9c\x0f_}\xb0B\x07\x9e" +
+	"\xb6\xbaj\xe3\xdd\xa4\x02)~\x03\xa0\xbb\xf3\xb6\xf1s" +
+	"7\x8f6>T]\xb1\x9b\xe3t\xdf\xcd|\xe5\xe5K" +
+	"O\x7f\xed\x07\xbb\x7f\xc8W\x86\xdd\x96Gg\x7f\xbc\xf2" +
+	"\xb1[~\x0f\xcd\x11\xbe\xf2\xe5\xf8K\x80]\xaf\xc6\xf9" +
+	"\xf9\x1f\xbd\xf1\xe8+\x0f\xb5\x7fog\xa5\x0e\xb8bo" +
+	"o\xda\x0f(\xdf\xdeD{\x1e\xfavj\xe7\xad\xf3\xdf" +
+	"x\x0cT\x19Y\xd9\x1c}\xc8\xe5:\xda\xf4\x12\xa0\xfc" +
+	"\x9fM\xa4\xad\x7f\xf9\xe7\x95\xefm\xb0\xba\x1f\x9f\xc2\x01" +
+	"dZ\xb9]\xa6=\x8f\xae\x9b\xdd\xf47\x8b\xfb\x7f\x02" +
+	"\xea\\\xc4O\x87n\xee\xd8\xd5x\xec\x17\xfc\xe8\xae\x93" +
+	"\xf2>\x92\x1c\x15Z7\xe3>q\xcb\xe3?\xbacw" +
+	"uy\xd6+t\xcb\xf5|\xe5\x03\xefw-Y\xf0\x8f" +
+	"+\xf6T_\xb9W!\x1d\xedU\xc8\xf9v\xff]\xec" +
+	"\xe4\xe1\xaf\x0c\xec\x05\xa9\x09\xcb\x0b=\xb9\x8f+o\x03" +
+	"\xca'\xf9:\xe7\xed\xb5\x0dM\x87\xde\xdbW\xdd@R" +
+	"\xf3\xdf\xd35g6\xd3\xd2\xdf\xd7\xdfv\xdb\xfe\xd7\xb7" +
+	"\xfd\x8cT$\x94\x1daM(\x8a\x0c\xc3r_\xf3\xbf" +
+	"\x02\xca\xcb\x9b\xe9\x9e?\x7f\xa3n\xe6\x07\xcb\x0e<[" +
+	"\xe1\xce!\x12\xfd\x89\xe6&\x94\x9fin\x01\x90\x0f5" +
+	"\x93\xef\x97\x0e\x9d\xa8P\xc2\x89\x80!\xf9\xc89\xc7\x00" +
+	"\xe5_\x9fC\xba\x7f$q\xd7\xed\x0b\xb7\xed\xdb\x0fR" +
+	"\x1b\xba?Y'\x87\xfe\xa3\xed\xae?xRu\xcdk" +
+	"\x99Ew\xbd\xa2\x85\xeej9O}\xfb\xea\xf0\xec\x17" +
+	"*\xee\xda\x1c\xf2T\xda\xc2U\xdaB\xa7\x9fy\xe7\xa1" +
+	"\xef\x9d\xf3r\xea \xad\xc4\x89\x86\x07\x90\xafj%\xa1" +
+	"\x16\xb7\x92PR\xdb\xafF~\xf5\xf2\x99\x97\xaa\xef\xf9" +
+	"`+\x19\xfe\xe1V:\xfd\x07\x0d\xbb\xde\xf8\xe5[\xb3" +
+	"\x7fN^\xc7\x02 \xc1\xa8\x0c ?\xdf\xba\x03\xb0\xeb" +
+	"\xf9\xd6\xaf\x86\xa0\xe4\x15\xea,\x0ch\x8dC\xb4\xab\xaf" +
+	"\xed\x16\x94\xafk#Eim$}I5\x15\xd8\xf3" +
+	"\xb6\x15\xcf\xbb\x17\xb0K<\x8f\xb6u/\x986\xb0o" +
+	"\xeb\xdd\xdb_\x05I\x0c\\\x00P\x0e\xcf\xa2k\xd6\xce" +
+	"z\x11\xd0M}\xff/\xb7\xfe\xd9\xdc\xf5oV\x80\x83" +
+	"\xac$o\x9d\xf5K@\xf9\xe6Ytn\xef\xfe\xd8'" +
+	"?[\xf3\xec\xbf\x91\x81&9\xd3\xb4\xd9d\x9e\xb6\xd9" +
+	"7@\x80\xa5\x8a\x18\x0e\\0\x12\x8d`D\x1e\x9b}" +
+	"\xaf|\xf3\xec\xaf\x91\x0b\xccn\x11\x00\xdd\xbbg\xee?" +
+	"\xf1\x8b\xe4\x85\xefV\xf7\xe6\x03\x17\x90\x97\x1e\xba\x806" +
+	"\xde\xc6\xea\x16\xbd6\xed\x9c\xff\xae\xber\xf9\x85\xc7\x00" +
+	"\xbbV]\xf8\xef\x0c\xd0}\xb6\xe1\xf4\x87\xc6K\x7f}" +
+	"\xbc:<\xc7\xdai\xd3\xad\xed\xb4io\xe1\xfc\xc7\xc4" +
+	"\xed\x07OV\x8d\x8fG\xda\xc9E\x8e\xb4?\x09\x01G" +
+	"\xab\x90\x9f/\xdc|\xc91y\xeb%d\xa4[/y" +
+	"\x12\\P\xdc|jD\xcfj\x1d)\xd4rf\xae{" +
+	"\xf5X\xaeG\xef\xc8\x18yG\x0d\x09!\x00\x05\x1b\x01" +
+	"$q\x08@m\x10Pme\xe8\xea\x19=\xab\x9b\xce" +
+	"j\x88\x8e\xe5t\x8c\x97/\x0d\x88q\xc0\xd2\x86\xa1\xe2" +
+	"\x86z\x87f\x8e\xf5[\x86\xe9\xe8vG\xc1LYf" +
+	"\xde\xb15\xc3\x14\xf4\xb4\x1a\x17B\x0d\xae\xab`\x13\x80" +
+	"\xa4\xf5\x02\xa8\x7f.\xa0:\xc2P\xc4\xb3\xae\x82\xd3\x00" +
+	"$\xbd\x1b@\xdd \xa0\x9aa(\xb2O]\x05\xcf\x05" +
+	"\x90\x8cv\x005-\xa0\x9ac(\x0ag\\\x05\xbf\x02" +
+	" e\x07\x01\xd4\x8c\x80\xea(\xc3q\xcd\x1c\xfbS\xc3" +
+	"LC\xa4'\xef\xd8\x85\x94\x03\x91\x18\xc9\x05\x117\xa5" +
+	"\xe5\xb4!#c\x80\xe0\x8cA\xa4B\x03\xbd\xb6&\x98" +
+	"i\xb5\x06\x03QV\xaa\xed,#O\x0a\xf7&\x92)" +
+	"+\xa7\x8f\xf7\x1af\xda07z\x9a\x0a!)\x8a." +
+	"[#\xa0:\x87aO\x9e\x16\xe5\xb1\x11\xb0_@\x8c" +
+	"\x97\xb7\x03$b\xc5\xb9\xabt':b\xa5\xd5\x19\xa5" +
+	"\xcd^%\x19\x0f\x0b\xa8\xbe\xc9\x10QA\xa2\xbd>\x00" +
+	"\xa0\xbe&\xa0\xfa\x1b\x86\x92\x80\x0a2\x00\xe9\xd7\xb7\x00" +
+	"\xa8o\x09\xa8\xbe\xcbP\x0a3\x05\x05\x00\xe9\xe8\x1d\x00" +
+	"\xea\xbb\x02\xaa\xa7\x18JQT0\x04 \x9d$C\x9e" +
+	"\x100\xd9\x80\x0c\xa5\x10S0\x0c \xd7\xe2 @\xb2" +
+	"\x06\x05L*D\x8f\x08\x0aF\x00d\x09\x87\x00\x92q" +
+	"\xa2O':\x0b)\x1e\x88p\x07@r:\xd1\x17\"" +
+	"\xc3\x98\xa9eul\x00\x86\x0d\x04S+\xad\x7f\xd3N" +
+	"\xeb\x806F\x81a\x14\xd0\xcdi\xb6\x96M:6\x16" +
+	"R\x0e9\x04`-0\xac\x05tm=_\xc88I" +
+	"\x07\xed\"\xab\xcc\xd3L\xd3r4\xc7\x80\xa8e\x06\xd4" +
+	"X\x02\x82\xafF\xbey\xaf\xad\x81`\xa61^\x8e\x89" +
+	"\xbe;z'\xf4\xda\x10\xd5\xaa\xf2\x8dl.c\xa4\x0c" +
+	"\x07\xfbi\x1f\xdd\xd1\x05;pX)1U\xb5Y\x9f" +
+	"Y\xe8\xc9\xea\xb6f:jC\xc9l}d\xb6E\x02" +
+	"\xaa+\xcbf[Nf\xfb\x86\x80\xeajR\xa3o6" +
+	"\x95\x8c\xd1\xefy\xf6g\xeb\xf0sj\xa3xA\x81_" +
+	"p\x99\xa1g\xd2\x1d\xa6\xb5\xd4\xc8\xa7l#k\x98\x9a" +
+	"\x89\x0em)F]w\x928\xcb\x0c]\xc8\xa4\xd5\x10" +
+	"\x06\x8b,\xdc\xe2\x16\x7f\x0f=|\x07G\x9d^\x12v" +
+	"\x0f\x09\xbbK@\xf5\xe9\xb2\xb0{I\xd8\x9f\x0a\xa8\xbe" +
+	"\x10\x10\xf6y\x12\xf69\x01\xd5\xb7|\xc7\x15\x10\xa5#" +
+	"\xf7\x96\x1dW\x0c\xb9.\x06R\xa1t\xb4\x1d\x98\x18>" +
+	"\xebb \x97I/w\x02\xc3\x08\x06\xd2\xbb\xb4\xa7\x17" +
+	"\xd8\x97\xa6\xbe\xb4\xaf($9\xd7j\x99\x02\xeaeu" +
+	"\xc5\xf2\x19\xcbIl\xb4\xadBn\xdc\xb2\xd3\x86\xa9e" +
+	"*\xf4]\x19\xedr\x9a\xdd\xc3\x9d\xcaVk\x84P\x9c" +
+	")(\x03H\x17S\xa0\x9b#\xa0:\x97\xa1\x84a\x05" +
+	"\x15\x00\xe9\xb2-\x00\xea\xa5\x02\xaa\x0b\x19\x8e\xf3\xd0\xb1" +
+	"<]\x82B\xce\xf7M\xe8\xb1\x97\x9bi}\xb4$V" +
+	"\xb5\xe8\xad\x9b\x85,?\x0e\x15\x8c\xd1q\xdd\xe5\xe3\xc8" +
+	"F\xcdtZ'\x80z\x91\x80\xea|\x86=\xceX\xf0" +
+	"\xb0\xc4\x90]\x1d)\xc5\xb3\x18?\xeb\x1a+\xadw\xf8" +
+	"\x91\x15\xc8#\xe2Q\x1e\x1d\xa4=6\x80\xfa\x94\x80\xea" +
+	"s$]\x83\x825\x00\xd23\x9b\x00\xd4\xa7\x05T\x0f" +
+	"\x92O\x88\x0a\xd6\x02H\x07\xfe\x01@=(\xa0\xfa\x1a" +
+	"\xf9\xc4o\x14\xac\xa3\xa8\xd7[\x8ezR(\xa6`=" +
+	"\x85=r\x947\x05T\x7fG\x11\xaeF\xc1\x06\x00\xe9" +
+	"\xb7;\x00\xd4\xdf\x09\xa8\x9e\xf0c\x96\x08 \x1d\xef\xf6" +
+	"\xc2^2\x84\x0c\xdd\xb4\xe6h\xeb,;\x0d\x89%V" +
+	"\xc1t\xca\x01\xc9\xb3\xcf\x12\x88M$\xdb\xfa\xb0n\xdb" +
+	":\xa6W\x1ay\xa7\xcfL%,\x0a\xf0\x18+W(" +
+	"\x80\x18\x03\x1c7\xf2W\x93\x1b \x02C\xac\xf4\x1b:" +
+	"\x0b\xcb\xbbN\xe0}sx8/\xe8\x0e\xd6\x00\xc3\x1a" +
+	"\xc0\x9eaBh\xc0!\x03\x0d\x0d6NRz\xb2\x90" +
+	"\xd3\xedTF\xcb\xe7)\xcd\x14Ax\xf1\xb9\x13\x0d\x8c" +
+	"\x15\x06\x16\x8c/j\xdc^Z\xd5\xc1\xb3\x1c\xcf\xfc\xa5" +
+	"\xd8\xd6[\x8em\"R\xe2\xe6\xd1\x8d\x82\xc0R\x01\xd5" +
+	"\x0d\x94\xa2\xcf\xba\x1e\xe2\xd7\xd3\xdak\x05T\xd3\x93\x1d" +
+	":6d\x98\xe9\xb2\xd0\xa5\xdc\xea\x09=n\x98#\xba" +
+	"m8\x10\xa9\xb8\x16\x87W\xc9\xe7|\x17\x8fWq\xf1" +
+	"s\xfe\x9f.\x1e\xe6\xc7-\xb1\xd2\xfa\xd5\xba\xa9\xdb\x9a" +
+	"c\xd9\x03\xfa\xe6\x82\x9ew:\xfc\xbfzz\x99\x91\xd1" +
+	";z\x96gs\x96\xed|\x961\xda\xab\x1acb\xd4" +
+	"\xaa\x02/^*y\x82\x0a\xbe\xa0\xed\xc1\xd0\x11RP" +
+	"\xaa\x904\xe6T\xad\xca\x12\xd7k\x99\x02\xd1K\xc5~" +
+	"\x85\xc4X<\xb5\x18@B\xde\xa1\xbc\xfc\x1b\xf4\xcb\xbf" +
+	"\x8b\xa8\xfc3\x0b<\xe5\x81\xe0\x04\xdc\xb6\xd4\xbdWu" +
+	"[.\x8c\x97_\xa3\x14\x0b\x03\x05S\xbb_0)l" +
+	"\x0au\x84\xab\x06\xd6b\xd6^\xa5;#V\x9ao\x1d" +
+	"\xd3\xfd\xad\xe3a\x05[i\xef-\x81\xaa\xf53#(" +
+	"\x0b$L\x1e\xdc\x05-\xa3\xd6\xf8\xe5)\x85\xb0\x8bW" +
+	"\x94\xb5\xdc\x86g\xdd\x88\x17\xc4\xe6\x11y\xae\x80\xea\x95" +
+	"\xac\\L\x00D]}\xb4\xf8\x19&\x1d&L\xe5_" +
+	"\xe8P\xfa-\xf6Y\x12\xdan\xd1\xdf \xc1=\x8e*" +
+	"fD\xae;\xad\xd3\xaf\x97G\xfd\x94J\xc4\x02\x09\xed" +
+	"\x08\xa8nc\x18g\x1e\x12\xef\xa4\xe0\xfb]\x01\xd5\x07" +
+	"(\xf8\x0a^}\xb8\x9d\x8cz\x9f\x80\xea.\x86\x09\xd3" +
+	"J\x07+\xd5R\xd3\xe0[\xd3.\xde\xa1\x87\xdf\xa1\xbc" +
+	"\xb2xQ\x7f]\x8a\xc4Z\xab\xdb\x10\xcb\x1b\x96\x89\xf1" +
+	"r\xcfV\xf46\xab`\xa7\xf4\xe5&\x08\xc3V\xf9\xb8" +
+	"R\xc7R\xd5y\x02\xa6\x8f\x91\xed)\x1c5\xf0Z\xa1" +
+	"4\xb2\x92\xfal`\xd42``\x86#\xcd\x1b\x00F" +
+	"\x1d\x03\x06\xe6\x06R\xdb~`n\xb1\x09\x81\x84f\x98" +
+	"z\xba\xec\x1ch\x97L\x18\x09\xb8\x16y\x16\x14oU" +
+	"\xf6\xe8\xa4/\xcd\xb0\xd5\xb1J\xcfF\x87&z\xf6`" +
+	"\xc0\xfb\xd2Vj\x89\x95\xcd\xea \x98\xce\x14\x80\xf7<" +
+	"\x8f*\x0cP\xe3^d\xa3\x8a]\xeb.7E\x12z" +
+	"\x05\xbb\xa4\xb7\x97{\"n\xd0\x08\xb5Dd\xe5\x11\x01" +
+	"U\x87\xdc\xe1&/\x9bn\xde\xe1\xbb\xc3M\x0c{\xac" +
+	"\xe1\xe1|9\xf5L\x11)\xdc\xb4>\xac\x152\xceZ" +
+	"\x88M\x112F\xb4t\x1f\xf96\xa6\x0cg)-\x16" +
+	"2N)\x11V\xcb#\xbcO\x12\xcc\x8d>\x9c\xbc0" +
+	"\x19\xa8\x81\xbcf\xaf2P\x8e\x17\xcc!\xab@\x0d\xdc" +
+	"T7\xad\xa6@^\x9d\x01\xf08\xc0\xbc\x82\xa4\xd4\x94" +
+	")\x93\x12AE\xf8[\xabe\x84\x82\xae\xae,]S" +
+	"\x0e\xb3v\x80\x01F\x1d\x13\xf3`\x1f\xe77\x95k\x89" +
+	"\x91\x0c\x11'N\x1c\xf6\xa9\xeb\xa1M\x169\xa7\x868" +
+	"\x0aq\x843.r\xc8\xc9\x12\xeb\x04H6\x10\xa7\x95" +
+	"8\xa1\xd3\xaege\xb9\x99s\xe2\xc4\x99N\x9c\xf0'" +
+	".z\xbd\xd94\xceQ\x883\x838\x91\x8f]\xe6u" +
+	"gm\x9c\xd3J\x9c9\xc4\x89~\xe4\xa2\xd7\x9f\xcdd" +
+	"\xdd\xd4\x9f\x11\xe7\"\xe2\xd4|H\x9c\x1a\x00\xf9|\xce" +
+	"\x99A\x9cK\x89S\xfb\x01qj\x01\xe4\x8b9g\x0e" +
+	"q\xe6\x12\xa7\xee}\xe2\xd4\x01\xc8\x97\xb1^\x80\xe4E" +
+	"\xc4\x99O\x9c\xfaS\xc4\xa9\x07\x90\xe7q\xce\xa5\xc4Y" +
+	"\xc8\x18\x8a\x0d\x7fpye&_\xceU0\x97\x18W" +
+	"\x12C\xfc_\x97Wg\xf2\x15\x9c1\x9f\x18\x8b\x88\xd1" +
+	"x\xd2\xe5)F\xbe\x8a3\x16\x12c)\x1d\x12;\xe1" +
+	"z\xd5\xab\xbc\x98s\xae$\xce7\xe8'\xf1\xf7\\\x9e" +
+	"\x0a\xe5>\xd6-\xf7\xb1D2M\xac\x1c\xb1\xa4\xffq" +
+	"yB\x94\xb3l\x00 \x99!\xc6(1\x9a\x8e{#" +
+	"\x06\xb9\xc0\xa8\xd3u\x88q\x13c\x18\xbb\xde2\xc8\xbf" +
+	"\x86,+S\xf4\xe0\x98a:\x0b\x91\x01C\x06\x980" +
+	"Lg\xde\x02\x14\x80\xa1\xe0}\xeb\xea\xc4\x100\x0cy" +
+	"\xdf\x16\xcc\xc700\x0c\x03&\x0a\xfcw\x11jO\x00" +
+	"{\x0a\xde\x0f\xfd\xb8\xcf\xbfvu\x96\x8a\xbe\x82\xf7S" +
+	"\xdf\x09\xc7\x873\x96F\xec:`XW\xfc\xbe`>" +
+	"\xd6\x03\xc3z\x02\xaa>Z\x8a\x1a1*iQ\x04\x86" +
+	"\" \x1fh`\x130l\x02\x8cQf.\x9d\xe8U" +
+	"Ij\x08\x99{\xf2\xce\xaf\xb64m\xd8\xbb\x1f\xd4\x10" +
+	"C\x00^;\xe3\x90\xeb\xadX\xabA\x94`\xeeo\xe2" +
+	"\xf2\xf4:\xac\xa5\x00u\x88\xb8\xc5\x8c\x0b\x82n\x97\x96" +
+	"|\xd1\"\x09xGY\x1a/K\xd8]\xac\x9a\x02\x85" +
+	"\xe5\xb9U\x9a\xe6\x15\xd5\x9af\x8a\x1b+\xbdx\x18(" +
+	"\xa5\xdca#\xa3S\xfd\x00\x00EU\x8d\x1b\xfc\x94@" +
+	"^+\xdd\xa1j7\xbfz,\x87\x7f\x04\xfc\xa5(U" +
+	"\x89}o*U\x05\xfa\xde`\xaa\x0a\xf2\xc5\xd0i\xb7" +
+	"*\xf0\xc5\xf0'nU\xdc\x8b\x91\x8f\xdd\xaa\xb0\x17\xa3" +
+	"\x1f\xb9UQ/\xd6|\xe8V\x05\xbdX\xfb\x81[\x15" +
+	"\xf3b\xdd\xfbnU\xc8\x8b\xf5\xa7\xdc/\x11\xf1\x88\xe5" +
+	"g)\x0e|&\x12\xdc\x03o*\xf2\xf9\x9c\x1a\x7f\xcf" +
+	"\xc5\xc0\x0b\x86,\xb2nYd\x09\xe0H\xc7\xc0\xf0T" +
+	"\x9e\xc6(\xd77\x1dw10\xa0\x97\xc3l\x10X\x10" +
+	"\xe3\x10\xe1\xe8\x86\x88\x87k\xefoW\xa7\xf7w\xc1|" +
+	"\x88x(\x86\x88\x8f_\xffCW\xa7\xff\x81\x96\x14\xd1" +
+	"Z\xfcD4\x8eP\x88pl\xfacF\x8e\xc6\xcfD" +
+	"\xe1\xa0\x8f\xc2\xd5c \xe4\xf4 \xf8&@\xaf\"\xd3" +
+	"\xf5y\x13\xd8\xa4\xb1E\x07Pgp\\\\\xd7\x09\x80" +
+	"(\xa9\xb3\x00\x90\xf1\x8e\x0c\x05i\xf1\x0a\x00\x0cIW" +
+	"\x0d\x00`X\xbab\x10\x00#\xd2\xe5\xbd\x00\x18\x95." +
+	"\xbb\x05 \xa1gs\xceXt\xc8pbCc\x8e\xee" +
+	":7X\xbdc\x8e\x9e\x07\x00w\xd8*\xd8\xf4\x050" +
+	"\xef\xea\xc6\xc6\x11\xa7w\x8c\xee\x92\x1f\xf7{g\xd70" +
+	"3\x86\xa9/\xb1B\xd9\x9c\x957\x1c}b\x81\xb4\xc4" +
+	"/\x05\xf3\x86\x85f\x10\xe8\x9de\xa0\xf3z\x86#\xbd" +
+	"\xd3o \xfb\xfdz\x86$Z\xd5Y\x86\x7f\"\xabm" +
+	"\xb2J\xc3\x9cD\xd60-\xbb\x18k\x13Y#e[" +
+	"\xc5o\xd5:\x8fkx(\x8a\xd1\xe7`\xa3\xd6^\xa5" +
+	"Q;7\xd0I\x05\xdb\x91`\xa8\x99\xd42\xa1\xae6" +
+	"`\xf0\x11K\x1a(\xbfBH\xd2`\xb9\xc0\xa5/\xfd" +
+	"\x81b\xd3\xbb\xd95\x16\x08i\xddM\x06\x8acua" +
+	"\xf1\x9e\xf2\x18\x0d\xbc\x93\x0eR\xce\xc2\xd2U\xe5\xad|" +
+	"8{#\x91\xbf\xc3\x87\xb3~\xf9q+\xee\x07H~" +
+	"\x87\xe8\xf7\x11]\xf0\xe6\xc1\xf2=H\xd0\xfd.\xd1\x1f" +
+	" z\xc4/=\xb6\xf3}\xee#\xfa.\xa2G\xfd\xa1" +
+	"\xf0\x13\x9c\xfe8\xd1\x0f\"\x85\x13\xd7\x0f@\x07\x90\x10" +
+	"\xfd\x1c1\x0e\x13\xa3\x96\x97\xdd\xa5WN\xf9\x10v\xcb" +
+	"\x87\x90PZ\xc7k\xef\xd2+\xa0\xfc:\x12\xaa\xeb\xcf" +
+	"\xb8\x18xM\x91\x9fA\xc2n\xc3i\x17\x03o\x8b\xf2" +
+	"N\xec\x04&\x8a\x9f\xb8\x18xO\x91\xef\xc1A`R" +
+	"(\xac\xe0\x0c\xae\x82\xc1\xa2\x0aH\xa4y\xe1\x19\xa8\xe0" +
+	"L.\xd3@Q\xa6\x1f\xe2\xc4<\x916\xf2\xb9\x8c6" +
+	"v\x0dD\x83\xcdf\x91\xca\xb4\xac\xdeo\xeb\xc3\xc6\xe8" +
+	"J\xdd\xdc\xe8\x8c@1eO\x9a\xc8\x99E\xcbE'" +
+	"tN%\xb3\xfb\x19\xe6\xf3\x8d\x1dc\x94\xbd \xf2\xb9" +
+	"\xc3\x85\xe70<\xc4\x04cF\x82w5\xe53\x05\xcb" +
+	",\xb76\x7ft\xacm\xe4y\x0a7\x00SST\xf2" +
+	"\x1cH\xdeYQ-\xa5\x17g\x12\xb1`)\xbf\xc8\x9f" +
+	"I$\x00\xa4\xab\xa8\x1b\xb9R@\xf5Z\x86\xe3Y\xde" +
+	"J\x95\xcf/\xfeS@1\x0d\xfbS-\x88\xe5\xf3A" +
+	"e\x96\x9e\xc3\xa7\x1e*x\xb2\xc6\x1c\xc32\xd5\xf9\xde" +
+	"\x95\xa8\xce[\xcf}\xf4Zr\x80495\xe6\xf8\xe4" +
+	"U\xd6\xb8So z\x86\xe8l3\x1f\xbe\xca\x06n" +
+	"\x02H\x8e\x10\xdd!\xba`\xf31\xa9\xbc\x99\xaf\xcf\x11" +
+	"\xfdF\xa2\x87\xf2|\xb6$\x8f\xe1\x1d\x13\xc0\x17v\x14" +
+	"\xe4\x8fjh\x03$\xff\x8a\xe8\xdb8\xc8\x0a|\x14!" +
+	"\xdf\x89\x9b&\x80/z=\x7f\xdc\x92\xb7\xe3\xa6\xa0\xa3" +
+	"J57\xf0\xe7-\xf9AN\xff[\xa2\xff\x98\xe8\xb5" +
+	"\xa3\xfc\x81K\xfe\x11?\xf7\xc7D\x7f\x8a\xe8uc\x0a" +
+	"N\x07\x90w\xf3sw\x11\xfdi\xa2\xd7oQ\xb0\x8d" +
+	"\xde\xd8\xf9>?%\xfa\x0bDo\xf8\x0b\x05\xcf\xa3G" +
+	"]\xbc\x17 \xf9\x02\xd1_\xc1)\x87F\xae\xa3\xd9\x1b" +
+	"u'\xbf\x0c\xa2FF/9\x86O\xa5\xe1\xa9\x99w" +
+	"*\xc9}\x10\xa5:\xb4\x92\x8a\xfe\xd8\xc8\x01\xa8\xe4%" +
+	"!\xc1\xbd\xba\x92\xbe\x0cb\xd4\xd2U\x92\xd7@\xcc\xa4" +
+	"\xe9B\x05\xf9j\x88M\x98\xc8\xfa\xe4\xe5\xe8\xe3C\x9f" +
+	"|\xf0*Hp\xc7\xac\xa4\xf7C\x8cPSI^\x8c" +
+	">\xb0,4\xa7@\x09\x9fUT\xa0\x04\xbd!]\xe5" +
+	"\x88\xb2\xe5K\x99\xc2/.^\xc9\x84\xa9\xaa\xe9j9" +
+	"\x16qr\x8e\x0dN\x89\xa7\x98\x16~\x91\xa7\x01/\x9b" +
+	"\xc5h:\xc2\xeb\xff\xf2?C`w\xcf*=;\xa4" +
+	"\xdbS\xdd\xb8X\xff\x0f~\x81\xfa\xbf\xda\x84e<\xcb" +
+	"\x8f\x09D\x94\xd2%\xbc\x88\xf2\x7f\x03\x00\x12\xa9^\x06"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -4191,3 +4518,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_a93fc509624c72d9,
+		Compressed: true,
+	}
+	return s.Request()
+}
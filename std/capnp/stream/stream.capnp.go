@@ -8,6 +8,25 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 )
 
+// Empty struct that serves as the return type for "streaming" methods.
+//
+// Defining a method like:
+//
+//	write @0 (bytes :Data) -> stream;
+//
+// Is equivalent to:
+//
+//	write @0 (bytes :Data) -> import "/capnp/stream.capnp".StreamResult;
+//
+// However, implementations that recognize streaming will elide the reference to StreamResult
+// and instead give write() a different signature appropriate for streaming.
+//
+// Streaming methods do not return a result -- that is, they return Promise<void>. This promise
+// resolves not to indicate that the call was actually delivered, but instead to provide
+// backpressure. When the previous call's promise resolves, it is time to make another call. On
+// the client side, the RPC system will resolve promises immediately until an appropriate number
+// of requests are in-flight, and then will delay promise resolution to apply back-pressure.
+// On the server side, the RPC system will deliver one call at a time.
 type StreamResult capnp.Struct
 
 // StreamResult_TypeID is the unique identifier for the type StreamResult.
@@ -73,12 +92,12 @@ func (f StreamResult_Future) Struct() (StreamResult, error) {
 	return StreamResult(p.Struct()), err
 }
 
-const schema_86c366a91393f3f8 = "x\xda\x12\x88p`1\xe4\xcdgb`\x0a\x94ae" +
-	"\xfb\x9f\xb7\xf1@\xb9\xf1\xac\xf8\x99\x0c\x82\xbc\x8c\xff\x7f" +
-	"|\x9e,\xbc2\xedp\x1b\x03\x0b;\x03\x83\xb0,\xe3" +
-	"%aMFv\x06\xd7\xff\xc5%E\xa9\x89\xb9z\xc9" +
-	"L\x89\x05y\x05V\xc1`^Pjqi\x0ecI" +
-	"\x00## \x00\x00\xff\xff\x13|\x19\xd3"
+const schema_86c366a91393f3f8 = "x\xda\x12\xf8\xeb\xc0b\xc8;\x9d\x9d\x81)0\x82\x95" +
+	"\xed\x7f\xde\xc6\x03\xe5\xc6\xb3\xe2g2\x08\xf22\xfe\xff" +
+	"\xf1y\xb2\xf0\xca\xb4\xc3m\x0c,\xec\x0c\x0c\xc2\x1b\x99" +
+	"/10\x0aoefgx\xf1\xbf\xb8\xa4(51" +
+	"W/\x99)\xb1 \xaf\xc0*\x18\xcc\x0bJ-.\xcd" +
+	"a,\x09`f\x01\x0c\x00\xc4\xef\x1c\xae"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
@@ -89,3 +108,15 @@ func RegisterSchema(reg *schemas.Registry) {
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_86c366a91393f3f8,
+		Compressed: true,
+	}
+	return s.Request()
+}
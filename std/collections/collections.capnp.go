@@ -0,0 +1,320 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package collections
+
+import (
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+)
+
+// A map from keys to values.
+type Map capnp.Struct
+
+// Map_TypeID is the unique identifier for the type Map.
+const Map_TypeID = 0xe3f5c990a9148c27
+
+func NewMap(s *capnp.Segment) (Map, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Map(st), err
+}
+
+func NewRootMap(s *capnp.Segment) (Map, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Map(st), err
+}
+
+func ReadRootMap(msg *capnp.Message) (Map, error) {
+	root, err := msg.Root()
+	return Map(root.Struct()), err
+}
+
+func (s Map) String() string {
+	str, _ := text.Marshal(0xe3f5c990a9148c27, capnp.Struct(s))
+	return str
+}
+
+func (s Map) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Map) DecodeFromPtr(p capnp.Ptr) Map {
+	return Map(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Map) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Map) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Map) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Map) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// The entries of the map, sorted by key.  No two entries have the
+// same key.
+func (s Map) Entries() (Map_Entry_List, error) {
+	return capnp.GetListField[Map_Entry_List](s, 0, "Map.entries")
+}
+
+func (s Map) HasEntries() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Map) SetEntries(v Map_Entry_List) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewEntries sets the entries field to a newly
+// allocated Map_Entry_List, preferring placement in s's segment.
+func (s Map) NewEntries(n int32) (Map_Entry_List, error) {
+	return capnp.NewListField(s, 0, n, NewMap_Entry_List)
+}
+
+// Map_List is a list of Map.
+type Map_List = capnp.StructList[Map]
+
+// NewMap creates a new list of Map.
+func NewMap_List(s *capnp.Segment, sz int32) (Map_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Map](l), err
+}
+
+// Map_Future is a wrapper for a Map promised by a client call.
+type Map_Future struct{ *capnp.Future }
+
+func (f Map_Future) Struct() (Map, error) {
+	p, err := f.Future.Ptr()
+	return Map(p.Struct()), err
+}
+
+type Map_Entry capnp.Struct
+
+// Map_Entry_TypeID is the unique identifier for the type Map_Entry.
+const Map_Entry_TypeID = 0xd921301a7142ed2a
+
+func NewMap_Entry(s *capnp.Segment) (Map_Entry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Map_Entry(st), err
+}
+
+func NewRootMap_Entry(s *capnp.Segment) (Map_Entry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Map_Entry(st), err
+}
+
+func ReadRootMap_Entry(msg *capnp.Message) (Map_Entry, error) {
+	root, err := msg.Root()
+	return Map_Entry(root.Struct()), err
+}
+
+func (s Map_Entry) String() string {
+	str, _ := text.Marshal(0xd921301a7142ed2a, capnp.Struct(s))
+	return str
+}
+
+func (s Map_Entry) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Map_Entry) DecodeFromPtr(p capnp.Ptr) Map_Entry {
+	return Map_Entry(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Map_Entry) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Map_Entry) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Map_Entry) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Map_Entry) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Map_Entry) Key() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(0, "Map.Entry.key")
+}
+
+func (s Map_Entry) HasKey() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Map_Entry) SetKey(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(0, v)
+}
+func (s Map_Entry) Value() (capnp.Ptr, error) {
+	return capnp.Struct(s).FieldPtr(1, "Map.Entry.value")
+}
+
+func (s Map_Entry) HasValue() bool {
+	return capnp.Struct(s).HasPtr(1)
+}
+
+func (s Map_Entry) SetValue(v capnp.Ptr) error {
+	return capnp.Struct(s).SetPtr(1, v)
+}
+
+// Map_Entry_List is a list of Map_Entry.
+type Map_Entry_List = capnp.StructList[Map_Entry]
+
+// NewMap_Entry creates a new list of Map_Entry.
+func NewMap_Entry_List(s *capnp.Segment, sz int32) (Map_Entry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Map_Entry](l), err
+}
+
+// Map_Entry_Future is a wrapper for a Map_Entry promised by a client call.
+type Map_Entry_Future struct{ *capnp.Future }
+
+func (f Map_Entry_Future) Struct() (Map_Entry, error) {
+	p, err := f.Future.Ptr()
+	return Map_Entry(p.Struct()), err
+}
+func (p Map_Entry_Future) Key() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+func (p Map_Entry_Future) Value() *capnp.Future {
+	return p.Future.Field(1, nil)
+}
+
+// A set of keys.
+type Set capnp.Struct
+
+// Set_TypeID is the unique identifier for the type Set.
+const Set_TypeID = 0xe5cc4805f7aa75ae
+
+func NewSet(s *capnp.Segment) (Set, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Set(st), err
+}
+
+func NewRootSet(s *capnp.Segment) (Set, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Set(st), err
+}
+
+func ReadRootSet(msg *capnp.Message) (Set, error) {
+	root, err := msg.Root()
+	return Set(root.Struct()), err
+}
+
+func (s Set) String() string {
+	str, _ := text.Marshal(0xe5cc4805f7aa75ae, capnp.Struct(s))
+	return str
+}
+
+func (s Set) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Set) DecodeFromPtr(p capnp.Ptr) Set {
+	return Set(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Set) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Set) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Set) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Set) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// The members of the set, sorted.  No key appears more than once.
+func (s Set) Keys() (capnp.PointerList, error) {
+	return capnp.GetListField[capnp.PointerList](s, 0, "Set.keys")
+}
+
+func (s Set) HasKeys() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Set) SetKeys(v capnp.PointerList) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewKeys sets the keys field to a newly
+// allocated capnp.PointerList, preferring placement in s's segment.
+func (s Set) NewKeys(n int32) (capnp.PointerList, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewPointerList)
+}
+
+// Set_List is a list of Set.
+type Set_List = capnp.StructList[Set]
+
+// NewSet creates a new list of Set.
+func NewSet_List(s *capnp.Segment, sz int32) (Set_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Set](l), err
+}
+
+// Set_Future is a wrapper for a Set promised by a client call.
+type Set_Future struct{ *capnp.Future }
+
+func (f Set_Future) Struct() (Set, error) {
+	p, err := f.Future.Ptr()
+	return Set(p.Struct()), err
+}
+
+const schema_d2cffe94eb256489 = "x\xdal\x90AK\x1bA\x18\x86\xbfwv\xa6\xbb=" +
+	"\xa4\xc9\xec\x86\x86\xf6\xb24\xa4\xb4\x0d4\xa4\xa1\x87\xd2" +
+	"K\xd3@h\xa1\x14:\x14<\x1b\xe2\x1cB\xc2f\x93" +
+	"l\x94=y\x16<x\xf0O\xe8\xc1C\xfe\x85 \x88" +
+	"\x82\x07\xaf\x8a^\xc4\xa3xse$\xae\x0b\xe62\x87" +
+	"\xf9\xde\x99\xe7\xf9\xdez\x88&\xff\x92\xfb\xe5\x10S\xcb" +
+	"\xe2ER\xbdn\x8d\xde\xd6\xdf\x9d\x92|\x8d\xe4\xc3f" +
+	"qgk\xff\xe6\x9c\x04\xb3\x0b\xf0^\xf23\x82\x97\xe3" +
+	"k\x94\x19I\x17\xc9\xc6\xca\xfb\xab\xed\xbb\xc3c\x120" +
+	"\xb9\x1e\x9fy#^\"\xf2b\xfe\x83\xc8;\xe1\xa5d" +
+	"o\xba{+~\x1f\\,\xca\x1f\xf1\x19\x99\xd3d\xdf" +
+	"\x88<]&\xdd\xe1`\xa0\xbbQ\x8f\x0d\x83I\xad\xdb" +
+	"\x09\x83\xf0\xfb\xdfNXk\x07v4\x8e\x95cq\"" +
+	"\x0e\"\xf9\xa9L\xa4*\x16T\x9dA\x02E\x98\xcb\xcf" +
+	"\x0d\"\xf5\xd1\x82\xfa\xca`\xf7u\x0c\x17\x19_\"\xb8" +
+	"\x04\x7f\xb53\x98j\xb8\xe2\xf9(\x85\xe3\x11\xee?\xd0" +
+	"\x15\x072\xf5\xa0\xe1\xb7\x03c\xc3S\x9b\\\x8bH9" +
+	"\x16\xd47\x86u\x1dD\xe3\x9e\x9e\xe0\x15\xe1\x9f\x05\x14" +
+	"\x9e\x9e\x125!\xe1+\xce2l\x80\xc8d\x7f:\x90" +
+	"\xa2,E\xc3\xfe\xa3c\x7f\xc9X.\x14\xfa\xaf\xa3," +
+	"\xb9:'W\x18\xf2}\x1d\xa7X\xb3zZ\xfd\x9c\xc0" +
+	"!Q6\xdf\xdf\x0f\x00\x0fE\x84\x97"
+
+func RegisterSchema(reg *schemas.Registry) {
+	reg.Register(&schemas.Schema{
+		String: schema_d2cffe94eb256489,
+		Nodes: []uint64{
+			0xd921301a7142ed2a,
+			0xe3f5c990a9148c27,
+			0xe5cc4805f7aa75ae,
+		},
+		Compressed: true,
+	})
+}
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_d2cffe94eb256489,
+		Compressed: true,
+	}
+	return s.Request()
+}
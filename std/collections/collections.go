@@ -0,0 +1,523 @@
+// Package collections provides the ordered Map and Set schemas, and
+// wrappers that keep their sorted-key invariant:
+//
+//	m := collections.NewOrderedMap[string, capnp.Ptr](mapStruct, collections.TextKeys)
+//	err := m.Put("key", value)
+//	v, ok, err := m.Get("key")
+//
+// Lookups are binary searches over the sorted list.  Adding a key
+// allocates a new list and leaves the old one in the message, so to
+// build a large collection, fill in its list directly and call Sort.
+package collections
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// Keys describes how keys of Go type K are stored in a collection, and
+// how they are ordered.
+type Keys[K any] struct {
+	// Compare returns a negative number, zero or a positive number
+	// as a is less than, equal to or greater than b.
+	Compare func(a, b K) int
+
+	// Decode returns the key stored in p.
+	Decode func(p capnp.Ptr) K
+
+	// Encode stores k in seg.
+	Encode func(seg *capnp.Segment, k K) (capnp.Ptr, error)
+}
+
+// TextKeys describes Text keys, which are ordered by their bytes.
+var TextKeys = Keys[string]{
+	Compare: func(a, b string) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	},
+	Decode: capnp.Ptr.Text,
+	Encode: func(seg *capnp.Segment, k string) (capnp.Ptr, error) {
+		t, err := capnp.NewText(seg, k)
+		return t.ToPtr(), err
+	},
+}
+
+// DataKeys describes Data keys, which are ordered by their bytes.
+var DataKeys = Keys[[]byte]{
+	Compare: bytes.Compare,
+	Decode:  capnp.Ptr.Data,
+	Encode: func(seg *capnp.Segment, k []byte) (capnp.Ptr, error) {
+		d, err := capnp.NewData(seg, k)
+		return d.ToPtr(), err
+	},
+}
+
+// ErrNotSorted is returned by Validate when a collection's list is not
+// sorted, or has duplicate keys.
+var ErrNotSorted = errors.New("collection is not sorted by key")
+
+// An OrderedMap provides access to a Map whose keys have Go type K and
+// whose values have Go type V.
+type OrderedMap[K any, V capnp.TypeParam[V]] struct {
+	m    Map
+	keys Keys[K]
+}
+
+// NewOrderedMap returns an OrderedMap that accesses m.  It assumes that
+// m's entries are sorted; call Validate or Sort first if m came from an
+// untrusted peer.
+func NewOrderedMap[K any, V capnp.TypeParam[V]](m Map, keys Keys[K]) OrderedMap[K, V] {
+	return OrderedMap[K, V]{m: m, keys: keys}
+}
+
+// Map returns the underlying Map.
+func (om OrderedMap[K, V]) Map() Map {
+	return om.m
+}
+
+// Len returns the number of entries in the map.
+func (om OrderedMap[K, V]) Len() (int, error) {
+	entries, err := om.m.Entries()
+	return entries.Len(), err
+}
+
+// search returns the map's entries and the index of the first entry
+// whose key is not less than k, and whether its key is k.
+func (om OrderedMap[K, V]) search(k K) (Map_Entry_List, int, bool, error) {
+	entries, err := om.m.Entries()
+	if err != nil {
+		return entries, 0, false, err
+	}
+	i, found, err := search(entries.Len(), k, om.keys, func(i int) (capnp.Ptr, error) {
+		return entries.At(i).Key()
+	})
+	return entries, i, found, err
+}
+
+// Get returns the value for k, and whether the map has it.
+func (om OrderedMap[K, V]) Get(k K) (V, bool, error) {
+	var v V
+	entries, i, found, err := om.search(k)
+	if err != nil || !found {
+		return v, false, err
+	}
+	p, err := entries.At(i).Value()
+	if err != nil {
+		return v, false, err
+	}
+	return v.DecodeFromPtr(p), true, nil
+}
+
+// Put sets the value for k to v, adding k to the map if it isn't there.
+func (om OrderedMap[K, V]) Put(k K, v V) error {
+	entries, i, found, err := om.search(k)
+	if err != nil {
+		return err
+	}
+	seg := om.m.Segment()
+	if found {
+		return entries.At(i).SetValue(v.EncodeAsPtr(seg))
+	}
+
+	n := entries.Len()
+	grown, err := NewMap_Entry_List(seg, int32(n+1))
+	if err != nil {
+		return err
+	}
+	for j := 0; j < n; j++ {
+		dst := j
+		if j >= i {
+			dst++
+		}
+		if err := moveEntry(grown.At(dst), entries.At(j)); err != nil {
+			return err
+		}
+	}
+	key, err := om.keys.Encode(seg, k)
+	if err != nil {
+		return err
+	}
+	e := grown.At(i)
+	if err := e.SetKey(key); err != nil {
+		return err
+	}
+	if err := e.SetValue(v.EncodeAsPtr(seg)); err != nil {
+		return err
+	}
+	return om.m.SetEntries(grown)
+}
+
+// Delete removes k from the map, and reports whether it was there.
+func (om OrderedMap[K, V]) Delete(k K) (bool, error) {
+	entries, i, found, err := om.search(k)
+	if err != nil || !found {
+		return false, err
+	}
+	n := entries.Len()
+	shrunk, err := NewMap_Entry_List(om.m.Segment(), int32(n-1))
+	if err != nil {
+		return false, err
+	}
+	for j := 0; j < n; j++ {
+		if j == i {
+			continue
+		}
+		dst := j
+		if j > i {
+			dst--
+		}
+		if err := moveEntry(shrunk.At(dst), entries.At(j)); err != nil {
+			return false, err
+		}
+	}
+	return true, om.m.SetEntries(shrunk)
+}
+
+// Range calls f for each entry in the map, in key order, until f
+// returns false.
+func (om OrderedMap[K, V]) Range(f func(k K, v V) bool) error {
+	entries, err := om.m.Entries()
+	if err != nil {
+		return err
+	}
+	var zero V
+	for i := 0; i < entries.Len(); i++ {
+		e := entries.At(i)
+		key, err := e.Key()
+		if err != nil {
+			return err
+		}
+		val, err := e.Value()
+		if err != nil {
+			return err
+		}
+		if !f(om.keys.Decode(key), zero.DecodeFromPtr(val)) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Validate returns ErrNotSorted if the map's entries are not sorted by
+// key, or if two entries have the same key.
+func (om OrderedMap[K, V]) Validate() error {
+	entries, err := om.m.Entries()
+	if err != nil {
+		return err
+	}
+	return validate(entries.Len(), om.keys, func(i int) (capnp.Ptr, error) {
+		return entries.At(i).Key()
+	})
+}
+
+// Sort sorts the map's entries by key.  It returns ErrNotSorted if two
+// entries have the same key.
+func (om OrderedMap[K, V]) Sort() error {
+	entries, err := om.m.Entries()
+	if err != nil {
+		return err
+	}
+	s := &sorter[K]{
+		n:    entries.Len(),
+		keys: om.keys,
+		key: func(i int) (capnp.Ptr, error) {
+			return entries.At(i).Key()
+		},
+		swap: func(i, j int) error {
+			a, b := entries.At(i), entries.At(j)
+			ak, err := a.Key()
+			if err != nil {
+				return err
+			}
+			av, err := a.Value()
+			if err != nil {
+				return err
+			}
+			if err := moveEntry(a, b); err != nil {
+				return err
+			}
+			if err := b.SetKey(ak); err != nil {
+				return err
+			}
+			return b.SetValue(av)
+		},
+	}
+	return s.sort()
+}
+
+// moveEntry points dst's key and value at src's.  Since the entries
+// are in the same message, this doesn't copy the key or value.
+func moveEntry(dst, src Map_Entry) error {
+	key, err := src.Key()
+	if err != nil {
+		return err
+	}
+	val, err := src.Value()
+	if err != nil {
+		return err
+	}
+	if err := dst.SetKey(key); err != nil {
+		return err
+	}
+	return dst.SetValue(val)
+}
+
+// An OrderedSet provides access to a Set whose keys have Go type K.
+type OrderedSet[K any] struct {
+	s    Set
+	keys Keys[K]
+}
+
+// NewOrderedSet returns an OrderedSet that accesses s.  It assumes that
+// s's keys are sorted; call Validate or Sort first if s came from an
+// untrusted peer.
+func NewOrderedSet[K any](s Set, keys Keys[K]) OrderedSet[K] {
+	return OrderedSet[K]{s: s, keys: keys}
+}
+
+// Set returns the underlying Set.
+func (set OrderedSet[K]) Set() Set {
+	return set.s
+}
+
+// Len returns the number of keys in the set.
+func (set OrderedSet[K]) Len() (int, error) {
+	keys, err := set.s.Keys()
+	return keys.Len(), err
+}
+
+func (set OrderedSet[K]) search(k K) (capnp.PointerList, int, bool, error) {
+	keys, err := set.s.Keys()
+	if err != nil {
+		return keys, 0, false, err
+	}
+	i, found, err := search(keys.Len(), k, set.keys, keys.At)
+	return keys, i, found, err
+}
+
+// Has reports whether k is in the set.
+func (set OrderedSet[K]) Has(k K) (bool, error) {
+	_, _, found, err := set.search(k)
+	return found, err
+}
+
+// Put adds k to the set, if it isn't there already.
+func (set OrderedSet[K]) Put(k K) error {
+	keys, i, found, err := set.search(k)
+	if err != nil || found {
+		return err
+	}
+	seg := set.s.Segment()
+	n := keys.Len()
+	grown, err := capnp.NewPointerList(seg, int32(n+1))
+	if err != nil {
+		return err
+	}
+	for j := 0; j < n; j++ {
+		dst := j
+		if j >= i {
+			dst++
+		}
+		p, err := keys.At(j)
+		if err != nil {
+			return err
+		}
+		if err := grown.Set(dst, p); err != nil {
+			return err
+		}
+	}
+	p, err := set.keys.Encode(seg, k)
+	if err != nil {
+		return err
+	}
+	if err := grown.Set(i, p); err != nil {
+		return err
+	}
+	return set.s.SetKeys(grown)
+}
+
+// Delete removes k from the set, and reports whether it was there.
+func (set OrderedSet[K]) Delete(k K) (bool, error) {
+	keys, i, found, err := set.search(k)
+	if err != nil || !found {
+		return false, err
+	}
+	n := keys.Len()
+	shrunk, err := capnp.NewPointerList(set.s.Segment(), int32(n-1))
+	if err != nil {
+		return false, err
+	}
+	for j := 0; j < n; j++ {
+		if j == i {
+			continue
+		}
+		dst := j
+		if j > i {
+			dst--
+		}
+		p, err := keys.At(j)
+		if err != nil {
+			return false, err
+		}
+		if err := shrunk.Set(dst, p); err != nil {
+			return false, err
+		}
+	}
+	return true, set.s.SetKeys(shrunk)
+}
+
+// Range calls f for each key in the set, in order, until f returns
+// false.
+func (set OrderedSet[K]) Range(f func(k K) bool) error {
+	keys, err := set.s.Keys()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < keys.Len(); i++ {
+		p, err := keys.At(i)
+		if err != nil {
+			return err
+		}
+		if !f(set.keys.Decode(p)) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Validate returns ErrNotSorted if the set's keys are not sorted, or if
+// a key appears more than once.
+func (set OrderedSet[K]) Validate() error {
+	keys, err := set.s.Keys()
+	if err != nil {
+		return err
+	}
+	return validate(keys.Len(), set.keys, keys.At)
+}
+
+// Sort sorts the set's keys.  It returns ErrNotSorted if a key appears
+// more than once.
+func (set OrderedSet[K]) Sort() error {
+	keys, err := set.s.Keys()
+	if err != nil {
+		return err
+	}
+	s := &sorter[K]{
+		n:    keys.Len(),
+		keys: set.keys,
+		key:  keys.At,
+		swap: func(i, j int) error {
+			a, err := keys.At(i)
+			if err != nil {
+				return err
+			}
+			b, err := keys.At(j)
+			if err != nil {
+				return err
+			}
+			if err := keys.Set(i, b); err != nil {
+				return err
+			}
+			return keys.Set(j, a)
+		},
+	}
+	return s.sort()
+}
+
+// search returns the index of the first of n sorted keys that is not
+// less than k, and whether it is k.
+func search[K any](n int, k K, keys Keys[K], key func(int) (capnp.Ptr, error)) (int, bool, error) {
+	var err error
+	i := sort.Search(n, func(i int) bool {
+		p, e := key(i)
+		if e != nil {
+			if err == nil {
+				err = e
+			}
+			return true
+		}
+		return keys.Compare(keys.Decode(p), k) >= 0
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if i == n {
+		return i, false, nil
+	}
+	p, err := key(i)
+	if err != nil {
+		return 0, false, err
+	}
+	return i, keys.Compare(keys.Decode(p), k) == 0, nil
+}
+
+func validate[K any](n int, keys Keys[K], key func(int) (capnp.Ptr, error)) error {
+	var prev K
+	for i := 0; i < n; i++ {
+		p, err := key(i)
+		if err != nil {
+			return err
+		}
+		k := keys.Decode(p)
+		if i > 0 && keys.Compare(prev, k) >= 0 {
+			return ErrNotSorted
+		}
+		prev = k
+	}
+	return nil
+}
+
+// sorter implements sort.Interface for a collection's list, keeping
+// the first error that it encounters.
+type sorter[K any] struct {
+	n    int
+	keys Keys[K]
+	key  func(int) (capnp.Ptr, error)
+	swap func(i, j int) error
+	err  error
+}
+
+func (s *sorter[K]) sort() error {
+	sort.Sort(s)
+	if s.err != nil {
+		return s.err
+	}
+	return validate(s.n, s.keys, s.key)
+}
+
+func (s *sorter[K]) Len() int { return s.n }
+
+func (s *sorter[K]) Less(i, j int) bool {
+	a, err := s.key(i)
+	if err != nil {
+		s.fail(err)
+		return false
+	}
+	b, err := s.key(j)
+	if err != nil {
+		s.fail(err)
+		return false
+	}
+	return s.keys.Compare(s.keys.Decode(a), s.keys.Decode(b)) < 0
+}
+
+func (s *sorter[K]) Swap(i, j int) {
+	if err := s.swap(i, j); err != nil {
+		s.fail(err)
+	}
+}
+
+func (s *sorter[K]) fail(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
@@ -0,0 +1,138 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/std/collections"
+)
+
+func newMap(t *testing.T) (collections.OrderedMap[string, capnp.Ptr], *capnp.Segment) {
+	t.Helper()
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	m, err := collections.NewRootMap(seg)
+	require.NoError(t, err)
+	return collections.NewOrderedMap[string, capnp.Ptr](m, collections.TextKeys), seg
+}
+
+func textPtr(t *testing.T, seg *capnp.Segment, s string) capnp.Ptr {
+	t.Helper()
+	p, err := collections.TextKeys.Encode(seg, s)
+	require.NoError(t, err)
+	return p
+}
+
+func mapEntries(t *testing.T, m collections.OrderedMap[string, capnp.Ptr]) []string {
+	t.Helper()
+	var got []string
+	require.NoError(t, m.Range(func(k string, v capnp.Ptr) bool {
+		got = append(got, k+"="+v.Text())
+		return true
+	}))
+	return got
+}
+
+func TestOrderedMap(t *testing.T) {
+	t.Parallel()
+
+	m, seg := newMap(t)
+	for _, k := range []string{"b", "d", "a", "c"} {
+		require.NoError(t, m.Put(k, textPtr(t, seg, k+k)))
+	}
+	require.NoError(t, m.Put("c", textPtr(t, seg, "C")))
+	assert.Equal(t, []string{"a=aa", "b=bb", "c=C", "d=dd"}, mapEntries(t, m))
+	assert.NoError(t, m.Validate())
+
+	v, ok, err := m.Get("b")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "bb", v.Text())
+	_, ok, err = m.Get("bb")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = m.Delete("a")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = m.Delete("a")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	n, err := m.Len()
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	var first []string
+	require.NoError(t, m.Range(func(k string, _ capnp.Ptr) bool {
+		first = append(first, k)
+		return false
+	}))
+	assert.Equal(t, []string{"b"}, first, "Range should stop when f returns false")
+}
+
+func TestOrderedMapSort(t *testing.T) {
+	t.Parallel()
+
+	m, seg := newMap(t)
+	entries, err := m.Map().NewEntries(4)
+	require.NoError(t, err)
+	for i, k := range []string{"d", "b", "a", "c"} {
+		e := entries.At(i)
+		require.NoError(t, e.SetKey(textPtr(t, seg, k)))
+		require.NoError(t, e.SetValue(textPtr(t, seg, k+k)))
+	}
+	assert.ErrorIs(t, m.Validate(), collections.ErrNotSorted)
+
+	require.NoError(t, m.Sort())
+	assert.Equal(t, []string{"a=aa", "b=bb", "c=cc", "d=dd"}, mapEntries(t, m))
+	assert.NoError(t, m.Validate())
+
+	require.NoError(t, entries.At(1).SetKey(textPtr(t, seg, "a")))
+	assert.ErrorIs(t, m.Sort(), collections.ErrNotSorted, "duplicate keys")
+}
+
+func TestOrderedSet(t *testing.T) {
+	t.Parallel()
+
+	_, seg := capnp.NewSingleSegmentMessage(nil)
+	s, err := collections.NewRootSet(seg)
+	require.NoError(t, err)
+	set := collections.NewOrderedSet(s, collections.DataKeys)
+
+	for _, k := range []string{"\x02", "\x00", "\x01\x00", "\x01", "\x00"} {
+		require.NoError(t, set.Put([]byte(k)))
+	}
+	var got []string
+	require.NoError(t, set.Range(func(k []byte) bool {
+		got = append(got, string(k))
+		return true
+	}))
+	assert.Equal(t, []string{"\x00", "\x01", "\x01\x00", "\x02"}, got)
+
+	ok, err := set.Has([]byte("\x01\x00"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = set.Delete([]byte("\x01"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = set.Has([]byte("\x01"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	keys, err := s.Keys()
+	require.NoError(t, err)
+	a, err := keys.At(0)
+	require.NoError(t, err)
+	b, err := keys.At(2)
+	require.NoError(t, err)
+	require.NoError(t, keys.Set(0, b))
+	require.NoError(t, keys.Set(2, a))
+	assert.ErrorIs(t, set.Validate(), collections.ErrNotSorted)
+	require.NoError(t, set.Sort())
+	assert.NoError(t, set.Validate())
+	n, err := set.Len()
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+}
@@ -0,0 +1,13 @@
+// Package std holds the standard Cap'n Proto schemas, annotated for
+// use with Go, along with the Go packages generated from them.
+package std
+
+import "embed"
+
+// Schemas holds the schema files in this directory, with the same
+// paths as they have here: "go.capnp", "capnp/schema.capnp", and so
+// on.  It can be used as an import path when compiling schemas, so
+// that imports like "/go.capnp" resolve without a system installation.
+//
+//go:embed go.capnp bytestream.capnp collections.capnp gateway.capnp introspect.capnp paginator.capnp capnp/*.capnp capnp/compat/*.capnp
+var Schemas embed.FS
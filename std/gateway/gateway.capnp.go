@@ -0,0 +1,601 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package gateway
+
+import (
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	fc "capnproto.org/go/capnp/v3/flowcontrol"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	context "context"
+)
+
+// The bootstrap interface of a vat that serves named capabilities.
+type Gateway capnp.Client
+
+// Gateway_TypeID is the unique identifier for the type Gateway.
+const Gateway_TypeID = 0xc2fcc91c0fee91d8
+
+// Returns the capability with the given name.  Fails if the gateway
+// has no capability with that name.  The empty name is the vat's
+// default capability, if it has one.
+func (c Gateway) Restore(ctx context.Context, params func(Gateway_restore_Params) error) (Gateway_restore_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc2fcc91c0fee91d8,
+			MethodID:      0,
+			InterfaceName: "gateway.capnp:Gateway",
+			MethodName:    "restore",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Gateway_restore_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Gateway_restore_Results_Future{Future: ans.Future()}, release
+
+}
+
+// Returns the names of the gateway's capabilities, in sorted order.
+func (c Gateway) List(ctx context.Context, params func(Gateway_list_Params) error) (Gateway_list_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc2fcc91c0fee91d8,
+			MethodID:      1,
+			InterfaceName: "gateway.capnp:Gateway",
+			MethodName:    "list",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Gateway_list_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Gateway_list_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Gateway) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Gateway) String() string {
+	return "Gateway(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Gateway) AddRef() Gateway {
+	return Gateway(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Gateway) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Gateway) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Gateway) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Gateway) DecodeFromPtr(p capnp.Ptr) Gateway {
+	return Gateway(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Gateway) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Gateway) IsSame(other Gateway) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Gateway) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Gateway) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Gateway_Server is a Gateway with a local implementation.
+type Gateway_Server interface {
+	// Returns the capability with the given name.  Fails if the gateway
+	// has no capability with that name.  The empty name is the vat's
+	// default capability, if it has one.
+	Restore(context.Context, Gateway_restore) error
+	// Returns the names of the gateway's capabilities, in sorted order.
+	List(context.Context, Gateway_list) error
+}
+
+// Gateway_NewServer creates a new Server from an implementation of Gateway_Server.
+func Gateway_NewServer(s Gateway_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Gateway_Methods(nil, s), s, c)
+}
+
+// Gateway_ServerToClient creates a new Client from an implementation of Gateway_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Gateway_ServerToClient(s Gateway_Server) Gateway {
+	return Gateway(capnp.NewClient(Gateway_NewServer(s)))
+}
+
+// Gateway_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Gateway_Methods(methods []server.Method, s Gateway_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc2fcc91c0fee91d8,
+			MethodID:      0,
+			InterfaceName: "gateway.capnp:Gateway",
+			MethodName:    "restore",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Restore(ctx, Gateway_restore{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc2fcc91c0fee91d8,
+			MethodID:      1,
+			InterfaceName: "gateway.capnp:Gateway",
+			MethodName:    "list",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.List(ctx, Gateway_list{call})
+		},
+	})
+
+	return methods
+}
+
+// Gateway_restore holds the state for a server call to Gateway.restore.
+// See server.Call for documentation.
+type Gateway_restore struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Gateway_restore) Args() Gateway_restore_Params {
+	return Gateway_restore_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Gateway_restore) AllocResults() (Gateway_restore_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_restore_Results(r), err
+}
+
+// Gateway_list holds the state for a server call to Gateway.list.
+// See server.Call for documentation.
+type Gateway_list struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Gateway_list) Args() Gateway_list_Params {
+	return Gateway_list_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Gateway_list) AllocResults() (Gateway_list_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_list_Results(r), err
+}
+
+// Gateway_List is a list of Gateway.
+type Gateway_List = capnp.CapList[Gateway]
+
+// NewGateway_List creates a new list of Gateway.
+func NewGateway_List(s *capnp.Segment, sz int32) (Gateway_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Gateway](l), err
+}
+
+type Gateway_list_Params capnp.Struct
+
+// Gateway_list_Params_TypeID is the unique identifier for the type Gateway_list_Params.
+const Gateway_list_Params_TypeID = 0xc4697ad4e937053c
+
+func NewGateway_list_Params(s *capnp.Segment) (Gateway_list_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Gateway_list_Params(st), err
+}
+
+func NewRootGateway_list_Params(s *capnp.Segment) (Gateway_list_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Gateway_list_Params(st), err
+}
+
+func ReadRootGateway_list_Params(msg *capnp.Message) (Gateway_list_Params, error) {
+	root, err := msg.Root()
+	return Gateway_list_Params(root.Struct()), err
+}
+
+func (s Gateway_list_Params) String() string {
+	str, _ := text.Marshal(0xc4697ad4e937053c, capnp.Struct(s))
+	return str
+}
+
+func (s Gateway_list_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Gateway_list_Params) DecodeFromPtr(p capnp.Ptr) Gateway_list_Params {
+	return Gateway_list_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Gateway_list_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Gateway_list_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Gateway_list_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Gateway_list_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Gateway_list_Params_List is a list of Gateway_list_Params.
+type Gateway_list_Params_List = capnp.StructList[Gateway_list_Params]
+
+// NewGateway_list_Params creates a new list of Gateway_list_Params.
+func NewGateway_list_Params_List(s *capnp.Segment, sz int32) (Gateway_list_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Gateway_list_Params](l), err
+}
+
+// Gateway_list_Params_Future is a wrapper for a Gateway_list_Params promised by a client call.
+type Gateway_list_Params_Future struct{ *capnp.Future }
+
+func (f Gateway_list_Params_Future) Struct() (Gateway_list_Params, error) {
+	p, err := f.Future.Ptr()
+	return Gateway_list_Params(p.Struct()), err
+}
+
+type Gateway_list_Results capnp.Struct
+
+// Gateway_list_Results_TypeID is the unique identifier for the type Gateway_list_Results.
+const Gateway_list_Results_TypeID = 0xa3d3502ecddc4767
+
+func NewGateway_list_Results(s *capnp.Segment) (Gateway_list_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_list_Results(st), err
+}
+
+func NewRootGateway_list_Results(s *capnp.Segment) (Gateway_list_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_list_Results(st), err
+}
+
+func ReadRootGateway_list_Results(msg *capnp.Message) (Gateway_list_Results, error) {
+	root, err := msg.Root()
+	return Gateway_list_Results(root.Struct()), err
+}
+
+func (s Gateway_list_Results) String() string {
+	str, _ := text.Marshal(0xa3d3502ecddc4767, capnp.Struct(s))
+	return str
+}
+
+func (s Gateway_list_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Gateway_list_Results) DecodeFromPtr(p capnp.Ptr) Gateway_list_Results {
+	return Gateway_list_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Gateway_list_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Gateway_list_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Gateway_list_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Gateway_list_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Gateway_list_Results) Names() (capnp.TextList, error) {
+	return capnp.GetListField[capnp.TextList](s, 0, "Gateway.list$Results.names")
+}
+
+func (s Gateway_list_Results) HasNames() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Gateway_list_Results) SetNames(v capnp.TextList) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewNames sets the names field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Gateway_list_Results) NewNames(n int32) (capnp.TextList, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewTextList)
+}
+
+// SetNamesFromSlice sets the names field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Gateway_list_Results) SetNamesFromSlice(v []string) error {
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewTextListFromSlice)
+}
+
+// Gateway_list_Results_List is a list of Gateway_list_Results.
+type Gateway_list_Results_List = capnp.StructList[Gateway_list_Results]
+
+// NewGateway_list_Results creates a new list of Gateway_list_Results.
+func NewGateway_list_Results_List(s *capnp.Segment, sz int32) (Gateway_list_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Gateway_list_Results](l), err
+}
+
+// Gateway_list_Results_Future is a wrapper for a Gateway_list_Results promised by a client call.
+type Gateway_list_Results_Future struct{ *capnp.Future }
+
+func (f Gateway_list_Results_Future) Struct() (Gateway_list_Results, error) {
+	p, err := f.Future.Ptr()
+	return Gateway_list_Results(p.Struct()), err
+}
+
+type Gateway_restore_Params capnp.Struct
+
+// Gateway_restore_Params_TypeID is the unique identifier for the type Gateway_restore_Params.
+const Gateway_restore_Params_TypeID = 0xc65b3e2d75ed7c72
+
+func NewGateway_restore_Params(s *capnp.Segment) (Gateway_restore_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_restore_Params(st), err
+}
+
+func NewRootGateway_restore_Params(s *capnp.Segment) (Gateway_restore_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_restore_Params(st), err
+}
+
+func ReadRootGateway_restore_Params(msg *capnp.Message) (Gateway_restore_Params, error) {
+	root, err := msg.Root()
+	return Gateway_restore_Params(root.Struct()), err
+}
+
+func (s Gateway_restore_Params) String() string {
+	str, _ := text.Marshal(0xc65b3e2d75ed7c72, capnp.Struct(s))
+	return str
+}
+
+func (s Gateway_restore_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Gateway_restore_Params) DecodeFromPtr(p capnp.Ptr) Gateway_restore_Params {
+	return Gateway_restore_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Gateway_restore_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Gateway_restore_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Gateway_restore_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Gateway_restore_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Gateway_restore_Params) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "Gateway.restore$Params.name")
+}
+
+func (s Gateway_restore_Params) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Gateway_restore_Params) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Gateway.restore$Params.name")
+}
+
+func (s Gateway_restore_Params) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+// Gateway_restore_Params_List is a list of Gateway_restore_Params.
+type Gateway_restore_Params_List = capnp.StructList[Gateway_restore_Params]
+
+// NewGateway_restore_Params creates a new list of Gateway_restore_Params.
+func NewGateway_restore_Params_List(s *capnp.Segment, sz int32) (Gateway_restore_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Gateway_restore_Params](l), err
+}
+
+// Gateway_restore_Params_Future is a wrapper for a Gateway_restore_Params promised by a client call.
+type Gateway_restore_Params_Future struct{ *capnp.Future }
+
+func (f Gateway_restore_Params_Future) Struct() (Gateway_restore_Params, error) {
+	p, err := f.Future.Ptr()
+	return Gateway_restore_Params(p.Struct()), err
+}
+
+type Gateway_restore_Results capnp.Struct
+
+// Gateway_restore_Results_TypeID is the unique identifier for the type Gateway_restore_Results.
+const Gateway_restore_Results_TypeID = 0xd57a4fbe9b85a2e6
+
+func NewGateway_restore_Results(s *capnp.Segment) (Gateway_restore_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_restore_Results(st), err
+}
+
+func NewRootGateway_restore_Results(s *capnp.Segment) (Gateway_restore_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Gateway_restore_Results(st), err
+}
+
+func ReadRootGateway_restore_Results(msg *capnp.Message) (Gateway_restore_Results, error) {
+	root, err := msg.Root()
+	return Gateway_restore_Results(root.Struct()), err
+}
+
+func (s Gateway_restore_Results) String() string {
+	str, _ := text.Marshal(0xd57a4fbe9b85a2e6, capnp.Struct(s))
+	return str
+}
+
+func (s Gateway_restore_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Gateway_restore_Results) DecodeFromPtr(p capnp.Ptr) Gateway_restore_Results {
+	return Gateway_restore_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Gateway_restore_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Gateway_restore_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Gateway_restore_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Gateway_restore_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Gateway_restore_Results) Cap() capnp.Client {
+	p, _ := capnp.Struct(s).Ptr(0)
+	return p.Interface().Client()
+}
+
+func (s Gateway_restore_Results) HasCap() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Gateway_restore_Results) SetCap(c capnp.Client) error {
+	if !c.IsValid() {
+		return capnp.Struct(s).SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().CapTable().Add(c))
+	return capnp.Struct(s).SetPtr(0, in.ToPtr())
+}
+
+// Gateway_restore_Results_List is a list of Gateway_restore_Results.
+type Gateway_restore_Results_List = capnp.StructList[Gateway_restore_Results]
+
+// NewGateway_restore_Results creates a new list of Gateway_restore_Results.
+func NewGateway_restore_Results_List(s *capnp.Segment, sz int32) (Gateway_restore_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Gateway_restore_Results](l), err
+}
+
+// Gateway_restore_Results_Future is a wrapper for a Gateway_restore_Results promised by a client call.
+type Gateway_restore_Results_Future struct{ *capnp.Future }
+
+func (f Gateway_restore_Results_Future) Struct() (Gateway_restore_Results, error) {
+	p, err := f.Future.Ptr()
+	return Gateway_restore_Results(p.Struct()), err
+}
+func (p Gateway_restore_Results_Future) Cap() capnp.Client {
+	return p.Future.Field(0, nil).Client()
+}
+
+const schema_f9c75be821ea10b0 = "x\xda\x84\x90\xb1K\xebP\x14\xc6\xbf\x93{\xf3\xd2\xa1" +
+	"m^\xda\xf7\xde\xf0\x10\x8a\xd2\xa9`\xb1\xed \x88X" +
+	"q\xe9h\xd2\xb5\xd3\xa5\x84ZhkIR\xc4\"\xb8" +
+	"(\xce\x8e\xe2\xa6\xff\x80\x08\x8e:\x88(\x1d\x1cD\x05" +
+	"\x07\x1d\x1c\x94*\xe8*\x82\x10Ilk;\xa8K\xc8" +
+	"\xb9|\xfc\xce\xf7;c+4\xcdS\xa1\x97\x00$c" +
+	"N\xfe\xe5\x96r\xd7\xa7I\xfd|\x1b\xda?\x02dR" +
+	"\x80\x8c\xe0\xff\x09\x14-\xf3,\xc8\xbdZ\x7fV\x87Z" +
+	"o\x87\xd0\xc2\xcc\xdd\xf9\xfd8\xdc.\x9c\xbc\x02\x14\xdd" +
+	"\xe0\xbb\xfew-z\xcb\x15\xc0\x9d\x94\xc7\x1f.\x9a\xe5" +
+	"\xa3\x0f\x92\xf7\x94i\xf1\x88\x07:\xe3\x0a\xc8\xb5\x96\x9e" +
+	"\x1a\xa3S\x85\xe3\xfeM{<\xe1\x05\xf6\xfdM\xf7[" +
+	"\xab\x9b\x07\xb3\xcd\xcb\xfe\xc0\x0dO{\x81;\x9eE\xdb" +
+	"-\x09\xc7\\\x10\x8bIV\x14\xf5Z}\"\xd7\x19+" +
+	"e\xdb\x89\xe7M\xbbQa\x8emp\xc6\x01N\x80\x16" +
+	"J\x03F\x80\x91\x11\x97(V\x13U\xd3\xa60Hg" +
+	"DAH\xdeo\x0fH]`\xcc\x9f\x8d\x00\x93\x81^" +
+	"a\xea\x16\xd3R3`D=S\xea\x1eO\xfb\x9b\x00" +
+	"[\xb6L\xdb\x99\xb7L\xd5\xeb\xa3\x13}[W\x17\x96" +
+	"\xa8\x92\xad3\xfeU\xacC\x8b\xebB\xb5Du\xc0+" +
+	"\xd1\xf1\xfa#\x91\xeay\xf9>A\xd0O\xa8\xbc\x19\xb3" +
+	"\x1b\x95\xc1\x1b\x8d|\xb2\x94\xa2\xa8S\x843\x10E@" +
+	"\xef\x03\x00y\xb4\x99\xf7"
+
+func RegisterSchema(reg *schemas.Registry) {
+	reg.Register(&schemas.Schema{
+		String: schema_f9c75be821ea10b0,
+		Nodes: []uint64{
+			0xa3d3502ecddc4767,
+			0xc2fcc91c0fee91d8,
+			0xc4697ad4e937053c,
+			0xc65b3e2d75ed7c72,
+			0xd57a4fbe9b85a2e6,
+		},
+		Compressed: true,
+	})
+}
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_f9c75be821ea10b0,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -0,0 +1,129 @@
+// Package gateway serves several capabilities from one bootstrap
+// interface, each under a name, using the Gateway interface.
+//
+// The server adds its capabilities to a Registry and serves it as the
+// bootstrap capability of its connections:
+//
+//	var reg gateway.Registry
+//	reg.Add("users", capnp.Client(users))
+//	reg.Add("billing", capnp.Client(billing))
+//	opts := &rpc.Options{BootstrapClient: capnp.Client(reg.Client())}
+//
+// and the client restores them by name:
+//
+//	users := gateway.Restore(ctx, gateway.Gateway(conn.Bootstrap(ctx)), "users")
+//	defer users.Release()
+//
+// rpc.Options.Bootstraps and rpc.Conn.BootstrapNamed do the same for a
+// fixed set of capabilities.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// A Registry is a Gateway_Server that maps names to capabilities.  The
+// zero value is an empty registry.  It is safe to use from multiple
+// goroutines, and capabilities may be added and removed while it is
+// being served.
+type Registry struct {
+	mu   sync.Mutex
+	caps map[string]capnp.Client
+}
+
+// Add serves c under name, replacing and releasing any capability that
+// was served under it before.  Add steals the reference to c.
+func (r *Registry) Add(name string, c capnp.Client) {
+	r.mu.Lock()
+	if r.caps == nil {
+		r.caps = make(map[string]capnp.Client)
+	}
+	old := r.caps[name]
+	r.caps[name] = c
+	r.mu.Unlock()
+	old.Release()
+}
+
+// Remove stops serving the capability named name and releases it.
+// Clients that have already restored it keep their references.
+func (r *Registry) Remove(name string) {
+	r.mu.Lock()
+	c := r.caps[name]
+	delete(r.caps, name)
+	r.mu.Unlock()
+	c.Release()
+}
+
+// Client returns a Gateway that serves r.  The registry's
+// capabilities are released when the returned client and all the
+// references to it are released.
+func (r *Registry) Client() Gateway {
+	return Gateway_ServerToClient(r)
+}
+
+func (r *Registry) Restore(ctx context.Context, call Gateway_restore) error {
+	name, err := call.Args().Name()
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	c, ok := r.caps[name]
+	if ok {
+		c = c.AddRef()
+	}
+	r.mu.Unlock()
+	if !ok {
+		return errors.New("gateway: no capability named " + strconv.Quote(name))
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		c.Release()
+		return err
+	}
+	return res.SetCap(c)
+}
+
+func (r *Registry) List(ctx context.Context, call Gateway_list) error {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.caps))
+	for name := range r.caps {
+		names = append(names, name)
+	}
+	r.mu.Unlock()
+	sort.Strings(names)
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetNamesFromSlice(names)
+}
+
+// Shutdown releases the registry's capabilities.  It is called when
+// the client returned by Client is released.
+func (r *Registry) Shutdown() {
+	r.mu.Lock()
+	caps := r.caps
+	r.caps = nil
+	r.mu.Unlock()
+	for _, c := range caps {
+		c.Release()
+	}
+}
+
+// Restore returns the capability named name from g.  The capability is
+// a promise that resolves when the call returns, so calls on it can be
+// made without waiting for the round trip.  If g has no capability
+// with that name, calls on it fail.  The caller must release it.
+func Restore(ctx context.Context, g Gateway, name string) capnp.Client {
+	f, release := g.Restore(ctx, func(p Gateway_restore_Params) error {
+		return p.SetName(name)
+	})
+	defer release()
+	return f.Cap().AddRef()
+}
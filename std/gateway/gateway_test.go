@@ -0,0 +1,83 @@
+package gateway_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/std/gateway"
+)
+
+// remote serves g over an RPC connection and returns the client's view
+// of it.
+func remote(t *testing.T, g gateway.Gateway) gateway.Gateway {
+	t.Helper()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(g),
+	})
+	t.Cleanup(func() { serverConn.Close() })
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+	t.Cleanup(func() { clientConn.Close() })
+	client := gateway.Gateway(clientConn.Bootstrap(context.Background()))
+	t.Cleanup(client.Release)
+	return client
+}
+
+// names returns the names that g lists.
+func names(t *testing.T, g gateway.Gateway) []string {
+	t.Helper()
+	f, release := g.List(context.Background(), nil)
+	defer release()
+	res, err := f.Struct()
+	require.NoError(t, err)
+	list, err := res.Names()
+	require.NoError(t, err)
+	names := make([]string, list.Len())
+	for i := range names {
+		names[i], err = list.At(i)
+		require.NoError(t, err)
+	}
+	return names
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// The registries served by the registry under test list their own
+	// names, so that the test can tell them apart.
+	var inner [2]gateway.Registry
+	inner[0].Add("in-a", capnp.Client{})
+	inner[1].Add("in-b", capnp.Client{})
+	var reg gateway.Registry
+	reg.Add("b", capnp.Client(inner[1].Client()))
+	reg.Add("a", capnp.Client(inner[0].Client()))
+	g := remote(t, reg.Client())
+
+	assert.Equal(t, []string{"a", "b"}, names(t, g))
+
+	restore := func(name string) gateway.Gateway {
+		c := gateway.Gateway(gateway.Restore(ctx, g, name))
+		t.Cleanup(c.Release)
+		return c
+	}
+	assert.Equal(t, []string{"in-a"}, names(t, restore("a")))
+
+	f, release := restore("c").List(ctx, nil)
+	defer release()
+	_, err := f.Struct()
+	assert.ErrorContains(t, err, `no capability named "c"`)
+
+	// Capabilities can change while the registry is served.
+	reg.Remove("b")
+	reg.Add("a", capnp.Client(new(gateway.Registry).Client()))
+	assert.Equal(t, []string{"a"}, names(t, g))
+	assert.Empty(t, names(t, restore("a")), "Add replaces the capability")
+}
@@ -6,45 +6,102 @@ import (
 	schemas "capnproto.org/go/capnp/v3/schemas"
 )
 
-const Package_ = uint64(0xbea97f1023792be0)
-const Import_ = uint64(0xe130b601260e44b5)
-const Doc_ = uint64(0xc58ad6bd519f935e)
-const Tag_ = uint64(0xa574b41924caefc7)
-const Notag_ = uint64(0xc8768679ec52e012)
 const Customtype_ = uint64(0xfa10659ae02f2093)
+const Deprecated_ = uint64(0xc52416aab2dee380)
+const Doc_ = uint64(0xc58ad6bd519f935e)
+const EmbedSchema_ = uint64(0xf05d3e886c7e6c99)
+const Flatten_ = uint64(0xa1f4c5658297aab9)
+const GetterPrefix_ = uint64(0xbdc942455af8bdea)
+const Idempotent_ = uint64(0xc5c67716e14c947e)
+const ImportAlias_ = uint64(0xbbf01c906ac1209d)
+const Import_ = uint64(0xe130b601260e44b5)
+const Max_ = uint64(0xe1f93203db42ac8b)
+const Min_ = uint64(0xeef9cfe81ddeca5e)
 const Name_ = uint64(0xc2b96012172f8df1)
-const schema_d12a1c51fedd6c88 = "x\xda\x12\x98\xe8\xc0b\xc8{\x9c\x89\x81)P\x81\x95" +
-	"\xed\xff\xf1\xf7\xa7T$\xb7\x94,e\x08\xe4d\xe5\xf8" +
-	"\xdf\x91s\xf7_\xa0\x8c\xd6E\x06\x06FaO\xc6," +
-	"a_Fv\x06\x86`\x0fFfF\x06\xc6\xff\x0f\xb4" +
-	"+\x95\x05\xeaW\xee\x03)eDQj\xc9\xd8%\xec" +
-	"\x08Vj\x03Q\xfa\xb1W_\\(a\xe7!\x86\x8b" +
-	"\x9c\xac\xff\xf8Q\xd4\xea2\x16\x09\x1b\x82\xd5\xea@\xd4" +
-	"\xc6M\x9e\x1f\xb8\xf7Z\xd7Q\x90\xb1&(Je\x19" +
-	"\xb3\x84\x15\xc1Je J\x85\x1e\x04\xbd\xa9l+;" +
-	"\x81\xe9X^\xc6*aA\xb0R\x1e\x88\xd2\xad.|" +
-	"j\x8c\xdb\x0c\x1eb8V\xf0o\x930#He\xe0" +
-	"\x1f\xb0\xc2\xc9\x0a\xfa\x0ff\xa5\x0a\xfc\x02)T@Q" +
-	"\xf8v\x91\xe0W\x90\xba\x0f\xcc\x8c\x0c<\xff\xd3\xf3\xf5" +
-	"\x92\x13\x0b\xf2\x0a\x18\xf8\xadJ\x12\xd3\x03\x18\x19\x19y" +
-	"\x18\x98\xe0\xa2\x8cV\x05\x89\xc9\xd9\x89\xe9\xa9\x0c\x0c\xe8" +
-	"R\x0c\xf2Vy\x89\xb9\xa9\x18\xc2\xfcV)\xf9\xc9\x18" +
-	"\xa2\xf6Vy\xf9\x10\xf3\x19\x98\x91L\xcf\xcc-\xc8/" +
-	"*a\xc0\xb46\xb9\xb4\xb8$?\xb7\x84\xbd\xb2\x00f" +
-	"\x03 \x00\x00\xff\xff\xb5C\x8e\x03"
+const Notag_ = uint64(0xc8768679ec52e012)
+const Package_ = uint64(0xbea97f1023792be0)
+const Regex_ = uint64(0xa6bc2151e60649c5)
+const Required_ = uint64(0x8b2455025d97a887)
+const SetterPrefix_ = uint64(0x8ef7184fcd66536e)
+const Tag_ = uint64(0xa574b41924caefc7)
+const Timeout_ = uint64(0x808ecdd9a5393352)
+const schema_d12a1c51fedd6c88 = "x\xda|\xd1]H\x14Q\x14\x07\xf0s\xef\xb8\x9ad" +
+	"\xadX\x98\x82\xe1F\x12Z\x90\xf6\xf1\xa0K\xa5IA" +
+	"\x82\x90\xeb\x10T`9\xed^\xa7\xb1\x9d\x8f\xc6k\xb9" +
+	">\x98\xf8P\xa6$\x95\x81\x15H\x14\x18%\xf6\"\x15" +
+	"H\x1a\x18l\x1f\x82\x94/AD\xb2KP\x11Q\x0a" +
+	"A\xd9\x83\x1b3\xb7\x87\xbds\xa1\xd7s~3\xe7\xfc" +
+	"\xcf\xad8\x8ej2\xb6\xad\xfa\x9c\x098\xd4\xe0\xcbL" +
+	"5\xee\xa8\x1ay7;\xd0\x0ds\xd9\xbe@F\xaa7" +
+	"\xfaa9T\xb4y\x0e\x00\xad9\"\xf5\x01\x92\x0fK" +
+	"\x12\x02\x94\xbapo\xa8\x09\x1f*\xe9\x87P\xb6/\xc0" +
+	"\xb1:\xe9* \xf9\x00c\x86\xdc2{\xb0\xe0\xd7\x80" +
+	"\xc3\x10\xc7\xaa\xa4q@r%c\x13\xa3C=$\xfe" +
+	"\xf3\xb6\xc3j8V\xe6\x0e-e\xec\xc5\x8f\x99\x92\xc2" +
+	"\x87t\xc4a+8V(\xb5\x02\x92\xd72\x16\xaf\xcb" +
+	"\xfc\x14\xda0yW\xdc\xcd'u\x02jdj80" +
+	"\xddz\xb9h\xe1\x89\xb8\xda\"\x1e\x05$/`\x97}" +
+	"\x9d\xfa}t\x7f\xed\xab)\x91%\xb1\x93 \xc1Xb" +
+	"Klc\xee\xb9\xfbOE6\x87\x9d\x04o\x18[\xbc" +
+	"T\xbe.\xafy\xe2\x99s\xdee?\xe7\xa6\xb1\x0dH" +
+	"\x9ed\xae\xfb\xe3\xfc\xf8h~I\xdcq\xbb$\xce=" +
+	"\xc0w\x00\xc9c\xcc\x1d\x1b\xbc\x15\x9az\xdb\x17w\xc6" +
+	"\xee\xe4\xd80v.r\x9d\xb1\xaek\xf5\xc9\xfc\xb3\xcf" +
+	"\xe3p%\xdb\x879v\xd1\xfd[/cy\x89\xc6o" +
+	"\xb1\xf3g^\x8a\xf7\x8d\xe1N@2e\xec\xd1\xbe\xd5" +
+	"\x9b\xd0\xe3\x8a\xa4\x98\x95\xe0\x1e@r3c\xfdc\xb5" +
+	"\xef\xa5\xedKI\xf1\x19B\xeen\xf5\xff\"\xcc\xcc\xaf" +
+	"\xff\xf2z\xe9\xbb\xc8v\xbb\xac\x92\xb1\x1b\xd1\xaeh\xef" +
+	"\x9e\xa6\x05qh\x99\xfb\\\xa5\x8c\x0d\x06\xca\x137I" +
+	"\xee\x1f\xf1o\x85n\xd2\x02\x87\xe5\xa4TskX\xb1" +
+	"\x0c\x0b\x05\xa9\xa6\x13\xb3\x9dBFZ\xcd&\xa7\xdb5" +
+	"\x9b\xa0\x08Hi\xd56B)\xb1\x1b\x8am\xd2\xa2u" +
+	"\xa0\x1c\xc0i\xbd\x96\xa8B)1\x00\xb8:\xf8\x83T" +
+	"Q\xf9Ru\xd0&*\xf1~\xaf\xe9\x96i\xd3\xbd\xfe" +
+	"\xa8\xa6\xb4yZ\xea\x7f\xc6ZJ\xf8\x94\xa2\x12\xef\xd8" +
+	"\xe2\xa0\xa1\xe8\xc4c#\xc4\xb2IX\xc9\xa2$\xe2]" +
+	"2b\x86\xbd\xfbD\x88n\x994\x8b\x184\xed\x06P" +
+	"\x1d4L\xaa\xa8 \x09\xab\x0b\xc1u\xa5\x03\xad\xf4\x94" +
+	"4\x83+\xa1 \xd1O\x90\x88\x1c\xf6\x9f$\xba\x82\x10" +
+	"\xd7\x0a\xb7\xb7QS\xa7Y1\xcb\x0d\xf2w\x00`\xd2" +
+	"\x841"
 
 func RegisterSchema(reg *schemas.Registry) {
 	reg.Register(&schemas.Schema{
 		String: schema_d12a1c51fedd6c88,
 		Nodes: []uint64{
+			0x808ecdd9a5393352,
+			0x8b2455025d97a887,
+			0x8ef7184fcd66536e,
+			0xa1f4c5658297aab9,
 			0xa574b41924caefc7,
+			0xa6bc2151e60649c5,
+			0xbbf01c906ac1209d,
+			0xbdc942455af8bdea,
 			0xbea97f1023792be0,
 			0xc2b96012172f8df1,
+			0xc52416aab2dee380,
 			0xc58ad6bd519f935e,
+			0xc5c67716e14c947e,
 			0xc8768679ec52e012,
 			0xe130b601260e44b5,
+			0xe1f93203db42ac8b,
+			0xeef9cfe81ddeca5e,
+			0xf05d3e886c7e6c99,
 			0xfa10659ae02f2093,
 		},
 		Compressed: true,
 	})
 }
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_d12a1c51fedd6c88,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -0,0 +1,943 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package introspect
+
+import (
+	bytes "bytes"
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	fc "capnproto.org/go/capnp/v3/flowcontrol"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	context "context"
+	io "io"
+)
+
+// Describes the capability that it is called on.  Servers answer it in
+// addition to their own interfaces, so callers make these calls on the
+// capability that they want to know about, cast to Introspection.
+// Servers that do not support it fail the calls as unimplemented.
+type Introspection capnp.Client
+
+// Introspection_TypeID is the unique identifier for the type Introspection.
+const Introspection_TypeID = 0xba473f537717d4fd
+
+// Returns the IDs of the interfaces that the capability implements, in
+// ascending order, including the superclasses of its interfaces and
+// Introspection itself.
+func (c Introspection) ListInterfaces(ctx context.Context, params func(Introspection_listInterfaces_Params) error) (Introspection_listInterfaces_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xba473f537717d4fd,
+			MethodID:      0,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "listInterfaces",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Introspection_listInterfaces_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Introspection_listInterfaces_Results_Future{Future: ans.Future()}, release
+
+}
+
+// Returns the methods of the interface with the given ID that the
+// capability implements, in ascending order of ID.
+func (c Introspection) ListMethods(ctx context.Context, params func(Introspection_listMethods_Params) error) (Introspection_listMethods_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xba473f537717d4fd,
+			MethodID:      1,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "listMethods",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Introspection_listMethods_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Introspection_listMethods_Results_Future{Future: ans.Future()}, release
+
+}
+
+// Returns a CodeGeneratorRequest message, in the standard unpacked
+// framing format, that contains the schema node with the given ID and
+// the nodes in the same file.  Fails if the capability's vat does not
+// have the node's schema.
+func (c Introspection) GetSchema(ctx context.Context, params func(Introspection_getSchema_Params) error) (Introspection_getSchema_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xba473f537717d4fd,
+			MethodID:      2,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "getSchema",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Introspection_getSchema_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Introspection_getSchema_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Introspection) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Introspection) String() string {
+	return "Introspection(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Introspection) AddRef() Introspection {
+	return Introspection(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Introspection) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Introspection) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Introspection) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Introspection) DecodeFromPtr(p capnp.Ptr) Introspection {
+	return Introspection(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Introspection) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Introspection) IsSame(other Introspection) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Introspection) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Introspection) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Introspection_Server is a Introspection with a local implementation.
+type Introspection_Server interface {
+	// Returns the IDs of the interfaces that the capability implements, in
+	// ascending order, including the superclasses of its interfaces and
+	// Introspection itself.
+	ListInterfaces(context.Context, Introspection_listInterfaces) error
+	// Returns the methods of the interface with the given ID that the
+	// capability implements, in ascending order of ID.
+	ListMethods(context.Context, Introspection_listMethods) error
+	// Returns a CodeGeneratorRequest message, in the standard unpacked
+	// framing format, that contains the schema node with the given ID and
+	// the nodes in the same file.  Fails if the capability's vat does not
+	// have the node's schema.
+	GetSchema(context.Context, Introspection_getSchema) error
+}
+
+// Introspection_NewServer creates a new Server from an implementation of Introspection_Server.
+func Introspection_NewServer(s Introspection_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Introspection_Methods(nil, s), s, c)
+}
+
+// Introspection_ServerToClient creates a new Client from an implementation of Introspection_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Introspection_ServerToClient(s Introspection_Server) Introspection {
+	return Introspection(capnp.NewClient(Introspection_NewServer(s)))
+}
+
+// Introspection_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Introspection_Methods(methods []server.Method, s Introspection_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xba473f537717d4fd,
+			MethodID:      0,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "listInterfaces",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ListInterfaces(ctx, Introspection_listInterfaces{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xba473f537717d4fd,
+			MethodID:      1,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "listMethods",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ListMethods(ctx, Introspection_listMethods{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xba473f537717d4fd,
+			MethodID:      2,
+			InterfaceName: "introspect.capnp:Introspection",
+			MethodName:    "getSchema",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetSchema(ctx, Introspection_getSchema{call})
+		},
+	})
+
+	return methods
+}
+
+// Introspection_listInterfaces holds the state for a server call to Introspection.listInterfaces.
+// See server.Call for documentation.
+type Introspection_listInterfaces struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Introspection_listInterfaces) Args() Introspection_listInterfaces_Params {
+	return Introspection_listInterfaces_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Introspection_listInterfaces) AllocResults() (Introspection_listInterfaces_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_listInterfaces_Results(r), err
+}
+
+// Introspection_listMethods holds the state for a server call to Introspection.listMethods.
+// See server.Call for documentation.
+type Introspection_listMethods struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Introspection_listMethods) Args() Introspection_listMethods_Params {
+	return Introspection_listMethods_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Introspection_listMethods) AllocResults() (Introspection_listMethods_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_listMethods_Results(r), err
+}
+
+// Introspection_getSchema holds the state for a server call to Introspection.getSchema.
+// See server.Call for documentation.
+type Introspection_getSchema struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Introspection_getSchema) Args() Introspection_getSchema_Params {
+	return Introspection_getSchema_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Introspection_getSchema) AllocResults() (Introspection_getSchema_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_getSchema_Results(r), err
+}
+
+// Introspection_List is a list of Introspection.
+type Introspection_List = capnp.CapList[Introspection]
+
+// NewIntrospection_List creates a new list of Introspection.
+func NewIntrospection_List(s *capnp.Segment, sz int32) (Introspection_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Introspection](l), err
+}
+
+type Introspection_Method capnp.Struct
+
+// Introspection_Method_TypeID is the unique identifier for the type Introspection_Method.
+const Introspection_Method_TypeID = 0xf94d0ba698028ed9
+
+func NewIntrospection_Method(s *capnp.Segment) (Introspection_Method, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Introspection_Method(st), err
+}
+
+func NewRootIntrospection_Method(s *capnp.Segment) (Introspection_Method, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Introspection_Method(st), err
+}
+
+func ReadRootIntrospection_Method(msg *capnp.Message) (Introspection_Method, error) {
+	root, err := msg.Root()
+	return Introspection_Method(root.Struct()), err
+}
+
+func (s Introspection_Method) String() string {
+	str, _ := text.Marshal(0xf94d0ba698028ed9, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_Method) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_Method) DecodeFromPtr(p capnp.Ptr) Introspection_Method {
+	return Introspection_Method(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_Method) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_Method) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_Method) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_Method) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Introspection_Method) Id() uint16 {
+	return capnp.Struct(s).Uint16(0)
+}
+
+func (s Introspection_Method) SetId(v uint16) {
+	capnp.Struct(s).SetUint16(0, v)
+}
+
+// Empty if the interface's schema is not available.
+func (s Introspection_Method) Name() (string, error) {
+	return capnp.GetTextField(s, 0, "Introspection.Method.name")
+}
+
+func (s Introspection_Method) HasName() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Introspection_Method) NameBytes() ([]byte, error) {
+	return capnp.GetTextBytesField(s, 0, "Introspection.Method.name")
+}
+
+func (s Introspection_Method) SetName(v string) error {
+	return capnp.Struct(s).SetText(0, v)
+}
+
+func (s Introspection_Method) ParamStructType() uint64 {
+	return capnp.Struct(s).Uint64(8)
+}
+
+func (s Introspection_Method) SetParamStructType(v uint64) {
+	capnp.Struct(s).SetUint64(8, v)
+}
+
+// IDs of the method's parameter and result structs, or zero if the
+// interface's schema is not available.  Look them up with getSchema.
+func (s Introspection_Method) ResultStructType() uint64 {
+	return capnp.Struct(s).Uint64(16)
+}
+
+func (s Introspection_Method) SetResultStructType(v uint64) {
+	capnp.Struct(s).SetUint64(16, v)
+}
+
+// Introspection_Method_List is a list of Introspection_Method.
+type Introspection_Method_List = capnp.StructList[Introspection_Method]
+
+// NewIntrospection_Method creates a new list of Introspection_Method.
+func NewIntrospection_Method_List(s *capnp.Segment, sz int32) (Introspection_Method_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[Introspection_Method](l), err
+}
+
+// Introspection_Method_Future is a wrapper for a Introspection_Method promised by a client call.
+type Introspection_Method_Future struct{ *capnp.Future }
+
+func (f Introspection_Method_Future) Struct() (Introspection_Method, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_Method(p.Struct()), err
+}
+
+type Introspection_getSchema_Params capnp.Struct
+
+// Introspection_getSchema_Params_TypeID is the unique identifier for the type Introspection_getSchema_Params.
+const Introspection_getSchema_Params_TypeID = 0xa2f2e002a64c0caf
+
+func NewIntrospection_getSchema_Params(s *capnp.Segment) (Introspection_getSchema_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Introspection_getSchema_Params(st), err
+}
+
+func NewRootIntrospection_getSchema_Params(s *capnp.Segment) (Introspection_getSchema_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Introspection_getSchema_Params(st), err
+}
+
+func ReadRootIntrospection_getSchema_Params(msg *capnp.Message) (Introspection_getSchema_Params, error) {
+	root, err := msg.Root()
+	return Introspection_getSchema_Params(root.Struct()), err
+}
+
+func (s Introspection_getSchema_Params) String() string {
+	str, _ := text.Marshal(0xa2f2e002a64c0caf, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_getSchema_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_getSchema_Params) DecodeFromPtr(p capnp.Ptr) Introspection_getSchema_Params {
+	return Introspection_getSchema_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_getSchema_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_getSchema_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_getSchema_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_getSchema_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Introspection_getSchema_Params) Id() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Introspection_getSchema_Params) SetId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// Introspection_getSchema_Params_List is a list of Introspection_getSchema_Params.
+type Introspection_getSchema_Params_List = capnp.StructList[Introspection_getSchema_Params]
+
+// NewIntrospection_getSchema_Params creates a new list of Introspection_getSchema_Params.
+func NewIntrospection_getSchema_Params_List(s *capnp.Segment, sz int32) (Introspection_getSchema_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Introspection_getSchema_Params](l), err
+}
+
+// Introspection_getSchema_Params_Future is a wrapper for a Introspection_getSchema_Params promised by a client call.
+type Introspection_getSchema_Params_Future struct{ *capnp.Future }
+
+func (f Introspection_getSchema_Params_Future) Struct() (Introspection_getSchema_Params, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_getSchema_Params(p.Struct()), err
+}
+
+type Introspection_getSchema_Results capnp.Struct
+
+// Introspection_getSchema_Results_TypeID is the unique identifier for the type Introspection_getSchema_Results.
+const Introspection_getSchema_Results_TypeID = 0x96e3f34bbeef4b06
+
+func NewIntrospection_getSchema_Results(s *capnp.Segment) (Introspection_getSchema_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_getSchema_Results(st), err
+}
+
+func NewRootIntrospection_getSchema_Results(s *capnp.Segment) (Introspection_getSchema_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_getSchema_Results(st), err
+}
+
+func ReadRootIntrospection_getSchema_Results(msg *capnp.Message) (Introspection_getSchema_Results, error) {
+	root, err := msg.Root()
+	return Introspection_getSchema_Results(root.Struct()), err
+}
+
+func (s Introspection_getSchema_Results) String() string {
+	str, _ := text.Marshal(0x96e3f34bbeef4b06, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_getSchema_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_getSchema_Results) DecodeFromPtr(p capnp.Ptr) Introspection_getSchema_Results {
+	return Introspection_getSchema_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_getSchema_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_getSchema_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_getSchema_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_getSchema_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Introspection_getSchema_Results) Request() ([]byte, error) {
+	return capnp.GetDataField(s, 0, "Introspection.getSchema$Results.request")
+}
+
+func (s Introspection_getSchema_Results) HasRequest() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Introspection_getSchema_Results) SetRequest(v []byte) error {
+	return capnp.Struct(s).SetData(0, v)
+}
+
+// RequestReader returns a reader of request's data, which
+// is read directly from the message.
+func (s Introspection_getSchema_Results) RequestReader() (*bytes.Reader, error) {
+	v, err := s.Request()
+	return bytes.NewReader(v), err
+}
+
+// SetRequestFromReader sets request to the next size bytes of r,
+// which are read directly into the message.
+func (s Introspection_getSchema_Results) SetRequestFromReader(r io.Reader, size int) error {
+	return capnp.Struct(s).SetDataFromReader(0, r, size)
+}
+
+// Introspection_getSchema_Results_List is a list of Introspection_getSchema_Results.
+type Introspection_getSchema_Results_List = capnp.StructList[Introspection_getSchema_Results]
+
+// NewIntrospection_getSchema_Results creates a new list of Introspection_getSchema_Results.
+func NewIntrospection_getSchema_Results_List(s *capnp.Segment, sz int32) (Introspection_getSchema_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Introspection_getSchema_Results](l), err
+}
+
+// Introspection_getSchema_Results_Future is a wrapper for a Introspection_getSchema_Results promised by a client call.
+type Introspection_getSchema_Results_Future struct{ *capnp.Future }
+
+func (f Introspection_getSchema_Results_Future) Struct() (Introspection_getSchema_Results, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_getSchema_Results(p.Struct()), err
+}
+
+type Introspection_listInterfaces_Params capnp.Struct
+
+// Introspection_listInterfaces_Params_TypeID is the unique identifier for the type Introspection_listInterfaces_Params.
+const Introspection_listInterfaces_Params_TypeID = 0xbe7c2d84877dd0e0
+
+func NewIntrospection_listInterfaces_Params(s *capnp.Segment) (Introspection_listInterfaces_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Introspection_listInterfaces_Params(st), err
+}
+
+func NewRootIntrospection_listInterfaces_Params(s *capnp.Segment) (Introspection_listInterfaces_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Introspection_listInterfaces_Params(st), err
+}
+
+func ReadRootIntrospection_listInterfaces_Params(msg *capnp.Message) (Introspection_listInterfaces_Params, error) {
+	root, err := msg.Root()
+	return Introspection_listInterfaces_Params(root.Struct()), err
+}
+
+func (s Introspection_listInterfaces_Params) String() string {
+	str, _ := text.Marshal(0xbe7c2d84877dd0e0, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_listInterfaces_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_listInterfaces_Params) DecodeFromPtr(p capnp.Ptr) Introspection_listInterfaces_Params {
+	return Introspection_listInterfaces_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_listInterfaces_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_listInterfaces_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_listInterfaces_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_listInterfaces_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+
+// Introspection_listInterfaces_Params_List is a list of Introspection_listInterfaces_Params.
+type Introspection_listInterfaces_Params_List = capnp.StructList[Introspection_listInterfaces_Params]
+
+// NewIntrospection_listInterfaces_Params creates a new list of Introspection_listInterfaces_Params.
+func NewIntrospection_listInterfaces_Params_List(s *capnp.Segment, sz int32) (Introspection_listInterfaces_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Introspection_listInterfaces_Params](l), err
+}
+
+// Introspection_listInterfaces_Params_Future is a wrapper for a Introspection_listInterfaces_Params promised by a client call.
+type Introspection_listInterfaces_Params_Future struct{ *capnp.Future }
+
+func (f Introspection_listInterfaces_Params_Future) Struct() (Introspection_listInterfaces_Params, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_listInterfaces_Params(p.Struct()), err
+}
+
+type Introspection_listInterfaces_Results capnp.Struct
+
+// Introspection_listInterfaces_Results_TypeID is the unique identifier for the type Introspection_listInterfaces_Results.
+const Introspection_listInterfaces_Results_TypeID = 0xe25a560b3bbe3851
+
+func NewIntrospection_listInterfaces_Results(s *capnp.Segment) (Introspection_listInterfaces_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_listInterfaces_Results(st), err
+}
+
+func NewRootIntrospection_listInterfaces_Results(s *capnp.Segment) (Introspection_listInterfaces_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_listInterfaces_Results(st), err
+}
+
+func ReadRootIntrospection_listInterfaces_Results(msg *capnp.Message) (Introspection_listInterfaces_Results, error) {
+	root, err := msg.Root()
+	return Introspection_listInterfaces_Results(root.Struct()), err
+}
+
+func (s Introspection_listInterfaces_Results) String() string {
+	str, _ := text.Marshal(0xe25a560b3bbe3851, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_listInterfaces_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_listInterfaces_Results) DecodeFromPtr(p capnp.Ptr) Introspection_listInterfaces_Results {
+	return Introspection_listInterfaces_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_listInterfaces_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_listInterfaces_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_listInterfaces_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_listInterfaces_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Introspection_listInterfaces_Results) InterfaceIds() (capnp.UInt64List, error) {
+	return capnp.GetListField[capnp.UInt64List](s, 0, "Introspection.listInterfaces$Results.interfaceIds")
+}
+
+func (s Introspection_listInterfaces_Results) HasInterfaceIds() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Introspection_listInterfaces_Results) SetInterfaceIds(v capnp.UInt64List) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewInterfaceIds sets the interfaceIds field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Introspection_listInterfaces_Results) NewInterfaceIds(n int32) (capnp.UInt64List, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewUInt64List)
+}
+
+// SetInterfaceIdsFromSlice sets the interfaceIds field to a newly
+// allocated list holding the elements of v, preferring placement in
+// s's segment.
+func (s Introspection_listInterfaces_Results) SetInterfaceIdsFromSlice(v []uint64) error {
+	return capnp.SetListFieldFromSlice(s, 0, v, capnp.NewUInt64ListFromSlice)
+}
+
+// Introspection_listInterfaces_Results_List is a list of Introspection_listInterfaces_Results.
+type Introspection_listInterfaces_Results_List = capnp.StructList[Introspection_listInterfaces_Results]
+
+// NewIntrospection_listInterfaces_Results creates a new list of Introspection_listInterfaces_Results.
+func NewIntrospection_listInterfaces_Results_List(s *capnp.Segment, sz int32) (Introspection_listInterfaces_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Introspection_listInterfaces_Results](l), err
+}
+
+// Introspection_listInterfaces_Results_Future is a wrapper for a Introspection_listInterfaces_Results promised by a client call.
+type Introspection_listInterfaces_Results_Future struct{ *capnp.Future }
+
+func (f Introspection_listInterfaces_Results_Future) Struct() (Introspection_listInterfaces_Results, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_listInterfaces_Results(p.Struct()), err
+}
+
+type Introspection_listMethods_Params capnp.Struct
+
+// Introspection_listMethods_Params_TypeID is the unique identifier for the type Introspection_listMethods_Params.
+const Introspection_listMethods_Params_TypeID = 0xe5f16c052f3a33bf
+
+func NewIntrospection_listMethods_Params(s *capnp.Segment) (Introspection_listMethods_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Introspection_listMethods_Params(st), err
+}
+
+func NewRootIntrospection_listMethods_Params(s *capnp.Segment) (Introspection_listMethods_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Introspection_listMethods_Params(st), err
+}
+
+func ReadRootIntrospection_listMethods_Params(msg *capnp.Message) (Introspection_listMethods_Params, error) {
+	root, err := msg.Root()
+	return Introspection_listMethods_Params(root.Struct()), err
+}
+
+func (s Introspection_listMethods_Params) String() string {
+	str, _ := text.Marshal(0xe5f16c052f3a33bf, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_listMethods_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_listMethods_Params) DecodeFromPtr(p capnp.Ptr) Introspection_listMethods_Params {
+	return Introspection_listMethods_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_listMethods_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_listMethods_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_listMethods_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_listMethods_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Introspection_listMethods_Params) InterfaceId() uint64 {
+	return capnp.Struct(s).Uint64(0)
+}
+
+func (s Introspection_listMethods_Params) SetInterfaceId(v uint64) {
+	capnp.Struct(s).SetUint64(0, v)
+}
+
+// Introspection_listMethods_Params_List is a list of Introspection_listMethods_Params.
+type Introspection_listMethods_Params_List = capnp.StructList[Introspection_listMethods_Params]
+
+// NewIntrospection_listMethods_Params creates a new list of Introspection_listMethods_Params.
+func NewIntrospection_listMethods_Params_List(s *capnp.Segment, sz int32) (Introspection_listMethods_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Introspection_listMethods_Params](l), err
+}
+
+// Introspection_listMethods_Params_Future is a wrapper for a Introspection_listMethods_Params promised by a client call.
+type Introspection_listMethods_Params_Future struct{ *capnp.Future }
+
+func (f Introspection_listMethods_Params_Future) Struct() (Introspection_listMethods_Params, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_listMethods_Params(p.Struct()), err
+}
+
+type Introspection_listMethods_Results capnp.Struct
+
+// Introspection_listMethods_Results_TypeID is the unique identifier for the type Introspection_listMethods_Results.
+const Introspection_listMethods_Results_TypeID = 0xe4323adaeedf4815
+
+func NewIntrospection_listMethods_Results(s *capnp.Segment) (Introspection_listMethods_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_listMethods_Results(st), err
+}
+
+func NewRootIntrospection_listMethods_Results(s *capnp.Segment) (Introspection_listMethods_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Introspection_listMethods_Results(st), err
+}
+
+func ReadRootIntrospection_listMethods_Results(msg *capnp.Message) (Introspection_listMethods_Results, error) {
+	root, err := msg.Root()
+	return Introspection_listMethods_Results(root.Struct()), err
+}
+
+func (s Introspection_listMethods_Results) String() string {
+	str, _ := text.Marshal(0xe4323adaeedf4815, capnp.Struct(s))
+	return str
+}
+
+func (s Introspection_listMethods_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Introspection_listMethods_Results) DecodeFromPtr(p capnp.Ptr) Introspection_listMethods_Results {
+	return Introspection_listMethods_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Introspection_listMethods_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Introspection_listMethods_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Introspection_listMethods_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Introspection_listMethods_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Introspection_listMethods_Results) Methods() (Introspection_Method_List, error) {
+	return capnp.GetListField[Introspection_Method_List](s, 0, "Introspection.listMethods$Results.methods")
+}
+
+func (s Introspection_listMethods_Results) HasMethods() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Introspection_listMethods_Results) SetMethods(v Introspection_Method_List) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewMethods sets the methods field to a newly
+// allocated Introspection_Method_List, preferring placement in s's segment.
+func (s Introspection_listMethods_Results) NewMethods(n int32) (Introspection_Method_List, error) {
+	return capnp.NewListField(s, 0, n, NewIntrospection_Method_List)
+}
+
+// Introspection_listMethods_Results_List is a list of Introspection_listMethods_Results.
+type Introspection_listMethods_Results_List = capnp.StructList[Introspection_listMethods_Results]
+
+// NewIntrospection_listMethods_Results creates a new list of Introspection_listMethods_Results.
+func NewIntrospection_listMethods_Results_List(s *capnp.Segment, sz int32) (Introspection_listMethods_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Introspection_listMethods_Results](l), err
+}
+
+// Introspection_listMethods_Results_Future is a wrapper for a Introspection_listMethods_Results promised by a client call.
+type Introspection_listMethods_Results_Future struct{ *capnp.Future }
+
+func (f Introspection_listMethods_Results_Future) Struct() (Introspection_listMethods_Results, error) {
+	p, err := f.Future.Ptr()
+	return Introspection_listMethods_Results(p.Struct()), err
+}
+
+const schema_b3dbe7f7ba0acf00 = "x\xda\x94\x93=hS_\x18\xc6\x9f\xf7\x9c{\x93\x7f" +
+	"\xffM\xda^\x92Rq\x09\x85\x0e\"\xb4j\x0b\xa2\x11" +
+	"\x89\xbah\xa8\x81\x9cF\x1d\xc4\xc1\xdb\xe4h\"\xf9j" +
+	"\xee)\xa2(\x95\"\x08\x1d\x1c\x1cD\x1c\x1d\x0a\xba\x08" +
+	"\x8a\x83\x1d\xea$N\xa2\x83\x8b:X\xd4R\x17\x15\x15" +
+	"\x11\x85z\xe5\xdc\xdb$\xb7~\xa0]\xcf\xfd\xe5y\xde" +
+	"\xf7y\x9fl\xde\xcav\x19[\xa2n\x07\x98Pf\xc8" +
+	"\x0d\x8d\xbe\x9f\x1f\xfd\xf4\xea2\xac\x04\x01&\x85\x81\x91" +
+	"Ec\x86@\xb1\xcfF\x0a\xe4\xde\x8c\xec\x9fe\x0b\x1f" +
+	"\xafA$\x88\x00C\x03\xbd\xe6\xb4\x06\xfaM\x0d,?" +
+	"\xe9;\x99K\xed\x9d\x83e\xf1\xef\x8f\xfe\x9f\xfb\xb2\xf4" +
+	"\xfc6(\xb6\xdb\xfc\x16\xcb\x98}@\xec\xa0\xf9 \xf6" +
+	"\xd0\x0c\x03\xee\xc2\xe3\xb3\x17\xce\x0f\x9e\x99\xf7\xcd<\xa9" +
+	";\xe6\x0d-u\xdf\x0c\x83\\\xb1m~G\xe7\xa1\xc3" +
+	"/\x83\xc3\\7oi\xe0\xae\xe7\xd5\xbb\xef\xc5\xbbg" +
+	"\xc9\xe1\xd7A\xe0\xadyU\x03\xcb\x1epo$\xb9\xc9" +
+	",\x7fX\x0cN;\x18\xba\xa4\x81\x9d!\x0d<\xbd\xc8" +
+	"\xae\xccvf\xbej\x80\xb7g\xf7\xa5\xec\xd0\xb0&K" +
+	"\xa1%\xbcqKU\xd5\xa89ui\xe6\xd5P\xde\xae" +
+	"W\xeb\xc9\xf4\xcaK^\x95j\xd5\xa1\xe3R\xe5\xf2E" +
+	"Y\xb1\x07\xc6\xa43YV\x0e \x0cn\x00\x06\x01V" +
+	"t\x0f \xfe\xe3$\xe2\x8c\xa6\x1arbR:\x8a\xa2" +
+	"`\x14\x05\xadA:k7\xec\x8a\xb3Jx}[\x98" +
+	"\x97\x0a\xd4\x01F\x1d\x01M\xf6\xb3f\xca\x17\x15\x06\x05" +
+	"\xb6\xb7(\x99\xcaHU\xac\x15D\x84\x9b\x81\xd3P\xf3" +
+	"\x06\x968\x0dN\xedD\xa9\x99\xbd\xb5}\x1c\x9cX\xab" +
+	"\x18\xd4\xac\x90\xd5?\x06\xee\x96K\x8eJW\x95D\xaa" +
+	"q\xcc\xceK\xc7{\xd0V\x08\xd7\x0a\x8e\xdb\\\x0dd" +
+	"g\xe9\xefI4\xd5|\xad\x81\xac\xdd\xad\xf3\xc8rc" +
+	"\xad\xbf\x1c\x93\x09\xefH\xc1$O\x00\"\xc2Il`" +
+	"\xde \x1e\x8an\x99.8\xd4\x05\xcar\xf2\xc2\xed\xc2" +
+	"\xbf\x8d\xe9\xc7\xe9\xf8m\xe0\xca\xf9]\x19\x06\x18MU" +
+	"|\xaei\xd1\xd3>\x0ah\xcdf^?h\x95\xd7\xf8" +
+	"\xcaV\xeb\x82[\x85e\xfa\xd7\xaa\xf0?\x18d\x12~" +
+	"3zZ\xa2\xb6.\xdd\x11N\xa2\xc8\x88(N\xfaM" +
+	"n\x04\xc4QN\xa2\xcc\xc8b\x14'\x06X\xa5i@" +
+	"\x149\x09\xc5\xc8\xe2,N\x1c\xb0&f\x00\xa18\x89" +
+	"s~e\xc3`\x14\x06uW\xed\x8a\xa4\x08\x18E@" +
+	"n]o\x92S\x0d\x9a\xcc\xab\x03\xa7\xea\x12\xadq\x1b" +
+	"\xde\xdf+\xa7\xa8\xd1\xfc\xd4\xfa\xf6c\x00@K]\xc2"
+
+func RegisterSchema(reg *schemas.Registry) {
+	reg.Register(&schemas.Schema{
+		String: schema_b3dbe7f7ba0acf00,
+		Nodes: []uint64{
+			0x96e3f34bbeef4b06,
+			0xa2f2e002a64c0caf,
+			0xba473f537717d4fd,
+			0xbe7c2d84877dd0e0,
+			0xe25a560b3bbe3851,
+			0xe4323adaeedf4815,
+			0xe5f16c052f3a33bf,
+			0xf94d0ba698028ed9,
+		},
+		Compressed: true,
+	})
+}
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_b3dbe7f7ba0acf00,
+		Compressed: true,
+	}
+	return s.Request()
+}
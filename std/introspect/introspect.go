@@ -0,0 +1,185 @@
+// Package introspect lets peers discover the interfaces and methods of
+// a capability, and fetch their schemas, using the Introspection
+// interface, so that generic tools can call methods that they were not
+// compiled with.
+//
+// Servers answer Introspection only if it is enabled for them:
+//
+//	srv := foo.Foo_NewServer(impl)
+//	introspect.Enable(srv, nil)
+//	c := foo.Foo(capnp.NewClient(srv))
+//
+// and callers make the calls on the capability that they want to know
+// about:
+//
+//	ids, err := introspect.ListInterfaces(ctx, capnp.Client(c))
+//
+// The interfaces' schemas must be registered, typically in
+// schemas.DefaultRegistry with the generated RegisterSchema functions,
+// for the server to report method names and schemas.
+package introspect
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/internal/nodemap"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/server"
+)
+
+// Options configures the Introspection methods added by Enable.
+type Options struct {
+	// Registry is the schema registry that method names and schemas
+	// are read from.  If nil, schemas.DefaultRegistry is used.  The
+	// superclasses of the server's interfaces are always found in
+	// schemas.DefaultRegistry.
+	Registry *schemas.Registry
+}
+
+// Enable makes srv answer the Introspection interface, describing
+// itself, in addition to its own methods.  Like the server's fields,
+// it must be called before srv receives its first call.  opts may be
+// nil.
+func Enable(srv *server.Server, opts *Options) {
+	s := &introspectionServer{srv: srv}
+	if opts != nil && opts.Registry != nil {
+		s.nodes.UseRegistry(opts.Registry)
+		s.reg = opts.Registry
+	} else {
+		s.reg = schemas.DefaultRegistry
+	}
+	srv.AddMethods(Introspection_Methods(nil, s)...)
+}
+
+// ListInterfaces returns the IDs of the interfaces that c implements,
+// in ascending order.  The call fails with an unimplemented exception
+// if c does not support introspection.
+func ListInterfaces(ctx context.Context, c capnp.Client) ([]uint64, error) {
+	f, release := Introspection(c).ListInterfaces(ctx, nil)
+	defer release()
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+	l, err := res.InterfaceIds()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint64, l.Len())
+	for i := range ids {
+		ids[i] = l.At(i)
+	}
+	return ids, nil
+}
+
+// GetSchema returns the CodeGeneratorRequest message that holds the
+// schema node with the given ID from c's vat, suitable for
+// capnp.Unmarshal.
+func GetSchema(ctx context.Context, c capnp.Client, id uint64) ([]byte, error) {
+	f, release := Introspection(c).GetSchema(ctx, func(p Introspection_getSchema_Params) error {
+		p.SetId(id)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+	data, err := res.Request()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(nil), data...), nil
+}
+
+// introspectionServer implements Introspection_Server for srv.
+type introspectionServer struct {
+	srv *server.Server
+	reg *schemas.Registry
+
+	mu    sync.Mutex
+	nodes nodemap.Map
+}
+
+func (s *introspectionServer) ListInterfaces(ctx context.Context, call Introspection_listInterfaces) error {
+	ids := s.srv.Interfaces()
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	l, err := res.NewInterfaceIds(int32(len(ids)))
+	if err != nil {
+		return err
+	}
+	for i, id := range ids {
+		l.Set(i, id)
+	}
+	return nil
+}
+
+func (s *introspectionServer) ListMethods(ctx context.Context, call Introspection_listMethods) error {
+	id := call.Args().InterfaceId()
+	var ms []capnp.Method
+	for _, m := range s.srv.Methods() {
+		if m.InterfaceID == id {
+			ms = append(ms, m)
+		}
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	l, err := res.NewMethods(int32(len(ms)))
+	if err != nil {
+		return err
+	}
+	methods := s.schemaMethods(id)
+	for i, m := range ms {
+		out := l.At(i)
+		out.SetId(m.MethodID)
+		name := m.MethodName
+		if int(m.MethodID) < methods.Len() {
+			sm := methods.At(int(m.MethodID))
+			name, _ = sm.Name()
+			out.SetParamStructType(sm.ParamStructType())
+			out.SetResultStructType(sm.ResultStructType())
+		}
+		if err := out.SetName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schemaMethods returns the methods of the interface with the given ID
+// from the registry, or an empty list if its schema is not registered.
+func (s *introspectionServer) schemaMethods(id uint64) schema.Method_List {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, err := s.nodes.Find(id)
+	if err != nil || n.Which() != schema.Node_Which_interface {
+		return schema.Method_List{}
+	}
+	methods, _ := n.Interface().Methods()
+	return methods
+}
+
+func (s *introspectionServer) GetSchema(ctx context.Context, call Introspection_getSchema) error {
+	id := call.Args().Id()
+	data, err := s.reg.Find(id)
+	if schemas.IsNotFound(err) {
+		return fmt.Errorf("introspect: no schema for node %#x", id)
+	}
+	if err != nil {
+		return err
+	}
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	return res.SetRequest(data)
+}
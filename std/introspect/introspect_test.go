@@ -0,0 +1,89 @@
+package introspect_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/exc"
+	air "capnproto.org/go/capnp/v3/internal/aircraftlib"
+	"capnproto.org/go/capnp/v3/internal/schema"
+	"capnproto.org/go/capnp/v3/schemas"
+	"capnproto.org/go/capnp/v3/std/introspect"
+)
+
+func init() {
+	air.RegisterSchema(schemas.DefaultRegistry)
+}
+
+type callSeq struct{}
+
+func (callSeq) GetNumber(ctx context.Context, call air.CallSequence_getNumber) error {
+	return nil
+}
+
+type pipeliner struct{ callSeq }
+
+func (pipeliner) NewPipeliner(ctx context.Context, call air.Pipeliner_newPipeliner) error {
+	return nil
+}
+
+func TestIntrospection(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	srv := air.Pipeliner_NewServer(pipeliner{})
+	introspect.Enable(srv, nil)
+	c := capnp.NewClient(srv)
+	defer c.Release()
+
+	ids, err := introspect.ListInterfaces(ctx, c)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{
+		air.CallSequence_TypeID,
+		air.Pipeliner_TypeID,
+		introspect.Introspection_TypeID,
+	}, ids)
+
+	f, release := introspect.Introspection(c).ListMethods(ctx, func(p introspect.Introspection_listMethods_Params) error {
+		p.SetInterfaceId(air.Pipeliner_TypeID)
+		return nil
+	})
+	defer release()
+	res, err := f.Struct()
+	require.NoError(t, err)
+	methods, err := res.Methods()
+	require.NoError(t, err)
+	require.Equal(t, 1, methods.Len())
+	m := methods.At(0)
+	name, err := m.Name()
+	require.NoError(t, err)
+	assert.Equal(t, "newPipeliner", name)
+	assert.Equal(t, uint16(0), m.Id())
+	assert.NotZero(t, m.ParamStructType())
+
+	data, err := introspect.GetSchema(ctx, c, m.ResultStructType())
+	require.NoError(t, err)
+	msg, err := capnp.Unmarshal(data)
+	require.NoError(t, err)
+	req, err := schema.ReadRootCodeGeneratorRequest(msg)
+	require.NoError(t, err)
+	nodes, err := req.Nodes()
+	require.NoError(t, err)
+	assert.NotZero(t, nodes.Len())
+
+	_, err = introspect.GetSchema(ctx, c, 0xdeadbeef)
+	assert.Error(t, err)
+}
+
+func TestNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	c := capnp.Client(air.CallSequence_ServerToClient(callSeq{}))
+	defer c.Release()
+	_, err := introspect.ListInterfaces(context.Background(), c)
+	assert.True(t, exc.IsType(err, exc.Unimplemented), "error = %v", err)
+}
@@ -0,0 +1,391 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package paginator
+
+import (
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	fc "capnproto.org/go/capnp/v3/flowcontrol"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	context "context"
+)
+
+// A cursor over a sequence of items.  The holder reads the sequence in
+// order by calling next until it reports that the sequence is done.
+// Calls to next must not overlap.
+type Paginator capnp.Client
+
+// Paginator_TypeID is the unique identifier for the type Paginator.
+const Paginator_TypeID = 0xe73af3766c79b15d
+
+// Returns the next page of items.  The page has at most count items,
+// and may have fewer if the server limits the size of its pages.  It
+// has at least one item unless done is true.  done is true when the
+// page ends the sequence.
+func (c Paginator) Next(ctx context.Context, params func(Paginator_next_Params) error) (Paginator_next_Results_Future, capnp.ReleaseFunc) {
+
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe73af3766c79b15d,
+			MethodID:      0,
+			InterfaceName: "paginator.capnp:Paginator",
+			MethodName:    "next",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Paginator_next_Params(s)) }
+	}
+
+	ans, release := capnp.Client(c).SendCall(ctx, s)
+	return Paginator_next_Results_Future{Future: ans.Future()}, release
+
+}
+
+func (c Paginator) WaitStreaming() error {
+	return capnp.Client(c).WaitStreaming()
+}
+
+// String returns a string that identifies this capability for debugging
+// purposes.  Its format should not be depended on: in particular, it
+// should not be used to compare clients.  Use IsSame to compare clients
+// for equality.
+func (c Paginator) String() string {
+	return "Paginator(" + capnp.Client(c).String() + ")"
+}
+
+// AddRef creates a new Client that refers to the same capability as c.
+// If c is nil or has resolved to null, then AddRef returns nil.
+func (c Paginator) AddRef() Paginator {
+	return Paginator(capnp.Client(c).AddRef())
+}
+
+// Release releases a capability reference.  If this is the last
+// reference to the capability, then the underlying resources associated
+// with the capability will be released.
+//
+// Release will panic if c has already been released, but not if c is
+// nil or resolved to null.
+func (c Paginator) Release() {
+	capnp.Client(c).Release()
+}
+
+// Resolve blocks until the capability is fully resolved or the Context
+// expires.
+func (c Paginator) Resolve(ctx context.Context) error {
+	return capnp.Client(c).Resolve(ctx)
+}
+
+func (c Paginator) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Client(c).EncodeAsPtr(seg)
+}
+
+func (Paginator) DecodeFromPtr(p capnp.Ptr) Paginator {
+	return Paginator(capnp.Client{}.DecodeFromPtr(p))
+}
+
+// IsValid reports whether c is a valid reference to a capability.
+// A reference is invalid if it is nil, has resolved to null, or has
+// been released.
+func (c Paginator) IsValid() bool {
+	return capnp.Client(c).IsValid()
+}
+
+// IsSame reports whether c and other refer to a capability created by the
+// same call to NewClient.  This can return false negatives if c or other
+// are not fully resolved: use Resolve if this is an issue.  If either
+// c or other are released, then IsSame panics.
+func (c Paginator) IsSame(other Paginator) bool {
+	return capnp.Client(c).IsSame(capnp.Client(other))
+}
+
+// Update the flowcontrol.FlowLimiter used to manage flow control for
+// this client. This affects all future calls, but not calls already
+// waiting to send. Passing nil sets the value to flowcontrol.NopLimiter,
+// which is also the default.
+func (c Paginator) SetFlowLimiter(lim fc.FlowLimiter) {
+	capnp.Client(c).SetFlowLimiter(lim)
+}
+
+// Get the current flowcontrol.FlowLimiter used to manage flow control
+// for this client.
+func (c Paginator) GetFlowLimiter() fc.FlowLimiter {
+	return capnp.Client(c).GetFlowLimiter()
+}
+
+// A Paginator_Server is a Paginator with a local implementation.
+type Paginator_Server interface {
+	// Returns the next page of items.  The page has at most count items,
+	// and may have fewer if the server limits the size of its pages.  It
+	// has at least one item unless done is true.  done is true when the
+	// page ends the sequence.
+	Next(context.Context, Paginator_next) error
+}
+
+// Paginator_NewServer creates a new Server from an implementation of Paginator_Server.
+func Paginator_NewServer(s Paginator_Server) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Paginator_Methods(nil, s), s, c)
+}
+
+// Paginator_ServerToClient creates a new Client from an implementation of Paginator_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Paginator_ServerToClient(s Paginator_Server) Paginator {
+	return Paginator(capnp.NewClient(Paginator_NewServer(s)))
+}
+
+// Paginator_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Paginator_Methods(methods []server.Method, s Paginator_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe73af3766c79b15d,
+			MethodID:      0,
+			InterfaceName: "paginator.capnp:Paginator",
+			MethodName:    "next",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Next(ctx, Paginator_next{call})
+		},
+	})
+
+	return methods
+}
+
+// Paginator_next holds the state for a server call to Paginator.next.
+// See server.Call for documentation.
+type Paginator_next struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Paginator_next) Args() Paginator_next_Params {
+	return Paginator_next_Params(c.Call.Args())
+}
+
+// AllocResults allocates the results struct.
+func (c Paginator_next) AllocResults() (Paginator_next_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Paginator_next_Results(r), err
+}
+
+// Paginator_List is a list of Paginator.
+type Paginator_List = capnp.CapList[Paginator]
+
+// NewPaginator_List creates a new list of Paginator.
+func NewPaginator_List(s *capnp.Segment, sz int32) (Paginator_List, error) {
+	l, err := capnp.NewPointerList(s, sz)
+	return capnp.CapList[Paginator](l), err
+}
+
+type Paginator_next_Params capnp.Struct
+
+// Paginator_next_Params_TypeID is the unique identifier for the type Paginator_next_Params.
+const Paginator_next_Params_TypeID = 0xf847486daea9533b
+
+func NewPaginator_next_Params(s *capnp.Segment) (Paginator_next_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Paginator_next_Params(st), err
+}
+
+func NewRootPaginator_next_Params(s *capnp.Segment) (Paginator_next_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Paginator_next_Params(st), err
+}
+
+func ReadRootPaginator_next_Params(msg *capnp.Message) (Paginator_next_Params, error) {
+	root, err := msg.Root()
+	return Paginator_next_Params(root.Struct()), err
+}
+
+func (s Paginator_next_Params) String() string {
+	str, _ := text.Marshal(0xf847486daea9533b, capnp.Struct(s))
+	return str
+}
+
+func (s Paginator_next_Params) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Paginator_next_Params) DecodeFromPtr(p capnp.Ptr) Paginator_next_Params {
+	return Paginator_next_Params(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Paginator_next_Params) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Paginator_next_Params) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Paginator_next_Params) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Paginator_next_Params) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Paginator_next_Params) Count() uint32 {
+	return capnp.Struct(s).Uint32(0)
+}
+
+func (s Paginator_next_Params) SetCount(v uint32) {
+	capnp.Struct(s).SetUint32(0, v)
+}
+
+// Paginator_next_Params_List is a list of Paginator_next_Params.
+type Paginator_next_Params_List = capnp.StructList[Paginator_next_Params]
+
+// NewPaginator_next_Params creates a new list of Paginator_next_Params.
+func NewPaginator_next_Params_List(s *capnp.Segment, sz int32) (Paginator_next_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Paginator_next_Params](l), err
+}
+
+// Paginator_next_Params_Future is a wrapper for a Paginator_next_Params promised by a client call.
+type Paginator_next_Params_Future struct{ *capnp.Future }
+
+func (f Paginator_next_Params_Future) Struct() (Paginator_next_Params, error) {
+	p, err := f.Future.Ptr()
+	return Paginator_next_Params(p.Struct()), err
+}
+
+type Paginator_next_Results capnp.Struct
+
+// Paginator_next_Results_TypeID is the unique identifier for the type Paginator_next_Results.
+const Paginator_next_Results_TypeID = 0x94041943c09f4e0b
+
+func NewPaginator_next_Results(s *capnp.Segment) (Paginator_next_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Paginator_next_Results(st), err
+}
+
+func NewRootPaginator_next_Results(s *capnp.Segment) (Paginator_next_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Paginator_next_Results(st), err
+}
+
+func ReadRootPaginator_next_Results(msg *capnp.Message) (Paginator_next_Results, error) {
+	root, err := msg.Root()
+	return Paginator_next_Results(root.Struct()), err
+}
+
+func (s Paginator_next_Results) String() string {
+	str, _ := text.Marshal(0x94041943c09f4e0b, capnp.Struct(s))
+	return str
+}
+
+func (s Paginator_next_Results) EncodeAsPtr(seg *capnp.Segment) capnp.Ptr {
+	return capnp.Struct(s).EncodeAsPtr(seg)
+}
+
+func (Paginator_next_Results) DecodeFromPtr(p capnp.Ptr) Paginator_next_Results {
+	return Paginator_next_Results(capnp.Struct{}.DecodeFromPtr(p))
+}
+
+func (s Paginator_next_Results) ToPtr() capnp.Ptr {
+	return capnp.Struct(s).ToPtr()
+}
+func (s Paginator_next_Results) IsValid() bool {
+	return capnp.Struct(s).IsValid()
+}
+
+func (s Paginator_next_Results) Message() *capnp.Message {
+	return capnp.Struct(s).Message()
+}
+
+func (s Paginator_next_Results) Segment() *capnp.Segment {
+	return capnp.Struct(s).Segment()
+}
+func (s Paginator_next_Results) Items() (capnp.PointerList, error) {
+	return capnp.GetListField[capnp.PointerList](s, 0, "Paginator.next$Results.items")
+}
+
+func (s Paginator_next_Results) HasItems() bool {
+	return capnp.Struct(s).HasPtr(0)
+}
+
+func (s Paginator_next_Results) SetItems(v capnp.PointerList) error {
+	return capnp.SetListField(s, 0, v)
+}
+
+// NewItems sets the items field to a newly
+// allocated capnp.PointerList, preferring placement in s's segment.
+func (s Paginator_next_Results) NewItems(n int32) (capnp.PointerList, error) {
+	return capnp.NewListField(s, 0, n, capnp.NewPointerList)
+}
+func (s Paginator_next_Results) Done() bool {
+	return capnp.Struct(s).Bit(0)
+}
+
+func (s Paginator_next_Results) SetDone(v bool) {
+	capnp.Struct(s).SetBit(0, v)
+}
+
+// Paginator_next_Results_List is a list of Paginator_next_Results.
+type Paginator_next_Results_List = capnp.StructList[Paginator_next_Results]
+
+// NewPaginator_next_Results creates a new list of Paginator_next_Results.
+func NewPaginator_next_Results_List(s *capnp.Segment, sz int32) (Paginator_next_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Paginator_next_Results](l), err
+}
+
+// Paginator_next_Results_Future is a wrapper for a Paginator_next_Results promised by a client call.
+type Paginator_next_Results_Future struct{ *capnp.Future }
+
+func (f Paginator_next_Results_Future) Struct() (Paginator_next_Results, error) {
+	p, err := f.Future.Ptr()
+	return Paginator_next_Results(p.Struct()), err
+}
+
+const schema_919f08cd82d78cbd = "x\xda\x84\x90\xbfk\x1aa\x18\xc7\xbf\xdf\xf7}\xacW" +
+	"Z\xab\xe7\xb9\xb4\x14nq\xa9\x83\xb4v)\x96\xa2\xd2" +
+	"\x82\x9d\xca\xbdm\xd7B\x0fs\x88\xa0\xa7\xdc\x9d\xf91" +
+	"dH\xe6,\x81\xec\xceY\x02\xf9\x03\x02\xc9\x98\xc1%" +
+	"K\xfe\x84d\xcf\x92\xed\xc2\x05#\x12\x02Y?\xc3\xf7" +
+	"\xf9|\x9e\x8f}\xb6\xe5S\xa1kA\x99\xff\xb9\x17\xe9" +
+	"\xab_\xb3\xd3\xefo\xe5\x00\xe6\x1d\x09\xe4\x98/\xf1\xf3" +
+	"Ki\x12tl\xd9\x00\xd3\x7f\xc7[\xc3\xf5\x9b\xe6\x15" +
+	"\xec\x92NO\xf6.w\xe7\xd6l\x1f@\x89\xce\xb6\\" +
+	"\x80\xce\x8et\x9d\xb9\xe4\x9d\xb9\x14\xd3\xaf\x7f\x0e\x8fF" +
+	"?\xbb\xb7\x8b9\xc9\xd6\xce\xa4\x91\xad\x9dK\x0b\xd7\xe9" +
+	"\xc4\xef\x0fB?\x19\xeb\xa8\xde\xf3'\xe1\xa4\xe9-@" +
+	"T\x0f\x83\xcd\xa4\xfa\xbb\x15\xc4\xd3a\x12\x1bK\x0b " +
+	"\x04\xec\x0f\x0d\xc0T5M[\x91\xac0c\xdfj\x80" +
+	"\xf9\xa2i~(\xba\x83$\x18\xc5|\x03z\x9a,s" +
+	"E\x19\xc8pqm\x1c\x06$\x14\x09.\x0d\xd4c\x03" +
+	"FFt\x0eXF\xf0\xe19\xb6]\x03:\xaf\xd9y" +
+	"O\xa0\x98y\xdat\x8d\xa8\x95K\xf7VOA\x8f\xec" +
+	"\x08m\x96\xf9\xf7\xd9x\xcf\xf5#\x7f\x14\x1bY\xb6\x17" +
+	"\xb2vK\xd3T\x14\xdd\xdex\x1a&\xb4\xa0h\x81w" +
+	"\x03\x00\x00\xd6s@"
+
+func RegisterSchema(reg *schemas.Registry) {
+	reg.Register(&schemas.Schema{
+		String: schema_919f08cd82d78cbd,
+		Nodes: []uint64{
+			0x94041943c09f4e0b,
+			0xe73af3766c79b15d,
+			0xf847486daea9533b,
+		},
+		Compressed: true,
+	})
+}
+
+// SchemaRequest returns the schema of the types in this package as an
+// unpacked CodeGeneratorRequest message, suitable for capnp.Unmarshal,
+// for reflection and dynamic encoding.  The schema is decompressed on
+// each call.
+func SchemaRequest() ([]byte, error) {
+	s := schemas.Schema{
+		String:     schema_919f08cd82d78cbd,
+		Compressed: true,
+	}
+	return s.Request()
+}
@@ -0,0 +1,225 @@
+// Package paginator returns long sequences of items over Cap'n Proto
+// RPC in pages, using the Paginator interface, so that no single
+// message has to hold the whole sequence.
+//
+// The server wraps a slice or a function that produces items:
+//
+//	p := paginator.ServeSlice(books)
+//
+// and the client reads the items with an Iterator, which fetches pages
+// as they are needed:
+//
+//	it := paginator.NewIterator[Book](p, 0)
+//	defer it.Release()
+//	for it.Next(ctx) {
+//		use(it.Item())
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+package paginator
+
+import (
+	"context"
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// MaxPageSize is the largest page that a Paginator returned by Serve or
+// ServeSlice sends, however many items the caller asks for.
+const MaxPageSize = 1024
+
+// DefaultPageSize is the page size that an Iterator asks for if none is
+// given.
+const DefaultPageSize = 64
+
+// Serve returns a Paginator that serves the items returned by next, in
+// order.  next returns false when there are no more items.  It is
+// called with the context of the call that requested the page, and it
+// is never called concurrently.
+func Serve[T capnp.TypeParam[T]](next func(ctx context.Context) (item T, ok bool, err error)) Paginator {
+	return Paginator_ServerToClient(&funcServer[T]{next: next})
+}
+
+// ServeSlice returns a Paginator that serves items, in order.
+func ServeSlice[T capnp.TypeParam[T]](items []T) Paginator {
+	return Serve(func(context.Context) (T, bool, error) {
+		var item T
+		if len(items) == 0 {
+			return item, false, nil
+		}
+		item, items = items[0], items[1:]
+		return item, true, nil
+	})
+}
+
+// funcServer implements Paginator_Server with a function that produces
+// items.
+type funcServer[T capnp.TypeParam[T]] struct {
+	next func(context.Context) (T, bool, error)
+
+	// peeked is an item that next returned while checking whether the
+	// previous page ended the sequence.
+	peeked    T
+	hasPeeked bool
+	done      bool
+}
+
+func (s *funcServer[T]) Next(ctx context.Context, call Paginator_next) error {
+	count := int(call.Args().Count())
+	if count == 0 {
+		return errors.New("paginator: count must be positive")
+	}
+	if count > MaxPageSize {
+		count = MaxPageSize
+	}
+
+	page := make([]T, 0, count)
+	if s.hasPeeked {
+		page = append(page, s.peeked)
+		s.hasPeeked = false
+	}
+	for !s.done && len(page) < count {
+		item, ok, err := s.next(ctx)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			s.done = true
+			break
+		}
+		page = append(page, item)
+	}
+	// Look ahead, so that the page can say whether it is the last.
+	if !s.done {
+		item, ok, err := s.next(ctx)
+		if err != nil {
+			return err
+		}
+		if ok {
+			s.peeked, s.hasPeeked = item, true
+		} else {
+			s.done = true
+		}
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+	items, err := res.NewItems(int32(len(page)))
+	if err != nil {
+		return err
+	}
+	for i, item := range page {
+		if err := items.Set(i, item.EncodeAsPtr(res.Segment())); err != nil {
+			return err
+		}
+	}
+	res.SetDone(s.done)
+	return nil
+}
+
+// An Iterator reads the items of a Paginator, fetching a page at a time
+// as they are needed.  An Iterator is not safe to use from multiple
+// goroutines.
+type Iterator[T capnp.TypeParam[T]] struct {
+	p        Paginator
+	pageSize uint32
+
+	page    capnp.PointerList
+	release capnp.ReleaseFunc // releases page
+	i       int
+	done    bool // page is the last
+
+	item T
+	err  error
+}
+
+// NewIterator returns an Iterator that reads the items of p, asking for
+// pageSize items at a time.  If pageSize is zero, DefaultPageSize is
+// used.  The Iterator takes ownership of p, which is released by
+// Release.
+func NewIterator[T capnp.TypeParam[T]](p Paginator, pageSize int) *Iterator[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &Iterator[T]{p: p, pageSize: uint32(pageSize)}
+}
+
+// Next advances to the next item, fetching the next page if needed.  It
+// returns false at the end of the sequence or when an error occurs,
+// which Err reports.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for it.err == nil {
+		if it.i < it.page.Len() {
+			p, err := it.page.At(it.i)
+			if err != nil {
+				it.err = err
+				return false
+			}
+			it.i++
+			it.item = it.item.DecodeFromPtr(p)
+			return true
+		}
+		if it.done {
+			return false
+		}
+		it.fetch(ctx)
+	}
+	return false
+}
+
+// fetch replaces the current page with the next one.
+func (it *Iterator[T]) fetch(ctx context.Context) {
+	it.releasePage()
+	f, release := it.p.Next(ctx, func(p Paginator_next_Params) error {
+		p.SetCount(it.pageSize)
+		return nil
+	})
+	res, err := f.Struct()
+	if err != nil {
+		release()
+		it.err = err
+		return
+	}
+	it.page, err = res.Items()
+	if err != nil {
+		release()
+		it.err = err
+		return
+	}
+	it.release = release
+	it.i = 0
+	it.done = res.Done()
+	if it.page.Len() == 0 && !it.done {
+		it.err = errors.New("paginator: server returned an empty page")
+	}
+}
+
+// Item returns the current item.  It is valid until the next call to
+// Next or Release.
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// Release releases the current page and the Paginator.
+func (it *Iterator[T]) Release() {
+	it.releasePage()
+	it.p.Release()
+}
+
+func (it *Iterator[T]) releasePage() {
+	if it.release != nil {
+		it.release()
+		it.release = nil
+	}
+	it.page = capnp.PointerList{}
+	it.i = 0
+}
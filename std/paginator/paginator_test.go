@@ -0,0 +1,124 @@
+package paginator_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/rpc/transport"
+	"capnproto.org/go/capnp/v3/std/paginator"
+)
+
+// textItems returns n Text pointers, "0" through "n-1".
+func textItems(t *testing.T, n int) []capnp.Ptr {
+	t.Helper()
+	_, seg := capnp.NewMultiSegmentMessage(nil)
+	items := make([]capnp.Ptr, n)
+	for i := range items {
+		text, err := capnp.NewText(seg, strconv.Itoa(i))
+		require.NoError(t, err)
+		items[i] = text.ToPtr()
+	}
+	return items
+}
+
+// remote serves p over an RPC connection and returns the client's view
+// of it.
+func remote(t *testing.T, p paginator.Paginator) paginator.Paginator {
+	t.Helper()
+	serverNetConn, clientNetConn := net.Pipe()
+	serverConn := rpc.NewConn(transport.NewStream(serverNetConn), &rpc.Options{
+		BootstrapClient: capnp.Client(p),
+	})
+	t.Cleanup(func() { serverConn.Close() })
+	clientConn := rpc.NewConn(transport.NewStream(clientNetConn), nil)
+	t.Cleanup(func() { clientConn.Close() })
+	client := paginator.Paginator(clientConn.Bootstrap(context.Background()))
+	require.NoError(t, client.Resolve(context.Background()))
+	return client
+}
+
+func TestIterator(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	for _, n := range []int{0, 1, 10, 11, 25} {
+		p := remote(t, paginator.ServeSlice(textItems(t, n)))
+		it := paginator.NewIterator[capnp.Ptr](p, 10)
+		var got []string
+		for it.Next(ctx) {
+			got = append(got, it.Item().Text())
+		}
+		require.NoError(t, it.Err(), "n = %d", n)
+		it.Release()
+
+		require.Len(t, got, n)
+		for i, s := range got {
+			assert.Equal(t, strconv.Itoa(i), s)
+		}
+	}
+}
+
+func TestPages(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	p := paginator.ServeSlice(textItems(t, paginator.MaxPageSize+5))
+	defer p.Release()
+
+	next := func(count uint32) (int, bool) {
+		f, release := p.Next(ctx, func(args paginator.Paginator_next_Params) error {
+			args.SetCount(count)
+			return nil
+		})
+		defer release()
+		res, err := f.Struct()
+		require.NoError(t, err)
+		items, err := res.Items()
+		require.NoError(t, err)
+		return items.Len(), res.Done()
+	}
+
+	n, done := next(3)
+	assert.Equal(t, 3, n)
+	assert.False(t, done)
+
+	n, done = next(paginator.MaxPageSize + 100)
+	assert.Equal(t, paginator.MaxPageSize, n, "pages should be limited to MaxPageSize")
+	assert.False(t, done)
+
+	n, done = next(2)
+	assert.Equal(t, 2, n)
+	assert.True(t, done, "the page that ends the sequence should be done")
+}
+
+func TestServeError(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	items := textItems(t, 3)
+	i := 0
+	p := remote(t, paginator.Serve(func(context.Context) (capnp.Ptr, bool, error) {
+		if i == len(items) {
+			return capnp.Ptr{}, false, errors.New("database went away")
+		}
+		i++
+		return items[i-1], true, nil
+	}))
+	it := paginator.NewIterator[capnp.Ptr](p, 2)
+	defer it.Release()
+
+	n := 0
+	for it.Next(ctx) {
+		n++
+	}
+	assert.Equal(t, 2, n, "items of the pages before the error should be read")
+	assert.ErrorContains(t, it.Err(), "database went away")
+}
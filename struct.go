@@ -2,6 +2,8 @@ package capnp
 
 import (
 	"errors"
+	"hash/fnv"
+	"io"
 
 	"capnproto.org/go/capnp/v3/exc"
 	"capnproto.org/go/capnp/v3/internal/str"
@@ -93,12 +95,34 @@ func (p Struct) Size() ObjectSize {
 // meaning there is a risk of data loss when copying from messages built
 // with future versions of the protocol.
 func (p Struct) CopyFrom(other Struct) error {
+	if p.flags&isViewMember != 0 {
+		return errListView
+	}
 	if err := copyStruct(p, other); err != nil {
 		return exc.WrapError("copy struct", err)
 	}
 	return nil
 }
 
+// Clone returns a deep copy of the struct in seg's message, preferring
+// placement in seg.  Capabilities are copied by reference, as with
+// DeepCopy.
+func (p Struct) Clone(seg *Segment) (Struct, error) {
+	c, err := DeepCopy(seg, p.ToPtr())
+	return c.Struct(), err
+}
+
+// Hash64 returns the 64-bit FNV-1a hash of the struct, as written by
+// Hash.  Equal hashes do not prove that structs are equal, but structs
+// with the same canonical form always have the same hash.
+func (p Struct) Hash64() (uint64, error) {
+	h := fnv.New64a()
+	if err := Hash(p.ToPtr(), h); err != nil {
+		return 0, err
+	}
+	return h.Sum64(), nil
+}
+
 // readSize returns the struct's size for the purposes of read limit
 // accounting.
 func (p Struct) readSize() Size {
@@ -116,6 +140,17 @@ func (p Struct) Ptr(i uint16) (Ptr, error) {
 	return p.seg.readPtr(p.pointerAddress(i), p.depthLimit)
 }
 
+// FieldPtr returns the i'th pointer in the struct, like Ptr, but wraps
+// any error in a *FieldError with the given path.  Generated accessors
+// use it so that errors name the field being read.
+func (p Struct) FieldPtr(i uint16, path string) (Ptr, error) {
+	ptr, err := p.Ptr(i)
+	if err != nil {
+		return Ptr{}, &FieldError{Path: path, Err: err}
+	}
+	return ptr, nil
+}
+
 // HasPtr reports whether the i'th pointer in the struct is non-null.
 // It does not affect the read limit.
 func (p Struct) HasPtr(i uint16) bool {
@@ -130,6 +165,9 @@ func (p Struct) SetPtr(i uint16, src Ptr) error {
 	if p.seg == nil || i >= p.size.PointerCount {
 		panic("capnp: set field outside struct boundaries")
 	}
+	if p.flags&isViewMember != 0 {
+		return errListView
+	}
 	return p.seg.writePtr(p.pointerAddress(i), src, false)
 }
 
@@ -174,6 +212,16 @@ func (p Struct) SetData(i uint16, v []byte) error {
 	return p.SetPtr(i, d.ToPtr())
 }
 
+// SetDataFromReader sets the i'th pointer to a newly allocated data
+// holding the next size bytes of r.  See NewDataFromReader.
+func (p Struct) SetDataFromReader(i uint16, r io.Reader, size int) error {
+	d, err := NewDataFromReader(p.seg, r, size)
+	if err != nil {
+		return err
+	}
+	return p.SetPtr(i, d.ToPtr())
+}
+
 func (p Struct) pointerAddress(i uint16) address {
 	// Struct already had bounds check
 	ptrStart, _ := p.off.addSize(p.size.DataSize)
@@ -200,6 +248,7 @@ func (p Struct) SetBit(n BitOffset, v bool) {
 	if !p.bitInData(n) {
 		panic("capnp: set field outside struct boundaries")
 	}
+	p.checkWritable()
 	addr := p.off.addOffset(n.offset())
 	b := p.seg.readUint8(addr)
 	if v {
@@ -259,6 +308,7 @@ func (p Struct) SetUint8(off DataOffset, v uint8) {
 	if !ok {
 		panic("capnp: set field outside struct boundaries")
 	}
+	p.checkWritable()
 	p.seg.writeUint8(addr, v)
 }
 
@@ -268,6 +318,7 @@ func (p Struct) SetUint16(off DataOffset, v uint16) {
 	if !ok {
 		panic("capnp: set field outside struct boundaries")
 	}
+	p.checkWritable()
 	p.seg.writeUint16(addr, v)
 }
 
@@ -277,6 +328,7 @@ func (p Struct) SetUint32(off DataOffset, v uint32) {
 	if !ok {
 		panic("capnp: set field outside struct boundaries")
 	}
+	p.checkWritable()
 	p.seg.writeUint32(addr, v)
 }
 
@@ -286,15 +338,25 @@ func (p Struct) SetUint64(off DataOffset, v uint64) {
 	if !ok {
 		panic("capnp: set field outside struct boundaries")
 	}
+	p.checkWritable()
 	p.seg.writeUint64(addr, v)
 }
 
+// checkWritable panics if p is an element of a view returned by
+// List.Slice.
+func (p Struct) checkWritable() {
+	if p.flags&isViewMember != 0 {
+		panic("capnp: write to read-only list view")
+	}
+}
+
 // structFlags is a bitmask of flags for a pointer.
 type structFlags uint8
 
 // Pointer flags.
 const (
 	isListMember structFlags = 1 << iota
+	isViewMember             // element of a list view; read-only
 )
 
 // copyStruct makes a deep copy of src into dst.
@@ -0,0 +1,251 @@
+package capnp
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"capnproto.org/go/capnp/v3/exc"
+	"capnproto.org/go/capnp/v3/internal/str"
+)
+
+// Validate checks that every object reachable from the message's root
+// is well-formed: that all pointers are in bounds, far pointers land on
+// valid landing pads and pointer types are known.  It returns an error
+// describing the first problem found.
+//
+// Validation is subject to DepthLimit and TraverseLimit in the same way
+// as reading the whole message would be, but it does not consume the
+// message's read limit.  A validated message can still fail to read if
+// the reader's schema does not match the message's layout.
+func (m *Message) Validate() error {
+	s, err := m.Segment(0)
+	if err != nil {
+		return exc.WrapError("validate", err)
+	}
+	if !s.regionInBounds(0, wordSize) {
+		return errors.New("validate: root pointer out of bounds")
+	}
+	limit := m.TraverseLimit
+	if limit == 0 {
+		limit = defaultTraverseLimit
+	}
+	v := validator{limit: limit}
+	if err := v.ptr(s, 0, m.depthLimit()); err != nil {
+		return exc.WrapError("validate", err)
+	}
+	return nil
+}
+
+// validator walks a message's objects, keeping track of the number of
+// bytes traversed.
+type validator struct {
+	limit uint64
+}
+
+func (v *validator) canRead(sz Size) bool {
+	if v.limit < uint64(sz) {
+		return false
+	}
+	v.limit -= uint64(sz)
+	return true
+}
+
+// ptr validates the pointer at paddr in s and the object it refers to,
+// mirroring the checks made by Segment.readPtr.
+func (v *validator) ptr(s *Segment, paddr address, depthLimit uint) error {
+	s, base, val, err := s.resolveFarPointer(paddr)
+	if err != nil {
+		return err
+	}
+	if val == 0 {
+		return nil
+	}
+	if depthLimit == 0 {
+		return errors.New("depth limit reached")
+	}
+	switch val.pointerType() {
+	case structPointer:
+		sp, err := s.readStructPtr(base, val)
+		if err != nil {
+			return err
+		}
+		if !v.canRead(sp.readSize()) {
+			return errors.New("read traversal limit reached")
+		}
+		return v.structPtrs(sp, depthLimit-1)
+	case listPointer:
+		lp, err := s.readListPtr(base, val)
+		if err != nil {
+			return err
+		}
+		if !v.canRead(lp.readSize()) {
+			return errors.New("read traversal limit reached")
+		}
+		return v.list(lp, depthLimit-1)
+	case otherPointer:
+		if val.otherPointerType() != 0 {
+			return errors.New("unknown pointer type")
+		}
+		return nil
+	default:
+		// Only other types are far pointers.
+		return errors.New("far pointer landing pad is a far pointer")
+	}
+}
+
+func (v *validator) structPtrs(p Struct, depthLimit uint) error {
+	for i := uint16(0); i < p.size.PointerCount; i++ {
+		if err := v.ptr(p.seg, p.pointerAddress(i), depthLimit); err != nil {
+			return exc.WrapError("struct pointer "+str.Utod(i), err)
+		}
+	}
+	return nil
+}
+
+func (v *validator) list(l List, depthLimit uint) error {
+	switch {
+	case l.flags&isCompositeList != 0:
+		elemDepth := depthLimit
+		if elemDepth > 0 {
+			elemDepth--
+		}
+		for i := 0; i < l.Len(); i++ {
+			if err := v.structPtrs(l.Struct(i), elemDepth); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+		}
+	case l.size.PointerCount > 0:
+		for i := 0; i < l.Len(); i++ {
+			addr, ok := l.off.element(int32(i), l.size.totalSize())
+			if !ok {
+				return errors.New("list element " + str.Itod(i) + ": address overflow")
+			}
+			if err := v.ptr(l.seg, addr, depthLimit); err != nil {
+				return exc.WrapError("list element "+str.Itod(i), err)
+			}
+		}
+	}
+	return nil
+}
+
+// A ValidationCache remembers the contents of messages that passed
+// validation, so that validating a byte-identical message again can be
+// skipped.  This trades the cost of hashing every segment of the message
+// for the cost of walking it, so it only pays off for workloads that
+// decode the same messages repeatedly, such as cached blobs.
+//
+// Set Decoder.ValidationCache or use ValidationCache.Unmarshal to
+// validate messages as they are decoded.  Validation does not change how
+// the message is read afterward: reads still count against the
+// message's read limit.
+//
+// The cache holds a bounded number of entries and evicts the least
+// recently used entry when full.  It is safe to use from multiple
+// goroutines.
+type ValidationCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[validationKey]*list.Element
+	lru     list.List // of validationKey, most recently used first
+}
+
+type validationKey [sha256.Size]byte
+
+// NewValidationCache returns a cache that remembers up to size
+// messages.
+func NewValidationCache(size int) *ValidationCache {
+	if size <= 0 {
+		panic("capnp: validation cache size must be positive")
+	}
+	return &ValidationCache{
+		size:    size,
+		entries: make(map[validationKey]*list.Element, size),
+	}
+}
+
+// Validate is like m.Validate, but skips validation if a message with
+// the same contents and limits has already passed validation through
+// the cache.  Messages that fail validation are not remembered.
+func (c *ValidationCache) Validate(m *Message) error {
+	key, err := validationKeyOf(m)
+	if err != nil {
+		return exc.WrapError("validate", err)
+	}
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok {
+		c.lru.MoveToFront(e)
+	}
+	c.mu.Unlock()
+	if ok {
+		return nil
+	}
+
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok {
+		return nil
+	}
+	if c.lru.Len() >= c.size {
+		oldest := c.lru.Back()
+		delete(c.entries, oldest.Value.(validationKey))
+		c.lru.Remove(oldest)
+	}
+	c.entries[key] = c.lru.PushFront(key)
+	return nil
+}
+
+// Unmarshal is like the Unmarshal function, but also validates the
+// message through the cache.  It returns an error if the message is
+// invalid.
+func (c *ValidationCache) Unmarshal(data []byte) (*Message, error) {
+	msg, err := Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Validate(msg); err != nil {
+		msg.Release()
+		return nil, exc.WrapError("unmarshal", err)
+	}
+	return msg, nil
+}
+
+// Len returns the number of messages remembered by the cache.
+func (c *ValidationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// validationKeyOf hashes the limits that validation depends on and the
+// contents of every segment in m.
+func validationKeyOf(m *Message) (validationKey, error) {
+	h := sha256.New()
+	var buf [8]byte
+	writeUint64 := func(v uint64) {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+	writeUint64(m.TraverseLimit)
+	writeUint64(uint64(m.depthLimit()))
+	n := m.NumSegments()
+	writeUint64(uint64(n))
+	for i := int64(0); i < n; i++ {
+		s, err := m.Segment(SegmentID(i))
+		if err != nil {
+			return validationKey{}, err
+		}
+		writeUint64(uint64(len(s.Data())))
+		h.Write(s.Data())
+	}
+	var key validationKey
+	h.Sum(key[:0])
+	return key, nil
+}
@@ -0,0 +1,235 @@
+package capnp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rawWords encodes words as a segment.
+func rawWords(words ...rawPointer) []byte {
+	b := make([]byte, 0, len(words)*int(wordSize))
+	for _, w := range words {
+		b = binary.LittleEndian.AppendUint64(b, uint64(w))
+	}
+	return b
+}
+
+// structChain returns a segment with a chain of n structs, each with a
+// single pointer to the next.
+func structChain(n int) []byte {
+	words := make([]rawPointer, n+1)
+	for i := 0; i < n; i++ {
+		words[i] = rawStructPointer(0, ObjectSize{PointerCount: 1})
+	}
+	return rawWords(words...)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		segs          [][]byte
+		depthLimit    uint
+		traverseLimit uint64
+		ok            bool
+	}{
+		{
+			name: "null root",
+			segs: [][]byte{rawWords(0)},
+			ok:   true,
+		},
+		{
+			name: "struct with list",
+			segs: [][]byte{rawWords(
+				rawStructPointer(0, ObjectSize{DataSize: 8, PointerCount: 1}),
+				0x1234,
+				rawListPointer(0, byte1List, 8),
+				0x0102030405060708,
+			)},
+			ok: true,
+		},
+		{
+			name: "composite list",
+			segs: [][]byte{rawWords(
+				rawListPointer(0, compositeList, 4),
+				rawStructPointer(2, ObjectSize{DataSize: 8, PointerCount: 1}),
+				0x1,
+				rawListPointer(2, byte1List, 8),
+				0x2,
+				0,
+				0x0102030405060708,
+			)},
+			ok: true,
+		},
+		{
+			name: "far pointer",
+			segs: [][]byte{
+				rawWords(rawFarPointer(1, 0)),
+				rawWords(rawStructPointer(0, ObjectSize{DataSize: 8}), 0x1),
+			},
+			ok: true,
+		},
+		{
+			name: "root out of bounds",
+			segs: [][]byte{{}},
+		},
+		{
+			name: "struct out of bounds",
+			segs: [][]byte{rawWords(rawStructPointer(0, ObjectSize{DataSize: 8, PointerCount: 2}), 0)},
+		},
+		{
+			name: "nested list out of bounds",
+			segs: [][]byte{rawWords(
+				rawStructPointer(0, ObjectSize{PointerCount: 1}),
+				rawListPointer(0, byte8List, 2),
+				0,
+			)},
+		},
+		{
+			name: "composite list element out of bounds",
+			segs: [][]byte{rawWords(
+				rawListPointer(0, compositeList, 1),
+				rawStructPointer(1, ObjectSize{PointerCount: 1}),
+				rawStructPointer(5, ObjectSize{DataSize: 8}),
+			)},
+		},
+		{
+			name: "far pointer to missing segment",
+			segs: [][]byte{rawWords(rawFarPointer(1, 0))},
+		},
+		{
+			name: "unknown pointer type",
+			segs: [][]byte{rawWords(rawPointer(otherPointer) | 4)},
+		},
+		{
+			name:       "depth limit",
+			segs:       [][]byte{structChain(4)},
+			depthLimit: 3,
+		},
+		{
+			name:       "within depth limit",
+			segs:       [][]byte{structChain(3)},
+			depthLimit: 3,
+			ok:         true,
+		},
+		{
+			name:          "traversal limit",
+			segs:          [][]byte{rawWords(rawListPointer(0, byte8List, 4), 1, 2, 3, 4)},
+			traverseLimit: 24,
+		},
+		{
+			name:          "within traversal limit",
+			segs:          [][]byte{rawWords(rawListPointer(0, byte8List, 4), 1, 2, 3, 4)},
+			traverseLimit: 32,
+			ok:            true,
+		},
+	}
+	for _, test := range tests {
+		msg := &Message{
+			Arena:         MultiSegment(test.segs),
+			DepthLimit:    test.depthLimit,
+			TraverseLimit: test.traverseLimit,
+		}
+		err := msg.Validate()
+		if test.ok {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Error(t, err, test.name)
+		}
+	}
+}
+
+func TestValidateReadLimit(t *testing.T) {
+	t.Parallel()
+
+	msg := &Message{
+		Arena:         SingleSegment(rawWords(rawListPointer(0, byte8List, 4), 1, 2, 3, 4)),
+		TraverseLimit: 32,
+	}
+	require.NoError(t, msg.Validate())
+
+	// Validation must not use up the message's read limit.
+	p, err := msg.Root()
+	require.NoError(t, err)
+	assert.Equal(t, 4, p.List().Len())
+}
+
+func TestValidationCache(t *testing.T) {
+	t.Parallel()
+
+	newMsg := func(words ...rawPointer) *Message {
+		return &Message{Arena: SingleSegment(rawWords(words...))}
+	}
+	valid := func(v rawPointer) *Message {
+		return newMsg(rawStructPointer(0, ObjectSize{DataSize: 8}), v)
+	}
+
+	c := NewValidationCache(2)
+	require.NoError(t, c.Validate(valid(1)))
+	assert.Equal(t, 1, c.Len())
+	require.NoError(t, c.Validate(valid(1)), "byte-identical message")
+	assert.Equal(t, 1, c.Len(), "byte-identical message added to cache")
+
+	// Messages that fail validation are not cached.
+	invalid := newMsg(rawStructPointer(0, ObjectSize{DataSize: 16}), 1)
+	assert.Error(t, c.Validate(invalid))
+	assert.Error(t, c.Validate(invalid), "second validation")
+	assert.Equal(t, 1, c.Len())
+
+	// The limits are part of the key.
+	limited := valid(1)
+	limited.TraverseLimit = 4
+	assert.Error(t, c.Validate(limited), "cached result used with lower traversal limit")
+
+	require.NoError(t, c.Validate(valid(2)))
+	require.NoError(t, c.Validate(valid(3)))
+	assert.Equal(t, 2, c.Len(), "cache exceeded its size")
+}
+
+func TestValidationCacheDecode(t *testing.T) {
+	t.Parallel()
+
+	_, seg, err := NewMessage(SingleSegment(nil))
+	require.NoError(t, err)
+	root, err := NewRootStruct(seg, ObjectSize{PointerCount: 1})
+	require.NoError(t, err)
+	l, err := NewInt64List(seg, 4)
+	require.NoError(t, err)
+	require.NoError(t, root.SetPtr(0, l.ToPtr()))
+	data, err := seg.Message().Marshal()
+	require.NoError(t, err)
+
+	c := NewValidationCache(1)
+	d := NewDecoder(bytes.NewReader(append(append([]byte(nil), data...), data...)))
+	d.ValidationCache = c
+	for i := 0; i < 2; i++ {
+		msg, err := d.Decode()
+		require.NoError(t, err)
+		assert.Equal(t, 1, c.Len())
+
+		p, err := msg.Root()
+		require.NoError(t, err)
+		lp, err := p.Struct().Ptr(0)
+		require.NoError(t, err)
+		assert.Equal(t, 4, lp.List().Len())
+		msg.Release()
+	}
+
+	msg, err := c.Unmarshal(data)
+	require.NoError(t, err)
+	_, err = msg.Root()
+	assert.NoError(t, err)
+
+	// Validation does not lift the read limit.
+	msg.ResetReadLimit(0)
+	_, err = msg.Root()
+	assert.Error(t, err)
+
+	_, err = c.Unmarshal(rawWords(0, rawStructPointer(0, ObjectSize{DataSize: 16})))
+	assert.Error(t, err, "invalid message")
+}